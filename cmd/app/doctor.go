@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/app"
+	"github.com/device-management-toolkit/console/internal/controller/tcp/cira"
+	"github.com/device-management-toolkit/console/internal/doctor"
+	secrets "github.com/device-management-toolkit/console/pkg/secrets/vault"
+)
+
+const defaultNTPServer = "pool.ntp.org"
+
+// runDoctor runs the preflight diagnostics (`console doctor`) and returns the process exit code.
+func runDoctor(ctx context.Context, cfg *config.Config, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	asJSON := fs.Bool("json", false, "print the report as JSON")
+	ntpServer := fs.String("ntp-server", defaultNTPServer, "NTP server used to check the local clock")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	report := doctor.Run(ctx, doctorChecks(cfg, *ntpServer))
+
+	write := report.WriteText
+	if *asJSON {
+		write = report.WriteJSON
+	}
+
+	if err := write(stdout); err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return 1
+	}
+
+	if report.Failed {
+		return 1
+	}
+
+	return 0
+}
+
+func doctorChecks(cfg *config.Config, ntpServer string) []doctor.Check {
+	addresses := []string{net.JoinHostPort(cfg.ListenHost(), cfg.Port)}
+	if !cfg.DisableCIRA {
+		addresses = append(addresses, ":"+cira.Port)
+	}
+
+	return []doctor.Check{
+		doctor.Config(cfg),
+		doctor.Database(cfg, sql.Open, app.LatestMigrationVersion),
+		doctor.SecretStore(&cfg.Secrets, func(s *config.Secrets) (doctor.CapabilityChecker, error) {
+			return secrets.NewClient(s)
+		}),
+		doctor.Certificates(cfg, fmt.Sprintf("config/%s_cert.pem", cfg.CommonName)),
+		doctor.Ports(addresses...),
+		doctor.Clock(ntpServer),
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// fixtureCall is a read-only Management call recorded into a fixture.
+type fixtureCall struct {
+	name string
+	call func(ctx context.Context, m wsman.Management) error
+}
+
+var fixtureCalls = []fixtureCall{
+	{"GetSetupAndConfiguration", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetSetupAndConfiguration(ctx)
+
+		return err
+	}},
+	{"GetGeneralSettings", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetGeneralSettings(ctx)
+
+		return err
+	}},
+	{"GetHardwareInfo", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetHardwareInfo(ctx)
+
+		return err
+	}},
+	{"GetDiskInfo", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetDiskInfo(ctx)
+
+		return err
+	}},
+	{"GetPowerState", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetPowerState(ctx)
+
+		return err
+	}},
+	{"GetOSPowerSavingState", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetOSPowerSavingState(ctx)
+
+		return err
+	}},
+	{"GetPowerCapabilities", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetPowerCapabilities(ctx)
+
+		return err
+	}},
+	{"GetAMTRedirectionService", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetAMTRedirectionService(ctx)
+
+		return err
+	}},
+	{"GetIPSOptInService", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetIPSOptInService(ctx)
+
+		return err
+	}},
+	{"GetKVMRedirection", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetKVMRedirection(ctx)
+
+		return err
+	}},
+	{"GetIPSKVMRedirectionSettingData", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetIPSKVMRedirectionSettingData(ctx)
+
+		return err
+	}},
+	{"GetAlarmOccurrences", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetAlarmOccurrences(ctx)
+
+		return err
+	}},
+	{"GetBootData", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetBootData(ctx)
+
+		return err
+	}},
+	{"GetCIMBootSourceSetting", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetCIMBootSourceSetting(ctx)
+
+		return err
+	}},
+	{"GetNetworkSettings", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetNetworkSettings(ctx)
+
+		return err
+	}},
+	{"GetCertificates", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetCertificates(ctx)
+
+		return err
+	}},
+	{"GetTLSSettingData", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetTLSSettingData(ctx)
+
+		return err
+	}},
+	{"GetAuditLog", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetAuditLog(ctx, 1)
+
+		return err
+	}},
+	{"GetEventLog", func(ctx context.Context, m wsman.Management) error {
+		_, err := m.GetEventLog(ctx, 1, 390)
+
+		return err
+	}},
+}
+
+// runRecordFixture records the read-only WS-Man calls of a live device into a sanitized
+// fixture (`console record-fixture`) and returns the process exit code.
+func runRecordFixture(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("record-fixture", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	host := fs.String("host", "", "address of the AMT device")
+	username := fs.String("username", "admin", "AMT user")
+	useTLS := fs.Bool("tls", false, "connect with TLS on port 16993")
+	selfSigned := fs.Bool("self-signed", true, "accept a self-signed device certificate")
+	name := fs.String("name", "", "name of the fixture, defaults to the AMT version")
+	out := fs.String("o", "fixture.json", "file the fixture is written to")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	// the password is taken from the environment so that it does not show in the process list
+	password := os.Getenv("AMT_PASSWORD")
+	if *host == "" || password == "" {
+		fmt.Fprintln(stderr, "record-fixture needs -host and the AMT_PASSWORD environment variable")
+
+		return 2
+	}
+
+	rec := wsman.NewRecorder(*name, *host)
+	m := wsman.NewRecordingManagement(client.Parameters{
+		Target:            *host,
+		Username:          *username,
+		Password:          password,
+		UseDigest:         true,
+		UseTLS:            *useTLS,
+		SelfSignedAllowed: *selfSigned,
+	}, rec)
+
+	versions, err := m.GetAMTVersion(ctx)
+	if err != nil {
+		fmt.Fprintf(stderr, "GetAMTVersion: %v\n", err)
+
+		return 1
+	}
+
+	for i := range versions {
+		if versions[i].InstanceID == "AMT" {
+			rec.SetAMTVersion(versions[i].VersionString)
+		}
+	}
+
+	for _, c := range fixtureCalls {
+		if err := c.call(ctx, m); err != nil {
+			// the fault of a call the firmware does not support is recorded too, replaying it fails the same way
+			fmt.Fprintf(stderr, "%s: %v\n", c.name, err)
+		}
+	}
+
+	f := rec.Fixture()
+	if f.Name == "" {
+		f.Name = "AMT " + f.AMTVersion
+	}
+
+	if err := f.Save(*out); err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return 1
+	}
+
+	fmt.Fprintf(stdout, "recorded %d exchanges of AMT %s to %s\n", len(f.Exchanges), f.AMTVersion, *out)
+
+	return 0
+}
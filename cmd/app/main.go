@@ -2,12 +2,18 @@ package main
 
 import (
 	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/x509"
 	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"os/exec"
 	"runtime"
+	"time"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
 
@@ -24,6 +30,9 @@ import (
 var (
 	ErrSecretStoreAddressNotConfigured = errors.New("secret store address not configured")
 	ErrSecretStoreTokenNotConfigured   = errors.New("secret store token not configured")
+	ErrRootKeyBackend                  = errors.New("unknown root key backend")
+	ErrRootKeyNeedsSecretStore         = errors.New("the transit root key backend needs the secret store")
+	ErrTransitNeedsSecretStore         = errors.New("transit encryption needs the secret store")
 )
 
 // Function pointers for better testability.
@@ -35,12 +44,14 @@ var (
 	NewGeneratorFunc = func(u usecase.Usecases, l logger.Interface) interface {
 		GenerateSpec() ([]byte, error)
 		SaveSpec([]byte, string) error
+		SaveVersionedSpecs(string) error
 	} {
 		return openapi.NewGenerator(u, l)
 	}
 	// Certificate loading functions for testability.
-	loadOrGenerateRootCertFunc      = certificates.LoadOrGenerateRootCertificateWithVault
-	loadOrGenerateWebServerCertFunc = certificates.LoadOrGenerateWebServerCertificateWithVault
+	loadOrGenerateRootCertFunc        = certificates.LoadOrGenerateRootCertificateWithVault
+	loadOrGenerateWebServerCertFunc   = certificates.LoadOrGenerateWebServerCertificateWithVault
+	loadOrGenerateRootCertWithKeyFunc = certificates.LoadOrGenerateRootCertificateWithKey
 )
 
 func main() {
@@ -49,6 +60,14 @@ func main() {
 		log.Fatalf("Config error: %s", err)
 	}
 
+	if flag.Arg(0) == "doctor" {
+		os.Exit(runDoctor(context.Background(), cfg, flag.Args()[1:], os.Stdout, os.Stderr))
+	}
+
+	if flag.Arg(0) == "record-fixture" {
+		os.Exit(runRecordFixture(context.Background(), flag.Args()[1:], os.Stdout, os.Stderr))
+	}
+
 	if err = initializeAppFunc(cfg); err != nil {
 		log.Fatalf("App init error: %s", err)
 	}
@@ -63,7 +82,16 @@ func main() {
 		log.Fatalf("CIRA certificate setup error: %s", err)
 	}
 
-	handleEncryptionKey(cfg)
+	if err = setupTransitEncryption(cfg, secretsClient); err != nil {
+		log.Fatalf("Transit encryption setup error: %s", err)
+	}
+
+	if cfg.TransitKey == "" {
+		handleEncryptionKey(cfg)
+	}
+
+	app.CertStore = withSecretsCache(cfg, app.CertStore)
+
 	handleDebugMode(cfg)
 	runAppFunc(cfg)
 }
@@ -73,12 +101,35 @@ func setupCIRACertificates(cfg *config.Config, secretsClient security.Storager)
 		return nil
 	}
 
-	root, privateKey, err := loadOrGenerateRootCertFunc(secretsClient, true, cfg.CommonName, "US", "device-management-toolkit", true)
+	alg, err := certificates.ParseKeyAlgorithm(cfg.CertificateKeyAlgorithm)
+	if err != nil {
+		return err
+	}
+
+	rootKey, err := rootKeySigner(cfg, secretsClient)
+	if err != nil {
+		return fmt.Errorf("opening root key: %w", err)
+	}
+
+	var (
+		root       *x509.Certificate
+		privateKey crypto.Signer
+	)
+
+	if rootKey != nil {
+		privateKey = rootKey
+		root, err = loadOrGenerateRootCertWithKeyFunc(secretsClient, rootKey, true, cfg.CommonName, "US", "device-management-toolkit")
+	} else {
+		root, privateKey, err = loadOrGenerateRootCertFunc(secretsClient, true, cfg.CommonName, "US", "device-management-toolkit", alg)
+	}
+
 	if err != nil {
 		return fmt.Errorf("loading or generating root certificate: %w", err)
 	}
 
-	_, _, err = loadOrGenerateWebServerCertFunc(secretsClient, certificates.CertAndKeyType{Cert: root, Key: privateKey}, false, cfg.CommonName, "US", "device-management-toolkit", true)
+	app.RootKey = rootKey
+
+	_, _, err = loadOrGenerateWebServerCertFunc(secretsClient, certificates.CertAndKeyType{Cert: root, Key: privateKey}, false, cfg.CommonName, "US", "device-management-toolkit", alg)
 	if err != nil {
 		return fmt.Errorf("loading or generating web server certificate: %w", err)
 	}
@@ -86,9 +137,56 @@ func setupCIRACertificates(cfg *config.Config, secretsClient security.Storager)
 	return nil
 }
 
+// rootKeySigner opens the private key of the root CA kept on a token or in the Vault transit engine, there is none
+// with the file backend.
+func rootKeySigner(cfg *config.Config, secretsClient security.Storager) (crypto.Signer, error) {
+	switch cfg.RootKey.Backend {
+	case "", "file":
+		return nil, nil
+	case "pkcs11":
+		return certificates.NewPKCS11Signer(certificates.PKCS11Config{
+			Module:     cfg.RootKey.PKCS11Module,
+			TokenLabel: cfg.RootKey.PKCS11TokenLabel,
+			PIN:        cfg.RootKey.PKCS11PIN,
+			KeyLabel:   cfg.RootKey.PKCS11KeyLabel,
+		})
+	case "transit":
+		client, ok := secretsClient.(*secrets.Client)
+		if !ok {
+			return nil, ErrRootKeyNeedsSecretStore
+		}
+
+		return client.TransitSigner(cfg.RootKey.TransitMount, cfg.RootKey.TransitKey)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrRootKeyBackend, cfg.RootKey.Backend)
+	}
+}
+
+// setupTransitEncryption encrypts the passwords with the key of the Vault transit engine when one is configured. The
+// local encryption key is then neither loaded nor generated, only the one of the configuration is kept to decrypt
+// the passwords encrypted before.
+func setupTransitEncryption(cfg *config.Config, secretsClient security.Storager) error {
+	if cfg.TransitKey == "" {
+		return nil
+	}
+
+	client, ok := secretsClient.(*secrets.Client)
+	if !ok {
+		return ErrTransitNeedsSecretStore
+	}
+
+	app.Cryptor = client.TransitCryptor(cfg.TransitMount, cfg.TransitKey, cfg.EncryptionKey)
+
+	log.Printf("Passwords encrypted with the transit key %s", cfg.TransitKey)
+
+	return nil
+}
+
 func handleDebugMode(cfg *config.Config) {
 	if os.Getenv("GIN_MODE") != "debug" {
-		go launchBrowser(cfg)
+		if cfg.AutoOpenBrowser {
+			go launchBrowser(cfg)
+		}
 	} else {
 		if err := handleOpenAPIGeneration(); err != nil {
 			log.Fatalf("Failed to generate OpenAPI spec: %s", err)
@@ -96,17 +194,31 @@ func handleDebugMode(cfg *config.Config) {
 	}
 }
 
+// launchBrowser opens the console UI in the default browser. Failing to do so is not fatal,
+// headless servers typically have no browser or xdg-open installed.
 func launchBrowser(cfg *config.Config) {
 	scheme := "http"
 	if cfg.TLS.Enabled {
 		scheme = "https"
 	}
 
-	if err := openBrowser(scheme+"://localhost:"+cfg.Port, runtime.GOOS); err != nil {
-		panic(err)
+	url := scheme + "://" + net.JoinHostPort(browserHost(cfg.ListenHost()), cfg.Port)
+
+	if err := openBrowser(url, runtime.GOOS); err != nil {
+		log.Printf("Warning: could not open browser, browse to %s manually: %v", url, err)
 	}
 }
 
+// browserHost returns the host the browser should connect to for the given bind address.
+func browserHost(bindAddress string) string {
+	ip := net.ParseIP(bindAddress)
+	if bindAddress == "" || (ip != nil && ip.IsUnspecified()) {
+		return "localhost"
+	}
+
+	return bindAddress
+}
+
 func handleOpenAPIGeneration() error {
 	l := logger.New("info")
 	usecases := usecase.Usecases{}
@@ -125,7 +237,12 @@ func handleOpenAPIGeneration() error {
 		return err
 	}
 
-	log.Println("OpenAPI specification generated at doc/openapi.json")
+	// Save one spec per API version alongside the combined spec
+	if err := generator.SaveVersionedSpecs("doc/openapi.json"); err != nil {
+		return err
+	}
+
+	log.Println("OpenAPI specifications generated in doc/")
 
 	return nil
 }
@@ -151,6 +268,55 @@ func handleSecretsConfig(cfg *config.Config) (security.Storager, error) {
 	return secretsClient, nil
 }
 
+// withSecretsCache wraps the secret store with the break-glass cache when it is enabled.
+// The cache is encrypted with the console encryption key, so it must be called after handleEncryptionKey.
+// Without a usable key, as with transit encryption and no local key configured, the cache is disabled
+// with a warning rather than failing to write each entry.
+func withSecretsCache(cfg *config.Config, store security.Storager) security.Storager {
+	if !cfg.CacheEnabled || store == nil {
+		return store
+	}
+
+	// with transit encryption the local encryption key is only there when configured
+	if cfg.EncryptionKey == "" {
+		log.Println("Warning: Secrets cache disabled: it is encrypted with the encryption key, which is not configured")
+
+		return store
+	}
+
+	if _, err := aes.NewCipher([]byte(cfg.EncryptionKey)); err != nil {
+		log.Printf("Warning: Secrets cache disabled: the encryption key cannot encrypt it: %v", err)
+
+		return store
+	}
+
+	objStore, ok := store.(secrets.ObjectStorager)
+	if !ok {
+		return store
+	}
+
+	path := cfg.CachePath
+	if path == "" {
+		var err error
+
+		path, err = secrets.DefaultCachePath()
+		if err != nil {
+			log.Printf("Warning: Secrets cache disabled: %v", err)
+
+			return store
+		}
+	}
+
+	l := logger.New(cfg.Level)
+	audit := func(key string, cachedAt time.Time, cause error) {
+		l.Warn("audit: secret store unreachable, using cached secret %s from %s: %v", key, cachedAt.Format(time.RFC3339), cause)
+	}
+
+	log.Printf("Secrets cache enabled at: %s", path)
+
+	return secrets.NewCachedClient(objStore, security.Crypto{EncryptionKey: cfg.EncryptionKey}, path, cfg.CacheTTL, audit)
+}
+
 func handleEncryptionKey(cfg *config.Config) {
 	// If encryption key is already provided via config/env, just use it
 	if cfg.EncryptionKey != "" {
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"crypto"
 	"crypto/rsa"
 	"crypto/x509"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,9 +14,11 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
 
 	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/app"
 	"github.com/device-management-toolkit/console/internal/certificates"
 	"github.com/device-management-toolkit/console/internal/usecase"
 	"github.com/device-management-toolkit/console/pkg/logger"
+	secrets "github.com/device-management-toolkit/console/pkg/secrets/vault"
 )
 
 type MockCommandExecutor struct {
@@ -42,11 +46,11 @@ func TestMainFunction(_ *testing.T) { //nolint:paralleltest // cannot have simul
 	runAppFunc = func(_ *config.Config) {}
 
 	// Mock certificate functions
-	loadOrGenerateRootCertFunc = func(_ security.Storager, _ bool, _, _, _ string, _ bool) (*x509.Certificate, *rsa.PrivateKey, error) {
+	loadOrGenerateRootCertFunc = func(_ security.Storager, _ bool, _, _, _ string, _ certificates.KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
 		return &x509.Certificate{}, &rsa.PrivateKey{}, nil
 	}
 
-	loadOrGenerateWebServerCertFunc = func(_ security.Storager, _ certificates.CertAndKeyType, _ bool, _, _, _ string, _ bool) (*x509.Certificate, *rsa.PrivateKey, error) {
+	loadOrGenerateWebServerCertFunc = func(_ security.Storager, _ certificates.CertAndKeyType, _ bool, _, _, _ string, _ certificates.KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
 		return &x509.Certificate{}, &rsa.PrivateKey{}, nil
 	}
 
@@ -111,6 +115,12 @@ func (m *MockGenerator) SaveSpec(b []byte, path string) error {
 	return args.Error(0)
 }
 
+func (m *MockGenerator) SaveVersionedSpecs(path string) error {
+	args := m.Called(path)
+
+	return args.Error(0)
+}
+
 //nolint:paralleltest // modifies package-level NewGeneratorFunc
 func TestHandleOpenAPIGeneration_Success(t *testing.T) {
 	mockGen := new(MockGenerator)
@@ -118,6 +128,7 @@ func TestHandleOpenAPIGeneration_Success(t *testing.T) {
 	NewGeneratorFunc = func(_ usecase.Usecases, _ logger.Interface) interface {
 		GenerateSpec() ([]byte, error)
 		SaveSpec([]byte, string) error
+		SaveVersionedSpecs(string) error
 	} {
 		return mockGen
 	}
@@ -125,6 +136,7 @@ func TestHandleOpenAPIGeneration_Success(t *testing.T) {
 	expectedSpec := []byte("{}")
 	mockGen.On("GenerateSpec").Return(expectedSpec, nil)
 	mockGen.On("SaveSpec", expectedSpec, "doc/openapi.json").Return(nil)
+	mockGen.On("SaveVersionedSpecs", "doc/openapi.json").Return(nil)
 
 	err := handleOpenAPIGeneration()
 	assert.NoError(t, err)
@@ -139,6 +151,7 @@ func TestHandleOpenAPIGeneration_GenerateFails(t *testing.T) {
 	NewGeneratorFunc = func(_ usecase.Usecases, _ logger.Interface) interface {
 		GenerateSpec() ([]byte, error)
 		SaveSpec([]byte, string) error
+		SaveVersionedSpecs(string) error
 	} {
 		return mockGen
 	}
@@ -150,3 +163,103 @@ func TestHandleOpenAPIGeneration_GenerateFails(t *testing.T) {
 
 	mockGen.AssertExpectations(t)
 }
+
+func TestLaunchBrowserFailureDoesNotPanic(t *testing.T) { //nolint:paralleltest // cannot have simultaneous tests modifying executor.
+	mockCmdExecutor := new(MockCommandExecutor)
+	cmdExecutor = mockCmdExecutor
+
+	mockCmdExecutor.On("Execute", mock.Anything, mock.Anything).Return(assert.AnError)
+
+	cfg := &config.Config{HTTP: config.HTTP{Host: "localhost", Port: "8181"}, Server: config.Server{BindAddress: "0.0.0.0"}}
+
+	assert.NotPanics(t, func() { launchBrowser(cfg) })
+	mockCmdExecutor.AssertExpectations(t)
+}
+
+func TestHandleDebugModeAutoOpenBrowserDisabled(t *testing.T) { //nolint:paralleltest // cannot have simultaneous tests modifying env variables.
+	t.Setenv("GIN_MODE", "release")
+
+	mockCmdExecutor := new(MockCommandExecutor)
+	cmdExecutor = mockCmdExecutor
+
+	handleDebugMode(&config.Config{UI: config.UI{AutoOpenBrowser: false}})
+
+	mockCmdExecutor.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestBrowserHost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		bindAddress string
+		expected    string
+	}{
+		{bindAddress: "", expected: "localhost"},
+		{bindAddress: "0.0.0.0", expected: "localhost"},
+		{bindAddress: "::", expected: "localhost"},
+		{bindAddress: "192.168.1.10", expected: "192.168.1.10"},
+		{bindAddress: "console.local", expected: "console.local"},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, browserHost(tc.bindAddress))
+	}
+}
+
+func TestWithSecretsCache(t *testing.T) {
+	t.Parallel()
+
+	store, err := secrets.NewClient(&config.Secrets{Address: "http://localhost:8200", Token: "token"})
+	assert.NoError(t, err)
+
+	disabled := &config.Config{}
+	assert.Same(t, store, withSecretsCache(disabled, store))
+	assert.Nil(t, withSecretsCache(&config.Config{Secrets: config.Secrets{CacheEnabled: true}}, nil))
+
+	enabled := &config.Config{Secrets: config.Secrets{CacheEnabled: true, CachePath: filepath.Join(t.TempDir(), "secrets.cache")}}
+	enabled.EncryptionKey = "0123456789abcdef0123456789abcdef"
+	assert.IsType(t, &secrets.CachedClient{}, withSecretsCache(enabled, store))
+
+	// a key that cannot encrypt the cache disables it instead of failing each write
+	invalid := &config.Config{Secrets: config.Secrets{CacheEnabled: true, CachePath: filepath.Join(t.TempDir(), "secrets.cache")}}
+	invalid.EncryptionKey = "short"
+	assert.Same(t, store, withSecretsCache(invalid, store))
+}
+
+func TestRootKeySigner(t *testing.T) {
+	t.Parallel()
+
+	signer, err := rootKeySigner(&config.Config{RootKey: config.RootKey{Backend: "file"}}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, signer)
+
+	_, err = rootKeySigner(&config.Config{RootKey: config.RootKey{Backend: "transit", TransitKey: "root"}}, nil)
+	assert.ErrorIs(t, err, ErrRootKeyNeedsSecretStore)
+
+	_, err = rootKeySigner(&config.Config{RootKey: config.RootKey{Backend: "kms"}}, nil)
+	assert.ErrorIs(t, err, ErrRootKeyBackend)
+}
+
+func TestSetupTransitEncryption(t *testing.T) { //nolint:paralleltest // cannot have simultaneous tests modifying app.Cryptor.
+	assert.NoError(t, setupTransitEncryption(&config.Config{}, nil))
+	assert.Nil(t, app.Cryptor)
+
+	transit := &config.Config{Secrets: config.Secrets{TransitKey: "passwords", TransitMount: "transit"}}
+	assert.ErrorIs(t, setupTransitEncryption(transit, nil), ErrTransitNeedsSecretStore)
+
+	store, err := secrets.NewClient(&config.Secrets{Address: "http://localhost:8200", Token: "token"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, setupTransitEncryption(transit, store))
+	assert.IsType(t, &secrets.TransitCryptor{}, app.Cryptor)
+
+	app.Cryptor = nil
+
+	// the cache is not encrypted without a local key, it is with the configured one
+	transit.CacheEnabled = true
+	transit.CachePath = filepath.Join(t.TempDir(), "secrets.cache")
+	assert.Same(t, store, withSecretsCache(transit, store))
+
+	transit.EncryptionKey = "0123456789abcdef0123456789abcdef"
+	assert.IsType(t, &secrets.CachedClient{}, withSecretsCache(transit, store))
+}
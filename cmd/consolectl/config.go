@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/device-management-toolkit/console/pkg/client"
+)
+
+// Backup is the document written by the backup command.
+// Secrets are not returned by the API and are therefore not part of a backup.
+type Backup struct {
+	CreatedAt        time.Time                `json:"createdAt"`
+	Console          string                   `json:"console"`
+	Domains          []client.Domain          `json:"domains"`
+	CIRAConfigs      []client.CIRAConfig      `json:"ciraConfigs"`
+	WirelessConfigs  []client.WirelessConfig  `json:"wirelessConfigs"`
+	IEEE8021xConfigs []client.IEEE8021xConfig `json:"ieee8021xConfigs"`
+	Profiles         []client.Profile         `json:"profiles"`
+	Devices          []client.Device          `json:"devices"`
+}
+
+func runProfilesApply(ctx context.Context, app *cli, args []string) error {
+	fs := flag.NewFlagSet("profiles apply", flag.ContinueOnError)
+	fs.SetOutput(app.stderr)
+
+	file := fs.String("f", "", "JSON file holding a profile or a list of profiles, - for stdin")
+
+	if err := fs.Parse(args); err != nil {
+		return ErrUsage
+	}
+
+	if *file == "" {
+		return fmt.Errorf("%w: profiles apply -f <file>", ErrUsage)
+	}
+
+	profiles, err := readProfiles(*file)
+	if err != nil {
+		return err
+	}
+
+	for i := range profiles {
+		created, err := applyProfile(ctx, app.client, &profiles[i])
+		if err != nil {
+			return fmt.Errorf("profile %q: %w", profiles[i].ProfileName, err)
+		}
+
+		verb := "updated"
+		if created {
+			verb = "created"
+		}
+
+		fmt.Fprintf(app.stdout, "profile %q %s\n", profiles[i].ProfileName, verb)
+	}
+
+	return nil
+}
+
+// applyProfile updates the profile when it already exists and creates it otherwise.
+func applyProfile(ctx context.Context, c *client.Client, p *client.Profile) (bool, error) {
+	existing, err := c.Profiles.Get(ctx, p.ProfileName)
+
+	var apiErr *client.APIError
+
+	switch {
+	case err == nil:
+		if p.Version == "" {
+			p.Version = existing.Version
+		}
+
+		_, err = c.Profiles.Update(ctx, *p)
+
+		return false, err
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+		_, err = c.Profiles.Create(ctx, *p)
+
+		return true, err
+	default:
+		return false, err
+	}
+}
+
+// readProfiles decodes either a single profile object or an array of profiles.
+func readProfiles(file string) ([]client.Profile, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []client.Profile
+	if err := json.Unmarshal(data, &profiles); err == nil {
+		return profiles, nil
+	}
+
+	var profile client.Profile
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", file, err)
+	}
+
+	return []client.Profile{profile}, nil
+}
+
+func runBackup(ctx context.Context, app *cli, args []string) error {
+	fs := flag.NewFlagSet("backup", flag.ContinueOnError)
+	fs.SetOutput(app.stderr)
+
+	output := fs.String("o", "-", "output file, - for stdout")
+
+	if err := fs.Parse(args); err != nil {
+		return ErrUsage
+	}
+
+	backup := Backup{CreatedAt: time.Now().UTC(), Console: app.baseURL}
+
+	var err error
+
+	if backup.Domains, err = collect(ctx, app.client.Domains); err != nil {
+		return fmt.Errorf("domains: %w", err)
+	}
+
+	if backup.CIRAConfigs, err = collect(ctx, app.client.CIRAConfigs); err != nil {
+		return fmt.Errorf("cira configs: %w", err)
+	}
+
+	if backup.WirelessConfigs, err = collect(ctx, app.client.WirelessConfigs); err != nil {
+		return fmt.Errorf("wireless configs: %w", err)
+	}
+
+	if backup.IEEE8021xConfigs, err = collect(ctx, app.client.IEEE8021xConfigs); err != nil {
+		return fmt.Errorf("ieee8021x configs: %w", err)
+	}
+
+	if backup.Profiles, err = collect(ctx, app.client.Profiles); err != nil {
+		return fmt.Errorf("profiles: %w", err)
+	}
+
+	if backup.Devices, err = collect(ctx, app.client.Devices); err != nil {
+		return fmt.Errorf("devices: %w", err)
+	}
+
+	w := app.stdout
+
+	if *output != "-" {
+		f, err := os.OpenFile(*output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(backup)
+}
+
+func collect[T any](ctx context.Context, col *client.Collection[T]) ([]T, error) {
+	items := []T{}
+
+	for item, err := range col.All(ctx, client.DefaultPageSize) {
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/device-management-toolkit/console/pkg/client"
+)
+
+// powerActions maps friendly names to the AMT power action codes accepted by the API.
+var powerActions = map[string]int{
+	"on":        2,
+	"sleep":     4,
+	"cycle":     5,
+	"hibernate": 7,
+	"off":       8,
+	"reset":     10,
+	"softoff":   12,
+	"softreset": 14,
+	"bios":      101,
+	"pxe":       400,
+}
+
+func runDevicesList(ctx context.Context, app *cli, args []string) error {
+	fs := flag.NewFlagSet("devices list", flag.ContinueOnError)
+	fs.SetOutput(app.stderr)
+
+	asJSON := fs.Bool("json", false, "print devices as JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return ErrUsage
+	}
+
+	devices := []client.Device{}
+
+	for device, err := range app.client.Devices.All(ctx, client.DefaultPageSize) {
+		if err != nil {
+			return err
+		}
+
+		devices = append(devices, device)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(app.stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(devices)
+	}
+
+	w := tabwriter.NewWriter(app.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "GUID\tHOSTNAME\tFRIENDLY NAME\tLOCATION\tASSIGNEE\tCONNECTED\tTAGS")
+
+	for i := range devices {
+		d := &devices[i]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\t%s\n", d.GUID, d.Hostname, d.FriendlyName, d.Location, d.Assignee, d.ConnectionStatus, strings.Join(d.Tags, ","))
+	}
+
+	return w.Flush()
+}
+
+func runPower(ctx context.Context, app *cli, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("%w: power <guid> <action>", ErrUsage)
+	}
+
+	action, err := parsePowerAction(args[1])
+	if err != nil {
+		return err
+	}
+
+	resp, err := app.client.PowerAction(ctx, args[0], action)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(app.stdout, "power action %d sent to %s, return value %d\n", action, args[0], resp.ReturnValue)
+
+	return nil
+}
+
+// parsePowerAction accepts either a friendly action name or a numeric AMT action code.
+func parsePowerAction(s string) (int, error) {
+	if action, ok := powerActions[strings.ToLower(s)]; ok {
+		return action, nil
+	}
+
+	action, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: unknown power action %q", ErrUsage, s)
+	}
+
+	return action, nil
+}
@@ -0,0 +1,207 @@
+// Command consolectl administers a console instance from the command line.
+//
+// It talks to the console REST API through pkg/client, so it works against
+// local and remote consoles alike and needs no browser:
+//
+//	consolectl [global flags] devices list
+//	consolectl [global flags] power <guid> <action>
+//	consolectl [global flags] profiles apply -f profile.json
+//	consolectl [global flags] backup [-o backup.json]
+//	consolectl openapi generate [-o doc/openapi.json]
+//
+// Connection settings default to the CONSOLE_URL, CONSOLE_USERNAME,
+// CONSOLE_PASSWORD and CONSOLE_TOKEN environment variables.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+
+	"github.com/device-management-toolkit/console/pkg/client"
+)
+
+const _defaultURL = "http://localhost:8181"
+
+var (
+	ErrUsage          = errors.New("invalid usage")
+	ErrUnknownCommand = errors.New("unknown command")
+)
+
+// command is a single consolectl subcommand.
+type command struct {
+	usage string
+	// offline commands do not need a console connection.
+	offline bool
+	run     func(ctx context.Context, app *cli, args []string) error
+}
+
+var commands = map[string]map[string]command{
+	"devices": {
+		"list": {usage: "devices list [-json]", run: runDevicesList},
+	},
+	"power": {
+		"": {usage: "power <guid> <action>", run: runPower},
+	},
+	"profiles": {
+		"apply": {usage: "profiles apply -f <file>", run: runProfilesApply},
+	},
+	"backup": {
+		"": {usage: "backup [-o <file>]", run: runBackup},
+	},
+	"openapi": {
+		"generate": {usage: "openapi generate [-o <file>]", offline: true, run: runOpenAPIGenerate},
+	},
+}
+
+// cli carries the state shared by all subcommands.
+type cli struct {
+	stdout io.Writer
+	stderr io.Writer
+
+	baseURL  string
+	username string
+	password string
+	token    string
+	insecure bool
+
+	client *client.Client
+}
+
+// newClientFunc allows tests to point consolectl at a fake console.
+var newClientFunc = client.New
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+
+	err := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+
+	stop()
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "consolectl:", err)
+
+		if errors.Is(err, ErrUsage) || errors.Is(err, ErrUnknownCommand) {
+			os.Exit(2)
+		}
+
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	app := &cli{stdout: stdout, stderr: stderr}
+
+	fs := flag.NewFlagSet("consolectl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&app.baseURL, "url", envOr("CONSOLE_URL", _defaultURL), "console base URL")
+	fs.StringVar(&app.username, "username", os.Getenv("CONSOLE_USERNAME"), "console username")
+	fs.StringVar(&app.password, "password", os.Getenv("CONSOLE_PASSWORD"), "console password")
+	fs.StringVar(&app.token, "token", os.Getenv("CONSOLE_TOKEN"), "bearer token, used instead of username and password")
+	fs.BoolVar(&app.insecure, "insecure", false, "skip TLS certificate verification")
+	fs.Usage = func() { app.usage(fs) }
+
+	if err := fs.Parse(args); err != nil {
+		return ErrUsage
+	}
+
+	cmd, rest, err := lookup(fs.Args())
+	if err != nil {
+		app.usage(fs)
+
+		return err
+	}
+
+	if !cmd.offline {
+		if err := app.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	return cmd.run(ctx, app, rest)
+}
+
+// lookup resolves "<group> [<name>]" to a command and returns the remaining arguments.
+func lookup(args []string) (command, []string, error) {
+	if len(args) == 0 {
+		return command{}, nil, ErrUsage
+	}
+
+	group, ok := commands[args[0]]
+	if !ok {
+		return command{}, nil, fmt.Errorf("%w: %s", ErrUnknownCommand, args[0])
+	}
+
+	if cmd, ok := group[""]; ok {
+		return cmd, args[1:], nil
+	}
+
+	if len(args) < 2 {
+		return command{}, nil, fmt.Errorf("%w: %s needs a subcommand", ErrUsage, args[0])
+	}
+
+	cmd, ok := group[args[1]]
+	if !ok {
+		return command{}, nil, fmt.Errorf("%w: %s %s", ErrUnknownCommand, args[0], args[1])
+	}
+
+	return cmd, args[2:], nil
+}
+
+func (app *cli) usage(fs *flag.FlagSet) {
+	fmt.Fprintln(app.stderr, "Usage: consolectl [global flags] <command>")
+	fmt.Fprintln(app.stderr, "\nCommands:")
+
+	for _, group := range []string{"devices", "power", "profiles", "backup", "openapi"} {
+		for _, cmd := range commands[group] {
+			fmt.Fprintln(app.stderr, "  "+cmd.usage)
+		}
+	}
+
+	fmt.Fprintln(app.stderr, "\nGlobal flags:")
+	fs.PrintDefaults()
+}
+
+// connect creates the API client and logs in when no token was given.
+func (app *cli) connect(ctx context.Context) error {
+	opts := []client.Option{}
+
+	if app.insecure {
+		opts = append(opts, client.HTTPClient(&http.Client{
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}, //nolint:gosec // explicitly requested with -insecure
+		}))
+	}
+
+	if app.token != "" {
+		opts = append(opts, client.Token(app.token))
+	}
+
+	c, err := newClientFunc(app.baseURL, opts...)
+	if err != nil {
+		return err
+	}
+
+	if app.token == "" && app.username != "" {
+		if err := c.Login(ctx, app.username, app.password); err != nil {
+			return fmt.Errorf("login: %w", err)
+		}
+	}
+
+	app.client = c
+
+	return nil
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+
+	return fallback
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/client"
+)
+
+// fakeConsole serves just enough of the v1 API for the CLI commands.
+func fakeConsole(t *testing.T, profiles map[string]client.Profile) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /api/v1/authorize", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"token":"jwt"}`))
+	})
+
+	mux.HandleFunc("GET /api/v1/devices", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer jwt", r.Header.Get("Authorization"))
+
+		_, _ = w.Write([]byte(`{"data":[{"guid":"guid-1","hostname":"host-1","connectionStatus":true,"tags":["a","b"]}],"totalCount":1}`))
+	})
+
+	mux.HandleFunc("POST /api/v1/amt/power/action/{guid}", func(w http.ResponseWriter, r *http.Request) {
+		var action client.PowerAction
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&action))
+		assert.Equal(t, "guid-1", r.PathValue("guid"))
+		assert.Equal(t, 8, action.Action)
+
+		_, _ = w.Write([]byte(`{"ReturnValue":0}`))
+	})
+
+	mux.HandleFunc("GET /api/v1/admin/profiles/{name}", func(w http.ResponseWriter, r *http.Request) {
+		p, ok := profiles[r.PathValue("name")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(p)
+	})
+
+	store := func(w http.ResponseWriter, r *http.Request) {
+		var p client.Profile
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&p))
+
+		profiles[p.ProfileName] = p
+
+		_ = json.NewEncoder(w).Encode(p)
+	}
+
+	mux.HandleFunc("POST /api/v1/admin/profiles", store)
+	mux.HandleFunc("PATCH /api/v1/admin/profiles", store)
+
+	mux.HandleFunc("GET /api/v1/admin/", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[],"totalCount":0}`))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func runCLI(t *testing.T, srv *httptest.Server, args ...string) (string, error) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+
+	args = append([]string{"-url", srv.URL, "-username", "admin", "-password", "secret"}, args...)
+	err := run(context.Background(), args, &stdout, &stderr)
+
+	return stdout.String(), err
+}
+
+func TestDevicesList(t *testing.T) {
+	t.Parallel()
+
+	out, err := runCLI(t, fakeConsole(t, nil), "devices", "list")
+	require.NoError(t, err)
+	assert.Contains(t, out, "GUID")
+	assert.Contains(t, out, "guid-1")
+	assert.Contains(t, out, "a,b")
+}
+
+func TestPower(t *testing.T) {
+	t.Parallel()
+
+	out, err := runCLI(t, fakeConsole(t, nil), "power", "guid-1", "off")
+	require.NoError(t, err)
+	assert.Contains(t, out, "return value 0")
+}
+
+func TestProfilesApply(t *testing.T) {
+	t.Parallel()
+
+	profiles := map[string]client.Profile{"existing": {ProfileName: "existing", Version: "v1"}}
+	srv := fakeConsole(t, profiles)
+
+	file := filepath.Join(t.TempDir(), "profiles.json")
+	require.NoError(t, os.WriteFile(file, []byte(`[{"profileName":"existing","activation":"ccmactivate"},{"profileName":"new","activation":"acmactivate"}]`), 0o600))
+
+	out, err := runCLI(t, srv, "profiles", "apply", "-f", file)
+	require.NoError(t, err)
+	assert.Contains(t, out, `profile "existing" updated`)
+	assert.Contains(t, out, `profile "new" created`)
+	assert.Equal(t, "v1", profiles["existing"].Version)
+	assert.Equal(t, "acmactivate", profiles["new"].Activation)
+}
+
+func TestBackup(t *testing.T) {
+	t.Parallel()
+
+	out, err := runCLI(t, fakeConsole(t, map[string]client.Profile{}), "backup")
+	require.NoError(t, err)
+
+	var backup Backup
+
+	require.NoError(t, json.Unmarshal([]byte(out), &backup))
+	assert.Len(t, backup.Devices, 1)
+	assert.Empty(t, backup.Profiles)
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		err  error
+	}{
+		{name: "no command", args: nil, err: ErrUsage},
+		{name: "unknown group", args: []string{"nope"}, err: ErrUnknownCommand},
+		{name: "missing subcommand", args: []string{"devices"}, err: ErrUsage},
+		{name: "unknown subcommand", args: []string{"devices", "nope"}, err: ErrUnknownCommand},
+		{name: "single word command", args: []string{"power", "guid", "on"}},
+		{name: "grouped command", args: []string{"devices", "list", "-json"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := lookup(tc.args)
+			if tc.err == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestParsePowerAction(t *testing.T) {
+	t.Parallel()
+
+	action, err := parsePowerAction("Reset")
+	require.NoError(t, err)
+	assert.Equal(t, 10, action)
+
+	action, err = parsePowerAction("100")
+	require.NoError(t, err)
+	assert.Equal(t, 100, action)
+
+	_, err = parsePowerAction("explode")
+	require.ErrorIs(t, err, ErrUsage)
+}
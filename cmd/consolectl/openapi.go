@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/device-management-toolkit/console/internal/controller/openapi"
+	"github.com/device-management-toolkit/console/internal/usecase"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// runOpenAPIGenerate writes the combined and per-version specs without starting a console.
+func runOpenAPIGenerate(_ context.Context, app *cli, args []string) error {
+	fs := flag.NewFlagSet("openapi generate", flag.ContinueOnError)
+	fs.SetOutput(app.stderr)
+
+	output := fs.String("o", "doc/openapi.json", "output file for the combined spec")
+
+	if err := fs.Parse(args); err != nil {
+		return ErrUsage
+	}
+
+	generator := openapi.NewGenerator(usecase.Usecases{}, logger.New("error"))
+
+	spec, err := generator.GenerateSpec()
+	if err != nil {
+		return err
+	}
+
+	if err := generator.SaveSpec(spec, *output); err != nil {
+		return err
+	}
+
+	if err := generator.SaveVersionedSpecs(*output); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(app.stdout, "OpenAPI specifications written next to %s\n", *output)
+
+	return nil
+}
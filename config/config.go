@@ -28,6 +28,28 @@ type (
 		Auth    `yaml:"auth"`
 		UI      `yaml:"ui"`
 		Redfish `yaml:"redfish"`
+
+		Server        Server        `yaml:"server"`
+		LogForwarding LogForwarding `yaml:"log_forwarding"`
+		SNMPTraps     SNMPTraps     `yaml:"snmp_traps"`
+		LDAPSync      LDAPSync      `yaml:"ldap_sync"`
+		Images        Images        `yaml:"images"`
+		Jobs          Jobs          `yaml:"jobs"`
+		Simulator     Simulator     `yaml:"simulator"`
+		KVM           KVM           `yaml:"kvm"`
+		Redirection   Redirection   `yaml:"redirection"`
+		DeviceLock    DeviceLock    `yaml:"device_lock"`
+		Network       Network       `yaml:"network"`
+		WsmanProxy    WsmanProxy    `yaml:"wsman_proxy"`
+
+		CredentialCheckout CredentialCheckout `yaml:"credential_checkout"`
+		Enrollment         Enrollment         `yaml:"enrollment"`
+		RPS                RPS                `yaml:"rps"`
+		MPSCompat          MPSCompat          `yaml:"mps_compat"`
+		Checks             Checks             `yaml:"checks"`
+		Revocation         Revocation         `yaml:"revocation"`
+		RootKey            RootKey            `yaml:"root_key"`
+		IntegrityCheck     IntegrityCheck     `yaml:"integrity_check"`
 	}
 
 	// App -.
@@ -39,16 +61,96 @@ type (
 		EncryptionKey        string `yaml:"encryption_key" env:"APP_ENCRYPTION_KEY"`
 		AllowInsecureCiphers bool   `yaml:"allow_insecure_ciphers" env:"APP_ALLOW_INSECURE_CIPHERS"`
 		DisableCIRA          bool   `yaml:"disable_cira" env:"APP_DISABLE_CIRA"`
+		// CertificateKeyAlgorithm is the key of the generated root and web server certificates, rsa3072,
+		// rsa4096, ecdsa-p256 or ecdsa-p384. The existing certificates keep their keys.
+		CertificateKeyAlgorithm string `yaml:"certificate_key_algorithm" env:"APP_CERTIFICATE_KEY_ALGORITHM"`
+		// TenantEncryptionKeys encrypts the secrets of each tenant with a key of its own, wrapped by the
+		// encryption key, so that the keys of a tenant can be rotated and revoked on their own.
+		TenantEncryptionKeys bool `yaml:"tenant_encryption_keys" env:"APP_TENANT_ENCRYPTION_KEYS"`
+		// PowerPollInterval is how often the power state of every device is recorded, 0 disables the poller.
+		PowerPollInterval     time.Duration `yaml:"power_poll_interval" env:"APP_POWER_POLL_INTERVAL"`
+		PowerHistoryRetention time.Duration `yaml:"power_history_retention" env:"APP_POWER_HISTORY_RETENTION"`
+		// WsmanRetryAttempts is how often a WS-Man request is sent when the device cannot be connected to.
+		WsmanRetryAttempts int           `yaml:"wsman_retry_attempts" env:"APP_WSMAN_RETRY_ATTEMPTS"`
+		WsmanRetryBackoff  time.Duration `yaml:"wsman_retry_backoff" env:"APP_WSMAN_RETRY_BACKOFF"`
+		// WsmanCircuitFailures is the number of failed requests after which calls to a device are rejected
+		// for WsmanCircuitCooldown, 0 disables the circuit breaker.
+		WsmanCircuitFailures int           `yaml:"wsman_circuit_failures" env:"APP_WSMAN_CIRCUIT_FAILURES"`
+		WsmanCircuitCooldown time.Duration `yaml:"wsman_circuit_cooldown" env:"APP_WSMAN_CIRCUIT_COOLDOWN"`
 	}
 
 	// HTTP -.
 	HTTP struct {
-		Host           string   `env-required:"true" yaml:"host" env:"HTTP_HOST"`
-		Port           string   `env-required:"true" yaml:"port" env:"HTTP_PORT"`
-		AllowedOrigins []string `env-required:"true" yaml:"allowed_origins" env:"HTTP_ALLOWED_ORIGINS"`
-		AllowedHeaders []string `env-required:"true" yaml:"allowed_headers" env:"HTTP_ALLOWED_HEADERS"`
-		WSCompression  bool     `yaml:"ws_compression" env:"WS_COMPRESSION"`
-		TLS            TLS      `yaml:"tls"`
+		Host           string     `env-required:"true" yaml:"host" env:"HTTP_HOST"`
+		Port           string     `env-required:"true" yaml:"port" env:"HTTP_PORT"`
+		AllowedOrigins []string   `env-required:"true" yaml:"allowed_origins" env:"HTTP_ALLOWED_ORIGINS"`
+		AllowedHeaders []string   `env-required:"true" yaml:"allowed_headers" env:"HTTP_ALLOWED_HEADERS"`
+		WSCompression  bool       `yaml:"ws_compression" env:"WS_COMPRESSION"`
+		Pprof          bool       `yaml:"pprof" env:"HTTP_PPROF"`
+		TLS            TLS        `yaml:"tls"`
+		BodyLimits     BodyLimits `yaml:"body_limits"`
+		RateLimits     RateLimits `yaml:"rate_limits"`
+		Timeouts       Timeouts   `yaml:"timeouts"`
+		ReadOnly       ReadOnly   `yaml:"read_only"`
+
+		// RequestValidation checks the JSON request bodies against the OpenAPI document before they are bound.
+		RequestValidation bool `yaml:"request_validation" env:"HTTP_REQUEST_VALIDATION"`
+
+		// Compression and HTTP2 serve dashboards fetching many devices at once
+		Compression Compression `yaml:"compression"`
+		HTTP2       HTTP2       `yaml:"http2"`
+	}
+
+	// Timeouts bounds the time a request to /api is handled, 0 disables a limit. A request over its limit
+	// is cancelled, together with the device and database calls made for it, and gets 504 Gateway Timeout.
+	Timeouts struct {
+		Request time.Duration `yaml:"request" env:"HTTP_REQUEST_TIMEOUT"`
+		// Routes overrides Request per route, keyed by the method and the path as registered,
+		// e.g. "GET /api/v1/admin/images/:name". The streaming routes are never timed.
+		Routes map[string]time.Duration `yaml:"routes"`
+	}
+
+	// ReadOnly rejects the requests to /api that change anything with 503 Service Unavailable while the reads
+	// are served, e.g. during a database migration or failover. It can be switched at runtime by the admin API.
+	ReadOnly struct {
+		Enabled bool `yaml:"enabled" env:"HTTP_READ_ONLY"`
+		// RetryAfter is sent in the Retry-After header of the rejected requests.
+		RetryAfter time.Duration `yaml:"retry_after" env:"HTTP_READ_ONLY_RETRY_AFTER"`
+	}
+
+	// Compression -.
+	Compression struct {
+		// Enabled compresses the responses with gzip or deflate, as accepted by the client.
+		Enabled bool `yaml:"enabled" env:"HTTP_COMPRESSION_ENABLED"`
+		// MinSize is the size in bytes from which a response is compressed.
+		MinSize int `yaml:"min_size" env:"HTTP_COMPRESSION_MIN_SIZE"`
+	}
+
+	// HTTP2 -.
+	HTTP2 struct {
+		MaxConcurrentStreams int `yaml:"max_concurrent_streams" env:"HTTP2_MAX_CONCURRENT_STREAMS"`
+		// IdleTimeout closes the connections idle for longer, HTTP/1.1 keep-alive connections included.
+		IdleTimeout time.Duration `yaml:"idle_timeout" env:"HTTP_IDLE_TIMEOUT"`
+	}
+
+	// BodyLimits caps the size of the request bodies per route group in bytes, 0 disables a limit.
+	BodyLimits struct {
+		// API applies to the device routes of /api/v1 and /api/v2.
+		API   int64 `yaml:"api" env:"HTTP_BODY_LIMIT_API"`
+		Admin int64 `yaml:"admin" env:"HTTP_BODY_LIMIT_ADMIN"`
+		// Upload applies to the streamed uploads, such as boot images.
+		Upload int64 `yaml:"upload" env:"HTTP_BODY_LIMIT_UPLOAD"`
+	}
+
+	// RateLimits caps the requests to /api with token buckets refilled at a rate in requests per second,
+	// a rate of 0 disables a limit.
+	RateLimits struct {
+		// Tenant is shared by all the callers of a tenant, given by the tenantId claim of their access token.
+		Tenant      float64 `yaml:"tenant" env:"HTTP_RATE_LIMIT_TENANT"`
+		TenantBurst int     `yaml:"tenant_burst" env:"HTTP_RATE_LIMIT_TENANT_BURST"`
+		// Token applies to each access token, or each client address when authentication is disabled.
+		Token      float64 `yaml:"token" env:"HTTP_RATE_LIMIT_TOKEN"`
+		TokenBurst int     `yaml:"token_burst" env:"HTTP_RATE_LIMIT_TOKEN_BURST"`
 	}
 
 	// TLS -.
@@ -68,6 +170,16 @@ type (
 		Address string `yaml:"address" env:"SECRETS_ADDR"`
 		Token   string `yaml:"token" env:"SECRETS_TOKEN"`
 		Path    string `yaml:"path" env:"SECRETS_PATH"`
+		// DeviceCredentials keeps device AMT/MPS/MEBX passwords in the secret store instead of the database.
+		DeviceCredentials bool `yaml:"device_credentials" env:"SECRETS_DEVICE_CREDENTIALS"`
+		// CacheEnabled keeps recently used secrets in an encrypted local file, used only while the secret store is unreachable.
+		CacheEnabled bool          `yaml:"cache_enabled" env:"SECRETS_CACHE_ENABLED"`
+		CacheTTL     time.Duration `yaml:"cache_ttl" env:"SECRETS_CACHE_TTL"`
+		CachePath    string        `yaml:"cache_path" env:"SECRETS_CACHE_PATH"`
+		// TransitKey encrypts the passwords with this key of the Vault transit secrets engine instead of the
+		// encryption key, which is then only read from the configuration to decrypt the passwords encrypted before.
+		TransitKey   string `yaml:"transit_key" env:"SECRETS_TRANSIT_KEY"`
+		TransitMount string `yaml:"transit_mount" env:"SECRETS_TRANSIT_MOUNT"`
 	}
 
 	// DB -.
@@ -85,15 +197,31 @@ type (
 
 	// Auth -.
 	Auth struct {
-		Disabled                 bool          `yaml:"disabled" env:"AUTH_DISABLED"`
-		AdminUsername            string        `yaml:"adminUsername" env:"AUTH_ADMIN_USERNAME"`
-		AdminPassword            string        `yaml:"adminPassword" env:"AUTH_ADMIN_PASSWORD"`
-		JWTKey                   string        `env-required:"true" yaml:"jwtKey" env:"AUTH_JWT_KEY"`
-		JWTExpiration            time.Duration `yaml:"jwtExpiration" env:"AUTH_JWT_EXPIRATION"`
-		RedirectionJWTExpiration time.Duration `yaml:"redirectionJWTExpiration" env:"AUTH_REDIRECTION_JWT_EXPIRATION"`
-		ClientID                 string        `yaml:"clientId" env:"AUTH_CLIENT_ID"`
-		Issuer                   string        `yaml:"issuer" env:"AUTH_ISSUER"`
-		UI                       UIAuthConfig  `yaml:"ui"`
+		Disabled                    bool          `yaml:"disabled" env:"AUTH_DISABLED"`
+		AdminUsername               string        `yaml:"adminUsername" env:"AUTH_ADMIN_USERNAME"`
+		AdminPassword               string        `yaml:"adminPassword" env:"AUTH_ADMIN_PASSWORD"`
+		JWTKey                      string        `env-required:"true" yaml:"jwtKey" env:"AUTH_JWT_KEY"`
+		JWTExpiration               time.Duration `yaml:"jwtExpiration" env:"AUTH_JWT_EXPIRATION"`
+		RedirectionJWTExpiration    time.Duration `yaml:"redirectionJWTExpiration" env:"AUTH_REDIRECTION_JWT_EXPIRATION"`
+		RedirectionTicketExpiration time.Duration `yaml:"redirectionTicketExpiration" env:"AUTH_REDIRECTION_TICKET_EXPIRATION"`
+		ClientID                    string        `yaml:"clientId" env:"AUTH_CLIENT_ID"`
+		Issuer                      string        `yaml:"issuer" env:"AUTH_ISSUER"`
+		UI                          UIAuthConfig  `yaml:"ui"`
+		Kerberos                    KerberosAuth  `yaml:"kerberos"`
+	}
+
+	// KerberosAuth accepts "Authorization: Negotiate" tickets of domain-joined workstations next to
+	// the JWT of the configured login, an empty keytab disables it. A ticket signs in the enabled console
+	// user named as its client principal without the realm, such as jdoe for jdoe@EXAMPLE.COM, the
+	// principals without an account are rejected. Devices are still connected to with digest
+	// authentication, the console does not request Kerberos tickets for AMT.
+	KerberosAuth struct {
+		Keytab string `yaml:"keytab" env:"AUTH_KERBEROS_KEYTAB"`
+		// ServicePrincipal is the principal of the console, such as HTTP/console.example.com, empty
+		// accepts tickets for any principal of the keytab.
+		ServicePrincipal string `yaml:"servicePrincipal" env:"AUTH_KERBEROS_SERVICE_PRINCIPAL"`
+		// Realm rejects the client principals of other realms, empty accepts those of any trusted realm.
+		Realm string `yaml:"realm" env:"AUTH_KERBEROS_REALM"`
 	}
 
 	// UIAuthConfig -.
@@ -107,16 +235,245 @@ type (
 		StrictDiscoveryDocumentValidation bool   `yaml:"strictDiscoveryDocumentValidation"`
 	}
 
+	// Server -.
+	Server struct {
+		// BindAddress is the address the HTTP server listens on, e.g. 0.0.0.0 for all interfaces or 127.0.0.1
+		// for loopback only. The server listens on the HTTP host when it is empty.
+		BindAddress string `yaml:"bindAddress" env:"SERVER_BIND_ADDRESS"`
+	}
+
 	// UI -.
 	UI struct {
-		ExternalURL string `yaml:"externalUrl" env:"UI_EXTERNAL_URL"`
+		ExternalURL     string `yaml:"externalUrl" env:"UI_EXTERNAL_URL"`
+		Path            string `yaml:"path" env:"UI_PATH"`
+		AutoOpenBrowser bool   `yaml:"autoOpenBrowser" env:"UI_AUTO_OPEN_BROWSER"`
 	}
 	// Redfish -.
 	Redfish struct {
-		EnvironmentUUID string `yaml:"environment_uuid" env:"REDFISH_ENV_UUID"`
+		EnvironmentUUID     string `yaml:"environment_uuid" env:"REDFISH_ENV_UUID"`
+		BaseRegistryVersion string `yaml:"base_registry_version" env:"REDFISH_BASE_REGISTRY_VERSION"`
+		SessionTimeout      int    `yaml:"session_timeout" env:"REDFISH_SESSION_TIMEOUT"`
+		MaxSessionsPerUser  int    `yaml:"max_sessions_per_user" env:"REDFISH_MAX_SESSIONS_PER_USER"`
+	}
+
+	// LogForwarding -.
+	LogForwarding struct {
+		// Interval is how often new audit and event log records are pulled from the devices, 0 disables forwarding.
+		Interval time.Duration `yaml:"interval" env:"LOG_FORWARDING_INTERVAL"`
+		// Target is syslog, splunk or http.
+		Target string `yaml:"target" env:"LOG_FORWARDING_TARGET"`
+		// Address is the host:port of the syslog server or the URL of the Splunk HEC or HTTP collector.
+		Address string `yaml:"address" env:"LOG_FORWARDING_ADDRESS"`
+		// Network is udp or tcp for syslog.
+		Network string `yaml:"network" env:"LOG_FORWARDING_NETWORK"`
+		// Token is the Splunk HEC token or the bearer token of the HTTP collector.
+		Token string `yaml:"token" env:"LOG_FORWARDING_TOKEN"`
+	}
+
+	// SNMPTraps -.
+	SNMPTraps struct {
+		// Interval is how often the devices are checked for critical conditions, 0 disables the traps.
+		Interval time.Duration `yaml:"interval" env:"SNMP_TRAPS_INTERVAL"`
+		// Address is the host:port of the trap receiver, port 162 when omitted.
+		Address string `yaml:"address" env:"SNMP_TRAPS_ADDRESS"`
+		// Version is 2c or 3.
+		Version   string `yaml:"version" env:"SNMP_TRAPS_VERSION"`
+		Community string `yaml:"community" env:"SNMP_TRAPS_COMMUNITY"`
+		// User is the SNMPv3 user, authenticated with HMAC-SHA-96 when AuthPassword is set and
+		// encrypted with AES-128 when PrivPassword is set as well.
+		User         string `yaml:"user" env:"SNMP_TRAPS_USER"`
+		AuthPassword string `yaml:"auth_password" env:"SNMP_TRAPS_AUTH_PASSWORD"`
+		PrivPassword string `yaml:"priv_password" env:"SNMP_TRAPS_PRIV_PASSWORD"`
+		// EngineID is the hex SNMPv3 engine ID of the console, the receiver must know the user under this ID.
+		EngineID string `yaml:"engine_id" env:"SNMP_TRAPS_ENGINE_ID"`
+	}
+
+	// LDAPSync -.
+	LDAPSync struct {
+		// Interval is how often the console accounts are synced from the directory, 0 disables the sync.
+		Interval time.Duration `yaml:"interval" env:"LDAP_SYNC_INTERVAL"`
+		// URL is the ldap:// or ldaps:// URL of the directory server.
+		URL                string `yaml:"url" env:"LDAP_SYNC_URL"`
+		InsecureSkipVerify bool   `yaml:"insecure_skip_verify" env:"LDAP_SYNC_INSECURE_SKIP_VERIFY"`
+		BindDN             string `yaml:"bind_dn" env:"LDAP_SYNC_BIND_DN"`
+		BindPassword       string `yaml:"bind_password" env:"LDAP_SYNC_BIND_PASSWORD"`
+		BaseDN             string `yaml:"base_dn" env:"LDAP_SYNC_BASE_DN"`
+		UserFilter         string `yaml:"user_filter" env:"LDAP_SYNC_USER_FILTER"`
+		UsernameAttribute  string `yaml:"username_attribute" env:"LDAP_SYNC_USERNAME_ATTRIBUTE"`
+		// GroupRoles maps the DNs of directory groups to console roles. Only members of a mapped group get
+		// an account, a member of several groups gets the role with the most rights. The API holds the
+		// accounts to their role: a viewer reads the devices, an operator also changes them and only an
+		// admin reads and changes the console configuration.
+		GroupRoles map[string]string `yaml:"group_roles"`
+	}
+
+	// Images -.
+	Images struct {
+		// Dir keeps the ISO and boot images uploaded for HTTPS boot and IDE redirection, empty disables the uploads.
+		Dir string `yaml:"dir" env:"IMAGES_DIR"`
+	}
+
+	// Jobs -.
+	Jobs struct {
+		// Workers is the number of background jobs run at the same time.
+		Workers int `yaml:"workers" env:"JOBS_WORKERS"`
+		// TenantWorkers is the number of workers the jobs of one tenant may take at the same time. It is
+		// capped at one less than Workers, so that a tenant with long jobs always leaves a worker to the others.
+		TenantWorkers int `yaml:"tenant_workers" env:"JOBS_TENANT_WORKERS"`
+		// DeviceConcurrency is the number of devices a job works on at the same time.
+		DeviceConcurrency int `yaml:"device_concurrency" env:"JOBS_DEVICE_CONCURRENCY"`
+		// QueueDepth is the number of jobs waiting to run, over all tenants, before new jobs are refused; 0 removes the limit.
+		QueueDepth int `yaml:"queue_depth" env:"JOBS_QUEUE_DEPTH"`
+		// TenantQueueDepth is the number of jobs of one tenant waiting to run before its new jobs are refused; 0 removes the limit.
+		TenantQueueDepth int `yaml:"tenant_queue_depth" env:"JOBS_TENANT_QUEUE_DEPTH"`
+	}
+
+	// Simulator -.
+	Simulator struct {
+		// Devices is the number of simulated AMT devices seeded at startup, 0 disables the simulator. The
+		// --simulate flag takes precedence.
+		Devices int `yaml:"devices" env:"SIMULATOR_DEVICES"`
+		// Latency is the mean delay of a call to a simulated device, each call waits between half and one and a half of it.
+		Latency time.Duration `yaml:"latency" env:"SIMULATOR_LATENCY"`
+		// ErrorRate is the share of the calls to a simulated device that fail, between 0 and 1.
+		ErrorRate float64 `yaml:"error_rate" env:"SIMULATOR_ERROR_RATE"`
+	}
+
+	// KVM holds the limits of KVM sessions for links with little bandwidth, a session can override them with
+	// the query parameters of the redirection websocket.
+	KVM struct {
+		// Quality is the JPEG quality, 1 (lowest) to 10, viewers are asked for; 0 leaves it to the viewer.
+		Quality int `yaml:"quality" env:"KVM_QUALITY"`
+		// FrameRate caps the screen updates per second, 0 removes the cap.
+		FrameRate int `yaml:"frame_rate" env:"KVM_FRAME_RATE"`
+		// Bandwidth caps the bytes per second, at least 1024, sent from the device to the viewer; 0 removes the cap.
+		Bandwidth int `yaml:"bandwidth" env:"KVM_BANDWIDTH"`
+	}
+
+	// Redirection -.
+	Redirection struct {
+		// IdleTimeout closes KVM and SOL sessions without input from the viewer for this long, 0 disables it.
+		IdleTimeout time.Duration `yaml:"idle_timeout" env:"REDIRECTION_IDLE_TIMEOUT"`
+		// IdleWarning is how long before the idle timeout the viewer is warned.
+		IdleWarning time.Duration `yaml:"idle_warning" env:"REDIRECTION_IDLE_WARNING"`
+	}
+
+	// DeviceLock -.
+	DeviceLock struct {
+		// Required refuses power actions and boot options, such as secure erase, on devices the caller has not locked.
+		Required bool `yaml:"required" env:"DEVICE_LOCK_REQUIRED"`
+		// TTL is how long a lock is held when the request does not say.
+		TTL time.Duration `yaml:"ttl" env:"DEVICE_LOCK_TTL"`
+	}
+
+	// CredentialCheckout -.
+	CredentialCheckout struct {
+		// TTL is how long the AMT password of a device stays checked out when the request does not say.
+		TTL time.Duration `yaml:"ttl" env:"CREDENTIAL_CHECKOUT_TTL"`
+		// MaxTTL is the longest a password may be checked out for at once.
+		MaxTTL time.Duration `yaml:"max_ttl" env:"CREDENTIAL_CHECKOUT_MAX_TTL"`
+	}
+
+	// Enrollment -.
+	Enrollment struct {
+		// TokenTTL is how long an enrollment token can be redeemed when the request minting it does not say.
+		TokenTTL time.Duration `yaml:"token_ttl" env:"ENROLLMENT_TOKEN_TTL"`
+		// MaxTokenTTL is the longest an enrollment token may be redeemed for.
+		MaxTokenTTL time.Duration `yaml:"max_token_ttl" env:"ENROLLMENT_MAX_TOKEN_TTL"`
+	}
+
+	// RPS -.
+	RPS struct {
+		// Enabled serves the activation of rpc-go clients pointed at the console as their RPS with the domains
+		// and profiles of the console.
+		Enabled bool `yaml:"enabled" env:"RPS_ENABLED"`
+		// Timeout is how long a client is waited for to answer a WS-Management message relayed to its device.
+		Timeout time.Duration `yaml:"timeout" env:"RPS_TIMEOUT"`
+	}
+
+	// MPSCompat -.
+	MPSCompat struct {
+		// Enabled serves the device list, power actions and general settings of the devices at the paths of MPS
+		// and in its response shapes, for the scripts and the Sample Web UI written for MPS.
+		Enabled bool `yaml:"enabled" env:"MPS_COMPAT_ENABLED"`
+		// Prefix is the path MPS was reached at, /mps behind the gateway of the toolkit.
+		Prefix string `yaml:"prefix" env:"MPS_COMPAT_PREFIX"`
+	}
+
+	// Checks -.
+	Checks struct {
+		// APIKey lets the monitoring tools run the status checks of the devices with the key in the X-API-Key
+		// header instead of an access token. Empty serves the checks with access tokens.
+		APIKey string `yaml:"api_key" env:"CHECKS_API_KEY"`
+	}
+
+	// Revocation -.
+	Revocation struct {
+		// Enabled asks the OCSP responders and CRL distribution points of the uploaded domain and device
+		// certificates whether they were revoked, refusing the revoked ones.
+		Enabled bool `yaml:"enabled" env:"REVOCATION_ENABLED"`
+		// CacheTTL is how long a revocation status is kept, a CRL is kept until its next update at most.
+		CacheTTL time.Duration `yaml:"cache_ttl" env:"REVOCATION_CACHE_TTL"`
+		// Timeout is how long a responder or distribution point is waited for.
+		Timeout time.Duration `yaml:"timeout" env:"REVOCATION_TIMEOUT"`
+	}
+
+	// RootKey -.
+	RootKey struct {
+		// Backend keeps the private key of the console root CA: file, in the config directory or the secret
+		// store, pkcs11, on an HSM or token, or transit, in the Vault transit secrets engine.
+		Backend string `yaml:"backend" env:"ROOT_KEY_BACKEND"`
+		// PKCS11Module is the PKCS#11 library of the token, needs a console built with cgo and the pkcs11 tag.
+		PKCS11Module     string `yaml:"pkcs11_module" env:"ROOT_KEY_PKCS11_MODULE"`
+		PKCS11TokenLabel string `yaml:"pkcs11_token_label" env:"ROOT_KEY_PKCS11_TOKEN_LABEL"`
+		PKCS11PIN        string `yaml:"pkcs11_pin" env:"ROOT_KEY_PKCS11_PIN"`
+		PKCS11KeyLabel   string `yaml:"pkcs11_key_label" env:"ROOT_KEY_PKCS11_KEY_LABEL"`
+		// TransitMount is where the transit engine is mounted in the secret store.
+		TransitMount string `yaml:"transit_mount" env:"ROOT_KEY_TRANSIT_MOUNT"`
+		TransitKey   string `yaml:"transit_key" env:"ROOT_KEY_TRANSIT_KEY"`
+	}
+
+	// IntegrityCheck -.
+	IntegrityCheck struct {
+		// Enabled decrypts a sample of the stored secrets at startup and reports those that cannot be
+		// decrypted with the configured key.
+		Enabled bool `yaml:"enabled" env:"INTEGRITY_CHECK_ENABLED"`
+		// Sample is how many secrets of each column are checked, 0 checks every secret.
+		Sample int `yaml:"sample" env:"INTEGRITY_CHECK_SAMPLE"`
+		// Quarantine moves the secrets that cannot be decrypted to the quarantined_secrets table, clearing
+		// them from their rows.
+		Quarantine bool `yaml:"quarantine" env:"INTEGRITY_CHECK_QUARANTINE"`
+	}
+
+	// Network -.
+	Network struct {
+		// PreferFamily is the address family, ipv4 or ipv6, used to reach the device hostnames resolving to both.
+		// Empty keeps the order of the resolver.
+		PreferFamily string `yaml:"prefer_family" env:"NETWORK_PREFER_FAMILY"`
+		// Domains sets the family per DNS domain of the device hostnames, overriding PreferFamily.
+		Domains map[string]string `yaml:"domains" env:"NETWORK_DOMAINS"`
+	}
+
+	// WsmanProxy -.
+	WsmanProxy struct {
+		// URL is the proxy the devices are reached through, http://, https:// or socks5:// with the credentials
+		// as user info. Empty connects directly.
+		URL string `yaml:"url" env:"WSMAN_PROXY_URL"`
+		// Devices sets the proxy per device GUID, overriding URL; "direct" connects the device directly.
+		Devices map[string]string `yaml:"devices"`
 	}
 )
 
+// ListenHost returns the address the HTTP server binds to.
+// Server.BindAddress takes precedence; otherwise the server binds to Host as before.
+func (c *Config) ListenHost() string {
+	if c.Server.BindAddress != "" {
+		return c.Server.BindAddress
+	}
+
+	return c.Host
+}
+
 // getPreferredIPAddress detects the most likely candidate IP address for this machine.
 // It prefers non-loopback IPv4 addresses and excludes link-local addresses.
 func getPreferredIPAddress() string {
@@ -143,13 +500,21 @@ func getPreferredIPAddress() string {
 func defaultConfig() *Config {
 	return &Config{
 		App: App{
-			Name:                 "console",
-			Repo:                 "device-management-toolkit/console",
-			Version:              "DEVELOPMENT",
-			CommonName:           getPreferredIPAddress(),
-			EncryptionKey:        "",
-			AllowInsecureCiphers: false,
-			DisableCIRA:          true,
+			Name:                    "console",
+			Repo:                    "device-management-toolkit/console",
+			Version:                 "DEVELOPMENT",
+			CommonName:              getPreferredIPAddress(),
+			EncryptionKey:           "",
+			AllowInsecureCiphers:    false,
+			DisableCIRA:             true,
+			CertificateKeyAlgorithm: "rsa3072",
+			TenantEncryptionKeys:    false,
+			PowerPollInterval:       0,
+			PowerHistoryRetention:   7 * 24 * time.Hour,
+			WsmanRetryAttempts:      3,
+			WsmanRetryBackoff:       250 * time.Millisecond,
+			WsmanCircuitFailures:    5,
+			WsmanCircuitCooldown:    30 * time.Second,
 		},
 		HTTP: HTTP{
 			Host:           "localhost",
@@ -157,19 +522,54 @@ func defaultConfig() *Config {
 			AllowedOrigins: []string{"*"},
 			AllowedHeaders: []string{"*"},
 			WSCompression:  true,
+			Pprof:          false,
 			TLS: TLS{
 				Enabled:  true,
 				CertFile: "",
 				KeyFile:  "",
 			},
+			BodyLimits: BodyLimits{
+				API:    4 << 20,
+				Admin:  16 << 20,
+				Upload: 16 << 30,
+			},
+			RateLimits: RateLimits{
+				Tenant:      0,
+				TenantBurst: 200,
+				Token:       0,
+				TokenBurst:  50,
+			},
+			Timeouts: Timeouts{
+				Request: 12 * time.Second,
+				Routes: map[string]time.Duration{
+					"GET /api/v1/admin/images/:name":      0,
+					"POST /api/v1/admin/images":           0,
+					"GET /api/v1/admin/debug/pprof/:name": 0,
+				},
+			},
+			ReadOnly: ReadOnly{
+				Enabled:    false,
+				RetryAfter: time.Minute,
+			},
+			RequestValidation: true,
+			Compression: Compression{
+				Enabled: true,
+				MinSize: 1024,
+			},
+			HTTP2: HTTP2{
+				MaxConcurrentStreams: 250,
+				IdleTimeout:          120 * time.Second,
+			},
 		},
 		Log: Log{
 			Level: "info",
 		},
 		Secrets: Secrets{
-			Address: "http://localhost:8200",
-			Token:   "",
-			Path:    "secret/data/console",
+			Address:      "http://localhost:8200",
+			Token:        "",
+			Path:         "secret/data/console",
+			CacheTTL:     24 * time.Hour,
+			TransitMount: "transit",
 		},
 		DB: DB{
 			PoolMax: 2,
@@ -181,11 +581,12 @@ func defaultConfig() *Config {
 			Password: "",
 		},
 		Auth: Auth{
-			AdminUsername:            "standalone",
-			AdminPassword:            "G@ppm0ym",
-			JWTKey:                   "your_secret_jwt_key",
-			JWTExpiration:            24 * time.Hour,
-			RedirectionJWTExpiration: 5 * time.Minute,
+			AdminUsername:               "standalone",
+			AdminPassword:               "G@ppm0ym",
+			JWTKey:                      "your_secret_jwt_key",
+			JWTExpiration:               24 * time.Hour,
+			RedirectionJWTExpiration:    5 * time.Minute,
+			RedirectionTicketExpiration: 30 * time.Second,
 			// OAUTH CONFIG, if provided will not use basic auth
 			ClientID: "",
 			Issuer:   "",
@@ -198,12 +599,110 @@ func defaultConfig() *Config {
 				RequireHTTPS:                      false,
 				StrictDiscoveryDocumentValidation: true,
 			},
+			Kerberos: KerberosAuth{
+				Keytab:           "",
+				ServicePrincipal: "",
+				Realm:            "",
+			},
 		},
 		UI: UI{
-			ExternalURL: "",
+			ExternalURL:     "",
+			Path:            "",
+			AutoOpenBrowser: true,
 		},
 		Redfish: Redfish{
-			EnvironmentUUID: "",
+			EnvironmentUUID:     "",
+			BaseRegistryVersion: "1.22.0",
+			SessionTimeout:      1800,
+			MaxSessionsPerUser:  1,
+		},
+		LogForwarding: LogForwarding{
+			Interval: 0,
+			Target:   "syslog",
+			Address:  "",
+			Network:  "udp",
+			Token:    "",
+		},
+		SNMPTraps: SNMPTraps{
+			Interval:  0,
+			Version:   "2c",
+			Community: "public",
+			EngineID:  "80007ed904636f6e736f6c65",
+		},
+		LDAPSync: LDAPSync{
+			Interval:          0,
+			UserFilter:        "(&(objectCategory=person)(objectClass=user))",
+			UsernameAttribute: "sAMAccountName",
+			GroupRoles:        map[string]string{},
+		},
+		Images: Images{
+			Dir: "",
+		},
+		Jobs: Jobs{
+			Workers:           4,
+			TenantWorkers:     2,
+			DeviceConcurrency: 8,
+			QueueDepth:        1000,
+			TenantQueueDepth:  100,
+		},
+		Simulator: Simulator{
+			Devices:   0,
+			Latency:   50 * time.Millisecond,
+			ErrorRate: 0,
+		},
+		KVM: KVM{
+			Quality:   0,
+			FrameRate: 0,
+			Bandwidth: 0,
+		},
+		Redirection: Redirection{
+			IdleTimeout: 0,
+			IdleWarning: time.Minute,
+		},
+		DeviceLock: DeviceLock{
+			Required: false,
+			TTL:      15 * time.Minute,
+		},
+		Network: Network{
+			PreferFamily: "",
+			Domains:      map[string]string{},
+		},
+		WsmanProxy: WsmanProxy{
+			URL:     "",
+			Devices: map[string]string{},
+		},
+		CredentialCheckout: CredentialCheckout{
+			TTL:    15 * time.Minute,
+			MaxTTL: 4 * time.Hour,
+		},
+		Enrollment: Enrollment{
+			TokenTTL:    24 * time.Hour,
+			MaxTokenTTL: 30 * 24 * time.Hour,
+		},
+		RPS: RPS{
+			Enabled: false,
+			Timeout: 30 * time.Second,
+		},
+		MPSCompat: MPSCompat{
+			Enabled: false,
+			Prefix:  "/mps",
+		},
+		Checks: Checks{
+			APIKey: "",
+		},
+		Revocation: Revocation{
+			Enabled:  false,
+			CacheTTL: time.Hour,
+			Timeout:  5 * time.Second,
+		},
+		RootKey: RootKey{
+			Backend:      "file",
+			TransitMount: "transit",
+		},
+		IntegrityCheck: IntegrityCheck{
+			Enabled:    true,
+			Sample:     100,
+			Quarantine: false,
 		},
 	}
 }
@@ -269,6 +768,11 @@ func NewConfig() (*Config, error) {
 		flag.StringVar(&configPathFlag, "config", "", "path to config file")
 	}
 
+	var simulateFlag int
+	if flag.Lookup("simulate") == nil {
+		flag.IntVar(&simulateFlag, "simulate", 0, "number of simulated AMT devices to seed, for UI and load testing")
+	}
+
 	if !flag.Parsed() {
 		flag.Parse()
 	}
@@ -287,5 +791,9 @@ func NewConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if simulateFlag > 0 {
+		ConsoleConfig.Simulator.Devices = simulateFlag
+	}
+
 	return ConsoleConfig, nil
 }
@@ -2,6 +2,8 @@
 package app
 
 import (
+	"context"
+	"crypto"
 	"database/sql"
 	"fmt"
 	"net/http"
@@ -18,6 +20,7 @@ import (
 
 	"github.com/device-management-toolkit/console/config"
 	"github.com/device-management-toolkit/console/internal/controller/httpapi"
+	httpv1 "github.com/device-management-toolkit/console/internal/controller/httpapi/v1"
 	"github.com/device-management-toolkit/console/internal/controller/tcp/cira"
 	wsv1 "github.com/device-management-toolkit/console/internal/controller/ws/v1"
 	"github.com/device-management-toolkit/console/internal/usecase"
@@ -29,6 +32,14 @@ import (
 // CertStore holds the certificate store for domain certificates (set during Init).
 var CertStore security.Storager
 
+// Cryptor encrypts the passwords when their key is kept outside the console (set during Init), nil encrypts them
+// with the local encryption key.
+var Cryptor security.Cryptor
+
+// RootKey holds the private key of the root CA when it is kept on a token or in a KMS (set during Init), nil when
+// the console holds it.
+var RootKey crypto.Signer
+
 var Version = "DEVELOPMENT"
 
 // Run creates objects via constructors.
@@ -36,9 +47,10 @@ func Run(cfg *config.Config) {
 	log := logger.New(cfg.Level)
 	cfg.Version = Version
 	log.Info("app - Run - version: " + cfg.Version)
-	// route standard and Gin logs through our JSON logger
+	// route standard and Gin logs through our JSON logger, and redact the WS-Man message trace
 	logger.SetupStdLog(log)
 	logger.SetupGin(log)
+	logger.SetupLogrus()
 	// Repository
 	database, err := db.New(cfg.DB.URL, sql.Open, db.MaxPoolSize(cfg.PoolMax), db.EnableForeignKeys(true))
 	if err != nil {
@@ -48,7 +60,28 @@ func Run(cfg *config.Config) {
 	defer database.Close()
 
 	// Use case
-	usecases := usecase.NewUseCases(database, log, CertStore)
+	usecases := usecase.NewUseCases(database, log, CertStore, RootKey, Cryptor)
+	defer usecases.Events.Close()
+
+	// undecryptable secrets are reported before the background jobs first reach the devices
+	if cfg.IntegrityCheck.Enabled {
+		if _, err := usecases.Integrity.Check(context.Background()); err != nil {
+			log.Error(err, "app - Run - integrity check")
+		}
+	}
+
+	// background jobs stop when Run returns
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	usecases.Devices.Start(jobsCtx)
+	usecases.PowerHistory.Start(jobsCtx)
+	usecases.AddressHistory.Start(jobsCtx)
+	usecases.EnergyPolicies.Start(jobsCtx)
+	usecases.LogForwarding.Start(jobsCtx)
+	usecases.SNMPTraps.Start(jobsCtx)
+	usecases.LDAPSync.Start(jobsCtx)
+	usecases.Jobs.Start(jobsCtx)
 
 	handler := setupHTTPHandler(cfg, log, usecases, database)
 
@@ -56,8 +89,10 @@ func Run(cfg *config.Config) {
 
 	httpServer := httpserver.New(
 		handler,
-		httpserver.Port(cfg.Host, cfg.Port),
+		httpserver.Port(cfg.ListenHost(), cfg.Port),
 		httpserver.TLS(cfg.TLS.Enabled, cfg.TLS.CertFile, cfg.TLS.KeyFile),
+		httpserver.IdleTimeout(cfg.HTTP2.IdleTimeout),
+		httpserver.HTTP2(cfg.HTTP2.MaxConcurrentStreams),
 		httpserver.Logger(log),
 	)
 
@@ -77,7 +112,11 @@ func setupHTTPHandler(cfg *config.Config, log logger.Interface, usecases *usecas
 	defaultConfig.AllowHeaders = cfg.AllowedHeaders
 
 	handler.Use(cors.New(defaultConfig))
-	httpapi.NewRouter(handler, log, *usecases, cfg, database)
+
+	// Maintenance windows switch the console to read-only, on the API as on the Redfish service and the activation
+	readOnly := httpv1.NewReadOnlyMode(cfg.ReadOnly)
+
+	authenticated := httpapi.NewRouter(handler, log, *usecases, cfg, database, readOnly)
 
 	// Optionally enable pprof endpoints (e.g., for staging) via env ENABLE_PPROF=true
 	if os.Getenv("ENABLE_PPROF") == "true" {
@@ -93,7 +132,11 @@ func setupHTTPHandler(cfg *config.Config, log logger.Interface, usecases *usecas
 		EnableCompression: cfg.WSCompression,
 	}
 
-	wsv1.RegisterRoutes(handler, log, usecases.Devices, upgrader)
+	wsv1.RegisterRoutes(handler, log, usecases.Devices, usecases.Tickets, upgrader)
+
+	if usecases.RPS != nil {
+		wsv1.RegisterRPSRoutes(handler, log, usecases.RPS, upgrader, append(authenticated, httpv1.ReadOnlyRouteMiddleware(readOnly))...)
+	}
 
 	return handler
 }
@@ -106,7 +149,7 @@ func setupCIRAServer(cfg *config.Config, log logger.Interface, database *db.SQL,
 	ciraCertFile := fmt.Sprintf("config/%s_cert.pem", cfg.CommonName)
 	ciraKeyFile := fmt.Sprintf("config/%s_key.pem", cfg.CommonName)
 
-	ciraServer, err := cira.NewServer(ciraCertFile, ciraKeyFile, usecases.Devices, log)
+	ciraServer, err := cira.NewServer(ciraCertFile, ciraKeyFile, usecases.Devices, usecases.Events, log)
 	if err != nil {
 		database.Close()
 		log.Fatal("CIRA Server failed: %v", err)
@@ -6,6 +6,7 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
@@ -65,6 +66,33 @@ func Init(cfg *config.Config) error {
 	return nil
 }
 
+// LatestMigrationVersion returns the version of the newest migration embedded in this build.
+func LatestMigrationVersion() (uint, error) {
+	migrationsSource, err := iofs.New(content, "migrations")
+	if err != nil {
+		return 0, err
+	}
+	defer migrationsSource.Close()
+
+	version, err := migrationsSource.First()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		next, err := migrationsSource.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		version = next
+	}
+}
+
 func setupLocalDB(migrationsSource source.Driver) error {
 	dirname, err := os.UserConfigDir()
 	if err != nil {
@@ -0,0 +1,7 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+ALTER TABLE devices DROP COLUMN notes;
+ALTER TABLE devices DROP COLUMN location;
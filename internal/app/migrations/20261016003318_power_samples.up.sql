@@ -0,0 +1,11 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+CREATE TABLE IF NOT EXISTS power_samples(
+  guid TEXT NOT NULL,
+  sampled_at BIGINT NOT NULL,
+  power_state INTEGER NOT NULL,
+  PRIMARY KEY (guid, sampled_at)
+);
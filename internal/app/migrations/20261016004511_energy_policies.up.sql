@@ -0,0 +1,17 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+CREATE TABLE IF NOT EXISTS energy_policies(
+  name TEXT NOT NULL,
+  tags TEXT NOT NULL,
+  time_zone TEXT NOT NULL,
+  days TEXT NOT NULL,
+  power_off_time TEXT NOT NULL DEFAULT '',
+  power_on_time TEXT NOT NULL DEFAULT '',
+  exceptions TEXT NOT NULL DEFAULT '',
+  enabled BOOLEAN NOT NULL,
+  tenant_id TEXT NOT NULL,
+  PRIMARY KEY (name, tenant_id)
+);
@@ -0,0 +1,6 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+DROP TABLE IF EXISTS log_cursors;
@@ -0,0 +1,12 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+CREATE TABLE IF NOT EXISTS log_cursors(
+  guid TEXT NOT NULL,
+  log TEXT NOT NULL,
+  last_index INTEGER NOT NULL,
+  last_time BIGINT NOT NULL,
+  PRIMARY KEY (guid, log)
+);
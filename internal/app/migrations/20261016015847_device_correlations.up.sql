@@ -0,0 +1,20 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+CREATE TABLE IF NOT EXISTS device_correlations(
+  guid TEXT NOT NULL,
+  tenantid TEXT NOT NULL DEFAULT '',
+  source TEXT NOT NULL,
+  external_id TEXT NOT NULL DEFAULT '',
+  device_name TEXT NOT NULL DEFAULT '',
+  serial_number TEXT NOT NULL DEFAULT '',
+  matched_by TEXT NOT NULL,
+  compliance_state TEXT NOT NULL DEFAULT '',
+  management_state TEXT NOT NULL DEFAULT '',
+  last_sync BIGINT NOT NULL DEFAULT 0,
+  imported_at BIGINT NOT NULL,
+  PRIMARY KEY (guid, source),
+  FOREIGN KEY (guid) REFERENCES devices(guid) ON DELETE CASCADE
+);
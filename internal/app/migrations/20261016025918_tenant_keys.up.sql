@@ -0,0 +1,15 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+-- wrapped_key is the data-encryption key of the tenant encrypted with the master key,
+-- it is cleared when the key is revoked so that the data encrypted with it cannot be recovered
+CREATE TABLE IF NOT EXISTS tenant_keys(
+  key_id TEXT NOT NULL,
+  tenant_id TEXT NOT NULL DEFAULT '',
+  wrapped_key TEXT NOT NULL DEFAULT '',
+  created_at BIGINT NOT NULL,
+  revoked_at BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (key_id)
+);
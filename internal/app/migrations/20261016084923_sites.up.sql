@@ -0,0 +1,15 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+CREATE TABLE IF NOT EXISTS sites(
+  name TEXT NOT NULL,
+  subnets TEXT NOT NULL,
+  proxy TEXT NOT NULL DEFAULT '',
+  tls_policy TEXT NOT NULL DEFAULT '',
+  username TEXT NOT NULL DEFAULT '',
+  password TEXT NOT NULL DEFAULT '',
+  tenant_id TEXT NOT NULL,
+  PRIMARY KEY (name, tenant_id)
+);
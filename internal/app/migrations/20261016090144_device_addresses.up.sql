@@ -0,0 +1,12 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+CREATE TABLE IF NOT EXISTS device_addresses(
+  guid TEXT NOT NULL,
+  recorded_at BIGINT NOT NULL,
+  hostname TEXT NOT NULL,
+  addresses TEXT NOT NULL,
+  PRIMARY KEY (guid, recorded_at)
+);
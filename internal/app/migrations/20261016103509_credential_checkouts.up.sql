@@ -0,0 +1,16 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+-- the times are unix seconds, checked_in_at is 0 until the password is checked in before it expires
+CREATE TABLE IF NOT EXISTS credential_checkouts(
+  id TEXT NOT NULL,
+  guid TEXT NOT NULL,
+  username TEXT NOT NULL,
+  reason TEXT NOT NULL,
+  checked_out_at BIGINT NOT NULL,
+  expires_at BIGINT NOT NULL,
+  checked_in_at BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (id)
+);
@@ -0,0 +1,6 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+ALTER TABLE profiles ADD COLUMN tls_trusted_cns TEXT NOT NULL DEFAULT '';
\ No newline at end of file
@@ -0,0 +1,18 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+-- secrets the startup integrity check could not decrypt, cleared from their row and kept here for recovery;
+-- row_key names the row within its tenant, quarantined_at is unix seconds
+CREATE TABLE IF NOT EXISTS quarantined_secrets(
+  id TEXT NOT NULL,
+  table_name TEXT NOT NULL,
+  column_name TEXT NOT NULL,
+  row_key TEXT NOT NULL,
+  tenant_id TEXT NOT NULL DEFAULT '',
+  cipher_text TEXT NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  quarantined_at BIGINT NOT NULL,
+  PRIMARY KEY (id)
+);
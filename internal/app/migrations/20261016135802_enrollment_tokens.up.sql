@@ -0,0 +1,21 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2023
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+-- only the SHA-256 of a token is stored, the times are unix seconds and used_at is 0 until the token is redeemed
+CREATE TABLE IF NOT EXISTS enrollment_tokens(
+  id TEXT NOT NULL,
+  token_hash TEXT NOT NULL,
+  tenant_id TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  cira_config TEXT NOT NULL,
+  tags TEXT NOT NULL DEFAULT '',
+  created_by TEXT NOT NULL DEFAULT '',
+  created_at BIGINT NOT NULL,
+  expires_at BIGINT NOT NULL,
+  used_at BIGINT NOT NULL DEFAULT 0,
+  used_by TEXT NOT NULL DEFAULT '',
+  PRIMARY KEY (id),
+  UNIQUE (token_hash)
+);
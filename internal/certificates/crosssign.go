@@ -0,0 +1,77 @@
+package certificates
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// CrossSign issues a certificate with the subject and public key of cert signed by signer. The certificates cert
+// issued verify through it up to the signer, so that clients trusting the signer trust them too.
+func CrossSign(cert *x509.Certificate, signer CertAndKeyType) (*x509.Certificate, error) {
+	var maxValue uint = 128
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), maxValue))
+	if err != nil {
+		return nil, err
+	}
+
+	notAfter := cert.NotAfter
+	if signer.Cert.NotAfter.Before(notAfter) {
+		notAfter = signer.Cert.NotAfter
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               cert.Subject,
+		NotBefore:             cert.NotBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              cert.KeyUsage,
+		ExtKeyUsage:           cert.ExtKeyUsage,
+		BasicConstraintsValid: true,
+		IsCA:                  cert.IsCA,
+		SubjectKeyId:          cert.SubjectKeyId,
+	}
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, &template, signer.Cert, cert.PublicKey, signer.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParseCertificate(certBytes)
+}
+
+// LoadCertificate loads a certificate and private key saved as name in the store, falling back to the files.
+func LoadCertificate(store security.Storager, name, certPath, keyPath string) (*x509.Certificate, crypto.Signer, error) {
+	if store != nil {
+		cert, key, err := LoadCertificateFromStore(store, name)
+		if err == nil {
+			return cert, key, nil
+		}
+	}
+
+	return LoadCertificateFromFile(certPath, keyPath)
+}
+
+// RootCertificateInfo describes a root certificate, with its PEM encoding.
+func RootCertificateInfo(cert *x509.Certificate) *dto.RootCertificate {
+	fingerprint := sha256.Sum256(cert.Raw)
+
+	return &dto.RootCertificate{
+		Subject:           cert.Subject.String(),
+		SerialNumber:      cert.SerialNumber.Text(16),
+		NotBefore:         cert.NotBefore,
+		NotAfter:          cert.NotAfter,
+		SHA256Fingerprint: hex.EncodeToString(fingerprint[:]),
+		KeyAlgorithm:      KeyAlgorithmOf(cert.PublicKey),
+		Certificate:       string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})),
+	}
+}
@@ -1,9 +1,8 @@
 package certificates
 
 import (
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
-	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
@@ -44,7 +43,7 @@ type ObjectStorager interface {
 // LoadCertificateFromStore loads a certificate and private key from a security.Storager.
 // If the store implements ObjectStorager, certificates are stored as {cert, key} fields.
 // Path: certs/{name}.
-func LoadCertificateFromStore(store security.Storager, name string) (*x509.Certificate, *rsa.PrivateKey, error) {
+func LoadCertificateFromStore(store security.Storager, name string) (*x509.Certificate, crypto.Signer, error) {
 	// Try object storage first (stores cert/key as proper fields)
 	if objStore, ok := store.(ObjectStorager); ok {
 		data, err := objStore.GetObject("certs/" + name)
@@ -72,16 +71,24 @@ func LoadCertificateFromStore(store security.Storager, name string) (*x509.Certi
 // SaveCertificateToStore saves a certificate and private key to a security.Storager.
 // If the store implements ObjectStorager, certificates are stored as {cert, key} fields.
 // Path: certs/{name}.
-func SaveCertificateToStore(store security.Storager, name string, cert *x509.Certificate, key *rsa.PrivateKey) error {
-	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
-	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+// A key kept outside the console, on a token or in a KMS, is not stored: only the certificate is.
+func SaveCertificateToStore(store security.Storager, name string, cert *x509.Certificate, key crypto.Signer) error {
+	data := map[string]string{
+		"cert": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})),
+	}
+
+	if exportable(key) {
+		keyBlock, err := encodePrivateKey(key)
+		if err != nil {
+			return err
+		}
+
+		data["key"] = string(pem.EncodeToMemory(keyBlock))
+	}
 
 	// Try object storage first (stores cert/key as proper fields)
 	if objStore, ok := store.(ObjectStorager); ok {
-		return objStore.SetObject("certs/"+name, map[string]string{
-			"cert": certPEM,
-			"key":  keyPEM,
-		})
+		return objStore.SetObject("certs/"+name, data)
 	}
 
 	// Fallback: not supported for non-object stores
@@ -89,7 +96,7 @@ func SaveCertificateToStore(store security.Storager, name string, cert *x509.Cer
 }
 
 // ParseCertificateFromPEM parses PEM-encoded certificate and key strings.
-func ParseCertificateFromPEM(certPEM, keyPEM string) (*x509.Certificate, *rsa.PrivateKey, error) {
+func ParseCertificateFromPEM(certPEM, keyPEM string) (*x509.Certificate, crypto.Signer, error) {
 	// Decode certificate PEM
 	certBlock, _ := pem.Decode([]byte(certPEM))
 	if certBlock == nil {
@@ -107,7 +114,7 @@ func ParseCertificateFromPEM(certPEM, keyPEM string) (*x509.Certificate, *rsa.Pr
 		return nil, nil, ErrDecodePrivateKeyPEM
 	}
 
-	privateKey, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	privateKey, err := parsePrivateKey(keyBlock)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to parse private key: %w", err)
 	}
@@ -116,7 +123,7 @@ func ParseCertificateFromPEM(certPEM, keyPEM string) (*x509.Certificate, *rsa.Pr
 }
 
 // LoadCertificateFromFile loads a certificate and private key from PEM files.
-func LoadCertificateFromFile(certPath, keyPath string) (*x509.Certificate, *rsa.PrivateKey, error) {
+func LoadCertificateFromFile(certPath, keyPath string) (*x509.Certificate, crypto.Signer, error) {
 	// Read certificate file
 	certPEM, err := os.ReadFile(certPath)
 	if err != nil {
@@ -148,7 +155,7 @@ func LoadCertificateFromFile(certPath, keyPath string) (*x509.Certificate, *rsa.
 	}
 
 	// Parse private key
-	privateKey, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	privateKey, err := parsePrivateKey(keyBlock)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to parse private key: %w", err)
 	}
@@ -158,7 +165,7 @@ func LoadCertificateFromFile(certPath, keyPath string) (*x509.Certificate, *rsa.
 
 // CheckAndLoadOrGenerateRootCertificate checks if root certificate files exist,
 // loads them if they do, or generates new ones if they don't.
-func CheckAndLoadOrGenerateRootCertificate(addThumbPrintToName bool, commonName, country, organization string, strong bool) (*x509.Certificate, *rsa.PrivateKey, error) {
+func CheckAndLoadOrGenerateRootCertificate(addThumbPrintToName bool, commonName, country, organization string, alg KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
 	// Check if both files exist
 	_, certErr := os.Stat(RootCertPath)
 	_, keyErr := os.Stat(RootKeyPath)
@@ -174,14 +181,14 @@ func CheckAndLoadOrGenerateRootCertificate(addThumbPrintToName bool, commonName,
 	}
 
 	// Files don't exist or loading failed, generate new certificates
-	return GenerateRootCertificate(addThumbPrintToName, commonName, country, organization, strong)
+	return GenerateRootCertificate(addThumbPrintToName, commonName, country, organization, alg)
 }
 
 // LoadOrGenerateRootCertificateWithVault attempts to load the root certificate from Vault first,
 // falls back to local files, and generates new certificates if neither exists.
 // When a new certificate is generated, it is stored in Vault (if available) and locally.
 // Certificate is stored at: {basePath}/certs/root.
-func LoadOrGenerateRootCertificateWithVault(store security.Storager, addThumbPrintToName bool, commonName, country, organization string, strong bool) (*x509.Certificate, *rsa.PrivateKey, error) {
+func LoadOrGenerateRootCertificateWithVault(store security.Storager, addThumbPrintToName bool, commonName, country, organization string, alg KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
 	const certName = "root"
 
 	// Try Vault first (primary store for high-value certs)
@@ -203,11 +210,11 @@ func LoadOrGenerateRootCertificateWithVault(store security.Storager, addThumbPri
 	}
 
 	// Generate new certificates
-	return generateAndStoreRootCert(store, certName, addThumbPrintToName, commonName, country, organization, strong)
+	return generateAndStoreRootCert(store, certName, addThumbPrintToName, commonName, country, organization, alg)
 }
 
 // tryLoadRootCertFromFiles attempts to load root certificate from local files and sync to vault.
-func tryLoadRootCertFromFiles(store security.Storager, certName string) (*x509.Certificate, *rsa.PrivateKey, error) {
+func tryLoadRootCertFromFiles(store security.Storager, certName string) (*x509.Certificate, crypto.Signer, error) {
 	_, certErr := os.Stat(RootCertPath)
 	_, keyErr := os.Stat(RootKeyPath)
 
@@ -237,8 +244,8 @@ func tryLoadRootCertFromFiles(store security.Storager, certName string) (*x509.C
 }
 
 // generateAndStoreRootCert generates a new root certificate and stores it.
-func generateAndStoreRootCert(store security.Storager, certName string, addThumbPrintToName bool, commonName, country, organization string, strong bool) (*x509.Certificate, *rsa.PrivateKey, error) {
-	cert, key, err := GenerateRootCertificate(addThumbPrintToName, commonName, country, organization, strong)
+func generateAndStoreRootCert(store security.Storager, certName string, addThumbPrintToName bool, commonName, country, organization string, alg KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
+	cert, key, err := GenerateRootCertificate(addThumbPrintToName, commonName, country, organization, alg)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -259,7 +266,7 @@ func generateAndStoreRootCert(store security.Storager, certName string, addThumb
 
 // CheckAndLoadOrGenerateWebServerCertificate checks if web server certificate files exist,
 // loads them if they do, or generates new ones if they don't.
-func CheckAndLoadOrGenerateWebServerCertificate(rootCert CertAndKeyType, addThumbPrintToName bool, commonName, country, organization string, strong bool) (*x509.Certificate, *rsa.PrivateKey, error) {
+func CheckAndLoadOrGenerateWebServerCertificate(rootCert CertAndKeyType, addThumbPrintToName bool, commonName, country, organization string, alg KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
 	certPath := "config/" + commonName + "_cert.pem"
 	keyPath := "config/" + commonName + "_key.pem"
 
@@ -278,14 +285,14 @@ func CheckAndLoadOrGenerateWebServerCertificate(rootCert CertAndKeyType, addThum
 	}
 
 	// Files don't exist or loading failed, generate new certificates
-	return IssueWebServerCertificate(rootCert, addThumbPrintToName, commonName, country, organization, strong)
+	return IssueWebServerCertificate(rootCert, addThumbPrintToName, commonName, country, organization, alg)
 }
 
 // LoadOrGenerateWebServerCertificateWithVault attempts to load the web server certificate from Vault first,
 // falls back to local files, and generates new certificates if neither exists.
 // When a new certificate is generated, it is stored in Vault (if available) and locally.
 // Certificate is stored at: {basePath}/certs/webserver-{commonName}.
-func LoadOrGenerateWebServerCertificateWithVault(store security.Storager, rootCert CertAndKeyType, addThumbPrintToName bool, commonName, country, organization string, strong bool) (*x509.Certificate, *rsa.PrivateKey, error) {
+func LoadOrGenerateWebServerCertificateWithVault(store security.Storager, rootCert CertAndKeyType, addThumbPrintToName bool, commonName, country, organization string, alg KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
 	certName := "webserver-" + commonName
 	certPath := "config/" + commonName + "_cert.pem"
 	keyPath := "config/" + commonName + "_key.pem"
@@ -293,6 +300,11 @@ func LoadOrGenerateWebServerCertificateWithVault(store security.Storager, rootCe
 	// Try Vault first (primary store for high-value certs)
 	if store != nil {
 		cert, key, err := LoadCertificateFromStore(store, certName)
+		if err == nil {
+			// a certificate issued by another root is issued again
+			err = cert.CheckSignatureFrom(rootCert.Cert)
+		}
+
 		if err == nil {
 			log.Println("Web server certificate loaded from Vault")
 
@@ -304,16 +316,16 @@ func LoadOrGenerateWebServerCertificateWithVault(store security.Storager, rootCe
 
 	// Try local files as fallback
 	cert, key, err := tryLoadWebServerCertFromFiles(store, certName, certPath, keyPath)
-	if err == nil {
+	if err == nil && cert.CheckSignatureFrom(rootCert.Cert) == nil {
 		return cert, key, nil
 	}
 
 	// Generate new certificates
-	return generateAndStoreWebServerCert(store, rootCert, certName, addThumbPrintToName, commonName, country, organization, strong)
+	return generateAndStoreWebServerCert(store, rootCert, certName, addThumbPrintToName, commonName, country, organization, alg)
 }
 
 // tryLoadWebServerCertFromFiles attempts to load web server certificate from local files and sync to vault.
-func tryLoadWebServerCertFromFiles(store security.Storager, certName, certPath, keyPath string) (*x509.Certificate, *rsa.PrivateKey, error) {
+func tryLoadWebServerCertFromFiles(store security.Storager, certName, certPath, keyPath string) (*x509.Certificate, crypto.Signer, error) {
 	_, certErr := os.Stat(certPath)
 	_, keyErr := os.Stat(keyPath)
 
@@ -343,8 +355,8 @@ func tryLoadWebServerCertFromFiles(store security.Storager, certName, certPath,
 }
 
 // generateAndStoreWebServerCert generates a new web server certificate and stores it.
-func generateAndStoreWebServerCert(store security.Storager, rootCert CertAndKeyType, certName string, addThumbPrintToName bool, commonName, country, organization string, strong bool) (*x509.Certificate, *rsa.PrivateKey, error) {
-	cert, key, err := IssueWebServerCertificate(rootCert, addThumbPrintToName, commonName, country, organization, strong)
+func generateAndStoreWebServerCert(store security.Storager, rootCert CertAndKeyType, certName string, addThumbPrintToName bool, commonName, country, organization string, alg KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
+	cert, key, err := IssueWebServerCertificate(rootCert, addThumbPrintToName, commonName, country, organization, alg)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -363,32 +375,49 @@ func generateAndStoreWebServerCert(store security.Storager, rootCert CertAndKeyT
 	return cert, key, nil
 }
 
-func GenerateRootCertificate(addThumbPrintToName bool, commonName, country, organization string, strong bool) (*x509.Certificate, *rsa.PrivateKey, error) {
-	keyLength := 2048
-	if strong {
-		keyLength = 3072
+// GenerateRootCertificate creates a self-signed root certificate and saves it to RootCertPath and RootKeyPath.
+func GenerateRootCertificate(addThumbPrintToName bool, commonName, country, organization string, alg KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
+	cert, privateKey, err := NewRootCertificate(addThumbPrintToName, commonName, country, organization, alg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := SaveCertificateToFiles(cert, privateKey, RootCertPath, RootKeyPath); err != nil {
+		return nil, nil, err
+	}
+
+	return cert, privateKey, nil
+}
+
+// NewRootCertificate creates a self-signed root certificate with a new key without saving it.
+func NewRootCertificate(addThumbPrintToName bool, commonName, country, organization string, alg KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
+	privateKey, err := GenerateKey(alg)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Generate RSA keys
-	privateKey, err := rsa.GenerateKey(rand.Reader, keyLength)
+	cert, err := NewRootCertificateWithKey(privateKey, addThumbPrintToName, commonName, country, organization)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	return cert, privateKey, nil
+}
+
+// NewRootCertificateWithKey creates a self-signed root certificate of a key, such as one kept on a token.
+func NewRootCertificateWithKey(privateKey crypto.Signer, addThumbPrintToName bool, commonName, country, organization string) (*x509.Certificate, error) {
 	// Preparing the certificate
 	var maxValue uint = 128
 
 	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), maxValue))
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	thirtyYears := 30
 
 	if addThumbPrintToName {
-		hash := sha256.New()
-		hash.Write(privateKey.N.Bytes()) // Simplified approach to get a thumbprint-like result
-		commonName += "-" + fmt.Sprintf("%x", hash.Sum(nil)[:3])
+		commonName += "-" + fmt.Sprintf("%x", thumbprint(privateKey.Public())[:3])
 	}
 
 	if country == "" {
@@ -416,55 +445,69 @@ func GenerateRootCertificate(addThumbPrintToName bool, commonName, country, orga
 	}
 
 	// Create a self-signed certificate
-	certBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, &privateKey.PublicKey, privateKey)
+	certBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, privateKey.Public(), privateKey)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// Encoding certificate to PEM format
-	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
+	return x509.ParseCertificate(certBytes)
+}
 
-	// Save to files (optional)
-	certOut, err := os.Create("config/root_cert.pem")
+// SaveCertificateToFiles saves a certificate and its private key as PEM files, the certificate alone when its key
+// is kept outside the console.
+func SaveCertificateToFiles(cert *x509.Certificate, key crypto.Signer, certPath, keyPath string) error {
+	certOut, err := os.Create(certPath)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
+	defer certOut.Close()
 
-	_, err = certOut.Write(certPEM)
-	if err != nil {
-		return nil, nil, err
+	if err := pem.Encode(certOut, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}); err != nil {
+		return err
 	}
 
-	certOut.Close()
+	if !exportable(key) {
+		return nil
+	}
 
-	keyOut, err := os.Create("config/root_key.pem")
+	keyBlock, err := encodePrivateKey(key)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
 
-	err = pem.Encode(keyOut, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	keyOut, err := os.Create(keyPath)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
+	defer keyOut.Close()
 
-	keyOut.Close()
-
-	return &template, privateKey, nil
+	return pem.Encode(keyOut, keyBlock)
 }
 
 type CertAndKeyType struct {
 	Cert *x509.Certificate
-	Key  *rsa.PrivateKey
+	Key  crypto.Signer
 }
 
-func IssueWebServerCertificate(rootCert CertAndKeyType, addThumbPrintToName bool, commonName, country, organization string, strong bool) (*x509.Certificate, *rsa.PrivateKey, error) {
-	keyLength := 2048
-	if strong {
-		keyLength = 3072
+// IssueWebServerCertificate issues a web server certificate signed by rootCert and saves it to
+// config/{commonName}_cert.pem and config/{commonName}_key.pem.
+func IssueWebServerCertificate(rootCert CertAndKeyType, addThumbPrintToName bool, commonName, country, organization string, alg KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
+	cert, keys, err := NewWebServerCertificate(rootCert, addThumbPrintToName, commonName, country, organization, alg)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Generate RSA keys
-	keys, err := rsa.GenerateKey(rand.Reader, keyLength)
+	// Save certificate and key to files
+	if err := saveCertAndKeyToFiles(commonName, cert.Raw, keys); err != nil {
+		return nil, nil, err
+	}
+
+	return cert, keys, nil
+}
+
+// NewWebServerCertificate issues a web server certificate signed by rootCert without saving it.
+func NewWebServerCertificate(rootCert CertAndKeyType, addThumbPrintToName bool, commonName, country, organization string, alg KeyAlgorithm) (*x509.Certificate, crypto.Signer, error) {
+	keys, err := GenerateKey(alg)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -493,23 +536,21 @@ func IssueWebServerCertificate(rootCert CertAndKeyType, addThumbPrintToName bool
 	}
 
 	if addThumbPrintToName {
-		hash := sha256.New()
-		hash.Write(keys.N.Bytes()) // Simplified approach to get a thumbprint-like result
-		subject.CommonName += "-" + string(hash.Sum(nil)[:3])
+		subject.CommonName += "-" + string(thumbprint(keys.Public())[:3])
 	}
 
-	hash := sha256.Sum256(keys.N.Bytes())
+	hash := thumbprint(keys.Public())
 
 	template := x509.Certificate{
 		SerialNumber:          serialNumber,
 		Subject:               subject,
 		NotBefore:             notBefore,
 		NotAfter:              notAfter,
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign | x509.KeyUsageDataEncipherment,
+		KeyUsage:              keyUsage(keys.Public(), x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment|x509.KeyUsageCertSign|x509.KeyUsageDataEncipherment),
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
 		BasicConstraintsValid: true,
 		IsCA:                  false,
-		SubjectKeyId:          hash[:],
+		SubjectKeyId:          hash,
 	}
 
 	// Subject Alternative Name
@@ -518,21 +559,26 @@ func IssueWebServerCertificate(rootCert CertAndKeyType, addThumbPrintToName bool
 	template.URIs = []*url.URL{uri}
 
 	// Sign the certificate with root certificate private key
-	certBytes, err := x509.CreateCertificate(rand.Reader, &template, rootCert.Cert, &keys.PublicKey, rootCert.Key)
+	certBytes, err := x509.CreateCertificate(rand.Reader, &template, rootCert.Cert, keys.Public(), rootCert.Key)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// Save certificate and key to files
-	if err := saveCertAndKeyToFiles(commonName, certBytes, keys); err != nil {
+	cert, err := x509.ParseCertificate(certBytes)
+	if err != nil {
 		return nil, nil, err
 	}
 
-	return &template, keys, nil
+	return cert, keys, nil
 }
 
 // saveCertAndKeyToFiles saves a certificate and private key to PEM files.
-func saveCertAndKeyToFiles(commonName string, certBytes []byte, keys *rsa.PrivateKey) error {
+func saveCertAndKeyToFiles(commonName string, certBytes []byte, keys crypto.Signer) error {
+	keyBlock, err := encodePrivateKey(keys)
+	if err != nil {
+		return err
+	}
+
 	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
 
 	certOut, err := os.Create("config/" + commonName + "_cert.pem")
@@ -551,5 +597,5 @@ func saveCertAndKeyToFiles(commonName string, certBytes []byte, keys *rsa.Privat
 	}
 	defer keyOut.Close()
 
-	return pem.Encode(keyOut, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(keys)})
+	return pem.Encode(keyOut, keyBlock)
 }
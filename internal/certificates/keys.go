@@ -0,0 +1,153 @@
+package certificates
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// KeyAlgorithm is the algorithm and size of the keys of the generated root and web server certificates.
+type KeyAlgorithm string
+
+// Key algorithms of the generated certificates.
+const (
+	KeyRSA2048   KeyAlgorithm = "rsa2048"
+	KeyRSA3072   KeyAlgorithm = "rsa3072"
+	KeyRSA4096   KeyAlgorithm = "rsa4096"
+	KeyECDSAP256 KeyAlgorithm = "ecdsa-p256"
+	KeyECDSAP384 KeyAlgorithm = "ecdsa-p384"
+)
+
+// DefaultKeyAlgorithm is used when no key algorithm is configured.
+const DefaultKeyAlgorithm = KeyRSA3072
+
+// ErrUnsupportedKeyAlgorithm is returned for a key algorithm or private key other than RSA and ECDSA P-256 and P-384.
+var ErrUnsupportedKeyAlgorithm = errors.New("unsupported key algorithm")
+
+// ParseKeyAlgorithm returns the configured key algorithm, rsa3072, rsa4096, ecdsa-p256 or ecdsa-p384, the default
+// when empty.
+func ParseKeyAlgorithm(s string) (KeyAlgorithm, error) {
+	switch alg := KeyAlgorithm(strings.ToLower(s)); alg {
+	case "":
+		return DefaultKeyAlgorithm, nil
+	case KeyRSA3072, KeyRSA4096, KeyECDSAP256, KeyECDSAP384:
+		return alg, nil
+	default:
+		return "", fmt.Errorf("%w: %s, use rsa3072, rsa4096, ecdsa-p256 or ecdsa-p384", ErrUnsupportedKeyAlgorithm, s)
+	}
+}
+
+// GenerateKey generates a private key of the algorithm.
+func GenerateKey(alg KeyAlgorithm) (crypto.Signer, error) {
+	switch alg {
+	case KeyRSA2048:
+		return rsa.GenerateKey(rand.Reader, 2048)
+	case KeyRSA3072:
+		return rsa.GenerateKey(rand.Reader, 3072)
+	case KeyRSA4096:
+		return rsa.GenerateKey(rand.Reader, 4096)
+	case KeyECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyAlgorithm, alg)
+	}
+}
+
+// KeyAlgorithmOf returns the algorithm of a public key, rsa followed by its size or ecdsa followed by its curve.
+func KeyAlgorithmOf(pub crypto.PublicKey) string {
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("rsa%d", key.N.BitLen())
+	case *ecdsa.PublicKey:
+		return "ecdsa-" + strings.ToLower(strings.ReplaceAll(key.Curve.Params().Name, "-", ""))
+	default:
+		return "unknown"
+	}
+}
+
+// keyUsage returns the key usages of a certificate with the public key: the key of an RSA certificate also
+// encrypts the keys of the sessions, that of an ECDSA certificate only signs.
+func keyUsage(pub crypto.PublicKey, usage x509.KeyUsage) x509.KeyUsage {
+	if _, ok := pub.(*rsa.PublicKey); ok {
+		return usage
+	}
+
+	return usage &^ (x509.KeyUsageKeyEncipherment | x509.KeyUsageDataEncipherment)
+}
+
+// thumbprint returns a hash of a public key, that of the modulus for an RSA key as the first certificates had.
+func thumbprint(pub crypto.PublicKey) []byte {
+	var data []byte
+
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		data = key.N.Bytes()
+	default:
+		data, _ = x509.MarshalPKIXPublicKey(pub)
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hash[:]
+}
+
+// exportable reports whether a private key is held by the console, rather than kept on a token or in a KMS that
+// only signs with it.
+func exportable(key crypto.Signer) bool {
+	switch key.(type) {
+	case *rsa.PrivateKey, *ecdsa.PrivateKey:
+		return true
+	default:
+		return false
+	}
+}
+
+// encodePrivateKey encodes an RSA key as PKCS #1 and an ECDSA key as SEC 1, which the TLS key pairs take.
+func encodePrivateKey(key crypto.Signer) (*pem.Block, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}, nil
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}, nil
+	default:
+		return nil, ErrUnsupportedKeyAlgorithm
+	}
+}
+
+// parsePrivateKey decodes a PKCS #1, SEC 1 or PKCS #8 private key.
+func parsePrivateKey(block *pem.Block) (crypto.Signer, error) {
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		// keys written before the type was looked at
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, ErrUnsupportedKeyAlgorithm
+	}
+
+	return signer, nil
+}
@@ -0,0 +1,70 @@
+package certificates
+
+import (
+	"crypto/tls"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value string
+		want  KeyAlgorithm
+		err   bool
+	}{
+		{value: "", want: KeyRSA3072},
+		{value: "rsa4096", want: KeyRSA4096},
+		{value: "ECDSA-P384", want: KeyECDSAP384},
+		{value: "rsa1024", err: true},
+		{value: "ed25519", err: true},
+	}
+
+	for _, tc := range tests {
+		alg, err := ParseKeyAlgorithm(tc.value)
+		if tc.err {
+			assert.ErrorIs(t, err, ErrUnsupportedKeyAlgorithm, tc.value)
+
+			continue
+		}
+
+		assert.NoError(t, err, tc.value)
+		assert.Equal(t, tc.want, alg, tc.value)
+	}
+}
+
+func TestGeneratedCertificateKeys(t *testing.T) {
+	t.Parallel()
+
+	for _, alg := range []KeyAlgorithm{KeyRSA2048, KeyECDSAP256, KeyECDSAP384} {
+		t.Run(string(alg), func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			root, rootKey, err := NewRootCertificate(true, "console", "US", "device-management-toolkit", alg)
+			require.NoError(t, err)
+			require.Equal(t, string(alg), KeyAlgorithmOf(root.PublicKey))
+
+			web, webKey, err := NewWebServerCertificate(CertAndKeyType{Cert: root, Key: rootKey}, false, "console", "US", "device-management-toolkit", alg)
+			require.NoError(t, err)
+			require.NoError(t, web.CheckSignatureFrom(root))
+
+			certPath, keyPath := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+			require.NoError(t, SaveCertificateToFiles(web, webKey, certPath, keyPath))
+
+			// the MPS loads the web server certificate as a TLS key pair
+			_, err = tls.LoadX509KeyPair(certPath, keyPath)
+			require.NoError(t, err)
+
+			loaded, loadedKey, err := LoadCertificateFromFile(certPath, keyPath)
+			require.NoError(t, err)
+			require.Equal(t, web.Raw, loaded.Raw)
+			require.Equal(t, webKey.Public(), loadedKey.Public())
+		})
+	}
+}
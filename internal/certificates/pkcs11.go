@@ -0,0 +1,256 @@
+//go:build pkcs11 && cgo
+
+package certificates
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+)
+
+// Sentinel errors of the PKCS#11 signer.
+var (
+	ErrPKCS11TokenNotFound = errors.New("PKCS#11 token not found")
+	ErrPKCS11KeyNotFound   = errors.New("PKCS#11 key not found")
+	ErrPKCS11Unsupported   = errors.New("unsupported by the PKCS#11 signer")
+)
+
+// digestInfoPrefixes are the DER prefixes of the PKCS#1 v1.5 DigestInfo of each hash, the token signs the
+// DigestInfo as is with CKM_RSA_PKCS.
+var digestInfoPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+// curves are the named curves of the EC keys, by the DER encoding of their OID.
+var curves = map[string]elliptic.Curve{
+	string([]byte{0x06, 0x08, 0x2a, 0x86, 0x48, 0xce, 0x3d, 0x03, 0x01, 0x07}): elliptic.P256(),
+	string([]byte{0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x22}):                   elliptic.P384(),
+}
+
+// pkcs11Signer signs with a private key that never leaves a PKCS#11 token. Sessions of a token cannot be shared
+// between goroutines, the signatures are made one at a time.
+type pkcs11Signer struct {
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	key     pkcs11.ObjectHandle
+	public  crypto.PublicKey
+
+	mu sync.Mutex // Protects session
+}
+
+// NewPKCS11Signer logs in to the token of the configuration and returns a signer of its key.
+func NewPKCS11Signer(cfg PKCS11Config) (crypto.Signer, error) {
+	ctx := pkcs11.New(cfg.Module)
+	if ctx == nil {
+		return nil, fmt.Errorf("%w: cannot load module %s", ErrPKCS11TokenNotFound, cfg.Module)
+	}
+
+	if err := ctx.Initialize(); err != nil {
+		return nil, err
+	}
+
+	session, err := openSession(ctx, cfg.TokenLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Login(session, pkcs11.CKU_USER, cfg.PIN); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return nil, err
+	}
+
+	key, err := findObject(ctx, session, pkcs11.CKO_PRIVATE_KEY, cfg.KeyLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := findObject(ctx, session, pkcs11.CKO_PUBLIC_KEY, cfg.KeyLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	public, err := readPublicKey(ctx, session, pub)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pkcs11Signer{ctx: ctx, session: session, key: key, public: public}, nil
+}
+
+// openSession opens a session of the token labelled label.
+func openSession(ctx *pkcs11.Ctx, label string) (pkcs11.SessionHandle, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil || info.Label != label {
+			continue
+		}
+
+		return ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrPKCS11TokenNotFound, label)
+}
+
+// findObject returns the object of a class labelled label.
+func findObject(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, class uint, label string) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+
+	if err := ctx.FindObjectsInit(session, template); err != nil {
+		return 0, err
+	}
+
+	objects, _, err := ctx.FindObjects(session, 1)
+
+	if finalErr := ctx.FindObjectsFinal(session); err == nil {
+		err = finalErr
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(objects) == 0 {
+		return 0, fmt.Errorf("%w: %s", ErrPKCS11KeyNotFound, label)
+	}
+
+	return objects[0], nil
+}
+
+// readPublicKey reads an RSA or EC public key object of the token.
+func readPublicKey(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, object pkcs11.ObjectHandle) (crypto.PublicKey, error) {
+	attrs, err := ctx.GetAttributeValue(session, object, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil)})
+	if err != nil {
+		return nil, err
+	}
+
+	switch ulong(attrs[0].Value) {
+	case pkcs11.CKK_RSA:
+		attrs, err = ctx.GetAttributeValue(session, object, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(attrs[0].Value),
+			E: int(new(big.Int).SetBytes(attrs[1].Value).Int64()),
+		}, nil
+	case pkcs11.CKK_EC:
+		attrs, err = ctx.GetAttributeValue(session, object, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		curve, ok := curves[string(attrs[0].Value)]
+		if !ok {
+			return nil, fmt.Errorf("%w: curve", ErrPKCS11Unsupported)
+		}
+
+		// the point is an uncompressed point wrapped in an OCTET STRING
+		var point []byte
+		if _, err := asn1.Unmarshal(attrs[1].Value, &point); err != nil {
+			return nil, err
+		}
+
+		x, y := elliptic.Unmarshal(curve, point) //nolint:staticcheck // the point is read from the token, not used for ECDH
+		if x == nil {
+			return nil, fmt.Errorf("%w: EC point", ErrPKCS11Unsupported)
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("%w: key type", ErrPKCS11Unsupported)
+	}
+}
+
+// ulong decodes a CK_ULONG attribute, in the byte order and size of the platform.
+func ulong(b []byte) uint {
+	if len(b) == 4 {
+		return uint(binary.NativeEndian.Uint32(b))
+	}
+
+	if len(b) == 8 {
+		return uint(binary.NativeEndian.Uint64(b))
+	}
+
+	return ^uint(0)
+}
+
+// Public returns the public key of the token key.
+func (s *pkcs11Signer) Public() crypto.PublicKey {
+	return s.public
+}
+
+// Sign signs a digest with the token key, PKCS#1 v1.5 for RSA and ASN.1 encoded for ECDSA.
+func (s *pkcs11Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var (
+		mechanism *pkcs11.Mechanism
+		input     []byte
+	)
+
+	switch s.public.(type) {
+	case *rsa.PublicKey:
+		if _, ok := opts.(*rsa.PSSOptions); ok {
+			return nil, fmt.Errorf("%w: RSA-PSS", ErrPKCS11Unsupported)
+		}
+
+		prefix, ok := digestInfoPrefixes[opts.HashFunc()]
+		if !ok {
+			return nil, fmt.Errorf("%w: hash %v", ErrPKCS11Unsupported, opts.HashFunc())
+		}
+
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)
+		input = append(append([]byte{}, prefix...), digest...)
+	default:
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+		input = digest
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.ctx.SignInit(s.session, []*pkcs11.Mechanism{mechanism}, s.key); err != nil {
+		return nil, err
+	}
+
+	signature, err := s.ctx.Sign(s.session, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.public.(*ecdsa.PublicKey); !ok {
+		return signature, nil
+	}
+
+	// the token returns r and s concatenated, x509 wants them as an ASN.1 sequence
+	half := len(signature) / 2
+
+	return asn1.Marshal(struct{ R, S *big.Int }{
+		R: new(big.Int).SetBytes(signature[:half]),
+		S: new(big.Int).SetBytes(signature[half:]),
+	})
+}
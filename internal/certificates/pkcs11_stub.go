@@ -0,0 +1,16 @@
+//go:build !pkcs11 || !cgo
+
+package certificates
+
+import (
+	"crypto"
+	"errors"
+)
+
+// ErrPKCS11Unavailable is returned when the console is built without PKCS#11 support.
+var ErrPKCS11Unavailable = errors.New("PKCS#11 support not built in, build with cgo and the pkcs11 tag")
+
+// NewPKCS11Signer is not available in this build.
+func NewPKCS11Signer(_ PKCS11Config) (crypto.Signer, error) {
+	return nil, ErrPKCS11Unavailable
+}
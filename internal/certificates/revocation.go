@@ -0,0 +1,259 @@
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// maxRevocationResponse is the largest OCSP response, CRL or issuer certificate read, in bytes.
+const maxRevocationResponse = 10 << 20
+
+// Sentinel errors for revocation checks.
+var (
+	ErrIssuerNotFound     = errors.New("issuer certificate not found")
+	ErrRevocationResponse = errors.New("unexpected response")
+	ErrOCSPUnknown        = errors.New("OCSP responder does not know the certificate")
+	ErrCRLOutdated        = errors.New("CRL is out of date")
+)
+
+// RevocationChecker asks the OCSP responders and CRL distribution points named in certificates whether their
+// issuer revoked them, OCSP first. The statuses are cached for the cache TTL, the CRLs until their next update
+// at most. A status that cannot be found out is not cached, the responders are asked again the next time.
+type RevocationChecker struct {
+	client   *http.Client
+	cacheTTL time.Duration
+	now      func() time.Time
+
+	mu       sync.Mutex // Protects statuses and crls
+	statuses map[[sha256.Size]byte]cachedStatus
+	crls     map[string]cachedCRL
+}
+
+type cachedStatus struct {
+	status  dto.RevocationStatus
+	expires time.Time
+}
+
+type cachedCRL struct {
+	crl     *x509.RevocationList
+	expires time.Time
+}
+
+// NewRevocationChecker creates a checker giving up on a responder after timeout and caching for cacheTTL.
+func NewRevocationChecker(timeout, cacheTTL time.Duration) *RevocationChecker {
+	return &RevocationChecker{
+		client:   &http.Client{Timeout: timeout},
+		cacheTTL: cacheTTL,
+		now:      time.Now,
+		statuses: map[[sha256.Size]byte]cachedStatus{},
+		crls:     map[string]cachedCRL{},
+	}
+}
+
+// Check returns the revocation status of cert. Its issuer is looked for in intermediates, then downloaded from
+// the authority information access of cert.
+func (r *RevocationChecker) Check(ctx context.Context, cert *x509.Certificate, intermediates []*x509.Certificate) dto.RevocationStatus {
+	key := sha256.Sum256(cert.Raw)
+
+	r.mu.Lock()
+	cached, ok := r.statuses[key]
+	r.mu.Unlock()
+
+	if ok && r.now().Before(cached.expires) {
+		return cached.status
+	}
+
+	status := r.check(ctx, cert, intermediates)
+	status.CheckedAt = r.now()
+
+	if status.Status != dto.RevocationUnknown {
+		r.mu.Lock()
+		r.statuses[key] = cachedStatus{status: status, expires: status.CheckedAt.Add(r.cacheTTL)}
+		r.mu.Unlock()
+	}
+
+	return status
+}
+
+func (r *RevocationChecker) check(ctx context.Context, cert *x509.Certificate, intermediates []*x509.Certificate) dto.RevocationStatus {
+	if len(cert.OCSPServer) == 0 && len(cert.CRLDistributionPoints) == 0 {
+		return dto.RevocationStatus{Status: dto.RevocationUnknown, Reason: "no OCSP or CRL endpoint"}
+	}
+
+	issuer, err := r.issuer(ctx, cert, intermediates)
+	if err != nil {
+		return dto.RevocationStatus{Status: dto.RevocationUnknown, Reason: err.Error()}
+	}
+
+	reasons := []string{}
+
+	for _, server := range cert.OCSPServer {
+		status, err := r.ocsp(ctx, server, cert, issuer)
+		if err == nil {
+			return status
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s: %v", server, err))
+	}
+
+	for _, point := range cert.CRLDistributionPoints {
+		status, err := r.crl(ctx, point, cert, issuer)
+		if err == nil {
+			return status
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s: %v", point, err))
+	}
+
+	return dto.RevocationStatus{Status: dto.RevocationUnknown, Reason: strings.Join(reasons, "; ")}
+}
+
+// issuer returns the certificate that signed cert, from intermediates or downloaded from its issuing
+// certificate URLs.
+func (r *RevocationChecker) issuer(ctx context.Context, cert *x509.Certificate, intermediates []*x509.Certificate) (*x509.Certificate, error) {
+	for _, candidate := range intermediates {
+		if bytes.Equal(cert.RawIssuer, candidate.RawSubject) && cert.CheckSignatureFrom(candidate) == nil {
+			return candidate, nil
+		}
+	}
+
+	for _, url := range cert.IssuingCertificateURL {
+		body, err := r.fetch(ctx, http.MethodGet, url, "", nil)
+		if err != nil {
+			continue
+		}
+
+		if block, _ := pem.Decode(body); block != nil {
+			body = block.Bytes
+		}
+
+		candidate, err := x509.ParseCertificate(body)
+		if err == nil && cert.CheckSignatureFrom(candidate) == nil {
+			return candidate, nil
+		}
+	}
+
+	return nil, ErrIssuerNotFound
+}
+
+// ocsp asks an OCSP responder for the status of cert, the response must be signed by issuer or a responder it
+// delegated to.
+func (r *RevocationChecker) ocsp(ctx context.Context, server string, cert, issuer *x509.Certificate) (dto.RevocationStatus, error) {
+	request, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return dto.RevocationStatus{}, err
+	}
+
+	body, err := r.fetch(ctx, http.MethodPost, server, "application/ocsp-request", request)
+	if err != nil {
+		return dto.RevocationStatus{}, err
+	}
+
+	response, err := ocsp.ParseResponseForCert(body, cert, issuer)
+	if err != nil {
+		return dto.RevocationStatus{}, err
+	}
+
+	switch response.Status {
+	case ocsp.Good:
+		return dto.RevocationStatus{Status: dto.RevocationGood, Source: "ocsp"}, nil
+	case ocsp.Revoked:
+		return dto.RevocationStatus{Status: dto.RevocationRevoked, Source: "ocsp", RevokedAt: &response.RevokedAt}, nil
+	default:
+		return dto.RevocationStatus{}, ErrOCSPUnknown
+	}
+}
+
+// crl looks for cert in the CRL of a distribution point, which must be signed by issuer and up to date.
+func (r *RevocationChecker) crl(ctx context.Context, point string, cert, issuer *x509.Certificate) (dto.RevocationStatus, error) {
+	now := r.now()
+
+	r.mu.Lock()
+	cached, ok := r.crls[point]
+	r.mu.Unlock()
+
+	list := cached.crl
+
+	if !ok || !now.Before(cached.expires) {
+		body, err := r.fetch(ctx, http.MethodGet, point, "", nil)
+		if err != nil {
+			return dto.RevocationStatus{}, err
+		}
+
+		if block, _ := pem.Decode(body); block != nil {
+			body = block.Bytes
+		}
+
+		list, err = x509.ParseRevocationList(body)
+		if err != nil {
+			return dto.RevocationStatus{}, err
+		}
+
+		if err := list.CheckSignatureFrom(issuer); err != nil {
+			return dto.RevocationStatus{}, err
+		}
+
+		expires := now.Add(r.cacheTTL)
+		if !list.NextUpdate.IsZero() && list.NextUpdate.Before(expires) {
+			expires = list.NextUpdate
+		}
+
+		r.mu.Lock()
+		r.crls[point] = cachedCRL{crl: list, expires: expires}
+		r.mu.Unlock()
+	}
+
+	if !list.NextUpdate.IsZero() && list.NextUpdate.Before(now) {
+		return dto.RevocationStatus{}, ErrCRLOutdated
+	}
+
+	for _, entry := range list.RevokedCertificateEntries {
+		if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return dto.RevocationStatus{Status: dto.RevocationRevoked, Source: "crl", RevokedAt: &entry.RevocationTime}, nil
+		}
+	}
+
+	return dto.RevocationStatus{Status: dto.RevocationGood, Source: "crl"}, nil
+}
+
+// fetch sends a request to a revocation endpoint and returns the body of its answer.
+func (r *RevocationChecker) fetch(ctx context.Context, method, url, contentType string, body []byte) ([]byte, error) {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return nil, fmt.Errorf("%w: unsupported URL scheme", ErrRevocationResponse)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s", ErrRevocationResponse, resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxRevocationResponse))
+}
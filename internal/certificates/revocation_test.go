@@ -0,0 +1,236 @@
+package certificates
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// revocationAuthority is a CA answering OCSP requests and serving its CRL and certificate over HTTP.
+type revocationAuthority struct {
+	cert       *x509.Certificate
+	key        crypto.Signer
+	server     *httptest.Server
+	ocspStatus int // the status answered, -1 fails the request
+	revoked    []x509.RevocationListEntry
+	requests   atomic.Int32
+}
+
+func newRevocationAuthority(t *testing.T) *revocationAuthority {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	ca := &revocationAuthority{cert: cert, key: key, ocspStatus: ocsp.Good}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ca.crt", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(ca.cert.Raw)
+	})
+	mux.HandleFunc("/ocsp", func(w http.ResponseWriter, r *http.Request) {
+		ca.requests.Add(1)
+
+		if ca.ocspStatus < 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		request, err := ocsp.ParseRequest(body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		response, _ := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+			Status:       ca.ocspStatus,
+			SerialNumber: request.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now().Add(-time.Minute).Truncate(time.Second),
+		}, ca.key)
+		_, _ = w.Write(response)
+	})
+	mux.HandleFunc("/ca.crl", func(w http.ResponseWriter, _ *http.Request) {
+		ca.requests.Add(1)
+
+		crl, _ := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+			Number:                    big.NewInt(1),
+			ThisUpdate:                time.Now().Add(-time.Minute),
+			NextUpdate:                time.Now().Add(time.Hour),
+			RevokedCertificateEntries: ca.revoked,
+		}, ca.cert, ca.key)
+		_, _ = w.Write(crl)
+	})
+
+	ca.server = httptest.NewServer(mux)
+	t.Cleanup(ca.server.Close)
+
+	return ca
+}
+
+// issue returns a certificate of the CA naming its OCSP responder, CRL and certificate as asked.
+func (ca *revocationAuthority) issue(t *testing.T, serial int64, withOCSP, withCRL, withIssuerURL bool) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "provisioning.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	if withOCSP {
+		template.OCSPServer = []string{ca.server.URL + "/ocsp"}
+	}
+
+	if withCRL {
+		template.CRLDistributionPoints = []string{ca.server.URL + "/ca.crl"}
+	}
+
+	if withIssuerURL {
+		template.IssuingCertificateURL = []string{ca.server.URL + "/ca.crt"}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func TestRevocationCheckerOCSP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		want   string
+	}{
+		{name: "good", status: ocsp.Good, want: dto.RevocationGood},
+		{name: "revoked", status: ocsp.Revoked, want: dto.RevocationRevoked},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ca := newRevocationAuthority(t)
+			ca.ocspStatus = tc.status
+			cert := ca.issue(t, 2, true, false, false)
+
+			status := NewRevocationChecker(time.Second, time.Hour).Check(context.Background(), cert, []*x509.Certificate{ca.cert})
+			require.Equal(t, tc.want, status.Status)
+			require.Equal(t, "ocsp", status.Source)
+			require.Equal(t, tc.status == ocsp.Revoked, status.RevokedAt != nil)
+		})
+	}
+}
+
+func TestRevocationCheckerCRL(t *testing.T) {
+	t.Parallel()
+
+	ca := newRevocationAuthority(t)
+	ca.ocspStatus = -1
+	ca.revoked = []x509.RevocationListEntry{{SerialNumber: big.NewInt(3), RevocationTime: time.Now().Add(-time.Minute)}}
+
+	checker := NewRevocationChecker(time.Second, time.Hour)
+
+	// the responder fails, the CRL is used instead
+	status := checker.Check(context.Background(), ca.issue(t, 3, true, true, false), []*x509.Certificate{ca.cert})
+	require.Equal(t, dto.RevocationRevoked, status.Status)
+	require.Equal(t, "crl", status.Source)
+
+	// the CRL is kept for the next certificates of the CA
+	status = checker.Check(context.Background(), ca.issue(t, 4, false, true, false), []*x509.Certificate{ca.cert})
+	require.Equal(t, dto.RevocationGood, status.Status)
+	require.Equal(t, int32(2), ca.requests.Load())
+}
+
+func TestRevocationCheckerIssuer(t *testing.T) {
+	t.Parallel()
+
+	ca := newRevocationAuthority(t)
+
+	checker := NewRevocationChecker(time.Second, time.Hour)
+
+	status := checker.Check(context.Background(), ca.issue(t, 5, true, false, false), nil)
+	require.Equal(t, dto.RevocationUnknown, status.Status)
+	require.Equal(t, ErrIssuerNotFound.Error(), status.Reason)
+
+	// the issuer is downloaded from the authority information access
+	status = checker.Check(context.Background(), ca.issue(t, 6, true, false, true), nil)
+	require.Equal(t, dto.RevocationGood, status.Status)
+}
+
+func TestRevocationCheckerCache(t *testing.T) {
+	t.Parallel()
+
+	ca := newRevocationAuthority(t)
+	cert := ca.issue(t, 7, true, false, false)
+
+	checker := NewRevocationChecker(time.Second, time.Hour)
+
+	now := time.Now()
+	checker.now = func() time.Time { return now }
+
+	first := checker.Check(context.Background(), cert, []*x509.Certificate{ca.cert})
+	require.Equal(t, first, checker.Check(context.Background(), cert, []*x509.Certificate{ca.cert}))
+	require.Equal(t, int32(1), ca.requests.Load())
+
+	// the status is asked again once the cache TTL is over
+	now = now.Add(2 * time.Hour)
+
+	checker.Check(context.Background(), cert, []*x509.Certificate{ca.cert})
+	require.Equal(t, int32(2), ca.requests.Load())
+}
+
+func TestRevocationCheckerNoEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ca := newRevocationAuthority(t)
+
+	status := NewRevocationChecker(time.Second, time.Hour).Check(context.Background(), ca.cert, nil)
+	require.Equal(t, dto.RevocationStatus{Status: dto.RevocationUnknown, Reason: "no OCSP or CRL endpoint", CheckedAt: status.CheckedAt}, status)
+}
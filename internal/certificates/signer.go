@@ -0,0 +1,105 @@
+package certificates
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+)
+
+// ErrKeyMismatch is returned when a certificate was not issued for the key it is loaded with.
+var ErrKeyMismatch = errors.New("certificate does not match the key")
+
+// PKCS11Config names the key of a PKCS#11 token the root CA signs with.
+type PKCS11Config struct {
+	Module     string // path of the PKCS#11 library of the token
+	TokenLabel string
+	PIN        string
+	KeyLabel   string // label of the private and public key objects
+}
+
+// publicKey is implemented by the public keys of the standard library.
+type publicKey interface {
+	Equal(x crypto.PublicKey) bool
+}
+
+// LoadCertificateWithKey loads a certificate saved as name in the store, falling back to the file at certPath, whose
+// private key is key. It is used for the keys kept on a token or in a KMS, that the console only signs with.
+func LoadCertificateWithKey(store security.Storager, name, certPath string, key crypto.Signer) (*x509.Certificate, error) {
+	var certPEM []byte
+
+	if objStore, ok := store.(ObjectStorager); ok {
+		if data, err := objStore.GetObject("certs/" + name); err == nil {
+			certPEM = []byte(data["cert"])
+		}
+	}
+
+	if len(certPEM) == 0 {
+		var err error
+
+		certPEM, err = os.ReadFile(certPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read certificate file: %w", err)
+		}
+	}
+
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, ErrDecodeCertificatePEM
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	pub, ok := cert.PublicKey.(publicKey)
+	if !ok || !pub.Equal(key.Public()) {
+		return nil, ErrKeyMismatch
+	}
+
+	return cert, nil
+}
+
+// LoadOrGenerateRootCertificateWithKey loads the root certificate of key from the store or the local files, or
+// issues a new one when there is none yet or it was issued for another key. Only the certificate is saved, key
+// stays where it is kept.
+func LoadOrGenerateRootCertificateWithKey(store security.Storager, key crypto.Signer, addThumbPrintToName bool, commonName, country, organization string) (*x509.Certificate, error) {
+	const certName = "root"
+
+	cert, err := LoadCertificateWithKey(store, certName, RootCertPath, key)
+	if err == nil {
+		log.Println("Root certificate of the external key loaded")
+
+		return cert, nil
+	}
+
+	log.Printf("Root certificate of the external key not found: %v. Generating a new one...", err)
+
+	cert, err = NewRootCertificateWithKey(key, addThumbPrintToName, commonName, country, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SaveCertificateToFiles(cert, key, RootCertPath, RootKeyPath); err != nil {
+		return nil, err
+	}
+
+	// a key of the root left by the file backend must not outlive its certificate
+	if err := os.Remove(RootKeyPath); err != nil && !os.IsNotExist(err) {
+		log.Printf("Warning: Failed to remove the previous root key: %v", err)
+	}
+
+	if store != nil {
+		if storeErr := SaveCertificateToStore(store, certName, cert, key); storeErr != nil {
+			log.Printf("Warning: Failed to store root certificate in Vault: %v", storeErr)
+		}
+	}
+
+	return cert, nil
+}
@@ -0,0 +1,56 @@
+package certificates
+
+import (
+	"crypto"
+	"encoding/pem"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// externalKey hides the private key it signs with, as the signers of a token or a KMS do.
+type externalKey struct {
+	crypto.Signer
+}
+
+func TestLoadCertificateWithKey(t *testing.T) {
+	t.Parallel()
+
+	key, err := GenerateKey(KeyRSA2048)
+	require.NoError(t, err)
+
+	rootKey := externalKey{key}
+
+	cert, err := NewRootCertificateWithKey(rootKey, false, "console", "US", "device-management-toolkit")
+	require.NoError(t, err)
+
+	// the key is not stored with the certificate
+	store := new(MockObjectStorager)
+	store.On("SetObject", "certs/root", mock.MatchedBy(func(data map[string]string) bool {
+		_, hasKey := data["key"]
+
+		return data["cert"] != "" && !hasKey
+	})).Return(nil)
+	require.NoError(t, SaveCertificateToStore(store, "root", cert, rootKey))
+	store.AssertExpectations(t)
+
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+	store = new(MockObjectStorager)
+	store.On("GetObject", "certs/root").Return(map[string]string{"cert": certPEM}, nil)
+
+	loaded, err := LoadCertificateWithKey(store, "root", filepath.Join(t.TempDir(), "root_cert.pem"), rootKey)
+	require.NoError(t, err)
+	require.Equal(t, cert.Raw, loaded.Raw)
+
+	other, err := GenerateKey(KeyRSA2048)
+	require.NoError(t, err)
+
+	_, err = LoadCertificateWithKey(store, "root", filepath.Join(t.TempDir(), "root_cert.pem"), externalKey{other})
+	require.ErrorIs(t, err, ErrKeyMismatch)
+
+	// the file is read when the store does not hold the certificate
+	_, err = LoadCertificateWithKey(nil, "root", filepath.Join(t.TempDir(), "root_cert.pem"), rootKey)
+	require.Error(t, err)
+}
@@ -0,0 +1,248 @@
+package httpapi
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	gzipWriters  = sync.Pool{New: func() any { return gzip.NewWriter(io.Discard) }}
+	flateWriters = sync.Pool{New: func() any {
+		w, _ := flate.NewWriter(io.Discard, flate.DefaultCompression) //nolint:errcheck // the level is valid
+
+		return w
+	}}
+)
+
+// compressibleTypes are the media types worth compressing, next to text/*. Images, archives,
+// boot images and other binary responses are sent as they are.
+var compressibleTypes = map[string]bool{
+	"application/json":         true,
+	"application/problem+json": true,
+	"application/xml":          true,
+	"application/javascript":   true,
+	"application/x-ndjson":     true,
+	"image/svg+xml":            true,
+}
+
+// CompressionMiddleware compresses the responses of minSize bytes and more with gzip or deflate,
+// as negotiated with the client through Accept-Encoding. The first minSize bytes of a response
+// are held back to decide, a response flushed before is compressed whatever its size. WebSocket
+// upgrades, HEAD requests and partial content pass through untouched.
+func CompressionMiddleware(minSize int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" || c.Request.Method == http.MethodHead || c.GetHeader("Upgrade") != "" {
+			c.Next()
+
+			return
+		}
+
+		// caches must keep the variants apart, compressed or not
+		c.Header("Vary", "Accept-Encoding")
+
+		cw := &compressWriter{ResponseWriter: c.Writer, encoding: encoding, minSize: minSize}
+		c.Writer = cw
+
+		defer func() {
+			cw.close()
+			c.Writer = cw.ResponseWriter
+		}()
+
+		c.Next()
+	}
+}
+
+// negotiateEncoding picks gzip or deflate from an Accept-Encoding header, the one with the highest
+// quality and gzip on a tie. It returns "" when the client accepts neither.
+func negotiateEncoding(header string) string {
+	best, bestQuality := "", 0.0
+
+	for _, item := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(item), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		quality := 1.0
+
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+
+			quality = parsed
+		}
+
+		if name == "*" {
+			name = "gzip"
+		}
+
+		if (name != "gzip" && name != "deflate") || quality <= 0 {
+			continue
+		}
+
+		if quality > bestQuality || (quality == bestQuality && name == "gzip") {
+			best, bestQuality = name, quality
+		}
+	}
+
+	return best
+}
+
+// compressWriter buffers the start of a response until it knows whether to compress it.
+type compressWriter struct {
+	gin.ResponseWriter
+
+	encoding string
+	minSize  int
+
+	buf        []byte
+	decided    bool
+	compressor io.WriteCloser
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if w.decided {
+		return w.write(data)
+	}
+
+	w.buf = append(w.buf, data...)
+	if len(w.buf) < w.minSize {
+		return len(data), nil
+	}
+
+	if err := w.decide(true); err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow keeps the headers open until the response is either compressed or not.
+func (w *compressWriter) WriteHeaderNow() {
+	if w.decided {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+// Written reports a response as written once its handler started it, even when it is still buffered.
+func (w *compressWriter) Written() bool {
+	return len(w.buf) > 0 || w.ResponseWriter.Written()
+}
+
+// Flush sends what was written so far, the streamed responses are compressed whatever their size.
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		if err := w.decide(true); err != nil {
+			return
+		}
+	}
+
+	if f, ok := w.compressor.(interface{ Flush() error }); ok {
+		_ = f.Flush() //nolint:errcheck // the connection failing is reported by the next write
+	}
+
+	w.ResponseWriter.Flush()
+}
+
+func (w *compressWriter) write(data []byte) (int, error) {
+	if w.compressor != nil {
+		return w.compressor.Write(data)
+	}
+
+	return w.ResponseWriter.Write(data)
+}
+
+// decide compresses the response from now on if compress holds and the response qualifies, then
+// writes out the buffered start of the response.
+func (w *compressWriter) decide(compress bool) error {
+	w.decided = true
+
+	if compress && w.compressible() {
+		header := w.Header()
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+
+		if w.encoding == "gzip" {
+			gz, _ := gzipWriters.Get().(*gzip.Writer)
+			gz.Reset(w.ResponseWriter)
+			w.compressor = gz
+		} else {
+			fw, _ := flateWriters.Get().(*flate.Writer)
+			fw.Reset(w.ResponseWriter)
+			w.compressor = fw
+		}
+	}
+
+	buf := w.buf
+	w.buf = nil
+
+	if len(buf) == 0 {
+		return nil
+	}
+
+	_, err := w.write(buf)
+
+	return err
+}
+
+func (w *compressWriter) compressible() bool {
+	status := w.Status()
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusPartialContent ||
+		status == http.StatusNotModified {
+		return false
+	}
+
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" || header.Get("Content-Range") != "" {
+		return false
+	}
+
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(w.buf)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(mediaType, "text/") || compressibleTypes[mediaType]
+}
+
+// close writes out a response smaller than minSize uncompressed and ends the compressed ones.
+func (w *compressWriter) close() {
+	if !w.decided {
+		if len(w.buf) == 0 {
+			w.decided = true
+
+			return
+		}
+
+		if err := w.decide(false); err != nil {
+			return
+		}
+	}
+
+	switch compressor := w.compressor.(type) {
+	case *gzip.Writer:
+		_ = compressor.Close() //nolint:errcheck // the client went away
+		gzipWriters.Put(compressor)
+	case *flate.Writer:
+		_ = compressor.Close() //nolint:errcheck // the client went away
+		flateWriters.Put(compressor)
+	}
+}
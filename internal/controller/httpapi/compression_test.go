@@ -0,0 +1,103 @@
+package httpapi
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"":                            "",
+		"identity":                    "",
+		"gzip":                        "gzip",
+		"deflate, gzip":               "gzip",
+		"gzip;q=0.5, deflate":         "deflate",
+		"GZIP;q=0, deflate;q=0.1":     "deflate",
+		"*":                           "gzip",
+		"br, gzip;q=0.8, deflate;q=x": "gzip",
+		"gzip;q=0":                    "",
+	}
+
+	for header, expected := range tests {
+		assert.Equal(t, expected, negotiateEncoding(header), header)
+	}
+}
+
+func TestCompressionMiddleware(t *testing.T) {
+	t.Parallel()
+
+	large := `{"data":"` + strings.Repeat("a", 2048) + `"}`
+
+	engine := gin.New()
+	engine.Use(CompressionMiddleware(1024))
+	engine.GET("/large", func(c *gin.Context) { c.String(http.StatusOK, large) })
+	engine.GET("/json", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"data": strings.Repeat("a", 2048)}) })
+	engine.GET("/small", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"data": "a"}) })
+	engine.GET("/binary", func(c *gin.Context) { c.Data(http.StatusOK, "application/octet-stream", []byte(large)) })
+	engine.GET("/empty", func(c *gin.Context) { c.AbortWithStatus(http.StatusUnauthorized) })
+	engine.GET("/stream", func(c *gin.Context) {
+		c.Header("Content-Type", "text/event-stream")
+		c.SSEvent("message", "hello")
+		c.Writer.Flush()
+	})
+
+	tests := []struct {
+		name           string
+		url            string
+		acceptEncoding string
+		encoding       string
+		code           int
+		body           string
+	}{
+		{name: "gzip", url: "/large", acceptEncoding: "gzip, deflate", encoding: "gzip", code: http.StatusOK, body: large},
+		{name: "deflate", url: "/large", acceptEncoding: "deflate", encoding: "deflate", code: http.StatusOK, body: large},
+		{name: "json", url: "/json", acceptEncoding: "gzip", encoding: "gzip", code: http.StatusOK, body: `{"data":"aaa`},
+		{name: "not accepted", url: "/large", acceptEncoding: "br", code: http.StatusOK, body: large},
+		{name: "below the minimum size", url: "/small", acceptEncoding: "gzip", code: http.StatusOK, body: `{"data":"a"}`},
+		{name: "binary", url: "/binary", acceptEncoding: "gzip", code: http.StatusOK, body: large},
+		{name: "no body", url: "/empty", acceptEncoding: "gzip", code: http.StatusUnauthorized},
+		{name: "flushed stream", url: "/stream", acceptEncoding: "gzip", encoding: "gzip", code: http.StatusOK, body: "data:hello"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tc.url, http.NoBody)
+			req.Header.Set("Accept-Encoding", tc.acceptEncoding)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.encoding, w.Header().Get("Content-Encoding"))
+
+			var body io.Reader = w.Body
+
+			switch tc.encoding {
+			case "gzip":
+				gz, err := gzip.NewReader(w.Body)
+				require.NoError(t, err)
+
+				body = gz
+			case "deflate":
+				body = flate.NewReader(w.Body)
+			}
+
+			data, err := io.ReadAll(body)
+			require.NoError(t, err)
+			require.Contains(t, string(data), tc.body)
+		})
+	}
+}
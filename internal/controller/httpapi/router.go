@@ -10,18 +10,33 @@ import (
 	"github.com/device-management-toolkit/console/config"
 	v1 "github.com/device-management-toolkit/console/internal/controller/httpapi/v1"
 	v2 "github.com/device-management-toolkit/console/internal/controller/httpapi/v2"
+	"github.com/device-management-toolkit/console/internal/controller/httpapi/validators"
 	openapi "github.com/device-management-toolkit/console/internal/controller/openapi"
 	"github.com/device-management-toolkit/console/internal/usecase"
 	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+	"github.com/device-management-toolkit/console/pkg/kerberos"
 	"github.com/device-management-toolkit/console/pkg/logger"
+	"github.com/device-management-toolkit/console/pkg/openapivalidate"
 	redfish "github.com/device-management-toolkit/console/redfish"
 )
 
-// NewRouter sets up the HTTP router with redfish support.
-func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.Usecases, cfg *config.Config, database *db.SQL) {
+// NewRouter sets up the HTTP router with redfish support. It returns the middlewares authenticating the users of
+// the API and holding them to their role, for the routes served outside of it.
+func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.Usecases, cfg *config.Config, database *db.SQL, readOnly *v1.ReadOnlyMode) []gin.HandlerFunc {
 	// Options
 	handler.Use(gin.Logger())
 	handler.Use(gin.Recovery())
+	handler.Use(i18n.Middleware())
+
+	if cfg.Compression.Enabled {
+		handler.Use(CompressionMiddleware(cfg.Compression.MinSize))
+	}
+
+	// Custom binding tags must be known before any request is bound
+	if err := validators.RegisterBinding(); err != nil {
+		l.Error(err, "failed to register custom validators")
+	}
 
 	// Initialize redfish directly
 	if err := redfish.Initialize(handler, l, database, &t, cfg); err != nil {
@@ -34,9 +49,23 @@ func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.Usecases, cfg
 	fuegoAdapter.AddToGinRouter(handler)
 
 	// Public routes
-	login := v1.NewLoginRoute(cfg)
+	login := v1.NewLoginRoute(cfg, t.Events)
 	handler.POST("/api/v1/authorize", login.Login)
 
+	if cfg.Kerberos.Keytab != "" {
+		kt, err := kerberos.LoadKeytab(cfg.Kerberos.Keytab)
+		if err != nil {
+			l.Error(err, "kerberos authentication is disabled")
+		} else {
+			login.Kerberos = kerberos.NewAcceptor(kt, cfg.Kerberos.ServicePrincipal)
+			login.KerberosRealm = cfg.Kerberos.Realm
+			login.Users = t.LDAPSync
+			handler.GET("/api/v1/authorize/kerberos", login.KerberosLogin)
+		}
+	}
+
+	handler.GET("/api/v1/messages", v1.Messages)
+
 	// Setup UI routes (no-op in noui builds)
 	setupUIRoutes(handler, l, cfg)
 
@@ -58,30 +87,156 @@ func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.Usecases, cfg
 		protected = handler.Group("/api", login.JWTAuthMiddleware())
 	}
 
+	// Each tenant and each access token has its share of the requests
+	rateLimit := v1.RateLimitMiddleware(cfg.RateLimits)
+	protected.Use(rateLimit)
+
+	// The users with a console account are held to the rights of their role
+	roles := v1.RoleMiddleware(cfg.AdminUsername, t.LDAPSync)
+	protected.Use(roles)
+
+	// Maintenance windows switch the API to read-only, the reads are still served
+	protected.Use(v1.ReadOnlyMiddleware(readOnly))
+
+	// A request handled for too long is cancelled rather than holding on to its device and database calls
+	protected.Use(TimeoutMiddleware(cfg.Timeouts))
+
 	// Routers
-	h2 := protected.Group("/v1")
+	// v1 is superseded by v2; every v1 response advertises its successor
+	deprecated := v1.DeprecationMiddleware("/api/v2")
+
+	// Each group caps the size of its request bodies, the uploads have a limit of their own
+	apiLimit := v1.BodyLimitMiddleware(cfg.BodyLimits.API)
+
+	// JSON bodies not matching the OpenAPI document are refused with the fields in error
+	validate := v1.RequestValidationMiddleware(requestValidator(fuegoAdapter, cfg, l))
+
+	// Devices are addressed by their GUID in canonical form, whatever form the client sends it in
+	guidParam := v1.GUIDParamMiddleware()
+
+	// Retried POSTs carrying an Idempotency-Key get the response of the first request
+	idempotent := v1.IdempotencyMiddleware(v1.IdempotencyTTL)
+
+	h2 := protected.Group("/v1", deprecated, apiLimit, guidParam, validate, idempotent)
 	{
-		v1.NewDeviceRoutes(h2, t.Devices, l)
+		v1.NewDeviceRoutes(h2, t.Devices, t.Correlations, l)
 		v1.NewAmtRoutes(h2, t.Devices, t.AMTExplorer, t.Exporter, l)
-		v1.NewCIRACertRoutes(h2, l)
+		v1.NewPowerHistoryRoutes(h2, t.PowerHistory, l)
+		v1.NewAddressHistoryRoutes(h2, t.AddressHistory, l)
+		v1.NewCredentialCheckoutRoutes(h2, t.Checkouts, l)
+		v1.NewJobRoutes(h2, t.Jobs, l)
+		v1.NewCIRACertRoutes(h2, t.RootCA, l)
+		v1.NewRedirectionRoutes(h2, t.Devices, t.Tickets, l)
+		v1.NewInventoryRoutes(h2, t.Inventory, l)
 	}
 
-	h := protected.Group("/v1/admin")
+	h := protected.Group("/v1/admin", deprecated, v1.BodyLimitMiddleware(cfg.BodyLimits.Admin), validate, idempotent)
 	{
 		v1.NewDomainRoutes(h, t.Domains, l)
 		v1.NewCIRAConfigRoutes(h, t.CIRAConfigs, l)
 		v1.NewProfileRoutes(h, t.Profiles, l)
 		v1.NewWirelessConfigRoutes(h, t.WirelessProfiles, l)
 		v1.NewIEEE8021xConfigRoutes(h, t.IEEE8021xProfiles, l)
+		v1.NewEnergyPolicyRoutes(h, t.EnergyPolicies, l)
+		v1.NewSiteRoutes(h, t.Sites, l)
+		v1.NewUserRoutes(h, t.LDAPSync, l)
+		v1.NewEnrollmentTokenRoutes(h, t.Enrollment, l)
+
+		if t.TenantKeys != nil {
+			v1.NewTenantKeyRoutes(h, t.TenantKeys, l)
+		}
+
+		if t.RootCA != nil {
+			v1.NewRootCARoutes(h, t.RootCA, l)
+		}
+
+		v1.NewLoggingRoutes(h, l)
+		v1.NewReadOnlyRoutes(h, readOnly, l)
+
+		if cfg.Pprof {
+			if cfg.Disabled {
+				l.Warn("pprof endpoints are enabled without authentication")
+			}
+
+			v1.NewPprofRoutes(h, l)
+		}
+	}
+
+	// Provisioning agents enroll the devices with the single-use tokens minted by the admins, not with access tokens
+	enroll := handler.Group("/api/v1", v1.ReadOnlyMiddleware(readOnly), deprecated, apiLimit, validate)
+	v1.NewEnrollRoutes(enroll, t.Enrollment, l)
+
+	// Monitoring tools run the status checks of the devices with the API key of the checks when one is configured
+	if key := cfg.Checks.APIKey; key != "" {
+		checks := handler.Group("/api/v1", v1.APIKeyMiddleware(key), rateLimit, TimeoutMiddleware(cfg.Timeouts), deprecated, guidParam)
+		v1.NewCheckRoutes(checks, t.Checks, l)
+	} else {
+		checks := protected.Group("/v1", deprecated, guidParam)
+		v1.NewCheckRoutes(checks, t.Checks, l)
 	}
 
-	h3 := protected.Group("/v2")
+	if t.Images != nil {
+		uploads := protected.Group("/v1/admin", deprecated, v1.BodyLimitMiddleware(cfg.BodyLimits.Upload))
+		v1.NewImageRoutes(uploads, t.Images, l)
+	}
+
+	h3 := protected.Group("/v2", apiLimit, guidParam, validate, idempotent)
 	{
 		v2.NewAmtRoutes(h3, t.Devices, l)
 	}
 
+	// The scripts and the Sample Web UI written for MPS log in and call the devices at the paths of MPS
+	if cfg.MPSCompat.Enabled {
+		handler.POST(cfg.MPSCompat.Prefix+"/login/api/v1/authorize", login.Login)
+
+		mps := handler.Group(cfg.MPSCompat.Prefix + "/api/v1")
+		if !cfg.Disabled {
+			mps.Use(login.JWTAuthMiddleware())
+		}
+
+		mps.Use(rateLimit, roles, v1.ReadOnlyMiddleware(readOnly), TimeoutMiddleware(cfg.Timeouts), apiLimit, guidParam)
+		v1.NewMPSRoutes(mps, t.Devices, l)
+	}
+
 	// Register redfish routes directly
-	if err := redfish.RegisterRoutes(handler, l); err != nil {
+	if err := redfish.RegisterRoutes(handler, l, readOnly.State); err != nil {
 		l.Fatal("Failed to register redfish routes: " + err.Error())
 	}
+
+	// The activation WebSocket is authenticated and held to the roles as the API is
+	authenticated := []gin.HandlerFunc{rateLimit, roles}
+	if !cfg.Disabled {
+		authenticated = append([]gin.HandlerFunc{login.JWTAuthMiddleware()}, authenticated...)
+	}
+
+	return authenticated
+}
+
+// requestValidator returns the validator of the request bodies against the OpenAPI document of the adapter,
+// nil when request validation is disabled or the document cannot be loaded.
+func requestValidator(adapter *openapi.FuegoAdapter, cfg *config.Config, l logger.Interface) *openapivalidate.Validator {
+	if !cfg.RequestValidation {
+		return nil
+	}
+
+	spec, err := adapter.GetOpenAPISpec()
+	if err != nil {
+		l.Error(err, "request validation is disabled")
+
+		return nil
+	}
+
+	// the document describes the devices routes under /api/v1/admin, they are served outside of it
+	validator, err := openapivalidate.Load(spec,
+		openapivalidate.ServedAt("/api/v1/admin/devices", "/api/v1/devices"),
+		openapivalidate.ServedAt("/api/v1/admin/amt", "/api/v1/amt"),
+		openapivalidate.ServedAt("/api/v1/admin/kvm", "/api/v1/amt/kvm"),
+	)
+	if err != nil {
+		l.Error(err, "request validation is disabled")
+
+		return nil
+	}
+
+	return validator
 }
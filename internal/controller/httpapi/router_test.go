@@ -0,0 +1,44 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/config"
+	v1 "github.com/device-management-toolkit/console/internal/controller/httpapi/v1"
+	openapi "github.com/device-management-toolkit/console/internal/controller/openapi"
+	"github.com/device-management-toolkit/console/internal/usecase"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestRequestValidatorDeviceRoutes(t *testing.T) {
+	t.Parallel()
+
+	adapter := openapi.NewFuegoAdapter(usecase.Usecases{}, logger.New("error"))
+	adapter.RegisterRoutes()
+
+	cfg := &config.Config{}
+	cfg.RequestValidation = true
+
+	validator := requestValidator(adapter, cfg, logger.New("error"))
+	require.NotNil(t, validator)
+
+	// the devices are documented under /api/v1/admin and served outside of it
+	engine := gin.New()
+	engine.POST("/api/v1/devices", v1.RequestValidationMiddleware(validator), func(c *gin.Context) { c.Status(http.StatusCreated) })
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/devices", strings.NewReader(`{"guid":1,"hostname":true}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), `"field":"hostname"`)
+}
@@ -0,0 +1,136 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	v2 "github.com/device-management-toolkit/console/internal/controller/httpapi/v2"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+)
+
+// TimeoutMiddleware cancels the context of a request once it is handled for longer than the timeout of its
+// route, so that the device and database calls made for it give up. What the handler writes afterwards is
+// discarded and the request gets 504 Gateway Timeout with an RFC 7807 problem instead, wrapped in the
+// envelope under /api/v2. WebSocket upgrades and the streaming routes are not timed.
+func TimeoutMiddleware(timeouts config.Timeouts) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout := routeTimeout(timeouts, c.Request.Method, c.FullPath())
+		if timeout <= 0 || c.GetHeader("Upgrade") != "" {
+			c.Next()
+
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+
+		tw := &timeoutWriter{ResponseWriter: c.Writer, ctx: ctx}
+		c.Writer = tw
+
+		c.Next()
+
+		c.Writer = tw.ResponseWriter
+
+		if tw.timedOut || (errors.Is(ctx.Err(), context.DeadlineExceeded) && !tw.Written()) {
+			requestTimedOut(c)
+		}
+	}
+}
+
+// streamingRoutes stream their response for as long as the job or the export lasts and push the write
+// deadline forward themselves, they are never timed whatever the configuration.
+var streamingRoutes = map[string]bool{
+	"GET /api/v1/jobs/:id/events":            true,
+	"GET /api/v1/amt/log/audit/:guid/export": true,
+}
+
+// routeTimeout returns the timeout configured for the route, the default one unless it has its own.
+func routeTimeout(timeouts config.Timeouts, method, path string) time.Duration {
+	if streamingRoutes[method+" "+path] {
+		return 0
+	}
+
+	if timeout, ok := timeouts.Routes[method+" "+path]; ok {
+		return timeout
+	}
+
+	return timeouts.Request
+}
+
+func requestTimedOut(c *gin.Context) {
+	msg := i18n.T(i18n.Language(c), "error.requestTimeout")
+
+	if strings.HasPrefix(c.Request.URL.Path, "/api/v2/") {
+		v2.Fail(c, http.StatusGatewayTimeout, consoleerrors.CodeRequestTimeout, msg)
+
+		return
+	}
+
+	c.Header("Content-Type", "application/problem+json")
+	c.AbortWithStatusJSON(http.StatusGatewayTimeout, v2.Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(http.StatusGatewayTimeout),
+		Status:   http.StatusGatewayTimeout,
+		Detail:   msg,
+		Instance: c.Request.URL.Path,
+		Code:     consoleerrors.CodeRequestTimeout,
+	})
+}
+
+// timeoutWriter discards the response of a handler that did not start it before the deadline.
+type timeoutWriter struct {
+	gin.ResponseWriter
+
+	ctx      context.Context
+	timedOut bool
+}
+
+// late reports whether the response is started after the deadline, it is discarded then.
+func (w *timeoutWriter) late() bool {
+	if !w.timedOut && !w.ResponseWriter.Written() && errors.Is(w.ctx.Err(), context.DeadlineExceeded) {
+		w.timedOut = true
+	}
+
+	return w.timedOut
+}
+
+func (w *timeoutWriter) WriteHeader(code int) {
+	if !w.late() {
+		w.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (w *timeoutWriter) WriteHeaderNow() {
+	if !w.late() {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *timeoutWriter) Write(data []byte) (int, error) {
+	if w.late() {
+		return 0, context.DeadlineExceeded
+	}
+
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *timeoutWriter) WriteString(s string) (int, error) {
+	if w.late() {
+		return 0, context.DeadlineExceeded
+	}
+
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *timeoutWriter) Written() bool {
+	return !w.timedOut && w.ResponseWriter.Written()
+}
@@ -0,0 +1,110 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/config"
+	v2 "github.com/device-management-toolkit/console/internal/controller/httpapi/v2"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+func TestTimeoutMiddleware(t *testing.T) {
+	t.Parallel()
+
+	// hung waits for the request to be cancelled, then answers as a handler seeing the error of a device call would
+	hung := func(c *gin.Context) {
+		<-c.Request.Context().Done()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": c.Request.Context().Err().Error()})
+	}
+
+	engine := gin.New()
+	api := engine.Group("/api", TimeoutMiddleware(config.Timeouts{
+		Request: 20 * time.Millisecond,
+		Routes:  map[string]time.Duration{"GET /api/v1/slow/:guid": time.Hour, "GET /api/v1/export": 0},
+	}))
+	api.GET("/v1/devices", hung)
+	api.GET("/v2/devices", hung)
+	api.GET("/v1/quiet", func(c *gin.Context) { <-c.Request.Context().Done() })
+	api.GET("/v1/fast", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"ok": true}) })
+	api.GET("/v1/slow/:guid", func(c *gin.Context) {
+		time.Sleep(40 * time.Millisecond)
+		c.Status(http.StatusNoContent)
+	})
+	api.GET("/v1/export", func(c *gin.Context) {
+		_, hasDeadline := c.Request.Context().Deadline()
+		c.JSON(http.StatusOK, gin.H{"deadline": hasDeadline})
+	})
+
+	get := func(target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, http.NoBody))
+
+		return w
+	}
+
+	for _, target := range []string{"/api/v1/devices", "/api/v1/quiet"} {
+		w := get(target)
+		require.Equal(t, http.StatusGatewayTimeout, w.Code, target)
+		assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+
+		var problem v2.Problem
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+		assert.Equal(t, http.StatusGatewayTimeout, problem.Status)
+		assert.Equal(t, target, problem.Instance)
+		assert.Equal(t, consoleerrors.CodeRequestTimeout, problem.Code)
+	}
+
+	w := get("/api/v2/devices")
+	require.Equal(t, http.StatusGatewayTimeout, w.Code)
+
+	var envelope v2.Envelope
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &envelope))
+	require.NotNil(t, envelope.Error, "v2 problems are wrapped in the envelope")
+	assert.Equal(t, "/api/v2/devices", envelope.Error.Instance)
+	assert.Equal(t, consoleerrors.CodeRequestTimeout, envelope.Error.Code)
+
+	assert.Equal(t, http.StatusOK, get("/api/v1/fast").Code)
+	assert.Equal(t, http.StatusNoContent, get("/api/v1/slow/guid").Code, "a route overrides the default timeout")
+	assert.JSONEq(t, `{"deadline":false}`, get("/api/v1/export").Body.String(), "a timeout of 0 disables it")
+}
+
+func TestTimeoutMiddlewareStreams(t *testing.T) {
+	t.Parallel()
+
+	engine := gin.New()
+	api := engine.Group("/api", TimeoutMiddleware(config.Timeouts{
+		Request: 20 * time.Millisecond,
+		Routes:  map[string]time.Duration{"GET /api/v1/amt/log/audit/:guid/export": time.Millisecond},
+	}))
+
+	// stream writes an event every 20 ms, the stream lasts three times the timeout
+	stream := func(c *gin.Context) {
+		_, hasDeadline := c.Request.Context().Deadline()
+		assert.False(t, hasDeadline)
+
+		for i := range 3 {
+			time.Sleep(20 * time.Millisecond)
+			c.SSEvent("job", i)
+			c.Writer.Flush()
+		}
+	}
+	api.GET("/v1/jobs/:id/events", stream)
+	api.GET("/v1/amt/log/audit/:guid/export", stream)
+
+	for _, target := range []string{"/api/v1/jobs/job1/events", "/api/v1/amt/log/audit/guid/export"} {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, http.NoBody))
+
+		require.Equal(t, http.StatusOK, w.Code, target)
+		assert.Equal(t, 3, strings.Count(w.Body.String(), "event:job"), "the configuration cannot time a stream")
+	}
+}
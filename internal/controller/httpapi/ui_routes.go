@@ -0,0 +1,207 @@
+package httpapi
+
+import (
+	"bytes"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	protocolHTTP  = "http://"
+	protocolHTTPS = "https://"
+
+	uiIndex  = "index.html"
+	uiMainJS = "main.js"
+
+	// cacheControlAsset applies to images, fonts and translations, which rarely change between releases.
+	cacheControlAsset = "public, max-age=86400"
+	// cacheControlApp makes browsers revalidate the app shell and bundles on every load.
+	cacheControlApp = "no-cache"
+)
+
+// setupUIRoutes serves the web UI. In order of precedence the UI is taken from
+// ui.externalUrl (redirect), ui.path (a directory on disk, e.g. a custom-branded
+// build) or the assets embedded in the binary. Unknown non-API paths fall back
+// to index.html so the single page app can route them.
+func setupUIRoutes(handler *gin.Engine, l logger.Interface, cfg *config.Config) {
+	if cfg.UI.ExternalURL != "" {
+		l.Info("Redirecting UI requests to: " + cfg.UI.ExternalURL)
+
+		handler.NoRoute(func(c *gin.Context) {
+			path := c.Request.URL.Path
+			// Only redirect likely UI paths, not API endpoints
+			if isAPIPath(path) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "Not Found"})
+
+				return
+			}
+
+			c.Redirect(http.StatusMovedPermanently, cfg.UI.ExternalURL+path)
+		})
+
+		return
+	}
+
+	files, err := uiFiles(l, cfg)
+	if err != nil {
+		l.Info("UI disabled: %v", err)
+
+		return
+	}
+
+	ui := newUIServer(l, cfg, files)
+
+	handler.NoRoute(ui.serve)
+}
+
+// uiFiles returns the UI directory configured with ui.path, falling back to the embedded UI.
+func uiFiles(l logger.Interface, cfg *config.Config) (fs.FS, error) {
+	if cfg.UI.Path != "" {
+		files := os.DirFS(cfg.UI.Path)
+		if _, err := fs.Stat(files, uiIndex); err == nil {
+			l.Info("Serving UI from: " + cfg.UI.Path)
+
+			return files, nil
+		}
+
+		l.Warn("UI path %s has no %s, falling back to embedded UI", cfg.UI.Path, uiIndex)
+	}
+
+	return embeddedUI()
+}
+
+// uiServer serves static UI files with cache headers and SPA fallback routing.
+type uiServer struct {
+	files   fs.FS
+	mainJS  []byte
+	started time.Time
+}
+
+func newUIServer(l logger.Interface, cfg *config.Config, files fs.FS) *uiServer {
+	return &uiServer{
+		files:   files,
+		mainJS:  injectConfigToMainJS(l, cfg, files),
+		started: time.Now(),
+	}
+}
+
+func (s *uiServer) serve(c *gin.Context) {
+	if (c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead) || isAPIPath(c.Request.URL.Path) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Not Found"})
+
+		return
+	}
+
+	name := strings.TrimPrefix(path.Clean("/"+c.Request.URL.Path), "/")
+
+	// main.js carries the runtime configuration and is always served from memory
+	if name == uiMainJS && s.mainJS != nil {
+		c.Header("Cache-Control", cacheControlApp)
+		http.ServeContent(c.Writer, c.Request, uiMainJS, s.started, bytes.NewReader(s.mainJS))
+
+		return
+	}
+
+	if info, err := fs.Stat(s.files, name); err == nil && !info.IsDir() {
+		c.Header("Cache-Control", cacheControl(name))
+		http.ServeFileFS(c.Writer, c.Request, s.files, name)
+
+		return
+	}
+
+	// missing files are real 404s, anything else is a client-side route
+	if path.Ext(name) != "" {
+		c.Status(http.StatusNotFound)
+
+		return
+	}
+
+	c.Header("Cache-Control", cacheControlApp)
+	http.ServeFileFS(c.Writer, c.Request, s.files, uiIndex)
+}
+
+// cacheControl returns the Cache-Control header value for a UI file.
+func cacheControl(name string) string {
+	if strings.HasPrefix(name, "assets/") || strings.HasPrefix(name, "media/") || name == "favicon.ico" {
+		return cacheControlAsset
+	}
+
+	return cacheControlApp
+}
+
+// isAPIPath checks if the path is an API endpoint that should not be served by the UI.
+func isAPIPath(path string) bool {
+	apiPrefixes := []string{"/api/", "/redfish/", "/healthz", "/metrics", "/version"}
+	for _, prefix := range apiPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// injectConfigToMainJS returns main.js with the console configuration injected, or nil if the UI has no main.js.
+func injectConfigToMainJS(l logger.Interface, cfg *config.Config, files fs.FS) []byte {
+	data, err := fs.ReadFile(files, uiMainJS)
+	if err != nil {
+		l.Warn("Could not read UI main.js: %v", err)
+
+		return nil
+	}
+
+	protocol := protocolHTTP
+
+	requireHTTPSReplacement := ",requireHttps:!1"
+	if cfg.Auth.UI.RequireHTTPS {
+		requireHTTPSReplacement = ",requireHttps:!0"
+		protocol = protocolHTTPS
+	}
+
+	if cfg.TLS.Enabled {
+		protocol = protocolHTTPS
+	}
+
+	// if there is a clientID, we assume oauth will be configured, so inject UI config values from YAML
+	if cfg.ClientID != "" {
+		strictDiscoveryReplacement := ",strictDiscoveryDocumentValidation:!1"
+		if cfg.Auth.UI.StrictDiscoveryDocumentValidation {
+			strictDiscoveryReplacement = ",strictDiscoveryDocumentValidation:!0"
+		}
+
+		data = injectPlaceholders(data, map[string]string{
+			",useOAuth:!1,":                         ",useOAuth:!0,",
+			",requireHttps:!0":                      requireHTTPSReplacement,
+			",strictDiscoveryDocumentValidation:!0": strictDiscoveryReplacement,
+			"##CLIENTID##":                          cfg.Auth.UI.ClientID,
+			"##ISSUER##":                            cfg.Auth.UI.Issuer,
+			"##SCOPE##":                             cfg.Auth.UI.Scope,
+			"##REDIRECTURI##":                       cfg.Auth.UI.RedirectURI,
+		})
+	}
+
+	data = injectPlaceholders(data, map[string]string{
+		"##CONSOLE_SERVER_API##": protocol + net.JoinHostPort(cfg.Host, cfg.Port),
+	})
+
+	return data
+}
+
+func injectPlaceholders(content []byte, replacements map[string]string) []byte {
+	result := string(content)
+	for placeholder, value := range replacements {
+		result = strings.ReplaceAll(result, placeholder, value)
+	}
+
+	return []byte(result)
+}
@@ -0,0 +1,107 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func newTestUIRouter(cfg *config.Config) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	files := fstest.MapFS{
+		"index.html":      {Data: []byte("<html>index</html>")},
+		"main.js":         {Data: []byte(`api:"##CONSOLE_SERVER_API##"`)},
+		"assets/logo.png": {Data: []byte("png")},
+	}
+
+	engine := gin.New()
+	engine.NoRoute(newUIServer(logger.New("error"), cfg, files).serve)
+
+	return engine
+}
+
+func TestUIServer(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{HTTP: config.HTTP{Host: "console.local", Port: "8181", TLS: config.TLS{Enabled: true}}}
+	engine := newTestUIRouter(cfg)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		expectedCode int
+		expectedBody string
+		cacheControl string
+	}{
+		{name: "root serves index", method: http.MethodGet, path: "/", expectedCode: http.StatusOK, expectedBody: "<html>index</html>", cacheControl: cacheControlApp},
+		{name: "spa route falls back to index", method: http.MethodGet, path: "/devices/abc", expectedCode: http.StatusOK, expectedBody: "<html>index</html>", cacheControl: cacheControlApp},
+		{name: "asset is cached", method: http.MethodGet, path: "/assets/logo.png", expectedCode: http.StatusOK, expectedBody: "png", cacheControl: cacheControlAsset},
+		{name: "main.js has config injected", method: http.MethodGet, path: "/main.js", expectedCode: http.StatusOK, expectedBody: `api:"https://console.local:8181"`, cacheControl: cacheControlApp},
+		{name: "missing file is not found", method: http.MethodGet, path: "/missing.js", expectedCode: http.StatusNotFound},
+		{name: "api path is not found", method: http.MethodGet, path: "/api/v1/nope", expectedCode: http.StatusNotFound, expectedBody: `{"error":"Not Found"}`},
+		{name: "non GET is not found", method: http.MethodPost, path: "/devices", expectedCode: http.StatusNotFound, expectedBody: `{"error":"Not Found"}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.method, tc.path, http.NoBody)
+
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+
+			if tc.expectedBody != "" {
+				assert.Equal(t, tc.expectedBody, w.Body.String())
+			}
+
+			assert.Equal(t, tc.cacheControl, w.Header().Get("Cache-Control"))
+		})
+	}
+}
+
+func TestSetupUIRoutesExternalURL(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	setupUIRoutes(engine, logger.New("error"), &config.Config{UI: config.UI{ExternalURL: "https://ui.example.com"}})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/devices", http.NoBody))
+
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "https://ui.example.com/devices", w.Header().Get("Location"))
+}
+
+func TestSetupUIRoutesPath(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("custom"), 0o600))
+
+	engine := gin.New()
+	setupUIRoutes(engine, logger.New("error"), &config.Config{UI: config.UI{Path: dir}})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/profiles", http.NoBody))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "custom", w.Body.String())
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/usecase/addresshistory"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+type addressHistoryRoutes struct {
+	a addresshistory.Feature
+	l logger.Interface
+}
+
+func NewAddressHistoryRoutes(handler *gin.RouterGroup, a addresshistory.Feature, l logger.Interface) {
+	r := &addressHistoryRoutes{a, l}
+
+	h := handler.Group("/devices")
+	{
+		h.GET(":guid/addresses", r.getAddressHistory)
+	}
+}
+
+func (r *addressHistoryRoutes) getAddressHistory(c *gin.Context) {
+	history, err := r.a.GetHistory(c.Request.Context(), c.Param("guid"))
+	if err != nil {
+		r.l.Error(err, "http - v1 - getAddressHistory")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
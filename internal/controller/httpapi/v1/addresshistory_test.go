@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestAddressHistoryRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		url          string
+		mock         func(m *mocks.MockAddressHistoryFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name: "history",
+			url:  "/api/v1/devices/valid-guid/addresses",
+			mock: func(m *mocks.MockAddressHistoryFeature) {
+				m.EXPECT().GetHistory(context.Background(), "valid-guid").Return([]dto.DeviceAddress{
+					{Hostname: "amt01.example.com", Addresses: []string{"10.0.0.9"}, RecordedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `[{"hostname":"amt01.example.com","addresses":["10.0.0.9"],"recordedAt":"2024-01-01T00:00:00Z"}]`,
+		},
+		{
+			name: "unknown device",
+			url:  "/api/v1/devices/unknown-guid/addresses",
+			mock: func(m *mocks.MockAddressHistoryFeature) {
+				m.EXPECT().GetHistory(context.Background(), "unknown-guid").Return(nil, devices.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: `"code":"DEVICE_NOT_FOUND"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			addressHistory := mocks.NewMockAddressHistoryFeature(mockCtl)
+			tc.mock(addressHistory)
+
+			engine := gin.New()
+			NewAddressHistoryRoutes(engine.Group("/api/v1"), addressHistory, logger.New("error"))
+
+			req, err := http.NewRequest(http.MethodGet, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -9,7 +9,10 @@ import (
 )
 
 func (r *deviceManagementRoutes) getAlarmOccurrences(c *gin.Context) {
-	guid := c.Param("guid")
+	guid, ok := bindAlarmDevice(c)
+	if !ok {
+		return
+	}
 
 	alarms, err := r.d.GetAlarmOccurrences(c.Request.Context(), guid)
 	if err != nil {
@@ -23,7 +26,10 @@ func (r *deviceManagementRoutes) getAlarmOccurrences(c *gin.Context) {
 }
 
 func (r *deviceManagementRoutes) createAlarmOccurrences(c *gin.Context) {
-	guid := c.Param("guid")
+	guid, ok := bindAlarmDevice(c)
+	if !ok {
+		return
+	}
 
 	alarm := &dto.AlarmClockOccurrenceInput{}
 	if err := c.ShouldBindJSON(alarm); err != nil {
@@ -44,7 +50,10 @@ func (r *deviceManagementRoutes) createAlarmOccurrences(c *gin.Context) {
 }
 
 func (r *deviceManagementRoutes) deleteAlarmOccurrences(c *gin.Context) {
-	guid := c.Param("guid")
+	guid, ok := bindAlarmDevice(c)
+	if !ok {
+		return
+	}
 
 	alarm := dto.DeleteAlarmOccurrenceRequest{}
 	if err := c.ShouldBindJSON(&alarm); err != nil {
@@ -63,3 +72,15 @@ func (r *deviceManagementRoutes) deleteAlarmOccurrences(c *gin.Context) {
 
 	c.JSON(http.StatusNoContent, nil)
 }
+
+// bindAlarmDevice returns the validated device GUID of an alarm route, writing a 400 response if it is malformed.
+func bindAlarmDevice(c *gin.Context) (string, bool) {
+	var param dto.AlarmDeviceParam
+	if err := c.ShouldBindUri(&param); err != nil {
+		ErrorResponse(c, err)
+
+		return "", false
+	}
+
+	return param.GUID, true
+}
@@ -1,21 +1,51 @@
 package v1
 
 import (
+	"encoding/json"
+	"errors"
 	"io"
+	"mime"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/export"
 )
 
+// auditLogExportWriteTimeout is the time granted to write each record of an audit log export,
+// the export of a long log takes longer than the write timeout of the server.
+const auditLogExportWriteTimeout = 30 * time.Second
+
+var errAuditLogRange = errors.New("to is before from")
+
+// auditLogQuery pages and filters the audit log, the times are RFC 3339.
+type auditLogQuery struct {
+	Top         int       `form:"$top,default=25" binding:"min=1,max=1000"`
+	Skip        int       `form:"$skip" binding:"min=0"`
+	From        time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To          time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	AuditAppIDs []int     `form:"auditAppId"`
+	EventIDs    []int     `form:"eventId"`
+}
+
+type auditLogExportQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=ndjson csv"`
+}
+
 func (r *deviceManagementRoutes) getAuditLog(c *gin.Context) {
 	guid := c.Param("guid")
 
-	startIndex := c.Query("startIndex")
+	startIndex, ok := c.GetQuery("startIndex")
+	if !ok {
+		r.queryAuditLog(c, guid)
+
+		return
+	}
 
 	startIdx, err := strconv.Atoi(startIndex)
 	if err != nil {
@@ -36,6 +66,41 @@ func (r *deviceManagementRoutes) getAuditLog(c *gin.Context) {
 	c.JSON(http.StatusOK, auditLogs)
 }
 
+// queryAuditLog answers a page of the decoded audit log, newest record first, filtered by time range and event.
+func (r *deviceManagementRoutes) queryAuditLog(c *gin.Context, guid string) {
+	var query auditLogQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		validationErr := ErrValidationProfile.Wrap("queryAuditLog", "ShouldBindQuery", err)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	if !query.From.IsZero() && !query.To.IsZero() && query.To.Before(query.From) {
+		validationErr := ErrValidationProfile.Wrap("queryAuditLog", "ShouldBindQuery", errAuditLogRange)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	page, err := r.d.QueryAuditLog(c.Request.Context(), guid, dto.AuditLogQuery{
+		Skip:        query.Skip,
+		Top:         query.Top,
+		From:        query.From,
+		To:          query.To,
+		AuditAppIDs: query.AuditAppIDs,
+		EventIDs:    query.EventIDs,
+	})
+	if err != nil {
+		r.l.Error(err, "http - v1 - queryAuditLog")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
 func (r *deviceManagementRoutes) downloadAuditLog(c *gin.Context) {
 	guid := c.Param("guid")
 
@@ -81,6 +146,104 @@ func (r *deviceManagementRoutes) downloadAuditLog(c *gin.Context) {
 	}
 }
 
+// exportAuditLog streams the complete decoded audit log of a device as NDJSON or CSV. Errors after
+// the first record was sent cannot change the status anymore, an NDJSON export ends with an error line then.
+func (r *deviceManagementRoutes) exportAuditLog(c *gin.Context) {
+	guid := c.Param("guid")
+
+	var query auditLogExportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		validationErr := ErrValidationProfile.Wrap("exportAuditLog", "ShouldBindQuery", err)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	w := &auditLogExport{c: c, format: query.Format, name: "audit-log-" + guid}
+
+	err := r.d.StreamAuditLog(c.Request.Context(), guid, w.write)
+	if err == nil && !w.started {
+		err = w.start()
+	}
+
+	if err != nil {
+		r.l.Error(err, "http - v1 - exportAuditLog")
+
+		if !w.started {
+			ErrorResponse(c, err)
+
+			return
+		}
+
+		w.fail(err)
+	}
+}
+
+// auditLogExport writes the entries of an audit log export, the response starts with the first entry.
+type auditLogExport struct {
+	c       *gin.Context
+	format  string
+	name    string
+	started bool
+	csv     *export.AuditLogCSVWriter
+}
+
+func (w *auditLogExport) start() error {
+	w.started = true
+
+	contentType, ext := "application/x-ndjson", ".ndjson"
+	if w.format == "csv" {
+		contentType, ext = "text/csv", ".csv"
+	}
+
+	w.c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": w.name + ext}))
+	w.c.Header("Content-Type", contentType)
+	w.c.Status(http.StatusOK)
+
+	if w.format != "csv" {
+		return nil
+	}
+
+	w.csv = export.NewAuditLogCSVWriter(w.c.Writer)
+
+	return w.csv.WriteHeader()
+}
+
+func (w *auditLogExport) write(entry dto.AuditLogEntry) error {
+	if !w.started {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+
+	// not supported by all writers, the server write timeout applies then
+	_ = http.NewResponseController(w.c.Writer).SetWriteDeadline(time.Now().Add(auditLogExportWriteTimeout))
+
+	var err error
+	if w.csv != nil {
+		err = w.csv.Write(&entry)
+	} else {
+		err = json.NewEncoder(w.c.Writer).Encode(entry)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	w.c.Writer.Flush()
+
+	return nil
+}
+
+// fail marks an NDJSON export as incomplete, a CSV export just ends.
+func (w *auditLogExport) fail(err error) {
+	if w.csv != nil {
+		return
+	}
+
+	_ = json.NewEncoder(w.c.Writer).Encode(gin.H{"error": err.Error()})
+}
+
 func (r *deviceManagementRoutes) getEventLog(c *gin.Context) {
 	guid := c.Param("guid")
 
@@ -103,6 +266,20 @@ func (r *deviceManagementRoutes) getEventLog(c *gin.Context) {
 	c.JSON(http.StatusOK, eventLogs)
 }
 
+func (r *deviceManagementRoutes) getCrashReport(c *gin.Context) {
+	guid := c.Param("guid")
+
+	report, err := r.d.GetCrashReport(c.Request.Context(), guid)
+	if err != nil {
+		r.l.Error(err, "http - v1 - getCrashReport")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
 func (r *deviceManagementRoutes) downloadEventLog(c *gin.Context) {
 	guid := c.Param("guid")
 
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+var exportedAuditLog = []dto.AuditLogEntry{
+	{Index: 1, Time: time.Date(2024, 3, 29, 18, 0, 0, 0, time.UTC), AuditAppID: 16, AuditApp: "Security Admin", Event: "Provisioning Started", Description: "Security Admin: Provisioning Started"},
+	{Index: 2, Time: time.Date(2024, 3, 29, 18, 1, 0, 0, time.UTC), AuditAppID: 16, AuditApp: "Security Admin", EventID: 1, Event: "Provisioning Completed", Description: "Security Admin: Provisioning Completed"},
+}
+
+// streamAuditLog passes the exported entries to emit and fails with err afterwards.
+func streamAuditLog(err error) func(context.Context, string, func(dto.AuditLogEntry) error) error {
+	return func(_ context.Context, _ string, emit func(dto.AuditLogEntry) error) error {
+		for i := range exportedAuditLog {
+			if emitErr := emit(exportedAuditLog[i]); emitErr != nil {
+				return emitErr
+			}
+		}
+
+		return err
+	}
+}
+
+func TestExportAuditLog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		url                 string
+		stream              func(context.Context, string, func(dto.AuditLogEntry) error) error
+		expectedCode        int
+		expectedBody        string
+		expectedDisposition string
+	}{
+		{
+			name:         "ndjson",
+			url:          "/api/v1/amt/log/audit/valid-guid/export",
+			stream:       streamAuditLog(nil),
+			expectedCode: http.StatusOK,
+			expectedBody: `{"index":1,"time":"2024-03-29T18:00:00Z","auditAppId":16,"auditApp":"Security Admin","eventId":0,"event":"Provisioning Started","initiator":"","netAddress":"","description":"Security Admin: Provisioning Started"}` + "\n" +
+				`{"index":2,"time":"2024-03-29T18:01:00Z","auditAppId":16,"auditApp":"Security Admin","eventId":1,"event":"Provisioning Completed","initiator":"","netAddress":"","description":"Security Admin: Provisioning Completed"}` + "\n",
+			expectedDisposition: "attachment; filename=audit-log-valid-guid.ndjson",
+		},
+		{
+			name:         "csv",
+			url:          "/api/v1/amt/log/audit/valid-guid/export?format=csv",
+			stream:       streamAuditLog(nil),
+			expectedCode: http.StatusOK,
+			expectedBody: "Index,Time,Application ID,Application,Event ID,Event,Initiator,Net Address,Description\n" +
+				"1,2024-03-29T18:00:00Z,16,Security Admin,0,Provisioning Started,,,Security Admin: Provisioning Started\n" +
+				"2,2024-03-29T18:01:00Z,16,Security Admin,1,Provisioning Completed,,,Security Admin: Provisioning Completed\n",
+			expectedDisposition: "attachment; filename=audit-log-valid-guid.csv",
+		},
+		{
+			name: "empty csv",
+			url:  "/api/v1/amt/log/audit/valid-guid/export?format=csv",
+			stream: func(context.Context, string, func(dto.AuditLogEntry) error) error {
+				return nil
+			},
+			expectedCode:        http.StatusOK,
+			expectedBody:        "Index,Time,Application ID,Application,Event ID,Event,Initiator,Net Address,Description\n",
+			expectedDisposition: "attachment; filename=audit-log-valid-guid.csv",
+		},
+		{
+			name:         "ndjson ends with the error of an incomplete export",
+			url:          "/api/v1/amt/log/audit/valid-guid/export",
+			stream:       streamAuditLog(context.DeadlineExceeded),
+			expectedCode: http.StatusOK,
+			expectedBody: `{"error":"context deadline exceeded"}` + "\n",
+		},
+		{
+			name: "device not found",
+			url:  "/api/v1/amt/log/audit/valid-guid/export",
+			stream: func(context.Context, string, func(dto.AuditLogEntry) error) error {
+				return devices.ErrNotFound
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deviceManagement, engine := deviceManagementTest(t)
+
+			deviceManagement.EXPECT().StreamAuditLog(gomock.Any(), "valid-guid", gomock.Any()).DoAndReturn(tc.stream)
+
+			req, err := http.NewRequest(http.MethodGet, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+
+			if tc.expectedCode == http.StatusOK {
+				assert.Contains(t, w.Body.String(), tc.expectedBody)
+			}
+
+			if tc.expectedDisposition != "" {
+				assert.Equal(t, tc.expectedBody, w.Body.String())
+				assert.Equal(t, tc.expectedDisposition, w.Header().Get("Content-Disposition"))
+			}
+		})
+	}
+}
@@ -34,6 +34,27 @@ func (r *deviceManagementRoutes) getTLSSettingData(c *gin.Context) {
 	c.JSON(http.StatusOK, tlsSettingData)
 }
 
+func (r *deviceManagementRoutes) setTLSSettings(c *gin.Context) {
+	guid := c.Param("guid")
+
+	var req dto.TLSSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	settings, err := r.d.SetTLSSettings(c.Request.Context(), guid, req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - setTLSSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
 func (r *deviceManagementRoutes) addCertificate(c *gin.Context) {
 	guid := c.Param("guid")
 
@@ -53,3 +74,26 @@ func (r *deviceManagementRoutes) addCertificate(c *gin.Context) {
 
 	c.JSON(http.StatusOK, handle)
 }
+
+// cleanupCertificates deletes the certificates and key pairs no credential context of the device uses, or with
+// dryRun=true only lists them.
+func (r *deviceManagementRoutes) cleanupCertificates(c *gin.Context) {
+	guid := c.Param("guid")
+
+	dryRun, err := isDryRun(c)
+	if err != nil {
+		ErrorResponse(c, ErrValidationDevices.Wrap("cleanupCertificates", "isDryRun", err))
+
+		return
+	}
+
+	result, err := r.d.CleanupCertificates(c.Request.Context(), guid, dryRun)
+	if err != nil {
+		r.l.Error(err, "http - v1 - cleanupCertificates")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
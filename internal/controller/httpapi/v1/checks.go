@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/checks"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// APIKeyHeader is the header the monitoring tools send the key of the status checks in.
+const APIKeyHeader = "X-API-Key"
+
+// checkStatusCodes answers the checks so that a monitoring tool only looking at the status code alerts
+// on the critical and unknown ones.
+var checkStatusCodes = map[int]int{
+	dto.CheckOK:       http.StatusOK,
+	dto.CheckWarning:  http.StatusOK,
+	dto.CheckCritical: http.StatusServiceUnavailable,
+	dto.CheckUnknown:  http.StatusInternalServerError,
+}
+
+type checkRoutes struct {
+	c checks.Feature
+	l logger.Interface
+}
+
+func NewCheckRoutes(handler *gin.RouterGroup, c checks.Feature, l logger.Interface) {
+	r := &checkRoutes{c, l}
+
+	h := handler.Group("/checks")
+	{
+		h.GET(":guid/:check", r.check)
+	}
+}
+
+// check runs a status check of a device and answers with the output line of a Nagios plugin as plain text.
+func (r *checkRoutes) check(c *gin.Context) {
+	result, err := r.c.Check(c.Request.Context(), c.Param("guid"), c.Param("check"))
+	if err != nil {
+		r.l.Error(err, "http - v1 - check")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.String(checkStatusCodes[result.Status], "%s\n", result.String())
+}
+
+// APIKeyMiddleware refuses the requests without key in the X-API-Key header.
+func APIKeyMiddleware(key string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if subtle.ConstantTimeCompare([]byte(c.GetHeader(APIKeyHeader)), []byte(key)) != 1 {
+			abortWithError(c, http.StatusUnauthorized, consoleerrors.CodeUnauthorized, "request does not contain a valid API key")
+
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestCheckRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		url          string
+		apiKey       string
+		mock         func(m *mocks.MockChecksFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:   "ok",
+			url:    "/api/v1/checks/valid-guid/power",
+			apiKey: "secret",
+			mock: func(m *mocks.MockChecksFeature) {
+				m.EXPECT().Check(context.Background(), "valid-guid", "power").
+					Return(dto.CheckResult{Check: "power", Status: dto.CheckOK, Message: "on", PerfData: "power_state=2"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "POWER OK - on | power_state=2\n",
+		},
+		{
+			name:   "warning",
+			url:    "/api/v1/checks/valid-guid/power",
+			apiKey: "secret",
+			mock: func(m *mocks.MockChecksFeature) {
+				m.EXPECT().Check(context.Background(), "valid-guid", "power").
+					Return(dto.CheckResult{Check: "power", Status: dto.CheckWarning, Message: "sleeping"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "POWER WARNING - sleeping\n",
+		},
+		{
+			name:   "critical",
+			url:    "/api/v1/checks/valid-guid/cira",
+			apiKey: "secret",
+			mock: func(m *mocks.MockChecksFeature) {
+				m.EXPECT().Check(context.Background(), "valid-guid", "cira").
+					Return(dto.CheckResult{Check: "cira", Status: dto.CheckCritical, Message: "not connected"}, nil)
+			},
+			expectedCode: http.StatusServiceUnavailable,
+			expectedBody: "CIRA CRITICAL - not connected\n",
+		},
+		{
+			name:   "unknown",
+			url:    "/api/v1/checks/valid-guid/cira",
+			apiKey: "secret",
+			mock: func(m *mocks.MockChecksFeature) {
+				m.EXPECT().Check(context.Background(), "valid-guid", "cira").
+					Return(dto.CheckResult{Check: "cira", Status: dto.CheckUnknown, Message: "the device does not connect over CIRA"}, nil)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "CIRA UNKNOWN - the device does not connect over CIRA\n",
+		},
+		{
+			name:   "unknown device",
+			url:    "/api/v1/checks/unknown-guid/amt",
+			apiKey: "secret",
+			mock: func(m *mocks.MockChecksFeature) {
+				m.EXPECT().Check(context.Background(), "unknown-guid", "amt").Return(dto.CheckResult{}, devices.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "invalid api key",
+			url:          "/api/v1/checks/valid-guid/power",
+			apiKey:       "wrong",
+			mock:         func(_ *mocks.MockChecksFeature) {},
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "no api key",
+			url:          "/api/v1/checks/valid-guid/power",
+			mock:         func(_ *mocks.MockChecksFeature) {},
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			checks := mocks.NewMockChecksFeature(mockCtl)
+			tc.mock(checks)
+
+			engine := gin.New()
+			NewCheckRoutes(engine.Group("/api/v1", APIKeyMiddleware("secret")), checks, logger.New("error"))
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			if tc.apiKey != "" {
+				req.Header.Set(APIKeyHeader, tc.apiKey)
+			}
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+
+			if tc.expectedBody != "" {
+				require.Equal(t, tc.expectedBody, w.Body.String())
+				require.Contains(t, w.Header().Get("Content-Type"), "text/plain")
+			}
+		})
+	}
+}
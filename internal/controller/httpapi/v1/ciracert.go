@@ -1,16 +1,28 @@
 package v1
 
 import (
+	"context"
+	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"net/http"
 	"os"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/device-management-toolkit/console/internal/certificates"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/rootca"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
+var (
+	ErrValidationCIRACert = dto.NotValidError{Console: consoleerrors.CreateConsoleError("CIRACertAPI")}
+	ErrCIRACertFormat     = errors.New("format is pem or json")
+)
+
 // CertReader defines the interface for reading certificate files.
 type CertReader interface {
 	ReadCert() ([]byte, error)
@@ -26,15 +38,37 @@ func (f *FileCertReader) ReadCert() ([]byte, error) {
 	return os.ReadFile(f.Path)
 }
 
+// RootCACertReader reads the current root certificate of the console, from the secret store when it holds it.
+type RootCACertReader struct {
+	RootCA rootca.Feature
+}
+
+// ReadCert returns the PEM encoded current root certificate.
+func (r *RootCACertReader) ReadCert() ([]byte, error) {
+	root, err := r.RootCA.Get(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(root.Current.Certificate), nil
+}
+
 type ciraCertRoutes struct {
 	l          logger.Interface
 	certReader CertReader
 }
 
-func NewCIRACertRoutes(handler *gin.RouterGroup, l logger.Interface) {
+// NewCIRACertRoutes serves the root certificate of the MPS, read through the root CA when it is managed and from
+// config/root_cert.pem otherwise.
+func NewCIRACertRoutes(handler *gin.RouterGroup, root rootca.Feature, l logger.Interface) {
+	var certReader CertReader = &FileCertReader{Path: certificates.RootCertPath}
+	if root != nil {
+		certReader = &RootCACertReader{RootCA: root}
+	}
+
 	r := &ciraCertRoutes{
 		l:          l,
-		certReader: &FileCertReader{Path: "config/root_cert.pem"},
+		certReader: certReader,
 	}
 
 	h := handler.Group("/ciracert")
@@ -56,7 +90,16 @@ func NewCIRACertRoutesWithReader(handler *gin.RouterGroup, l logger.Interface, c
 	}
 }
 
+// getCIRACert returns the root certificate as the base64 encoded DER that profiles take, as a PEM file with
+// format=pem, or described with its fingerprint with format=json.
 func (r *ciraCertRoutes) getCIRACert(c *gin.Context) {
+	format := c.Query("format")
+	if format != "" && format != "pem" && format != "json" {
+		ErrorResponse(c, ErrValidationCIRACert.Wrap("getCIRACert", "c.Query", ErrCIRACertFormat))
+
+		return
+	}
+
 	// Read the root certificate file
 	certData, err := r.certReader.ReadCert()
 	if err != nil {
@@ -75,6 +118,26 @@ func (r *ciraCertRoutes) getCIRACert(c *gin.Context) {
 		return
 	}
 
+	switch format {
+	case "pem":
+		c.Header("Content-Disposition", "attachment; filename=root_cert.pem")
+		c.Data(http.StatusOK, "application/x-pem-file", pem.EncodeToMemory(block))
+
+		return
+	case "json":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			r.l.Error(err, "http - CIRA cert - v1 - getCIRACert - failed to parse certificate")
+			c.String(http.StatusInternalServerError, "Failed to decode certificate")
+
+			return
+		}
+
+		c.JSON(http.StatusOK, certificates.RootCertificateInfo(cert))
+
+		return
+	}
+
 	// Extract just the base64-encoded certificate data (strip PEM headers/footers)
 	pemString := string(certData)
 	lines := strings.Split(pemString, "\n")
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -10,7 +11,11 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
 
+	"github.com/device-management-toolkit/console/internal/certificates"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -292,3 +297,74 @@ MIID
 		})
 	}
 }
+
+func TestCIRACertRoutes_Formats(t *testing.T) {
+	t.Parallel()
+
+	cert, _, err := certificates.NewRootCertificate(false, "console", "US", "device-management-toolkit", certificates.KeyRSA2048)
+	require.NoError(t, err)
+
+	root := certificates.RootCertificateInfo(cert)
+
+	mockCtl := gomock.NewController(t)
+	rootCA := mocks.NewMockRootCAFeature(mockCtl)
+	rootCA.EXPECT().Get(context.Background()).Return(dto.RootCA{Current: root}, nil).AnyTimes()
+
+	engine := ciraCertTestWithReader(t, &RootCACertReader{RootCA: rootCA})
+
+	tests := []struct {
+		name     string
+		url      string
+		wantCode int
+		check    func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "pem",
+			url:      "/api/v1/admin/ciracert?format=pem",
+			wantCode: http.StatusOK,
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				t.Helper()
+
+				require.Equal(t, root.Certificate, w.Body.String())
+				require.Equal(t, "application/x-pem-file", w.Header().Get("Content-Type"))
+			},
+		},
+		{
+			name:     "json",
+			url:      "/api/v1/admin/ciracert?format=json",
+			wantCode: http.StatusOK,
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				t.Helper()
+
+				var got dto.RootCertificate
+
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+				require.Equal(t, root.SHA256Fingerprint, got.SHA256Fingerprint)
+				require.Equal(t, root.Certificate, got.Certificate)
+			},
+		},
+		{
+			name:     "unknown format",
+			url:      "/api/v1/admin/ciracert?format=der",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code, w.Body.String())
+
+			if tc.check != nil {
+				tc.check(t, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/checkouts"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var ErrValidationCheckouts = dto.NotValidError{Console: consoleerrors.CreateConsoleError("CheckoutsAPI")}
+
+type checkoutRoutes struct {
+	t checkouts.Feature
+	l logger.Interface
+}
+
+func NewCredentialCheckoutRoutes(handler *gin.RouterGroup, t checkouts.Feature, l logger.Interface) {
+	r := &checkoutRoutes{t, l}
+
+	h := handler.Group("/devices")
+	{
+		h.POST(":guid/credentials/checkout", r.checkout)
+		h.DELETE(":guid/credentials/checkout", r.checkIn)
+		h.GET(":guid/credentials/checkouts", r.getHistory)
+	}
+}
+
+// checkout reveals the AMT admin password of the device to the authenticated user.
+func (r *checkoutRoutes) checkout(c *gin.Context) {
+	var req dto.CredentialCheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, ErrValidationCheckouts.Wrap("checkout", "ShouldBindJSON", err))
+
+		return
+	}
+
+	checkout, err := r.t.Checkout(c.Request.Context(), c.Param("guid"), c.GetString(ContextKeyUser), req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - checkout")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	// the password must not be kept by the proxies and browsers on the way
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, checkout)
+}
+
+func (r *checkoutRoutes) checkIn(c *gin.Context) {
+	if err := r.t.CheckIn(c.Request.Context(), c.Param("guid"), c.GetString(ContextKeyUser)); err != nil {
+		r.l.Error(err, "http - v1 - checkIn")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (r *checkoutRoutes) getHistory(c *gin.Context) {
+	history, err := r.t.GetHistory(c.Request.Context(), c.Param("guid"), c.GetString(ContextKeyUser))
+	if err != nil {
+		r.l.Error(err, "http - v1 - getHistory")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
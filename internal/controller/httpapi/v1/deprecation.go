@@ -0,0 +1,13 @@
+package v1
+
+import "github.com/gin-gonic/gin"
+
+// DeprecationMiddleware marks v1 responses as deprecated (draft-ietf-httpapi-deprecation-header)
+// and points clients at the successor API version.
+func DeprecationMiddleware(successor string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Deprecation", "true")
+		c.Header("Link", "<"+successor+">; rel=\"successor-version\"")
+		c.Next()
+	}
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+// lockContext returns the context of the request acting as the authenticated user on device locks.
+func lockContext(c *gin.Context) context.Context {
+	user := c.GetString(ContextKeyUser)
+	if user == "" {
+		return c.Request.Context()
+	}
+
+	return devices.WithLockHolder(c.Request.Context(), user)
+}
+
+// lockDevice locks the device for the user, or renews the lock the user has.
+func (dr *deviceRoutes) lockDevice(c *gin.Context) {
+	// the body is optional
+	var req dto.DeviceLockRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ErrorResponse(c, ErrValidationDevices.Wrap("lockDevice", "ShouldBindJSON", err))
+
+		return
+	}
+
+	lock, err := dr.t.LockDevice(lockContext(c), c.Param("guid"), req)
+	if err != nil {
+		dr.l.Error(err, "http - devices - v1 - lockDevice")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, lock)
+}
+
+func (dr *deviceRoutes) getDeviceLock(c *gin.Context) {
+	lock, err := dr.t.GetDeviceLock(c.Request.Context(), c.Param("guid"))
+	if err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, lock)
+}
+
+// unlockDevice releases the lock of the user, force=true releases the lock of another holder.
+func (dr *deviceRoutes) unlockDevice(c *gin.Context) {
+	force, _ := strconv.ParseBool(c.Query("force"))
+
+	if err := dr.t.UnlockDevice(lockContext(c), c.Param("guid"), force); err != nil {
+		dr.l.Error(err, "http - devices - v1 - unlockDevice")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+// holder matches a context acting as holder on device locks.
+func holder(name string) gomock.Matcher {
+	return gomock.Cond(func(ctx context.Context) bool { return devices.LockHolder(ctx) == name })
+}
+
+func TestDeviceLockRoutes(t *testing.T) {
+	t.Parallel()
+
+	acquired := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lock := dto.DeviceLock{GUID: "guid", Holder: "alice", Reason: "firmware update", AcquiredAt: acquired, ExpiresAt: acquired.Add(15 * time.Minute)}
+	locked := devices.LockedError{Holder: "bob", ExpiresAt: lock.ExpiresAt}
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		body         string
+		mock         func(*mocks.MockDeviceManagementFeature)
+		expectedCode int
+		response     any
+	}{
+		{
+			name:   "lock",
+			method: http.MethodPost,
+			url:    "/api/v1/devices/guid/lock",
+			body:   `{"reason":"firmware update"}`,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().LockDevice(holder("alice"), "guid", dto.DeviceLockRequest{Reason: "firmware update"}).Return(lock, nil)
+			},
+			expectedCode: http.StatusOK,
+			response:     lock,
+		},
+		{
+			name:   "lock without a body",
+			method: http.MethodPost,
+			url:    "/api/v1/devices/guid/lock",
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().LockDevice(holder("alice"), "guid", dto.DeviceLockRequest{}).Return(lock, nil)
+			},
+			expectedCode: http.StatusOK,
+			response:     lock,
+		},
+		{
+			name:   "lock - locked by another holder",
+			method: http.MethodPost,
+			url:    "/api/v1/devices/guid/lock",
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().LockDevice(holder("alice"), "guid", dto.DeviceLockRequest{}).Return(dto.DeviceLock{}, locked)
+			},
+			expectedCode: http.StatusConflict,
+			response:     response{Error: locked.Error(), Message: locked.Error(), Code: consoleerrors.CodeDeviceLocked},
+		},
+		{
+			name:   "get",
+			method: http.MethodGet,
+			url:    "/api/v1/devices/guid/lock",
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetDeviceLock(gomock.Any(), "guid").Return(lock, nil)
+			},
+			expectedCode: http.StatusOK,
+			response:     lock,
+		},
+		{
+			name:   "get - not locked",
+			method: http.MethodGet,
+			url:    "/api/v1/devices/guid/lock",
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetDeviceLock(gomock.Any(), "guid").Return(dto.DeviceLock{}, devices.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "unlock by force",
+			method: http.MethodDelete,
+			url:    "/api/v1/devices/guid/lock?force=true",
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().UnlockDevice(holder("alice"), "guid", true).Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:   "unlock - locked by another holder",
+			method: http.MethodDelete,
+			url:    "/api/v1/devices/guid/lock",
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().UnlockDevice(holder("alice"), "guid", false).Return(locked)
+			},
+			expectedCode: http.StatusConflict,
+			response:     response{Error: locked.Error(), Message: locked.Error(), Code: consoleerrors.CodeDeviceLocked},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			device, engine := devicesTestAs(t, "alice")
+			tc.mock(device)
+
+			req := httptest.NewRequest(tc.method, tc.url, bytes.NewBufferString(tc.body))
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+
+			if tc.response != nil {
+				expected, err := json.Marshal(tc.response)
+				require.NoError(t, err)
+				require.JSONEq(t, string(expected), w.Body.String())
+			}
+		})
+	}
+}
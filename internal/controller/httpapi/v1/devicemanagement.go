@@ -14,10 +14,14 @@ type deviceManagementRoutes struct {
 	a amtexplorer.Feature
 	e export.Exporter
 	l logger.Interface
+
+	snapshots *snapshotStore
 }
 
 func NewAmtRoutes(handler *gin.RouterGroup, d devices.Feature, amt amtexplorer.Feature, e export.Exporter, l logger.Interface) {
-	r := &deviceManagementRoutes{d, amt, e, l}
+	r := &deviceManagementRoutes{d: d, a: amt, e: e, l: l, snapshots: newSnapshotStore(snapshotTTL)}
+
+	go r.snapshots.sweepEvery(snapshotSweepInterval)
 
 	h := handler.Group("/amt")
 	{
@@ -41,8 +45,15 @@ func NewAmtRoutes(handler *gin.RouterGroup, d devices.Feature, amt amtexplorer.F
 
 		h.GET("log/audit/:guid", r.getAuditLog)
 		h.GET("log/audit/:guid/download", r.downloadAuditLog)
+		h.GET("log/audit/:guid/export", r.exportAuditLog)
 		h.GET("log/event/:guid", r.getEventLog)
 		h.GET("log/event/:guid/download", r.downloadEventLog)
+		h.GET("log/crash/:guid", r.getCrashReport)
+
+		h.POST("capture/:guid", r.startWsmanCapture)
+		h.DELETE("capture/:guid", r.stopWsmanCapture)
+		h.GET("capture/:guid/download", r.downloadWsmanCapture)
+
 		h.GET("generalSettings/:guid", r.getGeneralSettings)
 
 		h.GET("userConsentCode/cancel/:guid", r.cancelUserConsentCode)
@@ -54,15 +65,43 @@ func NewAmtRoutes(handler *gin.RouterGroup, d devices.Feature, amt amtexplorer.F
 		h.GET("explorer", r.getCallList)
 		h.GET("explorer/:guid/:call", r.executeCall)
 		h.GET("tls/:guid", r.getTLSSettingData)
+		h.PUT("tls/:guid", r.setTLSSettings)
 
 		h.GET("certificates/:guid", r.getCertificates)
 		h.POST("certificates/:guid", r.addCertificate)
+		h.DELETE("certificates/:guid/orphans", r.cleanupCertificates)
 
 		// KVM display settings
 		h.GET("kvm/displays/:guid", r.getKVMDisplays)
 		h.PUT("kvm/displays/:guid", r.setKVMDisplays)
 
+		// KVM session limits
+		h.GET("kvm/limits", r.getKVMLimits)
+		h.PUT("kvm/limits", r.setKVMLimits)
+
 		// Network link preference
 		h.POST("network/linkPreference/:guid", r.setLinkPreference)
+
+		// Network link settings
+		h.GET("network/linkSettings/:guid", r.getLinkSettings)
+		h.PUT("network/linkSettings/:guid", r.setLinkSettings)
+
+		// Wi-Fi profile priorities
+		h.GET("network/wifiProfiles/:guid", r.getWiFiProfiles)
+		h.PUT("network/wifiProfiles/:guid", r.reorderWiFiProfiles)
+
+		// Wi-Fi port settings
+		h.GET("network/wifiPortSettings/:guid", r.getWiFiPortSettings)
+		h.PUT("network/wifiPortSettings/:guid", r.setWiFiPortSettings)
+
+		// Kerberos authentication
+		h.GET("kerberos/:guid", r.getKerberosSettings)
+		h.PUT("kerberos/:guid", r.setKerberosSettings)
+
+		// Digest and Kerberos users
+		h.GET("users/:guid", r.getDeviceUsers)
+		h.POST("users/:guid", r.addDeviceUser)
+		h.PUT("users/:guid/:handle", r.updateDeviceUser)
+		h.DELETE("users/:guid/:handle", r.removeDeviceUser)
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -18,6 +19,8 @@ import (
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	dtov2 "github.com/device-management-toolkit/console/internal/entity/dto/v2"
 	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -261,6 +264,29 @@ func TestDeviceManagement(t *testing.T) {
 			expectedCode: http.StatusOK,
 			response:     dto.AuditLog{},
 		},
+		{
+			name:   "getAuditLog - filtered page",
+			url:    "/api/v1/amt/log/audit/valid-guid?$skip=10&$top=5&from=2026-10-15T00:00:00Z&auditAppId=16&eventId=0&eventId=1",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().QueryAuditLog(context.Background(), "valid-guid", dto.AuditLogQuery{
+					Skip:        10,
+					Top:         5,
+					From:        time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+					AuditAppIDs: []int{16},
+					EventIDs:    []int{0, 1},
+				}).Return(dto.AuditLogPage{TotalCount: 40, Records: []dto.AuditLogEntry{}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			response:     dto.AuditLogPage{TotalCount: 40, Records: []dto.AuditLogEntry{}},
+		},
+		{
+			name:         "getAuditLog - range ends before it starts",
+			url:          "/api/v1/amt/log/audit/valid-guid?from=2026-10-15T00:00:00Z&to=2026-10-14T00:00:00Z",
+			method:       http.MethodGet,
+			mock:         func(*mocks.MockDeviceManagementFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
 		{
 			name:   "getEventLog - successful retrieval",
 			url:    "/api/v1/amt/log/event/valid-guid?$skip=1&$top=10",
@@ -272,6 +298,17 @@ func TestDeviceManagement(t *testing.T) {
 			expectedCode: http.StatusOK,
 			response:     dto.EventLogs{},
 		},
+		{
+			name:   "getCrashReport - successful retrieval",
+			url:    "/api/v1/amt/log/crash/valid-guid",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetCrashReport(context.Background(), "valid-guid").
+					Return(dto.CrashReport{Supported: true, Failures: []dto.CrashEvent{}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			response:     dto.CrashReport{Supported: true, Failures: []dto.CrashEvent{}},
+		},
 		{
 			name:   "setBootOptions - successful setting",
 			url:    "/api/v1/amt/power/bootOptions/valid-guid",
@@ -320,6 +357,25 @@ func TestDeviceManagement(t *testing.T) {
 			expectedCode: http.StatusInternalServerError,
 			response:     dto.SecuritySettings{},
 		},
+		{
+			name:   "cleanupCertificates - dry run",
+			url:    "/api/v1/amt/certificates/valid-guid/orphans?dryRun=true",
+			method: http.MethodDelete,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().CleanupCertificates(context.Background(), "valid-guid", true).
+					Return(dto.CertificateCleanup{DryRun: true, Certificates: []string{"Intel(r) AMT Certificate: Handle: 2"}, Keys: []string{}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			response:     dto.CertificateCleanup{DryRun: true, Certificates: []string{"Intel(r) AMT Certificate: Handle: 2"}, Keys: []string{}},
+		},
+		{
+			name:         "cleanupCertificates - invalid dry run",
+			url:          "/api/v1/amt/certificates/valid-guid/orphans?dryRun=maybe",
+			method:       http.MethodDelete,
+			mock:         func(*mocks.MockDeviceManagementFeature) {},
+			expectedCode: http.StatusBadRequest,
+			response:     nil,
+		},
 		{
 			name:   "getTLSsettingData - successful retrieval",
 			url:    "/api/v1/amt/tls/valid-guid",
@@ -441,3 +497,29 @@ func TestDeviceManagement(t *testing.T) {
 		})
 	}
 }
+
+func TestPowerActionRefused(t *testing.T) {
+	t.Parallel()
+
+	deviceManagement, engine := deviceManagementTest(t)
+
+	refused := amtstatus.Error{Operation: "RequestPowerStateChange", Explanation: amtstatus.ExplainPower(4097)}
+	deviceManagement.EXPECT().SendPowerAction(context.Background(), "valid-guid", 10).
+		Return(power.PowerActionResponse{ReturnValue: 4097}, refused)
+
+	reqBody, _ := json.Marshal(dto.PowerAction{Action: 10})
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/api/v1/amt/power/action/valid-guid", bytes.NewBuffer(reqBody))
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	var body response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, consoleerrors.CodeAMTError, body.Code)
+	require.NotNil(t, body.AMTStatus)
+	require.Equal(t, "InvalidStateTransition", body.AMTStatus.Name)
+	require.NotEmpty(t, body.AMTStatus.Remediation)
+}
@@ -1,7 +1,11 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -9,20 +13,34 @@ import (
 
 	"github.com/device-management-toolkit/console/config"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/correlations"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/export"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
 type deviceRoutes struct {
 	t devices.Feature
+	c correlations.Feature
 	l logger.Interface
 }
 
 var ErrValidationDevices = dto.NotValidError{Console: consoleerrors.CreateConsoleError("ProfileAPI")}
 
-func NewDeviceRoutes(handler *gin.RouterGroup, t devices.Feature, l logger.Interface) {
-	r := &deviceRoutes{t, l}
+// attributeParamPrefix starts the query parameters filtering devices by a custom attribute.
+const attributeParamPrefix = "attr."
+
+// deviceExportPage is the number of devices read at a time for an export.
+const deviceExportPage = 100
+
+// me filters the owner or assignee of devices by the logged in user.
+const me = "me"
+
+var errNoUser = errors.New("\"me\" requires an authenticated user")
+
+func NewDeviceRoutes(handler *gin.RouterGroup, t devices.Feature, c correlations.Feature, l logger.Interface) {
+	r := &deviceRoutes{t, c, l}
 
 	handler.GET("authorize/redirection/:id", r.LoginRedirection)
 
@@ -30,16 +48,49 @@ func NewDeviceRoutes(handler *gin.RouterGroup, t devices.Feature, l logger.Inter
 	{
 		h.GET("", r.get)
 		h.GET("stats", r.getStats)
+		h.GET("export", r.exportDevices)
+		h.GET("certificates", r.getCertificateInventory)
 		h.GET("redirectstatus/:guid", r.redirectStatus)
 		h.GET("cert/:guid", r.getDeviceCertificate)
 		h.POST("cert/:guid", r.pinDeviceCertificate)
 		h.DELETE("cert/:guid", r.deleteDeviceCertificate)
+		h.POST("correlations/import", r.importCorrelations)
 		h.GET(":guid", r.getByID)
+		h.GET(":guid/redirection", r.getRedirectionSettings)
+		h.PUT(":guid/redirection", r.setRedirectionSettings)
+		h.PUT(":guid/redirection/display", r.selectKVMDisplay)
+		h.GET(":guid/presence", r.getPresence)
+		h.POST(":guid/presence", r.pingPresence)
+		h.GET(":guid/lock", r.getDeviceLock)
+		h.POST(":guid/lock", r.lockDevice)
+		h.DELETE(":guid/lock", r.unlockDevice)
 		h.GET("tags", r.getTags)
 		h.POST("", r.insert)
 		h.PATCH("", r.update)
+		h.PATCH(":guid", r.patch)
 		h.DELETE(":guid", r.delete)
+		h.POST(":guid/merge", r.merge)
+	}
+}
+
+// getCertificateInventory lists the certificates of every device, filtered by the query.
+func (dr *deviceRoutes) getCertificateInventory(c *gin.Context) {
+	var q dto.CertificateInventoryQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		ErrorResponse(c, ErrValidationDevices.Wrap("getCertificateInventory", "ShouldBindQuery", err))
+
+		return
+	}
+
+	inventory, err := dr.t.GetCertificateInventory(c.Request.Context(), "", q)
+	if err != nil {
+		dr.l.Error(err, "http - devices - v1 - getCertificateInventory")
+		ErrorResponse(c, err)
+
+		return
 	}
+
+	c.JSON(http.StatusOK, inventory)
 }
 
 func (dr *deviceRoutes) getStats(c *gin.Context) {
@@ -58,6 +109,35 @@ func (dr *deviceRoutes) getStats(c *gin.Context) {
 	c.JSON(http.StatusOK, countResponse)
 }
 
+// exportDevices downloads every device as CSV with its friendly name, location and notes, for helpdesk tooling.
+func (dr *deviceRoutes) exportDevices(c *gin.Context) {
+	var items []dto.Device
+
+	for skip := 0; ; skip += deviceExportPage {
+		page, err := dr.t.Get(c.Request.Context(), deviceExportPage, skip, "")
+		if err != nil {
+			dr.l.Error(err, "http - devices - v1 - exportDevices")
+			ErrorResponse(c, err)
+
+			return
+		}
+
+		items = append(items, page...)
+
+		if len(page) < deviceExportPage {
+			break
+		}
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=devices.csv")
+	c.Header("Content-Type", "text/csv")
+	c.Status(http.StatusOK)
+
+	if err := export.WriteDevicesCSV(c.Writer, items); err != nil {
+		dr.l.Error(err, "http - devices - v1 - exportDevices")
+	}
+}
+
 func (dr *deviceRoutes) LoginRedirection(c *gin.Context) {
 	deviceID := c.Param("id")
 
@@ -78,7 +158,7 @@ func (dr *deviceRoutes) LoginRedirection(c *gin.Context) {
 
 	tokenString, err := token.SignedString([]byte(config.ConsoleConfig.JWTKey))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not create token"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not create token", "code": consoleerrors.CodeInternal})
 
 		return
 	}
@@ -97,10 +177,25 @@ func (dr *deviceRoutes) get(c *gin.Context) {
 	tags := c.Query("tags")
 	hostname := c.Query("hostname")
 	friendlyName := c.Query("friendlyName")
+	location := c.Query("location")
 
-	var items []dto.Device
+	owner, err := resolveMe(c, c.Query("owner"))
+	if err != nil {
+		ErrorResponse(c, ErrValidationDevices.Wrap("get", "resolveMe", err))
 
-	var err error
+		return
+	}
+
+	assignee, err := resolveMe(c, c.Query("assignee"))
+	if err != nil {
+		ErrorResponse(c, ErrValidationDevices.Wrap("get", "resolveMe", err))
+
+		return
+	}
+
+	attributes := attributeFilter(c)
+
+	var items []dto.Device
 
 	switch {
 	case hostname != "":
@@ -109,6 +204,18 @@ func (dr *deviceRoutes) get(c *gin.Context) {
 	case friendlyName != "":
 		items, err = dr.getByColumnOrTags(c, "FriendlyName", friendlyName, odata.Top, odata.Skip, "")
 
+	case location != "":
+		items, err = dr.getByColumnOrTags(c, "Location", location, odata.Top, odata.Skip, "")
+
+	case assignee != "":
+		items, err = dr.getByColumnOrTags(c, "Assignee", assignee, odata.Top, odata.Skip, "")
+
+	case owner != "":
+		items, err = dr.getByColumnOrTags(c, "Owner", owner, odata.Top, odata.Skip, "")
+
+	case len(attributes) > 0:
+		items, err = dr.t.GetByAttributes(c.Request.Context(), attributes, odata.Top, odata.Skip, "")
+
 	case tags != "":
 		items, err = dr.getByColumnOrTags(c, "Tags", tags, odata.Top, odata.Skip, "")
 
@@ -143,6 +250,40 @@ func (dr *deviceRoutes) get(c *gin.Context) {
 	}
 }
 
+// resolveMe replaces "me" with the subject of the token the request was authenticated with.
+func resolveMe(c *gin.Context, value string) (string, error) {
+	if value != me {
+		return value, nil
+	}
+
+	user := c.GetString(ContextKeyUser)
+	if user == "" {
+		return "", errNoUser
+	}
+
+	return user, nil
+}
+
+// attributeFilter collects the custom attributes queried with attr.<name>=<value> parameters.
+func attributeFilter(c *gin.Context) map[string]string {
+	var attributes map[string]string
+
+	for param, values := range c.Request.URL.Query() {
+		name, ok := strings.CutPrefix(param, attributeParamPrefix)
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		if attributes == nil {
+			attributes = map[string]string{}
+		}
+
+		attributes[name] = values[0]
+	}
+
+	return attributes
+}
+
 func (dr *deviceRoutes) getByColumnOrTags(c *gin.Context, column, value string, limit, skip int, tenantID string) ([]dto.Device, error) {
 	var items []dto.Device
 
@@ -180,7 +321,58 @@ func (dr *deviceRoutes) getByID(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, item)
+	selection := fields(c)
+
+	if len(selection) == 0 || slices.Contains(selection, "correlations") {
+		item.Correlations, err = dr.c.GetByGUID(c.Request.Context(), item.GUID, "")
+		if err != nil {
+			dr.l.Error(err, "http - devices - v1 - get")
+			ErrorResponse(c, err)
+
+			return
+		}
+	}
+
+	// the fields unknown to a device are left out of the response
+	response, err := selectFields(item, selection)
+	if err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	jsonWithETag(c, http.StatusOK, response)
+}
+
+// importCorrelations matches the devices of an Intune or ConfigMgr export to console devices. The export
+// is either a CSV file as downloaded from the admin console, with the source in the query, or JSON.
+func (dr *deviceRoutes) importCorrelations(c *gin.Context) {
+	var batch dto.CorrelationImport
+
+	if c.ContentType() == "text/csv" {
+		records, err := correlations.ParseCSV(c.Request.Body)
+		if err != nil {
+			ErrorResponse(c, ErrValidationDevices.Wrap("importCorrelations", "correlations.ParseCSV", err))
+
+			return
+		}
+
+		batch = dto.CorrelationImport{Source: c.Query("source"), Records: records}
+	} else if err := c.ShouldBindJSON(&batch); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	result, err := dr.c.Import(c.Request.Context(), batch.Source, batch.Records, "")
+	if err != nil {
+		dr.l.Error(err, "http - devices - v1 - importCorrelations")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
 }
 
 func (dr *deviceRoutes) insert(c *gin.Context) {
@@ -222,10 +414,62 @@ func (dr *deviceRoutes) update(c *gin.Context) {
 	c.JSON(http.StatusOK, updatedDevice)
 }
 
+func (dr *deviceRoutes) patch(c *gin.Context) {
+	var patch dto.DevicePatch
+	if err := c.ShouldBindJSON(&patch); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	patchedDevice, err := dr.t.Patch(c.Request.Context(), c.Param("guid"), "", patch)
+	if err != nil {
+		dr.l.Error(err, "http - devices - v1 - patch")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, patchedDevice)
+}
+
 func (dr *deviceRoutes) delete(c *gin.Context) {
 	guid := c.Param("guid")
 
-	err := dr.t.Delete(c.Request.Context(), guid, "")
+	dryRun, err := isDryRun(c)
+	if err != nil {
+		ErrorResponse(c, ErrValidationDevices.Wrap("delete", "isDryRun", err))
+
+		return
+	}
+
+	if dryRun {
+		result, err := dr.t.PlanDelete(c.Request.Context(), guid, "")
+		if err != nil {
+			dr.l.Error(err, "http - devices - v1 - delete")
+			ErrorResponse(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, result)
+
+		return
+	}
+
+	// cleanup=true removes what the console configured on the device before deleting it, and reports what could
+	// not be removed
+	cleanup := false
+
+	if value, ok := c.GetQuery("cleanup"); ok {
+		if cleanup, err = strconv.ParseBool(value); err != nil {
+			ErrorResponse(c, ErrValidationDevices.Wrap("delete", "strconv.ParseBool", err))
+
+			return
+		}
+	}
+
+	result, err := dr.t.DeleteWithCleanup(c.Request.Context(), guid, "", cleanup)
 	if err != nil {
 		dr.l.Error(err, "http - devices - v1 - delete")
 		ErrorResponse(c, err)
@@ -233,7 +477,40 @@ func (dr *deviceRoutes) delete(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusNoContent, nil)
+	if !cleanup {
+		c.JSON(http.StatusNoContent, nil)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// merge merges duplicate records into the device, dryRun=true returns the merged device without changing anything.
+func (dr *deviceRoutes) merge(c *gin.Context) {
+	var req dto.DeviceMergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, ErrValidationDevices.Wrap("merge", "ShouldBindJSON", err))
+
+		return
+	}
+
+	dryRun, err := isDryRun(c)
+	if err != nil {
+		ErrorResponse(c, ErrValidationDevices.Wrap("merge", "isDryRun", err))
+
+		return
+	}
+
+	result, err := dr.t.MergeDevices(c.Request.Context(), c.Param("guid"), "", req.Duplicates, dryRun)
+	if err != nil {
+		dr.l.Error(err, "http - devices - v1 - merge")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
 }
 
 func (dr *deviceRoutes) redirectStatus(c *gin.Context) {
@@ -3,9 +3,11 @@ package v1
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/correlations"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
@@ -27,11 +30,13 @@ func devicesTest(t *testing.T) (*mocks.MockDeviceManagementFeature, *gin.Engine)
 
 	log := logger.New("error")
 	device := mocks.NewMockDeviceManagementFeature(mockCtl)
+	correlation := mocks.NewMockCorrelationsFeature(mockCtl)
+	correlation.EXPECT().GetByGUID(gomock.Any(), gomock.Any(), "").Return(nil, nil).AnyTimes()
 
 	engine := gin.New()
 	handler := engine.Group("/api/v1")
 
-	NewDeviceRoutes(handler, device, log)
+	NewDeviceRoutes(handler, device, correlation, log)
 
 	return device, engine
 }
@@ -81,6 +86,64 @@ func TestDevicesRoutes(t *testing.T) {
 			response:     dto.DeviceCountResponse{Count: 1, Data: []dto.Device{{GUID: "guid", MPSUsername: "mpsusername", Username: "admin", Password: "password", ConnectionStatus: true, Hostname: "hostname"}}},
 			expectedCode: http.StatusOK,
 		},
+		{
+			name:   "get devices by location",
+			method: http.MethodGet,
+			url:    "/api/v1/devices?location=Lab%201",
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().GetByColumn(context.Background(), "Location", "Lab 1", "").Return([]dto.Device{{GUID: "guid", Location: "Lab 1"}}, nil)
+			},
+			response:     []dto.Device{{GUID: "guid", Location: "Lab 1"}},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "get devices by assignee",
+			method: http.MethodGet,
+			url:    "/api/v1/devices?assignee=jdoe",
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().GetByColumn(context.Background(), "Assignee", "jdoe", "").Return([]dto.Device{{GUID: "guid", Assignee: "jdoe"}}, nil)
+			},
+			response:     []dto.Device{{GUID: "guid", Assignee: "jdoe"}},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "get devices by owner",
+			method: http.MethodGet,
+			url:    "/api/v1/devices?owner=helpdesk",
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().GetByColumn(context.Background(), "Owner", "helpdesk", "").Return([]dto.Device{{GUID: "guid", Owner: "helpdesk"}}, nil)
+			},
+			response:     []dto.Device{{GUID: "guid", Owner: "helpdesk"}},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "get devices by attributes",
+			method: http.MethodGet,
+			url:    "/api/v1/devices?attr.costCenter=1234&attr.asset-tag=A-1&$top=10",
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().GetByAttributes(context.Background(), map[string]string{"costCenter": "1234", "asset-tag": "A-1"}, 10, 0, "").
+					Return([]dto.Device{{GUID: "guid", Attributes: map[string]string{"costCenter": "1234", "asset-tag": "A-1"}}}, nil)
+			},
+			response:     []dto.Device{{GUID: "guid", Attributes: map[string]string{"costCenter": "1234", "asset-tag": "A-1"}}},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "get devices by attributes - invalid name",
+			method: http.MethodGet,
+			url:    "/api/v1/devices?attr.cost%20center=1234",
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().GetByAttributes(context.Background(), map[string]string{"cost center": "1234"}, 25, 0, "").
+					Return(nil, devices.ErrValidationUseCase)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "get my devices - not authenticated",
+			method:       http.MethodGet,
+			url:          "/api/v1/devices?assignee=me",
+			mock:         func(_ *mocks.MockDeviceManagementFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
 		{
 			name:   "get device by id",
 			method: http.MethodGet,
@@ -176,21 +239,72 @@ func TestDevicesRoutes(t *testing.T) {
 			method: http.MethodDelete,
 			url:    "/api/v1/devices/profile",
 			mock: func(device *mocks.MockDeviceManagementFeature) {
-				device.EXPECT().Delete(context.Background(), "profile", "").Return(nil)
+				device.EXPECT().DeleteWithCleanup(context.Background(), "profile", "", false).Return(dto.DeviceDeletion{}, nil)
 			},
 			response:     nil,
 			expectedCode: http.StatusNoContent,
 		},
+		{
+			name:   "delete device - cleanup",
+			method: http.MethodDelete,
+			url:    "/api/v1/devices/profile?cleanup=true",
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().DeleteWithCleanup(context.Background(), "profile", "", true).Return(dto.DeviceDeletion{
+					GUID:       "profile",
+					Cleanup:    []dto.DeviceCleanupStep{{Step: "redirection", Status: "failed", Error: "device is offline"}},
+					Deleted:    map[string]int64{"devices": 1},
+					Incomplete: true,
+				}, nil)
+			},
+			response: dto.DeviceDeletion{
+				GUID:       "profile",
+				Cleanup:    []dto.DeviceCleanupStep{{Step: "redirection", Status: "failed", Error: "device is offline"}},
+				Deleted:    map[string]int64{"devices": 1},
+				Incomplete: true,
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "delete device - invalid cleanup",
+			method:       http.MethodDelete,
+			url:          "/api/v1/devices/profile?cleanup=maybe",
+			mock:         func(_ *mocks.MockDeviceManagementFeature) {},
+			response:     nil,
+			expectedCode: http.StatusBadRequest,
+		},
 		{
 			name:   "delete device - failed",
 			method: http.MethodDelete,
 			url:    "/api/v1/devices/profile",
 			mock: func(device *mocks.MockDeviceManagementFeature) {
-				device.EXPECT().Delete(context.Background(), "profile", "").Return(devices.ErrDatabase)
+				device.EXPECT().DeleteWithCleanup(context.Background(), "profile", "", false).Return(dto.DeviceDeletion{}, devices.ErrDatabase)
 			},
 			response:     devices.ErrDatabase,
 			expectedCode: http.StatusBadRequest,
 		},
+		{
+			name:   "delete device - dry run",
+			method: http.MethodDelete,
+			url:    "/api/v1/devices/profile?dryRun=true",
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().PlanDelete(context.Background(), "profile", "").Return(dto.DryRunResult{
+					Operation: "delete device",
+					Actions:   []dto.PlannedAction{{Target: "profile", Action: "delete the device and its stored credentials"}},
+				}, nil)
+			},
+			response: dto.DryRunResult{
+				Operation: "delete device",
+				Actions:   []dto.PlannedAction{{Target: "profile", Action: "delete the device and its stored credentials"}},
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "delete device - dry run is not a boolean",
+			method:       http.MethodDelete,
+			url:          "/api/v1/devices/profile?dryRun=maybe",
+			mock:         func(_ *mocks.MockDeviceManagementFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
 		{
 			name:   "update device",
 			method: http.MethodPatch,
@@ -249,6 +363,30 @@ func TestDevicesRoutes(t *testing.T) {
 			requestBody:  requestDevice,
 			expectedCode: http.StatusBadRequest,
 		},
+		{
+			name:   "patch device details",
+			method: http.MethodPatch,
+			url:    "/api/v1/devices/guid",
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				friendlyName, location, notes, owner, assignee := "friendlyName", "", "", "", ""
+				patch := dto.DevicePatch{FriendlyName: &friendlyName, Location: &location, Notes: &notes, Owner: &owner, Assignee: &assignee}
+				device.EXPECT().Patch(context.Background(), "guid", "", patch).Return(&responseDevice, nil)
+			},
+			response:     responseDevice,
+			requestBody:  requestDevice,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "patch device details - not found",
+			method: http.MethodPatch,
+			url:    "/api/v1/devices/guid",
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().Patch(context.Background(), "guid", "", gomock.Any()).Return(nil, devices.ErrNotFound)
+			},
+			response:     devices.ErrNotFound,
+			requestBody:  requestDevice,
+			expectedCode: http.StatusNotFound,
+		},
 		{
 			name:   "tags of a device",
 			method: http.MethodGet,
@@ -279,6 +417,25 @@ func TestDevicesRoutes(t *testing.T) {
 			response:     dto.DeviceStatResponse{TotalCount: 5},
 			expectedCode: http.StatusOK,
 		},
+		{
+			name:   "certificate inventory of the devices trusting a CA",
+			method: http.MethodGet,
+			url:    "/api/v1/devices/certificates?issuer=Contoso&trustedRoot=true",
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				trusted := true
+				device.EXPECT().GetCertificateInventory(context.Background(), "", dto.CertificateInventoryQuery{Issuer: "Contoso", TrustedRoot: &trusted}).
+					Return(dto.CertificateInventory{Devices: 1, Certificates: []dto.CertificateInventoryEntry{{GUID: "guid1", Issuer: "CN=Contoso Root CA", TrustedRoot: true, Usage: []string{}}}}, nil)
+			},
+			response:     dto.CertificateInventory{Devices: 1, Certificates: []dto.CertificateInventoryEntry{{GUID: "guid1", Issuer: "CN=Contoso Root CA", TrustedRoot: true, Usage: []string{}}}},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "certificate inventory with an invalid expiry",
+			method:       http.MethodGet,
+			url:          "/api/v1/devices/certificates?expiresBefore=soon",
+			mock:         func(*mocks.MockDeviceManagementFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tc := range tests {
@@ -319,3 +476,269 @@ func TestDevicesRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMyDevices(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	device := mocks.NewMockDeviceManagementFeature(mockCtl)
+
+	engine := gin.New()
+	handler := engine.Group("/api/v1", func(c *gin.Context) { c.Set(ContextKeyUser, "jdoe") })
+
+	NewDeviceRoutes(handler, device, mocks.NewMockCorrelationsFeature(mockCtl), logger.New("error"))
+
+	device.EXPECT().GetByColumn(context.Background(), "Assignee", "jdoe", "").Return([]dto.Device{{GUID: "guid", Assignee: "jdoe"}}, nil)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/api/v1/devices?assignee=me", http.NoBody)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	expected, err := json.Marshal([]dto.Device{{GUID: "guid", Assignee: "jdoe"}})
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, string(expected), w.Body.String())
+}
+
+func TestExportDevices(t *testing.T) {
+	t.Parallel()
+
+	device, engine := devicesTest(t)
+
+	// the devices are read a page at a time until a page is not full
+	page := make([]dto.Device, deviceExportPage)
+	for i := range page {
+		page[i] = dto.Device{GUID: "guid", Hostname: "hostname"}
+	}
+
+	device.EXPECT().Get(context.Background(), deviceExportPage, 0, "").Return(page, nil)
+	device.EXPECT().Get(context.Background(), deviceExportPage, deviceExportPage, "").Return([]dto.Device{{
+		GUID: "last", Hostname: "pc-042", FriendlyName: "Front desk PC", Location: "Building 2, Floor 3", Notes: "Replaced battery 2026-09", Tags: []string{"lobby"},
+	}}, nil)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/api/v1/devices/export", http.NoBody)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, deviceExportPage+2)
+	require.Equal(t, []string{"GUID", "Hostname", "Friendly Name", "Location", "Notes", "Tags", "Owner", "Assignee", "Connected"}, records[0])
+	require.Equal(t, []string{"last", "pc-042", "Front desk PC", "Building 2, Floor 3", "Replaced battery 2026-09", "lobby", "", "", "false"}, records[deviceExportPage+1])
+}
+
+func TestDeviceCorrelations(t *testing.T) {
+	t.Parallel()
+
+	importedAt := time.Date(2026, 10, 2, 8, 0, 0, 0, time.UTC)
+	records := []dto.CorrelationRecord{{ID: "id1", DeviceName: "PC1", SerialNumber: "SN1"}}
+	result := dto.CorrelationImportResult{Matched: 1, Unmatched: []string{}}
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		contentType  string
+		body         string
+		mock         func(*mocks.MockDeviceManagementFeature, *mocks.MockCorrelationsFeature)
+		response     interface{}
+		expectedCode int
+	}{
+		{
+			name:   "device detail includes correlations",
+			method: http.MethodGet,
+			url:    "/api/v1/devices/guid",
+			mock: func(device *mocks.MockDeviceManagementFeature, correlation *mocks.MockCorrelationsFeature) {
+				device.EXPECT().GetByID(context.Background(), "guid", "", false).Return(&dto.Device{GUID: "guid"}, nil)
+				correlation.EXPECT().GetByGUID(context.Background(), "guid", "").
+					Return([]dto.DeviceCorrelation{{Source: "intune", ComplianceState: "compliant", MatchedBy: "uuid", ImportedAt: importedAt}}, nil)
+			},
+			response: dto.Device{GUID: "guid", Correlations: []dto.DeviceCorrelation{
+				{Source: "intune", ComplianceState: "compliant", MatchedBy: "uuid", ImportedAt: importedAt},
+			}},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "device detail - correlations cannot be read",
+			method: http.MethodGet,
+			url:    "/api/v1/devices/guid",
+			mock: func(device *mocks.MockDeviceManagementFeature, correlation *mocks.MockCorrelationsFeature) {
+				device.EXPECT().GetByID(context.Background(), "guid", "", false).Return(&dto.Device{GUID: "guid"}, nil)
+				correlation.EXPECT().GetByGUID(context.Background(), "guid", "").Return(nil, correlations.ErrDatabase)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:        "import json",
+			method:      http.MethodPost,
+			url:         "/api/v1/devices/correlations/import",
+			contentType: "application/json",
+			body:        `{"source":"intune","records":[{"id":"id1","deviceName":"PC1","serialNumber":"SN1"}]}`,
+			mock: func(_ *mocks.MockDeviceManagementFeature, correlation *mocks.MockCorrelationsFeature) {
+				correlation.EXPECT().Import(context.Background(), "intune", records, "").Return(result, nil)
+			},
+			response:     result,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:        "import csv",
+			method:      http.MethodPost,
+			url:         "/api/v1/devices/correlations/import?source=configmgr",
+			contentType: "text/csv",
+			body:        "ResourceID,Name,SerialNumber\nid1,PC1,SN1\n",
+			mock: func(_ *mocks.MockDeviceManagementFeature, correlation *mocks.MockCorrelationsFeature) {
+				correlation.EXPECT().Import(context.Background(), "configmgr", records, "").Return(result, nil)
+			},
+			response:     result,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "import csv - no identifier column",
+			method:       http.MethodPost,
+			url:          "/api/v1/devices/correlations/import?source=configmgr",
+			contentType:  "text/csv",
+			body:         "Name\nPC1\n",
+			mock:         func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockCorrelationsFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:        "import - unknown source",
+			method:      http.MethodPost,
+			url:         "/api/v1/devices/correlations/import?source=jamf",
+			contentType: "text/csv",
+			body:        "SerialNumber\nSN1\n",
+			mock: func(_ *mocks.MockDeviceManagementFeature, correlation *mocks.MockCorrelationsFeature) {
+				correlation.EXPECT().Import(context.Background(), "jamf", gomock.Any(), "").
+					Return(dto.CorrelationImportResult{}, correlations.ErrValidation.Wrap("Import", "source", correlations.ErrUnknownSource))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			device := mocks.NewMockDeviceManagementFeature(mockCtl)
+			correlation := mocks.NewMockCorrelationsFeature(mockCtl)
+
+			tc.mock(device, correlation)
+
+			engine := gin.New()
+			NewDeviceRoutes(engine.Group("/api/v1"), device, correlation, logger.New("error"))
+
+			req, err := http.NewRequestWithContext(context.Background(), tc.method, tc.url, bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+
+			if tc.expectedCode == http.StatusOK {
+				expected, err := json.Marshal(tc.response)
+				require.NoError(t, err)
+				require.JSONEq(t, string(expected), w.Body.String())
+			}
+		})
+	}
+}
+
+func TestDeviceMerge(t *testing.T) {
+	t.Parallel()
+
+	const (
+		survivor  = "123e4567-e89b-12d3-a456-426614174000"
+		duplicate = "67453e12-9be8-d312-a456-426614174000"
+	)
+
+	merged := dto.DeviceMerge{
+		Device:      &dto.Device{GUID: survivor, Tags: []string{"lab"}},
+		Merged:      []string{duplicate},
+		Reassigned:  map[string]int64{"power_samples": 12},
+		PendingJobs: 1,
+	}
+
+	tests := []struct {
+		name         string
+		url          string
+		body         string
+		mock         func(*mocks.MockDeviceManagementFeature)
+		response     interface{}
+		expectedCode int
+	}{
+		{
+			name: "merge",
+			url:  "/api/v1/devices/" + survivor + "/merge",
+			body: `{"duplicates":["` + duplicate + `"]}`,
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().MergeDevices(context.Background(), survivor, "", []string{duplicate}, false).Return(merged, nil)
+			},
+			response:     merged,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "dry run",
+			url:  "/api/v1/devices/" + survivor + "/merge?dryRun=true",
+			body: `{"duplicates":["` + duplicate + `"]}`,
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().MergeDevices(context.Background(), survivor, "", []string{duplicate}, true).
+					Return(dto.DeviceMerge{Device: merged.Device, Merged: merged.Merged, Reassigned: map[string]int64{}, DryRun: true}, nil)
+			},
+			response:     dto.DeviceMerge{Device: merged.Device, Merged: merged.Merged, Reassigned: map[string]int64{}, DryRun: true},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "malformed body",
+			url:          "/api/v1/devices/" + survivor + "/merge",
+			body:         `{"duplicates":"` + duplicate + `"}`,
+			mock:         func(*mocks.MockDeviceManagementFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "device not found",
+			url:  "/api/v1/devices/" + survivor + "/merge",
+			body: `{"duplicates":["` + duplicate + `"]}`,
+			mock: func(device *mocks.MockDeviceManagementFeature) {
+				device.EXPECT().MergeDevices(context.Background(), survivor, "", []string{duplicate}, false).Return(dto.DeviceMerge{}, devices.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			device, engine := devicesTest(t)
+			tc.mock(device)
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, tc.url, strings.NewReader(tc.body))
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+
+			if tc.expectedCode == http.StatusOK {
+				expected, err := json.Marshal(tc.response)
+				require.NoError(t, err)
+				require.JSONEq(t, string(expected), w.Body.String())
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
-	"github.com/go-playground/validator/v10"
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/domains"
@@ -23,15 +22,6 @@ type domainRoutes struct {
 func NewDomainRoutes(handler *gin.RouterGroup, t domains.Feature, l logger.Interface) {
 	r := &domainRoutes{t, l}
 
-	if binding.Validator != nil {
-		if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-			if err := v.RegisterValidation("alphanumhyphenunderscore", dto.ValidateAlphaNumHyphenUnderscore); err != nil {
-				validationErr := ErrValidationDomains.Wrap("NewDomainRoutes", "RegisterValidation", err)
-				l.Error(validationErr, "failed to register alphanumhyphenunderscore validation")
-			}
-		}
-	}
-
 	h := handler.Group("/domains")
 	{
 		h.GET("", r.get)
@@ -96,9 +86,18 @@ func (r *domainRoutes) getByName(c *gin.Context) {
 	c.JSON(http.StatusOK, item)
 }
 
+// insert accepts the domain as JSON, or as multipart/form-data with the provisioning certificate
+// uploaded as a file instead of base64 encoded by the client.
 func (r *domainRoutes) insert(c *gin.Context) {
 	var domain dto.Domain
-	if err := c.ShouldBindJSON(&domain); err != nil {
+	if c.ContentType() == binding.MIMEMultipartPOSTForm {
+		if err := bindMultipart(c, &domain, "provisioningCert"); err != nil {
+			validationErr := ErrValidationDomains.Wrap("insert", "bindMultipart", err)
+			ErrorResponse(c, validationErr)
+
+			return
+		}
+	} else if err := c.ShouldBindJSON(&domain); err != nil {
 		validationErr := ErrValidationDomains.Wrap("insert", "ShouldBindJSON", err)
 		ErrorResponse(c, validationErr)
 
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// isDryRun reports whether the request asks with dryRun=true for the actions it would take instead of taking them.
+// A value that is not a boolean is an error rather than a request to go ahead.
+func isDryRun(c *gin.Context) (bool, error) {
+	value, ok := c.GetQuery("dryRun")
+	if !ok {
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/energypolicies"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var ErrValidationEnergyPolicies = dto.NotValidError{Console: consoleerrors.CreateConsoleError("EnergyPoliciesAPI")}
+
+type energyPolicyRoutes struct {
+	t energypolicies.Feature
+	l logger.Interface
+}
+
+func NewEnergyPolicyRoutes(handler *gin.RouterGroup, t energypolicies.Feature, l logger.Interface) {
+	r := &energyPolicyRoutes{t, l}
+
+	h := handler.Group("/energypolicies")
+	{
+		h.GET("", r.get)
+		h.GET(":name", r.getByName)
+		h.GET(":name/dryrun", r.dryRun)
+		h.POST("", r.insert)
+		h.PATCH("", r.update)
+		h.DELETE(":name", r.delete)
+	}
+}
+
+func (r *energyPolicyRoutes) get(c *gin.Context) {
+	var odata OData
+	if err := c.ShouldBindQuery(&odata); err != nil {
+		validationErr := ErrValidationEnergyPolicies.Wrap("get", "ShouldBindQuery", err)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	items, err := r.t.Get(c.Request.Context(), odata.Top, odata.Skip, "")
+	if err != nil {
+		r.l.Error(err, "http - v1 - energy policies - get")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	if odata.Count {
+		count, err := r.t.GetCount(c.Request.Context(), "")
+		if err != nil {
+			r.l.Error(err, "http - v1 - energy policies - getCount")
+			ErrorResponse(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.EnergyPolicyCountResponse{Count: count, Data: items})
+	} else {
+		c.JSON(http.StatusOK, items)
+	}
+}
+
+func (r *energyPolicyRoutes) getByName(c *gin.Context) {
+	name := c.Param("name")
+
+	item, err := r.t.GetByName(c.Request.Context(), name, "")
+	if err != nil {
+		r.l.Error(err, "http - v1 - energy policies - getByName")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+func (r *energyPolicyRoutes) dryRun(c *gin.Context) {
+	name := c.Param("name")
+
+	reports, err := r.t.DryRun(c.Request.Context(), name, "")
+	if err != nil {
+		r.l.Error(err, "http - v1 - energy policies - dryRun")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, reports)
+}
+
+func (r *energyPolicyRoutes) insert(c *gin.Context) {
+	var policy dto.EnergyPolicy
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		validationErr := ErrValidationEnergyPolicies.Wrap("insert", "ShouldBindJSON", err)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	newPolicy, err := r.t.Insert(c.Request.Context(), &policy)
+	if err != nil {
+		r.l.Error(err, "http - v1 - energy policies - insert")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, newPolicy)
+}
+
+func (r *energyPolicyRoutes) update(c *gin.Context) {
+	var policy dto.EnergyPolicy
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		validationErr := ErrValidationEnergyPolicies.Wrap("update", "ShouldBindJSON", err)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	updatedPolicy, err := r.t.Update(c.Request.Context(), &policy)
+	if err != nil {
+		r.l.Error(err, "http - v1 - energy policies - update")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, updatedPolicy)
+}
+
+func (r *energyPolicyRoutes) delete(c *gin.Context) {
+	name := c.Param("name")
+
+	if err := r.t.Delete(c.Request.Context(), name, ""); err != nil {
+		r.l.Error(err, "http - v1 - energy policies - delete")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/energypolicies"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestEnergyPolicyRoutes(t *testing.T) {
+	t.Parallel()
+
+	policy := dto.EnergyPolicy{
+		Name:         "office",
+		Tags:         []string{"office"},
+		TimeZone:     "Europe/Berlin",
+		Days:         []string{"mon", "fri"},
+		PowerOffTime: "19:00",
+		Enabled:      true,
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		body         string
+		mock         func(m *mocks.MockEnergyPolicyFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:   "list with count",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/energypolicies?$top=10&$count=true",
+			mock: func(m *mocks.MockEnergyPolicyFeature) {
+				m.EXPECT().Get(context.Background(), 10, 0, "").Return([]dto.EnergyPolicy{policy}, nil)
+				m.EXPECT().GetCount(context.Background(), "").Return(1, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"totalCount":1`,
+		},
+		{
+			name:   "create",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/energypolicies",
+			body:   `{"name":"office","tags":["office"],"timeZone":"Europe/Berlin","days":["mon","fri"],"powerOffTime":"19:00","enabled":true}`,
+			mock: func(m *mocks.MockEnergyPolicyFeature) {
+				m.EXPECT().Insert(context.Background(), &policy).Return(&policy, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: `"powerOffTime":"19:00"`,
+		},
+		{
+			name:   "dry run",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/energypolicies/office/dryrun",
+			mock: func(m *mocks.MockEnergyPolicyFeature) {
+				m.EXPECT().DryRun(context.Background(), "office", "").Return([]dto.EnergyPolicyReport{{
+					Policy:  "office",
+					Action:  dto.EnergyActionPowerOff,
+					Devices: []dto.EnergyPolicyDevice{{GUID: "guid1", Decision: dto.EnergyActionSkip, Reason: "redirection session active"}},
+				}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"reason":"redirection session active"`,
+		},
+		{
+			name:   "delete unknown policy",
+			method: http.MethodDelete,
+			url:    "/api/v1/admin/energypolicies/missing",
+			mock: func(m *mocks.MockEnergyPolicyFeature) {
+				m.EXPECT().Delete(context.Background(), "missing", "").Return(energypolicies.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			energyPolicies := mocks.NewMockEnergyPolicyFeature(mockCtl)
+			tc.mock(energyPolicies)
+
+			engine := gin.New()
+			NewEnergyPolicyRoutes(engine.Group("/api/v1/admin"), energyPolicies, logger.New("error"))
+
+			req, err := http.NewRequest(tc.method, tc.url, bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
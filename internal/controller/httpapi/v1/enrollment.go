@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/enrollment"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var ErrValidationEnrollment = dto.NotValidError{Console: consoleerrors.CreateConsoleError("EnrollmentAPI")}
+
+type enrollmentRoutes struct {
+	t enrollment.Feature
+	l logger.Interface
+}
+
+// NewEnrollmentTokenRoutes registers the routes the admins mint and revoke the enrollment tokens with.
+func NewEnrollmentTokenRoutes(handler *gin.RouterGroup, t enrollment.Feature, l logger.Interface) {
+	r := &enrollmentRoutes{t, l}
+
+	h := handler.Group("/enrollmenttokens")
+	{
+		h.GET("", r.get)
+		h.POST("", r.mint)
+		h.DELETE(":id", r.delete)
+	}
+}
+
+// NewEnrollRoutes registers the route the provisioning agents enroll the devices with. It is authenticated by
+// the enrollment token of its body, not by an access token.
+func NewEnrollRoutes(handler *gin.RouterGroup, t enrollment.Feature, l logger.Interface) {
+	r := &enrollmentRoutes{t, l}
+
+	handler.POST("/enroll", r.enroll)
+}
+
+func (r *enrollmentRoutes) get(c *gin.Context) {
+	tokens, err := r.t.Get(c.Request.Context(), "")
+	if err != nil {
+		r.l.Error(err, "http - v1 - enrollment - get")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+// mint creates an enrollment token, returned once.
+func (r *enrollmentRoutes) mint(c *gin.Context) {
+	var req dto.EnrollmentTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, ErrValidationEnrollment.Wrap("mint", "ShouldBindJSON", err))
+
+		return
+	}
+
+	token, err := r.t.Mint(c.Request.Context(), "", c.GetString(ContextKeyUser), req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - enrollment - mint")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	// the token must not be kept by the proxies and browsers on the way
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, token)
+}
+
+func (r *enrollmentRoutes) delete(c *gin.Context) {
+	if err := r.t.Delete(c.Request.Context(), c.Param("id"), ""); err != nil {
+		r.l.Error(err, "http - v1 - enrollment - delete")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// enroll creates the device of a provisioning agent presenting an enrollment token and returns its CIRA settings.
+func (r *enrollmentRoutes) enroll(c *gin.Context) {
+	var req dto.EnrollmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, ErrValidationEnrollment.Wrap("enroll", "ShouldBindJSON", err))
+
+		return
+	}
+
+	res, err := r.t.Enroll(c.Request.Context(), req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - enrollment - enroll")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	// the MPS password of the device must not be kept on the way
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, res)
+}
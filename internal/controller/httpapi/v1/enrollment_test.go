@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/enrollment"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestEnrollmentRoutes(t *testing.T) {
+	t.Parallel()
+
+	enrollReq := dto.EnrollmentRequest{
+		Token:    "secret",
+		GUID:     "123e4567-e89b-12d3-a456-426614174000",
+		Hostname: "frontdesk-01",
+		Password: "P@ssw0rd",
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		url           string
+		body          string
+		mock          func(m *mocks.MockEnrollmentFeature)
+		expectedCode  int
+		expectedBody  string
+		expectNoStore bool
+	}{
+		{
+			name:   "mint",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/enrollmenttokens",
+			body:   `{"ciraConfig":"cira","tags":["lab"]}`,
+			mock: func(m *mocks.MockEnrollmentFeature) {
+				m.EXPECT().Mint(context.Background(), "", "", dto.EnrollmentTokenRequest{CIRAConfig: "cira", Tags: []string{"lab"}}).
+					Return(dto.EnrollmentToken{ID: "token1", Token: "secret", CIRAConfig: "cira"}, nil)
+			},
+			expectedCode:  http.StatusCreated,
+			expectedBody:  `"token":"secret"`,
+			expectNoStore: true,
+		},
+		{
+			name:   "list",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/enrollmenttokens",
+			mock: func(m *mocks.MockEnrollmentFeature) {
+				m.EXPECT().Get(context.Background(), "").Return([]dto.EnrollmentToken{{ID: "token1", CIRAConfig: "cira"}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"id":"token1"`,
+		},
+		{
+			name:   "revoke unknown token",
+			method: http.MethodDelete,
+			url:    "/api/v1/admin/enrollmenttokens/missing",
+			mock: func(m *mocks.MockEnrollmentFeature) {
+				m.EXPECT().Delete(context.Background(), "missing", "").Return(enrollment.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "enroll",
+			method: http.MethodPost,
+			url:    "/api/v1/enroll",
+			body:   `{"token":"secret","guid":"123e4567-e89b-12d3-a456-426614174000","hostname":"frontdesk-01","password":"P@ssw0rd"}`,
+			mock: func(m *mocks.MockEnrollmentFeature) {
+				m.EXPECT().Enroll(context.Background(), enrollReq).
+					Return(dto.Enrollment{GUID: enrollReq.GUID, MPSAddress: "console.example.com", Username: "mpsuser", Password: "Xk3#pQ9!vL2@mN7$"}, nil)
+			},
+			expectedCode:  http.StatusCreated,
+			expectedBody:  `"mpsServerAddress":"console.example.com"`,
+			expectNoStore: true,
+		},
+		{
+			name:   "enroll with an invalid token",
+			method: http.MethodPost,
+			url:    "/api/v1/enroll",
+			body:   `{"token":"secret","guid":"123e4567-e89b-12d3-a456-426614174000","hostname":"frontdesk-01","password":"P@ssw0rd"}`,
+			mock: func(m *mocks.MockEnrollmentFeature) {
+				m.EXPECT().Enroll(context.Background(), enrollReq).Return(dto.Enrollment{}, enrollment.TokenError{})
+			},
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: `"code":"UNAUTHORIZED"`,
+		},
+		{
+			name:         "enroll with a malformed body",
+			method:       http.MethodPost,
+			url:          "/api/v1/enroll",
+			body:         `{"token":`,
+			mock:         func(*mocks.MockEnrollmentFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			enrollmentFeature := mocks.NewMockEnrollmentFeature(mockCtl)
+			tc.mock(enrollmentFeature)
+
+			engine := gin.New()
+			NewEnrollmentTokenRoutes(engine.Group("/api/v1/admin"), enrollmentFeature, logger.New("error"))
+			NewEnrollRoutes(engine.Group("/api/v1"), enrollmentFeature, logger.New("error"))
+
+			req, err := http.NewRequest(tc.method, tc.url, bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+
+			if tc.expectNoStore {
+				assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+			}
+		})
+	}
+}
@@ -4,23 +4,58 @@ import (
 	"errors"
 	"net"
 	"net/http"
-	"strings"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/checkouts"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
 	"github.com/device-management-toolkit/console/internal/usecase/domains"
+	"github.com/device-management-toolkit/console/internal/usecase/enrollment"
+	"github.com/device-management-toolkit/console/internal/usecase/jobs"
 	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+	"github.com/device-management-toolkit/console/pkg/openapivalidate"
 )
 
 type response struct {
-	Error   string `json:"error,omitempty" example:"message"`
-	Message string `json:"message,omitempty" example:"message"`
+	Error   string             `json:"error,omitempty" example:"message"`
+	Message string             `json:"message,omitempty" example:"message"`
+	Code    consoleerrors.Code `json:"code,omitempty" example:"DEVICE_NOT_FOUND"`
+	// AMTStatus explains the return code of AMT for the operations the device refused
+	AMTStatus *amtstatus.Explanation `json:"amtStatus,omitempty"`
+	// Fields lists the properties of a request body not matching the OpenAPI document
+	Fields []openapivalidate.FieldError `json:"fields,omitempty"`
 }
 
+// abortWithError ends the request with an error body carrying msg and the machine-readable code.
+func abortWithError(c *gin.Context, status int, code consoleerrors.Code, msg string) {
+	c.AbortWithStatusJSON(status, response{Error: msg, Message: msg, Code: code})
+}
+
+// ErrorResponse ends the request with the error body of the response err maps to.
 func ErrorResponse(c *gin.Context, err error) {
+	e := MapError(c, err)
+
+	c.AbortWithStatusJSON(e.Status, response{Error: e.Message, Message: e.Message, Code: e.Code, AMTStatus: e.AMTStatus})
+}
+
+// MappedError is the HTTP status, the machine-readable code and the message an error is answered with.
+type MappedError struct {
+	Status    int
+	Code      consoleerrors.Code
+	Message   string
+	AMTStatus *amtstatus.Explanation
+}
+
+// MapError maps err to the response it is answered with, setting the Retry-After header of the errors
+// that clear with time. The v2 API answers its errors from the same mapping.
+func MapError(c *gin.Context, err error) MappedError {
 	var (
 		validatorErr    validator.ValidationErrors
 		nfErr           sqldb.NotFoundError
@@ -29,96 +64,132 @@ func ErrorResponse(c *gin.Context, err error) {
 		NotUniqueErr    sqldb.NotUniqueError
 		amtErr          devices.AMTError
 		notSupportedErr devices.NotSupportedError
+		validationErr   devices.ValidationError
 		certExpErr      domains.CertExpirationError
 		certPasswordErr domains.CertPasswordError
+		certRevokedErr  domains.CertRevokedError
+		circuitErr      wsman.CircuitOpenError
+		queueFullErr    jobs.QueueFullError
+		lockedErr       devices.LockedError
+		checkedOutErr   checkouts.CheckedOutError
+		forbiddenErr    checkouts.ForbiddenError
+		tokenErr        enrollment.TokenError
+		unauthorizedErr devices.UnauthorizedError
+		unreachableErr  devices.UnreachableError
+		amtReturnedErr  devices.AMTReturnedError
+		amtStatusErr    amtstatus.Error
 		netErr          net.Error
+		maxBytesErr     *http.MaxBytesError
 	)
 
 	switch {
+	case errors.As(err, &maxBytesErr):
+		return bodyTooLargeError(c)
+	case errors.As(err, &circuitErr):
+		c.Header("Retry-After", strconv.Itoa(circuitErr.RetryAfterSeconds()))
+
+		return MappedError{http.StatusServiceUnavailable, circuitErr.ErrorCode(), circuitErr.Error(), nil}
+	case errors.As(err, &queueFullErr):
+		status := http.StatusServiceUnavailable
+
+		if queueFullErr.Tenant {
+			status = http.StatusTooManyRequests
+		}
+
+		c.Header("Retry-After", strconv.Itoa(queueFullErr.RetryAfterSeconds()))
+
+		return MappedError{status, queueFullErr.ErrorCode(), queueFullErr.Error(), nil}
+	case errors.As(err, &lockedErr):
+		return MappedError{http.StatusConflict, lockedErr.ErrorCode(), lockedErr.Error(), nil}
+	case errors.As(err, &checkedOutErr):
+		return MappedError{http.StatusConflict, checkedOutErr.ErrorCode(), checkedOutErr.Error(), nil}
+	case errors.As(err, &forbiddenErr):
+		return MappedError{http.StatusForbidden, forbiddenErr.ErrorCode(), forbiddenErr.Error(), nil}
+	case errors.As(err, &tokenErr):
+		return MappedError{http.StatusUnauthorized, tokenErr.ErrorCode(), tokenErr.Error(), nil}
+	case errors.As(err, &unauthorizedErr):
+		return MappedError{http.StatusBadGateway, unauthorizedErr.ErrorCode(), unauthorizedErr.Console.FriendlyMessage(), nil}
+	case errors.As(err, &unreachableErr):
+		return MappedError{http.StatusGatewayTimeout, unreachableErr.ErrorCode(), unreachableErr.Console.FriendlyMessage(), nil}
+	case errors.As(err, &amtStatusErr):
+		return MappedError{http.StatusBadRequest, amtStatusErr.ErrorCode(), amtStatusErr.Error(), &amtStatusErr.Explanation}
+	case errors.As(err, &amtReturnedErr):
+		return MappedError{http.StatusBadRequest, amtReturnedErr.ErrorCode(), amtReturnedErr.Console.FriendlyMessage(), nil}
 	case errors.As(err, &netErr):
-		netErrorHandle(c, netErr)
+		return MappedError{http.StatusGatewayTimeout, consoleerrors.CodeOf(netErr), netErr.Error(), nil}
 	case errors.As(err, &notValidErr):
-		notValidErrorHandle(c, notValidErr)
+		return notValidError(c, notValidErr)
 	case errors.As(err, &validatorErr):
-		validatorErrorHandle(c, validatorErr)
+		return MappedError{http.StatusBadRequest, consoleerrors.CodeValidation, i18n.ValidationMessage(i18n.Language(c), validatorErr), nil}
 	case errors.As(err, &nfErr):
-		notFoundErrorHandle(c, nfErr)
+		return notFoundError(c, nfErr)
 	case errors.As(err, &NotUniqueErr):
-		notUniqueErrorHandle(c, NotUniqueErr)
+		return notUniqueError(NotUniqueErr)
 	case errors.As(err, &dbErr):
-		dbErrorHandle(c, dbErr)
+		return dbError(dbErr)
 	case errors.As(err, &amtErr):
-		amtErrorHandle(c, amtErr)
+		return amtError(amtErr)
 	case errors.As(err, &notSupportedErr):
-		msg := notSupportedErr.Console.FriendlyMessage()
-		c.AbortWithStatusJSON(http.StatusNotImplemented, response{Error: msg, Message: msg})
+		return MappedError{http.StatusNotImplemented, notSupportedErr.ErrorCode(), notSupportedErr.Console.FriendlyMessage(), nil}
+	case errors.As(err, &validationErr):
+		return MappedError{http.StatusBadRequest, validationErr.ErrorCode(), validationErr.Console.FriendlyMessage(), nil}
 	case errors.As(err, &certExpErr):
-		msg := certExpErr.Console.FriendlyMessage()
-		c.AbortWithStatusJSON(http.StatusBadRequest, response{Error: msg, Message: msg})
+		return MappedError{http.StatusBadRequest, certExpErr.ErrorCode(), certExpErr.Console.FriendlyMessage(), nil}
 	case errors.As(err, &certPasswordErr):
-		msg := certPasswordErr.Console.FriendlyMessage()
-		c.AbortWithStatusJSON(http.StatusBadRequest, response{Error: msg, Message: msg})
+		return MappedError{http.StatusBadRequest, certPasswordErr.ErrorCode(), certPasswordErr.Console.FriendlyMessage(), nil}
+	case errors.As(err, &certRevokedErr):
+		return MappedError{http.StatusBadRequest, certRevokedErr.ErrorCode(), certRevokedErr.Console.FriendlyMessage(), nil}
 	default:
-		c.AbortWithStatusJSON(http.StatusInternalServerError, response{Error: "general error", Message: "general error"})
+		return MappedError{http.StatusInternalServerError, consoleerrors.CodeOf(err), i18n.T(i18n.Language(c), "error.general"), nil}
 	}
 }
 
-func netErrorHandle(c *gin.Context, netErr net.Error) {
-	msg := netErr.Error()
-	c.AbortWithStatusJSON(http.StatusGatewayTimeout, response{Error: msg, Message: msg})
-}
+func notValidError(c *gin.Context, err dto.NotValidError) MappedError {
+	var maxBytesErr *http.MaxBytesError
 
-func notValidErrorHandle(c *gin.Context, err dto.NotValidError) {
-	msg := err.Console.FriendlyMessage()
-	c.AbortWithStatusJSON(http.StatusBadRequest, response{Error: msg, Message: msg})
-}
+	// the body was cut short by BodyLimitMiddleware while being bound
+	if errors.As(err.Console.OriginalError, &maxBytesErr) {
+		return bodyTooLargeError(c)
+	}
 
-func validatorErrorHandle(c *gin.Context, err validator.ValidationErrors) {
-	msg := err.Error()
-	c.AbortWithStatusJSON(http.StatusBadRequest, response{Error: msg, Message: msg})
+	return MappedError{http.StatusBadRequest, err.ErrorCode(), err.Console.FriendlyMessage(), nil}
 }
 
-func notFoundErrorHandle(c *gin.Context, err sqldb.NotFoundError) {
-	message := "Error not found"
+func notFoundError(c *gin.Context, err sqldb.NotFoundError) MappedError {
+	message := i18n.T(i18n.Language(c), "error.notFound")
 	if err.Console.FriendlyMessage() != "" {
 		message = err.Console.FriendlyMessage()
 	}
 
-	c.AbortWithStatusJSON(http.StatusNotFound, response{Error: message, Message: message})
+	return MappedError{http.StatusNotFound, err.ErrorCode(), message, nil}
 }
 
-func dbErrorHandle(c *gin.Context, err sqldb.DatabaseError) {
+func dbError(err sqldb.DatabaseError) MappedError {
 	var notUniqueErr sqldb.NotUniqueError
 
 	var foreignKeyViolationErr sqldb.ForeignKeyViolationError
 
 	if errors.As(err.Console.OriginalError, &notUniqueErr) {
-		notUniqueErrorHandle(c, notUniqueErr)
-
-		return
+		return notUniqueError(notUniqueErr)
 	}
 
 	if errors.As(err.Console.OriginalError, &foreignKeyViolationErr) {
-		msg := foreignKeyViolationErr.Console.FriendlyMessage()
-		c.AbortWithStatusJSON(http.StatusBadRequest, response{Error: msg, Message: msg})
-
-		return
+		return MappedError{http.StatusBadRequest, foreignKeyViolationErr.ErrorCode(), foreignKeyViolationErr.Console.FriendlyMessage(), nil}
 	}
 
-	msg := err.Console.FriendlyMessage()
-	c.AbortWithStatusJSON(http.StatusBadRequest, response{Error: msg, Message: msg})
+	return MappedError{http.StatusBadRequest, err.ErrorCode(), err.Console.FriendlyMessage(), nil}
 }
 
-func amtErrorHandle(c *gin.Context, err devices.AMTError) {
-	msg := err.Console.FriendlyMessage()
-	if strings.Contains(err.Console.Error(), "400 Bad Request") {
-		c.AbortWithStatusJSON(http.StatusBadRequest, response{Error: msg, Message: msg})
-	} else {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, response{Error: msg, Message: msg})
+func amtError(err devices.AMTError) MappedError {
+	var returnedErr devices.AMTReturnedError
+
+	if errors.As(err.Console.OriginalError, &returnedErr) {
+		return MappedError{http.StatusBadRequest, err.ErrorCode(), err.Console.FriendlyMessage(), nil}
 	}
+
+	return MappedError{http.StatusInternalServerError, err.ErrorCode(), err.Console.FriendlyMessage(), nil}
 }
 
-func notUniqueErrorHandle(c *gin.Context, err sqldb.NotUniqueError) {
-	msg := err.Console.FriendlyMessage()
-	c.AbortWithStatusJSON(http.StatusBadRequest, response{Error: msg, Message: msg})
+func notUniqueError(err sqldb.NotUniqueError) MappedError {
+	return MappedError{http.StatusBadRequest, err.ErrorCode(), err.Console.FriendlyMessage(), nil}
 }
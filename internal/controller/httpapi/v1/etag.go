@@ -0,0 +1,176 @@
+package v1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// snapshotTTL is how long the hardware information read from a device is answered from its snapshot.
+	snapshotTTL = time.Minute
+
+	snapshotSweepInterval = time.Minute
+)
+
+// jsonWithETag responds with obj as JSON along with a weak ETag, the hash of its JSON encoding.
+// A request whose If-None-Match names the ETag gets 304 Not Modified without a body.
+func jsonWithETag(c *gin.Context, code int, obj any) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	sum := sha256.Sum256(data)
+
+	jsonDataWithETag(c, code, `W/"`+base64.RawURLEncoding.EncodeToString(sum[:16])+`"`, data)
+}
+
+func jsonDataWithETag(c *gin.Context, code int, etag string, data []byte) {
+	c.Header("ETag", etag)
+	// the response may be stored, but must be revalidated before it is reused
+	c.Header("Cache-Control", "private, no-cache")
+
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+
+		return
+	}
+
+	c.Data(code, "application/json; charset=utf-8", data)
+}
+
+// snapshot is the JSON of a response read from a device, its ETag naming its version.
+type snapshot struct {
+	etag    string
+	data    []byte
+	expires time.Time
+}
+
+// snapshotStore keeps the last response read from each device for a route, for the UIs polling the
+// hardware information of a device. While a snapshot is fresh it is answered, or a request naming its
+// ETag gets 304 Not Modified, without calling the device or encoding the response again.
+type snapshotStore struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	epoch     string
+	version   uint64
+	snapshots map[string]*snapshot
+}
+
+func newSnapshotStore(ttl time.Duration) *snapshotStore {
+	return &snapshotStore{
+		ttl: ttl,
+		// the versions start over with the console, the epoch keeps the ETags of a previous run from matching
+		epoch:     strconv.FormatInt(time.Now().UnixNano(), 36),
+		snapshots: map[string]*snapshot{},
+	}
+}
+
+// fresh returns the snapshot of key, if it has not expired.
+func (s *snapshotStore) fresh(key string, now time.Time) (snapshot, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap, ok := s.snapshots[key]
+	if !ok || !now.Before(snap.expires) {
+		return snapshot{}, false
+	}
+
+	return *snap, true
+}
+
+// update stores the JSON just read for key. Its version, and so its ETag, only changes with the JSON.
+func (s *snapshotStore) update(key string, data []byte, now time.Time) snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap, ok := s.snapshots[key]
+	if !ok || !bytes.Equal(snap.data, data) {
+		s.version++
+
+		snap = &snapshot{etag: `W/"` + s.epoch + "-" + strconv.FormatUint(s.version, 36) + `"`, data: data}
+		s.snapshots[key] = snap
+	}
+
+	snap.expires = now.Add(s.ttl)
+
+	return *snap
+}
+
+// sweepEvery drops the expired snapshots at each interval, for as long as the server runs.
+func (s *snapshotStore) sweepEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		s.sweep(now)
+	}
+}
+
+func (s *snapshotStore) sweep(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, snap := range s.snapshots {
+		if !now.Before(snap.expires) {
+			delete(s.snapshots, key)
+		}
+	}
+}
+
+// jsonFromSnapshot responds with the snapshot of key while it is fresh, and otherwise with the JSON of what
+// read returns, kept as the new snapshot. A request whose If-None-Match names the ETag of the snapshot gets
+// 304 Not Modified, so that the UIs polling a device transfer its hardware information only when it changed.
+func jsonFromSnapshot(c *gin.Context, s *snapshotStore, key string, read func() (any, error)) {
+	now := time.Now()
+
+	if snap, ok := s.fresh(key, now); ok {
+		jsonDataWithETag(c, http.StatusOK, snap.etag, snap.data)
+
+		return
+	}
+
+	obj, err := read()
+	if err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	snap := s.update(key, data, now)
+
+	jsonDataWithETag(c, http.StatusOK, snap.etag, snap.data)
+}
+
+// etagMatches implements the weak comparison of If-None-Match, RFC 9110 section 13.1.2.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func TestHardwareInfoETag(t *testing.T) {
+	t.Parallel()
+
+	deviceManagement, engine := deviceManagementTest(t)
+
+	hwInfo := dto.HardwareInfo{CIMChassis: dto.CIMResponse{Response: map[string]interface{}{"Manufacturer": "Intel"}}}
+	// the device is read once, the other requests are answered from its snapshot
+	deviceManagement.EXPECT().GetHardwareInfo(context.Background(), "valid-guid").Return(hwInfo, nil).Times(1)
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, "/api/v1/amt/hardwareInfo/valid-guid", http.NoBody)
+		require.NoError(t, err)
+
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+
+		return w
+	}
+
+	w := get("")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"Manufacturer":"Intel"`)
+
+	etag := w.Header().Get("ETag")
+	require.Regexp(t, `^W/"[A-Za-z0-9_-]+"$`, etag)
+
+	w = get(etag)
+	require.Equal(t, http.StatusNotModified, w.Code)
+	require.Empty(t, w.Body.String())
+	require.Equal(t, etag, w.Header().Get("ETag"))
+
+	// strong and weak forms compare equal, any of several tags matches
+	w = get(`"other", ` + etag[2:])
+	require.Equal(t, http.StatusNotModified, w.Code)
+
+	w = get(`W/"stale"`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, etag, w.Header().Get("ETag"))
+}
+
+func TestSnapshotStore(t *testing.T) {
+	t.Parallel()
+
+	s := newSnapshotStore(time.Minute)
+	now := time.Now()
+
+	_, ok := s.fresh("key", now)
+	require.False(t, ok)
+
+	first := s.update("key", []byte(`{"a":1}`), now)
+
+	snap, ok := s.fresh("key", now.Add(59*time.Second))
+	require.True(t, ok)
+	require.Equal(t, first, snap)
+
+	_, ok = s.fresh("key", now.Add(time.Minute))
+	require.False(t, ok, "a snapshot expires after the ttl")
+
+	// the same JSON read again keeps its ETag, a change makes a new one
+	later := now.Add(2 * time.Minute)
+	require.Equal(t, first.etag, s.update("key", []byte(`{"a":1}`), later).etag)
+
+	changed := s.update("key", []byte(`{"a":2}`), later)
+	require.NotEqual(t, first.etag, changed.etag)
+	require.NotEqual(t, changed.etag, newSnapshotStore(time.Minute).update("key", []byte(`{"a":2}`), later).etag,
+		"the ETags of another run never match")
+
+	s.sweep(later.Add(time.Minute))
+	require.Empty(t, s.snapshots)
+}
+
+func TestEtagMatches(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, etagMatches("*", `W/"a"`))
+	require.True(t, etagMatches(`W/"a"`, `W/"a"`))
+	require.True(t, etagMatches(`"b", "a"`, `W/"a"`))
+	require.False(t, etagMatches(`"b"`, `W/"a"`))
+	require.False(t, etagMatches("", `W/"a"`))
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// fields returns the top-level fields selected by the fields query parameter, a comma-separated
+// list such as ?fields=CIM_Chassis,CIM_Processor, none when it is missing.
+func fields(c *gin.Context) []string {
+	var selection []string
+
+	for _, field := range strings.Split(c.Query("fields"), ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			selection = append(selection, field)
+		}
+	}
+
+	return selection
+}
+
+// selectFields keeps the given top-level JSON fields of obj, all of them when none is given.
+func selectFields(obj any, selection []string) (any, error) {
+	if len(selection) == 0 {
+		return obj, nil
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	kept := make(map[string]json.RawMessage, len(selection))
+
+	for _, field := range selection {
+		if value, ok := all[field]; ok {
+			kept[field] = value
+		}
+	}
+
+	return kept, nil
+}
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestFieldSelection(t *testing.T) {
+	t.Parallel()
+
+	chassis := dto.CIMResponse{Response: map[string]interface{}{"Manufacturer": "Intel"}}
+
+	tests := []struct {
+		name         string
+		url          string
+		mock         func(m *mocks.MockDeviceManagementFeature, c *mocks.MockCorrelationsFeature)
+		expectedCode int
+		expectedKeys []string
+	}{
+		{
+			name: "hardware info sections",
+			url:  "/api/v1/amt/hardwareInfo/valid-guid?fields=CIM_Chassis,%20CIM_Processor",
+			mock: func(m *mocks.MockDeviceManagementFeature, _ *mocks.MockCorrelationsFeature) {
+				m.EXPECT().GetHardwareInfo(context.Background(), "valid-guid", "CIM_Chassis", "CIM_Processor").
+					Return(dto.HardwareInfo{CIMChassis: chassis}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedKeys: []string{"CIM_Chassis", "CIM_Processor"},
+		},
+		{
+			name: "unknown hardware info section",
+			url:  "/api/v1/amt/hardwareInfo/valid-guid?fields=CIM_Fan",
+			mock: func(m *mocks.MockDeviceManagementFeature, _ *mocks.MockCorrelationsFeature) {
+				m.EXPECT().GetHardwareInfo(context.Background(), "valid-guid", "CIM_Fan").
+					Return(dto.HardwareInfo{}, devices.ErrValidationUseCase.Wrap("GetHardwareInfo", "validateSelection", "unknown field CIM_Fan"))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "network settings",
+			url:  "/api/v1/amt/networkSettings/valid-guid?fields=wired",
+			mock: func(m *mocks.MockDeviceManagementFeature, _ *mocks.MockCorrelationsFeature) {
+				m.EXPECT().GetNetworkSettings(context.Background(), "valid-guid", "wired").
+					Return(dto.NetworkSettings{Wired: &dto.WiredNetworkInfo{}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedKeys: []string{"wired"},
+		},
+		{
+			name: "device detail without correlations",
+			url:  "/api/v1/devices/valid-guid?fields=guid,hostname",
+			mock: func(m *mocks.MockDeviceManagementFeature, _ *mocks.MockCorrelationsFeature) {
+				m.EXPECT().GetByID(context.Background(), "valid-guid", "", false).
+					Return(&dto.Device{GUID: "valid-guid", Hostname: "host", FriendlyName: "name"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedKeys: []string{"guid", "hostname"},
+		},
+		{
+			name: "device detail with correlations",
+			url:  "/api/v1/devices/valid-guid?fields=guid,correlations",
+			mock: func(m *mocks.MockDeviceManagementFeature, c *mocks.MockCorrelationsFeature) {
+				m.EXPECT().GetByID(context.Background(), "valid-guid", "", false).
+					Return(&dto.Device{GUID: "valid-guid", Hostname: "host"}, nil)
+				c.EXPECT().GetByGUID(context.Background(), "valid-guid", "").
+					Return([]dto.DeviceCorrelation{{Source: "intune"}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedKeys: []string{"guid", "correlations"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			device := mocks.NewMockDeviceManagementFeature(mockCtl)
+			correlation := mocks.NewMockCorrelationsFeature(mockCtl)
+			tc.mock(device, correlation)
+
+			engine := gin.New()
+			handler := engine.Group("/api/v1")
+			NewDeviceRoutes(handler, device, correlation, logger.New("error"))
+			NewAmtRoutes(handler, device, mocks.NewMockAMTExplorerFeature(mockCtl), mocks.NewMockExporter(mockCtl), logger.New("error"))
+
+			req, err := http.NewRequest(http.MethodGet, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+
+			if tc.expectedKeys == nil {
+				return
+			}
+
+			var body map[string]json.RawMessage
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+			keys := make([]string, 0, len(body))
+			for key := range body {
+				keys = append(keys, key)
+			}
+
+			require.ElementsMatch(t, tc.expectedKeys, keys)
+		})
+	}
+}
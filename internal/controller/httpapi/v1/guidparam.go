@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/guid"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+)
+
+// GUIDParamMiddleware puts the :guid parameter of the route in the canonical form of the device GUIDs, so that a
+// device is found whatever the case, hyphenation or braces it is addressed with. A parameter that is not a GUID is
+// refused with 400 Bad Request.
+func GUIDParamMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for i := range c.Params {
+			if c.Params[i].Key != "guid" {
+				continue
+			}
+
+			id, err := guid.Parse(c.Params[i].Value)
+			if err != nil {
+				abortWithError(c, http.StatusBadRequest, consoleerrors.CodeValidation, i18n.T(i18n.Language(c), "error.invalidGUID"))
+
+				return
+			}
+
+			c.Params[i].Value = id
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+func TestGUIDParamMiddleware(t *testing.T) {
+	t.Parallel()
+
+	engine := gin.New()
+	engine.Use(GUIDParamMiddleware())
+	engine.GET("/devices/:guid", func(c *gin.Context) { c.String(http.StatusOK, c.Param("guid")) })
+	engine.GET("/jobs/:id", func(c *gin.Context) { c.String(http.StatusOK, c.Param("id")) })
+
+	tests := []struct {
+		target string
+		code   int
+		body   string
+	}{
+		{target: "/devices/123e4567-e89b-12d3-a456-426614174000", code: http.StatusOK, body: "123e4567-e89b-12d3-a456-426614174000"},
+		{target: "/devices/123E4567-E89B-12D3-A456-426614174000", code: http.StatusOK, body: "123e4567-e89b-12d3-a456-426614174000"},
+		{target: "/devices/123e4567e89b12d3a456426614174000", code: http.StatusOK, body: "123e4567-e89b-12d3-a456-426614174000"},
+		{target: "/devices/%7B123e4567-e89b-12d3-a456-426614174000%7D", code: http.StatusOK, body: "123e4567-e89b-12d3-a456-426614174000"},
+		{target: "/devices/not-a-guid", code: http.StatusBadRequest},
+		{target: "/jobs/not-a-guid", code: http.StatusOK, body: "not-a-guid"},
+	}
+
+	for _, tc := range tests {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.target, http.NoBody))
+
+		assert.Equal(t, tc.code, w.Code, tc.target)
+
+		if tc.code == http.StatusOK {
+			assert.Equal(t, tc.body, w.Body.String(), tc.target)
+		} else {
+			assert.Contains(t, w.Body.String(), string(consoleerrors.CodeValidation), tc.target)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -9,31 +10,34 @@ import (
 func (r *deviceManagementRoutes) getHardwareInfo(c *gin.Context) {
 	guid := c.Param("guid")
 
-	hwInfo, err := r.d.GetHardwareInfo(c.Request.Context(), guid)
-	if err != nil {
-		r.l.Error(err, "http - v1 - getHardwareInfo")
-		ErrorResponse(c, err)
+	selection := fields(c)
 
-		return
-	}
+	jsonFromSnapshot(c, r.snapshots, c.Request.URL.Path+"?"+strings.Join(selection, ","), func() (any, error) {
+		hwInfo, err := r.d.GetHardwareInfo(c.Request.Context(), guid, selection...)
+		if err != nil {
+			r.l.Error(err, "http - v1 - getHardwareInfo")
+
+			return nil, err
+		}
 
-	c.JSON(http.StatusOK, hwInfo)
+		return selectFields(hwInfo, selection)
+	})
 }
 
 func (r *deviceManagementRoutes) getDiskInfo(c *gin.Context) {
 	guid := c.Param("guid")
 
-	diskInfo, err := r.d.GetDiskInfo(c.Request.Context(), guid)
-	if err != nil {
-		r.l.Error(err, "http - v1 - getHardwareInfo")
-		ErrorResponse(c, err)
+	jsonFromSnapshot(c, r.snapshots, c.Request.URL.Path, func() (any, error) {
+		diskInfo, err := r.d.GetDiskInfo(c.Request.Context(), guid)
+		if err != nil {
+			r.l.Error(err, "http - v1 - getHardwareInfo")
 
-		return
-	}
+			return nil, err
+		}
 
-	c.JSON(http.StatusOK, diskInfo)
+		return diskInfo, nil
+	})
 }
-
 func (r *deviceManagementRoutes) getGeneralSettings(c *gin.Context) {
 	guid := c.Param("guid")
 
@@ -0,0 +1,250 @@
+package v1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+)
+
+const (
+	// IdempotencyKeyHeader names the header a client sets to make retrying a POST safe.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotencyTTL is how long the response to a request with an Idempotency-Key is replayed.
+	IdempotencyTTL = 24 * time.Hour
+	// IdempotencyInProgressTTL is how long a request with an Idempotency-Key holds its key while it runs,
+	// a retry after it runs the handler again.
+	IdempotencyInProgressTTL = 5 * time.Minute
+
+	idempotencySweepInterval = time.Minute
+
+	maxIdempotencyKey = 255
+)
+
+// replayedHeaders are the response headers stored with a response and sent again when it is replayed.
+var replayedHeaders = []string{"Content-Type", "Location", "ETag"}
+
+// idempotentRequest is a request seen with an Idempotency-Key, and once it is done its response. It
+// expires IdempotencyInProgressTTL after it started while it runs, the ttl after it is done.
+type idempotentRequest struct {
+	fingerprint [sha256.Size]byte
+	done        bool
+	expires     time.Time
+
+	status int
+	header http.Header
+	body   []byte
+}
+
+// IdempotencyMiddleware makes POST requests carrying an Idempotency-Key safe to retry. The response
+// to the first request with a key is kept for ttl and sent again, with Idempotent-Replayed set, to
+// the retries of the same user with the same key, path and body, without running the handler again.
+// A key reused for a different body is rejected, as is a retry while the first request is running,
+// for up to IdempotencyInProgressTTL. Server errors, panics and responses marked no-store, such as a
+// revealed password, are not kept, so the retry runs again.
+func IdempotencyMiddleware(ttl time.Duration) gin.HandlerFunc {
+	store := &idempotencyStore{requests: map[string]*idempotentRequest{}}
+
+	go store.sweepEvery(idempotencySweepInterval)
+
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+
+			return
+		}
+
+		if len(key) > maxIdempotencyKey {
+			idempotencyError(c, http.StatusBadRequest, "error.idempotencyKeyInvalid")
+
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				bodyTooLarge(c)
+
+				return
+			}
+
+			ErrorResponse(c, err)
+
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		id := c.GetString(ContextKeyUser) + " " + c.Request.URL.Path + " " + key
+		fingerprint := sha256.Sum256(body)
+
+		seen, started := store.start(id, fingerprint, time.Now())
+
+		if seen != nil {
+			switch {
+			case seen.fingerprint != fingerprint:
+				idempotencyError(c, http.StatusUnprocessableEntity, "error.idempotencyKeyReused")
+			case !seen.done:
+				idempotencyError(c, http.StatusConflict, "error.idempotencyKeyInProgress")
+			default:
+				replay(c, seen)
+			}
+
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		handled := false
+
+		// the key is released even when the handler panics, so that the retry runs again
+		defer func() {
+			c.Writer = recorder.ResponseWriter
+
+			store.complete(id, started, recorder, handled, ttl)
+		}()
+
+		c.Next()
+
+		handled = true
+	}
+}
+
+// idempotencyStore keeps the requests seen with an Idempotency-Key by user, path and key.
+type idempotencyStore struct {
+	mu       sync.Mutex
+	requests map[string]*idempotentRequest
+}
+
+// start returns the live request seen with id, or records a new one in progress and returns it as started.
+func (s *idempotencyStore) start(id string, fingerprint [sha256.Size]byte, now time.Time) (seen, started *idempotentRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, ok := s.requests[id]; ok && now.Before(r.expires) {
+		return r, nil
+	}
+
+	started = &idempotentRequest{fingerprint: fingerprint, expires: now.Add(IdempotencyInProgressTTL)}
+	s.requests[id] = started
+
+	return nil, started
+}
+
+// complete keeps the response of the request started with id, or forgets the request after a server
+// error, a panic or a response that must not be stored. A request taken over once it was in progress
+// for too long is left alone.
+func (s *idempotencyStore) complete(id string, started *idempotentRequest, recorder *responseRecorder, handled bool, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.requests[id] != started {
+		return
+	}
+
+	status := recorder.Status()
+	if !handled || status >= http.StatusInternalServerError || noStore(recorder.Header()) {
+		delete(s.requests, id)
+
+		return
+	}
+
+	header := http.Header{}
+
+	for _, name := range replayedHeaders {
+		if value := recorder.Header().Get(name); value != "" {
+			header.Set(name, value)
+		}
+	}
+
+	s.requests[id] = &idempotentRequest{
+		fingerprint: started.fingerprint,
+		done:        true,
+		expires:     time.Now().Add(ttl),
+		status:      status,
+		header:      header,
+		body:        recorder.body.Bytes(),
+	}
+}
+
+// noStore reports whether the response forbids keeping a copy of it.
+func noStore(header http.Header) bool {
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		if strings.EqualFold(strings.TrimSpace(directive), "no-store") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sweepEvery drops the expired requests at each interval, for as long as the server runs.
+func (s *idempotencyStore) sweepEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		s.sweep(now)
+	}
+}
+
+func (s *idempotencyStore) sweep(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, r := range s.requests {
+		if !now.Before(r.expires) {
+			delete(s.requests, k)
+		}
+	}
+}
+
+func replay(c *gin.Context, r *idempotentRequest) {
+	for name, values := range r.header {
+		c.Header(name, values[0])
+	}
+
+	c.Header("Idempotent-Replayed", "true")
+	c.Status(r.status)
+
+	if len(r.body) > 0 {
+		_, _ = c.Writer.Write(r.body) //nolint:errcheck // the client went away
+	}
+
+	c.Abort()
+}
+
+func idempotencyError(c *gin.Context, status int, key string) {
+	abortWithError(c, status, consoleerrors.CodeIdempotencyConflict, i18n.T(i18n.Language(c), key))
+}
+
+// responseRecorder keeps a copy of the response written by the handler.
+type responseRecorder struct {
+	gin.ResponseWriter
+
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+
+	return w.ResponseWriter.WriteString(s)
+}
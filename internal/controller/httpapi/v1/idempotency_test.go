@@ -0,0 +1,226 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func idempotencyTestEngine(ttl time.Duration, calls *int) *gin.Engine {
+	engine := gin.New()
+	engine.Use(func(c *gin.Context) {
+		c.Set(ContextKeyUser, c.GetHeader("X-User"))
+		c.Next()
+	})
+
+	engine.POST("/api/v1/devices", IdempotencyMiddleware(ttl), func(c *gin.Context) {
+		*calls++
+
+		if c.Query("fail") != "" {
+			c.Status(http.StatusInternalServerError)
+
+			return
+		}
+
+		c.Header("Location", "/api/v1/devices/1")
+		c.JSON(http.StatusCreated, gin.H{"call": *calls})
+	})
+
+	return engine
+}
+
+func idempotentPost(engine *gin.Engine, target, user, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	req.Header.Set("X-User", user)
+
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	engine := idempotencyTestEngine(time.Hour, &calls)
+
+	first := idempotentPost(engine, "/api/v1/devices", "alice", "key-1", `{"guid":"1"}`)
+	require.Equal(t, http.StatusCreated, first.Code)
+	assert.JSONEq(t, `{"call":1}`, first.Body.String())
+	assert.Empty(t, first.Header().Get("Idempotent-Replayed"))
+
+	retry := idempotentPost(engine, "/api/v1/devices", "alice", "key-1", `{"guid":"1"}`)
+	require.Equal(t, http.StatusCreated, retry.Code)
+	assert.JSONEq(t, `{"call":1}`, retry.Body.String())
+	assert.Equal(t, "true", retry.Header().Get("Idempotent-Replayed"))
+	assert.Equal(t, "/api/v1/devices/1", retry.Header().Get("Location"))
+	assert.Equal(t, "application/json; charset=utf-8", retry.Header().Get("Content-Type"))
+	assert.Equal(t, 1, calls, "the retry does not run the handler")
+
+	reused := idempotentPost(engine, "/api/v1/devices", "alice", "key-1", `{"guid":"2"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, reused.Code)
+
+	// keys are kept apart per user, and requests without a key always run
+	assert.JSONEq(t, `{"call":2}`, idempotentPost(engine, "/api/v1/devices", "bob", "key-1", `{"guid":"1"}`).Body.String())
+	assert.JSONEq(t, `{"call":3}`, idempotentPost(engine, "/api/v1/devices", "alice", "", `{"guid":"1"}`).Body.String())
+	assert.JSONEq(t, `{"call":4}`, idempotentPost(engine, "/api/v1/devices", "alice", "", `{"guid":"1"}`).Body.String())
+
+	tooLong := idempotentPost(engine, "/api/v1/devices", "alice", strings.Repeat("k", maxIdempotencyKey+1), "{}")
+	assert.Equal(t, http.StatusBadRequest, tooLong.Code)
+	assert.Equal(t, 4, calls)
+}
+
+func TestIdempotencyMiddlewareServerError(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	engine := idempotencyTestEngine(time.Hour, &calls)
+
+	// server errors are not kept, the retry runs again
+	assert.Equal(t, http.StatusInternalServerError, idempotentPost(engine, "/api/v1/devices?fail=1", "alice", "key-1", "{}").Code)
+	assert.Equal(t, http.StatusInternalServerError, idempotentPost(engine, "/api/v1/devices?fail=1", "alice", "key-1", "{}").Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotencyMiddlewareExpiry(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	engine := idempotencyTestEngine(time.Millisecond, &calls)
+
+	idempotentPost(engine, "/api/v1/devices", "alice", "key-1", "{}")
+	time.Sleep(5 * time.Millisecond)
+
+	retry := idempotentPost(engine, "/api/v1/devices", "alice", "key-1", "{}")
+	assert.Empty(t, retry.Header().Get("Idempotent-Replayed"))
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotencyMiddlewareInProgress(t *testing.T) {
+	t.Parallel()
+
+	started, release := make(chan struct{}), make(chan struct{})
+
+	engine := gin.New()
+	engine.POST("/api/v1/jobs", IdempotencyMiddleware(time.Hour), func(c *gin.Context) {
+		close(started)
+		<-release
+		c.Status(http.StatusAccepted)
+	})
+
+	done := make(chan *httptest.ResponseRecorder)
+
+	go func() { done <- idempotentPost(engine, "/api/v1/jobs", "", "key-1", "{}") }()
+
+	<-started
+	assert.Equal(t, http.StatusConflict, idempotentPost(engine, "/api/v1/jobs", "", "key-1", "{}").Code)
+
+	close(release)
+	assert.Equal(t, http.StatusAccepted, (<-done).Code)
+
+	replayed := idempotentPost(engine, "/api/v1/jobs", "", "key-1", "{}")
+	assert.Equal(t, http.StatusAccepted, replayed.Code)
+	assert.Equal(t, "true", replayed.Header().Get("Idempotent-Replayed"))
+}
+
+func TestIdempotencyMiddlewarePanic(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+
+	engine := gin.New()
+	engine.Use(gin.CustomRecovery(func(c *gin.Context, _ any) { c.AbortWithStatus(http.StatusInternalServerError) }))
+	engine.POST("/api/v1/jobs", IdempotencyMiddleware(time.Hour), func(c *gin.Context) {
+		calls++
+
+		if calls == 1 {
+			panic("handler failure")
+		}
+
+		c.Status(http.StatusAccepted)
+	})
+
+	// the panic releases the key, the retry runs again instead of being refused as in progress
+	assert.Equal(t, http.StatusInternalServerError, idempotentPost(engine, "/api/v1/jobs", "", "key-1", "{}").Code)
+	assert.Equal(t, http.StatusAccepted, idempotentPost(engine, "/api/v1/jobs", "", "key-1", "{}").Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotencyMiddlewareNoStore(t *testing.T) {
+	t.Parallel()
+
+	checkedOut := false
+
+	engine := gin.New()
+	engine.POST("/api/v1/devices/1/credentials/checkout", IdempotencyMiddleware(time.Hour), func(c *gin.Context) {
+		if checkedOut {
+			c.Status(http.StatusConflict)
+
+			return
+		}
+
+		checkedOut = true
+
+		c.Header("Cache-Control", "no-store")
+		c.JSON(http.StatusCreated, gin.H{"password": "secret"})
+	})
+	engine.DELETE("/api/v1/devices/1/credentials/checkout", func(c *gin.Context) {
+		checkedOut = false
+
+		c.Status(http.StatusNoContent)
+	})
+
+	first := idempotentPost(engine, "/api/v1/devices/1/credentials/checkout", "", "key-1", "{}")
+	require.Equal(t, http.StatusCreated, first.Code)
+	assert.Contains(t, first.Body.String(), "secret")
+
+	checkIn := httptest.NewRecorder()
+	engine.ServeHTTP(checkIn, httptest.NewRequest(http.MethodDelete, "/api/v1/devices/1/credentials/checkout", http.NoBody))
+	require.Equal(t, http.StatusNoContent, checkIn.Code)
+
+	// the password was not kept, the retry after the check-in runs the handler again
+	retry := idempotentPost(engine, "/api/v1/devices/1/credentials/checkout", "", "key-1", "{}")
+	assert.Empty(t, retry.Header().Get("Idempotent-Replayed"))
+	assert.Equal(t, http.StatusCreated, retry.Code)
+
+	// and a retry while it is checked out is refused instead of sent the password again
+	again := idempotentPost(engine, "/api/v1/devices/1/credentials/checkout", "", "key-1", "{}")
+	assert.Equal(t, http.StatusConflict, again.Code)
+	assert.NotContains(t, again.Body.String(), "secret")
+}
+
+func TestIdempotencyStore(t *testing.T) {
+	t.Parallel()
+
+	store := &idempotencyStore{requests: map[string]*idempotentRequest{}}
+	now := time.Now()
+
+	_, started := store.start("key-1", [32]byte{1}, now)
+	require.NotNil(t, started)
+
+	seen, _ := store.start("key-1", [32]byte{1}, now.Add(time.Second))
+	assert.Same(t, started, seen, "the request holds its key while it runs")
+
+	seen, takenOver := store.start("key-1", [32]byte{1}, now.Add(IdempotencyInProgressTTL))
+	assert.Nil(t, seen, "a request in progress for too long releases its key")
+	require.NotNil(t, takenOver)
+
+	// the request completing late leaves the retry that took its key over alone
+	store.complete("key-1", started, &responseRecorder{}, true, time.Hour)
+	assert.Same(t, takenOver, store.requests["key-1"])
+
+	store.start("key-2", [32]byte{2}, now.Add(IdempotencyInProgressTTL))
+	store.sweep(now.Add(2 * IdempotencyInProgressTTL))
+	assert.Empty(t, store.requests, "the sweep drops the expired requests")
+}
@@ -4,8 +4,6 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/gin-gonic/gin/binding"
-	"github.com/go-playground/validator/v10"
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/ieee8021xconfigs"
@@ -23,15 +21,6 @@ type ieee8021xConfigRoutes struct {
 func NewIEEE8021xConfigRoutes(handler *gin.RouterGroup, t ieee8021xconfigs.Feature, l logger.Interface) {
 	r := &ieee8021xConfigRoutes{t, l}
 
-	if binding.Validator != nil {
-		if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-			err := v.RegisterValidation("authProtocolValidator", dto.AuthProtocolValidator)
-			if err != nil {
-				l.Error(err, "failed to register validation")
-			}
-		}
-	}
-
 	h := handler.Group("/ieee8021xconfigs")
 	{
 		h.GET("", r.get)
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/images"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// imageTransferTimeout is the time granted to read or write each chunk of an image, the transfer of
+// an image takes longer than the read and write timeouts of the server.
+const imageTransferTimeout = 30 * time.Second
+
+var (
+	ErrValidationImages = dto.NotValidError{Console: consoleerrors.CreateConsoleError("ImagesAPI")}
+
+	// ErrNoImage is returned for an upload without a part named image.
+	ErrNoImage = errors.New("the upload has no image part")
+)
+
+type imageRoutes struct {
+	t images.Feature
+	l logger.Interface
+}
+
+// NewImageRoutes serves the ISO and boot images. The image of an upload is the multipart/form-data
+// part named image, streamed to disk as it is received.
+func NewImageRoutes(handler *gin.RouterGroup, t images.Feature, l logger.Interface) {
+	r := &imageRoutes{t, l}
+
+	h := handler.Group("/images")
+	{
+		h.GET("", r.get)
+		h.GET(":name", r.download)
+		h.POST("", r.upload)
+		h.DELETE(":name", r.delete)
+	}
+}
+
+func (r *imageRoutes) get(c *gin.Context) {
+	items, err := r.t.Get(c.Request.Context())
+	if err != nil {
+		r.l.Error(err, "http - v1 - images - get")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+func (r *imageRoutes) download(c *gin.Context) {
+	path, err := r.t.Path(c.Request.Context(), c.Param("name"))
+	if err != nil {
+		r.l.Error(err, "http - v1 - images - download")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	w := c.Writer
+	c.Writer = &deadlineWriter{ResponseWriter: w, rc: http.NewResponseController(w)}
+
+	c.FileAttachment(path, c.Param("name"))
+
+	c.Writer = w
+}
+
+func (r *imageRoutes) upload(c *gin.Context) {
+	var (
+		image    dto.Image
+		uploaded bool
+	)
+
+	c.Request.Body = &deadlineReader{ReadCloser: c.Request.Body, rc: http.NewResponseController(c.Writer)}
+
+	err := readMultipart(c, func(part *multipart.Part) error {
+		if part.FormName() != "image" || uploaded {
+			return nil
+		}
+
+		name := part.FileName()
+		if name == "" {
+			name = c.Query("name")
+		}
+
+		var err error
+
+		image, err = r.t.Upload(c.Request.Context(), name, part)
+		uploaded = err == nil
+
+		return err
+	})
+
+	if err == nil && !uploaded {
+		err = ErrNoImage
+	}
+
+	if errors.Is(err, ErrNotMultipart) || errors.Is(err, ErrNoImage) {
+		err = ErrValidationImages.Wrap("upload", "readMultipart", err)
+	}
+
+	if err != nil {
+		r.l.Error(err, "http - v1 - images - upload")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, image)
+}
+
+func (r *imageRoutes) delete(c *gin.Context) {
+	if err := r.t.Delete(c.Request.Context(), c.Param("name")); err != nil {
+		r.l.Error(err, "http - v1 - images - delete")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// deadlineReader pushes the read deadline forward before each read of an upload, and the write deadline
+// too so that the response still reaches the client once the upload is read.
+type deadlineReader struct {
+	io.ReadCloser
+
+	rc *http.ResponseController
+}
+
+func (r *deadlineReader) Read(p []byte) (int, error) {
+	// not supported by all writers, the server timeouts apply then
+	deadline := time.Now().Add(imageTransferTimeout)
+	_ = r.rc.SetReadDeadline(deadline)
+	_ = r.rc.SetWriteDeadline(deadline)
+
+	return r.ReadCloser.Read(p)
+}
+
+// deadlineWriter pushes the write deadline forward before each write of a download.
+type deadlineWriter struct {
+	gin.ResponseWriter
+
+	rc *http.ResponseController
+}
+
+func (w *deadlineWriter) Write(data []byte) (int, error) {
+	// not supported by all writers, the server write timeout applies then
+	_ = w.rc.SetWriteDeadline(time.Now().Add(imageTransferTimeout))
+
+	return w.ResponseWriter.Write(data)
+}
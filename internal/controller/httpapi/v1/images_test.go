@@ -0,0 +1,294 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/images"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func imagesTest(t *testing.T, limit int64) (*mocks.MockImagesFeature, *gin.Engine) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+	feature := mocks.NewMockImagesFeature(mockCtl)
+
+	engine := gin.New()
+	handler := engine.Group("/api/v1/admin", BodyLimitMiddleware(limit))
+
+	NewImageRoutes(handler, feature, logger.New("error"))
+
+	return feature, engine
+}
+
+func imageUpload(t *testing.T, part, fileName, content string) (*bytes.Buffer, string) {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	form := multipart.NewWriter(&body)
+	require.NoError(t, form.WriteField("description", "installer"))
+
+	w, err := form.CreateFormFile(part, fileName)
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	return &body, form.FormDataContentType()
+}
+
+func TestImageUpload(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		part         string
+		fileName     string
+		content      string
+		limit        int64
+		mock         func(m *mocks.MockImagesFeature)
+		expectedCode int
+	}{
+		{
+			name:     "upload",
+			part:     "image",
+			fileName: "boot.iso",
+			content:  "hello",
+			mock: func(m *mocks.MockImagesFeature) {
+				m.EXPECT().Upload(gomock.Any(), "boot.iso", gomock.Any()).
+					DoAndReturn(func(_ context.Context, name string, r io.Reader) (dto.Image, error) {
+						data, err := io.ReadAll(r)
+
+						return dto.Image{Name: name, Size: int64(len(data))}, err
+					})
+			},
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name:         "no image part",
+			part:         "file",
+			fileName:     "boot.iso",
+			content:      "hello",
+			mock:         func(_ *mocks.MockImagesFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid name",
+			part:     "image",
+			fileName: ".iso",
+			content:  "hello",
+			mock: func(m *mocks.MockImagesFeature) {
+				m.EXPECT().Upload(gomock.Any(), ".iso", gomock.Any()).
+					Return(dto.Image{}, images.ErrValidation.Wrap("Upload", "namePattern.MatchString", images.ErrInvalidName))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "too large",
+			part:         "image",
+			fileName:     "boot.iso",
+			content:      strings.Repeat("x", 1024),
+			limit:        512,
+			mock:         func(_ *mocks.MockImagesFeature) {},
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			feature, engine := imagesTest(t, tc.limit)
+			tc.mock(feature)
+
+			body, contentType := imageUpload(t, tc.part, tc.fileName, tc.content)
+
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/admin/images", body)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", contentType)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+
+			if tc.expectedCode == http.StatusCreated {
+				require.Contains(t, w.Body.String(), `"size":5`)
+			}
+		})
+	}
+}
+
+func TestImageRoutes(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "boot.iso")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		mock         func(m *mocks.MockImagesFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:   "get images",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/images",
+			mock: func(m *mocks.MockImagesFeature) {
+				m.EXPECT().Get(gomock.Any()).Return([]dto.Image{{Name: "boot.iso", Size: 5}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"name":"boot.iso"`,
+		},
+		{
+			name:   "get images failure",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/images",
+			mock: func(m *mocks.MockImagesFeature) {
+				m.EXPECT().Get(gomock.Any()).Return(nil, errors.New("permission denied"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "download",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/images/boot.iso",
+			mock: func(m *mocks.MockImagesFeature) {
+				m.EXPECT().Path(gomock.Any(), "boot.iso").Return(path, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "hello",
+		},
+		{
+			name:   "download missing image",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/images/missing.iso",
+			mock: func(m *mocks.MockImagesFeature) {
+				m.EXPECT().Path(gomock.Any(), "missing.iso").Return("", images.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			url:    "/api/v1/admin/images/boot.iso",
+			mock: func(m *mocks.MockImagesFeature) {
+				m.EXPECT().Delete(gomock.Any(), "boot.iso").Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "upload without multipart body",
+			method:       http.MethodPost,
+			url:          "/api/v1/admin/images",
+			mock:         func(_ *mocks.MockImagesFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			feature, engine := imagesTest(t, 0)
+			tc.mock(feature)
+
+			req, err := http.NewRequest(tc.method, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+
+			if tc.expectedBody != "" {
+				require.Contains(t, w.Body.String(), tc.expectedBody)
+			}
+		})
+	}
+}
+
+func TestImageTransferOutlivesServerTimeouts(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "boot.iso")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Repeat("x", 1<<20)), 0o600))
+
+	feature, engine := imagesTest(t, 0)
+	feature.EXPECT().Upload(gomock.Any(), "boot.iso", gomock.Any()).
+		DoAndReturn(func(_ context.Context, name string, r io.Reader) (dto.Image, error) {
+			data, err := io.ReadAll(r)
+
+			return dto.Image{Name: name, Size: int64(len(data))}, err
+		})
+	feature.EXPECT().Path(gomock.Any(), "boot.iso").
+		DoAndReturn(func(context.Context, string) (string, error) {
+			// the download starts after the write timeout of the server
+			time.Sleep(100 * time.Millisecond)
+
+			return path, nil
+		})
+
+	server := httptest.NewUnstartedServer(engine)
+	server.Config.ReadTimeout = 50 * time.Millisecond
+	server.Config.WriteTimeout = 50 * time.Millisecond
+	server.Start()
+	t.Cleanup(server.Close)
+
+	body, contentType := imageUpload(t, "image", "boot.iso", "hello")
+	pr, pw := io.Pipe()
+
+	go func() {
+		// the upload is sent in chunks over three times the read timeout of the server
+		for chunk := range slices.Chunk(body.Bytes(), body.Len()/3+1) {
+			time.Sleep(50 * time.Millisecond)
+
+			if _, err := pw.Write(chunk); err != nil {
+				return
+			}
+		}
+
+		pw.Close()
+	}()
+
+	resp, err := http.Post(server.URL+"/api/v1/admin/images", contentType, pr)
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(data))
+	require.Contains(t, string(data), `"size":5`)
+
+	resp, err = http.Get(server.URL + "/api/v1/admin/images/boot.iso")
+	require.NoError(t, err)
+
+	data, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, data, 1<<20)
+}
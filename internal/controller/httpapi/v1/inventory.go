@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/usecase/inventory"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+type inventoryRoutes struct {
+	i inventory.Feature
+	l logger.Interface
+}
+
+func NewInventoryRoutes(handler *gin.RouterGroup, i inventory.Feature, l logger.Interface) {
+	r := &inventoryRoutes{i, l}
+
+	h := handler.Group("/inventory")
+	{
+		h.GET("ansible", r.getAnsible)
+	}
+}
+
+// getAnsible returns the devices as an Ansible dynamic inventory, the document an inventory script prints.
+func (r *inventoryRoutes) getAnsible(c *gin.Context) {
+	inventory, err := r.i.GetAnsible(c.Request.Context(), "")
+	if err != nil {
+		r.l.Error(err, "http - v1 - getAnsibleInventory")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, inventory)
+}
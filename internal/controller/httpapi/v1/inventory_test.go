@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestInventoryRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mock         func(m *mocks.MockInventoryFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name: "ansible inventory",
+			mock: func(m *mocks.MockInventoryFeature) {
+				m.EXPECT().GetAnsible(context.Background(), "").Return(dto.AnsibleInventory{
+					Groups: map[string]dto.AnsibleGroup{
+						"all":      {Children: []string{"power_on"}},
+						"power_on": {Hosts: []string{"amt01.example.com"}},
+					},
+					Meta: dto.AnsibleMeta{HostVars: map[string]dto.AnsibleHostVars{
+						"amt01.example.com": {AnsibleHost: "10.0.0.9", GUID: "guid1", IP: "10.0.0.9", Hostname: "amt01.example.com", Tags: []string{}, PowerState: "on"},
+					}},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"_meta":{"hostvars":{"amt01.example.com":{"ansible_host":"10.0.0.9","guid":"guid1","ip":"10.0.0.9",` +
+				`"hostname":"amt01.example.com","tags":[],"power_state":"on"}}},"all":{"children":["power_on"]},"power_on":{"hosts":["amt01.example.com"]}}`,
+		},
+		{
+			name: "service failure",
+			mock: func(m *mocks.MockInventoryFeature) {
+				m.EXPECT().GetAnsible(context.Background(), "").Return(dto.AnsibleInventory{}, ErrGeneral)
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			inventory := mocks.NewMockInventoryFeature(mockCtl)
+			tc.mock(inventory)
+
+			engine := gin.New()
+			NewInventoryRoutes(engine.Group("/api/v1"), inventory, logger.New("error"))
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/api/v1/inventory/ansible", http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/jobs"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// jobEventsWriteTimeout is the time granted to write each event of a job stream, a job runs
+// longer than the write timeout of the server.
+const jobEventsWriteTimeout = 30 * time.Second
+
+var ErrValidationJobs = dto.NotValidError{Console: consoleerrors.CreateConsoleError("JobsAPI")}
+
+type jobRoutes struct {
+	t jobs.Feature
+	l logger.Interface
+}
+
+// NewJobRoutes serves the background jobs. A job is queued with a 202, its progress is polled by
+// its ID or followed as server-sent events until it is finished. The jobs belong to the tenant
+// of the access token, the default tenant when it names none.
+func NewJobRoutes(handler *gin.RouterGroup, t jobs.Feature, l logger.Interface) {
+	r := &jobRoutes{t, l}
+
+	h := handler.Group("/jobs")
+	{
+		h.GET("", r.get)
+		h.POST("", r.enqueue)
+		h.POST("hardware-refresh", r.startHardwareRefresh)
+		h.GET(":id", r.getByID)
+		h.GET(":id/events", r.events)
+		h.POST(":id/cancel", r.cancel)
+		h.GET(":id/devices/:guid", r.getHardwareInfo)
+	}
+}
+
+func (r *jobRoutes) get(c *gin.Context) {
+	var odata OData
+	if err := c.ShouldBindQuery(&odata); err != nil {
+		ErrorResponse(c, ErrValidationJobs.Wrap("get", "ShouldBindQuery", err))
+
+		return
+	}
+
+	items, err := r.t.Get(c.Request.Context(), odata.Top, odata.Skip, c.GetString(ContextKeyTenant), c.Query("state"))
+	if err != nil {
+		r.l.Error(err, "http - v1 - jobs - get")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+func (r *jobRoutes) enqueue(c *gin.Context) {
+	var req dto.JobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, ErrValidationJobs.Wrap("enqueue", "ShouldBindJSON", err))
+
+		return
+	}
+
+	dryRun, err := isDryRun(c)
+	if err != nil {
+		ErrorResponse(c, ErrValidationJobs.Wrap("enqueue", "isDryRun", err))
+
+		return
+	}
+
+	if dryRun {
+		result, err := r.t.Plan(c.Request.Context(), c.GetString(ContextKeyTenant), req)
+		if err != nil {
+			r.l.Error(err, "http - v1 - jobs - enqueue")
+			ErrorResponse(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, result)
+
+		return
+	}
+
+	job, err := r.t.Enqueue(c.Request.Context(), c.GetString(ContextKeyTenant), req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - jobs - enqueue")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.Header("Location", strings.TrimSuffix(c.Request.URL.Path, "/")+"/"+job.ID)
+	c.JSON(http.StatusAccepted, job)
+}
+
+func (r *jobRoutes) startHardwareRefresh(c *gin.Context) {
+	var req dto.HardwareRefreshRequest
+
+	// without a body every device is refreshed
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ErrorResponse(c, ErrValidationJobs.Wrap("startHardwareRefresh", "ShouldBindJSON", err))
+
+		return
+	}
+
+	job, err := r.t.StartHardwareRefresh(c.Request.Context(), req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - jobs - startHardwareRefresh")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.Header("Location", strings.TrimSuffix(c.Request.URL.Path, "hardware-refresh")+job.ID)
+	c.JSON(http.StatusAccepted, job)
+}
+
+func (r *jobRoutes) getByID(c *gin.Context) {
+	job, err := r.t.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.l.Error(err, "http - v1 - jobs - getByID")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+// events streams the job and its updates as server-sent events named job, the stream ends once
+// the job is finished.
+func (r *jobRoutes) events(c *gin.Context) {
+	updates, err := r.t.Watch(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.l.Error(err, "http - v1 - jobs - events")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	rc := http.NewResponseController(c.Writer)
+
+	// the updates end with the job or when the client goes away
+	for job := range updates {
+		// not supported by all writers, the server write timeout applies then
+		_ = rc.SetWriteDeadline(time.Now().Add(jobEventsWriteTimeout))
+
+		c.SSEvent("job", job)
+		c.Writer.Flush()
+	}
+}
+
+func (r *jobRoutes) cancel(c *gin.Context) {
+	job, err := r.t.Cancel(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.l.Error(err, "http - v1 - jobs - cancel")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+func (r *jobRoutes) getHardwareInfo(c *gin.Context) {
+	info, err := r.t.GetHardwareInfo(c.Request.Context(), c.Param("id"), c.Param("guid"))
+	if err != nil {
+		r.l.Error(err, "http - v1 - jobs - getHardwareInfo")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, info)
+}
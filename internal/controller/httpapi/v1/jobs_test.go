@@ -0,0 +1,281 @@
+package v1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/jobs"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestJobRoutes(t *testing.T) {
+	t.Parallel()
+
+	job := dto.Job{ID: "job1", Type: dto.JobTypeHardwareRefresh, State: dto.JobStatePending, Total: 1}
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		tenant       string
+		body         string
+		mock         func(m *mocks.MockJobsFeature)
+		expectedCode int
+		location     string
+	}{
+		{
+			name:   "start a refresh of every device",
+			method: http.MethodPost,
+			url:    "/api/v1/jobs/hardware-refresh",
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().StartHardwareRefresh(gomock.Any(), dto.HardwareRefreshRequest{}).Return(job, nil)
+			},
+			expectedCode: http.StatusAccepted,
+			location:     "/api/v1/jobs/job1",
+		},
+		{
+			name:   "start a refresh of some devices",
+			method: http.MethodPost,
+			url:    "/api/v1/jobs/hardware-refresh",
+			body:   `{"guids":["guid1"]}`,
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().StartHardwareRefresh(gomock.Any(), dto.HardwareRefreshRequest{GUIDs: []string{"guid1"}}).Return(job, nil)
+			},
+			expectedCode: http.StatusAccepted,
+			location:     "/api/v1/jobs/job1",
+		},
+		{
+			name:   "list the jobs",
+			method: http.MethodGet,
+			url:    "/api/v1/jobs?$top=10&$skip=5&state=running",
+			tenant: "tenant1",
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().Get(gomock.Any(), 10, 5, "tenant1", "running").Return([]dto.Job{job}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "queue a job",
+			method: http.MethodPost,
+			url:    "/api/v1/jobs",
+			tenant: "tenant1",
+			body:   `{"type":"power","payload":{"action":8},"maxAttempts":3}`,
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().Enqueue(gomock.Any(), "tenant1", dto.JobRequest{
+					Type: "power", Payload: json.RawMessage(`{"action":8}`), MaxAttempts: 3,
+				}).Return(job, nil)
+			},
+			expectedCode: http.StatusAccepted,
+			location:     "/api/v1/jobs/job1",
+		},
+		{
+			name:   "plan a job",
+			method: http.MethodPost,
+			url:    "/api/v1/jobs?dryRun=true",
+			tenant: "tenant1",
+			body:   `{"type":"power","payload":{"action":8}}`,
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().Plan(gomock.Any(), "tenant1", dto.JobRequest{Type: "power", Payload: json.RawMessage(`{"action":8}`)}).
+					Return(dto.DryRunResult{Operation: "power", Actions: []dto.PlannedAction{{Target: "guid1", Action: "power action 8 (Power down)"}}}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "queue a job with a dry run that is not a boolean",
+			method:       http.MethodPost,
+			url:          "/api/v1/jobs?dryRun=1x",
+			body:         `{"type":"power","payload":{"action":8}}`,
+			mock:         func(_ *mocks.MockJobsFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "queue a job for another tenant than the one of the access token",
+			method: http.MethodPost,
+			url:    "/api/v1/jobs?tenantId=tenant2",
+			tenant: "tenant1",
+			body:   `{"type":"power","payload":{"action":8}}`,
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().Enqueue(gomock.Any(), "tenant1", gomock.Any()).Return(job, nil)
+			},
+			expectedCode: http.StatusAccepted,
+			location:     "/api/v1/jobs/job1",
+		},
+		{
+			name:   "queue a job of an unknown type",
+			method: http.MethodPost,
+			url:    "/api/v1/jobs",
+			body:   `{"type":"firmware-update"}`,
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().Enqueue(gomock.Any(), "", gomock.Any()).
+					Return(dto.Job{}, jobs.ErrValidation.Wrap("Enqueue", "uc.handlers", jobs.ErrUnknownType))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "queue a job while the queue of the tenant is full",
+			method: http.MethodPost,
+			url:    "/api/v1/jobs",
+			tenant: "tenant1",
+			body:   `{"type":"power","payload":{"action":8}}`,
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().Enqueue(gomock.Any(), "tenant1", gomock.Any()).
+					Return(dto.Job{}, jobs.QueueFullError{Limit: 100, Tenant: true})
+			},
+			expectedCode: http.StatusTooManyRequests,
+		},
+		{
+			name:   "queue a job while the queue is full",
+			method: http.MethodPost,
+			url:    "/api/v1/jobs",
+			body:   `{"type":"power","payload":{"action":8}}`,
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().Enqueue(gomock.Any(), "", gomock.Any()).Return(dto.Job{}, jobs.QueueFullError{Limit: 1000})
+			},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "cancel a job",
+			method: http.MethodPost,
+			url:    "/api/v1/jobs/job1/cancel",
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().Cancel(gomock.Any(), "job1").Return(dto.Job{ID: "job1", State: dto.JobStateCancelled}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "start with a malformed body",
+			method:       http.MethodPost,
+			url:          "/api/v1/jobs/hardware-refresh",
+			body:         `{"guids":`,
+			mock:         func(_ *mocks.MockJobsFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "get the progress",
+			method: http.MethodGet,
+			url:    "/api/v1/jobs/job1",
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().GetByID(gomock.Any(), "job1").Return(job, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "get an unknown job",
+			method: http.MethodGet,
+			url:    "/api/v1/jobs/job2",
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().GetByID(gomock.Any(), "job2").Return(dto.Job{}, jobs.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "get the hardware info of a device",
+			method: http.MethodGet,
+			url:    "/api/v1/jobs/job1/devices/guid1",
+			mock: func(m *mocks.MockJobsFeature) {
+				m.EXPECT().GetHardwareInfo(gomock.Any(), "job1", "guid1").Return(dto.HardwareInfo{}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			feature := mocks.NewMockJobsFeature(gomock.NewController(t))
+			tc.mock(feature)
+
+			engine := gin.New()
+			engine.Use(func(c *gin.Context) {
+				c.Set(ContextKeyTenant, tc.tenant)
+				c.Next()
+			})
+			NewJobRoutes(engine.Group("/api/v1"), feature, logger.New("error"))
+
+			req := httptest.NewRequest(tc.method, tc.url, strings.NewReader(tc.body))
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+			require.Equal(t, tc.location, w.Header().Get("Location"))
+		})
+	}
+}
+
+func TestJobEvents(t *testing.T) {
+	t.Parallel()
+
+	updates := make(chan dto.Job, 2)
+	updates <- dto.Job{ID: "job1", State: dto.JobStateRunning}
+	updates <- dto.Job{ID: "job1", State: dto.JobStateCompleted}
+	close(updates)
+
+	feature := mocks.NewMockJobsFeature(gomock.NewController(t))
+	feature.EXPECT().Watch(gomock.Any(), "job1").Return((<-chan dto.Job)(updates), nil)
+	feature.EXPECT().Watch(gomock.Any(), "job2").Return(nil, jobs.ErrNotFound)
+
+	engine := gin.New()
+	NewJobRoutes(engine.Group("/api/v1"), feature, logger.New("error"))
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/jobs/job1/events", http.NoBody))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Header().Get("Content-Type"), "text/event-stream")
+	require.Equal(t, 2, strings.Count(w.Body.String(), "event:job"))
+	require.Contains(t, w.Body.String(), `"state":"completed"`)
+
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/jobs/job2/events", http.NoBody))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestJobEventsOutliveServerWriteTimeout(t *testing.T) {
+	t.Parallel()
+
+	updates := make(chan dto.Job)
+
+	feature := mocks.NewMockJobsFeature(gomock.NewController(t))
+	feature.EXPECT().Watch(gomock.Any(), "job1").Return((<-chan dto.Job)(updates), nil)
+
+	engine := gin.New()
+	NewJobRoutes(engine.Group("/api/v1"), feature, logger.New("error"))
+
+	server := httptest.NewUnstartedServer(engine)
+	server.Config.WriteTimeout = 50 * time.Millisecond
+	server.Start()
+	t.Cleanup(server.Close)
+
+	go func() {
+		defer close(updates)
+
+		// the job finishes well after the write timeout of the server
+		time.Sleep(150 * time.Millisecond)
+		updates <- dto.Job{ID: "job1", State: dto.JobStateRunning}
+		time.Sleep(100 * time.Millisecond)
+		updates <- dto.Job{ID: "job1", State: dto.JobStateCompleted}
+	}()
+
+	resp, err := http.Get(server.URL + "/api/v1/jobs/job1/events")
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(string(body), "event:job"))
+	require.Contains(t, string(body), `"state":"completed"`)
+}
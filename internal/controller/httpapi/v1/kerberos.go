@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// getKerberosSettings returns the Kerberos authentication settings of the device
+func (r *deviceManagementRoutes) getKerberosSettings(c *gin.Context) {
+	guid := c.Param("guid")
+
+	settings, err := r.d.GetKerberosSettings(c.Request.Context(), guid)
+	if err != nil {
+		r.l.Error(err, "http - v1 - getKerberosSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+// setKerberosSettings replaces the Kerberos authentication settings of the device
+func (r *deviceManagementRoutes) setKerberosSettings(c *gin.Context) {
+	guid := c.Param("guid")
+
+	var req dto.KerberosSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	settings, err := r.d.SetKerberosSettings(c.Request.Context(), guid, req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - setKerberosSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
@@ -44,3 +44,28 @@ func (r *deviceManagementRoutes) setKVMDisplays(c *gin.Context) {
 
 	c.JSON(http.StatusOK, settings)
 }
+
+// getKVMLimits returns the quality, frame rate and bandwidth limits of new KVM sessions
+func (r *deviceManagementRoutes) getKVMLimits(c *gin.Context) {
+	c.JSON(http.StatusOK, r.d.GetKVMLimits(c.Request.Context()))
+}
+
+// setKVMLimits replaces the quality, frame rate and bandwidth limits of new KVM sessions
+func (r *deviceManagementRoutes) setKVMLimits(c *gin.Context) {
+	var limits dto.KVMLimits
+	if err := c.ShouldBindJSON(&limits); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	limits, err := r.d.SetKVMLimits(c.Request.Context(), limits)
+	if err != nil {
+		r.l.Error(err, "http - v1 - setKVMLimits")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, limits)
+}
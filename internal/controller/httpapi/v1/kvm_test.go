@@ -66,3 +66,54 @@ func TestKVMDisplaysEndpoints(t *testing.T) {
 		require.Equal(t, http.StatusOK, rr.Code)
 	})
 }
+
+func TestKVMLimitsEndpoints(t *testing.T) {
+	t.Parallel()
+
+	limits := dto.KVMLimits{Quality: 5, FrameRate: 10, Bandwidth: 262144}
+
+	t.Run("GET success", func(t *testing.T) {
+		t.Parallel()
+
+		mockCtl := gomock.NewController(t)
+		defer mockCtl.Finish()
+
+		log := logger.New("error")
+		deviceManagement := mocks.NewMockDeviceManagementFeature(mockCtl)
+		engine := gin.New()
+		handler := engine.Group("/api/v1")
+		NewAmtRoutes(handler, deviceManagement, mocks.NewMockAMTExplorerFeature(mockCtl), mocks.NewMockExporter(mockCtl), log)
+
+		deviceManagement.EXPECT().GetKVMLimits(context.Background()).Return(limits)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/amt/kvm/limits", http.NoBody)
+		rr := httptest.NewRecorder()
+		engine.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var got dto.KVMLimits
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+		require.Equal(t, limits, got)
+	})
+
+	t.Run("PUT success", func(t *testing.T) {
+		t.Parallel()
+
+		mockCtl := gomock.NewController(t)
+		defer mockCtl.Finish()
+
+		log := logger.New("error")
+		deviceManagement := mocks.NewMockDeviceManagementFeature(mockCtl)
+		engine := gin.New()
+		handler := engine.Group("/api/v1")
+		NewAmtRoutes(handler, deviceManagement, mocks.NewMockAMTExplorerFeature(mockCtl), mocks.NewMockExporter(mockCtl), log)
+
+		deviceManagement.EXPECT().SetKVMLimits(context.Background(), limits).Return(limits, nil)
+
+		b, _ := json.Marshal(limits)
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/amt/kvm/limits", bytes.NewReader(b))
+		rr := httptest.NewRecorder()
+		engine.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 )
 
 // setLinkPreference sets the link preference (ME or Host) on a device's WiFi interface.
@@ -28,6 +29,7 @@ func (r *deviceManagementRoutes) setLinkPreference(c *gin.Context) {
 		if errors.Is(err, wsman.ErrNoWiFiPort) {
 			c.JSON(http.StatusNotFound, gin.H{
 				"error": "Set Link Preference failed for guid: " + guid + ". - " + err.Error(),
+				"code":  consoleerrors.CodeNotSupported,
 			})
 
 			return
@@ -44,6 +46,7 @@ func (r *deviceManagementRoutes) setLinkPreference(c *gin.Context) {
 	if response.ReturnValue != 0 {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": "Set Link Preference failed for guid: " + guid + ".",
+			"code":  consoleerrors.CodeAMTError,
 		})
 
 		return
@@ -51,3 +54,40 @@ func (r *deviceManagementRoutes) setLinkPreference(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// getLinkSettings returns the link policy of the network interfaces of the device and the link preference of its wireless interface
+func (r *deviceManagementRoutes) getLinkSettings(c *gin.Context) {
+	guid := c.Param("guid")
+
+	settings, err := r.d.GetLinkSettings(c.Request.Context(), guid)
+	if err != nil {
+		r.l.Error(err, "http - v1 - getLinkSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+// setLinkSettings changes the link policy and link preference of a network interface of the device and returns them as read back from it
+func (r *deviceManagementRoutes) setLinkSettings(c *gin.Context) {
+	guid := c.Param("guid")
+
+	var req dto.LinkSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	settings, err := r.d.SetLinkSettings(c.Request.Context(), guid, req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - setLinkSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
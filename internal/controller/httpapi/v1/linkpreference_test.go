@@ -7,10 +7,12 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
 
 	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
 	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
@@ -92,3 +94,37 @@ func TestSetLinkPreferenceHandler(t *testing.T) {
 		t.Fatalf("expected 404 Not Found, got %d body=%s", w.Code, w.Body.String())
 	}
 }
+
+func TestSetLinkSettingsHandler(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	devMock := mocks.NewMockDeviceManagementFeature(mockCtl)
+
+	engine := gin.New()
+	NewAmtRoutes(engine.Group("/api/v1"), devMock, nil, nil, logger.New("error"))
+
+	policy := 17
+	request := dto.LinkSettingsRequest{Interface: "wired", LinkPolicy: &policy}
+
+	devMock.EXPECT().SetLinkSettings(gomock.Any(), "my-guid", request).
+		Return(dto.LinkSettings{Interface: "wired", InstanceID: "Intel(r) AMT Ethernet Port Settings 0", LinkPolicy: 17}, nil)
+	devMock.EXPECT().SetLinkSettings(gomock.Any(), "my-guid", request).
+		Return(dto.LinkSettings{}, devices.ErrNotSupportedUseCase.Wrap("SetLinkSettings", "device.GetEthernetPorts", "device has no wired interface"))
+
+	put := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/amt/network/linkSettings/my-guid", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+
+		return w
+	}
+
+	w := put(`{"interface":"wired","linkPolicy":17}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"interface":"wired","instanceID":"Intel(r) AMT Ethernet Port Settings 0","linkPolicy":17}`, w.Body.String())
+
+	require.Equal(t, http.StatusNotImplemented, put(`{"interface":"wired","linkPolicy":17}`).Code)
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var ErrValidationLogging = dto.NotValidError{Console: consoleerrors.CreateConsoleError("LoggingAPI")}
+
+type loggingRoutes struct {
+	l logger.Interface
+}
+
+func NewLoggingRoutes(handler *gin.RouterGroup, l logger.Interface) {
+	r := &loggingRoutes{l: l}
+
+	h := handler.Group("/logging")
+	{
+		h.GET("", r.get)
+		h.PUT("", r.update)
+	}
+}
+
+func (r *loggingRoutes) get(c *gin.Context) {
+	c.JSON(http.StatusOK, currentLoggingSettings())
+}
+
+// update changes the log level and the debug targets of the subsystems present in the request.
+// Subsystems missing from the request keep their targets, an empty list disables debug logging.
+// The whole request is validated first, an invalid one changes nothing.
+func (r *loggingRoutes) update(c *gin.Context) {
+	var settings dto.LoggingSettings
+	if err := c.ShouldBindJSON(&settings); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	for subsystem := range settings.Debug {
+		if !slices.Contains(logger.Subsystems(), subsystem) {
+			ErrorResponse(c, ErrValidationLogging.Wrap("update", "Subsystems", fmt.Errorf("%w: %s", logger.ErrUnknownSubsystem, subsystem)))
+
+			return
+		}
+	}
+
+	if settings.Level != "" && !logger.ValidLevel(settings.Level) {
+		ErrorResponse(c, ErrValidationLogging.Wrap("update", "Level", fmt.Errorf("%w: %s", logger.ErrInvalidLevel, settings.Level)))
+
+		return
+	}
+
+	for subsystem, targets := range settings.Debug {
+		if err := logger.SetDebugTargets(subsystem, targets); err != nil {
+			ErrorResponse(c, err)
+
+			return
+		}
+
+		r.l.Info("http - v1 - logging: %s debug logging set to %v", subsystem, targets)
+	}
+
+	if settings.Level != "" {
+		if err := logger.SetLevel(settings.Level); err != nil {
+			ErrorResponse(c, err)
+
+			return
+		}
+
+		r.l.Info("http - v1 - logging: log level set to %s", settings.Level)
+	}
+
+	c.JSON(http.StatusOK, currentLoggingSettings())
+}
+
+func currentLoggingSettings() dto.LoggingSettings {
+	return dto.LoggingSettings{
+		Level: logger.Level(),
+		Debug: logger.DebugTargets(),
+	}
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestUpdateLogging(t *testing.T) { //nolint:paralleltest // changes the global log level and debug targets
+	const guid = "123e4567-e89b-12d3-a456-426614174000"
+
+	log := logger.New("info")
+
+	defer func() {
+		_ = logger.SetLevel("info")
+		_ = logger.SetDebugTargets(logger.SubsystemWsman, nil)
+	}()
+
+	tests := []struct {
+		name         string
+		body         string
+		expectedCode int
+		expected     dto.LoggingSettings
+	}{
+		{
+			name:         "enable wsman debug for a device",
+			body:         `{"debug":{"wsman":["` + guid + `"]}}`,
+			expectedCode: http.StatusOK,
+			expected:     dto.LoggingSettings{Level: "info", Debug: map[string][]string{logger.SubsystemWsman: {guid}}},
+		},
+		{
+			name:         "change level keeps debug targets",
+			body:         `{"level":"debug"}`,
+			expectedCode: http.StatusOK,
+			expected:     dto.LoggingSettings{Level: "debug", Debug: map[string][]string{logger.SubsystemWsman: {guid}}},
+		},
+		{
+			name:         "unknown subsystem",
+			body:         `{"debug":{"wsman":[],"amt":["` + guid + `"]}}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid level",
+			body:         `{"level":"verbose"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid level keeps the debug targets",
+			body:         `{"level":"verbose","debug":{"wsman":[]}}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "disable wsman debug",
+			body:         `{"level":"info","debug":{"wsman":[]}}`,
+			expectedCode: http.StatusOK,
+			expected:     dto.LoggingSettings{Level: "info"},
+		},
+	}
+
+	for _, tc := range tests { //nolint:paralleltest // cases build on each other
+		t.Run(tc.name, func(t *testing.T) {
+			engine := gin.New()
+			NewLoggingRoutes(engine.Group("/api/v1/admin"), log)
+
+			before := currentLoggingSettings()
+
+			w := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPut, "/api/v1/admin/logging", bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+
+			if tc.expectedCode != http.StatusOK {
+				assert.Equal(t, before, currentLoggingSettings(), "an invalid request changes nothing")
+
+				return
+			}
+
+			var settings dto.LoggingSettings
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &settings))
+
+			assert.Equal(t, tc.expected, settings)
+		})
+	}
+}
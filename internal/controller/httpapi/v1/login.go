@@ -2,6 +2,9 @@ package v1
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -13,19 +16,58 @@ import (
 	"github.com/device-management-toolkit/console/config"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/kerberos"
 )
 
-var ErrLogin = consoleerrors.CreateConsoleError("LoginHandler")
+var (
+	ErrLogin = consoleerrors.CreateConsoleError("LoginHandler")
+
+	// ErrPrincipalRejected is returned for a Kerberos principal of another realm or without an enabled account.
+	ErrPrincipalRejected = errors.New("the principal is not an enabled console user")
+)
+
+const (
+	// ContextKeyUser is the gin context key holding the subject of the authenticated token.
+	ContextKeyUser = "user"
+	// ContextKeyTenant is the gin context key holding the tenant of the authenticated token, given by its
+	// tenantId claim. Tokens without the claim belong to the default tenant.
+	ContextKeyTenant = "tenant"
+)
+
+// tenantClaim is the claim naming the tenant of the caller in access tokens.
+const tenantClaim = "tenantId"
+
+const negotiatePrefix = "Negotiate "
+
+type (
+	// NegotiateAcceptor returns the client principal of the Kerberos ticket of a Negotiate token.
+	NegotiateAcceptor interface {
+		Accept(token []byte) (string, error)
+	}
+
+	// UserLookup returns the console account of a username, nil when there is none.
+	UserLookup interface {
+		GetUser(ctx context.Context, username string) (*dto.User, error)
+	}
+)
 
 type LoginRoute struct {
 	Config   *config.Config
 	Verifier *oidc.IDTokenVerifier
+	Events   *eventbus.Bus
+	// Kerberos accepts Negotiate tokens next to the JWT when set, signing in the Users named as their
+	// principals, from KerberosRealm only when it is set.
+	Kerberos      NegotiateAcceptor
+	KerberosRealm string
+	Users         UserLookup
 }
 
 // NewVersionRoute creates a new version route
-func NewLoginRoute(configData *config.Config) *LoginRoute {
+func NewLoginRoute(configData *config.Config, events *eventbus.Bus) *LoginRoute {
 	lr := &LoginRoute{
 		Config: configData,
+		Events: events,
 	}
 
 	if config.ConsoleConfig.ClientID != "" {
@@ -47,7 +89,7 @@ func (lr LoginRoute) Login(c *gin.Context) {
 	var creds dto.Credentials
 
 	if err := c.ShouldBindJSON(&creds); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "code": consoleerrors.CodeValidation})
 
 		return
 	}
@@ -57,14 +99,32 @@ func (lr LoginRoute) Login(c *gin.Context) {
 
 func (lr LoginRoute) handleBasicAuth(creds dto.Credentials, c *gin.Context) {
 	if creds.Username != lr.Config.AdminUsername || creds.Password != lr.Config.AdminPassword {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials", "code": consoleerrors.CodeUnauthorized})
+
+		return
+	}
+
+	lr.issueToken(c, creds.Username, "api")
+}
+
+// KerberosLogin returns a JWT token for the console user of the Kerberos principal of the Negotiate
+// token, so that the UI of a domain-joined workstation signs in without a password.
+func (lr LoginRoute) KerberosLogin(c *gin.Context) {
+	username, err := lr.negotiate(c.Request.Context(), c.GetHeader("Authorization"))
+	if err != nil {
+		lr.negotiateFailed(c, err)
 
 		return
 	}
 
+	lr.issueToken(c, username, "kerberos")
+}
+
+func (lr LoginRoute) issueToken(c *gin.Context, username, source string) {
 	// Create JWT token
 	expirationTime := time.Now().Add(config.ConsoleConfig.JWTExpiration)
 	claims := jwt.RegisteredClaims{
+		Subject:   username,
 		ExpiresAt: jwt.NewNumericDate(expirationTime),
 	}
 
@@ -72,34 +132,121 @@ func (lr LoginRoute) handleBasicAuth(creds dto.Credentials, c *gin.Context) {
 
 	tokenString, err := token.SignedString([]byte(lr.Config.JWTKey))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not create token"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not create token", "code": consoleerrors.CodeInternal})
 
 		return
 	}
 
+	eventbus.Publish(lr.Events, eventbus.SessionCreated, eventbus.SessionCreatedEvent{Username: username, Source: source})
+
 	c.JSON(http.StatusOK, gin.H{"token": tokenString})
 }
 
+// negotiate returns the console user of the client principal of an "Authorization: Negotiate" header.
+func (lr LoginRoute) negotiate(ctx context.Context, header string) (string, error) {
+	if lr.Kerberos == nil || !strings.HasPrefix(header, negotiatePrefix) {
+		return "", kerberos.ErrInvalidToken
+	}
+
+	token, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(header, negotiatePrefix))
+	if err != nil {
+		return "", kerberos.ErrInvalidToken
+	}
+
+	principal, err := lr.Kerberos.Accept(token)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", kerberos.ErrInvalidToken, err)
+	}
+
+	return lr.principalUser(ctx, principal)
+}
+
+// principalUser returns the enabled console user named as principal without its realm, such as jdoe
+// for jdoe@EXAMPLE.COM. A valid ticket alone does not sign in, the principal must have an account.
+func (lr LoginRoute) principalUser(ctx context.Context, principal string) (string, error) {
+	name, realm, _ := strings.Cut(principal, "@")
+	if name == "" || lr.Users == nil || (lr.KerberosRealm != "" && !strings.EqualFold(realm, lr.KerberosRealm)) {
+		return "", ErrPrincipalRejected
+	}
+
+	user, err := lr.Users.GetUser(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	if user == nil || user.Disabled {
+		return "", ErrPrincipalRejected
+	}
+
+	return user.Username, nil
+}
+
+// negotiateFailed aborts a request whose Negotiate token did not sign in, with 403 for a valid ticket
+// of a principal that is not a console user.
+func (lr LoginRoute) negotiateFailed(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrPrincipalRejected):
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error(), "code": consoleerrors.CodeForbidden})
+	case errors.Is(err, kerberos.ErrInvalidToken):
+		lr.unauthorized(c, "invalid negotiate token")
+	default:
+		ErrorResponse(c, err)
+		c.Abort()
+	}
+}
+
+// unauthorized aborts with 401, offering Negotiate to browsers when Kerberos is enabled.
+func (lr LoginRoute) unauthorized(c *gin.Context, message string) {
+	if lr.Kerberos != nil {
+		c.Header("WWW-Authenticate", "Negotiate")
+	}
+
+	c.JSON(http.StatusUnauthorized, gin.H{"error": message, "code": consoleerrors.CodeUnauthorized})
+	c.Abort()
+}
+
 // JWT Middleware
 func (lr LoginRoute) JWTAuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		tokenString := c.GetHeader("Authorization")
+
+		if lr.Kerberos != nil && strings.HasPrefix(tokenString, negotiatePrefix) {
+			username, err := lr.negotiate(c.Request.Context(), tokenString)
+			if err != nil {
+				lr.negotiateFailed(c, err)
+
+				return
+			}
+
+			c.Set(ContextKeyUser, username)
+			c.Next()
+
+			return
+		}
+
 		tokenString = strings.Replace(tokenString, "Bearer ", "", 1)
 
 		if tokenString == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "request does not contain an access token"})
-			c.Abort()
+			lr.unauthorized(c, "request does not contain an access token")
 
 			return
 		}
 
 		// if clientID is set, use the oidc verifier
 		if config.ConsoleConfig.ClientID != "" {
-			_, err := lr.Verifier.Verify(c.Request.Context(), tokenString)
+			idToken, err := lr.Verifier.Verify(c.Request.Context(), tokenString)
 			if err != nil {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid access token"})
-				c.Abort()
+				lr.unauthorized(c, "invalid access token")
+
+				return
 			}
+
+			var claims map[string]interface{}
+			if err := idToken.Claims(&claims); err == nil {
+				c.Set(ContextKeyTenant, tenantOf(claims))
+			}
+
+			c.Set(ContextKeyUser, idToken.Subject)
 		} else {
 			claims := &jwt.MapClaims{}
 
@@ -108,13 +255,25 @@ func (lr LoginRoute) JWTAuthMiddleware() gin.HandlerFunc {
 			})
 
 			if err != nil || !token.Valid {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid access token"})
-				c.Abort()
+				lr.unauthorized(c, "invalid access token")
 
 				return
 			}
+
+			if subject, err := claims.GetSubject(); err == nil {
+				c.Set(ContextKeyUser, subject)
+			}
+
+			c.Set(ContextKeyTenant, tenantOf(*claims))
 		}
 
 		c.Next()
 	}
 }
+
+// tenantOf returns the tenant named by the claims of a token, empty for the default tenant.
+func tenantOf(claims map[string]interface{}) string {
+	tenant, _ := claims[tenantClaim].(string)
+
+	return tenant
+}
@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/pkg/kerberos"
+)
+
+// validTicket accepts every Negotiate token as a valid ticket of its principal.
+type validTicket string
+
+func (p validTicket) Accept([]byte) (string, error) {
+	return string(p), nil
+}
+
+func TestJWTAuthMiddlewareNegotiate(t *testing.T) {
+	t.Parallel()
+
+	kt, err := kerberos.ParseKeytab([]byte{0x05, 0x02})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		kerberos      bool
+		authorization string
+		negotiate     bool
+	}{
+		{name: "no token without kerberos", authorization: ""},
+		{name: "no token with kerberos", kerberos: true, authorization: "", negotiate: true},
+		{name: "invalid base64", kerberos: true, authorization: "Negotiate !!", negotiate: true},
+		{name: "ntlm token", kerberos: true, authorization: "Negotiate TlRMTVNTUAABAAAA", negotiate: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lr := LoginRoute{}
+			if tc.kerberos {
+				lr.Kerberos = kerberos.NewAcceptor(kt, "")
+			}
+
+			engine := gin.New()
+			engine.GET("/api/v1/test", lr.JWTAuthMiddleware(), func(c *gin.Context) { c.Status(http.StatusOK) })
+			engine.GET("/api/v1/authorize/kerberos", lr.KerberosLogin)
+
+			for _, path := range []string{"/api/v1/test", "/api/v1/authorize/kerberos"} {
+				req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+				if tc.authorization != "" {
+					req.Header.Set("Authorization", tc.authorization)
+				}
+
+				w := httptest.NewRecorder()
+				engine.ServeHTTP(w, req)
+
+				assert.Equal(t, http.StatusUnauthorized, w.Code, path)
+
+				if tc.negotiate {
+					assert.Equal(t, "Negotiate", w.Header().Get("WWW-Authenticate"), path)
+				} else {
+					assert.Empty(t, w.Header().Get("WWW-Authenticate"), path)
+				}
+			}
+		})
+	}
+}
+
+func TestNegotiatePrincipal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		principal string
+		realm     string
+		mock      func(m *mocks.MockLDAPSyncFeature)
+		code      int
+		user      string
+	}{
+		{
+			name:      "enabled user",
+			principal: "jdoe@EXAMPLE.COM",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "jdoe").Return(&dto.User{Username: "jdoe", Role: "operator"}, nil)
+			},
+			code: http.StatusOK,
+			user: "jdoe",
+		},
+		{
+			name:      "enabled user of the realm",
+			principal: "jdoe@example.com",
+			realm:     "EXAMPLE.COM",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "jdoe").Return(&dto.User{Username: "jdoe"}, nil)
+			},
+			code: http.StatusOK,
+			user: "jdoe",
+		},
+		{
+			name:      "principal without an account",
+			principal: "mallory@EXAMPLE.COM",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "mallory").Return(nil, nil)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name:      "disabled user",
+			principal: "jdoe@EXAMPLE.COM",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "jdoe").Return(&dto.User{Username: "jdoe", Disabled: true}, nil)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name:      "principal of another realm",
+			principal: "jdoe@OTHER.COM",
+			realm:     "EXAMPLE.COM",
+			mock:      func(_ *mocks.MockLDAPSyncFeature) {},
+			code:      http.StatusForbidden,
+		},
+		{
+			name:      "lookup failure",
+			principal: "jdoe@EXAMPLE.COM",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "jdoe").Return(nil, errors.New("database is down"))
+			},
+			code: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			users := mocks.NewMockLDAPSyncFeature(gomock.NewController(t))
+			tc.mock(users)
+
+			lr := LoginRoute{Kerberos: validTicket(tc.principal), KerberosRealm: tc.realm, Users: users}
+
+			engine := gin.New()
+			engine.GET("/api/v1/test", lr.JWTAuthMiddleware(), func(c *gin.Context) {
+				c.String(http.StatusOK, c.GetString(ContextKeyUser))
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/test", http.NoBody)
+			req.Header.Set("Authorization", "Negotiate YIIB")
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code, w.Body.String())
+
+			if tc.user != "" {
+				assert.Equal(t, tc.user, w.Body.String(), "the request runs as the console user of the principal")
+			}
+		})
+	}
+}
+
+func TestKerberosLoginRejectedPrincipal(t *testing.T) {
+	t.Parallel()
+
+	users := mocks.NewMockLDAPSyncFeature(gomock.NewController(t))
+	users.EXPECT().GetUser(gomock.Any(), "mallory").Return(nil, nil)
+
+	lr := LoginRoute{Kerberos: validTicket("mallory@EXAMPLE.COM"), Users: users}
+
+	engine := gin.New()
+	engine.GET("/api/v1/authorize/kerberos", lr.KerberosLogin)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/authorize/kerberos", http.NoBody)
+	req.Header.Set("Authorization", "Negotiate YIIB")
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	// a valid ticket of a principal without an account gets no token
+	require.Equal(t, http.StatusForbidden, w.Code)
+	assert.NotContains(t, w.Body.String(), "token")
+}
+
+func TestTenantOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "t1", tenantOf(jwt.MapClaims{"sub": "a", "tenantId": "t1"}))
+	assert.Empty(t, tenantOf(jwt.MapClaims{"sub": "a"}))
+	assert.Empty(t, tenantOf(jwt.MapClaims{"tenantId": 1}))
+}
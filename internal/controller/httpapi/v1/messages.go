@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+)
+
+// Messages returns the translation catalog negotiated from Accept-Language, or
+// the one named by the lang query parameter. The UI uses it for strings the
+// API does not render itself, such as power action names and consent prompts.
+func Messages(c *gin.Context) {
+	lang := i18n.Language(c)
+
+	if requested := c.Query("lang"); requested != "" && slices.Contains(i18n.Languages(), requested) {
+		lang = requested
+	}
+
+	c.Header("Content-Language", lang)
+	c.JSON(http.StatusOK, dto.Messages{
+		Language:  lang,
+		Languages: i18n.Languages(),
+		Messages:  i18n.Catalog(lang),
+	})
+}
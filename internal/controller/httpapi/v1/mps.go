@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// mpsReturnValue is the body of the MPS responses to a power action or a change of the boot options.
+type mpsReturnValue struct {
+	ReturnValue    int    `json:"ReturnValue"`
+	ReturnValueStr string `json:"ReturnValueStr"`
+}
+
+type mpsRoutes struct {
+	d devices.Feature
+	l logger.Interface
+}
+
+// NewMPSRoutes serves the most used endpoints of MPS at their MPS paths, relative to handler, so that the scripts
+// and the Sample Web UI written for MPS can be pointed at the console. The device list, a device, its version and
+// power state have the same shape in both; the power actions and the general settings are answered as MPS does.
+func NewMPSRoutes(handler *gin.RouterGroup, d devices.Feature, l logger.Interface) {
+	r := &mpsRoutes{d, l}
+	dr := &deviceRoutes{t: d, l: l}
+	dm := &deviceManagementRoutes{d: d, l: l}
+
+	h := handler.Group("/devices")
+	{
+		h.GET("", dr.get)
+		h.GET("stats", dr.getStats)
+		h.GET(":guid", r.getDevice)
+	}
+
+	a := handler.Group("/amt")
+	{
+		a.GET("version/:guid", dm.getVersion)
+		a.GET("power/state/:guid", dm.getPowerState)
+		a.POST("power/action/:guid", r.powerAction)
+		a.POST("power/bootOptions/:guid", r.setBootOptions)
+		a.GET("generalSettings/:guid", r.getGeneralSettings)
+	}
+}
+
+// getDevice returns a device as MPS does, without the correlations of the console.
+func (r *mpsRoutes) getDevice(c *gin.Context) {
+	item, err := r.d.GetByID(c.Request.Context(), c.Param("guid"), "", false)
+	if err != nil {
+		r.l.Error(err, "http - mps - v1 - getDevice")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+func (r *mpsRoutes) powerAction(c *gin.Context) {
+	var powerAction dto.PowerAction
+	if err := c.ShouldBindJSON(&powerAction); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	response, err := r.d.SendPowerAction(lockContext(c), c.Param("guid"), powerAction.Action)
+	if err != nil {
+		r.l.Error(err, "http - mps - v1 - powerAction")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"Body": mpsBody(response)})
+}
+
+func (r *mpsRoutes) setBootOptions(c *gin.Context) {
+	var bootSetting dto.BootSetting
+	if err := c.ShouldBindJSON(&bootSetting); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	response, err := r.d.SetBootOptions(lockContext(c), c.Param("guid"), bootSetting)
+	if err != nil {
+		r.l.Error(err, "http - mps - v1 - setBootOptions")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"Body": mpsBody(response)})
+}
+
+// mpsBody names the return value of AMT as MPS does, the name of its PT_STATUS code.
+func mpsBody(response power.PowerActionResponse) mpsReturnValue {
+	code := int(response.ReturnValue)
+
+	return mpsReturnValue{
+		ReturnValue:    code,
+		ReturnValueStr: strings.TrimPrefix(amtstatus.Explain(code).Name, "PT_STATUS_"),
+	}
+}
+
+// getGeneralSettings returns the AMT_GeneralSettings of a device in the envelope MPS answers with.
+func (r *mpsRoutes) getGeneralSettings(c *gin.Context) {
+	generalSettings, err := r.d.GetGeneralSettings(c.Request.Context(), c.Param("guid"))
+	if err != nil {
+		r.l.Error(err, "http - mps - v1 - getGeneralSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	// the settings are the decoded XML of the device, the XML name is not part of them
+	var settings map[string]any
+
+	data, err := json.Marshal(generalSettings.Body)
+	if err == nil {
+		err = json.Unmarshal(data, &settings)
+	}
+
+	if err != nil {
+		r.l.Error(err, "http - mps - v1 - getGeneralSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	delete(settings, "XMLName")
+
+	c.JSON(http.StatusOK, gin.H{
+		"Header": gin.H{},
+		"Body":   gin.H{"AMT_GeneralSettings": settings},
+	})
+}
@@ -0,0 +1,186 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/general"
+	power "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func mpsTest(t *testing.T) (*mocks.MockDeviceManagementFeature, *gin.Engine) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+	deviceManagement := mocks.NewMockDeviceManagementFeature(mockCtl)
+	engine := gin.New()
+	handler := engine.Group("/mps/api/v1")
+
+	NewMPSRoutes(handler, deviceManagement, logger.New("error"))
+
+	return deviceManagement, engine
+}
+
+func TestMPSRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		url          string
+		method       string
+		requestBody  interface{}
+		mock         func(m *mocks.MockDeviceManagementFeature)
+		expectedCode int
+		response     interface{}
+	}{
+		{
+			name:   "getDevice - successful retrieval",
+			url:    "/mps/api/v1/devices/valid-guid",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetByID(context.Background(), "valid-guid", "", false).
+					Return(&dto.Device{GUID: "valid-guid", Hostname: "host"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			response:     dto.Device{GUID: "valid-guid", Hostname: "host"},
+		},
+		{
+			name:   "getDevice - service failure",
+			url:    "/mps/api/v1/devices/valid-guid",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetByID(context.Background(), "valid-guid", "", false).
+					Return(nil, ErrGeneral)
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:        "powerAction - successful action",
+			url:         "/mps/api/v1/amt/power/action/valid-guid",
+			method:      http.MethodPost,
+			requestBody: dto.PowerAction{Action: 8},
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().SendPowerAction(context.Background(), "valid-guid", 8).
+					Return(power.PowerActionResponse{ReturnValue: 0}, nil)
+			},
+			expectedCode: http.StatusOK,
+			response:     gin.H{"Body": mpsReturnValue{ReturnValue: 0, ReturnValueStr: "SUCCESS"}},
+		},
+		{
+			name:        "powerAction - service failure",
+			url:         "/mps/api/v1/amt/power/action/valid-guid",
+			method:      http.MethodPost,
+			requestBody: dto.PowerAction{Action: 8},
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().SendPowerAction(context.Background(), "valid-guid", 8).
+					Return(power.PowerActionResponse{}, ErrGeneral)
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:         "powerAction - invalid JSON payload",
+			url:          "/mps/api/v1/amt/power/action/valid-guid",
+			method:       http.MethodPost,
+			requestBody:  "invalid-json",
+			mock:         func(_ *mocks.MockDeviceManagementFeature) {},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:        "setBootOptions - successful change",
+			url:         "/mps/api/v1/amt/power/bootOptions/valid-guid",
+			method:      http.MethodPost,
+			requestBody: dto.BootSetting{Action: 400},
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().SetBootOptions(context.Background(), "valid-guid", dto.BootSetting{Action: 400}).
+					Return(power.PowerActionResponse{ReturnValue: 0}, nil)
+			},
+			expectedCode: http.StatusOK,
+			response:     gin.H{"Body": mpsReturnValue{ReturnValue: 0, ReturnValueStr: "SUCCESS"}},
+		},
+		{
+			name:   "getGeneralSettings - service failure",
+			url:    "/mps/api/v1/amt/generalSettings/valid-guid",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetGeneralSettings(context.Background(), "valid-guid").
+					Return(dto.GeneralSettings{}, ErrGeneral)
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deviceManagement, engine := mpsTest(t)
+
+			tc.mock(deviceManagement)
+
+			var req *http.Request
+
+			var err error
+
+			if tc.method == http.MethodPost {
+				reqBody, _ := json.Marshal(tc.requestBody)
+				req, err = http.NewRequestWithContext(context.Background(), tc.method, tc.url, bytes.NewBuffer(reqBody))
+			} else {
+				req, err = http.NewRequestWithContext(context.Background(), tc.method, tc.url, http.NoBody)
+			}
+
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+
+			if tc.response != nil {
+				jsonBytes, _ := json.Marshal(tc.response)
+				require.Equal(t, string(jsonBytes), w.Body.String())
+			}
+		})
+	}
+}
+
+func TestMPSGeneralSettingsEnvelope(t *testing.T) {
+	t.Parallel()
+
+	deviceManagement, engine := mpsTest(t)
+
+	deviceManagement.EXPECT().GetGeneralSettings(context.Background(), "valid-guid").
+		Return(dto.GeneralSettings{Body: general.GeneralSettingsResponse{HostName: "host", DigestRealm: "Digest:1234"}}, nil)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/mps/api/v1/amt/generalSettings/valid-guid", http.NoBody)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Header map[string]any
+		Body   struct {
+			AMTGeneralSettings map[string]any `json:"AMT_GeneralSettings"`
+		}
+	}
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.NotNil(t, response.Header)
+	require.Equal(t, "host", response.Body.AMTGeneralSettings["HostName"])
+	require.Equal(t, "Digest:1234", response.Body.AMTGeneralSettings["DigestRealm"])
+	require.NotContains(t, response.Body.AMTGeneralSettings, "XMLName")
+}
@@ -9,7 +9,9 @@ import (
 func (r *deviceManagementRoutes) getNetworkSettings(c *gin.Context) {
 	guid := c.Param("guid")
 
-	network, err := r.d.GetNetworkSettings(c.Request.Context(), guid)
+	selection := fields(c)
+
+	network, err := r.d.GetNetworkSettings(c.Request.Context(), guid, selection...)
 	if err != nil {
 		r.l.Error(err, "http - v1 - getNetworkSettings")
 		ErrorResponse(c, err)
@@ -17,5 +19,12 @@ func (r *deviceManagementRoutes) getNetworkSettings(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, network)
+	response, err := selectFields(network, selection)
+	if err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
 }
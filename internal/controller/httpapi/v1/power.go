@@ -46,7 +46,7 @@ func (r *deviceManagementRoutes) powerAction(c *gin.Context) {
 		return
 	}
 
-	response, err := r.d.SendPowerAction(c.Request.Context(), guid, powerAction.Action)
+	response, err := r.d.SendPowerAction(lockContext(c), guid, powerAction.Action)
 	if err != nil {
 		r.l.Error(err, "http - v1 - powerAction")
 		ErrorResponse(c, err)
@@ -67,7 +67,7 @@ func (r *deviceManagementRoutes) setBootOptions(c *gin.Context) {
 		return
 	}
 
-	features, err := r.d.SetBootOptions(c.Request.Context(), guid, bootSetting)
+	features, err := r.d.SetBootOptions(lockContext(c), guid, bootSetting)
 	if err != nil {
 		r.l.Error(err, "http - v1 - setBootOptions")
 		ErrorResponse(c, err)
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/powerhistory"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+type powerHistoryRoutes struct {
+	p powerhistory.Feature
+	l logger.Interface
+}
+
+func NewPowerHistoryRoutes(handler *gin.RouterGroup, p powerhistory.Feature, l logger.Interface) {
+	r := &powerHistoryRoutes{p, l}
+
+	h := handler.Group("/amt")
+	{
+		h.GET("power/history/:guid", r.getPowerHistory)
+	}
+}
+
+func (r *powerHistoryRoutes) getPowerHistory(c *gin.Context) {
+	guid := c.Param("guid")
+
+	var query dto.PowerHistoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	history, err := r.p.GetHistory(c.Request.Context(), guid, query)
+	if err != nil {
+		r.l.Error(err, "http - v1 - getPowerHistory")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
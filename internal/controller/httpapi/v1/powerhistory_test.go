@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestPowerHistoryRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		url          string
+		mock         func(m *mocks.MockPowerHistoryFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name: "history with query",
+			url:  "/api/v1/amt/power/history/valid-guid?hours=48&buckets=12",
+			mock: func(m *mocks.MockPowerHistoryFeature) {
+				m.EXPECT().GetHistory(context.Background(), "valid-guid", dto.PowerHistoryQuery{Hours: 48, Buckets: 12}).
+					Return(dto.PowerHistory{GUID: "valid-guid", UptimeSeconds: 3600, Availability: 0.5}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"uptimeSeconds":3600`,
+		},
+		{
+			name: "history with defaults",
+			url:  "/api/v1/amt/power/history/valid-guid",
+			mock: func(m *mocks.MockPowerHistoryFeature) {
+				m.EXPECT().GetHistory(context.Background(), "valid-guid", dto.PowerHistoryQuery{Hours: 24, Buckets: 24}).
+					Return(dto.PowerHistory{GUID: "valid-guid"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"guid":"valid-guid"`,
+		},
+		{
+			name: "unknown device",
+			url:  "/api/v1/amt/power/history/unknown-guid",
+			mock: func(m *mocks.MockPowerHistoryFeature) {
+				m.EXPECT().GetHistory(context.Background(), "unknown-guid", dto.PowerHistoryQuery{Hours: 24, Buckets: 24}).
+					Return(dto.PowerHistory{}, devices.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			powerHistory := mocks.NewMockPowerHistoryFeature(mockCtl)
+			tc.mock(powerHistory)
+
+			engine := gin.New()
+			NewPowerHistoryRoutes(engine.Group("/api/v1"), powerHistory, logger.New("error"))
+
+			req, err := http.NewRequest(http.MethodGet, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"net/http/pprof"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+type pprofRoutes struct {
+	l logger.Interface
+}
+
+// NewPprofRoutes exposes the Go runtime profiles, e.g. go tool pprof <url>/debug/pprof/heap.
+// CPU profiles and traces must be shorter than the server write timeout, use ?seconds=10.
+func NewPprofRoutes(handler *gin.RouterGroup, l logger.Interface) {
+	r := &pprofRoutes{l: l}
+
+	h := handler.Group("/debug/pprof")
+	{
+		h.GET("/", gin.WrapF(pprof.Index))
+		h.GET("/:name", r.profile)
+		h.POST("/symbol", gin.WrapF(pprof.Symbol))
+	}
+}
+
+func (r *pprofRoutes) profile(c *gin.Context) {
+	name := c.Param("name")
+
+	r.l.Debug("http - v1 - pprof - profile: " + name)
+
+	switch name {
+	case "cmdline":
+		pprof.Cmdline(c.Writer, c.Request)
+	case "profile":
+		pprof.Profile(c.Writer, c.Request)
+	case "symbol":
+		pprof.Symbol(c.Writer, c.Request)
+	case "trace":
+		pprof.Trace(c.Writer, c.Request)
+	default:
+		pprof.Handler(name).ServeHTTP(c.Writer, c.Request)
+	}
+}
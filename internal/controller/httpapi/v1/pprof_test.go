@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestPprofRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "index",
+			method:       http.MethodGet,
+			url:          "/api/v1/admin/debug/pprof/",
+			expectedCode: http.StatusOK,
+			expectedBody: "goroutine",
+		},
+		{
+			name:         "goroutine profile",
+			method:       http.MethodGet,
+			url:          "/api/v1/admin/debug/pprof/goroutine?debug=1",
+			expectedCode: http.StatusOK,
+			expectedBody: "goroutine profile:",
+		},
+		{
+			name:         "cmdline",
+			method:       http.MethodGet,
+			url:          "/api/v1/admin/debug/pprof/cmdline",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "symbol lookup",
+			method:       http.MethodPost,
+			url:          "/api/v1/admin/debug/pprof/symbol",
+			expectedCode: http.StatusOK,
+			expectedBody: "num_symbols",
+		},
+		{
+			name:         "unknown profile",
+			method:       http.MethodGet,
+			url:          "/api/v1/admin/debug/pprof/missing",
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Unknown profile",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			engine := gin.New()
+			NewPprofRoutes(engine.Group("/api/v1/admin"), logger.New("error"))
+
+			req, err := http.NewRequest(tc.method, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
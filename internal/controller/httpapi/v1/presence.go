@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// pingPresence records that the user has the device details or a KVM session of the device open
+// and returns the users with the device open.
+func (dr *deviceRoutes) pingPresence(c *gin.Context) {
+	var ping dto.PresencePing
+	if err := c.ShouldBindJSON(&ping); err != nil {
+		ErrorResponse(c, ErrValidationDevices.Wrap("pingPresence", "ShouldBindJSON", err))
+
+		return
+	}
+
+	presence, err := dr.t.PingPresence(c.Request.Context(), c.Param("guid"), c.GetString(ContextKeyUser), ping)
+	if err != nil {
+		dr.l.Error(err, "http - devices - v1 - pingPresence")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, presence)
+}
+
+// getPresence returns the users with the device open.
+func (dr *deviceRoutes) getPresence(c *gin.Context) {
+	c.JSON(http.StatusOK, dr.t.GetPresence(c.Request.Context(), c.Param("guid")))
+}
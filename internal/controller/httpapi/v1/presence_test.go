@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// devicesTestAs is devicesTest for requests authenticated as user, none when user is empty.
+func devicesTestAs(t *testing.T, user string) (*mocks.MockDeviceManagementFeature, *gin.Engine) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+	device := mocks.NewMockDeviceManagementFeature(mockCtl)
+
+	engine := gin.New()
+	engine.Use(func(c *gin.Context) {
+		if user != "" {
+			c.Set(ContextKeyUser, user)
+		}
+	})
+	NewDeviceRoutes(engine.Group("/api/v1"), device, mocks.NewMockCorrelationsFeature(mockCtl), logger.New("error"))
+
+	return device, engine
+}
+
+func TestPresenceRoutes(t *testing.T) {
+	t.Parallel()
+
+	seen := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	presence := []dto.Presence{
+		{User: "alice", View: dto.PresenceViewKVM, LastSeen: seen},
+		{User: "bob", View: dto.PresenceViewDetails, LastSeen: seen},
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		user         string
+		body         string
+		mock         func(*mocks.MockDeviceManagementFeature)
+		expectedCode int
+	}{
+		{
+			name:   "get",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetPresence(context.Background(), "guid").Return(presence)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "ping",
+			method: http.MethodPost,
+			user:   "alice",
+			body:   `{"view":"kvm"}`,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().PingPresence(context.Background(), "guid", "alice", dto.PresencePing{View: dto.PresenceViewKVM}).Return(presence, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "ping - no user",
+			method: http.MethodPost,
+			body:   `{"view":"details"}`,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().PingPresence(context.Background(), "guid", "", dto.PresencePing{View: dto.PresenceViewDetails}).
+					Return(nil, devices.ErrValidationUseCase.Wrap("PingPresence", "validate user", "presence requires an authenticated user"))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "ping - malformed body",
+			method:       http.MethodPost,
+			user:         "alice",
+			body:         `{"view":`,
+			mock:         func(*mocks.MockDeviceManagementFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			device, engine := devicesTestAs(t, tc.user)
+			tc.mock(device)
+
+			req := httptest.NewRequest(tc.method, "/api/v1/devices/guid/presence", bytes.NewBufferString(tc.body))
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+
+			if tc.expectedCode == http.StatusOK {
+				var response []dto.Presence
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				require.Equal(t, presence, response)
+			}
+		})
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/gin-gonic/gin/binding"
-	"github.com/go-playground/validator/v10"
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/profiles"
@@ -23,13 +21,6 @@ type profileRoutes struct {
 func NewProfileRoutes(handler *gin.RouterGroup, t profiles.Feature, l logger.Interface) {
 	r := &profileRoutes{t, l}
 
-	if binding.Validator != nil {
-		if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-			_ = v.RegisterValidation("genpasswordwone", dto.ValidateAMTPassOrGenRan)
-			_ = v.RegisterValidation("ciraortls", dto.ValidateCIRAOrTLS)
-		}
-	}
-
 	h := handler.Group("/profiles")
 	{
 		h.GET("", r.get)
@@ -38,6 +29,7 @@ func NewProfileRoutes(handler *gin.RouterGroup, t profiles.Feature, l logger.Int
 		h.PATCH("", r.update)
 		h.DELETE(":name", r.delete)
 		h.GET("export/:name", r.export)
+		h.GET(":name/tls/compliance", r.getTLSCompliance)
 	}
 }
 
@@ -112,6 +104,18 @@ func (r *profileRoutes) export(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+func (r *profileRoutes) getTLSCompliance(c *gin.Context) {
+	report, err := r.t.GetTLSCompliance(c.Request.Context(), c.Param("name"), "")
+	if err != nil {
+		r.l.Error(err, "http - v1 - getTLSCompliance")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
 func (r *profileRoutes) insert(c *gin.Context) {
 	var profile dto.Profile
 	if err := c.ShouldBindJSON(&profile); err != nil {
@@ -155,7 +159,28 @@ func (r *profileRoutes) update(c *gin.Context) {
 func (r *profileRoutes) delete(c *gin.Context) {
 	name := c.Param("name")
 
-	err := r.t.Delete(c.Request.Context(), name, "")
+	dryRun, err := isDryRun(c)
+	if err != nil {
+		ErrorResponse(c, ErrValidationProfile.Wrap("delete", "isDryRun", err))
+
+		return
+	}
+
+	if dryRun {
+		result, err := r.t.PlanDelete(c.Request.Context(), name, "")
+		if err != nil {
+			r.l.Error(err, "http - v1 - delete")
+			ErrorResponse(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, result)
+
+		return
+	}
+
+	err = r.t.Delete(c.Request.Context(), name, "")
 	if err != nil {
 		r.l.Error(err, "http - v1 - delete")
 		ErrorResponse(c, err)
@@ -176,6 +176,22 @@ func TestProfileRoutes(t *testing.T) { //nolint:gocognit // this is a test funct
 			response:     profiles.ErrDatabase,
 			expectedCode: http.StatusBadRequest,
 		},
+		{
+			name:   "delete profile - dry run",
+			method: http.MethodDelete,
+			url:    "/api/v1/admin/profiles/profile?dryRun=true",
+			mock: func(profile *mocks.MockProfilesFeature) {
+				profile.EXPECT().PlanDelete(context.Background(), "profile", "").Return(dto.DryRunResult{
+					Operation: "delete profile",
+					Actions:   []dto.PlannedAction{{Target: "profile", Action: "delete the profile"}},
+				}, nil)
+			},
+			response: dto.DryRunResult{
+				Operation: "delete profile",
+				Actions:   []dto.PlannedAction{{Target: "profile", Action: "delete the profile"}},
+			},
+			expectedCode: http.StatusOK,
+		},
 		{
 			name:   "update profile",
 			method: http.MethodPatch,
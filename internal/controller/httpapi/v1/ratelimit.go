@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+)
+
+// bucketPruneInterval is how often the buckets are scanned for the ones no longer used.
+const bucketPruneInterval = time.Minute
+
+// buckets are the token buckets of one limit, one bucket per tenant or per token.
+type buckets struct {
+	limit rate.Limit
+	burst int
+
+	mu     sync.Mutex
+	items  map[string]*bucket
+	pruned time.Time
+}
+
+type bucket struct {
+	limiter *rate.Limiter
+	seen    time.Time
+}
+
+func newBuckets(limit float64, burst int) *buckets {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &buckets{limit: rate.Limit(limit), burst: max(burst, 1), items: map[string]*bucket{}}
+}
+
+// get returns the bucket of key. Buckets unused for long enough to be full again are dropped, a new
+// bucket for their key starts full as well.
+func (b *buckets) get(key string, now time.Time) *rate.Limiter {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Sub(b.pruned) > bucketPruneInterval {
+		refill := time.Duration(float64(b.burst) / float64(b.limit) * float64(time.Second))
+
+		for k, item := range b.items {
+			if now.Sub(item.seen) > refill {
+				delete(b.items, k)
+			}
+		}
+
+		b.pruned = now
+	}
+
+	item, ok := b.items[key]
+	if !ok {
+		item = &bucket{limiter: rate.NewLimiter(b.limit, b.burst)}
+		b.items[key] = item
+	}
+
+	item.seen = now
+
+	return item.limiter
+}
+
+// RateLimitMiddleware caps the requests of each tenant and of each access token with token buckets. The tenant
+// is the one authenticated by the access token, never one the client names in the request.
+// Every response carries the RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers of the
+// bucket closest to running out, a request over a limit gets 429 Too Many Requests with Retry-After.
+func RateLimitMiddleware(limits config.RateLimits) gin.HandlerFunc {
+	tenants := newBuckets(limits.Tenant, limits.TenantBurst)
+	tokens := newBuckets(limits.Token, limits.TokenBurst)
+
+	return func(c *gin.Context) {
+		if tenants == nil && tokens == nil {
+			c.Next()
+
+			return
+		}
+
+		now := time.Now()
+		limiters := make([]*rate.Limiter, 0, 2)
+
+		if tenants != nil {
+			limiters = append(limiters, tenants.get(c.GetString(ContextKeyTenant), now))
+		}
+
+		if tokens != nil {
+			limiters = append(limiters, tokens.get(tokenKey(c), now))
+		}
+
+		reservations := make([]*rate.Reservation, 0, len(limiters))
+
+		var wait time.Duration
+
+		for _, limiter := range limiters {
+			r := limiter.ReserveN(now, 1)
+			reservations = append(reservations, r)
+			wait = max(wait, r.DelayFrom(now))
+		}
+
+		if wait > 0 {
+			// the request is refused, it does not count against the buckets that had room for it
+			for _, r := range reservations {
+				r.CancelAt(now)
+			}
+		}
+
+		setRateLimitHeaders(c, limiters, now)
+
+		if wait > 0 {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+
+			abortWithError(c, http.StatusTooManyRequests, consoleerrors.CodeRateLimited, i18n.T(i18n.Language(c), "error.rateLimited"))
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// tokenKey identifies the access token of the request without keeping it, or the client address
+// when the request carries none.
+func tokenKey(c *gin.Context) string {
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return "addr " + c.ClientIP()
+	}
+
+	sum := sha256.Sum256([]byte(token))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// setRateLimitHeaders describes the bucket with the fewest requests left (draft-ietf-httpapi-ratelimit-headers):
+// its size, the requests left and the seconds until it is full again.
+func setRateLimitHeaders(c *gin.Context, limiters []*rate.Limiter, now time.Time) {
+	var (
+		closest   *rate.Limiter
+		remaining = math.Inf(1)
+	)
+
+	for _, limiter := range limiters {
+		if tokens := limiter.TokensAt(now); tokens < remaining {
+			closest, remaining = limiter, tokens
+		}
+	}
+
+	reset := (float64(closest.Burst()) - remaining) / float64(closest.Limit())
+
+	c.Header("RateLimit-Limit", strconv.Itoa(closest.Burst()))
+	c.Header("RateLimit-Remaining", strconv.Itoa(max(int(remaining), 0)))
+	c.Header("RateLimit-Reset", strconv.Itoa(int(math.Ceil(reset))))
+}
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+func rateLimitedGet(engine *gin.Engine, target, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, http.NoBody)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	return w
+}
+
+func rateLimitTestEngine(limits config.RateLimits) *gin.Engine {
+	engine := gin.New()
+	engine.GET("/api/v1/devices", RateLimitMiddleware(limits), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	return engine
+}
+
+func TestRateLimitMiddlewareToken(t *testing.T) {
+	t.Parallel()
+
+	engine := rateLimitTestEngine(config.RateLimits{Token: 1, TokenBurst: 2})
+
+	first := rateLimitedGet(engine, "/api/v1/devices", "a")
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "2", first.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", first.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "1", first.Header().Get("RateLimit-Reset"))
+
+	assert.Equal(t, http.StatusOK, rateLimitedGet(engine, "/api/v1/devices", "a").Code)
+
+	refused := rateLimitedGet(engine, "/api/v1/devices", "a")
+	assert.Equal(t, http.StatusTooManyRequests, refused.Code)
+	assert.Equal(t, "0", refused.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "1", refused.Header().Get("Retry-After"))
+
+	// another token has a bucket of its own
+	assert.Equal(t, http.StatusOK, rateLimitedGet(engine, "/api/v1/devices", "b").Code)
+}
+
+func TestRateLimitMiddlewareTenant(t *testing.T) {
+	t.Parallel()
+
+	// the tenant is the one the authentication middleware found in the access token
+	tenants := map[string]string{"a": "t1", "b": "t1", "c": "t2"}
+	authenticate := func(c *gin.Context) {
+		c.Set(ContextKeyTenant, tenants[strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")])
+	}
+
+	engine := gin.New()
+	engine.GET("/api/v1/devices", authenticate, RateLimitMiddleware(config.RateLimits{Tenant: 0.5, TenantBurst: 1, Token: 0.5, TokenBurst: 1}),
+		func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	// the tenant bucket is shared by the tokens of the tenant
+	first := rateLimitedGet(engine, "/api/v1/devices", "a")
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "1", first.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "0", first.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "2", first.Header().Get("RateLimit-Reset"))
+
+	refused := rateLimitedGet(engine, "/api/v1/devices", "b")
+	assert.Equal(t, http.StatusTooManyRequests, refused.Code)
+	assert.Equal(t, "2", refused.Header().Get("Retry-After"))
+
+	// naming another tenant in the request does not get a fresh bucket
+	assert.Equal(t, http.StatusTooManyRequests, rateLimitedGet(engine, "/api/v1/devices?tenantId=t2", "b").Code)
+
+	// another tenant has a bucket of its own
+	assert.Equal(t, http.StatusOK, rateLimitedGet(engine, "/api/v1/devices", "c").Code)
+}
+
+func TestRateLimitMiddlewareDisabled(t *testing.T) {
+	t.Parallel()
+
+	engine := rateLimitTestEngine(config.RateLimits{TenantBurst: 1, TokenBurst: 1})
+
+	for range 3 {
+		w := rateLimitedGet(engine, "/api/v1/devices", "a")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("RateLimit-Limit"))
+	}
+}
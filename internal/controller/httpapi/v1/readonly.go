@@ -0,0 +1,157 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// readOnlyRoute is the route switching the read-only mode, it is served in read-only mode so that the mode can be left.
+const readOnlyRoute = "/api/v1/admin/readOnly"
+
+// ReadOnlyMode is the switch of the read-only mode, shared by the middleware and the admin routes.
+type ReadOnlyMode struct {
+	mu       sync.RWMutex
+	settings dto.ReadOnlySettings
+}
+
+// NewReadOnlyMode returns the switch in the configured state.
+func NewReadOnlyMode(cfg config.ReadOnly) *ReadOnlyMode {
+	m := &ReadOnlyMode{}
+	m.set(dto.ReadOnlySettings{Enabled: cfg.Enabled, RetryAfter: int(cfg.RetryAfter.Seconds())})
+
+	return m
+}
+
+// Settings returns the current state of the read-only mode.
+func (m *ReadOnlyMode) Settings() dto.ReadOnlySettings {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.settings
+}
+
+// set switches the read-only mode, the time it was enabled is kept while it stays enabled.
+func (m *ReadOnlyMode) set(settings dto.ReadOnlySettings) dto.ReadOnlySettings {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if settings.RetryAfter == 0 {
+		settings.RetryAfter = max(m.settings.RetryAfter, 1)
+	}
+
+	settings.Since = nil
+
+	if settings.Enabled {
+		settings.Since = m.settings.Since
+		if settings.Since == nil {
+			now := time.Now().UTC()
+			settings.Since = &now
+		}
+	} else {
+		settings.Reason = ""
+	}
+
+	m.settings = settings
+
+	return settings
+}
+
+// State reports whether the read-only mode is enabled and the seconds a rejected client should wait, for the
+// routes served outside of the API such as the Redfish service.
+func (m *ReadOnlyMode) State() (enabled bool, retryAfter int) {
+	settings := m.Settings()
+
+	return settings.Enabled, settings.RetryAfter
+}
+
+// ReadOnlyMiddleware rejects the requests other than GET, HEAD and OPTIONS with 503 Service Unavailable and
+// Retry-After while the read-only mode is enabled. The route switching the mode is always served.
+func ReadOnlyMiddleware(mode *ReadOnlyMode) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+
+			return
+		}
+
+		if c.FullPath() == readOnlyRoute {
+			c.Next()
+
+			return
+		}
+
+		rejectWhileReadOnly(c, mode)
+	}
+}
+
+// ReadOnlyRouteMiddleware rejects every request of its routes while the read-only mode is enabled, for the
+// routes changing data whatever their method, such as the WebSocket activating devices at /activate.
+func ReadOnlyRouteMiddleware(mode *ReadOnlyMode) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rejectWhileReadOnly(c, mode)
+	}
+}
+
+// rejectWhileReadOnly answers 503 Service Unavailable with Retry-After while the read-only mode is enabled.
+func rejectWhileReadOnly(c *gin.Context, mode *ReadOnlyMode) {
+	settings := mode.Settings()
+	if !settings.Enabled {
+		c.Next()
+
+		return
+	}
+
+	c.Header("Retry-After", strconv.Itoa(settings.RetryAfter))
+
+	abortWithError(c, http.StatusServiceUnavailable, consoleerrors.CodeReadOnly, i18n.T(i18n.Language(c), "error.readOnly"))
+}
+
+type readOnlyRoutes struct {
+	mode *ReadOnlyMode
+	l    logger.Interface
+}
+
+func NewReadOnlyRoutes(handler *gin.RouterGroup, mode *ReadOnlyMode, l logger.Interface) {
+	r := &readOnlyRoutes{mode: mode, l: l}
+
+	h := handler.Group("/readOnly")
+	{
+		h.GET("", r.get)
+		h.PUT("", r.update)
+	}
+}
+
+func (r *readOnlyRoutes) get(c *gin.Context) {
+	c.JSON(http.StatusOK, r.mode.Settings())
+}
+
+// update enables or disables the read-only mode, a missing retryAfter keeps the current one.
+func (r *readOnlyRoutes) update(c *gin.Context) {
+	var settings dto.ReadOnlySettings
+	if err := c.ShouldBindJSON(&settings); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	settings = r.mode.set(settings)
+
+	if settings.Enabled {
+		r.l.Warn("http - v1 - readOnly: read-only mode enabled: %s", settings.Reason)
+	} else {
+		r.l.Info("http - v1 - readOnly: read-only mode disabled")
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
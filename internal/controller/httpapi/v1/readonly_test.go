@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func readOnlyRequest(engine *gin.Engine, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestReadOnlyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	mode := NewReadOnlyMode(config.ReadOnly{RetryAfter: 2 * time.Minute})
+
+	engine := gin.New()
+	api := engine.Group("/api", ReadOnlyMiddleware(mode))
+	api.GET("/v1/devices", func(c *gin.Context) { c.Status(http.StatusOK) })
+	api.POST("/v1/devices", func(c *gin.Context) { c.Status(http.StatusCreated) })
+	NewReadOnlyRoutes(api.Group("/v1/admin"), mode, logger.New("error"))
+
+	assert.Equal(t, http.StatusCreated, readOnlyRequest(engine, http.MethodPost, "/api/v1/devices", "{}").Code)
+
+	enabled := readOnlyRequest(engine, http.MethodPut, "/api/v1/admin/readOnly", `{"enabled":true,"reason":"database migration"}`)
+	require.Equal(t, http.StatusOK, enabled.Code, enabled.Body.String())
+
+	var settings dto.ReadOnlySettings
+	require.NoError(t, json.Unmarshal(enabled.Body.Bytes(), &settings))
+	assert.True(t, settings.Enabled)
+	assert.Equal(t, 120, settings.RetryAfter)
+	assert.Equal(t, "database migration", settings.Reason)
+	require.NotNil(t, settings.Since)
+
+	refused := readOnlyRequest(engine, http.MethodPost, "/api/v1/devices", "{}")
+	assert.Equal(t, http.StatusServiceUnavailable, refused.Code)
+	assert.Equal(t, "120", refused.Header().Get("Retry-After"))
+	assert.Contains(t, refused.Body.String(), "READ_ONLY")
+
+	assert.Equal(t, http.StatusOK, readOnlyRequest(engine, http.MethodGet, "/api/v1/devices", "").Code)
+	assert.Equal(t, http.StatusOK, readOnlyRequest(engine, http.MethodGet, "/api/v1/admin/readOnly", "").Code)
+
+	disabled := readOnlyRequest(engine, http.MethodPut, "/api/v1/admin/readOnly", `{"enabled":false}`)
+	require.Equal(t, http.StatusOK, disabled.Code, disabled.Body.String())
+	assert.Equal(t, dto.ReadOnlySettings{RetryAfter: 120}, mode.Settings())
+
+	assert.Equal(t, http.StatusCreated, readOnlyRequest(engine, http.MethodPost, "/api/v1/devices", "{}").Code)
+}
+
+func TestReadOnlyModeKeepsSince(t *testing.T) {
+	t.Parallel()
+
+	mode := NewReadOnlyMode(config.ReadOnly{Enabled: true, RetryAfter: time.Minute})
+
+	since := mode.Settings().Since
+	require.NotNil(t, since)
+
+	settings := mode.set(dto.ReadOnlySettings{Enabled: true, RetryAfter: 30, Reason: "failover"})
+	assert.Equal(t, since, settings.Since)
+	assert.Equal(t, 30, settings.RetryAfter)
+	assert.Equal(t, "failover", settings.Reason)
+}
+
+func TestReadOnlyRouteMiddleware(t *testing.T) {
+	t.Parallel()
+
+	mode := NewReadOnlyMode(config.ReadOnly{RetryAfter: time.Minute})
+
+	engine := gin.New()
+	engine.GET("/activate", ReadOnlyRouteMiddleware(mode), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	assert.Equal(t, http.StatusOK, readOnlyRequest(engine, http.MethodGet, "/activate", "").Code)
+
+	mode.set(dto.ReadOnlySettings{Enabled: true})
+
+	// the route changes data with a GET, it is refused too
+	refused := readOnlyRequest(engine, http.MethodGet, "/activate", "")
+	assert.Equal(t, http.StatusServiceUnavailable, refused.Code)
+	assert.Equal(t, "60", refused.Header().Get("Retry-After"))
+
+	enabled, retryAfter := mode.State()
+	assert.True(t, enabled)
+	assert.Equal(t, 60, retryAfter)
+}
@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/tickets"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var ErrValidationRedirection = dto.NotValidError{Console: consoleerrors.CreateConsoleError("RedirectionAPI")}
+
+type redirectionRoutes struct {
+	d devices.Feature
+	t tickets.Feature
+	l logger.Interface
+}
+
+func NewRedirectionRoutes(handler *gin.RouterGroup, d devices.Feature, t tickets.Feature, l logger.Interface) {
+	r := &redirectionRoutes{d, t, l}
+
+	h := handler.Group("/redirection")
+	{
+		h.POST("ticket", r.mintTicket)
+	}
+}
+
+// mintTicket issues a one-time ticket the browser can present when opening the redirection websocket.
+func (r *redirectionRoutes) mintTicket(c *gin.Context) {
+	var req dto.RedirectionTicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErr := ErrValidationRedirection.Wrap("mintTicket", "ShouldBindJSON", err)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	if _, err := r.d.GetByID(c.Request.Context(), req.GUID, "", false); err != nil {
+		r.l.Error(err, "http - redirection - v1 - mintTicket")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	ticket, err := r.t.Mint(c.Request.Context(), req.GUID, c.GetString(ContextKeyUser), req.Options)
+	if err != nil {
+		r.l.Error(err, "http - redirection - v1 - mintTicket")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, ticket)
+}
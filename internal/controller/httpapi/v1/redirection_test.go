@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/tickets"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestMintRedirectionTicket(t *testing.T) {
+	t.Parallel()
+
+	const guid = "123e4567-e89b-12d3-a456-426614174000"
+
+	tests := []struct {
+		name         string
+		body         string
+		mock         func(d *mocks.MockDeviceManagementFeature)
+		expectedCode int
+		options      dto.KVMSessionOptions
+	}{
+		{
+			name: "ticket issued",
+			body: `{"guid":"` + guid + `"}`,
+			mock: func(d *mocks.MockDeviceManagementFeature) {
+				d.EXPECT().GetByID(context.Background(), guid, "", false).Return(&dto.Device{GUID: guid}, nil)
+			},
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name: "ticket with session options",
+			body: `{"guid":"` + guid + `","options":{"keyboardLayout":"de-DE","clipboard":true}}`,
+			mock: func(d *mocks.MockDeviceManagementFeature) {
+				d.EXPECT().GetByID(context.Background(), guid, "", false).Return(&dto.Device{GUID: guid}, nil)
+			},
+			expectedCode: http.StatusCreated,
+			options:      dto.KVMSessionOptions{KeyboardLayout: dto.KeyboardLayoutGerman, Clipboard: true},
+		},
+		{
+			name:         "malformed body",
+			body:         `{"guid":`,
+			mock:         func(_ *mocks.MockDeviceManagementFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "unknown device",
+			body: `{"guid":"` + guid + `"}`,
+			mock: func(d *mocks.MockDeviceManagementFeature) {
+				d.EXPECT().GetByID(context.Background(), guid, "", false).Return(nil, devices.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			device := mocks.NewMockDeviceManagementFeature(ctrl)
+			tc.mock(device)
+
+			ticketUC := tickets.New(time.Minute)
+
+			engine := gin.New()
+			engine.Use(func(c *gin.Context) { c.Set(ContextKeyUser, "admin") })
+			NewRedirectionRoutes(engine.Group("/api/v1"), device, ticketUC, logger.New("error"))
+
+			w := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/redirection/ticket", bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+
+			if tc.expectedCode != http.StatusCreated {
+				return
+			}
+
+			var ticket dto.RedirectionTicket
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &ticket))
+			assert.NotEmpty(t, ticket.Ticket)
+
+			redeemed, err := ticketUC.Redeem(context.Background(), ticket.Ticket, guid)
+			require.NoError(t, err)
+			assert.Equal(t, "admin", redeemed.Subject)
+			assert.Equal(t, tc.options, redeemed.Options)
+			assert.Equal(t, tc.options, ticket.Options)
+		})
+	}
+}
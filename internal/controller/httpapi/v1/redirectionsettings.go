@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (dr *deviceRoutes) getRedirectionSettings(c *gin.Context) {
+	settings, err := dr.t.GetRedirectionSettings(c.Request.Context(), c.Param("guid"))
+	if err != nil {
+		dr.l.Error(err, "http - devices - v1 - getRedirectionSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+func (dr *deviceRoutes) setRedirectionSettings(c *gin.Context) {
+	var settings dto.RedirectionSettings
+	if err := c.ShouldBindJSON(&settings); err != nil {
+		ErrorResponse(c, ErrValidationDevices.Wrap("setRedirectionSettings", "ShouldBindJSON", err))
+
+		return
+	}
+
+	updated, err := dr.t.SetRedirectionSettings(c.Request.Context(), c.Param("guid"), settings)
+	if err != nil {
+		dr.l.Error(err, "http - devices - v1 - setRedirectionSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+func (dr *deviceRoutes) selectKVMDisplay(c *gin.Context) {
+	var sel dto.KVMDisplaySelection
+	if err := c.ShouldBindJSON(&sel); err != nil {
+		ErrorResponse(c, ErrValidationDevices.Wrap("selectKVMDisplay", "ShouldBindJSON", err))
+
+		return
+	}
+
+	selected, err := dr.t.SelectKVMDisplay(c.Request.Context(), c.Param("guid"), sel)
+	if err != nil {
+		dr.l.Error(err, "http - devices - v1 - selectKVMDisplay")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, selected)
+}
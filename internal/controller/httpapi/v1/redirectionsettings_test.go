@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+var redirectionSettings = dto.RedirectionSettings{
+	ListenerEnabled: true,
+	SOL:             true,
+	KVM: dto.KVMRedirectionSettings{
+		Enabled:        true,
+		Available:      true,
+		EnabledByMEBx:  true,
+		OptInPolicy:    true,
+		SessionTimeout: 15,
+		Displays:       []dto.KVMScreenDisplay{{DisplayIndex: 0, IsActive: true, IsDefault: true}},
+	},
+}
+
+func TestRedirectionSettingsRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		method       string
+		body         string
+		mock         func(*mocks.MockDeviceManagementFeature)
+		expectedCode int
+	}{
+		{
+			name:   "get",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetRedirectionSettings(context.Background(), "guid").Return(redirectionSettings, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "get - device not found",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetRedirectionSettings(context.Background(), "guid").Return(dto.RedirectionSettings{}, devices.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "put",
+			method: http.MethodPut,
+			body:   `{"sol":true,"ider":false,"kvm":{"enabled":true,"optInPolicy":true,"sessionTimeout":15,"port5900Enabled":false,"defaultScreen":0}}`,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().SetRedirectionSettings(context.Background(), "guid", dto.RedirectionSettings{
+					SOL: true,
+					KVM: dto.KVMRedirectionSettings{Enabled: true, OptInPolicy: true, SessionTimeout: 15},
+				}).Return(redirectionSettings, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "put - interdependent flags",
+			method: http.MethodPut,
+			body:   `{"kvm":{"port5900Enabled":true}}`,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().SetRedirectionSettings(context.Background(), "guid", dto.RedirectionSettings{KVM: dto.KVMRedirectionSettings{Port5900Enabled: true}}).
+					Return(dto.RedirectionSettings{}, devices.ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate port 5900", "port 5900 requires KVM to be enabled"))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			device, engine := devicesTest(t)
+			tc.mock(device)
+
+			req := httptest.NewRequest(tc.method, "/api/v1/devices/guid/redirection", bytes.NewBufferString(tc.body))
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+
+			if tc.expectedCode == http.StatusOK {
+				var settings dto.RedirectionSettings
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &settings))
+				require.Equal(t, redirectionSettings, settings)
+			}
+		})
+	}
+}
+
+func TestSelectKVMDisplayRoute(t *testing.T) {
+	t.Parallel()
+
+	device, engine := devicesTest(t)
+
+	selected := dto.KVMDisplaySelected{
+		Display:           dto.KVMScreenDisplay{DisplayIndex: 1, IsActive: true, ResolutionX: 1280, ResolutionY: 720, IsDefault: true},
+		RestartedSessions: 1,
+	}
+	device.EXPECT().SelectKVMDisplay(context.Background(), "guid", dto.KVMDisplaySelection{DisplayIndex: 1}).Return(selected, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/devices/guid/redirection/display", bytes.NewBufferString(`{"displayIndex":1}`))
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.KVMDisplaySelected
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Equal(t, selected, response)
+}
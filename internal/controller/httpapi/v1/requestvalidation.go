@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+	"github.com/device-management-toolkit/console/pkg/openapivalidate"
+)
+
+// RequestValidationMiddleware refuses the requests whose JSON body does not match the schema of their operation
+// in the OpenAPI document with 400 Bad Request, listing the fields in error. A nil validator checks nothing.
+func RequestValidationMiddleware(validator *openapivalidate.Validator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if validator == nil {
+			c.Next()
+
+			return
+		}
+
+		err := validator.Validate(c.Request.Context(), c.Request, c.FullPath())
+
+		var invalid openapivalidate.Error
+
+		switch {
+		case err == nil:
+			c.Next()
+		case errors.As(err, &invalid):
+			msg := i18n.T(i18n.Language(c), "error.invalidBody")
+			c.AbortWithStatusJSON(http.StatusBadRequest, response{Error: msg, Message: msg, Code: consoleerrors.CodeValidation, Fields: invalid.Fields})
+		default:
+			ErrorResponse(c, err)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/openapivalidate"
+)
+
+const requestValidationSpec = `{
+  "openapi": "3.0.3",
+  "info": {"title": "test", "version": "1.0.0"},
+  "paths": {"/api/v1/admin/domains": {"post": {
+    "requestBody": {"content": {"*/*": {"schema": {"type": "object", "properties": {"profileName": {"type": "string"}}}}}},
+    "responses": {"201": {"description": "Created"}}
+  }}}
+}`
+
+func TestRequestValidationMiddleware(t *testing.T) {
+	t.Parallel()
+
+	validator, err := openapivalidate.Load([]byte(requestValidationSpec))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		validator    *openapivalidate.Validator
+		limit        int64
+		body         string
+		expectedCode int
+		fields       []openapivalidate.FieldError
+	}{
+		{
+			name:         "valid body reaches the handler",
+			validator:    validator,
+			body:         `{"profileName":"domain"}`,
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name:         "property of the wrong type",
+			validator:    validator,
+			body:         `{"profileName":5}`,
+			expectedCode: http.StatusBadRequest,
+			fields:       []openapivalidate.FieldError{{Field: "profileName", Message: "value must be a string"}},
+		},
+		{
+			name:         "body over the limit",
+			validator:    validator,
+			limit:        8,
+			body:         `{"profileName":"domain"}`,
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:         "validation disabled",
+			body:         `{"profileName":5}`,
+			expectedCode: http.StatusCreated,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			engine := gin.New()
+			engine.POST("/api/v1/admin/domains", BodyLimitMiddleware(tc.limit), RequestValidationMiddleware(tc.validator), func(c *gin.Context) {
+				var body map[string]any
+				if err := c.ShouldBindJSON(&body); err != nil {
+					ErrorResponse(c, err)
+
+					return
+				}
+
+				c.JSON(http.StatusCreated, body)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/domains", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.ContentLength = -1
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+
+			if tc.fields == nil {
+				return
+			}
+
+			var res response
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			assert.Equal(t, consoleerrors.CodeValidation, res.Code)
+			assert.Equal(t, tc.fields, res.Fields)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+)
+
+const (
+	// adminRoutes is the prefix of the routes of the console configuration, its secrets and its diagnostics.
+	adminRoutes = "/api/v1/admin/"
+	// activateRoute is the WebSocket the rpc-go clients activate the devices on, opened with a GET.
+	activateRoute = "/activate"
+)
+
+// RoleMiddleware restricts the users with a console account, such as the accounts synced from the directory,
+// to the rights of their role: a viewer reads the devices, an operator also changes and activates them and only
+// an admin reads and changes the console configuration under /api/v1/admin, with its keys, users and profiles. A disabled account is refused. The built-in admin and the
+// users without an account, such as those of the OpenID Connect provider, keep every right.
+func RoleMiddleware(adminUsername string, users UserLookup) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username := c.GetString(ContextKeyUser)
+		if username == "" || username == adminUsername || users == nil {
+			c.Next()
+
+			return
+		}
+
+		user, err := users.GetUser(c.Request.Context(), username)
+		if err != nil {
+			ErrorResponse(c, err)
+			c.Abort()
+
+			return
+		}
+
+		if user != nil && (user.Disabled || !roleAllows(user.Role, c.Request.Method, c.Request.URL.Path)) {
+			abortWithError(c, http.StatusForbidden, consoleerrors.CodeForbidden, i18n.T(i18n.Language(c), "error.roleForbidden"))
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// roleAllows reports whether role grants the request, unknown roles grant nothing.
+func roleAllows(role, method, path string) bool {
+	switch {
+	case strings.HasPrefix(path, adminRoutes):
+		return role == entity.RoleAdmin
+	case path == activateRoute:
+		return role == entity.RoleOperator || role == entity.RoleAdmin
+	case method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions:
+		return role == entity.RoleViewer || role == entity.RoleOperator || role == entity.RoleAdmin
+	}
+
+	return role == entity.RoleOperator || role == entity.RoleAdmin
+}
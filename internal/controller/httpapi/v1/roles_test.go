@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+)
+
+func TestRoleMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		user   string
+		method string
+		path   string
+		mock   func(m *mocks.MockLDAPSyncFeature)
+		code   int
+	}{
+		{name: "built-in admin", user: "admin", method: http.MethodPost, path: "/api/v1/admin/profiles", code: http.StatusOK},
+		{name: "no user", method: http.MethodPost, path: "/api/v1/admin/profiles", code: http.StatusOK},
+		{
+			name: "user without an account", user: "oidc-subject", method: http.MethodPost, path: "/api/v1/admin/profiles",
+			mock: func(m *mocks.MockLDAPSyncFeature) { m.EXPECT().GetUser(gomock.Any(), "oidc-subject").Return(nil, nil) },
+			code: http.StatusOK,
+		},
+		{
+			name: "viewer reads", user: "vera", method: http.MethodGet, path: "/api/v1/devices",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "vera").Return(&dto.User{Username: "vera", Role: entity.RoleViewer}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "viewer reads the profiler", user: "vera", method: http.MethodGet, path: "/api/v1/admin/debug/pprof/heap",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "vera").Return(&dto.User{Username: "vera", Role: entity.RoleViewer}, nil)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name: "viewer reads the tenant keys", user: "vera", method: http.MethodGet, path: "/api/v1/admin/tenantkeys",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "vera").Return(&dto.User{Username: "vera", Role: entity.RoleViewer}, nil)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name: "operator reads the configuration", user: "otto", method: http.MethodGet, path: "/api/v1/admin/profiles",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "otto").Return(&dto.User{Username: "otto", Role: entity.RoleOperator}, nil)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name: "admin reads the configuration", user: "ada", method: http.MethodGet, path: "/api/v1/admin/profiles",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "ada").Return(&dto.User{Username: "ada", Role: entity.RoleAdmin}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "viewer changes a device", user: "vera", method: http.MethodPost, path: "/api/v1/amt/power/action/guid",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "vera").Return(&dto.User{Username: "vera", Role: entity.RoleViewer}, nil)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name: "operator changes a device", user: "otto", method: http.MethodPost, path: "/api/v1/amt/power/action/guid",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "otto").Return(&dto.User{Username: "otto", Role: entity.RoleOperator}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "viewer activates a device", user: "vera", method: http.MethodGet, path: "/activate",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "vera").Return(&dto.User{Username: "vera", Role: entity.RoleViewer}, nil)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name: "operator activates a device", user: "otto", method: http.MethodGet, path: "/activate",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "otto").Return(&dto.User{Username: "otto", Role: entity.RoleOperator}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "operator changes the configuration", user: "otto", method: http.MethodDelete, path: "/api/v1/admin/profiles/p1",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "otto").Return(&dto.User{Username: "otto", Role: entity.RoleOperator}, nil)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name: "admin changes the configuration", user: "ada", method: http.MethodDelete, path: "/api/v1/admin/profiles/p1",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "ada").Return(&dto.User{Username: "ada", Role: entity.RoleAdmin}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "disabled account", user: "ada", method: http.MethodGet, path: "/api/v1/devices",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "ada").Return(&dto.User{Username: "ada", Role: entity.RoleAdmin, Disabled: true}, nil)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name: "lookup failure", user: "ada", method: http.MethodGet, path: "/api/v1/devices",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUser(gomock.Any(), "ada").Return(nil, errors.New("database is down"))
+			},
+			code: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			users := mocks.NewMockLDAPSyncFeature(gomock.NewController(t))
+			if tc.mock != nil {
+				tc.mock(users)
+			}
+
+			engine := gin.New()
+			engine.Use(func(c *gin.Context) {
+				c.Set(ContextKeyUser, tc.user)
+				c.Next()
+			}, RoleMiddleware("admin", users))
+			engine.Any("/api/v1/*path", func(c *gin.Context) { c.Status(http.StatusOK) })
+			engine.GET("/activate", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(tc.method, tc.path, http.NoBody))
+
+			assert.Equal(t, tc.code, w.Code, w.Body.String())
+		})
+	}
+}
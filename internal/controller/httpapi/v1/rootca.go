@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/rootca"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var ErrValidationRootCA = dto.NotValidError{Console: consoleerrors.CreateConsoleError("RootCAAPI")}
+
+type rootCARoutes struct {
+	r rootca.Feature
+	l logger.Interface
+}
+
+// NewRootCARoutes manages the root certificate of the console and its rollover.
+func NewRootCARoutes(handler *gin.RouterGroup, r rootca.Feature, l logger.Interface) {
+	routes := &rootCARoutes{r, l}
+
+	h := handler.Group("/rootca")
+	{
+		h.GET("", routes.get)
+		h.POST("regenerate", routes.regenerate)
+		h.POST("crosssign", routes.crossSign)
+		h.POST("rollover", routes.rollover)
+	}
+}
+
+func (routes *rootCARoutes) get(c *gin.Context) {
+	root, err := routes.r.Get(c.Request.Context())
+	if err != nil {
+		routes.l.Error(err, "http - v1 - rootca - get")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, root)
+}
+
+// regenerate generates the root to roll over to, the current root stays in use until the rollover.
+func (routes *rootCARoutes) regenerate(c *gin.Context) {
+	root, err := routes.r.Regenerate(c.Request.Context())
+	if err != nil {
+		routes.l.Error(err, "http - v1 - rootca - regenerate")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, root)
+}
+
+func (routes *rootCARoutes) crossSign(c *gin.Context) {
+	crossSigned, err := routes.r.CrossSign(c.Request.Context())
+	if err != nil {
+		routes.l.Error(err, "http - v1 - rootca - crosssign")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, crossSigned)
+}
+
+// rollover pushes the pending root to the devices, then replaces the current root once all of them trust it,
+// or regardless of the devices with force=true.
+func (routes *rootCARoutes) rollover(c *gin.Context) {
+	force := false
+
+	if value, ok := c.GetQuery("force"); ok {
+		var err error
+
+		if force, err = strconv.ParseBool(value); err != nil {
+			ErrorResponse(c, ErrValidationRootCA.Wrap("rollover", "strconv.ParseBool", err))
+
+			return
+		}
+	}
+
+	result, err := routes.r.Rollover(c.Request.Context(), force)
+	if err != nil {
+		routes.l.Error(err, "http - v1 - rootca - rollover")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
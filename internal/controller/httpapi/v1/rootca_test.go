@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/rootca"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestRootCARoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		mock         func(m *mocks.MockRootCAFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:   "get",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/rootca",
+			mock: func(m *mocks.MockRootCAFeature) {
+				m.EXPECT().Get(context.Background()).Return(dto.RootCA{Current: &dto.RootCertificate{SerialNumber: "1f"}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"serialNumber":"1f"`,
+		},
+		{
+			name:   "get without root",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/rootca",
+			mock: func(m *mocks.MockRootCAFeature) {
+				m.EXPECT().Get(context.Background()).Return(dto.RootCA{}, rootca.ErrNotFound.WrapWithMessage("Get", "uc.load", "the console has no root certificate"))
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "regenerate",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/rootca/regenerate",
+			mock: func(m *mocks.MockRootCAFeature) {
+				m.EXPECT().Regenerate(context.Background()).Return(dto.RootCertificate{SerialNumber: "2e"}, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: `"serialNumber":"2e"`,
+		},
+		{
+			name:   "cross-sign",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/rootca/crosssign",
+			mock: func(m *mocks.MockRootCAFeature) {
+				m.EXPECT().CrossSign(context.Background()).Return(dto.RootCACrossSigned{PendingByCurrent: "pem"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"pendingByCurrent":"pem"`,
+		},
+		{
+			name:   "rollover",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/rootca/rollover",
+			mock: func(m *mocks.MockRootCAFeature) {
+				m.EXPECT().Rollover(context.Background(), false).Return(dto.RootCARollover{Devices: 2, Trusting: 2, Completed: true}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"completed":true`,
+		},
+		{
+			name:   "forced rollover",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/rootca/rollover?force=true",
+			mock: func(m *mocks.MockRootCAFeature) {
+				m.EXPECT().Rollover(context.Background(), true).Return(dto.RootCARollover{Completed: true}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "rollover with invalid force",
+			method:       http.MethodPost,
+			url:          "/api/v1/admin/rootca/rollover?force=maybe",
+			mock:         func(_ *mocks.MockRootCAFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			root := mocks.NewMockRootCAFeature(mockCtl)
+			tc.mock(root)
+
+			engine := gin.New()
+			NewRootCARoutes(engine.Group("/api/v1/admin"), root, logger.New("error"))
+
+			req, err := http.NewRequest(tc.method, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
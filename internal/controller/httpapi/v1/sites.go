@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sites"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var ErrValidationSites = dto.NotValidError{Console: consoleerrors.CreateConsoleError("SitesAPI")}
+
+type siteRoutes struct {
+	t sites.Feature
+	l logger.Interface
+}
+
+func NewSiteRoutes(handler *gin.RouterGroup, t sites.Feature, l logger.Interface) {
+	r := &siteRoutes{t, l}
+
+	h := handler.Group("/sites")
+	{
+		h.GET("", r.get)
+		h.GET(":name", r.getByName)
+		h.POST("", r.insert)
+		h.PATCH("", r.update)
+		h.DELETE(":name", r.delete)
+	}
+}
+
+func (r *siteRoutes) get(c *gin.Context) {
+	var odata OData
+	if err := c.ShouldBindQuery(&odata); err != nil {
+		validationErr := ErrValidationSites.Wrap("get", "ShouldBindQuery", err)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	items, err := r.t.Get(c.Request.Context(), odata.Top, odata.Skip, "")
+	if err != nil {
+		r.l.Error(err, "http - v1 - sites - get")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	if odata.Count {
+		count, err := r.t.GetCount(c.Request.Context(), "")
+		if err != nil {
+			r.l.Error(err, "http - v1 - sites - getCount")
+			ErrorResponse(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SiteCountResponse{Count: count, Data: items})
+	} else {
+		c.JSON(http.StatusOK, items)
+	}
+}
+
+func (r *siteRoutes) getByName(c *gin.Context) {
+	name := c.Param("name")
+
+	item, err := r.t.GetByName(c.Request.Context(), name, "")
+	if err != nil {
+		r.l.Error(err, "http - v1 - sites - getByName")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+func (r *siteRoutes) insert(c *gin.Context) {
+	var site dto.Site
+	if err := c.ShouldBindJSON(&site); err != nil {
+		validationErr := ErrValidationSites.Wrap("insert", "ShouldBindJSON", err)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	newSite, err := r.t.Insert(c.Request.Context(), &site)
+	if err != nil {
+		r.l.Error(err, "http - v1 - sites - insert")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, newSite)
+}
+
+func (r *siteRoutes) update(c *gin.Context) {
+	var site dto.Site
+	if err := c.ShouldBindJSON(&site); err != nil {
+		validationErr := ErrValidationSites.Wrap("update", "ShouldBindJSON", err)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	updatedSite, err := r.t.Update(c.Request.Context(), &site)
+	if err != nil {
+		r.l.Error(err, "http - v1 - sites - update")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, updatedSite)
+}
+
+func (r *siteRoutes) delete(c *gin.Context) {
+	name := c.Param("name")
+
+	if err := r.t.Delete(c.Request.Context(), name, ""); err != nil {
+		r.l.Error(err, "http - v1 - sites - delete")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
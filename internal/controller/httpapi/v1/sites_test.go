@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sites"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestSiteRoutes(t *testing.T) {
+	t.Parallel()
+
+	site := dto.Site{
+		Name:      "branch",
+		Subnets:   []string{"10.20.0.0/16"},
+		Proxy:     "socks5://jump.example.com",
+		TLSPolicy: dto.SiteTLSSelfSigned,
+		Username:  "admin",
+		Password:  "P@ssw0rd",
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		body         string
+		mock         func(m *mocks.MockSiteFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:   "list with count",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/sites?$top=10&$count=true",
+			mock: func(m *mocks.MockSiteFeature) {
+				m.EXPECT().Get(context.Background(), 10, 0, "").Return([]dto.Site{site}, nil)
+				m.EXPECT().GetCount(context.Background(), "").Return(1, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"totalCount":1`,
+		},
+		{
+			name:   "create",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/sites",
+			body:   `{"name":"branch","subnets":["10.20.0.0/16"],"proxy":"socks5://jump.example.com","tlsPolicy":"selfSigned","username":"admin","password":"P@ssw0rd"}`,
+			mock: func(m *mocks.MockSiteFeature) {
+				m.EXPECT().Insert(context.Background(), &site).Return(&dto.Site{Name: "branch", Subnets: []string{"10.20.0.0/16"}}, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: `"subnets":["10.20.0.0/16"]`,
+		},
+		{
+			name:   "delete unknown site",
+			method: http.MethodDelete,
+			url:    "/api/v1/admin/sites/missing",
+			mock: func(m *mocks.MockSiteFeature) {
+				m.EXPECT().Delete(context.Background(), "missing", "").Return(sites.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			siteFeature := mocks.NewMockSiteFeature(mockCtl)
+			tc.mock(siteFeature)
+
+			engine := gin.New()
+			NewSiteRoutes(engine.Group("/api/v1/admin"), siteFeature, logger.New("error"))
+
+			req, err := http.NewRequest(tc.method, tc.url, bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+type tenantKeyRoutes struct {
+	t tenantkeys.Feature
+	l logger.Interface
+}
+
+// NewTenantKeyRoutes manages the encryption keys of the tenant of the access token, the default tenant
+// when it names none.
+func NewTenantKeyRoutes(handler *gin.RouterGroup, t tenantkeys.Feature, l logger.Interface) {
+	r := &tenantKeyRoutes{t, l}
+
+	h := handler.Group("/tenantkeys")
+	{
+		h.GET("", r.get)
+		h.POST("rotate", r.rotate)
+		h.POST("revoke", r.revoke)
+	}
+}
+
+func (r *tenantKeyRoutes) get(c *gin.Context) {
+	items, err := r.t.GetKeys(c.Request.Context(), c.GetString(ContextKeyTenant))
+	if err != nil {
+		r.l.Error(err, "http - v1 - tenantkeys - get")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+func (r *tenantKeyRoutes) rotate(c *gin.Context) {
+	key, err := r.t.Rotate(c.Request.Context(), c.GetString(ContextKeyTenant))
+	if err != nil {
+		r.l.Error(err, "http - v1 - tenantkeys - rotate")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, key)
+}
+
+// revoke destroys the keys of the tenant, its secrets cannot be decrypted afterwards.
+func (r *tenantKeyRoutes) revoke(c *gin.Context) {
+	result, err := r.t.Revoke(c.Request.Context(), c.GetString(ContextKeyTenant))
+	if err != nil {
+		r.l.Error(err, "http - v1 - tenantkeys - revoke")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
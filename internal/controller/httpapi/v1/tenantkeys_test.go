@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestTenantKeyRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		tenant       string
+		mock         func(m *mocks.MockTenantKeysFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:   "get keys",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/tenantkeys",
+			tenant: "tenant1",
+			mock: func(m *mocks.MockTenantKeysFeature) {
+				m.EXPECT().GetKeys(context.Background(), "tenant1").Return([]dto.TenantKey{{KeyID: "key1", TenantID: "tenant1", Active: true}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"keyId":"key1"`,
+		},
+		{
+			name:   "get keys of the default tenant",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/tenantkeys",
+			mock: func(m *mocks.MockTenantKeysFeature) {
+				m.EXPECT().GetKeys(context.Background(), "").Return([]dto.TenantKey{}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `[]`,
+		},
+		{
+			name:   "get keys database error",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/tenantkeys",
+			mock: func(m *mocks.MockTenantKeysFeature) {
+				m.EXPECT().GetKeys(context.Background(), "").Return(nil, tenantkeys.ErrDatabase.Wrap("GetKeys", "uc.repo.GetByTenant", errors.New("db down")))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "rotate",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/tenantkeys/rotate",
+			tenant: "tenant1",
+			mock: func(m *mocks.MockTenantKeysFeature) {
+				m.EXPECT().Rotate(context.Background(), "tenant1").Return(dto.TenantKey{KeyID: "key2", TenantID: "tenant1", Active: true}, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: `"keyId":"key2"`,
+		},
+		{
+			name:   "rotate failure",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/tenantkeys/rotate",
+			mock: func(m *mocks.MockTenantKeysFeature) {
+				m.EXPECT().Rotate(context.Background(), "").Return(dto.TenantKey{}, errors.New("no entropy"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "revoke",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/tenantkeys/revoke",
+			tenant: "tenant1",
+			mock: func(m *mocks.MockTenantKeysFeature) {
+				m.EXPECT().Revoke(context.Background(), "tenant1").Return(dto.TenantKeyRevokeResult{Revoked: 2}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"revoked":2}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			keys := mocks.NewMockTenantKeysFeature(mockCtl)
+			tc.mock(keys)
+
+			engine := gin.New()
+			engine.Use(func(c *gin.Context) {
+				c.Set(ContextKeyTenant, tc.tenant)
+				c.Next()
+			})
+			NewTenantKeyRoutes(engine.Group("/api/v1/admin"), keys, logger.New("error"))
+
+			req, err := http.NewRequest(tc.method, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/i18n"
+)
+
+// maxFormValue caps the size of the plain form fields of a multipart body.
+const maxFormValue = 64 << 10
+
+var (
+	// ErrNotMultipart is returned for uploads sent without a multipart/form-data body.
+	ErrNotMultipart = errors.New("the request body is not multipart/form-data")
+	// ErrFormValueTooLarge is returned for a form field larger than maxFormValue.
+	ErrFormValueTooLarge = errors.New("form field too large")
+)
+
+// BodyLimitMiddleware rejects request bodies larger than limit bytes, 0 accepts any size. Bodies
+// announcing their length are rejected before they are read, the others once limit bytes were
+// read by the handler.
+func BodyLimitMiddleware(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if limit <= 0 {
+			c.Next()
+
+			return
+		}
+
+		if c.Request.ContentLength > limit {
+			bodyTooLarge(c)
+
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		c.Next()
+	}
+}
+
+func bodyTooLarge(c *gin.Context) {
+	e := bodyTooLargeError(c)
+
+	abortWithError(c, e.Status, e.Code, e.Message)
+}
+
+func bodyTooLargeError(c *gin.Context) MappedError {
+	return MappedError{http.StatusRequestEntityTooLarge, consoleerrors.CodeBodyTooLarge, i18n.T(i18n.Language(c), "error.bodyTooLarge"), nil}
+}
+
+// readMultipart hands the parts of a multipart/form-data body to fn one at a time, in the order
+// they were sent. Unlike gin's multipart binding, nothing is buffered in memory or spooled to
+// disk: fn reads each part straight from the connection.
+func readMultipart(c *gin.Context, fn func(part *multipart.Part) error) error {
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		return ErrNotMultipart
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = fn(part)
+		part.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// bindMultipart binds a multipart/form-data body to obj by the json names of its fields and
+// validates it like ShouldBindJSON. The files sent in the parts named in base64Fields are
+// encoded while they are read, for the fields holding a base64 encoded file in the JSON form
+// of obj, such as the provisioning certificate of a domain.
+func bindMultipart(c *gin.Context, obj any, base64Fields ...string) error {
+	form := map[string][]string{}
+
+	err := readMultipart(c, func(part *multipart.Part) error {
+		var value strings.Builder
+
+		if slices.Contains(base64Fields, part.FormName()) {
+			encoder := base64.NewEncoder(base64.StdEncoding, &value)
+			if _, err := io.Copy(encoder, part); err != nil {
+				return err
+			}
+
+			if err := encoder.Close(); err != nil {
+				return err
+			}
+		} else {
+			n, err := io.Copy(&value, io.LimitReader(part, maxFormValue+1))
+			if err != nil {
+				return err
+			}
+
+			if n > maxFormValue {
+				return ErrFormValueTooLarge
+			}
+		}
+
+		form[part.FormName()] = append(form[part.FormName()], value.String())
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := binding.MapFormWithTag(obj, form, "json"); err != nil {
+		return err
+	}
+
+	if binding.Validator == nil {
+		return nil
+	}
+
+	return binding.Validator.ValidateStruct(obj)
+}
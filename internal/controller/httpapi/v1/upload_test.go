@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func TestBodyLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		limit        int64
+		body         io.Reader
+		expectedCode int
+	}{
+		{
+			name:         "within the limit",
+			limit:        5,
+			body:         strings.NewReader("hello"),
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "announced length over the limit",
+			limit:        4,
+			body:         strings.NewReader("hello"),
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:         "unknown length over the limit",
+			limit:        4,
+			body:         io.MultiReader(strings.NewReader("hello")),
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:         "no limit",
+			body:         strings.NewReader("hello"),
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			engine := gin.New()
+			engine.POST("/", BodyLimitMiddleware(tc.limit), func(c *gin.Context) {
+				if _, err := io.ReadAll(c.Request.Body); err != nil {
+					ErrorResponse(c, err)
+
+					return
+				}
+
+				c.Status(http.StatusOK)
+			})
+
+			req, err := http.NewRequest(http.MethodPost, "/", tc.body)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+		})
+	}
+}
+
+func TestDomainInsertMultipart(t *testing.T) {
+	t.Parallel()
+
+	domain, engine := domainsTest(t)
+
+	var body bytes.Buffer
+
+	form := multipart.NewWriter(&body)
+	require.NoError(t, form.WriteField("profileName", "newProfile"))
+	require.NoError(t, form.WriteField("domainSuffix", "domain.com"))
+	require.NoError(t, form.WriteField("provisioningCertStorageFormat", "string"))
+	require.NoError(t, form.WriteField("provisioningCertPassword", "password"))
+	require.NoError(t, form.WriteField("tenantId", "tenant1"))
+
+	cert, err := form.CreateFormFile("provisioningCert", "cert.pfx")
+	require.NoError(t, err)
+
+	_, err = cert.Write([]byte("cert\x00"))
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	expected := dto.Domain{
+		ProfileName:                   "newProfile",
+		DomainSuffix:                  "domain.com",
+		ProvisioningCert:              "Y2VydAA=",
+		ProvisioningCertStorageFormat: "string",
+		ProvisioningCertPassword:      "password",
+		TenantID:                      "tenant1",
+	}
+
+	domain.EXPECT().Insert(gomock.Any(), &expected).Return(&expected, nil)
+
+	req, err := http.NewRequest(http.MethodPost, "/api/v1/admin/domains", &body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	req, err = http.NewRequest(http.MethodPost, "/api/v1/admin/domains", strings.NewReader("--x--"))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "multipart/form-data")
+
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
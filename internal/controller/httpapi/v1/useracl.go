@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// getDeviceUsers returns the digest and Kerberos users of the device
+func (r *deviceManagementRoutes) getDeviceUsers(c *gin.Context) {
+	guid := c.Param("guid")
+
+	users, err := r.d.GetDeviceUsers(c.Request.Context(), guid)
+	if err != nil {
+		r.l.Error(err, "http - v1 - getDeviceUsers")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, users)
+}
+
+// addDeviceUser adds a digest or Kerberos user to the device
+func (r *deviceManagementRoutes) addDeviceUser(c *gin.Context) {
+	guid := c.Param("guid")
+
+	var req dto.DeviceUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	user, err := r.d.AddDeviceUser(c.Request.Context(), guid, req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - addDeviceUser")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, user)
+}
+
+// updateDeviceUser replaces a user of the device
+func (r *deviceManagementRoutes) updateDeviceUser(c *gin.Context) {
+	var param dto.DeviceUserParam
+	if err := c.ShouldBindUri(&param); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	var req dto.DeviceUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	user, err := r.d.UpdateDeviceUser(c.Request.Context(), param.GUID, param.Handle, req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - updateDeviceUser")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// removeDeviceUser removes a user from the device
+func (r *deviceManagementRoutes) removeDeviceUser(c *gin.Context) {
+	var param dto.DeviceUserParam
+	if err := c.ShouldBindUri(&param); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	if err := r.d.RemoveDeviceUser(c.Request.Context(), param.GUID, param.Handle); err != nil {
+		r.l.Error(err, "http - v1 - removeDeviceUser")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
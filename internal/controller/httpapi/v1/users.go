@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/ldapsync"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var ErrValidationUsers = dto.NotValidError{Console: consoleerrors.CreateConsoleError("UsersAPI")}
+
+type userRoutes struct {
+	u ldapsync.Feature
+	l logger.Interface
+}
+
+func NewUserRoutes(handler *gin.RouterGroup, u ldapsync.Feature, l logger.Interface) {
+	r := &userRoutes{u, l}
+
+	h := handler.Group("/users")
+	{
+		h.GET("", r.get)
+		h.POST("sync", r.sync)
+	}
+}
+
+func (r *userRoutes) get(c *gin.Context) {
+	var odata OData
+	if err := c.ShouldBindQuery(&odata); err != nil {
+		validationErr := ErrValidationUsers.Wrap("get", "ShouldBindQuery", err)
+		ErrorResponse(c, validationErr)
+
+		return
+	}
+
+	items, err := r.u.GetUsers(c.Request.Context(), odata.Top, odata.Skip)
+	if err != nil {
+		r.l.Error(err, "http - v1 - users - get")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+// sync runs a directory sync now instead of waiting for the next scheduled one.
+func (r *userRoutes) sync(c *gin.Context) {
+	result, err := r.u.Sync(c.Request.Context())
+	if err != nil {
+		r.l.Error(err, "http - v1 - users - sync")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
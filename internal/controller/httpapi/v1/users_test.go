@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/ldapsync"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestUserRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		mock         func(m *mocks.MockLDAPSyncFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:   "get users",
+			method: http.MethodGet,
+			url:    "/api/v1/admin/users?$top=10&$skip=5",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().GetUsers(context.Background(), 10, 5).Return([]dto.User{{Username: "jdoe", Role: "operator"}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"username":"jdoe"`,
+		},
+		{
+			name:         "get users with invalid paging",
+			method:       http.MethodGet,
+			url:          "/api/v1/admin/users?$top=abc",
+			mock:         func(_ *mocks.MockLDAPSyncFeature) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "sync",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/users/sync",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().Sync(context.Background()).Return(dto.UserSyncResult{Created: 2, Disabled: 1}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"created":2,"updated":0,"disabled":1}`,
+		},
+		{
+			name:   "sync not configured",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/users/sync",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().Sync(context.Background()).Return(dto.UserSyncResult{}, ldapsync.ErrValidation.Wrap("Sync", "uc.directory", ldapsync.ErrNotConfigured))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "sync failure",
+			method: http.MethodPost,
+			url:    "/api/v1/admin/users/sync",
+			mock: func(m *mocks.MockLDAPSyncFeature) {
+				m.EXPECT().Sync(context.Background()).Return(dto.UserSyncResult{}, errors.New("ldap request failed"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			users := mocks.NewMockLDAPSyncFeature(mockCtl)
+			tc.mock(users)
+
+			engine := gin.New()
+			NewUserRoutes(engine.Group("/api/v1/admin"), users, logger.New("error"))
+
+			req, err := http.NewRequest(tc.method, tc.url, http.NoBody)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/gin-gonic/gin/binding"
-	"github.com/go-playground/validator/v10"
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/wificonfigs"
@@ -23,15 +21,6 @@ type WirelessConfigRoutes struct {
 func NewWirelessConfigRoutes(handler *gin.RouterGroup, t wificonfigs.Feature, l logger.Interface) {
 	r := &WirelessConfigRoutes{t, l}
 
-	if binding.Validator != nil {
-		if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-			err := v.RegisterValidation("authforieee8021x", dto.ValidateAuthandIEEE)
-			if err != nil {
-				l.Error(err, "failed to register validation")
-			}
-		}
-	}
-
 	h := handler.Group("/wirelessconfigs")
 	{
 		h.GET("", r.get)
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// getWiFiPortSettings returns the profile synchronization and UEFI profile share settings of the Wi-Fi port of the device
+func (r *deviceManagementRoutes) getWiFiPortSettings(c *gin.Context) {
+	guid := c.Param("guid")
+
+	settings, err := r.d.GetWiFiPortSettings(c.Request.Context(), guid)
+	if err != nil {
+		r.l.Error(err, "http - v1 - getWiFiPortSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+// setWiFiPortSettings replaces the profile synchronization and UEFI profile share settings of the Wi-Fi port of the device
+func (r *deviceManagementRoutes) setWiFiPortSettings(c *gin.Context) {
+	guid := c.Param("guid")
+
+	var req dto.WiFiPortSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	settings, err := r.d.SetWiFiPortSettings(c.Request.Context(), guid, req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - setWiFiPortSettings")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// getWiFiProfiles returns the Wi-Fi profiles of the device in the order it connects to them
+func (r *deviceManagementRoutes) getWiFiProfiles(c *gin.Context) {
+	guid := c.Param("guid")
+
+	profiles, err := r.d.GetWiFiProfiles(c.Request.Context(), guid)
+	if err != nil {
+		r.l.Error(err, "http - v1 - getWiFiProfiles")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, profiles)
+}
+
+// reorderWiFiProfiles renumbers the priorities of the Wi-Fi profiles of the device in the order of the request
+func (r *deviceManagementRoutes) reorderWiFiProfiles(c *gin.Context) {
+	guid := c.Param("guid")
+
+	var order dto.WiFiProfileOrder
+	if err := c.ShouldBindJSON(&order); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	profiles, err := r.d.ReorderWiFiProfiles(c.Request.Context(), guid, order)
+	if err != nil {
+		r.l.Error(err, "http - v1 - reorderWiFiProfiles")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, profiles)
+}
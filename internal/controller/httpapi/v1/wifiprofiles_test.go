@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestReorderWiFiProfilesHandler(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	devMock := mocks.NewMockDeviceManagementFeature(mockCtl)
+
+	engine := gin.New()
+	NewAmtRoutes(engine.Group("/api/v1"), devMock, nil, nil, logger.New("error"))
+
+	order := dto.WiFiProfileOrder{InstanceIDs: []string{"office", "home"}}
+	reordered := []dto.WiFiProfile{{InstanceID: "office", Priority: 1}, {InstanceID: "home", Priority: 2}}
+
+	devMock.EXPECT().ReorderWiFiProfiles(gomock.Any(), "my-guid", order).Return(reordered, nil)
+	devMock.EXPECT().ReorderWiFiProfiles(gomock.Any(), "my-guid", order).
+		Return(nil, devices.ErrValidationUseCase.Wrap("ReorderWiFiProfiles", "validate order", "unknown or repeated Wi-Fi profile office"))
+
+	put := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/amt/network/wifiProfiles/my-guid", strings.NewReader(`{"instanceIDs":["office","home"]}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+
+		return w
+	}
+
+	w := put()
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{"instanceID":"office","elementName":"","ssid":"","authenticationMethod":"","encryptionMethod":"","priority":1},
+		{"instanceID":"home","elementName":"","ssid":"","authenticationMethod":"","encryptionMethod":"","priority":2}]`, w.Body.String())
+
+	require.Equal(t, http.StatusBadRequest, put().Code)
+}
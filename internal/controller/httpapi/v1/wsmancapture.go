@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (r *deviceManagementRoutes) startWsmanCapture(c *gin.Context) {
+	guid := c.Param("guid")
+
+	var req dto.WsmanCaptureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	capture, err := r.d.StartWsmanCapture(c.Request.Context(), guid, req)
+	if err != nil {
+		r.l.Error(err, "http - v1 - startWsmanCapture")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, capture)
+}
+
+func (r *deviceManagementRoutes) stopWsmanCapture(c *gin.Context) {
+	guid := c.Param("guid")
+
+	capture, err := r.d.StopWsmanCapture(c.Request.Context(), guid)
+	if err != nil {
+		r.l.Error(err, "http - v1 - stopWsmanCapture")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, capture)
+}
+
+func (r *deviceManagementRoutes) downloadWsmanCapture(c *gin.Context) {
+	guid := c.Param("guid")
+
+	_, trace, err := r.d.GetWsmanCaptureTrace(c.Request.Context(), guid)
+	if err != nil {
+		r.l.Error(err, "http - v1 - downloadWsmanCapture")
+		ErrorResponse(c, err)
+
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": "wsman-trace-" + guid + ".txt"}))
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", trace)
+}
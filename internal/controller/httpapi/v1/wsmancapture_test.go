@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+func TestWsmanCaptureRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		body         string
+		mock         func(m *mocks.MockDeviceManagementFeature)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:   "start capture",
+			method: http.MethodPost,
+			url:    "/api/v1/amt/capture/valid-guid",
+			body:   `{"minutes":10}`,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().StartWsmanCapture(context.Background(), "valid-guid", dto.WsmanCaptureRequest{Minutes: 10}).
+					Return(dto.WsmanCapture{GUID: "valid-guid", Active: true}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"active":true`,
+		},
+		{
+			name:   "stop capture - none running",
+			method: http.MethodDelete,
+			url:    "/api/v1/amt/capture/valid-guid",
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().StopWsmanCapture(context.Background(), "valid-guid").Return(dto.WsmanCapture{}, devices.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "download trace",
+			method: http.MethodGet,
+			url:    "/api/v1/amt/capture/valid-guid/download",
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetWsmanCaptureTrace(context.Background(), "valid-guid").
+					Return(dto.WsmanCapture{GUID: "valid-guid"}, []byte("# WS-Man trace of device valid-guid\n"), nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "# WS-Man trace of device valid-guid\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deviceManagement, engine := deviceManagementTest(t)
+			tc.mock(deviceManagement)
+
+			req, err := http.NewRequest(tc.method, tc.url, bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+
+			if tc.method == http.MethodGet {
+				assert.Equal(t, `attachment; filename=wsman-trace-valid-guid.txt`, w.Header().Get("Content-Disposition"))
+			}
+		})
+	}
+}
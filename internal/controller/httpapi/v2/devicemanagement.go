@@ -1,16 +1,16 @@
 package v2
 
 import (
-	"net/http"
-
 	"github.com/gin-gonic/gin"
 
-	v1 "github.com/device-management-toolkit/console/internal/controller/httpapi/v1"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
+var ErrValidationDeviceManagement = dto.NotValidError{Console: consoleerrors.CreateConsoleError("DeviceManagementAPI")}
+
 type deviceManagementRoutes struct {
 	d devices.Feature
 	l logger.Interface
@@ -33,12 +33,12 @@ func (r *deviceManagementRoutes) getVersion(c *gin.Context) {
 	_, v2, err := r.d.GetVersion(c.Request.Context(), guid)
 	if err != nil {
 		r.l.Error(err, "http - v2 - GetVersion")
-		v1.ErrorResponse(c, err)
+		ErrorResponse(c, err)
 
 		return
 	}
 
-	c.JSON(http.StatusOK, v2)
+	OK(c, v2)
 }
 
 func (r *deviceManagementRoutes) getFeatures(c *gin.Context) {
@@ -47,12 +47,12 @@ func (r *deviceManagementRoutes) getFeatures(c *gin.Context) {
 	_, v2, err := r.d.GetFeatures(c.Request.Context(), guid)
 	if err != nil {
 		r.l.Error(err, "http - v2 - getFeatures")
-		v1.ErrorResponse(c, err)
+		ErrorResponse(c, err)
 
 		return
 	}
 
-	c.JSON(http.StatusOK, v2)
+	OK(c, v2)
 }
 
 func (r *deviceManagementRoutes) setFeatures(c *gin.Context) {
@@ -62,7 +62,7 @@ func (r *deviceManagementRoutes) setFeatures(c *gin.Context) {
 
 	if err := c.ShouldBindJSON(&features); err != nil {
 		r.l.Error(err, "http - v2 - setFeatures")
-		v1.ErrorResponse(c, err)
+		ErrorResponse(c, ErrValidationDeviceManagement.Wrap("setFeatures", "ShouldBindJSON", err))
 
 		return
 	}
@@ -70,10 +70,10 @@ func (r *deviceManagementRoutes) setFeatures(c *gin.Context) {
 	_, v2, err := r.d.SetFeatures(c.Request.Context(), guid, features)
 	if err != nil {
 		r.l.Error(err, "http - v2 - setFeatures")
-		v1.ErrorResponse(c, err)
+		ErrorResponse(c, err)
 
 		return
 	}
 
-	c.JSON(http.StatusOK, v2)
+	OK(c, v2)
 }
@@ -7,7 +7,9 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -16,6 +18,9 @@ import (
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	dtov2 "github.com/device-management-toolkit/console/internal/entity/dto/v2"
 	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -64,6 +69,7 @@ func TestDeviceManagement(t *testing.T) {
 		method       string
 		mock         func(m *mocks.MockDeviceManagementFeature)
 		expectedCode int
+		errorCode    consoleerrors.Code
 		requestBody  interface{}
 		response     interface{}
 	}{
@@ -76,7 +82,48 @@ func TestDeviceManagement(t *testing.T) {
 					Return(dto.Version{}, dtov2.Version{}, nil)
 			},
 			expectedCode: http.StatusOK,
-			response:     dtov2.Version{},
+			response:     Envelope{Data: dtov2.Version{}},
+		},
+		{
+			name:   "getVersion - device not found",
+			url:    "/api/v2/amt/version/missing-guid",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetVersion(context.Background(), "missing-guid").
+					Return(dto.Version{}, dtov2.Version{}, devices.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "getVersion - unexpected error",
+			url:    "/api/v2/amt/version/valid-guid",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetVersion(context.Background(), "valid-guid").
+					Return(dto.Version{}, dtov2.Version{}, ErrGeneral)
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "getVersion - device circuit open",
+			url:    "/api/v2/amt/version/valid-guid",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetVersion(context.Background(), "valid-guid").
+					Return(dto.Version{}, dtov2.Version{}, &url.Error{Op: "Post", URL: "http://device:16992/wsman", Err: wsman.CircuitOpenError{GUID: "valid-guid", RetryAfter: 20 * time.Second}})
+			},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "getFeatures - device locked",
+			url:    "/api/v2/amt/features/valid-guid",
+			method: http.MethodGet,
+			mock: func(m *mocks.MockDeviceManagementFeature) {
+				m.EXPECT().GetFeatures(context.Background(), "valid-guid").
+					Return(dto.Features{}, dtov2.Features{}, devices.LockedError{Holder: "admin", ExpiresAt: time.Now().Add(time.Minute)})
+			},
+			expectedCode: http.StatusConflict,
+			errorCode:    consoleerrors.CodeDeviceLocked,
 		},
 		{
 			name:   "getFeatures - successful retrieval",
@@ -87,7 +134,7 @@ func TestDeviceManagement(t *testing.T) {
 					Return(dto.Features{}, dtov2.Features{}, nil)
 			},
 			expectedCode: http.StatusOK,
-			response:     dtov2.Features{},
+			response:     Envelope{Data: dtov2.Features{}},
 		},
 		{
 			name:   "setFeatures - successful post",
@@ -99,7 +146,15 @@ func TestDeviceManagement(t *testing.T) {
 			},
 			expectedCode: http.StatusOK,
 			requestBody:  featuresInput,
-			response:     featuresOutput,
+			response:     Envelope{Data: featuresOutput},
+		},
+		{
+			name:         "setFeatures - invalid body",
+			url:          "/api/v2/amt/features/valid-guid",
+			method:       http.MethodPost,
+			mock:         func(_ *mocks.MockDeviceManagementFeature) {},
+			expectedCode: http.StatusBadRequest,
+			requestBody:  "not an object",
 		},
 	}
 
@@ -137,6 +192,27 @@ func TestDeviceManagement(t *testing.T) {
 			if tc.expectedCode == http.StatusOK || tc.expectedCode == http.StatusCreated {
 				jsonBytes, _ := json.Marshal(tc.response)
 				require.Equal(t, string(jsonBytes), w.Body.String())
+
+				return
+			}
+
+			require.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+			if tc.expectedCode == http.StatusServiceUnavailable {
+				require.Equal(t, "20", w.Header().Get("Retry-After"))
+			}
+
+			var envelope Envelope
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &envelope))
+			require.Nil(t, envelope.Data)
+			require.NotNil(t, envelope.Error)
+			require.Equal(t, tc.expectedCode, envelope.Error.Status)
+			require.Equal(t, http.StatusText(tc.expectedCode), envelope.Error.Title)
+			require.Equal(t, req.URL.Path, envelope.Error.Instance)
+
+			if tc.errorCode != "" {
+				require.Equal(t, tc.errorCode, envelope.Error.Code)
 			}
 		})
 	}
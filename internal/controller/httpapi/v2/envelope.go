@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+// Envelope is the response shape shared by every v2 endpoint, successful or not, always served as
+// application/json. Exactly one of Data or Error is set.
+type Envelope struct {
+	Data  interface{} `json:"data,omitempty"`
+	Error *Problem    `json:"error,omitempty"`
+}
+
+// Problem is an RFC 7807 problem details object, extended with the machine-readable code of the error and
+// the explanation of the return code of AMT for the operations the device refused.
+type Problem struct {
+	Type      string                 `json:"type" example:"about:blank"`
+	Title     string                 `json:"title" example:"Not Found"`
+	Status    int                    `json:"status" example:"404"`
+	Detail    string                 `json:"detail,omitempty" example:"device not found"`
+	Instance  string                 `json:"instance,omitempty" example:"/api/v2/amt/version/123"`
+	Code      consoleerrors.Code     `json:"code,omitempty" example:"DEVICE_NOT_FOUND"`
+	AMTStatus *amtstatus.Explanation `json:"amtStatus,omitempty"`
+}
+
+// OK writes data wrapped in the v2 envelope.
+func OK(c *gin.Context, data interface{}) {
+	c.JSON(http.StatusOK, Envelope{Data: data})
+}
+
+// Fail aborts the request with an RFC 7807 problem wrapped in the v2 envelope.
+func Fail(c *gin.Context, status int, code consoleerrors.Code, detail string) {
+	abortWithProblem(c, newProblem(c, status, code, detail))
+}
+
+func newProblem(c *gin.Context, status int, code consoleerrors.Code, detail string) *Problem {
+	return &Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request.URL.Path,
+		Code:     code,
+	}
+}
+
+func abortWithProblem(c *gin.Context, problem *Problem) {
+	c.AbortWithStatusJSON(problem.Status, Envelope{Error: problem})
+}
@@ -0,0 +1,18 @@
+package v2
+
+import (
+	"github.com/gin-gonic/gin"
+
+	v1 "github.com/device-management-toolkit/console/internal/controller/httpapi/v1"
+)
+
+// ErrorResponse answers err with the status, code and message v1 maps it to, as an RFC 7807 problem in the
+// error of the envelope.
+func ErrorResponse(c *gin.Context, err error) {
+	e := v1.MapError(c, err)
+
+	problem := newProblem(c, e.Status, e.Code, e.Message)
+	problem.AMTStatus = e.AMTStatus
+
+	abortWithProblem(c, problem)
+}
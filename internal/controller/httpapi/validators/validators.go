@@ -0,0 +1,71 @@
+// Package validators registers the console's custom binding tags.
+//
+// Every tag used in a `binding:"..."` struct tag that is not built into
+// go-playground/validator must be listed here; NewRouter registers them all
+// with gin's validator at startup.
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// ErrUnsupportedEngine is returned when gin is configured with a validator other than go-playground/validator.
+var ErrUnsupportedEngine = errors.New("binding validator is not a go-playground validator")
+
+// registry maps binding tags to their validation functions.
+var registry = map[string]validator.Func{
+	"alphanumhyphenunderscore": dto.ValidateAlphaNumHyphenUnderscore,
+	"authProtocolValidator":    dto.AuthProtocolValidator,
+	"authforieee8021x":         dto.ValidateAuthandIEEE,
+	"ciraortls":                dto.ValidateCIRAOrTLS,
+	"genpasswordwone":          dto.ValidateAMTPassOrGenRan,
+	"guid":                     dto.ValidateGUID,
+	"ianatimezone":             dto.ValidateIANATimezone,
+	"mutualtls":                dto.ValidateMutualTLS,
+}
+
+// Tags returns the registered custom tags in alphabetical order.
+func Tags() []string {
+	tags := make([]string, 0, len(registry))
+	for tag := range registry {
+		tags = append(tags, tag)
+	}
+
+	sort.Strings(tags)
+
+	return tags
+}
+
+// Register adds every custom tag to v.
+func Register(v *validator.Validate) error {
+	var errs []error
+
+	for _, tag := range Tags() {
+		if err := v.RegisterValidation(tag, registry[tag]); err != nil {
+			errs = append(errs, fmt.Errorf("registering %s: %w", tag, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// RegisterBinding adds every custom tag to gin's binding validator.
+func RegisterBinding() error {
+	if binding.Validator == nil {
+		return nil
+	}
+
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return ErrUnsupportedEngine
+	}
+
+	return Register(v)
+}
@@ -0,0 +1,131 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	v := validator.New()
+	v.SetTagName("binding")
+
+	require.NoError(t, Register(v))
+
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{tag: "guid", value: "123e4567-e89b-12d3-a456-426614174000", valid: true},
+		{tag: "guid", value: "123E4567-E89B-12D3-A456-426614174000", valid: true},
+		{tag: "guid", value: "123e4567e89b12d3a456426614174000", valid: false},
+		{tag: "guid", value: "valid-guid", valid: false},
+		{tag: "ianatimezone", value: "Europe/Berlin", valid: true},
+		{tag: "ianatimezone", value: "UTC", valid: true},
+		{tag: "ianatimezone", value: "Local", valid: false},
+		{tag: "ianatimezone", value: "Mars/Olympus_Mons", valid: false},
+		{tag: "alphanumhyphenunderscore", value: "my-domain_1", valid: true},
+		{tag: "alphanumhyphenunderscore", value: "my domain", valid: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.tag+"/"+tc.value, func(t *testing.T) {
+			t.Parallel()
+
+			err := v.Var(tc.value, tc.tag)
+			assert.Equal(t, tc.valid, err == nil, "%v", err)
+		})
+	}
+}
+
+func TestTags(t *testing.T) {
+	t.Parallel()
+
+	tags := Tags()
+
+	assert.Contains(t, tags, "guid")
+	assert.Contains(t, tags, "ianatimezone")
+	assert.IsIncreasing(t, tags)
+}
+
+func TestDeviceAttributes(t *testing.T) {
+	t.Parallel()
+
+	v := validator.New()
+	v.SetTagName("binding")
+
+	require.NoError(t, Register(v))
+
+	tooMany := map[string]string{}
+	for i := range dto.MaxDeviceAttributes + 1 {
+		tooMany[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	value := "1234"
+	longValue := strings.Repeat("x", 257)
+
+	tests := []struct {
+		name  string
+		value any
+		valid bool
+	}{
+		{name: "no attributes", value: dto.Device{}, valid: true},
+		{name: "attribute", value: dto.Device{Attributes: map[string]string{"costCenter": "1234"}}, valid: true},
+		{name: "too many attributes", value: dto.Device{Attributes: tooMany}},
+		{name: "invalid name", value: dto.Device{Attributes: map[string]string{"cost center": "1234"}}},
+		{name: "empty name", value: dto.Device{Attributes: map[string]string{"": "1234"}}},
+		{name: "long name", value: dto.Device{Attributes: map[string]string{strings.Repeat("k", 65): "1234"}}},
+		{name: "long value", value: dto.Device{Attributes: map[string]string{"costCenter": longValue}}},
+		{name: "patch attribute", value: dto.DevicePatch{Attributes: map[string]*string{"costCenter": &value}}, valid: true},
+		{name: "patch removes attribute", value: dto.DevicePatch{Attributes: map[string]*string{"costCenter": nil}}, valid: true},
+		{name: "patch invalid name", value: dto.DevicePatch{Attributes: map[string]*string{"cost.center": &value}}},
+		{name: "patch long value", value: dto.DevicePatch{Attributes: map[string]*string{"costCenter": &longValue}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := v.Struct(tc.value)
+			assert.Equal(t, tc.valid, err == nil, "%v", err)
+		})
+	}
+}
+
+func TestTrustedCNs(t *testing.T) {
+	t.Parallel()
+
+	v := validator.New()
+	v.SetTagName("binding")
+
+	require.NoError(t, Register(v))
+
+	tests := []struct {
+		name    string
+		profile dto.Profile
+		valid   bool
+	}{
+		{name: "no trusted CNs", profile: dto.Profile{TLSMode: 1}, valid: true},
+		{name: "mutual TLS", profile: dto.Profile{TLSMode: 3, TLSTrustedCNs: []string{"console.example.com"}}, valid: true},
+		{name: "server authentication only", profile: dto.Profile{TLSMode: 1, TLSTrustedCNs: []string{"console.example.com"}}},
+		{name: "invalid common name", profile: dto.Profile{TLSMode: 4, TLSTrustedCNs: []string{"console example"}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := v.StructPartial(tc.profile, "TLSTrustedCNs")
+			assert.Equal(t, tc.valid, err == nil, "%v", err)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package openapi
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -47,13 +49,77 @@ func (f *FuegoAdapter) RegisterRoutes() {
 
 	// Devices
 	f.RegisterDeviceRoutes()
+	f.RegisterInventoryRoutes()
 
 	// Device Management
 	f.RegisterDeviceManagementRoutes()
+	f.RegisterPowerHistoryRoutes()
+	f.RegisterAddressHistoryRoutes()
+	f.RegisterCredentialCheckoutRoutes()
+	f.RegisterCheckRoutes()
+
+	// Jobs
+	f.RegisterJobRoutes()
+
+	// Energy Policies
+	f.RegisterEnergyPolicyRoutes()
+
+	// Sites
+	f.RegisterSiteRoutes()
+
+	// Enrollment
+	f.RegisterEnrollmentRoutes()
+
+	// Users
+	f.RegisterUserRoutes()
+	f.RegisterTenantKeyRoutes()
+	f.RegisterRootCARoutes()
+
+	// Images
+	f.RegisterImageRoutes()
+
+	// Logging
+	f.RegisterLoggingRoutes()
+
+	// Maintenance
+	f.RegisterReadOnlyRoutes()
+
+	// v2
+	f.RegisterV2DeviceManagementRoutes()
 }
 
-// Generates OpenAPI specification as JSON.
+// Generates OpenAPI specification as JSON for the whole server.
 func (f *FuegoAdapter) GetOpenAPISpec() ([]byte, error) {
+	return f.GetVersionedSpec(SpecVersionAll)
+}
+
+// Generates OpenAPI specification as JSON for a single API version.
+// SpecVersionAll returns every console API version plus the Redfish service.
+func (f *FuegoAdapter) GetVersionedSpec(specVersion string) ([]byte, error) {
+	prefix, ok := specVersionPrefixes[specVersion]
+	if !ok {
+		return nil, ErrUnknownSpecVersion
+	}
+
+	validSpec := f.buildSpec()
+
+	if specVersion == SpecVersionAll {
+		f.mergeRedfishSpec(validSpec)
+	} else {
+		filterPaths(validSpec, prefix)
+
+		if info, ok := validSpec["info"].(map[string]interface{}); ok {
+			info["title"] = fmt.Sprintf("Console API (%s)", specVersion)
+		}
+	}
+
+	validSpec["tags"] = collectTags(validSpec["paths"])
+
+	return json.MarshalIndent(validSpec, "", "  ")
+}
+
+// buildSpec converts the Fuego generated document into a plain map with defaults filled in.
+func (f *FuegoAdapter) buildSpec() map[string]interface{} {
 	spec := f.server.OutputOpenAPISpec()
 
 	// Default
@@ -76,12 +142,12 @@ func (f *FuegoAdapter) GetOpenAPISpec() ([]byte, error) {
 	}
 
 	if spec == nil {
-		return json.MarshalIndent(validSpec, "", "  ")
+		return validSpec
 	}
 
 	specBytes, err := json.Marshal(spec)
 	if err != nil {
-		return json.MarshalIndent(validSpec, "", "  ")
+		return validSpec
 	}
 
 	var fuegoSpec map[string]interface{}
@@ -100,7 +166,63 @@ func (f *FuegoAdapter) GetOpenAPISpec() ([]byte, error) {
 		}
 	}
 
-	return json.MarshalIndent(validSpec, "", "  ")
+	return validSpec
+}
+
+// mergeRedfishSpec adds the Redfish paths and components so one document describes the whole server.
+// Console definitions win when a component name exists in both documents.
+func (f *FuegoAdapter) mergeRedfishSpec(validSpec map[string]interface{}) {
+	redfishJSON, err := redfishOpenAPISpec()
+	if err != nil {
+		f.logger.Warn("openapi - failed to load Redfish spec: " + err.Error())
+
+		return
+	}
+
+	var redfishSpec map[string]interface{}
+	if err := json.Unmarshal(redfishJSON, &redfishSpec); err != nil {
+		f.logger.Warn("openapi - failed to parse Redfish spec: " + err.Error())
+
+		return
+	}
+
+	paths, _ := validSpec["paths"].(map[string]interface{})
+	if paths == nil {
+		paths = make(map[string]interface{})
+		validSpec["paths"] = paths
+	}
+
+	redfishPaths, _ := redfishSpec["paths"].(map[string]interface{})
+	for path, item := range redfishPaths {
+		tagOperations(item, redfishTag)
+		paths[path] = item
+	}
+
+	components, _ := validSpec["components"].(map[string]interface{})
+	if components == nil {
+		components = make(map[string]interface{})
+		validSpec["components"] = components
+	}
+
+	redfishComponents, _ := redfishSpec["components"].(map[string]interface{})
+	for section, entries := range redfishComponents {
+		entriesMap, ok := entries.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		existing, _ := components[section].(map[string]interface{})
+		if existing == nil {
+			existing = make(map[string]interface{})
+			components[section] = existing
+		}
+
+		for name, entry := range entriesMap {
+			if _, ok := existing[name]; !ok {
+				existing[name] = entry
+			}
+		}
+	}
 }
 
 func (f *FuegoAdapter) cleanDescriptions(paths interface{}) interface{} {
@@ -143,9 +265,16 @@ func (f *FuegoAdapter) cleanMethodDescription(m interface{}) {
 }
 
 // Adds Fuego-generated OpenAPI endpoints to existing Gin router.
+// The optional version query parameter (v1, v2) limits the spec to one API version.
 func (f *FuegoAdapter) AddToGinRouter(router *gin.Engine) {
 	router.GET("/api/openapi.json", func(c *gin.Context) {
-		spec, err := f.GetOpenAPISpec()
+		spec, err := f.GetVersionedSpec(c.Query("version"))
+		if errors.Is(err, ErrUnknownSpecVersion) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+
+			return
+		}
+
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate OpenAPI spec"})
 
@@ -155,3 +284,9 @@ func (f *FuegoAdapter) AddToGinRouter(router *gin.Engine) {
 		c.Data(http.StatusOK, "application/json", spec)
 	})
 }
+
+// optionIdempotencyKey documents the Idempotency-Key header making a POST safe to retry.
+func optionIdempotencyKey() fuego.RouteOption {
+	return fuego.OptionHeader("Idempotency-Key", "Client chosen key of the request, a retry with the same key and body "+
+		"within 24 hours gets the response of the first request without running it again")
+}
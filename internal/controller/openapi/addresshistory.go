@@ -0,0 +1,27 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterAddressHistoryRoutes() {
+	// net/http cannot tell devices/cert/{id} from devices/{guid}/addresses, see RegisterDeviceRoutes
+	history := fuego.Group(f.server, "")
+	history.Mux = http.NewServeMux()
+
+	fuego.Get(history, "/api/v1/admin/devices/{guid}/addresses", f.getAddressHistory,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Get Address History"),
+		fuego.OptionDescription("Retrieve the hostnames of a device and the addresses they resolved to, newest first. "+
+			"The hostname is resolved again whenever the device is changed or cannot be reached"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+}
+
+func (f *FuegoAdapter) getAddressHistory(_ fuego.ContextNoBody) ([]dto.DeviceAddress, error) {
+	return []dto.DeviceAddress{}, nil
+}
@@ -0,0 +1,27 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/go-fuego/fuego"
+)
+
+func (f *FuegoAdapter) RegisterCheckRoutes() {
+	fuego.Get(f.server, "/api/v1/checks/{guid}/{check}", f.runCheck,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Run Status Check"),
+		fuego.OptionDescription("Run a status check of a device for Nagios, Zabbix and other monitoring tools, answered with the "+
+			"output line of a Nagios plugin as plain text, e.g. POWER OK - on | power_state=2. "+
+			"power is OK when the device is on, WARNING while it sleeps and CRITICAL when it is off; cira is OK while the device "+
+			"is connected over CIRA; amt is OK when AMT answers. OK and WARNING are answered with 200, CRITICAL with 503 and "+
+			"UNKNOWN with 500. When the checks have an API key configured, it is sent in the X-API-Key header instead of an access token"),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionPath("check", "The check: power, cira or amt"),
+		fuego.OptionHeader("X-API-Key", "API key of the checks, when one is configured"),
+		fuego.OptionAddResponse(http.StatusOK, "The output line of the check", fuego.Response{Type: "", ContentTypes: []string{"text/plain"}}),
+	)
+}
+
+func (f *FuegoAdapter) runCheck(_ fuego.ContextNoBody) (string, error) {
+	return "POWER OK - on | power_state=2\n", nil
+}
@@ -0,0 +1,52 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterCredentialCheckoutRoutes() {
+	// net/http cannot tell devices/cert/{id} from devices/{guid}/credentials, see RegisterDeviceRoutes
+	credentials := fuego.Group(f.server, "")
+	credentials.Mux = http.NewServeMux()
+
+	fuego.Post(credentials, "/api/v1/admin/devices/{guid}/credentials/checkout", f.checkoutCredential,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Check Out Device Password"),
+		fuego.OptionDescription("Reveal the AMT admin password of a device to an admin for the given reason, until the checkout expires "+
+			"after ttl seconds or the configured default. The checkout is recorded in the audit trail of the device; "+
+			"a password checked out by another admin is refused with 409 until it is checked in or expires"),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionDefaultStatusCode(http.StatusCreated),
+	)
+
+	fuego.Delete(credentials, "/api/v1/admin/devices/{guid}/credentials/checkout", f.checkInCredential,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Check In Device Password"),
+		fuego.OptionDescription("End the checkout of the AMT admin password of a device by the user before it expires"),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionDefaultStatusCode(http.StatusNoContent),
+	)
+
+	fuego.Get(credentials, "/api/v1/admin/devices/{guid}/credentials/checkouts", f.getCredentialCheckouts,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Get Device Password Checkouts"),
+		fuego.OptionDescription("Retrieve the audit trail of the checkouts of the AMT admin password of a device, newest first"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+}
+
+func (f *FuegoAdapter) checkoutCredential(_ fuego.ContextWithBody[dto.CredentialCheckoutRequest]) (dto.CredentialCheckout, error) {
+	return dto.CredentialCheckout{}, nil
+}
+
+func (f *FuegoAdapter) checkInCredential(_ fuego.ContextNoBody) (any, error) {
+	return nil, nil
+}
+
+func (f *FuegoAdapter) getCredentialCheckouts(_ fuego.ContextNoBody) ([]dto.CredentialCheckoutRecord, error) {
+	return []dto.CredentialCheckoutRecord{}, nil
+}
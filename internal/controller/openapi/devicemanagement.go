@@ -1,6 +1,9 @@
 package openapi
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/go-fuego/fuego"
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
@@ -32,6 +35,19 @@ func (f *FuegoAdapter) registerKVMAndCertificateRoutes() {
 		fuego.OptionPath("guid", "Device GUID"),
 	)
 
+	// kvm session limits
+	fuego.Get(f.server, "/api/v1/admin/kvm/limits", f.getKVMLimits,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Get KVM limits"),
+		fuego.OptionDescription("Retrieve the quality, frame rate and bandwidth limits of new KVM sessions"),
+	)
+
+	fuego.Put(f.server, "/api/v1/admin/kvm/limits", f.setKVMLimits,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Set KVM limits"),
+		fuego.OptionDescription("Replace the quality, frame rate and bandwidth limits of new KVM sessions, 0 leaves a limit out. A session overrides them with the quality, frameRate and bandwidth query parameters of the redirection websocket"),
+	)
+
 	// Certificates
 	fuego.Get(f.server, "/api/v1/admin/amt/certificates/{guid}", f.getCertificates,
 		fuego.OptionTags("Device Management"),
@@ -53,6 +69,14 @@ func (f *FuegoAdapter) registerKVMAndCertificateRoutes() {
 		fuego.OptionDescription("Delete a certificate from the device"),
 		fuego.OptionPath("guid", "Device GUID"),
 	)
+
+	fuego.Delete(f.server, "/api/v1/admin/amt/certificates/{guid}/orphans", f.cleanupCertificates,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Clean up Certificates"),
+		fuego.OptionDescription("Delete the certificates and key pairs left by failed provisioning attempts, those no TLS, wireless or 802.1x credential context uses. Read-only and trusted root certificates are kept, certificates are deleted before their key pairs. Devices are cleaned up in bulk with a certificate-cleanup job."),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionQueryBool("dryRun", "List the certificates and key pairs the cleanup would delete without deleting them"),
+	)
 }
 
 func (f *FuegoAdapter) registerExplorerRoutes() {
@@ -81,12 +105,113 @@ func (f *FuegoAdapter) registerNetworkAndFeatureRoutes() {
 		fuego.OptionPath("guid", "Device GUID"),
 	)
 
+	fuego.Put(f.server, "/api/v1/admin/amt/tls/{guid}", f.setTLSSettings,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Set TLS Settings"),
+		fuego.OptionDescription("Enable or disable mutual TLS authentication and set the trusted common names of the remote TLS interface of a device"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
 	// Network settings
 	fuego.Get(f.server, "/api/v1/admin/amt/networkSettings/{guid}", f.getNetworkSettings,
 		fuego.OptionTags("Device Management"),
 		fuego.OptionSummary("Get Network Settings"),
 		fuego.OptionDescription("Retrieve network settings for a device"),
 		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionQuery("fields", "Comma-separated interfaces to retrieve, wired and wireless; all of them when omitted"),
+	)
+
+	// Wi-Fi profile priorities
+	fuego.Get(f.server, "/api/v1/admin/amt/network/wifiProfiles/{guid}", f.getWiFiProfiles,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Get Wi-Fi Profiles"),
+		fuego.OptionDescription("Retrieve the Wi-Fi profiles of a device in the order it connects to them"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Put(f.server, "/api/v1/admin/amt/network/wifiProfiles/{guid}", f.reorderWiFiProfiles,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Reorder Wi-Fi Profiles"),
+		fuego.OptionDescription("Renumber the priorities of the Wi-Fi profiles of a device in the order of instanceIDs, which lists each of them once. The previous priorities are restored when the device refuses an update."),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	// Wi-Fi port settings
+	fuego.Get(f.server, "/api/v1/admin/amt/network/wifiPortSettings/{guid}", f.getWiFiPortSettings,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Get Wi-Fi Port Settings"),
+		fuego.OptionDescription("Retrieve the local profile synchronization and UEFI Wi-Fi profile share settings of a device"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Put(f.server, "/api/v1/admin/amt/network/wifiPortSettings/{guid}", f.setWiFiPortSettings,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Set Wi-Fi Port Settings"),
+		fuego.OptionDescription("Replace the local profile synchronization and UEFI Wi-Fi profile share settings of a device. The share can only be enabled on firmware supporting it, from Intel CSME 16.0."),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	// Kerberos authentication
+	fuego.Get(f.server, "/api/v1/admin/amt/kerberos/{guid}", f.getKerberosSettings,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Get Kerberos Settings"),
+		fuego.OptionDescription("Retrieve the Kerberos settings AMT uses to authenticate Active Directory users: realm, key version and encryption algorithms. The keys are never returned."),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Put(f.server, "/api/v1/admin/amt/kerberos/{guid}", f.setKerberosSettings,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Set Kerberos Settings"),
+		fuego.OptionDescription("Replace the Kerberos settings of a device. Enabling Kerberos needs the realm and the key of the AMT service principal: a masterKey configures RC4-HMAC only, a passphrase and salt configure the AES algorithms too."),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	// Digest and Kerberos users
+	fuego.Get(f.server, "/api/v1/admin/amt/users/{guid}", f.getDeviceUsers,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Get Device Users"),
+		fuego.OptionDescription("List the digest and Kerberos users of the user ACL of a device with their access permission and realms. The admin account is not listed and passwords are never returned."),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/amt/users/{guid}", f.addDeviceUser,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Add Device User"),
+		fuego.OptionDescription("Add a digest user, with a username and password, or a Kerberos user, with the SID of an Active Directory account, to a device. The user may use the given realms from the local interface, the network or both."),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionDefaultStatusCode(http.StatusCreated),
+	)
+
+	fuego.Put(f.server, "/api/v1/admin/amt/users/{guid}/{handle}", f.updateDeviceUser,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Update Device User"),
+		fuego.OptionDescription("Replace a user of a device. A digest user keeps its password unless a new one is given."),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionPath("handle", "User handle"),
+	)
+
+	fuego.Delete(f.server, "/api/v1/admin/amt/users/{guid}/{handle}", f.removeDeviceUser,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Remove Device User"),
+		fuego.OptionDescription("Remove a user from a device."),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionPath("handle", "User handle"),
+		fuego.OptionDefaultStatusCode(http.StatusNoContent),
+	)
+
+	// Network link settings
+	fuego.Get(f.server, "/api/v1/admin/amt/network/linkSettings/{guid}", f.getLinkSettings,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Get Link Settings"),
+		fuego.OptionDescription("Retrieve the link policy of the network interfaces of a device and the link preference of its wireless interface. The link policy is a sum of 1 (S0 AC), 14 (Sx AC), 16 (S0 DC) and 224 (Sx DC)."),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Put(f.server, "/api/v1/admin/amt/network/linkSettings/{guid}", f.setLinkSettings,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Set Link Settings"),
+		fuego.OptionDescription("Change the link policy of a network interface of a device and, on the wireless interface, its link preference: 1 gives the link to ME for timeout seconds, 2 gives it back to the host. The settings are read back from the device and returned; the request fails when the device did not apply them."),
+		fuego.OptionPath("guid", "Device GUID"),
 	)
 
 	// Features
@@ -141,7 +266,7 @@ func (f *FuegoAdapter) registerPowerRoutes() {
 	fuego.Post(f.server, "/api/v1/admin/amt/power/action/{guid}", f.powerAction,
 		fuego.OptionTags("Device Management"),
 		fuego.OptionSummary("Perform Power Action"),
-		fuego.OptionDescription("Perform a power action on a device"),
+		fuego.OptionDescription("Perform a power action on a device. Action 600 (graceful shutdown) and 601 (graceful restart) ask the OS to shut down through the in-band agent and power the device down or reset it if it is still on after two minutes"),
 		fuego.OptionPath("guid", "Device GUID"),
 	)
 
@@ -179,9 +304,15 @@ func (f *FuegoAdapter) registerLogsAndAlarmRoutes() {
 	fuego.Get(f.server, "/api/v1/admin/amt/log/audit/{guid}", f.getAuditLog,
 		fuego.OptionTags("Device Management"),
 		fuego.OptionSummary("Get Audit Log"),
-		fuego.OptionDescription("Retrieve audit log entries for a device"),
+		fuego.OptionDescription("Retrieve a page of the decoded audit log of a device, newest record first, filtered by time range and event. With startIndex, the raw records of the AMT window at that index are returned instead, as totalCnt and records"),
 		fuego.OptionPath("guid", "Device GUID"),
-		fuego.OptionQuery("startIndex", "Start index for pagination"),
+		fuego.OptionQuery("startIndex", "Index of the AMT window of raw records to return"),
+		fuego.OptionQueryInt("$top", "Number of records to return"),
+		fuego.OptionQueryInt("$skip", "Number of matching records to skip"),
+		fuego.OptionQuery("from", "Oldest time of the records, RFC 3339"),
+		fuego.OptionQuery("to", "Newest time of the records, RFC 3339"),
+		fuego.OptionQueryInt("auditAppId", "Application ID of the records, repeatable"),
+		fuego.OptionQueryInt("eventId", "Event ID of the records, repeatable"),
 	)
 
 	fuego.Get(f.server, "/api/v1/admin/amt/log/audit/{guid}/download", f.downloadAuditLog,
@@ -191,6 +322,14 @@ func (f *FuegoAdapter) registerLogsAndAlarmRoutes() {
 		fuego.OptionPath("guid", "Device GUID"),
 	)
 
+	fuego.Get(f.server, "/api/v1/admin/amt/log/audit/{guid}/export", f.exportAuditLog,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Export Audit Log"),
+		fuego.OptionDescription("Stream the complete decoded audit log of a device, oldest record first, as NDJSON (default) or CSV"),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionQuery("format", "Export format: ndjson or csv"),
+	)
+
 	fuego.Get(f.server, "/api/v1/admin/amt/log/event/{guid}", f.getEventLog,
 		fuego.OptionTags("Device Management"),
 		fuego.OptionSummary("Get Event Log"),
@@ -205,6 +344,35 @@ func (f *FuegoAdapter) registerLogsAndAlarmRoutes() {
 		fuego.OptionPath("guid", "Device GUID"),
 	)
 
+	fuego.Get(f.server, "/api/v1/admin/amt/log/crash/{guid}", f.getCrashReport,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Get Crash Report"),
+		fuego.OptionDescription("Retrieve the OS crashes, boot failures, firmware errors and watchdog expirations a device logged, newest first, with the last firmware progress before each"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	// WS-Man message capture
+	fuego.Post(f.server, "/api/v1/admin/amt/capture/{guid}", f.startWsmanCapture,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Start WS-Man Capture"),
+		fuego.OptionDescription("Record the SOAP requests and responses exchanged with a device for the given number of minutes. Credentials are redacted and the trace replaces any earlier trace of the device"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Delete(f.server, "/api/v1/admin/amt/capture/{guid}", f.stopWsmanCapture,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Stop WS-Man Capture"),
+		fuego.OptionDescription("End a running capture early; the trace remains available for download"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Get(f.server, "/api/v1/admin/amt/capture/{guid}/download", f.downloadWsmanCapture,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Download WS-Man Capture"),
+		fuego.OptionDescription("Download the redacted trace of the last capture of a device"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
 	// Alarm occurrences
 	fuego.Get(f.server, "/api/v1/admin/amt/alarmOccurrences/{guid}", f.getAlarmOccurrences,
 		fuego.OptionTags("Device Management"),
@@ -218,6 +386,7 @@ func (f *FuegoAdapter) registerLogsAndAlarmRoutes() {
 		fuego.OptionSummary("Create Alarm Occurrence"),
 		fuego.OptionDescription("Create an alarm occurrence on a device"),
 		fuego.OptionPath("guid", "Device GUID"),
+		optionIdempotencyKey(),
 	)
 
 	fuego.Delete(f.server, "/api/v1/admin/amt/alarmOccurrences/{guid}", f.deleteAlarmOccurrences,
@@ -243,6 +412,8 @@ func (f *FuegoAdapter) registerVersionAndHardwareRoutes() {
 		fuego.OptionSummary("Get Hardware Info"),
 		fuego.OptionDescription("Retrieve hardware information for a device"),
 		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionQuery("fields", "Comma-separated CIM classes to retrieve, such as CIM_Chassis,CIM_Processor; all of them when omitted. "+
+			"The classes left out are not read from the device"),
 	)
 
 	// Disk Info
@@ -299,6 +470,14 @@ func (f *FuegoAdapter) setKVMDisplays(c fuego.ContextWithBody[dto.KVMScreenSetti
 	return dto.KVMScreenSettings{Displays: []dto.KVMScreenDisplay{display}}, nil
 }
 
+func (f *FuegoAdapter) getKVMLimits(_ fuego.ContextNoBody) (dto.KVMLimits, error) {
+	return dto.KVMLimits{Quality: 5, FrameRate: 10, Bandwidth: 262144}, nil
+}
+
+func (f *FuegoAdapter) setKVMLimits(c fuego.ContextWithBody[dto.KVMLimits]) (dto.KVMLimits, error) {
+	return c.Body()
+}
+
 func (f *FuegoAdapter) getCertificates(_ fuego.ContextNoBody) (dto.SecuritySettings, error) {
 	return dto.SecuritySettings{}, nil
 }
@@ -311,6 +490,10 @@ func (f *FuegoAdapter) deleteCertificate(_ fuego.ContextWithBody[dto.DeleteCerti
 	return nil, nil
 }
 
+func (f *FuegoAdapter) cleanupCertificates(_ fuego.ContextNoBody) (dto.CertificateCleanup, error) {
+	return dto.CertificateCleanup{}, nil
+}
+
 func (f *FuegoAdapter) getCallList(_ fuego.ContextNoBody) ([]string, error) {
 	return []string{"GetInventory", "Reboot", "CollectLogs"}, nil
 }
@@ -323,10 +506,62 @@ func (f *FuegoAdapter) getTLSSettingData(_ fuego.ContextNoBody) ([]dto.SettingDa
 	return []dto.SettingDataResponse{}, nil
 }
 
+func (f *FuegoAdapter) setTLSSettings(_ fuego.ContextWithBody[dto.TLSSettingsRequest]) (dto.SettingDataResponse, error) {
+	return dto.SettingDataResponse{}, nil
+}
+
 func (f *FuegoAdapter) getNetworkSettings(_ fuego.ContextNoBody) (dto.NetworkSettings, error) {
 	return dto.NetworkSettings{}, nil
 }
 
+func (f *FuegoAdapter) getWiFiProfiles(_ fuego.ContextNoBody) ([]dto.WiFiProfile, error) {
+	return []dto.WiFiProfile{}, nil
+}
+
+func (f *FuegoAdapter) reorderWiFiProfiles(_ fuego.ContextWithBody[dto.WiFiProfileOrder]) ([]dto.WiFiProfile, error) {
+	return []dto.WiFiProfile{}, nil
+}
+
+func (f *FuegoAdapter) getWiFiPortSettings(_ fuego.ContextNoBody) (dto.WiFiPortSettings, error) {
+	return dto.WiFiPortSettings{}, nil
+}
+
+func (f *FuegoAdapter) setWiFiPortSettings(_ fuego.ContextWithBody[dto.WiFiPortSettingsRequest]) (dto.WiFiPortSettings, error) {
+	return dto.WiFiPortSettings{}, nil
+}
+
+func (f *FuegoAdapter) getKerberosSettings(_ fuego.ContextNoBody) (dto.KerberosSettings, error) {
+	return dto.KerberosSettings{}, nil
+}
+
+func (f *FuegoAdapter) setKerberosSettings(_ fuego.ContextWithBody[dto.KerberosSettingsRequest]) (dto.KerberosSettings, error) {
+	return dto.KerberosSettings{}, nil
+}
+
+func (f *FuegoAdapter) getDeviceUsers(_ fuego.ContextNoBody) ([]dto.DeviceUser, error) {
+	return []dto.DeviceUser{}, nil
+}
+
+func (f *FuegoAdapter) addDeviceUser(_ fuego.ContextWithBody[dto.DeviceUserRequest]) (dto.DeviceUser, error) {
+	return dto.DeviceUser{}, nil
+}
+
+func (f *FuegoAdapter) updateDeviceUser(_ fuego.ContextWithBody[dto.DeviceUserRequest]) (dto.DeviceUser, error) {
+	return dto.DeviceUser{}, nil
+}
+
+func (f *FuegoAdapter) removeDeviceUser(_ fuego.ContextNoBody) (any, error) {
+	return nil, nil
+}
+
+func (f *FuegoAdapter) getLinkSettings(_ fuego.ContextNoBody) ([]dto.LinkSettings, error) {
+	return []dto.LinkSettings{}, nil
+}
+
+func (f *FuegoAdapter) setLinkSettings(_ fuego.ContextWithBody[dto.LinkSettingsRequest]) (dto.LinkSettings, error) {
+	return dto.LinkSettings{}, nil
+}
+
 func (f *FuegoAdapter) cancelUserConsentCode(_ fuego.ContextNoBody) (dto.UserConsentMessage, error) {
 	return dto.UserConsentMessage{}, nil
 }
@@ -379,14 +614,18 @@ func (f *FuegoAdapter) setFeatures(_ fuego.ContextWithBody[dto.Features]) (dto.F
 	return dto.Features{}, nil
 }
 
-func (f *FuegoAdapter) getAuditLog(_ fuego.ContextNoBody) (dto.AuditLog, error) {
-	return dto.AuditLog{}, nil
+func (f *FuegoAdapter) getAuditLog(_ fuego.ContextNoBody) (dto.AuditLogPage, error) {
+	return dto.AuditLogPage{}, nil
 }
 
 func (f *FuegoAdapter) downloadAuditLog(_ fuego.ContextNoBody) (string, error) {
 	return "timestamp,level,message\n", nil
 }
 
+func (f *FuegoAdapter) exportAuditLog(_ fuego.ContextNoBody) (dto.AuditLogEntry, error) {
+	return dto.AuditLogEntry{}, nil
+}
+
 func (f *FuegoAdapter) getEventLog(_ fuego.ContextNoBody) (dto.EventLogs, error) {
 	return dto.EventLogs{}, nil
 }
@@ -395,6 +634,34 @@ func (f *FuegoAdapter) downloadEventLog(_ fuego.ContextNoBody) (string, error) {
 	return "timestamp,source,desc\n", nil
 }
 
+func (f *FuegoAdapter) getCrashReport(_ fuego.ContextNoBody) (dto.CrashReport, error) {
+	return dto.CrashReport{}, nil
+}
+
+func (f *FuegoAdapter) startWsmanCapture(c fuego.ContextWithBody[dto.WsmanCaptureRequest]) (dto.WsmanCapture, error) {
+	req, err := c.Body()
+	if err != nil {
+		return dto.WsmanCapture{}, err
+	}
+
+	startedAt := time.Now()
+
+	return dto.WsmanCapture{
+		GUID:      c.PathParam("guid"),
+		StartedAt: startedAt,
+		EndsAt:    startedAt.Add(time.Duration(req.Minutes) * time.Minute),
+		Active:    true,
+	}, nil
+}
+
+func (f *FuegoAdapter) stopWsmanCapture(c fuego.ContextNoBody) (dto.WsmanCapture, error) {
+	return dto.WsmanCapture{GUID: c.PathParam("guid")}, nil
+}
+
+func (f *FuegoAdapter) downloadWsmanCapture(c fuego.ContextNoBody) (string, error) {
+	return "# WS-Man trace of device " + c.PathParam("guid") + "\n", nil
+}
+
 func (f *FuegoAdapter) getVersion(_ fuego.ContextNoBody) (dto.Version, error) {
 	return dto.Version{}, nil
 }
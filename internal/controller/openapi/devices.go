@@ -1,6 +1,7 @@
 package openapi
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/go-fuego/fuego"
@@ -12,10 +13,15 @@ func (f *FuegoAdapter) RegisterDeviceRoutes() {
 	fuego.Get(f.server, "/api/v1/admin/devices", f.getDevices,
 		fuego.OptionTags("Devices"),
 		fuego.OptionSummary("List Devices"),
-		fuego.OptionDescription("Retrieve all devices with optional pagination and filtering"),
+		fuego.OptionDescription("Retrieve all devices with optional pagination and filtering. Custom attributes are filtered with attr.<name>=<value> parameters, e.g. attr.costCenter=1234"),
 		fuego.OptionQueryInt("$top", "Number of records to return"),
 		fuego.OptionQueryInt("$skip", "Number of records to skip"),
 		fuego.OptionQueryBool("$count", "Include total count"),
+		fuego.OptionQuery("hostname", "Filter devices by hostname"),
+		fuego.OptionQuery("friendlyName", "Filter devices by friendly name"),
+		fuego.OptionQuery("location", "Filter devices by location"),
+		fuego.OptionQuery("owner", "Filter devices by owner, \"me\" selects the devices of the logged in user"),
+		fuego.OptionQuery("assignee", "Filter devices by assignee, \"me\" selects the devices assigned to the logged in user"),
 		fuego.OptionQuery("tags", "Comma-separated list of tags to filter devices"),
 		fuego.OptionQuery("method", "Method to filter tags (any/all)"),
 	)
@@ -26,6 +32,24 @@ func (f *FuegoAdapter) RegisterDeviceRoutes() {
 		fuego.OptionDescription("Retrieve statistics for devices"),
 	)
 
+	fuego.Get(f.server, "/api/v1/admin/devices/export", f.exportDevices,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Export Devices"),
+		fuego.OptionDescription("Download every device as CSV with its GUID, hostname, friendly name, location, notes, tags, owner, assignee and connection status"),
+	)
+
+	fuego.Get(f.server, "/api/v1/admin/devices/certificates", f.getCertificateInventory,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Get Certificate Inventory"),
+		fuego.OptionDescription("Read the certificates of every device and list those matching the filters, the earliest to expire first. Text filters match any part of the value ignoring case, so issuer=Contoso Root CA with trustedRoot=true lists the devices trusting that CA. Devices whose certificates cannot be read are listed as unreachable"),
+		fuego.OptionQuery("search", "Text in the subject, issuer, hostname or GUID"),
+		fuego.OptionQuery("issuer", "Text in the issuer"),
+		fuego.OptionQuery("subject", "Text in the subject"),
+		fuego.OptionQuery("usage", "TLS, Wireless, Wired, or none for the certificates no profile uses"),
+		fuego.OptionQueryBool("trustedRoot", "Only the trusted root certificates, or only the others when false"),
+		fuego.OptionQuery("expiresBefore", "Only the certificates expiring before this RFC 3339 time"),
+	)
+
 	fuego.Get(f.server, "/api/v1/admin/devices/cert/{id}", f.getDeviceCertificate,
 		fuego.OptionTags("Devices"),
 		fuego.OptionSummary("Get Device Certificate"),
@@ -40,13 +64,92 @@ func (f *FuegoAdapter) RegisterDeviceRoutes() {
 		fuego.OptionPath("id", "Device ID"),
 	)
 
+	fuego.Post(f.server, "/api/v1/admin/devices/correlations/import", f.importCorrelations,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Import Intune or ConfigMgr Devices"),
+		fuego.OptionDescription("Match the devices of an Intune or ConfigMgr export to console devices by UUID or by the serialNumber attribute. "+
+			"The export is sent as JSON, or as CSV with Content-Type text/csv and the source in the query"),
+		fuego.OptionQuery("source", "intune or configmgr, only used for CSV exports"),
+	)
+
 	fuego.Get(f.server, "/api/v1/admin/devices/{id}", f.getDeviceByID,
 		fuego.OptionTags("Devices"),
 		fuego.OptionSummary("Get Device by ID"),
-		fuego.OptionDescription("Retrieve a specific device by ID"),
+		fuego.OptionDescription("Retrieve a specific device by ID, including its status in Intune or ConfigMgr as of the last import"),
 		fuego.OptionPath("id", "Device ID"),
 	)
 
+	// net/http, unlike gin, cannot tell devices/cert/{id} from devices/{guid}/redirection,
+	// the routes under devices/{guid} are registered on a mux of their own as they are only documented here
+	redirection := fuego.Group(f.server, "")
+	redirection.Mux = http.NewServeMux()
+
+	fuego.Get(redirection, "/api/v1/admin/devices/{guid}/redirection", f.getRedirectionSettings,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Get Redirection Settings"),
+		fuego.OptionDescription("Retrieve the SOL, IDER and KVM redirection settings of a device, including its KVM displays"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Put(redirection, "/api/v1/admin/devices/{guid}/redirection", f.setRedirectionSettings,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Set Redirection Settings"),
+		fuego.OptionDescription("Update the SOL, IDER and KVM redirection settings of a device. "+
+			"KVM can only be enabled when it is available and enabled in MEBx, port 5900 needs KVM enabled, "+
+			"user consent cannot be turned off in client control mode and the default screen must be one of the displays"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Put(redirection, "/api/v1/admin/devices/{guid}/redirection/display", f.selectKVMDisplay,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Select KVM Display"),
+		fuego.OptionDescription("Select the active display KVM sessions of a multi-monitor device show. "+
+			"Open KVM sessions to the device are ended for the viewers to reconnect to the display, its resolution is returned as a hint for sizing the viewer"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Get(redirection, "/api/v1/admin/devices/{guid}/presence", f.getPresence,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Get Device Presence"),
+		fuego.OptionDescription("Retrieve the console users with the details page or a KVM session of a device open, "+
+			"as told by their presence pings of the last 30 seconds"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Post(redirection, "/api/v1/admin/devices/{guid}/presence", f.pingPresence,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Ping Device Presence"),
+		fuego.OptionDescription("Record that the authenticated user has the details page or a KVM session of a device open "+
+			"and retrieve the users with the device open. Viewers ping while the page is open, a ping counts for 30 seconds"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Get(redirection, "/api/v1/admin/devices/{guid}/lock", f.getDeviceLock,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Get Device Lock"),
+		fuego.OptionDescription("Retrieve the advisory lock of a device, not found when it is not locked"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Post(redirection, "/api/v1/admin/devices/{guid}/lock", f.lockDevice,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Lock Device"),
+		fuego.OptionDescription("Lock a device for the authenticated user, or renew the lock the user has. "+
+			"While a device is locked, power actions and boot options such as secure erase of others are refused with 409 Conflict; "+
+			"when locks are required by the configuration they are refused on devices the caller has not locked. "+
+			"The body is optional, the lock is held for the configured TTL when it does not give one"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+
+	fuego.Delete(redirection, "/api/v1/admin/devices/{guid}/lock", f.unlockDevice,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Unlock Device"),
+		fuego.OptionDescription("Release the lock the authenticated user has on a device, force releases the lock of another holder"),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionQueryBool("force", "Release the lock of another holder"),
+		fuego.OptionDefaultStatusCode(http.StatusNoContent),
+	)
+
 	fuego.Get(f.server, "/api/v1/admin/devices/tags", f.getTags,
 		fuego.OptionTags("Devices"),
 		fuego.OptionSummary("Get Available Device Tags"),
@@ -57,6 +160,7 @@ func (f *FuegoAdapter) RegisterDeviceRoutes() {
 		fuego.OptionTags("Devices"),
 		fuego.OptionSummary("Create Device"),
 		fuego.OptionDescription("Create a new device"),
+		optionIdempotencyKey(),
 	)
 
 	fuego.Patch(f.server, "/api/v1/admin/devices", f.updateDevice,
@@ -65,11 +169,31 @@ func (f *FuegoAdapter) RegisterDeviceRoutes() {
 		fuego.OptionDescription("Update an existing device"),
 	)
 
+	fuego.Patch(f.server, "/api/v1/admin/devices/{id}", f.patchDevice,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Update Device Details"),
+		fuego.OptionDescription("Update the friendly name, location, notes, owner, assignee or custom attributes of a device; omitted fields are left unchanged, attributes set to null are removed"),
+		fuego.OptionPath("id", "Device ID"),
+	)
+
 	fuego.Delete(f.server, "/api/v1/admin/devices/{id}", f.deleteDevice,
 		fuego.OptionTags("Devices"),
 		fuego.OptionSummary("Delete Device"),
 		fuego.OptionDescription("Delete a device by ID"),
 		fuego.OptionPath("id", "Device ID"),
+		fuego.OptionQueryBool("dryRun", "Return what the deletion would do without deleting the device"),
+		fuego.OptionQueryBool("cleanup", "Remove the redirection, alarms, CIRA configuration and console issued certificates of the device first, answering with a report of what could not be removed"),
+		fuego.OptionAddResponse(http.StatusOK, "The actions of a dry run", fuego.Response{Type: dto.DryRunResult{}}),
+	)
+
+	fuego.Post(redirection, "/api/v1/admin/devices/{guid}/merge", f.mergeDevices,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Merge Duplicate Devices"),
+		fuego.OptionDescription("Merge duplicate records of the same machine into a device, as found by a duplicate-detection job. "+
+			"The device takes the tags of the duplicates and the custom attributes it lacks, their power, address and log history, "+
+			"correlations, credential checkouts and pending jobs; the duplicates are then deleted"),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionQueryBool("dryRun", "Return the merged device without changing anything"),
 	)
 }
 
@@ -99,6 +223,14 @@ func (f *FuegoAdapter) getDevices(_ fuego.ContextNoBody) (dto.DeviceCountRespons
 	}, nil
 }
 
+func (f *FuegoAdapter) getCertificateInventory(_ fuego.ContextNoBody) (dto.CertificateInventory, error) {
+	return dto.CertificateInventory{}, nil
+}
+
+func (f *FuegoAdapter) exportDevices(_ fuego.ContextNoBody) (string, error) {
+	return "", nil
+}
+
 func (f *FuegoAdapter) getDeviceStats(_ fuego.ContextNoBody) (dto.DeviceStatResponse, error) {
 	return dto.DeviceStatResponse{
 		TotalCount:        5,
@@ -133,6 +265,15 @@ func (f *FuegoAdapter) getDeviceByID(_ fuego.ContextNoBody) (dto.Device, error)
 	}, nil
 }
 
+func (f *FuegoAdapter) importCorrelations(c fuego.ContextWithBody[dto.CorrelationImport]) (dto.CorrelationImportResult, error) {
+	batch, err := c.Body()
+	if err != nil {
+		return dto.CorrelationImportResult{}, err
+	}
+
+	return dto.CorrelationImportResult{Matched: len(batch.Records), Unmatched: []string{}}, nil
+}
+
 func (f *FuegoAdapter) getTags(_ fuego.ContextNoBody) ([]string, error) {
 	return []string{"tag1", "tag2", "tag3"}, nil
 }
@@ -155,6 +296,140 @@ func (f *FuegoAdapter) updateDevice(c fuego.ContextWithBody[dto.Device]) (dto.De
 	return config, nil
 }
 
+func (f *FuegoAdapter) patchDevice(c fuego.ContextWithBody[dto.DevicePatch]) (dto.Device, error) {
+	patch, err := c.Body()
+	if err != nil {
+		return dto.Device{}, err
+	}
+
+	device := dto.Device{
+		GUID:     "example-guid-1",
+		Hostname: "device1.example.com",
+	}
+
+	if patch.FriendlyName != nil {
+		device.FriendlyName = *patch.FriendlyName
+	}
+
+	if patch.Location != nil {
+		device.Location = *patch.Location
+	}
+
+	if patch.Notes != nil {
+		device.Notes = *patch.Notes
+	}
+
+	if patch.Owner != nil {
+		device.Owner = *patch.Owner
+	}
+
+	if patch.Assignee != nil {
+		device.Assignee = *patch.Assignee
+	}
+
+	for name, value := range patch.Attributes {
+		if value == nil {
+			continue
+		}
+
+		if device.Attributes == nil {
+			device.Attributes = map[string]string{}
+		}
+
+		device.Attributes[name] = *value
+	}
+
+	return device, nil
+}
+
 func (f *FuegoAdapter) deleteDevice(_ fuego.ContextNoBody) (any, error) {
 	return nil, nil
 }
+
+func (f *FuegoAdapter) mergeDevices(c fuego.ContextWithBody[dto.DeviceMergeRequest]) (dto.DeviceMerge, error) {
+	req, err := c.Body()
+	if err != nil {
+		return dto.DeviceMerge{}, err
+	}
+
+	return dto.DeviceMerge{
+		Device:      &dto.Device{GUID: c.PathParam("guid"), Tags: []string{}},
+		Merged:      req.Duplicates,
+		Reassigned:  map[string]int64{"power_samples": 120, "device_addresses": 3},
+		PendingJobs: 1,
+	}, nil
+}
+
+func (f *FuegoAdapter) getRedirectionSettings(_ fuego.ContextNoBody) (dto.RedirectionSettings, error) {
+	return dto.RedirectionSettings{
+		ListenerEnabled: true,
+		SOL:             true,
+		IDER:            true,
+		KVM: dto.KVMRedirectionSettings{
+			Enabled:       true,
+			Available:     true,
+			EnabledByMEBx: true,
+			OptInPolicy:   true,
+			Displays: []dto.KVMScreenDisplay{
+				{DisplayIndex: 0, IsActive: true, ResolutionX: 1920, ResolutionY: 1080, Role: "primary", IsDefault: true},
+			},
+		},
+	}, nil
+}
+
+func (f *FuegoAdapter) setRedirectionSettings(c fuego.ContextWithBody[dto.RedirectionSettings]) (dto.RedirectionSettings, error) {
+	return c.Body()
+}
+
+func (f *FuegoAdapter) selectKVMDisplay(c fuego.ContextWithBody[dto.KVMDisplaySelection]) (dto.KVMDisplaySelected, error) {
+	sel, err := c.Body()
+	if err != nil {
+		return dto.KVMDisplaySelected{}, err
+	}
+
+	return dto.KVMDisplaySelected{
+		Display: dto.KVMScreenDisplay{DisplayIndex: sel.DisplayIndex, IsActive: true, ResolutionX: 1920, ResolutionY: 1080, IsDefault: true},
+	}, nil
+}
+
+func (f *FuegoAdapter) getPresence(_ fuego.ContextNoBody) ([]dto.Presence, error) {
+	return []dto.Presence{{User: "admin", View: dto.PresenceViewKVM, LastSeen: time.Now()}}, nil
+}
+
+func (f *FuegoAdapter) pingPresence(c fuego.ContextWithBody[dto.PresencePing]) ([]dto.Presence, error) {
+	ping, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+
+	return []dto.Presence{{User: "admin", View: ping.View, LastSeen: time.Now()}}, nil
+}
+
+func (f *FuegoAdapter) getDeviceLock(_ fuego.ContextNoBody) (dto.DeviceLock, error) {
+	return exampleDeviceLock(dto.DeviceLockRequest{Reason: "firmware update"}), nil
+}
+
+func (f *FuegoAdapter) lockDevice(c fuego.ContextWithBody[dto.DeviceLockRequest]) (dto.DeviceLock, error) {
+	req, err := c.Body()
+	if err != nil {
+		return dto.DeviceLock{}, err
+	}
+
+	return exampleDeviceLock(req), nil
+}
+
+func (f *FuegoAdapter) unlockDevice(_ fuego.ContextNoBody) (any, error) {
+	return nil, nil
+}
+
+func exampleDeviceLock(req dto.DeviceLockRequest) dto.DeviceLock {
+	now := time.Now()
+
+	return dto.DeviceLock{
+		GUID:       "123e4567-e89b-12d3-a456-426614174000",
+		Holder:     "admin",
+		Reason:     req.Reason,
+		AcquiredAt: now,
+		ExpiresAt:  now.Add(15 * time.Minute),
+	}
+}
@@ -0,0 +1,106 @@
+package openapi
+
+import (
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterEnergyPolicyRoutes() {
+	fuego.Get(f.server, "/api/v1/admin/energypolicies", f.getEnergyPolicies,
+		fuego.OptionTags("Energy Policies"),
+		fuego.OptionSummary("List Energy Policies"),
+		fuego.OptionDescription("Retrieve all energy policies with optional pagination"),
+		fuego.OptionQueryInt("$top", "Number of records to return"),
+		fuego.OptionQueryInt("$skip", "Number of records to skip"),
+		fuego.OptionQueryBool("$count", "Include total count"),
+	)
+
+	fuego.Get(f.server, "/api/v1/admin/energypolicies/{name}", f.getEnergyPolicyByName,
+		fuego.OptionTags("Energy Policies"),
+		fuego.OptionSummary("Get Energy Policy by Name"),
+		fuego.OptionDescription("Retrieve a specific energy policy by name"),
+		fuego.OptionPath("name", "Policy name"),
+	)
+
+	fuego.Get(f.server, "/api/v1/admin/energypolicies/{name}/dryrun", f.dryRunEnergyPolicy,
+		fuego.OptionTags("Energy Policies"),
+		fuego.OptionSummary("Dry Run Energy Policy"),
+		fuego.OptionDescription("Report for each action of the policy when it runs next and whether each device carrying "+
+			"one of its tags would be powered off or on, or skipped and why, without changing any device"),
+		fuego.OptionPath("name", "Policy name"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/energypolicies", f.createEnergyPolicy,
+		fuego.OptionTags("Energy Policies"),
+		fuego.OptionSummary("Create Energy Policy"),
+		fuego.OptionDescription("Create a policy that gracefully powers off idle devices with any of its tags at the power off time "+
+			"and powers them on at the power on time, on the given days in its time zone. Devices powered off by a policy "+
+			"get an alarm clock occurrence that wakes them at the next power on time"),
+	)
+
+	fuego.Patch(f.server, "/api/v1/admin/energypolicies", f.updateEnergyPolicy,
+		fuego.OptionTags("Energy Policies"),
+		fuego.OptionSummary("Update Energy Policy"),
+		fuego.OptionDescription("Update an existing energy policy"),
+	)
+
+	fuego.Delete(f.server, "/api/v1/admin/energypolicies/{name}", f.deleteEnergyPolicy,
+		fuego.OptionTags("Energy Policies"),
+		fuego.OptionSummary("Delete Energy Policy"),
+		fuego.OptionDescription("Delete an energy policy by name"),
+		fuego.OptionPath("name", "Policy name"),
+	)
+}
+
+func exampleEnergyPolicy(name string) dto.EnergyPolicy {
+	return dto.EnergyPolicy{
+		Name:         name,
+		Tags:         []string{"office"},
+		TimeZone:     "Europe/Berlin",
+		Days:         []string{"mon", "tue", "wed", "thu", "fri"},
+		PowerOffTime: "19:00",
+		PowerOnTime:  "07:00",
+		Exceptions:   []string{},
+		Enabled:      true,
+	}
+}
+
+func (f *FuegoAdapter) getEnergyPolicies(_ fuego.ContextNoBody) (dto.EnergyPolicyCountResponse, error) {
+	policies := []dto.EnergyPolicy{exampleEnergyPolicy("office-nights")}
+
+	return dto.EnergyPolicyCountResponse{
+		Count: len(policies),
+		Data:  policies,
+	}, nil
+}
+
+func (f *FuegoAdapter) getEnergyPolicyByName(c fuego.ContextNoBody) (dto.EnergyPolicy, error) {
+	return exampleEnergyPolicy(c.PathParam("name")), nil
+}
+
+func (f *FuegoAdapter) dryRunEnergyPolicy(_ fuego.ContextNoBody) ([]dto.EnergyPolicyReport, error) {
+	return []dto.EnergyPolicyReport{}, nil
+}
+
+func (f *FuegoAdapter) createEnergyPolicy(c fuego.ContextWithBody[dto.EnergyPolicy]) (dto.EnergyPolicy, error) {
+	policy, err := c.Body()
+	if err != nil {
+		return dto.EnergyPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (f *FuegoAdapter) updateEnergyPolicy(c fuego.ContextWithBody[dto.EnergyPolicy]) (dto.EnergyPolicy, error) {
+	policy, err := c.Body()
+	if err != nil {
+		return dto.EnergyPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (f *FuegoAdapter) deleteEnergyPolicy(_ fuego.ContextNoBody) (any, error) {
+	return nil, nil
+}
@@ -0,0 +1,60 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterEnrollmentRoutes() {
+	fuego.Get(f.server, "/api/v1/admin/enrollmenttokens", f.getEnrollmentTokens,
+		fuego.OptionTags("Enrollment"),
+		fuego.OptionSummary("List Enrollment Tokens"),
+		fuego.OptionDescription("Retrieve the enrollment tokens, newest first, with the device that used each of them. The tokens "+
+			"themselves are not returned, the console keeps their hashes only"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/enrollmenttokens", f.mintEnrollmentToken,
+		fuego.OptionTags("Enrollment"),
+		fuego.OptionSummary("Mint Enrollment Token"),
+		fuego.OptionDescription("Mint a single-use token a provisioning agent enrolls a device with before it expires after ttl "+
+			"seconds or the configured default. The device gets the tags of the token and is managed over CIRA with its CIRA "+
+			"config. The token is only returned in this response"),
+		fuego.OptionDefaultStatusCode(http.StatusCreated),
+	)
+
+	fuego.Delete(f.server, "/api/v1/admin/enrollmenttokens/{id}", f.deleteEnrollmentToken,
+		fuego.OptionTags("Enrollment"),
+		fuego.OptionSummary("Revoke Enrollment Token"),
+		fuego.OptionDescription("Delete an enrollment token, a device can no longer enroll with it"),
+		fuego.OptionPath("id", "Enrollment token ID"),
+		fuego.OptionDefaultStatusCode(http.StatusNoContent),
+	)
+
+	fuego.Post(f.server, "/api/v1/enroll", f.enrollDevice,
+		fuego.OptionTags("Enrollment"),
+		fuego.OptionSummary("Enroll Device"),
+		fuego.OptionDescription("Create a device with an enrollment token minted by an admin, without an access token. The device "+
+			"is created with the identity and the AMT admin credentials given, and the CIRA settings it connects to the console "+
+			"with are returned, with MPS credentials of its own. A token that is unknown, used or expired is refused with 401"),
+		fuego.OptionDefaultStatusCode(http.StatusCreated),
+	)
+}
+
+func (f *FuegoAdapter) getEnrollmentTokens(_ fuego.ContextNoBody) ([]dto.EnrollmentToken, error) {
+	return []dto.EnrollmentToken{}, nil
+}
+
+func (f *FuegoAdapter) mintEnrollmentToken(_ fuego.ContextWithBody[dto.EnrollmentTokenRequest]) (dto.EnrollmentToken, error) {
+	return dto.EnrollmentToken{}, nil
+}
+
+func (f *FuegoAdapter) deleteEnrollmentToken(_ fuego.ContextNoBody) (any, error) {
+	return nil, nil
+}
+
+func (f *FuegoAdapter) enrollDevice(_ fuego.ContextWithBody[dto.EnrollmentRequest]) (dto.Enrollment, error) {
+	return dto.Enrollment{}, nil
+}
@@ -2,7 +2,8 @@ package openapi
 
 import (
 	"encoding/json"
-	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/device-management-toolkit/console/internal/usecase"
 	"github.com/device-management-toolkit/console/pkg/logger"
@@ -12,22 +13,34 @@ import (
 type Generator struct {
 	usecases usecase.Usecases
 	logger   logger.Interface
+	store    SpecStore
 }
 
-// NewGenerator creates a new OpenAPI generator.
+// NewGenerator creates a new OpenAPI generator that saves specs to the local filesystem.
 func NewGenerator(usecases usecase.Usecases, log logger.Interface) *Generator {
 	return &Generator{
 		usecases: usecases,
 		logger:   log,
+		store:    NewFileSpecStore(""),
 	}
 }
 
-// GenerateSpec generates OpenAPI 3.1.0 specification with compliance fixes.
+// UseStore replaces the storage used by SaveSpec and SaveVersionedSpecs.
+func (g *Generator) UseStore(store SpecStore) {
+	g.store = store
+}
+
+// GenerateSpec generates the OpenAPI specification for the whole server, including Redfish.
 func (g *Generator) GenerateSpec() ([]byte, error) {
+	return g.GenerateVersionedSpec(SpecVersionAll)
+}
+
+// GenerateVersionedSpec generates the OpenAPI specification for a single API version.
+func (g *Generator) GenerateVersionedSpec(version string) ([]byte, error) {
 	adapter := NewFuegoAdapter(g.usecases, g.logger)
 	adapter.RegisterRoutes()
 
-	spec, err := adapter.GetOpenAPISpec()
+	spec, err := adapter.GetVersionedSpec(version)
 	if err != nil {
 		return nil, err
 	}
@@ -45,9 +58,27 @@ func (g *Generator) GenerateSpec() ([]byte, error) {
 	return finalSpec, nil
 }
 
-// SaveSpec saves the OpenAPI specification to a file using restrictive permissions.
+// SaveSpec saves the OpenAPI specification through the configured store.
 func (g *Generator) SaveSpec(spec []byte, filePath string) error {
-	const specFilePerm = 0o600
+	return g.store.Save(filePath, spec)
+}
+
+// SaveVersionedSpecs generates and saves one spec per API version next to filePath,
+// e.g. doc/openapi.json produces doc/openapi-v1.json and doc/openapi-v2.json.
+func (g *Generator) SaveVersionedSpecs(filePath string) error {
+	ext := filepath.Ext(filePath)
+	base := strings.TrimSuffix(filePath, ext)
+
+	for _, version := range SpecVersions {
+		spec, err := g.GenerateVersionedSpec(version)
+		if err != nil {
+			return err
+		}
+
+		if err := g.SaveSpec(spec, base+"-"+version+ext); err != nil {
+			return err
+		}
+	}
 
-	return os.WriteFile(filePath, spec, specFilePerm)
+	return nil
 }
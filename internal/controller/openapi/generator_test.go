@@ -0,0 +1,133 @@
+package openapi
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/usecase"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+type specDocument struct {
+	Info struct {
+		Title string `json:"title"`
+	} `json:"info"`
+	Paths map[string]interface{} `json:"paths"`
+	Tags  []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+}
+
+func generateSpec(t *testing.T, version string) specDocument {
+	t.Helper()
+
+	spec, err := NewGenerator(usecase.Usecases{}, logger.New("error")).GenerateVersionedSpec(version)
+	require.NoError(t, err)
+
+	var doc specDocument
+
+	require.NoError(t, json.Unmarshal(spec, &doc))
+
+	return doc
+}
+
+func TestGenerateVersionedSpec(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		version       string
+		prefixes      []string
+		expectedTitle string
+		expectedTag   string
+	}{
+		{
+			name:          "whole server",
+			version:       SpecVersionAll,
+			prefixes:      []string{"/api/v1/", "/api/v2/", "/redfish/v1"},
+			expectedTitle: "Console API",
+			expectedTag:   redfishTag,
+		},
+		{
+			name:          "v1",
+			version:       SpecVersionV1,
+			prefixes:      []string{"/api/v1/"},
+			expectedTitle: "Console API (v1)",
+			expectedTag:   "Profiles",
+		},
+		{
+			name:          "v2",
+			version:       SpecVersionV2,
+			prefixes:      []string{"/api/v2/"},
+			expectedTitle: "Console API (v2)",
+			expectedTag:   "Device Management",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := generateSpec(t, tc.version)
+
+			assert.Equal(t, tc.expectedTitle, doc.Info.Title)
+			require.NotEmpty(t, doc.Paths)
+
+			seen := make(map[string]bool)
+
+			for path := range doc.Paths {
+				matched := false
+
+				for _, prefix := range tc.prefixes {
+					if strings.HasPrefix(path, prefix) {
+						seen[prefix] = true
+						matched = true
+					}
+				}
+
+				assert.True(t, matched, "unexpected path %s", path)
+			}
+
+			for _, prefix := range tc.prefixes {
+				assert.True(t, seen[prefix], "no paths for %s", prefix)
+			}
+
+			tags := make([]string, 0, len(doc.Tags))
+			for _, tag := range doc.Tags {
+				tags = append(tags, tag.Name)
+			}
+
+			assert.Contains(t, tags, tc.expectedTag)
+		})
+	}
+}
+
+func TestGenerateVersionedSpecUnknownVersion(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewGenerator(usecase.Usecases{}, logger.New("error")).GenerateVersionedSpec("v9")
+	require.ErrorIs(t, err, ErrUnknownSpecVersion)
+}
+
+func TestSaveVersionedSpecs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	generator := NewGenerator(usecase.Usecases{}, logger.New("error"))
+	generator.UseStore(NewFileSpecStore(dir))
+
+	require.NoError(t, generator.SaveVersionedSpecs("openapi.json"))
+
+	for _, name := range []string{"openapi-v1.json", "openapi-v2.json"} {
+		info, err := os.Stat(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(specFilePerm), info.Mode().Perm())
+	}
+}
@@ -0,0 +1,54 @@
+package openapi
+
+import (
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterImageRoutes() {
+	fuego.Get(f.server, "/api/v1/admin/images", f.getImages,
+		fuego.OptionTags("Images"),
+		fuego.OptionSummary("List boot images"),
+		fuego.OptionDescription("Retrieve the ISO and boot images kept for HTTPS boot and IDE redirection. Only available when an image directory is configured"),
+	)
+
+	fuego.Get(f.server, "/api/v1/admin/images/{name}", f.downloadImage,
+		fuego.OptionTags("Images"),
+		fuego.OptionSummary("Download a boot image"),
+		fuego.OptionDescription("Download the content of an image"),
+		fuego.OptionPath("name", "Image name"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/images", f.uploadImage,
+		fuego.OptionTags("Images"),
+		fuego.OptionSummary("Upload a boot image"),
+		fuego.OptionDescription("Upload an image as the multipart/form-data part named image, replacing the image of the same name. "+
+			"The image is named after the file name of the part, or the name query parameter when the part has none. "+
+			"The upload is streamed to disk, its size is only limited by the upload body limit"),
+		fuego.OptionQuery("name", "Image name, when the part has no file name"),
+	)
+
+	fuego.Delete(f.server, "/api/v1/admin/images/{name}", f.deleteImage,
+		fuego.OptionTags("Images"),
+		fuego.OptionSummary("Delete a boot image"),
+		fuego.OptionDescription("Delete an image by name"),
+		fuego.OptionPath("name", "Image name"),
+	)
+}
+
+func (f *FuegoAdapter) getImages(_ fuego.ContextNoBody) ([]dto.Image, error) {
+	return []dto.Image{{Name: "ubuntu-24.04-live-server-amd64.iso", Size: 2754981888}}, nil
+}
+
+func (f *FuegoAdapter) downloadImage(_ fuego.ContextNoBody) (any, error) {
+	return nil, nil
+}
+
+func (f *FuegoAdapter) uploadImage(_ fuego.ContextNoBody) (dto.Image, error) {
+	return dto.Image{Name: "ubuntu-24.04-live-server-amd64.iso", Size: 2754981888}, nil
+}
+
+func (f *FuegoAdapter) deleteImage(_ fuego.ContextNoBody) (any, error) {
+	return nil, nil
+}
@@ -0,0 +1,22 @@
+package openapi
+
+import (
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterInventoryRoutes() {
+	fuego.Get(f.server, "/api/v1/inventory/ansible", f.getAnsibleInventory,
+		fuego.OptionTags("Devices"),
+		fuego.OptionSummary("Get Ansible Inventory"),
+		fuego.OptionDescription("Retrieve the devices as an Ansible dynamic inventory. The groups tag_<tag>, site_<site> "+
+			"and power_on, power_sleep, power_off or power_unknown are at the top level of the document, next to _meta "+
+			"holding the variables of each host: its GUID, its last recorded IP address as ansible_host, its site and power state. "+
+			"Hosts are named by their hostname, by their GUID when they have none or share it"),
+	)
+}
+
+func (f *FuegoAdapter) getAnsibleInventory(_ fuego.ContextNoBody) (dto.AnsibleInventory, error) {
+	return dto.AnsibleInventory{}, nil
+}
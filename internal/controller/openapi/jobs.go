@@ -0,0 +1,101 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterJobRoutes() {
+	fuego.Get(f.server, "/api/v1/jobs", f.getJobs,
+		fuego.OptionTags("Jobs"),
+		fuego.OptionSummary("List jobs"),
+		fuego.OptionDescription("Retrieve the jobs of the tenant of the access token, the newest first, without the progress of their devices. "+
+			"Finished jobs are kept for 7 days"),
+		fuego.OptionQueryInt("$top", "Number of jobs to return"),
+		fuego.OptionQueryInt("$skip", "Number of jobs to skip"),
+		fuego.OptionQuery("state", "Only the jobs in this state: pending, running, completed, failed or cancelled"),
+	)
+
+	fuego.Post(f.server, "/api/v1/jobs", f.enqueueJob,
+		fuego.OptionTags("Jobs"),
+		fuego.OptionSummary("Queue a job"),
+		fuego.OptionDescription("Queue a job of type hardware-refresh, power, correlation-import, key-rotation, tls-enforcement, certificate-cleanup or duplicate-detection with its payload. "+
+			"A failed attempt is retried with a growing delay while the job has attempts left, "+
+			"only the devices that failed are retried. The job belongs to the tenant of the access token"),
+		fuego.OptionQueryBool("dryRun", "Validate the job and return the actions it would take on its resolved targets without queueing it"),
+		optionIdempotencyKey(),
+		fuego.OptionDefaultStatusCode(http.StatusAccepted),
+		fuego.OptionAddResponse(http.StatusOK, "The actions of a dry run", fuego.Response{Type: dto.DryRunResult{}}),
+	)
+
+	fuego.Post(f.server, "/api/v1/jobs/hardware-refresh", f.startHardwareRefresh,
+		fuego.OptionTags("Jobs"),
+		fuego.OptionSummary("Start a hardware refresh"),
+		fuego.OptionDescription("Start reading the hardware information of the listed devices, or of every device without a body, "+
+			"in the background. The job is returned right away, its progress is polled at the Location returned"),
+		optionIdempotencyKey(),
+		fuego.OptionDefaultStatusCode(http.StatusAccepted),
+	)
+
+	fuego.Get(f.server, "/api/v1/jobs/{id}", f.getJob,
+		fuego.OptionTags("Jobs"),
+		fuego.OptionSummary("Get a job"),
+		fuego.OptionDescription("Retrieve the state of a job and the progress of each of its devices"),
+		fuego.OptionPath("id", "Job ID"),
+	)
+
+	fuego.Get(f.server, "/api/v1/jobs/{id}/events", f.getJobEvents,
+		fuego.OptionTags("Jobs"),
+		fuego.OptionSummary("Follow a job"),
+		fuego.OptionDescription("Stream the job and its updates as server-sent events named job until it is finished. "+
+			"The updates carry the totals of the job without the progress of each device"),
+		fuego.OptionPath("id", "Job ID"),
+	)
+
+	fuego.Post(f.server, "/api/v1/jobs/{id}/cancel", f.cancelJob,
+		fuego.OptionTags("Jobs"),
+		fuego.OptionSummary("Cancel a job"),
+		fuego.OptionDescription("Cancel a pending job right away, or a running job once the devices in progress are done"),
+		fuego.OptionPath("id", "Job ID"),
+	)
+
+	fuego.Get(f.server, "/api/v1/jobs/{id}/devices/{guid}", f.getJobHardwareInfo,
+		fuego.OptionTags("Jobs"),
+		fuego.OptionSummary("Get the hardware info read by a job"),
+		fuego.OptionDescription("Retrieve the hardware information a hardware refresh job read from a device, "+
+			"kept in memory for an hour"),
+		fuego.OptionPath("id", "Job ID"),
+		fuego.OptionPath("guid", "Device GUID"),
+	)
+}
+
+func (f *FuegoAdapter) startHardwareRefresh(_ fuego.ContextWithBody[dto.HardwareRefreshRequest]) (dto.Job, error) {
+	return dto.Job{ID: "0b6f5a1e-8f5e-4d43-9f7b-2e8d7c0f6a11", Type: dto.JobTypeHardwareRefresh, State: dto.JobStatePending}, nil
+}
+
+func (f *FuegoAdapter) getJob(_ fuego.ContextNoBody) (dto.Job, error) {
+	return dto.Job{}, nil
+}
+
+func (f *FuegoAdapter) getJobHardwareInfo(_ fuego.ContextNoBody) (dto.HardwareInfo, error) {
+	return dto.HardwareInfo{}, nil
+}
+
+func (f *FuegoAdapter) getJobs(_ fuego.ContextNoBody) ([]dto.Job, error) {
+	return []dto.Job{}, nil
+}
+
+func (f *FuegoAdapter) enqueueJob(_ fuego.ContextWithBody[dto.JobRequest]) (dto.Job, error) {
+	return dto.Job{ID: "0b6f5a1e-8f5e-4d43-9f7b-2e8d7c0f6a11", Type: dto.JobTypePower, State: dto.JobStatePending}, nil
+}
+
+func (f *FuegoAdapter) getJobEvents(_ fuego.ContextNoBody) (dto.Job, error) {
+	return dto.Job{}, nil
+}
+
+func (f *FuegoAdapter) cancelJob(_ fuego.ContextNoBody) (dto.Job, error) {
+	return dto.Job{State: dto.JobStateCancelled}, nil
+}
@@ -0,0 +1,38 @@
+package openapi
+
+import (
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterLoggingRoutes() {
+	fuego.Get(f.server, "/api/v1/admin/logging", f.getLogging,
+		fuego.OptionTags("Logging"),
+		fuego.OptionSummary("Get logging settings"),
+		fuego.OptionDescription("Retrieve the current log level and the devices with debug logging enabled per subsystem"),
+	)
+
+	fuego.Put(f.server, "/api/v1/admin/logging", f.updateLogging,
+		fuego.OptionTags("Logging"),
+		fuego.OptionSummary("Update logging settings"),
+		fuego.OptionDescription("Change the log level and enable debug logging per subsystem (wsman, cira) for specific device GUIDs without restarting. "+
+			"Subsystems missing from the request are unchanged, an empty list disables debug logging and \"*\" enables it for every device."),
+	)
+}
+
+func (f *FuegoAdapter) getLogging(_ fuego.ContextNoBody) (dto.LoggingSettings, error) {
+	return dto.LoggingSettings{
+		Level: "info",
+		Debug: map[string][]string{"wsman": {"123e4567-e89b-12d3-a456-426614174000"}},
+	}, nil
+}
+
+func (f *FuegoAdapter) updateLogging(c fuego.ContextWithBody[dto.LoggingSettings]) (dto.LoggingSettings, error) {
+	req, err := c.Body()
+	if err != nil {
+		return dto.LoggingSettings{}, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,23 @@
+package openapi
+
+import (
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterPowerHistoryRoutes() {
+	fuego.Get(f.server, "/api/v1/admin/amt/power/history/{guid}", f.getPowerHistory,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Get Power History"),
+		fuego.OptionDescription("Retrieve the uptime, sleep and downtime of a device recorded by the power state poller, "+
+			"with the samples and the share of each bucket of the window the device was on"),
+		fuego.OptionPath("guid", "Device GUID"),
+		fuego.OptionQueryInt("hours", "Length of the window in hours, 1 to 720 (default 24)"),
+		fuego.OptionQueryInt("buckets", "Number of buckets the window is split into, 1 to 288 (default 24)"),
+	)
+}
+
+func (f *FuegoAdapter) getPowerHistory(_ fuego.ContextNoBody) (dto.PowerHistory, error) {
+	return dto.PowerHistory{}, nil
+}
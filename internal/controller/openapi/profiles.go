@@ -1,6 +1,8 @@
 package openapi
 
 import (
+	"net/http"
+
 	"github.com/go-fuego/fuego"
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
@@ -40,6 +42,8 @@ func (f *FuegoAdapter) RegisterProfileRoutes() {
 		fuego.OptionSummary("Delete Profile"),
 		fuego.OptionDescription("Delete a profile by name"),
 		fuego.OptionPath("name", "Profile name"),
+		fuego.OptionQueryBool("dryRun", "Return what the deletion would do without deleting the profile"),
+		fuego.OptionAddResponse(http.StatusOK, "The actions of a dry run", fuego.Response{Type: dto.DryRunResult{}}),
 	)
 
 	fuego.Get(f.server, "/api/v1/admin/profiles/export/{name}", f.exportProfile,
@@ -49,6 +53,13 @@ func (f *FuegoAdapter) RegisterProfileRoutes() {
 		fuego.OptionPath("name", "Profile name"),
 		fuego.OptionQuery("domainName", "Domain name for export"),
 	)
+
+	fuego.Get(f.server, "/api/v1/admin/profiles/{name}/tls/compliance", f.getProfileTLSCompliance,
+		fuego.OptionTags("Profiles"),
+		fuego.OptionSummary("Get Profile TLS Compliance"),
+		fuego.OptionDescription("Check the TLS settings of the devices with the tags of a profile and list those not enforcing its mutual authentication and trusted common names. The settings are applied with a tls-enforcement job."),
+		fuego.OptionPath("name", "Profile name"),
+	)
 }
 
 func (f *FuegoAdapter) getProfiles(_ fuego.ContextNoBody) (dto.ProfileCountResponse, error) {
@@ -125,3 +136,7 @@ func (f *FuegoAdapter) exportProfile(_ fuego.ContextNoBody) (dto.ProfileExportRe
 		Key:      "example-key",
 	}, nil
 }
+
+func (f *FuegoAdapter) getProfileTLSCompliance(_ fuego.ContextNoBody) (dto.TLSComplianceReport, error) {
+	return dto.TLSComplianceReport{Devices: []dto.TLSComplianceDevice{}}, nil
+}
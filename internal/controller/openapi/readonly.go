@@ -0,0 +1,40 @@
+package openapi
+
+import (
+	"time"
+
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterReadOnlyRoutes() {
+	fuego.Get(f.server, "/api/v1/admin/readOnly", f.getReadOnly,
+		fuego.OptionTags("Maintenance"),
+		fuego.OptionSummary("Get read-only mode"),
+		fuego.OptionDescription("Retrieve whether the API is in read-only mode, since when and why"),
+	)
+
+	fuego.Put(f.server, "/api/v1/admin/readOnly", f.updateReadOnly,
+		fuego.OptionTags("Maintenance"),
+		fuego.OptionSummary("Switch read-only mode"),
+		fuego.OptionDescription("Enable or disable the read-only mode without restarting, e.g. for a database migration or failover. "+
+			"While it is enabled the requests under /api other than GET, HEAD and OPTIONS get 503 Service Unavailable with the error code READ_ONLY "+
+			"and a Retry-After header of retryAfter seconds; this route stays available. A missing retryAfter keeps the current one."),
+	)
+}
+
+func (f *FuegoAdapter) getReadOnly(_ fuego.ContextNoBody) (dto.ReadOnlySettings, error) {
+	since := time.Date(2026, 10, 16, 22, 0, 0, 0, time.UTC)
+
+	return dto.ReadOnlySettings{Enabled: true, RetryAfter: 60, Reason: "database migration", Since: &since}, nil
+}
+
+func (f *FuegoAdapter) updateReadOnly(c fuego.ContextWithBody[dto.ReadOnlySettings]) (dto.ReadOnlySettings, error) {
+	req, err := c.Body()
+	if err != nil {
+		return dto.ReadOnlySettings{}, err
+	}
+
+	return req, nil
+}
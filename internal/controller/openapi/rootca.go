@@ -0,0 +1,79 @@
+package openapi
+
+import (
+	"time"
+
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterRootCARoutes() {
+	fuego.Get(f.server, "/api/v1/admin/rootca", f.getRootCA,
+		fuego.OptionTags("Root CA"),
+		fuego.OptionSummary("Get the console root certificate"),
+		fuego.OptionDescription("Retrieve the root certificate issuing the MPS web server certificate, with the root pending rollover "+
+			"and the one retired by the last rollover. Not available when CIRA is disabled"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/rootca/regenerate", f.regenerateRootCA,
+		fuego.OptionTags("Root CA"),
+		fuego.OptionSummary("Generate a new root certificate"),
+		fuego.OptionDescription("Generate the root certificate to roll over to. The current root stays in use until the rollover"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/rootca/crosssign", f.crossSignRootCA,
+		fuego.OptionTags("Root CA"),
+		fuego.OptionSummary("Cross-sign the root certificates"),
+		fuego.OptionDescription("Sign the pending root with the current one and the current root with the pending one, "+
+			"so that clients trusting either root verify the certificates of both"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/rootca/rollover", f.rolloverRootCA,
+		fuego.OptionTags("Root CA"),
+		fuego.OptionSummary("Roll over to the pending root certificate"),
+		fuego.OptionDescription("Add the pending root to the trusted roots of every device. Once all of them trust it, the MPS web "+
+			"server certificate is re-issued by the pending root, which replaces the current one. The console must be restarted "+
+			"for the MPS to serve the new certificate"),
+		fuego.OptionQueryBool("force", "Complete the rollover even when devices could not be given the new root"),
+	)
+
+	fuego.Get(f.server, "/api/v1/ciracert", f.getCIRACert,
+		fuego.OptionTags("CIRA"),
+		fuego.OptionSummary("Get the MPS root certificate"),
+		fuego.OptionDescription("Retrieve the current root certificate of the MPS for CIRA profiles and manual provisioning. "+
+			"Returned as the base64 encoded DER in plain text by default, as a PEM file with format=pem, or with its "+
+			"SHA-256 fingerprint as below with format=json"),
+		fuego.OptionQuery("format", "pem or json, plain base64 when omitted"),
+	)
+}
+
+func (f *FuegoAdapter) getRootCA(_ fuego.ContextNoBody) (dto.RootCA, error) {
+	return dto.RootCA{Current: exampleRootCertificate()}, nil
+}
+
+func (f *FuegoAdapter) regenerateRootCA(_ fuego.ContextNoBody) (dto.RootCertificate, error) {
+	return *exampleRootCertificate(), nil
+}
+
+func (f *FuegoAdapter) crossSignRootCA(_ fuego.ContextNoBody) (dto.RootCACrossSigned, error) {
+	return dto.RootCACrossSigned{}, nil
+}
+
+func (f *FuegoAdapter) rolloverRootCA(_ fuego.ContextNoBody) (dto.RootCARollover, error) {
+	return dto.RootCARollover{Devices: 2, Trusting: 1, Pushed: []string{"123e4567-e89b-12d3-a456-426614174000"}, Completed: true, Current: exampleRootCertificate()}, nil
+}
+
+func (f *FuegoAdapter) getCIRACert(_ fuego.ContextNoBody) (dto.RootCertificate, error) {
+	return *exampleRootCertificate(), nil
+}
+
+func exampleRootCertificate() *dto.RootCertificate {
+	return &dto.RootCertificate{
+		Subject:      "CN=console-1a2b3c,O=device-management-toolkit,C=US",
+		SerialNumber: "1f2e3d4c5b6a",
+		KeyAlgorithm: "rsa3072",
+		NotBefore:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2056, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
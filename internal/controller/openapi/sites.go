@@ -0,0 +1,91 @@
+package openapi
+
+import (
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterSiteRoutes() {
+	fuego.Get(f.server, "/api/v1/admin/sites", f.getSites,
+		fuego.OptionTags("Sites"),
+		fuego.OptionSummary("List Sites"),
+		fuego.OptionDescription("Retrieve all sites with optional pagination"),
+		fuego.OptionQueryInt("$top", "Number of records to return"),
+		fuego.OptionQueryInt("$skip", "Number of records to skip"),
+		fuego.OptionQueryBool("$count", "Include total count"),
+	)
+
+	fuego.Get(f.server, "/api/v1/admin/sites/{name}", f.getSiteByName,
+		fuego.OptionTags("Sites"),
+		fuego.OptionSummary("Get Site by Name"),
+		fuego.OptionDescription("Retrieve a specific site by name"),
+		fuego.OptionPath("name", "Site name"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/sites", f.createSite,
+		fuego.OptionTags("Sites"),
+		fuego.OptionSummary("Create Site"),
+		fuego.OptionDescription("Create a site, a network zone of subnets. Devices with an address in one of its subnets are "+
+			"reached through its proxy with its TLS policy, and with its credentials when they have none of their own. A device "+
+			"in several sites belongs to the one with the most specific subnet"),
+	)
+
+	fuego.Patch(f.server, "/api/v1/admin/sites", f.updateSite,
+		fuego.OptionTags("Sites"),
+		fuego.OptionSummary("Update Site"),
+		fuego.OptionDescription("Update an existing site, its password is kept when the update has none"),
+	)
+
+	fuego.Delete(f.server, "/api/v1/admin/sites/{name}", f.deleteSite,
+		fuego.OptionTags("Sites"),
+		fuego.OptionSummary("Delete Site"),
+		fuego.OptionDescription("Delete a site by name"),
+		fuego.OptionPath("name", "Site name"),
+	)
+}
+
+func exampleSite(name string) dto.Site {
+	return dto.Site{
+		Name:      name,
+		Subnets:   []string{"10.20.0.0/16"},
+		Proxy:     "socks5://jump.branch.example.com:1080",
+		TLSPolicy: dto.SiteTLSSelfSigned,
+		Username:  "admin",
+	}
+}
+
+func (f *FuegoAdapter) getSites(_ fuego.ContextNoBody) (dto.SiteCountResponse, error) {
+	sites := []dto.Site{exampleSite("branch-office")}
+
+	return dto.SiteCountResponse{
+		Count: len(sites),
+		Data:  sites,
+	}, nil
+}
+
+func (f *FuegoAdapter) getSiteByName(c fuego.ContextNoBody) (dto.Site, error) {
+	return exampleSite(c.PathParam("name")), nil
+}
+
+func (f *FuegoAdapter) createSite(c fuego.ContextWithBody[dto.Site]) (dto.Site, error) {
+	site, err := c.Body()
+	if err != nil {
+		return dto.Site{}, err
+	}
+
+	return site, nil
+}
+
+func (f *FuegoAdapter) updateSite(c fuego.ContextWithBody[dto.Site]) (dto.Site, error) {
+	site, err := c.Body()
+	if err != nil {
+		return dto.Site{}, err
+	}
+
+	return site, nil
+}
+
+func (f *FuegoAdapter) deleteSite(_ fuego.ContextNoBody) (any, error) {
+	return nil, nil
+}
@@ -0,0 +1,126 @@
+package openapi
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/device-management-toolkit/console/redfish"
+)
+
+// Spec versions that can be generated and served.
+const (
+	SpecVersionAll = ""
+	SpecVersionV1  = "v1"
+	SpecVersionV2  = "v2"
+
+	redfishTag = "Redfish"
+)
+
+// ErrUnknownSpecVersion is returned when a spec is requested for a version the server does not expose.
+var ErrUnknownSpecVersion = errors.New("unknown OpenAPI spec version")
+
+// SpecVersions lists the API versions that get a dedicated spec.
+var SpecVersions = []string{SpecVersionV1, SpecVersionV2}
+
+// specVersionPrefixes maps a spec version to the path prefix of the routes it documents.
+var specVersionPrefixes = map[string]string{
+	SpecVersionAll: "",
+	SpecVersionV1:  "/api/v1/",
+	SpecVersionV2:  "/api/v2/",
+}
+
+// moduleTags describes the per-module tags used to group operations.
+var moduleTags = map[string]string{
+	"CIRA":              "Client Initiated Remote Access configurations",
+	"Device Management": "Intel AMT device operations",
+	"Devices":           "Device inventory",
+	"IEEE 802.1x":       "IEEE 802.1x configurations",
+	"Profiles":          "Activation profiles",
+	"Wireless":          "Wireless configurations",
+	redfishTag:          "DMTF Redfish service",
+}
+
+// redfishOpenAPISpec is swappable for tests.
+var redfishOpenAPISpec = redfish.OpenAPISpec
+
+// filterPaths removes every path that does not start with prefix.
+func filterPaths(spec map[string]interface{}, prefix string) {
+	paths, ok := spec["paths"].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for path := range paths {
+		if !strings.HasPrefix(path, prefix) {
+			delete(paths, path)
+		}
+	}
+}
+
+// tagOperations tags every operation of a path item that has no tags yet.
+func tagOperations(item interface{}, tag string) {
+	operations, ok := item.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for _, op := range operations {
+		opMap, ok := op.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if tags, ok := opMap["tags"].([]interface{}); ok && len(tags) > 0 {
+			continue
+		}
+
+		opMap["tags"] = []interface{}{tag}
+	}
+}
+
+// collectTags returns the sorted top-level tag list for the tags used by the given paths.
+func collectTags(paths interface{}) []map[string]string {
+	used := make(map[string]struct{})
+
+	pathsMap, _ := paths.(map[string]interface{})
+	for _, item := range pathsMap {
+		operations, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, op := range operations {
+			opMap, ok := op.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			tags, _ := opMap["tags"].([]interface{})
+			for _, tag := range tags {
+				if name, ok := tag.(string); ok {
+					used[name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(used))
+	for name := range used {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	tags := make([]map[string]string, 0, len(names))
+	for _, name := range names {
+		tag := map[string]string{"name": name}
+		if desc, ok := moduleTags[name]; ok {
+			tag["description"] = desc
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
@@ -0,0 +1,28 @@
+package openapi
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const specFilePerm = 0o600
+
+// SpecStore persists generated OpenAPI specifications.
+type SpecStore interface {
+	Save(name string, spec []byte) error
+}
+
+// FileSpecStore writes specifications to files below Dir using restrictive permissions.
+type FileSpecStore struct {
+	Dir string
+}
+
+// NewFileSpecStore creates a store rooted at dir; an empty dir resolves names relative to the working directory.
+func NewFileSpecStore(dir string) *FileSpecStore {
+	return &FileSpecStore{Dir: dir}
+}
+
+// Save writes spec to the file name below the store directory.
+func (s *FileSpecStore) Save(name string, spec []byte) error {
+	return os.WriteFile(filepath.Join(s.Dir, name), spec, specFilePerm)
+}
@@ -0,0 +1,40 @@
+package openapi
+
+import (
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterTenantKeyRoutes() {
+	fuego.Get(f.server, "/api/v1/admin/tenantkeys", f.getTenantKeys,
+		fuego.OptionTags("Tenant Keys"),
+		fuego.OptionSummary("List tenant encryption keys"),
+		fuego.OptionDescription("Retrieve the data-encryption keys of the tenant of the access token, newest first. Only available when tenant encryption keys are enabled"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/tenantkeys/rotate", f.rotateTenantKey,
+		fuego.OptionTags("Tenant Keys"),
+		fuego.OptionSummary("Rotate the tenant encryption key"),
+		fuego.OptionDescription("Create a new key that encrypts the secrets of the tenant of the access token from now on, the secrets written with the older keys stay readable"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/tenantkeys/revoke", f.revokeTenantKeys,
+		fuego.OptionTags("Tenant Keys"),
+		fuego.OptionSummary("Revoke the tenant encryption keys"),
+		fuego.OptionDescription("Destroy the keys of the tenant of the access token. The secrets encrypted with them, such as device and profile passwords, "+
+			"cannot be decrypted anymore"),
+	)
+}
+
+func (f *FuegoAdapter) getTenantKeys(_ fuego.ContextNoBody) ([]dto.TenantKey, error) {
+	return []dto.TenantKey{{KeyID: "5f1c2a9e-7b43-4d0e-9a61-2f8d3c4b5a60", Active: true}}, nil
+}
+
+func (f *FuegoAdapter) rotateTenantKey(_ fuego.ContextNoBody) (dto.TenantKey, error) {
+	return dto.TenantKey{KeyID: "5f1c2a9e-7b43-4d0e-9a61-2f8d3c4b5a60", Active: true}, nil
+}
+
+func (f *FuegoAdapter) revokeTenantKeys(_ fuego.ContextNoBody) (dto.TenantKeyRevokeResult, error) {
+	return dto.TenantKeyRevokeResult{Revoked: 2}, nil
+}
@@ -0,0 +1,32 @@
+package openapi
+
+import (
+	"github.com/go-fuego/fuego"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func (f *FuegoAdapter) RegisterUserRoutes() {
+	fuego.Get(f.server, "/api/v1/admin/users", f.getUsers,
+		fuego.OptionTags("Users"),
+		fuego.OptionSummary("List console accounts"),
+		fuego.OptionDescription("Retrieve the console accounts, including the accounts disabled by the directory sync"),
+		fuego.OptionQueryInt("$top", "Number of records to return"),
+		fuego.OptionQueryInt("$skip", "Number of records to skip"),
+	)
+
+	fuego.Post(f.server, "/api/v1/admin/users/sync", f.syncUsers,
+		fuego.OptionTags("Users"),
+		fuego.OptionSummary("Sync accounts from LDAP"),
+		fuego.OptionDescription("Create accounts for the members of the directory groups mapped to console roles and disable the accounts "+
+			"that left those groups or were disabled in the directory, without waiting for the next scheduled sync"),
+	)
+}
+
+func (f *FuegoAdapter) getUsers(_ fuego.ContextNoBody) ([]dto.User, error) {
+	return []dto.User{{Username: "jdoe", DisplayName: "Jane Doe", Email: "jane.doe@example.com", Role: "operator", Source: "ldap"}}, nil
+}
+
+func (f *FuegoAdapter) syncUsers(_ fuego.ContextNoBody) (dto.UserSyncResult, error) {
+	return dto.UserSyncResult{Created: 3, Updated: 1, Disabled: 2}, nil
+}
@@ -0,0 +1,71 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/go-fuego/fuego"
+
+	httpv2 "github.com/device-management-toolkit/console/internal/controller/httpapi/v2"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	dtov2 "github.com/device-management-toolkit/console/internal/entity/dto/v2"
+)
+
+func (f *FuegoAdapter) RegisterV2DeviceManagementRoutes() {
+	fuego.Get(f.server, "/api/v2/amt/version/{guid}", f.getVersionV2,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Get Version"),
+		fuego.OptionDescription("Retrieve AMT/software version information for a device"),
+		fuego.OptionPath("guid", "Device GUID"),
+		v2Errors,
+	)
+
+	fuego.Get(f.server, "/api/v2/amt/features/{guid}", f.getFeaturesV2,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Get Features"),
+		fuego.OptionDescription("Retrieve AMT feature configuration for a device"),
+		fuego.OptionPath("guid", "Device GUID"),
+		v2Errors,
+	)
+
+	fuego.Post(f.server, "/api/v2/amt/features/{guid}", f.setFeaturesV2,
+		fuego.OptionTags("Device Management"),
+		fuego.OptionSummary("Set Features"),
+		fuego.OptionDescription("Update AMT feature configuration for a device"),
+		fuego.OptionPath("guid", "Device GUID"),
+		v2Errors,
+	)
+}
+
+// Envelope documents the {data} envelope returned by every v2 endpoint.
+type Envelope[T any] struct {
+	Data T `json:"data"`
+}
+
+// ErrorEnvelope documents the {error} envelope every v2 endpoint answers its failures with, as application/json.
+// The error is an RFC 7807 problem carrying the code of the error, the status and code being those of v1.
+type ErrorEnvelope struct {
+	Error httpv2.Problem `json:"error"`
+}
+
+// v2Errors documents the failures of a v2 endpoint.
+var v2Errors = fuego.GroupOptions(
+	fuego.OptionAddError(http.StatusBadRequest, "Bad Request", ErrorEnvelope{}),
+	fuego.OptionAddError(http.StatusNotFound, "Not Found", ErrorEnvelope{}),
+	fuego.OptionAddError(http.StatusConflict, "Conflict", ErrorEnvelope{}),
+	fuego.OptionAddError(http.StatusInternalServerError, "Internal Server Error", ErrorEnvelope{}),
+	fuego.OptionAddError(http.StatusBadGateway, "Bad Gateway", ErrorEnvelope{}),
+	fuego.OptionAddError(http.StatusServiceUnavailable, "Service Unavailable", ErrorEnvelope{}),
+	fuego.OptionAddError(http.StatusGatewayTimeout, "Gateway Timeout", ErrorEnvelope{}),
+)
+
+func (f *FuegoAdapter) getVersionV2(_ fuego.ContextNoBody) (Envelope[dtov2.Version], error) {
+	return Envelope[dtov2.Version]{}, nil
+}
+
+func (f *FuegoAdapter) getFeaturesV2(_ fuego.ContextNoBody) (Envelope[dtov2.Features], error) {
+	return Envelope[dtov2.Features]{}, nil
+}
+
+func (f *FuegoAdapter) setFeaturesV2(_ fuego.ContextWithBody[dto.Features]) (Envelope[dtov2.Features], error) {
+	return Envelope[dtov2.Features]{}, nil
+}
@@ -2,11 +2,13 @@ package cira
 
 import (
 	"context"
-	"strings"
+	"errors"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/apf"
 
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/guid"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -37,10 +39,10 @@ func (h *APFHandler) DeviceID() string {
 
 // OnProtocolVersion is called when an APF_PROTOCOLVERSION message is received.
 // Extracts and stores the device UUID for later use.
-// The UUID is normalized to lowercase to ensure case-insensitive matching
+// The UUID is put in the canonical form of the device GUIDs to ensure case-insensitive matching
 // since AMT devices send UUIDs in uppercase but users may add devices with lowercase UUIDs.
 func (h *APFHandler) OnProtocolVersion(info apf.ProtocolVersionInfo) error {
-	h.deviceID = strings.ToLower(info.UUID)
+	h.deviceID = guid.Normalize(info.UUID)
 
 	h.log.Debug("APF Protocol Version - Version: %d.%d, Trigger: %d, UUID: %s",
 		info.MajorVersion, info.MinorVersion, info.TriggerReason, info.UUID)
@@ -82,10 +84,8 @@ func (h *APFHandler) validateCredentials(username, password string) bool {
 		return false
 	}
 
-	ctx := context.Background()
-
 	// Fetch device from database using the UUID
-	device, err := h.devices.GetByID(ctx, h.deviceID, "", true)
+	device, err := h.device()
 	if err != nil {
 		h.log.Warn("Failed to fetch device %s from database: %v", h.deviceID, err)
 
@@ -116,6 +116,38 @@ func (h *APFHandler) validateCredentials(username, password string) bool {
 	return true
 }
 
+// device fetches the device of the UUID from the database. Some firmware reports the UUID with the byte order of
+// its first fields swapped, a device stored under the swapped GUID is the same machine: its GUID is adopted so that
+// the connection is registered under the GUID the device is known by.
+func (h *APFHandler) device() (*dto.Device, error) {
+	ctx := context.Background()
+
+	device, err := h.devices.GetByID(ctx, h.deviceID, "", true)
+	if err == nil || !errors.Is(err, devices.ErrNotFound) {
+		return device, err
+	}
+
+	swapped := guid.Swapped(h.deviceID)
+	if swapped == "" {
+		return nil, nil
+	}
+
+	device, swappedErr := h.devices.GetByID(ctx, swapped, "", true)
+	if swappedErr != nil {
+		if errors.Is(swappedErr, devices.ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, swappedErr
+	}
+
+	h.log.Info("Device %s is known by the byte-swapped GUID %s", h.deviceID, device.GUID)
+
+	h.deviceID = device.GUID
+
+	return device, nil
+}
+
 // OnGlobalRequest is called when an APF_GLOBAL_REQUEST message is received.
 // Tracks TCP forwarding requests and returns true when keep-alive should be sent.
 func (h *APFHandler) OnGlobalRequest(request apf.GlobalRequest) bool {
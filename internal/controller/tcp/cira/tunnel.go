@@ -9,7 +9,6 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"sync"
 	"time"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/apf"
@@ -18,34 +17,33 @@ import (
 
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
 	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
 const (
-	maxIdleTime          = 300 * time.Second
-	port                 = "4433"
+	maxIdleTime = 300 * time.Second
+	// Port is the port the CIRA server listens on.
+	Port                 = "4433"
 	readBufferSize       = 4096
 	weakCipherSuiteCount = 3
 	keepAliveInterval    = 30
 	keepAliveTimeout     = 90
 )
 
-var (
-	mu sync.Mutex
-
-	// ErrChannelOpenFailed is returned when an APF channel open request fails.
-	ErrChannelOpenFailed = errors.New("channel open failed")
-)
+// ErrChannelOpenFailed is returned when an APF channel open request fails.
+var ErrChannelOpenFailed = errors.New("channel open failed")
 
 type Server struct {
 	certificates tls.Certificate
 	notify       chan error
 	listener     net.Listener
 	devices      devices.Feature
+	events       *eventbus.Bus
 	log          logger.Interface
 }
 
-func NewServer(certFile, keyFile string, d devices.Feature, l logger.Interface) (*Server, error) {
+func NewServer(certFile, keyFile string, d devices.Feature, events *eventbus.Bus, l logger.Interface) (*Server, error) {
 	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
 	if err != nil {
 		return nil, err
@@ -55,6 +53,7 @@ func NewServer(certFile, keyFile string, d devices.Feature, l logger.Interface)
 		certificates: cert,
 		notify:       make(chan error, 1),
 		devices:      d,
+		events:       events,
 		log:          l,
 	}
 
@@ -99,14 +98,14 @@ func (s *Server) ListenAndServe() error {
 		tls.TLS_RSA_WITH_AES_256_CBC_SHA,
 	)
 
-	listener, err := tls.Listen("tcp", ":"+port, config)
+	listener, err := tls.Listen("tcp", ":"+Port, config)
 	if err != nil {
 		return err
 	}
 
 	s.listener = listener
 
-	s.log.Info("CIRA server running on port %s", port)
+	s.log.Info("CIRA server running on port %s", Port)
 
 	for {
 		conn, err := listener.Accept()
@@ -126,9 +125,15 @@ type connectionContext struct {
 	session       *apf.Session
 	authenticated bool
 	device        *wsman.ConnectionEntry
+	events        *eventbus.Bus
 	log           logger.Interface
 }
 
+// deviceLog returns a logger whose debug messages are written when cira debug logging is enabled for the device.
+func (ctx *connectionContext) deviceLog() logger.Interface {
+	return logger.ForTarget(ctx.log, logger.SubsystemCIRA, ctx.handler.DeviceID())
+}
+
 func (s *Server) handleConnection(conn net.Conn) {
 	defer conn.Close()
 
@@ -146,6 +151,7 @@ func (s *Server) handleConnection(conn net.Conn) {
 		tlsConn: tlsConn,
 		handler: NewAPFHandler(s.devices, s.log),
 		session: &apf.Session{},
+		events:  s.events,
 		log:     s.log,
 	}
 	ctx.processor = apf.NewProcessor(ctx.handler)
@@ -158,9 +164,7 @@ func (s *Server) handleConnection(conn net.Conn) {
 func (ctx *connectionContext) cleanup() {
 	deviceID := ctx.handler.DeviceID()
 	if ctx.authenticated && deviceID != "" {
-		mu.Lock()
-		delete(wsman.Connections, deviceID)
-		mu.Unlock()
+		wsman.RemoveConnection(deviceID)
 	}
 }
 
@@ -226,7 +230,7 @@ func (ctx *connectionContext) readData() ([]byte, error) {
 	}
 
 	data := buf[:n]
-	ctx.log.Debug("Received data from %s: %s", ctx.handler.DeviceID(), hex.EncodeToString(data))
+	ctx.deviceLog().Debug("Received data from %s: %s", ctx.handler.DeviceID(), hex.EncodeToString(data))
 
 	return data, nil
 }
@@ -270,13 +274,11 @@ func (ctx *connectionContext) registerDevice() {
 		WsmanMessages: wsman2.NewMessages(client.Parameters{}),
 	}
 
-	mu.Lock()
-
-	wsman.Connections[deviceID] = ctx.device
-
-	mu.Unlock()
+	wsman.RegisterConnection(deviceID, ctx.device)
 
 	ctx.log.Info("Device authenticated and registered: %s", deviceID)
+
+	eventbus.Publish(ctx.events, eventbus.DeviceConnected, eventbus.DeviceConnectedEvent{GUID: deviceID, Transport: "cira"})
 }
 
 func (ctx *connectionContext) writeResponse(response bytes.Buffer) error {
@@ -310,7 +312,7 @@ func (ctx *connectionContext) sendKeepAliveIfNeeded(messageType byte) error {
 		return err
 	}
 
-	ctx.log.Debug("Sent keep-alive options request for device %s", ctx.handler.DeviceID())
+	ctx.deviceLog().Debug("Sent keep-alive options request for device %s", ctx.handler.DeviceID())
 
 	return nil
 }
@@ -362,7 +364,7 @@ func (ctx *connectionContext) handleChannelOpenConfirmation(data []byte) bool {
 	// Signal success
 	channel.SignalOpen(nil)
 
-	ctx.log.Debug("APF channel opened: our=%d, device=%d, window=%d",
+	ctx.deviceLog().Debug("APF channel opened: our=%d, device=%d, window=%d",
 		channel.GetSenderChannel(), channel.GetRecipientChannel(), channel.GetTXWindow())
 
 	return true
@@ -34,8 +34,10 @@ type Feature interface {
 	GetByTags(ctx context.Context, tags, method string, limit, offset int, tenantID string) ([]dto.Device, error)
 	Delete(ctx context.Context, guid, tenantID string) error
 	Update(ctx context.Context, d *dto.Device) (*dto.Device, error)
+	Patch(ctx context.Context, guid, tenantID string, p dto.DevicePatch) (*dto.Device, error)
 	Insert(ctx context.Context, d *dto.Device) (*dto.Device, error)
 	GetByColumn(ctx context.Context, columnName, queryValue, tenantID string) ([]dto.Device, error)
+	GetByAttributes(ctx context.Context, attributes map[string]string, top, skip int, tenantID string) ([]dto.Device, error)
 	// Management Calls
 	GetVersion(ctx context.Context, guid string) (dto.Version, dtov2.Version, error)
 	GetFeatures(ctx context.Context, guid string) (dto.Features, dtov2.Features, error)
@@ -43,7 +45,7 @@ type Feature interface {
 	GetAlarmOccurrences(ctx context.Context, guid string) ([]dto.AlarmClockOccurrence, error)
 	CreateAlarmOccurrences(ctx context.Context, guid string, alarm dto.AlarmClockOccurrenceInput) (dto.AddAlarmOutput, error)
 	DeleteAlarmOccurrences(ctx context.Context, guid, instanceID string) error
-	GetHardwareInfo(ctx context.Context, guid string) (dto.HardwareInfo, error)
+	GetHardwareInfo(ctx context.Context, guid string, sections ...string) (dto.HardwareInfo, error)
 	GetPowerState(ctx context.Context, guid string) (dto.PowerState, error)
 	GetPowerCapabilities(ctx context.Context, guid string) (dto.PowerCapabilities, error)
 	GetGeneralSettings(ctx context.Context, guid string) (dto.GeneralSettings, error)
@@ -53,11 +55,13 @@ type Feature interface {
 	SendPowerAction(ctx context.Context, guid string, action int) (power.PowerActionResponse, error)
 	SetBootOptions(ctx context.Context, guid string, bootSetting dto.BootSetting) (power.PowerActionResponse, error)
 	GetAuditLog(ctx context.Context, startIndex int, guid string) (dto.AuditLog, error)
+	StreamAuditLog(c context.Context, guid string, emit func(dto.AuditLogEntry) error) error
 	GetEventLog(ctx context.Context, startIndex, maxReadRecords int, guid string) (dto.EventLogs, error)
-	Redirect(ctx context.Context, conn *websocket.Conn, guid, mode string) error
-	GetNetworkSettings(c context.Context, guid string) (dto.NetworkSettings, error)
+	Redirect(ctx context.Context, conn *websocket.Conn, guid, mode string, limits dto.KVMLimits, options dto.KVMSessionOptions) error
+	GetNetworkSettings(c context.Context, guid string, sections ...string) (dto.NetworkSettings, error)
 	GetCertificates(c context.Context, guid string) (dto.SecuritySettings, error)
 	GetTLSSettingData(c context.Context, guid string) ([]dto.SettingDataResponse, error)
+	SetTLSSettings(c context.Context, guid string, req dto.TLSSettingsRequest) (dto.SettingDataResponse, error)
 	GetDiskInfo(c context.Context, guid string) (dto.DiskInfo, error)
 	GetDeviceCertificate(c context.Context, guid string) (dto.Certificate, error)
 	AddCertificate(c context.Context, guid string, certInfo dto.CertInfo) (string, error)
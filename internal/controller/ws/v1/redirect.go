@@ -9,19 +9,24 @@ import (
 	"github.com/gorilla/websocket"
 
 	"github.com/device-management-toolkit/console/config"
+	httpv1 "github.com/device-management-toolkit/console/internal/controller/httpapi/v1"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/tickets"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
 type RedirectRoutes struct {
-	d devices.Feature
-	l logger.Interface
-	u Upgrader
+	d  devices.Feature
+	tk tickets.Feature
+	l  logger.Interface
+	u  Upgrader
 }
 
-func RegisterRoutes(r *gin.Engine, l logger.Interface, t devices.Feature, u Upgrader) {
+func RegisterRoutes(r *gin.Engine, l logger.Interface, t devices.Feature, tk tickets.Feature, u Upgrader) {
 	rr := &RedirectRoutes{
 		t,
+		tk,
 		l,
 		u,
 	}
@@ -30,9 +35,26 @@ func RegisterRoutes(r *gin.Engine, l logger.Interface, t devices.Feature, u Upgr
 
 func (r *RedirectRoutes) websocketHandler(c *gin.Context) {
 	tokenString := c.GetHeader("Sec-Websocket-Protocol")
+	ticket := c.Query("ticket")
 
-	// validate jwt token in the Sec-Websocket-protocol header
-	if !config.ConsoleConfig.Disabled {
+	// the session options are negotiated with the ticket, sessions opened with a jwt get none
+	var options dto.KVMSessionOptions
+
+	// a one-time ticket takes precedence over the jwt in the Sec-Websocket-protocol header, it authenticates the
+	// session as the user it was minted for
+	if ticket != "" {
+		redeemed, err := r.tk.Redeem(c.Request.Context(), ticket, c.Query("host"))
+		if err != nil {
+			r.l.Warn("http - devices - v1 - redirect - ticket rejected: " + err.Error())
+			http.Error(c.Writer, "invalid redirection ticket", http.StatusUnauthorized)
+
+			return
+		}
+
+		options = redeemed.Options
+
+		c.Set(httpv1.ContextKeyUser, redeemed.Subject)
+	} else if !config.ConsoleConfig.Disabled {
 		if tokenString == "" {
 			http.Error(c.Writer, "request does not contain an access token", http.StatusUnauthorized)
 
@@ -50,12 +72,24 @@ func (r *RedirectRoutes) websocketHandler(c *gin.Context) {
 
 			return
 		}
+
+		if subject, err := claims.GetSubject(); err == nil {
+			c.Set(httpv1.ContextKeyUser, subject)
+		}
+	}
+
+	// a session overrides the global KVM limits with the query parameters quality, frameRate and bandwidth
+	var limits dto.KVMLimits
+	if err := c.ShouldBindQuery(&limits); err != nil {
+		http.Error(c.Writer, "invalid KVM limits", http.StatusBadRequest)
+
+		return
 	}
 
 	upgrader, ok := r.u.(*websocket.Upgrader)
 	if !ok {
 		r.l.Debug("failed to cast Upgrader to *websocket.Upgrader")
-	} else {
+	} else if tokenString != "" {
 		upgrader.Subprotocols = []string{tokenString}
 	}
 
@@ -77,7 +111,7 @@ func (r *RedirectRoutes) websocketHandler(c *gin.Context) {
 
 	r.l.Info("Websocket connection opened")
 
-	err = r.d.Redirect(c, conn, c.Query("host"), c.Query("mode"))
+	err = r.d.Redirect(c, conn, c.Query("host"), c.Query("mode"), limits, options)
 	if err != nil {
 		r.l.Error(err, "http - devices - v1 - redirect")
 		errorResponse(c, http.StatusInternalServerError, "redirect failed")
@@ -1,10 +1,12 @@
 package v1
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -12,7 +14,10 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/device-management-toolkit/console/config"
+	httpv1 "github.com/device-management-toolkit/console/internal/controller/httpapi/v1"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/tickets"
 )
 
 var (
@@ -78,12 +83,12 @@ func TestWebSocketHandler(t *testing.T) { //nolint:paralleltest // logging libra
 				}
 
 				mockFeature.EXPECT().
-					Redirect(gomock.Any(), gomock.Any(), "someHost", "someMode").
+					Redirect(gomock.Any(), gomock.Any(), "someHost", "someMode", dto.KVMLimits{}, dto.KVMSessionOptions{}).
 					Return(tc.redirectError)
 			}
 
 			r := gin.Default()
-			RegisterRoutes(r, mockLogger, mockFeature, mockUpgrader)
+			RegisterRoutes(r, mockLogger, mockFeature, tickets.New(time.Minute), mockUpgrader)
 
 			req := httptest.NewRequest(http.MethodGet, "/relay/webrelay.ashx?host=someHost&mode=someMode", http.NoBody)
 			w := httptest.NewRecorder()
@@ -94,3 +99,81 @@ func TestWebSocketHandler(t *testing.T) { //nolint:paralleltest // logging libra
 		})
 	}
 }
+
+func TestWebSocketHandlerTicket(t *testing.T) { //nolint:paralleltest // logging library is not thread-safe for tests
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	_, _ = config.NewConfig()
+
+	config.ConsoleConfig.Disabled = false
+	mockFeature := mocks.NewMockFeature(ctrl)
+	mockUpgrader := mocks.NewMockUpgrader(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	ticketUC := tickets.New(time.Minute)
+
+	r := gin.Default()
+	RegisterRoutes(r, mockLogger, mockFeature, ticketUC, mockUpgrader)
+
+	options := dto.KVMSessionOptions{KeyboardLayout: dto.KeyboardLayoutGerman, Clipboard: true}
+
+	ticket, err := ticketUC.Mint(context.Background(), "someHost", "admin", options)
+	assert.NoError(t, err)
+
+	// first use opens the connection without a jwt, as the user and with the options of the ticket
+	mockUpgrader.EXPECT().Upgrade(gomock.Any(), gomock.Any(), nil).Return(&websocket.Conn{}, nil)
+	mockLogger.EXPECT().Debug("failed to cast Upgrader to *websocket.Upgrader")
+	mockLogger.EXPECT().Info("Websocket connection opened")
+	mockFeature.EXPECT().Redirect(gomock.Any(), gomock.Any(), "someHost", "someMode", dto.KVMLimits{}, options).
+		DoAndReturn(func(ctx context.Context, _ *websocket.Conn, _, _ string, _ dto.KVMLimits, _ dto.KVMSessionOptions) error {
+			assert.Equal(t, "admin", ctx.Value(httpv1.ContextKeyUser))
+
+			return nil
+		})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/relay/webrelay.ashx?host=someHost&mode=someMode&ticket="+ticket.Ticket, http.NoBody))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// replaying the ticket is rejected
+	mockLogger.EXPECT().Warn(gomock.Any())
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/relay/webrelay.ashx?host=someHost&mode=someMode&ticket="+ticket.Ticket, http.NoBody))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	// without a ticket the jwt is required
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/relay/webrelay.ashx?host=someHost&mode=someMode", http.NoBody))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestWebSocketHandlerKVMLimits(t *testing.T) { //nolint:paralleltest // logging library is not thread-safe for tests
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	_, _ = config.NewConfig()
+
+	config.ConsoleConfig.Disabled = true
+	mockFeature := mocks.NewMockFeature(ctrl)
+	mockUpgrader := mocks.NewMockUpgrader(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	r := gin.Default()
+	RegisterRoutes(r, mockLogger, mockFeature, tickets.New(time.Minute), mockUpgrader)
+
+	// the query parameters override the global limits of the session
+	mockUpgrader.EXPECT().Upgrade(gomock.Any(), gomock.Any(), nil).Return(&websocket.Conn{}, nil)
+	mockLogger.EXPECT().Debug("failed to cast Upgrader to *websocket.Upgrader")
+	mockLogger.EXPECT().Info("Websocket connection opened")
+	mockFeature.EXPECT().Redirect(gomock.Any(), gomock.Any(), "someHost", "kvm", dto.KVMLimits{Quality: 3, FrameRate: 10, Bandwidth: 65536}, dto.KVMSessionOptions{}).Return(nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/relay/webrelay.ashx?host=someHost&mode=kvm&quality=3&frameRate=10&bandwidth=65536", http.NoBody))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	for _, query := range []string{"quality=high", "quality=11", "frameRate=-1", "bandwidth=512"} {
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/relay/webrelay.ashx?host=someHost&mode=kvm&"+query, http.NoBody))
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
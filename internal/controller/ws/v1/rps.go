@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	httpv1 "github.com/device-management-toolkit/console/internal/controller/httpapi/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/rps"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+type RPSRoutes struct {
+	t rps.Feature
+	l logger.Interface
+	u Upgrader
+}
+
+// RegisterRPSRoutes serves the rpc-go clients pointed at the console as their RPS at /activate, behind the
+// middlewares given, those authenticating the users of the API and holding them to their role. With authentication
+// on, a client gives an access token of the console with its -token option and activates the devices of its tenant.
+func RegisterRPSRoutes(r *gin.Engine, l logger.Interface, t rps.Feature, u Upgrader, middlewares ...gin.HandlerFunc) {
+	rr := &RPSRoutes{
+		t,
+		l,
+		u,
+	}
+	r.GET("/activate", append(middlewares, rr.websocketHandler)...)
+}
+
+func (r *RPSRoutes) websocketHandler(c *gin.Context) {
+	conn, err := r.u.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		http.Error(c.Writer, "Could not open websocket connection", http.StatusInternalServerError)
+
+		return
+	}
+
+	defer conn.Close()
+
+	if err := r.t.Serve(c.Request.Context(), conn, c.GetString(httpv1.ContextKeyTenant)); err != nil {
+		r.l.Warn("ws - v1 - rps - activation of %s failed: %v", c.ClientIP(), err)
+	}
+}
@@ -0,0 +1,130 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/config"
+	httpv1 "github.com/device-management-toolkit/console/internal/controller/httpapi/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/rps"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestRPSWebSocketHandler(t *testing.T) { //nolint:paralleltest // the console config is global
+	_, _ = config.NewConfig()
+
+	disabled := config.ConsoleConfig.Disabled
+	config.ConsoleConfig.Disabled = false
+	config.ConsoleConfig.JWTKey = "secret"
+
+	t.Cleanup(func() { config.ConsoleConfig.Disabled = disabled })
+
+	valid, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp":      time.Now().Add(time.Hour).Unix(),
+		"tenantId": "tenant",
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		token  string
+		serve  bool
+		status int
+	}{
+		{name: "served", token: "Bearer " + valid, serve: true, status: http.StatusSwitchingProtocols},
+		{name: "no access token", status: http.StatusUnauthorized},
+		{name: "invalid access token", token: "Bearer invalid", status: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests { //nolint:paralleltest // the console config is global
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			feature := mocks.NewMockRPSFeature(ctrl)
+
+			served := make(chan struct{})
+
+			if tc.serve {
+				feature.EXPECT().Serve(gomock.Any(), gomock.Any(), "tenant").DoAndReturn(func(_ context.Context, conn rps.Conn, _ string) error {
+					defer close(served)
+
+					return conn.WriteJSON(map[string]string{"method": "success"})
+				})
+			}
+
+			gin.SetMode(gin.TestMode)
+
+			engine := gin.New()
+			RegisterRPSRoutes(engine, logger.New("error"), feature, &websocket.Upgrader{}, httpv1.NewLoginRoute(config.ConsoleConfig, nil).JWTAuthMiddleware())
+
+			server := httptest.NewServer(engine)
+			defer server.Close()
+
+			header := http.Header{}
+			if tc.token != "" {
+				header.Set("Authorization", tc.token)
+			}
+
+			conn, res, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/activate", header)
+			if res != nil {
+				defer res.Body.Close()
+			}
+
+			require.NotNil(t, res)
+			assert.Equal(t, tc.status, res.StatusCode)
+
+			if !tc.serve {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			defer conn.Close()
+
+			var msg map[string]string
+			require.NoError(t, conn.ReadJSON(&msg))
+			assert.Equal(t, "success", msg["method"])
+
+			<-served
+		})
+	}
+}
+
+func TestRPSWebSocketHandlerReadOnly(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	// the activation is refused before the connection is upgraded, Serve is never called
+	feature := mocks.NewMockRPSFeature(gomock.NewController(t))
+	mode := httpv1.NewReadOnlyMode(config.ReadOnly{Enabled: true, RetryAfter: time.Minute})
+
+	engine := gin.New()
+	RegisterRPSRoutes(engine, logger.New("error"), feature, &websocket.Upgrader{}, httpv1.ReadOnlyRouteMiddleware(mode))
+
+	server := httptest.NewServer(engine)
+	defer server.Close()
+
+	_, res, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/activate", nil)
+	if res != nil {
+		defer res.Body.Close()
+	}
+
+	require.Error(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, "60", res.Header.Get("Retry-After"))
+}
@@ -0,0 +1,305 @@
+package doctor
+
+import (
+	"context"
+	"crypto/x509"
+	"database/sql"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/db"
+)
+
+const (
+	checkTimeout       = 5 * time.Second
+	certExpiryWarning  = 30 * 24 * time.Hour
+	clockSkewWarning   = time.Minute
+	defaultJWTKey      = "your_secret_jwt_key"
+	embeddedDBDir      = "device-management-toolkit"
+	embeddedDBFileName = "console.db"
+)
+
+var errNoCertificate = errors.New("no PEM encoded certificate found")
+
+// Config validates settings that would otherwise only fail once the console is running.
+func Config(cfg *config.Config) Check {
+	return Check{Name: "config", Run: func(_ context.Context) (Status, string) {
+		var problems, warnings []string
+
+		if port, err := strconv.Atoi(cfg.Port); err != nil || port < 1 || port > 65535 {
+			problems = append(problems, fmt.Sprintf("http port %q is not a valid port", cfg.Port))
+		}
+
+		if cfg.CommonName == "" {
+			problems = append(problems, "app common_name is empty")
+		}
+
+		if cfg.TLS.Enabled && (cfg.TLS.CertFile == "") != (cfg.TLS.KeyFile == "") {
+			problems = append(problems, "http tls needs both certFile and keyFile, or neither for a self-signed certificate")
+		}
+
+		if !cfg.Disabled {
+			if cfg.JWTKey == defaultJWTKey {
+				warnings = append(warnings, "auth jwtKey is the default value")
+			}
+
+			if cfg.Issuer == "" && cfg.AdminPassword == "" {
+				problems = append(problems, "auth is enabled but neither adminPassword nor an OAuth issuer is set")
+			}
+		}
+
+		if cfg.UI.Path != "" {
+			if _, err := os.Stat(filepath.Join(cfg.UI.Path, "index.html")); err != nil {
+				warnings = append(warnings, fmt.Sprintf("ui path %s has no index.html, the embedded UI will be served", cfg.UI.Path))
+			}
+		}
+
+		if cfg.DeviceCredentials && (cfg.Address == "" || cfg.Token == "") {
+			warnings = append(warnings, "secrets device_credentials is set but no secret store is configured")
+		}
+
+		switch {
+		case len(problems) > 0:
+			return StatusFail, strings.Join(append(problems, warnings...), "; ")
+		case len(warnings) > 0:
+			return StatusWarn, strings.Join(warnings, "; ")
+		default:
+			return StatusOK, "configuration is valid"
+		}
+	}}
+}
+
+// Database checks connectivity and compares the schema version with the newest migration of this build.
+func Database(cfg *config.Config, open db.OpenFunc, latestVersion func() (uint, error)) Check {
+	return Check{Name: "database", Run: func(ctx context.Context) (Status, string) {
+		driver, dsn := "pgx", cfg.DB.URL
+		if !strings.HasPrefix(cfg.DB.URL, "postgres://") {
+			dirname, err := os.UserConfigDir()
+			if err != nil {
+				return StatusFail, err.Error()
+			}
+
+			driver, dsn = "sqlite", filepath.Join(dirname, embeddedDBDir, embeddedDBFileName)
+
+			if _, err := os.Stat(dsn); errors.Is(err, os.ErrNotExist) {
+				return StatusWarn, fmt.Sprintf("embedded database %s does not exist yet, it is created on first start", dsn)
+			}
+		}
+
+		database, err := open(driver, dsn)
+		if err != nil {
+			return StatusFail, fmt.Sprintf("cannot open database: %v", err)
+		}
+		defer database.Close()
+
+		ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+		defer cancel()
+
+		if err := database.PingContext(ctx); err != nil {
+			return StatusFail, fmt.Sprintf("cannot connect to database: %v", err)
+		}
+
+		latest, err := latestVersion()
+		if err != nil {
+			return StatusFail, fmt.Sprintf("cannot read migrations: %v", err)
+		}
+
+		return schemaStatus(ctx, database, latest)
+	}}
+}
+
+func schemaStatus(ctx context.Context, database *sql.DB, latest uint) (Status, string) {
+	var (
+		version uint
+		dirty   bool
+	)
+
+	err := database.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if err != nil {
+		return StatusWarn, fmt.Sprintf("schema version unknown, migrations run on next start: %v", err)
+	}
+
+	switch {
+	case dirty:
+		return StatusFail, fmt.Sprintf("schema version %d is dirty, a migration failed part way and must be fixed manually", version)
+	case version > latest:
+		return StatusFail, fmt.Sprintf("schema version %d is newer than this build (%d)", version, latest)
+	case version < latest:
+		return StatusWarn, fmt.Sprintf("schema version %d is migrated to %d on next start", version, latest)
+	default:
+		return StatusOK, fmt.Sprintf("connected, schema version %d", version)
+	}
+}
+
+// CapabilityChecker reports what the configured token may do in the secret store.
+type CapabilityChecker interface {
+	Capabilities(ctx context.Context) ([]string, error)
+}
+
+// SecretStore checks that the configured token may read and write secrets.
+func SecretStore(cfg *config.Secrets, newChecker func(*config.Secrets) (CapabilityChecker, error)) Check {
+	return Check{Name: "secret store", Run: func(ctx context.Context) (Status, string) {
+		if cfg.Address == "" || cfg.Token == "" {
+			return StatusSkip, "no secret store configured"
+		}
+
+		checker, err := newChecker(cfg)
+		if err != nil {
+			return StatusFail, fmt.Sprintf("cannot create client for %s: %v", cfg.Address, err)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+		defer cancel()
+
+		capabilities, err := checker.Capabilities(ctx)
+		if err != nil {
+			return StatusFail, fmt.Sprintf("cannot check token at %s: %v", cfg.Address, err)
+		}
+
+		if slices.Contains(capabilities, "root") {
+			return StatusOK, "token has root access"
+		}
+
+		canRead := slices.Contains(capabilities, "read")
+		canWrite := slices.Contains(capabilities, "create") || slices.Contains(capabilities, "update")
+
+		if !canRead || !canWrite {
+			return StatusFail, fmt.Sprintf("token needs read and create/update on %s, has %s", cfg.Path, strings.Join(capabilities, ","))
+		}
+
+		return StatusOK, "token can read and write secrets: " + strings.Join(capabilities, ",")
+	}}
+}
+
+// Certificates checks that the certificates the console serves are valid and not about to expire.
+// ciraCertFile is only checked when CIRA is enabled.
+func Certificates(cfg *config.Config, ciraCertFile string) Check {
+	return Check{Name: "certificates", Run: func(_ context.Context) (Status, string) {
+		now := time.Now()
+		status := StatusOK
+		details := []string{}
+
+		add := func(name, path string) {
+			s, detail := certificateStatus(path, now)
+			details = append(details, name+": "+detail)
+
+			if severity(s) > severity(status) {
+				status = s
+			}
+		}
+
+		if cfg.TLS.Enabled {
+			if cfg.TLS.CertFile == "" {
+				details = append(details, "https: self-signed certificate generated on start")
+			} else {
+				add("https", cfg.TLS.CertFile)
+			}
+		}
+
+		if !cfg.DisableCIRA {
+			if _, err := os.Stat(ciraCertFile); errors.Is(err, os.ErrNotExist) {
+				details = append(details, "cira: "+ciraCertFile+" not found, loaded from the secret store or generated on start")
+			} else {
+				add("cira", ciraCertFile)
+			}
+		}
+
+		if len(details) == 0 {
+			return StatusSkip, "tls and cira are disabled"
+		}
+
+		return status, strings.Join(details, "; ")
+	}}
+}
+
+func certificateStatus(path string, now time.Time) (Status, string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return StatusFail, fmt.Sprintf("%s: %v", path, errNoCertificate)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return StatusFail, fmt.Sprintf("%s: %v", path, err)
+	}
+
+	switch {
+	case now.Before(cert.NotBefore):
+		return StatusFail, fmt.Sprintf("not valid before %s", cert.NotBefore.Format(time.RFC3339))
+	case now.After(cert.NotAfter):
+		return StatusFail, fmt.Sprintf("expired %s", cert.NotAfter.Format(time.RFC3339))
+	case cert.NotAfter.Sub(now) < certExpiryWarning:
+		return StatusWarn, fmt.Sprintf("expires %s", cert.NotAfter.Format(time.RFC3339))
+	default:
+		return StatusOK, fmt.Sprintf("valid until %s", cert.NotAfter.Format(time.RFC3339))
+	}
+}
+
+// Ports checks that the addresses the console listens on are available.
+func Ports(addresses ...string) Check {
+	return Check{Name: "ports", Run: func(_ context.Context) (Status, string) {
+		var busy []string
+
+		for _, address := range addresses {
+			listener, err := net.Listen("tcp", address)
+			if err != nil {
+				busy = append(busy, fmt.Sprintf("%s: %v", address, err))
+
+				continue
+			}
+
+			listener.Close()
+		}
+
+		if len(busy) > 0 {
+			return StatusFail, strings.Join(busy, "; ")
+		}
+
+		return StatusOK, strings.Join(addresses, ", ") + " available"
+	}}
+}
+
+// Clock compares the local clock with an NTP server; AMT digest authentication
+// and certificate validation both fail when clocks drift too far.
+func Clock(server string) Check {
+	return Check{Name: "time sync", Run: func(ctx context.Context) (Status, string) {
+		ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+		defer cancel()
+
+		offset, err := sntpOffset(ctx, server)
+		if err != nil {
+			return StatusWarn, fmt.Sprintf("cannot query %s: %v", server, err)
+		}
+
+		if offset.Abs() > clockSkewWarning {
+			return StatusWarn, fmt.Sprintf("local clock is off by %s compared to %s", offset.Round(time.Second), server)
+		}
+
+		return StatusOK, fmt.Sprintf("offset %s from %s", offset.Round(time.Millisecond), server)
+	}}
+}
+
+func severity(s Status) int {
+	switch s {
+	case StatusFail:
+		return 2
+	case StatusWarn:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,87 @@
+// Package doctor runs preflight diagnostics against a console configuration
+// and reports misconfiguration before the console is started.
+package doctor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// Status is the outcome of a single check.
+type Status string
+
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip"
+)
+
+// Result is the outcome of a single check together with a human readable explanation.
+type Result struct {
+	Name   string `json:"name"`
+	Status Status `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// Check inspects one aspect of the environment.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) (Status, string)
+}
+
+// Report collects the results of all checks.
+type Report struct {
+	Results []Result `json:"results"`
+	Failed  bool     `json:"failed"`
+}
+
+// Run runs checks in order and collects their results.
+func Run(ctx context.Context, checks []Check) Report {
+	report := Report{Results: make([]Result, 0, len(checks))}
+
+	for _, check := range checks {
+		status, detail := check.Run(ctx)
+		if status == StatusFail {
+			report.Failed = true
+		}
+
+		report.Results = append(report.Results, Result{Name: check.Name, Status: status, Detail: detail})
+	}
+
+	return report
+}
+
+// WriteText prints the report as a table followed by a summary line.
+func (r Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "STATUS\tCHECK\tDETAIL")
+
+	counts := map[Status]int{}
+
+	for _, result := range r.Results {
+		counts[result.Status]++
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", result.Status, result.Name, result.Detail)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d ok, %d warnings, %d failed, %d skipped\n",
+		counts[StatusOK], counts[StatusWarn], counts[StatusFail], counts[StatusSkip])
+
+	return err
+}
+
+// WriteJSON prints the report as JSON.
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
@@ -0,0 +1,320 @@
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+var errPermissionDenied = errors.New("permission denied")
+
+func TestRunReport(t *testing.T) {
+	t.Parallel()
+
+	report := Run(context.Background(), []Check{
+		{Name: "good", Run: func(context.Context) (Status, string) { return StatusOK, "fine" }},
+		{Name: "bad", Run: func(context.Context) (Status, string) { return StatusFail, "broken" }},
+		{Name: "meh", Run: func(context.Context) (Status, string) { return StatusWarn, "hmm" }},
+	})
+
+	assert.True(t, report.Failed)
+	assert.Equal(t, []Result{
+		{Name: "good", Status: StatusOK, Detail: "fine"},
+		{Name: "bad", Status: StatusFail, Detail: "broken"},
+		{Name: "meh", Status: StatusWarn, Detail: "hmm"},
+	}, report.Results)
+
+	var text bytes.Buffer
+	require.NoError(t, report.WriteText(&text))
+	assert.Contains(t, text.String(), "fail    bad    broken")
+	assert.Contains(t, text.String(), "1 ok, 1 warnings, 1 failed, 0 skipped")
+
+	var js bytes.Buffer
+	require.NoError(t, report.WriteJSON(&js))
+
+	var decoded Report
+	require.NoError(t, json.Unmarshal(js.Bytes(), &decoded))
+	assert.Equal(t, report, decoded)
+}
+
+func validConfig() *config.Config {
+	return &config.Config{
+		App:  config.App{CommonName: "console.local"},
+		HTTP: config.HTTP{Port: "8181"},
+		Auth: config.Auth{JWTKey: "secret", AdminPassword: "admin"},
+	}
+}
+
+func TestConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		modify func(*config.Config)
+		status Status
+	}{
+		{name: "valid", modify: func(*config.Config) {}, status: StatusOK},
+		{name: "invalid port", modify: func(c *config.Config) { c.Port = "http" }, status: StatusFail},
+		{name: "missing common name", modify: func(c *config.Config) { c.CommonName = "" }, status: StatusFail},
+		{name: "tls cert without key", modify: func(c *config.Config) { c.TLS = config.TLS{Enabled: true, CertFile: "cert.pem"} }, status: StatusFail},
+		{name: "tls self-signed", modify: func(c *config.Config) { c.TLS = config.TLS{Enabled: true} }, status: StatusOK},
+		{name: "default jwt key", modify: func(c *config.Config) { c.JWTKey = defaultJWTKey }, status: StatusWarn},
+		{name: "no admin password", modify: func(c *config.Config) { c.AdminPassword = "" }, status: StatusFail},
+		{name: "auth disabled", modify: func(c *config.Config) { c.AdminPassword = ""; c.Disabled = true }, status: StatusOK},
+		{name: "ui path without index", modify: func(c *config.Config) { c.UI.Path = "/nonexistent" }, status: StatusWarn},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := validConfig()
+			tc.modify(cfg)
+
+			status, detail := Config(cfg).Run(context.Background())
+
+			assert.Equal(t, tc.status, status, detail)
+		})
+	}
+}
+
+func TestSchemaStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		version uint
+		dirty   bool
+		status  Status
+	}{
+		{name: "current", version: 5, status: StatusOK},
+		{name: "behind", version: 4, status: StatusWarn},
+		{name: "ahead", version: 6, status: StatusFail},
+		{name: "dirty", version: 5, dirty: true, status: StatusFail},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			database, err := sql.Open("sqlite", ":memory:")
+			require.NoError(t, err)
+
+			defer database.Close()
+
+			_, err = database.ExecContext(context.Background(), "CREATE TABLE schema_migrations (version INTEGER, dirty BOOLEAN)")
+			require.NoError(t, err)
+
+			_, err = database.ExecContext(context.Background(), "INSERT INTO schema_migrations VALUES (?, ?)", tc.version, tc.dirty)
+			require.NoError(t, err)
+
+			status, detail := schemaStatus(context.Background(), database, 5)
+
+			assert.Equal(t, tc.status, status, detail)
+		})
+	}
+
+	t.Run("no migrations table", func(t *testing.T) {
+		t.Parallel()
+
+		database, err := sql.Open("sqlite", ":memory:")
+		require.NoError(t, err)
+
+		defer database.Close()
+
+		status, _ := schemaStatus(context.Background(), database, 5)
+
+		assert.Equal(t, StatusWarn, status)
+	})
+}
+
+type fakeCapabilities struct {
+	capabilities []string
+	err          error
+}
+
+func (f fakeCapabilities) Capabilities(context.Context) ([]string, error) {
+	return f.capabilities, f.err
+}
+
+func TestSecretStore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		secrets config.Secrets
+		checker fakeCapabilities
+		status  Status
+	}{
+		{name: "not configured", status: StatusSkip},
+		{name: "root", secrets: config.Secrets{Address: "http://vault", Token: "t"}, checker: fakeCapabilities{capabilities: []string{"root"}}, status: StatusOK},
+		{name: "read write", secrets: config.Secrets{Address: "http://vault", Token: "t"}, checker: fakeCapabilities{capabilities: []string{"create", "read", "update"}}, status: StatusOK},
+		{name: "read only", secrets: config.Secrets{Address: "http://vault", Token: "t"}, checker: fakeCapabilities{capabilities: []string{"read"}}, status: StatusFail},
+		{name: "token rejected", secrets: config.Secrets{Address: "http://vault", Token: "t"}, checker: fakeCapabilities{err: errPermissionDenied}, status: StatusFail},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			check := SecretStore(&tc.secrets, func(*config.Secrets) (CapabilityChecker, error) {
+				return tc.checker, nil
+			})
+
+			status, detail := check.Run(context.Background())
+
+			assert.Equal(t, tc.status, status, detail)
+		})
+	}
+}
+
+func writeCertificate(t *testing.T, notBefore, notAfter time.Time) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "console.local"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "cert.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+
+	return path
+}
+
+func TestCertificateStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		notBefore time.Time
+		notAfter  time.Time
+		status    Status
+	}{
+		{name: "valid", notBefore: now.Add(-time.Hour), notAfter: now.Add(365 * 24 * time.Hour), status: StatusOK},
+		{name: "expiring", notBefore: now.Add(-time.Hour), notAfter: now.Add(24 * time.Hour), status: StatusWarn},
+		{name: "expired", notBefore: now.Add(-48 * time.Hour), notAfter: now.Add(-24 * time.Hour), status: StatusFail},
+		{name: "not yet valid", notBefore: now.Add(time.Hour), notAfter: now.Add(48 * time.Hour), status: StatusFail},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, detail := certificateStatus(writeCertificate(t, tc.notBefore, tc.notAfter), now)
+
+			assert.Equal(t, tc.status, status, detail)
+		})
+	}
+
+	t.Run("missing", func(t *testing.T) {
+		t.Parallel()
+
+		status, _ := certificateStatus(filepath.Join(t.TempDir(), "missing.pem"), now)
+
+		assert.Equal(t, StatusFail, status)
+	})
+}
+
+func TestPorts(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer listener.Close()
+
+	status, _ := Ports("127.0.0.1:0").Run(context.Background())
+	assert.Equal(t, StatusOK, status)
+
+	status, detail := Ports(listener.Addr().String()).Run(context.Background())
+	assert.Equal(t, StatusFail, status)
+	assert.Contains(t, detail, listener.Addr().String())
+}
+
+// serveSNTP answers a single SNTP request with a clock that is ahead of the local one by skew.
+func serveSNTP(t *testing.T, skew time.Duration) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, sntpPacketSize)
+
+		_, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		now := time.Now().Add(skew)
+		seconds := uint32(now.Unix() + ntpEpochOffset)
+		fraction := uint32((uint64(now.Nanosecond()) << 32) / uint64(time.Second))
+
+		response := make([]byte, sntpPacketSize)
+		response[0] = 0x24
+
+		for _, offset := range []int{32, 40} {
+			binary.BigEndian.PutUint32(response[offset:], seconds)
+			binary.BigEndian.PutUint32(response[offset+4:], fraction)
+		}
+
+		_, _ = conn.WriteTo(response, addr)
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func TestClock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("in sync", func(t *testing.T) {
+		t.Parallel()
+
+		status, detail := Clock(serveSNTP(t, 0)).Run(context.Background())
+
+		assert.Equal(t, StatusOK, status, detail)
+	})
+
+	t.Run("skewed", func(t *testing.T) {
+		t.Parallel()
+
+		status, detail := Clock(serveSNTP(t, 10*time.Minute)).Run(context.Background())
+
+		assert.Equal(t, StatusWarn, status, detail)
+		assert.Contains(t, detail, "10m0s")
+	})
+}
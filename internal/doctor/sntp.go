@@ -0,0 +1,85 @@
+package doctor
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"time"
+)
+
+const (
+	sntpPacketSize = 48
+	// sntpClientRequest is LI=0, VN=4, Mode=3 (client).
+	sntpClientRequest = 0x23
+	// seconds between the NTP epoch (1900) and the Unix epoch (1970).
+	ntpEpochOffset = 2208988800
+)
+
+var errInvalidSNTPResponse = errors.New("invalid SNTP response")
+
+// sntpOffset returns how far the local clock is behind (positive) or ahead (negative) of server.
+func sntpOffset(ctx context.Context, server string) (time.Duration, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "udp", server)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return 0, err
+		}
+	}
+
+	request := make([]byte, sntpPacketSize)
+	request[0] = sntpClientRequest
+
+	sent := time.Now()
+
+	if _, err := conn.Write(request); err != nil {
+		return 0, err
+	}
+
+	response := make([]byte, sntpPacketSize)
+
+	n, err := conn.Read(response)
+	if err != nil {
+		return 0, err
+	}
+
+	received := time.Now()
+
+	if n < sntpPacketSize {
+		return 0, errInvalidSNTPResponse
+	}
+
+	serverReceived := ntpTime(response[32:40])
+	serverSent := ntpTime(response[40:48])
+
+	if serverSent.IsZero() {
+		return 0, errInvalidSNTPResponse
+	}
+
+	return (serverReceived.Sub(sent) + serverSent.Sub(received)) / 2, nil
+}
+
+// ntpTime converts a 64 bit NTP timestamp to a time.Time; a zero timestamp returns the zero time.
+func ntpTime(b []byte) time.Time {
+	seconds := binary.BigEndian.Uint32(b[0:4])
+	fraction := binary.BigEndian.Uint32(b[4:8])
+
+	if seconds == 0 && fraction == 0 {
+		return time.Time{}
+	}
+
+	nanos := (int64(fraction) * int64(time.Second)) >> 32
+
+	return time.Unix(int64(seconds)-ntpEpochOffset, nanos)
+}
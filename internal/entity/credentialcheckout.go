@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+// CredentialCheckout records a user revealing the AMT password of a device. The password stays checked out by
+// the user until ExpiresAt, or until CheckedInAt when it is checked in before, zero until then.
+type CredentialCheckout struct {
+	ID           string
+	GUID         string
+	Username     string
+	Reason       string
+	CheckedOutAt time.Time
+	ExpiresAt    time.Time
+	CheckedInAt  time.Time
+}
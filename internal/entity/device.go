@@ -11,6 +11,11 @@ type Device struct {
 	Tags             string
 	TenantID         string
 	FriendlyName     string
+	Location         string
+	Notes            string
+	Owner            string
+	Assignee         string
+	Attributes       map[string]string
 	DNSSuffix        string
 	LastConnected    *time.Time
 	LastSeen         *time.Time
@@ -23,6 +28,13 @@ type Device struct {
 	UseTLS           bool
 	AllowSelfSigned  bool
 	CertHash         *string
+
+	// ControlMode and ProvisioningState are read from the device when it connects.
+	ControlMode       string
+	ProvisioningState string
+
+	// Proxy is the proxy of the site of the device, derived when connecting to it rather than stored.
+	Proxy string
 }
 
 type Explorer struct {
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// DeviceAddress is the hostname of a device and the addresses it resolved to, recorded whenever they change.
+type DeviceAddress struct {
+	GUID       string
+	Hostname   string
+	Addresses  []string
+	RecordedAt time.Time
+}
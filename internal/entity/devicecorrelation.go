@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// DeviceCorrelation links a device to its record in an endpoint management system such as Intune or ConfigMgr.
+// MatchedBy names the field the record was matched on, "uuid" or "serialNumber".
+type DeviceCorrelation struct {
+	GUID            string
+	TenantID        string
+	Source          string
+	ExternalID      string
+	DeviceName      string
+	SerialNumber    string
+	MatchedBy       string
+	ComplianceState string
+	ManagementState string
+	LastSync        time.Time
+	ImportedAt      time.Time
+}
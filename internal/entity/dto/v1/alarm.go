@@ -18,6 +18,13 @@ type (
 		StartTime          time.Time `json:"StartTime" binding:"required"`
 		Interval           int       `json:"Interval" default:"0" example:"1"`
 		DeleteOnCompletion bool      `json:"DeleteOnCompletion" binding:"" example:"true"`
+		// TimeZone, when set, is the IANA zone the wall-clock StartTime is in; the offset of StartTime is then ignored.
+		TimeZone string `json:"TimeZone,omitempty" binding:"omitempty,ianatimezone" example:"Europe/Berlin"`
+	}
+
+	// AlarmDeviceParam binds the device GUID path parameter of the alarm routes.
+	AlarmDeviceParam struct {
+		GUID string `uri:"guid" binding:"required,guid"`
 	}
 
 	DeleteAlarmOccurrenceRequest struct {
@@ -1,8 +1,43 @@
 package dto
 
-import "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+import (
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+)
 
 type AuditLog struct {
 	TotalCount int                       `json:"totalCnt" binding:"required" example:"0"`
 	Records    []auditlog.AuditLogRecord `json:"records" binding:"required"`
 }
+
+// AuditLogEntry is a decoded audit log record of an export, Index is its position in the log starting at 1.
+type AuditLogEntry struct {
+	Index       int       `json:"index" example:"1"`
+	Time        time.Time `json:"time" example:"2023-04-19T20:38:20Z"`
+	AuditAppID  int       `json:"auditAppId" example:"16"`
+	AuditApp    string    `json:"auditApp" example:"Security Admin"`
+	EventID     int       `json:"eventId" example:"0"`
+	Event       string    `json:"event" example:"Provisioning Started"`
+	Initiator   string    `json:"initiator" example:"Local"`
+	NetAddress  string    `json:"netAddress" example:"127.0.0.1"`
+	Description string    `json:"description" example:"Security Admin: Provisioning Started"`
+}
+
+// AuditLogQuery selects a page of the records of an audit log, newest first. A zero From or To leaves that end of
+// the range open, empty AuditAppIDs and EventIDs keep the records of every application and event.
+type AuditLogQuery struct {
+	Skip        int
+	Top         int
+	From        time.Time
+	To          time.Time
+	AuditAppIDs []int
+	EventIDs    []int
+}
+
+// AuditLogPage is a page of the decoded records of an audit log matching a query, newest first.
+type AuditLogPage struct {
+	TotalCount int             `json:"totalCnt" example:"120"` // records in the log, matching or not
+	Records    []AuditLogEntry `json:"records"`
+	HasMore    bool            `json:"hasMore" example:"false"` // more records match after this page
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "time"
+
+// CertificateInventoryQuery filters the certificates of the fleet. Text filters match any part of the value,
+// ignoring case, so issuer=Contoso Root CA with trustedRoot=true lists the devices trusting that CA.
+type CertificateInventoryQuery struct {
+	Search        string    `form:"search"`
+	Issuer        string    `form:"issuer"`
+	Subject       string    `form:"subject"`
+	Usage         string    `form:"usage"` // TLS, Wireless, Wired or none for the certificates no profile uses
+	TrustedRoot   *bool     `form:"trustedRoot"`
+	ExpiresBefore time.Time `form:"expiresBefore"`
+}
+
+// CertificateInventory is the certificates of the devices of a tenant, the earliest to expire first.
+type CertificateInventory struct {
+	Devices      int                           `json:"devices" example:"250"`
+	Certificates []CertificateInventoryEntry   `json:"certificates"`
+	Unreachable  []CertificateInventoryFailure `json:"unreachable"`
+}
+
+// CertificateInventoryEntry is a certificate kept by a device.
+type CertificateInventoryEntry struct {
+	GUID              string     `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Hostname          string     `json:"hostname" example:"desktop-1.example.com"`
+	InstanceID        string     `json:"instanceID" example:"Intel(r) AMT Certificate: Handle: 1"`
+	Subject           string     `json:"subject" example:"CN=Contoso Root CA,O=Contoso"`
+	Issuer            string     `json:"issuer" example:"CN=Contoso Root CA,O=Contoso"`
+	NotAfter          *time.Time `json:"notAfter,omitempty" example:"2027-01-01T00:00:00Z"`
+	SHA256Fingerprint string     `json:"sha256Fingerprint,omitempty" example:"3e5e4c1f..."`
+	KeyAlgorithm      string     `json:"keyAlgorithm,omitempty" example:"rsa2048"`
+	TrustedRoot       bool       `json:"trustedRoot" example:"true"`
+	ReadOnly          bool       `json:"readOnly" example:"false"`
+	Usage             []string   `json:"usage"`
+}
+
+// CertificateInventoryFailure is a device whose certificates could not be read.
+type CertificateInventoryFailure struct {
+	GUID     string `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Hostname string `json:"hostname" example:"desktop-1.example.com"`
+	Error    string `json:"error" example:"device is not connected"`
+}
@@ -8,3 +8,11 @@ type CertInfo struct {
 type DeleteCertificateRequest struct {
 	InstanceID string `json:"instanceID" binding:"required" example:"cert-instance-123"`
 }
+
+// CertificateCleanup lists the certificates and key pairs of a device no credential context uses, deleted
+// unless the cleanup was a dry run.
+type CertificateCleanup struct {
+	DryRun       bool     `json:"dryRun" example:"false"`
+	Certificates []string `json:"certificates" example:"Intel(r) AMT Certificate: Handle: 2"`
+	Keys         []string `json:"keys" example:"Intel(r) AMT Key: Handle: 1"`
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+)
+
+// States of a check, with the exit codes of the Nagios plugins.
+const (
+	CheckOK       = 0
+	CheckWarning  = 1
+	CheckCritical = 2
+	CheckUnknown  = 3
+)
+
+var checkStatusNames = [...]string{"OK", "WARNING", "CRITICAL", "UNKNOWN"}
+
+// CheckResult is the outcome of a status check of a device for a monitoring tool.
+type CheckResult struct {
+	Check   string `json:"check" example:"power"`
+	Status  int    `json:"status" example:"0"`
+	Message string `json:"message" example:"on"`
+	// PerfData is the performance data of the check in the format of the Nagios plugins, e.g. power_state=2.
+	PerfData string `json:"perfData,omitempty" example:"power_state=2"`
+}
+
+// StatusName returns the name of the state of the check, UNKNOWN for a state out of range.
+func (r CheckResult) StatusName() string {
+	if r.Status < CheckOK || r.Status > CheckUnknown {
+		return checkStatusNames[CheckUnknown]
+	}
+
+	return checkStatusNames[r.Status]
+}
+
+// String formats the result as the output line of a Nagios plugin: "POWER OK - on | power_state=2".
+func (r CheckResult) String() string {
+	line := fmt.Sprintf("%s %s - %s", strings.ToUpper(r.Check), r.StatusName(), r.Message)
+	if r.PerfData != "" {
+		line += " | " + r.PerfData
+	}
+
+	return line
+}
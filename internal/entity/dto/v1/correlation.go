@@ -0,0 +1,41 @@
+package dto
+
+import "time"
+
+// CorrelationImport is a batch of device records exported from Intune or ConfigMgr.
+type CorrelationImport struct {
+	Source  string              `json:"source" binding:"required,oneof=intune configmgr" example:"intune"`
+	Records []CorrelationRecord `json:"records" binding:"required,max=10000,dive"`
+}
+
+// CorrelationRecord is a device as it is known to Intune or ConfigMgr, it is matched to a console
+// device by UUID or else by serial number.
+type CorrelationRecord struct {
+	ID              string     `json:"id" binding:"max=256" example:"3f2b7c1e-4c3a-4f5e-9d1b-2a6c8e0f1b2d"`
+	DeviceName      string     `json:"deviceName" binding:"max=256" example:"DESKTOP-1234"`
+	SerialNumber    string     `json:"serialNumber" binding:"max=256" example:"5CG1234XYZ"`
+	UUID            string     `json:"uuid" binding:"max=64" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ComplianceState string     `json:"complianceState" binding:"max=64" example:"compliant"`
+	ManagementState string     `json:"managementState" binding:"max=64" example:"co-managed"`
+	LastSync        *time.Time `json:"lastSync,omitempty" example:"2026-10-01T08:00:00Z"`
+}
+
+// CorrelationImportResult reports how many records of an import were matched to a device.
+// Unmatched lists the ID, or else the name, of every record no device was found for.
+type CorrelationImportResult struct {
+	Matched   int      `json:"matched" example:"41"`
+	Unmatched []string `json:"unmatched" example:"DESKTOP-9999"`
+}
+
+// DeviceCorrelation is the status of a device in Intune or ConfigMgr as of the last import.
+type DeviceCorrelation struct {
+	Source          string     `json:"source" example:"intune"`
+	ExternalID      string     `json:"externalId" example:"3f2b7c1e-4c3a-4f5e-9d1b-2a6c8e0f1b2d"`
+	DeviceName      string     `json:"deviceName" example:"DESKTOP-1234"`
+	SerialNumber    string     `json:"serialNumber" example:"5CG1234XYZ"`
+	MatchedBy       string     `json:"matchedBy" example:"uuid"`
+	ComplianceState string     `json:"complianceState" example:"compliant"`
+	ManagementState string     `json:"managementState" example:"co-managed"`
+	LastSync        *time.Time `json:"lastSync,omitempty" example:"2026-10-01T08:00:00Z"`
+	ImportedAt      time.Time  `json:"importedAt" example:"2026-10-02T08:00:00Z"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// CredentialCheckoutRequest checks out the AMT password of a device. Reason is recorded in the audit trail and
+// TTL, in seconds, is how long the checkout lasts, the configured default when omitted.
+type CredentialCheckoutRequest struct {
+	Reason string `json:"reason" binding:"required,max=500" example:"reconfigure the KVM consent of a kiosk"`
+	TTL    int    `json:"ttl,omitempty" binding:"omitempty,min=1" example:"900"`
+}
+
+// CredentialCheckout is the AMT admin password of a device, revealed to Holder until ExpiresAt.
+type CredentialCheckout struct {
+	ID           string    `json:"id" example:"6f1c9a54-3b5e-4f43-9d2a-0c7a6b8e2f11"`
+	GUID         string    `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Username     string    `json:"username" example:"admin"`
+	Password     string    `json:"password" example:"P@ssw0rd"`
+	Holder       string    `json:"holder" example:"jdoe"`
+	Reason       string    `json:"reason" example:"reconfigure the KVM consent of a kiosk"`
+	CheckedOutAt time.Time `json:"checkedOutAt" example:"2024-01-01T00:00:00Z"`
+	ExpiresAt    time.Time `json:"expiresAt" example:"2024-01-01T00:15:00Z"`
+}
+
+// CredentialCheckoutRecord is a checkout of the audit trail of a device. CheckedInAt is omitted when the
+// password was not checked in before ExpiresAt.
+type CredentialCheckoutRecord struct {
+	ID           string     `json:"id" example:"6f1c9a54-3b5e-4f43-9d2a-0c7a6b8e2f11"`
+	Holder       string     `json:"holder" example:"jdoe"`
+	Reason       string     `json:"reason" example:"reconfigure the KVM consent of a kiosk"`
+	CheckedOutAt time.Time  `json:"checkedOutAt" example:"2024-01-01T00:00:00Z"`
+	ExpiresAt    time.Time  `json:"expiresAt" example:"2024-01-01T00:15:00Z"`
+	CheckedInAt  *time.Time `json:"checkedInAt,omitempty" example:"2024-01-01T00:05:00Z"`
+}
@@ -1,9 +1,21 @@
 package dto
 
 import (
+	"regexp"
 	"time"
 )
 
+// MaxDeviceAttributes is the number of custom attributes a device can hold, matching the binding of Device.Attributes.
+const MaxDeviceAttributes = 32
+
+var deviceAttributeKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// ValidDeviceAttributeKey reports whether key can name a custom attribute, keys are also used in
+// `attr.<key>` query parameters and must consist of letters, digits, hyphens and underscores.
+func ValidDeviceAttributeKey(key string) bool {
+	return deviceAttributeKeyRegex.MatchString(key)
+}
+
 type DeviceCountResponse struct {
 	Count int      `json:"totalCount"`
 	Data  []Device `json:"data"`
@@ -14,28 +26,59 @@ type DeviceStatResponse struct {
 	DisconnectedCount int `json:"disconnectedCount"`
 }
 type Device struct {
-	ConnectionStatus bool        `json:"connectionStatus"`
-	MPSInstance      string      `json:"mpsInstance"`
-	Hostname         string      `json:"hostname"`
-	GUID             string      `json:"guid"`
-	MPSUsername      string      `json:"mpsusername"`
-	Tags             []string    `json:"tags"`
-	TenantID         string      `json:"tenantId"`
-	FriendlyName     string      `json:"friendlyName"`
-	DNSSuffix        string      `json:"dnsSuffix"`
-	LastConnected    *time.Time  `json:"lastConnected,omitempty"`
-	LastSeen         *time.Time  `json:"lastSeen,omitempty"`
-	LastDisconnected *time.Time  `json:"lastDisconnected,omitempty"`
-	DeviceInfo       *DeviceInfo `json:"deviceInfo,omitempty"`
-	Username         string      `json:"username" binding:"max=16"`
-	Password         string      `json:"password"`
-	MPSPassword      string      `json:"mpspassword"`
-	MEBXPassword     string      `json:"mebxpassword"`
-	UseTLS           bool        `json:"useTLS"`
-	AllowSelfSigned  bool        `json:"allowSelfSigned"`
-	CertHash         string      `json:"certHash"`
+	ConnectionStatus bool              `json:"connectionStatus"`
+	MPSInstance      string            `json:"mpsInstance"`
+	Hostname         string            `json:"hostname"`
+	GUID             string            `json:"guid"`
+	MPSUsername      string            `json:"mpsusername"`
+	Tags             []string          `json:"tags"`
+	TenantID         string            `json:"tenantId"`
+	FriendlyName     string            `json:"friendlyName"`
+	Location         string            `json:"location" binding:"max=256"`
+	Notes            string            `json:"notes" binding:"max=4096"`
+	Owner            string            `json:"owner" binding:"max=256"`
+	Assignee         string            `json:"assignee" binding:"max=256"`
+	Attributes       map[string]string `json:"attributes,omitempty" binding:"omitempty,max=32,dive,keys,required,max=64,alphanumhyphenunderscore,endkeys,max=256"`
+	DNSSuffix        string            `json:"dnsSuffix"`
+	LastConnected    *time.Time        `json:"lastConnected,omitempty"`
+	LastSeen         *time.Time        `json:"lastSeen,omitempty"`
+	LastDisconnected *time.Time        `json:"lastDisconnected,omitempty"`
+	DeviceInfo       *DeviceInfo       `json:"deviceInfo,omitempty"`
+	Username         string            `json:"username" binding:"max=16"`
+	Password         string            `json:"password"`
+	MPSPassword      string            `json:"mpspassword"`
+	MEBXPassword     string            `json:"mebxpassword"`
+	UseTLS           bool              `json:"useTLS"`
+	AllowSelfSigned  bool              `json:"allowSelfSigned"`
+	CertHash         string            `json:"certHash"`
+	// ControlMode (ACM or CCM) and ProvisioningState are read from the device when it connects and cannot be set.
+	ControlMode       string `json:"controlMode" binding:"-" example:"ACM"`
+	ProvisioningState string `json:"provisioningState" binding:"-" example:"post"`
+	// Correlations is only filled in when a single device is read.
+	Correlations []DeviceCorrelation `json:"correlations,omitempty" binding:"-"`
 }
 
+// DevicePatch updates the descriptive fields, the assignment and the custom attributes of a device; fields left
+// out of the request are not changed. Attributes are merged into the stored ones, an attribute set to null is removed.
+type DevicePatch struct {
+	FriendlyName *string            `json:"friendlyName,omitempty" binding:"omitempty,max=256" example:"Front desk PC"`
+	Location     *string            `json:"location,omitempty" binding:"omitempty,max=256" example:"Building 2, Floor 3"`
+	Notes        *string            `json:"notes,omitempty" binding:"omitempty,max=4096" example:"Replaced battery 2026-09"`
+	Owner        *string            `json:"owner,omitempty" binding:"omitempty,max=256" example:"helpdesk"`
+	Assignee     *string            `json:"assignee,omitempty" binding:"omitempty,max=256" example:"jdoe"`
+	Attributes   map[string]*string `json:"attributes,omitempty" binding:"omitempty,dive,keys,required,max=64,alphanumhyphenunderscore,endkeys,omitempty,max=256"`
+}
+
+// Control modes and provisioning states of Device.ControlMode and Device.ProvisioningState.
+const (
+	ControlModeACM = "ACM"
+	ControlModeCCM = "CCM"
+
+	ProvisioningStatePre  = "pre"
+	ProvisioningStateIn   = "in"
+	ProvisioningStatePost = "post"
+)
+
 type DeviceInfo struct {
 	FWVersion   string    `json:"fwVersion"`
 	FWBuild     string    `json:"fwBuild"`
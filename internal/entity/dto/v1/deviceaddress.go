@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+// DeviceAddress is the hostname of a device and the addresses it resolved to from RecordedAt on.
+// Addresses is empty while the hostname did not resolve.
+type DeviceAddress struct {
+	Hostname   string    `json:"hostname" example:"amt01.example.com"`
+	Addresses  []string  `json:"addresses" example:"192.168.1.50"`
+	RecordedAt time.Time `json:"recordedAt" example:"2024-01-01T00:00:00Z"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+// DeviceDeletion reports a device deleted with the data the console kept about it, and with the cleanup of the
+// device when requested.
+type DeviceDeletion struct {
+	GUID string `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// Cleanup lists the steps run on the device before it was deleted, empty without cleanup.
+	Cleanup []DeviceCleanupStep `json:"cleanup"`
+	// Deleted is how many rows of each table were deleted, the device itself included.
+	Deleted map[string]int64 `json:"deleted" example:"devices:1,power_samples:120"`
+	// PendingJobs is how many pending jobs no longer work on the device, those requested for it alone are cancelled.
+	PendingJobs int `json:"pendingJobs" example:"1"`
+	// Incomplete is set when a cleanup step failed or was skipped, typically because the device was offline.
+	Incomplete bool `json:"incomplete" example:"false"`
+}
+
+// DeviceCleanupStep is a step of the cleanup of a device being deleted.
+type DeviceCleanupStep struct {
+	Step    string   `json:"step" example:"certificates"`
+	Status  string   `json:"status" example:"done"` // done, failed or skipped after an earlier step failed
+	Removed []string `json:"removed,omitempty" example:"Intel(r) AMT Certificate: Handle: 2"`
+	Error   string   `json:"error,omitempty" example:"device is offline"`
+}
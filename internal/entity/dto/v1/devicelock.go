@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+// DeviceLockRequest locks a device for the caller.
+type DeviceLockRequest struct {
+	Reason string `json:"reason,omitempty" example:"firmware update"`
+	// TTL is how long the lock is held in seconds, the configured default when 0.
+	TTL int `json:"ttl,omitempty" binding:"omitempty,min=1,max=86400" example:"900"`
+}
+
+// DeviceLock is an advisory lock of a device that keeps others from running power actions and boot options on it.
+type DeviceLock struct {
+	GUID       string    `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Holder     string    `json:"holder" example:"admin"`
+	Reason     string    `json:"reason,omitempty" example:"firmware update"`
+	AcquiredAt time.Time `json:"acquiredAt" example:"2024-01-01T00:00:00Z"`
+	ExpiresAt  time.Time `json:"expiresAt" example:"2024-01-01T00:15:00Z"`
+}
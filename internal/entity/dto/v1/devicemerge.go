@@ -0,0 +1,35 @@
+package dto
+
+// DuplicateReport is the result of a duplicate detection job: the groups of device records likely to be the
+// same machine.
+type DuplicateReport struct {
+	Groups []DuplicateGroup `json:"groups"`
+}
+
+// DuplicateGroup is a set of device records sharing an identifier. MatchedBy names the identifier: "uuid" for
+// GUIDs that are the byte-swapped forms of each other, "serialNumber" or "macAddress" for the custom attributes
+// of those names.
+type DuplicateGroup struct {
+	MatchedBy string   `json:"matchedBy" example:"serialNumber"`
+	Value     string   `json:"value" example:"5CG1234XYZ"`
+	GUIDs     []string `json:"guids" example:"123e4567-e89b-12d3-a456-426614174000,67453e12-9be8-d312-a456-426614174000"`
+}
+
+// DeviceMergeRequest lists the duplicate records merged into the device of the route, they are deleted once
+// their data belongs to it.
+type DeviceMergeRequest struct {
+	Duplicates []string `json:"duplicates" binding:"required,min=1,max=100,dive,guid" example:"67453e12-9be8-d312-a456-426614174000"`
+}
+
+// DeviceMerge reports duplicate records merged into a device, or the merge a dry run would make.
+type DeviceMerge struct {
+	// Device is the surviving record with the tags and attributes of the duplicates.
+	Device *Device `json:"device"`
+	// Merged lists the GUIDs of the duplicates deleted.
+	Merged []string `json:"merged" example:"67453e12-9be8-d312-a456-426614174000"`
+	// Reassigned is how many rows of each table were moved to the surviving record, empty for a dry run.
+	Reassigned map[string]int64 `json:"reassigned" example:"power_samples:120,device_addresses:3"`
+	// PendingJobs is how many pending jobs now work on the surviving record instead of a duplicate.
+	PendingJobs int  `json:"pendingJobs" example:"1"`
+	DryRun      bool `json:"dryRun,omitempty" example:"false"`
+}
@@ -22,6 +22,8 @@ type Domain struct {
 	ExpirationDate                time.Time `json:"expirationDate,omitempty" example:"2022-01-01T00:00:00Z"`
 	TenantID                      string    `json:"tenantId" example:"abc123"`
 	Version                       string    `json:"version,omitempty" example:"1.0.0"`
+	// Revocation is the revocation status of the provisioning certificate, when revocation is checked.
+	Revocation *RevocationStatus `json:"revocation,omitempty"`
 }
 
 // ValidateAlphaNumHyphenUnderscore validates that a field contains only alphanumeric characters, hyphens, and underscores.
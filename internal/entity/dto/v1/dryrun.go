@@ -0,0 +1,13 @@
+package dto
+
+// DryRunResult lists the actions an operation requested with dryRun=true would take. Nothing is changed.
+type DryRunResult struct {
+	Operation string          `json:"operation" example:"power"`
+	Actions   []PlannedAction `json:"actions"`
+}
+
+// PlannedAction is an action a dry run would take on its target.
+type PlannedAction struct {
+	Target string `json:"target" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Action string `json:"action" example:"power action 8 (Power down)"`
+}
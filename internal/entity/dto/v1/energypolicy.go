@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+const (
+	EnergyActionPowerOff = "powerOff"
+	EnergyActionPowerOn  = "powerOn"
+	EnergyActionSkip     = "skip"
+)
+
+type EnergyPolicyCountResponse struct {
+	Count int            `json:"totalCount"`
+	Data  []EnergyPolicy `json:"data"`
+}
+
+// EnergyPolicy powers the devices carrying any of its tags off at PowerOffTime if they are idle and on again at
+// PowerOnTime, both on the given days in the time zone of the policy.
+type EnergyPolicy struct {
+	Name         string   `json:"name" binding:"required" example:"office-nights"`
+	Tags         []string `json:"tags" binding:"required,min=1" example:"office"`
+	TimeZone     string   `json:"timeZone" binding:"required,ianatimezone" example:"Europe/Berlin"`
+	Days         []string `json:"days" binding:"required,min=1,dive,oneof=mon tue wed thu fri sat sun" example:"mon,tue,wed,thu,fri"`
+	PowerOffTime string   `json:"powerOffTime,omitempty" binding:"required_without=PowerOnTime,omitempty,datetime=15:04" example:"19:00"`
+	PowerOnTime  string   `json:"powerOnTime,omitempty" binding:"required_without=PowerOffTime,omitempty,datetime=15:04" example:"07:00"`
+	// Exceptions lists the GUIDs of devices the policy never powers off or on.
+	Exceptions []string `json:"exceptions" binding:"omitempty,dive,guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Enabled    bool     `json:"enabled" example:"true"`
+	TenantID   string   `json:"tenantId" example:"abc123"`
+}
+
+// EnergyPolicyReport lists what a policy does to each of its devices when its action next runs.
+type EnergyPolicyReport struct {
+	Policy      string               `json:"policy" example:"office-nights"`
+	Action      string               `json:"action" example:"powerOff"`
+	ScheduledAt time.Time            `json:"scheduledAt" example:"2024-01-01T19:00:00+01:00"`
+	Devices     []EnergyPolicyDevice `json:"devices"`
+}
+
+type EnergyPolicyDevice struct {
+	GUID     string `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Hostname string `json:"hostname" example:"office-pc-01"`
+	// Decision is powerOff, powerOn or skip.
+	Decision string `json:"decision" example:"skip"`
+	Reason   string `json:"reason,omitempty" example:"redirection session active"`
+}
@@ -0,0 +1,56 @@
+package dto
+
+import "time"
+
+// EnrollmentTokenRequest mints a single-use enrollment token. The devices enrolled with it are managed over CIRA
+// with the CIRA configuration CIRAConfig and get Tags. TTL, in seconds, is how long the token can be redeemed,
+// the configured default when omitted.
+type EnrollmentTokenRequest struct {
+	Description string   `json:"description" binding:"max=500" example:"front desk PCs of the Berlin office"`
+	CIRAConfig  string   `json:"ciraConfig" binding:"required,max=256" example:"My CIRA Config"`
+	Tags        []string `json:"tags,omitempty" binding:"omitempty,max=32,dive,required,max=64" example:"berlin"`
+	TTL         int      `json:"ttl,omitempty" binding:"omitempty,min=1" example:"86400"`
+}
+
+// EnrollmentToken is an enrollment token as it is listed. Token is only returned when the token is minted, the
+// console keeps its hash only. UsedAt and UsedBy are set once a device has enrolled with it.
+type EnrollmentToken struct {
+	ID          string     `json:"id" example:"6f1c9a54-3b5e-4f43-9d2a-0c7a6b8e2f11"`
+	Token       string     `json:"token,omitempty" example:"q3Xn1f0m8J2k6QpV4sLw9ZcT7yHbR5dE1uGaN0oKiMe"`
+	TenantID    string     `json:"tenantId" example:"abc123"`
+	Description string     `json:"description" example:"front desk PCs of the Berlin office"`
+	CIRAConfig  string     `json:"ciraConfig" example:"My CIRA Config"`
+	Tags        []string   `json:"tags" example:"berlin"`
+	CreatedBy   string     `json:"createdBy" example:"admin"`
+	CreatedAt   time.Time  `json:"createdAt" example:"2024-01-01T00:00:00Z"`
+	ExpiresAt   time.Time  `json:"expiresAt" example:"2024-01-02T00:00:00Z"`
+	UsedAt      *time.Time `json:"usedAt,omitempty" example:"2024-01-01T08:00:00Z"`
+	UsedBy      string     `json:"usedBy,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+}
+
+// EnrollmentRequest enrolls a device with an enrollment token: the provisioning agent gives the identity of the
+// device and the AMT admin credentials it activates the device with.
+type EnrollmentRequest struct {
+	Token        string `json:"token" binding:"required,max=256" example:"q3Xn1f0m8J2k6QpV4sLw9ZcT7yHbR5dE1uGaN0oKiMe"`
+	GUID         string `json:"guid" binding:"required,guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Hostname     string `json:"hostname" binding:"required,max=256" example:"frontdesk-01"`
+	FriendlyName string `json:"friendlyName,omitempty" binding:"max=256" example:"Front desk PC"`
+	DNSSuffix    string `json:"dnsSuffix,omitempty" binding:"max=256" example:"corp.example.com"`
+	Username     string `json:"username,omitempty" binding:"max=16" example:"admin"`
+	Password     string `json:"password" binding:"required,min=8,max=32" example:"P@ssw0rd"`
+}
+
+// Enrollment is the CIRA configuration an enrolled device connects to the console with. Username and Password
+// are the MPS credentials of the device, generated for it alone.
+type Enrollment struct {
+	GUID                string `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	MPSAddress          string `json:"mpsServerAddress" example:"console.example.com"`
+	MPSPort             int    `json:"mpsPort" example:"4433"`
+	Username            string `json:"username" example:"my_username"`
+	Password            string `json:"password" example:"Xk3#pQ9!vL2@mN7$"`
+	CommonName          string `json:"commonName" example:"console.example.com"`
+	ServerAddressFormat int    `json:"serverAddressFormat" example:"201"`
+	AuthMethod          int    `json:"authMethod" example:"2"`
+	MPSRootCertificate  string `json:"mpsRootCertificate" example:"-----BEGIN CERTIFICATE-----\n..."`
+	ProxyDetails        string `json:"proxyDetails,omitempty" example:"http://proxy.example.com:8080"`
+}
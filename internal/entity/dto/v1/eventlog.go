@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // EventLog defines the structure of an event log.
 type EventLogs struct {
 	Records        []EventLog `json:"records"`
@@ -21,4 +23,25 @@ type EventLog struct {
 	EntityStr       string `json:"EntityStr"`
 	Description     string `json:"Desc"`
 	EventTypeDesc   string `json:"eventTypeDesc"`
+	// SensorType and Event name the IPMI sensor type and event of the record, Event is empty for generic and OEM events.
+	SensorType string `json:"sensorType" example:"Processor"`
+	Event      string `json:"event" example:"CPU thermal trip"`
+	Deasserted bool   `json:"deasserted" example:"false"`
+	// Raw is the base64 record as read from the device.
+	Raw string `json:"raw,omitempty" example:"AAAAAA=="`
+}
+
+// CrashReport lists the OS crashes, boot failures, firmware errors and watchdog expirations a device logged, newest
+// first. Supported is false when the platform has no event log.
+type CrashReport struct {
+	Supported bool         `json:"supported" example:"true"`
+	Failures  []CrashEvent `json:"failures"`
+}
+
+// CrashEvent is a failure of a crash report with the last firmware progress logged before it.
+type CrashEvent struct {
+	Kind     string    `json:"kind" example:"os-crash"` // os-crash, boot-failure, firmware-error, watchdog or hardware
+	Time     time.Time `json:"time" example:"2026-10-01T08:15:00Z"`
+	Progress string    `json:"progress,omitempty" example:"Hard-disk initialization"`
+	Record   EventLog  `json:"record"`
 }
@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+// Image is an ISO or boot image kept by the console for HTTPS boot and IDE redirection.
+type Image struct {
+	Name       string    `json:"name" example:"ubuntu-24.04-live-server-amd64.iso"`
+	Size       int64     `json:"size" example:"2754981888"`
+	SHA256     string    `json:"sha256,omitempty" example:"d6dab0c3a657988501b4bd76f1297c053df710e06e0c3aece60dead24f270b4d"`
+	ModifiedAt time.Time `json:"modifiedAt" example:"2026-10-01T08:00:00Z"`
+}
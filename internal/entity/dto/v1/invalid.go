@@ -18,3 +18,7 @@ func (e NotValidError) Wrap(function, call string, err error) error {
 
 	return e
 }
+
+func (e NotValidError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeValidation
+}
@@ -0,0 +1,45 @@
+package dto
+
+import "encoding/json"
+
+// AnsibleInventory is the devices as an Ansible dynamic inventory: the groups by name, at the top level of the
+// document next to _meta, and the variables of each host.
+type AnsibleInventory struct {
+	Groups map[string]AnsibleGroup `json:"-"`
+	Meta   AnsibleMeta             `json:"_meta"`
+}
+
+// AnsibleGroup lists the hosts of a group, or the groups below it for the all group.
+type AnsibleGroup struct {
+	Hosts    []string `json:"hosts,omitempty"`
+	Children []string `json:"children,omitempty"`
+}
+
+// AnsibleMeta holds the variables of all hosts, so that Ansible does not ask for them host by host.
+type AnsibleMeta struct {
+	HostVars map[string]AnsibleHostVars `json:"hostvars"`
+}
+
+// AnsibleHostVars are the variables of a device. ansible_host is its address, its hostname when none was recorded.
+type AnsibleHostVars struct {
+	AnsibleHost  string   `json:"ansible_host" example:"192.168.1.50"`
+	GUID         string   `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	IP           string   `json:"ip,omitempty" example:"192.168.1.50"`
+	Hostname     string   `json:"hostname" example:"amt01.example.com"`
+	FriendlyName string   `json:"friendly_name,omitempty" example:"Front desk PC"`
+	Tags         []string `json:"tags"`
+	Site         string   `json:"site,omitempty" example:"branch"`
+	PowerState   string   `json:"power_state" example:"on"`
+}
+
+// MarshalJSON writes the groups at the top level of the inventory, as Ansible expects them.
+func (i AnsibleInventory) MarshalJSON() ([]byte, error) {
+	document := make(map[string]any, len(i.Groups)+1)
+	for name, group := range i.Groups {
+		document[name] = group
+	}
+
+	document["_meta"] = i.Meta
+
+	return json.Marshal(document)
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	JobTypeHardwareRefresh    = "hardware-refresh"
+	JobTypePower              = "power"
+	JobTypeCorrelationImport  = "correlation-import"
+	JobTypeKeyRotation        = "key-rotation"
+	JobTypeTLSEnforcement     = "tls-enforcement"
+	JobTypeCertificateCleanup = "certificate-cleanup"
+	JobTypeDuplicateDetection = "duplicate-detection"
+
+	JobStatePending   = "pending"
+	JobStateRunning   = "running"
+	JobStateCompleted = "completed"
+	JobStateFailed    = "failed"
+	JobStateCancelled = "cancelled"
+)
+
+// JobRequest queues a job of a type with its payload, the payload is described by the request
+// type of the job: HardwareRefreshRequest, BulkPowerRequest, CorrelationImport, TLSEnforcementRequest,
+// CertificateCleanupRequest or none for a key rotation and a duplicate detection.
+type JobRequest struct {
+	Type        string          `json:"type" binding:"required" example:"power"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+	MaxAttempts int             `json:"maxAttempts,omitempty" example:"3"`
+}
+
+// HardwareRefreshRequest starts a hardware refresh job. Without GUIDs every device is refreshed.
+type HardwareRefreshRequest struct {
+	GUIDs []string `json:"guids,omitempty"`
+}
+
+// BulkPowerRequest sends a power action to several devices, or to every device without GUIDs.
+type BulkPowerRequest struct {
+	GUIDs  []string `json:"guids,omitempty"`
+	Action int      `json:"action" binding:"required" example:"8"`
+}
+
+// TLSEnforcementRequest applies the TLS settings of a profile to the devices with its tags, or to the
+// listed devices only.
+type TLSEnforcementRequest struct {
+	Profile string   `json:"profile" binding:"required" example:"My Profile"`
+	GUIDs   []string `json:"guids,omitempty"`
+}
+
+// CertificateCleanupRequest deletes the orphaned certificates and key pairs of several devices, or of every
+// device without GUIDs.
+type CertificateCleanupRequest struct {
+	GUIDs []string `json:"guids,omitempty"`
+}
+
+// Job is a long running task started through the API, with its progress per device.
+type Job struct {
+	ID          string              `json:"id" example:"0b6f5a1e-8f5e-4d43-9f7b-2e8d7c0f6a11"`
+	Type        string              `json:"type" example:"hardware-refresh"`
+	TenantID    string              `json:"tenantId" example:""`
+	State       string              `json:"state" example:"running"`
+	Attempts    int                 `json:"attempts" example:"1"`
+	MaxAttempts int                 `json:"maxAttempts" example:"3"`
+	Total       int                 `json:"total" example:"250"`
+	Succeeded   int                 `json:"succeeded" example:"120"`
+	Failed      int                 `json:"failed" example:"3"`
+	Devices     []JobDeviceProgress `json:"devices,omitempty"`
+	Result      json.RawMessage     `json:"result,omitempty"`
+	Error       string              `json:"error,omitempty" example:"device is not connected"`
+	CreatedAt   time.Time           `json:"createdAt" example:"2026-10-16T08:00:00Z"`
+	StartedAt   *time.Time          `json:"startedAt,omitempty" example:"2026-10-16T08:00:01Z"`
+	FinishedAt  *time.Time          `json:"finishedAt,omitempty" example:"2026-10-16T08:04:00Z"`
+}
+
+// Finished reports whether the job reached a final state.
+func (j Job) Finished() bool {
+	return j.State == JobStateCompleted || j.State == JobStateFailed || j.State == JobStateCancelled
+}
+
+// JobDeviceProgress is the state of one device in a job.
+type JobDeviceProgress struct {
+	GUID  string `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	State string `json:"state" example:"completed"`
+	Error string `json:"error,omitempty" example:"device is not connected"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+// KerberosSettings are the settings AMT uses to authenticate the users of an Active Directory domain with
+// Kerberos. The keys of the device are never returned.
+type KerberosSettings struct {
+	Enabled   bool   `json:"enabled" example:"true"`
+	RealmName string `json:"realmName" example:"CORP.EXAMPLE.COM"`
+	// KeyVersion is the KVNO of the key of the AMT service principal in the domain.
+	KeyVersion int `json:"keyVersion" example:"2"`
+	// MaximumClockTolerance is the number of minutes the clocks of AMT and the KDC may differ.
+	MaximumClockTolerance          int      `json:"maximumClockTolerance" example:"5"`
+	SupportedEncryptionAlgorithms  []string `json:"supportedEncryptionAlgorithms" example:"RC4-HMAC,AES128-CTS-HMAC-SHA1-96,AES256-CTS-HMAC-SHA1-96"`
+	ConfiguredEncryptionAlgorithms []string `json:"configuredEncryptionAlgorithms" example:"RC4-HMAC,AES128-CTS-HMAC-SHA1-96,AES256-CTS-HMAC-SHA1-96"`
+}
+
+// KerberosSettingsRequest replaces the Kerberos settings of a device. Enabling Kerberos needs the key of the AMT
+// service principal: MasterKey configures RC4-HMAC only, Passphrase and Salt configure the AES algorithms too.
+type KerberosSettingsRequest struct {
+	Enabled               bool   `json:"enabled" example:"true"`
+	RealmName             string `json:"realmName" binding:"max=255" example:"CORP.EXAMPLE.COM"`
+	KeyVersion            int    `json:"keyVersion" binding:"min=0" example:"2"`
+	MaximumClockTolerance int    `json:"maximumClockTolerance,omitempty" binding:"omitempty,min=1,max=60" example:"5"` // 5 minutes when omitted
+	// MasterKey is the 128-bit RC4-HMAC key of the service principal as 32 hexadecimal digits.
+	MasterKey      string `json:"masterKey,omitempty" binding:"omitempty,hexadecimal,len=32" example:"00112233445566778899aabbccddeeff"`
+	Passphrase     string `json:"passphrase,omitempty" binding:"omitempty,max=255" example:"P@ssw0rd"`
+	Salt           string `json:"salt,omitempty" binding:"omitempty,max=255" example:"CORP.EXAMPLE.COMHTTP/amt.corp.example.com"`
+	IterationCount int    `json:"iterationCount,omitempty" binding:"omitempty,min=1" example:"4096"` // 4096 when omitted
+}
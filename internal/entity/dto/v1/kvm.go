@@ -23,3 +23,37 @@ type KVMScreenSettings struct {
 type KVMScreenSettingsRequest struct {
 	DisplayIndex int `json:"displayIndex,omitempty"`
 }
+
+// KVMDisplaySelection selects the display KVM sessions of a device show.
+type KVMDisplaySelection struct {
+	DisplayIndex int `json:"displayIndex" binding:"min=0,max=255" example:"1"`
+}
+
+// KVMDisplaySelected is the display KVM sessions show from now on, its resolution is a hint for sizing the viewer.
+type KVMDisplaySelected struct {
+	Display           KVMScreenDisplay `json:"display"`
+	RestartedSessions int              `json:"restartedSessions" example:"1"` // open KVM sessions ended for the viewers to reconnect
+}
+
+// KVMLimits are the limits of KVM sessions for links with little bandwidth, 0 leaves a limit out. The global
+// limits apply to every new session, a session can override them with the query parameters of the redirection websocket.
+type KVMLimits struct {
+	Quality   int `json:"quality" form:"quality" binding:"min=0,max=10" example:"5"`                // JPEG quality, 1 (lowest) to 10, the viewer is asked for
+	FrameRate int `json:"frameRate" form:"frameRate" binding:"min=0,max=60" example:"10"`           // screen updates per second
+	Bandwidth int `json:"bandwidth" form:"bandwidth" binding:"omitempty,min=1024" example:"262144"` // bytes per second sent from the device to the viewer, at least 1024
+}
+
+// Keyboard layouts of the devices a KVM session can translate the keys of the viewer to.
+const (
+	KeyboardLayoutUS     = "en-US"
+	KeyboardLayoutGerman = "de-DE"
+	KeyboardLayoutFrench = "fr-FR"
+)
+
+// KVMSessionOptions are negotiated when a KVM session opens with a redirection ticket.
+type KVMSessionOptions struct {
+	// KeyboardLayout is the layout of the keyboard of the device, the keys of the viewer are translated to it.
+	KeyboardLayout string `json:"keyboardLayout,omitempty" binding:"omitempty,oneof=en-US de-DE fr-FR" example:"de-DE"`
+	// Clipboard types the clipboard text the viewer sends into the device.
+	Clipboard bool `json:"clipboard,omitempty" example:"true"`
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // LinkPreferenceRequest represents the request to set link preference on a device.
 type LinkPreferenceRequest struct {
 	LinkPreference uint32 `json:"linkPreference" binding:"required,min=1,max=2"` // 1 for ME, 2 for HOST
@@ -19,3 +21,23 @@ const (
 
 // Console-specific return value for no WiFi interface found.
 const ReturnValueNoWiFiPort = -1
+
+// LinkSettings is the link policy and, for the wireless interface, the link preference of a network interface
+// of a device.
+type LinkSettings struct {
+	Interface               string     `json:"interface" example:"wireless"`                                     // wired or wireless
+	InstanceID              string     `json:"instanceID" example:"Intel(r) AMT Ethernet Port Settings 1"`       // InstanceID of the AMT_EthernetPortSettings
+	LinkPolicy              int        `json:"linkPolicy" example:"17"`                                          // Power states in which AMT may use the link: sum of 1 (S0 AC), 14 (Sx AC), 16 (S0 DC) and 224 (Sx DC)
+	LinkPreference          int        `json:"linkPreference,omitempty" example:"2"`                             // 1 for ME, 2 for HOST
+	LinkControl             int        `json:"linkControl,omitempty" example:"2"`                                // Current owner of the link: 1 for ME, 2 for HOST
+	LinkPreferenceRevertsAt *time.Time `json:"linkPreferenceRevertsAt,omitempty" example:"2025-01-01T00:05:00Z"` // When the link returns to the host after it was given to ME
+}
+
+// LinkSettingsRequest changes the link policy and, for the wireless interface, the link preference of a network
+// interface. Omitted settings are left as they are.
+type LinkSettingsRequest struct {
+	Interface      string `json:"interface" binding:"required,oneof=wired wireless" example:"wireless"`
+	LinkPolicy     *int   `json:"linkPolicy,omitempty" binding:"omitempty,min=1,max=255" example:"17"`  // Sum of 1 (S0 AC), 14 (Sx AC), 16 (S0 DC) and 224 (Sx DC)
+	LinkPreference uint32 `json:"linkPreference,omitempty" binding:"omitempty,min=1,max=2" example:"1"` // 1 for ME, 2 for HOST
+	Timeout        uint32 `json:"timeout,omitempty" binding:"omitempty,max=65535" example:"300"`        // Seconds before the link returns to the host, required with ME
+}
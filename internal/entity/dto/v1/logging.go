@@ -0,0 +1,8 @@
+package dto
+
+// LoggingSettings is the runtime logging configuration. Debug maps a subsystem (wsman, cira)
+// to the device GUIDs it logs debug messages for; "*" enables it for every device.
+type LoggingSettings struct {
+	Level string              `json:"level,omitempty" binding:"omitempty,oneof=error warn info debug" example:"info"`
+	Debug map[string][]string `json:"debug,omitempty"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type Messages struct {
+	Language  string            `json:"language" example:"es"`
+	Languages []string          `json:"languages" example:"en,de,es"`
+	Messages  map[string]string `json:"messages"`
+}
@@ -26,4 +26,7 @@ type PowerCapabilities struct {
 
 	ResetToPXE   int `json:"Reset to PXE,omitempty" example:"0"`
 	PowerOnToPXE int `json:"Power on to PXE,omitempty" example:"0"`
+
+	GracefulShutdown int `json:"Graceful shutdown,omitempty" example:"0"`
+	GracefulRestart  int `json:"Graceful restart,omitempty" example:"0"`
 }
@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// PowerHistoryQuery selects the window of a power history and how many buckets it is split into.
+type PowerHistoryQuery struct {
+	Hours   int `form:"hours,default=24" binding:"min=1,max=720"`
+	Buckets int `form:"buckets,default=24" binding:"min=1,max=288"`
+}
+
+// PowerHistory summarizes the power states of a device recorded by the power state poller.
+// Time without a sample, e.g. while the poller was stopped, counts as unknown.
+type PowerHistory struct {
+	GUID            string    `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	From            time.Time `json:"from" example:"2024-01-01T00:00:00Z"`
+	To              time.Time `json:"to" example:"2024-01-02T00:00:00Z"`
+	UptimeSeconds   int64     `json:"uptimeSeconds" example:"82800"`
+	SleepSeconds    int64     `json:"sleepSeconds" example:"0"`
+	DowntimeSeconds int64     `json:"downtimeSeconds" example:"3600"`
+	UnknownSeconds  int64     `json:"unknownSeconds" example:"0"`
+	// Availability is the share of the known time the device was on, from 0 to 1.
+	Availability float64 `json:"availability" example:"0.958"`
+	// Buckets splits the window into equal parts holding the share of each part the device was on,
+	// null for parts without samples.
+	Buckets []*float64    `json:"buckets"`
+	Samples []PowerSample `json:"samples"`
+}
+
+// PowerSample is a power state observed at a point in time.
+type PowerSample struct {
+	Timestamp  time.Time `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	PowerState int       `json:"powerState" example:"2"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// Views of a device a console user pings presence for.
+const (
+	PresenceViewDetails = "details"
+	PresenceViewKVM     = "kvm"
+)
+
+// PresencePing tells the console a user has a view of a device open.
+type PresencePing struct {
+	View string `json:"view" binding:"required,oneof=details kvm" example:"kvm"`
+}
+
+// Presence is a console user with a view of a device open.
+type Presence struct {
+	User     string    `json:"user" example:"admin"`
+	View     string    `json:"view" example:"kvm"`
+	LastSeen time.Time `json:"lastSeen" example:"2024-01-01T00:00:00Z"`
+}
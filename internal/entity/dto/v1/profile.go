@@ -26,6 +26,7 @@ type Profile struct {
 	TLSMode                    int                  `json:"tlsMode,omitempty" binding:"omitempty,min=1,max=4,ciraortls" example:"1"`
 	TLSCerts                   *TLSCerts            `json:"tlsCerts,omitempty"`
 	TLSSigningAuthority        string               `json:"tlsSigningAuthority,omitempty" binding:"omitempty,oneof=SelfSigned MicrosoftCA" example:"SelfSigned"`
+	TLSTrustedCNs              []string             `json:"tlsTrustedCNs,omitempty" binding:"omitempty,mutualtls,dive,hostname_rfc1123" example:"console.example.com"`
 	UserConsent                string               `json:"userConsent,omitempty" binding:"omitempty" default:"All" example:"All"`
 	IDEREnabled                bool                 `json:"iderEnabled" example:"true"`
 	KVMEnabled                 bool                 `json:"kvmEnabled" example:"true"`
@@ -50,6 +51,13 @@ var ValidateCIRAOrTLS validator.Func = func(fl validator.FieldLevel) bool {
 	return true
 }
 
+// ValidateMutualTLS accepts trusted common names only on a profile with a mutual TLS mode.
+var ValidateMutualTLS validator.Func = func(fl validator.FieldLevel) bool {
+	tlsMode, _ := fl.Parent().FieldByName("TLSMode").Interface().(int)
+
+	return fl.Field().Len() == 0 || tlsMode == 3 || tlsMode == 4
+}
+
 var ValidateAMTPassOrGenRan validator.Func = func(fl validator.FieldLevel) bool {
 	amtPass := fl.Parent().FieldByName("AMTPassword").String()
 
@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+// ReadOnlySettings is the read-only mode of the API. While it is enabled the requests that change anything
+// are rejected with 503 Service Unavailable and a Retry-After of RetryAfter seconds, reads are served.
+type ReadOnlySettings struct {
+	Enabled    bool       `json:"enabled"`
+	RetryAfter int        `json:"retryAfter,omitempty" binding:"omitempty,min=1,max=86400" example:"60"`
+	Reason     string     `json:"reason,omitempty" binding:"max=256" example:"database migration"`
+	Since      *time.Time `json:"since,omitempty"`
+}
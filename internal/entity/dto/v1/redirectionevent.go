@@ -0,0 +1,13 @@
+package dto
+
+// Events of a redirection session sent to the viewer as websocket text messages, next to the binary data of the session.
+const (
+	RedirectionEventIdleWarning = "idleWarning"
+	RedirectionEventIdleTimeout = "idleTimeout"
+)
+
+// RedirectionEvent is an event of a redirection session sent to the viewer.
+type RedirectionEvent struct {
+	Event    string `json:"event" example:"idleWarning"`
+	ClosesIn int    `json:"closesIn,omitempty" example:"60"` // seconds until an idle session is closed
+}
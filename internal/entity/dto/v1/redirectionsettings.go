@@ -0,0 +1,23 @@
+package dto
+
+// RedirectionSettings is the redirection configuration of a device read from AMT_RedirectionService,
+// CIM_KVMRedirectionSAP, IPS_KVMRedirectionSettingData and IPS_ScreenSettingData as one document.
+// Fields with binding:"-" are reported by the device and ignored on update.
+type RedirectionSettings struct {
+	ListenerEnabled bool                   `json:"listenerEnabled" binding:"-" example:"true"`
+	SOL             bool                   `json:"sol" example:"true"`
+	IDER            bool                   `json:"ider" example:"true"`
+	KVM             KVMRedirectionSettings `json:"kvm"`
+}
+
+// KVMRedirectionSettings is the KVM part of RedirectionSettings.
+type KVMRedirectionSettings struct {
+	Enabled         bool               `json:"enabled" example:"true"`
+	Available       bool               `json:"available" binding:"-" example:"true"`
+	EnabledByMEBx   bool               `json:"enabledByMEBx" binding:"-" example:"true"`
+	OptInPolicy     bool               `json:"optInPolicy" example:"true"`                           // user consent is required for KVM sessions
+	SessionTimeout  int                `json:"sessionTimeout" binding:"min=0,max=65535" example:"0"` // minutes, 0 for no timeout
+	Port5900Enabled bool               `json:"port5900Enabled" example:"false"`                      // standard VNC port, needs KVM enabled
+	DefaultScreen   int                `json:"defaultScreen" binding:"min=0,max=255" example:"0"`    // index into Displays
+	Displays        []KVMScreenDisplay `json:"displays" binding:"-"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// RedirectionTicketRequest requests a one-time ticket for a redirection (KVM/SOL/IDER) websocket.
+type RedirectionTicketRequest struct {
+	GUID    string            `json:"guid" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Options KVMSessionOptions `json:"options"`
+}
+
+// RedirectionTicket is a single-use, short-lived credential bound to a device and user.
+type RedirectionTicket struct {
+	Ticket    string            `json:"ticket" example:"3q2-7wAAAAA"`
+	GUID      string            `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Subject   string            `json:"-"`
+	ExpiresAt time.Time         `json:"expiresAt" example:"2024-01-01T00:00:30Z"`
+	Options   KVMSessionOptions `json:"options"` // applied to the session opened with the ticket
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+// Revocation statuses of a certificate.
+const (
+	RevocationGood    = "good"
+	RevocationRevoked = "revoked"
+	RevocationUnknown = "unknown"
+)
+
+// RevocationStatus is whether the issuer of a certificate revoked it, as answered by the OCSP responder or the CRL
+// named in the certificate.
+type RevocationStatus struct {
+	Status    string     `json:"status" example:"good"`                              // good, revoked or unknown
+	Source    string     `json:"source,omitempty" example:"ocsp"`                    // ocsp or crl
+	Reason    string     `json:"reason,omitempty" example:"no OCSP or CRL endpoint"` // why the status is unknown
+	RevokedAt *time.Time `json:"revokedAt,omitempty" example:"2026-01-01T00:00:00Z"`
+	CheckedAt time.Time  `json:"checkedAt" example:"2026-01-01T00:00:00Z"`
+}
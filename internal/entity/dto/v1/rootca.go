@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// RootCA is the root certificate of the console, which issues the certificate of the MPS web server the devices
+// connecting over CIRA trust, with the root being rolled over to and the one retired by the last rollover.
+type RootCA struct {
+	Current  *RootCertificate `json:"current,omitempty"`
+	Pending  *RootCertificate `json:"pending,omitempty"`
+	Previous *RootCertificate `json:"previous,omitempty"`
+}
+
+// RootCertificate is a root certificate of the console.
+type RootCertificate struct {
+	Subject           string    `json:"subject" example:"CN=console-1a2b3c,O=device-management-toolkit,C=US"`
+	SerialNumber      string    `json:"serialNumber" example:"1f2e3d4c5b6a"`
+	NotBefore         time.Time `json:"notBefore" example:"2025-01-01T00:00:00Z"`
+	NotAfter          time.Time `json:"notAfter" example:"2056-01-01T00:00:00Z"`
+	SHA256Fingerprint string    `json:"sha256Fingerprint" example:"3e5e4c1f..."`
+	KeyAlgorithm      string    `json:"keyAlgorithm" example:"rsa3072"` // rsa and the key size, or ecdsa and the curve
+	Certificate       string    `json:"certificate" example:"-----BEGIN CERTIFICATE-----\n..."`
+}
+
+// RootCACrossSigned is the pending and the current root certificates each signed by the other, so that clients
+// trusting either root verify the certificates issued by both during the rollover.
+type RootCACrossSigned struct {
+	PendingByCurrent string `json:"pendingByCurrent" example:"-----BEGIN CERTIFICATE-----\n..."`
+	CurrentByPending string `json:"currentByPending" example:"-----BEGIN CERTIFICATE-----\n..."`
+}
+
+// RootCARollover is the outcome of a rollover to the pending root certificate. The pending root is promoted,
+// re-issuing the MPS web server certificate, once every device trusts it; the MPS serves the new web server
+// certificate after the console restarts.
+type RootCARollover struct {
+	Devices   int                     `json:"devices" example:"250"`
+	Trusting  int                     `json:"trusting" example:"240"` // devices already trusting the pending root
+	Pushed    []string                `json:"pushed"`                 // devices the pending root was added to
+	Failed    []RootCARolloverFailure `json:"failed"`
+	Completed bool                    `json:"completed" example:"false"`
+	Current   *RootCertificate        `json:"current,omitempty"`
+}
+
+// RootCARolloverFailure is a device the pending root certificate could not be added to.
+type RootCARolloverFailure struct {
+	GUID     string `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Hostname string `json:"hostname" example:"desktop-1.example.com"`
+	Error    string `json:"error" example:"device is not connected"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+// RPSMessage is a message exchanged with an rpc-go client over the WebSocket of an activation. Payload is base64
+// encoded: the RPSPayload of the client in its request, the raw WS-Management messages relayed to and from its
+// device afterwards.
+type RPSMessage struct {
+	Method          string `json:"method"`
+	APIKey          string `json:"apiKey"`
+	AppVersion      string `json:"appVersion"`
+	ProtocolVersion string `json:"protocolVersion"`
+	Status          string `json:"status"`
+	Message         string `json:"message"`
+	FQDN            string `json:"fqdn"`
+	Payload         string `json:"payload"`
+	TenantID        string `json:"tenantId"`
+}
+
+// RPSPayload is what an rpc-go client tells of its device when it asks for an activation. Username and Password
+// are the local credentials AMT accepts from the host before it is provisioned, FQDN is the DNS suffix of the
+// device, the domain of an activation in admin control mode.
+type RPSPayload struct {
+	Version      string   `json:"ver"`
+	Build        string   `json:"build"`
+	SKU          string   `json:"sku"`
+	UUID         string   `json:"uuid"`
+	Username     string   `json:"username"`
+	Password     string   `json:"password"`
+	CurrentMode  int      `json:"currentMode"`
+	Hostname     string   `json:"hostname"`
+	FQDN         string   `json:"fqdn"`
+	Client       string   `json:"client"`
+	CertHashes   []string `json:"certHashes"`
+	FriendlyName string   `json:"friendlyName"`
+	Profile      string   `json:"profile"`
+}
+
+// RPSStatus is the outcome of an activation as an rpc-go client prints it.
+type RPSStatus struct {
+	Status           string `json:"Status"`
+	Network          string `json:"Network,omitempty"`
+	CIRAConnection   string `json:"CIRAConnection,omitempty"`
+	TLSConfiguration string `json:"TLSConfiguration,omitempty"`
+}
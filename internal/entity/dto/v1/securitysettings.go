@@ -46,6 +46,8 @@ type (
 		PublicKeyHandle        string   `json:"publicKeyHandle,omitempty"`
 		AssociatedProfiles     []string `json:"associatedProfiles,omitempty"`
 		DisplayName            string   `json:"displayName,omitempty"`
+		// Revocation is the revocation status of the certificate, when revocation is checked.
+		Revocation *RevocationStatus `json:"revocation,omitempty"`
 	}
 
 	Key struct {
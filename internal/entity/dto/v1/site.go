@@ -0,0 +1,27 @@
+package dto
+
+// TLS policies of a site, a site without one leaves TLS to the settings of each device.
+const (
+	SiteTLSNone       = "none"
+	SiteTLSVerified   = "verified"
+	SiteTLSSelfSigned = "selfSigned"
+)
+
+type SiteCountResponse struct {
+	Count int    `json:"totalCount"`
+	Data  []Site `json:"data"`
+}
+
+// Site is a network zone: the devices with an address in one of its subnets are connected to with its settings.
+// Its proxy and TLS policy apply to all of them, its credentials to the ones without credentials of their own.
+type Site struct {
+	Name    string   `json:"name" binding:"required" example:"branch-office"`
+	Subnets []string `json:"subnets" binding:"required,min=1,dive,cidr" example:"10.20.0.0/16"`
+	// Proxy is the proxy the devices of the site are reached through, "direct" for none at all.
+	Proxy     string `json:"proxy,omitempty" example:"socks5://jump.branch.example.com:1080"`
+	TLSPolicy string `json:"tlsPolicy,omitempty" binding:"omitempty,oneof=none verified selfSigned" example:"selfSigned"`
+	Username  string `json:"username,omitempty" binding:"required_with=Password" example:"admin"`
+	// Password is never returned, an update without one keeps the current password.
+	Password string `json:"password,omitempty" example:"my_password"`
+	TenantID string `json:"tenantId" example:"abc123"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// TenantKey describes a data-encryption key of a tenant, never the key itself. The active key
+// encrypts new secrets, the others are kept to decrypt the secrets written with them.
+type TenantKey struct {
+	KeyID     string     `json:"keyId" example:"5f1c2a9e-7b43-4d0e-9a61-2f8d3c4b5a60"`
+	TenantID  string     `json:"tenantId" example:""`
+	Active    bool       `json:"active" example:"true"`
+	CreatedAt time.Time  `json:"createdAt" example:"2026-10-01T08:00:00Z"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty" example:"2026-10-02T08:00:00Z"`
+}
+
+// TenantKeyRevokeResult reports how many keys of a tenant a revocation destroyed.
+type TenantKeyRevokeResult struct {
+	Revoked int `json:"revoked" example:"2"`
+}
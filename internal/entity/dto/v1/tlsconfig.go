@@ -26,3 +26,28 @@ type SettingDataResponse struct {
 	AcceptNonSecureConnections    bool     `json:"AcceptNonSecureConnections"`
 	NonSecureConnectionsSupported *bool    `json:"NonSecureConnectionsSupported"`
 }
+
+// TLSSettingsRequest sets the TLS of the network interface of a device: whether the clients must present a
+// certificate, and the common names their certificate may have. TLS must already be enabled on the interface.
+type TLSSettingsRequest struct {
+	MutualAuthentication bool     `json:"mutualAuthentication" example:"true"`
+	TrustedCNs           []string `json:"trustedCNs,omitempty" binding:"omitempty,dive,hostname_rfc1123" example:"console.example.com"`
+}
+
+// TLSComplianceReport lists the devices of a profile, selected by its tags, that do not enforce its TLS
+// settings yet.
+type TLSComplianceReport struct {
+	Profile              string                `json:"profile" example:"My Profile"`
+	MutualAuthentication bool                  `json:"mutualAuthentication" example:"true"`
+	TrustedCNs           []string              `json:"trustedCNs,omitempty" example:"console.example.com"`
+	Total                int                   `json:"total" example:"10"`
+	Compliant            int                   `json:"compliant" example:"8"`
+	Devices              []TLSComplianceDevice `json:"devices"`
+}
+
+// TLSComplianceDevice is a device not enforcing the TLS settings of its profile, and why.
+type TLSComplianceDevice struct {
+	GUID     string `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Hostname string `json:"hostname" example:"office-pc-01"`
+	Reason   string `json:"reason" example:"mutual authentication is disabled"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+// User is a console account. Disabled accounts are kept so that a user who returns to a
+// synced directory group gets the same account back.
+type User struct {
+	Username    string    `json:"username" example:"jdoe"`
+	DisplayName string    `json:"displayName" example:"Jane Doe"`
+	Email       string    `json:"email" example:"jane.doe@example.com"`
+	Role        string    `json:"role" example:"operator"`
+	Source      string    `json:"source" example:"ldap"`
+	Disabled    bool      `json:"disabled" example:"false"`
+	UpdatedAt   time.Time `json:"updatedAt" example:"2026-10-01T08:00:00Z"`
+}
+
+// UserSyncResult reports the accounts a directory sync created, changed and disabled.
+type UserSyncResult struct {
+	Created  int `json:"created" example:"3"`
+	Updated  int `json:"updated" example:"1"`
+	Disabled int `json:"disabled" example:"2"`
+}
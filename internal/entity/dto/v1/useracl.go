@@ -0,0 +1,32 @@
+package dto
+
+// DeviceUser is an entry of the user ACL of a device: a digest user, named by Username, or an Active Directory
+// user authenticated with Kerberos, named by KerberosUserSID. The admin account is not listed and passwords are
+// never returned.
+type DeviceUser struct {
+	Handle          int    `json:"handle" example:"1"`
+	Username        string `json:"username,omitempty" example:"operator"`
+	KerberosUserSID string `json:"kerberosUserSid,omitempty" example:"S-1-5-21-3623811015-3361044348-30300820-1013"`
+	// AccessPermission tells from where the user may connect: local, network or any.
+	AccessPermission string   `json:"accessPermission" example:"network"`
+	Realms           []string `json:"realms" example:"Redirection,HardwareAsset,RemoteControl"`
+	Enabled          bool     `json:"enabled" example:"true"`
+}
+
+// DeviceUserRequest adds or replaces a user of a device. A digest user has a Username and, when added, a
+// Password; a Kerberos user has a KerberosUserSID instead.
+type DeviceUserRequest struct {
+	Username string `json:"username,omitempty" binding:"omitempty,max=16" example:"operator"`
+	// Password is required to add a digest user; omitted on a replacement, the user keeps its password.
+	Password         string   `json:"password,omitempty" binding:"omitempty,min=8,max=32" example:"P@ssw0rd"`
+	KerberosUserSID  string   `json:"kerberosUserSid,omitempty" example:"S-1-5-21-3623811015-3361044348-30300820-1013"`
+	AccessPermission string   `json:"accessPermission" binding:"required,oneof=local network any" example:"network"`
+	Realms           []string `json:"realms" binding:"required,min=1" example:"Redirection,HardwareAsset,RemoteControl"`
+	Enabled          *bool    `json:"enabled,omitempty" example:"true"` // true when omitted
+}
+
+// DeviceUserParam identifies a user of a device in the URI.
+type DeviceUserParam struct {
+	GUID   string `uri:"guid" binding:"required"`
+	Handle int    `uri:"handle" binding:"required,min=1"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// guidRegex matches a device GUID in its canonical 8-4-4-4-12 hexadecimal form.
+var guidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ValidateGUID validates that a field holds a device GUID such as 123e4567-e89b-12d3-a456-426614174000.
+func ValidateGUID(fl validator.FieldLevel) bool {
+	return guidRegex.MatchString(fl.Field().String())
+}
+
+// ValidateIANATimezone validates that a field names an IANA time zone such as Europe/Berlin.
+// Unlike the built-in timezone tag it rejects "Local", which depends on the server's settings.
+func ValidateIANATimezone(fl validator.FieldLevel) bool {
+	name := fl.Field().String()
+	if name == "" || name == "Local" {
+		return false
+	}
+
+	_, err := time.LoadLocation(name)
+
+	return err == nil
+}
@@ -0,0 +1,19 @@
+package dto
+
+// WiFiPortSettings are the settings of the Wi-Fi port of a device deciding which Wi-Fi profiles AMT shares
+// with the OS and the UEFI BIOS.
+type WiFiPortSettings struct {
+	// LocalProfileSynchronization is 0 when AMT ignores the profiles of the OS, 1 when it uses the profiles of
+	// the logged in user and 3 when it uses all of them.
+	LocalProfileSynchronization int  `json:"localProfileSynchronization" example:"3"`
+	UEFIWiFiProfileShareEnabled bool `json:"uefiWiFiProfileShareEnabled" example:"true"`
+	// UEFIWiFiProfileShareSupported is whether the firmware can share its profiles with the UEFI BIOS, from
+	// Intel CSME 16.0.
+	UEFIWiFiProfileShareSupported bool `json:"uefiWiFiProfileShareSupported" example:"true"`
+}
+
+// WiFiPortSettingsRequest replaces the settings of the Wi-Fi port of a device.
+type WiFiPortSettingsRequest struct {
+	LocalProfileSynchronization int  `json:"localProfileSynchronization" binding:"oneof=0 1 3" example:"3"`
+	UEFIWiFiProfileShareEnabled bool `json:"uefiWiFiProfileShareEnabled" example:"true"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+// WiFiProfile is a Wi-Fi profile of a device. The device connects to the networks of its profiles in
+// ascending priority, 1 first.
+type WiFiProfile struct {
+	InstanceID           string `json:"instanceID" example:"Intel(r) AMT:WiFi Endpoint Settings home"`
+	ElementName          string `json:"elementName" example:"home"`
+	SSID                 string `json:"ssid" example:"home"`
+	AuthenticationMethod string `json:"authenticationMethod" example:"WPA2PSK"`
+	EncryptionMethod     string `json:"encryptionMethod" example:"CCMP"`
+	Priority             int    `json:"priority" example:"1"`
+}
+
+// WiFiProfileOrder lists the instance IDs of all the Wi-Fi profiles of a device, the one to connect to first
+// at the top.
+type WiFiProfileOrder struct {
+	InstanceIDs []string `json:"instanceIDs" binding:"required,min=1,dive,required" example:"Intel(r) AMT:WiFi Endpoint Settings office,Intel(r) AMT:WiFi Endpoint Settings home"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// WsmanCaptureRequest starts recording the WS-Man messages exchanged with a device.
+type WsmanCaptureRequest struct {
+	Minutes int `json:"minutes" binding:"required,min=1,max=60" example:"10"`
+}
+
+// WsmanCapture describes a capture of the WS-Man messages exchanged with a device.
+type WsmanCapture struct {
+	GUID      string    `json:"guid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	StartedAt time.Time `json:"startedAt" example:"2024-01-01T00:00:00Z"`
+	EndsAt    time.Time `json:"endsAt" example:"2024-01-01T00:10:00Z"`
+	Active    bool      `json:"active" example:"true"`
+	Exchanges int       `json:"exchanges" example:"42"`
+	Truncated bool      `json:"truncated" example:"false"`
+}
@@ -0,0 +1,13 @@
+package entity
+
+type EnergyPolicy struct {
+	Name         string
+	Tags         string
+	TimeZone     string
+	Days         string
+	PowerOffTime string
+	PowerOnTime  string
+	Exceptions   string
+	Enabled      bool
+	TenantID     string
+}
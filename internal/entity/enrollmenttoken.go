@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// EnrollmentToken is a single-use token a provisioning agent enrolls a device with. Only the SHA-256 of the token
+// is stored as TokenHash. UsedAt is zero until the token is redeemed by the device UsedBy.
+type EnrollmentToken struct {
+	ID          string
+	TokenHash   string
+	TenantID    string
+	Description string
+	CIRAConfig  string
+	Tags        string
+	CreatedBy   string
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	UsedAt      time.Time
+	UsedBy      string
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// Job is a background job as it is stored. Payload, Items and Result are JSON documents: the
+// input of the job, the progress of each of its items and its outcome.
+type Job struct {
+	ID          string
+	Type        string
+	TenantID    string
+	Payload     string
+	State       string
+	Attempts    int
+	MaxAttempts int
+	Total       int
+	Succeeded   int
+	Failed      int
+	Items       string
+	Result      string
+	Error       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	RunAfter    time.Time
+	StartedAt   time.Time
+	FinishedAt  time.Time
+}
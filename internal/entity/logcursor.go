@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// LogCursor is the position up to which the records of a device log were forwarded.
+// Log is "audit" or "event", LastIndex is only used for the audit log.
+type LogCursor struct {
+	GUID      string
+	Log       string
+	LastIndex int
+	LastTime  time.Time
+}
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// PowerSample is the power state of a device recorded by the power state poller.
+// PowerState is a CIM power state, 0 when the device could not be reached.
+type PowerSample struct {
+	GUID       string
+	PowerState int
+	SampledAt  time.Time
+}
@@ -17,6 +17,7 @@ type Profile struct {
 	TenantID                   string
 	TLSMode                    int
 	TLSSigningAuthority        string
+	TLSTrustedCNs              string
 	UserConsent                string
 	IDEREnabled                bool
 	KVMEnabled                 bool
@@ -0,0 +1,11 @@
+package entity
+
+type Site struct {
+	Name      string
+	Subnets   string
+	Proxy     string
+	TLSPolicy string
+	Username  string
+	Password  string
+	TenantID  string
+}
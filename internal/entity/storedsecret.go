@@ -0,0 +1,11 @@
+package entity
+
+// StoredSecret is a value of a database column encrypted with the console key, Key names its row within its
+// tenant.
+type StoredSecret struct {
+	Table      string
+	Column     string
+	Key        string
+	TenantID   string
+	CipherText string
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// TenantKey is a data-encryption key of a tenant, WrappedKey is the key encrypted with the master
+// key. A revoked key has no WrappedKey left, the data encrypted with it cannot be decrypted anymore.
+type TenantKey struct {
+	KeyID      string
+	TenantID   string
+	WrappedKey string
+	CreatedAt  time.Time
+	RevokedAt  time.Time
+}
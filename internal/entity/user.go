@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// Console roles, from the most to the least rights.
+const (
+	RoleAdmin    = "admin"
+	RoleOperator = "operator"
+	RoleViewer   = "viewer"
+)
+
+// User is a console account. Source is where the account is provisioned from, "ldap" for
+// accounts synced from a directory, and DN is the distinguished name of the account there.
+type User struct {
+	Username    string
+	DN          string
+	DisplayName string
+	Email       string
+	Role        string
+	Source      string
+	Disabled    bool
+	UpdatedAt   time.Time
+}
@@ -89,858 +89,858 @@ func (m *MockAMTExplorer) EXPECT() *MockAMTExplorerMockRecorder {
 }
 
 // GetAMT8021xCredentialContext mocks base method.
-func (m *MockAMTExplorer) GetAMT8021xCredentialContext() (ieee8021x.Response, error) {
+func (m *MockAMTExplorer) GetAMT8021xCredentialContext(ctx context.Context) (ieee8021x.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMT8021xCredentialContext")
+	ret := m.ctrl.Call(m, "GetAMT8021xCredentialContext", ctx)
 	ret0, _ := ret[0].(ieee8021x.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMT8021xCredentialContext indicates an expected call of GetAMT8021xCredentialContext.
-func (mr *MockAMTExplorerMockRecorder) GetAMT8021xCredentialContext() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMT8021xCredentialContext(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMT8021xCredentialContext", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMT8021xCredentialContext))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMT8021xCredentialContext", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMT8021xCredentialContext), ctx)
 }
 
 // GetAMT8021xProfile mocks base method.
-func (m *MockAMTExplorer) GetAMT8021xProfile() (ieee8021x.Response, error) {
+func (m *MockAMTExplorer) GetAMT8021xProfile(ctx context.Context) (ieee8021x.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMT8021xProfile")
+	ret := m.ctrl.Call(m, "GetAMT8021xProfile", ctx)
 	ret0, _ := ret[0].(ieee8021x.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMT8021xProfile indicates an expected call of GetAMT8021xProfile.
-func (mr *MockAMTExplorerMockRecorder) GetAMT8021xProfile() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMT8021xProfile(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMT8021xProfile", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMT8021xProfile))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMT8021xProfile", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMT8021xProfile), ctx)
 }
 
 // GetAMTAlarmClockService mocks base method.
-func (m *MockAMTExplorer) GetAMTAlarmClockService() (alarmclock.Response, error) {
+func (m *MockAMTExplorer) GetAMTAlarmClockService(ctx context.Context) (alarmclock.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTAlarmClockService")
+	ret := m.ctrl.Call(m, "GetAMTAlarmClockService", ctx)
 	ret0, _ := ret[0].(alarmclock.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTAlarmClockService indicates an expected call of GetAMTAlarmClockService.
-func (mr *MockAMTExplorerMockRecorder) GetAMTAlarmClockService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTAlarmClockService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTAlarmClockService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTAlarmClockService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTAlarmClockService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTAlarmClockService), ctx)
 }
 
 // GetAMTAuditLog mocks base method.
-func (m *MockAMTExplorer) GetAMTAuditLog() (auditlog.Response, error) {
+func (m *MockAMTExplorer) GetAMTAuditLog(ctx context.Context) (auditlog.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTAuditLog")
+	ret := m.ctrl.Call(m, "GetAMTAuditLog", ctx)
 	ret0, _ := ret[0].(auditlog.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTAuditLog indicates an expected call of GetAMTAuditLog.
-func (mr *MockAMTExplorerMockRecorder) GetAMTAuditLog() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTAuditLog(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTAuditLog", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTAuditLog))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTAuditLog", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTAuditLog), ctx)
 }
 
 // GetAMTAuthorizationService mocks base method.
-func (m *MockAMTExplorer) GetAMTAuthorizationService() (authorization.Response, error) {
+func (m *MockAMTExplorer) GetAMTAuthorizationService(ctx context.Context) (authorization.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTAuthorizationService")
+	ret := m.ctrl.Call(m, "GetAMTAuthorizationService", ctx)
 	ret0, _ := ret[0].(authorization.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTAuthorizationService indicates an expected call of GetAMTAuthorizationService.
-func (mr *MockAMTExplorerMockRecorder) GetAMTAuthorizationService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTAuthorizationService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTAuthorizationService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTAuthorizationService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTAuthorizationService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTAuthorizationService), ctx)
 }
 
 // GetAMTBootCapabilities mocks base method.
-func (m *MockAMTExplorer) GetAMTBootCapabilities() (boot.Response, error) {
+func (m *MockAMTExplorer) GetAMTBootCapabilities(ctx context.Context) (boot.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTBootCapabilities")
+	ret := m.ctrl.Call(m, "GetAMTBootCapabilities", ctx)
 	ret0, _ := ret[0].(boot.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTBootCapabilities indicates an expected call of GetAMTBootCapabilities.
-func (mr *MockAMTExplorerMockRecorder) GetAMTBootCapabilities() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTBootCapabilities(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTBootCapabilities", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTBootCapabilities))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTBootCapabilities", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTBootCapabilities), ctx)
 }
 
 // GetAMTBootSettingData mocks base method.
-func (m *MockAMTExplorer) GetAMTBootSettingData() (boot.Response, error) {
+func (m *MockAMTExplorer) GetAMTBootSettingData(ctx context.Context) (boot.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTBootSettingData")
+	ret := m.ctrl.Call(m, "GetAMTBootSettingData", ctx)
 	ret0, _ := ret[0].(boot.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTBootSettingData indicates an expected call of GetAMTBootSettingData.
-func (mr *MockAMTExplorerMockRecorder) GetAMTBootSettingData() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTBootSettingData(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTBootSettingData", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTBootSettingData))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTBootSettingData", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTBootSettingData), ctx)
 }
 
 // GetAMTEnvironmentDetectionSettingData mocks base method.
-func (m *MockAMTExplorer) GetAMTEnvironmentDetectionSettingData() (environmentdetection.Response, error) {
+func (m *MockAMTExplorer) GetAMTEnvironmentDetectionSettingData(ctx context.Context) (environmentdetection.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTEnvironmentDetectionSettingData")
+	ret := m.ctrl.Call(m, "GetAMTEnvironmentDetectionSettingData", ctx)
 	ret0, _ := ret[0].(environmentdetection.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTEnvironmentDetectionSettingData indicates an expected call of GetAMTEnvironmentDetectionSettingData.
-func (mr *MockAMTExplorerMockRecorder) GetAMTEnvironmentDetectionSettingData() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTEnvironmentDetectionSettingData(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTEnvironmentDetectionSettingData", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTEnvironmentDetectionSettingData))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTEnvironmentDetectionSettingData", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTEnvironmentDetectionSettingData), ctx)
 }
 
 // GetAMTEthernetPortSettings mocks base method.
-func (m *MockAMTExplorer) GetAMTEthernetPortSettings() (ethernetport.Response, error) {
+func (m *MockAMTExplorer) GetAMTEthernetPortSettings(ctx context.Context) (ethernetport.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTEthernetPortSettings")
+	ret := m.ctrl.Call(m, "GetAMTEthernetPortSettings", ctx)
 	ret0, _ := ret[0].(ethernetport.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTEthernetPortSettings indicates an expected call of GetAMTEthernetPortSettings.
-func (mr *MockAMTExplorerMockRecorder) GetAMTEthernetPortSettings() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTEthernetPortSettings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTEthernetPortSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTEthernetPortSettings))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTEthernetPortSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTEthernetPortSettings), ctx)
 }
 
 // GetAMTGeneralSettings mocks base method.
-func (m *MockAMTExplorer) GetAMTGeneralSettings() (general.Response, error) {
+func (m *MockAMTExplorer) GetAMTGeneralSettings(ctx context.Context) (general.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTGeneralSettings")
+	ret := m.ctrl.Call(m, "GetAMTGeneralSettings", ctx)
 	ret0, _ := ret[0].(general.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTGeneralSettings indicates an expected call of GetAMTGeneralSettings.
-func (mr *MockAMTExplorerMockRecorder) GetAMTGeneralSettings() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTGeneralSettings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTGeneralSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTGeneralSettings))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTGeneralSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTGeneralSettings), ctx)
 }
 
 // GetAMTKerberosSettingData mocks base method.
-func (m *MockAMTExplorer) GetAMTKerberosSettingData() (kerberos.Response, error) {
+func (m *MockAMTExplorer) GetAMTKerberosSettingData(ctx context.Context) (kerberos.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTKerberosSettingData")
+	ret := m.ctrl.Call(m, "GetAMTKerberosSettingData", ctx)
 	ret0, _ := ret[0].(kerberos.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTKerberosSettingData indicates an expected call of GetAMTKerberosSettingData.
-func (mr *MockAMTExplorerMockRecorder) GetAMTKerberosSettingData() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTKerberosSettingData(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTKerberosSettingData", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTKerberosSettingData))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTKerberosSettingData", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTKerberosSettingData), ctx)
 }
 
 // GetAMTMPSUsernamePassword mocks base method.
-func (m *MockAMTExplorer) GetAMTMPSUsernamePassword() (mps.Response, error) {
+func (m *MockAMTExplorer) GetAMTMPSUsernamePassword(ctx context.Context) (mps.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTMPSUsernamePassword")
+	ret := m.ctrl.Call(m, "GetAMTMPSUsernamePassword", ctx)
 	ret0, _ := ret[0].(mps.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTMPSUsernamePassword indicates an expected call of GetAMTMPSUsernamePassword.
-func (mr *MockAMTExplorerMockRecorder) GetAMTMPSUsernamePassword() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTMPSUsernamePassword(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTMPSUsernamePassword", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTMPSUsernamePassword))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTMPSUsernamePassword", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTMPSUsernamePassword), ctx)
 }
 
 // GetAMTManagementPresenceRemoteSAP mocks base method.
-func (m *MockAMTExplorer) GetAMTManagementPresenceRemoteSAP() (managementpresence.Response, error) {
+func (m *MockAMTExplorer) GetAMTManagementPresenceRemoteSAP(ctx context.Context) (managementpresence.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTManagementPresenceRemoteSAP")
+	ret := m.ctrl.Call(m, "GetAMTManagementPresenceRemoteSAP", ctx)
 	ret0, _ := ret[0].(managementpresence.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTManagementPresenceRemoteSAP indicates an expected call of GetAMTManagementPresenceRemoteSAP.
-func (mr *MockAMTExplorerMockRecorder) GetAMTManagementPresenceRemoteSAP() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTManagementPresenceRemoteSAP(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTManagementPresenceRemoteSAP", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTManagementPresenceRemoteSAP))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTManagementPresenceRemoteSAP", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTManagementPresenceRemoteSAP), ctx)
 }
 
 // GetAMTMessageLog mocks base method.
-func (m *MockAMTExplorer) GetAMTMessageLog() (messagelog.Response, error) {
+func (m *MockAMTExplorer) GetAMTMessageLog(ctx context.Context) (messagelog.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTMessageLog")
+	ret := m.ctrl.Call(m, "GetAMTMessageLog", ctx)
 	ret0, _ := ret[0].(messagelog.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTMessageLog indicates an expected call of GetAMTMessageLog.
-func (mr *MockAMTExplorerMockRecorder) GetAMTMessageLog() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTMessageLog(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTMessageLog", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTMessageLog))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTMessageLog", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTMessageLog), ctx)
 }
 
 // GetAMTPublicKeyCertificate mocks base method.
-func (m *MockAMTExplorer) GetAMTPublicKeyCertificate() (publickey.Response, error) {
+func (m *MockAMTExplorer) GetAMTPublicKeyCertificate(ctx context.Context) (publickey.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTPublicKeyCertificate")
+	ret := m.ctrl.Call(m, "GetAMTPublicKeyCertificate", ctx)
 	ret0, _ := ret[0].(publickey.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTPublicKeyCertificate indicates an expected call of GetAMTPublicKeyCertificate.
-func (mr *MockAMTExplorerMockRecorder) GetAMTPublicKeyCertificate() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTPublicKeyCertificate(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTPublicKeyCertificate", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTPublicKeyCertificate))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTPublicKeyCertificate", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTPublicKeyCertificate), ctx)
 }
 
 // GetAMTPublicKeyManagementService mocks base method.
-func (m *MockAMTExplorer) GetAMTPublicKeyManagementService() (publickey.Response, error) {
+func (m *MockAMTExplorer) GetAMTPublicKeyManagementService(ctx context.Context) (publickey.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTPublicKeyManagementService")
+	ret := m.ctrl.Call(m, "GetAMTPublicKeyManagementService", ctx)
 	ret0, _ := ret[0].(publickey.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTPublicKeyManagementService indicates an expected call of GetAMTPublicKeyManagementService.
-func (mr *MockAMTExplorerMockRecorder) GetAMTPublicKeyManagementService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTPublicKeyManagementService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTPublicKeyManagementService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTPublicKeyManagementService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTPublicKeyManagementService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTPublicKeyManagementService), ctx)
 }
 
 // GetAMTPublicPrivateKeyPair mocks base method.
-func (m *MockAMTExplorer) GetAMTPublicPrivateKeyPair() (publicprivate.Response, error) {
+func (m *MockAMTExplorer) GetAMTPublicPrivateKeyPair(ctx context.Context) (publicprivate.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTPublicPrivateKeyPair")
+	ret := m.ctrl.Call(m, "GetAMTPublicPrivateKeyPair", ctx)
 	ret0, _ := ret[0].(publicprivate.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTPublicPrivateKeyPair indicates an expected call of GetAMTPublicPrivateKeyPair.
-func (mr *MockAMTExplorerMockRecorder) GetAMTPublicPrivateKeyPair() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTPublicPrivateKeyPair(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTPublicPrivateKeyPair", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTPublicPrivateKeyPair))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTPublicPrivateKeyPair", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTPublicPrivateKeyPair), ctx)
 }
 
 // GetAMTRedirectionService mocks base method.
-func (m *MockAMTExplorer) GetAMTRedirectionService() (redirection.Response, error) {
+func (m *MockAMTExplorer) GetAMTRedirectionService(ctx context.Context) (redirection.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTRedirectionService")
+	ret := m.ctrl.Call(m, "GetAMTRedirectionService", ctx)
 	ret0, _ := ret[0].(redirection.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTRedirectionService indicates an expected call of GetAMTRedirectionService.
-func (mr *MockAMTExplorerMockRecorder) GetAMTRedirectionService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTRedirectionService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTRedirectionService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTRedirectionService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTRedirectionService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTRedirectionService), ctx)
 }
 
 // GetAMTRemoteAccessPolicyAppliesToMPS mocks base method.
-func (m *MockAMTExplorer) GetAMTRemoteAccessPolicyAppliesToMPS() (remoteaccess.Response, error) {
+func (m *MockAMTExplorer) GetAMTRemoteAccessPolicyAppliesToMPS(ctx context.Context) (remoteaccess.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTRemoteAccessPolicyAppliesToMPS")
+	ret := m.ctrl.Call(m, "GetAMTRemoteAccessPolicyAppliesToMPS", ctx)
 	ret0, _ := ret[0].(remoteaccess.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTRemoteAccessPolicyAppliesToMPS indicates an expected call of GetAMTRemoteAccessPolicyAppliesToMPS.
-func (mr *MockAMTExplorerMockRecorder) GetAMTRemoteAccessPolicyAppliesToMPS() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTRemoteAccessPolicyAppliesToMPS(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTRemoteAccessPolicyAppliesToMPS", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTRemoteAccessPolicyAppliesToMPS))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTRemoteAccessPolicyAppliesToMPS", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTRemoteAccessPolicyAppliesToMPS), ctx)
 }
 
 // GetAMTRemoteAccessPolicyRule mocks base method.
-func (m *MockAMTExplorer) GetAMTRemoteAccessPolicyRule() (remoteaccess.Response, error) {
+func (m *MockAMTExplorer) GetAMTRemoteAccessPolicyRule(ctx context.Context) (remoteaccess.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTRemoteAccessPolicyRule")
+	ret := m.ctrl.Call(m, "GetAMTRemoteAccessPolicyRule", ctx)
 	ret0, _ := ret[0].(remoteaccess.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTRemoteAccessPolicyRule indicates an expected call of GetAMTRemoteAccessPolicyRule.
-func (mr *MockAMTExplorerMockRecorder) GetAMTRemoteAccessPolicyRule() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTRemoteAccessPolicyRule(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTRemoteAccessPolicyRule", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTRemoteAccessPolicyRule))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTRemoteAccessPolicyRule", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTRemoteAccessPolicyRule), ctx)
 }
 
 // GetAMTRemoteAccessService mocks base method.
-func (m *MockAMTExplorer) GetAMTRemoteAccessService() (remoteaccess.Response, error) {
+func (m *MockAMTExplorer) GetAMTRemoteAccessService(ctx context.Context) (remoteaccess.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTRemoteAccessService")
+	ret := m.ctrl.Call(m, "GetAMTRemoteAccessService", ctx)
 	ret0, _ := ret[0].(remoteaccess.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTRemoteAccessService indicates an expected call of GetAMTRemoteAccessService.
-func (mr *MockAMTExplorerMockRecorder) GetAMTRemoteAccessService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTRemoteAccessService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTRemoteAccessService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTRemoteAccessService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTRemoteAccessService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTRemoteAccessService), ctx)
 }
 
 // GetAMTSetupAndConfigurationService mocks base method.
-func (m *MockAMTExplorer) GetAMTSetupAndConfigurationService() (setupandconfiguration.Response, error) {
+func (m *MockAMTExplorer) GetAMTSetupAndConfigurationService(ctx context.Context) (setupandconfiguration.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTSetupAndConfigurationService")
+	ret := m.ctrl.Call(m, "GetAMTSetupAndConfigurationService", ctx)
 	ret0, _ := ret[0].(setupandconfiguration.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTSetupAndConfigurationService indicates an expected call of GetAMTSetupAndConfigurationService.
-func (mr *MockAMTExplorerMockRecorder) GetAMTSetupAndConfigurationService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTSetupAndConfigurationService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTSetupAndConfigurationService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTSetupAndConfigurationService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTSetupAndConfigurationService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTSetupAndConfigurationService), ctx)
 }
 
 // GetAMTTLSCredentialContext mocks base method.
-func (m *MockAMTExplorer) GetAMTTLSCredentialContext() (tls.Response, error) {
+func (m *MockAMTExplorer) GetAMTTLSCredentialContext(ctx context.Context) (tls.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTTLSCredentialContext")
+	ret := m.ctrl.Call(m, "GetAMTTLSCredentialContext", ctx)
 	ret0, _ := ret[0].(tls.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTTLSCredentialContext indicates an expected call of GetAMTTLSCredentialContext.
-func (mr *MockAMTExplorerMockRecorder) GetAMTTLSCredentialContext() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTTLSCredentialContext(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTTLSCredentialContext", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTTLSCredentialContext))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTTLSCredentialContext", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTTLSCredentialContext), ctx)
 }
 
 // GetAMTTLSProtocolEndpointCollection mocks base method.
-func (m *MockAMTExplorer) GetAMTTLSProtocolEndpointCollection() (tls.Response, error) {
+func (m *MockAMTExplorer) GetAMTTLSProtocolEndpointCollection(ctx context.Context) (tls.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTTLSProtocolEndpointCollection")
+	ret := m.ctrl.Call(m, "GetAMTTLSProtocolEndpointCollection", ctx)
 	ret0, _ := ret[0].(tls.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTTLSProtocolEndpointCollection indicates an expected call of GetAMTTLSProtocolEndpointCollection.
-func (mr *MockAMTExplorerMockRecorder) GetAMTTLSProtocolEndpointCollection() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTTLSProtocolEndpointCollection(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTTLSProtocolEndpointCollection", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTTLSProtocolEndpointCollection))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTTLSProtocolEndpointCollection", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTTLSProtocolEndpointCollection), ctx)
 }
 
 // GetAMTTLSSettingData mocks base method.
-func (m *MockAMTExplorer) GetAMTTLSSettingData() (tls.Response, error) {
+func (m *MockAMTExplorer) GetAMTTLSSettingData(ctx context.Context) (tls.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTTLSSettingData")
+	ret := m.ctrl.Call(m, "GetAMTTLSSettingData", ctx)
 	ret0, _ := ret[0].(tls.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTTLSSettingData indicates an expected call of GetAMTTLSSettingData.
-func (mr *MockAMTExplorerMockRecorder) GetAMTTLSSettingData() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTTLSSettingData(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTTLSSettingData", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTTLSSettingData))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTTLSSettingData", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTTLSSettingData), ctx)
 }
 
 // GetAMTTimeSynchronizationService mocks base method.
-func (m *MockAMTExplorer) GetAMTTimeSynchronizationService() (timesynchronization.Response, error) {
+func (m *MockAMTExplorer) GetAMTTimeSynchronizationService(ctx context.Context) (timesynchronization.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTTimeSynchronizationService")
+	ret := m.ctrl.Call(m, "GetAMTTimeSynchronizationService", ctx)
 	ret0, _ := ret[0].(timesynchronization.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTTimeSynchronizationService indicates an expected call of GetAMTTimeSynchronizationService.
-func (mr *MockAMTExplorerMockRecorder) GetAMTTimeSynchronizationService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTTimeSynchronizationService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTTimeSynchronizationService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTTimeSynchronizationService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTTimeSynchronizationService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTTimeSynchronizationService), ctx)
 }
 
 // GetAMTUserInitiatedConnectionService mocks base method.
-func (m *MockAMTExplorer) GetAMTUserInitiatedConnectionService() (userinitiatedconnection.Response, error) {
+func (m *MockAMTExplorer) GetAMTUserInitiatedConnectionService(ctx context.Context) (userinitiatedconnection.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTUserInitiatedConnectionService")
+	ret := m.ctrl.Call(m, "GetAMTUserInitiatedConnectionService", ctx)
 	ret0, _ := ret[0].(userinitiatedconnection.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTUserInitiatedConnectionService indicates an expected call of GetAMTUserInitiatedConnectionService.
-func (mr *MockAMTExplorerMockRecorder) GetAMTUserInitiatedConnectionService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTUserInitiatedConnectionService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTUserInitiatedConnectionService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTUserInitiatedConnectionService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTUserInitiatedConnectionService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTUserInitiatedConnectionService), ctx)
 }
 
 // GetAMTWiFiPortConfigurationService mocks base method.
-func (m *MockAMTExplorer) GetAMTWiFiPortConfigurationService() (wifiportconfiguration.Response, error) {
+func (m *MockAMTExplorer) GetAMTWiFiPortConfigurationService(ctx context.Context) (wifiportconfiguration.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTWiFiPortConfigurationService")
+	ret := m.ctrl.Call(m, "GetAMTWiFiPortConfigurationService", ctx)
 	ret0, _ := ret[0].(wifiportconfiguration.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTWiFiPortConfigurationService indicates an expected call of GetAMTWiFiPortConfigurationService.
-func (mr *MockAMTExplorerMockRecorder) GetAMTWiFiPortConfigurationService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetAMTWiFiPortConfigurationService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTWiFiPortConfigurationService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTWiFiPortConfigurationService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTWiFiPortConfigurationService", reflect.TypeOf((*MockAMTExplorer)(nil).GetAMTWiFiPortConfigurationService), ctx)
 }
 
 // GetCIMBIOSElement mocks base method.
-func (m *MockAMTExplorer) GetCIMBIOSElement() (bios.Response, error) {
+func (m *MockAMTExplorer) GetCIMBIOSElement(ctx context.Context) (bios.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMBIOSElement")
+	ret := m.ctrl.Call(m, "GetCIMBIOSElement", ctx)
 	ret0, _ := ret[0].(bios.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMBIOSElement indicates an expected call of GetCIMBIOSElement.
-func (mr *MockAMTExplorerMockRecorder) GetCIMBIOSElement() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMBIOSElement(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMBIOSElement", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMBIOSElement))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMBIOSElement", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMBIOSElement), ctx)
 }
 
 // GetCIMBootConfigSetting mocks base method.
-func (m *MockAMTExplorer) GetCIMBootConfigSetting() (boot0.Response, error) {
+func (m *MockAMTExplorer) GetCIMBootConfigSetting(ctx context.Context) (boot0.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMBootConfigSetting")
+	ret := m.ctrl.Call(m, "GetCIMBootConfigSetting", ctx)
 	ret0, _ := ret[0].(boot0.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMBootConfigSetting indicates an expected call of GetCIMBootConfigSetting.
-func (mr *MockAMTExplorerMockRecorder) GetCIMBootConfigSetting() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMBootConfigSetting(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMBootConfigSetting", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMBootConfigSetting))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMBootConfigSetting", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMBootConfigSetting), ctx)
 }
 
 // GetCIMBootService mocks base method.
-func (m *MockAMTExplorer) GetCIMBootService() (boot0.Response, error) {
+func (m *MockAMTExplorer) GetCIMBootService(ctx context.Context) (boot0.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMBootService")
+	ret := m.ctrl.Call(m, "GetCIMBootService", ctx)
 	ret0, _ := ret[0].(boot0.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMBootService indicates an expected call of GetCIMBootService.
-func (mr *MockAMTExplorerMockRecorder) GetCIMBootService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMBootService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMBootService", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMBootService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMBootService", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMBootService), ctx)
 }
 
 // GetCIMBootSourceSetting mocks base method.
-func (m *MockAMTExplorer) GetCIMBootSourceSetting() (boot0.Response, error) {
+func (m *MockAMTExplorer) GetCIMBootSourceSetting(ctx context.Context) (boot0.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMBootSourceSetting")
+	ret := m.ctrl.Call(m, "GetCIMBootSourceSetting", ctx)
 	ret0, _ := ret[0].(boot0.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMBootSourceSetting indicates an expected call of GetCIMBootSourceSetting.
-func (mr *MockAMTExplorerMockRecorder) GetCIMBootSourceSetting() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMBootSourceSetting(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMBootSourceSetting", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMBootSourceSetting))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMBootSourceSetting", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMBootSourceSetting), ctx)
 }
 
 // GetCIMCard mocks base method.
-func (m *MockAMTExplorer) GetCIMCard() (card.Response, error) {
+func (m *MockAMTExplorer) GetCIMCard(ctx context.Context) (card.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMCard")
+	ret := m.ctrl.Call(m, "GetCIMCard", ctx)
 	ret0, _ := ret[0].(card.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMCard indicates an expected call of GetCIMCard.
-func (mr *MockAMTExplorerMockRecorder) GetCIMCard() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMCard(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMCard", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMCard))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMCard", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMCard), ctx)
 }
 
 // GetCIMChassis mocks base method.
-func (m *MockAMTExplorer) GetCIMChassis() (chassis.Response, error) {
+func (m *MockAMTExplorer) GetCIMChassis(ctx context.Context) (chassis.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMChassis")
+	ret := m.ctrl.Call(m, "GetCIMChassis", ctx)
 	ret0, _ := ret[0].(chassis.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMChassis indicates an expected call of GetCIMChassis.
-func (mr *MockAMTExplorerMockRecorder) GetCIMChassis() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMChassis(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMChassis", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMChassis))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMChassis", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMChassis), ctx)
 }
 
 // GetCIMChip mocks base method.
-func (m *MockAMTExplorer) GetCIMChip() (chip.Response, error) {
+func (m *MockAMTExplorer) GetCIMChip(ctx context.Context) (chip.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMChip")
+	ret := m.ctrl.Call(m, "GetCIMChip", ctx)
 	ret0, _ := ret[0].(chip.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMChip indicates an expected call of GetCIMChip.
-func (mr *MockAMTExplorerMockRecorder) GetCIMChip() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMChip(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMChip", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMChip))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMChip", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMChip), ctx)
 }
 
 // GetCIMComputerSystemPackage mocks base method.
-func (m *MockAMTExplorer) GetCIMComputerSystemPackage() (computer.Response, error) {
+func (m *MockAMTExplorer) GetCIMComputerSystemPackage(ctx context.Context) (computer.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMComputerSystemPackage")
+	ret := m.ctrl.Call(m, "GetCIMComputerSystemPackage", ctx)
 	ret0, _ := ret[0].(computer.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMComputerSystemPackage indicates an expected call of GetCIMComputerSystemPackage.
-func (mr *MockAMTExplorerMockRecorder) GetCIMComputerSystemPackage() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMComputerSystemPackage(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMComputerSystemPackage", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMComputerSystemPackage))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMComputerSystemPackage", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMComputerSystemPackage), ctx)
 }
 
 // GetCIMConcreteDependency mocks base method.
-func (m *MockAMTExplorer) GetCIMConcreteDependency() (concrete.Response, error) {
+func (m *MockAMTExplorer) GetCIMConcreteDependency(ctx context.Context) (concrete.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMConcreteDependency")
+	ret := m.ctrl.Call(m, "GetCIMConcreteDependency", ctx)
 	ret0, _ := ret[0].(concrete.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMConcreteDependency indicates an expected call of GetCIMConcreteDependency.
-func (mr *MockAMTExplorerMockRecorder) GetCIMConcreteDependency() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMConcreteDependency(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMConcreteDependency", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMConcreteDependency))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMConcreteDependency", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMConcreteDependency), ctx)
 }
 
 // GetCIMCredentialContext mocks base method.
-func (m *MockAMTExplorer) GetCIMCredentialContext() (credential.Response, error) {
+func (m *MockAMTExplorer) GetCIMCredentialContext(ctx context.Context) (credential.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMCredentialContext")
+	ret := m.ctrl.Call(m, "GetCIMCredentialContext", ctx)
 	ret0, _ := ret[0].(credential.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMCredentialContext indicates an expected call of GetCIMCredentialContext.
-func (mr *MockAMTExplorerMockRecorder) GetCIMCredentialContext() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMCredentialContext(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMCredentialContext", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMCredentialContext))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMCredentialContext", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMCredentialContext), ctx)
 }
 
 // GetCIMIEEE8021xSettings mocks base method.
-func (m *MockAMTExplorer) GetCIMIEEE8021xSettings() (ieee8021x0.Response, error) {
+func (m *MockAMTExplorer) GetCIMIEEE8021xSettings(ctx context.Context) (ieee8021x0.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMIEEE8021xSettings")
+	ret := m.ctrl.Call(m, "GetCIMIEEE8021xSettings", ctx)
 	ret0, _ := ret[0].(ieee8021x0.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMIEEE8021xSettings indicates an expected call of GetCIMIEEE8021xSettings.
-func (mr *MockAMTExplorerMockRecorder) GetCIMIEEE8021xSettings() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMIEEE8021xSettings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMIEEE8021xSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMIEEE8021xSettings))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMIEEE8021xSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMIEEE8021xSettings), ctx)
 }
 
 // GetCIMKVMRedirectionSAP mocks base method.
-func (m *MockAMTExplorer) GetCIMKVMRedirectionSAP() (kvm.Response, error) {
+func (m *MockAMTExplorer) GetCIMKVMRedirectionSAP(ctx context.Context) (kvm.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMKVMRedirectionSAP")
+	ret := m.ctrl.Call(m, "GetCIMKVMRedirectionSAP", ctx)
 	ret0, _ := ret[0].(kvm.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMKVMRedirectionSAP indicates an expected call of GetCIMKVMRedirectionSAP.
-func (mr *MockAMTExplorerMockRecorder) GetCIMKVMRedirectionSAP() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMKVMRedirectionSAP(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMKVMRedirectionSAP", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMKVMRedirectionSAP))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMKVMRedirectionSAP", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMKVMRedirectionSAP), ctx)
 }
 
 // GetCIMMediaAccessDevice mocks base method.
-func (m *MockAMTExplorer) GetCIMMediaAccessDevice() (mediaaccess.Response, error) {
+func (m *MockAMTExplorer) GetCIMMediaAccessDevice(ctx context.Context) (mediaaccess.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMMediaAccessDevice")
+	ret := m.ctrl.Call(m, "GetCIMMediaAccessDevice", ctx)
 	ret0, _ := ret[0].(mediaaccess.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMMediaAccessDevice indicates an expected call of GetCIMMediaAccessDevice.
-func (mr *MockAMTExplorerMockRecorder) GetCIMMediaAccessDevice() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMMediaAccessDevice(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMMediaAccessDevice", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMMediaAccessDevice))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMMediaAccessDevice", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMMediaAccessDevice), ctx)
 }
 
 // GetCIMPhysicalMemory mocks base method.
-func (m *MockAMTExplorer) GetCIMPhysicalMemory() (physical.Response, error) {
+func (m *MockAMTExplorer) GetCIMPhysicalMemory(ctx context.Context) (physical.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMPhysicalMemory")
+	ret := m.ctrl.Call(m, "GetCIMPhysicalMemory", ctx)
 	ret0, _ := ret[0].(physical.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMPhysicalMemory indicates an expected call of GetCIMPhysicalMemory.
-func (mr *MockAMTExplorerMockRecorder) GetCIMPhysicalMemory() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMPhysicalMemory(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMPhysicalMemory", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMPhysicalMemory))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMPhysicalMemory", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMPhysicalMemory), ctx)
 }
 
 // GetCIMPhysicalPackage mocks base method.
-func (m *MockAMTExplorer) GetCIMPhysicalPackage() (physical.Response, error) {
+func (m *MockAMTExplorer) GetCIMPhysicalPackage(ctx context.Context) (physical.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMPhysicalPackage")
+	ret := m.ctrl.Call(m, "GetCIMPhysicalPackage", ctx)
 	ret0, _ := ret[0].(physical.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMPhysicalPackage indicates an expected call of GetCIMPhysicalPackage.
-func (mr *MockAMTExplorerMockRecorder) GetCIMPhysicalPackage() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMPhysicalPackage(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMPhysicalPackage", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMPhysicalPackage))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMPhysicalPackage", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMPhysicalPackage), ctx)
 }
 
 // GetCIMPowerManagementService mocks base method.
-func (m *MockAMTExplorer) GetCIMPowerManagementService() (power.Response, error) {
+func (m *MockAMTExplorer) GetCIMPowerManagementService(ctx context.Context) (power.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMPowerManagementService")
+	ret := m.ctrl.Call(m, "GetCIMPowerManagementService", ctx)
 	ret0, _ := ret[0].(power.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMPowerManagementService indicates an expected call of GetCIMPowerManagementService.
-func (mr *MockAMTExplorerMockRecorder) GetCIMPowerManagementService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMPowerManagementService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMPowerManagementService", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMPowerManagementService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMPowerManagementService", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMPowerManagementService), ctx)
 }
 
 // GetCIMProcessor mocks base method.
-func (m *MockAMTExplorer) GetCIMProcessor() (processor.Response, error) {
+func (m *MockAMTExplorer) GetCIMProcessor(ctx context.Context) (processor.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMProcessor")
+	ret := m.ctrl.Call(m, "GetCIMProcessor", ctx)
 	ret0, _ := ret[0].(processor.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMProcessor indicates an expected call of GetCIMProcessor.
-func (mr *MockAMTExplorerMockRecorder) GetCIMProcessor() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMProcessor(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMProcessor", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMProcessor))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMProcessor", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMProcessor), ctx)
 }
 
 // GetCIMServiceAvailableToElement mocks base method.
-func (m *MockAMTExplorer) GetCIMServiceAvailableToElement() (service.Response, error) {
+func (m *MockAMTExplorer) GetCIMServiceAvailableToElement(ctx context.Context) (service.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMServiceAvailableToElement")
+	ret := m.ctrl.Call(m, "GetCIMServiceAvailableToElement", ctx)
 	ret0, _ := ret[0].(service.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMServiceAvailableToElement indicates an expected call of GetCIMServiceAvailableToElement.
-func (mr *MockAMTExplorerMockRecorder) GetCIMServiceAvailableToElement() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMServiceAvailableToElement(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMServiceAvailableToElement", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMServiceAvailableToElement))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMServiceAvailableToElement", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMServiceAvailableToElement), ctx)
 }
 
 // GetCIMSoftwareIdentity mocks base method.
-func (m *MockAMTExplorer) GetCIMSoftwareIdentity() (software.Response, error) {
+func (m *MockAMTExplorer) GetCIMSoftwareIdentity(ctx context.Context) (software.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMSoftwareIdentity")
+	ret := m.ctrl.Call(m, "GetCIMSoftwareIdentity", ctx)
 	ret0, _ := ret[0].(software.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMSoftwareIdentity indicates an expected call of GetCIMSoftwareIdentity.
-func (mr *MockAMTExplorerMockRecorder) GetCIMSoftwareIdentity() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMSoftwareIdentity(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMSoftwareIdentity", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMSoftwareIdentity))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMSoftwareIdentity", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMSoftwareIdentity), ctx)
 }
 
 // GetCIMSystemPackaging mocks base method.
-func (m *MockAMTExplorer) GetCIMSystemPackaging() (system.Response, error) {
+func (m *MockAMTExplorer) GetCIMSystemPackaging(ctx context.Context) (system.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMSystemPackaging")
+	ret := m.ctrl.Call(m, "GetCIMSystemPackaging", ctx)
 	ret0, _ := ret[0].(system.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMSystemPackaging indicates an expected call of GetCIMSystemPackaging.
-func (mr *MockAMTExplorerMockRecorder) GetCIMSystemPackaging() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMSystemPackaging(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMSystemPackaging", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMSystemPackaging))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMSystemPackaging", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMSystemPackaging), ctx)
 }
 
 // GetCIMWiFiEndpointSettings mocks base method.
-func (m *MockAMTExplorer) GetCIMWiFiEndpointSettings() (wifi.Response, error) {
+func (m *MockAMTExplorer) GetCIMWiFiEndpointSettings(ctx context.Context) (wifi.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMWiFiEndpointSettings")
+	ret := m.ctrl.Call(m, "GetCIMWiFiEndpointSettings", ctx)
 	ret0, _ := ret[0].(wifi.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMWiFiEndpointSettings indicates an expected call of GetCIMWiFiEndpointSettings.
-func (mr *MockAMTExplorerMockRecorder) GetCIMWiFiEndpointSettings() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMWiFiEndpointSettings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMWiFiEndpointSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMWiFiEndpointSettings))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMWiFiEndpointSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMWiFiEndpointSettings), ctx)
 }
 
 // GetCIMWiFiPort mocks base method.
-func (m *MockAMTExplorer) GetCIMWiFiPort() (wifi.Response, error) {
+func (m *MockAMTExplorer) GetCIMWiFiPort(ctx context.Context) (wifi.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMWiFiPort")
+	ret := m.ctrl.Call(m, "GetCIMWiFiPort", ctx)
 	ret0, _ := ret[0].(wifi.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMWiFiPort indicates an expected call of GetCIMWiFiPort.
-func (mr *MockAMTExplorerMockRecorder) GetCIMWiFiPort() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetCIMWiFiPort(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMWiFiPort", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMWiFiPort))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMWiFiPort", reflect.TypeOf((*MockAMTExplorer)(nil).GetCIMWiFiPort), ctx)
 }
 
 // GetIPS8021xCredentialContext mocks base method.
-func (m *MockAMTExplorer) GetIPS8021xCredentialContext() (ieee8021x1.Response, error) {
+func (m *MockAMTExplorer) GetIPS8021xCredentialContext(ctx context.Context) (ieee8021x1.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPS8021xCredentialContext")
+	ret := m.ctrl.Call(m, "GetIPS8021xCredentialContext", ctx)
 	ret0, _ := ret[0].(ieee8021x1.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPS8021xCredentialContext indicates an expected call of GetIPS8021xCredentialContext.
-func (mr *MockAMTExplorerMockRecorder) GetIPS8021xCredentialContext() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetIPS8021xCredentialContext(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPS8021xCredentialContext", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPS8021xCredentialContext))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPS8021xCredentialContext", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPS8021xCredentialContext), ctx)
 }
 
 // GetIPSAlarmClockOccurrence mocks base method.
-func (m *MockAMTExplorer) GetIPSAlarmClockOccurrence() (alarmclock0.Response, error) {
+func (m *MockAMTExplorer) GetIPSAlarmClockOccurrence(ctx context.Context) (alarmclock0.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPSAlarmClockOccurrence")
+	ret := m.ctrl.Call(m, "GetIPSAlarmClockOccurrence", ctx)
 	ret0, _ := ret[0].(alarmclock0.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPSAlarmClockOccurrence indicates an expected call of GetIPSAlarmClockOccurrence.
-func (mr *MockAMTExplorerMockRecorder) GetIPSAlarmClockOccurrence() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetIPSAlarmClockOccurrence(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSAlarmClockOccurrence", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSAlarmClockOccurrence))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSAlarmClockOccurrence", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSAlarmClockOccurrence), ctx)
 }
 
 // GetIPSHostBasedSetupService mocks base method.
-func (m *MockAMTExplorer) GetIPSHostBasedSetupService() (hostbasedsetup.Response, error) {
+func (m *MockAMTExplorer) GetIPSHostBasedSetupService(ctx context.Context) (hostbasedsetup.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPSHostBasedSetupService")
+	ret := m.ctrl.Call(m, "GetIPSHostBasedSetupService", ctx)
 	ret0, _ := ret[0].(hostbasedsetup.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPSHostBasedSetupService indicates an expected call of GetIPSHostBasedSetupService.
-func (mr *MockAMTExplorerMockRecorder) GetIPSHostBasedSetupService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetIPSHostBasedSetupService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSHostBasedSetupService", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSHostBasedSetupService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSHostBasedSetupService", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSHostBasedSetupService), ctx)
 }
 
 // GetIPSIEEE8021xSettings mocks base method.
-func (m *MockAMTExplorer) GetIPSIEEE8021xSettings() (ieee8021x1.Response, error) {
+func (m *MockAMTExplorer) GetIPSIEEE8021xSettings(ctx context.Context) (ieee8021x1.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPSIEEE8021xSettings")
+	ret := m.ctrl.Call(m, "GetIPSIEEE8021xSettings", ctx)
 	ret0, _ := ret[0].(ieee8021x1.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPSIEEE8021xSettings indicates an expected call of GetIPSIEEE8021xSettings.
-func (mr *MockAMTExplorerMockRecorder) GetIPSIEEE8021xSettings() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetIPSIEEE8021xSettings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSIEEE8021xSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSIEEE8021xSettings))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSIEEE8021xSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSIEEE8021xSettings), ctx)
 }
 
 // GetIPSKVMRedirectionSettings mocks base method.
-func (m *MockAMTExplorer) GetIPSKVMRedirectionSettings() (kvmredirection.Response, error) {
+func (m *MockAMTExplorer) GetIPSKVMRedirectionSettings(ctx context.Context) (kvmredirection.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPSKVMRedirectionSettings")
+	ret := m.ctrl.Call(m, "GetIPSKVMRedirectionSettings", ctx)
 	ret0, _ := ret[0].(kvmredirection.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPSKVMRedirectionSettings indicates an expected call of GetIPSKVMRedirectionSettings.
-func (mr *MockAMTExplorerMockRecorder) GetIPSKVMRedirectionSettings() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetIPSKVMRedirectionSettings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSKVMRedirectionSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSKVMRedirectionSettings))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSKVMRedirectionSettings", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSKVMRedirectionSettings), ctx)
 }
 
 // GetIPSOptInService mocks base method.
-func (m *MockAMTExplorer) GetIPSOptInService() (optin.Response, error) {
+func (m *MockAMTExplorer) GetIPSOptInService(ctx context.Context) (optin.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPSOptInService")
+	ret := m.ctrl.Call(m, "GetIPSOptInService", ctx)
 	ret0, _ := ret[0].(optin.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPSOptInService indicates an expected call of GetIPSOptInService.
-func (mr *MockAMTExplorerMockRecorder) GetIPSOptInService() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetIPSOptInService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSOptInService", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSOptInService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSOptInService", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSOptInService), ctx)
 }
 
 // GetIPSScreenSettingData mocks base method.
-func (m *MockAMTExplorer) GetIPSScreenSettingData() (screensetting.Response, error) {
+func (m *MockAMTExplorer) GetIPSScreenSettingData(ctx context.Context) (screensetting.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPSScreenSettingData")
+	ret := m.ctrl.Call(m, "GetIPSScreenSettingData", ctx)
 	ret0, _ := ret[0].(screensetting.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPSScreenSettingData indicates an expected call of GetIPSScreenSettingData.
-func (mr *MockAMTExplorerMockRecorder) GetIPSScreenSettingData() *gomock.Call {
+func (mr *MockAMTExplorerMockRecorder) GetIPSScreenSettingData(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSScreenSettingData", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSScreenSettingData))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSScreenSettingData", reflect.TypeOf((*MockAMTExplorer)(nil).GetIPSScreenSettingData), ctx)
 }
 
 // MockAMTExplorerFeature is a mock of Feature interface.
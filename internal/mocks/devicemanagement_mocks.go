@@ -11,7 +11,9 @@ package mocks
 
 import (
 	context "context"
+	x509 "crypto/x509"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/device-management-toolkit/console/internal/entity"
 	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
@@ -48,6 +50,22 @@ func (m *MockWSMAN) EXPECT() *MockWSMANMockRecorder {
 	return m.recorder
 }
 
+// CaptureTrace mocks base method.
+func (m *MockWSMAN) CaptureTrace(guid string) (wsman.CaptureInfo, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureTrace", guid)
+	ret0, _ := ret[0].(wsman.CaptureInfo)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CaptureTrace indicates an expected call of CaptureTrace.
+func (mr *MockWSMANMockRecorder) CaptureTrace(guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureTrace", reflect.TypeOf((*MockWSMAN)(nil).CaptureTrace), guid)
+}
+
 // DestroyWsmanClient mocks base method.
 func (m *MockWSMAN) DestroyWsmanClient(device dto.Device) {
 	m.ctrl.T.Helper()
@@ -75,6 +93,36 @@ func (mr *MockWSMANMockRecorder) SetupWsmanClient(device, isRedirection, logMess
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupWsmanClient", reflect.TypeOf((*MockWSMAN)(nil).SetupWsmanClient), device, isRedirection, logMessages)
 }
 
+// StartCapture mocks base method.
+func (m *MockWSMAN) StartCapture(guid string, duration time.Duration) (wsman.CaptureInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartCapture", guid, duration)
+	ret0, _ := ret[0].(wsman.CaptureInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartCapture indicates an expected call of StartCapture.
+func (mr *MockWSMANMockRecorder) StartCapture(guid, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartCapture", reflect.TypeOf((*MockWSMAN)(nil).StartCapture), guid, duration)
+}
+
+// StopCapture mocks base method.
+func (m *MockWSMAN) StopCapture(guid string) (wsman.CaptureInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopCapture", guid)
+	ret0, _ := ret[0].(wsman.CaptureInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopCapture indicates an expected call of StopCapture.
+func (mr *MockWSMANMockRecorder) StopCapture(guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopCapture", reflect.TypeOf((*MockWSMAN)(nil).StopCapture), guid)
+}
+
 // Worker mocks base method.
 func (m *MockWSMAN) Worker() {
 	m.ctrl.T.Helper()
@@ -155,6 +203,83 @@ func (mr *MockWebSocketConnMockRecorder) WriteMessage(messageType, data any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteMessage", reflect.TypeOf((*MockWebSocketConn)(nil).WriteMessage), messageType, data)
 }
 
+// MockSites is a mock of Sites interface.
+type MockSites struct {
+	ctrl     *gomock.Controller
+	recorder *MockSitesMockRecorder
+	isgomock struct{}
+}
+
+// MockSitesMockRecorder is the mock recorder for MockSites.
+type MockSitesMockRecorder struct {
+	mock *MockSites
+}
+
+// NewMockSites creates a new mock instance.
+func NewMockSites(ctrl *gomock.Controller) *MockSites {
+	mock := &MockSites{ctrl: ctrl}
+	mock.recorder = &MockSitesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSites) EXPECT() *MockSitesMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockSites) Apply(ctx context.Context, device entity.Device) (entity.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, device)
+	ret0, _ := ret[0].(entity.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockSitesMockRecorder) Apply(ctx, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockSites)(nil).Apply), ctx, device)
+}
+
+// MockDevicesRevocation is a mock of Revocation interface.
+type MockDevicesRevocation struct {
+	ctrl     *gomock.Controller
+	recorder *MockDevicesRevocationMockRecorder
+	isgomock struct{}
+}
+
+// MockDevicesRevocationMockRecorder is the mock recorder for MockDevicesRevocation.
+type MockDevicesRevocationMockRecorder struct {
+	mock *MockDevicesRevocation
+}
+
+// NewMockDevicesRevocation creates a new mock instance.
+func NewMockDevicesRevocation(ctrl *gomock.Controller) *MockDevicesRevocation {
+	mock := &MockDevicesRevocation{ctrl: ctrl}
+	mock.recorder = &MockDevicesRevocationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDevicesRevocation) EXPECT() *MockDevicesRevocationMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockDevicesRevocation) Check(ctx context.Context, cert *x509.Certificate, intermediates []*x509.Certificate) dto.RevocationStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, cert, intermediates)
+	ret0, _ := ret[0].(dto.RevocationStatus)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockDevicesRevocationMockRecorder) Check(ctx, cert, intermediates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockDevicesRevocation)(nil).Check), ctx, cert, intermediates)
+}
+
 // MockRedirection is a mock of Redirection interface.
 type MockRedirection struct {
 	ctrl     *gomock.Controller
@@ -289,6 +414,22 @@ func (mr *MockDeviceManagementRepositoryMockRecorder) Delete(ctx, guid, tenantID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeviceManagementRepository)(nil).Delete), ctx, guid, tenantID)
 }
 
+// DeleteCascade mocks base method.
+func (m *MockDeviceManagementRepository) DeleteCascade(ctx context.Context, guid, tenantID string) (map[string]int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCascade", ctx, guid, tenantID)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeleteCascade indicates an expected call of DeleteCascade.
+func (mr *MockDeviceManagementRepositoryMockRecorder) DeleteCascade(ctx, guid, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCascade", reflect.TypeOf((*MockDeviceManagementRepository)(nil).DeleteCascade), ctx, guid, tenantID)
+}
+
 // Get mocks base method.
 func (m *MockDeviceManagementRepository) Get(ctx context.Context, top, skip int, tenantID string) ([]entity.Device, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +445,21 @@ func (mr *MockDeviceManagementRepositoryMockRecorder) Get(ctx, top, skip, tenant
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDeviceManagementRepository)(nil).Get), ctx, top, skip, tenantID)
 }
 
+// GetByAttributes mocks base method.
+func (m *MockDeviceManagementRepository) GetByAttributes(ctx context.Context, attributes map[string]string, top, skip int, tenantID string) ([]entity.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAttributes", ctx, attributes, top, skip, tenantID)
+	ret0, _ := ret[0].([]entity.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAttributes indicates an expected call of GetByAttributes.
+func (mr *MockDeviceManagementRepositoryMockRecorder) GetByAttributes(ctx, attributes, top, skip, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAttributes", reflect.TypeOf((*MockDeviceManagementRepository)(nil).GetByAttributes), ctx, attributes, top, skip, tenantID)
+}
+
 // GetByColumn mocks base method.
 func (m *MockDeviceManagementRepository) GetByColumn(ctx context.Context, columnName, queryValue, tenantID string) ([]entity.Device, error) {
 	m.ctrl.T.Helper()
@@ -394,6 +550,22 @@ func (mr *MockDeviceManagementRepositoryMockRecorder) Insert(ctx, d any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockDeviceManagementRepository)(nil).Insert), ctx, d)
 }
 
+// Merge mocks base method.
+func (m *MockDeviceManagementRepository) Merge(ctx context.Context, d *entity.Device, duplicates []string) (map[string]int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, d, duplicates)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockDeviceManagementRepositoryMockRecorder) Merge(ctx, d, duplicates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockDeviceManagementRepository)(nil).Merge), ctx, d, duplicates)
+}
+
 // Update mocks base method.
 func (m *MockDeviceManagementRepository) Update(ctx context.Context, d *entity.Device) (bool, error) {
 	m.ctrl.T.Helper()
@@ -409,6 +581,21 @@ func (mr *MockDeviceManagementRepositoryMockRecorder) Update(ctx, d any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDeviceManagementRepository)(nil).Update), ctx, d)
 }
 
+// UpdateControlMode mocks base method.
+func (m *MockDeviceManagementRepository) UpdateControlMode(ctx context.Context, guid, tenantID, controlMode, provisioningState string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateControlMode", ctx, guid, tenantID, controlMode, provisioningState)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateControlMode indicates an expected call of UpdateControlMode.
+func (mr *MockDeviceManagementRepositoryMockRecorder) UpdateControlMode(ctx, guid, tenantID, controlMode, provisioningState any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateControlMode", reflect.TypeOf((*MockDeviceManagementRepository)(nil).UpdateControlMode), ctx, guid, tenantID, controlMode, provisioningState)
+}
+
 // MockDeviceManagementFeature is a mock of Feature interface.
 type MockDeviceManagementFeature struct {
 	ctrl     *gomock.Controller
@@ -448,6 +635,21 @@ func (mr *MockDeviceManagementFeatureMockRecorder) AddCertificate(c, guid, certI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCertificate", reflect.TypeOf((*MockDeviceManagementFeature)(nil).AddCertificate), c, guid, certInfo)
 }
 
+// AddDeviceUser mocks base method.
+func (m *MockDeviceManagementFeature) AddDeviceUser(c context.Context, guid string, req dto.DeviceUserRequest) (dto.DeviceUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeviceUser", c, guid, req)
+	ret0, _ := ret[0].(dto.DeviceUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDeviceUser indicates an expected call of AddDeviceUser.
+func (mr *MockDeviceManagementFeatureMockRecorder) AddDeviceUser(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeviceUser", reflect.TypeOf((*MockDeviceManagementFeature)(nil).AddDeviceUser), c, guid, req)
+}
+
 // CancelUserConsent mocks base method.
 func (m *MockDeviceManagementFeature) CancelUserConsent(ctx context.Context, guid string) (dto.UserConsentMessage, error) {
 	m.ctrl.T.Helper()
@@ -463,6 +665,21 @@ func (mr *MockDeviceManagementFeatureMockRecorder) CancelUserConsent(ctx, guid a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserConsent", reflect.TypeOf((*MockDeviceManagementFeature)(nil).CancelUserConsent), ctx, guid)
 }
 
+// CleanupCertificates mocks base method.
+func (m *MockDeviceManagementFeature) CleanupCertificates(c context.Context, guid string, dryRun bool) (dto.CertificateCleanup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupCertificates", c, guid, dryRun)
+	ret0, _ := ret[0].(dto.CertificateCleanup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanupCertificates indicates an expected call of CleanupCertificates.
+func (mr *MockDeviceManagementFeatureMockRecorder) CleanupCertificates(c, guid, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupCertificates", reflect.TypeOf((*MockDeviceManagementFeature)(nil).CleanupCertificates), c, guid, dryRun)
+}
+
 // CreateAlarmOccurrences mocks base method.
 func (m *MockDeviceManagementFeature) CreateAlarmOccurrences(ctx context.Context, guid string, alarm dto.AlarmClockOccurrenceInput) (dto.AddAlarmOutput, error) {
 	m.ctrl.T.Helper()
@@ -506,6 +723,21 @@ func (mr *MockDeviceManagementFeatureMockRecorder) DeleteAlarmOccurrences(ctx, g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlarmOccurrences", reflect.TypeOf((*MockDeviceManagementFeature)(nil).DeleteAlarmOccurrences), ctx, guid, instanceID)
 }
 
+// DeleteWithCleanup mocks base method.
+func (m *MockDeviceManagementFeature) DeleteWithCleanup(ctx context.Context, guid, tenantID string, cleanup bool) (dto.DeviceDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithCleanup", ctx, guid, tenantID, cleanup)
+	ret0, _ := ret[0].(dto.DeviceDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWithCleanup indicates an expected call of DeleteWithCleanup.
+func (mr *MockDeviceManagementFeatureMockRecorder) DeleteWithCleanup(ctx, guid, tenantID, cleanup any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithCleanup", reflect.TypeOf((*MockDeviceManagementFeature)(nil).DeleteWithCleanup), ctx, guid, tenantID, cleanup)
+}
+
 // Get mocks base method.
 func (m *MockDeviceManagementFeature) Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Device, error) {
 	m.ctrl.T.Helper()
@@ -566,6 +798,21 @@ func (mr *MockDeviceManagementFeatureMockRecorder) GetBootSourceSetting(c, guid
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBootSourceSetting", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetBootSourceSetting), c, guid)
 }
 
+// GetByAttributes mocks base method.
+func (m *MockDeviceManagementFeature) GetByAttributes(ctx context.Context, attributes map[string]string, top, skip int, tenantID string) ([]dto.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAttributes", ctx, attributes, top, skip, tenantID)
+	ret0, _ := ret[0].([]dto.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAttributes indicates an expected call of GetByAttributes.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetByAttributes(ctx, attributes, top, skip, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAttributes", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetByAttributes), ctx, attributes, top, skip, tenantID)
+}
+
 // GetByColumn mocks base method.
 func (m *MockDeviceManagementFeature) GetByColumn(ctx context.Context, columnName, queryValue, tenantID string) ([]dto.Device, error) {
 	m.ctrl.T.Helper()
@@ -611,6 +858,21 @@ func (mr *MockDeviceManagementFeatureMockRecorder) GetByTags(ctx, tags, method,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTags", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetByTags), ctx, tags, method, limit, offset, tenantID)
 }
 
+// GetCertificateInventory mocks base method.
+func (m *MockDeviceManagementFeature) GetCertificateInventory(ctx context.Context, tenantID string, q dto.CertificateInventoryQuery) (dto.CertificateInventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateInventory", ctx, tenantID, q)
+	ret0, _ := ret[0].(dto.CertificateInventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificateInventory indicates an expected call of GetCertificateInventory.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetCertificateInventory(ctx, tenantID, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateInventory", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetCertificateInventory), ctx, tenantID, q)
+}
+
 // GetCertificates mocks base method.
 func (m *MockDeviceManagementFeature) GetCertificates(c context.Context, guid string) (dto.SecuritySettings, error) {
 	m.ctrl.T.Helper()
@@ -626,6 +888,21 @@ func (mr *MockDeviceManagementFeatureMockRecorder) GetCertificates(c, guid any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificates", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetCertificates), c, guid)
 }
 
+// GetCrashReport mocks base method.
+func (m *MockDeviceManagementFeature) GetCrashReport(c context.Context, guid string) (dto.CrashReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCrashReport", c, guid)
+	ret0, _ := ret[0].(dto.CrashReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCrashReport indicates an expected call of GetCrashReport.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetCrashReport(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrashReport", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetCrashReport), c, guid)
+}
+
 // GetCount mocks base method.
 func (m *MockDeviceManagementFeature) GetCount(arg0 context.Context, arg1 string) (int, error) {
 	m.ctrl.T.Helper()
@@ -656,6 +933,36 @@ func (mr *MockDeviceManagementFeatureMockRecorder) GetDeviceCertificate(c, guid
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceCertificate", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetDeviceCertificate), c, guid)
 }
 
+// GetDeviceLock mocks base method.
+func (m *MockDeviceManagementFeature) GetDeviceLock(c context.Context, guid string) (dto.DeviceLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceLock", c, guid)
+	ret0, _ := ret[0].(dto.DeviceLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceLock indicates an expected call of GetDeviceLock.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetDeviceLock(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceLock", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetDeviceLock), c, guid)
+}
+
+// GetDeviceUsers mocks base method.
+func (m *MockDeviceManagementFeature) GetDeviceUsers(c context.Context, guid string) ([]dto.DeviceUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceUsers", c, guid)
+	ret0, _ := ret[0].([]dto.DeviceUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceUsers indicates an expected call of GetDeviceUsers.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetDeviceUsers(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceUsers", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetDeviceUsers), c, guid)
+}
+
 // GetDiskInfo mocks base method.
 func (m *MockDeviceManagementFeature) GetDiskInfo(c context.Context, guid string) (dto.DiskInfo, error) {
 	m.ctrl.T.Helper()
@@ -733,18 +1040,246 @@ func (mr *MockDeviceManagementFeatureMockRecorder) GetGeneralSettings(ctx, guid
 }
 
 // GetHardwareInfo mocks base method.
-func (m *MockDeviceManagementFeature) GetHardwareInfo(ctx context.Context, guid string) (dto.HardwareInfo, error) {
+func (m *MockDeviceManagementFeature) GetHardwareInfo(ctx context.Context, guid string, sections ...string) (dto.HardwareInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHardwareInfo", ctx, guid)
+	varargs := []any{ctx, guid}
+	for _, a := range sections {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHardwareInfo", varargs...)
 	ret0, _ := ret[0].(dto.HardwareInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHardwareInfo indicates an expected call of GetHardwareInfo.
-func (mr *MockDeviceManagementFeatureMockRecorder) GetHardwareInfo(ctx, guid any) *gomock.Call {
+func (mr *MockDeviceManagementFeatureMockRecorder) GetHardwareInfo(ctx, guid any, sections ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, guid}, sections...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardwareInfo", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetHardwareInfo), varargs...)
+}
+
+// GetKerberosSettings mocks base method.
+func (m *MockDeviceManagementFeature) GetKerberosSettings(c context.Context, guid string) (dto.KerberosSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKerberosSettings", c, guid)
+	ret0, _ := ret[0].(dto.KerberosSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKerberosSettings indicates an expected call of GetKerberosSettings.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetKerberosSettings(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKerberosSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetKerberosSettings), c, guid)
+}
+
+// GetLinkSettings mocks base method.
+func (m *MockDeviceManagementFeature) GetLinkSettings(c context.Context, guid string) ([]dto.LinkSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinkSettings", c, guid)
+	ret0, _ := ret[0].([]dto.LinkSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLinkSettings indicates an expected call of GetLinkSettings.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetLinkSettings(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinkSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetLinkSettings), c, guid)
+}
+
+// GetPresence mocks base method.
+func (m *MockDeviceManagementFeature) GetPresence(c context.Context, guid string) []dto.Presence {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresence", c, guid)
+	ret0, _ := ret[0].([]dto.Presence)
+	return ret0
+}
+
+// GetPresence indicates an expected call of GetPresence.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetPresence(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresence", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetPresence), c, guid)
+}
+
+// GetRedirectionSettings mocks base method.
+func (m *MockDeviceManagementFeature) GetRedirectionSettings(c context.Context, guid string) (dto.RedirectionSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedirectionSettings", c, guid)
+	ret0, _ := ret[0].(dto.RedirectionSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedirectionSettings indicates an expected call of GetRedirectionSettings.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetRedirectionSettings(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedirectionSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetRedirectionSettings), c, guid)
+}
+
+// GetWiFiPortSettings mocks base method.
+func (m *MockDeviceManagementFeature) GetWiFiPortSettings(c context.Context, guid string) (dto.WiFiPortSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWiFiPortSettings", c, guid)
+	ret0, _ := ret[0].(dto.WiFiPortSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWiFiPortSettings indicates an expected call of GetWiFiPortSettings.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetWiFiPortSettings(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWiFiPortSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetWiFiPortSettings), c, guid)
+}
+
+// GetWiFiProfiles mocks base method.
+func (m *MockDeviceManagementFeature) GetWiFiProfiles(c context.Context, guid string) ([]dto.WiFiProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWiFiProfiles", c, guid)
+	ret0, _ := ret[0].([]dto.WiFiProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWiFiProfiles indicates an expected call of GetWiFiProfiles.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetWiFiProfiles(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWiFiProfiles", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetWiFiProfiles), c, guid)
+}
+
+// LockDevice mocks base method.
+func (m *MockDeviceManagementFeature) LockDevice(c context.Context, guid string, req dto.DeviceLockRequest) (dto.DeviceLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockDevice", c, guid, req)
+	ret0, _ := ret[0].(dto.DeviceLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockDevice indicates an expected call of LockDevice.
+func (mr *MockDeviceManagementFeatureMockRecorder) LockDevice(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockDevice", reflect.TypeOf((*MockDeviceManagementFeature)(nil).LockDevice), c, guid, req)
+}
+
+// PingPresence mocks base method.
+func (m *MockDeviceManagementFeature) PingPresence(c context.Context, guid, user string, ping dto.PresencePing) ([]dto.Presence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PingPresence", c, guid, user, ping)
+	ret0, _ := ret[0].([]dto.Presence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PingPresence indicates an expected call of PingPresence.
+func (mr *MockDeviceManagementFeatureMockRecorder) PingPresence(c, guid, user, ping any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PingPresence", reflect.TypeOf((*MockDeviceManagementFeature)(nil).PingPresence), c, guid, user, ping)
+}
+
+// PlanDelete mocks base method.
+func (m *MockDeviceManagementFeature) PlanDelete(ctx context.Context, guid, tenantID string) (dto.DryRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanDelete", ctx, guid, tenantID)
+	ret0, _ := ret[0].(dto.DryRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanDelete indicates an expected call of PlanDelete.
+func (mr *MockDeviceManagementFeatureMockRecorder) PlanDelete(ctx, guid, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanDelete", reflect.TypeOf((*MockDeviceManagementFeature)(nil).PlanDelete), ctx, guid, tenantID)
+}
+
+// QueryAuditLog mocks base method.
+func (m *MockDeviceManagementFeature) QueryAuditLog(c context.Context, guid string, query dto.AuditLogQuery) (dto.AuditLogPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryAuditLog", c, guid, query)
+	ret0, _ := ret[0].(dto.AuditLogPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryAuditLog indicates an expected call of QueryAuditLog.
+func (mr *MockDeviceManagementFeatureMockRecorder) QueryAuditLog(c, guid, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAuditLog", reflect.TypeOf((*MockDeviceManagementFeature)(nil).QueryAuditLog), c, guid, query)
+}
+
+// RemoveDeviceUser mocks base method.
+func (m *MockDeviceManagementFeature) RemoveDeviceUser(c context.Context, guid string, handle int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDeviceUser", c, guid, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDeviceUser indicates an expected call of RemoveDeviceUser.
+func (mr *MockDeviceManagementFeatureMockRecorder) RemoveDeviceUser(c, guid, handle any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardwareInfo", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetHardwareInfo), ctx, guid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeviceUser", reflect.TypeOf((*MockDeviceManagementFeature)(nil).RemoveDeviceUser), c, guid, handle)
+}
+
+// ReorderWiFiProfiles mocks base method.
+func (m *MockDeviceManagementFeature) ReorderWiFiProfiles(c context.Context, guid string, order dto.WiFiProfileOrder) ([]dto.WiFiProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderWiFiProfiles", c, guid, order)
+	ret0, _ := ret[0].([]dto.WiFiProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderWiFiProfiles indicates an expected call of ReorderWiFiProfiles.
+func (mr *MockDeviceManagementFeatureMockRecorder) ReorderWiFiProfiles(c, guid, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderWiFiProfiles", reflect.TypeOf((*MockDeviceManagementFeature)(nil).ReorderWiFiProfiles), c, guid, order)
+}
+
+// SelectKVMDisplay mocks base method.
+func (m *MockDeviceManagementFeature) SelectKVMDisplay(c context.Context, guid string, sel dto.KVMDisplaySelection) (dto.KVMDisplaySelected, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectKVMDisplay", c, guid, sel)
+	ret0, _ := ret[0].(dto.KVMDisplaySelected)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectKVMDisplay indicates an expected call of SelectKVMDisplay.
+func (mr *MockDeviceManagementFeatureMockRecorder) SelectKVMDisplay(c, guid, sel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectKVMDisplay", reflect.TypeOf((*MockDeviceManagementFeature)(nil).SelectKVMDisplay), c, guid, sel)
+}
+
+// SetKVMLimits mocks base method.
+func (m *MockDeviceManagementFeature) SetKVMLimits(c context.Context, limits dto.KVMLimits) (dto.KVMLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKVMLimits", c, limits)
+	ret0, _ := ret[0].(dto.KVMLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetKVMLimits indicates an expected call of SetKVMLimits.
+func (mr *MockDeviceManagementFeatureMockRecorder) SetKVMLimits(c, limits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKVMLimits", reflect.TypeOf((*MockDeviceManagementFeature)(nil).SetKVMLimits), c, limits)
+}
+
+// SetKerberosSettings mocks base method.
+func (m *MockDeviceManagementFeature) SetKerberosSettings(c context.Context, guid string, req dto.KerberosSettingsRequest) (dto.KerberosSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKerberosSettings", c, guid, req)
+	ret0, _ := ret[0].(dto.KerberosSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetKerberosSettings indicates an expected call of SetKerberosSettings.
+func (mr *MockDeviceManagementFeatureMockRecorder) SetKerberosSettings(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKerberosSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).SetKerberosSettings), c, guid, req)
 }
 
 // SetLinkPreference mocks base method.
@@ -762,6 +1297,152 @@ func (mr *MockDeviceManagementFeatureMockRecorder) SetLinkPreference(c, guid, re
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLinkPreference", reflect.TypeOf((*MockDeviceManagementFeature)(nil).SetLinkPreference), c, guid, req)
 }
 
+// GetWsmanCaptureTrace mocks base method.
+func (m *MockDeviceManagementFeature) GetWsmanCaptureTrace(c context.Context, guid string) (dto.WsmanCapture, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWsmanCaptureTrace", c, guid)
+	ret0, _ := ret[0].(dto.WsmanCapture)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWsmanCaptureTrace indicates an expected call of GetWsmanCaptureTrace.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetWsmanCaptureTrace(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWsmanCaptureTrace", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetWsmanCaptureTrace), c, guid)
+}
+
+// SetLinkSettings mocks base method.
+func (m *MockDeviceManagementFeature) SetLinkSettings(c context.Context, guid string, req dto.LinkSettingsRequest) (dto.LinkSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLinkSettings", c, guid, req)
+	ret0, _ := ret[0].(dto.LinkSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLinkSettings indicates an expected call of SetLinkSettings.
+func (mr *MockDeviceManagementFeatureMockRecorder) SetLinkSettings(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLinkSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).SetLinkSettings), c, guid, req)
+}
+
+// SetRedirectionSettings mocks base method.
+func (m *MockDeviceManagementFeature) SetRedirectionSettings(c context.Context, guid string, settings dto.RedirectionSettings) (dto.RedirectionSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRedirectionSettings", c, guid, settings)
+	ret0, _ := ret[0].(dto.RedirectionSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRedirectionSettings indicates an expected call of SetRedirectionSettings.
+func (mr *MockDeviceManagementFeatureMockRecorder) SetRedirectionSettings(c, guid, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRedirectionSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).SetRedirectionSettings), c, guid, settings)
+}
+
+// SetTLSSettings mocks base method.
+func (m *MockDeviceManagementFeature) SetTLSSettings(c context.Context, guid string, req dto.TLSSettingsRequest) (dto.SettingDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTLSSettings", c, guid, req)
+	ret0, _ := ret[0].(dto.SettingDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTLSSettings indicates an expected call of SetTLSSettings.
+func (mr *MockDeviceManagementFeatureMockRecorder) SetTLSSettings(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTLSSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).SetTLSSettings), c, guid, req)
+}
+
+// SetWiFiPortSettings mocks base method.
+func (m *MockDeviceManagementFeature) SetWiFiPortSettings(c context.Context, guid string, req dto.WiFiPortSettingsRequest) (dto.WiFiPortSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWiFiPortSettings", c, guid, req)
+	ret0, _ := ret[0].(dto.WiFiPortSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWiFiPortSettings indicates an expected call of SetWiFiPortSettings.
+func (mr *MockDeviceManagementFeatureMockRecorder) SetWiFiPortSettings(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWiFiPortSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).SetWiFiPortSettings), c, guid, req)
+}
+
+// Start mocks base method.
+func (m *MockDeviceManagementFeature) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockDeviceManagementFeatureMockRecorder) Start(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockDeviceManagementFeature)(nil).Start), ctx)
+}
+
+// StartWsmanCapture mocks base method.
+func (m *MockDeviceManagementFeature) StartWsmanCapture(c context.Context, guid string, req dto.WsmanCaptureRequest) (dto.WsmanCapture, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartWsmanCapture", c, guid, req)
+	ret0, _ := ret[0].(dto.WsmanCapture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartWsmanCapture indicates an expected call of StartWsmanCapture.
+func (mr *MockDeviceManagementFeatureMockRecorder) StartWsmanCapture(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWsmanCapture", reflect.TypeOf((*MockDeviceManagementFeature)(nil).StartWsmanCapture), c, guid, req)
+}
+
+// StopWsmanCapture mocks base method.
+func (m *MockDeviceManagementFeature) StopWsmanCapture(c context.Context, guid string) (dto.WsmanCapture, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopWsmanCapture", c, guid)
+	ret0, _ := ret[0].(dto.WsmanCapture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopWsmanCapture indicates an expected call of StopWsmanCapture.
+func (mr *MockDeviceManagementFeatureMockRecorder) StopWsmanCapture(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopWsmanCapture", reflect.TypeOf((*MockDeviceManagementFeature)(nil).StopWsmanCapture), c, guid)
+}
+
+// StreamAuditLog mocks base method.
+func (m *MockDeviceManagementFeature) StreamAuditLog(c context.Context, guid string, emit func(dto.AuditLogEntry) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamAuditLog", c, guid, emit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamAuditLog indicates an expected call of StreamAuditLog.
+func (mr *MockDeviceManagementFeatureMockRecorder) StreamAuditLog(c, guid, emit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAuditLog", reflect.TypeOf((*MockDeviceManagementFeature)(nil).StreamAuditLog), c, guid, emit)
+}
+
+// GetKVMLimits mocks base method.
+func (m *MockDeviceManagementFeature) GetKVMLimits(c context.Context) dto.KVMLimits {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKVMLimits", c)
+	ret0, _ := ret[0].(dto.KVMLimits)
+	return ret0
+}
+
+// GetKVMLimits indicates an expected call of GetKVMLimits.
+func (mr *MockDeviceManagementFeatureMockRecorder) GetKVMLimits(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKVMLimits", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetKVMLimits), c)
+}
+
 // GetKVMScreenSettings mocks base method.
 func (m *MockDeviceManagementFeature) GetKVMScreenSettings(c context.Context, guid string) (dto.KVMScreenSettings, error) {
 	m.ctrl.T.Helper()
@@ -778,18 +1459,23 @@ func (mr *MockDeviceManagementFeatureMockRecorder) GetKVMScreenSettings(c, guid
 }
 
 // GetNetworkSettings mocks base method.
-func (m *MockDeviceManagementFeature) GetNetworkSettings(c context.Context, guid string) (dto.NetworkSettings, error) {
+func (m *MockDeviceManagementFeature) GetNetworkSettings(c context.Context, guid string, sections ...string) (dto.NetworkSettings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNetworkSettings", c, guid)
+	varargs := []any{c, guid}
+	for _, a := range sections {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkSettings", varargs...)
 	ret0, _ := ret[0].(dto.NetworkSettings)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNetworkSettings indicates an expected call of GetNetworkSettings.
-func (mr *MockDeviceManagementFeatureMockRecorder) GetNetworkSettings(c, guid any) *gomock.Call {
+func (mr *MockDeviceManagementFeatureMockRecorder) GetNetworkSettings(c, guid any, sections ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetNetworkSettings), c, guid)
+	varargs := append([]any{c, guid}, sections...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).GetNetworkSettings), varargs...)
 }
 
 // GetPowerCapabilities mocks base method.
@@ -883,18 +1569,77 @@ func (mr *MockDeviceManagementFeatureMockRecorder) Insert(ctx, d any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockDeviceManagementFeature)(nil).Insert), ctx, d)
 }
 
+// MergeDevices mocks base method.
+func (m *MockDeviceManagementFeature) MergeDevices(ctx context.Context, guid, tenantID string, duplicates []string, dryRun bool) (dto.DeviceMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeDevices", ctx, guid, tenantID, duplicates, dryRun)
+	ret0, _ := ret[0].(dto.DeviceMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeDevices indicates an expected call of MergeDevices.
+func (mr *MockDeviceManagementFeatureMockRecorder) MergeDevices(ctx, guid, tenantID, duplicates, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDevices", reflect.TypeOf((*MockDeviceManagementFeature)(nil).MergeDevices), ctx, guid, tenantID, duplicates, dryRun)
+}
+
+// Patch mocks base method.
+func (m *MockDeviceManagementFeature) Patch(ctx context.Context, guid, tenantID string, p dto.DevicePatch) (*dto.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, guid, tenantID, p)
+	ret0, _ := ret[0].(*dto.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockDeviceManagementFeatureMockRecorder) Patch(ctx, guid, tenantID, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockDeviceManagementFeature)(nil).Patch), ctx, guid, tenantID, p)
+}
+
+// HasActiveRedirection mocks base method.
+func (m *MockDeviceManagementFeature) HasActiveRedirection(guid string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasActiveRedirection", guid)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasActiveRedirection indicates an expected call of HasActiveRedirection.
+func (mr *MockDeviceManagementFeatureMockRecorder) HasActiveRedirection(guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasActiveRedirection", reflect.TypeOf((*MockDeviceManagementFeature)(nil).HasActiveRedirection), guid)
+}
+
+// RefreshControlMode mocks base method.
+func (m *MockDeviceManagementFeature) RefreshControlMode(ctx context.Context, guid string) (*dto.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshControlMode", ctx, guid)
+	ret0, _ := ret[0].(*dto.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshControlMode indicates an expected call of RefreshControlMode.
+func (mr *MockDeviceManagementFeatureMockRecorder) RefreshControlMode(ctx, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshControlMode", reflect.TypeOf((*MockDeviceManagementFeature)(nil).RefreshControlMode), ctx, guid)
+}
+
 // Redirect mocks base method.
-func (m *MockDeviceManagementFeature) Redirect(ctx context.Context, conn *websocket.Conn, guid, mode string) error {
+func (m *MockDeviceManagementFeature) Redirect(ctx context.Context, conn *websocket.Conn, guid, mode string, limits dto.KVMLimits, options dto.KVMSessionOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Redirect", ctx, conn, guid, mode)
+	ret := m.ctrl.Call(m, "Redirect", ctx, conn, guid, mode, limits, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Redirect indicates an expected call of Redirect.
-func (mr *MockDeviceManagementFeatureMockRecorder) Redirect(ctx, conn, guid, mode any) *gomock.Call {
+func (mr *MockDeviceManagementFeatureMockRecorder) Redirect(ctx, conn, guid, mode, limits, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redirect", reflect.TypeOf((*MockDeviceManagementFeature)(nil).Redirect), ctx, conn, guid, mode)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redirect", reflect.TypeOf((*MockDeviceManagementFeature)(nil).Redirect), ctx, conn, guid, mode, limits, options)
 }
 
 // SendConsentCode mocks base method.
@@ -973,6 +1718,20 @@ func (mr *MockDeviceManagementFeatureMockRecorder) SetKVMScreenSettings(c, guid,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKVMScreenSettings", reflect.TypeOf((*MockDeviceManagementFeature)(nil).SetKVMScreenSettings), c, guid, req)
 }
 
+// UnlockDevice mocks base method.
+func (m *MockDeviceManagementFeature) UnlockDevice(c context.Context, guid string, force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockDevice", c, guid, force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockDevice indicates an expected call of UnlockDevice.
+func (mr *MockDeviceManagementFeatureMockRecorder) UnlockDevice(c, guid, force any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockDevice", reflect.TypeOf((*MockDeviceManagementFeature)(nil).UnlockDevice), c, guid, force)
+}
+
 // Update mocks base method.
 func (m *MockDeviceManagementFeature) Update(ctx context.Context, d *dto.Device) (*dto.Device, error) {
 	m.ctrl.T.Helper()
@@ -987,3 +1746,18 @@ func (mr *MockDeviceManagementFeatureMockRecorder) Update(ctx, d any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDeviceManagementFeature)(nil).Update), ctx, d)
 }
+
+// UpdateDeviceUser mocks base method.
+func (m *MockDeviceManagementFeature) UpdateDeviceUser(c context.Context, guid string, handle int, req dto.DeviceUserRequest) (dto.DeviceUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeviceUser", c, guid, handle, req)
+	ret0, _ := ret[0].(dto.DeviceUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDeviceUser indicates an expected call of UpdateDeviceUser.
+func (mr *MockDeviceManagementFeatureMockRecorder) UpdateDeviceUser(c, guid, handle, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeviceUser", reflect.TypeOf((*MockDeviceManagementFeature)(nil).UpdateDeviceUser), c, guid, handle, req)
+}
@@ -11,6 +11,7 @@ package mocks
 
 import (
 	context "context"
+	x509 "crypto/x509"
 	reflect "reflect"
 
 	entity "github.com/device-management-toolkit/console/internal/entity"
@@ -289,3 +290,41 @@ func (mr *MockDomainsFeatureMockRecorder) Update(ctx, d any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDomainsFeature)(nil).Update), ctx, d)
 }
+
+// MockDomainsRevocation is a mock of Revocation interface.
+type MockDomainsRevocation struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainsRevocationMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainsRevocationMockRecorder is the mock recorder for MockDomainsRevocation.
+type MockDomainsRevocationMockRecorder struct {
+	mock *MockDomainsRevocation
+}
+
+// NewMockDomainsRevocation creates a new mock instance.
+func NewMockDomainsRevocation(ctrl *gomock.Controller) *MockDomainsRevocation {
+	mock := &MockDomainsRevocation{ctrl: ctrl}
+	mock.recorder = &MockDomainsRevocationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainsRevocation) EXPECT() *MockDomainsRevocationMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockDomainsRevocation) Check(ctx context.Context, cert *x509.Certificate, intermediates []*x509.Certificate) dto.RevocationStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, cert, intermediates)
+	ret0, _ := ret[0].(dto.RevocationStatus)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockDomainsRevocationMockRecorder) Check(ctx, cert, intermediates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockDomainsRevocation)(nil).Check), ctx, cert, intermediates)
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source ./internal/usecase/profiles/interfaces.go -package mocks -mock_names Repository=MockProfilesRepository,Feature=MockProfilesFeature
+//	mockgen -source ./internal/usecase/profiles/interfaces.go -package mocks -mock_names Repository=MockProfilesRepository,Devices=MockProfilesDevices,Feature=MockProfilesFeature
 //
 
 // Package mocks is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 
 	entity "github.com/device-management-toolkit/console/internal/entity"
 	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	config "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/config"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -132,6 +133,60 @@ func (mr *MockProfilesRepositoryMockRecorder) Update(ctx, p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProfilesRepository)(nil).Update), ctx, p)
 }
 
+// MockProfilesDevices is a mock of Devices interface.
+type MockProfilesDevices struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfilesDevicesMockRecorder
+	isgomock struct{}
+}
+
+// MockProfilesDevicesMockRecorder is the mock recorder for MockProfilesDevices.
+type MockProfilesDevicesMockRecorder struct {
+	mock *MockProfilesDevices
+}
+
+// NewMockProfilesDevices creates a new mock instance.
+func NewMockProfilesDevices(ctrl *gomock.Controller) *MockProfilesDevices {
+	mock := &MockProfilesDevices{ctrl: ctrl}
+	mock.recorder = &MockProfilesDevicesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfilesDevices) EXPECT() *MockProfilesDevicesMockRecorder {
+	return m.recorder
+}
+
+// GetByTags mocks base method.
+func (m *MockProfilesDevices) GetByTags(ctx context.Context, tags, method string, limit, offset int, tenantID string) ([]dto.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTags", ctx, tags, method, limit, offset, tenantID)
+	ret0, _ := ret[0].([]dto.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTags indicates an expected call of GetByTags.
+func (mr *MockProfilesDevicesMockRecorder) GetByTags(ctx, tags, method, limit, offset, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTags", reflect.TypeOf((*MockProfilesDevices)(nil).GetByTags), ctx, tags, method, limit, offset, tenantID)
+}
+
+// GetTLSSettingData mocks base method.
+func (m *MockProfilesDevices) GetTLSSettingData(c context.Context, guid string) ([]dto.SettingDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTLSSettingData", c, guid)
+	ret0, _ := ret[0].([]dto.SettingDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTLSSettingData indicates an expected call of GetTLSSettingData.
+func (mr *MockProfilesDevicesMockRecorder) GetTLSSettingData(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTLSSettingData", reflect.TypeOf((*MockProfilesDevices)(nil).GetTLSSettingData), c, guid)
+}
+
 // MockProfilesFeature is a mock of Feature interface.
 type MockProfilesFeature struct {
 	ctrl     *gomock.Controller
@@ -156,6 +211,21 @@ func (m *MockProfilesFeature) EXPECT() *MockProfilesFeatureMockRecorder {
 	return m.recorder
 }
 
+// Configuration mocks base method.
+func (m *MockProfilesFeature) Configuration(ctx context.Context, profileName, domainName, tenantID string) (config.Configuration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Configuration", ctx, profileName, domainName, tenantID)
+	ret0, _ := ret[0].(config.Configuration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Configuration indicates an expected call of Configuration.
+func (mr *MockProfilesFeatureMockRecorder) Configuration(ctx, profileName, domainName, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Configuration", reflect.TypeOf((*MockProfilesFeature)(nil).Configuration), ctx, profileName, domainName, tenantID)
+}
+
 // Delete mocks base method.
 func (m *MockProfilesFeature) Delete(ctx context.Context, profileName, tenantID string) error {
 	m.ctrl.T.Helper()
@@ -231,6 +301,21 @@ func (mr *MockProfilesFeatureMockRecorder) GetCount(ctx, tenantID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCount", reflect.TypeOf((*MockProfilesFeature)(nil).GetCount), ctx, tenantID)
 }
 
+// GetTLSCompliance mocks base method.
+func (m *MockProfilesFeature) GetTLSCompliance(ctx context.Context, profileName, tenantID string) (dto.TLSComplianceReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTLSCompliance", ctx, profileName, tenantID)
+	ret0, _ := ret[0].(dto.TLSComplianceReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTLSCompliance indicates an expected call of GetTLSCompliance.
+func (mr *MockProfilesFeatureMockRecorder) GetTLSCompliance(ctx, profileName, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTLSCompliance", reflect.TypeOf((*MockProfilesFeature)(nil).GetTLSCompliance), ctx, profileName, tenantID)
+}
+
 // Insert mocks base method.
 func (m *MockProfilesFeature) Insert(ctx context.Context, p *dto.Profile) (*dto.Profile, error) {
 	m.ctrl.T.Helper()
@@ -246,6 +331,37 @@ func (mr *MockProfilesFeatureMockRecorder) Insert(ctx, p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockProfilesFeature)(nil).Insert), ctx, p)
 }
 
+// PlanDelete mocks base method.
+func (m *MockProfilesFeature) PlanDelete(ctx context.Context, profileName, tenantID string) (dto.DryRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanDelete", ctx, profileName, tenantID)
+	ret0, _ := ret[0].(dto.DryRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanDelete indicates an expected call of PlanDelete.
+func (mr *MockProfilesFeatureMockRecorder) PlanDelete(ctx, profileName, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanDelete", reflect.TypeOf((*MockProfilesFeature)(nil).PlanDelete), ctx, profileName, tenantID)
+}
+
+// TLSEnforcement mocks base method.
+func (m *MockProfilesFeature) TLSEnforcement(ctx context.Context, profileName, tenantID string) (dto.TLSSettingsRequest, []dto.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TLSEnforcement", ctx, profileName, tenantID)
+	ret0, _ := ret[0].(dto.TLSSettingsRequest)
+	ret1, _ := ret[1].([]dto.Device)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TLSEnforcement indicates an expected call of TLSEnforcement.
+func (mr *MockProfilesFeatureMockRecorder) TLSEnforcement(ctx, profileName, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TLSEnforcement", reflect.TypeOf((*MockProfilesFeature)(nil).TLSEnforcement), ctx, profileName, tenantID)
+}
+
 // Update mocks base method.
 func (m *MockProfilesFeature) Update(ctx context.Context, p *dto.Profile) (*dto.Profile, error) {
 	m.ctrl.T.Helper()
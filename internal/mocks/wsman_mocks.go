@@ -10,6 +10,7 @@
 package mocks
 
 import (
+	context "context"
 	tls "crypto/tls"
 	reflect "reflect"
 	time "time"
@@ -18,10 +19,13 @@ import (
 	alarmclock "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/alarmclock"
 	auditlog "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
 	boot "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	ethernetport "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ethernetport"
+	kerberos "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
 	messagelog "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
 	redirection "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	setupandconfiguration "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
 	tls0 "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
+	wifiportconfiguration "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
 	boot0 "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
 	concrete "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/concrete"
 	credential "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
@@ -29,7 +33,9 @@ import (
 	power "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
 	service "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/service"
 	software "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/software"
+	wifi "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/wifi"
 	alarmclock0 "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/alarmclock"
+	hostbasedsetup "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbasedsetup"
 	kvmredirection "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/kvmredirection"
 	optin "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/optin"
 	power0 "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/power"
@@ -62,532 +68,747 @@ func (m *MockManagement) EXPECT() *MockManagementMockRecorder {
 }
 
 // AddClientCert mocks base method.
-func (m *MockManagement) AddClientCert(clientCert string) (string, error) {
+func (m *MockManagement) AddClientCert(ctx context.Context, clientCert string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddClientCert", clientCert)
+	ret := m.ctrl.Call(m, "AddClientCert", ctx, clientCert)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddClientCert indicates an expected call of AddClientCert.
-func (mr *MockManagementMockRecorder) AddClientCert(clientCert any) *gomock.Call {
+func (mr *MockManagementMockRecorder) AddClientCert(ctx, clientCert any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddClientCert", reflect.TypeOf((*MockManagement)(nil).AddClientCert), clientCert)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddClientCert", reflect.TypeOf((*MockManagement)(nil).AddClientCert), ctx, clientCert)
 }
 
 // AddTrustedRootCert mocks base method.
-func (m *MockManagement) AddTrustedRootCert(caCert string) (string, error) {
+func (m *MockManagement) AddTrustedRootCert(ctx context.Context, caCert string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTrustedRootCert", caCert)
+	ret := m.ctrl.Call(m, "AddTrustedRootCert", ctx, caCert)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddTrustedRootCert indicates an expected call of AddTrustedRootCert.
-func (mr *MockManagementMockRecorder) AddTrustedRootCert(caCert any) *gomock.Call {
+func (mr *MockManagementMockRecorder) AddTrustedRootCert(ctx, caCert any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrustedRootCert", reflect.TypeOf((*MockManagement)(nil).AddTrustedRootCert), caCert)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrustedRootCert", reflect.TypeOf((*MockManagement)(nil).AddTrustedRootCert), ctx, caCert)
+}
+
+// AddUserACLEntry mocks base method.
+func (m *MockManagement) AddUserACLEntry(ctx context.Context, entry wsman.UserACLEntry, digestPassword string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserACLEntry", ctx, entry, digestPassword)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUserACLEntry indicates an expected call of AddUserACLEntry.
+func (mr *MockManagementMockRecorder) AddUserACLEntry(ctx, entry, digestPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserACLEntry", reflect.TypeOf((*MockManagement)(nil).AddUserACLEntry), ctx, entry, digestPassword)
 }
 
 // BootServiceStateChange mocks base method.
-func (m *MockManagement) BootServiceStateChange(requestedState int) (boot0.BootService, error) {
+func (m *MockManagement) BootServiceStateChange(ctx context.Context, requestedState int) (boot0.BootService, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BootServiceStateChange", requestedState)
+	ret := m.ctrl.Call(m, "BootServiceStateChange", ctx, requestedState)
 	ret0, _ := ret[0].(boot0.BootService)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // BootServiceStateChange indicates an expected call of BootServiceStateChange.
-func (mr *MockManagementMockRecorder) BootServiceStateChange(requestedState any) *gomock.Call {
+func (mr *MockManagementMockRecorder) BootServiceStateChange(ctx, requestedState any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootServiceStateChange", reflect.TypeOf((*MockManagement)(nil).BootServiceStateChange), requestedState)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootServiceStateChange", reflect.TypeOf((*MockManagement)(nil).BootServiceStateChange), ctx, requestedState)
 }
 
 // CancelUserConsentRequest mocks base method.
-func (m *MockManagement) CancelUserConsentRequest() (optin.Response, error) {
+func (m *MockManagement) CancelUserConsentRequest(ctx context.Context) (optin.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelUserConsentRequest")
+	ret := m.ctrl.Call(m, "CancelUserConsentRequest", ctx)
 	ret0, _ := ret[0].(optin.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CancelUserConsentRequest indicates an expected call of CancelUserConsentRequest.
-func (mr *MockManagementMockRecorder) CancelUserConsentRequest() *gomock.Call {
+func (mr *MockManagementMockRecorder) CancelUserConsentRequest(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserConsentRequest", reflect.TypeOf((*MockManagement)(nil).CancelUserConsentRequest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserConsentRequest", reflect.TypeOf((*MockManagement)(nil).CancelUserConsentRequest), ctx)
 }
 
 // ChangeBootOrder mocks base method.
-func (m *MockManagement) ChangeBootOrder(bootSource string) (boot0.ChangeBootOrder_OUTPUT, error) {
+func (m *MockManagement) ChangeBootOrder(ctx context.Context, bootSource string) (boot0.ChangeBootOrder_OUTPUT, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeBootOrder", bootSource)
+	ret := m.ctrl.Call(m, "ChangeBootOrder", ctx, bootSource)
 	ret0, _ := ret[0].(boot0.ChangeBootOrder_OUTPUT)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ChangeBootOrder indicates an expected call of ChangeBootOrder.
-func (mr *MockManagementMockRecorder) ChangeBootOrder(bootSource any) *gomock.Call {
+func (mr *MockManagementMockRecorder) ChangeBootOrder(ctx, bootSource any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBootOrder", reflect.TypeOf((*MockManagement)(nil).ChangeBootOrder), bootSource)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBootOrder", reflect.TypeOf((*MockManagement)(nil).ChangeBootOrder), ctx, bootSource)
 }
 
 // CreateAlarmOccurrences mocks base method.
-func (m *MockManagement) CreateAlarmOccurrences(name string, startTime time.Time, interval int, deleteOnCompletion bool) (alarmclock.AddAlarmOutput, error) {
+func (m *MockManagement) CreateAlarmOccurrences(ctx context.Context, name string, startTime time.Time, interval int, deleteOnCompletion bool) (alarmclock.AddAlarmOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAlarmOccurrences", name, startTime, interval, deleteOnCompletion)
+	ret := m.ctrl.Call(m, "CreateAlarmOccurrences", ctx, name, startTime, interval, deleteOnCompletion)
 	ret0, _ := ret[0].(alarmclock.AddAlarmOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAlarmOccurrences indicates an expected call of CreateAlarmOccurrences.
-func (mr *MockManagementMockRecorder) CreateAlarmOccurrences(name, startTime, interval, deleteOnCompletion any) *gomock.Call {
+func (mr *MockManagementMockRecorder) CreateAlarmOccurrences(ctx, name, startTime, interval, deleteOnCompletion any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlarmOccurrences", reflect.TypeOf((*MockManagement)(nil).CreateAlarmOccurrences), name, startTime, interval, deleteOnCompletion)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlarmOccurrences", reflect.TypeOf((*MockManagement)(nil).CreateAlarmOccurrences), ctx, name, startTime, interval, deleteOnCompletion)
 }
 
 // DeleteAlarmOccurrences mocks base method.
-func (m *MockManagement) DeleteAlarmOccurrences(instanceID string) error {
+func (m *MockManagement) DeleteAlarmOccurrences(ctx context.Context, instanceID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAlarmOccurrences", instanceID)
+	ret := m.ctrl.Call(m, "DeleteAlarmOccurrences", ctx, instanceID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteAlarmOccurrences indicates an expected call of DeleteAlarmOccurrences.
-func (mr *MockManagementMockRecorder) DeleteAlarmOccurrences(instanceID any) *gomock.Call {
+func (mr *MockManagementMockRecorder) DeleteAlarmOccurrences(ctx, instanceID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlarmOccurrences", reflect.TypeOf((*MockManagement)(nil).DeleteAlarmOccurrences), instanceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlarmOccurrences", reflect.TypeOf((*MockManagement)(nil).DeleteAlarmOccurrences), ctx, instanceID)
 }
 
 // DeleteCertificate mocks base method.
-func (m *MockManagement) DeleteCertificate(instanceID string) error {
+func (m *MockManagement) DeleteCertificate(ctx context.Context, instanceID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCertificate", instanceID)
+	ret := m.ctrl.Call(m, "DeleteCertificate", ctx, instanceID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteCertificate indicates an expected call of DeleteCertificate.
-func (mr *MockManagementMockRecorder) DeleteCertificate(instanceID any) *gomock.Call {
+func (mr *MockManagementMockRecorder) DeleteCertificate(ctx, instanceID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertificate", reflect.TypeOf((*MockManagement)(nil).DeleteCertificate), instanceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertificate", reflect.TypeOf((*MockManagement)(nil).DeleteCertificate), ctx, instanceID)
+}
+
+// DeleteKeyPair mocks base method.
+func (m *MockManagement) DeleteKeyPair(ctx context.Context, instanceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKeyPair", ctx, instanceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKeyPair indicates an expected call of DeleteKeyPair.
+func (mr *MockManagementMockRecorder) DeleteKeyPair(ctx, instanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKeyPair", reflect.TypeOf((*MockManagement)(nil).DeleteKeyPair), ctx, instanceID)
 }
 
 // GetAMTRedirectionService mocks base method.
-func (m *MockManagement) GetAMTRedirectionService() (redirection.Response, error) {
+func (m *MockManagement) GetAMTRedirectionService(ctx context.Context) (redirection.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTRedirectionService")
+	ret := m.ctrl.Call(m, "GetAMTRedirectionService", ctx)
 	ret0, _ := ret[0].(redirection.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTRedirectionService indicates an expected call of GetAMTRedirectionService.
-func (mr *MockManagementMockRecorder) GetAMTRedirectionService() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetAMTRedirectionService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTRedirectionService", reflect.TypeOf((*MockManagement)(nil).GetAMTRedirectionService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTRedirectionService", reflect.TypeOf((*MockManagement)(nil).GetAMTRedirectionService), ctx)
 }
 
 // GetAMTVersion mocks base method.
-func (m *MockManagement) GetAMTVersion() ([]software.SoftwareIdentity, error) {
+func (m *MockManagement) GetAMTVersion(ctx context.Context) ([]software.SoftwareIdentity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAMTVersion")
+	ret := m.ctrl.Call(m, "GetAMTVersion", ctx)
 	ret0, _ := ret[0].([]software.SoftwareIdentity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAMTVersion indicates an expected call of GetAMTVersion.
-func (mr *MockManagementMockRecorder) GetAMTVersion() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetAMTVersion(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTVersion", reflect.TypeOf((*MockManagement)(nil).GetAMTVersion))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMTVersion", reflect.TypeOf((*MockManagement)(nil).GetAMTVersion), ctx)
 }
 
 // GetAlarmOccurrences mocks base method.
-func (m *MockManagement) GetAlarmOccurrences() ([]alarmclock0.AlarmClockOccurrence, error) {
+func (m *MockManagement) GetAlarmOccurrences(ctx context.Context) ([]alarmclock0.AlarmClockOccurrence, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAlarmOccurrences")
+	ret := m.ctrl.Call(m, "GetAlarmOccurrences", ctx)
 	ret0, _ := ret[0].([]alarmclock0.AlarmClockOccurrence)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAlarmOccurrences indicates an expected call of GetAlarmOccurrences.
-func (mr *MockManagementMockRecorder) GetAlarmOccurrences() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetAlarmOccurrences(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmOccurrences", reflect.TypeOf((*MockManagement)(nil).GetAlarmOccurrences))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmOccurrences", reflect.TypeOf((*MockManagement)(nil).GetAlarmOccurrences), ctx)
 }
 
 // GetAuditLog mocks base method.
-func (m *MockManagement) GetAuditLog(startIndex int) (auditlog.Response, error) {
+func (m *MockManagement) GetAuditLog(ctx context.Context, startIndex int) (auditlog.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAuditLog", startIndex)
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, startIndex)
 	ret0, _ := ret[0].(auditlog.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAuditLog indicates an expected call of GetAuditLog.
-func (mr *MockManagementMockRecorder) GetAuditLog(startIndex any) *gomock.Call {
+func (mr *MockManagementMockRecorder) GetAuditLog(ctx, startIndex any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockManagement)(nil).GetAuditLog), startIndex)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockManagement)(nil).GetAuditLog), ctx, startIndex)
 }
 
 // GetBootData mocks base method.
-func (m *MockManagement) GetBootData() (boot.BootSettingDataResponse, error) {
+func (m *MockManagement) GetBootData(ctx context.Context) (boot.BootSettingDataResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBootData")
+	ret := m.ctrl.Call(m, "GetBootData", ctx)
 	ret0, _ := ret[0].(boot.BootSettingDataResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBootData indicates an expected call of GetBootData.
-func (mr *MockManagementMockRecorder) GetBootData() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetBootData(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBootData", reflect.TypeOf((*MockManagement)(nil).GetBootData))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBootData", reflect.TypeOf((*MockManagement)(nil).GetBootData), ctx)
 }
 
 // GetBootService mocks base method.
-func (m *MockManagement) GetBootService() (boot0.BootService, error) {
+func (m *MockManagement) GetBootService(ctx context.Context) (boot0.BootService, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBootService")
+	ret := m.ctrl.Call(m, "GetBootService", ctx)
 	ret0, _ := ret[0].(boot0.BootService)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBootService indicates an expected call of GetBootService.
-func (mr *MockManagementMockRecorder) GetBootService() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetBootService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBootService", reflect.TypeOf((*MockManagement)(nil).GetBootService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBootService", reflect.TypeOf((*MockManagement)(nil).GetBootService), ctx)
 }
 
 // GetCIMBootSourceSetting mocks base method.
-func (m *MockManagement) GetCIMBootSourceSetting() (boot0.Response, error) {
+func (m *MockManagement) GetCIMBootSourceSetting(ctx context.Context) (boot0.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCIMBootSourceSetting")
+	ret := m.ctrl.Call(m, "GetCIMBootSourceSetting", ctx)
 	ret0, _ := ret[0].(boot0.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCIMBootSourceSetting indicates an expected call of GetCIMBootSourceSetting.
-func (mr *MockManagementMockRecorder) GetCIMBootSourceSetting() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetCIMBootSourceSetting(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMBootSourceSetting", reflect.TypeOf((*MockManagement)(nil).GetCIMBootSourceSetting))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIMBootSourceSetting", reflect.TypeOf((*MockManagement)(nil).GetCIMBootSourceSetting), ctx)
 }
 
 // GetCertificates mocks base method.
-func (m *MockManagement) GetCertificates() (wsman.Certificates, error) {
+func (m *MockManagement) GetCertificates(ctx context.Context) (wsman.Certificates, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCertificates")
+	ret := m.ctrl.Call(m, "GetCertificates", ctx)
 	ret0, _ := ret[0].(wsman.Certificates)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCertificates indicates an expected call of GetCertificates.
-func (mr *MockManagementMockRecorder) GetCertificates() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetCertificates(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificates", reflect.TypeOf((*MockManagement)(nil).GetCertificates))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificates", reflect.TypeOf((*MockManagement)(nil).GetCertificates), ctx)
 }
 
 // GetConcreteDependencies mocks base method.
-func (m *MockManagement) GetConcreteDependencies() ([]concrete.ConcreteDependency, error) {
+func (m *MockManagement) GetConcreteDependencies(ctx context.Context) ([]concrete.ConcreteDependency, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConcreteDependencies")
+	ret := m.ctrl.Call(m, "GetConcreteDependencies", ctx)
 	ret0, _ := ret[0].([]concrete.ConcreteDependency)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetConcreteDependencies indicates an expected call of GetConcreteDependencies.
-func (mr *MockManagementMockRecorder) GetConcreteDependencies() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetConcreteDependencies(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConcreteDependencies", reflect.TypeOf((*MockManagement)(nil).GetConcreteDependencies))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConcreteDependencies", reflect.TypeOf((*MockManagement)(nil).GetConcreteDependencies), ctx)
 }
 
 // GetCredentialRelationships mocks base method.
-func (m *MockManagement) GetCredentialRelationships() (credential.Items, error) {
+func (m *MockManagement) GetCredentialRelationships(ctx context.Context) (credential.Items, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCredentialRelationships")
+	ret := m.ctrl.Call(m, "GetCredentialRelationships", ctx)
 	ret0, _ := ret[0].(credential.Items)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCredentialRelationships indicates an expected call of GetCredentialRelationships.
-func (mr *MockManagementMockRecorder) GetCredentialRelationships() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetCredentialRelationships(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentialRelationships", reflect.TypeOf((*MockManagement)(nil).GetCredentialRelationships))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentialRelationships", reflect.TypeOf((*MockManagement)(nil).GetCredentialRelationships), ctx)
 }
 
 // GetDeviceCertificate mocks base method.
-func (m *MockManagement) GetDeviceCertificate() (*tls.Certificate, error) {
+func (m *MockManagement) GetDeviceCertificate(ctx context.Context) (*tls.Certificate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDeviceCertificate")
+	ret := m.ctrl.Call(m, "GetDeviceCertificate", ctx)
 	ret0, _ := ret[0].(*tls.Certificate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDeviceCertificate indicates an expected call of GetDeviceCertificate.
-func (mr *MockManagementMockRecorder) GetDeviceCertificate() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetDeviceCertificate(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceCertificate", reflect.TypeOf((*MockManagement)(nil).GetDeviceCertificate))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceCertificate", reflect.TypeOf((*MockManagement)(nil).GetDeviceCertificate), ctx)
 }
 
 // GetDiskInfo mocks base method.
-func (m *MockManagement) GetDiskInfo() (any, error) {
+func (m *MockManagement) GetDiskInfo(ctx context.Context) (any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDiskInfo")
+	ret := m.ctrl.Call(m, "GetDiskInfo", ctx)
 	ret0, _ := ret[0].(any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDiskInfo indicates an expected call of GetDiskInfo.
-func (mr *MockManagementMockRecorder) GetDiskInfo() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetDiskInfo(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskInfo", reflect.TypeOf((*MockManagement)(nil).GetDiskInfo))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskInfo", reflect.TypeOf((*MockManagement)(nil).GetDiskInfo), ctx)
+}
+
+// GetEthernetPorts mocks base method.
+func (m *MockManagement) GetEthernetPorts(ctx context.Context) ([]ethernetport.SettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEthernetPorts", ctx)
+	ret0, _ := ret[0].([]ethernetport.SettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEthernetPorts indicates an expected call of GetEthernetPorts.
+func (mr *MockManagementMockRecorder) GetEthernetPorts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEthernetPorts", reflect.TypeOf((*MockManagement)(nil).GetEthernetPorts), ctx)
 }
 
 // GetEventLog mocks base method.
-func (m *MockManagement) GetEventLog(startIndex, maxReadRecords int) (messagelog.GetRecordsResponse, error) {
+func (m *MockManagement) GetEventLog(ctx context.Context, startIndex, maxReadRecords int) (messagelog.GetRecordsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventLog", startIndex, maxReadRecords)
+	ret := m.ctrl.Call(m, "GetEventLog", ctx, startIndex, maxReadRecords)
 	ret0, _ := ret[0].(messagelog.GetRecordsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEventLog indicates an expected call of GetEventLog.
-func (mr *MockManagementMockRecorder) GetEventLog(startIndex, maxReadRecords any) *gomock.Call {
+func (mr *MockManagementMockRecorder) GetEventLog(ctx, startIndex, maxReadRecords any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventLog", reflect.TypeOf((*MockManagement)(nil).GetEventLog), startIndex, maxReadRecords)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventLog", reflect.TypeOf((*MockManagement)(nil).GetEventLog), ctx, startIndex, maxReadRecords)
 }
 
 // GetGeneralSettings mocks base method.
-func (m *MockManagement) GetGeneralSettings() (any, error) {
+func (m *MockManagement) GetGeneralSettings(ctx context.Context) (any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGeneralSettings")
+	ret := m.ctrl.Call(m, "GetGeneralSettings", ctx)
 	ret0, _ := ret[0].(any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGeneralSettings indicates an expected call of GetGeneralSettings.
-func (mr *MockManagementMockRecorder) GetGeneralSettings() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetGeneralSettings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGeneralSettings", reflect.TypeOf((*MockManagement)(nil).GetGeneralSettings))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGeneralSettings", reflect.TypeOf((*MockManagement)(nil).GetGeneralSettings), ctx)
 }
 
 // GetHardwareInfo mocks base method.
-func (m *MockManagement) GetHardwareInfo() (any, error) {
+func (m *MockManagement) GetHardwareInfo(ctx context.Context, classes ...string) (any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHardwareInfo")
+	varargs := []any{ctx}
+	for _, a := range classes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHardwareInfo", varargs...)
 	ret0, _ := ret[0].(any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHardwareInfo indicates an expected call of GetHardwareInfo.
-func (mr *MockManagementMockRecorder) GetHardwareInfo() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetHardwareInfo(ctx any, classes ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardwareInfo", reflect.TypeOf((*MockManagement)(nil).GetHardwareInfo))
+	varargs := append([]any{ctx}, classes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardwareInfo", reflect.TypeOf((*MockManagement)(nil).GetHardwareInfo), varargs...)
 }
 
 // GetIPSKVMRedirectionSettingData mocks base method.
-func (m *MockManagement) GetIPSKVMRedirectionSettingData() (kvmredirection.Response, error) {
+func (m *MockManagement) GetIPSKVMRedirectionSettingData(ctx context.Context) (kvmredirection.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPSKVMRedirectionSettingData")
+	ret := m.ctrl.Call(m, "GetIPSKVMRedirectionSettingData", ctx)
 	ret0, _ := ret[0].(kvmredirection.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPSKVMRedirectionSettingData indicates an expected call of GetIPSKVMRedirectionSettingData.
-func (mr *MockManagementMockRecorder) GetIPSKVMRedirectionSettingData() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetIPSKVMRedirectionSettingData(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSKVMRedirectionSettingData", reflect.TypeOf((*MockManagement)(nil).GetIPSKVMRedirectionSettingData))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSKVMRedirectionSettingData", reflect.TypeOf((*MockManagement)(nil).GetIPSKVMRedirectionSettingData), ctx)
 }
 
 // GetIPSOptInService mocks base method.
-func (m *MockManagement) GetIPSOptInService() (optin.Response, error) {
+func (m *MockManagement) GetIPSOptInService(ctx context.Context) (optin.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPSOptInService")
+	ret := m.ctrl.Call(m, "GetIPSOptInService", ctx)
 	ret0, _ := ret[0].(optin.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPSOptInService indicates an expected call of GetIPSOptInService.
-func (mr *MockManagementMockRecorder) GetIPSOptInService() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetIPSOptInService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSOptInService", reflect.TypeOf((*MockManagement)(nil).GetIPSOptInService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSOptInService", reflect.TypeOf((*MockManagement)(nil).GetIPSOptInService), ctx)
 }
 
 // GetIPSPowerManagementService mocks base method.
-func (m *MockManagement) GetIPSPowerManagementService() (power0.PowerManagementService, error) {
+func (m *MockManagement) GetIPSPowerManagementService(ctx context.Context) (power0.PowerManagementService, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPSPowerManagementService")
+	ret := m.ctrl.Call(m, "GetIPSPowerManagementService", ctx)
 	ret0, _ := ret[0].(power0.PowerManagementService)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPSPowerManagementService indicates an expected call of GetIPSPowerManagementService.
-func (mr *MockManagementMockRecorder) GetIPSPowerManagementService() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetIPSPowerManagementService(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSPowerManagementService", reflect.TypeOf((*MockManagement)(nil).GetIPSPowerManagementService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSPowerManagementService", reflect.TypeOf((*MockManagement)(nil).GetIPSPowerManagementService), ctx)
 }
 
 // GetIPSScreenSettingData mocks base method.
-func (m *MockManagement) GetIPSScreenSettingData() (screensetting.Response, error) {
+func (m *MockManagement) GetIPSScreenSettingData(ctx context.Context) (screensetting.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPSScreenSettingData")
+	ret := m.ctrl.Call(m, "GetIPSScreenSettingData", ctx)
 	ret0, _ := ret[0].(screensetting.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIPSScreenSettingData indicates an expected call of GetIPSScreenSettingData.
-func (mr *MockManagementMockRecorder) GetIPSScreenSettingData() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetIPSScreenSettingData(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSScreenSettingData", reflect.TypeOf((*MockManagement)(nil).GetIPSScreenSettingData))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSScreenSettingData", reflect.TypeOf((*MockManagement)(nil).GetIPSScreenSettingData), ctx)
 }
 
 // GetKVMRedirection mocks base method.
-func (m *MockManagement) GetKVMRedirection() (kvm.Response, error) {
+func (m *MockManagement) GetKVMRedirection(ctx context.Context) (kvm.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKVMRedirection")
+	ret := m.ctrl.Call(m, "GetKVMRedirection", ctx)
 	ret0, _ := ret[0].(kvm.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetKVMRedirection indicates an expected call of GetKVMRedirection.
-func (mr *MockManagementMockRecorder) GetKVMRedirection() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetKVMRedirection(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKVMRedirection", reflect.TypeOf((*MockManagement)(nil).GetKVMRedirection))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKVMRedirection", reflect.TypeOf((*MockManagement)(nil).GetKVMRedirection), ctx)
+}
+
+// GetKerberosSettingData mocks base method.
+func (m *MockManagement) GetKerberosSettingData(ctx context.Context) (kerberos.KerberosSettingDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKerberosSettingData", ctx)
+	ret0, _ := ret[0].(kerberos.KerberosSettingDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKerberosSettingData indicates an expected call of GetKerberosSettingData.
+func (mr *MockManagementMockRecorder) GetKerberosSettingData(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKerberosSettingData", reflect.TypeOf((*MockManagement)(nil).GetKerberosSettingData), ctx)
 }
 
 // GetNetworkSettings mocks base method.
-func (m *MockManagement) GetNetworkSettings() (wsman.NetworkResults, error) {
+func (m *MockManagement) GetNetworkSettings(ctx context.Context, interfaces ...string) (wsman.NetworkResults, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNetworkSettings")
+	varargs := []any{ctx}
+	for _, a := range interfaces {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkSettings", varargs...)
 	ret0, _ := ret[0].(wsman.NetworkResults)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNetworkSettings indicates an expected call of GetNetworkSettings.
-func (mr *MockManagementMockRecorder) GetNetworkSettings() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetNetworkSettings(ctx any, interfaces ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkSettings", reflect.TypeOf((*MockManagement)(nil).GetNetworkSettings))
+	varargs := append([]any{ctx}, interfaces...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkSettings", reflect.TypeOf((*MockManagement)(nil).GetNetworkSettings), varargs...)
 }
 
 // GetOSPowerSavingState mocks base method.
-func (m *MockManagement) GetOSPowerSavingState() (power0.OSPowerSavingState, error) {
+func (m *MockManagement) GetOSPowerSavingState(ctx context.Context) (power0.OSPowerSavingState, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOSPowerSavingState")
+	ret := m.ctrl.Call(m, "GetOSPowerSavingState", ctx)
 	ret0, _ := ret[0].(power0.OSPowerSavingState)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOSPowerSavingState indicates an expected call of GetOSPowerSavingState.
-func (mr *MockManagementMockRecorder) GetOSPowerSavingState() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetOSPowerSavingState(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOSPowerSavingState", reflect.TypeOf((*MockManagement)(nil).GetOSPowerSavingState))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOSPowerSavingState", reflect.TypeOf((*MockManagement)(nil).GetOSPowerSavingState), ctx)
 }
 
 // GetPowerCapabilities mocks base method.
-func (m *MockManagement) GetPowerCapabilities() (boot.BootCapabilitiesResponse, error) {
+func (m *MockManagement) GetPowerCapabilities(ctx context.Context) (boot.BootCapabilitiesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPowerCapabilities")
+	ret := m.ctrl.Call(m, "GetPowerCapabilities", ctx)
 	ret0, _ := ret[0].(boot.BootCapabilitiesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPowerCapabilities indicates an expected call of GetPowerCapabilities.
-func (mr *MockManagementMockRecorder) GetPowerCapabilities() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetPowerCapabilities(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPowerCapabilities", reflect.TypeOf((*MockManagement)(nil).GetPowerCapabilities))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPowerCapabilities", reflect.TypeOf((*MockManagement)(nil).GetPowerCapabilities), ctx)
 }
 
 // GetPowerState mocks base method.
-func (m *MockManagement) GetPowerState() ([]service.CIM_AssociatedPowerManagementService, error) {
+func (m *MockManagement) GetPowerState(ctx context.Context) ([]service.CIM_AssociatedPowerManagementService, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPowerState")
+	ret := m.ctrl.Call(m, "GetPowerState", ctx)
 	ret0, _ := ret[0].([]service.CIM_AssociatedPowerManagementService)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPowerState indicates an expected call of GetPowerState.
-func (mr *MockManagementMockRecorder) GetPowerState() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetPowerState(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPowerState", reflect.TypeOf((*MockManagement)(nil).GetPowerState))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPowerState", reflect.TypeOf((*MockManagement)(nil).GetPowerState), ctx)
+}
+
+// GetIPSHostBasedSetupService mocks base method.
+func (m *MockManagement) GetIPSHostBasedSetupService(ctx context.Context) (hostbasedsetup.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPSHostBasedSetupService", ctx)
+	ret0, _ := ret[0].(hostbasedsetup.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPSHostBasedSetupService indicates an expected call of GetIPSHostBasedSetupService.
+func (mr *MockManagementMockRecorder) GetIPSHostBasedSetupService(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSHostBasedSetupService", reflect.TypeOf((*MockManagement)(nil).GetIPSHostBasedSetupService), ctx)
 }
 
 // GetSetupAndConfiguration mocks base method.
-func (m *MockManagement) GetSetupAndConfiguration() ([]setupandconfiguration.SetupAndConfigurationServiceResponse, error) {
+func (m *MockManagement) GetSetupAndConfiguration(ctx context.Context) ([]setupandconfiguration.SetupAndConfigurationServiceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSetupAndConfiguration")
+	ret := m.ctrl.Call(m, "GetSetupAndConfiguration", ctx)
 	ret0, _ := ret[0].([]setupandconfiguration.SetupAndConfigurationServiceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSetupAndConfiguration indicates an expected call of GetSetupAndConfiguration.
-func (mr *MockManagementMockRecorder) GetSetupAndConfiguration() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetSetupAndConfiguration(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSetupAndConfiguration", reflect.TypeOf((*MockManagement)(nil).GetSetupAndConfiguration))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSetupAndConfiguration", reflect.TypeOf((*MockManagement)(nil).GetSetupAndConfiguration), ctx)
 }
 
 // GetTLSSettingData mocks base method.
-func (m *MockManagement) GetTLSSettingData() ([]tls0.SettingDataResponse, error) {
+func (m *MockManagement) GetTLSSettingData(ctx context.Context) ([]tls0.SettingDataResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTLSSettingData")
+	ret := m.ctrl.Call(m, "GetTLSSettingData", ctx)
 	ret0, _ := ret[0].([]tls0.SettingDataResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTLSSettingData indicates an expected call of GetTLSSettingData.
-func (mr *MockManagementMockRecorder) GetTLSSettingData() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetTLSSettingData(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTLSSettingData", reflect.TypeOf((*MockManagement)(nil).GetTLSSettingData))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTLSSettingData", reflect.TypeOf((*MockManagement)(nil).GetTLSSettingData), ctx)
+}
+
+// GetUserACLEntries mocks base method.
+func (m *MockManagement) GetUserACLEntries(ctx context.Context) ([]wsman.UserACLEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserACLEntries", ctx)
+	ret0, _ := ret[0].([]wsman.UserACLEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserACLEntries indicates an expected call of GetUserACLEntries.
+func (mr *MockManagementMockRecorder) GetUserACLEntries(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserACLEntries", reflect.TypeOf((*MockManagement)(nil).GetUserACLEntries), ctx)
 }
 
 // GetUserConsentCode mocks base method.
-func (m *MockManagement) GetUserConsentCode() (optin.Response, error) {
+func (m *MockManagement) GetUserConsentCode(ctx context.Context) (optin.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserConsentCode")
+	ret := m.ctrl.Call(m, "GetUserConsentCode", ctx)
 	ret0, _ := ret[0].(optin.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserConsentCode indicates an expected call of GetUserConsentCode.
-func (mr *MockManagementMockRecorder) GetUserConsentCode() *gomock.Call {
+func (mr *MockManagementMockRecorder) GetUserConsentCode(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserConsentCode", reflect.TypeOf((*MockManagement)(nil).GetUserConsentCode), ctx)
+}
+
+// GetWiFiEndpointSettings mocks base method.
+func (m *MockManagement) GetWiFiEndpointSettings(ctx context.Context) ([]wifi.WiFiEndpointSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWiFiEndpointSettings", ctx)
+	ret0, _ := ret[0].([]wifi.WiFiEndpointSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWiFiEndpointSettings indicates an expected call of GetWiFiEndpointSettings.
+func (mr *MockManagementMockRecorder) GetWiFiEndpointSettings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserConsentCode", reflect.TypeOf((*MockManagement)(nil).GetUserConsentCode))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWiFiEndpointSettings", reflect.TypeOf((*MockManagement)(nil).GetWiFiEndpointSettings), ctx)
+}
+
+// GetWiFiPortConfigurationService mocks base method.
+func (m *MockManagement) GetWiFiPortConfigurationService(ctx context.Context) (wifiportconfiguration.WiFiPortConfigurationServiceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWiFiPortConfigurationService", ctx)
+	ret0, _ := ret[0].(wifiportconfiguration.WiFiPortConfigurationServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWiFiPortConfigurationService indicates an expected call of GetWiFiPortConfigurationService.
+func (mr *MockManagementMockRecorder) GetWiFiPortConfigurationService(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWiFiPortConfigurationService", reflect.TypeOf((*MockManagement)(nil).GetWiFiPortConfigurationService), ctx)
+}
+
+// PutKerberosSettingData mocks base method.
+func (m *MockManagement) PutKerberosSettingData(ctx context.Context, settings kerberos.KerberosSettingDataResponse) (kerberos.KerberosSettingDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutKerberosSettingData", ctx, settings)
+	ret0, _ := ret[0].(kerberos.KerberosSettingDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutKerberosSettingData indicates an expected call of PutKerberosSettingData.
+func (mr *MockManagementMockRecorder) PutKerberosSettingData(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutKerberosSettingData", reflect.TypeOf((*MockManagement)(nil).PutKerberosSettingData), ctx, settings)
+}
+
+// PutTLSSettingData mocks base method.
+func (m *MockManagement) PutTLSSettingData(ctx context.Context, settings tls0.SettingDataRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutTLSSettingData", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutTLSSettingData indicates an expected call of PutTLSSettingData.
+func (mr *MockManagementMockRecorder) PutTLSSettingData(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutTLSSettingData", reflect.TypeOf((*MockManagement)(nil).PutTLSSettingData), ctx, settings)
+}
+
+// PutWiFiEndpointSettings mocks base method.
+func (m *MockManagement) PutWiFiEndpointSettings(ctx context.Context, settings wifi.WiFiEndpointSettingsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWiFiEndpointSettings", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutWiFiEndpointSettings indicates an expected call of PutWiFiEndpointSettings.
+func (mr *MockManagementMockRecorder) PutWiFiEndpointSettings(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWiFiEndpointSettings", reflect.TypeOf((*MockManagement)(nil).PutWiFiEndpointSettings), ctx, settings)
+}
+
+// PutWiFiPortConfigurationService mocks base method.
+func (m *MockManagement) PutWiFiPortConfigurationService(ctx context.Context, settings wifiportconfiguration.WiFiPortConfigurationServiceResponse) (wifiportconfiguration.WiFiPortConfigurationServiceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWiFiPortConfigurationService", ctx, settings)
+	ret0, _ := ret[0].(wifiportconfiguration.WiFiPortConfigurationServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWiFiPortConfigurationService indicates an expected call of PutWiFiPortConfigurationService.
+func (mr *MockManagementMockRecorder) PutWiFiPortConfigurationService(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWiFiPortConfigurationService", reflect.TypeOf((*MockManagement)(nil).PutWiFiPortConfigurationService), ctx, settings)
+}
+
+// RemoveRemoteAccess mocks base method.
+func (m *MockManagement) RemoveRemoteAccess(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRemoteAccess", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRemoteAccess indicates an expected call of RemoveRemoteAccess.
+func (mr *MockManagementMockRecorder) RemoveRemoteAccess(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRemoteAccess", reflect.TypeOf((*MockManagement)(nil).RemoveRemoteAccess), ctx)
+}
+
+// RemoveUserACLEntry mocks base method.
+func (m *MockManagement) RemoveUserACLEntry(ctx context.Context, handle int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserACLEntry", ctx, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserACLEntry indicates an expected call of RemoveUserACLEntry.
+func (mr *MockManagementMockRecorder) RemoveUserACLEntry(ctx, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserACLEntry", reflect.TypeOf((*MockManagement)(nil).RemoveUserACLEntry), ctx, handle)
 }
 
 // RequestAMTRedirectionServiceStateChange mocks base method.
-func (m *MockManagement) RequestAMTRedirectionServiceStateChange(ider, sol bool) (redirection.RequestedState, int, error) {
+func (m *MockManagement) RequestAMTRedirectionServiceStateChange(ctx context.Context, ider, sol bool) (redirection.RequestedState, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RequestAMTRedirectionServiceStateChange", ider, sol)
+	ret := m.ctrl.Call(m, "RequestAMTRedirectionServiceStateChange", ctx, ider, sol)
 	ret0, _ := ret[0].(redirection.RequestedState)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -595,156 +816,198 @@ func (m *MockManagement) RequestAMTRedirectionServiceStateChange(ider, sol bool)
 }
 
 // RequestAMTRedirectionServiceStateChange indicates an expected call of RequestAMTRedirectionServiceStateChange.
-func (mr *MockManagementMockRecorder) RequestAMTRedirectionServiceStateChange(ider, sol any) *gomock.Call {
+func (mr *MockManagementMockRecorder) RequestAMTRedirectionServiceStateChange(ctx, ider, sol any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAMTRedirectionServiceStateChange", reflect.TypeOf((*MockManagement)(nil).RequestAMTRedirectionServiceStateChange), ider, sol)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAMTRedirectionServiceStateChange", reflect.TypeOf((*MockManagement)(nil).RequestAMTRedirectionServiceStateChange), ctx, ider, sol)
 }
 
 // RequestOSPowerSavingStateChange mocks base method.
-func (m *MockManagement) RequestOSPowerSavingStateChange(osPowerSavingState power0.OSPowerSavingState) (power0.PowerActionResponse, error) {
+func (m *MockManagement) RequestOSPowerSavingStateChange(ctx context.Context, osPowerSavingState power0.OSPowerSavingState) (power0.PowerActionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RequestOSPowerSavingStateChange", osPowerSavingState)
+	ret := m.ctrl.Call(m, "RequestOSPowerSavingStateChange", ctx, osPowerSavingState)
 	ret0, _ := ret[0].(power0.PowerActionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RequestOSPowerSavingStateChange indicates an expected call of RequestOSPowerSavingStateChange.
-func (mr *MockManagementMockRecorder) RequestOSPowerSavingStateChange(osPowerSavingState any) *gomock.Call {
+func (mr *MockManagementMockRecorder) RequestOSPowerSavingStateChange(ctx, osPowerSavingState any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestOSPowerSavingStateChange", reflect.TypeOf((*MockManagement)(nil).RequestOSPowerSavingStateChange), osPowerSavingState)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestOSPowerSavingStateChange", reflect.TypeOf((*MockManagement)(nil).RequestOSPowerSavingStateChange), ctx, osPowerSavingState)
 }
 
 // SendConsentCode mocks base method.
-func (m *MockManagement) SendConsentCode(code int) (optin.Response, error) {
+func (m *MockManagement) SendConsentCode(ctx context.Context, code int) (optin.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendConsentCode", code)
+	ret := m.ctrl.Call(m, "SendConsentCode", ctx, code)
 	ret0, _ := ret[0].(optin.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SendConsentCode indicates an expected call of SendConsentCode.
-func (mr *MockManagementMockRecorder) SendConsentCode(code any) *gomock.Call {
+func (mr *MockManagementMockRecorder) SendConsentCode(ctx, code any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendConsentCode", reflect.TypeOf((*MockManagement)(nil).SendConsentCode), code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendConsentCode", reflect.TypeOf((*MockManagement)(nil).SendConsentCode), ctx, code)
 }
 
 // SendPowerAction mocks base method.
-func (m *MockManagement) SendPowerAction(action int) (power.PowerActionResponse, error) {
+func (m *MockManagement) SendPowerAction(ctx context.Context, action int) (power.PowerActionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendPowerAction", action)
+	ret := m.ctrl.Call(m, "SendPowerAction", ctx, action)
 	ret0, _ := ret[0].(power.PowerActionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SendPowerAction indicates an expected call of SendPowerAction.
-func (mr *MockManagementMockRecorder) SendPowerAction(action any) *gomock.Call {
+func (mr *MockManagementMockRecorder) SendPowerAction(ctx, action any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPowerAction", reflect.TypeOf((*MockManagement)(nil).SendPowerAction), action)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPowerAction", reflect.TypeOf((*MockManagement)(nil).SendPowerAction), ctx, action)
 }
 
 // SetAMTRedirectionService mocks base method.
-func (m *MockManagement) SetAMTRedirectionService(arg0 *redirection.RedirectionRequest) (redirection.Response, error) {
+func (m *MockManagement) SetAMTRedirectionService(ctx context.Context, request *redirection.RedirectionRequest) (redirection.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetAMTRedirectionService", arg0)
+	ret := m.ctrl.Call(m, "SetAMTRedirectionService", ctx, request)
 	ret0, _ := ret[0].(redirection.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetAMTRedirectionService indicates an expected call of SetAMTRedirectionService.
-func (mr *MockManagementMockRecorder) SetAMTRedirectionService(arg0 any) *gomock.Call {
+func (mr *MockManagementMockRecorder) SetAMTRedirectionService(ctx, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAMTRedirectionService", reflect.TypeOf((*MockManagement)(nil).SetAMTRedirectionService), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAMTRedirectionService", reflect.TypeOf((*MockManagement)(nil).SetAMTRedirectionService), ctx, request)
 }
 
 // SetBootConfigRole mocks base method.
-func (m *MockManagement) SetBootConfigRole(role int) (any, error) {
+func (m *MockManagement) SetBootConfigRole(ctx context.Context, role int) (any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBootConfigRole", role)
+	ret := m.ctrl.Call(m, "SetBootConfigRole", ctx, role)
 	ret0, _ := ret[0].(any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetBootConfigRole indicates an expected call of SetBootConfigRole.
-func (mr *MockManagementMockRecorder) SetBootConfigRole(role any) *gomock.Call {
+func (mr *MockManagementMockRecorder) SetBootConfigRole(ctx, role any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBootConfigRole", reflect.TypeOf((*MockManagement)(nil).SetBootConfigRole), role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBootConfigRole", reflect.TypeOf((*MockManagement)(nil).SetBootConfigRole), ctx, role)
 }
 
 // SetBootData mocks base method.
-func (m *MockManagement) SetBootData(data boot.BootSettingDataRequest) (any, error) {
+func (m *MockManagement) SetBootData(ctx context.Context, data boot.BootSettingDataRequest) (any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBootData", data)
+	ret := m.ctrl.Call(m, "SetBootData", ctx, data)
 	ret0, _ := ret[0].(any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetBootData indicates an expected call of SetBootData.
-func (mr *MockManagementMockRecorder) SetBootData(data any) *gomock.Call {
+func (mr *MockManagementMockRecorder) SetBootData(ctx, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBootData", reflect.TypeOf((*MockManagement)(nil).SetBootData), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBootData", reflect.TypeOf((*MockManagement)(nil).SetBootData), ctx, data)
 }
 
 // SetIPSKVMRedirectionSettingData mocks base method.
-func (m *MockManagement) SetIPSKVMRedirectionSettingData(data *kvmredirection.KVMRedirectionSettingsRequest) (kvmredirection.Response, error) {
+func (m *MockManagement) SetIPSKVMRedirectionSettingData(ctx context.Context, data *kvmredirection.KVMRedirectionSettingsRequest) (kvmredirection.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetIPSKVMRedirectionSettingData", data)
+	ret := m.ctrl.Call(m, "SetIPSKVMRedirectionSettingData", ctx, data)
 	ret0, _ := ret[0].(kvmredirection.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetIPSKVMRedirectionSettingData indicates an expected call of SetIPSKVMRedirectionSettingData.
-func (mr *MockManagementMockRecorder) SetIPSKVMRedirectionSettingData(data any) *gomock.Call {
+func (mr *MockManagementMockRecorder) SetIPSKVMRedirectionSettingData(ctx, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIPSKVMRedirectionSettingData", reflect.TypeOf((*MockManagement)(nil).SetIPSKVMRedirectionSettingData), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIPSKVMRedirectionSettingData", reflect.TypeOf((*MockManagement)(nil).SetIPSKVMRedirectionSettingData), ctx, data)
 }
 
 // SetIPSOptInService mocks base method.
-func (m *MockManagement) SetIPSOptInService(arg0 optin.OptInServiceRequest) error {
+func (m *MockManagement) SetIPSOptInService(ctx context.Context, request optin.OptInServiceRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetIPSOptInService", arg0)
+	ret := m.ctrl.Call(m, "SetIPSOptInService", ctx, request)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetIPSOptInService indicates an expected call of SetIPSOptInService.
-func (mr *MockManagementMockRecorder) SetIPSOptInService(arg0 any) *gomock.Call {
+func (mr *MockManagementMockRecorder) SetIPSOptInService(ctx, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIPSOptInService", reflect.TypeOf((*MockManagement)(nil).SetIPSOptInService), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIPSOptInService", reflect.TypeOf((*MockManagement)(nil).SetIPSOptInService), ctx, request)
 }
 
 // SetKVMRedirection mocks base method.
-func (m *MockManagement) SetKVMRedirection(enable bool) (int, error) {
+func (m *MockManagement) SetKVMRedirection(ctx context.Context, enable bool) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetKVMRedirection", enable)
+	ret := m.ctrl.Call(m, "SetKVMRedirection", ctx, enable)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetKVMRedirection indicates an expected call of SetKVMRedirection.
-func (mr *MockManagementMockRecorder) SetKVMRedirection(enable any) *gomock.Call {
+func (mr *MockManagementMockRecorder) SetKVMRedirection(ctx, enable any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKVMRedirection", reflect.TypeOf((*MockManagement)(nil).SetKVMRedirection), ctx, enable)
+}
+
+// SetLinkPolicy mocks base method.
+func (m *MockManagement) SetLinkPolicy(ctx context.Context, instanceID string, policy []ethernetport.LinkPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLinkPolicy", ctx, instanceID, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLinkPolicy indicates an expected call of SetLinkPolicy.
+func (mr *MockManagementMockRecorder) SetLinkPolicy(ctx, instanceID, policy any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKVMRedirection", reflect.TypeOf((*MockManagement)(nil).SetKVMRedirection), enable)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLinkPolicy", reflect.TypeOf((*MockManagement)(nil).SetLinkPolicy), ctx, instanceID, policy)
 }
 
 // SetLinkPreference mocks base method.
-func (m *MockManagement) SetLinkPreference(linkPreference, timeout uint32) (int, error) {
+func (m *MockManagement) SetLinkPreference(ctx context.Context, linkPreference, timeout uint32) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetLinkPreference", linkPreference, timeout)
+	ret := m.ctrl.Call(m, "SetLinkPreference", ctx, linkPreference, timeout)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetLinkPreference indicates an expected call of SetLinkPreference.
-func (mr *MockManagementMockRecorder) SetLinkPreference(linkPreference, timeout any) *gomock.Call {
+func (mr *MockManagementMockRecorder) SetLinkPreference(ctx, linkPreference, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLinkPreference", reflect.TypeOf((*MockManagement)(nil).SetLinkPreference), ctx, linkPreference, timeout)
+}
+
+// SetUserACLEnabledState mocks base method.
+func (m *MockManagement) SetUserACLEnabledState(ctx context.Context, handle int, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserACLEnabledState", ctx, handle, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserACLEnabledState indicates an expected call of SetUserACLEnabledState.
+func (mr *MockManagementMockRecorder) SetUserACLEnabledState(ctx, handle, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserACLEnabledState", reflect.TypeOf((*MockManagement)(nil).SetUserACLEnabledState), ctx, handle, enabled)
+}
+
+// UpdateUserACLEntry mocks base method.
+func (m *MockManagement) UpdateUserACLEntry(ctx context.Context, entry wsman.UserACLEntry, digestPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserACLEntry", ctx, entry, digestPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserACLEntry indicates an expected call of UpdateUserACLEntry.
+func (mr *MockManagementMockRecorder) UpdateUserACLEntry(ctx, entry, digestPassword any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLinkPreference", reflect.TypeOf((*MockManagement)(nil).SetLinkPreference), linkPreference, timeout)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserACLEntry", reflect.TypeOf((*MockManagement)(nil).UpdateUserACLEntry), ctx, entry, digestPassword)
 }
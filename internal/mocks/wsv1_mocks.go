@@ -138,6 +138,21 @@ func (mr *MockFeatureMockRecorder) AddCertificate(c, guid, certInfo any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCertificate", reflect.TypeOf((*MockFeature)(nil).AddCertificate), c, guid, certInfo)
 }
 
+// AddDeviceUser mocks base method.
+func (m *MockFeature) AddDeviceUser(c context.Context, guid string, req dto.DeviceUserRequest) (dto.DeviceUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeviceUser", c, guid, req)
+	ret0, _ := ret[0].(dto.DeviceUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDeviceUser indicates an expected call of AddDeviceUser.
+func (mr *MockFeatureMockRecorder) AddDeviceUser(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeviceUser", reflect.TypeOf((*MockFeature)(nil).AddDeviceUser), c, guid, req)
+}
+
 // CancelUserConsent mocks base method.
 func (m *MockFeature) CancelUserConsent(ctx context.Context, guid string) (dto.UserConsentMessage, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +168,21 @@ func (mr *MockFeatureMockRecorder) CancelUserConsent(ctx, guid any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserConsent", reflect.TypeOf((*MockFeature)(nil).CancelUserConsent), ctx, guid)
 }
 
+// CleanupCertificates mocks base method.
+func (m *MockFeature) CleanupCertificates(c context.Context, guid string, dryRun bool) (dto.CertificateCleanup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupCertificates", c, guid, dryRun)
+	ret0, _ := ret[0].(dto.CertificateCleanup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanupCertificates indicates an expected call of CleanupCertificates.
+func (mr *MockFeatureMockRecorder) CleanupCertificates(c, guid, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupCertificates", reflect.TypeOf((*MockFeature)(nil).CleanupCertificates), c, guid, dryRun)
+}
+
 // CreateAlarmOccurrences mocks base method.
 func (m *MockFeature) CreateAlarmOccurrences(ctx context.Context, guid string, alarm dto.AlarmClockOccurrenceInput) (dto.AddAlarmOutput, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +226,21 @@ func (mr *MockFeatureMockRecorder) DeleteAlarmOccurrences(ctx, guid, instanceID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlarmOccurrences", reflect.TypeOf((*MockFeature)(nil).DeleteAlarmOccurrences), ctx, guid, instanceID)
 }
 
+// DeleteWithCleanup mocks base method.
+func (m *MockFeature) DeleteWithCleanup(ctx context.Context, guid, tenantID string, cleanup bool) (dto.DeviceDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithCleanup", ctx, guid, tenantID, cleanup)
+	ret0, _ := ret[0].(dto.DeviceDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWithCleanup indicates an expected call of DeleteWithCleanup.
+func (mr *MockFeatureMockRecorder) DeleteWithCleanup(ctx, guid, tenantID, cleanup any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithCleanup", reflect.TypeOf((*MockFeature)(nil).DeleteWithCleanup), ctx, guid, tenantID, cleanup)
+}
+
 // Get mocks base method.
 func (m *MockFeature) Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Device, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +271,274 @@ func (mr *MockFeatureMockRecorder) GetAlarmOccurrences(ctx, guid any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmOccurrences", reflect.TypeOf((*MockFeature)(nil).GetAlarmOccurrences), ctx, guid)
 }
 
+// GetCertificateInventory mocks base method.
+func (m *MockFeature) GetCertificateInventory(ctx context.Context, tenantID string, q dto.CertificateInventoryQuery) (dto.CertificateInventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateInventory", ctx, tenantID, q)
+	ret0, _ := ret[0].(dto.CertificateInventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificateInventory indicates an expected call of GetCertificateInventory.
+func (mr *MockFeatureMockRecorder) GetCertificateInventory(ctx, tenantID, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateInventory", reflect.TypeOf((*MockFeature)(nil).GetCertificateInventory), ctx, tenantID, q)
+}
+
+// GetDeviceLock mocks base method.
+func (m *MockFeature) GetDeviceLock(c context.Context, guid string) (dto.DeviceLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceLock", c, guid)
+	ret0, _ := ret[0].(dto.DeviceLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceLock indicates an expected call of GetDeviceLock.
+func (mr *MockFeatureMockRecorder) GetDeviceLock(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceLock", reflect.TypeOf((*MockFeature)(nil).GetDeviceLock), c, guid)
+}
+
+// GetDeviceUsers mocks base method.
+func (m *MockFeature) GetDeviceUsers(c context.Context, guid string) ([]dto.DeviceUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceUsers", c, guid)
+	ret0, _ := ret[0].([]dto.DeviceUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceUsers indicates an expected call of GetDeviceUsers.
+func (mr *MockFeatureMockRecorder) GetDeviceUsers(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceUsers", reflect.TypeOf((*MockFeature)(nil).GetDeviceUsers), c, guid)
+}
+
+// GetKerberosSettings mocks base method.
+func (m *MockFeature) GetKerberosSettings(c context.Context, guid string) (dto.KerberosSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKerberosSettings", c, guid)
+	ret0, _ := ret[0].(dto.KerberosSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKerberosSettings indicates an expected call of GetKerberosSettings.
+func (mr *MockFeatureMockRecorder) GetKerberosSettings(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKerberosSettings", reflect.TypeOf((*MockFeature)(nil).GetKerberosSettings), c, guid)
+}
+
+// GetLinkSettings mocks base method.
+func (m *MockFeature) GetLinkSettings(c context.Context, guid string) ([]dto.LinkSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinkSettings", c, guid)
+	ret0, _ := ret[0].([]dto.LinkSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLinkSettings indicates an expected call of GetLinkSettings.
+func (mr *MockFeatureMockRecorder) GetLinkSettings(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinkSettings", reflect.TypeOf((*MockFeature)(nil).GetLinkSettings), c, guid)
+}
+
+// GetPresence mocks base method.
+func (m *MockFeature) GetPresence(c context.Context, guid string) []dto.Presence {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresence", c, guid)
+	ret0, _ := ret[0].([]dto.Presence)
+	return ret0
+}
+
+// GetPresence indicates an expected call of GetPresence.
+func (mr *MockFeatureMockRecorder) GetPresence(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresence", reflect.TypeOf((*MockFeature)(nil).GetPresence), c, guid)
+}
+
+// GetRedirectionSettings mocks base method.
+func (m *MockFeature) GetRedirectionSettings(c context.Context, guid string) (dto.RedirectionSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedirectionSettings", c, guid)
+	ret0, _ := ret[0].(dto.RedirectionSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedirectionSettings indicates an expected call of GetRedirectionSettings.
+func (mr *MockFeatureMockRecorder) GetRedirectionSettings(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedirectionSettings", reflect.TypeOf((*MockFeature)(nil).GetRedirectionSettings), c, guid)
+}
+
+// GetWiFiPortSettings mocks base method.
+func (m *MockFeature) GetWiFiPortSettings(c context.Context, guid string) (dto.WiFiPortSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWiFiPortSettings", c, guid)
+	ret0, _ := ret[0].(dto.WiFiPortSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWiFiPortSettings indicates an expected call of GetWiFiPortSettings.
+func (mr *MockFeatureMockRecorder) GetWiFiPortSettings(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWiFiPortSettings", reflect.TypeOf((*MockFeature)(nil).GetWiFiPortSettings), c, guid)
+}
+
+// GetWiFiProfiles mocks base method.
+func (m *MockFeature) GetWiFiProfiles(c context.Context, guid string) ([]dto.WiFiProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWiFiProfiles", c, guid)
+	ret0, _ := ret[0].([]dto.WiFiProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWiFiProfiles indicates an expected call of GetWiFiProfiles.
+func (mr *MockFeatureMockRecorder) GetWiFiProfiles(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWiFiProfiles", reflect.TypeOf((*MockFeature)(nil).GetWiFiProfiles), c, guid)
+}
+
+// LockDevice mocks base method.
+func (m *MockFeature) LockDevice(c context.Context, guid string, req dto.DeviceLockRequest) (dto.DeviceLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockDevice", c, guid, req)
+	ret0, _ := ret[0].(dto.DeviceLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockDevice indicates an expected call of LockDevice.
+func (mr *MockFeatureMockRecorder) LockDevice(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockDevice", reflect.TypeOf((*MockFeature)(nil).LockDevice), c, guid, req)
+}
+
+// PingPresence mocks base method.
+func (m *MockFeature) PingPresence(c context.Context, guid, user string, ping dto.PresencePing) ([]dto.Presence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PingPresence", c, guid, user, ping)
+	ret0, _ := ret[0].([]dto.Presence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PingPresence indicates an expected call of PingPresence.
+func (mr *MockFeatureMockRecorder) PingPresence(c, guid, user, ping any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PingPresence", reflect.TypeOf((*MockFeature)(nil).PingPresence), c, guid, user, ping)
+}
+
+// PlanDelete mocks base method.
+func (m *MockFeature) PlanDelete(ctx context.Context, guid, tenantID string) (dto.DryRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanDelete", ctx, guid, tenantID)
+	ret0, _ := ret[0].(dto.DryRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanDelete indicates an expected call of PlanDelete.
+func (mr *MockFeatureMockRecorder) PlanDelete(ctx, guid, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanDelete", reflect.TypeOf((*MockFeature)(nil).PlanDelete), ctx, guid, tenantID)
+}
+
+// QueryAuditLog mocks base method.
+func (m *MockFeature) QueryAuditLog(c context.Context, guid string, query dto.AuditLogQuery) (dto.AuditLogPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryAuditLog", c, guid, query)
+	ret0, _ := ret[0].(dto.AuditLogPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryAuditLog indicates an expected call of QueryAuditLog.
+func (mr *MockFeatureMockRecorder) QueryAuditLog(c, guid, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAuditLog", reflect.TypeOf((*MockFeature)(nil).QueryAuditLog), c, guid, query)
+}
+
+// RemoveDeviceUser mocks base method.
+func (m *MockFeature) RemoveDeviceUser(c context.Context, guid string, handle int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDeviceUser", c, guid, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDeviceUser indicates an expected call of RemoveDeviceUser.
+func (mr *MockFeatureMockRecorder) RemoveDeviceUser(c, guid, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeviceUser", reflect.TypeOf((*MockFeature)(nil).RemoveDeviceUser), c, guid, handle)
+}
+
+// ReorderWiFiProfiles mocks base method.
+func (m *MockFeature) ReorderWiFiProfiles(c context.Context, guid string, order dto.WiFiProfileOrder) ([]dto.WiFiProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderWiFiProfiles", c, guid, order)
+	ret0, _ := ret[0].([]dto.WiFiProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderWiFiProfiles indicates an expected call of ReorderWiFiProfiles.
+func (mr *MockFeatureMockRecorder) ReorderWiFiProfiles(c, guid, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderWiFiProfiles", reflect.TypeOf((*MockFeature)(nil).ReorderWiFiProfiles), c, guid, order)
+}
+
+// SelectKVMDisplay mocks base method.
+func (m *MockFeature) SelectKVMDisplay(c context.Context, guid string, sel dto.KVMDisplaySelection) (dto.KVMDisplaySelected, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectKVMDisplay", c, guid, sel)
+	ret0, _ := ret[0].(dto.KVMDisplaySelected)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectKVMDisplay indicates an expected call of SelectKVMDisplay.
+func (mr *MockFeatureMockRecorder) SelectKVMDisplay(c, guid, sel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectKVMDisplay", reflect.TypeOf((*MockFeature)(nil).SelectKVMDisplay), c, guid, sel)
+}
+
+// SetKVMLimits mocks base method.
+func (m *MockFeature) SetKVMLimits(c context.Context, limits dto.KVMLimits) (dto.KVMLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKVMLimits", c, limits)
+	ret0, _ := ret[0].(dto.KVMLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetKVMLimits indicates an expected call of SetKVMLimits.
+func (mr *MockFeatureMockRecorder) SetKVMLimits(c, limits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKVMLimits", reflect.TypeOf((*MockFeature)(nil).SetKVMLimits), c, limits)
+}
+
+// SetKerberosSettings mocks base method.
+func (m *MockFeature) SetKerberosSettings(c context.Context, guid string, req dto.KerberosSettingsRequest) (dto.KerberosSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKerberosSettings", c, guid, req)
+	ret0, _ := ret[0].(dto.KerberosSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetKerberosSettings indicates an expected call of SetKerberosSettings.
+func (mr *MockFeatureMockRecorder) SetKerberosSettings(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKerberosSettings", reflect.TypeOf((*MockFeature)(nil).SetKerberosSettings), c, guid, req)
+}
+
 // SetLinkPreference mocks base method.
 func (m *MockFeature) SetLinkPreference(c context.Context, guid string, req dto.LinkPreferenceRequest) (dto.LinkPreferenceResponse, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +554,124 @@ func (mr *MockFeatureMockRecorder) SetLinkPreference(c, guid, req any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLinkPreference", reflect.TypeOf((*MockFeature)(nil).SetLinkPreference), c, guid, req)
 }
 
+// GetWsmanCaptureTrace mocks base method.
+func (m *MockFeature) GetWsmanCaptureTrace(c context.Context, guid string) (dto.WsmanCapture, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWsmanCaptureTrace", c, guid)
+	ret0, _ := ret[0].(dto.WsmanCapture)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWsmanCaptureTrace indicates an expected call of GetWsmanCaptureTrace.
+func (mr *MockFeatureMockRecorder) GetWsmanCaptureTrace(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWsmanCaptureTrace", reflect.TypeOf((*MockFeature)(nil).GetWsmanCaptureTrace), c, guid)
+}
+
+// SetLinkSettings mocks base method.
+func (m *MockFeature) SetLinkSettings(c context.Context, guid string, req dto.LinkSettingsRequest) (dto.LinkSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLinkSettings", c, guid, req)
+	ret0, _ := ret[0].(dto.LinkSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLinkSettings indicates an expected call of SetLinkSettings.
+func (mr *MockFeatureMockRecorder) SetLinkSettings(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLinkSettings", reflect.TypeOf((*MockFeature)(nil).SetLinkSettings), c, guid, req)
+}
+
+// SetRedirectionSettings mocks base method.
+func (m *MockFeature) SetRedirectionSettings(c context.Context, guid string, settings dto.RedirectionSettings) (dto.RedirectionSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRedirectionSettings", c, guid, settings)
+	ret0, _ := ret[0].(dto.RedirectionSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRedirectionSettings indicates an expected call of SetRedirectionSettings.
+func (mr *MockFeatureMockRecorder) SetRedirectionSettings(c, guid, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRedirectionSettings", reflect.TypeOf((*MockFeature)(nil).SetRedirectionSettings), c, guid, settings)
+}
+
+// SetTLSSettings mocks base method.
+func (m *MockFeature) SetTLSSettings(c context.Context, guid string, req dto.TLSSettingsRequest) (dto.SettingDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTLSSettings", c, guid, req)
+	ret0, _ := ret[0].(dto.SettingDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTLSSettings indicates an expected call of SetTLSSettings.
+func (mr *MockFeatureMockRecorder) SetTLSSettings(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTLSSettings", reflect.TypeOf((*MockFeature)(nil).SetTLSSettings), c, guid, req)
+}
+
+// SetWiFiPortSettings mocks base method.
+func (m *MockFeature) SetWiFiPortSettings(c context.Context, guid string, req dto.WiFiPortSettingsRequest) (dto.WiFiPortSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWiFiPortSettings", c, guid, req)
+	ret0, _ := ret[0].(dto.WiFiPortSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWiFiPortSettings indicates an expected call of SetWiFiPortSettings.
+func (mr *MockFeatureMockRecorder) SetWiFiPortSettings(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWiFiPortSettings", reflect.TypeOf((*MockFeature)(nil).SetWiFiPortSettings), c, guid, req)
+}
+
+// Start mocks base method.
+func (m *MockFeature) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockFeatureMockRecorder) Start(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockFeature)(nil).Start), ctx)
+}
+
+// StartWsmanCapture mocks base method.
+func (m *MockFeature) StartWsmanCapture(c context.Context, guid string, req dto.WsmanCaptureRequest) (dto.WsmanCapture, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartWsmanCapture", c, guid, req)
+	ret0, _ := ret[0].(dto.WsmanCapture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartWsmanCapture indicates an expected call of StartWsmanCapture.
+func (mr *MockFeatureMockRecorder) StartWsmanCapture(c, guid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWsmanCapture", reflect.TypeOf((*MockFeature)(nil).StartWsmanCapture), c, guid, req)
+}
+
+// StopWsmanCapture mocks base method.
+func (m *MockFeature) StopWsmanCapture(c context.Context, guid string) (dto.WsmanCapture, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopWsmanCapture", c, guid)
+	ret0, _ := ret[0].(dto.WsmanCapture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopWsmanCapture indicates an expected call of StopWsmanCapture.
+func (mr *MockFeatureMockRecorder) StopWsmanCapture(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopWsmanCapture", reflect.TypeOf((*MockFeature)(nil).StopWsmanCapture), c, guid)
+}
+
 // GetAuditLog mocks base method.
 func (m *MockFeature) GetAuditLog(ctx context.Context, startIndex int, guid string) (dto.AuditLog, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +687,20 @@ func (mr *MockFeatureMockRecorder) GetAuditLog(ctx, startIndex, guid any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockFeature)(nil).GetAuditLog), ctx, startIndex, guid)
 }
 
+// StreamAuditLog mocks base method.
+func (m *MockFeature) StreamAuditLog(c context.Context, guid string, emit func(dto.AuditLogEntry) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamAuditLog", c, guid, emit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamAuditLog indicates an expected call of StreamAuditLog.
+func (mr *MockFeatureMockRecorder) StreamAuditLog(c, guid, emit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAuditLog", reflect.TypeOf((*MockFeature)(nil).StreamAuditLog), c, guid, emit)
+}
+
 // GetBootSourceSetting mocks base method.
 func (m *MockFeature) GetBootSourceSetting(ctx context.Context, guid string) ([]dto.BootSources, error) {
 	m.ctrl.T.Helper()
@@ -271,6 +716,21 @@ func (mr *MockFeatureMockRecorder) GetBootSourceSetting(ctx, guid any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBootSourceSetting", reflect.TypeOf((*MockFeature)(nil).GetBootSourceSetting), ctx, guid)
 }
 
+// GetByAttributes mocks base method.
+func (m *MockFeature) GetByAttributes(ctx context.Context, attributes map[string]string, top, skip int, tenantID string) ([]dto.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAttributes", ctx, attributes, top, skip, tenantID)
+	ret0, _ := ret[0].([]dto.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAttributes indicates an expected call of GetByAttributes.
+func (mr *MockFeatureMockRecorder) GetByAttributes(ctx, attributes, top, skip, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAttributes", reflect.TypeOf((*MockFeature)(nil).GetByAttributes), ctx, attributes, top, skip, tenantID)
+}
+
 // GetByColumn mocks base method.
 func (m *MockFeature) GetByColumn(ctx context.Context, columnName, queryValue, tenantID string) ([]dto.Device, error) {
 	m.ctrl.T.Helper()
@@ -331,6 +791,21 @@ func (mr *MockFeatureMockRecorder) GetCertificates(c, guid any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificates", reflect.TypeOf((*MockFeature)(nil).GetCertificates), c, guid)
 }
 
+// GetCrashReport mocks base method.
+func (m *MockFeature) GetCrashReport(c context.Context, guid string) (dto.CrashReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCrashReport", c, guid)
+	ret0, _ := ret[0].(dto.CrashReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCrashReport indicates an expected call of GetCrashReport.
+func (mr *MockFeatureMockRecorder) GetCrashReport(c, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrashReport", reflect.TypeOf((*MockFeature)(nil).GetCrashReport), c, guid)
+}
+
 // GetCount mocks base method.
 func (m *MockFeature) GetCount(arg0 context.Context, arg1 string) (int, error) {
 	m.ctrl.T.Helper()
@@ -438,18 +913,37 @@ func (mr *MockFeatureMockRecorder) GetGeneralSettings(ctx, guid any) *gomock.Cal
 }
 
 // GetHardwareInfo mocks base method.
-func (m *MockFeature) GetHardwareInfo(ctx context.Context, guid string) (dto.HardwareInfo, error) {
+func (m *MockFeature) GetHardwareInfo(ctx context.Context, guid string, sections ...string) (dto.HardwareInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHardwareInfo", ctx, guid)
+	varargs := []any{ctx, guid}
+	for _, a := range sections {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHardwareInfo", varargs...)
 	ret0, _ := ret[0].(dto.HardwareInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHardwareInfo indicates an expected call of GetHardwareInfo.
-func (mr *MockFeatureMockRecorder) GetHardwareInfo(ctx, guid any) *gomock.Call {
+func (mr *MockFeatureMockRecorder) GetHardwareInfo(ctx, guid any, sections ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, guid}, sections...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardwareInfo", reflect.TypeOf((*MockFeature)(nil).GetHardwareInfo), varargs...)
+}
+
+// GetKVMLimits mocks base method.
+func (m *MockFeature) GetKVMLimits(c context.Context) dto.KVMLimits {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKVMLimits", c)
+	ret0, _ := ret[0].(dto.KVMLimits)
+	return ret0
+}
+
+// GetKVMLimits indicates an expected call of GetKVMLimits.
+func (mr *MockFeatureMockRecorder) GetKVMLimits(c any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardwareInfo", reflect.TypeOf((*MockFeature)(nil).GetHardwareInfo), ctx, guid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKVMLimits", reflect.TypeOf((*MockFeature)(nil).GetKVMLimits), c)
 }
 
 // GetKVMScreenSettings mocks base method.
@@ -468,18 +962,23 @@ func (mr *MockFeatureMockRecorder) GetKVMScreenSettings(c, guid any) *gomock.Cal
 }
 
 // GetNetworkSettings mocks base method.
-func (m *MockFeature) GetNetworkSettings(c context.Context, guid string) (dto.NetworkSettings, error) {
+func (m *MockFeature) GetNetworkSettings(c context.Context, guid string, sections ...string) (dto.NetworkSettings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNetworkSettings", c, guid)
+	varargs := []any{c, guid}
+	for _, a := range sections {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkSettings", varargs...)
 	ret0, _ := ret[0].(dto.NetworkSettings)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNetworkSettings indicates an expected call of GetNetworkSettings.
-func (mr *MockFeatureMockRecorder) GetNetworkSettings(c, guid any) *gomock.Call {
+func (mr *MockFeatureMockRecorder) GetNetworkSettings(c, guid any, sections ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkSettings", reflect.TypeOf((*MockFeature)(nil).GetNetworkSettings), c, guid)
+	varargs := append([]any{c, guid}, sections...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkSettings", reflect.TypeOf((*MockFeature)(nil).GetNetworkSettings), varargs...)
 }
 
 // GetPowerCapabilities mocks base method.
@@ -573,18 +1072,77 @@ func (mr *MockFeatureMockRecorder) Insert(ctx, d any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockFeature)(nil).Insert), ctx, d)
 }
 
+// MergeDevices mocks base method.
+func (m *MockFeature) MergeDevices(ctx context.Context, guid, tenantID string, duplicates []string, dryRun bool) (dto.DeviceMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeDevices", ctx, guid, tenantID, duplicates, dryRun)
+	ret0, _ := ret[0].(dto.DeviceMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeDevices indicates an expected call of MergeDevices.
+func (mr *MockFeatureMockRecorder) MergeDevices(ctx, guid, tenantID, duplicates, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDevices", reflect.TypeOf((*MockFeature)(nil).MergeDevices), ctx, guid, tenantID, duplicates, dryRun)
+}
+
+// Patch mocks base method.
+func (m *MockFeature) Patch(ctx context.Context, guid, tenantID string, p dto.DevicePatch) (*dto.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, guid, tenantID, p)
+	ret0, _ := ret[0].(*dto.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockFeatureMockRecorder) Patch(ctx, guid, tenantID, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockFeature)(nil).Patch), ctx, guid, tenantID, p)
+}
+
+// HasActiveRedirection mocks base method.
+func (m *MockFeature) HasActiveRedirection(guid string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasActiveRedirection", guid)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasActiveRedirection indicates an expected call of HasActiveRedirection.
+func (mr *MockFeatureMockRecorder) HasActiveRedirection(guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasActiveRedirection", reflect.TypeOf((*MockFeature)(nil).HasActiveRedirection), guid)
+}
+
+// RefreshControlMode mocks base method.
+func (m *MockFeature) RefreshControlMode(ctx context.Context, guid string) (*dto.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshControlMode", ctx, guid)
+	ret0, _ := ret[0].(*dto.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshControlMode indicates an expected call of RefreshControlMode.
+func (mr *MockFeatureMockRecorder) RefreshControlMode(ctx, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshControlMode", reflect.TypeOf((*MockFeature)(nil).RefreshControlMode), ctx, guid)
+}
+
 // Redirect mocks base method.
-func (m *MockFeature) Redirect(ctx context.Context, conn *websocket.Conn, guid, mode string) error {
+func (m *MockFeature) Redirect(ctx context.Context, conn *websocket.Conn, guid, mode string, limits dto.KVMLimits, options dto.KVMSessionOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Redirect", ctx, conn, guid, mode)
+	ret := m.ctrl.Call(m, "Redirect", ctx, conn, guid, mode, limits, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Redirect indicates an expected call of Redirect.
-func (mr *MockFeatureMockRecorder) Redirect(ctx, conn, guid, mode any) *gomock.Call {
+func (mr *MockFeatureMockRecorder) Redirect(ctx, conn, guid, mode, limits, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redirect", reflect.TypeOf((*MockFeature)(nil).Redirect), ctx, conn, guid, mode)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redirect", reflect.TypeOf((*MockFeature)(nil).Redirect), ctx, conn, guid, mode, limits, options)
 }
 
 // SendConsentCode mocks base method.
@@ -663,6 +1221,20 @@ func (mr *MockFeatureMockRecorder) SetKVMScreenSettings(c, guid, req any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKVMScreenSettings", reflect.TypeOf((*MockFeature)(nil).SetKVMScreenSettings), c, guid, req)
 }
 
+// UnlockDevice mocks base method.
+func (m *MockFeature) UnlockDevice(c context.Context, guid string, force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockDevice", c, guid, force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockDevice indicates an expected call of UnlockDevice.
+func (mr *MockFeatureMockRecorder) UnlockDevice(c, guid, force any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockDevice", reflect.TypeOf((*MockFeature)(nil).UnlockDevice), c, guid, force)
+}
+
 // Update mocks base method.
 func (m *MockFeature) Update(ctx context.Context, d *dto.Device) (*dto.Device, error) {
 	m.ctrl.T.Helper()
@@ -677,3 +1249,18 @@ func (mr *MockFeatureMockRecorder) Update(ctx, d any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFeature)(nil).Update), ctx, d)
 }
+
+// UpdateDeviceUser mocks base method.
+func (m *MockFeature) UpdateDeviceUser(c context.Context, guid string, handle int, req dto.DeviceUserRequest) (dto.DeviceUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeviceUser", c, guid, handle, req)
+	ret0, _ := ret[0].(dto.DeviceUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDeviceUser indicates an expected call of UpdateDeviceUser.
+func (mr *MockFeatureMockRecorder) UpdateDeviceUser(c, guid, handle, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeviceUser", reflect.TypeOf((*MockFeature)(nil).UpdateDeviceUser), c, guid, handle, req)
+}
@@ -0,0 +1,26 @@
+package addresshistory
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		Insert(ctx context.Context, a entity.DeviceAddress) error
+		GetByGUID(ctx context.Context, guid string, limit int) ([]entity.DeviceAddress, error)
+	}
+
+	// Devices is the part of the devices use case the address history depends on.
+	Devices interface {
+		GetByID(ctx context.Context, guid, tenantID string, includeSecrets bool) (*dto.Device, error)
+	}
+
+	Feature interface {
+		Start(ctx context.Context)
+		Resolve(ctx context.Context, guid string) error
+		GetHistory(ctx context.Context, guid string) ([]dto.DeviceAddress, error)
+	}
+)
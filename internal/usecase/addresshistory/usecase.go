@@ -0,0 +1,227 @@
+package addresshistory
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	resolveTimeout = 5 * time.Second
+
+	// resolveInterval is how often at most an unreachable device has its hostname resolved again.
+	resolveInterval = time.Minute
+)
+
+var ErrDatabase = sqldb.DatabaseError{Console: consoleerrors.CreateConsoleError("AddressHistoryUseCase")}
+
+// UseCase resolves the hostnames of the devices again when they become unreachable or are changed, and records
+// the addresses they resolve to whenever those change.
+type UseCase struct {
+	repo     Repository
+	devices  Devices
+	log      logger.Interface
+	events   *eventbus.Bus
+	resolver resolver
+	now      func() time.Time
+
+	mu       sync.Mutex
+	resolved map[string]time.Time
+}
+
+// resolver looks up the addresses of the hostnames of the devices.
+type resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// New creates the address history of the devices.
+func New(repo Repository, devices Devices, log logger.Interface, events *eventbus.Bus) *UseCase {
+	return &UseCase{
+		repo:     repo,
+		devices:  devices,
+		log:      log,
+		events:   events,
+		resolver: net.DefaultResolver,
+		now:      time.Now,
+		resolved: map[string]time.Time{},
+	}
+}
+
+// Start resolves the hostname of a device again whenever requests cannot be delivered to it, at most once per
+// minute, and whenever it is added or changed, until ctx is done.
+func (uc *UseCase) Start(ctx context.Context) {
+	stopUnreachable := wsman.OnUnreachable(func(guid string) {
+		if !uc.due(guid) {
+			return
+		}
+
+		if err := uc.Resolve(ctx, guid); err != nil {
+			uc.log.Debug("addresshistory - Start: device %s not resolved: %v", guid, err)
+		}
+	})
+
+	stopUpdated := eventbus.Subscribe(uc.events, eventbus.DeviceUpdated, func(e eventbus.DeviceUpdatedEvent) {
+		if err := uc.Resolve(ctx, e.GUID); err != nil {
+			uc.log.Debug("addresshistory - Start: device %s not resolved: %v", e.GUID, err)
+		}
+	})
+
+	go func() {
+		<-ctx.Done()
+		stopUnreachable()
+		stopUpdated()
+	}()
+}
+
+// due reports whether the hostname of the device was not resolved for it being unreachable in the last
+// resolveInterval, and takes the resolution for now.
+func (uc *UseCase) due(guid string) bool {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	now := uc.now()
+	if last, ok := uc.resolved[guid]; ok && now.Sub(last) < resolveInterval {
+		return false
+	}
+
+	for g, last := range uc.resolved {
+		if now.Sub(last) >= resolveInterval {
+			delete(uc.resolved, g)
+		}
+	}
+
+	uc.resolved[guid] = now
+
+	return true
+}
+
+// Resolve resolves the hostname of a device and records the addresses when they differ from the last ones
+// recorded, publishing a DeviceAddressChanged event unless it is the first record of the device. Devices
+// connected over CIRA are not reached at their hostname and are skipped. A failed lookup is not recorded,
+// a hostname no longer resolving is.
+func (uc *UseCase) Resolve(ctx context.Context, guid string) error {
+	device, err := uc.devices.GetByID(ctx, guid, "", false)
+	if err != nil {
+		return err
+	}
+
+	if device.MPSUsername != "" || device.Hostname == "" {
+		return nil
+	}
+
+	addresses, err := uc.lookup(ctx, device.Hostname)
+	if err != nil {
+		return err
+	}
+
+	latest, err := uc.repo.GetByGUID(ctx, device.GUID, 1)
+	if err != nil {
+		return ErrDatabase.Wrap("Resolve", "uc.repo.GetByGUID", err)
+	}
+
+	var previous entity.DeviceAddress
+	if len(latest) > 0 {
+		previous = latest[0]
+		if previous.Hostname == device.Hostname && slices.Equal(previous.Addresses, addresses) {
+			return nil
+		}
+	}
+
+	record := entity.DeviceAddress{GUID: device.GUID, Hostname: device.Hostname, Addresses: addresses, RecordedAt: uc.now().UTC()}
+	if len(latest) > 0 && !record.RecordedAt.After(previous.RecordedAt) {
+		// records are kept per second
+		record.RecordedAt = previous.RecordedAt.Add(time.Second)
+	}
+
+	if err := uc.repo.Insert(ctx, record); err != nil {
+		return ErrDatabase.Wrap("Resolve", "uc.repo.Insert", err)
+	}
+
+	if len(latest) == 0 {
+		return nil
+	}
+
+	uc.log.Info("addresshistory - Resolve: device %s moved from %s %v to %s %v",
+		device.GUID, previous.Hostname, previous.Addresses, device.Hostname, addresses)
+
+	eventbus.Publish(uc.events, eventbus.DeviceAddressChanged, eventbus.DeviceAddressChangedEvent{
+		GUID:              device.GUID,
+		Hostname:          device.Hostname,
+		PreviousHostname:  previous.Hostname,
+		Addresses:         addresses,
+		PreviousAddresses: previous.Addresses,
+	})
+
+	return nil
+}
+
+// lookup returns the sorted addresses hostname resolves to, none when it does not resolve. An address
+// literal is its own address.
+func (uc *UseCase) lookup(ctx context.Context, hostname string) ([]string, error) {
+	host := strings.TrimSuffix(strings.TrimPrefix(hostname, "["), "]")
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []string{addr.String()}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, resolveTimeout)
+	defer cancel()
+
+	ipAddrs, err := uc.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return []string{}, nil
+		}
+
+		return nil, err
+	}
+
+	addresses := make([]string, 0, len(ipAddrs))
+
+	for _, ipAddr := range ipAddrs {
+		addr, ok := netip.AddrFromSlice(ipAddr.IP)
+		if !ok {
+			continue
+		}
+
+		addresses = append(addresses, addr.Unmap().WithZone(ipAddr.Zone).String())
+	}
+
+	slices.Sort(addresses)
+
+	return slices.Compact(addresses), nil
+}
+
+// GetHistory returns the hostnames and addresses recorded for a device, newest first.
+func (uc *UseCase) GetHistory(ctx context.Context, guid string) ([]dto.DeviceAddress, error) {
+	device, err := uc.devices.GetByID(ctx, guid, "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := uc.repo.GetByGUID(ctx, device.GUID, 0)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("GetHistory", "uc.repo.GetByGUID", err)
+	}
+
+	history := make([]dto.DeviceAddress, len(records))
+	for i, r := range records {
+		history[i] = dto.DeviceAddress{Hostname: r.Hostname, Addresses: r.Addresses, RecordedAt: r.RecordedAt}
+	}
+
+	return history, nil
+}
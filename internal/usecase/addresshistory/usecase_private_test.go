@@ -0,0 +1,63 @@
+package addresshistory
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResolver struct{}
+
+func (fakeResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	switch host {
+	case "amt01.example.com":
+		return []net.IPAddr{{IP: net.ParseIP("fd00::9")}, {IP: net.ParseIP("10.0.0.9")}, {IP: net.ParseIP("10.0.0.9")}}, nil
+	case "gone.example.com":
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	default:
+		return nil, &net.DNSError{Err: "server misbehaving", Name: host, IsTemporary: true}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	uc := &UseCase{resolver: fakeResolver{}}
+
+	addresses, err := uc.lookup(context.Background(), "amt01.example.com")
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.9", "fd00::9"}, addresses, "sorted without duplicates, IPv4-mapped addresses unmapped")
+
+	addresses, err = uc.lookup(context.Background(), "[fe80::1%eth0]")
+	require.NoError(t, err)
+	require.Equal(t, []string{"fe80::1%eth0"}, addresses)
+
+	addresses, err = uc.lookup(context.Background(), "gone.example.com")
+	require.NoError(t, err)
+	require.Empty(t, addresses, "a hostname no longer resolving is recorded")
+
+	_, err = uc.lookup(context.Background(), "flaky.example.com")
+
+	var dnsErr *net.DNSError
+	require.True(t, errors.As(err, &dnsErr), "a failed lookup is not recorded")
+}
+
+func TestDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uc := &UseCase{now: func() time.Time { return now }, resolved: map[string]time.Time{}}
+
+	require.True(t, uc.due("guid1"))
+	require.False(t, uc.due("guid1"), "resolved at most once per interval")
+	require.True(t, uc.due("guid2"))
+
+	now = now.Add(resolveInterval)
+
+	require.True(t, uc.due("guid1"))
+	require.Len(t, uc.resolved, 1, "expired resolutions are forgotten")
+}
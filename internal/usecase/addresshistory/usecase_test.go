@@ -0,0 +1,160 @@
+package addresshistory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/addresshistory"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errQuery = errors.New("query failed")
+
+func recordOf(hostname string, addresses ...string) gomock.Matcher {
+	return gomock.Cond(func(a entity.DeviceAddress) bool {
+		return a.GUID == "guid1" && a.Hostname == hostname && len(a.Addresses) == len(addresses) &&
+			(len(addresses) == 0 || a.Addresses[0] == addresses[0]) && !a.RecordedAt.IsZero()
+	})
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	recorded := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		mock    func(*mocks.MockAddressHistoryRepository, *mocks.MockAddressHistoryDevices)
+		changed *eventbus.DeviceAddressChangedEvent
+		err     error
+	}{
+		{
+			name: "records the first addresses without an event",
+			mock: func(repo *mocks.MockAddressHistoryRepository, d *mocks.MockAddressHistoryDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "guid1", "", false).Return(&dto.Device{GUID: "guid1", Hostname: "10.0.0.5"}, nil)
+				repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).Return([]entity.DeviceAddress{}, nil)
+				repo.EXPECT().Insert(gomock.Any(), recordOf("10.0.0.5", "10.0.0.5")).Return(nil)
+			},
+		},
+		{
+			name: "unchanged addresses are not recorded",
+			mock: func(repo *mocks.MockAddressHistoryRepository, d *mocks.MockAddressHistoryDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "guid1", "", false).Return(&dto.Device{GUID: "guid1", Hostname: "[fd00::5]"}, nil)
+				repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).
+					Return([]entity.DeviceAddress{{GUID: "guid1", Hostname: "[fd00::5]", Addresses: []string{"fd00::5"}, RecordedAt: recorded}}, nil)
+			},
+		},
+		{
+			name: "a changed hostname is recorded and published",
+			mock: func(repo *mocks.MockAddressHistoryRepository, d *mocks.MockAddressHistoryDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "guid1", "", false).Return(&dto.Device{GUID: "guid1", Hostname: "10.0.0.9"}, nil)
+				repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).
+					Return([]entity.DeviceAddress{{GUID: "guid1", Hostname: "10.0.0.5", Addresses: []string{"10.0.0.5"}, RecordedAt: recorded}}, nil)
+				repo.EXPECT().Insert(gomock.Any(), recordOf("10.0.0.9", "10.0.0.9")).Return(nil)
+			},
+			changed: &eventbus.DeviceAddressChangedEvent{
+				GUID:              "guid1",
+				Hostname:          "10.0.0.9",
+				PreviousHostname:  "10.0.0.5",
+				Addresses:         []string{"10.0.0.9"},
+				PreviousAddresses: []string{"10.0.0.5"},
+			},
+		},
+		{
+			name: "devices connected over CIRA are skipped",
+			mock: func(_ *mocks.MockAddressHistoryRepository, d *mocks.MockAddressHistoryDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "guid1", "", false).Return(&dto.Device{GUID: "guid1", Hostname: "10.0.0.5", MPSUsername: "admin"}, nil)
+			},
+		},
+		{
+			name: "unknown device",
+			mock: func(_ *mocks.MockAddressHistoryRepository, d *mocks.MockAddressHistoryDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "guid1", "", false).Return(nil, devices.ErrNotFound)
+			},
+			err: devices.ErrNotFound,
+		},
+		{
+			name: "database error",
+			mock: func(repo *mocks.MockAddressHistoryRepository, d *mocks.MockAddressHistoryDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "guid1", "", false).Return(&dto.Device{GUID: "guid1", Hostname: "10.0.0.5"}, nil)
+				repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).Return(nil, errQuery)
+			},
+			err: addresshistory.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := mocks.NewMockAddressHistoryRepository(ctrl)
+			d := mocks.NewMockAddressHistoryDevices(ctrl)
+
+			tc.mock(repo, d)
+
+			bus := eventbus.New()
+			defer bus.Close()
+
+			changed := make(chan eventbus.DeviceAddressChangedEvent, 1)
+			eventbus.Subscribe(bus, eventbus.DeviceAddressChanged, func(e eventbus.DeviceAddressChangedEvent) { changed <- e })
+
+			uc := addresshistory.New(repo, d, logger.New("error"), bus)
+
+			err := uc.Resolve(context.Background(), "guid1")
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tc.changed == nil {
+				return
+			}
+
+			select {
+			case e := <-changed:
+				require.Equal(t, *tc.changed, e)
+			case <-time.After(time.Second):
+				t.Fatal("no DeviceAddressChanged event")
+			}
+		})
+	}
+}
+
+func TestGetHistory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockAddressHistoryRepository(ctrl)
+	d := mocks.NewMockAddressHistoryDevices(ctrl)
+
+	recorded := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	d.EXPECT().GetByID(gomock.Any(), "GUID1", "", false).Return(&dto.Device{GUID: "guid1"}, nil)
+	repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 0).Return([]entity.DeviceAddress{
+		{GUID: "guid1", Hostname: "amt01.example.com", Addresses: []string{"10.0.0.9"}, RecordedAt: recorded.Add(time.Hour)},
+		{GUID: "guid1", Hostname: "amt01.example.com", Addresses: []string{"10.0.0.5"}, RecordedAt: recorded},
+	}, nil)
+
+	uc := addresshistory.New(repo, d, logger.New("error"), nil)
+
+	history, err := uc.GetHistory(context.Background(), "GUID1")
+	require.NoError(t, err)
+	require.Equal(t, []dto.DeviceAddress{
+		{Hostname: "amt01.example.com", Addresses: []string{"10.0.0.9"}, RecordedAt: recorded.Add(time.Hour)},
+		{Hostname: "amt01.example.com", Addresses: []string{"10.0.0.5"}, RecordedAt: recorded},
+	}, history)
+}
@@ -65,7 +65,7 @@ func (uc *UseCase) ExecuteCall(ctx context.Context, guid, call, tenantID string)
 		return &dto.Explorer{}, ErrExplorerUseCase.Wrap("ExecuteCall", "uc.amt.Get"+call, nil)
 	}
 
-	input := make([]reflect.Value, 0)
+	input := []reflect.Value{reflect.ValueOf(ctx)}
 	// invoke the method
 	resultType, err := invokeMethod(input, method)
 	if err != nil {
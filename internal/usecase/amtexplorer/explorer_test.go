@@ -199,7 +199,7 @@ func TestExecuteCall(t *testing.T) {
 					Return(amt, nil)
 
 				amt.EXPECT().
-					GetAMT8021xCredentialContext().
+					GetAMT8021xCredentialContext(gomock.Any()).
 					Return(ieee8021x.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -219,7 +219,7 @@ func TestExecuteCall(t *testing.T) {
 					Return(amt, nil)
 
 				amt.EXPECT().
-					GetAMT8021xCredentialContext().
+					GetAMT8021xCredentialContext(gomock.Any()).
 					Return(ieee8021x.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -239,7 +239,7 @@ func TestExecuteCall(t *testing.T) {
 					Return(amt, nil)
 
 				amt.EXPECT().
-					GetAMT8021xProfile().
+					GetAMT8021xProfile(gomock.Any()).
 					Return(ieee8021x.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -258,7 +258,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMT8021xProfile().
+					GetAMT8021xProfile(gomock.Any()).
 					Return(ieee8021x.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -277,7 +277,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTAlarmClockService().
+					GetAMTAlarmClockService(gomock.Any()).
 					Return(alarmclock.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: dto.Explorer{
@@ -299,7 +299,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTAlarmClockService().
+					GetAMTAlarmClockService(gomock.Any()).
 					Return(alarmclock.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -318,7 +318,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTAuditLog().
+					GetAMTAuditLog(gomock.Any()).
 					Return(auditlog.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: dto.Explorer{
@@ -340,7 +340,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTAuditLog().
+					GetAMTAuditLog(gomock.Any()).
 					Return(auditlog.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -359,7 +359,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTAuthorizationService().
+					GetAMTAuthorizationService(gomock.Any()).
 					Return(authorization.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: dto.Explorer{
@@ -381,7 +381,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTAuthorizationService().
+					GetAMTAuthorizationService(gomock.Any()).
 					Return(authorization.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -400,7 +400,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTBootCapabilities().
+					GetAMTBootCapabilities(gomock.Any()).
 					Return(boot.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -419,7 +419,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTBootCapabilities().
+					GetAMTBootCapabilities(gomock.Any()).
 					Return(boot.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -438,7 +438,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTBootSettingData().
+					GetAMTBootSettingData(gomock.Any()).
 					Return(boot.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -457,7 +457,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTBootSettingData().
+					GetAMTBootSettingData(gomock.Any()).
 					Return(boot.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -476,7 +476,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTEnvironmentDetectionSettingData().
+					GetAMTEnvironmentDetectionSettingData(gomock.Any()).
 					Return(environmentdetection.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -495,7 +495,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTEnvironmentDetectionSettingData().
+					GetAMTEnvironmentDetectionSettingData(gomock.Any()).
 					Return(environmentdetection.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -514,7 +514,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTEthernetPortSettings().
+					GetAMTEthernetPortSettings(gomock.Any()).
 					Return(ethernetport.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -533,7 +533,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTEthernetPortSettings().
+					GetAMTEthernetPortSettings(gomock.Any()).
 					Return(ethernetport.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -552,7 +552,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTGeneralSettings().
+					GetAMTGeneralSettings(gomock.Any()).
 					Return(general.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -571,7 +571,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTGeneralSettings().
+					GetAMTGeneralSettings(gomock.Any()).
 					Return(general.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -590,7 +590,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTKerberosSettingData().
+					GetAMTKerberosSettingData(gomock.Any()).
 					Return(kerberos.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -609,7 +609,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTKerberosSettingData().
+					GetAMTKerberosSettingData(gomock.Any()).
 					Return(kerberos.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -628,7 +628,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTManagementPresenceRemoteSAP().
+					GetAMTManagementPresenceRemoteSAP(gomock.Any()).
 					Return(managementpresence.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -647,7 +647,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTManagementPresenceRemoteSAP().
+					GetAMTManagementPresenceRemoteSAP(gomock.Any()).
 					Return(managementpresence.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -666,7 +666,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTMessageLog().
+					GetAMTMessageLog(gomock.Any()).
 					Return(messagelog.Response{Message: &client.Message{
 						XMLInput:  executeResponse.XMLInput,
 						XMLOutput: executeResponse.XMLOutput,
@@ -688,7 +688,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTMessageLog().
+					GetAMTMessageLog(gomock.Any()).
 					Return(messagelog.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -707,7 +707,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTMPSUsernamePassword().
+					GetAMTMPSUsernamePassword(gomock.Any()).
 					Return(mps.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -726,7 +726,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTMPSUsernamePassword().
+					GetAMTMPSUsernamePassword(gomock.Any()).
 					Return(mps.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -745,7 +745,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTPublicKeyCertificate().
+					GetAMTPublicKeyCertificate(gomock.Any()).
 					Return(publickey.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -764,7 +764,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTPublicKeyCertificate().
+					GetAMTPublicKeyCertificate(gomock.Any()).
 					Return(publickey.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -783,7 +783,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTPublicKeyManagementService().
+					GetAMTPublicKeyManagementService(gomock.Any()).
 					Return(publickey.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -802,7 +802,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTPublicKeyManagementService().
+					GetAMTPublicKeyManagementService(gomock.Any()).
 					Return(publickey.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -821,7 +821,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTPublicPrivateKeyPair().
+					GetAMTPublicPrivateKeyPair(gomock.Any()).
 					Return(publicprivate.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -840,7 +840,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTPublicPrivateKeyPair().
+					GetAMTPublicPrivateKeyPair(gomock.Any()).
 					Return(publicprivate.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -859,7 +859,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -878,7 +878,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -897,7 +897,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTRemoteAccessPolicyAppliesToMPS().
+					GetAMTRemoteAccessPolicyAppliesToMPS(gomock.Any()).
 					Return(remoteaccess.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -916,7 +916,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTRemoteAccessPolicyAppliesToMPS().
+					GetAMTRemoteAccessPolicyAppliesToMPS(gomock.Any()).
 					Return(remoteaccess.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -935,7 +935,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTRemoteAccessPolicyRule().
+					GetAMTRemoteAccessPolicyRule(gomock.Any()).
 					Return(remoteaccess.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -954,7 +954,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTRemoteAccessPolicyRule().
+					GetAMTRemoteAccessPolicyRule(gomock.Any()).
 					Return(remoteaccess.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -973,7 +973,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTRemoteAccessService().
+					GetAMTRemoteAccessService(gomock.Any()).
 					Return(remoteaccess.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -992,7 +992,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTRemoteAccessService().
+					GetAMTRemoteAccessService(gomock.Any()).
 					Return(remoteaccess.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1011,7 +1011,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTSetupAndConfigurationService().
+					GetAMTSetupAndConfigurationService(gomock.Any()).
 					Return(setupandconfiguration.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1030,7 +1030,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTSetupAndConfigurationService().
+					GetAMTSetupAndConfigurationService(gomock.Any()).
 					Return(setupandconfiguration.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1049,7 +1049,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTTimeSynchronizationService().
+					GetAMTTimeSynchronizationService(gomock.Any()).
 					Return(timesynchronization.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1068,7 +1068,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTTimeSynchronizationService().
+					GetAMTTimeSynchronizationService(gomock.Any()).
 					Return(timesynchronization.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1087,7 +1087,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTTLSCredentialContext().
+					GetAMTTLSCredentialContext(gomock.Any()).
 					Return(tls.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1106,7 +1106,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTTLSCredentialContext().
+					GetAMTTLSCredentialContext(gomock.Any()).
 					Return(tls.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1125,7 +1125,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTTLSProtocolEndpointCollection().
+					GetAMTTLSProtocolEndpointCollection(gomock.Any()).
 					Return(tls.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1144,7 +1144,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTTLSProtocolEndpointCollection().
+					GetAMTTLSProtocolEndpointCollection(gomock.Any()).
 					Return(tls.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1163,7 +1163,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTTLSSettingData().
+					GetAMTTLSSettingData(gomock.Any()).
 					Return(tls.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1182,7 +1182,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTTLSSettingData().
+					GetAMTTLSSettingData(gomock.Any()).
 					Return(tls.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1201,7 +1201,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTUserInitiatedConnectionService().
+					GetAMTUserInitiatedConnectionService(gomock.Any()).
 					Return(userinitiatedconnection.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1220,7 +1220,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTUserInitiatedConnectionService().
+					GetAMTUserInitiatedConnectionService(gomock.Any()).
 					Return(userinitiatedconnection.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1240,7 +1240,7 @@ func TestExecuteCall(t *testing.T) {
 					Return(amt, nil)
 
 				amt.EXPECT().
-					GetAMTWiFiPortConfigurationService().
+					GetAMTWiFiPortConfigurationService(gomock.Any()).
 					Return(wifiportconfiguration.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1259,7 +1259,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetAMTWiFiPortConfigurationService().
+					GetAMTWiFiPortConfigurationService(gomock.Any()).
 					Return(wifiportconfiguration.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1278,7 +1278,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMBIOSElement().
+					GetCIMBIOSElement(gomock.Any()).
 					Return(bios.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1297,7 +1297,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMBIOSElement().
+					GetCIMBIOSElement(gomock.Any()).
 					Return(bios.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1316,7 +1316,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMBootConfigSetting().
+					GetCIMBootConfigSetting(gomock.Any()).
 					Return(cimboot.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1335,7 +1335,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMBootConfigSetting().
+					GetCIMBootConfigSetting(gomock.Any()).
 					Return(cimboot.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1354,7 +1354,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMBootService().
+					GetCIMBootService(gomock.Any()).
 					Return(cimboot.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1373,7 +1373,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMBootService().
+					GetCIMBootService(gomock.Any()).
 					Return(cimboot.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1392,7 +1392,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimboot.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1411,7 +1411,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimboot.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1430,7 +1430,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMCard().
+					GetCIMCard(gomock.Any()).
 					Return(card.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1449,7 +1449,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMCard().
+					GetCIMCard(gomock.Any()).
 					Return(card.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1468,7 +1468,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMChassis().
+					GetCIMChassis(gomock.Any()).
 					Return(chassis.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1487,7 +1487,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMChassis().
+					GetCIMChassis(gomock.Any()).
 					Return(chassis.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1506,7 +1506,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMChip().
+					GetCIMChip(gomock.Any()).
 					Return(chip.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1525,7 +1525,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMChip().
+					GetCIMChip(gomock.Any()).
 					Return(chip.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1544,7 +1544,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMComputerSystemPackage().
+					GetCIMComputerSystemPackage(gomock.Any()).
 					Return(computer.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1563,7 +1563,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMComputerSystemPackage().
+					GetCIMComputerSystemPackage(gomock.Any()).
 					Return(computer.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1582,7 +1582,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMConcreteDependency().
+					GetCIMConcreteDependency(gomock.Any()).
 					Return(concrete.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1601,7 +1601,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMConcreteDependency().
+					GetCIMConcreteDependency(gomock.Any()).
 					Return(concrete.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1620,7 +1620,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMCredentialContext().
+					GetCIMCredentialContext(gomock.Any()).
 					Return(credential.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1639,7 +1639,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMCredentialContext().
+					GetCIMCredentialContext(gomock.Any()).
 					Return(credential.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1658,7 +1658,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMIEEE8021xSettings().
+					GetCIMIEEE8021xSettings(gomock.Any()).
 					Return(cimieee8021x.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1677,7 +1677,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMIEEE8021xSettings().
+					GetCIMIEEE8021xSettings(gomock.Any()).
 					Return(cimieee8021x.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1696,7 +1696,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMKVMRedirectionSAP().
+					GetCIMKVMRedirectionSAP(gomock.Any()).
 					Return(kvm.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1715,7 +1715,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMKVMRedirectionSAP().
+					GetCIMKVMRedirectionSAP(gomock.Any()).
 					Return(kvm.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1734,7 +1734,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMMediaAccessDevice().
+					GetCIMMediaAccessDevice(gomock.Any()).
 					Return(mediaaccess.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1753,7 +1753,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMMediaAccessDevice().
+					GetCIMMediaAccessDevice(gomock.Any()).
 					Return(mediaaccess.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1772,7 +1772,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMPhysicalMemory().
+					GetCIMPhysicalMemory(gomock.Any()).
 					Return(physical.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1791,7 +1791,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMPhysicalMemory().
+					GetCIMPhysicalMemory(gomock.Any()).
 					Return(physical.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1810,7 +1810,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMPhysicalPackage().
+					GetCIMPhysicalPackage(gomock.Any()).
 					Return(physical.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1829,7 +1829,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMPhysicalPackage().
+					GetCIMPhysicalPackage(gomock.Any()).
 					Return(physical.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1848,7 +1848,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMPowerManagementService().
+					GetCIMPowerManagementService(gomock.Any()).
 					Return(power.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1867,7 +1867,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMPowerManagementService().
+					GetCIMPowerManagementService(gomock.Any()).
 					Return(power.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1886,7 +1886,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMProcessor().
+					GetCIMProcessor(gomock.Any()).
 					Return(processor.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1905,7 +1905,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMProcessor().
+					GetCIMProcessor(gomock.Any()).
 					Return(processor.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1924,7 +1924,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMServiceAvailableToElement().
+					GetCIMServiceAvailableToElement(gomock.Any()).
 					Return(service.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1943,7 +1943,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMServiceAvailableToElement().
+					GetCIMServiceAvailableToElement(gomock.Any()).
 					Return(service.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -1962,7 +1962,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMSoftwareIdentity().
+					GetCIMSoftwareIdentity(gomock.Any()).
 					Return(software.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -1981,7 +1981,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMSoftwareIdentity().
+					GetCIMSoftwareIdentity(gomock.Any()).
 					Return(software.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -2000,7 +2000,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMSystemPackaging().
+					GetCIMSystemPackaging(gomock.Any()).
 					Return(system.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -2019,7 +2019,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMSystemPackaging().
+					GetCIMSystemPackaging(gomock.Any()).
 					Return(system.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -2038,7 +2038,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMWiFiEndpointSettings().
+					GetCIMWiFiEndpointSettings(gomock.Any()).
 					Return(wifi.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -2057,7 +2057,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMWiFiEndpointSettings().
+					GetCIMWiFiEndpointSettings(gomock.Any()).
 					Return(wifi.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -2076,7 +2076,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMWiFiPort().
+					GetCIMWiFiPort(gomock.Any()).
 					Return(wifi.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -2095,7 +2095,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetCIMWiFiPort().
+					GetCIMWiFiPort(gomock.Any()).
 					Return(wifi.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -2114,7 +2114,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetIPS8021xCredentialContext().
+					GetIPS8021xCredentialContext(gomock.Any()).
 					Return(ipsieee8021x.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -2133,7 +2133,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetIPS8021xCredentialContext().
+					GetIPS8021xCredentialContext(gomock.Any()).
 					Return(ipsieee8021x.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -2152,7 +2152,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetIPSAlarmClockOccurrence().
+					GetIPSAlarmClockOccurrence(gomock.Any()).
 					Return(ipsalarmclock.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -2171,7 +2171,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetIPSAlarmClockOccurrence().
+					GetIPSAlarmClockOccurrence(gomock.Any()).
 					Return(ipsalarmclock.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -2190,7 +2190,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetIPSHostBasedSetupService().
+					GetIPSHostBasedSetupService(gomock.Any()).
 					Return(hostbasedsetup.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -2209,7 +2209,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetIPSHostBasedSetupService().
+					GetIPSHostBasedSetupService(gomock.Any()).
 					Return(hostbasedsetup.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -2228,7 +2228,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetIPSIEEE8021xSettings().
+					GetIPSIEEE8021xSettings(gomock.Any()).
 					Return(ipsieee8021x.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -2247,7 +2247,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetIPSIEEE8021xSettings().
+					GetIPSIEEE8021xSettings(gomock.Any()).
 					Return(ipsieee8021x.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -2266,7 +2266,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{Message: &client.Message{XMLInput: executeResponse.XMLInput, XMLOutput: executeResponse.XMLOutput}}, nil)
 			},
 			res: executeResponse,
@@ -2285,7 +2285,7 @@ func TestExecuteCall(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), true).
 					Return(amt, nil)
 				amt.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{}, ErrExplorerGeneral)
 			},
 			res: &dto.Explorer{},
@@ -55,63 +55,63 @@ import (
 
 type (
 	AMTExplorer interface {
-		GetAMT8021xCredentialContext() (ieee8021x.Response, error)
-		GetAMT8021xProfile() (ieee8021x.Response, error)
-		GetAMTAlarmClockService() (alarmclock.Response, error)
-		GetAMTAuditLog() (auditlog.Response, error)
-		GetAMTAuthorizationService() (authorization.Response, error)
-		GetAMTBootCapabilities() (boot.Response, error)
-		GetAMTBootSettingData() (boot.Response, error)
-		GetAMTEnvironmentDetectionSettingData() (environmentdetection.Response, error)
-		GetAMTEthernetPortSettings() (ethernetport.Response, error)
-		GetAMTGeneralSettings() (general.Response, error)
-		GetAMTKerberosSettingData() (kerberos.Response, error)
-		GetAMTManagementPresenceRemoteSAP() (managementpresence.Response, error)
-		GetAMTMessageLog() (messagelog.Response, error)
-		GetAMTMPSUsernamePassword() (mps.Response, error)
-		GetAMTPublicKeyCertificate() (publickey.Response, error)
-		GetAMTPublicKeyManagementService() (publickey.Response, error)
-		GetAMTPublicPrivateKeyPair() (publicprivate.Response, error)
-		GetAMTRedirectionService() (redirection.Response, error)
-		GetAMTRemoteAccessPolicyAppliesToMPS() (remoteaccess.Response, error)
-		GetAMTRemoteAccessPolicyRule() (remoteaccess.Response, error)
-		GetAMTRemoteAccessService() (remoteaccess.Response, error)
-		GetAMTSetupAndConfigurationService() (setupandconfiguration.Response, error)
-		GetAMTTimeSynchronizationService() (timesynchronization.Response, error)
-		GetAMTTLSCredentialContext() (tls.Response, error)
-		GetAMTTLSProtocolEndpointCollection() (tls.Response, error)
-		GetAMTTLSSettingData() (tls.Response, error)
-		GetAMTUserInitiatedConnectionService() (userinitiatedconnection.Response, error)
-		GetAMTWiFiPortConfigurationService() (wifiportconfiguration.Response, error)
-		GetCIMBIOSElement() (bios.Response, error)
-		GetCIMBootConfigSetting() (cimBoot.Response, error)
-		GetCIMBootService() (cimBoot.Response, error)
-		GetCIMBootSourceSetting() (cimBoot.Response, error)
-		GetCIMCard() (card.Response, error)
-		GetCIMChassis() (chassis.Response, error)
-		GetCIMChip() (chip.Response, error)
-		GetCIMComputerSystemPackage() (computer.Response, error)
-		GetCIMConcreteDependency() (concrete.Response, error)
-		GetCIMCredentialContext() (credential.Response, error)
-		GetCIMIEEE8021xSettings() (cimIEEE8021x.Response, error)
-		GetCIMKVMRedirectionSAP() (kvm.Response, error)
-		GetCIMMediaAccessDevice() (mediaaccess.Response, error)
-		GetCIMPhysicalMemory() (physical.Response, error)
-		GetCIMPhysicalPackage() (physical.Response, error)
-		GetCIMPowerManagementService() (power.Response, error)
-		GetCIMProcessor() (processor.Response, error)
-		GetCIMServiceAvailableToElement() (service.Response, error)
-		GetCIMSoftwareIdentity() (software.Response, error)
-		GetCIMSystemPackaging() (system.Response, error)
-		GetCIMWiFiEndpointSettings() (wifi.Response, error)
-		GetCIMWiFiPort() (wifi.Response, error)
-		GetIPS8021xCredentialContext() (ipsIEEE8021x.Response, error)
-		GetIPSAlarmClockOccurrence() (ipsAlarmClock.Response, error)
-		GetIPSHostBasedSetupService() (hostbasedsetup.Response, error)
-		GetIPSIEEE8021xSettings() (ipsIEEE8021x.Response, error)
-		GetIPSOptInService() (optin.Response, error)
-		GetIPSKVMRedirectionSettings() (kvmredirection.Response, error)
-		GetIPSScreenSettingData() (screensetting.Response, error)
+		GetAMT8021xCredentialContext(ctx context.Context) (ieee8021x.Response, error)
+		GetAMT8021xProfile(ctx context.Context) (ieee8021x.Response, error)
+		GetAMTAlarmClockService(ctx context.Context) (alarmclock.Response, error)
+		GetAMTAuditLog(ctx context.Context) (auditlog.Response, error)
+		GetAMTAuthorizationService(ctx context.Context) (authorization.Response, error)
+		GetAMTBootCapabilities(ctx context.Context) (boot.Response, error)
+		GetAMTBootSettingData(ctx context.Context) (boot.Response, error)
+		GetAMTEnvironmentDetectionSettingData(ctx context.Context) (environmentdetection.Response, error)
+		GetAMTEthernetPortSettings(ctx context.Context) (ethernetport.Response, error)
+		GetAMTGeneralSettings(ctx context.Context) (general.Response, error)
+		GetAMTKerberosSettingData(ctx context.Context) (kerberos.Response, error)
+		GetAMTManagementPresenceRemoteSAP(ctx context.Context) (managementpresence.Response, error)
+		GetAMTMessageLog(ctx context.Context) (messagelog.Response, error)
+		GetAMTMPSUsernamePassword(ctx context.Context) (mps.Response, error)
+		GetAMTPublicKeyCertificate(ctx context.Context) (publickey.Response, error)
+		GetAMTPublicKeyManagementService(ctx context.Context) (publickey.Response, error)
+		GetAMTPublicPrivateKeyPair(ctx context.Context) (publicprivate.Response, error)
+		GetAMTRedirectionService(ctx context.Context) (redirection.Response, error)
+		GetAMTRemoteAccessPolicyAppliesToMPS(ctx context.Context) (remoteaccess.Response, error)
+		GetAMTRemoteAccessPolicyRule(ctx context.Context) (remoteaccess.Response, error)
+		GetAMTRemoteAccessService(ctx context.Context) (remoteaccess.Response, error)
+		GetAMTSetupAndConfigurationService(ctx context.Context) (setupandconfiguration.Response, error)
+		GetAMTTimeSynchronizationService(ctx context.Context) (timesynchronization.Response, error)
+		GetAMTTLSCredentialContext(ctx context.Context) (tls.Response, error)
+		GetAMTTLSProtocolEndpointCollection(ctx context.Context) (tls.Response, error)
+		GetAMTTLSSettingData(ctx context.Context) (tls.Response, error)
+		GetAMTUserInitiatedConnectionService(ctx context.Context) (userinitiatedconnection.Response, error)
+		GetAMTWiFiPortConfigurationService(ctx context.Context) (wifiportconfiguration.Response, error)
+		GetCIMBIOSElement(ctx context.Context) (bios.Response, error)
+		GetCIMBootConfigSetting(ctx context.Context) (cimBoot.Response, error)
+		GetCIMBootService(ctx context.Context) (cimBoot.Response, error)
+		GetCIMBootSourceSetting(ctx context.Context) (cimBoot.Response, error)
+		GetCIMCard(ctx context.Context) (card.Response, error)
+		GetCIMChassis(ctx context.Context) (chassis.Response, error)
+		GetCIMChip(ctx context.Context) (chip.Response, error)
+		GetCIMComputerSystemPackage(ctx context.Context) (computer.Response, error)
+		GetCIMConcreteDependency(ctx context.Context) (concrete.Response, error)
+		GetCIMCredentialContext(ctx context.Context) (credential.Response, error)
+		GetCIMIEEE8021xSettings(ctx context.Context) (cimIEEE8021x.Response, error)
+		GetCIMKVMRedirectionSAP(ctx context.Context) (kvm.Response, error)
+		GetCIMMediaAccessDevice(ctx context.Context) (mediaaccess.Response, error)
+		GetCIMPhysicalMemory(ctx context.Context) (physical.Response, error)
+		GetCIMPhysicalPackage(ctx context.Context) (physical.Response, error)
+		GetCIMPowerManagementService(ctx context.Context) (power.Response, error)
+		GetCIMProcessor(ctx context.Context) (processor.Response, error)
+		GetCIMServiceAvailableToElement(ctx context.Context) (service.Response, error)
+		GetCIMSoftwareIdentity(ctx context.Context) (software.Response, error)
+		GetCIMSystemPackaging(ctx context.Context) (system.Response, error)
+		GetCIMWiFiEndpointSettings(ctx context.Context) (wifi.Response, error)
+		GetCIMWiFiPort(ctx context.Context) (wifi.Response, error)
+		GetIPS8021xCredentialContext(ctx context.Context) (ipsIEEE8021x.Response, error)
+		GetIPSAlarmClockOccurrence(ctx context.Context) (ipsAlarmClock.Response, error)
+		GetIPSHostBasedSetupService(ctx context.Context) (hostbasedsetup.Response, error)
+		GetIPSIEEE8021xSettings(ctx context.Context) (ipsIEEE8021x.Response, error)
+		GetIPSOptInService(ctx context.Context) (optin.Response, error)
+		GetIPSKVMRedirectionSettings(ctx context.Context) (kvmredirection.Response, error)
+		GetIPSScreenSettingData(ctx context.Context) (screensetting.Response, error)
 	}
 	Feature interface {
 		GetExplorerSupportedCalls() []string
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
-	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 
 	"github.com/device-management-toolkit/console/internal/entity"
@@ -41,12 +40,12 @@ func (g GoWSMANMessages) DestroyWsmanClient(device dto.Device) {
 
 func (g GoWSMANMessages) SetupWsmanClient(device entity.Device, logAMTMessages bool) (AMTExplorer, error) {
 	clientParams := client.Parameters{
-		Target:            device.Hostname,
+		Target:            wsmanAPI.TargetHost(device, true),
 		Username:          device.Username,
 		UseDigest:         true,
 		UseTLS:            device.UseTLS,
 		SelfSignedAllowed: device.AllowSelfSigned,
-		LogAMTMessages:    logAMTMessages,
+		LogAMTMessages:    logAMTMessages && logger.DebugEnabled(logger.SubsystemWsman, device.GUID),
 		IsRedirection:     false,
 	}
 
@@ -65,7 +64,7 @@ func (g GoWSMANMessages) SetupWsmanClient(device entity.Device, logAMTMessages b
 			removeConnection(device.GUID)
 		})
 	} else {
-		wsmanMsgs := wsman.NewMessages(clientParams)
+		wsmanMsgs := wsmanAPI.NewMessages(device, clientParams)
 		timer := time.AfterFunc(expireAfter, func() {
 			removeConnection(device.GUID)
 		})
@@ -0,0 +1,33 @@
+package checkouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		Insert(ctx context.Context, c entity.CredentialCheckout) error
+		CheckIn(ctx context.Context, id string, at time.Time) (bool, error)
+		GetByGUID(ctx context.Context, guid string, limit int) ([]entity.CredentialCheckout, error)
+	}
+
+	// Users is the part of the user repository the checkouts depend on to tell the role of the users.
+	Users interface {
+		GetByUsername(ctx context.Context, username string) (*entity.User, error)
+	}
+
+	// Devices is the part of the devices use case the checkouts depend on.
+	Devices interface {
+		GetByID(ctx context.Context, guid, tenantID string, includeSecrets bool) (*dto.Device, error)
+	}
+
+	Feature interface {
+		Checkout(ctx context.Context, guid, user string, req dto.CredentialCheckoutRequest) (dto.CredentialCheckout, error)
+		CheckIn(ctx context.Context, guid, user string) error
+		GetHistory(ctx context.Context, guid, user string) ([]dto.CredentialCheckoutRecord, error)
+	}
+)
@@ -0,0 +1,296 @@
+package checkouts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// historyLimit is how many checkouts of a device are returned at most.
+const historyLimit = 100
+
+var (
+	ErrCheckoutsUseCase = consoleerrors.CreateConsoleError("CheckoutsUseCase")
+	ErrDatabase         = sqldb.DatabaseError{Console: ErrCheckoutsUseCase}
+	ErrNotFound         = sqldb.NotFoundError{Console: ErrCheckoutsUseCase}
+	ErrValidation       = dto.NotValidError{Console: ErrCheckoutsUseCase}
+	// ErrReason is returned for a checkout without a reason.
+	ErrReason = errors.New("a reason is required to check out the password of a device")
+)
+
+// ForbiddenError is returned when the user is not allowed to see the passwords of the devices.
+type ForbiddenError struct {
+	User string
+}
+
+func (e ForbiddenError) Error() string {
+	if e.User == "" {
+		return "the passwords of the devices are only revealed to known admins"
+	}
+
+	return fmt.Sprintf("%s is not allowed to see the passwords of the devices", e.User)
+}
+
+func (e ForbiddenError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeForbidden
+}
+
+// CheckedOutError is returned when the password of a device is checked out by another user.
+type CheckedOutError struct {
+	Holder    string
+	ExpiresAt time.Time
+}
+
+func (e CheckedOutError) Error() string {
+	return fmt.Sprintf("the password of the device is checked out by %s until %s", e.Holder, e.ExpiresAt.UTC().Format(time.RFC3339))
+}
+
+func (e CheckedOutError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeCredentialCheckedOut
+}
+
+// UseCase reveals the AMT admin password of a device to one admin at a time, for a reason and a limited time,
+// and keeps the audit trail of who saw it, when and why.
+type UseCase struct {
+	repo          Repository
+	users         Users
+	devices       Devices
+	adminUsername string
+	ttl           time.Duration
+	maxTTL        time.Duration
+	log           logger.Interface
+	events        *eventbus.Bus
+	now           func() time.Time
+
+	// mu serializes the checkouts so that two users cannot check out the same password at once
+	mu sync.Mutex
+}
+
+// New creates the checkouts of the device passwords. adminUsername is the built-in admin of the console, allowed
+// to check out passwords next to the users with the admin role. ttl is how long a checkout lasts when the
+// request does not say, maxTTL the longest it may last.
+func New(repo Repository, users Users, devices Devices, adminUsername string, ttl, maxTTL time.Duration, log logger.Interface, events *eventbus.Bus) *UseCase {
+	return &UseCase{
+		repo:          repo,
+		users:         users,
+		devices:       devices,
+		adminUsername: adminUsername,
+		ttl:           ttl,
+		maxTTL:        maxTTL,
+		log:           log,
+		events:        events,
+		now:           time.Now,
+	}
+}
+
+// Checkout reveals the AMT admin password of a device to user until the checkout expires. The checkout of the
+// same user still active is checked in and replaced; the one of another user fails with a CheckedOutError.
+func (uc *UseCase) Checkout(ctx context.Context, guid, user string, req dto.CredentialCheckoutRequest) (dto.CredentialCheckout, error) {
+	if err := uc.authorize(ctx, user); err != nil {
+		return dto.CredentialCheckout{}, err
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return dto.CredentialCheckout{}, ErrValidation.Wrap("Checkout", "validate", ErrReason)
+	}
+
+	ttl := uc.ttl
+	if req.TTL > 0 {
+		ttl = time.Duration(req.TTL) * time.Second
+	}
+
+	if ttl > uc.maxTTL {
+		return dto.CredentialCheckout{}, ErrValidation.Wrap("Checkout", "validate",
+			fmt.Errorf("a checkout lasts at most %d seconds", int(uc.maxTTL.Seconds())))
+	}
+
+	device, err := uc.devices.GetByID(ctx, guid, "", true)
+	if err != nil {
+		return dto.CredentialCheckout{}, err
+	}
+
+	if device == nil || device.GUID == "" {
+		return dto.CredentialCheckout{}, ErrNotFound
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	now := uc.now().UTC().Truncate(time.Second)
+
+	active, err := uc.active(ctx, device.GUID, now)
+	if err != nil {
+		return dto.CredentialCheckout{}, err
+	}
+
+	if active != nil {
+		if active.Username != user {
+			return dto.CredentialCheckout{}, CheckedOutError{Holder: active.Username, ExpiresAt: active.ExpiresAt}
+		}
+
+		if err := uc.checkIn(ctx, *active, now); err != nil {
+			return dto.CredentialCheckout{}, err
+		}
+	}
+
+	checkout := entity.CredentialCheckout{
+		ID:           uuid.NewString(),
+		GUID:         device.GUID,
+		Username:     user,
+		Reason:       reason,
+		CheckedOutAt: now,
+		ExpiresAt:    now.Add(ttl),
+	}
+
+	if err := uc.repo.Insert(ctx, checkout); err != nil {
+		return dto.CredentialCheckout{}, ErrDatabase.Wrap("Checkout", "uc.repo.Insert", err)
+	}
+
+	uc.log.Info("checkouts - Checkout: password of device %s checked out by %s until %s: %s",
+		device.GUID, user, checkout.ExpiresAt.Format(time.RFC3339), reason)
+
+	eventbus.Publish(uc.events, eventbus.CredentialCheckedOut, eventbus.CredentialCheckoutEvent{
+		ID:        checkout.ID,
+		GUID:      checkout.GUID,
+		Holder:    checkout.Username,
+		Reason:    checkout.Reason,
+		ExpiresAt: checkout.ExpiresAt,
+	})
+
+	return dto.CredentialCheckout{
+		ID:           checkout.ID,
+		GUID:         checkout.GUID,
+		Username:     device.Username,
+		Password:     device.Password,
+		Holder:       checkout.Username,
+		Reason:       checkout.Reason,
+		CheckedOutAt: checkout.CheckedOutAt,
+		ExpiresAt:    checkout.ExpiresAt,
+	}, nil
+}
+
+// CheckIn ends the active checkout of user on the password of a device before it expires.
+func (uc *UseCase) CheckIn(ctx context.Context, guid, user string) error {
+	if err := uc.authorize(ctx, user); err != nil {
+		return err
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	now := uc.now().UTC().Truncate(time.Second)
+
+	active, err := uc.active(ctx, guid, now)
+	if err != nil {
+		return err
+	}
+
+	if active == nil {
+		return ErrNotFound
+	}
+
+	if active.Username != user {
+		return CheckedOutError{Holder: active.Username, ExpiresAt: active.ExpiresAt}
+	}
+
+	return uc.checkIn(ctx, *active, now)
+}
+
+// GetHistory returns the latest checkouts of the password of a device, newest first.
+func (uc *UseCase) GetHistory(ctx context.Context, guid, user string) ([]dto.CredentialCheckoutRecord, error) {
+	if err := uc.authorize(ctx, user); err != nil {
+		return nil, err
+	}
+
+	checkouts, err := uc.repo.GetByGUID(ctx, guid, historyLimit)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("GetHistory", "uc.repo.GetByGUID", err)
+	}
+
+	records := make([]dto.CredentialCheckoutRecord, len(checkouts))
+
+	for i := range checkouts {
+		records[i] = dto.CredentialCheckoutRecord{
+			ID:           checkouts[i].ID,
+			Holder:       checkouts[i].Username,
+			Reason:       checkouts[i].Reason,
+			CheckedOutAt: checkouts[i].CheckedOutAt,
+			ExpiresAt:    checkouts[i].ExpiresAt,
+		}
+
+		if !checkouts[i].CheckedInAt.IsZero() {
+			checkedInAt := checkouts[i].CheckedInAt
+			records[i].CheckedInAt = &checkedInAt
+		}
+	}
+
+	return records, nil
+}
+
+// authorize fails with a ForbiddenError unless user is the built-in admin or an enabled user with the admin role.
+func (uc *UseCase) authorize(ctx context.Context, user string) error {
+	if user == "" {
+		return ForbiddenError{}
+	}
+
+	if user == uc.adminUsername {
+		return nil
+	}
+
+	account, err := uc.users.GetByUsername(ctx, user)
+	if err != nil {
+		return ErrDatabase.Wrap("authorize", "uc.users.GetByUsername", err)
+	}
+
+	if account == nil || account.Disabled || account.Role != entity.RoleAdmin {
+		return ForbiddenError{User: user}
+	}
+
+	return nil
+}
+
+// active returns the checkout of the password of a device neither checked in nor expired at now, nil when there
+// is none.
+func (uc *UseCase) active(ctx context.Context, guid string, now time.Time) (*entity.CredentialCheckout, error) {
+	latest, err := uc.repo.GetByGUID(ctx, guid, 1)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("active", "uc.repo.GetByGUID", err)
+	}
+
+	if len(latest) == 0 || !latest[0].CheckedInAt.IsZero() || !now.Before(latest[0].ExpiresAt) {
+		return nil, nil
+	}
+
+	return &latest[0], nil
+}
+
+func (uc *UseCase) checkIn(ctx context.Context, checkout entity.CredentialCheckout, now time.Time) error {
+	if _, err := uc.repo.CheckIn(ctx, checkout.ID, now); err != nil {
+		return ErrDatabase.Wrap("CheckIn", "uc.repo.CheckIn", err)
+	}
+
+	uc.log.Info("checkouts - CheckIn: password of device %s checked in by %s", checkout.GUID, checkout.Username)
+
+	eventbus.Publish(uc.events, eventbus.CredentialCheckedIn, eventbus.CredentialCheckoutEvent{
+		ID:        checkout.ID,
+		GUID:      checkout.GUID,
+		Holder:    checkout.Username,
+		Reason:    checkout.Reason,
+		ExpiresAt: checkout.ExpiresAt,
+	})
+
+	return nil
+}
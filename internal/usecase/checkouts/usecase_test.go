@@ -0,0 +1,268 @@
+package checkouts_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/checkouts"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errQuery = errors.New("query failed")
+
+type checkoutMocks struct {
+	repo    *mocks.MockCheckoutRepository
+	users   *mocks.MockCheckoutUsers
+	devices *mocks.MockCheckoutDevices
+}
+
+func newCheckouts(t *testing.T, mock func(checkoutMocks), bus *eventbus.Bus) *checkouts.UseCase {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	m := checkoutMocks{
+		repo:    mocks.NewMockCheckoutRepository(ctrl),
+		users:   mocks.NewMockCheckoutUsers(ctrl),
+		devices: mocks.NewMockCheckoutDevices(ctrl),
+	}
+
+	mock(m)
+
+	return checkouts.New(m.repo, m.users, m.devices, "standalone", 15*time.Minute, time.Hour, logger.New("error"), bus)
+}
+
+func activeCheckout(holder string) entity.CredentialCheckout {
+	return entity.CredentialCheckout{
+		ID:           "checkout1",
+		GUID:         "guid1",
+		Username:     holder,
+		Reason:       "kiosk",
+		CheckedOutAt: time.Now().Add(-time.Minute),
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+}
+
+func TestCheckout(t *testing.T) {
+	t.Parallel()
+
+	device := &dto.Device{GUID: "guid1", Username: "admin", Password: "P@ssw0rd"}
+
+	tests := []struct {
+		name string
+		user string
+		req  dto.CredentialCheckoutRequest
+		mock func(checkoutMocks)
+		err  error
+	}{
+		{
+			name: "the built-in admin checks out the password",
+			user: "standalone",
+			req:  dto.CredentialCheckoutRequest{Reason: "reconfigure the KVM consent"},
+			mock: func(m checkoutMocks) {
+				m.devices.EXPECT().GetByID(gomock.Any(), "guid1", "", true).Return(device, nil)
+				m.repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).Return([]entity.CredentialCheckout{}, nil)
+				m.repo.EXPECT().Insert(gomock.Any(), gomock.Cond(func(c entity.CredentialCheckout) bool {
+					return c.GUID == "guid1" && c.Username == "standalone" && c.Reason == "reconfigure the KVM consent" &&
+						c.ExpiresAt.Sub(c.CheckedOutAt) == 15*time.Minute
+				})).Return(nil)
+			},
+		},
+		{
+			name: "an admin replaces its own checkout",
+			user: "jdoe",
+			req:  dto.CredentialCheckoutRequest{Reason: "kiosk", TTL: 60},
+			mock: func(m checkoutMocks) {
+				m.users.EXPECT().GetByUsername(gomock.Any(), "jdoe").Return(&entity.User{Username: "jdoe", Role: entity.RoleAdmin}, nil)
+				m.devices.EXPECT().GetByID(gomock.Any(), "guid1", "", true).Return(device, nil)
+				m.repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).Return([]entity.CredentialCheckout{activeCheckout("jdoe")}, nil)
+				m.repo.EXPECT().CheckIn(gomock.Any(), "checkout1", gomock.Any()).Return(true, nil)
+				m.repo.EXPECT().Insert(gomock.Any(), gomock.Cond(func(c entity.CredentialCheckout) bool {
+					return c.ExpiresAt.Sub(c.CheckedOutAt) == time.Minute
+				})).Return(nil)
+			},
+		},
+		{
+			name: "an operator is refused",
+			user: "op",
+			req:  dto.CredentialCheckoutRequest{Reason: "kiosk"},
+			mock: func(m checkoutMocks) {
+				m.users.EXPECT().GetByUsername(gomock.Any(), "op").Return(&entity.User{Username: "op", Role: entity.RoleOperator}, nil)
+			},
+			err: checkouts.ForbiddenError{},
+		},
+		{
+			name: "a disabled admin is refused",
+			user: "jdoe",
+			req:  dto.CredentialCheckoutRequest{Reason: "kiosk"},
+			mock: func(m checkoutMocks) {
+				m.users.EXPECT().GetByUsername(gomock.Any(), "jdoe").Return(&entity.User{Username: "jdoe", Role: entity.RoleAdmin, Disabled: true}, nil)
+			},
+			err: checkouts.ForbiddenError{},
+		},
+		{
+			name: "an anonymous request is refused",
+			req:  dto.CredentialCheckoutRequest{Reason: "kiosk"},
+			mock: func(checkoutMocks) {},
+			err:  checkouts.ForbiddenError{},
+		},
+		{
+			name: "a blank reason is refused",
+			user: "standalone",
+			req:  dto.CredentialCheckoutRequest{Reason: "  "},
+			mock: func(checkoutMocks) {},
+			err:  checkouts.ErrValidation,
+		},
+		{
+			name: "a checkout longer than the maximum is refused",
+			user: "standalone",
+			req:  dto.CredentialCheckoutRequest{Reason: "kiosk", TTL: 7200},
+			mock: func(checkoutMocks) {},
+			err:  checkouts.ErrValidation,
+		},
+		{
+			name: "the password checked out by another admin",
+			user: "standalone",
+			req:  dto.CredentialCheckoutRequest{Reason: "kiosk"},
+			mock: func(m checkoutMocks) {
+				m.devices.EXPECT().GetByID(gomock.Any(), "guid1", "", true).Return(device, nil)
+				m.repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).Return([]entity.CredentialCheckout{activeCheckout("jdoe")}, nil)
+			},
+			err: checkouts.CheckedOutError{},
+		},
+		{
+			name: "unknown device",
+			user: "standalone",
+			req:  dto.CredentialCheckoutRequest{Reason: "kiosk"},
+			mock: func(m checkoutMocks) {
+				m.devices.EXPECT().GetByID(gomock.Any(), "guid1", "", true).Return(nil, devices.ErrNotFound)
+			},
+			err: devices.ErrNotFound,
+		},
+		{
+			name: "database error",
+			user: "standalone",
+			req:  dto.CredentialCheckoutRequest{Reason: "kiosk"},
+			mock: func(m checkoutMocks) {
+				m.devices.EXPECT().GetByID(gomock.Any(), "guid1", "", true).Return(device, nil)
+				m.repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).Return(nil, errQuery)
+			},
+			err: checkouts.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			bus := eventbus.New()
+			defer bus.Close()
+
+			checkedOut := make(chan eventbus.CredentialCheckoutEvent, 1)
+			eventbus.Subscribe(bus, eventbus.CredentialCheckedOut, func(e eventbus.CredentialCheckoutEvent) { checkedOut <- e })
+
+			uc := newCheckouts(t, tc.mock, bus)
+
+			checkout, err := uc.Checkout(context.Background(), "guid1", tc.user, tc.req)
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "admin", checkout.Username)
+			require.Equal(t, "P@ssw0rd", checkout.Password)
+			require.Equal(t, tc.user, checkout.Holder)
+
+			select {
+			case e := <-checkedOut:
+				require.Equal(t, checkout.ID, e.ID)
+				require.Equal(t, tc.user, e.Holder)
+			case <-time.After(time.Second):
+				t.Fatal("no checkout published")
+			}
+		})
+	}
+}
+
+func TestCheckIn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		mock func(checkoutMocks)
+		err  error
+	}{
+		{
+			name: "the holder checks in the password",
+			mock: func(m checkoutMocks) {
+				m.repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).Return([]entity.CredentialCheckout{activeCheckout("standalone")}, nil)
+				m.repo.EXPECT().CheckIn(gomock.Any(), "checkout1", gomock.Any()).Return(true, nil)
+			},
+		},
+		{
+			name: "the password of another holder",
+			mock: func(m checkoutMocks) {
+				m.repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).Return([]entity.CredentialCheckout{activeCheckout("jdoe")}, nil)
+			},
+			err: checkouts.CheckedOutError{},
+		},
+		{
+			name: "an expired checkout",
+			mock: func(m checkoutMocks) {
+				expired := activeCheckout("standalone")
+				expired.ExpiresAt = time.Now().Add(-time.Second)
+
+				m.repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).Return([]entity.CredentialCheckout{expired}, nil)
+			},
+			err: checkouts.ErrNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := newCheckouts(t, tc.mock, nil)
+
+			err := uc.CheckIn(context.Background(), "guid1", "standalone")
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetHistory(t *testing.T) {
+	t.Parallel()
+
+	checkedIn := time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)
+
+	uc := newCheckouts(t, func(m checkoutMocks) {
+		m.repo.EXPECT().GetByGUID(gomock.Any(), "guid1", 100).Return([]entity.CredentialCheckout{
+			{ID: "2", Username: "jdoe", Reason: "kiosk"},
+			{ID: "1", Username: "standalone", Reason: "audit", CheckedInAt: checkedIn},
+		}, nil)
+	}, nil)
+
+	history, err := uc.GetHistory(context.Background(), "guid1", "standalone")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, "jdoe", history[0].Holder)
+	require.Nil(t, history[0].CheckedInAt)
+	require.Equal(t, checkedIn, *history[1].CheckedInAt)
+}
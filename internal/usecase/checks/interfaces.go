@@ -0,0 +1,20 @@
+package checks
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	// Devices is the part of the devices use case the checks depend on.
+	Devices interface {
+		GetByID(ctx context.Context, guid, tenantID string, includeSecrets bool) (*dto.Device, error)
+		GetPowerState(ctx context.Context, guid string) (dto.PowerState, error)
+		GetGeneralSettings(ctx context.Context, guid string) (dto.GeneralSettings, error)
+	}
+
+	Feature interface {
+		Check(ctx context.Context, guid, check string) (dto.CheckResult, error)
+	}
+)
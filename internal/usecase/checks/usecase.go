@@ -0,0 +1,130 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// Checks of a device.
+const (
+	CheckPower = "power"
+	CheckCIRA  = "cira"
+	CheckAMT   = "amt"
+)
+
+var (
+	ErrChecksUseCase = consoleerrors.CreateConsoleError("ChecksUseCase")
+	ErrValidation    = dto.NotValidError{Console: ErrChecksUseCase}
+)
+
+// UseCase checks the status of the devices for monitoring tools such as Nagios and Zabbix.
+type UseCase struct {
+	devices   Devices
+	log       logger.Interface
+	connected func(guid string) bool
+	now       func() time.Time
+}
+
+// New creates the status checks of the devices.
+func New(devices Devices, log logger.Interface) *UseCase {
+	return &UseCase{
+		devices:   devices,
+		log:       log,
+		connected: wsman.CIRAConnected,
+		now:       time.Now,
+	}
+}
+
+// Check runs a check of a device: power is OK when the device is on, WARNING while it sleeps and CRITICAL when
+// it is off; cira is OK while the device is connected over CIRA; amt is OK when AMT answers. A device that
+// cannot be reached for its power state, or is not set up for CIRA, is UNKNOWN. An unknown device is an error.
+func (uc *UseCase) Check(ctx context.Context, guid, check string) (dto.CheckResult, error) {
+	device, err := uc.devices.GetByID(ctx, guid, "", false)
+	if err != nil {
+		return dto.CheckResult{}, err
+	}
+
+	switch check {
+	case CheckPower:
+		return uc.checkPower(ctx, device.GUID), nil
+	case CheckCIRA:
+		return uc.checkCIRA(device), nil
+	case CheckAMT:
+		return uc.checkAMT(ctx, device.GUID), nil
+	default:
+		return dto.CheckResult{}, ErrValidation.Wrap("Check", "check", fmt.Errorf("unknown check %q, expected power, cira or amt", check))
+	}
+}
+
+// checkPower groups the CIM power states: 2 is on, 3 and 4 are sleep states and the others are off or
+// transitions to off.
+func (uc *UseCase) checkPower(ctx context.Context, guid string) dto.CheckResult {
+	result := dto.CheckResult{Check: CheckPower}
+
+	// the power state is still returned when only the OS power saving state cannot be read
+	state, err := uc.devices.GetPowerState(ctx, guid)
+	if err != nil && state.PowerState == 0 {
+		uc.log.Debug("checks - power: power state of device %s unavailable: %v", guid, err)
+
+		result.Status, result.Message = dto.CheckUnknown, "power state unavailable: "+err.Error()
+
+		return result
+	}
+
+	result.PerfData = fmt.Sprintf("power_state=%d", state.PowerState)
+
+	switch state.PowerState {
+	case 2:
+		result.Status, result.Message = dto.CheckOK, "on"
+	case 3, 4:
+		result.Status, result.Message = dto.CheckWarning, "sleeping"
+	default:
+		result.Status, result.Message = dto.CheckCritical, "off"
+	}
+
+	return result
+}
+
+func (uc *UseCase) checkCIRA(device *dto.Device) dto.CheckResult {
+	result := dto.CheckResult{Check: CheckCIRA}
+
+	switch {
+	case device.MPSUsername == "":
+		result.Status, result.Message = dto.CheckUnknown, "the device does not connect over CIRA"
+	case uc.connected(device.GUID):
+		result.Status, result.Message, result.PerfData = dto.CheckOK, "connected", "connected=1"
+	default:
+		result.Status, result.Message, result.PerfData = dto.CheckCritical, "not connected", "connected=0"
+	}
+
+	return result
+}
+
+// checkAMT reads the general settings of AMT, the smallest request answered by every device.
+func (uc *UseCase) checkAMT(ctx context.Context, guid string) dto.CheckResult {
+	result := dto.CheckResult{Check: CheckAMT}
+
+	start := uc.now()
+
+	if _, err := uc.devices.GetGeneralSettings(ctx, guid); err != nil {
+		uc.log.Debug("checks - amt: device %s not reachable: %v", guid, err)
+
+		result.Status, result.Message = dto.CheckCritical, "not reachable: "+err.Error()
+
+		return result
+	}
+
+	elapsed := uc.now().Sub(start)
+
+	result.Status = dto.CheckOK
+	result.Message = "answered in " + elapsed.Round(time.Millisecond).String()
+	result.PerfData = fmt.Sprintf("time=%.3fs", elapsed.Seconds())
+
+	return result
+}
@@ -0,0 +1,166 @@
+package checks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/checks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errUnreachable = errors.New("connection refused")
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	wsman.RegisterConnection("checks-cira-connected", &wsman.ConnectionEntry{IsCIRA: true})
+	t.Cleanup(func() { wsman.RemoveConnection("checks-cira-connected") })
+
+	tests := []struct {
+		name   string
+		guid   string
+		check  string
+		mock   func(m *mocks.MockChecksDevices)
+		status int
+		output string
+	}{
+		{
+			name:  "power on",
+			check: checks.CheckPower,
+			mock: func(m *mocks.MockChecksDevices) {
+				m.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 2}, nil)
+			},
+			status: dto.CheckOK,
+			output: "POWER OK - on | power_state=2",
+		},
+		{
+			name:  "power sleeping",
+			check: checks.CheckPower,
+			mock: func(m *mocks.MockChecksDevices) {
+				m.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 4}, errUnreachable)
+			},
+			status: dto.CheckWarning,
+			output: "POWER WARNING - sleeping | power_state=4",
+		},
+		{
+			name:  "power off",
+			check: checks.CheckPower,
+			mock: func(m *mocks.MockChecksDevices) {
+				m.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 8}, nil)
+			},
+			status: dto.CheckCritical,
+			output: "POWER CRITICAL - off | power_state=8",
+		},
+		{
+			name:  "power unavailable",
+			check: checks.CheckPower,
+			mock: func(m *mocks.MockChecksDevices) {
+				m.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{}, errUnreachable)
+			},
+			status: dto.CheckUnknown,
+			output: "POWER UNKNOWN - power state unavailable: connection refused",
+		},
+		{
+			name:   "cira connected",
+			guid:   "checks-cira-connected",
+			check:  checks.CheckCIRA,
+			status: dto.CheckOK,
+			output: "CIRA OK - connected | connected=1",
+		},
+		{
+			name:   "cira not connected",
+			guid:   "checks-cira-disconnected",
+			check:  checks.CheckCIRA,
+			status: dto.CheckCritical,
+			output: "CIRA CRITICAL - not connected | connected=0",
+		},
+		{
+			name:   "not a cira device",
+			check:  checks.CheckCIRA,
+			status: dto.CheckUnknown,
+			output: "CIRA UNKNOWN - the device does not connect over CIRA",
+		},
+		{
+			name:  "amt not reachable",
+			check: checks.CheckAMT,
+			mock: func(m *mocks.MockChecksDevices) {
+				m.EXPECT().GetGeneralSettings(gomock.Any(), "guid1").Return(dto.GeneralSettings{}, errUnreachable)
+			},
+			status: dto.CheckCritical,
+			output: "AMT CRITICAL - not reachable: connection refused",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			d := mocks.NewMockChecksDevices(ctrl)
+
+			device := &dto.Device{GUID: "guid1"}
+			if tc.guid != "" {
+				device = &dto.Device{GUID: tc.guid, MPSUsername: "mps"}
+			}
+
+			d.EXPECT().GetByID(gomock.Any(), device.GUID, "", false).Return(device, nil)
+
+			if tc.mock != nil {
+				tc.mock(d)
+			}
+
+			result, err := checks.New(d, logger.New("error")).Check(context.Background(), device.GUID, tc.check)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, result.Status)
+			require.Equal(t, tc.output, result.String())
+		})
+	}
+}
+
+func TestCheckAMTReachable(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	d := mocks.NewMockChecksDevices(ctrl)
+
+	d.EXPECT().GetByID(gomock.Any(), "guid1", "", false).Return(&dto.Device{GUID: "guid1"}, nil)
+	d.EXPECT().GetGeneralSettings(gomock.Any(), "guid1").Return(dto.GeneralSettings{}, nil)
+
+	result, err := checks.New(d, logger.New("error")).Check(context.Background(), "guid1", checks.CheckAMT)
+	require.NoError(t, err)
+	require.Equal(t, dto.CheckOK, result.Status)
+	require.Contains(t, result.String(), "AMT OK - answered in ")
+	require.Contains(t, result.PerfData, "time=")
+}
+
+func TestCheckErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown device", func(t *testing.T) {
+		t.Parallel()
+
+		d := mocks.NewMockChecksDevices(gomock.NewController(t))
+		d.EXPECT().GetByID(gomock.Any(), "unknown", "", false).Return(nil, devices.ErrNotFound)
+
+		_, err := checks.New(d, logger.New("error")).Check(context.Background(), "unknown", checks.CheckPower)
+		require.ErrorIs(t, err, devices.ErrNotFound)
+	})
+
+	t.Run("unknown check", func(t *testing.T) {
+		t.Parallel()
+
+		d := mocks.NewMockChecksDevices(gomock.NewController(t))
+		d.EXPECT().GetByID(gomock.Any(), "guid1", "", false).Return(&dto.Device{GUID: "guid1"}, nil)
+
+		_, err := checks.New(d, logger.New("error")).Check(context.Background(), "guid1", "disk")
+		require.IsType(t, checks.ErrValidation, err)
+	})
+}
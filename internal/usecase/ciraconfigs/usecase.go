@@ -8,6 +8,7 @@ import (
 	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
@@ -146,7 +147,7 @@ func (uc *UseCase) dtoToEntity(d *dto.CIRAConfig) *entity.CIRAConfig {
 		Version:                d.Version,
 	}
 	// Encrypt password before storing
-	d1.Password, _ = uc.safeRequirements.Encrypt(d.Password)
+	d1.Password, _ = tenantkeys.Encrypt(uc.safeRequirements, d.TenantID, d.Password)
 
 	return d1
 }
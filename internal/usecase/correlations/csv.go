@@ -0,0 +1,127 @@
+package correlations
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+var (
+	ErrNoIdentifierColumn = errors.New("the export has no UUID or serial number column")
+	ErrTooManyRecords     = errors.New("the export has too many records")
+)
+
+// MaxRecords is the number of records accepted in one import.
+const MaxRecords = 10000
+
+// columns maps the normalized column headers of Intune and ConfigMgr exports, and of the Graph API
+// property names, to the fields they fill.
+var columns = map[string]func(r *dto.CorrelationRecord, value string){
+	"deviceid":         func(r *dto.CorrelationRecord, v string) { r.ID = v },
+	"id":               func(r *dto.CorrelationRecord, v string) { r.ID = v },
+	"resourceid":       func(r *dto.CorrelationRecord, v string) { r.ID = v },
+	"devicename":       func(r *dto.CorrelationRecord, v string) { r.DeviceName = v },
+	"name":             func(r *dto.CorrelationRecord, v string) { r.DeviceName = v },
+	"serialnumber":     func(r *dto.CorrelationRecord, v string) { r.SerialNumber = v },
+	"uuid":             func(r *dto.CorrelationRecord, v string) { r.UUID = v },
+	"smbiosguid":       func(r *dto.CorrelationRecord, v string) { r.UUID = v },
+	"compliance":       func(r *dto.CorrelationRecord, v string) { r.ComplianceState = v },
+	"compliancestate":  func(r *dto.CorrelationRecord, v string) { r.ComplianceState = v },
+	"managedby":        func(r *dto.CorrelationRecord, v string) { r.ManagementState = v },
+	"managementagent":  func(r *dto.CorrelationRecord, v string) { r.ManagementState = v },
+	"managementstate":  func(r *dto.CorrelationRecord, v string) { r.ManagementState = v },
+	"clientactivity":   func(r *dto.CorrelationRecord, v string) { r.ManagementState = v },
+	"lastcheckin":      setLastSync,
+	"lastsyncdatetime": setLastSync,
+	"lastactivitytime": setLastSync,
+	"lastonlinetime":   setLastSync,
+}
+
+// lastSyncLayouts are the time formats found in the exports.
+var lastSyncLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "1/2/2006 3:04:05 PM", "1/2/2006 15:04", "2006-01-02"}
+
+// ParseCSV reads the records of a device export downloaded from the Intune admin center or a ConfigMgr
+// device collection. Columns are found by name, unknown columns are ignored.
+func ParseCSV(r io.Reader) ([]dto.CorrelationRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	setters := make([]func(*dto.CorrelationRecord, string), len(header))
+	identified := false
+
+	for i, name := range header {
+		key := normalizeColumn(name)
+		setters[i] = columns[key]
+
+		if key == "uuid" || key == "smbiosguid" || key == "serialnumber" {
+			identified = true
+		}
+	}
+
+	if !identified {
+		return nil, ErrNoIdentifierColumn
+	}
+
+	records := make([]dto.CorrelationRecord, 0)
+
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(records) == MaxRecords {
+			return nil, ErrTooManyRecords
+		}
+
+		var record dto.CorrelationRecord
+
+		for i, value := range row {
+			if i < len(setters) && setters[i] != nil {
+				setters[i](&record, strings.TrimSpace(value))
+			}
+		}
+
+		records = append(records, record)
+	}
+}
+
+// normalizeColumn lowercases a column header and drops everything but letters, so that "Serial number",
+// "SerialNumber" and "serial_number" are the same column and the byte order mark Excel writes is ignored.
+func normalizeColumn(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		default:
+			return -1
+		}
+	}, name)
+}
+
+func setLastSync(r *dto.CorrelationRecord, value string) {
+	for _, layout := range lastSyncLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			r.LastSync = &t
+
+			return
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package correlations_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/correlations"
+)
+
+func TestParseCSV(t *testing.T) {
+	t.Parallel()
+
+	lastSync := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		csv      string
+		expected []dto.CorrelationRecord
+		err      error
+	}{
+		{
+			name: "intune export",
+			csv: "\ufeffDevice ID,Device name,Managed by,Compliance,OS,Serial number,Last check-in\n" +
+				"id1,DESKTOP-1,Co-managed,Compliant,Windows,SN1,2026-10-01 08:00:00\n",
+			expected: []dto.CorrelationRecord{{
+				ID: "id1", DeviceName: "DESKTOP-1", ManagementState: "Co-managed", ComplianceState: "Compliant", SerialNumber: "SN1", LastSync: &lastSync,
+			}},
+		},
+		{
+			name: "configmgr export",
+			csv:  "Name,ResourceID,SMBIOS GUID,Client Activity\nPC1,16777220,123E4567-E89B-12D3-A456-426614174000,Active\n",
+			expected: []dto.CorrelationRecord{{
+				ID: "16777220", DeviceName: "PC1", UUID: "123E4567-E89B-12D3-A456-426614174000", ManagementState: "Active",
+			}},
+		},
+		{
+			name:     "header only",
+			csv:      "serialNumber,deviceName\n",
+			expected: []dto.CorrelationRecord{},
+		},
+		{
+			name: "no identifier column",
+			csv:  "Device name,Compliance\nPC1,Compliant\n",
+			err:  correlations.ErrNoIdentifierColumn,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			records, err := correlations.ParseCSV(strings.NewReader(tc.csv))
+
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.expected, records)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package correlations
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		GetByGUID(ctx context.Context, guid, tenantID string) ([]entity.DeviceCorrelation, error)
+		Save(ctx context.Context, c entity.DeviceCorrelation) error
+	}
+
+	// Devices is the part of the device repository records are matched against.
+	Devices interface {
+		GetByID(ctx context.Context, guid, tenantID string) (*entity.Device, error)
+		GetByAttributes(ctx context.Context, attributes map[string]string, top, skip int, tenantID string) ([]entity.Device, error)
+	}
+
+	Feature interface {
+		Import(ctx context.Context, source string, records []dto.CorrelationRecord, tenantID string) (dto.CorrelationImportResult, error)
+		GetByGUID(ctx context.Context, guid, tenantID string) ([]dto.DeviceCorrelation, error)
+	}
+)
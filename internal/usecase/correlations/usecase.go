@@ -0,0 +1,207 @@
+// Package correlations matches console devices to their records in Microsoft Intune or Configuration
+// Manager, so that co-managed endpoints show their compliance and management state next to AMT data.
+package correlations
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	SourceIntune    = "intune"
+	SourceConfigMgr = "configmgr"
+
+	MatchedByUUID         = "uuid"
+	MatchedBySerialNumber = "serialNumber"
+
+	// SerialNumberAttribute is the custom device attribute records are matched on by serial number.
+	SerialNumberAttribute = "serialNumber"
+)
+
+var (
+	ErrUnknownSource = errors.New("source must be intune or configmgr")
+
+	ErrCorrelationsUseCase = consoleerrors.CreateConsoleError("CorrelationsUseCase")
+	ErrDatabase            = sqldb.DatabaseError{Console: ErrCorrelationsUseCase}
+	ErrValidation          = dto.NotValidError{Console: ErrCorrelationsUseCase}
+)
+
+// UseCase stores the Intune and ConfigMgr records matched to devices.
+type UseCase struct {
+	repo    Repository
+	devices Devices
+	log     logger.Interface
+	now     func() time.Time
+}
+
+// New -.
+func New(repo Repository, d Devices, log logger.Interface) *UseCase {
+	return &UseCase{
+		repo:    repo,
+		devices: d,
+		log:     log,
+		now:     time.Now,
+	}
+}
+
+// Import matches every record to a device, first by UUID against the device GUID and then by serial number
+// against the serialNumber attribute, and replaces the stored correlation of the device with the source.
+func (uc *UseCase) Import(ctx context.Context, source string, records []dto.CorrelationRecord, tenantID string) (dto.CorrelationImportResult, error) {
+	result := dto.CorrelationImportResult{Unmatched: []string{}}
+
+	if source != SourceIntune && source != SourceConfigMgr {
+		return result, ErrValidation.Wrap("Import", "source", ErrUnknownSource)
+	}
+
+	importedAt := uc.now().UTC()
+
+	for i := range records {
+		record := &records[i]
+
+		device, matchedBy, err := uc.match(ctx, record, tenantID)
+		if err != nil {
+			return result, ErrDatabase.Wrap("Import", "uc.match", err)
+		}
+
+		if device == nil {
+			result.Unmatched = append(result.Unmatched, recordName(record))
+
+			continue
+		}
+
+		c := entity.DeviceCorrelation{
+			GUID:            device.GUID,
+			TenantID:        device.TenantID,
+			Source:          source,
+			ExternalID:      record.ID,
+			DeviceName:      record.DeviceName,
+			SerialNumber:    record.SerialNumber,
+			MatchedBy:       matchedBy,
+			ComplianceState: record.ComplianceState,
+			ManagementState: record.ManagementState,
+			ImportedAt:      importedAt,
+		}
+
+		if record.LastSync != nil {
+			c.LastSync = record.LastSync.UTC()
+		}
+
+		if err := uc.repo.Save(ctx, c); err != nil {
+			return result, ErrDatabase.Wrap("Import", "uc.repo.Save", err)
+		}
+
+		result.Matched++
+	}
+
+	uc.log.Info("correlations - Import: matched %d of %d %s records", result.Matched, len(records), source)
+
+	return result, nil
+}
+
+// GetByGUID returns the Intune and ConfigMgr status of a device.
+func (uc *UseCase) GetByGUID(ctx context.Context, guid, tenantID string) ([]dto.DeviceCorrelation, error) {
+	data, err := uc.repo.GetByGUID(ctx, strings.ToLower(guid), tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("GetByGUID", "uc.repo.GetByGUID", err)
+	}
+
+	correlations := make([]dto.DeviceCorrelation, len(data))
+
+	for i := range data {
+		c := &data[i]
+		correlations[i] = dto.DeviceCorrelation{
+			Source:          c.Source,
+			ExternalID:      c.ExternalID,
+			DeviceName:      c.DeviceName,
+			SerialNumber:    c.SerialNumber,
+			MatchedBy:       c.MatchedBy,
+			ComplianceState: c.ComplianceState,
+			ManagementState: c.ManagementState,
+			ImportedAt:      c.ImportedAt,
+		}
+
+		if !c.LastSync.IsZero() {
+			lastSync := c.LastSync
+			correlations[i].LastSync = &lastSync
+		}
+	}
+
+	return correlations, nil
+}
+
+// match finds the device of a record, a serial number only matches when exactly one device carries it.
+func (uc *UseCase) match(ctx context.Context, record *dto.CorrelationRecord, tenantID string) (*entity.Device, string, error) {
+	for _, guid := range uuidCandidates(record.UUID) {
+		device, err := uc.devices.GetByID(ctx, guid, tenantID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if device != nil && device.GUID != "" {
+			return device, MatchedByUUID, nil
+		}
+	}
+
+	serialNumber := strings.TrimSpace(record.SerialNumber)
+	if serialNumber == "" {
+		return nil, "", nil
+	}
+
+	devices, err := uc.devices.GetByAttributes(ctx, map[string]string{SerialNumberAttribute: serialNumber}, 2, 0, tenantID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(devices) != 1 {
+		return nil, "", nil
+	}
+
+	return &devices[0], MatchedBySerialNumber, nil
+}
+
+// uuidCandidates returns the GUIDs a record UUID can stand for. Some inventories report the SMBIOS UUID
+// with its first three fields byte swapped, so the swapped form is tried after the UUID as reported.
+func uuidCandidates(uuid string) []string {
+	uuid = strings.ToLower(strings.Trim(strings.TrimSpace(uuid), "{}"))
+
+	parts := strings.Split(uuid, "-")
+	if len(parts) != 5 || len(parts[0]) != 8 || len(parts[1]) != 4 || len(parts[2]) != 4 || len(parts[3]) != 4 || len(parts[4]) != 12 {
+		return nil
+	}
+
+	swapped := strings.Join([]string{swapBytes(parts[0]), swapBytes(parts[1]), swapBytes(parts[2]), parts[3], parts[4]}, "-")
+	if swapped == uuid {
+		return []string{uuid}
+	}
+
+	return []string{uuid, swapped}
+}
+
+// swapBytes reverses the order of the bytes of a hexadecimal string.
+func swapBytes(hex string) string {
+	b := make([]byte, 0, len(hex))
+	for i := len(hex) - 2; i >= 0; i -= 2 {
+		b = append(b, hex[i], hex[i+1])
+	}
+
+	return string(b)
+}
+
+func recordName(record *dto.CorrelationRecord) string {
+	switch {
+	case record.ID != "":
+		return record.ID
+	case record.DeviceName != "":
+		return record.DeviceName
+	default:
+		return record.SerialNumber
+	}
+}
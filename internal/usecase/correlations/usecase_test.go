@@ -0,0 +1,152 @@
+package correlations_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/correlations"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const guid = "123e4567-e89b-12d3-a456-426614174000"
+
+var errDatabase = errors.New("database error")
+
+// saved matches a correlation of guid with the given match.
+func saved(matchedBy string) gomock.Matcher {
+	return gomock.Cond(func(c entity.DeviceCorrelation) bool {
+		return c.GUID == guid && c.Source == correlations.SourceIntune && c.MatchedBy == matchedBy && !c.ImportedAt.IsZero()
+	})
+}
+
+func TestImport(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: guid}
+
+	tests := []struct {
+		name    string
+		source  string
+		records []dto.CorrelationRecord
+		mock    func(*mocks.MockCorrelationsRepository, *mocks.MockCorrelationsDevices)
+		res     dto.CorrelationImportResult
+		err     error
+	}{
+		{
+			name:    "matched by uuid",
+			source:  correlations.SourceIntune,
+			records: []dto.CorrelationRecord{{ID: "id1", UUID: "{123E4567-E89B-12D3-A456-426614174000}"}},
+			mock: func(repo *mocks.MockCorrelationsRepository, d *mocks.MockCorrelationsDevices) {
+				d.EXPECT().GetByID(gomock.Any(), guid, "").Return(device, nil)
+				repo.EXPECT().Save(gomock.Any(), saved(correlations.MatchedByUUID)).Return(nil)
+			},
+			res: dto.CorrelationImportResult{Matched: 1, Unmatched: []string{}},
+		},
+		{
+			name:    "matched by byte swapped uuid",
+			source:  correlations.SourceIntune,
+			records: []dto.CorrelationRecord{{ID: "id1", UUID: "67452301-ab89-d312-a456-426614174000"}},
+			mock: func(repo *mocks.MockCorrelationsRepository, d *mocks.MockCorrelationsDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "67452301-ab89-d312-a456-426614174000", "").Return(nil, nil)
+				d.EXPECT().GetByID(gomock.Any(), "01234567-89ab-12d3-a456-426614174000", "").Return(&entity.Device{GUID: guid}, nil)
+				repo.EXPECT().Save(gomock.Any(), saved(correlations.MatchedByUUID)).Return(nil)
+			},
+			res: dto.CorrelationImportResult{Matched: 1, Unmatched: []string{}},
+		},
+		{
+			name:    "matched by serial number",
+			source:  correlations.SourceIntune,
+			records: []dto.CorrelationRecord{{ID: "id1", SerialNumber: "SN1"}},
+			mock: func(repo *mocks.MockCorrelationsRepository, d *mocks.MockCorrelationsDevices) {
+				d.EXPECT().GetByAttributes(gomock.Any(), map[string]string{"serialNumber": "SN1"}, 2, 0, "").Return([]entity.Device{*device}, nil)
+				repo.EXPECT().Save(gomock.Any(), saved(correlations.MatchedBySerialNumber)).Return(nil)
+			},
+			res: dto.CorrelationImportResult{Matched: 1, Unmatched: []string{}},
+		},
+		{
+			name:    "ambiguous serial number and unknown uuid are not matched",
+			source:  correlations.SourceConfigMgr,
+			records: []dto.CorrelationRecord{{DeviceName: "PC1", SerialNumber: "SN1"}, {ID: "id2", UUID: guid}},
+			mock: func(_ *mocks.MockCorrelationsRepository, d *mocks.MockCorrelationsDevices) {
+				d.EXPECT().GetByAttributes(gomock.Any(), map[string]string{"serialNumber": "SN1"}, 2, 0, "").Return([]entity.Device{*device, *device}, nil)
+				d.EXPECT().GetByID(gomock.Any(), guid, "").Return(nil, nil)
+				d.EXPECT().GetByID(gomock.Any(), "67453e12-9be8-d312-a456-426614174000", "").Return(nil, nil)
+			},
+			res: dto.CorrelationImportResult{Unmatched: []string{"PC1", "id2"}},
+		},
+		{
+			name:   "unknown source",
+			source: "jamf",
+			mock:   func(_ *mocks.MockCorrelationsRepository, _ *mocks.MockCorrelationsDevices) {},
+			res:    dto.CorrelationImportResult{Unmatched: []string{}},
+			err:    correlations.ErrValidation,
+		},
+		{
+			name:    "database error",
+			source:  correlations.SourceIntune,
+			records: []dto.CorrelationRecord{{ID: "id1", UUID: guid}},
+			mock: func(repo *mocks.MockCorrelationsRepository, d *mocks.MockCorrelationsDevices) {
+				d.EXPECT().GetByID(gomock.Any(), guid, "").Return(device, nil)
+				repo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errDatabase)
+			},
+			res: dto.CorrelationImportResult{Unmatched: []string{}},
+			err: correlations.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := mocks.NewMockCorrelationsRepository(ctrl)
+			d := mocks.NewMockCorrelationsDevices(ctrl)
+
+			tc.mock(repo, d)
+
+			uc := correlations.New(repo, d, logger.New("error"))
+
+			res, err := uc.Import(context.Background(), tc.source, tc.records, "")
+
+			require.Equal(t, tc.res, res)
+			require.IsType(t, tc.err, err)
+		})
+	}
+}
+
+func TestGetByGUID(t *testing.T) {
+	t.Parallel()
+
+	lastSync := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	importedAt := lastSync.Add(time.Hour)
+
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockCorrelationsRepository(ctrl)
+
+	repo.EXPECT().GetByGUID(gomock.Any(), guid, "").Return([]entity.DeviceCorrelation{
+		{GUID: guid, Source: "configmgr", MatchedBy: "serialNumber", ImportedAt: importedAt},
+		{GUID: guid, Source: "intune", ExternalID: "id1", ComplianceState: "compliant", MatchedBy: "uuid", LastSync: lastSync, ImportedAt: importedAt},
+	}, nil)
+	repo.EXPECT().GetByGUID(gomock.Any(), guid, "").Return(nil, errDatabase)
+
+	uc := correlations.New(repo, mocks.NewMockCorrelationsDevices(ctrl), logger.New("error"))
+
+	res, err := uc.GetByGUID(context.Background(), "123E4567-E89B-12D3-A456-426614174000", "")
+	require.NoError(t, err)
+	require.Equal(t, []dto.DeviceCorrelation{
+		{Source: "configmgr", MatchedBy: "serialNumber", ImportedAt: importedAt},
+		{Source: "intune", ExternalID: "id1", ComplianceState: "compliant", MatchedBy: "uuid", LastSync: &lastSync, ImportedAt: importedAt},
+	}, res)
+
+	_, err = uc.GetByGUID(context.Background(), guid, "")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
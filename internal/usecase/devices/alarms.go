@@ -32,7 +32,7 @@ func (uc *UseCase) GetAlarmOccurrences(c context.Context, guid string) ([]dto.Al
 		return nil, err
 	}
 
-	alarms, err := device.GetAlarmOccurrences()
+	alarms, err := device.GetAlarmOccurrences(c)
 	if err != nil {
 		return nil, err
 	}
@@ -64,12 +64,21 @@ func (uc *UseCase) CreateAlarmOccurrences(c context.Context, guid string, alarm
 
 	alarm.InstanceID = alarm.ElementName
 
+	if alarm.TimeZone != "" {
+		startTime, err := inTimeZone(alarm.StartTime, alarm.TimeZone)
+		if err != nil {
+			return dto.AddAlarmOutput{}, err
+		}
+
+		alarm.StartTime = startTime
+	}
+
 	device, err := uc.device.SetupWsmanClient(*item, false, true)
 	if err != nil {
 		return dto.AddAlarmOutput{}, err
 	}
 
-	alarmReference, err := device.CreateAlarmOccurrences(alarm.InstanceID, alarm.StartTime, alarm.Interval, alarm.DeleteOnCompletion)
+	alarmReference, err := device.CreateAlarmOccurrences(c, alarm.InstanceID, alarm.StartTime, alarm.Interval, alarm.DeleteOnCompletion)
 	if err != nil {
 		return dto.AddAlarmOutput{}, ErrAMT.Wrap("CreateAlarmOccurrences", "device.CreateAlarmOccurrences", err)
 	}
@@ -94,7 +103,7 @@ func (uc *UseCase) DeleteAlarmOccurrences(c context.Context, guid, instanceID st
 		return err
 	}
 
-	err = device.DeleteAlarmOccurrences(instanceID)
+	err = device.DeleteAlarmOccurrences(c, instanceID)
 	if err != nil {
 		return err
 	}
@@ -160,3 +169,13 @@ func ParseInterval(duration string) (int, error) {
 
 	return totalMinutes, nil
 }
+
+// inTimeZone reinterprets the wall-clock date and time of t in the IANA zone name.
+func inTimeZone(t time.Time, name string) (time.Time, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.Time{}, ErrValidationUseCase.Wrap("CreateAlarmOccurrences", "time.LoadLocation", "invalid time zone "+name)
+	}
+
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc), nil
+}
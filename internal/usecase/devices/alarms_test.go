@@ -35,7 +35,7 @@ func initAlarmsTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *mocks.Mo
 
 	log := logger.New("error")
 
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, wsmanMock, management, repo
 }
@@ -57,7 +57,7 @@ func TestGetAlarmOccurrences(t *testing.T) {
 					SetupWsmanClient(*device, false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetAlarmOccurrences().
+					GetAlarmOccurrences(gomock.Any()).
 					Return([]alarmclock.AlarmClockOccurrence{}, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -87,7 +87,7 @@ func TestGetAlarmOccurrences(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetAlarmOccurrences().
+					GetAlarmOccurrences(gomock.Any()).
 					Return([]alarmclock.AlarmClockOccurrence{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -106,7 +106,7 @@ func TestGetAlarmOccurrences(t *testing.T) {
 					SetupWsmanClient(*device, false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetAlarmOccurrences().
+					GetAlarmOccurrences(gomock.Any()).
 					Return(nil, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -179,7 +179,7 @@ func TestCreateAlarmOccurrences(t *testing.T) {
 					SetupWsmanClient(*device, false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					CreateAlarmOccurrences(occ.InstanceID, occ.StartTime, 1, occ.DeleteOnCompletion).
+					CreateAlarmOccurrences(gomock.Any(), occ.InstanceID, occ.StartTime, 1, occ.DeleteOnCompletion).
 					Return(amtAlarmClock.AddAlarmOutput{}, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -209,7 +209,7 @@ func TestCreateAlarmOccurrences(t *testing.T) {
 					SetupWsmanClient(*device, false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					CreateAlarmOccurrences(occ.InstanceID, occ.StartTime, 1, occ.DeleteOnCompletion).
+					CreateAlarmOccurrences(gomock.Any(), occ.InstanceID, occ.StartTime, 1, occ.DeleteOnCompletion).
 					Return(amtAlarmClock.AddAlarmOutput{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -247,6 +247,33 @@ func TestCreateAlarmOccurrences(t *testing.T) {
 	}
 }
 
+func TestCreateAlarmOccurrencesInTimeZone(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123"}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	occ := dto.AlarmClockOccurrenceInput{
+		ElementName: "wake",
+		StartTime:   time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+		Interval:    1,
+		TimeZone:    "Europe/Berlin",
+	}
+
+	useCase, wsmanMock, management, repo := initAlarmsTest(t)
+
+	repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+	wsmanMock.EXPECT().SetupWsmanClient(*device, false, true).Return(management, nil)
+	management.EXPECT().
+		CreateAlarmOccurrences(gomock.Any(), "wake", time.Date(2024, 1, 1, 9, 0, 0, 0, berlin), 1, false).
+		Return(amtAlarmClock.AddAlarmOutput{}, nil)
+
+	_, err = useCase.CreateAlarmOccurrences(context.Background(), device.GUID, occ)
+	require.NoError(t, err)
+}
+
 func TestDeleteAlarmOccurrences(t *testing.T) {
 	t.Parallel()
 
@@ -270,7 +297,7 @@ func TestDeleteAlarmOccurrences(t *testing.T) {
 					SetupWsmanClient(*device, false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					DeleteAlarmOccurrences("").
+					DeleteAlarmOccurrences(gomock.Any(), "").
 					Return(nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -298,7 +325,7 @@ func TestDeleteAlarmOccurrences(t *testing.T) {
 					SetupWsmanClient(*device, false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					DeleteAlarmOccurrences("").
+					DeleteAlarmOccurrences(gomock.Any(), "").
 					Return(ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -1,6 +1,17 @@
 package devices
 
-import "github.com/device-management-toolkit/console/pkg/consoleerrors"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/amterror"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
 
 type AMTError struct {
 	Console consoleerrors.InternalError
@@ -11,7 +22,7 @@ func (e AMTError) Error() string {
 }
 
 func (e AMTError) Wrap(call, function string, err error) error {
-	_ = e.Console.Wrap(call, function, err)
+	_ = e.Console.Wrap(call, function, deviceError(call, err))
 	e.Console.Message = "amt error"
 
 	return e
@@ -21,6 +32,16 @@ type ExplorerError struct {
 	Console consoleerrors.InternalError
 }
 
+// ErrorCode is the code of the typed device error wrapped, CodeAMTError for the others.
+func (e AMTError) ErrorCode() consoleerrors.Code {
+	var coder consoleerrors.Coder
+	if errors.As(e.Console.OriginalError, &coder) {
+		return coder.ErrorCode()
+	}
+
+	return consoleerrors.CodeAMTError
+}
+
 func (e ExplorerError) Error() string {
 	return e.Console.Error()
 }
@@ -32,6 +53,10 @@ func (e ExplorerError) Wrap(call, function string, err error) error {
 	return e
 }
 
+func (e ExplorerError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeAMTError
+}
+
 type NotSupportedError struct {
 	Console consoleerrors.InternalError
 }
@@ -47,6 +72,10 @@ func (e NotSupportedError) Wrap(call, function, message string) error {
 	return e
 }
 
+func (e NotSupportedError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeNotSupported
+}
+
 type ValidationError struct {
 	Console consoleerrors.InternalError
 }
@@ -61,3 +90,120 @@ func (e ValidationError) Wrap(call, function, message string) error {
 
 	return e
 }
+
+// ErrorCode is the code of the error, CodeValidation unless the check sets its own.
+func (e ValidationError) ErrorCode() consoleerrors.Code {
+	if e.Console.Code != "" {
+		return e.Console.Code
+	}
+
+	return consoleerrors.CodeValidation
+}
+
+// UnauthorizedError is returned when a device rejects the credentials stored for it.
+type UnauthorizedError struct {
+	Console consoleerrors.InternalError
+}
+
+func (e UnauthorizedError) Error() string {
+	return e.Console.Error()
+}
+
+func (e UnauthorizedError) Unwrap() error {
+	return e.Console.OriginalError
+}
+
+func (e UnauthorizedError) Wrap(call, function string, err error) error {
+	_ = e.Console.Wrap(call, function, err)
+	e.Console.Message = "the device rejected its credentials"
+
+	return e
+}
+
+func (e UnauthorizedError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeAMTAuthFailed
+}
+
+// UnreachableError is returned when a device cannot be connected to or does not answer.
+type UnreachableError struct {
+	Console consoleerrors.InternalError
+}
+
+func (e UnreachableError) Error() string {
+	return e.Console.Error()
+}
+
+func (e UnreachableError) Unwrap() error {
+	return e.Console.OriginalError
+}
+
+func (e UnreachableError) Wrap(call, function string, err error) error {
+	_ = e.Console.Wrap(call, function, err)
+	e.Console.Message = "the device could not be reached"
+
+	return e
+}
+
+func (e UnreachableError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeDeviceUnreachable
+}
+
+// AMTReturnedError is returned when a device answers a call with a WS-Man fault. Code is the subcode of the
+// fault, e.g. DestinationUnreachable or InvalidParameter.
+type AMTReturnedError struct {
+	Console consoleerrors.InternalError
+	Code    string
+}
+
+func (e AMTReturnedError) Error() string {
+	return e.Console.Error()
+}
+
+func (e AMTReturnedError) Unwrap() error {
+	return e.Console.OriginalError
+}
+
+func (e AMTReturnedError) Wrap(call, function string, err error) error {
+	var fault *amterror.AMTError
+	if errors.As(err, &fault) {
+		e.Code = fault.SubCode
+	}
+
+	_ = e.Console.Wrap(call, function, err)
+	e.Console.Message = fmt.Sprintf("the device answered with a fault: %s", e.Code)
+
+	return e
+}
+
+func (e AMTReturnedError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeAMTError
+}
+
+// unauthorizedStatus is how the WS-Man client reports a device answering 401, in its message only.
+var unauthorizedStatus = fmt.Sprintf("%d %s", http.StatusUnauthorized, http.StatusText(http.StatusUnauthorized))
+
+// deviceError types the error of a call to a device as a NotSupportedError, an UnauthorizedError, an
+// UnreachableError or an AMTReturnedError, so that callers tell them apart without matching messages. Errors already carrying a
+// code, those of a cancelled or timed out request and the others are returned as they are.
+func deviceError(function string, err error) error {
+	var (
+		coder  consoleerrors.Coder
+		fault  *amterror.AMTError
+		netErr net.Error
+	)
+
+	switch {
+	case err == nil, errors.As(err, &coder), errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return err
+	case unsupported(err):
+		return ErrNotSupportedUseCase.Wrap(function, "device."+function, "the firmware of the device does not support "+function)
+	case errors.As(err, &fault):
+		return ErrAMTReturned.Wrap(function, "device."+function, err)
+	case errors.As(err, &netErr):
+		return ErrUnreachable.Wrap(function, "device."+function, err)
+	case strings.Contains(err.Error(), unauthorizedStatus):
+		return ErrUnauthorized.Wrap(function, "device."+function, err)
+	default:
+		return err
+	}
+}
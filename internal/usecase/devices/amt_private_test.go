@@ -0,0 +1,59 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/amterror"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+var errDevice = errors.New("unexpected answer")
+
+func TestDeviceError(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, deviceError("GetPowerState", nil))
+
+	var unauthorizedErr UnauthorizedError
+
+	err := deviceError("GetPowerState", fmt.Errorf("wsman.Client post received: %s", "401 Unauthorized")) //nolint:err113 // message of the WS-Man client
+	require.ErrorAs(t, err, &unauthorizedErr)
+	require.Equal(t, consoleerrors.CodeAMTAuthFailed, consoleerrors.CodeOf(err))
+
+	var unreachableErr UnreachableError
+
+	dialErr := &net.OpError{Op: "dial", Err: errDevice}
+	err = deviceError("GetPowerState", dialErr)
+	require.ErrorAs(t, err, &unreachableErr)
+	require.ErrorIs(t, err, dialErr, "the original error stays in the chain")
+
+	var returnedErr AMTReturnedError
+
+	err = deviceError("SetBootData", amterror.NewAMTError("InvalidParameter", "bad boot source", ""))
+	require.ErrorAs(t, err, &returnedErr)
+	require.Equal(t, "InvalidParameter", returnedErr.Code)
+	require.Equal(t, consoleerrors.CodeAMTError, consoleerrors.CodeOf(err))
+
+	var notSupportedErr NotSupportedError
+
+	err = deviceError("GetOSPowerSavingState", amterror.NewAMTError("b:DestinationUnreachable", "no route", ""))
+	require.ErrorAs(t, err, &notSupportedErr, "a class the firmware lacks")
+	require.Equal(t, consoleerrors.CodeNotSupported, consoleerrors.CodeOf(err))
+
+	err = deviceError("RequestOSPowerSavingStateChange", amterror.NewAMTError("b:ActionNotSupported", "unknown action", ""))
+	require.ErrorAs(t, err, &notSupportedErr, "a method the firmware lacks")
+
+	err = ErrAMT.Wrap("CreateAlarmOccurrences", "device.CreateAlarmOccurrences", dialErr)
+	require.Equal(t, consoleerrors.CodeDeviceUnreachable, consoleerrors.CodeOf(err), "AMTError keeps the code of the typed error")
+
+	// errors already carrying a code, cancelled requests and unknown errors are left as they are
+	require.Equal(t, ErrNotFound, deviceError("GetPowerState", ErrNotFound))
+	require.ErrorIs(t, deviceError("GetPowerState", context.DeadlineExceeded), context.DeadlineExceeded)
+	require.Equal(t, errDevice, deviceError("GetPowerState", errDevice))
+}
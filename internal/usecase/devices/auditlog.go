@@ -0,0 +1,183 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// StreamAuditLog reads the complete audit log of a device page by page and passes every record
+// to emit, oldest first. Reading stops at the first error of emit or once c is done, so that an
+// export abandoned by the client or running past its deadline does not keep querying the device.
+func (uc *UseCase) StreamAuditLog(c context.Context, guid string, emit func(dto.AuditLogEntry) error) error {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return err
+	}
+
+	if item == nil || item.GUID == "" {
+		return ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return err
+	}
+
+	for startIndex := 1; ; {
+		if err := c.Err(); err != nil {
+			return err
+		}
+
+		response, err := device.GetAuditLog(c, startIndex)
+		if err != nil {
+			return err
+		}
+
+		// a page is read in chronological order but decoded newest first
+		records := response.Body.DecodedRecordsResponse
+		for i := len(records) - 1; i >= 0; i-- {
+			if err := emit(AuditLogEntry(startIndex+len(records)-1-i, &records[i])); err != nil {
+				return err
+			}
+		}
+
+		startIndex += len(records)
+
+		if len(records) == 0 || startIndex > response.Body.ReadRecordsResponse.TotalRecordCount {
+			return nil
+		}
+	}
+}
+
+// QueryAuditLog reads a page of the decoded audit log of a device, newest record first, keeping the records
+// matching query. AMT reads the log in fixed windows counted from its oldest record: the windows are read from
+// the end of the log, and reading stops at the first record older than query.From or once a record matches past
+// the page, so that recent records are found without reading the complete log.
+func (uc *UseCase) QueryAuditLog(c context.Context, guid string, query dto.AuditLogQuery) (dto.AuditLogPage, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.AuditLogPage{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.AuditLogPage{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.AuditLogPage{}, err
+	}
+
+	// the first window tells the size of the log and of the windows
+	first, err := device.GetAuditLog(c, 1)
+	if err != nil {
+		return dto.AuditLogPage{}, err
+	}
+
+	page := dto.AuditLogPage{TotalCount: first.Body.ReadRecordsResponse.TotalRecordCount, Records: []dto.AuditLogEntry{}}
+	window := len(first.Body.DecodedRecordsResponse)
+	matched := 0
+
+	for end := page.TotalCount; window > 0 && end >= 1; end -= window {
+		start := max(1, end-window+1)
+
+		response := first
+		if start > 1 {
+			if err := c.Err(); err != nil {
+				return dto.AuditLogPage{}, err
+			}
+
+			if response, err = device.GetAuditLog(c, start); err != nil {
+				return dto.AuditLogPage{}, err
+			}
+		}
+
+		// a window is decoded newest first, and may run past end
+		records := response.Body.DecodedRecordsResponse
+		for i := range records {
+			index := start + len(records) - 1 - i
+			if index > end {
+				continue
+			}
+
+			entry := AuditLogEntry(index, &records[i])
+
+			if !query.From.IsZero() && entry.Time.Before(query.From) {
+				return page, nil
+			}
+
+			if !auditLogMatches(&query, &entry) {
+				continue
+			}
+
+			if matched++; matched <= query.Skip {
+				continue
+			}
+
+			if len(page.Records) >= query.Top {
+				page.HasMore = true
+
+				return page, nil
+			}
+
+			page.Records = append(page.Records, entry)
+		}
+	}
+
+	return page, nil
+}
+
+// auditLogMatches reports whether entry is in the time range and of an application and event of query.
+func auditLogMatches(query *dto.AuditLogQuery, entry *dto.AuditLogEntry) bool {
+	if !query.To.IsZero() && entry.Time.After(query.To) {
+		return false
+	}
+
+	if len(query.AuditAppIDs) > 0 && !slices.Contains(query.AuditAppIDs, entry.AuditAppID) {
+		return false
+	}
+
+	return len(query.EventIDs) == 0 || slices.Contains(query.EventIDs, entry.EventID)
+}
+
+// AuditLogEntry decodes the record at index of an audit log.
+func AuditLogEntry(index int, record *auditlog.AuditLogRecord) dto.AuditLogEntry {
+	return dto.AuditLogEntry{
+		Index:       index,
+		Time:        record.Time.UTC(),
+		AuditAppID:  record.AuditAppID,
+		AuditApp:    record.AuditApp,
+		EventID:     record.EventID,
+		Event:       record.Event,
+		Initiator:   record.Initiator,
+		NetAddress:  record.NetAddress,
+		Description: describeAuditEvent(record),
+	}
+}
+
+// describeAuditEvent turns the application and event IDs of a record into a readable description,
+// keeping the IDs of events unknown to the decoder.
+func describeAuditEvent(record *auditlog.AuditLogRecord) string {
+	app := record.AuditApp
+	if app == "" {
+		app = fmt.Sprintf("Application %d", record.AuditAppID)
+	}
+
+	event := record.Event
+	if event == "" {
+		event = fmt.Sprintf("%s %d", auditlog.UnknownEventID, record.EventID)
+	}
+
+	description := app + ": " + event
+
+	if record.ExStr != "" && record.ExStr != auditlog.UnknownEventID {
+		description += " (" + record.ExStr + ")"
+	}
+
+	return description
+}
@@ -0,0 +1,265 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+// auditLogPage is a page of a log with total records, the records are decoded newest first like AMT returns them.
+func auditLogPage(total int, eventIDs ...int) auditlog.Response {
+	response := auditlog.Response{}
+	response.Body.ReadRecordsResponse.TotalRecordCount = total
+
+	for _, id := range eventIDs {
+		response.Body.DecodedRecordsResponse = append(response.Body.DecodedRecordsResponse, auditlog.AuditLogRecord{
+			AuditAppID: 16,
+			AuditApp:   "Security Admin",
+			EventID:    id,
+			Event:      "Provisioning Started",
+		})
+	}
+
+	return response
+}
+
+func TestStreamAuditLog(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	tests := []struct {
+		name     string
+		manMock  func(man *mocks.MockWSMAN, man2 *mocks.MockManagement)
+		repoMock func(repo *mocks.MockDeviceManagementRepository)
+		emitErr  error
+		events   []int
+		err      error
+	}{
+		{
+			name: "all pages oldest first",
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(man2, nil)
+				man2.EXPECT().GetAuditLog(gomock.Any(), 1).Return(auditLogPage(5, 3, 2, 1), nil)
+				man2.EXPECT().GetAuditLog(gomock.Any(), 4).Return(auditLogPage(5, 5, 4), nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(gomock.Any(), device.GUID, "").Return(device, nil)
+			},
+			events: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name: "empty log",
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(man2, nil)
+				man2.EXPECT().GetAuditLog(gomock.Any(), 1).Return(auditLogPage(0), nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(gomock.Any(), device.GUID, "").Return(device, nil)
+			},
+		},
+		{
+			name: "emit fails",
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(man2, nil)
+				man2.EXPECT().GetAuditLog(gomock.Any(), 1).Return(auditLogPage(5, 3, 2, 1), nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(gomock.Any(), device.GUID, "").Return(device, nil)
+			},
+			emitErr: ErrGeneral,
+			events:  []int{1},
+			err:     ErrGeneral,
+		},
+		{
+			name: "GetAuditLog fails on a later page",
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(man2, nil)
+				man2.EXPECT().GetAuditLog(gomock.Any(), 1).Return(auditLogPage(5, 3, 2, 1), nil)
+				man2.EXPECT().GetAuditLog(gomock.Any(), 4).Return(auditlog.Response{}, ErrGeneral)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(gomock.Any(), device.GUID, "").Return(device, nil)
+			},
+			events: []int{1, 2, 3},
+			err:    ErrGeneral,
+		},
+		{
+			name:    "device not found",
+			manMock: func(_ *mocks.MockWSMAN, _ *mocks.MockManagement) {},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(gomock.Any(), device.GUID, "").Return(nil, nil)
+			},
+			err: devices.ErrNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, wsmanMock, management, repo := initInfoTest(t)
+
+			tc.manMock(wsmanMock, management)
+			tc.repoMock(repo)
+
+			var events []int
+
+			err := useCase.StreamAuditLog(context.Background(), device.GUID, func(entry dto.AuditLogEntry) error {
+				events = append(events, entry.EventID)
+				require.Equal(t, entry.EventID, entry.Index)
+				require.Equal(t, "Security Admin: Provisioning Started", entry.Description)
+
+				return tc.emitErr
+			})
+
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.events, events)
+		})
+	}
+}
+
+func TestStreamAuditLogCancelled(t *testing.T) {
+	t.Parallel()
+
+	useCase, wsmanMock, management, repo := initInfoTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	repo.EXPECT().GetByID(gomock.Any(), "device-guid-123", "").Return(&entity.Device{GUID: "device-guid-123"}, nil)
+	wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
+	management.EXPECT().GetAuditLog(gomock.Any(), 1).DoAndReturn(func(_ context.Context, _ int) (auditlog.Response, error) {
+		cancel()
+
+		return auditLogPage(20, 3, 2, 1), nil
+	})
+
+	emitted := 0
+
+	err := useCase.StreamAuditLog(ctx, "device-guid-123", func(dto.AuditLogEntry) error {
+		emitted++
+
+		return nil
+	})
+
+	// the records of the page read are passed on, the next page is not requested anymore
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 3, emitted)
+}
+
+// auditLogTime is the time of the first record of the logs of TestQueryAuditLog, a record is logged every hour.
+var auditLogTime = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+// auditLogWindow is the window of a log of total records read at start, the event ID of a record is its index.
+func auditLogWindow(total, start int) auditlog.Response {
+	response := auditlog.Response{}
+	response.Body.ReadRecordsResponse.TotalRecordCount = total
+
+	for index := min(total, start+9); index >= start; index-- {
+		response.Body.DecodedRecordsResponse = append(response.Body.DecodedRecordsResponse, auditlog.AuditLogRecord{
+			AuditAppID: 16,
+			EventID:    index,
+			Time:       auditLogTime.Add(time.Duration(index) * time.Hour),
+		})
+	}
+
+	return response
+}
+
+func TestQueryAuditLog(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	tests := []struct {
+		name    string
+		total   int
+		starts  []int
+		query   dto.AuditLogQuery
+		events  []int
+		hasMore bool
+	}{
+		{
+			name:    "newest records",
+			total:   25,
+			starts:  []int{1, 16},
+			query:   dto.AuditLogQuery{Top: 3},
+			events:  []int{25, 24, 23},
+			hasMore: true,
+		},
+		{
+			name:    "page across windows",
+			total:   25,
+			starts:  []int{1, 16, 6},
+			query:   dto.AuditLogQuery{Skip: 8, Top: 5},
+			events:  []int{17, 16, 15, 14, 13},
+			hasMore: true,
+		},
+		{
+			name:   "records since a time stop the reading",
+			total:  25,
+			starts: []int{1, 16},
+			query:  dto.AuditLogQuery{Top: 25, From: auditLogTime.Add(20 * time.Hour)},
+			events: []int{25, 24, 23, 22, 21, 20},
+		},
+		{
+			name:   "time range and events",
+			total:  25,
+			starts: []int{1, 16, 6},
+			query:  dto.AuditLogQuery{Top: 25, To: auditLogTime.Add(12 * time.Hour), AuditAppIDs: []int{16}, EventIDs: []int{3, 12, 13}},
+			events: []int{12, 3},
+		},
+		{
+			name:   "other application",
+			total:  5,
+			starts: []int{1},
+			query:  dto.AuditLogQuery{Top: 25, AuditAppIDs: []int{17}},
+			events: []int{},
+		},
+		{
+			name:   "empty log",
+			starts: []int{1},
+			query:  dto.AuditLogQuery{Top: 25},
+			events: []int{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, wsmanMock, management, repo := initInfoTest(t)
+
+			repo.EXPECT().GetByID(gomock.Any(), device.GUID, "").Return(device, nil)
+			wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
+
+			for _, start := range tc.starts {
+				management.EXPECT().GetAuditLog(gomock.Any(), start).Return(auditLogWindow(tc.total, start), nil)
+			}
+
+			page, err := useCase.QueryAuditLog(context.Background(), device.GUID, tc.query)
+			require.NoError(t, err)
+
+			events := []int{}
+			for _, entry := range page.Records {
+				require.Equal(t, entry.EventID, entry.Index)
+
+				events = append(events, entry.EventID)
+			}
+
+			require.Equal(t, tc.total, page.TotalCount)
+			require.Equal(t, tc.events, events)
+			require.Equal(t, tc.hasMore, page.HasMore)
+		})
+	}
+}
@@ -34,9 +34,9 @@ func (uc *UseCase) GetBootData(c context.Context, guid string) (boot.BootSetting
 		return boot.BootSettingDataResponse{}, err
 	}
 
-	bootData, err := device.GetBootData()
+	bootData, err := device.GetBootData(c)
 	if err != nil {
-		return boot.BootSettingDataResponse{}, err
+		return boot.BootSettingDataResponse{}, deviceError("GetBootData", err)
 	}
 
 	return bootData, nil
@@ -50,21 +50,21 @@ func (uc *UseCase) SetBootData(c context.Context, guid string, bootData boot.Boo
 	}
 
 	// Clear existing boot order
-	_, err = device.ChangeBootOrder("")
+	_, err = device.ChangeBootOrder(c, "")
 	if err != nil {
-		return err
+		return deviceError("ChangeBootOrder", err)
 	}
 
 	// Set new boot data
-	_, err = device.SetBootData(bootData)
+	_, err = device.SetBootData(c, bootData)
 	if err != nil {
-		return err
+		return deviceError("SetBootData", err)
 	}
 
 	// Enable boot configuration
-	_, err = device.SetBootConfigRole(1)
+	_, err = device.SetBootConfigRole(c, 1)
 	if err != nil {
-		return err
+		return deviceError("SetBootConfigRole", err)
 	}
 
 	return nil
@@ -77,7 +77,7 @@ func (uc *UseCase) ChangeBootOrder(c context.Context, guid, bootSource string) e
 		return err
 	}
 
-	_, err = device.ChangeBootOrder(bootSource)
+	_, err = device.ChangeBootOrder(c, bootSource)
 
-	return err
+	return deviceError("ChangeBootOrder", err)
 }
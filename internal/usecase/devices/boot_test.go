@@ -37,7 +37,7 @@ func initBootTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *mocks.Mock
 
 	managementMock := mocks.NewMockManagement(mockCtl)
 	log := logger.New("error")
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, wsmanMock, managementMock, repo
 }
@@ -63,7 +63,7 @@ func TestGetBootData(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(bootDataResponse, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -120,7 +120,7 @@ func TestGetBootData(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -180,13 +180,13 @@ func TestSetBootData(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					ChangeBootOrder("").
+					ChangeBootOrder(gomock.Any(), "").
 					Return(changeBootOrderResponse, nil)
 				hmm.EXPECT().
-					SetBootData(bootDataRequest).
+					SetBootData(gomock.Any(), bootDataRequest).
 					Return(bootDataResponse, nil)
 				hmm.EXPECT().
-					SetBootConfigRole(1).
+					SetBootConfigRole(gomock.Any(), 1).
 					Return(bootDataResponse, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -239,7 +239,7 @@ func TestSetBootData(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					ChangeBootOrder("").
+					ChangeBootOrder(gomock.Any(), "").
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -256,10 +256,10 @@ func TestSetBootData(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					ChangeBootOrder("").
+					ChangeBootOrder(gomock.Any(), "").
 					Return(changeBootOrderResponse, nil)
 				hmm.EXPECT().
-					SetBootData(bootDataRequest).
+					SetBootData(gomock.Any(), bootDataRequest).
 					Return(boot.BootSettingDataResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -276,13 +276,13 @@ func TestSetBootData(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					ChangeBootOrder("").
+					ChangeBootOrder(gomock.Any(), "").
 					Return(changeBootOrderResponse, nil)
 				hmm.EXPECT().
-					SetBootData(bootDataRequest).
+					SetBootData(gomock.Any(), bootDataRequest).
 					Return(bootDataResponse, nil)
 				hmm.EXPECT().
-					SetBootConfigRole(1).
+					SetBootConfigRole(gomock.Any(), 1).
 					Return(boot.BootSettingDataResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -334,7 +334,7 @@ func TestChangeBootOrder(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					ChangeBootOrder(bootSource).
+					ChangeBootOrder(gomock.Any(), bootSource).
 					Return(changeBootOrderResponse, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -387,7 +387,7 @@ func TestChangeBootOrder(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					ChangeBootOrder(bootSource).
+					ChangeBootOrder(gomock.Any(), bootSource).
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -0,0 +1,71 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	wsmanAPI "github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// StartWsmanCapture records the SOAP requests and responses exchanged with the device for the requested
+// number of minutes. Credentials are redacted from the trace, which replaces any earlier trace of the device.
+func (uc *UseCase) StartWsmanCapture(c context.Context, guid string, req dto.WsmanCaptureRequest) (dto.WsmanCapture, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.WsmanCapture{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.WsmanCapture{}, ErrNotFound
+	}
+
+	info, err := uc.device.StartCapture(item.GUID, time.Duration(req.Minutes)*time.Minute)
+	if err != nil {
+		return dto.WsmanCapture{}, ErrDeviceUseCase.Wrap("StartWsmanCapture", "device.StartCapture", err)
+	}
+
+	uc.log.Info("devices - wsman capture of %s started until %s", item.GUID, info.EndsAt.Format(time.RFC3339))
+
+	return captureToDTO(info), nil
+}
+
+// StopWsmanCapture ends a running capture early; its trace remains available for download.
+func (uc *UseCase) StopWsmanCapture(_ context.Context, guid string) (dto.WsmanCapture, error) {
+	info, err := uc.device.StopCapture(guid)
+	if err != nil {
+		return dto.WsmanCapture{}, captureError("StopWsmanCapture", "device.StopCapture", err)
+	}
+
+	return captureToDTO(info), nil
+}
+
+// GetWsmanCaptureTrace returns the redacted trace of the last capture of the device.
+func (uc *UseCase) GetWsmanCaptureTrace(_ context.Context, guid string) (dto.WsmanCapture, []byte, error) {
+	info, trace, err := uc.device.CaptureTrace(guid)
+	if err != nil {
+		return dto.WsmanCapture{}, nil, captureError("GetWsmanCaptureTrace", "device.CaptureTrace", err)
+	}
+
+	return captureToDTO(info), trace, nil
+}
+
+func captureError(function, call string, err error) error {
+	if errors.Is(err, wsmanAPI.ErrCaptureNotFound) {
+		return ErrNotFound.Wrap(function, call, err)
+	}
+
+	return ErrDeviceUseCase.Wrap(function, call, err)
+}
+
+func captureToDTO(info wsmanAPI.CaptureInfo) dto.WsmanCapture {
+	return dto.WsmanCapture{
+		GUID:      info.GUID,
+		StartedAt: info.StartedAt,
+		EndsAt:    info.EndsAt,
+		Active:    info.Active,
+		Exchanges: info.Exchanges,
+		Truncated: info.Truncated,
+	}
+}
@@ -0,0 +1,105 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func initCaptureTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *mocks.MockDeviceManagementRepository) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+	wsmanMock := mocks.NewMockWSMAN(mockCtl)
+	wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, nil)
+
+	return u, wsmanMock, repo
+}
+
+func TestStartWsmanCapture(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	info := wsman.CaptureInfo{GUID: "device-guid-123", StartedAt: startedAt, EndsAt: startedAt.Add(5 * time.Minute), Active: true}
+
+	tests := []struct {
+		name     string
+		repoMock func(*mocks.MockDeviceManagementRepository)
+		manMock  func(*mocks.MockWSMAN)
+		res      dto.WsmanCapture
+		err      error
+	}{
+		{
+			name: "success",
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), "device-guid-123", "").Return(&entity.Device{GUID: "device-guid-123"}, nil)
+			},
+			manMock: func(man *mocks.MockWSMAN) {
+				man.EXPECT().StartCapture("device-guid-123", 5*time.Minute).Return(info, nil)
+			},
+			res: dto.WsmanCapture{GUID: "device-guid-123", StartedAt: startedAt, EndsAt: startedAt.Add(5 * time.Minute), Active: true},
+		},
+		{
+			name: "device not found",
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), "device-guid-123", "").Return(nil, nil)
+			},
+			manMock: func(_ *mocks.MockWSMAN) {},
+			err:     devices.ErrNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, wsmanMock, repo := initCaptureTest(t)
+			tc.repoMock(repo)
+			tc.manMock(wsmanMock)
+
+			res, err := useCase.StartWsmanCapture(context.Background(), "device-guid-123", dto.WsmanCaptureRequest{Minutes: 5})
+
+			require.IsType(t, tc.err, err)
+			assert.Equal(t, tc.res, res)
+		})
+	}
+}
+
+func TestGetWsmanCaptureTrace(t *testing.T) {
+	t.Parallel()
+
+	useCase, wsmanMock, _ := initCaptureTest(t)
+	wsmanMock.EXPECT().CaptureTrace("device-guid-123").Return(wsman.CaptureInfo{GUID: "device-guid-123", Exchanges: 2}, []byte("trace"), nil)
+
+	capture, trace, err := useCase.GetWsmanCaptureTrace(context.Background(), "device-guid-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, dto.WsmanCapture{GUID: "device-guid-123", Exchanges: 2}, capture)
+	assert.Equal(t, []byte("trace"), trace)
+}
+
+func TestStopWsmanCapture(t *testing.T) {
+	t.Parallel()
+
+	useCase, wsmanMock, _ := initCaptureTest(t)
+	wsmanMock.EXPECT().StopCapture("device-guid-123").Return(wsman.CaptureInfo{}, wsman.ErrCaptureNotFound)
+
+	_, err := useCase.StopWsmanCapture(context.Background(), "device-guid-123")
+
+	require.IsType(t, devices.ErrNotFound, err)
+}
@@ -0,0 +1,110 @@
+package devices
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// CleanupCertificates deletes the certificates and key pairs a device keeps without using them, left behind by
+// failed provisioning attempts until they exhaust its storage. A certificate is orphaned when no TLS, wireless
+// or 802.1x credential context holds it, a key pair when no certificate kept depends on it. Read-only and
+// trusted root certificates are always kept, the CIRA connection trusts its server through a root certificate
+// without any credential context. The certificates are deleted before the key pairs they depend on.
+func (uc *UseCase) CleanupCertificates(c context.Context, guid string, dryRun bool) (dto.CertificateCleanup, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.CertificateCleanup{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.CertificateCleanup{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.CertificateCleanup{}, err
+	}
+
+	// the certificates come with the credential contexts and the concrete dependencies between them and the keys
+	response, err := device.GetCertificates(c)
+	if err != nil {
+		return dto.CertificateCleanup{}, deviceError("GetCertificates", err)
+	}
+
+	result := orphans(response)
+	result.DryRun = dryRun
+
+	if dryRun {
+		return result, nil
+	}
+
+	for _, instanceID := range result.Certificates {
+		if err := device.DeleteCertificate(c, instanceID); err != nil {
+			return dto.CertificateCleanup{}, deviceError("DeleteCertificate", err)
+		}
+	}
+
+	for _, instanceID := range result.Keys {
+		if err := device.DeleteKeyPair(c, instanceID); err != nil {
+			return dto.CertificateCleanup{}, deviceError("DeleteKeyPair", err)
+		}
+	}
+
+	return result, nil
+}
+
+// orphans returns the certificates and key pairs of a device no credential context uses.
+func orphans(response wsman.Certificates) dto.CertificateCleanup {
+	used := map[string]bool{}
+
+	items := response.CIMCredentialContextResponse.Items
+	for _, contexts := range [][]credential.CredentialContext{items.CredentialContextTLS, items.CredentialContext, items.CredentialContext8021x} {
+		for i := range contexts {
+			used[selectorText(contexts[i].ElementInContext)] = true
+		}
+	}
+
+	result := dto.CertificateCleanup{Certificates: []string{}, Keys: []string{}}
+	kept := map[string]bool{}
+
+	for _, cert := range response.PublicKeyCertificateResponse.PublicKeyCertificateItems {
+		if used[cert.InstanceID] || cert.ReadOnlyCertificate || cert.TrustedRootCertificate {
+			kept[cert.InstanceID] = true
+
+			continue
+		}
+
+		result.Certificates = append(result.Certificates, cert.InstanceID)
+	}
+
+	keysInUse := map[string]bool{}
+
+	for _, dependency := range response.ConcreteDependencyResponse.Items {
+		if kept[selectorText(dependency.Antecedent)] {
+			keysInUse[selectorText(dependency.Dependent)] = true
+		}
+	}
+
+	for _, key := range response.PublicPrivateKeyPairResponse.PublicPrivateKeyPairItems {
+		if !keysInUse[key.InstanceID] {
+			result.Keys = append(result.Keys, key.InstanceID)
+		}
+	}
+
+	return result
+}
+
+// selectorText returns the value of the first selector of a reference, the instance ID of a certificate or a key.
+func selectorText(ref models.AssociationReference) string {
+	selectors := ref.ReferenceParameters.SelectorSet.Selectors
+	if len(selectors) == 0 {
+		return ""
+	}
+
+	return selectors[0].Text
+}
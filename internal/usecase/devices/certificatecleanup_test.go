@@ -0,0 +1,126 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publicprivate"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/concrete"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	wsman "github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+func reference(instanceID string) models.AssociationReference {
+	return models.AssociationReference{
+		ReferenceParameters: models.ReferenceParametersNoNamespace{
+			SelectorSet: models.SelectorNoNamespace{
+				Selectors: []models.SelectorResponse{{Name: "InstanceID", Text: instanceID}},
+			},
+		},
+	}
+}
+
+// certificateGarbage is a device with a TLS certificate and its key in use, an 802.1x certificate and key in
+// use, a root and a read-only certificate, and the certificate and keys of failed provisioning attempts.
+func certificateGarbage() wsman.Certificates {
+	return wsman.Certificates{
+		PublicKeyCertificateResponse: publickey.RefinedPullResponse{
+			PublicKeyCertificateItems: []publickey.RefinedPublicKeyCertificateResponse{
+				{InstanceID: "Intel(r) AMT Certificate: Handle: 0", ReadOnlyCertificate: true},
+				{InstanceID: "Intel(r) AMT Certificate: Handle: 1"},
+				{InstanceID: "Intel(r) AMT Certificate: Handle: 2", TrustedRootCertificate: true},
+				{InstanceID: "Intel(r) AMT Certificate: Handle: 3"},
+				{InstanceID: "Intel(r) AMT Certificate: Handle: 4"},
+			},
+		},
+		PublicPrivateKeyPairResponse: publicprivate.RefinedPullResponse{
+			PublicPrivateKeyPairItems: []publicprivate.RefinedPublicPrivateKeyPair{
+				{InstanceID: "Intel(r) AMT Key: Handle: 0"},
+				{InstanceID: "Intel(r) AMT Key: Handle: 1"},
+				{InstanceID: "Intel(r) AMT Key: Handle: 2"},
+				{InstanceID: "Intel(r) AMT Key: Handle: 3"},
+			},
+		},
+		CIMCredentialContextResponse: credential.PullResponse{
+			Items: credential.Items{
+				CredentialContextTLS:   []credential.CredentialContext{{ElementInContext: reference("Intel(r) AMT Certificate: Handle: 1")}},
+				CredentialContext8021x: []credential.CredentialContext{{ElementInContext: reference("Intel(r) AMT Certificate: Handle: 3")}},
+			},
+		},
+		ConcreteDependencyResponse: concrete.PullResponse{
+			Items: []concrete.ConcreteDependency{
+				{Antecedent: reference("Intel(r) AMT Certificate: Handle: 1"), Dependent: reference("Intel(r) AMT Key: Handle: 0")},
+				{Antecedent: reference("Intel(r) AMT Certificate: Handle: 3"), Dependent: reference("Intel(r) AMT Key: Handle: 1")},
+				{Antecedent: reference("Intel(r) AMT Certificate: Handle: 4"), Dependent: reference("Intel(r) AMT Key: Handle: 2")},
+			},
+		},
+	}
+}
+
+func TestCleanupCertificates(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+	orphaned := dto.CertificateCleanup{
+		Certificates: []string{"Intel(r) AMT Certificate: Handle: 4"},
+		Keys:         []string{"Intel(r) AMT Key: Handle: 2", "Intel(r) AMT Key: Handle: 3"},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, wsmanMock, management, repo := initCertificateTest(t)
+
+		repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+		wsmanMock.EXPECT().SetupWsmanClient(*device, false, true).Return(management, nil)
+		management.EXPECT().GetCertificates(gomock.Any()).Return(certificateGarbage(), nil)
+
+		result, err := useCase.CleanupCertificates(context.Background(), device.GUID, true)
+		require.NoError(t, err)
+
+		expected := orphaned
+		expected.DryRun = true
+		require.Equal(t, expected, result)
+	})
+
+	t.Run("deletes the certificates before their keys", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, wsmanMock, management, repo := initCertificateTest(t)
+
+		repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+		wsmanMock.EXPECT().SetupWsmanClient(*device, false, true).Return(management, nil)
+		management.EXPECT().GetCertificates(gomock.Any()).Return(certificateGarbage(), nil)
+		gomock.InOrder(
+			management.EXPECT().DeleteCertificate(gomock.Any(), "Intel(r) AMT Certificate: Handle: 4").Return(nil),
+			management.EXPECT().DeleteKeyPair(gomock.Any(), "Intel(r) AMT Key: Handle: 2").Return(nil),
+			management.EXPECT().DeleteKeyPair(gomock.Any(), "Intel(r) AMT Key: Handle: 3").Return(nil),
+		)
+
+		result, err := useCase.CleanupCertificates(context.Background(), device.GUID, false)
+		require.NoError(t, err)
+		require.Equal(t, orphaned, result)
+	})
+
+	t.Run("a failed deletion stops the cleanup", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, wsmanMock, management, repo := initCertificateTest(t)
+
+		repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+		wsmanMock.EXPECT().SetupWsmanClient(*device, false, true).Return(management, nil)
+		management.EXPECT().GetCertificates(gomock.Any()).Return(certificateGarbage(), nil)
+		management.EXPECT().DeleteCertificate(gomock.Any(), "Intel(r) AMT Certificate: Handle: 4").Return(ErrCertificate)
+
+		_, err := useCase.CleanupCertificates(context.Background(), device.GUID, false)
+		require.ErrorIs(t, err, ErrCertificate)
+	})
+}
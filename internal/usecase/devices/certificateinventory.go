@@ -0,0 +1,175 @@
+package devices
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/device-management-toolkit/console/internal/certificates"
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+const (
+	inventoryDevicesPerPage = 100
+	maxInventoryCalls       = 8
+)
+
+// GetCertificateInventory reads the certificates of every device of a tenant and returns those matching the
+// query, the earliest to expire first. The devices whose certificates cannot be read are listed apart.
+func (uc *UseCase) GetCertificateInventory(ctx context.Context, tenantID string, q dto.CertificateInventoryQuery) (dto.CertificateInventory, error) {
+	items := []entity.Device{}
+
+	for skip := 0; ; skip += inventoryDevicesPerPage {
+		page, err := uc.repo.Get(ctx, inventoryDevicesPerPage, skip, tenantID)
+		if err != nil {
+			return dto.CertificateInventory{}, ErrDatabase.Wrap("GetCertificateInventory", "uc.repo.Get", err)
+		}
+
+		items = append(items, page...)
+
+		if len(page) < inventoryDevicesPerPage {
+			break
+		}
+	}
+
+	settings := make([]dto.SecuritySettings, len(items))
+	errs := make([]error, len(items))
+
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, maxInventoryCalls)
+
+	for i := range items {
+		wg.Add(1)
+
+		sem <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			settings[i], errs[i] = uc.securitySettings(ctx, &items[i])
+		}()
+	}
+
+	wg.Wait()
+
+	inventory := dto.CertificateInventory{
+		Devices:      len(items),
+		Certificates: []dto.CertificateInventoryEntry{},
+		Unreachable:  []dto.CertificateInventoryFailure{},
+	}
+
+	for i := range items {
+		if errs[i] != nil {
+			inventory.Unreachable = append(inventory.Unreachable, dto.CertificateInventoryFailure{
+				GUID:     items[i].GUID,
+				Hostname: items[i].Hostname,
+				Error:    errs[i].Error(),
+			})
+
+			continue
+		}
+
+		for _, cert := range settings[i].CertificateResponse.Certificates {
+			entry := inventoryEntry(&items[i], &cert)
+			if inventoryMatches(&entry, q) {
+				inventory.Certificates = append(inventory.Certificates, entry)
+			}
+		}
+	}
+
+	// certificates without a readable expiry go last
+	slices.SortStableFunc(inventory.Certificates, func(a, b dto.CertificateInventoryEntry) int {
+		switch {
+		case a.NotAfter == nil && b.NotAfter == nil:
+			return 0
+		case a.NotAfter == nil:
+			return 1
+		case b.NotAfter == nil:
+			return -1
+		default:
+			return a.NotAfter.Compare(*b.NotAfter)
+		}
+	})
+
+	return inventory, nil
+}
+
+// inventoryEntry describes a certificate of a device, its expiry and fingerprint read from the certificate.
+func inventoryEntry(item *entity.Device, cert *dto.RefinedCertificate) dto.CertificateInventoryEntry {
+	entry := dto.CertificateInventoryEntry{
+		GUID:        item.GUID,
+		Hostname:    item.Hostname,
+		InstanceID:  cert.InstanceID,
+		Subject:     cert.Subject,
+		Issuer:      cert.Issuer,
+		TrustedRoot: cert.TrustedRootCertificate,
+		ReadOnly:    cert.ReadOnlyCertificate,
+		Usage:       cert.AssociatedProfiles,
+	}
+
+	if entry.Usage == nil {
+		entry.Usage = []string{}
+	}
+
+	der, err := base64.StdEncoding.DecodeString(cert.X509Certificate)
+	if err != nil {
+		return entry
+	}
+
+	parsed, err := x509.ParseCertificate(der)
+	if err != nil {
+		return entry
+	}
+
+	fingerprint := sha256.Sum256(parsed.Raw)
+	entry.SHA256Fingerprint = hex.EncodeToString(fingerprint[:])
+	entry.NotAfter = &parsed.NotAfter
+	entry.KeyAlgorithm = certificates.KeyAlgorithmOf(parsed.PublicKey)
+
+	return entry
+}
+
+// inventoryMatches reports whether a certificate passes every filter of the query.
+func inventoryMatches(entry *dto.CertificateInventoryEntry, q dto.CertificateInventoryQuery) bool {
+	if q.Search != "" && !containsFold(entry.Subject, q.Search) && !containsFold(entry.Issuer, q.Search) &&
+		!containsFold(entry.Hostname, q.Search) && !containsFold(entry.GUID, q.Search) {
+		return false
+	}
+
+	if !containsFold(entry.Issuer, q.Issuer) || !containsFold(entry.Subject, q.Subject) {
+		return false
+	}
+
+	if q.TrustedRoot != nil && entry.TrustedRoot != *q.TrustedRoot {
+		return false
+	}
+
+	if !q.ExpiresBefore.IsZero() && (entry.NotAfter == nil || !entry.NotAfter.Before(q.ExpiresBefore)) {
+		return false
+	}
+
+	switch {
+	case q.Usage == "":
+		return true
+	case strings.EqualFold(q.Usage, "none"):
+		return len(entry.Usage) == 0
+	default:
+		// a wireless usage names its profile, Wireless - office
+		return slices.ContainsFunc(entry.Usage, func(usage string) bool {
+			return strings.EqualFold(strings.SplitN(usage, " - ", 2)[0], q.Usage)
+		})
+	}
+}
+
+// containsFold reports whether s holds substr, ignoring case.
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
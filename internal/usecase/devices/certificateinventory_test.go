@@ -0,0 +1,157 @@
+package devices_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	wsman "github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// inventoryCertificate returns a self-signed certificate of subject cn expiring at notAfter, base64 encoded as
+// AMT returns it.
+func inventoryCertificate(t *testing.T, cn string, notAfter time.Time) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func TestGetCertificateInventory(t *testing.T) {
+	t.Parallel()
+
+	soon := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	office := &entity.Device{GUID: "guid1", Hostname: "office-1"}
+	lab := &entity.Device{GUID: "guid2", Hostname: "lab-1"}
+	offline := &entity.Device{GUID: "guid3", Hostname: "lab-2"}
+
+	officeCertificates := wsman.Certificates{
+		PublicKeyCertificateResponse: publickey.RefinedPullResponse{
+			PublicKeyCertificateItems: []publickey.RefinedPublicKeyCertificateResponse{
+				{
+					InstanceID: "Intel(r) AMT Certificate: Handle: 1", Subject: "CN=Contoso Root CA", Issuer: "CN=Contoso Root CA",
+					TrustedRootCertificate: true, X509Certificate: inventoryCertificate(t, "Contoso Root CA", later),
+				},
+				{
+					InstanceID: "Intel(r) AMT Certificate: Handle: 2", Subject: "CN=office-1", Issuer: "CN=Contoso Issuing CA",
+					X509Certificate: inventoryCertificate(t, "office-1", soon),
+				},
+			},
+		},
+		CIMCredentialContextResponse: credential.PullResponse{
+			Items: credential.Items{
+				CredentialContextTLS: []credential.CredentialContext{{
+					ElementInContext:        reference("Intel(r) AMT Certificate: Handle: 2"),
+					ElementProvidingContext: reference("Intel(r) AMT: TLS Protocol Endpoint Collection"),
+				}},
+			},
+		},
+	}
+	labCertificates := wsman.Certificates{
+		PublicKeyCertificateResponse: publickey.RefinedPullResponse{
+			PublicKeyCertificateItems: []publickey.RefinedPublicKeyCertificateResponse{
+				{
+					InstanceID: "Intel(r) AMT Certificate: Handle: 1", Subject: "CN=Fabrikam Root CA", Issuer: "CN=Fabrikam Root CA",
+					TrustedRootCertificate: true, X509Certificate: inventoryCertificate(t, "Fabrikam Root CA", later),
+				},
+			},
+		},
+	}
+
+	trusted := true
+
+	tests := []struct {
+		name     string
+		query    dto.CertificateInventoryQuery
+		expected []string // hostname and subject of the certificates, the earliest to expire first
+	}{
+		{
+			name:     "every certificate",
+			expected: []string{"office-1 CN=office-1", "office-1 CN=Contoso Root CA", "lab-1 CN=Fabrikam Root CA"},
+		},
+		{
+			name:     "devices trusting a CA",
+			query:    dto.CertificateInventoryQuery{Subject: "contoso root", TrustedRoot: &trusted},
+			expected: []string{"office-1 CN=Contoso Root CA"},
+		},
+		{
+			name:     "certificates expiring soon",
+			query:    dto.CertificateInventoryQuery{ExpiresBefore: soon.AddDate(0, 1, 0)},
+			expected: []string{"office-1 CN=office-1"},
+		},
+		{
+			name:     "certificates used for TLS",
+			query:    dto.CertificateInventoryQuery{Usage: "tls"},
+			expected: []string{"office-1 CN=office-1"},
+		},
+		{
+			name:     "search by device",
+			query:    dto.CertificateInventoryQuery{Search: "LAB"},
+			expected: []string{"lab-1 CN=Fabrikam Root CA"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, wsmanMock, _, repo := initCertificateTest(t)
+
+			ctrl := gomock.NewController(t)
+			officeManagement := mocks.NewMockManagement(ctrl)
+			labManagement := mocks.NewMockManagement(ctrl)
+			offlineManagement := mocks.NewMockManagement(ctrl)
+
+			repo.EXPECT().Get(context.Background(), 100, 0, "").Return([]entity.Device{*office, *lab, *offline}, nil)
+			wsmanMock.EXPECT().SetupWsmanClient(*office, false, true).Return(officeManagement, nil)
+			wsmanMock.EXPECT().SetupWsmanClient(*lab, false, true).Return(labManagement, nil)
+			wsmanMock.EXPECT().SetupWsmanClient(*offline, false, true).Return(offlineManagement, nil)
+			officeManagement.EXPECT().GetCertificates(gomock.Any()).Return(officeCertificates, nil)
+			labManagement.EXPECT().GetCertificates(gomock.Any()).Return(labCertificates, nil)
+			offlineManagement.EXPECT().GetCertificates(gomock.Any()).Return(wsman.Certificates{}, ErrCertificate)
+
+			inventory, err := useCase.GetCertificateInventory(context.Background(), "", tc.query)
+			require.NoError(t, err)
+			require.Equal(t, 3, inventory.Devices)
+			require.Equal(t, []dto.CertificateInventoryFailure{{GUID: "guid3", Hostname: "lab-2", Error: ErrCertificate.Error()}}, inventory.Unreachable)
+
+			certificates := make([]string, len(inventory.Certificates))
+			for i, entry := range inventory.Certificates {
+				certificates[i] = entry.Hostname + " " + entry.Subject
+				require.Equal(t, "ecdsa-p256", entry.KeyAlgorithm)
+			}
+
+			require.Equal(t, tc.expected, certificates)
+		})
+	}
+}
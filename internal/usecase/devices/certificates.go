@@ -22,6 +22,7 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/concrete"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
 
+	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
@@ -170,12 +171,24 @@ func (uc *UseCase) GetCertificates(c context.Context, guid string) (dto.Security
 		return dto.SecuritySettings{}, ErrNotFound
 	}
 
+	settings, err := uc.securitySettings(c, item)
+	if err != nil {
+		return dto.SecuritySettings{}, err
+	}
+
+	uc.setRevocationStatus(c, settings.CertificateResponse.Certificates)
+
+	return settings, nil
+}
+
+// securitySettings reads the certificates and keys of a device with the profiles they are associated with.
+func (uc *UseCase) securitySettings(c context.Context, item *entity.Device) (dto.SecuritySettings, error) {
 	device, err := uc.device.SetupWsmanClient(*item, false, true)
 	if err != nil {
 		return dto.SecuritySettings{}, err
 	}
 
-	response, err := device.GetCertificates()
+	response, err := device.GetCertificates(c)
 	if err != nil {
 		return dto.SecuritySettings{}, err
 	}
@@ -272,7 +285,7 @@ func (uc *UseCase) GetDeviceCertificate(c context.Context, guid string) (dto.Cer
 		return dto.Certificate{}, err
 	}
 
-	cert1, err := device.GetDeviceCertificate()
+	cert1, err := device.GetDeviceCertificate(c)
 	if err != nil {
 		return dto.Certificate{}, err
 	}
@@ -365,6 +378,10 @@ func (uc *UseCase) AddCertificate(c context.Context, guid string, certInfo dto.C
 		return "", err
 	}
 
+	if uc.revocation != nil && uc.revocation.Check(c, cert, nil).Status == dto.RevocationRevoked {
+		return "", ErrCertRevoked.Wrap("AddCertificate", "uc.revocation.Check", "certificate has been revoked by its issuer")
+	}
+
 	pemCert := pem.EncodeToMemory(&pem.Block{
 		Type:  "CERTIFICATE",
 		Bytes: cert.Raw,
@@ -383,12 +400,12 @@ func (uc *UseCase) AddCertificate(c context.Context, guid string, certInfo dto.C
 	}
 
 	if certInfo.IsTrusted {
-		handle, err = device.AddTrustedRootCert(cleanedCert)
+		handle, err = device.AddTrustedRootCert(c, cleanedCert)
 		if err != nil {
 			return "", err
 		}
 	} else {
-		handle, err = device.AddClientCert(cleanedCert)
+		handle, err = device.AddClientCert(c, cleanedCert)
 		if err != nil {
 			return "", err
 		}
@@ -448,7 +465,7 @@ func (uc *UseCase) DeleteCertificate(c context.Context, guid, instanceID string)
 		return err
 	}
 
-	err = device.DeleteCertificate(instanceID)
+	err = device.DeleteCertificate(c, instanceID)
 	if err != nil {
 		return ErrDeviceUseCase.Wrap("DeleteCertificate", "failed to delete certificate", fmt.Errorf("failed to delete certificate %s: %w", instanceID, err))
 	}
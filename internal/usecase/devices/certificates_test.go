@@ -35,7 +35,7 @@ func initCertificateTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *moc
 
 	management := mocks.NewMockManagement(mockCtl)
 	log := logger.New("error")
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, wsmanMock, management, repo
 }
@@ -57,7 +57,7 @@ func TestGetCertificates(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetCertificates().
+					GetCertificates(gomock.Any()).
 					Return(wsman.Certificates{}, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -85,7 +85,7 @@ func TestGetCertificates(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetCertificates().
+					GetCertificates(gomock.Any()).
 					Return(wsman.Certificates{
 						CIMCredentialContextResponse: credential.PullResponse{
 							XMLName: xml.Name{
@@ -201,7 +201,7 @@ func TestGetCertificates(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetCertificates().
+					GetCertificates(gomock.Any()).
 					Return(wsman.Certificates{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -296,7 +296,7 @@ func TestAddCertificate(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man, nil)
 				man.EXPECT().
-					AddTrustedRootCert(gomock.Any()).
+					AddTrustedRootCert(gomock.Any(), gomock.Any()).
 					Return("", ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -389,7 +389,7 @@ func TestDeleteCertificate(t *testing.T) {
 			},
 			mockWsman: func(wsmanMock *mocks.MockWSMAN, management *mocks.MockManagement) {
 				wsmanMock.EXPECT().SetupWsmanClient(*device, false, true).Return(management, nil)
-				management.EXPECT().GetCertificates().Return(wsman.Certificates{}, errors.New("wsman error"))
+				management.EXPECT().GetCertificates(gomock.Any()).Return(wsman.Certificates{}, errors.New("wsman error"))
 			},
 			err: errors.New("wsman error"),
 		},
@@ -402,7 +402,7 @@ func TestDeleteCertificate(t *testing.T) {
 			mockWsman: func(wsmanMock *mocks.MockWSMAN, management *mocks.MockManagement) {
 				wsmanMock.EXPECT().SetupWsmanClient(*device, false, true).Return(management, nil)
 				// Return empty certificates response
-				management.EXPECT().GetCertificates().Return(wsman.Certificates{}, nil)
+				management.EXPECT().GetCertificates(gomock.Any()).Return(wsman.Certificates{}, nil)
 			},
 			err: devices.ErrNotFound,
 		},
@@ -429,7 +429,7 @@ func TestDeleteCertificate(t *testing.T) {
 						},
 					},
 				}
-				management.EXPECT().GetCertificates().Return(certificates, nil)
+				management.EXPECT().GetCertificates(gomock.Any()).Return(certificates, nil)
 			},
 			err: &dto.NotValidError{},
 		},
@@ -456,7 +456,7 @@ func TestDeleteCertificate(t *testing.T) {
 						},
 					},
 				}
-				management.EXPECT().GetCertificates().Return(certificates, nil)
+				management.EXPECT().GetCertificates(gomock.Any()).Return(certificates, nil)
 			},
 			err: &dto.NotValidError{},
 		},
@@ -483,8 +483,8 @@ func TestDeleteCertificate(t *testing.T) {
 						},
 					},
 				}
-				management.EXPECT().GetCertificates().Return(certificates, nil)
-				management.EXPECT().DeleteCertificate("Intel(r) AMT Certificate: Handle: 1").Return(errors.New("wsman delete error"))
+				management.EXPECT().GetCertificates(gomock.Any()).Return(certificates, nil)
+				management.EXPECT().DeleteCertificate(gomock.Any(), "Intel(r) AMT Certificate: Handle: 1").Return(errors.New("wsman delete error"))
 			},
 			err: devices.ErrDeviceUseCase,
 		},
@@ -511,8 +511,8 @@ func TestDeleteCertificate(t *testing.T) {
 						},
 					},
 				}
-				management.EXPECT().GetCertificates().Return(certificates, nil)
-				management.EXPECT().DeleteCertificate("Intel(r) AMT Certificate: Handle: 1").Return(nil)
+				management.EXPECT().GetCertificates(gomock.Any()).Return(certificates, nil)
+				management.EXPECT().DeleteCertificate(gomock.Any(), "Intel(r) AMT Certificate: Handle: 1").Return(nil)
 			},
 			err: nil,
 		},
@@ -584,10 +584,10 @@ func TestDeleteCertificate_Integration(t *testing.T) {
 				},
 			},
 		}
-		management.EXPECT().GetCertificates().Return(certificates, nil)
+		management.EXPECT().GetCertificates(gomock.Any()).Return(certificates, nil)
 
 		// Mock DeleteCertificate
-		management.EXPECT().DeleteCertificate("Intel(r) AMT Certificate: Handle: 1").Return(nil)
+		management.EXPECT().DeleteCertificate(gomock.Any(), "Intel(r) AMT Certificate: Handle: 1").Return(nil)
 
 		err := useCase.DeleteCertificate(context.Background(), device.GUID, "Intel(r) AMT Certificate: Handle: 1")
 		require.NoError(t, err) // Should succeed now
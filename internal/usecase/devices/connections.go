@@ -2,12 +2,17 @@ package devices
 
 import (
 	"context"
+	"slices"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 )
 
+// RemoteTLSInstanceID is the InstanceID of the TLS settings of the network interface of AMT, next to those of
+// the local interface.
+const RemoteTLSInstanceID = "Intel(r) AMT 802.3 TLS Settings"
+
 func (uc *UseCase) GetTLSSettingData(c context.Context, guid string) ([]dto.SettingDataResponse, error) {
 	item, err := uc.repo.GetByID(c, guid, "")
 	if err != nil {
@@ -23,7 +28,7 @@ func (uc *UseCase) GetTLSSettingData(c context.Context, guid string) ([]dto.Sett
 		return nil, err
 	}
 
-	response, err := device.GetTLSSettingData()
+	response, err := device.GetTLSSettingData(c)
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +44,58 @@ func (uc *UseCase) GetTLSSettingData(c context.Context, guid string) ([]dto.Sett
 	return d1, nil
 }
 
+// SetTLSSettings requires or not a client certificate on the network interface of a device and sets the common
+// names the certificate may have, keeping whether non-TLS connections are accepted. It returns the settings of
+// the interface as applied.
+func (uc *UseCase) SetTLSSettings(c context.Context, guid string, req dto.TLSSettingsRequest) (dto.SettingDataResponse, error) {
+	if len(req.TrustedCNs) > 0 && !req.MutualAuthentication {
+		return dto.SettingDataResponse{}, ErrValidationUseCase.Wrap("SetTLSSettings", "validate", "trustedCNs require mutualAuthentication")
+	}
+
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.SettingDataResponse{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.SettingDataResponse{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.SettingDataResponse{}, err
+	}
+
+	response, err := device.GetTLSSettingData(c)
+	if err != nil {
+		return dto.SettingDataResponse{}, deviceError("GetTLSSettingData", err)
+	}
+
+	i := slices.IndexFunc(response, func(s tls.SettingDataResponse) bool { return s.InstanceID == RemoteTLSInstanceID })
+	if i < 0 || !response[i].Enabled {
+		return dto.SettingDataResponse{}, ErrValidationUseCase.Wrap("SetTLSSettings", "device.GetTLSSettingData", "TLS is not enabled on the network interface of the device")
+	}
+
+	settings := response[i]
+	settings.MutualAuthentication = req.MutualAuthentication
+	settings.TrustedCN = req.TrustedCNs
+
+	err = device.PutTLSSettingData(c, tls.SettingDataRequest{
+		ElementName:                   settings.ElementName,
+		InstanceID:                    settings.InstanceID,
+		MutualAuthentication:          settings.MutualAuthentication,
+		Enabled:                       settings.Enabled,
+		TrustedCN:                     settings.TrustedCN,
+		AcceptNonSecureConnections:    settings.AcceptNonSecureConnections,
+		NonSecureConnectionsSupported: settings.NonSecureConnectionsSupported != nil && *settings.NonSecureConnectionsSupported,
+	})
+	if err != nil {
+		return dto.SettingDataResponse{}, deviceError("PutTLSSettingData", err)
+	}
+
+	return *uc.tlsSettingDataEntityToDTO(&settings), nil
+}
+
 func (uc *UseCase) tlsSettingDataEntityToDTO(d *tls.SettingDataResponse) *dto.SettingDataResponse {
 	d1 := &dto.SettingDataResponse{
 		ElementName:                   d.ElementName,
@@ -28,7 +28,7 @@ func initConnectionsTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *moc
 
 	management := mocks.NewMockManagement(mockCtl)
 	log := logger.New("error")
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, wsmanMock, management, repo
 }
@@ -50,7 +50,7 @@ func TestGetTLSSettings(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetTLSSettingData().
+					GetTLSSettingData(gomock.Any()).
 					Return([]tls.SettingDataResponse{
 						{
 							ElementName:                   "",
@@ -101,7 +101,7 @@ func TestGetTLSSettings(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetTLSSettingData().
+					GetTLSSettingData(gomock.Any()).
 					Return(nil, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -134,3 +134,109 @@ func TestGetTLSSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestSetTLSSettings(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{
+		GUID:     "device-guid-123",
+		TenantID: "tenant-id-456",
+	}
+
+	remote := tls.SettingDataResponse{
+		ElementName:                "Intel(r) AMT 802.3 TLS Settings",
+		InstanceID:                 devices.RemoteTLSInstanceID,
+		Enabled:                    true,
+		AcceptNonSecureConnections: true,
+	}
+
+	tests := []struct {
+		name     string
+		req      dto.TLSSettingsRequest
+		manMock  func(*mocks.MockWSMAN, *mocks.MockManagement)
+		repoMock func(*mocks.MockDeviceManagementRepository)
+		res      dto.SettingDataResponse
+		err      error
+	}{
+		{
+			name: "success",
+			req:  dto.TLSSettingsRequest{MutualAuthentication: true, TrustedCNs: []string{"console.example.com"}},
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(man2, nil)
+				man2.EXPECT().GetTLSSettingData(gomock.Any()).Return([]tls.SettingDataResponse{remote}, nil)
+				man2.EXPECT().PutTLSSettingData(gomock.Any(), tls.SettingDataRequest{
+					ElementName:                remote.ElementName,
+					InstanceID:                 remote.InstanceID,
+					MutualAuthentication:       true,
+					Enabled:                    true,
+					TrustedCN:                  []string{"console.example.com"},
+					AcceptNonSecureConnections: true,
+				}).Return(nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+			},
+			res: dto.SettingDataResponse{
+				ElementName:                remote.ElementName,
+				InstanceID:                 remote.InstanceID,
+				MutualAuthentication:       true,
+				Enabled:                    true,
+				TrustedCN:                  []string{"console.example.com"},
+				AcceptNonSecureConnections: true,
+			},
+		},
+		{
+			name:     "trusted CNs without mutual authentication",
+			req:      dto.TLSSettingsRequest{TrustedCNs: []string{"console.example.com"}},
+			repoMock: func(*mocks.MockDeviceManagementRepository) {},
+			err:      devices.ErrValidationUseCase,
+		},
+		{
+			name: "TLS not enabled",
+			req:  dto.TLSSettingsRequest{MutualAuthentication: true},
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				disabled := remote
+				disabled.Enabled = false
+
+				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(man2, nil)
+				man2.EXPECT().GetTLSSettingData(gomock.Any()).Return([]tls.SettingDataResponse{disabled}, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+			},
+			err: devices.ErrValidationUseCase,
+		},
+		{
+			name: "PutTLSSettingData fails",
+			req:  dto.TLSSettingsRequest{MutualAuthentication: true},
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(man2, nil)
+				man2.EXPECT().GetTLSSettingData(gomock.Any()).Return([]tls.SettingDataResponse{remote}, nil)
+				man2.EXPECT().PutTLSSettingData(gomock.Any(), gomock.Any()).Return(ErrGeneral)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+			},
+			err: ErrGeneral,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, wsmanMock, management, repo := initConnectionsTest(t)
+
+			if tc.manMock != nil {
+				tc.manMock(wsmanMock, management)
+			}
+
+			tc.repoMock(repo)
+
+			res, err := useCase.SetTLSSettings(context.Background(), device.GUID, tc.req)
+
+			require.Equal(t, tc.res, res)
+			require.IsType(t, tc.err, err)
+		})
+	}
+}
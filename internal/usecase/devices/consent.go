@@ -22,7 +22,7 @@ func (uc *UseCase) CancelUserConsent(c context.Context, guid string) (dto.UserCo
 		return dto.UserConsentMessage{}, err
 	}
 
-	response, err := device.CancelUserConsentRequest()
+	response, err := device.CancelUserConsentRequest(c)
 	if err != nil {
 		return dto.UserConsentMessage{}, err
 	}
@@ -62,7 +62,7 @@ func (uc *UseCase) GetUserConsentCode(c context.Context, guid string) (dto.UserC
 		return dto.UserConsentMessage{}, err
 	}
 
-	response, err := device.GetUserConsentCode()
+	response, err := device.GetUserConsentCode(c)
 	if err != nil {
 		return dto.UserConsentMessage{}, err
 	}
@@ -104,7 +104,7 @@ func (uc *UseCase) SendConsentCode(c context.Context, userConsent dto.UserConsen
 
 	consentCode, _ := strconv.Atoi(userConsent.ConsentCode)
 
-	response, err := device.SendConsentCode(consentCode)
+	response, err := device.SendConsentCode(c, consentCode)
 	if err != nil {
 		return dto.UserConsentMessage{}, err
 	}
@@ -33,7 +33,7 @@ func initConsentTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *mocks.M
 
 	log := logger.New("error")
 
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, wsmanMock, management, repo
 }
@@ -70,7 +70,7 @@ func TestCancelUserConsent(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					CancelUserConsentRequest().
+					CancelUserConsentRequest(gomock.Any()).
 					Return(wsmanCancelResponse, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -105,7 +105,7 @@ func TestCancelUserConsent(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					CancelUserConsentRequest().
+					CancelUserConsentRequest(gomock.Any()).
 					Return(optin.Response{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -181,7 +181,7 @@ func TestGetUserConsentCode(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetUserConsentCode().
+					GetUserConsentCode(gomock.Any()).
 					Return(wsmanResponse, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -214,7 +214,7 @@ func TestGetUserConsentCode(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetUserConsentCode().
+					GetUserConsentCode(gomock.Any()).
 					Return(optin.Response{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -285,7 +285,7 @@ func TestSendConsentCode(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					SendConsentCode(123456).
+					SendConsentCode(gomock.Any(), 123456).
 					Return(wsmanSendResponse, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -318,7 +318,7 @@ func TestSendConsentCode(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					SendConsentCode(123456).
+					SendConsentCode(gomock.Any(), 123456).
 					Return(optin.Response{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -0,0 +1,152 @@
+package devices_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/general"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	ipsPower "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/power"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// contract is what the devices usecase must make of the responses of one AMT generation,
+// recorded in testdata/fixtures (see `console record-fixture`).
+type contract struct {
+	fixture       string
+	version       string
+	controlMode   setupandconfiguration.ProvisioningModeValue
+	storedMode    string
+	powerState    int
+	osPowerSaving int
+	hibernate     int
+	hostName      string
+	enableSOL     bool
+	enableIDER    bool
+	userConsent   string
+	kvmAvailable  bool
+	enableKVM     bool
+	ocr           bool
+	httpsBoot     bool
+	winREBoot     bool
+	localPBABoot  bool
+}
+
+var contracts = []contract{
+	{
+		// AMT 11 has no IPS_PowerManagementService, its OS power saving state is unsupported, and on this SKU no KVM
+		fixture: "amt11.json", version: "11.8.55", controlMode: setupandconfiguration.ClientControlMode, storedMode: dto.ControlModeCCM,
+		powerState: 2, osPowerSaving: int(ipsPower.Unsupported), hibernate: 7,
+		hostName: "device", enableSOL: true, enableIDER: true, userConsent: "all",
+	},
+	{
+		fixture: "amt12.json", version: "12.0.67", controlMode: setupandconfiguration.AdminControlMode, storedMode: dto.ControlModeACM,
+		powerState: 2, osPowerSaving: 2, hibernate: 7,
+		hostName: "device", enableSOL: true, userConsent: "kvm", kvmAvailable: true, enableKVM: true,
+	},
+	{
+		// AMT 15 adds UEFI HTTPS boot through one-click recovery
+		fixture: "amt15.json", version: "15.0.45", controlMode: setupandconfiguration.ClientControlMode, storedMode: dto.ControlModeCCM,
+		powerState: 8, osPowerSaving: 2, hibernate: 7,
+		hostName: "device", userConsent: "kvm", kvmAvailable: true, enableKVM: true, ocr: true, httpsBoot: true,
+	},
+	{
+		// AMT 16 adds WinRE and local PBA boot
+		fixture: "amt16.json", version: "16.1.27", controlMode: setupandconfiguration.AdminControlMode, storedMode: dto.ControlModeACM,
+		powerState: 4, osPowerSaving: 2, hibernate: 7,
+		hostName: "device", enableSOL: true, enableIDER: true, userConsent: "none", kvmAvailable: true, enableKVM: true,
+		ocr: true, httpsBoot: true, winREBoot: true, localPBABoot: true,
+	},
+}
+
+// initContractTest creates a usecase whose device answers from the fixture.
+func initContractTest(t *testing.T, c contract) *devices.UseCase {
+	t.Helper()
+
+	f, err := wsman.LoadFixture(filepath.Join("testdata", "fixtures", c.fixture))
+	require.NoError(t, err)
+
+	mockCtl := gomock.NewController(t)
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+	repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil).AnyTimes()
+	repo.EXPECT().UpdateControlMode(context.Background(), device.GUID, device.TenantID, c.storedMode, dto.ProvisioningStatePost).
+		Return(true, nil).MaxTimes(1)
+
+	wsmanMock := mocks.NewMockWSMAN(mockCtl)
+	wsmanMock.EXPECT().Worker().Return().AnyTimes()
+	wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).DoAndReturn(
+		func(entity.Device, bool, bool) (wsman.Management, error) {
+			return wsman.NewReplayManagement(f), nil
+		}).AnyTimes()
+
+	return devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, nil)
+}
+
+func TestManagementContract(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range contracts {
+		t.Run(c.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			uc := initContractTest(t, c)
+			ctx := context.Background()
+
+			t.Run("GetVersion", func(t *testing.T) {
+				v1, v2, err := uc.GetVersion(ctx, "device-guid-123")
+				require.NoError(t, err)
+				require.Equal(t, c.version, v2.AMT)
+				require.Len(t, v1.CIMSoftwareIdentity.Responses, 11)
+				require.Equal(t, c.controlMode, v1.AMTSetupAndConfigurationService.Response.ProvisioningMode)
+			})
+
+			t.Run("GetGeneralSettings", func(t *testing.T) {
+				settings, err := uc.GetGeneralSettings(ctx, "device-guid-123")
+				require.NoError(t, err)
+
+				body, ok := settings.Body.(general.GeneralSettingsResponse)
+				require.True(t, ok)
+				require.Equal(t, c.hostName, body.HostName)
+			})
+
+			t.Run("GetPowerState", func(t *testing.T) {
+				state, err := uc.GetPowerState(ctx, "device-guid-123")
+				require.NoError(t, err)
+				require.Equal(t, dto.PowerState{PowerState: c.powerState, OSPowerSavingState: c.osPowerSaving}, state)
+			})
+
+			t.Run("GetPowerCapabilities", func(t *testing.T) {
+				capabilities, err := uc.GetPowerCapabilities(ctx, "device-guid-123")
+				require.NoError(t, err)
+				require.Equal(t, c.hibernate, capabilities.Hibernate)
+				require.Equal(t, 100, capabilities.PowerOnToBIOS)
+			})
+
+			t.Run("GetFeatures", func(t *testing.T) {
+				_, features, err := uc.GetFeatures(ctx, "device-guid-123")
+				require.NoError(t, err)
+				require.Equal(t, c.enableSOL, features.EnableSOL, "SOL")
+				require.Equal(t, c.enableIDER, features.EnableIDER, "IDER")
+				require.Equal(t, c.userConsent, features.UserConsent)
+				require.Equal(t, c.kvmAvailable, features.KVMAvailable, "KVM available")
+				require.Equal(t, c.enableKVM, features.EnableKVM, "KVM")
+				require.Equal(t, c.ocr, features.OCR, "OCR")
+				require.Equal(t, c.httpsBoot, features.HTTPSBootSupported, "HTTPS boot")
+				require.Equal(t, c.winREBoot, features.WinREBootSupported, "WinRE boot")
+				require.Equal(t, c.localPBABoot, features.LocalPBABootSupported, "local PBA boot")
+			})
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package devices
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbasedsetup"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+)
+
+// Start refreshes the control mode and provisioning state of every device connecting over CIRA until ctx is done,
+// devices managed directly are refreshed when their version is read.
+func (uc *UseCase) Start(ctx context.Context) {
+	unsubscribe := eventbus.Subscribe(uc.events, eventbus.DeviceConnected, func(e eventbus.DeviceConnectedEvent) {
+		if _, err := uc.RefreshControlMode(ctx, e.GUID); err != nil {
+			uc.log.Warn("devices - Start - RefreshControlMode: %s: %v", e.GUID, err)
+		}
+	})
+
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+	}()
+}
+
+// RefreshControlMode reads the control mode and provisioning state of a device and stores them with the device.
+func (uc *UseCase) RefreshControlMode(c context.Context, guid string) (*dto.Device, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return nil, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := device.GetSetupAndConfiguration(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, ErrNotSupportedUseCase.Wrap("RefreshControlMode", "device.GetSetupAndConfiguration", "device reports no setup and configuration service")
+	}
+
+	item.ControlMode, item.ProvisioningState, err = uc.updateControlMode(c, item, device, data[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.entityToDTO(item), nil
+}
+
+// updateControlMode stores the control mode and provisioning state of item when they changed and returns them.
+func (uc *UseCase) updateControlMode(c context.Context, item *entity.Device, device wsman.Management, setup setupandconfiguration.SetupAndConfigurationServiceResponse) (controlMode, provisioningState string, err error) {
+	controlMode, provisioningState = controlModeFromSetup(setup)
+
+	// the host based setup service reports the current control mode of firmware where the provisioning mode
+	// still carries the pre-CCM values, it is missing on older firmware
+	if provisioningState == dto.ProvisioningStatePost {
+		if hbs, err := device.GetIPSHostBasedSetupService(c); err == nil {
+			if mode := controlModeFromHostBasedSetup(hbs.Body.GetResponse.CurrentControlMode); mode != "" {
+				controlMode = mode
+			}
+		}
+	}
+
+	if controlMode == item.ControlMode && provisioningState == item.ProvisioningState {
+		return controlMode, provisioningState, nil
+	}
+
+	if _, err := uc.repo.UpdateControlMode(c, item.GUID, item.TenantID, controlMode, provisioningState); err != nil {
+		return "", "", ErrDatabase.Wrap("updateControlMode", "uc.repo.UpdateControlMode", err)
+	}
+
+	return controlMode, provisioningState, nil
+}
+
+// controlModeFromSetup maps the provisioning mode and state of the setup and configuration service, a device
+// that is not provisioned has no control mode.
+func controlModeFromSetup(d setupandconfiguration.SetupAndConfigurationServiceResponse) (controlMode, provisioningState string) {
+	switch d.ProvisioningState {
+	case setupandconfiguration.PreProvisioning:
+		return "", dto.ProvisioningStatePre
+	case setupandconfiguration.InProvisioning:
+		return "", dto.ProvisioningStateIn
+	case setupandconfiguration.PostProvisioning:
+		provisioningState = dto.ProvisioningStatePost
+	}
+
+	switch d.ProvisioningMode {
+	case setupandconfiguration.AdminControlMode:
+		controlMode = dto.ControlModeACM
+	case setupandconfiguration.ClientControlMode:
+		controlMode = dto.ControlModeCCM
+	}
+
+	return controlMode, provisioningState
+}
+
+func controlModeFromHostBasedSetup(mode hostbasedsetup.CurrentControlMode) string {
+	switch mode {
+	case hostbasedsetup.Admin:
+		return dto.ControlModeACM
+	case hostbasedsetup.Client:
+		return dto.ControlModeCCM
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,180 @@
+package devices_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbasedsetup"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func initControlModeTest(t *testing.T, events *eventbus.Bus) (*devices.UseCase, *mocks.MockWSMAN, *mocks.MockManagement, *mocks.MockDeviceManagementRepository) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+	wsmanMock := mocks.NewMockWSMAN(mockCtl)
+	wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+	management := mocks.NewMockManagement(mockCtl)
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, events)
+
+	return u, wsmanMock, management, repo
+}
+
+func hostBasedSetup(mode hostbasedsetup.CurrentControlMode) hostbasedsetup.Response {
+	response := hostbasedsetup.Response{}
+	response.Body.GetResponse.CurrentControlMode = mode
+
+	return response
+}
+
+func TestRefreshControlMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		stored            entity.Device
+		setup             setupandconfiguration.SetupAndConfigurationServiceResponse
+		hostBasedSetup    *hostbasedsetup.Response
+		hostBasedSetupErr error
+		update            bool
+		controlMode       string
+		provisioningState string
+	}{
+		{
+			name:              "admin control mode",
+			setup:             setupandconfiguration.SetupAndConfigurationServiceResponse{ProvisioningMode: setupandconfiguration.AdminControlMode, ProvisioningState: setupandconfiguration.PostProvisioning},
+			hostBasedSetup:    &hostbasedsetup.Response{},
+			update:            true,
+			controlMode:       dto.ControlModeACM,
+			provisioningState: dto.ProvisioningStatePost,
+		},
+		{
+			name:              "host based setup takes precedence",
+			setup:             setupandconfiguration.SetupAndConfigurationServiceResponse{ProvisioningMode: setupandconfiguration.AdminControlMode, ProvisioningState: setupandconfiguration.PostProvisioning},
+			hostBasedSetup:    func() *hostbasedsetup.Response { r := hostBasedSetup(hostbasedsetup.Client); return &r }(),
+			update:            true,
+			controlMode:       dto.ControlModeCCM,
+			provisioningState: dto.ProvisioningStatePost,
+		},
+		{
+			name:              "host based setup not supported",
+			setup:             setupandconfiguration.SetupAndConfigurationServiceResponse{ProvisioningMode: setupandconfiguration.ClientControlMode, ProvisioningState: setupandconfiguration.PostProvisioning},
+			hostBasedSetupErr: errors.New("DestinationUnreachable"),
+			update:            true,
+			controlMode:       dto.ControlModeCCM,
+			provisioningState: dto.ProvisioningStatePost,
+		},
+		{
+			name:              "not provisioned",
+			stored:            entity.Device{ControlMode: dto.ControlModeACM, ProvisioningState: dto.ProvisioningStatePost},
+			setup:             setupandconfiguration.SetupAndConfigurationServiceResponse{ProvisioningMode: setupandconfiguration.AdminControlMode, ProvisioningState: setupandconfiguration.PreProvisioning},
+			update:            true,
+			provisioningState: dto.ProvisioningStatePre,
+		},
+		{
+			name:              "unchanged",
+			stored:            entity.Device{ControlMode: dto.ControlModeCCM, ProvisioningState: dto.ProvisioningStatePost},
+			setup:             setupandconfiguration.SetupAndConfigurationServiceResponse{ProvisioningMode: setupandconfiguration.ClientControlMode, ProvisioningState: setupandconfiguration.PostProvisioning},
+			hostBasedSetup:    func() *hostbasedsetup.Response { r := hostBasedSetup(hostbasedsetup.Client); return &r }(),
+			controlMode:       dto.ControlModeCCM,
+			provisioningState: dto.ProvisioningStatePost,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, wsmanMock, management, repo := initControlModeTest(t, nil)
+
+			device := tc.stored
+			device.GUID = "device-guid-123"
+			device.TenantID = "tenant-id-456"
+
+			repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(&device, nil)
+			wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
+			management.EXPECT().GetSetupAndConfiguration(context.Background()).
+				Return([]setupandconfiguration.SetupAndConfigurationServiceResponse{tc.setup}, nil)
+
+			if tc.hostBasedSetup != nil || tc.hostBasedSetupErr != nil {
+				var response hostbasedsetup.Response
+				if tc.hostBasedSetup != nil {
+					response = *tc.hostBasedSetup
+				}
+
+				management.EXPECT().GetIPSHostBasedSetupService(context.Background()).Return(response, tc.hostBasedSetupErr)
+			}
+
+			if tc.update {
+				repo.EXPECT().UpdateControlMode(context.Background(), device.GUID, device.TenantID, tc.controlMode, tc.provisioningState).Return(true, nil)
+			}
+
+			d, err := useCase.RefreshControlMode(context.Background(), device.GUID)
+			require.NoError(t, err)
+			require.Equal(t, tc.controlMode, d.ControlMode)
+			require.Equal(t, tc.provisioningState, d.ProvisioningState)
+		})
+	}
+}
+
+func TestRefreshControlModeNotFound(t *testing.T) {
+	t.Parallel()
+
+	useCase, _, _, repo := initControlModeTest(t, nil)
+	repo.EXPECT().GetByID(context.Background(), "device-guid-123", "").Return(nil, nil)
+
+	_, err := useCase.RefreshControlMode(context.Background(), "device-guid-123")
+	require.ErrorIs(t, err, devices.ErrNotFound)
+}
+
+func TestStartRefreshesConnectedDevices(t *testing.T) {
+	t.Parallel()
+
+	events := eventbus.New()
+	defer events.Close()
+
+	useCase, wsmanMock, management, repo := initControlModeTest(t, events)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updated := make(chan struct{})
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456", MPSUsername: "admin"}
+
+	repo.EXPECT().GetByID(ctx, device.GUID, "").Return(device, nil)
+	wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
+	management.EXPECT().GetSetupAndConfiguration(ctx).Return([]setupandconfiguration.SetupAndConfigurationServiceResponse{
+		{ProvisioningMode: setupandconfiguration.AdminControlMode, ProvisioningState: setupandconfiguration.PostProvisioning},
+	}, nil)
+	management.EXPECT().GetIPSHostBasedSetupService(ctx).Return(hostBasedSetup(hostbasedsetup.Admin), nil)
+	repo.EXPECT().UpdateControlMode(ctx, device.GUID, device.TenantID, dto.ControlModeACM, dto.ProvisioningStatePost).
+		DoAndReturn(func(context.Context, string, string, string, string) (bool, error) {
+			close(updated)
+
+			return true, nil
+		})
+
+	useCase.Start(ctx)
+	eventbus.Publish(events, eventbus.DeviceConnected, eventbus.DeviceConnectedEvent{GUID: device.GUID, Transport: "cira"})
+
+	select {
+	case <-updated:
+	case <-time.After(time.Second):
+		require.FailNow(t, "control mode not refreshed")
+	}
+}
@@ -0,0 +1,170 @@
+package devices
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+const (
+	// maxEventRecords is the most event log records AMT returns per request.
+	maxEventRecords = 390
+
+	// getRecordsNotSupported is the return value of GetRecords on platforms without an event log.
+	getRecordsNotSupported = 1
+)
+
+// The kinds of the failures of a crash report.
+const (
+	crashOS       = "os-crash"
+	crashBoot     = "boot-failure"
+	crashFirmware = "firmware-error"
+	crashWatchdog = "watchdog"
+	crashHardware = "hardware"
+)
+
+// IPMI sensor types and offsets of the events of a crash report.
+const (
+	sensorProcessor        = 0x07
+	sensorMemory           = 0x0c
+	sensorFirmwareProgress = 0x0f
+	sensorBootError        = 0x1e
+	sensorOSStop           = 0x20
+	sensorWatchdog         = 0x23
+
+	offsetFirmwareError    = 0x00
+	offsetFirmwareHang     = 0x01
+	offsetFirmwareProgress = 0x02
+)
+
+// crashEvents are the kinds of the failures by sensor type and offset, nil offsets take every offset of the sensor.
+var crashEvents = map[uint8]struct {
+	kind    string
+	offsets []uint8
+}{
+	sensorOSStop:           {kind: crashOS, offsets: []uint8{0x00, 0x01, 0x05}},
+	sensorBootError:        {kind: crashBoot},
+	sensorFirmwareProgress: {kind: crashFirmware, offsets: []uint8{offsetFirmwareError, offsetFirmwareHang}},
+	sensorWatchdog:         {kind: crashWatchdog, offsets: []uint8{0x00, 0x01, 0x02, 0x03}},
+	sensorProcessor:        {kind: crashHardware, offsets: []uint8{0x00, 0x01}},
+	sensorMemory:           {kind: crashHardware, offsets: []uint8{0x01}},
+}
+
+// GetCrashReport reads the event log of a device for the OS crashes, boot failures, firmware errors and watchdog
+// expirations the platform reported to AMT, newest first. Each failure comes with the last firmware progress
+// logged before it, telling how far the boot got. Platforms whose firmware does not log to AMT report no failures,
+// those without an event log are not supported.
+func (uc *UseCase) GetCrashReport(c context.Context, guid string) (dto.CrashReport, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.CrashReport{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.CrashReport{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.CrashReport{}, err
+	}
+
+	var events []messagelog.RefinedEventData
+
+	var raw []string
+
+	for startIndex := 1; ; {
+		page, err := device.GetEventLog(c, startIndex, maxEventRecords)
+		if err != nil {
+			return dto.CrashReport{}, err
+		}
+
+		if page.ReturnValue == getRecordsNotSupported {
+			return dto.CrashReport{Supported: false, Failures: []dto.CrashEvent{}}, nil
+		}
+
+		for i := range page.RefinedEventData {
+			events = append(events, page.RefinedEventData[i])
+
+			if i < len(page.RecordArray) {
+				raw = append(raw, page.RecordArray[i])
+			} else {
+				raw = append(raw, "")
+			}
+		}
+
+		startIndex += len(page.RefinedEventData)
+
+		if page.NoMoreRecords || len(page.RefinedEventData) == 0 {
+			break
+		}
+	}
+
+	return crashReport(events, raw), nil
+}
+
+// crashReport picks the failures out of the event log records, newest first, raw holds the base64 record of each.
+func crashReport(events []messagelog.RefinedEventData, raw []string) dto.CrashReport {
+	order := make([]int, len(events))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return events[order[i]].TimeStamp.Before(events[order[j]].TimeStamp) })
+
+	report := dto.CrashReport{Supported: true, Failures: []dto.CrashEvent{}}
+
+	var progress string
+
+	for _, i := range order {
+		event := &events[i]
+		offset := event.EventOffset & eventOffsetMask
+
+		if event.EventOffset&eventOffsetDeasserted != 0 {
+			continue
+		}
+
+		if event.EventSensorType == sensorFirmwareProgress && offset == offsetFirmwareProgress {
+			progress = event.Description
+
+			continue
+		}
+
+		kind := crashKind(event.EventSensorType, offset)
+		if kind == "" {
+			continue
+		}
+
+		report.Failures = append(report.Failures, dto.CrashEvent{
+			Kind:     kind,
+			Time:     event.TimeStamp.UTC(),
+			Progress: progress,
+			Record:   EventLogEntry(event, raw[i]),
+		})
+
+		// the progress of the next boot is logged anew
+		progress = ""
+	}
+
+	slices.Reverse(report.Failures)
+
+	return report
+}
+
+// crashKind is the kind of failure of an event, empty for the events that are none.
+func crashKind(sensorType, offset uint8) string {
+	crash, ok := crashEvents[sensorType]
+	if !ok {
+		return ""
+	}
+
+	if crash.offsets != nil && !slices.Contains(crash.offsets, offset) {
+		return ""
+	}
+
+	return crash.kind
+}
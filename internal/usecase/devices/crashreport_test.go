@@ -0,0 +1,137 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+// crashLogTime is the time of the first record of the logs of TestGetCrashReport, a record is logged every minute.
+var crashLogTime = time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+
+func crashLogEvent(minute int, sensorType, offset uint8, description string) messagelog.RefinedEventData {
+	return messagelog.RefinedEventData{
+		TimeStamp:       crashLogTime.Add(time.Duration(minute) * time.Minute),
+		EventSensorType: sensorType,
+		EventType:       0x6f,
+		EventOffset:     offset,
+		Description:     description,
+	}
+}
+
+func crashLogPage(more bool, events ...messagelog.RefinedEventData) messagelog.GetRecordsResponse {
+	page := messagelog.GetRecordsResponse{NoMoreRecords: !more, RefinedEventData: events}
+	for range events {
+		page.RecordArray = append(page.RecordArray, "cmF3")
+	}
+
+	return page
+}
+
+func TestGetCrashReport(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	tests := []struct {
+		name     string
+		manMock  func(man *mocks.MockWSMAN, man2 *mocks.MockManagement)
+		kinds    []string
+		times    []int
+		progress []string
+		res      dto.CrashReport
+		err      error
+	}{
+		{
+			name: "failures newest first with the boot progress",
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(man2, nil)
+				man2.EXPECT().GetEventLog(gomock.Any(), 1, 390).Return(crashLogPage(true,
+					crashLogEvent(5, 0x20, 0x81, "Unknown Sensor Type #32"),
+					crashLogEvent(4, 0x05, 0x00, "Unknown Sensor Type #5"),
+					crashLogEvent(3, 0x1e, 0x00, "No bootable media"),
+				), nil)
+				man2.EXPECT().GetEventLog(gomock.Any(), 4, 390).Return(crashLogPage(false,
+					crashLogEvent(2, 0x20, 0x01, "Operating system lockup or power interrupt"),
+					crashLogEvent(1, 0x0f, 0x02, "Hard-disk initialization"),
+				), nil)
+			},
+			kinds:    []string{"boot-failure", "os-crash"},
+			times:    []int{3, 2},
+			progress: []string{"", "Hard-disk initialization"},
+		},
+		{
+			name: "no event log",
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(man2, nil)
+				man2.EXPECT().GetEventLog(gomock.Any(), 1, 390).Return(messagelog.GetRecordsResponse{ReturnValue: 1}, nil)
+			},
+			res: dto.CrashReport{Supported: false, Failures: []dto.CrashEvent{}},
+		},
+		{
+			name: "GetEventLog fails",
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(man2, nil)
+				man2.EXPECT().GetEventLog(gomock.Any(), 1, 390).Return(messagelog.GetRecordsResponse{}, ErrGeneral)
+			},
+			err: ErrGeneral,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, wsmanMock, management, repo := initInfoTest(t)
+
+			repo.EXPECT().GetByID(gomock.Any(), device.GUID, "").Return(device, nil)
+			tc.manMock(wsmanMock, management)
+
+			res, err := useCase.GetCrashReport(context.Background(), device.GUID)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tc.kinds == nil {
+				require.Equal(t, tc.res, res)
+
+				return
+			}
+
+			require.True(t, res.Supported)
+			require.Len(t, res.Failures, len(tc.kinds))
+
+			for i, failure := range res.Failures {
+				require.Equal(t, tc.kinds[i], failure.Kind)
+				require.Equal(t, crashLogTime.Add(time.Duration(tc.times[i])*time.Minute), failure.Time)
+				require.Equal(t, tc.progress[i], failure.Progress)
+				require.Equal(t, "cmF3", failure.Record.Raw)
+			}
+		})
+	}
+}
+
+func TestGetCrashReportNotFound(t *testing.T) {
+	t.Parallel()
+
+	useCase, _, _, repo := initInfoTest(t)
+
+	repo.EXPECT().GetByID(gomock.Any(), "device-guid-123", "").Return(nil, nil)
+
+	_, err := useCase.GetCrashReport(context.Background(), "device-guid-123")
+	require.ErrorIs(t, err, devices.ErrNotFound)
+}
@@ -0,0 +1,285 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+	secrets "github.com/device-management-toolkit/console/pkg/secrets/vault"
+)
+
+// CredentialStore is the part of the secret store used to keep per-device AMT credentials.
+type CredentialStore interface {
+	GetObject(key string) (map[string]string, error)
+	SetObject(key string, data map[string]string) error
+	DeleteKeyValue(key string) error
+}
+
+type CredentialStoreError struct {
+	Console consoleerrors.InternalError
+}
+
+func (e CredentialStoreError) Error() string {
+	return e.Console.Error()
+}
+
+func (e CredentialStoreError) Wrap(call, function string, err error) error {
+	_ = e.Console.Wrap(call, function, err)
+	e.Console.Message = "credential store operation failed"
+
+	return e
+}
+
+var ErrCredentialStore = CredentialStoreError{Console: consoleerrors.CreateConsoleError("DevicesUseCase")}
+
+const (
+	credentialPassword     = "password"
+	credentialMPSPassword  = "mpspassword"
+	credentialMEBXPassword = "mebxpassword"
+)
+
+// deviceCredentialKey generates the key path for storing device credentials in Vault.
+// Format: credentials/devices/{tenantID}/{guid}.
+func deviceCredentialKey(tenantID, guid string) string {
+	return fmt.Sprintf("credentials/devices/%s/%s", tenantID, guid)
+}
+
+// VaultRepository keeps the AMT, MPS and MEBX passwords of devices in the secret store
+// instead of the encrypted columns of the devices table.
+//
+// Rows written before vaulting was enabled still carry their credentials in the database;
+// they are moved to the secret store the first time the device is read. Devices read
+// through GetByID have their credentials restored (encrypted, as stored in the database)
+// so callers such as SetupWsmanClient don't need to know where they are kept.
+type VaultRepository struct {
+	Repository
+	store            CredentialStore
+	safeRequirements security.Cryptor
+	log              logger.Interface
+}
+
+// NewVaultRepository wraps r so that device credentials are kept in store.
+func NewVaultRepository(r Repository, store CredentialStore, safeRequirements security.Cryptor, log logger.Interface) *VaultRepository {
+	return &VaultRepository{
+		Repository:       r,
+		store:            store,
+		safeRequirements: safeRequirements,
+		log:              log,
+	}
+}
+
+// GetByID returns the device with its credentials, migrating them out of the database if needed.
+func (r *VaultRepository) GetByID(ctx context.Context, guid, tenantID string) (*entity.Device, error) {
+	d, err := r.Repository.GetByID(ctx, guid, tenantID)
+	if err != nil || d == nil || d.GUID == "" {
+		return d, err
+	}
+
+	if hasStoredCredentials(d) {
+		migrated := *d
+		if err := r.vault(ctx, &migrated); err != nil {
+			// the credentials are still usable from the database, try again on the next read
+			r.log.Warn("failed to move credentials of device %s to the secret store: %v", d.GUID, err)
+		}
+
+		return d, nil
+	}
+
+	creds, err := r.store.GetObject(deviceCredentialKey(d.TenantID, d.GUID))
+	if err != nil {
+		if errors.Is(err, secrets.ErrSecretNotFound) {
+			return d, nil
+		}
+
+		return nil, ErrCredentialStore.Wrap("GetByID", "r.store.GetObject", err)
+	}
+
+	if err := r.restore(d, creds); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Insert stores the credentials of d in the secret store and the rest of the device in the database.
+func (r *VaultRepository) Insert(ctx context.Context, d *entity.Device) (string, error) {
+	key := deviceCredentialKey(d.TenantID, d.GUID)
+
+	if err := r.storeCredentials("Insert", d); err != nil {
+		return "", err
+	}
+
+	d1 := *d
+	clearCredentials(&d1)
+
+	version, err := r.Repository.Insert(ctx, &d1)
+	if err != nil {
+		// If DB insert fails, try to clean up the stored credentials
+		_ = r.store.DeleteKeyValue(key)
+
+		return "", err
+	}
+
+	return version, nil
+}
+
+// Update stores the credentials of d in the secret store and the rest of the device in the database.
+func (r *VaultRepository) Update(ctx context.Context, d *entity.Device) (bool, error) {
+	if err := r.storeCredentials("Update", d); err != nil {
+		return false, err
+	}
+
+	d1 := *d
+	clearCredentials(&d1)
+
+	return r.Repository.Update(ctx, &d1)
+}
+
+// Delete removes the device and its credentials.
+func (r *VaultRepository) Delete(ctx context.Context, guid, tenantID string) (bool, error) {
+	deleted, err := r.Repository.Delete(ctx, guid, tenantID)
+	if err != nil || !deleted {
+		return deleted, err
+	}
+
+	if err := r.store.DeleteKeyValue(deviceCredentialKey(tenantID, guid)); err != nil {
+		r.log.Warn("failed to delete credentials of device %s from the secret store: %v", guid, err)
+	}
+
+	return true, nil
+}
+
+// DeleteCascade deletes a device with its data, then its credentials from the secret store.
+func (r *VaultRepository) DeleteCascade(ctx context.Context, guid, tenantID string) (map[string]int64, bool, error) {
+	deleted, ok, err := r.Repository.DeleteCascade(ctx, guid, tenantID)
+	if err != nil || !ok {
+		return deleted, ok, err
+	}
+
+	if err := r.store.DeleteKeyValue(deviceCredentialKey(tenantID, guid)); err != nil {
+		r.log.Warn("failed to delete credentials of device %s from the secret store: %v", guid, err)
+	}
+
+	return deleted, true, nil
+}
+
+// Merge merges the duplicates into a device, then deletes their credentials from the secret store.
+func (r *VaultRepository) Merge(ctx context.Context, d *entity.Device, duplicates []string) (map[string]int64, bool, error) {
+	moved, ok, err := r.Repository.Merge(ctx, d, duplicates)
+	if err != nil || !ok {
+		return moved, ok, err
+	}
+
+	for _, duplicate := range duplicates {
+		if err := r.store.DeleteKeyValue(deviceCredentialKey(d.TenantID, duplicate)); err != nil {
+			r.log.Warn("failed to delete credentials of device %s from the secret store: %v", duplicate, err)
+		}
+	}
+
+	return moved, true, nil
+}
+
+// vault moves the credentials of a device read from the database into the secret store.
+func (r *VaultRepository) vault(ctx context.Context, d *entity.Device) error {
+	if err := r.storeCredentials("GetByID", d); err != nil {
+		return err
+	}
+
+	clearCredentials(d)
+
+	_, err := r.Repository.Update(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("Device credentials moved to the secret store: %s", d.GUID)
+
+	return nil
+}
+
+// credentials returns the decrypted passwords of d as they are kept in the secret store.
+func (r *VaultRepository) credentials(d *entity.Device) (map[string]string, error) {
+	creds := map[string]string{}
+
+	encrypted := map[string]*string{
+		credentialPassword:     &d.Password,
+		credentialMPSPassword:  d.MPSPassword,
+		credentialMEBXPassword: d.MEBXPassword,
+	}
+
+	for name, value := range encrypted {
+		if value == nil || *value == "" {
+			continue
+		}
+
+		password, err := r.safeRequirements.Decrypt(*value)
+		if err != nil {
+			return nil, ErrDeviceUseCase.Wrap("credentials", "failed to decrypt "+name, err)
+		}
+
+		creds[name] = password
+	}
+
+	return creds, nil
+}
+
+// storeCredentials writes the credentials of d to the secret store.
+func (r *VaultRepository) storeCredentials(call string, d *entity.Device) error {
+	creds, err := r.credentials(d)
+	if err != nil {
+		return err
+	}
+
+	if err := r.store.SetObject(deviceCredentialKey(d.TenantID, d.GUID), creds); err != nil {
+		return ErrCredentialStore.Wrap(call, "r.store.SetObject", err)
+	}
+
+	return nil
+}
+
+// restore sets the passwords of d from creds, encrypted as they would be in the database.
+func (r *VaultRepository) restore(d *entity.Device, creds map[string]string) error {
+	var err error
+
+	if password, ok := creds[credentialPassword]; ok {
+		if d.Password, err = tenantkeys.Encrypt(r.safeRequirements, d.TenantID, password); err != nil {
+			return ErrDeviceUseCase.Wrap("restore", "failed to encrypt password", err)
+		}
+	}
+
+	if password, ok := creds[credentialMPSPassword]; ok {
+		encrypted, err := tenantkeys.Encrypt(r.safeRequirements, d.TenantID, password)
+		if err != nil {
+			return ErrDeviceUseCase.Wrap("restore", "failed to encrypt MPS password", err)
+		}
+
+		d.MPSPassword = &encrypted
+	}
+
+	if password, ok := creds[credentialMEBXPassword]; ok {
+		encrypted, err := tenantkeys.Encrypt(r.safeRequirements, d.TenantID, password)
+		if err != nil {
+			return ErrDeviceUseCase.Wrap("restore", "failed to encrypt MEBX password", err)
+		}
+
+		d.MEBXPassword = &encrypted
+	}
+
+	return nil
+}
+
+func hasStoredCredentials(d *entity.Device) bool {
+	return d.Password != "" || d.MPSPassword != nil || d.MEBXPassword != nil
+}
+
+func clearCredentials(d *entity.Device) {
+	d.Password = ""
+	d.MPSPassword = nil
+	d.MEBXPassword = nil
+}
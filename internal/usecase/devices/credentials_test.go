@@ -0,0 +1,186 @@
+package devices_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+	secrets "github.com/device-management-toolkit/console/pkg/secrets/vault"
+)
+
+const credentialKey = "credentials/devices/tenant/guid"
+
+var errStoreUnavailable = errors.New("store unavailable")
+
+type fakeCredentialStore struct {
+	objects map[string]map[string]string
+	err     error
+}
+
+func (s *fakeCredentialStore) GetObject(key string) (map[string]string, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	obj, ok := s.objects[key]
+	if !ok {
+		return nil, secrets.ErrSecretNotFound
+	}
+
+	return obj, nil
+}
+
+func (s *fakeCredentialStore) SetObject(key string, data map[string]string) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.objects[key] = data
+
+	return nil
+}
+
+func (s *fakeCredentialStore) DeleteKeyValue(key string) error {
+	delete(s.objects, key)
+
+	return nil
+}
+
+func vaultRepoTest(t *testing.T) (*devices.VaultRepository, *mocks.MockDeviceManagementRepository, *fakeCredentialStore) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+	store := &fakeCredentialStore{objects: map[string]map[string]string{}}
+
+	return devices.NewVaultRepository(repo, store, mocks.MockCrypto{}, logger.New("error")), repo, store
+}
+
+func TestVaultRepositoryGetByIDMigratesStoredCredentials(t *testing.T) {
+	t.Parallel()
+
+	vault, repo, store := vaultRepoTest(t)
+
+	stored := &entity.Device{GUID: "guid", TenantID: "tenant", Password: "encrypted", MPSPassword: ptr("encrypted")}
+
+	repo.EXPECT().GetByID(context.Background(), "guid", "tenant").Return(stored, nil)
+	repo.EXPECT().Update(context.Background(), &entity.Device{GUID: "guid", TenantID: "tenant"}).Return(true, nil)
+
+	d, err := vault.GetByID(context.Background(), "guid", "tenant")
+
+	require.NoError(t, err)
+	require.Equal(t, "encrypted", d.Password)
+	require.Equal(t, map[string]string{"password": "decrypted", "mpspassword": "decrypted"}, store.objects[credentialKey])
+}
+
+func TestVaultRepositoryGetByIDKeepsCredentialsWhenMigrationFails(t *testing.T) {
+	t.Parallel()
+
+	vault, repo, store := vaultRepoTest(t)
+	store.err = errStoreUnavailable
+
+	repo.EXPECT().GetByID(context.Background(), "guid", "tenant").Return(&entity.Device{GUID: "guid", TenantID: "tenant", Password: "encrypted"}, nil)
+
+	d, err := vault.GetByID(context.Background(), "guid", "tenant")
+
+	require.NoError(t, err)
+	require.Equal(t, "encrypted", d.Password)
+}
+
+func TestVaultRepositoryGetByIDRestoresVaultedCredentials(t *testing.T) {
+	t.Parallel()
+
+	vault, repo, store := vaultRepoTest(t)
+	store.objects[credentialKey] = map[string]string{"password": "P@ssw0rd", "mebxpassword": "mebx"}
+
+	repo.EXPECT().GetByID(context.Background(), "guid", "tenant").Return(&entity.Device{GUID: "guid", TenantID: "tenant"}, nil)
+
+	d, err := vault.GetByID(context.Background(), "guid", "tenant")
+
+	require.NoError(t, err)
+	require.Equal(t, "encrypted", d.Password)
+	require.Nil(t, d.MPSPassword)
+	require.Equal(t, ptr("encrypted"), d.MEBXPassword)
+}
+
+func TestVaultRepositoryGetByIDStoreErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing secret", func(t *testing.T) {
+		t.Parallel()
+
+		vault, repo, _ := vaultRepoTest(t)
+
+		repo.EXPECT().GetByID(context.Background(), "guid", "tenant").Return(&entity.Device{GUID: "guid", TenantID: "tenant"}, nil)
+
+		d, err := vault.GetByID(context.Background(), "guid", "tenant")
+
+		require.NoError(t, err)
+		require.Empty(t, d.Password)
+	})
+
+	t.Run("store unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		vault, repo, store := vaultRepoTest(t)
+		store.err = errStoreUnavailable
+
+		repo.EXPECT().GetByID(context.Background(), "guid", "tenant").Return(&entity.Device{GUID: "guid", TenantID: "tenant"}, nil)
+
+		_, err := vault.GetByID(context.Background(), "guid", "tenant")
+
+		require.IsType(t, devices.ErrCredentialStore, err)
+	})
+}
+
+func TestVaultRepositoryInsert(t *testing.T) {
+	t.Parallel()
+
+	t.Run("credentials are kept out of the database", func(t *testing.T) {
+		t.Parallel()
+
+		vault, repo, store := vaultRepoTest(t)
+
+		repo.EXPECT().Insert(context.Background(), &entity.Device{GUID: "guid", TenantID: "tenant", Hostname: "host"}).Return("1", nil)
+
+		_, err := vault.Insert(context.Background(), &entity.Device{GUID: "guid", TenantID: "tenant", Hostname: "host", Password: "encrypted"})
+
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"password": "decrypted"}, store.objects[credentialKey])
+	})
+
+	t.Run("credentials are removed when the insert fails", func(t *testing.T) {
+		t.Parallel()
+
+		vault, repo, store := vaultRepoTest(t)
+
+		repo.EXPECT().Insert(context.Background(), gomock.Any()).Return("", devices.ErrDatabase)
+
+		_, err := vault.Insert(context.Background(), &entity.Device{GUID: "guid", TenantID: "tenant", Password: "encrypted"})
+
+		require.Error(t, err)
+		require.NotContains(t, store.objects, credentialKey)
+	})
+}
+
+func TestVaultRepositoryDelete(t *testing.T) {
+	t.Parallel()
+
+	vault, repo, store := vaultRepoTest(t)
+	store.objects[credentialKey] = map[string]string{"password": "P@ssw0rd"}
+
+	repo.EXPECT().Delete(context.Background(), "guid", "tenant").Return(true, nil)
+
+	deleted, err := vault.Delete(context.Background(), "guid", "tenant")
+
+	require.NoError(t, err)
+	require.True(t, deleted)
+	require.NotContains(t, store.objects, credentialKey)
+}
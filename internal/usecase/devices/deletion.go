@@ -0,0 +1,249 @@
+package devices
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"slices"
+	"strings"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	dtov2 "github.com/device-management-toolkit/console/internal/entity/dto/v2"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// consoleOrganization is the organization of the root certificates of the console, and the issuer of the
+// certificates they signed.
+const consoleOrganization = "device-management-toolkit"
+
+// The status of a cleanup step.
+const (
+	cleanupDone    = "done"
+	cleanupFailed  = "failed"
+	cleanupSkipped = "skipped"
+)
+
+// cleanupStep undoes on a device what the console configured on it, returning what was removed.
+type cleanupStep struct {
+	name string
+	run  func(ctx context.Context, device wsman.Management) ([]string, error)
+}
+
+// cleanupSteps run in order, redirection first to end the sessions still open.
+var cleanupSteps = []cleanupStep{
+	{name: "redirection", run: disableRedirection},
+	{name: "alarms", run: deleteAlarms},
+	{name: "cira", run: removeRemoteAccess},
+	{name: "certificates", run: deleteConsoleCertificates},
+}
+
+// DeleteWithCleanup deletes a device with the data the console keeps about it, its power, address and log
+// history, correlations and credential checkouts, in one transaction, then takes it out of the pending jobs. With
+// cleanup, the redirection, alarms, CIRA configuration and console issued certificates of the device are removed
+// first. The device is deleted even when it cannot be reached, the report tells what was left on it.
+func (uc *UseCase) DeleteWithCleanup(ctx context.Context, guid, tenantID string, cleanup bool) (dto.DeviceDeletion, error) {
+	guid = strings.ToLower(guid)
+
+	item, err := uc.repo.GetByID(ctx, guid, tenantID)
+	if err != nil {
+		return dto.DeviceDeletion{}, ErrDatabase.Wrap("DeleteWithCleanup", "uc.repo.GetByID", err)
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.DeviceDeletion{}, ErrNotFound
+	}
+
+	result := dto.DeviceDeletion{GUID: guid, Cleanup: []dto.DeviceCleanupStep{}}
+
+	if cleanup {
+		if err := uc.checkLock(ctx, guid, "DeleteWithCleanup"); err != nil {
+			return dto.DeviceDeletion{}, err
+		}
+
+		result.Cleanup = uc.cleanup(ctx, item)
+		result.Incomplete = slices.ContainsFunc(result.Cleanup, func(s dto.DeviceCleanupStep) bool { return s.Status != cleanupDone })
+	}
+
+	deleted, ok, err := uc.repo.DeleteCascade(ctx, guid, tenantID)
+	if err != nil {
+		return dto.DeviceDeletion{}, ErrDatabase.Wrap("DeleteWithCleanup", "uc.repo.DeleteCascade", err)
+	}
+
+	if !ok {
+		return dto.DeviceDeletion{}, ErrNotFound
+	}
+
+	result.Deleted = deleted
+
+	if uc.pending != nil {
+		// the device is deleted already, a job left on it fails on it
+		result.PendingJobs, err = uc.pending.RemoveDevice(ctx, tenantID, guid)
+		if err != nil {
+			uc.log.Warn("devices - DeleteWithCleanup - %s: the pending jobs of the device were not updated: %v", guid, err)
+		}
+	}
+
+	// a running capture of the device stops recording, the lock of the device goes with it
+	_, _ = uc.device.StopCapture(guid)
+
+	uc.lockMutex.Lock()
+	delete(uc.locks, guid)
+	uc.lockMutex.Unlock()
+
+	if result.Incomplete {
+		uc.log.Warn("devices - %s deleted without a complete cleanup of the device", guid)
+	}
+
+	return result, nil
+}
+
+// cleanup runs the cleanup steps on the device, the steps after a failed one are skipped: the device is most
+// likely offline.
+func (uc *UseCase) cleanup(ctx context.Context, item *entity.Device) []dto.DeviceCleanupStep {
+	steps := make([]dto.DeviceCleanupStep, len(cleanupSteps))
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+
+	for i, step := range cleanupSteps {
+		steps[i] = dto.DeviceCleanupStep{Step: step.name, Status: cleanupSkipped}
+
+		if err != nil {
+			steps[i].Error = err.Error()
+
+			continue
+		}
+
+		var removed []string
+
+		removed, err = step.run(ctx, device)
+		if err != nil {
+			err = deviceError(step.name, err)
+			steps[i].Status = cleanupFailed
+			steps[i].Error = err.Error()
+
+			continue
+		}
+
+		steps[i].Status = cleanupDone
+		steps[i].Removed = removed
+	}
+
+	return steps
+}
+
+// disableRedirection disables SOL, IDER and KVM and the redirection listener.
+func disableRedirection(ctx context.Context, device wsman.Management) ([]string, error) {
+	var results dtov2.Features
+
+	state, listenerEnabled, err := redirectionRequestStateChange(ctx, false, false, &results, device)
+	if err != nil {
+		return nil, err
+	}
+
+	kvmListenerEnabled, err := setKVM(ctx, false, &results, device)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setRedirectionService(ctx, state, listenerEnabled, kvmListenerEnabled, device); err != nil {
+		return nil, err
+	}
+
+	return []string{"SOL", "IDER", "KVM"}, nil
+}
+
+// deleteAlarms deletes the alarm occurrences of the device, set by the console to wake it up.
+func deleteAlarms(ctx context.Context, device wsman.Management) ([]string, error) {
+	alarms, err := device.GetAlarmOccurrences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := []string{}
+
+	for i := range alarms {
+		if err := device.DeleteAlarmOccurrences(ctx, alarms[i].InstanceID); err != nil {
+			return removed, err
+		}
+
+		removed = append(removed, alarms[i].InstanceID)
+	}
+
+	return removed, nil
+}
+
+// removeRemoteAccess removes the CIRA configuration of the device.
+func removeRemoteAccess(ctx context.Context, device wsman.Management) ([]string, error) {
+	if err := device.RemoveRemoteAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	return []string{"remote access policies", "MPS servers"}, nil
+}
+
+// deleteConsoleCertificates deletes the root certificates of the console the device trusts and the certificates
+// they issued, with the key pairs no other certificate depends on.
+func deleteConsoleCertificates(ctx context.Context, device wsman.Management) ([]string, error) {
+	response, err := device.GetCertificates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := map[string]bool{}
+	removed := []string{}
+
+	for _, cert := range response.PublicKeyCertificateResponse.PublicKeyCertificateItems {
+		if cert.ReadOnlyCertificate || !consoleIssued(cert.X509Certificate) {
+			continue
+		}
+
+		if err := device.DeleteCertificate(ctx, cert.InstanceID); err != nil {
+			return removed, err
+		}
+
+		deleted[cert.InstanceID] = true
+		removed = append(removed, cert.InstanceID)
+	}
+
+	// the key pairs of the deleted certificates, unless a kept certificate depends on them too
+	orphaned := map[string]bool{}
+	kept := map[string]bool{}
+
+	for _, dependency := range response.ConcreteDependencyResponse.Items {
+		if deleted[selectorText(dependency.Antecedent)] {
+			orphaned[selectorText(dependency.Dependent)] = true
+		} else {
+			kept[selectorText(dependency.Dependent)] = true
+		}
+	}
+
+	for _, key := range response.PublicPrivateKeyPairResponse.PublicPrivateKeyPairItems {
+		if !orphaned[key.InstanceID] || kept[key.InstanceID] {
+			continue
+		}
+
+		if err := device.DeleteKeyPair(ctx, key.InstanceID); err != nil {
+			return removed, err
+		}
+
+		removed = append(removed, key.InstanceID)
+	}
+
+	return removed, nil
+}
+
+// consoleIssued reports whether a base64 DER certificate is a root of the console or was issued by one.
+func consoleIssued(encoded string) bool {
+	der, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return false
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return false
+	}
+
+	return slices.Contains(cert.Issuer.Organization, consoleOrganization) || slices.Contains(cert.Subject.Organization, consoleOrganization)
+}
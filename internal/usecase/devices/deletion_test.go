@@ -0,0 +1,131 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	wsmanAPI "github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestDeleteWithCleanup(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{
+		GUID:     "device-guid-123",
+		TenantID: "tenant-id-456",
+	}
+
+	deleted := map[string]int64{"devices": 1, "power_samples": 12}
+
+	tests := []struct {
+		name     string
+		cleanup  bool
+		manMock  func(man *mocks.MockWSMAN)
+		repoMock func(repo *mocks.MockDeviceManagementRepository)
+		res      dto.DeviceDeletion
+		err      error
+	}{
+		{
+			name: "success without cleanup",
+			manMock: func(man *mocks.MockWSMAN) {
+				man.EXPECT().StopCapture(device.GUID).Return(wsmanAPI.CaptureInfo{}, wsmanAPI.ErrCaptureNotFound)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+				repo.EXPECT().DeleteCascade(context.Background(), device.GUID, "").Return(deleted, true, nil)
+			},
+			res: dto.DeviceDeletion{GUID: device.GUID, Cleanup: []dto.DeviceCleanupStep{}, Deleted: deleted, PendingJobs: 1},
+		},
+		{
+			name:    "offline device is deleted with an incomplete cleanup",
+			cleanup: true,
+			manMock: func(man *mocks.MockWSMAN) {
+				man.EXPECT().SetupWsmanClient(*device, false, true).Return(nil, ErrGeneral)
+				man.EXPECT().StopCapture(device.GUID).Return(wsmanAPI.CaptureInfo{}, wsmanAPI.ErrCaptureNotFound)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+				repo.EXPECT().DeleteCascade(context.Background(), device.GUID, "").Return(deleted, true, nil)
+			},
+			res: dto.DeviceDeletion{
+				GUID: device.GUID,
+				Cleanup: []dto.DeviceCleanupStep{
+					{Step: "redirection", Status: "skipped", Error: ErrGeneral.Error()},
+					{Step: "alarms", Status: "skipped", Error: ErrGeneral.Error()},
+					{Step: "cira", Status: "skipped", Error: ErrGeneral.Error()},
+					{Step: "certificates", Status: "skipped", Error: ErrGeneral.Error()},
+				},
+				Deleted:     deleted,
+				PendingJobs: 1,
+				Incomplete:  true,
+			},
+		},
+		{
+			name: "device not found",
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(nil, nil)
+			},
+			err: devices.ErrNotFound,
+		},
+		{
+			name: "GetByID fails",
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(nil, ErrGeneral)
+			},
+			err: devices.ErrDatabase,
+		},
+		{
+			name: "DeleteCascade fails",
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+				repo.EXPECT().DeleteCascade(context.Background(), device.GUID, "").Return(nil, false, ErrGeneral)
+			},
+			err: devices.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+
+			repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+			wsmanMock := mocks.NewMockWSMAN(mockCtl)
+			wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+			useCase := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, nil)
+
+			pending := &fakePending{}
+			useCase.SetPendingOperations(pending)
+
+			if tc.manMock != nil {
+				tc.manMock(wsmanMock)
+			}
+
+			tc.repoMock(repo)
+
+			res, err := useCase.DeleteWithCleanup(context.Background(), device.GUID, "", tc.cleanup)
+
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.res, res)
+			require.Equal(t, device.GUID, pending.removed)
+		})
+	}
+}
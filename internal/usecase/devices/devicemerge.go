@@ -0,0 +1,259 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/correlations"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/guid"
+)
+
+const (
+	// duplicateDevicesPerPage is the number of devices read at a time looking for duplicates.
+	duplicateDevicesPerPage = 100
+
+	// MACAddressAttribute is the custom device attribute duplicates are found by MAC address on.
+	MACAddressAttribute = "macAddress"
+
+	// MatchedByMACAddress names the MAC address attribute as the identifier of a group of duplicates.
+	MatchedByMACAddress = "macAddress"
+
+	// macAddressDigits is the number of hexadecimal digits of a MAC address.
+	macAddressDigits = 12
+)
+
+// SetPendingOperations moves the operations waiting to run on the duplicates merged into a device to that device,
+// and drops those waiting to run on deleted devices.
+func (uc *UseCase) SetPendingOperations(pending PendingOperations) {
+	uc.pending = pending
+}
+
+// FindDuplicates returns the groups of device records of a tenant likely to be the same machine: those whose GUIDs
+// are the byte-swapped forms of each other and those sharing a serial number or MAC address attribute.
+func (uc *UseCase) FindDuplicates(ctx context.Context, tenantID string) ([]dto.DuplicateGroup, error) {
+	type key struct{ matchedBy, value string }
+
+	members := map[key][]string{}
+
+	add := func(matchedBy, value, guid string) {
+		if value != "" {
+			members[key{matchedBy, value}] = append(members[key{matchedBy, value}], guid)
+		}
+	}
+
+	for skip := 0; ; skip += duplicateDevicesPerPage {
+		page, err := uc.repo.Get(ctx, duplicateDevicesPerPage, skip, tenantID)
+		if err != nil {
+			return nil, ErrDatabase.Wrap("FindDuplicates", "uc.repo.Get", err)
+		}
+
+		for i := range page {
+			d := &page[i]
+
+			// both forms of a byte-swapped GUID stand for the pair by the lesser of the two
+			if swapped := guid.Swapped(d.GUID); swapped != "" {
+				add(correlations.MatchedByUUID, min(guid.Normalize(d.GUID), swapped), d.GUID)
+			}
+
+			add(correlations.MatchedBySerialNumber, strings.ToUpper(strings.TrimSpace(d.Attributes[correlations.SerialNumberAttribute])), d.GUID)
+			add(MatchedByMACAddress, normalizeMACAddress(d.Attributes[MACAddressAttribute]), d.GUID)
+		}
+
+		if len(page) < duplicateDevicesPerPage {
+			break
+		}
+	}
+
+	groups := []dto.DuplicateGroup{}
+
+	for k, guids := range members {
+		if len(guids) < 2 {
+			continue
+		}
+
+		slices.Sort(guids)
+
+		groups = append(groups, dto.DuplicateGroup{MatchedBy: k.matchedBy, Value: k.value, GUIDs: guids})
+	}
+
+	slices.SortFunc(groups, func(a, b dto.DuplicateGroup) int {
+		return strings.Compare(a.MatchedBy+" "+a.Value, b.MatchedBy+" "+b.Value)
+	})
+
+	return groups, nil
+}
+
+// normalizeMACAddress returns a MAC address as lowercase colon separated hexadecimal, empty for a value that is not
+// one or the zero address some platforms report without a network port.
+func normalizeMACAddress(value string) string {
+	digits := strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9', r >= 'a' && r <= 'f':
+			return r
+		case r >= 'A' && r <= 'F':
+			return r + 'a' - 'A'
+		case r == ':' || r == '-' || r == '.' || r == ' ':
+			return -1
+		default:
+			return 'x'
+		}
+	}, value)
+
+	if len(digits) != macAddressDigits || strings.ContainsRune(digits, 'x') || strings.Trim(digits, "0") == "" {
+		return ""
+	}
+
+	pairs := make([]string, 0, macAddressDigits/2)
+	for i := 0; i < macAddressDigits; i += 2 {
+		pairs = append(pairs, digits[i:i+2])
+	}
+
+	return strings.Join(pairs, ":")
+}
+
+// MergeDevices merges duplicate records into the device guid: the device takes the tags and the attributes it
+// lacks of the duplicates, their power, address and log history, correlations and credential checkouts, and the
+// pending jobs working on them. The duplicates are then deleted, all in one transaction but for the jobs. A dry run
+// returns the device as it would be merged without changing anything.
+func (uc *UseCase) MergeDevices(ctx context.Context, guid, tenantID string, duplicates []string, dryRun bool) (dto.DeviceMerge, error) {
+	guid = strings.ToLower(guid)
+
+	item, err := uc.repo.GetByID(ctx, guid, tenantID)
+	if err != nil {
+		return dto.DeviceMerge{}, ErrDatabase.Wrap("MergeDevices", "uc.repo.GetByID", err)
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.DeviceMerge{}, ErrNotFound
+	}
+
+	merged := []string{}
+	records := []*entity.Device{}
+
+	for _, duplicate := range duplicates {
+		duplicate = strings.ToLower(duplicate)
+		if slices.Contains(merged, duplicate) {
+			continue
+		}
+
+		if duplicate == guid {
+			return dto.DeviceMerge{}, ErrValidationUseCase.Wrap("MergeDevices", "duplicates", "a device cannot be merged into itself")
+		}
+
+		record, err := uc.repo.GetByID(ctx, duplicate, tenantID)
+		if err != nil {
+			return dto.DeviceMerge{}, ErrDatabase.Wrap("MergeDevices", "uc.repo.GetByID", err)
+		}
+
+		if record == nil || record.GUID == "" {
+			return dto.DeviceMerge{}, ErrNotFound
+		}
+
+		merged = append(merged, duplicate)
+		records = append(records, record)
+	}
+
+	if len(merged) == 0 {
+		return dto.DeviceMerge{}, ErrValidationUseCase.Wrap("MergeDevices", "duplicates", "no duplicate to merge")
+	}
+
+	if err := mergeRecords(item, records); err != nil {
+		return dto.DeviceMerge{}, err
+	}
+
+	result := dto.DeviceMerge{Device: uc.entityToDTO(item), Merged: merged, Reassigned: map[string]int64{}, DryRun: dryRun}
+	if result.Device.Tags == nil {
+		result.Device.Tags = []string{}
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	for _, g := range append([]string{guid}, merged...) {
+		if err := uc.checkLock(ctx, g, "MergeDevices"); err != nil {
+			return dto.DeviceMerge{}, err
+		}
+	}
+
+	moved, ok, err := uc.repo.Merge(ctx, item, merged)
+	if err != nil {
+		return dto.DeviceMerge{}, ErrDatabase.Wrap("MergeDevices", "uc.repo.Merge", err)
+	}
+
+	if !ok {
+		return dto.DeviceMerge{}, ErrNotFound
+	}
+
+	result.Reassigned = moved
+
+	for _, duplicate := range merged {
+		// a running capture of a duplicate stops recording, its lock goes with it
+		_, _ = uc.device.StopCapture(duplicate)
+
+		uc.lockMutex.Lock()
+		delete(uc.locks, duplicate)
+		uc.lockMutex.Unlock()
+	}
+
+	if uc.pending != nil {
+		// the records are merged already, a job left on a deleted duplicate fails on it
+		result.PendingJobs, err = uc.pending.ReassignDevices(ctx, tenantID, merged, guid)
+		if err != nil {
+			uc.log.Warn("devices - MergeDevices - %s: the pending jobs of the duplicates were not reassigned: %v", guid, err)
+		}
+	}
+
+	uc.log.Info("devices - %s merged with its duplicates %s", guid, strings.Join(merged, ", "))
+
+	eventbus.Publish(uc.events, eventbus.DeviceUpdated, eventbus.DeviceUpdatedEvent{GUID: guid, TenantID: tenantID, Hostname: item.Hostname})
+
+	return result, nil
+}
+
+// mergeRecords adds the tags of the duplicates to d and the attributes it lacks, in the order of the duplicates.
+func mergeRecords(d *entity.Device, duplicates []*entity.Device) error {
+	tags := []string{}
+	if d.Tags != "" {
+		tags = strings.Split(d.Tags, ",")
+	}
+
+	attributes := maps.Clone(d.Attributes)
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	for _, duplicate := range duplicates {
+		if duplicate.Tags != "" {
+			for _, tag := range strings.Split(duplicate.Tags, ",") {
+				if !slices.Contains(tags, tag) {
+					tags = append(tags, tag)
+				}
+			}
+		}
+
+		for name, value := range duplicate.Attributes {
+			if _, ok := attributes[name]; !ok {
+				attributes[name] = value
+			}
+		}
+	}
+
+	if len(attributes) > dto.MaxDeviceAttributes {
+		return ErrValidationUseCase.Wrap("MergeDevices", "mergeRecords", fmt.Sprintf("the merged device would hold more than %d attributes", dto.MaxDeviceAttributes))
+	}
+
+	d.Tags = strings.Join(tags, ",")
+
+	if len(attributes) > 0 {
+		d.Attributes = attributes
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	wsmanAPI "github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	survivorGUID  = "123e4567-e89b-12d3-a456-426614174000"
+	duplicateGUID = "67453e12-9be8-d312-a456-426614174000"
+)
+
+// fakePending records the pending operations moved to a merged device or dropped with a deleted one.
+type fakePending struct {
+	from    []string
+	to      string
+	removed string
+}
+
+func (p *fakePending) ReassignDevices(_ context.Context, _ string, from []string, to string) (int, error) {
+	p.from, p.to = from, to
+
+	return 2, nil
+}
+
+func (p *fakePending) RemoveDevice(_ context.Context, _, guid string) (int, error) {
+	p.removed = guid
+
+	return 1, nil
+}
+
+func initMergeTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *mocks.MockDeviceManagementRepository) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+
+	wsmanMock := mocks.NewMockWSMAN(mockCtl)
+	wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, nil)
+
+	return u, wsmanMock, repo
+}
+
+func TestFindDuplicates(t *testing.T) {
+	t.Parallel()
+
+	u, _, repo := initMergeTest(t)
+
+	repo.EXPECT().Get(context.Background(), 100, 0, "tenant").Return([]entity.Device{
+		{GUID: survivorGUID, Attributes: map[string]string{"serialNumber": "5cg1234xyz"}},
+		{GUID: duplicateGUID},
+		{GUID: "guid3", Attributes: map[string]string{"serialNumber": " 5CG1234XYZ", "macAddress": "AA-BB-CC-DD-EE-FF"}},
+		{GUID: "guid4", Attributes: map[string]string{"macAddress": "aabb.ccdd.eeff"}},
+		{GUID: "guid5", Attributes: map[string]string{"macAddress": "00:00:00:00:00:00"}},
+		{GUID: "guid6", Attributes: map[string]string{"macAddress": "00:00:00:00:00:00"}},
+	}, nil)
+
+	groups, err := u.FindDuplicates(context.Background(), "tenant")
+	require.NoError(t, err)
+
+	assert.Equal(t, []dto.DuplicateGroup{
+		{MatchedBy: "macAddress", Value: "aa:bb:cc:dd:ee:ff", GUIDs: []string{"guid3", "guid4"}},
+		{MatchedBy: "serialNumber", Value: "5CG1234XYZ", GUIDs: []string{survivorGUID, "guid3"}},
+		{MatchedBy: "uuid", Value: survivorGUID, GUIDs: []string{survivorGUID, duplicateGUID}},
+	}, groups)
+}
+
+func TestMergeDevices(t *testing.T) {
+	t.Parallel()
+
+	survivor := func() *entity.Device {
+		return &entity.Device{GUID: survivorGUID, Tags: "lab", Attributes: map[string]string{"site": "berlin"}}
+	}
+
+	duplicate := func() *entity.Device {
+		return &entity.Device{GUID: duplicateGUID, Tags: "lab,floor2", Attributes: map[string]string{"site": "munich", "serialNumber": "5CG1234XYZ"}}
+	}
+
+	merged := &dto.Device{
+		GUID:       survivorGUID,
+		Tags:       []string{"lab", "floor2"},
+		Attributes: map[string]string{"site": "berlin", "serialNumber": "5CG1234XYZ"},
+	}
+
+	tests := []struct {
+		name       string
+		duplicates []string
+		dryRun     bool
+		mock       func(*mocks.MockWSMAN, *mocks.MockDeviceManagementRepository)
+		res        dto.DeviceMerge
+		err        error
+	}{
+		{
+			name:       "success",
+			duplicates: []string{duplicateGUID, duplicateGUID},
+			mock: func(man *mocks.MockWSMAN, repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), survivorGUID, "").Return(survivor(), nil)
+				repo.EXPECT().GetByID(context.Background(), duplicateGUID, "").Return(duplicate(), nil)
+				repo.EXPECT().Merge(context.Background(), gomock.Any(), []string{duplicateGUID}).
+					DoAndReturn(func(_ context.Context, d *entity.Device, _ []string) (map[string]int64, bool, error) {
+						assert.Equal(t, "lab,floor2", d.Tags)
+						assert.Equal(t, merged.Attributes, d.Attributes)
+
+						return map[string]int64{"power_samples": 12}, true, nil
+					})
+				man.EXPECT().StopCapture(duplicateGUID).Return(wsmanAPI.CaptureInfo{}, wsmanAPI.ErrCaptureNotFound)
+			},
+			res: dto.DeviceMerge{Device: merged, Merged: []string{duplicateGUID}, Reassigned: map[string]int64{"power_samples": 12}, PendingJobs: 2},
+		},
+		{
+			name:       "dry run",
+			duplicates: []string{duplicateGUID},
+			dryRun:     true,
+			mock: func(_ *mocks.MockWSMAN, repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), survivorGUID, "").Return(survivor(), nil)
+				repo.EXPECT().GetByID(context.Background(), duplicateGUID, "").Return(duplicate(), nil)
+			},
+			res: dto.DeviceMerge{Device: merged, Merged: []string{duplicateGUID}, Reassigned: map[string]int64{}, DryRun: true},
+		},
+		{
+			name:       "merged into itself",
+			duplicates: []string{survivorGUID},
+			mock: func(_ *mocks.MockWSMAN, repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), survivorGUID, "").Return(survivor(), nil)
+			},
+			err: devices.ErrValidationUseCase,
+		},
+		{
+			name:       "duplicate not found",
+			duplicates: []string{duplicateGUID},
+			mock: func(_ *mocks.MockWSMAN, repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), survivorGUID, "").Return(survivor(), nil)
+				repo.EXPECT().GetByID(context.Background(), duplicateGUID, "").Return(nil, nil)
+			},
+			err: devices.ErrNotFound,
+		},
+		{
+			name:       "duplicate deleted meanwhile",
+			duplicates: []string{duplicateGUID},
+			mock: func(_ *mocks.MockWSMAN, repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().GetByID(context.Background(), survivorGUID, "").Return(survivor(), nil)
+				repo.EXPECT().GetByID(context.Background(), duplicateGUID, "").Return(duplicate(), nil)
+				repo.EXPECT().Merge(context.Background(), gomock.Any(), []string{duplicateGUID}).Return(nil, false, nil)
+			},
+			err: devices.ErrNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			u, wsmanMock, repo := initMergeTest(t)
+
+			pending := &fakePending{}
+			u.SetPendingOperations(pending)
+
+			tc.mock(wsmanMock, repo)
+
+			res, err := u.MergeDevices(context.Background(), survivorGUID, "", tc.duplicates, tc.dryRun)
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.res, res)
+
+			if tc.res.PendingJobs > 0 {
+				assert.Equal(t, []string{duplicateGUID}, pending.from)
+				assert.Equal(t, survivorGUID, pending.to)
+			}
+		})
+	}
+}
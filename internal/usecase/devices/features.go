@@ -2,10 +2,8 @@ package devices
 
 import (
 	"context"
-	"errors"
 	"strings"
 
-	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/amterror"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	cimBoot "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
@@ -56,7 +54,7 @@ func (uc *UseCase) GetFeatures(c context.Context, guid string) (settingsResults
 	}
 
 	// Get redirection settings from AMT
-	err = getRedirectionService(&settingsResultsV2, device)
+	err = getRedirectionService(c, &settingsResultsV2, device)
 	if err != nil {
 		return settingsResults, settingsResultsV2, err
 	}
@@ -67,7 +65,7 @@ func (uc *UseCase) GetFeatures(c context.Context, guid string) (settingsResults
 	settingsResults.Redirection = settingsResultsV2.Redirection
 
 	// Get optinservice settings from AMT
-	err = getUserConsent(&settingsResultsV2, device)
+	err = getUserConsent(c, &settingsResultsV2, device)
 	if err != nil {
 		return settingsResults, settingsResultsV2, err
 	}
@@ -77,7 +75,7 @@ func (uc *UseCase) GetFeatures(c context.Context, guid string) (settingsResults
 	settingsResults.OptInState = settingsResultsV2.OptInState
 
 	// Get KVM settings from AMT
-	err = getKVM(&settingsResultsV2, device)
+	err = getKVM(c, &settingsResultsV2, device)
 	if err != nil {
 		return settingsResults, settingsResultsV2, err
 	}
@@ -87,7 +85,7 @@ func (uc *UseCase) GetFeatures(c context.Context, guid string) (settingsResults
 	settingsResults.KVMAvailable = settingsResultsV2.KVMAvailable
 
 	// Get boot service related settings
-	err = getOneClickRecoverySettings(&settingsResultsV2, device)
+	err = getOneClickRecoverySettings(c, &settingsResultsV2, device)
 	if err != nil {
 		return dto.Features{}, dtov2.Features{}, err
 	}
@@ -100,23 +98,23 @@ func (uc *UseCase) GetFeatures(c context.Context, guid string) (settingsResults
 	return settingsResults, settingsResultsV2, nil
 }
 
-func getOCRData(device wsman.Management) (OCRData, error) {
-	bootService, err := device.GetBootService()
+func getOCRData(ctx context.Context, device wsman.Management) (OCRData, error) {
+	bootService, err := device.GetBootService(ctx)
 	if err != nil {
 		return OCRData{}, err
 	}
 
-	bootSourceSettings, err := device.GetCIMBootSourceSetting()
+	bootSourceSettings, err := device.GetCIMBootSourceSetting(ctx)
 	if err != nil {
 		return OCRData{}, err
 	}
 
-	capabilities, err := device.GetPowerCapabilities()
+	capabilities, err := device.GetPowerCapabilities(ctx)
 	if err != nil {
 		return OCRData{}, err
 	}
 
-	bootData, err := device.GetBootData()
+	bootData, err := device.GetBootData(ctx)
 	if err != nil {
 		return OCRData{}, err
 	}
@@ -156,8 +154,8 @@ func FindBootSettingInstances(bootSourceSettings []cimBoot.BootSourceSetting) dt
 	return result
 }
 
-func getOneClickRecoverySettings(settingsResultsV2 *dtov2.Features, device wsman.Management) error {
-	ocrData, err := getOCRData(device)
+func getOneClickRecoverySettings(ctx context.Context, settingsResultsV2 *dtov2.Features, device wsman.Management) error {
+	ocrData, err := getOCRData(ctx, device)
 	if err != nil {
 		return err
 	}
@@ -198,7 +196,7 @@ func (uc *UseCase) SetFeatures(c context.Context, guid string, features dto.Feat
 	}
 
 	// redirection
-	state, listenerEnabled, err := redirectionRequestStateChange(features.EnableSOL, features.EnableIDER, &settingsResultsV2, device)
+	state, listenerEnabled, err := redirectionRequestStateChange(c, features.EnableSOL, features.EnableIDER, &settingsResultsV2, device)
 	if err != nil {
 		return settingsResults, settingsResultsV2, err
 	}
@@ -208,7 +206,7 @@ func (uc *UseCase) SetFeatures(c context.Context, guid string, features dto.Feat
 	settingsResults.EnableIDER = settingsResultsV2.EnableIDER
 
 	// kvm
-	kvmListenerEnabled, err := setKVM(features.EnableKVM, &settingsResultsV2, device)
+	kvmListenerEnabled, err := setKVM(c, features.EnableKVM, &settingsResultsV2, device)
 	if err != nil {
 		return settingsResults, settingsResultsV2, err
 	}
@@ -217,7 +215,7 @@ func (uc *UseCase) SetFeatures(c context.Context, guid string, features dto.Feat
 	settingsResults.EnableKVM = settingsResultsV2.EnableKVM
 
 	// get and put redirection
-	err = setRedirectionService(state, listenerEnabled, kvmListenerEnabled, device)
+	err = setRedirectionService(c, state, listenerEnabled, kvmListenerEnabled, device)
 	if err != nil {
 		return settingsResults, settingsResultsV2, err
 	}
@@ -227,7 +225,7 @@ func (uc *UseCase) SetFeatures(c context.Context, guid string, features dto.Feat
 	settingsResultsV2.Redirection = listenerEnabled == 1 || kvmListenerEnabled == 1
 
 	// user consent
-	err = setUserConsent(features.UserConsent, device)
+	err = setUserConsent(c, features.UserConsent, device)
 	if err != nil {
 		return settingsResults, settingsResultsV2, err
 	}
@@ -243,10 +241,10 @@ func (uc *UseCase) SetFeatures(c context.Context, guid string, features dto.Feat
 		requestedState = enabledStateDisabled
 	}
 
-	_, err = device.BootServiceStateChange(requestedState)
+	_, err = device.BootServiceStateChange(c, requestedState)
 	if err == nil {
 		// Get OCR settings
-		err = getOneClickRecoverySettings(&settingsResultsV2, device)
+		err = getOneClickRecoverySettings(c, &settingsResultsV2, device)
 		if err != nil {
 			return dto.Features{}, dtov2.Features{}, err
 		}
@@ -263,17 +261,14 @@ func (uc *UseCase) SetFeatures(c context.Context, guid string, features dto.Feat
 }
 
 func handleAMTKVMError(err error, results *dtov2.Features) bool {
-	amtErr := &amterror.AMTError{}
-	if errors.As(err, &amtErr) {
-		if strings.Contains(amtErr.SubCode, "DestinationUnreachable") {
-			results.EnableKVM = false
-			results.KVMAvailable = false
-
-			return true
-		}
+	if !unsupported(err) {
+		return false
 	}
 
-	return false
+	results.EnableKVM = false
+	results.KVMAvailable = false
+
+	return true
 }
 
 func getSOLAndIDERState(enabledState redirection.EnabledState) (iderEnabled, solEnabled bool) {
@@ -292,8 +287,8 @@ func getSOLAndIDERState(enabledState redirection.EnabledState) (iderEnabled, sol
 	}
 }
 
-func redirectionRequestStateChange(enableSOL, enableIDER bool, results *dtov2.Features, w wsman.Management) (state redirection.EnabledState, listenerEnabled int, err error) {
-	requestedState, listenerEnabled, err := w.RequestAMTRedirectionServiceStateChange(enableIDER, enableSOL)
+func redirectionRequestStateChange(ctx context.Context, enableSOL, enableIDER bool, results *dtov2.Features, w wsman.Management) (state redirection.EnabledState, listenerEnabled int, err error) {
+	requestedState, listenerEnabled, err := w.RequestAMTRedirectionServiceStateChange(ctx, enableIDER, enableSOL)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -307,8 +302,8 @@ func redirectionRequestStateChange(enableSOL, enableIDER bool, results *dtov2.Fe
 	return state, listenerEnabled, nil
 }
 
-func getKVM(results *dtov2.Features, w wsman.Management) error {
-	kvmResult, err := w.GetKVMRedirection()
+func getKVM(ctx context.Context, results *dtov2.Features, w wsman.Management) error {
+	kvmResult, err := w.GetKVMRedirection(ctx)
 	if err != nil {
 		isAMTErr := handleAMTKVMError(err, results)
 		if !isAMTErr {
@@ -322,8 +317,8 @@ func getKVM(results *dtov2.Features, w wsman.Management) error {
 	return nil
 }
 
-func setKVM(enableKVM bool, results *dtov2.Features, w wsman.Management) (kvmListenerEnabled int, err error) {
-	kvmListenerEnabled, err = w.SetKVMRedirection(enableKVM)
+func setKVM(ctx context.Context, enableKVM bool, results *dtov2.Features, w wsman.Management) (kvmListenerEnabled int, err error) {
+	kvmListenerEnabled, err = w.SetKVMRedirection(ctx, enableKVM)
 	if err != nil {
 		isAMTErr := handleAMTKVMError(err, results)
 		if !isAMTErr {
@@ -337,8 +332,8 @@ func setKVM(enableKVM bool, results *dtov2.Features, w wsman.Management) (kvmLis
 	return kvmListenerEnabled, nil
 }
 
-func getRedirectionService(results *dtov2.Features, w wsman.Management) error {
-	redirectionResult, err := w.GetAMTRedirectionService()
+func getRedirectionService(ctx context.Context, results *dtov2.Features, w wsman.Management) error {
+	redirectionResult, err := w.GetAMTRedirectionService(ctx)
 	if err != nil {
 		return err
 	}
@@ -351,8 +346,8 @@ func getRedirectionService(results *dtov2.Features, w wsman.Management) error {
 	return nil
 }
 
-func setRedirectionService(state redirection.EnabledState, listenerEnabled, kvmListenerEnabled int, w wsman.Management) error {
-	currentRedirection, err := w.GetAMTRedirectionService()
+func setRedirectionService(ctx context.Context, state redirection.EnabledState, listenerEnabled, kvmListenerEnabled int, w wsman.Management) error {
+	currentRedirection, err := w.GetAMTRedirectionService(ctx)
 	if err != nil {
 		return err
 	}
@@ -367,7 +362,7 @@ func setRedirectionService(state redirection.EnabledState, listenerEnabled, kvmL
 		SystemName:              currentRedirection.Body.GetAndPutResponse.SystemName,
 	}
 
-	_, err = w.SetAMTRedirectionService(request)
+	_, err = w.SetAMTRedirectionService(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -375,8 +370,8 @@ func setRedirectionService(state redirection.EnabledState, listenerEnabled, kvmL
 	return nil
 }
 
-func getUserConsent(results *dtov2.Features, w wsman.Management) error {
-	optServiceResult, err := w.GetIPSOptInService()
+func getUserConsent(ctx context.Context, results *dtov2.Features, w wsman.Management) error {
+	optServiceResult, err := w.GetIPSOptInService(ctx)
 	if err != nil {
 		return err
 	}
@@ -387,8 +382,8 @@ func getUserConsent(results *dtov2.Features, w wsman.Management) error {
 	return nil
 }
 
-func setUserConsent(userConsent string, w wsman.Management) error {
-	optInResponse, err := w.GetIPSOptInService()
+func setUserConsent(ctx context.Context, userConsent string, w wsman.Management) error {
+	optInResponse, err := w.GetIPSOptInService(ctx)
 	if err != nil {
 		return err
 	}
@@ -404,7 +399,7 @@ func setUserConsent(userConsent string, w wsman.Management) error {
 		SystemCreationClassName: optInResponse.Body.GetAndPutResponse.SystemCreationClassName,
 	}
 
-	err = w.SetIPSOptInService(optinRequest)
+	err = w.SetIPSOptInService(ctx, optinRequest)
 	if err != nil {
 		return err
 	}
@@ -154,7 +154,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -164,7 +164,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -174,7 +174,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -183,12 +183,12 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32769,
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -204,14 +204,14 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{
 						ForceUEFIHTTPSBoot:    true,
 						ForceWinREBoot:        true,
 						ForceUEFILocalPBABoot: true,
 					}, nil)
 				man2.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{
 						UEFIHTTPSBootEnabled:    true,
 						WinREBootEnabled:        true,
@@ -235,7 +235,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -245,7 +245,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -255,7 +255,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -264,12 +264,12 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32768, // Disabled state
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -285,14 +285,14 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{
 						ForceUEFIHTTPSBoot:    true,
 						ForceWinREBoot:        true,
 						ForceUEFILocalPBABoot: true,
 					}, nil)
 				man2.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{
 						UEFIHTTPSBootEnabled:    true,
 						WinREBootEnabled:        true,
@@ -341,7 +341,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -351,7 +351,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -361,7 +361,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -370,12 +370,12 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32768,
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -388,14 +388,14 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{
 						ForceUEFIHTTPSBoot:    false,
 						ForceWinREBoot:        false,
 						ForceUEFILocalPBABoot: false,
 					}, nil)
 				man2.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{
 						UEFIHTTPSBootEnabled:    false,
 						WinREBootEnabled:        false,
@@ -432,7 +432,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -452,7 +452,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -462,7 +462,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -490,7 +490,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -500,7 +500,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -510,7 +510,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -542,7 +542,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -552,7 +552,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -562,7 +562,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -571,7 +571,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -591,7 +591,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -601,7 +601,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -611,7 +611,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -620,7 +620,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -640,7 +640,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -650,7 +650,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -660,7 +660,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -669,12 +669,12 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32769,
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -694,7 +694,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -704,7 +704,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -714,7 +714,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -723,12 +723,12 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32769,
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -744,7 +744,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -764,7 +764,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -774,7 +774,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -784,7 +784,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -793,12 +793,12 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32769,
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -814,14 +814,14 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{
 						ForceUEFIHTTPSBoot:    true,
 						ForceWinREBoot:        true,
 						ForceUEFILocalPBABoot: true,
 					}, nil)
 				man2.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -841,7 +841,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -851,7 +851,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -861,15 +861,15 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{}, amterror.DecodeAMTErrorString(DestinationUnreachable))
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32769,
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -885,14 +885,14 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{
 						ForceUEFIHTTPSBoot:    true,
 						ForceWinREBoot:        true,
 						ForceUEFILocalPBABoot: true,
 					}, nil)
 				man2.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{
 						UEFIHTTPSBootEnabled:    true,
 						WinREBootEnabled:        true,
@@ -916,7 +916,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -926,7 +926,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -936,7 +936,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -945,12 +945,12 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32771, // Different enabled state that should also enable OCR
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -963,12 +963,12 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{
 						ForceUEFIHTTPSBoot: true,
 					}, nil)
 				man2.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{
 						UEFIHTTPSBootEnabled: true,
 					}, nil)
@@ -1014,7 +1014,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -1024,7 +1024,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -1034,7 +1034,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -1043,12 +1043,12 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32769,
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -1063,12 +1063,12 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{
 						ForceWinREBoot: true, // Only WinRE supported
 					}, nil)
 				man2.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{
 						WinREBootEnabled: true, // Only WinRE enabled
 					}, nil)
@@ -1114,7 +1114,7 @@ func TestGetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -1124,7 +1124,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -1134,7 +1134,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetKVMRedirection().
+					GetKVMRedirection(gomock.Any()).
 					Return(kvm.Response{
 						Body: kvm.Body{
 							GetResponse: kvm.KVMRedirectionSAP{
@@ -1143,7 +1143,7 @@ func TestGetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -1287,13 +1287,13 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.EnableIDERAndSOL, 1, nil)
 				man2.EXPECT().
-					SetKVMRedirection(true).
+					SetKVMRedirection(gomock.Any(), true).
 					Return(1, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -1303,7 +1303,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetAMTRedirectionService(&redirection.RedirectionRequest{
+					SetAMTRedirectionService(gomock.Any(), &redirection.RedirectionRequest{
 						EnabledState:    redirection.EnabledState(redirection.EnableIDERAndSOL),
 						ListenerEnabled: true,
 					}).
@@ -1316,7 +1316,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -1326,21 +1326,21 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetIPSOptInService(optin.OptInServiceRequest{
+					SetIPSOptInService(gomock.Any(), optin.OptInServiceRequest{
 						OptInRequired: 1,
 						OptInState:    0,
 					}).
 					Return(nil)
 				man2.EXPECT().
-					BootServiceStateChange(32769). // OCR enabled
+					BootServiceStateChange(gomock.Any(), 32769). // OCR enabled
 					Return(cimBoot.BootService{}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32769,
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -1356,14 +1356,14 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{
 						ForceUEFIHTTPSBoot:    true,
 						ForceWinREBoot:        false,
 						ForceUEFILocalPBABoot: false,
 					}, nil)
 				man2.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{
 						UEFIHTTPSBootEnabled:    true,
 						WinREBootEnabled:        false,
@@ -1398,13 +1398,13 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.EnableIDERAndSOL, 1, nil)
 				man2.EXPECT().
-					SetKVMRedirection(true).
+					SetKVMRedirection(gomock.Any(), true).
 					Return(1, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -1414,7 +1414,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetAMTRedirectionService(&redirection.RedirectionRequest{
+					SetAMTRedirectionService(gomock.Any(), &redirection.RedirectionRequest{
 						EnabledState:    redirection.EnabledState(redirection.EnableIDERAndSOL),
 						ListenerEnabled: true,
 					}).
@@ -1427,7 +1427,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -1437,21 +1437,21 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetIPSOptInService(optin.OptInServiceRequest{
+					SetIPSOptInService(gomock.Any(), optin.OptInServiceRequest{
 						OptInRequired: 1,
 						OptInState:    0,
 					}).
 					Return(nil)
 				man2.EXPECT().
-					BootServiceStateChange(32768).
+					BootServiceStateChange(gomock.Any(), 32768).
 					Return(cimBoot.BootService{}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32768,
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -1467,14 +1467,14 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{
 						ForceUEFIHTTPSBoot:    true,
 						ForceWinREBoot:        false,
 						ForceUEFILocalPBABoot: false,
 					}, nil)
 				man2.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{
 						UEFIHTTPSBootEnabled:    true,
 						WinREBootEnabled:        false,
@@ -1511,7 +1511,7 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.RequestedState(0), 0, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -1531,10 +1531,10 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.EnableIDERAndSOL, 1, nil)
 				man2.EXPECT().
-					SetKVMRedirection(true).
+					SetKVMRedirection(gomock.Any(), true).
 					Return(0, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -1552,13 +1552,13 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.EnableIDERAndSOL, 1, nil)
 				man2.EXPECT().
-					SetKVMRedirection(true).
+					SetKVMRedirection(gomock.Any(), true).
 					Return(0, amterror.DecodeAMTErrorString(DestinationUnreachable))
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -1568,7 +1568,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetAMTRedirectionService(&redirection.RedirectionRequest{
+					SetAMTRedirectionService(gomock.Any(), &redirection.RedirectionRequest{
 						EnabledState:    redirection.EnabledState(redirection.EnableIDERAndSOL),
 						ListenerEnabled: true,
 					}).
@@ -1581,7 +1581,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -1591,21 +1591,21 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetIPSOptInService(optin.OptInServiceRequest{
+					SetIPSOptInService(gomock.Any(), optin.OptInServiceRequest{
 						OptInRequired: 1,
 						OptInState:    0,
 					}).
 					Return(nil)
 				man2.EXPECT().
-					BootServiceStateChange(32769).
+					BootServiceStateChange(gomock.Any(), 32769).
 					Return(cimBoot.BootService{}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{
 						EnabledState: 32769,
 					}, nil)
 				man2.EXPECT().
-					GetCIMBootSourceSetting().
+					GetCIMBootSourceSetting(gomock.Any()).
 					Return(cimBoot.Response{
 						Body: cimBoot.Body{
 							PullResponse: cimBoot.PullResponse{
@@ -1621,14 +1621,14 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{
 						ForceUEFIHTTPSBoot:    true,
 						ForceWinREBoot:        false,
 						ForceUEFILocalPBABoot: false,
 					}, nil)
 				man2.EXPECT().
-					GetBootData().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{
 						UEFIHTTPSBootEnabled:    true,
 						WinREBootEnabled:        false,
@@ -1674,13 +1674,13 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.EnableIDERAndSOL, 1, nil)
 				man2.EXPECT().
-					SetKVMRedirection(true).
+					SetKVMRedirection(gomock.Any(), true).
 					Return(1, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -1709,13 +1709,13 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.EnableIDERAndSOL, 1, nil)
 				man2.EXPECT().
-					SetKVMRedirection(true).
+					SetKVMRedirection(gomock.Any(), true).
 					Return(1, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -1725,7 +1725,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetAMTRedirectionService(&redirection.RedirectionRequest{
+					SetAMTRedirectionService(gomock.Any(), &redirection.RedirectionRequest{
 						EnabledState:    redirection.EnabledState(redirection.EnableIDERAndSOL),
 						ListenerEnabled: true,
 					}).
@@ -1738,7 +1738,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -1769,13 +1769,13 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.EnableIDERAndSOL, 1, nil)
 				man2.EXPECT().
-					SetKVMRedirection(true).
+					SetKVMRedirection(gomock.Any(), true).
 					Return(1, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -1785,7 +1785,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetAMTRedirectionService(&redirection.RedirectionRequest{
+					SetAMTRedirectionService(gomock.Any(), &redirection.RedirectionRequest{
 						EnabledState:    redirection.EnabledState(redirection.EnableIDERAndSOL),
 						ListenerEnabled: true,
 					}).
@@ -1798,7 +1798,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -1808,13 +1808,13 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetIPSOptInService(optin.OptInServiceRequest{
+					SetIPSOptInService(gomock.Any(), optin.OptInServiceRequest{
 						OptInRequired: 1,
 						OptInState:    0,
 					}).
 					Return(nil)
 				man2.EXPECT().
-					BootServiceStateChange(32769).
+					BootServiceStateChange(gomock.Any(), 32769).
 					Return(cimBoot.BootService{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -1847,13 +1847,13 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.EnableIDERAndSOL, 1, nil)
 				man2.EXPECT().
-					SetKVMRedirection(true).
+					SetKVMRedirection(gomock.Any(), true).
 					Return(1, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -1863,7 +1863,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetAMTRedirectionService(&redirection.RedirectionRequest{
+					SetAMTRedirectionService(gomock.Any(), &redirection.RedirectionRequest{
 						EnabledState:    redirection.EnabledState(redirection.EnableIDERAndSOL),
 						ListenerEnabled: true,
 					}).
@@ -1876,7 +1876,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -1886,16 +1886,16 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetIPSOptInService(optin.OptInServiceRequest{
+					SetIPSOptInService(gomock.Any(), optin.OptInServiceRequest{
 						OptInRequired: 1,
 						OptInState:    0,
 					}).
 					Return(nil)
 				man2.EXPECT().
-					BootServiceStateChange(32769).
+					BootServiceStateChange(gomock.Any(), 32769).
 					Return(cimBoot.BootService{}, nil)
 				man2.EXPECT().
-					GetBootService().
+					GetBootService(gomock.Any()).
 					Return(cimBoot.BootService{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -1915,13 +1915,13 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.EnableIDERAndSOL, 1, nil)
 				man2.EXPECT().
-					SetKVMRedirection(true).
+					SetKVMRedirection(gomock.Any(), true).
 					Return(1, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -1931,7 +1931,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetAMTRedirectionService(&redirection.RedirectionRequest{
+					SetAMTRedirectionService(gomock.Any(), &redirection.RedirectionRequest{
 						EnabledState:    redirection.EnabledState(redirection.EnableIDERAndSOL),
 						ListenerEnabled: true,
 					}).
@@ -1963,13 +1963,13 @@ func TestSetFeatures(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					RequestAMTRedirectionServiceStateChange(true, true).
+					RequestAMTRedirectionServiceStateChange(gomock.Any(), true, true).
 					Return(redirection.EnableIDERAndSOL, 1, nil)
 				man2.EXPECT().
-					SetKVMRedirection(true).
+					SetKVMRedirection(gomock.Any(), true).
 					Return(1, nil)
 				man2.EXPECT().
-					GetAMTRedirectionService().
+					GetAMTRedirectionService(gomock.Any()).
 					Return(redirection.Response{
 						Body: redirection.Body{
 							GetAndPutResponse: redirection.RedirectionResponse{
@@ -1979,7 +1979,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetAMTRedirectionService(&redirection.RedirectionRequest{
+					SetAMTRedirectionService(gomock.Any(), &redirection.RedirectionRequest{
 						EnabledState:    redirection.EnabledState(redirection.EnableIDERAndSOL),
 						ListenerEnabled: true,
 					}).
@@ -1992,7 +1992,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					GetIPSOptInService().
+					GetIPSOptInService(gomock.Any()).
 					Return(optin.Response{
 						Body: optin.Body{
 							GetAndPutResponse: optin.OptInServiceResponse{
@@ -2002,7 +2002,7 @@ func TestSetFeatures(t *testing.T) {
 						},
 					}, nil)
 				man2.EXPECT().
-					SetIPSOptInService(optin.OptInServiceRequest{
+					SetIPSOptInService(gomock.Any(), optin.OptInServiceRequest{
 						OptInRequired: 1,
 						OptInState:    0,
 					}).
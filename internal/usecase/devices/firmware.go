@@ -0,0 +1,64 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/amterror"
+	ipsPower "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/power"
+
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// unsupportedFaults are the subcodes of the faults a device answers for a class its firmware does not have,
+// the address of the class being unknown, and for a method it does not have.
+var unsupportedFaults = []string{"DestinationUnreachable", "ActionNotSupported"}
+
+// firmwareFeature is a feature older versions of Intel AMT lack, minMajor is the first major version with it.
+type firmwareFeature struct {
+	name     string
+	minMajor int
+}
+
+// featureSoftPowerActions are the soft off, soft reset, sleep, hibernate and graceful power actions.
+var featureSoftPowerActions = firmwareFeature{name: "soft and graceful power actions", minMajor: MinAMTVersion + 1}
+
+// unsupported reports whether err is the fault of a device whose firmware lacks the class or method called.
+func unsupported(err error) bool {
+	var fault *amterror.AMTError
+	if !errors.As(err, &fault) {
+		return false
+	}
+
+	for _, code := range unsupportedFaults {
+		// the subcode carries the prefix of its namespace, e.g. b:DestinationUnreachable
+		if strings.HasSuffix(fault.SubCode, code) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// amtMajorVersion returns the major version of Intel AMT on a device. It is read on every request that needs it
+// and never kept, since the firmware of a device may be updated at any time.
+func amtMajorVersion(ctx context.Context, device wsman.Management) (int, error) {
+	version, err := device.GetAMTVersion(ctx)
+	if err != nil {
+		return 0, deviceError("GetAMTVersion", err)
+	}
+
+	return parseVersion(version)
+}
+
+// osPowerSavingState returns the OS power saving state of a device, Unsupported when its firmware has no
+// IPS_PowerManagementService.
+func osPowerSavingState(ctx context.Context, device wsman.Management) (ipsPower.OSPowerSavingState, error) {
+	state, err := device.GetOSPowerSavingState(ctx)
+	if unsupported(err) {
+		return ipsPower.Unsupported, nil
+	}
+
+	return state, err
+}
@@ -0,0 +1,148 @@
+package devices
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+)
+
+const (
+	// RedirectionModeSOL is the mode of the redirection websocket relaying a serial over LAN session.
+	RedirectionModeSOL = "sol"
+
+	// input of the viewer, screen update requests and SOL control messages do not count
+	rfbPointerEvent = 5
+	solDataToHost   = 0x28
+
+	idleReason = "idle timeout"
+)
+
+// SetIdleTimeout closes KVM and SOL sessions without input from the viewer for timeout, the viewer is warned
+// warning before. A timeout of 0 disables it. It applies to the sessions opened after it is called.
+func (uc *UseCase) SetIdleTimeout(timeout, warning time.Duration) {
+	uc.redirMutex.Lock()
+	defer uc.redirMutex.Unlock()
+
+	uc.idleTimeout = timeout
+	uc.idleWarning = min(max(warning, 0), timeout)
+}
+
+func (uc *UseCase) idleTimeouts() (timeout, warning time.Duration) {
+	uc.redirMutex.RLock()
+	defer uc.redirMutex.RUnlock()
+
+	return uc.idleTimeout, uc.idleWarning
+}
+
+// isInput reports whether msg of the viewer is input of the user, which keeps the session from being idle.
+func isInput(mode string, msg []byte) bool {
+	if len(msg) == 0 {
+		return false
+	}
+
+	switch mode {
+	case RedirectionModeKVM:
+		return msg[0] == rfbKeyEvent || msg[0] == rfbPointerEvent || msg[0] == rfbClientCutText
+	case RedirectionModeSOL:
+		return msg[0] == solDataToHost
+	default:
+		return false
+	}
+}
+
+// recordInput notes input of the viewer.
+func (d *DeviceConnection) recordInput() {
+	d.mu.Lock()
+	d.lastInput = time.Now()
+	d.mu.Unlock()
+}
+
+func (d *DeviceConnection) idleFor() time.Duration {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return time.Since(d.lastInput)
+}
+
+// writeMessage writes to the websocket of the viewer, which takes one writer at a time.
+func (d *DeviceConnection) writeMessage(conn WebSocketConn, messageType int, data []byte) error {
+	d.writeMu.Lock()
+	defer d.writeMu.Unlock()
+
+	return conn.WriteMessage(messageType, data)
+}
+
+func (d *DeviceConnection) sendEvent(event dto.RedirectionEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	_ = d.writeMessage(d.Conn, websocket.TextMessage, data)
+}
+
+// MonitorIdle warns the viewer of a KVM or SOL session without input before the idle timeout and closes the
+// session at the idle timeout.
+func (uc *UseCase) MonitorIdle(deviceConnection *DeviceConnection) {
+	timeout, warning := uc.idleTimeouts()
+	if timeout <= 0 || (deviceConnection.Mode != RedirectionModeKVM && deviceConnection.Mode != RedirectionModeSOL) {
+		return
+	}
+
+	timer := time.NewTimer(timeout - warning)
+	defer timer.Stop()
+
+	warned := false
+
+	for {
+		select {
+		case <-deviceConnection.ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		idle := deviceConnection.idleFor()
+
+		switch {
+		case idle >= timeout:
+			uc.closeIdleSession(deviceConnection, idle)
+
+			return
+		case idle >= timeout-warning:
+			if !warned {
+				deviceConnection.sendEvent(dto.RedirectionEvent{Event: dto.RedirectionEventIdleWarning, ClosesIn: int((timeout - idle).Round(time.Second).Seconds())})
+				warned = true
+			}
+
+			timer.Reset(timeout - idle)
+		default:
+			// the viewer gave input, a later warning is sent again
+			warned = false
+
+			timer.Reset(timeout - warning - idle)
+		}
+	}
+}
+
+// closeIdleSession tells the viewer the session is closed for being idle, ends it and records it.
+func (uc *UseCase) closeIdleSession(deviceConnection *DeviceConnection, idle time.Duration) {
+	deviceConnection.sendEvent(dto.RedirectionEvent{Event: dto.RedirectionEventIdleTimeout})
+	_ = deviceConnection.writeMessage(deviceConnection.Conn, websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, idleReason))
+
+	deviceConnection.cancel()
+	// closing the browser side unblocks ListenToBrowser, the device side is closed once the listeners are done
+	_ = deviceConnection.Conn.Close()
+
+	guid := deviceConnection.Device.GUID
+	uc.log.Info("audit - redirection session closed: guid %s, mode %s, %s after %s without input", guid, deviceConnection.Mode, idleReason, idle.Round(time.Second))
+	eventbus.Publish(uc.events, eventbus.RedirectionClosed, eventbus.RedirectionClosedEvent{
+		GUID:        guid,
+		Mode:        deviceConnection.Mode,
+		Reason:      idleReason,
+		IdleSeconds: int(idle.Seconds()),
+	})
+}
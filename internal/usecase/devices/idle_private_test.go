@@ -0,0 +1,177 @@
+package devices
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// messageRecorder is a browser websocket recording the messages written to it.
+type messageRecorder struct {
+	mu       sync.Mutex
+	events   []dto.RedirectionEvent
+	closeMsg bool
+	closed   bool
+}
+
+func (r *messageRecorder) ReadMessage() (int, []byte, error) { return 0, nil, io.EOF }
+
+func (r *messageRecorder) WriteMessage(messageType int, data []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch messageType {
+	case websocket.TextMessage:
+		var event dto.RedirectionEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return err
+		}
+
+		r.events = append(r.events, event)
+	case websocket.CloseMessage:
+		r.closeMsg = true
+	}
+
+	return nil
+}
+
+func (r *messageRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.closed = true
+
+	return nil
+}
+
+func (r *messageRecorder) state() (events []dto.RedirectionEvent, closeMsg, closed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]dto.RedirectionEvent(nil), r.events...), r.closeMsg, r.closed
+}
+
+func newIdleTestConnection(t *testing.T, mode string) (*DeviceConnection, *messageRecorder) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	conn := &messageRecorder{}
+
+	return &DeviceConnection{
+		Conn:      conn,
+		Device:    entity.Device{GUID: "guid"},
+		Mode:      mode,
+		ctx:       ctx,
+		cancel:    cancel,
+		lastInput: time.Now(),
+	}, conn
+}
+
+func TestMonitorIdle(t *testing.T) {
+	t.Parallel()
+
+	events := eventbus.New()
+	t.Cleanup(events.Close)
+
+	closed := make(chan eventbus.RedirectionClosedEvent, 1)
+	eventbus.Subscribe(events, eventbus.RedirectionClosed, func(e eventbus.RedirectionClosedEvent) { closed <- e })
+
+	uc := &UseCase{log: logger.New("error"), events: events}
+	uc.SetIdleTimeout(200*time.Millisecond, 100*time.Millisecond)
+
+	deviceConnection, conn := newIdleTestConnection(t, RedirectionModeKVM)
+
+	done := make(chan struct{})
+
+	go func() {
+		uc.MonitorIdle(deviceConnection)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("idle session is not closed")
+	}
+
+	sent, closeMsg, wasClosed := conn.state()
+	require.Equal(t, []dto.RedirectionEvent{
+		{Event: dto.RedirectionEventIdleWarning},
+		{Event: dto.RedirectionEventIdleTimeout},
+	}, sent, "the warning rounds to 0 seconds left")
+	require.True(t, closeMsg)
+	require.True(t, wasClosed)
+	require.Error(t, deviceConnection.ctx.Err())
+
+	e := <-closed
+	require.Equal(t, "guid", e.GUID)
+	require.Equal(t, RedirectionModeKVM, e.Mode)
+	require.Equal(t, idleReason, e.Reason)
+}
+
+func TestMonitorIdleInput(t *testing.T) {
+	t.Parallel()
+
+	uc := &UseCase{log: logger.New("error")}
+	uc.SetIdleTimeout(200*time.Millisecond, 100*time.Millisecond)
+
+	deviceConnection, conn := newIdleTestConnection(t, RedirectionModeSOL)
+
+	done := make(chan struct{})
+
+	go func() {
+		uc.MonitorIdle(deviceConnection)
+		close(done)
+	}()
+
+	// input keeps the session open
+	for range 8 {
+		time.Sleep(50 * time.Millisecond)
+		deviceConnection.recordInput()
+	}
+
+	sent, _, wasClosed := conn.state()
+	require.Empty(t, sent)
+	require.False(t, wasClosed)
+
+	deviceConnection.cancel()
+	<-done
+}
+
+func TestMonitorIdleDisabled(t *testing.T) {
+	t.Parallel()
+
+	// without an idle timeout, and for IDER, the monitor returns at once
+	uc := &UseCase{}
+	deviceConnection, _ := newIdleTestConnection(t, RedirectionModeKVM)
+	uc.MonitorIdle(deviceConnection)
+
+	uc.SetIdleTimeout(time.Minute, time.Second)
+	deviceConnection, _ = newIdleTestConnection(t, "ider")
+	uc.MonitorIdle(deviceConnection)
+}
+
+func TestIsInput(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isInput(RedirectionModeKVM, []byte{rfbKeyEvent, 1, 0, 0, 0, 0, 0, 'a'}))
+	require.True(t, isInput(RedirectionModeKVM, []byte{rfbPointerEvent, 0, 0, 1, 0, 1}))
+	require.False(t, isInput(RedirectionModeKVM, []byte{rfbFramebufferUpdateRequest, 1, 0, 0, 0, 0, 4, 0, 3, 0}))
+	require.True(t, isInput(RedirectionModeSOL, []byte{solDataToHost, 0, 0, 0}))
+	require.False(t, isInput(RedirectionModeSOL, []byte{0x2b, 0, 0, 0}))
+	require.False(t, isInput("ider", []byte{rfbKeyEvent}))
+	require.False(t, isInput(RedirectionModeKVM, nil))
+}
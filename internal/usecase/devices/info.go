@@ -9,6 +9,7 @@ import (
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	dtov2 "github.com/device-management-toolkit/console/internal/entity/dto/v2"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
 )
 
 func (uc *UseCase) GetVersion(c context.Context, guid string) (v1 dto.Version, v2 dtov2.Version, err error) {
@@ -26,16 +27,23 @@ func (uc *UseCase) GetVersion(c context.Context, guid string) (v1 dto.Version, v
 		return v1, v2, err
 	}
 
-	softwareIdentity, err := device.GetAMTVersion()
+	softwareIdentity, err := device.GetAMTVersion(c)
 	if err != nil {
 		return v1, v2, err
 	}
 
-	data, err := device.GetSetupAndConfiguration()
+	data, err := device.GetSetupAndConfiguration(c)
 	if err != nil {
 		return v1, v2, err
 	}
 
+	// the version is read when a device is opened, a device seen for the first time or provisioned since gets its control mode
+	if _, provisioningState := controlModeFromSetup(data[0]); provisioningState != item.ProvisioningState {
+		if _, _, err := uc.updateControlMode(c, item, device, data[0]); err != nil {
+			uc.log.Warn("devices - GetVersion - updateControlMode: %v", err)
+		}
+	}
+
 	// iterate over the data and convert each entity to dto
 	d1 := make([]dto.SoftwareIdentity, len(softwareIdentity))
 
@@ -62,7 +70,12 @@ func (uc *UseCase) GetVersion(c context.Context, guid string) (v1 dto.Version, v
 	return v1, v2, nil
 }
 
-func (uc *UseCase) GetHardwareInfo(c context.Context, guid string) (dto.HardwareInfo, error) {
+// GetHardwareInfo fetches the given sections, the wsman.HardwareClasses, all of them when none is given.
+func (uc *UseCase) GetHardwareInfo(c context.Context, guid string, sections ...string) (dto.HardwareInfo, error) {
+	if err := validateSelection("GetHardwareInfo", sections, wsman.HardwareClasses); err != nil {
+		return dto.HardwareInfo{}, err
+	}
+
 	item, err := uc.repo.GetByID(c, guid, "")
 	if err != nil {
 		return dto.HardwareInfo{}, err
@@ -77,9 +90,9 @@ func (uc *UseCase) GetHardwareInfo(c context.Context, guid string) (dto.Hardware
 		return dto.HardwareInfo{}, err
 	}
 
-	hwInfo, err := device.GetHardwareInfo()
+	hwInfo, err := device.GetHardwareInfo(c, sections...)
 	if err != nil {
-		return dto.HardwareInfo{}, err
+		return dto.HardwareInfo{}, deviceError("GetHardwareInfo", err)
 	}
 
 	result := uc.hardwareInfoToDTO(hwInfo)
@@ -122,7 +135,7 @@ func (uc *UseCase) GetDiskInfo(c context.Context, guid string) (dto.DiskInfo, er
 		return dto.DiskInfo{}, err
 	}
 
-	diskInfo, err := device.GetDiskInfo()
+	diskInfo, err := device.GetDiskInfo(c)
 	if err != nil {
 		return dto.DiskInfo{}, err
 	}
@@ -187,7 +200,7 @@ func (uc *UseCase) GetAuditLog(c context.Context, startIndex int, guid string) (
 		return dto.AuditLog{}, err
 	}
 
-	response, err := device.GetAuditLog(startIndex)
+	response, err := device.GetAuditLog(c, startIndex)
 	if err != nil {
 		return dto.AuditLog{}, err
 	}
@@ -214,7 +227,7 @@ func (uc *UseCase) GetEventLog(c context.Context, startIndex, maxReadRecords int
 		return dto.EventLogs{}, err
 	}
 
-	eventLogs, err := device.GetEventLog(startIndex, maxReadRecords)
+	eventLogs, err := device.GetEventLog(c, startIndex, maxReadRecords)
 	if err != nil {
 		return dto.EventLogs{}, err
 	}
@@ -225,25 +238,12 @@ func (uc *UseCase) GetEventLog(c context.Context, startIndex, maxReadRecords int
 		events = make([]dto.EventLog, len(eventLogs.RefinedEventData))
 
 		for idx := range eventLogs.RefinedEventData {
-			event := &eventLogs.RefinedEventData[idx]
-			dtoEvent := dto.EventLog{
-				// DeviceAddress:   event.DeviceAddress,
-				// EventSensorType: event.EventSensorType,
-				// EventType:       event.EventType,
-				// EventOffset:     event.EventOffset,
-				// EventSourceType: event.EventSourceType,
-				EventSeverity: event.EventSeverity,
-				// SensorNumber:    event.SensorNumber,
-				Entity: event.Entity,
-				// EntityInstance:  event.EntityInstance,
-				// EventData:       event.EventData,
-				Time: event.TimeStamp.String(),
-				// EntityStr:       event.EntityStr,
-				Description: event.Description,
-				// EventTypeDesc:   event.EventTypeDesc,
+			var raw string
+			if idx < len(eventLogs.RecordArray) {
+				raw = eventLogs.RecordArray[idx]
 			}
 
-			events[idx] = dtoEvent
+			events[idx] = EventLogEntry(&eventLogs.RefinedEventData[idx], raw)
 		}
 	}
 
@@ -268,7 +268,7 @@ func (uc *UseCase) GetGeneralSettings(c context.Context, guid string) (dto.Gener
 		return dto.GeneralSettings{}, err
 	}
 
-	generalSettings, err := device.GetGeneralSettings()
+	generalSettings, err := device.GetGeneralSettings(c)
 	if err != nil {
 		return dto.GeneralSettings{}, err
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/mocks"
 	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -36,7 +37,7 @@ func initInfoTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *mocks.Mock
 
 	log := logger.New("error")
 
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, wsmanMock, management, repo
 }
@@ -89,17 +90,20 @@ func TestGetVersion(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTVersion().
+					GetAMTVersion(gomock.Any()).
 					Return(softwares, nil)
 
 				man2.EXPECT().
-					GetSetupAndConfiguration().
+					GetSetupAndConfiguration(gomock.Any()).
 					Return(responses, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
 				repo.EXPECT().
 					GetByID(context.Background(), device.GUID, "").
 					Return(device, nil)
+				repo.EXPECT().
+					UpdateControlMode(context.Background(), device.GUID, device.TenantID, "", dto.ProvisioningStateIn).
+					Return(true, nil)
 			},
 
 			res: dto.Version{
@@ -176,7 +180,7 @@ func TestGetVersion(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTVersion().
+					GetAMTVersion(gomock.Any()).
 					Return(softwares, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -197,11 +201,11 @@ func TestGetVersion(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAMTVersion().
+					GetAMTVersion(gomock.Any()).
 					Return(softwares, nil)
 
 				man2.EXPECT().
-					GetSetupAndConfiguration().
+					GetSetupAndConfiguration(gomock.Any()).
 					Return(responses, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -271,7 +275,7 @@ func TestGetHardwareInfo(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetHardwareInfo().
+					GetHardwareInfo(gomock.Any()).
 					Return(gomock.Any(), nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -302,7 +306,7 @@ func TestGetHardwareInfo(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetHardwareInfo().
+					GetHardwareInfo(gomock.Any()).
 					Return(nil, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -335,6 +339,29 @@ func TestGetHardwareInfo(t *testing.T) {
 	}
 }
 
+func TestGetHardwareInfoSections(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	useCase, wsmanMock, management, repo := initInfoTest(t)
+
+	repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+	wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
+	management.EXPECT().GetHardwareInfo(gomock.Any(), wsman.ClassChassis, wsman.ClassProcessor).
+		Return(map[string]interface{}{
+			"CIM_Chassis": map[string]interface{}{"response": map[string]interface{}{"Manufacturer": "Intel"}},
+		}, nil)
+
+	res, err := useCase.GetHardwareInfo(context.Background(), device.GUID, wsman.ClassChassis, wsman.ClassProcessor)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"Manufacturer": "Intel"}, res.CIMChassis.Response)
+
+	// unknown sections are rejected before the device is called
+	_, err = useCase.GetHardwareInfo(context.Background(), device.GUID, "CIM_Fan")
+	require.ErrorAs(t, err, &devices.ValidationError{})
+}
+
 func TestGetAuditLog(t *testing.T) {
 	t.Parallel()
 
@@ -351,7 +378,7 @@ func TestGetAuditLog(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAuditLog(1).
+					GetAuditLog(gomock.Any(), 1).
 					Return(auditlog.Response{}, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -385,7 +412,7 @@ func TestGetAuditLog(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetAuditLog(1).
+					GetAuditLog(gomock.Any(), 1).
 					Return(auditlog.Response{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -434,7 +461,7 @@ func TestGetEventLog(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetEventLog(1, 10).
+					GetEventLog(gomock.Any(), 1, 10).
 					Return(messagelog.GetRecordsResponse{NoMoreRecords: true}, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -465,7 +492,7 @@ func TestGetEventLog(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetEventLog(1, 10).
+					GetEventLog(gomock.Any(), 1, 10).
 					Return(messagelog.GetRecordsResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -514,7 +541,7 @@ func TestGetGeneralSettings(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetGeneralSettings().
+					GetGeneralSettings(gomock.Any()).
 					Return(gomock.Any(), nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -545,7 +572,7 @@ func TestGetGeneralSettings(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetGeneralSettings().
+					GetGeneralSettings(gomock.Any()).
 					Return(nil, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -595,7 +622,7 @@ func TestGetDiskInfo(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetDiskInfo().
+					GetDiskInfo(gomock.Any()).
 					Return(gomock.Any(), nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -626,7 +653,7 @@ func TestGetDiskInfo(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetDiskInfo().
+					GetDiskInfo(gomock.Any()).
 					Return(nil, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -11,15 +11,18 @@ import (
 	"io"
 	"log"
 	"math"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"golang.org/x/time/rate"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 
 	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 )
 
 const (
@@ -32,6 +35,9 @@ const (
 	HeartbeatInterval          = 30 * time.Second // Check connection health every 30 seconds
 )
 
+// RedirectionModeKVM is the mode of the redirection websocket relaying a KVM session.
+const RedirectionModeKVM = "kvm"
+
 type DeviceConnection struct {
 	Conn          WebSocketConn
 	wsmanMessages wsman.Messages
@@ -45,9 +51,30 @@ type DeviceConnection struct {
 	lastDataRecv  time.Time // Track last data received from device
 	mu            sync.RWMutex
 	healthTicker  *time.Ticker
+	limits        dto.KVMLimits
+	bandwidth     *rate.Limiter // nil without a bandwidth limit
+	// lastUpdateRequest is when the last screen update request of the viewer was relayed, see waitFrame
+	lastUpdateRequest time.Time
+	keyboard          map[uint32]uint32 // nil for the US keyboard layout, see keyboardTranslation
+	clipboard         bool
+	lastInput         time.Time  // last input of the viewer, see MonitorIdle
+	writeMu           sync.Mutex // serializes the writes to Conn
+	proxied           bool       // connected through the proxy of the device, see RedirectConnect
 }
 
-func (uc *UseCase) Redirect(c context.Context, conn *websocket.Conn, guid, mode string) error {
+// Redirect relays the KVM, SOL or IDER session of the websocket to the device. For a KVM session the limits set in
+// limits take the place of the global ones and options choose the keyboard layout and clipboard typing.
+func (uc *UseCase) Redirect(c context.Context, conn *websocket.Conn, guid, mode string, limits dto.KVMLimits, options dto.KVMSessionOptions) error {
+	limits, err := uc.sessionKVMLimits(limits)
+	if err != nil {
+		return err
+	}
+
+	keyboard, err := keyboardTranslation(options.KeyboardLayout)
+	if err != nil {
+		return err
+	}
+
 	device, err := uc.repo.GetByID(c, guid, "")
 	if err != nil {
 		return err
@@ -57,6 +84,11 @@ func (uc *UseCase) Redirect(c context.Context, conn *websocket.Conn, guid, mode
 		return ErrNotFound
 	}
 
+	*device, err = uc.siteDevice(c, *device)
+	if err != nil {
+		return err
+	}
+
 	key := device.GUID + "-" + mode
 
 	deviceConnection, err := uc.getOrCreateConnection(c, conn, key, device)
@@ -64,6 +96,9 @@ func (uc *UseCase) Redirect(c context.Context, conn *websocket.Conn, guid, mode
 		return err
 	}
 
+	deviceConnection.setLimits(limits)
+	deviceConnection.setOptions(keyboard, options.Clipboard)
+
 	err = uc.redirection.RedirectConnect(c, deviceConnection)
 	if err != nil {
 		deviceConnection.cancel()
@@ -81,6 +116,45 @@ func (uc *UseCase) Redirect(c context.Context, conn *websocket.Conn, guid, mode
 	return nil
 }
 
+// HasActiveRedirection reports whether a KVM, SOL or IDER session to the device is open through the console.
+func (uc *UseCase) HasActiveRedirection(guid string) bool {
+	uc.redirMutex.RLock()
+	defer uc.redirMutex.RUnlock()
+
+	for _, conn := range uc.redirConnections {
+		if strings.EqualFold(conn.Device.GUID, guid) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// restartKVMSessions ends the KVM sessions to the device for their viewers to reconnect, which is how a change
+// of the display shown takes effect. It returns the number of sessions ended.
+func (uc *UseCase) restartKVMSessions(guid string) int {
+	uc.redirMutex.Lock()
+
+	sessions := make([]*DeviceConnection, 0, 1)
+
+	for key, conn := range uc.redirConnections {
+		if strings.EqualFold(conn.Device.GUID, guid) && conn.Mode == RedirectionModeKVM {
+			sessions = append(sessions, conn)
+			delete(uc.redirConnections, key)
+		}
+	}
+
+	uc.redirMutex.Unlock()
+
+	for _, conn := range sessions {
+		conn.cancel()
+		// closing the browser side unblocks ListenToBrowser, the device side is closed once the listeners are done
+		_ = conn.Conn.Close()
+	}
+
+	return len(sessions)
+}
+
 func (uc *UseCase) getOrCreateConnection(c context.Context, conn *websocket.Conn, key string, device *entity.Device) (*DeviceConnection, error) {
 	uc.redirMutex.RLock()
 	existingConn, ok := uc.redirConnections[key]
@@ -131,6 +205,7 @@ func (uc *UseCase) createNewConnection(c context.Context, conn *websocket.Conn,
 		cancel:       cancel,
 		lastActivity: now,
 		lastDataRecv: now,
+		lastInput:    now,
 		healthTicker: time.NewTicker(HeartbeatInterval),
 	}
 
@@ -144,13 +219,15 @@ func (uc *UseCase) createNewConnection(c context.Context, conn *websocket.Conn,
 func (uc *UseCase) updateConnectionActivity(deviceConnection *DeviceConnection) {
 	deviceConnection.mu.Lock()
 	deviceConnection.lastActivity = time.Now()
+	// a viewer taking over the connection is not idle
+	deviceConnection.lastInput = deviceConnection.lastActivity
 	deviceConnection.mu.Unlock()
 }
 
 func (uc *UseCase) startConnectionGoroutines(c context.Context, deviceConnection *DeviceConnection, key string) {
 	var wg sync.WaitGroup
 
-	const numGoroutines = 3 // Device listener, Browser listener, Health monitor
+	const numGoroutines = 4 // Device listener, Browser listener, Health monitor, Idle monitor
 
 	wg.Add(numGoroutines)
 
@@ -172,6 +249,12 @@ func (uc *UseCase) startConnectionGoroutines(c context.Context, deviceConnection
 		uc.MonitorConnectionHealth(deviceConnection, key)
 	}()
 
+	go func() {
+		defer wg.Done()
+
+		uc.MonitorIdle(deviceConnection)
+	}()
+
 	// Start cleanup goroutine
 	go func() {
 		wg.Wait()
@@ -232,6 +315,10 @@ func (uc *UseCase) ListenToDevice(deviceConnection *DeviceConnection) {
 			toSend, deviceConnection.Direct = processDeviceData(toSend, &deviceConnection.Challenge)
 		}
 
+		if err := deviceConnection.waitBandwidth(len(toSend)); err != nil {
+			return
+		}
+
 		// metrics: device -> browser
 		start := time.Now()
 
@@ -239,7 +326,7 @@ func (uc *UseCase) ListenToDevice(deviceConnection *DeviceConnection) {
 		kvmDeviceToBrowserBytes.WithLabelValues(deviceConnection.Mode).Add(float64(len(toSend)))
 		kvmDeviceToBrowserMessages.WithLabelValues(deviceConnection.Mode).Inc()
 
-		err = conn.WriteMessage(websocket.BinaryMessage, toSend)
+		err = deviceConnection.writeMessage(conn, websocket.BinaryMessage, toSend)
 
 		kvmDeviceToBrowserWriteSeconds.WithLabelValues(deviceConnection.Mode).Observe(time.Since(start).Seconds())
 
@@ -286,6 +373,17 @@ func (uc *UseCase) ListenToBrowser(deviceConnection *DeviceConnection) {
 		toSend := msg
 		if !deviceConnection.Direct {
 			toSend = processBrowserData(msg, &deviceConnection.Challenge)
+		} else {
+			if isInput(deviceConnection.Mode, msg) {
+				deviceConnection.recordInput()
+			}
+
+			toSend, err = deviceConnection.limitBrowserData(msg)
+			if err != nil {
+				return
+			}
+
+			toSend = deviceConnection.translateBrowserData(toSend)
 		}
 
 		if len(toSend) == 0 {
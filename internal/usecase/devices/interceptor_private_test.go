@@ -2,6 +2,7 @@ package devices
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"math"
@@ -11,6 +12,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+
+	"github.com/device-management-toolkit/console/internal/entity"
 )
 
 func TestProcessBrowserData(t *testing.T) {
@@ -834,3 +837,38 @@ func TestRandomValueHexErrorCase(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, result)
 }
+
+// closeRecorder is a browser websocket recording whether it was closed.
+type closeRecorder struct {
+	closed bool
+}
+
+func (r *closeRecorder) ReadMessage() (int, []byte, error) { return 0, nil, io.EOF }
+
+func (r *closeRecorder) WriteMessage(int, []byte) error { return nil }
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+
+	return nil
+}
+
+func TestRestartKVMSessions(t *testing.T) {
+	t.Parallel()
+
+	kvm, sol, other := &closeRecorder{}, &closeRecorder{}, &closeRecorder{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	uc := &UseCase{redirConnections: map[string]*DeviceConnection{
+		"GUID-kvm":  {Conn: kvm, Device: entity.Device{GUID: "GUID"}, Mode: RedirectionModeKVM, ctx: ctx, cancel: cancel},
+		"GUID-sol":  {Conn: sol, Device: entity.Device{GUID: "GUID"}, Mode: "sol", cancel: func() {}},
+		"other-kvm": {Conn: other, Device: entity.Device{GUID: "other"}, Mode: RedirectionModeKVM, cancel: func() {}},
+	}}
+
+	require.Equal(t, 1, uc.restartKVMSessions("guid"))
+	require.True(t, kvm.closed)
+	require.Error(t, ctx.Err())
+	require.False(t, sol.closed)
+	require.False(t, other.closed)
+	require.Len(t, uc.redirConnections, 2)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman"
 
 	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/mocks"
 	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
 	"github.com/device-management-toolkit/console/pkg/logger"
@@ -91,11 +92,11 @@ func TestRedirect(t *testing.T) {
 
 			tc.setup(mockRedirection, mockRepo, mockWSMAN, &wg)
 
-			uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{})
+			uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{}, nil)
 
 			wg.Wait()
 
-			err := uc.Redirect(context.Background(), mockConn, guid, mode)
+			err := uc.Redirect(context.Background(), mockConn, guid, mode, dto.KVMLimits{}, dto.KVMSessionOptions{})
 
 			if tc.expectedErr != nil {
 				require.Error(t, err)
@@ -126,7 +127,7 @@ func TestRedirectSuccessfulFlow(t *testing.T) {
 		defer wg.Done()
 	}).Times(1)
 
-	uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{})
+	uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{}, nil)
 
 	wg.Wait()
 
@@ -143,7 +144,7 @@ func TestRedirectSuccessfulFlow(t *testing.T) {
 	mockRedirection.EXPECT().RedirectConnect(gomock.Any(), gomock.Any()).Return(ErrConnectionFailed)
 
 	// Test redirect (should fail at RedirectConnect but test path up to that point)
-	err := uc.Redirect(context.Background(), mockConn, testGUID, testMode)
+	err := uc.Redirect(context.Background(), mockConn, testGUID, testMode, dto.KVMLimits{}, dto.KVMSessionOptions{})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "connection failed")
 }
@@ -167,7 +168,7 @@ func TestRedirectDeviceNotFound(t *testing.T) {
 		defer wg.Done()
 	}).Times(1)
 
-	uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{})
+	uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{}, nil)
 
 	wg.Wait()
 
@@ -175,7 +176,7 @@ func TestRedirectDeviceNotFound(t *testing.T) {
 	mockRepo.EXPECT().GetByID(gomock.Any(), testGUID, "").Return(nil, nil)
 
 	// Test device not found
-	err := uc.Redirect(context.Background(), mockConn, testGUID, testMode)
+	err := uc.Redirect(context.Background(), mockConn, testGUID, testMode, dto.KVMLimits{}, dto.KVMSessionOptions{})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "DevicesUseCase")
 }
@@ -199,7 +200,7 @@ func TestRedirectConnectionReuse(t *testing.T) {
 		defer wg.Done()
 	}).Times(1)
 
-	uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{})
+	uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{}, nil)
 
 	wg.Wait()
 
@@ -214,7 +215,7 @@ func TestRedirectConnectionReuse(t *testing.T) {
 	mockRedirection.EXPECT().SetupWsmanClient(*device, true, true).Return(wsman.Messages{})
 	mockRedirection.EXPECT().RedirectConnect(gomock.Any(), gomock.Any()).Return(ErrFirstConnectionFailed)
 
-	err := uc.Redirect(context.Background(), mockConn, testGUID, testMode)
+	err := uc.Redirect(context.Background(), mockConn, testGUID, testMode, dto.KVMLimits{}, dto.KVMSessionOptions{})
 	require.Error(t, err)
 
 	// Second call - also fail to avoid goroutines but test reuse logic
@@ -222,10 +223,60 @@ func TestRedirectConnectionReuse(t *testing.T) {
 	mockRedirection.EXPECT().SetupWsmanClient(*device, true, true).Return(wsman.Messages{})
 	mockRedirection.EXPECT().RedirectConnect(gomock.Any(), gomock.Any()).Return(ErrSecondConnectionFailed)
 
-	err = uc.Redirect(context.Background(), mockConn, testGUID, testMode)
+	err = uc.Redirect(context.Background(), mockConn, testGUID, testMode, dto.KVMLimits{}, dto.KVMSessionOptions{})
 	require.Error(t, err)
 }
 
+func TestHasActiveRedirection(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRedirection := mocks.NewMockRedirection(ctrl)
+	mockRepo := mocks.NewMockDeviceManagementRepository(ctrl)
+	mockWSMAN := mocks.NewMockWSMAN(ctrl)
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	mockWSMAN.EXPECT().Worker().Do(func() {
+		defer wg.Done()
+	}).Times(1)
+
+	uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{}, nil)
+
+	wg.Wait()
+
+	device := &entity.Device{
+		GUID:     testGUID,
+		Username: "user",
+		Password: "pass",
+	}
+
+	var activeDuringConnect, otherDuringConnect bool
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), testGUID, "").Return(device, nil)
+	mockRedirection.EXPECT().SetupWsmanClient(*device, true, true).Return(wsman.Messages{})
+	mockRedirection.EXPECT().RedirectConnect(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *devices.DeviceConnection) error {
+			activeDuringConnect = uc.HasActiveRedirection("TEST-GUID")
+			otherDuringConnect = uc.HasActiveRedirection("test")
+
+			return ErrConnectionFailed
+		})
+
+	require.False(t, uc.HasActiveRedirection(testGUID))
+
+	err := uc.Redirect(context.Background(), &websocket.Conn{}, testGUID, testMode, dto.KVMLimits{}, dto.KVMSessionOptions{})
+	require.Error(t, err)
+
+	assert.True(t, activeDuringConnect)
+	assert.False(t, otherDuringConnect)
+	assert.False(t, uc.HasActiveRedirection(testGUID))
+}
+
 // Add additional test cases for better coverage of the existing functions.
 func TestRandomValueHex(t *testing.T) {
 	t.Parallel()
@@ -330,14 +381,14 @@ func TestRedirectWithErrorScenarios(t *testing.T) {
 
 			tc.setupMocks(mockRedirection, mockRepo, mockWSMAN, &wg)
 
-			uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{})
+			uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{}, nil)
 
 			wg.Wait()
 
 			// Create a mock websocket connection - but we can still test error paths
 			mockConn := &websocket.Conn{}
 
-			err := uc.Redirect(context.Background(), mockConn, testGUID, testMode)
+			err := uc.Redirect(context.Background(), mockConn, testGUID, testMode, dto.KVMLimits{}, dto.KVMSessionOptions{})
 
 			if tc.expectedErr != "" {
 				require.Error(t, err)
@@ -411,13 +462,13 @@ func TestRedirectConnectionFlowCoverage(t *testing.T) {
 
 			tc.setupMocks(mockRedirection, mockRepo, mockWSMAN, &wg)
 
-			uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{})
+			uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{}, nil)
 
 			wg.Wait()
 
 			mockConn := &websocket.Conn{}
 
-			err := uc.Redirect(context.Background(), mockConn, tc.guid, tc.mode)
+			err := uc.Redirect(context.Background(), mockConn, tc.guid, tc.mode, dto.KVMLimits{}, dto.KVMSessionOptions{})
 
 			if tc.shouldErr {
 				require.Error(t, err)
@@ -483,13 +534,13 @@ func TestRedirectAdditionalCoverage(t *testing.T) {
 
 			tc.setupMocks(mockRedirection, mockRepo, mockWSMAN, &wg)
 
-			uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{})
+			uc := devices.New(mockRepo, mockWSMAN, mockRedirection, logger.New("test"), mocks.MockCrypto{}, nil)
 
 			wg.Wait()
 
 			mockConn := &websocket.Conn{}
 
-			err := uc.Redirect(context.Background(), mockConn, tc.guid, tc.mode)
+			err := uc.Redirect(context.Background(), mockConn, tc.guid, tc.mode, dto.KVMLimits{}, dto.KVMSessionOptions{})
 
 			require.Error(t, err)
 			assert.Contains(t, err.Error(), tc.expectedErr)
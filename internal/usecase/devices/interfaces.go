@@ -2,6 +2,8 @@ package devices
 
 import (
 	"context"
+	"crypto/x509"
+	"time"
 
 	"github.com/gorilla/websocket"
 
@@ -19,6 +21,9 @@ type (
 		SetupWsmanClient(device entity.Device, isRedirection, logMessages bool) (wsmanAPI.Management, error)
 		DestroyWsmanClient(device dto.Device)
 		Worker()
+		StartCapture(guid string, duration time.Duration) (wsmanAPI.CaptureInfo, error)
+		StopCapture(guid string) (wsmanAPI.CaptureInfo, error)
+		CaptureTrace(guid string) (wsmanAPI.CaptureInfo, []byte, error)
 	}
 
 	WebSocketConn interface {
@@ -27,6 +32,16 @@ type (
 		Close() error
 	}
 
+	// Sites derives the connection settings of a device from the site its address belongs to.
+	Sites interface {
+		Apply(ctx context.Context, device entity.Device) (entity.Device, error)
+	}
+
+	// Revocation asks the issuer of a certificate whether it was revoked.
+	Revocation interface {
+		Check(ctx context.Context, cert *x509.Certificate, intermediates []*x509.Certificate) dto.RevocationStatus
+	}
+
 	Redirection interface {
 		SetupWsmanClient(device entity.Device, isRedirection, logMessages bool) wsman.Messages
 		RedirectConnect(ctx context.Context, deviceConnection *DeviceConnection) error
@@ -41,10 +56,22 @@ type (
 		GetDistinctTags(ctx context.Context, tenantID string) ([]string, error)
 		GetByTags(ctx context.Context, tags []string, method string, limit, offset int, tenantID string) ([]entity.Device, error)
 		Delete(ctx context.Context, guid, tenantID string) (bool, error)
+		DeleteCascade(ctx context.Context, guid, tenantID string) (map[string]int64, bool, error)
 		Update(ctx context.Context, d *entity.Device) (bool, error)
+		UpdateControlMode(ctx context.Context, guid, tenantID, controlMode, provisioningState string) (bool, error)
 		Insert(ctx context.Context, d *entity.Device) (string, error)
 		GetByColumn(ctx context.Context, columnName, queryValue, tenantID string) ([]entity.Device, error)
+		GetByAttributes(ctx context.Context, attributes map[string]string, top, skip int, tenantID string) ([]entity.Device, error)
+		Merge(ctx context.Context, d *entity.Device, duplicates []string) (map[string]int64, bool, error)
 	}
+
+	// PendingOperations moves the operations waiting to run on merged devices to the device they were merged into,
+	// and drops those waiting to run on deleted devices.
+	PendingOperations interface {
+		ReassignDevices(ctx context.Context, tenantID string, from []string, to string) (int, error)
+		RemoveDevice(ctx context.Context, tenantID, guid string) (int, error)
+	}
+
 	Feature interface {
 		// Repository/Database Calls
 		GetCount(context.Context, string) (int, error)
@@ -53,17 +80,24 @@ type (
 		GetDistinctTags(ctx context.Context, tenantID string) ([]string, error)
 		GetByTags(ctx context.Context, tags, method string, limit, offset int, tenantID string) ([]dto.Device, error)
 		Delete(ctx context.Context, guid, tenantID string) error
+		PlanDelete(ctx context.Context, guid, tenantID string) (dto.DryRunResult, error)
+		DeleteWithCleanup(ctx context.Context, guid, tenantID string, cleanup bool) (dto.DeviceDeletion, error)
+		MergeDevices(ctx context.Context, guid, tenantID string, duplicates []string, dryRun bool) (dto.DeviceMerge, error)
 		Update(ctx context.Context, d *dto.Device) (*dto.Device, error)
+		Patch(ctx context.Context, guid, tenantID string, p dto.DevicePatch) (*dto.Device, error)
 		Insert(ctx context.Context, d *dto.Device) (*dto.Device, error)
 		GetByColumn(ctx context.Context, columnName, queryValue, tenantID string) ([]dto.Device, error)
+		GetByAttributes(ctx context.Context, attributes map[string]string, top, skip int, tenantID string) ([]dto.Device, error)
+		Start(ctx context.Context)
 		// Management Calls
+		RefreshControlMode(ctx context.Context, guid string) (*dto.Device, error)
 		GetVersion(ctx context.Context, guid string) (dto.Version, dtov2.Version, error)
 		GetFeatures(ctx context.Context, guid string) (dto.Features, dtov2.Features, error)
 		SetFeatures(ctx context.Context, guid string, features dto.Features) (dto.Features, dtov2.Features, error)
 		GetAlarmOccurrences(ctx context.Context, guid string) ([]dto.AlarmClockOccurrence, error)
 		CreateAlarmOccurrences(ctx context.Context, guid string, alarm dto.AlarmClockOccurrenceInput) (dto.AddAlarmOutput, error)
 		DeleteAlarmOccurrences(ctx context.Context, guid, instanceID string) error
-		GetHardwareInfo(ctx context.Context, guid string) (dto.HardwareInfo, error)
+		GetHardwareInfo(ctx context.Context, guid string, sections ...string) (dto.HardwareInfo, error)
 		GetPowerState(ctx context.Context, guid string) (dto.PowerState, error)
 		GetPowerCapabilities(ctx context.Context, guid string) (dto.PowerCapabilities, error)
 		GetGeneralSettings(ctx context.Context, guid string) (dto.GeneralSettings, error)
@@ -73,19 +107,56 @@ type (
 		SendPowerAction(ctx context.Context, guid string, action int) (power.PowerActionResponse, error)
 		SetBootOptions(ctx context.Context, guid string, bootSetting dto.BootSetting) (power.PowerActionResponse, error)
 		GetAuditLog(ctx context.Context, startIndex int, guid string) (dto.AuditLog, error)
+		StreamAuditLog(c context.Context, guid string, emit func(dto.AuditLogEntry) error) error
+		QueryAuditLog(c context.Context, guid string, query dto.AuditLogQuery) (dto.AuditLogPage, error)
 		GetEventLog(ctx context.Context, startIndex, maxReadRecords int, guid string) (dto.EventLogs, error)
-		Redirect(ctx context.Context, conn *websocket.Conn, guid, mode string) error
-		GetNetworkSettings(c context.Context, guid string) (dto.NetworkSettings, error)
+		GetCrashReport(c context.Context, guid string) (dto.CrashReport, error)
+		Redirect(ctx context.Context, conn *websocket.Conn, guid, mode string, limits dto.KVMLimits, options dto.KVMSessionOptions) error
+		HasActiveRedirection(guid string) bool
+		GetNetworkSettings(c context.Context, guid string, sections ...string) (dto.NetworkSettings, error)
 		GetCertificates(c context.Context, guid string) (dto.SecuritySettings, error)
 		GetTLSSettingData(c context.Context, guid string) ([]dto.SettingDataResponse, error)
+		SetTLSSettings(c context.Context, guid string, req dto.TLSSettingsRequest) (dto.SettingDataResponse, error)
 		GetDiskInfo(c context.Context, guid string) (dto.DiskInfo, error)
 		GetDeviceCertificate(c context.Context, guid string) (dto.Certificate, error)
 		AddCertificate(c context.Context, guid string, certInfo dto.CertInfo) (string, error)
+		CleanupCertificates(c context.Context, guid string, dryRun bool) (dto.CertificateCleanup, error)
+		GetCertificateInventory(ctx context.Context, tenantID string, q dto.CertificateInventoryQuery) (dto.CertificateInventory, error)
 		GetBootSourceSetting(c context.Context, guid string) ([]dto.BootSources, error)
 		// KVM Screen Settings (IPS_ScreenSettingData)
 		GetKVMScreenSettings(c context.Context, guid string) (dto.KVMScreenSettings, error)
 		SetKVMScreenSettings(c context.Context, guid string, req dto.KVMScreenSettingsRequest) (dto.KVMScreenSettings, error)
+		GetRedirectionSettings(c context.Context, guid string) (dto.RedirectionSettings, error)
+		SetRedirectionSettings(c context.Context, guid string, settings dto.RedirectionSettings) (dto.RedirectionSettings, error)
+		SelectKVMDisplay(c context.Context, guid string, sel dto.KVMDisplaySelection) (dto.KVMDisplaySelected, error)
+		GetKVMLimits(c context.Context) dto.KVMLimits
+		SetKVMLimits(c context.Context, limits dto.KVMLimits) (dto.KVMLimits, error)
+		PingPresence(c context.Context, guid, user string, ping dto.PresencePing) ([]dto.Presence, error)
+		GetPresence(c context.Context, guid string) []dto.Presence
+		LockDevice(c context.Context, guid string, req dto.DeviceLockRequest) (dto.DeviceLock, error)
+		GetDeviceLock(c context.Context, guid string) (dto.DeviceLock, error)
+		UnlockDevice(c context.Context, guid string, force bool) error
 		// Link Preference (AMT_EthernetPortSettings)
 		SetLinkPreference(c context.Context, guid string, req dto.LinkPreferenceRequest) (dto.LinkPreferenceResponse, error)
+		GetLinkSettings(c context.Context, guid string) ([]dto.LinkSettings, error)
+		SetLinkSettings(c context.Context, guid string, req dto.LinkSettingsRequest) (dto.LinkSettings, error)
+		// Wi-Fi profile priorities (CIM_WiFiEndpointSettings)
+		GetWiFiProfiles(c context.Context, guid string) ([]dto.WiFiProfile, error)
+		ReorderWiFiProfiles(c context.Context, guid string, order dto.WiFiProfileOrder) ([]dto.WiFiProfile, error)
+		// Wi-Fi port settings (AMT_WiFiPortConfigurationService)
+		GetWiFiPortSettings(c context.Context, guid string) (dto.WiFiPortSettings, error)
+		SetWiFiPortSettings(c context.Context, guid string, req dto.WiFiPortSettingsRequest) (dto.WiFiPortSettings, error)
+		// Kerberos authentication (AMT_KerberosSettingData)
+		GetKerberosSettings(c context.Context, guid string) (dto.KerberosSettings, error)
+		SetKerberosSettings(c context.Context, guid string, req dto.KerberosSettingsRequest) (dto.KerberosSettings, error)
+		// Digest and Kerberos users (AMT_AuthorizationService)
+		GetDeviceUsers(c context.Context, guid string) ([]dto.DeviceUser, error)
+		AddDeviceUser(c context.Context, guid string, req dto.DeviceUserRequest) (dto.DeviceUser, error)
+		UpdateDeviceUser(c context.Context, guid string, handle int, req dto.DeviceUserRequest) (dto.DeviceUser, error)
+		RemoveDeviceUser(c context.Context, guid string, handle int) error
+		// WS-Man message capture
+		StartWsmanCapture(c context.Context, guid string, req dto.WsmanCaptureRequest) (dto.WsmanCapture, error)
+		StopWsmanCapture(c context.Context, guid string) (dto.WsmanCapture, error)
+		GetWsmanCaptureTrace(c context.Context, guid string) (dto.WsmanCapture, []byte, error)
 	}
 )
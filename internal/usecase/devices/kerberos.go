@@ -0,0 +1,150 @@
+package devices
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+const (
+	kerberosMasterKeyLength       = 16
+	kerberosDefaultClockTolerance = 5
+	kerberosDefaultIterationCount = 4096
+)
+
+// GetKerberosSettings returns the Kerberos authentication settings of a device.
+func (uc *UseCase) GetKerberosSettings(c context.Context, guid string) (dto.KerberosSettings, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.KerberosSettings{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.KerberosSettings{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.KerberosSettings{}, err
+	}
+
+	settings, err := device.GetKerberosSettingData(c)
+	if err != nil {
+		return dto.KerberosSettings{}, deviceError("GetKerberosSettingData", err)
+	}
+
+	return kerberosSettings(settings), nil
+}
+
+// SetKerberosSettings replaces the Kerberos authentication settings of a device. Enabling Kerberos needs a realm
+// and the key of the AMT service principal, given either as a master key or as a passphrase and salt.
+func (uc *UseCase) SetKerberosSettings(c context.Context, guid string, req dto.KerberosSettingsRequest) (dto.KerberosSettings, error) {
+	masterKey, err := validateKerberosSettings(req)
+	if err != nil {
+		return dto.KerberosSettings{}, err
+	}
+
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.KerberosSettings{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.KerberosSettings{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.KerberosSettings{}, err
+	}
+
+	settings, err := device.GetKerberosSettingData(c)
+	if err != nil {
+		return dto.KerberosSettings{}, deviceError("GetKerberosSettingData", err)
+	}
+
+	settings.KrbEnabled = req.Enabled
+	settings.RealmName = req.RealmName
+	settings.KeyVersion = req.KeyVersion
+	settings.EncryptionAlgorithm = kerberos.EncryptionAlgorithmRC4EncryptionAndHMACAuthentication
+	settings.MaximumClockTolerance = req.MaximumClockTolerance
+	settings.MasterKey = nil
+	settings.Passphrase = req.Passphrase
+	settings.Salt = req.Salt
+	settings.IterationCount = 0
+
+	if settings.MaximumClockTolerance == 0 {
+		settings.MaximumClockTolerance = kerberosDefaultClockTolerance
+	}
+
+	for _, b := range masterKey {
+		settings.MasterKey = append(settings.MasterKey, int(b))
+	}
+
+	if req.Passphrase != "" {
+		settings.IterationCount = req.IterationCount
+		if settings.IterationCount == 0 {
+			settings.IterationCount = kerberosDefaultIterationCount
+		}
+	}
+
+	settings, err = device.PutKerberosSettingData(c, settings)
+	if err != nil {
+		return dto.KerberosSettings{}, deviceError("PutKerberosSettingData", err)
+	}
+
+	return kerberosSettings(settings), nil
+}
+
+// validateKerberosSettings returns the decoded master key of the request.
+func validateKerberosSettings(req dto.KerberosSettingsRequest) ([]byte, error) {
+	var masterKey []byte
+
+	if req.MasterKey != "" {
+		var err error
+
+		masterKey, err = hex.DecodeString(req.MasterKey)
+		if err != nil || len(masterKey) != kerberosMasterKeyLength {
+			return nil, ErrValidationUseCase.Wrap("SetKerberosSettings", "validate master key", "masterKey must be 32 hexadecimal digits")
+		}
+	}
+
+	switch {
+	case req.Passphrase != "" && req.Salt == "":
+		return nil, ErrValidationUseCase.Wrap("SetKerberosSettings", "validate passphrase", "salt is required with passphrase")
+	case req.Salt != "" && req.Passphrase == "":
+		return nil, ErrValidationUseCase.Wrap("SetKerberosSettings", "validate passphrase", "passphrase is required with salt")
+	case !req.Enabled:
+		return masterKey, nil
+	case req.RealmName == "":
+		return nil, ErrValidationUseCase.Wrap("SetKerberosSettings", "validate realm", "realmName is required to enable Kerberos")
+	case masterKey == nil && req.Passphrase == "":
+		return nil, ErrValidationUseCase.Wrap("SetKerberosSettings", "validate key", "masterKey or passphrase is required to enable Kerberos")
+	}
+
+	return masterKey, nil
+}
+
+func kerberosSettings(settings kerberos.KerberosSettingDataResponse) dto.KerberosSettings {
+	result := dto.KerberosSettings{
+		Enabled:                        settings.KrbEnabled,
+		RealmName:                      settings.RealmName,
+		KeyVersion:                     settings.KeyVersion,
+		MaximumClockTolerance:          settings.MaximumClockTolerance,
+		SupportedEncryptionAlgorithms:  make([]string, 0, len(settings.SupportedEncryptionAlgorithms)),
+		ConfiguredEncryptionAlgorithms: make([]string, 0, len(settings.ConfiguredEncryptionAlgorithms)),
+	}
+
+	for _, a := range settings.SupportedEncryptionAlgorithms {
+		result.SupportedEncryptionAlgorithms = append(result.SupportedEncryptionAlgorithms, a.String())
+	}
+
+	for _, a := range settings.ConfiguredEncryptionAlgorithms {
+		result.ConfiguredEncryptionAlgorithms = append(result.ConfiguredEncryptionAlgorithms, a.String())
+	}
+
+	return result
+}
@@ -0,0 +1,133 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestSetKerberosSettings(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	current := kerberos.KerberosSettingDataResponse{
+		ElementName: "Intel(r) AMT Kerberos Settings", InstanceID: "Intel(r) AMT Kerberos Settings", MaximumClockTolerance: 5,
+		SupportedEncryptionAlgorithms: []kerberos.SupportedEncryptionAlgorithms{0, 1, 2},
+	}
+
+	tests := []struct {
+		name    string
+		request dto.KerberosSettingsRequest
+		put     kerberos.KerberosSettingDataResponse
+		res     dto.KerberosSettings
+		invalid bool
+		err     error
+	}{
+		{
+			name:    "enable with a master key",
+			request: dto.KerberosSettingsRequest{Enabled: true, RealmName: "CORP.EXAMPLE.COM", KeyVersion: 2, MasterKey: "00112233445566778899aabbccddeeff"},
+			put: kerberos.KerberosSettingDataResponse{
+				ElementName: current.ElementName, InstanceID: current.InstanceID, SupportedEncryptionAlgorithms: current.SupportedEncryptionAlgorithms,
+				KrbEnabled: true, RealmName: "CORP.EXAMPLE.COM", KeyVersion: 2, MaximumClockTolerance: 5,
+				MasterKey: []int{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+			},
+			res: dto.KerberosSettings{
+				Enabled: true, RealmName: "CORP.EXAMPLE.COM", KeyVersion: 2, MaximumClockTolerance: 5,
+				SupportedEncryptionAlgorithms:  []string{"RC4-HMAC", "AES128-CTS-HMAC-SHA1-96", "AES256-CTS-HMAC-SHA1-96"},
+				ConfiguredEncryptionAlgorithms: []string{"RC4-HMAC"},
+			},
+		},
+		{
+			name:    "enable with a passphrase",
+			request: dto.KerberosSettingsRequest{Enabled: true, RealmName: "CORP.EXAMPLE.COM", KeyVersion: 3, MaximumClockTolerance: 10, Passphrase: "P@ssw0rd", Salt: "CORP.EXAMPLE.COMamt"},
+			put: kerberos.KerberosSettingDataResponse{
+				ElementName: current.ElementName, InstanceID: current.InstanceID, SupportedEncryptionAlgorithms: current.SupportedEncryptionAlgorithms,
+				KrbEnabled: true, RealmName: "CORP.EXAMPLE.COM", KeyVersion: 3, MaximumClockTolerance: 10,
+				Passphrase: "P@ssw0rd", Salt: "CORP.EXAMPLE.COMamt", IterationCount: 4096,
+			},
+			res: dto.KerberosSettings{
+				Enabled: true, RealmName: "CORP.EXAMPLE.COM", KeyVersion: 3, MaximumClockTolerance: 10,
+				SupportedEncryptionAlgorithms:  []string{"RC4-HMAC", "AES128-CTS-HMAC-SHA1-96", "AES256-CTS-HMAC-SHA1-96"},
+				ConfiguredEncryptionAlgorithms: []string{"RC4-HMAC"},
+			},
+		},
+		{
+			name:    "enable without a key",
+			request: dto.KerberosSettingsRequest{Enabled: true, RealmName: "CORP.EXAMPLE.COM"},
+			invalid: true,
+		},
+		{
+			name:    "enable without a realm",
+			request: dto.KerberosSettingsRequest{Enabled: true, MasterKey: "00112233445566778899aabbccddeeff"},
+			invalid: true,
+		},
+		{
+			name:    "short master key",
+			request: dto.KerberosSettingsRequest{MasterKey: "0011"},
+			invalid: true,
+		},
+		{
+			name:    "passphrase without salt",
+			request: dto.KerberosSettingsRequest{Enabled: true, RealmName: "CORP.EXAMPLE.COM", Passphrase: "P@ssw0rd"},
+			invalid: true,
+		},
+		{
+			name:    "device refuses the settings",
+			request: dto.KerberosSettingsRequest{RealmName: "CORP.EXAMPLE.COM"},
+			put: kerberos.KerberosSettingDataResponse{
+				ElementName: current.ElementName, InstanceID: current.InstanceID, SupportedEncryptionAlgorithms: current.SupportedEncryptionAlgorithms,
+				RealmName: "CORP.EXAMPLE.COM", MaximumClockTolerance: 5,
+			},
+			err: ErrGeneral,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+			wsmanMock := mocks.NewMockWSMAN(mockCtl)
+			wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+			management := mocks.NewMockManagement(mockCtl)
+			useCase := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, nil)
+
+			if !tc.invalid {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+				wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(wsman.Management(management), nil)
+				management.EXPECT().GetKerberosSettingData(gomock.Any()).Return(current, nil)
+
+				updated := tc.put
+				updated.MasterKey, updated.Passphrase = nil, ""
+				updated.ConfiguredEncryptionAlgorithms = []kerberos.ConfiguredEncryptionAlgorithms{0}
+
+				management.EXPECT().PutKerberosSettingData(gomock.Any(), tc.put).Return(updated, tc.err)
+			}
+
+			res, err := useCase.SetKerberosSettings(context.Background(), device.GUID, tc.request)
+
+			switch {
+			case tc.invalid:
+				require.ErrorAs(t, err, &devices.ValidationError{})
+			case tc.err != nil:
+				require.ErrorIs(t, err, tc.err)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package devices
+
+import (
+	"bytes"
+	"encoding/binary"
+	"unicode/utf8"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+const (
+	// RFB messages of the viewer translated for the keyboard layout of the device.
+	rfbKeyEvent              = 4
+	rfbKeyEventLength        = 8
+	rfbClientCutText         = 6
+	rfbClientCutTextHeader   = 8
+	keysymReturn             = 0xff0d
+	keysymTab                = 0xff09
+	minPrintableKeysym       = 0x20
+	maxASCIIKeysym           = 0x7e
+	minLatin1PrintableKeysym = 0xa0
+	maxLatin1Keysym          = 0xff
+
+	// maxClipboardText is the number of characters of the clipboard typed into the device, the rest is dropped.
+	maxClipboardText = 1024
+)
+
+// usKeyboard are the characters of the keys of a US keyboard AMT translates keysyms with, row by row,
+// unshifted and then shifted.
+var usKeyboard = [...]string{
+	"`1234567890-=", "qwertyuiop[]\\", "asdfghjkl;'", "zxcvbnm,./",
+	"~!@#$%^&*()_+", "QWERTYUIOP{}|", "ASDFGHJKL:\"", "ZXCVBNM<>?",
+}
+
+// keyboardLayouts are the characters of the same keys in other layouts. Characters that need AltGr are missing.
+var keyboardLayouts = map[string][len(usKeyboard)]string{
+	dto.KeyboardLayoutGerman: {
+		"^1234567890ß´", "qwertzuiopü+#", "asdfghjklöä", "yxcvbnm,.-",
+		"°!\"§$%&/()=?`", "QWERTZUIOPÜ*'", "ASDFGHJKLÖÄ", "YXCVBNM;:_",
+	},
+	dto.KeyboardLayoutFrench: {
+		"²&é\"'(-è_çà)=", "azertyuiop^$*", "qsdfghjklmù", "wxcvbn,;:!",
+		"~1234567890°+", "AZERTYUIOP¨£µ", "QSDFGHJKLM%", "WXCVBN?./§",
+	},
+}
+
+// keyboardTranslations map the keysyms of each layout to the keysyms of the US keys at the same place.
+var keyboardTranslations = buildKeyboardTranslations()
+
+func buildKeyboardTranslations() map[string]map[uint32]uint32 {
+	translations := make(map[string]map[uint32]uint32, len(keyboardLayouts))
+
+	for layout, rows := range keyboardLayouts {
+		translation := make(map[uint32]uint32)
+
+		for i, row := range rows {
+			us := []rune(usKeyboard[i])
+
+			for j, r := range []rune(row) {
+				keysym := uint32(r) //nolint:gosec // characters of the layouts above are Latin-1
+				if _, ok := translation[keysym]; !ok && r != us[j] {
+					translation[keysym] = uint32(us[j]) //nolint:gosec // characters of a US keyboard are ASCII
+				}
+			}
+		}
+
+		translations[layout] = translation
+	}
+
+	return translations
+}
+
+// keyboardTranslation returns the translation of the keysyms of the viewer for a device with the keyboard layout,
+// nil for the US layout AMT expects.
+func keyboardTranslation(layout string) (map[uint32]uint32, error) {
+	if layout == "" || layout == dto.KeyboardLayoutUS {
+		return nil, nil
+	}
+
+	translation, ok := keyboardTranslations[layout]
+	if !ok {
+		return nil, ErrValidationUseCase.Wrap("Redirect", "validate keyboard layout", "keyboard layout is not supported")
+	}
+
+	return translation, nil
+}
+
+// setOptions applies the keyboard translation and clipboard option to the connection when it relays a KVM session.
+func (d *DeviceConnection) setOptions(keyboard map[uint32]uint32, clipboard bool) {
+	if d.Mode != RedirectionModeKVM {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.keyboard = keyboard
+	d.clipboard = clipboard
+}
+
+// translateBrowserData translates the key events of the viewer to the keyboard layout of the device and, when
+// the session allows it, types the clipboard text of the viewer into the device.
+func (d *DeviceConnection) translateBrowserData(msg []byte) []byte {
+	d.mu.RLock()
+	keyboard, clipboard := d.keyboard, d.clipboard
+	d.mu.RUnlock()
+
+	switch {
+	case len(msg) == rfbKeyEventLength && msg[0] == rfbKeyEvent && keyboard != nil:
+		keysym, ok := keyboard[binary.BigEndian.Uint32(msg[4:])]
+		if !ok {
+			return msg
+		}
+
+		out := bytes.Clone(msg)
+		binary.BigEndian.PutUint32(out[4:], keysym)
+
+		return out
+	case len(msg) >= rfbClientCutTextHeader && msg[0] == rfbClientCutText && clipboard:
+		length := binary.BigEndian.Uint32(msg[4:rfbClientCutTextHeader])
+		if uint64(len(msg)) != rfbClientCutTextHeader+uint64(length) {
+			return msg
+		}
+
+		return typeText(msg[rfbClientCutTextHeader:], keyboard)
+	}
+
+	return msg
+}
+
+// typeText turns the Latin-1 clipboard text of a ClientCutText message into key presses, characters that
+// cannot be typed are dropped.
+func typeText(text []byte, keyboard map[uint32]uint32) []byte {
+	// RFB sends the clipboard in Latin-1, some viewers send UTF-8 instead
+	runes := make([]rune, 0, len(text))
+	if utf8.Valid(text) {
+		runes = append(runes, []rune(string(text))...)
+	} else {
+		for _, b := range text {
+			runes = append(runes, rune(b))
+		}
+	}
+
+	out := make([]byte, 0, min(len(runes), maxClipboardText)*2*rfbKeyEventLength)
+
+	for _, r := range runes[:min(len(runes), maxClipboardText)] {
+		keysym, ok := textKeysym(r)
+		if !ok {
+			continue
+		}
+
+		if translated, ok := keyboard[keysym]; ok {
+			keysym = translated
+		}
+
+		out = append(out, rfbKeyEvent, 1, 0, 0)
+		out = binary.BigEndian.AppendUint32(out, keysym)
+		out = append(out, rfbKeyEvent, 0, 0, 0)
+		out = binary.BigEndian.AppendUint32(out, keysym)
+	}
+
+	return out
+}
+
+// textKeysym returns the keysym typing r, Latin-1 characters are their own keysyms.
+func textKeysym(r rune) (uint32, bool) {
+	switch {
+	case r == '\n':
+		return keysymReturn, true
+	case r == '\t':
+		return keysymTab, true
+	case r >= minPrintableKeysym && r <= maxASCIIKeysym, r >= minLatin1PrintableKeysym && r <= maxLatin1Keysym:
+		return uint32(r), true //nolint:gosec // r is Latin-1
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,110 @@
+package devices
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// keyEvent builds an RFB KeyEvent message of the viewer.
+func keyEvent(down bool, keysym uint32) []byte {
+	msg := []byte{rfbKeyEvent, 0, 0, 0}
+	if down {
+		msg[1] = 1
+	}
+
+	return binary.BigEndian.AppendUint32(msg, keysym)
+}
+
+// keyPresses are the key events typing keysyms.
+func keyPresses(keysyms ...uint32) []byte {
+	out := []byte{}
+
+	for _, keysym := range keysyms {
+		out = append(out, keyEvent(true, keysym)...)
+		out = append(out, keyEvent(false, keysym)...)
+	}
+
+	return out
+}
+
+// clientCutText builds an RFB ClientCutText message of the viewer.
+func clientCutText(text string) []byte {
+	msg := []byte{rfbClientCutText, 0, 0, 0}
+	msg = binary.BigEndian.AppendUint32(msg, uint32(len(text))) //nolint:gosec // short text in tests
+
+	return append(msg, text...)
+}
+
+func TestKeyboardTranslation(t *testing.T) {
+	t.Parallel()
+
+	for _, layout := range []string{"", dto.KeyboardLayoutUS} {
+		translation, err := keyboardTranslation(layout)
+		require.NoError(t, err)
+		require.Nil(t, translation, layout)
+	}
+
+	german, err := keyboardTranslation(dto.KeyboardLayoutGerman)
+	require.NoError(t, err)
+	require.Equal(t, uint32('y'), german['z'])
+	require.Equal(t, uint32('z'), german['y'])
+	require.Equal(t, uint32(';'), german['ö'])
+	require.Equal(t, uint32('@'), german['"'], "shifted keys map to shifted keys")
+	require.NotContains(t, german, uint32('a'), "keys at the same place are not translated")
+
+	french, err := keyboardTranslation(dto.KeyboardLayoutFrench)
+	require.NoError(t, err)
+	require.Equal(t, uint32('q'), french['a'])
+	require.Equal(t, uint32(';'), french['m'])
+	require.Equal(t, uint32('!'), french['1'])
+
+	_, err = keyboardTranslation("xx-XX")
+	require.Equal(t, ErrValidationUseCase.Wrap("Redirect", "validate keyboard layout", "keyboard layout is not supported"), err)
+}
+
+func TestTranslateBrowserData(t *testing.T) {
+	t.Parallel()
+
+	german, err := keyboardTranslation(dto.KeyboardLayoutGerman)
+	require.NoError(t, err)
+
+	conn := &DeviceConnection{Mode: RedirectionModeKVM}
+	conn.setOptions(german, false)
+
+	require.Equal(t, keyEvent(true, 'y'), conn.translateBrowserData(keyEvent(true, 'z')))
+	require.Equal(t, keyEvent(false, 'a'), conn.translateBrowserData(keyEvent(false, 'a')))
+	require.Equal(t, keyEvent(true, keysymReturn), conn.translateBrowserData(keyEvent(true, keysymReturn)))
+
+	// without the clipboard option the text is relayed as it is
+	require.Equal(t, clientCutText("zy"), conn.translateBrowserData(clientCutText("zy")))
+
+	conn.setOptions(german, true)
+	require.Equal(t, keyPresses('y', 'z', keysymReturn), conn.translateBrowserData(clientCutText("zy\r\n")))
+
+	// a message split over several websocket messages is relayed as it is
+	split := clientCutText("text")[:10]
+	require.Equal(t, split, conn.translateBrowserData(split))
+
+	sol := &DeviceConnection{Mode: "sol"}
+	sol.setOptions(german, true)
+	require.Equal(t, keyEvent(true, 'z'), sol.translateBrowserData(keyEvent(true, 'z')), "only KVM sessions are translated")
+}
+
+func TestTypeText(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, keyPresses('a', keysymTab, 0xe9), typeText([]byte("a\té"), nil), "UTF-8")
+	require.Equal(t, keyPresses('a', 0xe9), typeText([]byte{'a', 0xe9}, nil), "Latin-1")
+	require.Equal(t, keyPresses('a', 'b'), typeText([]byte("a€b"), nil), "characters outside Latin-1 are dropped")
+
+	long := make([]byte, maxClipboardText+10)
+	for i := range long {
+		long[i] = 'x'
+	}
+
+	require.Len(t, typeText(long, nil), maxClipboardText*2*rfbKeyEventLength)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/kvmredirection"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/screensetting"
 
 	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
@@ -27,46 +28,18 @@ func (uc *UseCase) GetKVMScreenSettings(c context.Context, guid string) (dto.KVM
 		return dto.KVMScreenSettings{}, err
 	}
 
-	resp, err := device.GetIPSScreenSettingData()
+	resp, err := device.GetIPSScreenSettingData(c)
 	if err != nil {
 		return dto.KVMScreenSettings{}, err
 	}
 
-	redirResp, err := device.GetIPSKVMRedirectionSettingData()
+	redirResp, err := device.GetIPSKVMRedirectionSettingData(c)
 	if err != nil {
 		return dto.KVMScreenSettings{}, err
 	}
 
 	defaultScreen := redirResp.Body.KVMRedirectionSettingsResponse.DefaultScreen
-
-	// Map raw ScreenSettingData response into a user-friendly displays array.
-	const defaultDisplayCapacity = 4
-
-	displays := make([]dto.KVMScreenDisplay, 0, defaultDisplayCapacity)
-	items := resp.Body.PullResponse.ScreenSettingDataItems
-
-	for i := range items { // avoid copying large struct values
-		it := &items[i]
-		// could be problematic if arrays are of different lengths from firmware, but as of now AMT provides 4 for each.
-		count := len(it.IsActive)
-		for idx := 0; idx < count; idx++ {
-			isActive := it.IsActive[idx]
-			// Role assignment reflects AMT firmware configuration, not current activity status
-			// An inactive display can still be designated as "primary" by the firmware
-			role := getRoleForIndex(idx, it.PrimaryIndex, it.SecondaryIndex, it.TertiaryIndex, it.QuadraryIndex)
-
-			displays = append(displays, dto.KVMScreenDisplay{
-				DisplayIndex: idx,
-				IsActive:     isActive,
-				UpperLeftX:   safeIndex(it.UpperLeftX, idx),
-				UpperLeftY:   safeIndex(it.UpperLeftY, idx),
-				ResolutionX:  safeIndex(it.ResolutionX, idx),
-				ResolutionY:  safeIndex(it.ResolutionY, idx),
-				Role:         role,
-				IsDefault:    idx == int(defaultScreen),
-			})
-		}
-	}
+	displays := kvmDisplays(resp.Body.PullResponse.ScreenSettingDataItems, int(defaultScreen))
 
 	return dto.KVMScreenSettings{Displays: displays}, nil
 }
@@ -88,7 +61,7 @@ func (uc *UseCase) SetKVMScreenSettings(c context.Context, guid string, reqData
 		return dto.KVMScreenSettings{}, err
 	}
 
-	pull, err := device.GetIPSKVMRedirectionSettingData()
+	pull, err := device.GetIPSKVMRedirectionSettingData(c)
 	if err != nil {
 		return dto.KVMScreenSettings{}, err
 	}
@@ -118,15 +91,51 @@ func (uc *UseCase) SetKVMScreenSettings(c context.Context, guid string, reqData
 		BackToBackFbMode:               redirectionPull[0].BackToBackFbMode,
 	}
 
-	_, err = device.SetIPSKVMRedirectionSettingData(kvmRequest)
+	_, err = device.SetIPSKVMRedirectionSettingData(c, kvmRequest)
 	if err != nil {
 		return dto.KVMScreenSettings{}, err
 	}
 
+	if int(redirectionPull[0].DefaultScreen) != reqData.DisplayIndex {
+		uc.restartKVMSessions(item.GUID)
+	}
+
 	// Read-only for now
 	return uc.GetKVMScreenSettings(c, guid)
 }
 
+// kvmDisplays maps raw ScreenSettingData items into a user-friendly displays array.
+func kvmDisplays(items []screensetting.ScreenSettingDataResponse, defaultScreen int) []dto.KVMScreenDisplay {
+	const defaultDisplayCapacity = 4
+
+	displays := make([]dto.KVMScreenDisplay, 0, defaultDisplayCapacity)
+
+	for i := range items { // avoid copying large struct values
+		it := &items[i]
+		// could be problematic if arrays are of different lengths from firmware, but as of now AMT provides 4 for each.
+		count := len(it.IsActive)
+		for idx := 0; idx < count; idx++ {
+			isActive := it.IsActive[idx]
+			// Role assignment reflects AMT firmware configuration, not current activity status
+			// An inactive display can still be designated as "primary" by the firmware
+			role := getRoleForIndex(idx, it.PrimaryIndex, it.SecondaryIndex, it.TertiaryIndex, it.QuadraryIndex)
+
+			displays = append(displays, dto.KVMScreenDisplay{
+				DisplayIndex: idx,
+				IsActive:     isActive,
+				UpperLeftX:   safeIndex(it.UpperLeftX, idx),
+				UpperLeftY:   safeIndex(it.UpperLeftY, idx),
+				ResolutionX:  safeIndex(it.ResolutionX, idx),
+				ResolutionY:  safeIndex(it.ResolutionY, idx),
+				Role:         role,
+				IsDefault:    idx == defaultScreen,
+			})
+		}
+	}
+
+	return displays
+}
+
 // Helper functions.
 func safeIndex(a []int, i int) int {
 	if i < len(a) {
@@ -30,7 +30,7 @@ func initKVMScreenTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *mocks
 
 	management := mocks.NewMockManagement(mockCtl)
 	log := logger.New("error")
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, wsmanMock, management, repo
 }
@@ -43,9 +43,9 @@ func TestGetKVMScreenSettings(t *testing.T) {
 	repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
 	wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
 	// Respond with a minimal struct; not validating shape here
-	management.EXPECT().GetIPSScreenSettingData().Return(screensetting.Response{}, nil)
+	management.EXPECT().GetIPSScreenSettingData(gomock.Any()).Return(screensetting.Response{}, nil)
 	// Implementation also reads KVM redirection settings to determine default screen
-	management.EXPECT().GetIPSKVMRedirectionSettingData().Return(kvmredirection.Response{}, nil)
+	management.EXPECT().GetIPSKVMRedirectionSettingData(gomock.Any()).Return(kvmredirection.Response{}, nil)
 
 	res, err := useCase.GetKVMScreenSettings(context.Background(), device.GUID)
 	require.NoError(t, err)
@@ -69,15 +69,15 @@ func TestSetKVMScreenSettings_Success(t *testing.T) {
 			DefaultScreen: 0,
 		},
 	}
-	management.EXPECT().GetIPSKVMRedirectionSettingData().Return(kvmResp, nil)
-	management.EXPECT().SetIPSKVMRedirectionSettingData(gomock.Any()).Return(kvmredirection.Response{}, nil)
+	management.EXPECT().GetIPSKVMRedirectionSettingData(gomock.Any()).Return(kvmResp, nil)
+	management.EXPECT().SetIPSKVMRedirectionSettingData(gomock.Any(), gomock.Any()).Return(kvmredirection.Response{}, nil)
 
 	// Mock the subsequent call to GetKVMScreenSettings
 	repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
 	wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
 	// GetKVMScreenSettings will call both ScreenSettingData and KVMRedirectionSettingData
-	management.EXPECT().GetIPSScreenSettingData().Return(screensetting.Response{}, nil)
-	management.EXPECT().GetIPSKVMRedirectionSettingData().Return(kvmredirection.Response{}, nil)
+	management.EXPECT().GetIPSScreenSettingData(gomock.Any()).Return(screensetting.Response{}, nil)
+	management.EXPECT().GetIPSKVMRedirectionSettingData(gomock.Any()).Return(kvmredirection.Response{}, nil)
 
 	res, err := useCase.SetKVMScreenSettings(context.Background(), device.GUID, dto.KVMScreenSettingsRequest{DisplayIndex: 1})
 	require.NoError(t, err)
@@ -106,9 +106,9 @@ func TestGetKVMScreenSettings_DisplaysMapping(t *testing.T) {
 			ResolutionY:    []int{1080, 1080, 1080, 1080},
 		},
 	}
-	management.EXPECT().GetIPSScreenSettingData().Return(resp, nil)
+	management.EXPECT().GetIPSScreenSettingData(gomock.Any()).Return(resp, nil)
 	// Also expected by implementation to annotate default screen
-	management.EXPECT().GetIPSKVMRedirectionSettingData().Return(kvmredirection.Response{}, nil)
+	management.EXPECT().GetIPSKVMRedirectionSettingData(gomock.Any()).Return(kvmredirection.Response{}, nil)
 
 	res, err := useCase.GetKVMScreenSettings(context.Background(), device.GUID)
 	require.NoError(t, err)
@@ -147,9 +147,9 @@ func TestGetKVMScreenSettings_RoleAssignmentOnlyForActiveDisplays(t *testing.T)
 			ResolutionY:    []int{1600, 1600, 0, 0},
 		},
 	}
-	management.EXPECT().GetIPSScreenSettingData().Return(resp, nil)
+	management.EXPECT().GetIPSScreenSettingData(gomock.Any()).Return(resp, nil)
 	// Also expected by implementation to annotate default screen
-	management.EXPECT().GetIPSKVMRedirectionSettingData().Return(kvmredirection.Response{}, nil)
+	management.EXPECT().GetIPSKVMRedirectionSettingData(gomock.Any()).Return(kvmredirection.Response{}, nil)
 
 	res, err := useCase.GetKVMScreenSettings(context.Background(), device.GUID)
 	require.NoError(t, err)
@@ -209,7 +209,7 @@ func TestGetKVMScreenSettings_ErrorCases(t *testing.T) {
 		useCase, wsmanMock, management, repo := initKVMScreenTest(t)
 		repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
 		wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
-		management.EXPECT().GetIPSScreenSettingData().Return(screensetting.Response{}, errors.New("wsman error"))
+		management.EXPECT().GetIPSScreenSettingData(gomock.Any()).Return(screensetting.Response{}, errors.New("wsman error"))
 
 		_, err := useCase.GetKVMScreenSettings(context.Background(), device.GUID)
 		require.Error(t, err)
@@ -247,7 +247,7 @@ func TestSetKVMScreenSettings_ErrorCases(t *testing.T) {
 		useCase, wsmanMock, management, repo := initKVMScreenTest(t)
 		repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
 		wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
-		management.EXPECT().GetIPSKVMRedirectionSettingData().Return(kvmredirection.Response{}, errors.New("redirection error"))
+		management.EXPECT().GetIPSKVMRedirectionSettingData(gomock.Any()).Return(kvmredirection.Response{}, errors.New("redirection error"))
 
 		_, err := useCase.SetKVMScreenSettings(context.Background(), device.GUID, dto.KVMScreenSettingsRequest{})
 		require.Error(t, err)
@@ -264,8 +264,8 @@ func TestSetKVMScreenSettings_ErrorCases(t *testing.T) {
 
 		kvmResp := kvmredirection.Response{}
 		kvmResp.Body.PullResponse.KVMRedirectionSettingsItems = []kvmredirection.KVMRedirectionSettingsResponse{{}}
-		management.EXPECT().GetIPSKVMRedirectionSettingData().Return(kvmResp, nil)
-		management.EXPECT().SetIPSKVMRedirectionSettingData(gomock.Any()).Return(kvmredirection.Response{}, errors.New("set error"))
+		management.EXPECT().GetIPSKVMRedirectionSettingData(gomock.Any()).Return(kvmResp, nil)
+		management.EXPECT().SetIPSKVMRedirectionSettingData(gomock.Any(), gomock.Any()).Return(kvmredirection.Response{}, errors.New("set error"))
 
 		_, err := useCase.SetKVMScreenSettings(context.Background(), device.GUID, dto.KVMScreenSettingsRequest{})
 		require.Error(t, err)
@@ -0,0 +1,208 @@
+package devices
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+const (
+	// RFB messages of the viewer relayed once a KVM session is authenticated.
+	rfbSetEncodings                   = 2
+	rfbFramebufferUpdateRequest       = 3
+	rfbFramebufferUpdateRequestLength = 10
+	rfbSetEncodingsHeaderLength       = 4
+	rfbEncodingLength                 = 4
+	// rfbQualityLevel0 is the pseudo-encoding asking for the lowest JPEG quality, rfbQualityLevel9 for the highest.
+	rfbQualityLevel0 = -32
+	rfbQualityLevel9 = -23
+
+	maxKVMQuality   = 10
+	maxKVMFrameRate = 60
+	minKVMBandwidth = 1024
+)
+
+// GetKVMLimits returns the limits applied to new KVM sessions.
+func (uc *UseCase) GetKVMLimits(_ context.Context) dto.KVMLimits {
+	uc.kvmMutex.RLock()
+	defer uc.kvmMutex.RUnlock()
+
+	return uc.kvmLimits
+}
+
+// SetKVMLimits replaces the limits applied to new KVM sessions, open sessions keep theirs.
+func (uc *UseCase) SetKVMLimits(_ context.Context, limits dto.KVMLimits) (dto.KVMLimits, error) {
+	if err := validateKVMLimits("SetKVMLimits", limits); err != nil {
+		return dto.KVMLimits{}, err
+	}
+
+	uc.kvmMutex.Lock()
+	uc.kvmLimits = limits
+	uc.kvmMutex.Unlock()
+
+	return limits, nil
+}
+
+// sessionKVMLimits are the limits of a new KVM session, the limits set in override take the place of the global ones.
+func (uc *UseCase) sessionKVMLimits(override dto.KVMLimits) (dto.KVMLimits, error) {
+	if err := validateKVMLimits("Redirect", override); err != nil {
+		return dto.KVMLimits{}, err
+	}
+
+	limits := uc.GetKVMLimits(context.Background())
+
+	if override.Quality > 0 {
+		limits.Quality = override.Quality
+	}
+
+	if override.FrameRate > 0 {
+		limits.FrameRate = override.FrameRate
+	}
+
+	if override.Bandwidth > 0 {
+		limits.Bandwidth = override.Bandwidth
+	}
+
+	return limits, nil
+}
+
+func validateKVMLimits(fn string, limits dto.KVMLimits) error {
+	switch {
+	case limits.Quality < 0 || limits.Quality > maxKVMQuality:
+		return ErrValidationUseCase.Wrap(fn, "validate quality", "quality must be between 1 and 10, or 0 to leave it to the viewer")
+	case limits.FrameRate < 0 || limits.FrameRate > maxKVMFrameRate:
+		return ErrValidationUseCase.Wrap(fn, "validate frame rate", "frame rate must be between 1 and 60, or 0 for no cap")
+	case limits.Bandwidth != 0 && limits.Bandwidth < minKVMBandwidth:
+		return ErrValidationUseCase.Wrap(fn, "validate bandwidth", "bandwidth must be at least 1024 bytes per second, or 0 for no cap")
+	}
+
+	return nil
+}
+
+// setLimits applies limits to the connection when it relays a KVM session, SOL and IDER are not limited.
+func (d *DeviceConnection) setLimits(limits dto.KVMLimits) {
+	if d.Mode != RedirectionModeKVM {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.limits = limits
+	d.bandwidth = nil
+
+	if limits.Bandwidth > 0 {
+		// a burst of one second of data keeps the screen updates of slow links in one piece
+		d.bandwidth = rate.NewLimiter(rate.Limit(limits.Bandwidth), limits.Bandwidth)
+	}
+}
+
+// waitBandwidth holds back n bytes for the viewer until the bandwidth limit of the session lets them through.
+func (d *DeviceConnection) waitBandwidth(n int) error {
+	d.mu.RLock()
+	limiter := d.bandwidth
+	d.mu.RUnlock()
+
+	if limiter == nil {
+		return nil
+	}
+
+	for n > 0 {
+		chunk := min(n, limiter.Burst())
+		if err := limiter.WaitN(d.ctx, chunk); err != nil {
+			return err
+		}
+
+		n -= chunk
+	}
+
+	return nil
+}
+
+// limitBrowserData applies the quality and frame rate limits of the session to an RFB message of the viewer.
+// The quality replaces the one of SetEncodings and screen update requests are held back to the frame rate.
+// Messages split over several websocket messages, or sharing one, are relayed as they are.
+func (d *DeviceConnection) limitBrowserData(msg []byte) ([]byte, error) {
+	d.mu.RLock()
+	limits := d.limits
+	d.mu.RUnlock()
+
+	if len(msg) == 0 {
+		return msg, nil
+	}
+
+	switch {
+	case msg[0] == rfbSetEncodings && limits.Quality > 0:
+		return withQuality(msg, limits.Quality), nil
+	case msg[0] == rfbFramebufferUpdateRequest && len(msg) == rfbFramebufferUpdateRequestLength && limits.FrameRate > 0:
+		return msg, d.waitFrame(limits.FrameRate)
+	}
+
+	return msg, nil
+}
+
+// waitFrame waits until a frame of frameRate has passed since the last screen update request of the viewer,
+// only ListenToBrowser calls it.
+func (d *DeviceConnection) waitFrame(frameRate int) error {
+	next := d.lastUpdateRequest.Add(time.Second / time.Duration(frameRate))
+
+	if wait := time.Until(next); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-d.ctx.Done():
+			return d.ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	d.lastUpdateRequest = time.Now()
+
+	return nil
+}
+
+// withQuality replaces the JPEG quality pseudo-encodings of an RFB SetEncodings message with the one of quality.
+func withQuality(msg []byte, quality int) []byte {
+	if len(msg) < rfbSetEncodingsHeaderLength {
+		return msg
+	}
+
+	count := int(binary.BigEndian.Uint16(msg[2:4]))
+	if len(msg) != rfbSetEncodingsHeaderLength+count*rfbEncodingLength {
+		return msg
+	}
+
+	encodings := make([]uint32, 0, count+1)
+
+	for i := range count {
+		encoding := binary.BigEndian.Uint32(msg[rfbSetEncodingsHeaderLength+i*rfbEncodingLength:])
+		if level := int32(encoding); level >= rfbQualityLevel0 && level <= rfbQualityLevel9 { //nolint:gosec // encodings are signed on the wire
+			continue
+		}
+
+		encodings = append(encodings, encoding)
+	}
+
+	if len(encodings) >= math.MaxUint16 {
+		return msg
+	}
+
+	level := int32(rfbQualityLevel0 + quality - 1) //nolint:gosec // quality is checked by validateKVMLimits
+	encodings = append(encodings, uint32(level))   //nolint:gosec // encodings are signed on the wire
+
+	out := make([]byte, rfbSetEncodingsHeaderLength, rfbSetEncodingsHeaderLength+len(encodings)*rfbEncodingLength)
+	out[0] = rfbSetEncodings
+	binary.BigEndian.PutUint16(out[2:4], uint16(len(encodings))) //nolint:gosec // length is checked above
+
+	for _, encoding := range encodings {
+		out = binary.BigEndian.AppendUint32(out, encoding)
+	}
+
+	return out
+}
@@ -0,0 +1,163 @@
+package devices
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// setEncodings builds an RFB SetEncodings message of the viewer.
+func setEncodings(encodings ...int32) []byte {
+	msg := []byte{rfbSetEncodings, 0, 0, 0}
+	binary.BigEndian.PutUint16(msg[2:4], uint16(len(encodings))) //nolint:gosec // few encodings in tests
+
+	for _, encoding := range encodings {
+		msg = binary.BigEndian.AppendUint32(msg, uint32(encoding)) //nolint:gosec // encodings are signed on the wire
+	}
+
+	return msg
+}
+
+func TestSessionKVMLimits(t *testing.T) {
+	t.Parallel()
+
+	uc := &UseCase{}
+
+	_, err := uc.SetKVMLimits(context.Background(), dto.KVMLimits{Quality: 5, FrameRate: 10, Bandwidth: 262144})
+	require.NoError(t, err)
+
+	limits, err := uc.sessionKVMLimits(dto.KVMLimits{FrameRate: 30})
+	require.NoError(t, err)
+	require.Equal(t, dto.KVMLimits{Quality: 5, FrameRate: 30, Bandwidth: 262144}, limits)
+
+	limits, err = uc.sessionKVMLimits(dto.KVMLimits{})
+	require.NoError(t, err)
+	require.Equal(t, uc.GetKVMLimits(context.Background()), limits)
+
+	_, err = uc.sessionKVMLimits(dto.KVMLimits{Quality: 11})
+	require.Equal(t, ErrValidationUseCase.Wrap("Redirect", "validate quality", "quality must be between 1 and 10, or 0 to leave it to the viewer"), err)
+}
+
+func TestSetKVMLimitsValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		limits dto.KVMLimits
+		err    error
+	}{
+		{
+			name:   "no limits",
+			limits: dto.KVMLimits{},
+		},
+		{
+			name:   "quality out of range",
+			limits: dto.KVMLimits{Quality: -1},
+			err:    ErrValidationUseCase.Wrap("SetKVMLimits", "validate quality", "quality must be between 1 and 10, or 0 to leave it to the viewer"),
+		},
+		{
+			name:   "frame rate out of range",
+			limits: dto.KVMLimits{FrameRate: 61},
+			err:    ErrValidationUseCase.Wrap("SetKVMLimits", "validate frame rate", "frame rate must be between 1 and 60, or 0 for no cap"),
+		},
+		{
+			name:   "bandwidth too low",
+			limits: dto.KVMLimits{Bandwidth: 1023},
+			err:    ErrValidationUseCase.Wrap("SetKVMLimits", "validate bandwidth", "bandwidth must be at least 1024 bytes per second, or 0 for no cap"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := &UseCase{kvmLimits: dto.KVMLimits{Quality: 3}}
+
+			_, err := uc.SetKVMLimits(context.Background(), tc.limits)
+			require.Equal(t, tc.err, err)
+
+			if tc.err != nil {
+				require.Equal(t, dto.KVMLimits{Quality: 3}, uc.GetKVMLimits(context.Background()), "limits are kept")
+			}
+		})
+	}
+}
+
+func TestWithQuality(t *testing.T) {
+	t.Parallel()
+
+	// the quality levels of the viewer are replaced, its other encodings are kept in order
+	require.Equal(t, setEncodings(16, 0, -223, rfbQualityLevel0+2), withQuality(setEncodings(16, -25, 0, -223, rfbQualityLevel0), 3))
+	require.Equal(t, setEncodings(0, rfbQualityLevel9), withQuality(setEncodings(0), 10))
+
+	// a message that does not hold exactly its encodings is relayed as it is
+	short := setEncodings(16, 0)[:8]
+	require.Equal(t, short, withQuality(short, 3))
+	require.Equal(t, []byte{rfbSetEncodings, 0}, withQuality([]byte{rfbSetEncodings, 0}, 3))
+}
+
+func TestLimitBrowserData(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	conn := &DeviceConnection{Mode: RedirectionModeKVM, ctx: ctx}
+	conn.setLimits(dto.KVMLimits{Quality: 1, FrameRate: 20})
+
+	msg, err := conn.limitBrowserData(setEncodings(16))
+	require.NoError(t, err)
+	require.Equal(t, setEncodings(16, rfbQualityLevel0), msg)
+
+	request := []byte{rfbFramebufferUpdateRequest, 1, 0, 0, 0, 0, 4, 0, 3, 0}
+
+	start := time.Now()
+
+	for range 3 {
+		msg, err = conn.limitBrowserData(request)
+		require.NoError(t, err)
+		require.Equal(t, request, msg)
+	}
+
+	// the first request goes through at once, the others wait a frame of 50ms each
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	// other messages are not held back
+	keys := []byte{4, 1, 0, 0, 0, 0, 0, 0x61}
+	msg, err = conn.limitBrowserData(keys)
+	require.NoError(t, err)
+	require.Equal(t, keys, msg)
+
+	cancel()
+
+	_, err = conn.limitBrowserData(request)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestSetLimits(t *testing.T) {
+	t.Parallel()
+
+	sol := &DeviceConnection{Mode: "sol"}
+	sol.setLimits(dto.KVMLimits{Quality: 1, Bandwidth: 2048})
+	require.Equal(t, dto.KVMLimits{}, sol.limits, "only KVM sessions are limited")
+	require.Nil(t, sol.bandwidth)
+
+	kvm := &DeviceConnection{Mode: RedirectionModeKVM, ctx: context.Background()}
+	kvm.setLimits(dto.KVMLimits{Bandwidth: 2048})
+	require.NotNil(t, kvm.bandwidth)
+
+	// the first second of data goes through at once, the rest at the bandwidth
+	start := time.Now()
+
+	require.NoError(t, kvm.waitBandwidth(2048))
+	require.Less(t, time.Since(start), 250*time.Millisecond)
+	require.NoError(t, kvm.waitBandwidth(512))
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+	require.NoError(t, sol.waitBandwidth(1<<20), "no limit")
+}
@@ -2,10 +2,32 @@ package devices
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ethernetport"
 
 	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
 )
 
+// ErrLinkSettingsNotApplied is returned when the settings read back from the device differ from the ones set.
+var ErrLinkSettingsNotApplied = errors.New("the device did not apply the link settings")
+
+// linkPortIDs are the InstanceIDs of the AMT_EthernetPortSettings of the network interfaces.
+var linkPortIDs = map[string]string{
+	wsman.InterfaceWired:    "Intel(r) AMT Ethernet Port Settings 0",
+	wsman.InterfaceWireless: "Intel(r) AMT Ethernet Port Settings 1",
+}
+
+// linkPolicies are the bits of the link policy mask, in the order AMT lists them.
+var linkPolicies = []ethernetport.LinkPolicy{
+	ethernetport.LinkPolicyS0AC, ethernetport.LinkPolicySxAC, ethernetport.LinkPolicyS0DC, ethernetport.LinkPolicySxDC,
+}
+
 // SetLinkPreference sets the link preference (ME or Host) on a device's WiFi interface.
 func (uc *UseCase) SetLinkPreference(c context.Context, guid string, req dto.LinkPreferenceRequest) (dto.LinkPreferenceResponse, error) {
 	item, err := uc.repo.GetByID(c, guid, "")
@@ -34,10 +56,212 @@ func (uc *UseCase) SetLinkPreference(c context.Context, guid string, req dto.Lin
 		return dto.LinkPreferenceResponse{}, err
 	}
 
-	returnValue, err := device.SetLinkPreference(req.LinkPreference, req.Timeout)
+	returnValue, err := device.SetLinkPreference(c, req.LinkPreference, req.Timeout)
 	if err != nil {
 		return dto.LinkPreferenceResponse{ReturnValue: returnValue}, err
 	}
 
 	return dto.LinkPreferenceResponse{ReturnValue: returnValue}, nil
 }
+
+// GetLinkSettings returns the link policy of the network interfaces of a device and the link preference of its
+// wireless interface.
+func (uc *UseCase) GetLinkSettings(c context.Context, guid string) ([]dto.LinkSettings, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return nil, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := device.GetEthernetPorts(c)
+	if err != nil {
+		return nil, deviceError("GetEthernetPorts", err)
+	}
+
+	settings := make([]dto.LinkSettings, 0, len(linkPortIDs))
+
+	for _, iface := range wsman.NetworkInterfaces {
+		if port, ok := linkPort(ports, iface); ok {
+			settings = append(settings, linkSettings(iface, port))
+		}
+	}
+
+	return settings, nil
+}
+
+// SetLinkSettings changes the link policy of a network interface of a device and, for the wireless interface,
+// its link preference. A link given to ME returns to the host after req.Timeout seconds, the response tells when.
+// The settings are read back from the device once set: an AMTError wrapping ErrLinkSettingsNotApplied is returned
+// when they differ.
+func (uc *UseCase) SetLinkSettings(c context.Context, guid string, req dto.LinkSettingsRequest) (dto.LinkSettings, error) {
+	if err := validateLinkSettings(req); err != nil {
+		return dto.LinkSettings{}, err
+	}
+
+	var policy []ethernetport.LinkPolicy
+
+	if req.LinkPolicy != nil {
+		var err error
+
+		policy, err = linkPolicyOf(*req.LinkPolicy)
+		if err != nil {
+			return dto.LinkSettings{}, err
+		}
+	}
+
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.LinkSettings{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.LinkSettings{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.LinkSettings{}, err
+	}
+
+	ports, err := device.GetEthernetPorts(c)
+	if err != nil {
+		return dto.LinkSettings{}, deviceError("GetEthernetPorts", err)
+	}
+
+	port, ok := linkPort(ports, req.Interface)
+	if !ok {
+		return dto.LinkSettings{}, ErrNotSupportedUseCase.Wrap("SetLinkSettings", "device.GetEthernetPorts", "device has no "+req.Interface+" interface")
+	}
+
+	if req.LinkPolicy != nil {
+		if err := device.SetLinkPolicy(c, port.InstanceID, policy); err != nil {
+			return dto.LinkSettings{}, deviceError("SetLinkPolicy", err)
+		}
+	}
+
+	var revertsAt *time.Time
+
+	if req.LinkPreference != 0 {
+		returnValue, err := device.SetLinkPreference(c, req.LinkPreference, req.Timeout)
+		if err != nil {
+			return dto.LinkSettings{}, deviceError("SetLinkPreference", err)
+		}
+
+		if returnValue != 0 {
+			return dto.LinkSettings{}, amtstatus.Error{Operation: "SetLinkPreference", Explanation: amtstatus.Explain(returnValue)}
+		}
+
+		if req.LinkPreference == dto.LinkPreferenceME {
+			at := time.Now().Add(time.Duration(req.Timeout) * time.Second).UTC()
+			revertsAt = &at
+		}
+	}
+
+	ports, err = device.GetEthernetPorts(c)
+	if err != nil {
+		return dto.LinkSettings{}, deviceError("GetEthernetPorts", err)
+	}
+
+	port, _ = linkPort(ports, req.Interface)
+	settings := linkSettings(req.Interface, port)
+
+	if req.LinkPolicy != nil && settings.LinkPolicy != *req.LinkPolicy {
+		return dto.LinkSettings{}, linkSettingsNotApplied(fmt.Sprintf("link policy is %d instead of %d", settings.LinkPolicy, *req.LinkPolicy))
+	}
+
+	if req.LinkPreference != 0 && settings.LinkPreference != int(req.LinkPreference) {
+		return dto.LinkSettings{}, linkSettingsNotApplied(fmt.Sprintf("link preference is %d instead of %d", settings.LinkPreference, req.LinkPreference))
+	}
+
+	settings.LinkPreferenceRevertsAt = revertsAt
+
+	return settings, nil
+}
+
+// linkSettingsNotApplied returns an AMTError telling the caller which setting the device did not apply.
+func linkSettingsNotApplied(detail string) error {
+	err := ErrAMT
+	_ = err.Console.Wrap("SetLinkSettings", "verify link settings", fmt.Errorf("%w: %s", ErrLinkSettingsNotApplied, detail))
+	err.Console.Message = ErrLinkSettingsNotApplied.Error() + ": " + detail
+
+	return err
+}
+
+func validateLinkSettings(req dto.LinkSettingsRequest) error {
+	const maxTimeout = 65535
+
+	switch {
+	case linkPortIDs[req.Interface] == "":
+		return ErrValidationUseCase.Wrap("SetLinkSettings", "validate interface", "interface must be wired or wireless")
+	case req.LinkPolicy == nil && req.LinkPreference == 0:
+		return ErrValidationUseCase.Wrap("SetLinkSettings", "validate link settings", "linkPolicy or linkPreference is required")
+	case req.LinkPreference != 0 && req.Interface != wsman.InterfaceWireless:
+		return ErrValidationUseCase.Wrap("SetLinkSettings", "validate link preference", "linkPreference can only be set on the wireless interface")
+	case req.LinkPreference != 0 && req.LinkPreference != dto.LinkPreferenceME && req.LinkPreference != dto.LinkPreferenceHost:
+		return ErrValidationUseCase.Wrap("SetLinkSettings", "validate link preference", "linkPreference must be 1 (ME) or 2 (Host)")
+	case req.LinkPreference == dto.LinkPreferenceME && req.Timeout == 0:
+		// without a timeout the link would never return to the host
+		return ErrValidationUseCase.Wrap("SetLinkSettings", "validate timeout", "timeout is required when the link is given to ME")
+	case req.Timeout > maxTimeout:
+		return ErrValidationUseCase.Wrap("SetLinkSettings", "validate timeout", "timeout max value is 65535")
+	}
+
+	return nil
+}
+
+// linkPolicyOf splits a link policy mask into the link policies AMT expects.
+func linkPolicyOf(mask int) ([]ethernetport.LinkPolicy, error) {
+	var policy []ethernetport.LinkPolicy
+
+	for _, p := range linkPolicies {
+		switch mask & int(p) {
+		case int(p):
+			policy = append(policy, p)
+		case 0:
+		default:
+			return nil, ErrValidationUseCase.Wrap("SetLinkSettings", "validate link policy", fmt.Sprintf("linkPolicy %d sets part of %s (%d)", mask, p.String(), p))
+		}
+
+		mask &^= int(p)
+	}
+
+	// AMT keeps its policy when given none
+	if mask != 0 || len(policy) == 0 {
+		return nil, ErrValidationUseCase.Wrap("SetLinkSettings", "validate link policy", "linkPolicy must be a sum of 1 (S0 AC), 14 (Sx AC), 16 (S0 DC) and 224 (Sx DC)")
+	}
+
+	return policy, nil
+}
+
+func linkPort(ports []ethernetport.SettingsResponse, iface string) (ethernetport.SettingsResponse, bool) {
+	i := slices.IndexFunc(ports, func(p ethernetport.SettingsResponse) bool { return p.InstanceID == linkPortIDs[iface] })
+	if i < 0 {
+		return ethernetport.SettingsResponse{}, false
+	}
+
+	return ports[i], true
+}
+
+func linkSettings(iface string, port ethernetport.SettingsResponse) dto.LinkSettings {
+	settings := dto.LinkSettings{Interface: iface, InstanceID: port.InstanceID}
+
+	for _, p := range port.LinkPolicy {
+		settings.LinkPolicy |= int(p)
+	}
+
+	// only the wireless interface can be handed over between ME and the host
+	if iface == wsman.InterfaceWireless {
+		settings.LinkPreference = int(port.LinkPreference)
+		settings.LinkControl = int(port.LinkControl)
+	}
+
+	return settings
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ethernetport"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
@@ -14,6 +16,7 @@ import (
 	"github.com/device-management-toolkit/console/internal/mocks"
 	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
 	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -29,7 +32,7 @@ func initLinkPreferenceTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *
 
 	management := mocks.NewMockManagement(mockCtl)
 	log := logger.New("error")
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, wsmanMock, management, repo
 }
@@ -63,7 +66,7 @@ func TestSetLinkPreference(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(wsman.Management(man2), nil)
 				man2.EXPECT().
-					SetLinkPreference(uint32(1), uint32(300)).
+					SetLinkPreference(gomock.Any(), uint32(1), uint32(300)).
 					Return(0, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -85,7 +88,7 @@ func TestSetLinkPreference(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(wsman.Management(man2), nil)
 				man2.EXPECT().
-					SetLinkPreference(uint32(2), uint32(60)).
+					SetLinkPreference(gomock.Any(), uint32(2), uint32(60)).
 					Return(0, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -116,7 +119,7 @@ func TestSetLinkPreference(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					SetLinkPreference(uint32(1), uint32(300)).
+					SetLinkPreference(gomock.Any(), uint32(1), uint32(300)).
 					Return(-1, wsman.ErrNoWiFiPort)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -135,7 +138,7 @@ func TestSetLinkPreference(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					SetLinkPreference(uint32(1), uint32(300)).
+					SetLinkPreference(gomock.Any(), uint32(1), uint32(300)).
 					Return(5, errors.New("invalid parameter"))
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -154,7 +157,7 @@ func TestSetLinkPreference(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					SetLinkPreference(uint32(1), uint32(300)).
+					SetLinkPreference(gomock.Any(), uint32(1), uint32(300)).
 					Return(0, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -220,3 +223,132 @@ func TestSetLinkPreference(t *testing.T) {
 		})
 	}
 }
+
+func TestSetLinkSettings(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	const wirelessID = "Intel(r) AMT Ethernet Port Settings 1"
+
+	wired := ethernetport.SettingsResponse{InstanceID: "Intel(r) AMT Ethernet Port Settings 0", LinkPolicy: []ethernetport.LinkPolicy{1, 14, 16}}
+	wireless := ethernetport.SettingsResponse{InstanceID: wirelessID, LinkPolicy: []ethernetport.LinkPolicy{1, 16}, LinkPreference: 2, LinkControl: 2}
+
+	applied := wireless
+	applied.LinkPolicy = []ethernetport.LinkPolicy{1, 16, 224}
+	applied.LinkPreference = 1
+
+	policy := func(mask int) *int { return &mask }
+
+	tests := []struct {
+		name        string
+		request     dto.LinkSettingsRequest
+		ports       []ethernetport.SettingsResponse
+		manMock     func(*mocks.MockManagement)
+		res         dto.LinkSettings
+		invalid     bool
+		unsupported bool
+		err         error
+	}{
+		{
+			name:    "success",
+			request: dto.LinkSettingsRequest{Interface: "wireless", LinkPolicy: policy(241), LinkPreference: 1, Timeout: 300},
+			ports:   []ethernetport.SettingsResponse{wired, wireless},
+			manMock: func(man *mocks.MockManagement) {
+				gomock.InOrder(
+					man.EXPECT().SetLinkPolicy(gomock.Any(), wirelessID, []ethernetport.LinkPolicy{1, 16, 224}).Return(nil),
+					man.EXPECT().SetLinkPreference(gomock.Any(), uint32(1), uint32(300)).Return(0, nil),
+					man.EXPECT().GetEthernetPorts(gomock.Any()).Return([]ethernetport.SettingsResponse{wired, applied}, nil),
+				)
+			},
+			res: dto.LinkSettings{Interface: "wireless", InstanceID: wirelessID, LinkPolicy: 241, LinkPreference: 1, LinkControl: 2},
+		},
+		{
+			name:    "unknown interface",
+			request: dto.LinkSettingsRequest{Interface: "bluetooth", LinkPolicy: policy(17)},
+			invalid: true,
+		},
+		{
+			name:    "part of the Sx AC policy",
+			request: dto.LinkSettingsRequest{Interface: "wired", LinkPolicy: policy(3)},
+			invalid: true,
+		},
+		{
+			name:    "link preference of the wired interface",
+			request: dto.LinkSettingsRequest{Interface: "wired", LinkPreference: 2},
+			invalid: true,
+		},
+		{
+			name:    "link given to ME without timeout",
+			request: dto.LinkSettingsRequest{Interface: "wireless", LinkPreference: 1},
+			invalid: true,
+		},
+		{
+			name:        "no wireless interface",
+			request:     dto.LinkSettingsRequest{Interface: "wireless", LinkPreference: 2},
+			ports:       []ethernetport.SettingsResponse{wired},
+			unsupported: true,
+		},
+		{
+			name:    "link preference refused",
+			request: dto.LinkSettingsRequest{Interface: "wireless", LinkPreference: 2},
+			ports:   []ethernetport.SettingsResponse{wired, wireless},
+			manMock: func(man *mocks.MockManagement) {
+				man.EXPECT().SetLinkPreference(gomock.Any(), uint32(2), uint32(0)).Return(36, nil)
+			},
+			err: amtstatus.Error{Operation: "SetLinkPreference", Explanation: amtstatus.Explain(36)},
+		},
+		{
+			name:    "link policy not applied",
+			request: dto.LinkSettingsRequest{Interface: "wired", LinkPolicy: policy(17)},
+			ports:   []ethernetport.SettingsResponse{wired, wireless},
+			manMock: func(man *mocks.MockManagement) {
+				man.EXPECT().SetLinkPolicy(gomock.Any(), wired.InstanceID, []ethernetport.LinkPolicy{1, 16}).Return(nil)
+				man.EXPECT().GetEthernetPorts(gomock.Any()).Return([]ethernetport.SettingsResponse{wired, wireless}, nil)
+			},
+			err: devices.ErrLinkSettingsNotApplied,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, wsmanMock, management, repo := initLinkPreferenceTest(t)
+
+			if tc.ports != nil {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+				wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(wsman.Management(management), nil)
+				management.EXPECT().GetEthernetPorts(gomock.Any()).Return(tc.ports, nil)
+			}
+
+			if tc.manMock != nil {
+				tc.manMock(management)
+			}
+
+			res, err := useCase.SetLinkSettings(context.Background(), device.GUID, tc.request)
+
+			switch {
+			case tc.invalid:
+				require.ErrorAs(t, err, &devices.ValidationError{})
+			case tc.unsupported:
+				require.ErrorAs(t, err, &devices.NotSupportedError{})
+			case errors.Is(tc.err, devices.ErrLinkSettingsNotApplied):
+				var amtErr devices.AMTError
+
+				require.ErrorAs(t, err, &amtErr)
+				require.ErrorIs(t, amtErr.Console.OriginalError, tc.err)
+				require.Equal(t, "the device did not apply the link settings: link policy is 31 instead of 17", amtErr.Console.FriendlyMessage())
+			case tc.err != nil:
+				require.Equal(t, tc.err, err)
+			default:
+				require.NoError(t, err)
+				require.NotNil(t, res.LinkPreferenceRevertsAt)
+				require.WithinDuration(t, time.Now().Add(300*time.Second), *res.LinkPreferenceRevertsAt, time.Minute)
+
+				res.LinkPreferenceRevertsAt = nil
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
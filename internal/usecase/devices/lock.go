@@ -0,0 +1,165 @@
+package devices
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+const (
+	// defaultLockTTL is how long a lock is held when neither the request nor the lock policy say.
+	defaultLockTTL = 15 * time.Minute
+	// maxLockTTL is the longest a device is locked for at once, the holder renews the lock to keep it longer.
+	maxLockTTL = 24 * time.Hour
+)
+
+// LockedError refuses an operation on a device another holder has locked.
+type LockedError struct {
+	Holder    string
+	ExpiresAt time.Time
+}
+
+func (e LockedError) Error() string {
+	return fmt.Sprintf("the device is locked by %s until %s", e.Holder, e.ExpiresAt.UTC().Format(time.RFC3339))
+}
+
+func (e LockedError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeDeviceLocked
+}
+
+type lockHolderKey struct{}
+
+// WithLockHolder returns a context acting as holder, a user or an automated job, on device locks.
+func WithLockHolder(ctx context.Context, holder string) context.Context {
+	return context.WithValue(ctx, lockHolderKey{}, holder)
+}
+
+// LockHolder returns the holder the context acts as on device locks.
+func LockHolder(ctx context.Context) string {
+	holder, _ := ctx.Value(lockHolderKey{}).(string)
+
+	return holder
+}
+
+// SetLockPolicy makes power actions and boot options require a lock of the caller on the device when required
+// is set, a lock request without a TTL holds the lock for ttl.
+func (uc *UseCase) SetLockPolicy(required bool, ttl time.Duration) {
+	uc.lockMutex.Lock()
+	defer uc.lockMutex.Unlock()
+
+	uc.lockRequired = required
+	uc.lockTTL = min(max(ttl, 0), maxLockTTL)
+}
+
+// LockDevice locks the device for the holder of the context, or renews the lock the holder has.
+func (uc *UseCase) LockDevice(ctx context.Context, guid string, req dto.DeviceLockRequest) (dto.DeviceLock, error) {
+	holder := LockHolder(ctx)
+	if holder == "" {
+		return dto.DeviceLock{}, ErrValidationUseCase.Wrap("LockDevice", "validate holder", "locking a device requires an authenticated user")
+	}
+
+	ttl := time.Duration(req.TTL) * time.Second
+	if ttl < 0 || ttl > maxLockTTL {
+		return dto.DeviceLock{}, ErrValidationUseCase.Wrap("LockDevice", "validate ttl", "ttl must be between 1 and 86400 seconds, or 0 for the default")
+	}
+
+	guid = strings.ToLower(guid)
+	now := time.Now()
+
+	uc.lockMutex.Lock()
+	defer uc.lockMutex.Unlock()
+
+	if ttl == 0 {
+		ttl = cmp.Or(uc.lockTTL, defaultLockTTL)
+	}
+
+	lock, ok := uc.heldLock(guid, now)
+	if ok && lock.Holder != holder {
+		return dto.DeviceLock{}, LockedError{Holder: lock.Holder, ExpiresAt: lock.ExpiresAt}
+	}
+
+	if !ok {
+		lock = dto.DeviceLock{GUID: guid, Holder: holder, AcquiredAt: now}
+	}
+
+	lock.Reason = req.Reason
+	lock.ExpiresAt = now.Add(ttl)
+
+	if uc.locks == nil {
+		uc.locks = make(map[string]dto.DeviceLock)
+	}
+
+	uc.locks[guid] = lock
+
+	return lock, nil
+}
+
+// GetDeviceLock returns the lock of the device, ErrNotFound when it is not locked.
+func (uc *UseCase) GetDeviceLock(_ context.Context, guid string) (dto.DeviceLock, error) {
+	uc.lockMutex.Lock()
+	defer uc.lockMutex.Unlock()
+
+	lock, ok := uc.heldLock(strings.ToLower(guid), time.Now())
+	if !ok {
+		return dto.DeviceLock{}, ErrNotFound
+	}
+
+	return lock, nil
+}
+
+// UnlockDevice releases the lock the holder of the context has on the device, force releases the lock of
+// another holder. Unlocking a device that is not locked does nothing.
+func (uc *UseCase) UnlockDevice(ctx context.Context, guid string, force bool) error {
+	guid = strings.ToLower(guid)
+
+	uc.lockMutex.Lock()
+	defer uc.lockMutex.Unlock()
+
+	lock, ok := uc.heldLock(guid, time.Now())
+	if !ok {
+		return nil
+	}
+
+	if lock.Holder != LockHolder(ctx) && !force {
+		return LockedError{Holder: lock.Holder, ExpiresAt: lock.ExpiresAt}
+	}
+
+	delete(uc.locks, guid)
+
+	return nil
+}
+
+// checkLock refuses the operation when another holder has the device locked, or when locks are required and
+// the holder of the context has not locked it.
+func (uc *UseCase) checkLock(ctx context.Context, guid, operation string) error {
+	uc.lockMutex.Lock()
+	defer uc.lockMutex.Unlock()
+
+	lock, ok := uc.heldLock(strings.ToLower(guid), time.Now())
+
+	switch {
+	case ok && lock.Holder != LockHolder(ctx):
+		return LockedError{Holder: lock.Holder, ExpiresAt: lock.ExpiresAt}
+	case !ok && uc.lockRequired:
+		return ErrValidationUseCase.Wrap(operation, "check device lock", "the device must be locked before this operation")
+	default:
+		return nil
+	}
+}
+
+// heldLock returns the lock of the device unless it expired, the caller holds lockMutex.
+func (uc *UseCase) heldLock(guid string, now time.Time) (dto.DeviceLock, bool) {
+	lock, ok := uc.locks[guid]
+	if ok && !now.Before(lock.ExpiresAt) {
+		delete(uc.locks, guid)
+
+		return dto.DeviceLock{}, false
+	}
+
+	return lock, ok
+}
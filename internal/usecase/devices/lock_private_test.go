@@ -0,0 +1,94 @@
+package devices
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func TestLockDevice(t *testing.T) {
+	t.Parallel()
+
+	uc := &UseCase{}
+	alice := WithLockHolder(context.Background(), "alice")
+	bob := WithLockHolder(context.Background(), "bob")
+
+	_, err := uc.GetDeviceLock(alice, "guid")
+	require.Equal(t, ErrNotFound, err)
+
+	lock, err := uc.LockDevice(alice, "GUID", dto.DeviceLockRequest{Reason: "firmware update"})
+	require.NoError(t, err)
+	require.Equal(t, "guid", lock.GUID)
+	require.Equal(t, "alice", lock.Holder)
+	require.WithinDuration(t, time.Now().Add(defaultLockTTL), lock.ExpiresAt, time.Second)
+
+	got, err := uc.GetDeviceLock(bob, "guid")
+	require.NoError(t, err)
+	require.Equal(t, lock, got)
+
+	// another holder can neither take nor release the lock
+	_, err = uc.LockDevice(bob, "guid", dto.DeviceLockRequest{})
+	require.Equal(t, LockedError{Holder: "alice", ExpiresAt: lock.ExpiresAt}, err)
+	require.Equal(t, LockedError{Holder: "alice", ExpiresAt: lock.ExpiresAt}, uc.UnlockDevice(bob, "guid", false))
+
+	// the holder renews the lock and keeps the time it was acquired
+	renewed, err := uc.LockDevice(alice, "guid", dto.DeviceLockRequest{TTL: 60})
+	require.NoError(t, err)
+	require.Equal(t, lock.AcquiredAt, renewed.AcquiredAt)
+	require.Empty(t, renewed.Reason)
+	require.WithinDuration(t, time.Now().Add(time.Minute), renewed.ExpiresAt, time.Second)
+
+	require.NoError(t, uc.UnlockDevice(bob, "guid", true), "force releases the lock of another holder")
+
+	_, err = uc.GetDeviceLock(alice, "guid")
+	require.Equal(t, ErrNotFound, err)
+	require.NoError(t, uc.UnlockDevice(alice, "guid", false), "unlocking a device that is not locked does nothing")
+}
+
+func TestLockDeviceValidation(t *testing.T) {
+	t.Parallel()
+
+	uc := &UseCase{}
+
+	_, err := uc.LockDevice(context.Background(), "guid", dto.DeviceLockRequest{})
+	require.Equal(t, ErrValidationUseCase.Wrap("LockDevice", "validate holder", "locking a device requires an authenticated user"), err)
+
+	_, err = uc.LockDevice(WithLockHolder(context.Background(), "alice"), "guid", dto.DeviceLockRequest{TTL: 86401})
+	require.Equal(t, ErrValidationUseCase.Wrap("LockDevice", "validate ttl", "ttl must be between 1 and 86400 seconds, or 0 for the default"), err)
+}
+
+func TestCheckLock(t *testing.T) {
+	t.Parallel()
+
+	uc := &UseCase{}
+	alice := WithLockHolder(context.Background(), "alice")
+
+	require.NoError(t, uc.checkLock(context.Background(), "guid", "SendPowerAction"), "locks are advisory by default")
+
+	_, err := uc.LockDevice(alice, "guid", dto.DeviceLockRequest{})
+	require.NoError(t, err)
+
+	require.NoError(t, uc.checkLock(alice, "guid", "SendPowerAction"))
+	require.ErrorAs(t, uc.checkLock(context.Background(), "guid", "SendPowerAction"), &LockedError{})
+
+	uc.SetLockPolicy(true, time.Minute)
+	require.Equal(t, ErrValidationUseCase.Wrap("SetBootOptions", "check device lock", "the device must be locked before this operation"),
+		uc.checkLock(alice, "other", "SetBootOptions"))
+
+	// an expired lock no longer counts
+	uc.lockMutex.Lock()
+	lock := uc.locks["guid"]
+	lock.ExpiresAt = time.Now().Add(-time.Second)
+	uc.locks["guid"] = lock
+	uc.lockMutex.Unlock()
+
+	require.Error(t, uc.checkLock(alice, "guid", "SendPowerAction"))
+
+	lock, err = uc.LockDevice(WithLockHolder(context.Background(), "bob"), "guid", dto.DeviceLockRequest{})
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Minute), lock.ExpiresAt, time.Second, "the TTL of the lock policy")
+}
@@ -2,6 +2,7 @@ package devices
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ethernetport"
@@ -10,7 +11,13 @@ import (
 	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
 )
 
-func (uc *UseCase) GetNetworkSettings(c context.Context, guid string) (dto.NetworkSettings, error) {
+// GetNetworkSettings fetches the settings of the given sections, the wsman.NetworkInterfaces, all of
+// them when none is given.
+func (uc *UseCase) GetNetworkSettings(c context.Context, guid string, sections ...string) (dto.NetworkSettings, error) {
+	if err := validateSelection("GetNetworkSettings", sections, wsman.NetworkInterfaces); err != nil {
+		return dto.NetworkSettings{}, err
+	}
+
 	item, err := uc.repo.GetByID(c, guid, "")
 	if err != nil {
 		return dto.NetworkSettings{}, err
@@ -25,7 +32,7 @@ func (uc *UseCase) GetNetworkSettings(c context.Context, guid string) (dto.Netwo
 		return dto.NetworkSettings{}, err
 	}
 
-	response, err := device.GetNetworkSettings()
+	response, err := device.GetNetworkSettings(c, sections...)
 	if err != nil {
 		return dto.NetworkSettings{}, err
 	}
@@ -35,7 +42,7 @@ func (uc *UseCase) GetNetworkSettings(c context.Context, guid string) (dto.Netwo
 	for i := range response.EthernetPortSettingsResult {
 		portSetting := &response.EthernetPortSettingsResult[i]
 
-		if strings.Contains(portSetting.InstanceID, "Intel(r) AMT Ethernet Port Settings 0") {
+		if strings.Contains(portSetting.InstanceID, "Intel(r) AMT Ethernet Port Settings 0") && selected(sections, wsman.InterfaceWired) {
 			// Wired network
 			ns.Wired = &dto.WiredNetworkInfo{
 				IEEE8021x: dto.IEEE8021x{
@@ -47,7 +54,7 @@ func (uc *UseCase) GetNetworkSettings(c context.Context, guid string) (dto.Netwo
 			ns.Wired.NetworkInfo = convertToNetworkInfo(*portSetting)
 		}
 
-		if strings.Contains(portSetting.InstanceID, "Intel(r) AMT Ethernet Port Settings 1") {
+		if strings.Contains(portSetting.InstanceID, "Intel(r) AMT Ethernet Port Settings 1") && selected(sections, wsman.InterfaceWireless) {
 			// Wireless network
 			ns.Wireless = &dto.WirelessNetworkInfo{}
 			ns.Wireless.NetworkInfo = convertToNetworkInfo(*portSetting)
@@ -152,3 +159,20 @@ func (uc *UseCase) processIEEE8021xSettings(response wsman.NetworkResults) []dto
 
 	return ieee8021xSettings
 }
+
+// validateSelection rejects the sections that are not part of known.
+func validateSelection(function string, sections, known []string) error {
+	for _, section := range sections {
+		if !slices.Contains(known, section) {
+			return ErrValidationUseCase.Wrap(function, "validateSelection",
+				"unknown field "+section+", expected one of "+strings.Join(known, ", "))
+		}
+	}
+
+	return nil
+}
+
+// selected tells whether name is part of a selection, an empty selection selects everything.
+func selected(selection []string, name string) bool {
+	return len(selection) == 0 || slices.Contains(selection, name)
+}
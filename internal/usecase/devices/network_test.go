@@ -32,7 +32,7 @@ func initNetworkTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *mocks.M
 
 	management := mocks.NewMockManagement(mockCtl)
 	log := logger.New("error")
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, wsmanMock, management, repo
 }
@@ -54,7 +54,7 @@ func TestGetNetworkSettings(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetNetworkSettings().
+					GetNetworkSettings(gomock.Any()).
 					Return(wsman.NetworkResults{
 						EthernetPortSettingsResult: []ethernetport.SettingsResponse{
 							{
@@ -155,7 +155,7 @@ func TestGetNetworkSettings(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(man2, nil)
 				man2.EXPECT().
-					GetNetworkSettings().
+					GetNetworkSettings(gomock.Any()).
 					Return(wsman.NetworkResults{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -188,3 +188,29 @@ func TestGetNetworkSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNetworkSettingsSections(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	useCase, wsmanMock, management, repo := initNetworkTest(t)
+
+	repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+	wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
+	management.EXPECT().GetNetworkSettings(gomock.Any(), wsman.InterfaceWired).
+		Return(wsman.NetworkResults{
+			EthernetPortSettingsResult: []ethernetport.SettingsResponse{
+				{InstanceID: "Intel(r) AMT Ethernet Port Settings 0"},
+				{InstanceID: "Intel(r) AMT Ethernet Port Settings 1"},
+			},
+		}, nil)
+
+	res, err := useCase.GetNetworkSettings(context.Background(), device.GUID, wsman.InterfaceWired)
+	require.NoError(t, err)
+	require.NotNil(t, res.Wired)
+	require.Nil(t, res.Wireless)
+
+	_, err = useCase.GetNetworkSettings(context.Background(), device.GUID, "bluetooth")
+	require.ErrorAs(t, err, &devices.ValidationError{})
+}
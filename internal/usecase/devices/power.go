@@ -3,38 +3,63 @@ package devices
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
 	cimBoot "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/service"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/software"
 	ipsPower "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/power"
 
+	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/i18n"
 )
 
 const (
-	BootActionHTTPSBoot         = 105
-	BootActionPowerOnHTTPSBoot  = 106
-	BootActionPBA               = 107
-	BootActionPowerOnPBA        = 108
-	BootActionWinREBoot         = 109
-	BootActionPowerOnWinREBoot  = 110
-	BootActionResetToIDERCDROM  = 202
-	BootActionPowerOnIDERCDROM  = 203
-	BootActionResetToBIOS       = 101
-	BootActionResetToPXE        = 400
-	BootActionPowerOnToPXE      = 401
-	BootActionResetToDiag       = 301
-	BootActionResetToIDERFloppy = 200
-	OsToFullPower               = 500
-	OsToPowerSaving             = 501
-	CIMPMSPowerOn               = 2 // CIM > Power Management Service > Power On
+	BootActionHTTPSBoot           = 105
+	BootActionPowerOnHTTPSBoot    = 106
+	BootActionPBA                 = 107
+	BootActionPowerOnPBA          = 108
+	BootActionWinREBoot           = 109
+	BootActionPowerOnWinREBoot    = 110
+	BootActionResetToIDERCDROM    = 202
+	BootActionPowerOnIDERCDROM    = 203
+	BootActionPowerOnToBIOS       = 100
+	BootActionResetToBIOS         = 101
+	BootActionResetToSecureErase  = 104
+	BootActionResetToPXE          = 400
+	BootActionPowerOnToPXE        = 401
+	BootActionPowerOnToDiag       = 300
+	BootActionResetToDiag         = 301
+	BootActionResetToIDERFloppy   = 200
+	BootActionPowerOnToIDERFloppy = 201
+	OsToFullPower                 = 500
+	OsToPowerSaving               = 501
+	CIMPMSPowerOn                 = 2 // CIM > Power Management Service > Power On
+	CIMPMSPowerDown               = 8
+	CIMPMSReset                   = 10
+	CIMPMSSoftOff                 = 12 // graceful shutdown through the in-band agent
+	GracefulShutdown              = 600
+	GracefulRestart               = 601
+)
+
+// Graceful shutdowns are followed in the background, as the OS usually takes longer than a request may.
+// gracefulActionTimeout bounds the power action sent once the shutdown is over or timed out.
+var (
+	gracefulShutdownTimeout = 2 * time.Minute
+	gracefulPollInterval    = 5 * time.Second
+	gracefulActionTimeout   = 30 * time.Second
 )
 
 var (
@@ -43,6 +68,34 @@ var (
 )
 
 func (uc *UseCase) SendPowerAction(c context.Context, guid string, action int) (power.PowerActionResponse, error) {
+	if err := uc.checkLock(c, guid, "SendPowerAction"); err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	response, err := uc.sendPowerAction(c, guid, action)
+	if err != nil {
+		return response, deviceError("SendPowerAction", err)
+	}
+
+	if err := powerActionError(response); err != nil {
+		return response, err
+	}
+
+	eventbus.Publish(uc.events, eventbus.DevicePowerChanged, eventbus.DevicePowerChangedEvent{GUID: guid, Action: action})
+
+	return response, nil
+}
+
+// powerActionError explains the non-zero return value of a power action the device refused.
+func powerActionError(response power.PowerActionResponse) error {
+	if response.ReturnValue == 0 {
+		return nil
+	}
+
+	return amtstatus.Error{Operation: "RequestPowerStateChange", Explanation: amtstatus.ExplainPower(int(response.ReturnValue))}
+}
+
+func (uc *UseCase) sendPowerAction(c context.Context, guid string, action int) (power.PowerActionResponse, error) {
 	item, err := uc.repo.GetByID(c, guid, "")
 	if err != nil {
 		return power.PowerActionResponse{}, err
@@ -58,7 +111,7 @@ func (uc *UseCase) SendPowerAction(c context.Context, guid string, action int) (
 	}
 
 	if action == OsToFullPower || action == OsToPowerSaving {
-		response, err := handleOSPowerSavingStateChange(device, action)
+		response, err := handleOSPowerSavingStateChange(c, device, action)
 		if err != nil {
 			return power.PowerActionResponse{}, err
 		}
@@ -66,22 +119,222 @@ func (uc *UseCase) SendPowerAction(c context.Context, guid string, action int) (
 		return response, nil
 	}
 
+	if action == GracefulShutdown || action == GracefulRestart {
+		return uc.gracefulPowerAction(c, device, *item, action)
+	}
+
+	if err := checkAvailablePowerState(c, device, action); err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
 	if action == CIMPMSPowerOn {
-		_, err := ensureFullPowerBeforeReset(device)
+		_, err := ensureFullPowerBeforeReset(c, device)
 		if err != nil {
 			return power.PowerActionResponse{}, err
 		}
 	}
 
-	response, err := device.SendPowerAction(action)
+	response, err := device.SendPowerAction(c, action)
+	if err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	return response, nil
+}
+
+// DescribePowerAction formats an action code with its name in the message catalog, e.g. "8 (Power down)".
+func DescribePowerAction(action int) string {
+	key := "power.action." + strconv.Itoa(action)
+	if name := i18n.T(i18n.DefaultLanguage, key); name != key {
+		return fmt.Sprintf("%d (%s)", action, name)
+	}
+
+	return strconv.Itoa(action)
+}
+
+func describePowerActions(actions []int) string {
+	if len(actions) == 0 {
+		return "none"
+	}
+
+	described := make([]string, 0, len(actions))
+	for _, action := range actions {
+		described = append(described, DescribePowerAction(action))
+	}
+
+	return strings.Join(described, ", ")
+}
+
+// checkAvailablePowerState rejects a CIM power action the device does not offer in its current power state,
+// so that the caller gets the actions it can use instead of the return code of RequestPowerStateChange.
+func checkAvailablePowerState(ctx context.Context, device wsman.Management, action int) error {
+	states, err := device.GetPowerState(ctx)
+	if err != nil {
+		return err
+	}
+
+	// devices that do not advertise the available states are left to reject the action themselves
+	if len(states) == 0 || len(states[0].AvailableRequestedPowerStates) == 0 {
+		return nil
+	}
+
+	available := make([]int, 0, len(states[0].AvailableRequestedPowerStates))
+	for _, state := range states[0].AvailableRequestedPowerStates {
+		available = append(available, int(state))
+	}
+
+	if slices.Contains(available, action) {
+		return nil
+	}
+
+	return ErrValidationUseCase.Wrap("SendPowerAction", "check available power states",
+		fmt.Sprintf("power action %s is not available in the current power state of the device, available actions: %s",
+			DescribePowerAction(action), describePowerActions(available)))
+}
+
+// gracefulPowerAction asks the OS to shut down through the in-band agent and returns once the request is
+// accepted. Whether the OS shut down is followed in the background: a graceful restart powers the device
+// up again, and a device that is still on after gracefulShutdownTimeout is powered down or reset.
+// Without an in-band agent the hard action is sent right away.
+func (uc *UseCase) gracefulPowerAction(ctx context.Context, device wsman.Management, item entity.Device, action int) (power.PowerActionResponse, error) {
+	states, err := device.GetPowerState(ctx)
+	if err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	if len(states) > 0 && poweredOff(states[0].PowerState) {
+		if action == GracefulShutdown {
+			return power.PowerActionResponse{ReturnValue: power.ReturnValue(0)}, nil
+		}
+
+		return device.SendPowerAction(ctx, CIMPMSPowerOn)
+	}
+
+	if len(states) == 0 || !slices.Contains(states[0].AvailableRequestedPowerStates, service.AvailableRequestedPowerStates(CIMPMSSoftOff)) {
+		uc.log.Info("devices - gracefulPowerAction: no in-band agent on device %s, sending %s", item.GUID, DescribePowerAction(hardPowerAction(action)))
+
+		return device.SendPowerAction(ctx, hardPowerAction(action))
+	}
+
+	// the OS has to leave its power saving state to handle the shutdown request
+	if _, err := ensureFullPowerBeforeReset(ctx, device); err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	response, err := device.SendPowerAction(ctx, CIMPMSSoftOff)
 	if err != nil {
 		return power.PowerActionResponse{}, err
 	}
 
+	if response.ReturnValue != 0 {
+		uc.log.Info("devices - gracefulPowerAction: graceful shutdown of device %s rejected with %d, sending %s",
+			item.GUID, response.ReturnValue, DescribePowerAction(hardPowerAction(action)))
+
+		return device.SendPowerAction(ctx, hardPowerAction(action))
+	}
+
+	go uc.followGracefulShutdown(ctx, item, action, gracefulShutdownTimeout, gracefulPollInterval)
+
 	return response, nil
 }
 
-func handleOSPowerSavingStateChange(device wsman.Management, action int) (power.PowerActionResponse, error) {
+// followGracefulShutdown follows a graceful action accepted by the device and completes it. It runs once the
+// request is answered, so it keeps the values of the request context but not its cancellation: the context of
+// a request is canceled when its handler returns, which would cancel the calls to the device.
+func (uc *UseCase) followGracefulShutdown(ctx context.Context, item entity.Device, action int, timeout, interval time.Duration) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout+gracefulActionTimeout)
+	defer cancel()
+
+	device, err := uc.device.SetupWsmanClient(item, false, true)
+	if err != nil {
+		uc.log.Error(err, "devices - gracefulPowerAction: failed to follow the shutdown of device "+item.GUID)
+
+		return
+	}
+
+	sent, err := waitForGracefulShutdown(ctx, device, action, timeout, interval)
+	if err != nil {
+		uc.log.Error(err, "devices - gracefulPowerAction: failed to send "+DescribePowerAction(sent)+" to device "+item.GUID)
+
+		return
+	}
+
+	if sent == hardPowerAction(action) {
+		uc.log.Warn("devices - gracefulPowerAction: device %s did not shut down within %s, sent %s", item.GUID, timeout, DescribePowerAction(sent))
+
+		return
+	}
+
+	uc.log.Info("devices - gracefulPowerAction: %s of device %s completed", DescribePowerAction(action), item.GUID)
+}
+
+// waitForGracefulShutdown polls the power state of the device until it is off or the timeout expires,
+// then sends the action that completes the graceful action and returns it, 0 when none is needed.
+// It gives up without sending anything when ctx is done.
+func waitForGracefulShutdown(ctx context.Context, device wsman.Management, action int, timeout, interval time.Duration) (int, error) {
+	next := hardPowerAction(action)
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	poll := time.NewTicker(interval)
+	defer poll.Stop()
+
+wait:
+	for {
+		select {
+		case <-ctx.Done():
+			return next, ctx.Err()
+		case <-deadline.C:
+			break wait
+		case <-poll.C:
+			states, err := device.GetPowerState(ctx)
+			if err == nil && len(states) > 0 && poweredOff(states[0].PowerState) {
+				if action == GracefulShutdown {
+					return 0, nil
+				}
+
+				next = CIMPMSPowerOn
+
+				break wait
+			}
+		}
+	}
+
+	_, err := device.SendPowerAction(ctx, next)
+
+	return next, err
+}
+
+// hardPowerAction is the fallback of a graceful action when the OS does not shut down.
+func hardPowerAction(action int) int {
+	if action == GracefulRestart {
+		return CIMPMSReset
+	}
+
+	return CIMPMSPowerDown
+}
+
+// poweredOff reports whether a CIM power state is one of the off states (Off - Hard, Hibernate, Off - Soft).
+func poweredOff(state service.PowerState) bool {
+	return state == 6 || state == 7 || state == 8
+}
+
+func handleOSPowerSavingStateChange(ctx context.Context, device wsman.Management, action int) (power.PowerActionResponse, error) {
+	currentState, err := osPowerSavingState(ctx, device)
+	if err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	if currentState == ipsPower.Unsupported {
+		return power.PowerActionResponse{}, ErrNotSupportedUseCase.Wrap("SendPowerAction", "check OS power saving state",
+			fmt.Sprintf("power action %s is not supported, the device does not support OS power saving states", DescribePowerAction(action)))
+	}
+
+	return changeOSPowerSavingState(ctx, device, currentState, action)
+}
+
+func changeOSPowerSavingState(ctx context.Context, device wsman.Management, currentState ipsPower.OSPowerSavingState, action int) (power.PowerActionResponse, error) {
 	var targetStateValue int
 
 	if action == OsToFullPower {
@@ -90,18 +343,13 @@ func handleOSPowerSavingStateChange(device wsman.Management, action int) (power.
 		targetStateValue = 3
 	}
 
-	currentState, err := device.GetOSPowerSavingState()
-	if err != nil {
-		return power.PowerActionResponse{}, err
-	}
-
 	if int(currentState) == targetStateValue {
 		return power.PowerActionResponse{
 			ReturnValue: power.ReturnValue(0),
 		}, nil
 	}
 
-	response, err := device.RequestOSPowerSavingStateChange(ipsPower.OSPowerSavingState(targetStateValue))
+	response, err := device.RequestOSPowerSavingStateChange(ctx, ipsPower.OSPowerSavingState(targetStateValue))
 	if err != nil {
 		return power.PowerActionResponse{}, err
 	}
@@ -111,8 +359,18 @@ func handleOSPowerSavingStateChange(device wsman.Management, action int) (power.
 	}, nil
 }
 
-func ensureFullPowerBeforeReset(device wsman.Management) (power.PowerActionResponse, error) {
-	res, err := handleOSPowerSavingStateChange(device, OsToFullPower)
+func ensureFullPowerBeforeReset(ctx context.Context, device wsman.Management) (power.PowerActionResponse, error) {
+	currentState, err := osPowerSavingState(ctx, device)
+	if err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	// there is no OS power saving state to leave on devices that do not support it
+	if currentState == ipsPower.Unsupported {
+		return power.PowerActionResponse{ReturnValue: power.ReturnValue(0)}, nil
+	}
+
+	res, err := changeOSPowerSavingState(ctx, device, currentState, OsToFullPower)
 	if err != nil {
 		return power.PowerActionResponse{}, err
 	}
@@ -135,17 +393,17 @@ func (uc *UseCase) GetPowerState(c context.Context, guid string) (dto.PowerState
 		return dto.PowerState{}, err
 	}
 
-	state, err := device.GetPowerState()
+	state, err := device.GetPowerState(c)
 	if err != nil {
-		return dto.PowerState{}, err
+		return dto.PowerState{}, deviceError("GetPowerState", err)
 	}
 
-	stateOS, err := device.GetOSPowerSavingState()
+	stateOS, err := osPowerSavingState(c, device)
 	if err != nil {
 		return dto.PowerState{
 			PowerState:         int(state[0].PowerState),
 			OSPowerSavingState: 0, // UNKNOWN
-		}, err
+		}, deviceError("GetOSPowerSavingState", err)
 	}
 
 	return dto.PowerState{
@@ -169,17 +427,12 @@ func (uc *UseCase) GetPowerCapabilities(c context.Context, guid string) (dto.Pow
 		return dto.PowerCapabilities{}, err
 	}
 
-	version, err := device.GetAMTVersion()
+	amtversion, err := amtMajorVersion(c, device)
 	if err != nil {
 		return dto.PowerCapabilities{}, err
 	}
 
-	capabilities, err := device.GetPowerCapabilities()
-	if err != nil {
-		return dto.PowerCapabilities{}, err
-	}
-
-	amtversion, err := parseVersion(version)
+	capabilities, err := device.GetPowerCapabilities(c)
 	if err != nil {
 		return dto.PowerCapabilities{}, err
 	}
@@ -197,11 +450,13 @@ func determinePowerCapabilities(amtversion int, capabilities boot.BootCapabiliti
 		Reset:      10,
 	}
 
-	if amtversion > MinAMTVersion {
+	if amtversion >= featureSoftPowerActions.minMajor {
 		response.SoftOff = 12
 		response.SoftReset = 14
 		response.Sleep = 4
 		response.Hibernate = 7
+		response.GracefulShutdown = GracefulShutdown
+		response.GracefulRestart = GracefulRestart
 	}
 
 	if capabilities.BIOSSetup {
@@ -230,6 +485,10 @@ func determinePowerCapabilities(amtversion int, capabilities boot.BootCapabiliti
 }
 
 func (uc *UseCase) SetBootOptions(c context.Context, guid string, bootSetting dto.BootSetting) (power.PowerActionResponse, error) {
+	if err := uc.checkLock(c, guid, "SetBootOptions"); err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
 	item, err := uc.repo.GetByID(c, guid, "")
 	if err != nil {
 		return power.PowerActionResponse{}, err
@@ -244,7 +503,11 @@ func (uc *UseCase) SetBootOptions(c context.Context, guid string, bootSetting dt
 		return power.PowerActionResponse{}, err
 	}
 
-	bootData, err := device.GetBootData()
+	if err := checkBootActionSupported(c, device, bootSetting.Action); err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	bootData, err := device.GetBootData(c)
 	if err != nil {
 		return power.PowerActionResponse{}, err
 	}
@@ -284,23 +547,23 @@ func (uc *UseCase) SetBootOptions(c context.Context, guid string, bootSetting dt
 		return power.PowerActionResponse{}, err
 	}
 
-	_, err = device.ChangeBootOrder("")
+	_, err = device.ChangeBootOrder(c, "")
 	if err != nil {
 		return power.PowerActionResponse{}, err
 	}
 
-	_, err = device.SetBootData(newData)
+	_, err = device.SetBootData(c, newData)
 	if err != nil {
 		return power.PowerActionResponse{}, err
 	}
 
 	// set boot config role
-	_, err = device.SetBootConfigRole(1)
+	_, err = device.SetBootConfigRole(c, 1)
 	if err != nil {
 		return power.PowerActionResponse{}, err
 	}
 
-	_, err = device.ChangeBootOrder(bootSource)
+	_, err = device.ChangeBootOrder(c, bootSource)
 	if err != nil {
 		return power.PowerActionResponse{}, err
 	}
@@ -309,12 +572,85 @@ func (uc *UseCase) SetBootOptions(c context.Context, guid string, bootSetting dt
 	// power on
 	determineBootAction(&bootSetting)
 
-	powerActionResult, err := device.SendPowerAction(bootSetting.Action)
+	powerActionResult, err := device.SendPowerAction(c, bootSetting.Action)
 	if err != nil {
 		return power.PowerActionResponse{}, err
 	}
 
-	return powerActionResult, nil
+	return powerActionResult, powerActionError(powerActionResult)
+}
+
+// bootActions lists the boot actions in the order they are reported as supported.
+var bootActions = []int{
+	BootActionPowerOnToBIOS, BootActionResetToBIOS, BootActionResetToSecureErase,
+	BootActionHTTPSBoot, BootActionPowerOnHTTPSBoot, BootActionPBA, BootActionPowerOnPBA,
+	BootActionWinREBoot, BootActionPowerOnWinREBoot,
+	BootActionResetToIDERFloppy, BootActionPowerOnToIDERFloppy, BootActionResetToIDERCDROM, BootActionPowerOnIDERCDROM,
+	BootActionPowerOnToDiag, BootActionResetToDiag, BootActionResetToPXE, BootActionPowerOnToPXE,
+}
+
+// bootActionSupported reports whether the boot capabilities of a device allow a boot action.
+// Actions without a matching capability are always allowed.
+func bootActionSupported(action int, capabilities boot.BootCapabilitiesResponse) bool {
+	switch action {
+	case BootActionPowerOnToBIOS, BootActionResetToBIOS:
+		return capabilities.BIOSSetup
+	case BootActionResetToSecureErase:
+		return capabilities.SecureErase
+	case BootActionHTTPSBoot, BootActionPowerOnHTTPSBoot:
+		return capabilities.ForceUEFIHTTPSBoot
+	case BootActionPBA, BootActionPowerOnPBA:
+		return capabilities.ForceUEFILocalPBABoot
+	case BootActionWinREBoot, BootActionPowerOnWinREBoot:
+		return capabilities.ForceWinREBoot
+	case BootActionResetToIDERFloppy, BootActionPowerOnToIDERFloppy, BootActionResetToIDERCDROM, BootActionPowerOnIDERCDROM:
+		return capabilities.IDER
+	case BootActionPowerOnToDiag, BootActionResetToDiag:
+		return capabilities.ForceDiagnosticBoot
+	case BootActionResetToPXE, BootActionPowerOnToPXE:
+		return capabilities.ForcePXEBoot
+	default:
+		return true
+	}
+}
+
+// checkBootActionSupported rejects a boot action the device does not support, and IDE-R boot actions
+// while IDE redirection is disabled, before any boot setting of the device is changed.
+func checkBootActionSupported(ctx context.Context, device wsman.Management, action int) error {
+	capabilities, err := device.GetPowerCapabilities(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !bootActionSupported(action, capabilities) {
+		supported := []int{}
+
+		for _, a := range bootActions {
+			if bootActionSupported(a, capabilities) {
+				supported = append(supported, a)
+			}
+		}
+
+		return ErrValidationUseCase.Wrap("SetBootOptions", "check boot capabilities",
+			fmt.Sprintf("boot action %s is not supported by the device, supported boot actions: %s",
+				DescribePowerAction(action), describePowerActions(supported)))
+	}
+
+	if action < BootActionResetToIDERFloppy || action > BootActionPowerOnIDERCDROM {
+		return nil
+	}
+
+	redirectionResult, err := device.GetAMTRedirectionService(ctx)
+	if err != nil {
+		return err
+	}
+
+	if iderEnabled, _ := getSOLAndIDERState(redirectionResult.Body.GetAndPutResponse.EnabledState); !iderEnabled {
+		return ErrValidationUseCase.Wrap("SetBootOptions", "check redirection state",
+			fmt.Sprintf("boot action %s requires IDE redirection, which is disabled on the device", DescribePowerAction(action)))
+	}
+
+	return nil
 }
 
 func determineBootDevice(bootSetting dto.BootSetting, newData *boot.BootSettingDataRequest) error {
@@ -568,7 +904,7 @@ func (uc *UseCase) GetBootSourceSetting(c context.Context, guid string) ([]dto.B
 		return nil, err
 	}
 
-	settings, err := device.GetCIMBootSourceSetting()
+	settings, err := device.GetCIMBootSourceSetting(c)
 	if err != nil {
 		return nil, err
 	}
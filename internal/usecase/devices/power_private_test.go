@@ -1,15 +1,23 @@
 package devices
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
 	cimBoot "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/service"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/software"
 
+	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
 type powerTest struct {
@@ -53,6 +61,8 @@ func TestDeterminePowerCapabilities(t *testing.T) {
 				ResetToDiagnostic:   301,
 				ResetToPXE:          400,
 				PowerOnToPXE:        401,
+				GracefulShutdown:    600,
+				GracefulRestart:     601,
 			},
 		},
 		{
@@ -288,3 +298,160 @@ func Test_determineBootDevice(t *testing.T) {
 		})
 	}
 }
+
+var errPowerState = errors.New("power state unavailable")
+
+// fakePowerDevice reports the given power states in turn, repeating the last one, and records the power actions sent.
+// Like the transport of the WS-Man clients, it fails the calls made with a canceled context.
+type fakePowerDevice struct {
+	wsman.Management
+
+	states []service.PowerState
+	sent   []int
+}
+
+func (f *fakePowerDevice) GetPowerState(ctx context.Context) ([]service.CIM_AssociatedPowerManagementService, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	state := f.states[0]
+	if len(f.states) > 1 {
+		f.states = f.states[1:]
+	}
+
+	if state == 0 {
+		return nil, errPowerState
+	}
+
+	return []service.CIM_AssociatedPowerManagementService{{PowerState: state}}, nil
+}
+
+func (f *fakePowerDevice) SendPowerAction(ctx context.Context, action int) (power.PowerActionResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	f.sent = append(f.sent, action)
+
+	return power.PowerActionResponse{}, nil
+}
+
+func TestWaitForGracefulShutdown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		action   int
+		states   []service.PowerState
+		wantSent []int
+		want     int
+	}{
+		{
+			name:     "shutdown completes",
+			action:   GracefulShutdown,
+			states:   []service.PowerState{2, 2, 8},
+			wantSent: nil,
+			want:     0,
+		},
+		{
+			name:     "restart powers the device up again",
+			action:   GracefulRestart,
+			states:   []service.PowerState{2, 8},
+			wantSent: []int{CIMPMSPowerOn},
+			want:     CIMPMSPowerOn,
+		},
+		{
+			name:     "shutdown times out",
+			action:   GracefulShutdown,
+			states:   []service.PowerState{2},
+			wantSent: []int{CIMPMSPowerDown},
+			want:     CIMPMSPowerDown,
+		},
+		{
+			name:     "restart times out",
+			action:   GracefulRestart,
+			states:   []service.PowerState{2},
+			wantSent: []int{CIMPMSReset},
+			want:     CIMPMSReset,
+		},
+		{
+			name:     "power state errors are retried",
+			action:   GracefulShutdown,
+			states:   []service.PowerState{0, 7},
+			wantSent: nil,
+			want:     0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			device := &fakePowerDevice{states: tc.states}
+
+			sent, err := waitForGracefulShutdown(context.Background(), device, tc.action, 50*time.Millisecond, time.Millisecond)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, sent)
+			require.Equal(t, tc.wantSent, device.sent)
+		})
+	}
+}
+
+func TestWaitForGracefulShutdownCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	device := &fakePowerDevice{states: []service.PowerState{2}}
+
+	_, err := waitForGracefulShutdown(ctx, device, GracefulShutdown, time.Hour, time.Hour)
+
+	require.ErrorIs(t, err, context.Canceled)
+	require.Empty(t, device.sent)
+}
+
+// fakePowerWSMAN sets up the clients of the devices as the given device.
+type fakePowerWSMAN struct {
+	WSMAN
+
+	device wsman.Management
+}
+
+func (f fakePowerWSMAN) SetupWsmanClient(_ entity.Device, _, _ bool) (wsman.Management, error) {
+	return f.device, nil
+}
+
+func TestFollowGracefulShutdownAfterRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		action   int
+		states   []service.PowerState
+		wantSent []int
+	}{
+		{name: "shutdown falls back to power down", action: GracefulShutdown, states: []service.PowerState{2}, wantSent: []int{CIMPMSPowerDown}},
+		{name: "restart falls back to reset", action: GracefulRestart, states: []service.PowerState{2}, wantSent: []int{CIMPMSReset}},
+		{name: "restart powers up after the shutdown", action: GracefulRestart, states: []service.PowerState{2, 8}, wantSent: []int{CIMPMSPowerOn}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			device := &fakePowerDevice{states: tc.states}
+			uc := &UseCase{device: fakePowerWSMAN{device: device}, log: logger.New("error")}
+
+			// the request context is canceled once the handler has answered
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			uc.followGracefulShutdown(ctx, entity.Device{GUID: "guid"}, tc.action, 20*time.Millisecond, time.Millisecond)
+
+			require.Equal(t, tc.wantSent, device.sent)
+		})
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
 
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/amterror"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	cimBoot "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/service"
@@ -20,6 +23,8 @@ import (
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/mocks"
 	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -48,7 +53,7 @@ func initPowerTest(t *testing.T) (*devices.UseCase, *mocks.MockWSMAN, *mocks.Moc
 
 	managementMock := mocks.NewMockManagement(mockCtl)
 	log := logger.New("error")
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, wsmanMock, managementMock, repo
 }
@@ -70,6 +75,11 @@ func TestSendPowerAction(t *testing.T) {
 		ReturnValue: ipspower.ReturnValue(0),
 	}
 
+	powerOffState := []service.CIM_AssociatedPowerManagementService{{
+		PowerState:                    8,
+		AvailableRequestedPowerStates: []service.AvailableRequestedPowerStates{2, 5},
+	}}
+
 	tests := []test{
 		{
 			name:   "success for Action 0",
@@ -79,7 +89,10 @@ func TestSendPowerAction(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					SendPowerAction(0).
+					GetPowerState(gomock.Any()).
+					Return([]service.CIM_AssociatedPowerManagementService{{PowerState: 2}}, nil)
+				hmm.EXPECT().
+					SendPowerAction(gomock.Any(), 0).
 					Return(powerActionRes, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -98,13 +111,16 @@ func TestSendPowerAction(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetOSPowerSavingState().
+					GetPowerState(gomock.Any()).
+					Return(powerOffState, nil)
+				hmm.EXPECT().
+					GetOSPowerSavingState(gomock.Any()).
 					Return(ipspower.OSPowerSavingState(3), nil) // It emulates to be in SAVING MODE
 				hmm.EXPECT().
-					RequestOSPowerSavingStateChange(ipspower.OSPowerSavingState(2)).
+					RequestOSPowerSavingStateChange(gomock.Any(), ipspower.OSPowerSavingState(2)).
 					Return(ipsPowerActionRes, nil)
 				hmm.EXPECT().
-					SendPowerAction(2).
+					SendPowerAction(gomock.Any(), 2).
 					Return(powerActionRes, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -123,10 +139,10 @@ func TestSendPowerAction(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetOSPowerSavingState().
+					GetOSPowerSavingState(gomock.Any()).
 					Return(ipspower.OSPowerSavingState(3), nil) // It emulates to be in SAVING MODE
 				hmm.EXPECT().
-					RequestOSPowerSavingStateChange(ipspower.OSPowerSavingState(2)).
+					RequestOSPowerSavingStateChange(gomock.Any(), ipspower.OSPowerSavingState(2)).
 					Return(ipsPowerActionRes, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -145,10 +161,10 @@ func TestSendPowerAction(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetOSPowerSavingState().
+					GetOSPowerSavingState(gomock.Any()).
 					Return(ipspower.OSPowerSavingState(2), nil) // It emulates to be in FULL POWER
 				hmm.EXPECT().
-					RequestOSPowerSavingStateChange(ipspower.OSPowerSavingState(3)).
+					RequestOSPowerSavingStateChange(gomock.Any(), ipspower.OSPowerSavingState(3)).
 					Return(ipsPowerActionRes, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -179,7 +195,10 @@ func TestSendPowerAction(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					SendPowerAction(0).
+					GetPowerState(gomock.Any()).
+					Return([]service.CIM_AssociatedPowerManagementService{{PowerState: 2}}, nil)
+				hmm.EXPECT().
+					SendPowerAction(gomock.Any(), 0).
 					Return(power.PowerActionResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -198,13 +217,16 @@ func TestSendPowerAction(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					SendPowerAction(2).
+					GetPowerState(gomock.Any()).
+					Return(powerOffState, nil)
+				hmm.EXPECT().
+					SendPowerAction(gomock.Any(), 2).
 					Return(power.PowerActionResponse{}, ErrGeneral)
 				hmm.EXPECT().
-					GetOSPowerSavingState().
+					GetOSPowerSavingState(gomock.Any()).
 					Return(ipspower.OSPowerSavingState(3), nil) // It emulates to be in SAVING MODE
 				hmm.EXPECT().
-					RequestOSPowerSavingStateChange(ipspower.OSPowerSavingState(2)). // Go to FULL POWER
+					RequestOSPowerSavingStateChange(gomock.Any(), ipspower.OSPowerSavingState(2)). // Go to FULL POWER
 					Return(ipspower.PowerActionResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -223,10 +245,10 @@ func TestSendPowerAction(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetOSPowerSavingState().
+					GetOSPowerSavingState(gomock.Any()).
 					Return(ipspower.OSPowerSavingState(3), nil) // It emulates to be in SAVING MODE
 				hmm.EXPECT().
-					RequestOSPowerSavingStateChange(ipspower.OSPowerSavingState(2)). // Go to FULL POWER
+					RequestOSPowerSavingStateChange(gomock.Any(), ipspower.OSPowerSavingState(2)). // Go to FULL POWER
 					Return(ipspower.PowerActionResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -245,10 +267,10 @@ func TestSendPowerAction(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetOSPowerSavingState().
+					GetOSPowerSavingState(gomock.Any()).
 					Return(ipspower.OSPowerSavingState(2), nil) // It emulates to be in FULL POWER
 				hmm.EXPECT().
-					RequestOSPowerSavingStateChange(ipspower.OSPowerSavingState(3)). // Go to SAVING MODE
+					RequestOSPowerSavingStateChange(gomock.Any(), ipspower.OSPowerSavingState(3)). // Go to SAVING MODE
 					Return(ipspower.PowerActionResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -259,6 +281,187 @@ func TestSendPowerAction(t *testing.T) {
 			res: powerActionRes,
 			err: nil,
 		},
+		{
+			name:   "action not available in the current power state",
+			action: 8,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerState(gomock.Any()).
+					Return(powerOffState, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: power.PowerActionResponse{},
+			err: devices.ErrValidationUseCase.Wrap("SendPowerAction", "check available power states",
+				"power action 8 (Power down) is not available in the current power state of the device, available actions: 2 (Power up), 5 (Power cycle)"),
+		},
+		{
+			name:   "GetPowerState fails",
+			action: 8,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerState(gomock.Any()).
+					Return(nil, ErrGeneral)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: power.PowerActionResponse{},
+			err: ErrGeneral,
+		},
+		{
+			name:   "OSToPowerSaving without OS power saving support",
+			action: 501,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetOSPowerSavingState(gomock.Any()).
+					Return(ipspower.Unsupported, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: power.PowerActionResponse{},
+			err: devices.ErrNotSupportedUseCase.Wrap("SendPowerAction", "check OS power saving state",
+				"power action 501 (OS to power saving) is not supported, the device does not support OS power saving states"),
+		},
+		{
+			name:   "Action 2 without OS power saving support",
+			action: 2,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerState(gomock.Any()).
+					Return(powerOffState, nil)
+				hmm.EXPECT().
+					GetOSPowerSavingState(gomock.Any()).
+					Return(ipspower.Unsupported, nil)
+				hmm.EXPECT().
+					SendPowerAction(gomock.Any(), 2).
+					Return(powerActionRes, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: powerActionRes,
+			err: nil,
+		},
+		{
+			name:   "GracefulShutdown of a device that is off",
+			action: devices.GracefulShutdown,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerState(gomock.Any()).
+					Return(powerOffState, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: powerActionRes,
+			err: nil,
+		},
+		{
+			name:   "GracefulRestart of a device that is off powers it up",
+			action: devices.GracefulRestart,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerState(gomock.Any()).
+					Return(powerOffState, nil)
+				hmm.EXPECT().
+					SendPowerAction(gomock.Any(), 2).
+					Return(powerActionRes, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: powerActionRes,
+			err: nil,
+		},
+		{
+			name:   "GracefulShutdown without in-band agent powers down",
+			action: devices.GracefulShutdown,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerState(gomock.Any()).
+					Return([]service.CIM_AssociatedPowerManagementService{{
+						PowerState:                    2,
+						AvailableRequestedPowerStates: []service.AvailableRequestedPowerStates{5, 8, 10},
+					}}, nil)
+				hmm.EXPECT().
+					SendPowerAction(gomock.Any(), 8).
+					Return(powerActionRes, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: powerActionRes,
+			err: nil,
+		},
+		{
+			name:   "GracefulRestart rejected by the device resets",
+			action: devices.GracefulRestart,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerState(gomock.Any()).
+					Return([]service.CIM_AssociatedPowerManagementService{{
+						PowerState:                    2,
+						AvailableRequestedPowerStates: []service.AvailableRequestedPowerStates{5, 8, 10, 12, 14},
+					}}, nil)
+				hmm.EXPECT().
+					GetOSPowerSavingState(gomock.Any()).
+					Return(ipspower.OSPowerSavingState(2), nil)
+				hmm.EXPECT().
+					SendPowerAction(gomock.Any(), 12).
+					Return(power.PowerActionResponse{ReturnValue: 1}, nil)
+				hmm.EXPECT().
+					SendPowerAction(gomock.Any(), 10).
+					Return(powerActionRes, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: powerActionRes,
+			err: nil,
+		},
 	}
 
 	for _, tc := range tests {
@@ -282,6 +485,40 @@ func TestSendPowerAction(t *testing.T) {
 	}
 }
 
+func TestSendPowerActionPublishesPowerChange(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+	wsmanMock := mocks.NewMockWSMAN(mockCtl)
+	wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+	management := mocks.NewMockManagement(mockCtl)
+
+	bus := eventbus.New()
+	defer bus.Close()
+
+	changes := make(chan eventbus.DevicePowerChangedEvent, 1)
+	eventbus.Subscribe(bus, eventbus.DevicePowerChanged, func(e eventbus.DevicePowerChangedEvent) { changes <- e })
+
+	useCase := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, bus)
+
+	repo.EXPECT().GetByID(gomock.Any(), "device-guid-123", "").Return(&entity.Device{GUID: "device-guid-123"}, nil)
+	wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
+	management.EXPECT().GetPowerState(gomock.Any()).Return([]service.CIM_AssociatedPowerManagementService{{PowerState: 2}}, nil)
+	management.EXPECT().SendPowerAction(gomock.Any(), 8).Return(power.PowerActionResponse{ReturnValue: 0}, nil)
+
+	_, err := useCase.SendPowerAction(context.Background(), "device-guid-123", 8)
+	require.NoError(t, err)
+
+	select {
+	case e := <-changes:
+		require.Equal(t, eventbus.DevicePowerChangedEvent{GUID: "device-guid-123", Action: 8}, e)
+	case <-time.After(time.Second):
+		require.Fail(t, "power change not published")
+	}
+}
+
 func TestGetPowerState(t *testing.T) {
 	t.Parallel()
 
@@ -298,10 +535,10 @@ func TestGetPowerState(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetPowerState().
+					GetPowerState(gomock.Any()).
 					Return([]service.CIM_AssociatedPowerManagementService{{PowerState: 0}}, nil)
 				hmm.EXPECT().
-					GetOSPowerSavingState().
+					GetOSPowerSavingState(gomock.Any()).
 					Return(ipspower.OSPowerSavingState(3), nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -333,7 +570,7 @@ func TestGetPowerState(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetPowerState().
+					GetPowerState(gomock.Any()).
 					Return([]service.CIM_AssociatedPowerManagementService{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -351,10 +588,10 @@ func TestGetPowerState(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetPowerState().
+					GetPowerState(gomock.Any()).
 					Return([]service.CIM_AssociatedPowerManagementService{{PowerState: 0}}, nil)
 				hmm.EXPECT().
-					GetOSPowerSavingState().
+					GetOSPowerSavingState(gomock.Any()).
 					Return(ipspower.OSPowerSavingState(0), ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -368,6 +605,29 @@ func TestGetPowerState(t *testing.T) {
 			},
 			err: ErrGeneral,
 		},
+		{
+			name: "firmware without OS power saving",
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerState(gomock.Any()).
+					Return([]service.CIM_AssociatedPowerManagementService{{PowerState: 2}}, nil)
+				hmm.EXPECT().
+					GetOSPowerSavingState(gomock.Any()).
+					Return(ipspower.OSPowerSavingState(0), amterror.DecodeAMTErrorString(DestinationUnreachable))
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: dto.PowerState{
+				PowerState:         2,
+				OSPowerSavingState: int(ipspower.Unsupported),
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -404,10 +664,10 @@ func TestGetPowerCapabilities(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetAMTVersion().
+					GetAMTVersion(gomock.Any()).
 					Return([]software.SoftwareIdentity{}, nil)
 				hmm.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{}, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -447,10 +707,10 @@ func TestGetPowerCapabilities(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetPowerCapabilities().
+					GetPowerCapabilities(gomock.Any()).
 					Return(boot.BootCapabilitiesResponse{}, ErrGeneral)
 				hmm.EXPECT().
-					GetAMTVersion().
+					GetAMTVersion(gomock.Any()).
 					Return(nil, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -528,7 +788,9 @@ func TestSetBootOptions(t *testing.T) {
 		UseSOL: true,
 	}
 
-	powerActionRes := power.PowerActionResponse{ReturnValue: 5}
+	powerActionRes := power.PowerActionResponse{ReturnValue: 0}
+
+	bootCapabilities := boot.BootCapabilitiesResponse{IDER: true, ForcePXEBoot: true}
 
 	tests := []test{
 		{
@@ -538,22 +800,25 @@ func TestSetBootOptions(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetBootData().
+					GetPowerCapabilities(gomock.Any()).
+					Return(bootCapabilities, nil)
+				hmm.EXPECT().
+					GetBootData(gomock.Any()).
 					Return(bootResponse, nil)
 				hmm.EXPECT().
-					ChangeBootOrder("").
+					ChangeBootOrder(gomock.Any(), "").
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
 				hmm.EXPECT().
-					SetBootData(gomock.Any()).
+					SetBootData(gomock.Any(), gomock.Any()).
 					Return(nil, nil)
 				hmm.EXPECT().
-					SetBootConfigRole(1).
+					SetBootConfigRole(gomock.Any(), 1).
 					Return(powerActionRes, nil)
 				hmm.EXPECT().
-					ChangeBootOrder(string(cimBoot.PXE)).
+					ChangeBootOrder(gomock.Any(), string(cimBoot.PXE)).
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
 				hmm.EXPECT().
-					SendPowerAction(10).
+					SendPowerAction(gomock.Any(), 10).
 					Return(powerActionRes, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -564,6 +829,42 @@ func TestSetBootOptions(t *testing.T) {
 			res: powerActionRes,
 			err: nil,
 		},
+		{
+			name: "device refuses the boot action",
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerCapabilities(gomock.Any()).
+					Return(bootCapabilities, nil)
+				hmm.EXPECT().
+					GetBootData(gomock.Any()).
+					Return(bootResponse, nil)
+				hmm.EXPECT().
+					ChangeBootOrder(gomock.Any(), "").
+					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
+				hmm.EXPECT().
+					SetBootData(gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				hmm.EXPECT().
+					SetBootConfigRole(gomock.Any(), 1).
+					Return(powerActionRes, nil)
+				hmm.EXPECT().
+					ChangeBootOrder(gomock.Any(), string(cimBoot.PXE)).
+					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
+				hmm.EXPECT().
+					SendPowerAction(gomock.Any(), 10).
+					Return(power.PowerActionResponse{ReturnValue: 5}, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: power.PowerActionResponse{ReturnValue: 5},
+			err: amtstatus.Error{Operation: "RequestPowerStateChange", Explanation: amtstatus.ExplainPower(5)},
+		},
 		{
 			name:    "GetById fails",
 			manMock: func(_ *mocks.MockWSMAN, _ *mocks.MockManagement) {},
@@ -582,7 +883,10 @@ func TestSetBootOptions(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetBootData().
+					GetPowerCapabilities(gomock.Any()).
+					Return(bootCapabilities, nil)
+				hmm.EXPECT().
+					GetBootData(gomock.Any()).
 					Return(boot.BootSettingDataResponse{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -600,10 +904,13 @@ func TestSetBootOptions(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetBootData().
+					GetPowerCapabilities(gomock.Any()).
+					Return(bootCapabilities, nil)
+				hmm.EXPECT().
+					GetBootData(gomock.Any()).
 					Return(bootResponse, nil)
 				hmm.EXPECT().
-					ChangeBootOrder("").
+					ChangeBootOrder(gomock.Any(), "").
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -621,13 +928,16 @@ func TestSetBootOptions(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetBootData().
+					GetPowerCapabilities(gomock.Any()).
+					Return(bootCapabilities, nil)
+				hmm.EXPECT().
+					GetBootData(gomock.Any()).
 					Return(bootResponse, nil)
 				hmm.EXPECT().
-					ChangeBootOrder("").
+					ChangeBootOrder(gomock.Any(), "").
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
 				hmm.EXPECT().
-					SetBootData(gomock.Any()).
+					SetBootData(gomock.Any(), gomock.Any()).
 					Return(nil, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -645,16 +955,19 @@ func TestSetBootOptions(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetBootData().
+					GetPowerCapabilities(gomock.Any()).
+					Return(bootCapabilities, nil)
+				hmm.EXPECT().
+					GetBootData(gomock.Any()).
 					Return(bootResponse, nil)
 				hmm.EXPECT().
-					ChangeBootOrder("").
+					ChangeBootOrder(gomock.Any(), "").
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
 				hmm.EXPECT().
-					SetBootData(gomock.Any()).
+					SetBootData(gomock.Any(), gomock.Any()).
 					Return(nil, nil)
 				hmm.EXPECT().
-					SetBootConfigRole(1).
+					SetBootConfigRole(gomock.Any(), 1).
 					Return(powerActionRes, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -672,19 +985,22 @@ func TestSetBootOptions(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetBootData().
+					GetPowerCapabilities(gomock.Any()).
+					Return(bootCapabilities, nil)
+				hmm.EXPECT().
+					GetBootData(gomock.Any()).
 					Return(bootResponse, nil)
 				hmm.EXPECT().
-					ChangeBootOrder("").
+					ChangeBootOrder(gomock.Any(), "").
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
 				hmm.EXPECT().
-					SetBootData(gomock.Any()).
+					SetBootData(gomock.Any(), gomock.Any()).
 					Return(nil, nil)
 				hmm.EXPECT().
-					SetBootConfigRole(1).
+					SetBootConfigRole(gomock.Any(), 1).
 					Return(powerActionRes, nil)
 				hmm.EXPECT().
-					ChangeBootOrder(string(cimBoot.PXE)).
+					ChangeBootOrder(gomock.Any(), string(cimBoot.PXE)).
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -702,22 +1018,25 @@ func TestSetBootOptions(t *testing.T) {
 					SetupWsmanClient(gomock.Any(), false, true).
 					Return(hmm, nil)
 				hmm.EXPECT().
-					GetBootData().
+					GetPowerCapabilities(gomock.Any()).
+					Return(bootCapabilities, nil)
+				hmm.EXPECT().
+					GetBootData(gomock.Any()).
 					Return(bootResponse, nil)
 				hmm.EXPECT().
-					ChangeBootOrder("").
+					ChangeBootOrder(gomock.Any(), "").
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
 				hmm.EXPECT().
-					SetBootData(gomock.Any()).
+					SetBootData(gomock.Any(), gomock.Any()).
 					Return(nil, nil)
 				hmm.EXPECT().
-					SetBootConfigRole(1).
+					SetBootConfigRole(gomock.Any(), 1).
 					Return(powerActionRes, nil)
 				hmm.EXPECT().
-					ChangeBootOrder(string(cimBoot.PXE)).
+					ChangeBootOrder(gomock.Any(), string(cimBoot.PXE)).
 					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
 				hmm.EXPECT().
-					SendPowerAction(10).
+					SendPowerAction(gomock.Any(), 10).
 					Return(powerActionRes, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
@@ -728,6 +1047,74 @@ func TestSetBootOptions(t *testing.T) {
 			res: power.PowerActionResponse{},
 			err: ErrGeneral,
 		},
+		{
+			name:   "GetPowerCapabilities fails",
+			action: 400,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerCapabilities(gomock.Any()).
+					Return(boot.BootCapabilitiesResponse{}, ErrGeneral)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: power.PowerActionResponse{},
+			err: ErrGeneral,
+		},
+		{
+			name:   "boot action not supported by the device",
+			action: 101,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerCapabilities(gomock.Any()).
+					Return(bootCapabilities, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: power.PowerActionResponse{},
+			err: devices.ErrValidationUseCase.Wrap("SetBootOptions", "check boot capabilities",
+				"boot action 101 (Reset to BIOS) is not supported by the device, supported boot actions: "+
+					"200 (Reset to IDE-R Floppy), 201 (Power on to IDE-R Floppy), 202 (Reset to IDE-R CDROM), 203 (Power on to IDE-R CDROM), "+
+					"400 (Reset to PXE), 401 (Power on to PXE)"),
+		},
+		{
+			name:   "IDE-R boot action while IDE redirection is disabled",
+			action: 202,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm, nil)
+				hmm.EXPECT().
+					GetPowerCapabilities(gomock.Any()).
+					Return(bootCapabilities, nil)
+				hmm.EXPECT().
+					GetAMTRedirectionService(gomock.Any()).
+					Return(redirection.Response{
+						Body: redirection.Body{
+							GetAndPutResponse: redirection.RedirectionResponse{EnabledState: redirection.SOLIsEnabledAndIDERIsDisabled},
+						},
+					}, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			res: power.PowerActionResponse{},
+			err: devices.ErrValidationUseCase.Wrap("SetBootOptions", "check redirection state",
+				"boot action 202 (Reset to IDE-R CDROM) requires IDE redirection, which is disabled on the device"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -739,10 +1126,20 @@ func TestSetBootOptions(t *testing.T) {
 			tc.manMock(wsmanMock, management)
 			tc.repoMock(repo)
 
-			res, err := useCase.SetBootOptions(context.Background(), device.GUID, bootSetting)
+			setting := bootSetting
+			if tc.action != 0 {
+				setting.Action = tc.action
+			}
+
+			res, err := useCase.SetBootOptions(context.Background(), device.GUID, setting)
 
 			require.Equal(t, tc.res, res)
 			require.IsType(t, tc.err, err)
+
+			var validationErr devices.ValidationError
+			if errors.As(tc.err, &validationErr) {
+				require.Equal(t, tc.err, err)
+			}
 		})
 	}
 }
@@ -798,7 +1195,7 @@ func TestGetBootSourceSetting(t *testing.T) {
 			name: "success",
 			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
 				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(hmm, nil)
-				hmm.EXPECT().GetCIMBootSourceSetting().Return(settingsResponse, nil)
+				hmm.EXPECT().GetCIMBootSourceSetting(gomock.Any()).Return(settingsResponse, nil)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
 				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
@@ -819,7 +1216,7 @@ func TestGetBootSourceSetting(t *testing.T) {
 			name: "GetCIMBootSourceSetting error",
 			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
 				man.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(hmm, nil)
-				hmm.EXPECT().GetCIMBootSourceSetting().Return(settingsResponse, ErrGeneral)
+				hmm.EXPECT().GetCIMBootSourceSetting(gomock.Any()).Return(settingsResponse, ErrGeneral)
 			},
 			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
 				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
@@ -971,3 +1368,11 @@ func TestValidatePBAWinReBootParams(t *testing.T) {
 		})
 	}
 }
+
+func TestDescribePowerAction(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "8 (Power down)", devices.DescribePowerAction(8))
+	assert.Equal(t, "601 (Graceful restart)", devices.DescribePowerAction(devices.GracefulRestart))
+	assert.Equal(t, "3", devices.DescribePowerAction(3))
+}
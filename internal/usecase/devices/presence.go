@@ -0,0 +1,90 @@
+package devices
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// presenceTTL is how long a presence ping counts, viewers ping more often while the view is open.
+const presenceTTL = 30 * time.Second
+
+type presenceKey struct {
+	user string
+	view string
+}
+
+// PingPresence records that user has the view of the device open and returns the users with the device open.
+func (uc *UseCase) PingPresence(_ context.Context, guid, user string, ping dto.PresencePing) ([]dto.Presence, error) {
+	if user == "" {
+		return nil, ErrValidationUseCase.Wrap("PingPresence", "validate user", "presence requires an authenticated user")
+	}
+
+	if ping.View != dto.PresenceViewDetails && ping.View != dto.PresenceViewKVM {
+		return nil, ErrValidationUseCase.Wrap("PingPresence", "validate view", "view must be details or kvm")
+	}
+
+	guid = strings.ToLower(guid)
+	now := time.Now()
+
+	uc.presenceMutex.Lock()
+	defer uc.presenceMutex.Unlock()
+
+	uc.prunePresence(now)
+
+	if uc.presence == nil {
+		uc.presence = make(map[string]map[presenceKey]time.Time)
+	}
+
+	if uc.presence[guid] == nil {
+		uc.presence[guid] = make(map[presenceKey]time.Time)
+	}
+
+	uc.presence[guid][presenceKey{user: user, view: ping.View}] = now
+
+	return uc.devicePresence(guid), nil
+}
+
+// GetPresence returns the users with the device open.
+func (uc *UseCase) GetPresence(_ context.Context, guid string) []dto.Presence {
+	uc.presenceMutex.Lock()
+	defer uc.presenceMutex.Unlock()
+
+	uc.prunePresence(time.Now())
+
+	return uc.devicePresence(strings.ToLower(guid))
+}
+
+// prunePresence drops the pings older than presenceTTL, the caller holds presenceMutex.
+func (uc *UseCase) prunePresence(now time.Time) {
+	for guid, viewers := range uc.presence {
+		for key, seen := range viewers {
+			if now.Sub(seen) > presenceTTL {
+				delete(viewers, key)
+			}
+		}
+
+		if len(viewers) == 0 {
+			delete(uc.presence, guid)
+		}
+	}
+}
+
+// devicePresence lists the viewers of the device by user, the caller holds presenceMutex.
+func (uc *UseCase) devicePresence(guid string) []dto.Presence {
+	presence := make([]dto.Presence, 0, len(uc.presence[guid]))
+
+	for key, seen := range uc.presence[guid] {
+		presence = append(presence, dto.Presence{User: key.user, View: key.view, LastSeen: seen})
+	}
+
+	slices.SortFunc(presence, func(a, b dto.Presence) int {
+		return cmp.Or(strings.Compare(a.User, b.User), strings.Compare(a.View, b.View))
+	})
+
+	return presence
+}
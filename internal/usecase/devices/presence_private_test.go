@@ -0,0 +1,60 @@
+package devices
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+func TestPresence(t *testing.T) {
+	t.Parallel()
+
+	uc := &UseCase{}
+	ctx := context.Background()
+
+	require.Empty(t, uc.GetPresence(ctx, "guid"))
+
+	presence, err := uc.PingPresence(ctx, "GUID", "bob", dto.PresencePing{View: dto.PresenceViewDetails})
+	require.NoError(t, err)
+	require.Len(t, presence, 1)
+
+	_, err = uc.PingPresence(ctx, "guid", "alice", dto.PresencePing{View: dto.PresenceViewKVM})
+	require.NoError(t, err)
+
+	_, err = uc.PingPresence(ctx, "other", "carol", dto.PresencePing{View: dto.PresenceViewKVM})
+	require.NoError(t, err)
+
+	presence = uc.GetPresence(ctx, "guid")
+	require.Len(t, presence, 2)
+	require.Equal(t, "alice", presence[0].User)
+	require.Equal(t, dto.PresenceViewKVM, presence[0].View)
+	require.Equal(t, "bob", presence[1].User)
+	require.Equal(t, dto.PresenceViewDetails, presence[1].View)
+
+	// pings older than the TTL are dropped
+	uc.presenceMutex.Lock()
+	uc.presence["guid"][presenceKey{user: "bob", view: dto.PresenceViewDetails}] = time.Now().Add(-presenceTTL - time.Second)
+	uc.presenceMutex.Unlock()
+
+	presence = uc.GetPresence(ctx, "guid")
+	require.Len(t, presence, 1)
+	require.Equal(t, "alice", presence[0].User)
+}
+
+func TestPingPresenceValidation(t *testing.T) {
+	t.Parallel()
+
+	uc := &UseCase{}
+
+	_, err := uc.PingPresence(context.Background(), "guid", "", dto.PresencePing{View: dto.PresenceViewKVM})
+	require.Equal(t, ErrValidationUseCase.Wrap("PingPresence", "validate user", "presence requires an authenticated user"), err)
+
+	_, err = uc.PingPresence(context.Background(), "guid", "alice", dto.PresencePing{View: "sol"})
+	require.Equal(t, ErrValidationUseCase.Wrap("PingPresence", "validate view", "view must be details or kvm"), err)
+
+	require.Empty(t, uc.GetPresence(context.Background(), "guid"))
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 
 	"github.com/device-management-toolkit/console/internal/entity"
+	wsmanAPI "github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
 type Redirector struct {
@@ -15,13 +17,20 @@ type Redirector struct {
 }
 
 func (g *Redirector) SetupWsmanClient(device entity.Device, isRedirection, logAMTMessages bool) wsman.Messages {
+	clientParams := redirectionParams(device, isRedirection, logAMTMessages)
+	clientParams.Password, _ = g.SafeRequirements.Decrypt(device.Password)
+
+	return wsman.NewMessages(clientParams)
+}
+
+func redirectionParams(device entity.Device, isRedirection, logAMTMessages bool) client.Parameters {
 	clientParams := client.Parameters{
-		Target:            device.Hostname,
+		Target:            wsmanAPI.TargetHost(device, false),
 		Username:          device.Username,
 		UseDigest:         true,
 		UseTLS:            device.UseTLS,
 		SelfSignedAllowed: device.AllowSelfSigned,
-		LogAMTMessages:    logAMTMessages,
+		LogAMTMessages:    logAMTMessages && logger.DebugEnabled(logger.SubsystemWsman, device.GUID),
 		IsRedirection:     isRedirection,
 	}
 
@@ -29,9 +38,7 @@ func (g *Redirector) SetupWsmanClient(device entity.Device, isRedirection, logAM
 		clientParams.PinnedCert = *device.CertHash
 	}
 
-	clientParams.Password, _ = g.SafeRequirements.Decrypt(device.Password)
-
-	return wsman.NewMessages(clientParams)
+	return clientParams
 }
 
 func NewRedirector(safeRequirements security.Cryptor) *Redirector {
@@ -40,12 +47,31 @@ func NewRedirector(safeRequirements security.Cryptor) *Redirector {
 	}
 }
 
-func (g *Redirector) RedirectConnect(_ context.Context, deviceConnection *DeviceConnection) error {
-	err := deviceConnection.wsmanMessages.Client.Connect()
+// RedirectConnect connects the session to the device, through the proxy of the device when it has one.
+func (g *Redirector) RedirectConnect(ctx context.Context, deviceConnection *DeviceConnection) error {
+	proxyURL, err := wsmanAPI.ProxyFor(deviceConnection.Device)
 	if err != nil {
 		return err
 	}
 
+	// a reused session is connected already
+	if proxyURL == nil || deviceConnection.proxied {
+		return deviceConnection.wsmanMessages.Client.Connect()
+	}
+
+	conn, err := wsmanAPI.DialRedirection(ctx, proxyURL, deviceConnection.Device)
+	if err != nil {
+		return err
+	}
+
+	// the client takes over the connection opened through the proxy, the password of the session is decrypted
+	clientParams := redirectionParams(deviceConnection.Device, true, false)
+	clientParams.Password = deviceConnection.Device.Password
+	clientParams.Connection = conn
+
+	deviceConnection.wsmanMessages = wsman.NewMessages(clientParams)
+	deviceConnection.proxied = true
+
 	return nil
 }
 
@@ -0,0 +1,255 @@
+package devices
+
+import (
+	"context"
+	"math"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/kvmredirection"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	dtov2 "github.com/device-management-toolkit/console/internal/entity/dto/v2"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// GetRedirectionSettings returns the SOL, IDER and KVM redirection settings of the device.
+func (uc *UseCase) GetRedirectionSettings(c context.Context, guid string) (dto.RedirectionSettings, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.RedirectionSettings{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.RedirectionSettings{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.RedirectionSettings{}, err
+	}
+
+	settings, _, err := getRedirectionSettings(c, device)
+
+	return settings, err
+}
+
+// SetRedirectionSettings validates settings against the capabilities of the device and writes
+// them to the redirection service, the KVM redirection SAP and the KVM setting data.
+func (uc *UseCase) SetRedirectionSettings(c context.Context, guid string, settings dto.RedirectionSettings) (dto.RedirectionSettings, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.RedirectionSettings{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.RedirectionSettings{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.RedirectionSettings{}, err
+	}
+
+	current, data, err := getRedirectionSettings(c, device)
+	if err != nil {
+		return dto.RedirectionSettings{}, err
+	}
+
+	if err := validateRedirectionSettings(item, current, settings); err != nil {
+		return dto.RedirectionSettings{}, err
+	}
+
+	results := dtov2.Features{}
+
+	state, listenerEnabled, err := redirectionRequestStateChange(c, settings.SOL, settings.IDER, &results, device)
+	if err != nil {
+		return dto.RedirectionSettings{}, err
+	}
+
+	kvmListenerEnabled := 0
+	if current.KVM.Available {
+		kvmListenerEnabled, err = setKVM(c, settings.KVM.Enabled, &results, device)
+		if err != nil {
+			return dto.RedirectionSettings{}, err
+		}
+	}
+
+	err = setRedirectionService(c, state, listenerEnabled, kvmListenerEnabled, device)
+	if err != nil {
+		return dto.RedirectionSettings{}, err
+	}
+
+	if current.KVM.Available && kvmSettingDataChanged(current.KVM, settings.KVM) {
+		_, err = device.SetIPSKVMRedirectionSettingData(c, kvmSettingDataRequest(data, settings.KVM))
+		if err != nil {
+			return dto.RedirectionSettings{}, err
+		}
+
+		if settings.KVM.DefaultScreen != current.KVM.DefaultScreen {
+			uc.restartKVMSessions(item.GUID)
+		}
+	}
+
+	settings, _, err = getRedirectionSettings(c, device)
+
+	return settings, err
+}
+
+// SelectKVMDisplay makes the display at sel.DisplayIndex the one KVM sessions of the device show. AMT picks the
+// display when a session starts, so open KVM sessions are ended for their viewers to reconnect to it.
+func (uc *UseCase) SelectKVMDisplay(c context.Context, guid string, sel dto.KVMDisplaySelection) (dto.KVMDisplaySelected, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.KVMDisplaySelected{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.KVMDisplaySelected{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.KVMDisplaySelected{}, err
+	}
+
+	current, data, err := getRedirectionSettings(c, device)
+	if err != nil {
+		return dto.KVMDisplaySelected{}, err
+	}
+
+	switch {
+	case !current.KVM.Available:
+		return dto.KVMDisplaySelected{}, ErrValidationUseCase.Wrap("SelectKVMDisplay", "validate kvm", "KVM is not available on this device")
+	case sel.DisplayIndex < 0 || sel.DisplayIndex >= len(current.KVM.Displays):
+		return dto.KVMDisplaySelected{}, ErrValidationUseCase.Wrap("SelectKVMDisplay", "validate display", "display is not a display of the device")
+	case !current.KVM.Displays[sel.DisplayIndex].IsActive:
+		return dto.KVMDisplaySelected{}, ErrValidationUseCase.Wrap("SelectKVMDisplay", "validate display", "display is not active")
+	}
+
+	selected := dto.KVMDisplaySelected{Display: current.KVM.Displays[sel.DisplayIndex]}
+	selected.Display.IsDefault = true
+
+	if sel.DisplayIndex == current.KVM.DefaultScreen {
+		return selected, nil
+	}
+
+	settings := current.KVM
+	settings.DefaultScreen = sel.DisplayIndex
+
+	_, err = device.SetIPSKVMRedirectionSettingData(c, kvmSettingDataRequest(data, settings))
+	if err != nil {
+		return dto.KVMDisplaySelected{}, err
+	}
+
+	selected.RestartedSessions = uc.restartKVMSessions(item.GUID)
+
+	return selected, nil
+}
+
+// getRedirectionSettings reads the redirection settings of the device along with its KVM setting data,
+// which is only read when the device has KVM.
+func getRedirectionSettings(ctx context.Context, w wsman.Management) (dto.RedirectionSettings, kvmredirection.KVMRedirectionSettingsResponse, error) {
+	results := dtov2.Features{}
+
+	if err := getRedirectionService(ctx, &results, w); err != nil {
+		return dto.RedirectionSettings{}, kvmredirection.KVMRedirectionSettingsResponse{}, err
+	}
+
+	if err := getKVM(ctx, &results, w); err != nil {
+		return dto.RedirectionSettings{}, kvmredirection.KVMRedirectionSettingsResponse{}, err
+	}
+
+	settings := dto.RedirectionSettings{
+		ListenerEnabled: results.Redirection,
+		SOL:             results.EnableSOL,
+		IDER:            results.EnableIDER,
+		KVM: dto.KVMRedirectionSettings{
+			Enabled:   results.EnableKVM,
+			Available: results.KVMAvailable,
+			Displays:  []dto.KVMScreenDisplay{},
+		},
+	}
+
+	if !results.KVMAvailable {
+		return settings, kvmredirection.KVMRedirectionSettingsResponse{}, nil
+	}
+
+	pull, err := w.GetIPSKVMRedirectionSettingData(ctx)
+	if err != nil {
+		return dto.RedirectionSettings{}, kvmredirection.KVMRedirectionSettingsResponse{}, err
+	}
+
+	items := pull.Body.PullResponse.KVMRedirectionSettingsItems
+	if len(items) == 0 {
+		return dto.RedirectionSettings{}, kvmredirection.KVMRedirectionSettingsResponse{}, ErrNotSupportedUseCase.Wrap("getRedirectionSettings", "device.GetIPSKVMRedirectionSettingData", "device reports no KVM redirection setting data")
+	}
+
+	data := items[0]
+
+	screens, err := w.GetIPSScreenSettingData(ctx)
+	if err != nil {
+		return dto.RedirectionSettings{}, kvmredirection.KVMRedirectionSettingsResponse{}, err
+	}
+
+	settings.KVM.EnabledByMEBx = data.EnabledByMEBx
+	settings.KVM.OptInPolicy = data.OptInPolicy
+	settings.KVM.SessionTimeout = int(data.SessionTimeout)
+	settings.KVM.Port5900Enabled = data.Is5900PortEnabled
+	settings.KVM.DefaultScreen = int(data.DefaultScreen)
+	settings.KVM.Displays = kvmDisplays(screens.Body.PullResponse.ScreenSettingDataItems, int(data.DefaultScreen))
+
+	return settings, data, nil
+}
+
+// validateRedirectionSettings checks the flags of settings that depend on each other or on the device.
+func validateRedirectionSettings(item *entity.Device, current, settings dto.RedirectionSettings) error {
+	switch {
+	case settings.KVM.SessionTimeout < 0 || settings.KVM.SessionTimeout > math.MaxUint16:
+		return ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate session timeout", "session timeout out of range")
+	case settings.KVM.DefaultScreen < 0 || settings.KVM.DefaultScreen > math.MaxUint8:
+		return ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate default screen", "default screen out of range")
+	case settings.KVM.Enabled && !current.KVM.Available:
+		return ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate kvm", "KVM is not available on this device")
+	case settings.KVM.Enabled && !current.KVM.EnabledByMEBx:
+		return ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate kvm", "KVM is disabled in MEBx")
+	case settings.KVM.Port5900Enabled && !settings.KVM.Enabled:
+		return ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate port 5900", "port 5900 requires KVM to be enabled")
+	case !settings.KVM.OptInPolicy && current.KVM.OptInPolicy && item.ControlMode == dto.ControlModeCCM:
+		// AMT requires user consent for KVM sessions of devices in client control mode
+		return ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate opt-in policy", "user consent cannot be turned off in client control mode")
+	case len(current.KVM.Displays) > 0 && settings.KVM.DefaultScreen >= len(current.KVM.Displays):
+		return ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate default screen", "default screen is not a display of the device")
+	case settings.KVM.DefaultScreen != current.KVM.DefaultScreen && len(current.KVM.Displays) > 0 && !current.KVM.Displays[settings.KVM.DefaultScreen].IsActive:
+		return ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate default screen", "default screen is not an active display")
+	}
+
+	return nil
+}
+
+func kvmSettingDataChanged(current, settings dto.KVMRedirectionSettings) bool {
+	return current.OptInPolicy != settings.OptInPolicy ||
+		current.SessionTimeout != settings.SessionTimeout ||
+		current.Port5900Enabled != settings.Port5900Enabled ||
+		current.DefaultScreen != settings.DefaultScreen
+}
+
+// kvmSettingDataRequest builds the put of IPS_KVMRedirectionSettingData from the current data of the device.
+func kvmSettingDataRequest(data kvmredirection.KVMRedirectionSettingsResponse, settings dto.KVMRedirectionSettings) *kvmredirection.KVMRedirectionSettingsRequest {
+	return &kvmredirection.KVMRedirectionSettingsRequest{
+		XMLName:                        data.XMLName,
+		ElementName:                    data.ElementName,
+		InstanceID:                     data.InstanceID,
+		OptInPolicy:                    settings.OptInPolicy,
+		SessionTimeout:                 uint16(settings.SessionTimeout), //nolint:gosec // range checked by validateRedirectionSettings
+		RFBPassword:                    data.RFBPassword,
+		DefaultScreen:                  uint8(settings.DefaultScreen), //nolint:gosec // range checked by validateRedirectionSettings
+		InitialDecimationModeForLowRes: data.InitialDecimationModeForLowRes,
+		GreyscalePixelFormatSupported:  data.GreyscalePixelFormatSupported,
+		ZlibControlSupported:           data.ZlibControlSupported,
+		DoubleBufferMode:               data.DoubleBufferMode,
+		DoubleBufferState:              data.DoubleBufferState,
+		EnabledByMEBx:                  data.EnabledByMEBx,
+		Is5900PortEnabled:              settings.Port5900Enabled,
+		BackToBackFbMode:               data.BackToBackFbMode,
+	}
+}
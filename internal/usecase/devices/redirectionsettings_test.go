@@ -0,0 +1,328 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/amterror"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/kvm"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/kvmredirection"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/screensetting"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func initRedirectionSettingsTest(t *testing.T, device *entity.Device) (*devices.UseCase, *mocks.MockManagement) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+	repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+
+	management := mocks.NewMockManagement(mockCtl)
+	wsmanMock := mocks.NewMockWSMAN(mockCtl)
+	wsmanMock.EXPECT().Worker().Return().AnyTimes()
+	wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(management, nil)
+
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, nil)
+
+	return u, management
+}
+
+// expectRedirectionSettingsRead answers the reads of a device with SOL, IDER and KVM enabled and two of three displays active.
+func expectRedirectionSettingsRead(management *mocks.MockManagement, data kvmredirection.KVMRedirectionSettingsResponse) {
+	redirectionResponse := redirection.Response{}
+	redirectionResponse.Body.GetAndPutResponse = redirection.RedirectionResponse{
+		ElementName:     "Intel(r) AMT Redirection Service",
+		EnabledState:    redirection.IDERAndSOLAreEnabled,
+		ListenerEnabled: true,
+	}
+
+	kvmResponse := kvm.Response{}
+	kvmResponse.Body.GetResponse.EnabledState = kvm.EnabledState(redirection.Enabled)
+
+	settingData := kvmredirection.Response{}
+	settingData.Body.PullResponse.KVMRedirectionSettingsItems = []kvmredirection.KVMRedirectionSettingsResponse{data}
+
+	screens := screensetting.Response{}
+	screens.Body.PullResponse.ScreenSettingDataItems = []screensetting.ScreenSettingDataResponse{{
+		PrimaryIndex: 1,
+		IsActive:     []bool{true, true, false},
+		ResolutionX:  []int{1920, 1280, 0},
+		ResolutionY:  []int{1080, 720, 0},
+	}}
+
+	management.EXPECT().GetAMTRedirectionService(context.Background()).Return(redirectionResponse, nil).AnyTimes()
+	management.EXPECT().GetKVMRedirection(context.Background()).Return(kvmResponse, nil).AnyTimes()
+	management.EXPECT().GetIPSKVMRedirectionSettingData(context.Background()).Return(settingData, nil).AnyTimes()
+	management.EXPECT().GetIPSScreenSettingData(context.Background()).Return(screens, nil).AnyTimes()
+}
+
+var kvmSettingData = kvmredirection.KVMRedirectionSettingsResponse{
+	ElementName:    "Intel(r) KVM Redirection Settings",
+	InstanceID:     "Intel(r) KVM Redirection Settings",
+	EnabledByMEBx:  true,
+	OptInPolicy:    true,
+	SessionTimeout: 15,
+	RFBPassword:    "P@ssw0rd",
+}
+
+func TestGetRedirectionSettings(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+	useCase, management := initRedirectionSettingsTest(t, device)
+	expectRedirectionSettingsRead(management, kvmSettingData)
+
+	settings, err := useCase.GetRedirectionSettings(context.Background(), device.GUID)
+	require.NoError(t, err)
+	require.True(t, settings.ListenerEnabled)
+	require.True(t, settings.SOL)
+	require.True(t, settings.IDER)
+	require.True(t, settings.KVM.Enabled)
+	require.True(t, settings.KVM.Available)
+	require.True(t, settings.KVM.EnabledByMEBx)
+	require.True(t, settings.KVM.OptInPolicy)
+	require.Equal(t, 15, settings.KVM.SessionTimeout)
+	require.False(t, settings.KVM.Port5900Enabled)
+	require.Equal(t, 0, settings.KVM.DefaultScreen)
+	require.Len(t, settings.KVM.Displays, 3)
+	require.True(t, settings.KVM.Displays[0].IsDefault)
+	require.Equal(t, "primary", settings.KVM.Displays[0].Role)
+}
+
+func TestGetRedirectionSettingsWithoutKVM(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+	useCase, management := initRedirectionSettingsTest(t, device)
+
+	redirectionResponse := redirection.Response{}
+	redirectionResponse.Body.GetAndPutResponse.EnabledState = redirection.SOLIsEnabledAndIDERIsDisabled
+	management.EXPECT().GetAMTRedirectionService(context.Background()).Return(redirectionResponse, nil)
+	management.EXPECT().GetKVMRedirection(context.Background()).Return(kvm.Response{}, amterror.DecodeAMTErrorString(DestinationUnreachable))
+
+	settings, err := useCase.GetRedirectionSettings(context.Background(), device.GUID)
+	require.NoError(t, err)
+	require.Equal(t, dto.RedirectionSettings{SOL: true, KVM: dto.KVMRedirectionSettings{Displays: []dto.KVMScreenDisplay{}}}, settings)
+}
+
+func TestSetRedirectionSettings(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+	useCase, management := initRedirectionSettingsTest(t, device)
+	expectRedirectionSettingsRead(management, kvmSettingData)
+
+	management.EXPECT().RequestAMTRedirectionServiceStateChange(context.Background(), true, false).
+		Return(redirection.RequestedState(redirection.IDERIsEnabledAndSOLIsDisabled), 1, nil)
+	management.EXPECT().SetKVMRedirection(context.Background(), true).Return(1, nil)
+	management.EXPECT().SetAMTRedirectionService(context.Background(), &redirection.RedirectionRequest{
+		ElementName:     "Intel(r) AMT Redirection Service",
+		EnabledState:    redirection.IDERIsEnabledAndSOLIsDisabled,
+		ListenerEnabled: true,
+	}).Return(redirection.Response{}, nil)
+	management.EXPECT().SetIPSKVMRedirectionSettingData(context.Background(), &kvmredirection.KVMRedirectionSettingsRequest{
+		ElementName:       kvmSettingData.ElementName,
+		InstanceID:        kvmSettingData.InstanceID,
+		EnabledByMEBx:     true,
+		OptInPolicy:       true,
+		SessionTimeout:    30,
+		RFBPassword:       kvmSettingData.RFBPassword,
+		DefaultScreen:     1,
+		Is5900PortEnabled: true,
+	}).Return(kvmredirection.Response{}, nil)
+
+	_, err := useCase.SetRedirectionSettings(context.Background(), device.GUID, dto.RedirectionSettings{
+		IDER: true,
+		KVM: dto.KVMRedirectionSettings{
+			Enabled:         true,
+			OptInPolicy:     true,
+			SessionTimeout:  30,
+			Port5900Enabled: true,
+			DefaultScreen:   1,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestSetRedirectionSettingsKeepsKVMSettingData(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+	useCase, management := initRedirectionSettingsTest(t, device)
+	expectRedirectionSettingsRead(management, kvmSettingData)
+
+	management.EXPECT().RequestAMTRedirectionServiceStateChange(context.Background(), false, false).
+		Return(redirection.RequestedState(redirection.IDERAndSOLAreDisabled), 0, nil)
+	management.EXPECT().SetKVMRedirection(context.Background(), false).Return(0, nil)
+	management.EXPECT().SetAMTRedirectionService(context.Background(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *redirection.RedirectionRequest) (redirection.Response, error) {
+			require.False(t, request.ListenerEnabled)
+
+			return redirection.Response{}, nil
+		})
+
+	_, err := useCase.SetRedirectionSettings(context.Background(), device.GUID, dto.RedirectionSettings{
+		KVM: dto.KVMRedirectionSettings{OptInPolicy: true, SessionTimeout: 15},
+	})
+	require.NoError(t, err)
+}
+
+func TestSetRedirectionSettingsValidation(t *testing.T) {
+	t.Parallel()
+
+	disabledInMEBx := kvmSettingData
+	disabledInMEBx.EnabledByMEBx = false
+
+	tests := []struct {
+		name        string
+		controlMode string
+		data        kvmredirection.KVMRedirectionSettingsResponse
+		kvm         dto.KVMRedirectionSettings
+		err         error
+	}{
+		{
+			name: "kvm disabled in MEBx",
+			data: disabledInMEBx,
+			kvm:  dto.KVMRedirectionSettings{Enabled: true, OptInPolicy: true},
+			err:  devices.ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate kvm", "KVM is disabled in MEBx"),
+		},
+		{
+			name: "port 5900 without kvm",
+			data: kvmSettingData,
+			kvm:  dto.KVMRedirectionSettings{Port5900Enabled: true, OptInPolicy: true},
+			err:  devices.ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate port 5900", "port 5900 requires KVM to be enabled"),
+		},
+		{
+			name:        "user consent off in client control mode",
+			controlMode: dto.ControlModeCCM,
+			data:        kvmSettingData,
+			kvm:         dto.KVMRedirectionSettings{Enabled: true},
+			err:         devices.ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate opt-in policy", "user consent cannot be turned off in client control mode"),
+		},
+		{
+			name: "default screen is not a display",
+			data: kvmSettingData,
+			kvm:  dto.KVMRedirectionSettings{Enabled: true, OptInPolicy: true, DefaultScreen: 3},
+			err:  devices.ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate default screen", "default screen is not a display of the device"),
+		},
+		{
+			name: "default screen is not active",
+			data: kvmSettingData,
+			kvm:  dto.KVMRedirectionSettings{Enabled: true, OptInPolicy: true, DefaultScreen: 2},
+			err:  devices.ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate default screen", "default screen is not an active display"),
+		},
+		{
+			name: "session timeout out of range",
+			data: kvmSettingData,
+			kvm:  dto.KVMRedirectionSettings{Enabled: true, OptInPolicy: true, SessionTimeout: 65536},
+			err:  devices.ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate session timeout", "session timeout out of range"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456", ControlMode: tc.controlMode}
+			useCase, management := initRedirectionSettingsTest(t, device)
+			expectRedirectionSettingsRead(management, tc.data)
+
+			_, err := useCase.SetRedirectionSettings(context.Background(), device.GUID, dto.RedirectionSettings{SOL: true, KVM: tc.kvm})
+			require.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestSetRedirectionSettingsWithoutKVM(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+	useCase, management := initRedirectionSettingsTest(t, device)
+
+	management.EXPECT().GetAMTRedirectionService(context.Background()).Return(redirection.Response{}, nil)
+	management.EXPECT().GetKVMRedirection(context.Background()).Return(kvm.Response{}, amterror.DecodeAMTErrorString(DestinationUnreachable))
+
+	_, err := useCase.SetRedirectionSettings(context.Background(), device.GUID, dto.RedirectionSettings{KVM: dto.KVMRedirectionSettings{Enabled: true}})
+	require.Equal(t, devices.ErrValidationUseCase.Wrap("SetRedirectionSettings", "validate kvm", "KVM is not available on this device"), err)
+}
+
+func TestSelectKVMDisplay(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+	useCase, management := initRedirectionSettingsTest(t, device)
+	expectRedirectionSettingsRead(management, kvmSettingData)
+
+	management.EXPECT().SetIPSKVMRedirectionSettingData(context.Background(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *kvmredirection.KVMRedirectionSettingsRequest) (kvmredirection.Response, error) {
+			require.Equal(t, uint8(1), request.DefaultScreen)
+			require.Equal(t, kvmSettingData.SessionTimeout, request.SessionTimeout)
+			require.True(t, request.OptInPolicy)
+
+			return kvmredirection.Response{}, nil
+		})
+
+	selected, err := useCase.SelectKVMDisplay(context.Background(), device.GUID, dto.KVMDisplaySelection{DisplayIndex: 1})
+	require.NoError(t, err)
+	require.Equal(t, dto.KVMDisplaySelected{
+		Display: dto.KVMScreenDisplay{DisplayIndex: 1, IsActive: true, ResolutionX: 1280, ResolutionY: 720, IsDefault: true},
+	}, selected)
+}
+
+func TestSelectKVMDisplayUnchanged(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+	useCase, management := initRedirectionSettingsTest(t, device)
+	expectRedirectionSettingsRead(management, kvmSettingData)
+
+	selected, err := useCase.SelectKVMDisplay(context.Background(), device.GUID, dto.KVMDisplaySelection{DisplayIndex: 0})
+	require.NoError(t, err)
+	require.Equal(t, "primary", selected.Display.Role)
+	require.Equal(t, 1920, selected.Display.ResolutionX)
+}
+
+func TestSelectKVMDisplayValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		display int
+		err     error
+	}{
+		{
+			name:    "inactive display",
+			display: 2,
+			err:     devices.ErrValidationUseCase.Wrap("SelectKVMDisplay", "validate display", "display is not active"),
+		},
+		{
+			name:    "unknown display",
+			display: 3,
+			err:     devices.ErrValidationUseCase.Wrap("SelectKVMDisplay", "validate display", "display is not a display of the device"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+			useCase, management := initRedirectionSettingsTest(t, device)
+			expectRedirectionSettingsRead(management, kvmSettingData)
+
+			_, err := useCase.SelectKVMDisplay(context.Background(), device.GUID, dto.KVMDisplaySelection{DisplayIndex: tc.display})
+			require.Equal(t, tc.err, err)
+		})
+	}
+}
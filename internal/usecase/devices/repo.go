@@ -11,12 +11,14 @@ import (
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/guid"
 )
 
 var (
 	ErrDeviceUseCase = consoleerrors.CreateConsoleError("DevicesUseCase")
 	ErrDatabase      = sqldb.DatabaseError{Console: consoleerrors.CreateConsoleError("DevicesUseCase")}
-	ErrNotFound      = sqldb.NotFoundError{Console: consoleerrors.CreateConsoleError("DevicesUseCase")}
+	ErrNotFound      = sqldb.NotFoundError{Console: consoleerrors.CreateConsoleError("DevicesUseCase").WithCode(consoleerrors.CodeDeviceNotFound)}
 )
 
 // History - getting translate history from store.
@@ -46,6 +48,29 @@ func (uc *UseCase) Get(ctx context.Context, top, skip int, tenantID string) ([]d
 	return d1, nil
 }
 
+// GetByAttributes returns the devices whose custom attributes hold all of the given values.
+func (uc *UseCase) GetByAttributes(ctx context.Context, attributes map[string]string, top, skip int, tenantID string) ([]dto.Device, error) {
+	for key := range attributes {
+		if !dto.ValidDeviceAttributeKey(key) {
+			return nil, ErrValidationUseCase.Wrap("GetByAttributes", "dto.ValidDeviceAttributeKey", "invalid attribute name "+key)
+		}
+	}
+
+	data, err := uc.repo.GetByAttributes(ctx, attributes, top, skip, tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("GetByAttributes", "uc.repo.GetByAttributes", err)
+	}
+
+	d1 := make([]dto.Device, len(data))
+
+	for i := range data {
+		tmpEntity := data[i] // create a new variable to avoid memory aliasing
+		d1[i] = *uc.entityToDTO(&tmpEntity)
+	}
+
+	return d1, nil
+}
+
 func (uc *UseCase) GetByColumn(ctx context.Context, columnName, queryValue, tenantID string) ([]dto.Device, error) {
 	data, err := uc.repo.GetByColumn(ctx, columnName, queryValue, tenantID)
 	if err != nil {
@@ -158,6 +183,25 @@ func (uc *UseCase) Delete(ctx context.Context, guid, tenantID string) error {
 	return nil
 }
 
+// PlanDelete returns what Delete would do to the device without deleting it.
+func (uc *UseCase) PlanDelete(ctx context.Context, guid, tenantID string) (dto.DryRunResult, error) {
+	guid = strings.ToLower(guid)
+
+	item, err := uc.repo.GetByID(ctx, guid, tenantID)
+	if err != nil {
+		return dto.DryRunResult{}, ErrDatabase.Wrap("PlanDelete", "uc.repo.GetByID", err)
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.DryRunResult{}, ErrNotFound
+	}
+
+	return dto.DryRunResult{
+		Operation: "delete device",
+		Actions:   []dto.PlannedAction{{Target: guid, Action: "delete the device and its stored credentials"}},
+	}, nil
+}
+
 func (uc *UseCase) Update(ctx context.Context, d *dto.Device) (*dto.Device, error) {
 	d1, err := uc.dtoToEntity(d)
 	if err != nil {
@@ -183,9 +227,95 @@ func (uc *UseCase) Update(ctx context.Context, d *dto.Device) (*dto.Device, erro
 	// invalidate connection cache
 	uc.device.DestroyWsmanClient(*d2)
 
+	eventbus.Publish(uc.events, eventbus.DeviceUpdated, eventbus.DeviceUpdatedEvent{GUID: d2.GUID, TenantID: d2.TenantID, Hostname: d2.Hostname})
+
 	return d2, nil
 }
 
+// Patch changes the descriptive fields, the assignment and the custom attributes of a device without touching
+// its connection settings or credentials. Assigning the device to someone new publishes a DeviceAssigned event.
+func (uc *UseCase) Patch(ctx context.Context, guid, tenantID string, p dto.DevicePatch) (*dto.Device, error) {
+	d1, err := uc.repo.GetByID(ctx, strings.ToLower(guid), tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("Patch", "uc.repo.GetByID", err)
+	}
+
+	if d1 == nil || d1.GUID == "" {
+		return nil, ErrNotFound
+	}
+
+	if p.FriendlyName != nil {
+		d1.FriendlyName = *p.FriendlyName
+	}
+
+	if p.Location != nil {
+		d1.Location = *p.Location
+	}
+
+	if p.Notes != nil {
+		d1.Notes = *p.Notes
+	}
+
+	if p.Owner != nil {
+		d1.Owner = *p.Owner
+	}
+
+	previousAssignee := d1.Assignee
+	if p.Assignee != nil {
+		d1.Assignee = *p.Assignee
+	}
+
+	if err := mergeAttributes(d1, p.Attributes); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.repo.Update(ctx, d1)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("Patch", "uc.repo.Update", err)
+	}
+
+	if !updated {
+		return nil, ErrNotFound.Wrap("Patch", "uc.repo.Update", nil)
+	}
+
+	if d1.Assignee != "" && d1.Assignee != previousAssignee {
+		eventbus.Publish(uc.events, eventbus.DeviceAssigned, eventbus.DeviceAssignedEvent{
+			GUID:             d1.GUID,
+			Hostname:         d1.Hostname,
+			Owner:            d1.Owner,
+			Assignee:         d1.Assignee,
+			PreviousAssignee: previousAssignee,
+		})
+	}
+
+	return uc.entityToDTO(d1), nil
+}
+
+// mergeAttributes applies patch to the custom attributes of d, a nil value removes the attribute.
+func mergeAttributes(d *entity.Device, patch map[string]*string) error {
+	if len(patch) == 0 {
+		return nil
+	}
+
+	if d.Attributes == nil {
+		d.Attributes = map[string]string{}
+	}
+
+	for key, value := range patch {
+		if value == nil {
+			delete(d.Attributes, key)
+		} else {
+			d.Attributes[key] = *value
+		}
+	}
+
+	if len(d.Attributes) > dto.MaxDeviceAttributes {
+		return ErrValidationUseCase.Wrap("Patch", "mergeAttributes", fmt.Sprintf("a device can hold at most %d attributes", dto.MaxDeviceAttributes))
+	}
+
+	return nil
+}
+
 func (uc *UseCase) Insert(ctx context.Context, d *dto.Device) (*dto.Device, error) {
 	d1, err := uc.dtoToEntity(d)
 	if err != nil {
@@ -196,6 +326,19 @@ func (uc *UseCase) Insert(ctx context.Context, d *dto.Device) (*dto.Device, erro
 		d1.GUID = uuid.New().String()
 	}
 
+	// some firmware reports the GUID with the byte order of its first fields swapped, the machine may already be
+	// stored under the other one
+	if swapped := guid.Swapped(d1.GUID); swapped != "" {
+		twin, err := uc.repo.GetByID(ctx, swapped, d1.TenantID)
+		if err != nil {
+			return nil, ErrDatabase.Wrap("Insert", "uc.repo.GetByID", err)
+		}
+
+		if twin != nil && twin.GUID != "" {
+			return nil, ErrDatabase.Wrap("Insert", "uc.repo.Insert", sqldb.ErrDeviceNotUnique.Wrap("device "+twin.GUID+" has the byte-swapped GUID of "+d1.GUID))
+		}
+	}
+
 	_, err = uc.repo.Insert(ctx, d1)
 	if err != nil {
 		return nil, ErrDatabase.Wrap("Insert", "uc.repo.Insert", err)
@@ -211,5 +354,7 @@ func (uc *UseCase) Insert(ctx context.Context, d *dto.Device) (*dto.Device, erro
 		d2.Tags = []string{}
 	}
 
+	eventbus.Publish(uc.events, eventbus.DeviceUpdated, eventbus.DeviceUpdatedEvent{GUID: d2.GUID, TenantID: d2.TenantID, Hostname: d2.Hostname})
+
 	return d2, nil
 }
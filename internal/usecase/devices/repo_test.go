@@ -2,7 +2,9 @@ package devices_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -11,9 +13,17 @@ import (
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/mocks"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
+const (
+	// deviceGUID is the GUID of the device inserted and updated, swappedGUID that GUID as reported by firmware
+	// swapping the byte order of its first fields.
+	deviceGUID  = "123e4567-e89b-12d3-a456-426614174000"
+	swappedGUID = "67453e12-9be8-d312-a456-426614174000"
+)
+
 func ptr(s string) *string {
 	return &s
 }
@@ -40,7 +50,7 @@ func devicesTest(t *testing.T) (*devices.UseCase, *mocks.MockDeviceManagementRep
 	wsmanMock.EXPECT().Worker().Return().AnyTimes()
 
 	log := logger.New("error")
-	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{})
+	u := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), log, mocks.MockCrypto{}, nil)
 
 	return u, repo, wsmanMock
 }
@@ -288,11 +298,31 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestPlanDelete(t *testing.T) {
+	t.Parallel()
+
+	useCase, repo, _ := devicesTest(t)
+
+	repo.EXPECT().GetByID(context.Background(), "guid-123", "tenant-id-456").Return(&entity.Device{GUID: "guid-123"}, nil)
+	repo.EXPECT().GetByID(context.Background(), "guid-456", "tenant-id-456").Return(nil, nil)
+
+	// nothing is deleted
+	result, err := useCase.PlanDelete(context.Background(), "GUID-123", "tenant-id-456")
+	require.NoError(t, err)
+	require.Equal(t, dto.DryRunResult{
+		Operation: "delete device",
+		Actions:   []dto.PlannedAction{{Target: "guid-123", Action: "delete the device and its stored credentials"}},
+	}, result)
+
+	_, err = useCase.PlanDelete(context.Background(), "guid-456", "tenant-id-456")
+	require.Equal(t, devices.ErrNotFound, err)
+}
+
 func TestUpdate(t *testing.T) {
 	t.Parallel()
 
 	device := &entity.Device{
-		GUID:         "device-guid-123",
+		GUID:         deviceGUID,
 		TenantID:     "tenant-id-456",
 		Password:     "encrypted",
 		MPSPassword:  nil,
@@ -301,7 +331,7 @@ func TestUpdate(t *testing.T) {
 	}
 
 	deviceDTO := &dto.Device{
-		GUID:     "device-guid-123",
+		GUID:     deviceGUID,
 		TenantID: "tenant-id-456",
 		Tags:     []string{"hello", "test"},
 	}
@@ -314,7 +344,7 @@ func TestUpdate(t *testing.T) {
 					Update(context.Background(), device).
 					Return(true, nil)
 				repo.EXPECT().
-					GetByID(context.Background(), "device-guid-123", "tenant-id-456").
+					GetByID(context.Background(), deviceGUID, "tenant-id-456").
 					Return(device, nil)
 				management.EXPECT().
 					DestroyWsmanClient(*deviceDTO)
@@ -361,6 +391,269 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestPatch(t *testing.T) {
+	t.Parallel()
+
+	stored := func() *entity.Device {
+		return &entity.Device{
+			GUID:         "device-guid-123",
+			TenantID:     "tenant-id-456",
+			Hostname:     "host",
+			Password:     "encrypted",
+			FriendlyName: "old name",
+			Location:     "old location",
+			Notes:        "old notes",
+		}
+	}
+
+	patch := dto.DevicePatch{
+		Location: ptr("Building 2"),
+		Notes:    ptr(""),
+	}
+
+	patched := stored()
+	patched.Location = "Building 2"
+	patched.Notes = ""
+
+	tests := []testUsecase{
+		{
+			name: "successful patch leaves omitted fields unchanged",
+			mock: func(repo *mocks.MockDeviceManagementRepository, _ *mocks.MockWSMAN) {
+				repo.EXPECT().
+					GetByID(context.Background(), "device-guid-123", "tenant-id-456").
+					Return(stored(), nil)
+				repo.EXPECT().
+					Update(context.Background(), patched).
+					Return(true, nil)
+			},
+			res: &dto.Device{
+				GUID:         "device-guid-123",
+				TenantID:     "tenant-id-456",
+				Hostname:     "host",
+				FriendlyName: "old name",
+				Location:     "Building 2",
+			},
+			err: nil,
+		},
+		{
+			name: "patch fails - not found",
+			mock: func(repo *mocks.MockDeviceManagementRepository, _ *mocks.MockWSMAN) {
+				repo.EXPECT().
+					GetByID(context.Background(), "device-guid-123", "tenant-id-456").
+					Return(nil, nil)
+			},
+			res: (*dto.Device)(nil),
+			err: devices.ErrNotFound,
+		},
+		{
+			name: "patch fails - database error",
+			mock: func(repo *mocks.MockDeviceManagementRepository, _ *mocks.MockWSMAN) {
+				repo.EXPECT().
+					GetByID(context.Background(), "device-guid-123", "tenant-id-456").
+					Return(stored(), nil)
+				repo.EXPECT().
+					Update(context.Background(), patched).
+					Return(false, devices.ErrDatabase)
+			},
+			res: (*dto.Device)(nil),
+			err: devices.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, repo, management := devicesTest(t)
+
+			tc.mock(repo, management)
+
+			result, err := useCase.Patch(context.Background(), "DEVICE-GUID-123", "tenant-id-456", patch)
+
+			require.Equal(t, tc.res, result)
+			require.IsType(t, tc.err, err)
+		})
+	}
+}
+
+func TestPatchPublishesAssignment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		assignee  string
+		published bool
+	}{
+		{name: "new assignee is notified", assignee: "asmith", published: true},
+		{name: "unchanged assignee is not notified", assignee: "jdoe"},
+		{name: "unassigning is not notified", assignee: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+			wsmanMock := mocks.NewMockWSMAN(mockCtl)
+			wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+			bus := eventbus.New()
+			defer bus.Close()
+
+			assigned := make(chan eventbus.DeviceAssignedEvent, 1)
+			eventbus.Subscribe(bus, eventbus.DeviceAssigned, func(e eventbus.DeviceAssignedEvent) { assigned <- e })
+
+			useCase := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, bus)
+
+			repo.EXPECT().
+				GetByID(context.Background(), "device-guid-123", "").
+				Return(&entity.Device{GUID: "device-guid-123", Hostname: "host", Owner: "helpdesk", Assignee: "jdoe"}, nil)
+			repo.EXPECT().
+				Update(context.Background(), gomock.Any()).
+				Return(true, nil)
+
+			result, err := useCase.Patch(context.Background(), "device-guid-123", "", dto.DevicePatch{Assignee: ptr(tc.assignee)})
+			require.NoError(t, err)
+			require.Equal(t, tc.assignee, result.Assignee)
+			require.Equal(t, "helpdesk", result.Owner)
+
+			if !tc.published {
+				require.Never(t, func() bool { return len(assigned) > 0 }, 50*time.Millisecond, 10*time.Millisecond)
+
+				return
+			}
+
+			select {
+			case e := <-assigned:
+				require.Equal(t, eventbus.DeviceAssignedEvent{
+					GUID:             "device-guid-123",
+					Hostname:         "host",
+					Owner:            "helpdesk",
+					Assignee:         "asmith",
+					PreviousAssignee: "jdoe",
+				}, e)
+			case <-time.After(time.Second):
+				require.Fail(t, "assignment not published")
+			}
+		})
+	}
+}
+
+func TestGetByAttributes(t *testing.T) {
+	t.Parallel()
+
+	attributes := map[string]string{"costCenter": "1234"}
+
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		mock       func(*mocks.MockDeviceManagementRepository)
+		res        []dto.Device
+		err        error
+	}{
+		{
+			name:       "successful retrieval",
+			attributes: attributes,
+			mock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByAttributes(context.Background(), attributes, 10, 0, "tenant-id-456").
+					Return([]entity.Device{{GUID: "guid-123", TenantID: "tenant-id-456", Attributes: attributes}}, nil)
+			},
+			res: []dto.Device{{GUID: "guid-123", TenantID: "tenant-id-456", Attributes: attributes}},
+		},
+		{
+			name:       "invalid attribute name",
+			attributes: map[string]string{"cost center": "1234"},
+			mock:       func(_ *mocks.MockDeviceManagementRepository) {},
+			err:        devices.ErrValidationUseCase,
+		},
+		{
+			name:       "database error",
+			attributes: attributes,
+			mock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByAttributes(context.Background(), attributes, 10, 0, "tenant-id-456").
+					Return(nil, devices.ErrDatabase)
+			},
+			err: devices.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, repo, _ := devicesTest(t)
+
+			tc.mock(repo)
+
+			results, err := useCase.GetByAttributes(context.Background(), tc.attributes, 10, 0, "tenant-id-456")
+
+			require.Equal(t, tc.res, results)
+			require.IsType(t, tc.err, err)
+		})
+	}
+}
+
+func TestPatchAttributes(t *testing.T) {
+	t.Parallel()
+
+	tooMany := map[string]*string{}
+	for i := range dto.MaxDeviceAttributes {
+		tooMany[fmt.Sprintf("key%d", i)] = ptr("value")
+	}
+
+	tests := []struct {
+		name     string
+		patch    map[string]*string
+		expected map[string]string
+		err      error
+	}{
+		{
+			name:     "attributes are merged",
+			patch:    map[string]*string{"assetTag": ptr("A-1"), "costCenter": ptr("5678")},
+			expected: map[string]string{"assetTag": "A-1", "costCenter": "5678", "department": "finance"},
+		},
+		{
+			name:     "null removes an attribute",
+			patch:    map[string]*string{"department": nil},
+			expected: map[string]string{"costCenter": "1234"},
+		},
+		{
+			name:  "too many attributes",
+			patch: tooMany,
+			err:   devices.ErrValidationUseCase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, repo, _ := devicesTest(t)
+
+			repo.EXPECT().
+				GetByID(context.Background(), "device-guid-123", "").
+				Return(&entity.Device{GUID: "device-guid-123", Attributes: map[string]string{"costCenter": "1234", "department": "finance"}}, nil)
+
+			if tc.err == nil {
+				repo.EXPECT().
+					Update(context.Background(), &entity.Device{GUID: "device-guid-123", Attributes: tc.expected}).
+					Return(true, nil)
+			}
+
+			result, err := useCase.Patch(context.Background(), "device-guid-123", "", dto.DevicePatch{Attributes: tc.patch})
+			require.IsType(t, tc.err, err)
+
+			if tc.err == nil {
+				require.Equal(t, tc.expected, result.Attributes)
+			}
+		})
+	}
+}
+
 func TestInsert(t *testing.T) {
 	t.Parallel()
 
@@ -369,13 +662,16 @@ func TestInsert(t *testing.T) {
 			name: "successful insertion",
 			mock: func(repo *mocks.MockDeviceManagementRepository, _ *mocks.MockWSMAN) {
 				device := &entity.Device{
-					GUID:         "device-guid-123",
+					GUID:         deviceGUID,
 					Password:     "encrypted",
 					MPSPassword:  nil,
 					MEBXPassword: nil,
 					TenantID:     "tenant-id-456",
 				}
 
+				repo.EXPECT().
+					GetByID(context.Background(), swappedGUID, "tenant-id-456").
+					Return(nil, nil)
 				repo.EXPECT().
 					Insert(context.Background(), device).
 					Return("unique-device-id", nil)
@@ -390,13 +686,16 @@ func TestInsert(t *testing.T) {
 			name: "insertion fails - database error",
 			mock: func(repo *mocks.MockDeviceManagementRepository, _ *mocks.MockWSMAN) {
 				device := &entity.Device{
-					GUID:         "device-guid-123",
+					GUID:         deviceGUID,
 					Password:     "encrypted",
 					MPSPassword:  nil,
 					MEBXPassword: nil,
 					TenantID:     "tenant-id-456",
 				}
 
+				repo.EXPECT().
+					GetByID(context.Background(), swappedGUID, "tenant-id-456").
+					Return(nil, nil)
 				repo.EXPECT().
 					Insert(context.Background(), device).
 					Return("", devices.ErrDatabase)
@@ -416,7 +715,7 @@ func TestInsert(t *testing.T) {
 			tc.mock(repo, management)
 
 			deviceDTO := &dto.Device{
-				GUID:     "device-guid-123",
+				GUID:     deviceGUID,
 				TenantID: "tenant-id-456",
 				Tags:     []string{""},
 			}
@@ -436,12 +735,72 @@ func TestInsert(t *testing.T) {
 	}
 }
 
+func TestInsertNormalizesGUID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		guid string
+		mock func(*mocks.MockDeviceManagementRepository)
+		err  error
+	}{
+		{
+			name: "GUID in braces and uppercase is stored in canonical form",
+			guid: "{123E4567-E89B-12D3-A456-426614174000}",
+			mock: func(repo *mocks.MockDeviceManagementRepository) {
+				device := &entity.Device{GUID: deviceGUID, TenantID: "tenant-id-456", Password: "encrypted"}
+
+				repo.EXPECT().GetByID(context.Background(), swappedGUID, "tenant-id-456").Return(nil, nil)
+				repo.EXPECT().Insert(context.Background(), device).Return(deviceGUID, nil)
+				repo.EXPECT().GetByID(context.Background(), deviceGUID, "tenant-id-456").Return(device, nil)
+			},
+		},
+		{
+			name: "invalid GUID",
+			guid: "device-guid-123",
+			mock: func(_ *mocks.MockDeviceManagementRepository) {},
+			err:  devices.ErrValidationUseCase,
+		},
+		{
+			name: "device stored under the byte-swapped GUID",
+			guid: "123e4567e89b12d3a456426614174000",
+			mock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), swappedGUID, "tenant-id-456").
+					Return(&entity.Device{GUID: swappedGUID, TenantID: "tenant-id-456"}, nil)
+			},
+			err: devices.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, repo, _ := devicesTest(t)
+
+			tc.mock(repo)
+
+			inserted, err := useCase.Insert(context.Background(), &dto.Device{GUID: tc.guid, TenantID: "tenant-id-456"})
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+				require.Nil(t, inserted)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, deviceGUID, inserted.GUID)
+		})
+	}
+}
+
 func TestUpdateWithPasswords(t *testing.T) {
 	t.Parallel()
 
 	// Entity with encrypted passwords (what gets stored in DB)
 	deviceWithPasswords := &entity.Device{
-		GUID:         "device-guid-123",
+		GUID:         deviceGUID,
 		TenantID:     "tenant-id-456",
 		Password:     "encrypted",
 		MPSPassword:  ptr("encrypted"),
@@ -451,7 +810,7 @@ func TestUpdateWithPasswords(t *testing.T) {
 
 	// DTO with plaintext passwords (what comes from API)
 	deviceDTOWithPasswords := &dto.Device{
-		GUID:         "device-guid-123",
+		GUID:         deviceGUID,
 		TenantID:     "tenant-id-456",
 		Tags:         []string{"hello", "test"},
 		MPSPassword:  "mpspass",
@@ -460,7 +819,7 @@ func TestUpdateWithPasswords(t *testing.T) {
 
 	// Expected DTO result (passwords not returned without includeSecrets)
 	expectedDTO := &dto.Device{
-		GUID:         "device-guid-123",
+		GUID:         deviceGUID,
 		TenantID:     "tenant-id-456",
 		Tags:         []string{"hello", "test"},
 		MPSPassword:  "encrypted",
@@ -476,7 +835,7 @@ func TestUpdateWithPasswords(t *testing.T) {
 			Update(context.Background(), deviceWithPasswords).
 			Return(true, nil)
 		repo.EXPECT().
-			GetByID(context.Background(), "device-guid-123", "tenant-id-456").
+			GetByID(context.Background(), deviceGUID, "tenant-id-456").
 			Return(deviceWithPasswords, nil)
 		management.EXPECT().
 			DestroyWsmanClient(*expectedDTO)
@@ -498,23 +857,26 @@ func TestInsertWithPasswords(t *testing.T) {
 
 		// Entity with encrypted passwords
 		deviceWithPasswords := &entity.Device{
-			GUID:         "device-guid-123",
+			GUID:         deviceGUID,
 			TenantID:     "tenant-id-456",
 			Password:     "encrypted",
 			MPSPassword:  ptr("encrypted"),
 			MEBXPassword: ptr("encrypted"),
 		}
 
+		repo.EXPECT().
+			GetByID(context.Background(), swappedGUID, "tenant-id-456").
+			Return(nil, nil)
 		repo.EXPECT().
 			Insert(context.Background(), deviceWithPasswords).
 			Return("unique-device-id", nil)
 		repo.EXPECT().
-			GetByID(context.Background(), "device-guid-123", "tenant-id-456").
+			GetByID(context.Background(), deviceGUID, "tenant-id-456").
 			Return(deviceWithPasswords, nil)
 
 		// DTO with plaintext passwords
 		deviceDTO := &dto.Device{
-			GUID:         "device-guid-123",
+			GUID:         deviceGUID,
 			TenantID:     "tenant-id-456",
 			Tags:         []string{""},
 			MPSPassword:  "mpspass",
@@ -0,0 +1,51 @@
+package devices
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+var ErrCertRevoked = ValidationError{Console: consoleerrors.CreateConsoleError("DevicesUseCase").WithCode(consoleerrors.CodeCertRevoked)}
+
+// SetRevocation checks the certificates of the devices with revocation, refusing to add the revoked ones and
+// showing the status of those the devices keep.
+func (uc *UseCase) SetRevocation(revocation Revocation) {
+	uc.revocation = revocation
+}
+
+// setRevocationStatus sets the revocation status of the certificates of a device, their issuers looked for among
+// the other certificates of the device.
+func (uc *UseCase) setRevocationStatus(c context.Context, certs []dto.RefinedCertificate) {
+	if uc.revocation == nil {
+		return
+	}
+
+	parsed := make([]*x509.Certificate, len(certs))
+	chain := []*x509.Certificate{}
+
+	for i := range certs {
+		der, err := base64.StdEncoding.DecodeString(certs[i].X509Certificate)
+		if err != nil {
+			continue
+		}
+
+		if parsed[i], err = x509.ParseCertificate(der); err == nil {
+			chain = append(chain, parsed[i])
+		}
+	}
+
+	for i := range certs {
+		if parsed[i] == nil {
+			certs[i].Revocation = &dto.RevocationStatus{Status: dto.RevocationUnknown, Reason: "certificate cannot be read"}
+
+			continue
+		}
+
+		status := uc.revocation.Check(c, parsed[i], chain)
+		certs[i].Revocation = &status
+	}
+}
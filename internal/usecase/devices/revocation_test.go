@@ -0,0 +1,76 @@
+package devices_test
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	wsman "github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+// revocationStatus answers every revocation check with the same status.
+type revocationStatus dto.RevocationStatus
+
+func (r revocationStatus) Check(_ context.Context, _ *x509.Certificate, _ []*x509.Certificate) dto.RevocationStatus {
+	return dto.RevocationStatus(r)
+}
+
+func TestAddCertificateRevoked(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	useCase, _, _, repo := initCertificateTest(t)
+	useCase.SetRevocation(revocationStatus{Status: dto.RevocationRevoked, Source: "ocsp"})
+
+	repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+
+	_, err := useCase.AddCertificate(context.Background(), device.GUID, dto.CertInfo{
+		Cert:      inventoryCertificate(t, "Contoso Root CA", time.Now().AddDate(1, 0, 0)),
+		IsTrusted: true,
+	})
+
+	var validationErr devices.ValidationError
+
+	require.True(t, errors.As(err, &validationErr))
+	require.Equal(t, consoleerrors.CodeCertRevoked, validationErr.ErrorCode())
+}
+
+func TestGetCertificatesRevocation(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	useCase, wsmanMock, management, repo := initCertificateTest(t)
+	useCase.SetRevocation(revocationStatus{Status: dto.RevocationGood, Source: "crl"})
+
+	repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+	wsmanMock.EXPECT().SetupWsmanClient(*device, false, true).Return(management, nil)
+	management.EXPECT().GetCertificates(gomock.Any()).Return(wsman.Certificates{
+		PublicKeyCertificateResponse: publickey.RefinedPullResponse{
+			PublicKeyCertificateItems: []publickey.RefinedPublicKeyCertificateResponse{
+				{InstanceID: "Intel(r) AMT Certificate: Handle: 1", X509Certificate: inventoryCertificate(t, "office-1", time.Now().AddDate(1, 0, 0))},
+				{InstanceID: "Intel(r) AMT Certificate: Handle: 2", X509Certificate: "not a certificate"},
+			},
+		},
+	}, nil)
+
+	settings, err := useCase.GetCertificates(context.Background(), device.GUID)
+	require.NoError(t, err)
+
+	certs := settings.CertificateResponse.Certificates
+	require.Len(t, certs, 2)
+	require.Equal(t, &dto.RevocationStatus{Status: dto.RevocationGood, Source: "crl"}, certs[0].Revocation)
+	require.Equal(t, dto.RevocationUnknown, certs[1].Revocation.Status)
+}
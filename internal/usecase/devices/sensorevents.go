@@ -0,0 +1,296 @@
+package devices
+
+import (
+	"strings"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// The IPMI event types of the records of the event log.
+const (
+	eventTypeThreshold      = 0x01
+	eventTypeSensorSpecific = 0x6f
+
+	// eventOffsetDeasserted marks an event offset as deasserted, the offset itself is in the low nibble.
+	eventOffsetDeasserted = 0x80
+	eventOffsetMask       = 0x0f
+)
+
+// sensorTypes are the names of the IPMI sensor types.
+var sensorTypes = map[uint8]string{
+	0x01: "Temperature",
+	0x02: "Voltage",
+	0x03: "Current",
+	0x04: "Fan",
+	0x05: "Physical security",
+	0x06: "Platform security",
+	0x07: "Processor",
+	0x08: "Power supply",
+	0x09: "Power unit",
+	0x0a: "Cooling device",
+	0x0b: "Other units-based sensor",
+	0x0c: "Memory",
+	0x0d: "Drive slot",
+	0x0e: "POST memory resize",
+	0x0f: "System firmware progress",
+	0x10: "Event logging disabled",
+	0x11: "Watchdog",
+	0x12: "System event",
+	0x13: "Critical interrupt",
+	0x14: "Button",
+	0x15: "Module/board",
+	0x16: "Microcontroller/coprocessor",
+	0x17: "Add-in card",
+	0x18: "Chassis",
+	0x19: "Chip set",
+	0x1a: "Other FRU",
+	0x1b: "Cable/interconnect",
+	0x1c: "Terminator",
+	0x1d: "System boot initiated",
+	0x1e: "Boot error",
+	0x1f: "OS boot",
+	0x20: "OS stop/shutdown",
+	0x21: "Slot/connector",
+	0x22: "System ACPI power state",
+	0x23: "Watchdog",
+	0x24: "Platform alert",
+	0x25: "Entity presence",
+	0x26: "Monitor ASIC",
+	0x27: "LAN",
+	0x28: "Management subsystem health",
+	0x29: "Battery",
+	0x2a: "Session audit",
+	0x2b: "Version change",
+	0x2c: "FRU state",
+}
+
+// thresholdEvents are the events of threshold sensors, such as temperatures and voltages, by offset.
+var thresholdEvents = map[uint8]string{
+	0x00: "Lower non-critical, going low",
+	0x01: "Lower non-critical, going high",
+	0x02: "Lower critical, going low",
+	0x03: "Lower critical, going high",
+	0x04: "Lower non-recoverable, going low",
+	0x05: "Lower non-recoverable, going high",
+	0x06: "Upper non-critical, going low",
+	0x07: "Upper non-critical, going high",
+	0x08: "Upper critical, going low",
+	0x09: "Upper critical, going high",
+	0x0a: "Upper non-recoverable, going low",
+	0x0b: "Upper non-recoverable, going high",
+}
+
+// sensorEvents are the sensor-specific events by sensor type and offset.
+var sensorEvents = map[uint8]map[uint8]string{
+	0x05: {
+		0x00: "Case intrusion",
+		0x01: "Drive bay intrusion",
+		0x02: "I/O card area intrusion",
+		0x03: "Processor area intrusion",
+		0x04: "LAN leash lost",
+		0x05: "Unauthorized dock",
+		0x06: "Fan area intrusion",
+	},
+	0x06: {
+		0x00: "Secure mode violation attempt",
+		0x01: "Pre-boot user password violation",
+		0x02: "Pre-boot setup password violation",
+		0x03: "Pre-boot network boot password violation",
+		0x04: "Other pre-boot password violation",
+		0x05: "Out-of-band access password violation",
+	},
+	0x07: {
+		0x00: "CPU internal error",
+		0x01: "CPU thermal trip",
+		0x02: "CPU BIST failure",
+		0x03: "CPU hang in POST",
+		0x04: "CPU initialization failure",
+		0x05: "CPU configuration error",
+		0x06: "Uncorrectable CPU complex error",
+		0x07: "CPU present",
+		0x08: "CPU disabled",
+		0x09: "Terminator present",
+		0x0a: "CPU throttled",
+	},
+	0x08: {
+		0x00: "Power supply present",
+		0x01: "Power supply failure",
+		0x02: "Power supply predictive failure",
+		0x03: "Power supply input lost",
+		0x04: "Power supply input lost or out of range",
+		0x05: "Power supply input out of range",
+		0x06: "Power supply configuration error",
+	},
+	0x09: {
+		0x00: "Power down",
+		0x01: "Power cycle",
+		0x02: "240VA power down",
+		0x03: "Interlock power down",
+		0x04: "AC lost",
+		0x05: "Soft power control failure",
+		0x06: "Power unit failure",
+		0x07: "Power unit predictive failure",
+	},
+	0x0c: {
+		0x00: "Correctable ECC error",
+		0x01: "Uncorrectable ECC error",
+		0x02: "Memory parity error",
+		0x03: "Memory scrub failed",
+		0x04: "Memory device disabled",
+		0x05: "Correctable ECC logging limit reached",
+		0x06: "Memory present",
+		0x07: "Memory configuration error",
+		0x08: "Memory spare",
+	},
+	0x0f: {
+		0x00: "System firmware error",
+		0x01: "System firmware hang",
+		0x02: "System firmware progress",
+	},
+	0x10: {
+		0x00: "Correctable memory error logging disabled",
+		0x01: "Event type logging disabled",
+		0x02: "Log cleared",
+		0x03: "All event logging disabled",
+		0x04: "Event log full",
+		0x05: "Event log almost full",
+	},
+	0x12: {
+		0x00: "System reconfigured",
+		0x01: "OEM system boot event",
+		0x02: "Undetermined system hardware failure",
+		0x03: "Entry added to auxiliary log",
+		0x04: "Platform event filter action",
+		0x05: "Timestamp clock synchronized",
+	},
+	0x13: {
+		0x00: "Front panel NMI",
+		0x01: "Bus timeout",
+		0x02: "I/O channel check NMI",
+		0x03: "Software NMI",
+		0x04: "PCI PERR",
+		0x05: "PCI SERR",
+		0x06: "EISA fail-safe timeout",
+		0x07: "Bus correctable error",
+		0x08: "Bus uncorrectable error",
+		0x09: "Fatal NMI",
+		0x0a: "Bus fatal error",
+		0x0b: "Bus degraded",
+	},
+	0x14: {
+		0x00: "Power button pressed",
+		0x01: "Sleep button pressed",
+		0x02: "Reset button pressed",
+		0x03: "FRU latch open",
+		0x04: "FRU service request",
+	},
+	0x1d: {
+		0x00: "Boot on power up",
+		0x01: "Boot on hard reset",
+		0x02: "Boot on warm reset",
+		0x03: "PXE boot requested",
+		0x04: "Automatic boot to diagnostics",
+		0x05: "Hard reset by the OS",
+		0x06: "Warm reset by the OS",
+		0x07: "System restart",
+	},
+	0x1e: {
+		0x00: "No bootable media",
+		0x01: "Non-bootable diskette left in drive",
+		0x02: "PXE server not found",
+		0x03: "Invalid boot sector",
+		0x04: "Timeout waiting for boot source selection",
+	},
+	0x20: {
+		0x00: "Critical stop during OS load",
+		0x01: "OS run-time critical stop",
+		0x02: "OS graceful stop",
+		0x03: "OS graceful shutdown",
+		0x04: "Soft shutdown by platform event filter",
+		0x05: "Agent not responding",
+	},
+	0x22: {
+		0x00: "S0/G0 working",
+		0x01: "S1 sleeping",
+		0x02: "S2 sleeping",
+		0x03: "S3 sleeping",
+		0x04: "S4 hibernating",
+		0x05: "S5/G2 soft off",
+		0x06: "S4/S5 soft off",
+		0x07: "G3 mechanical off",
+		0x08: "Sleeping in S1, S2 or S3",
+		0x09: "G1 sleeping",
+		0x0a: "S5 entered by override",
+		0x0b: "Legacy on",
+		0x0c: "Legacy off",
+		0x0e: "Unknown power state",
+	},
+	0x23: {
+		0x00: "Watchdog timer expired",
+		0x01: "Watchdog hard reset",
+		0x02: "Watchdog power down",
+		0x03: "Watchdog power cycle",
+		0x08: "Watchdog timer interrupt",
+	},
+	0x25: {
+		0x00: "Entity present",
+		0x01: "Entity absent",
+		0x02: "Entity disabled",
+	},
+	0x29: {
+		0x00: "Battery low",
+		0x01: "Battery failed",
+		0x02: "Battery present",
+	},
+}
+
+// EventLogEntry decodes an event log record, raw is the base64 record as read from the device.
+func EventLogEntry(event *messagelog.RefinedEventData, raw string) dto.EventLog {
+	entry := dto.EventLog{
+		DeviceAddress:   int(event.DeviceAddress),
+		EventSensorType: int(event.EventSensorType),
+		EventType:       int(event.EventType),
+		EventOffset:     int(event.EventOffset),
+		EventSourceType: int(event.EventSourceType),
+		EventSeverity:   event.EventSeverity,
+		SensorNumber:    int(event.SensorNumber),
+		Entity:          event.Entity,
+		EntityInstance:  int(event.EntityInstance),
+		EventData:       make([]int, len(event.EventData)),
+		Time:            event.TimeStamp.String(),
+		Description:     event.Description,
+		SensorType:      sensorTypes[event.EventSensorType],
+		Deasserted:      event.EventOffset&eventOffsetDeasserted != 0,
+		Raw:             raw,
+	}
+
+	for i, b := range event.EventData {
+		entry.EventData[i] = int(b)
+	}
+
+	entry.Event = sensorEvent(event.EventSensorType, event.EventType, event.EventOffset&eventOffsetMask)
+
+	// the messagelog decoder only knows a few sensor types, describe the others from the decoded event
+	if entry.Event != "" && strings.HasPrefix(entry.Description, "Unknown Sensor Type") {
+		entry.Description = entry.SensorType + ": " + entry.Event
+		if entry.Deasserted {
+			entry.Description += " (deasserted)"
+		}
+	}
+
+	return entry
+}
+
+// sensorEvent names the event at offset of a sensor, empty for generic and OEM events.
+func sensorEvent(sensorType, eventType, offset uint8) string {
+	switch eventType {
+	case eventTypeThreshold:
+		return thresholdEvents[offset]
+	case eventTypeSensorSpecific:
+		return sensorEvents[sensorType][offset]
+	default:
+		return ""
+	}
+}
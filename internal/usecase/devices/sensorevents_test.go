@@ -0,0 +1,80 @@
+package devices_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+func TestEventLogEntry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		event       messagelog.RefinedEventData
+		sensorType  string
+		eventName   string
+		deasserted  bool
+		description string
+	}{
+		{
+			name:        "CPU thermal trip",
+			event:       messagelog.RefinedEventData{EventSensorType: 0x07, EventType: 0x6f, EventOffset: 0x01, Description: "Unknown Sensor Type #7"},
+			sensorType:  "Processor",
+			eventName:   "CPU thermal trip",
+			description: "Processor: CPU thermal trip",
+		},
+		{
+			name:        "case intrusion cleared",
+			event:       messagelog.RefinedEventData{EventSensorType: 0x05, EventType: 0x6f, EventOffset: 0x80, Description: "Unknown Sensor Type #5"},
+			sensorType:  "Physical security",
+			eventName:   "Case intrusion",
+			deasserted:  true,
+			description: "Physical security: Case intrusion (deasserted)",
+		},
+		{
+			name:        "temperature threshold",
+			event:       messagelog.RefinedEventData{EventSensorType: 0x01, EventType: 0x01, EventOffset: 0x09, Description: "Unknown Sensor Type #1"},
+			sensorType:  "Temperature",
+			eventName:   "Upper critical, going high",
+			description: "Temperature: Upper critical, going high",
+		},
+		{
+			name:        "described by the decoder",
+			event:       messagelog.RefinedEventData{EventSensorType: 0x1e, EventType: 0x6f, EventOffset: 0x00, Description: "No bootable media"},
+			sensorType:  "Boot error",
+			eventName:   "No bootable media",
+			description: "No bootable media",
+		},
+		{
+			name:        "OEM event",
+			event:       messagelog.RefinedEventData{EventSensorType: 0xc0, EventType: 0x70, EventOffset: 0x02, Description: "Unknown Sensor Type #192"},
+			description: "Unknown Sensor Type #192",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.event.TimeStamp = time.Unix(0, 0)
+			tc.event.EventData = []uint8{0x40, 0x01, 0xff}
+
+			entry := devices.EventLogEntry(&tc.event, "cmF3")
+
+			require.Equal(t, tc.sensorType, entry.SensorType)
+			require.Equal(t, tc.eventName, entry.Event)
+			require.Equal(t, tc.deasserted, entry.Deasserted)
+			require.Equal(t, tc.description, entry.Description)
+			require.Equal(t, int(tc.event.EventSensorType), entry.EventSensorType)
+			require.Equal(t, int(tc.event.EventOffset), entry.EventOffset)
+			require.Equal(t, []int{0x40, 0x01, 0xff}, entry.EventData)
+			require.Equal(t, "cmF3", entry.Raw)
+		})
+	}
+}
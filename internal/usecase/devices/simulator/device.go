@@ -0,0 +1,1261 @@
+package simulator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	gotls "crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/alarmclock"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ethernetport"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/general"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/bios"
+	cimBoot "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/card"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/chassis"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/chip"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/concrete"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/kvm"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/mediaaccess"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/physical"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/processor"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/service"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/software"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/wifi"
+	ipsAlarmClock "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/alarmclock"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbasedsetup"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/kvmredirection"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/optin"
+	ipspower "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/power"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/screensetting"
+
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
+)
+
+// ConsentCode is the user consent code every simulated device shows on its screen.
+const ConsentCode = 123456
+
+// CIM power states and the AMT return values the simulated devices use.
+const (
+	powerOn        = service.PowerState(2)
+	powerSleep     = service.PowerState(4)
+	powerHibernate = service.PowerState(7)
+	powerOffSoft   = service.PowerState(8)
+
+	optInNotStarted = 0
+	optInDisplayed  = 2
+	optInReceived   = 3
+
+	returnInvalidCode   = 2066
+	returnInvalidHandle = 2053
+	returnDuplicate     = 2058
+	returnInvalidParam  = 36
+
+	wiredPort    = "Intel(r) AMT Ethernet Port Settings 0"
+	wirelessPort = "Intel(r) AMT Ethernet Port Settings 1"
+)
+
+// Device is a simulated Intel AMT device in admin control mode. It answers with canned CIM data
+// derived from its index and keeps the state changed through it, such as its power state, its
+// redirection and user consent settings and its alarms, in memory.
+type Device struct {
+	sim   *Simulator
+	guid  string
+	index int
+
+	mu             sync.Mutex
+	powerState     service.PowerState
+	osPowerSaving  ipspower.OSPowerSavingState
+	redirection    redirection.EnabledState
+	listener       bool
+	kvm            bool
+	optInRequired  uint32
+	optInState     int
+	bootData       boot.BootSettingDataResponse
+	alarms         []ipsAlarmClock.AlarmClockOccurrence
+	kvmSettings    kvmredirection.KVMRedirectionSettingsResponse
+	linkPreference uint32
+	linkPolicies   map[string][]ethernetport.LinkPolicy
+	wifiProfiles   []wifi.WiFiEndpointSettingsResponse
+	wifiPort       wifiportconfiguration.WiFiPortConfigurationServiceResponse
+	kerberos       kerberos.KerberosSettingDataResponse
+	tlsSettings    []tls.SettingDataResponse
+	users          []wsman.UserACLEntry
+	nextUser       int
+	cert           *gotls.Certificate
+}
+
+var _ wsman.Management = (*Device)(nil)
+
+func newDevice(sim *Simulator, guid string, index int) *Device {
+	return &Device{
+		sim:           sim,
+		guid:          guid,
+		index:         index,
+		powerState:    powerOn,
+		osPowerSaving: ipspower.FullPower,
+		redirection:   redirection.IDERAndSOLAreEnabled,
+		listener:      true,
+		kvm:           true,
+		optInRequired: 1, // KVM only
+		bootData:      boot.BootSettingDataResponse{ElementName: "Intel(r) AMT Boot Configuration Settings", InstanceID: "Intel(r) AMT:BootSettingData 0"},
+		kvmSettings: kvmredirection.KVMRedirectionSettingsResponse{
+			ElementName: "Intel(r) KVM Redirection Settings", InstanceID: "Intel(r) KVM Redirection Settings",
+			EnabledByMEBx: true, SessionTimeout: 3, DefaultScreen: 0,
+		},
+		linkPreference: 2, // host
+		linkPolicies: map[string][]ethernetport.LinkPolicy{
+			wiredPort:    {ethernetport.LinkPolicyS0AC, ethernetport.LinkPolicySxAC, ethernetport.LinkPolicyS0DC},
+			wirelessPort: {ethernetport.LinkPolicyS0AC, ethernetport.LinkPolicyS0DC},
+		},
+		wifiProfiles: []wifi.WiFiEndpointSettingsResponse{
+			{ElementName: "home", InstanceID: "Intel(r) AMT:WiFi Endpoint Settings home", SSID: "home", Priority: 1, AuthenticationMethod: wifi.AuthenticationMethodWPA2PSK, EncryptionMethod: wifi.EncryptionMethodCCMP, BSSType: wifi.BSSTypeInfrastructure},
+			{ElementName: "office", InstanceID: "Intel(r) AMT:WiFi Endpoint Settings office", SSID: "office", Priority: 2, AuthenticationMethod: wifi.AuthenticationMethodWPA2IEEE8021x, EncryptionMethod: wifi.EncryptionMethodCCMP, BSSType: wifi.BSSTypeInfrastructure},
+		},
+		wifiPort: wifiportconfiguration.WiFiPortConfigurationServiceResponse{
+			ElementName: "Intel(r) AMT WiFiPort Configuration Service", SystemCreationClassName: "CIM_ComputerSystem",
+			SystemName: "Intel(r) AMT", CreationClassName: "AMT_WiFiPortConfigurationService", Name: "Intel(r) AMT WiFiPort Configuration Service",
+			LocalProfileSynchronizationEnabled: wifiportconfiguration.UnrestrictedSync,
+		},
+		kerberos: kerberos.KerberosSettingDataResponse{
+			ElementName: "Intel(r) AMT Kerberos Settings", InstanceID: "Intel(r) AMT Kerberos Settings", MaximumClockTolerance: 5,
+			SupportedEncryptionAlgorithms: []kerberos.SupportedEncryptionAlgorithms{
+				kerberos.SupportedEncryptionAlgorithmsRC4HMAC, kerberos.SupportedEncryptionAlgorithmsAES128CTSHMACSHA196, kerberos.SupportedEncryptionAlgorithmsAES256CTSHMACSHA196,
+			},
+		},
+		// the simulated devices are reached without TLS, which their network interface still accepts
+		tlsSettings: []tls.SettingDataResponse{
+			{ElementName: "Intel(r) AMT 802.3 TLS Settings", InstanceID: "Intel(r) AMT 802.3 TLS Settings", Enabled: true, AcceptNonSecureConnections: true},
+			{ElementName: "Intel(r) AMT LMS TLS Settings", InstanceID: "Intel(r) AMT LMS TLS Settings"},
+		},
+	}
+}
+
+// GUID -.
+func (d *Device) GUID() string {
+	return d.guid
+}
+
+func (d *Device) serial() string {
+	return fmt.Sprintf("SIM%07d", d.index)
+}
+
+// mac is in 00:53:00, the range of MAC addresses reserved for documentation.
+func (d *Device) mac() string {
+	return fmt.Sprintf("00:53:00:%02x:%02x:%02x", d.index>>16&0xff, d.index>>8&0xff, d.index&0xff)
+}
+
+func (d *Device) ip() string {
+	return fmt.Sprintf("10.%d.%d.%d", d.index>>16&0xff, d.index>>8&0xff, d.index&0xff)
+}
+
+func (d *Device) AddTrustedRootCert(ctx context.Context, _ string) (string, error) {
+	if err := d.sim.call(ctx, "AddTrustedRootCert"); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Intel(r) AMT Certificate: Handle: %d", d.index), nil
+}
+
+func (d *Device) AddClientCert(ctx context.Context, _ string) (string, error) {
+	if err := d.sim.call(ctx, "AddClientCert"); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Intel(r) AMT Certificate: Handle: %d", d.index+1), nil
+}
+
+func (d *Device) GetAMTVersion(ctx context.Context) ([]software.SoftwareIdentity, error) {
+	if err := d.sim.call(ctx, "GetAMTVersion"); err != nil {
+		return nil, err
+	}
+
+	versions := [][2]string{
+		{"Flash", "16.1.27"}, {"Netstack", "16.1.27"}, {"AMTApps", "16.1.27"}, {"AMT", "16.1.27"},
+		{"Sku", "16392"}, {"VendorID", "8086"}, {"Build Number", "2176"}, {"Recovery Version", "16.1.27"},
+		{"Recovery Build Num", "2176"}, {"Legacy Mode", "False"}, {"AMT FW Core Version", "16.1.27.2176"},
+	}
+
+	items := make([]software.SoftwareIdentity, len(versions))
+	for i, v := range versions {
+		items[i] = software.SoftwareIdentity{InstanceID: v[0], VersionString: v[1], IsEntity: true}
+	}
+
+	return items, nil
+}
+
+func (d *Device) GetSetupAndConfiguration(ctx context.Context) ([]setupandconfiguration.SetupAndConfigurationServiceResponse, error) {
+	if err := d.sim.call(ctx, "GetSetupAndConfiguration"); err != nil {
+		return nil, err
+	}
+
+	return []setupandconfiguration.SetupAndConfigurationServiceResponse{{
+		ElementName:       "Intel(r) AMT Setup and Configuration Service",
+		Name:              "Intel(r) AMT Setup and Configuration Service",
+		ProvisioningMode:  setupandconfiguration.AdminControlMode,
+		ProvisioningState: setupandconfiguration.PostProvisioning,
+		PasswordModel:     1,
+		DhcpDNSSuffix:     "example.com",
+	}}, nil
+}
+
+func (d *Device) GetIPSHostBasedSetupService(ctx context.Context) (hostbasedsetup.Response, error) {
+	if err := d.sim.call(ctx, "GetIPSHostBasedSetupService"); err != nil {
+		return hostbasedsetup.Response{}, err
+	}
+
+	response := hostbasedsetup.Response{}
+	response.Body.GetResponse = hostbasedsetup.HostBasedSetupService{
+		ElementName:        "Intel(r) AMT Host Based Setup Service",
+		Name:               "Intel(r) AMT Host Based Setup Service",
+		CurrentControlMode: hostbasedsetup.Admin,
+	}
+
+	return response, nil
+}
+
+func (d *Device) GetAMTRedirectionService(ctx context.Context) (redirection.Response, error) {
+	if err := d.sim.call(ctx, "GetAMTRedirectionService"); err != nil {
+		return redirection.Response{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	response := redirection.Response{}
+	response.Body.GetAndPutResponse = redirection.RedirectionResponse{
+		CreationClassName:       "AMT_RedirectionService",
+		ElementName:             "Intel(r) AMT Redirection Service",
+		Name:                    "Intel(r) AMT Redirection Service",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		EnabledState:            d.redirection,
+		ListenerEnabled:         d.listener,
+	}
+
+	return response, nil
+}
+
+func (d *Device) SetAMTRedirectionService(ctx context.Context, request *redirection.RedirectionRequest) (redirection.Response, error) {
+	if err := d.sim.call(ctx, "SetAMTRedirectionService"); err != nil {
+		return redirection.Response{}, err
+	}
+
+	d.mu.Lock()
+	d.listener = request.ListenerEnabled
+	d.mu.Unlock()
+
+	return d.GetAMTRedirectionService(ctx)
+}
+
+func (d *Device) RequestAMTRedirectionServiceStateChange(ctx context.Context, ider, sol bool) (redirection.RequestedState, int, error) {
+	if err := d.sim.call(ctx, "RequestAMTRedirectionServiceStateChange"); err != nil {
+		return 0, 0, err
+	}
+
+	requestedState := redirection.DisableIDERAndSOL
+	listenerEnabled := 0
+
+	if ider {
+		requestedState++
+		listenerEnabled = 1
+	}
+
+	if sol {
+		requestedState += 2
+		listenerEnabled = 1
+	}
+
+	d.mu.Lock()
+	d.redirection = redirection.EnabledState(requestedState)
+	d.mu.Unlock()
+
+	return requestedState, listenerEnabled, nil
+}
+
+func (d *Device) GetIPSOptInService(ctx context.Context) (optin.Response, error) {
+	if err := d.sim.call(ctx, "GetIPSOptInService"); err != nil {
+		return optin.Response{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	response := optin.Response{}
+	response.Body.GetAndPutResponse = optin.OptInServiceResponse{
+		Name:                    "Intel(r) AMT OptIn Service",
+		CreationClassName:       "IPS_OptInService",
+		SystemName:              "Intel(r) AMT",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		ElementName:             "Intel(r) AMT OptIn Service",
+		OptInCodeTimeout:        120,
+		OptInRequired:           d.optInRequired,
+		OptInState:              d.optInState,
+		CanModifyOptInPolicy:    1,
+		OptInDisplayTimeout:     300,
+	}
+
+	return response, nil
+}
+
+func (d *Device) SetIPSOptInService(ctx context.Context, request optin.OptInServiceRequest) error {
+	if err := d.sim.call(ctx, "SetIPSOptInService"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	d.optInRequired = uint32(request.OptInRequired) //nolint:gosec // 0, 1 or 4294967295 as sent by the console
+	d.mu.Unlock()
+
+	return nil
+}
+
+func (d *Device) GetKVMRedirection(ctx context.Context) (kvm.Response, error) {
+	if err := d.sim.call(ctx, "GetKVMRedirection"); err != nil {
+		return kvm.Response{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	state := kvm.EnabledStateDisabled
+	if d.kvm {
+		state = kvm.EnabledStateEnabled
+	}
+
+	response := kvm.Response{}
+	response.Body.GetResponse = kvm.KVMRedirectionSAP{
+		CreationClassName:       "CIM_KVMRedirectionSAP",
+		ElementName:             "KVM Redirection Service Access Point",
+		Name:                    "KVM Redirection Service Access Point",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "ManagedSystem",
+		EnabledState:            state,
+	}
+
+	return response, nil
+}
+
+func (d *Device) SetKVMRedirection(ctx context.Context, enable bool) (int, error) {
+	if err := d.sim.call(ctx, "SetKVMRedirection"); err != nil {
+		return 0, err
+	}
+
+	d.mu.Lock()
+	d.kvm = enable
+	d.mu.Unlock()
+
+	if enable {
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+func (d *Device) GetAlarmOccurrences(ctx context.Context) ([]ipsAlarmClock.AlarmClockOccurrence, error) {
+	if err := d.sim.call(ctx, "GetAlarmOccurrences"); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return slices.Clone(d.alarms), nil
+}
+
+func (d *Device) CreateAlarmOccurrences(ctx context.Context, name string, startTime time.Time, interval int, deleteOnCompletion bool) (alarmclock.AddAlarmOutput, error) {
+	if err := d.sim.call(ctx, "CreateAlarmOccurrences"); err != nil {
+		return alarmclock.AddAlarmOutput{}, err
+	}
+
+	alarm := ipsAlarmClock.AlarmClockOccurrence{
+		ElementName:        name,
+		InstanceID:         name,
+		StartTime:          ipsAlarmClock.StartTime{Datetime: startTime},
+		DeleteOnCompletion: deleteOnCompletion,
+	}
+
+	if interval > 0 {
+		alarm.Interval.Interval = fmt.Sprintf("PT%dM", interval)
+	}
+
+	d.mu.Lock()
+	d.alarms = append(d.alarms, alarm)
+	d.mu.Unlock()
+
+	return alarmclock.AddAlarmOutput{}, nil
+}
+
+func (d *Device) DeleteAlarmOccurrences(ctx context.Context, instanceID string) error {
+	if err := d.sim.call(ctx, "DeleteAlarmOccurrences"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	d.alarms = slices.DeleteFunc(d.alarms, func(a ipsAlarmClock.AlarmClockOccurrence) bool { return a.InstanceID == instanceID })
+	d.mu.Unlock()
+
+	return nil
+}
+
+// GetHardwareInfo answers in the shape of the real device, limited to the given wsman.HardwareClasses.
+func (d *Device) GetHardwareInfo(ctx context.Context, classes ...string) (interface{}, error) {
+	if err := d.sim.call(ctx, "GetHardwareInfo"); err != nil {
+		return nil, err
+	}
+
+	info := map[string]interface{}{
+		wsman.ClassChassis: map[string]interface{}{
+			"response": chassis.PackageResponse{
+				Manufacturer: "Simulated Systems", Model: "SIM-1000", SerialNumber: d.serial(), Version: "1.0",
+				ElementName: "Managed System Chassis", CreationClassName: "CIM_Chassis", Tag: "CIM_Chassis",
+				ChassisPackageType: 3,
+			},
+			"responses": []interface{}{},
+		},
+		wsman.ClassChip: map[string]interface{}{
+			"responses": []interface{}{chip.PackageResponse{
+				Manufacturer: "Intel(R) Corporation", Version: "13th Gen Intel(R) Core(TM) i7-1365U",
+				ElementName: "Managed System Processor Chip", CreationClassName: "CIM_Chip", Tag: "CPU 0",
+			}},
+		},
+		wsman.ClassCard: map[string]interface{}{
+			"response": card.PackageResponse{
+				Manufacturer: "Simulated Systems", Model: "SIM-BOARD", SerialNumber: d.serial(), Version: "A01",
+				ElementName: "Managed System Base Board", CreationClassName: "CIM_Card", Tag: "CIM_Card",
+			},
+			"responses": []interface{}{},
+		},
+		wsman.ClassBIOSElement: map[string]interface{}{
+			"response": bios.BiosElement{
+				Manufacturer: "Simulated Systems", Version: "SIM.1.0.0", Name: "Primary BIOS", ElementName: "Primary BIOS",
+				SoftwareElementID: "SIM.1.0.0", PrimaryBIOS: true, ReleaseDate: bios.Time{DateTime: "2026-01-15T00:00:00Z"},
+			},
+			"responses": []interface{}{},
+		},
+		wsman.ClassProcessor: map[string]interface{}{
+			"responses": []interface{}{processor.PackageResponse{
+				DeviceID: "CPU 0", CreationClassName: "CIM_Processor", SystemName: "ManagedSystem",
+				SystemCreationClassName: "CIM_ComputerSystem", ElementName: "Managed System CPU", Role: "Central Processor",
+				Family: 198, MaxClockSpeed: 5200, CurrentClockSpeed: 1800, Stepping: "1", ExternalBusClockSpeed: 100,
+				HealthState: 5, EnabledState: 2, RequestedState: 12, CPUStatus: 1, UpgradeMethod: 1,
+			}},
+		},
+		wsman.ClassPhysicalMemory: map[string]interface{}{
+			"responses": []physical.PhysicalMemory{
+				d.memory("Channel 0 DIMM 0"), d.memory("Channel 1 DIMM 0"),
+			},
+		},
+	}
+
+	if len(classes) > 0 {
+		for class := range info {
+			if !slices.Contains(classes, class) {
+				delete(info, class)
+			}
+		}
+	}
+
+	return info, nil
+}
+
+func (d *Device) memory(bank string) physical.PhysicalMemory {
+	return physical.PhysicalMemory{
+		PartNumber: "SIM-DDR5-16G", SerialNumber: d.serial(), Manufacturer: "Simulated Memory", ElementName: "Managed System Memory Chip",
+		CreationClassName: "CIM_PhysicalMemory", Tag: bank, FormFactor: 12, MemoryType: 34, Speed: 0, Capacity: 17179869184,
+		BankLabel: bank, ConfiguredMemoryClockSpeed: 5200, IsSpeedInMhz: true, MaxMemorySpeed: 5200,
+	}
+}
+
+func (d *Device) GetPowerState(ctx context.Context) ([]service.CIM_AssociatedPowerManagementService, error) {
+	if err := d.sim.call(ctx, "GetPowerState"); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return []service.CIM_AssociatedPowerManagementService{{PowerState: d.powerState}}, nil
+}
+
+func (d *Device) GetOSPowerSavingState(ctx context.Context) (ipspower.OSPowerSavingState, error) {
+	if err := d.sim.call(ctx, "GetOSPowerSavingState"); err != nil {
+		return 0, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.osPowerSaving, nil
+}
+
+func (d *Device) GetIPSPowerManagementService(ctx context.Context) (ipspower.PowerManagementService, error) {
+	if err := d.sim.call(ctx, "GetIPSPowerManagementService"); err != nil {
+		return ipspower.PowerManagementService{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return ipspower.PowerManagementService{
+		CreationClassName:       "IPS_PowerManagementService",
+		ElementName:             "Intel(r) AMT Power Management Service",
+		Name:                    "Intel(r) AMT Power Management Service",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		EnabledState:            2,
+		OSPowerSavingState:      d.osPowerSaving,
+	}, nil
+}
+
+func (d *Device) RequestOSPowerSavingStateChange(ctx context.Context, osPowerSavingState ipspower.OSPowerSavingState) (ipspower.PowerActionResponse, error) {
+	if err := d.sim.call(ctx, "RequestOSPowerSavingStateChange"); err != nil {
+		return ipspower.PowerActionResponse{}, err
+	}
+
+	d.mu.Lock()
+	d.osPowerSaving = osPowerSavingState
+	d.mu.Unlock()
+
+	return ipspower.PowerActionResponse{ReturnValue: 0}, nil
+}
+
+func (d *Device) GetPowerCapabilities(ctx context.Context) (boot.BootCapabilitiesResponse, error) {
+	if err := d.sim.call(ctx, "GetPowerCapabilities"); err != nil {
+		return boot.BootCapabilitiesResponse{}, err
+	}
+
+	return boot.BootCapabilitiesResponse{
+		InstanceID:             "Intel(r) AMT:BootCapabilities 0",
+		ElementName:            "Intel(r) AMT: Boot Capabilities",
+		IDER:                   true,
+		SOL:                    true,
+		BIOSSetup:              true,
+		BIOSPause:              true,
+		ForcePXEBoot:           true,
+		ForceHardDriveBoot:     true,
+		ForceCDorDVDBoot:       true,
+		VerbosityScreenBlank:   true,
+		KeyboardLock:           true,
+		UserPasswordBypass:     true,
+		BIOSSecureBoot:         true,
+		ForceUEFIHTTPSBoot:     true,
+		ForceWinREBoot:         true,
+		AMTSecureBootControl:   true,
+		SecureErase:            true,
+		ForcedProgressEvents:   true,
+		VerbosityVerbose:       true,
+		VerbosityQuiet:         true,
+		ConfigurationDataReset: true,
+
+		UEFIWiFiCoExistenceAndProfileShare: true,
+	}, nil
+}
+
+func (d *Device) GetGeneralSettings(ctx context.Context) (interface{}, error) {
+	if err := d.sim.call(ctx, "GetGeneralSettings"); err != nil {
+		return nil, err
+	}
+
+	return general.GeneralSettingsResponse{
+		ElementName:             "Intel(r) AMT: General Settings",
+		InstanceID:              "Intel(r) AMT: General Settings",
+		NetworkInterfaceEnabled: true,
+		DigestRealm:             "Digest:" + d.guid,
+		IdleWakeTimeout:         65535,
+		HostName:                fmt.Sprintf("sim-%05d", d.index),
+		DomainName:              "example.com",
+		PingResponseEnabled:     true,
+		WsmanOnlyMode:           false,
+		PreferredAddressFamily:  0,
+		DDNSUpdateEnabled:       false,
+		SharedFQDN:              true,
+		AMTNetworkEnabled:       1,
+		RmcpPingResponseEnabled: true,
+		PrivacyLevel:            0,
+		PowerSource:             0,
+	}, nil
+}
+
+func (d *Device) CancelUserConsentRequest(ctx context.Context) (optin.Response, error) {
+	if err := d.sim.call(ctx, "CancelUserConsentRequest"); err != nil {
+		return optin.Response{}, err
+	}
+
+	d.mu.Lock()
+	d.optInState = optInNotStarted
+	d.mu.Unlock()
+
+	return optin.Response{}, nil
+}
+
+func (d *Device) GetUserConsentCode(ctx context.Context) (optin.Response, error) {
+	if err := d.sim.call(ctx, "GetUserConsentCode"); err != nil {
+		return optin.Response{}, err
+	}
+
+	d.mu.Lock()
+	d.optInState = optInDisplayed
+	d.mu.Unlock()
+
+	return optin.Response{}, nil
+}
+
+func (d *Device) SendConsentCode(ctx context.Context, code int) (optin.Response, error) {
+	if err := d.sim.call(ctx, "SendConsentCode"); err != nil {
+		return optin.Response{}, err
+	}
+
+	response := optin.Response{}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if code != ConsentCode || d.optInState != optInDisplayed {
+		response.Body.SendOptInCodeResponse.ReturnValue = returnInvalidCode
+
+		return response, nil
+	}
+
+	d.optInState = optInReceived
+
+	return response, nil
+}
+
+// SendPowerAction changes the power state right away, the simulated devices do not boot.
+func (d *Device) SendPowerAction(ctx context.Context, action int) (power.PowerActionResponse, error) {
+	if err := d.sim.call(ctx, "SendPowerAction"); err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	switch service.PowerState(action) { //nolint:exhaustive // every other action leaves the device on
+	case powerSleep, powerHibernate:
+		d.powerState = service.PowerState(action)
+	case powerOffSoft, 6, 12, 13:
+		d.powerState = powerOffSoft
+	default:
+		d.powerState = powerOn
+	}
+
+	return power.PowerActionResponse{ReturnValue: 0}, nil
+}
+
+func (d *Device) GetBootData(ctx context.Context) (boot.BootSettingDataResponse, error) {
+	if err := d.sim.call(ctx, "GetBootData"); err != nil {
+		return boot.BootSettingDataResponse{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.bootData, nil
+}
+
+func (d *Device) SetBootData(ctx context.Context, data boot.BootSettingDataRequest) (interface{}, error) {
+	if err := d.sim.call(ctx, "SetBootData"); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.bootData.BIOSPause = data.BIOSPause
+	d.bootData.BIOSSetup = data.BIOSSetup
+	d.bootData.BootMediaIndex = data.BootMediaIndex
+	d.bootData.ConfigurationDataReset = data.ConfigurationDataReset
+	d.bootData.FirmwareVerbosity = data.FirmwareVerbosity
+	d.bootData.ForcedProgressEvents = data.ForcedProgressEvents
+	d.bootData.IDERBootDevice = data.IDERBootDevice
+	d.bootData.LockKeyboard = data.LockKeyboard
+	d.bootData.LockPowerButton = data.LockPowerButton
+	d.bootData.LockResetButton = data.LockResetButton
+	d.bootData.LockSleepButton = data.LockSleepButton
+	d.bootData.ReflashBIOS = data.ReflashBIOS
+	d.bootData.UseIDER = data.UseIDER
+	d.bootData.UseSOL = data.UseSOL
+	d.bootData.UseSafeMode = data.UseSafeMode
+	d.bootData.UserPasswordBypass = data.UserPasswordBypass
+	d.bootData.SecureErase = data.SecureErase
+	d.bootData.UEFIHTTPSBootEnabled = data.UEFIHTTPSBootEnabled
+	d.bootData.WinREBootEnabled = data.WinREBootEnabled
+
+	return d.bootData, nil
+}
+
+func (d *Device) GetBootService(ctx context.Context) (cimBoot.BootService, error) {
+	if err := d.sim.call(ctx, "GetBootService"); err != nil {
+		return cimBoot.BootService{}, err
+	}
+
+	return d.bootService(), nil
+}
+
+func (d *Device) bootService() cimBoot.BootService {
+	return cimBoot.BootService{
+		Name:                    "Intel(r) AMT Boot Service",
+		CreationClassName:       "CIM_BootService",
+		SystemName:              "Intel(r) AMT",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		ElementName:             "Intel(r) AMT Boot Service",
+		EnabledState:            32769,
+		RequestedState:          12,
+	}
+}
+
+func (d *Device) SetBootConfigRole(ctx context.Context, _ int) (interface{}, error) {
+	if err := d.sim.call(ctx, "SetBootConfigRole"); err != nil {
+		return nil, err
+	}
+
+	return cimBoot.Response{}, nil
+}
+
+func (d *Device) ChangeBootOrder(ctx context.Context, _ string) (cimBoot.ChangeBootOrder_OUTPUT, error) {
+	if err := d.sim.call(ctx, "ChangeBootOrder"); err != nil {
+		return cimBoot.ChangeBootOrder_OUTPUT{}, err
+	}
+
+	return cimBoot.ChangeBootOrder_OUTPUT{ReturnValue: 0}, nil
+}
+
+// GetAuditLog answers with an empty audit log.
+func (d *Device) GetAuditLog(ctx context.Context, _ int) (auditlog.Response, error) {
+	if err := d.sim.call(ctx, "GetAuditLog"); err != nil {
+		return auditlog.Response{}, err
+	}
+
+	response := auditlog.Response{}
+	response.Body.DecodedRecordsResponse = []auditlog.AuditLogRecord{}
+
+	return response, nil
+}
+
+// GetEventLog answers with an empty event log.
+func (d *Device) GetEventLog(ctx context.Context, _, _ int) (messagelog.GetRecordsResponse, error) {
+	if err := d.sim.call(ctx, "GetEventLog"); err != nil {
+		return messagelog.GetRecordsResponse{}, err
+	}
+
+	return messagelog.GetRecordsResponse{NoMoreRecords: true}, nil
+}
+
+// GetNetworkSettings answers with a wired port configured through DHCP.
+func (d *Device) GetNetworkSettings(ctx context.Context, _ ...string) (wsman.NetworkResults, error) {
+	if err := d.sim.call(ctx, "GetNetworkSettings"); err != nil {
+		return wsman.NetworkResults{}, err
+	}
+
+	return wsman.NetworkResults{
+		EthernetPortSettingsResult: []ethernetport.SettingsResponse{{
+			ElementName:     "Intel(r) AMT Ethernet Port Settings",
+			InstanceID:      wiredPort,
+			SharedMAC:       true,
+			MACAddress:      d.mac(),
+			LinkIsUp:        true,
+			LinkPolicy:      []ethernetport.LinkPolicy{1, 14, 16},
+			SharedStaticIp:  false,
+			SharedDynamicIP: true,
+			IpSyncEnabled:   true,
+			DHCPEnabled:     true,
+			IPAddress:       d.ip(),
+			SubnetMask:      "255.0.0.0",
+			DefaultGateway:  "10.0.0.1",
+			PrimaryDNS:      "10.0.0.1",
+		}},
+	}, nil
+}
+
+func (d *Device) GetCertificates(ctx context.Context) (wsman.Certificates, error) {
+	if err := d.sim.call(ctx, "GetCertificates"); err != nil {
+		return wsman.Certificates{}, err
+	}
+
+	return wsman.Certificates{}, nil
+}
+
+func (d *Device) GetTLSSettingData(ctx context.Context) ([]tls.SettingDataResponse, error) {
+	if err := d.sim.call(ctx, "GetTLSSettingData"); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	settings := make([]tls.SettingDataResponse, len(d.tlsSettings))
+	for i := range d.tlsSettings {
+		settings[i] = d.tlsSettings[i]
+		settings[i].TrustedCN = slices.Clone(d.tlsSettings[i].TrustedCN)
+	}
+
+	return settings, nil
+}
+
+func (d *Device) PutTLSSettingData(ctx context.Context, settings tls.SettingDataRequest) error {
+	if err := d.sim.call(ctx, "PutTLSSettingData"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i := range d.tlsSettings {
+		if d.tlsSettings[i].InstanceID != settings.InstanceID {
+			continue
+		}
+
+		d.tlsSettings[i].Enabled = settings.Enabled
+		d.tlsSettings[i].MutualAuthentication = settings.MutualAuthentication
+		d.tlsSettings[i].TrustedCN = slices.Clone(settings.TrustedCN)
+		d.tlsSettings[i].AcceptNonSecureConnections = settings.AcceptNonSecureConnections
+
+		return nil
+	}
+
+	return amtstatus.Error{Operation: "Put AMT_TLSSettingData", Explanation: amtstatus.Explain(returnInvalidParam)}
+}
+
+func (d *Device) GetCredentialRelationships(ctx context.Context) (credential.Items, error) {
+	if err := d.sim.call(ctx, "GetCredentialRelationships"); err != nil {
+		return credential.Items{}, err
+	}
+
+	return credential.Items{}, nil
+}
+
+func (d *Device) GetConcreteDependencies(ctx context.Context) ([]concrete.ConcreteDependency, error) {
+	if err := d.sim.call(ctx, "GetConcreteDependencies"); err != nil {
+		return nil, err
+	}
+
+	return []concrete.ConcreteDependency{}, nil
+}
+
+func (d *Device) GetDiskInfo(ctx context.Context) (interface{}, error) {
+	if err := d.sim.call(ctx, "GetDiskInfo"); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"CIM_MediaAccessDevice": map[string]interface{}{
+			"responses": []interface{}{[]mediaaccess.MediaAccessDevice{{
+				CreationClassName: "CIM_MediaAccessDevice", DeviceID: "MEDIA DEV 0", ElementName: "Managed System Media Access Device",
+				EnabledState: 2, MaxMediaSize: 512110190, SystemCreationClassName: "CIM_ComputerSystem", SystemName: "ManagedSystem",
+			}}},
+		},
+		"CIM_PhysicalPackage": map[string]interface{}{
+			"responses": []interface{}{[]physical.PhysicalPackage{{
+				Manufacturer: "Simulated Storage", Model: "SIM-NVME-512", SerialNumber: d.serial(),
+				ElementName: "Managed System Media Package", CreationClassName: "CIM_PhysicalPackage", Tag: "MEDIA DEV 0",
+			}}},
+		},
+	}, nil
+}
+
+// GetDeviceCertificate returns the self-signed TLS certificate of the device, created on the first call.
+func (d *Device) GetDeviceCertificate(ctx context.Context) (*gotls.Certificate, error) {
+	if err := d.sim.call(ctx, "GetDeviceCertificate"); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.cert != nil {
+		return d.cert, nil
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(int64(d.index)),
+		Subject:      pkix.Name{CommonName: fmt.Sprintf("sim-%05d", d.index), Organization: []string{"Simulated Systems"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(10, 0, 0),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	d.cert = &gotls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+
+	return d.cert, nil
+}
+
+func (d *Device) GetCIMBootSourceSetting(ctx context.Context) (cimBoot.Response, error) {
+	if err := d.sim.call(ctx, "GetCIMBootSourceSetting"); err != nil {
+		return cimBoot.Response{}, err
+	}
+
+	response := cimBoot.Response{}
+	response.Body.PullResponse.BootSourceSettingItems = []cimBoot.BootSourceSetting{
+		{ElementName: "Intel(r) AMT: Force Hard-drive Boot", InstanceID: "Intel(r) AMT: Force Hard-drive Boot"},
+		{ElementName: "Intel(r) AMT: Force PXE Boot", InstanceID: "Intel(r) AMT: Force PXE Boot"},
+		{ElementName: "Intel(r) AMT: Force CD/DVD Boot", InstanceID: "Intel(r) AMT: Force CD/DVD Boot"},
+	}
+
+	return response, nil
+}
+
+func (d *Device) BootServiceStateChange(ctx context.Context, _ int) (cimBoot.BootService, error) {
+	if err := d.sim.call(ctx, "BootServiceStateChange"); err != nil {
+		return cimBoot.BootService{}, err
+	}
+
+	return d.bootService(), nil
+}
+
+func (d *Device) GetIPSScreenSettingData(ctx context.Context) (screensetting.Response, error) {
+	if err := d.sim.call(ctx, "GetIPSScreenSettingData"); err != nil {
+		return screensetting.Response{}, err
+	}
+
+	return screensetting.Response{}, nil
+}
+
+func (d *Device) GetIPSKVMRedirectionSettingData(ctx context.Context) (kvmredirection.Response, error) {
+	if err := d.sim.call(ctx, "GetIPSKVMRedirectionSettingData"); err != nil {
+		return kvmredirection.Response{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	response := kvmredirection.Response{}
+	response.Body.KVMRedirectionSettingsResponse = d.kvmSettings
+
+	return response, nil
+}
+
+func (d *Device) SetIPSKVMRedirectionSettingData(ctx context.Context, data *kvmredirection.KVMRedirectionSettingsRequest) (kvmredirection.Response, error) {
+	if err := d.sim.call(ctx, "SetIPSKVMRedirectionSettingData"); err != nil {
+		return kvmredirection.Response{}, err
+	}
+
+	d.mu.Lock()
+	d.kvmSettings.Is5900PortEnabled = data.Is5900PortEnabled
+	d.kvmSettings.OptInPolicy = data.OptInPolicy
+	d.kvmSettings.SessionTimeout = data.SessionTimeout
+	d.kvmSettings.DefaultScreen = data.DefaultScreen
+	d.kvmSettings.DoubleBufferMode = data.DoubleBufferMode
+	d.kvmSettings.DoubleBufferState = data.DoubleBufferState
+	d.mu.Unlock()
+
+	return d.GetIPSKVMRedirectionSettingData(ctx)
+}
+
+func (d *Device) DeleteCertificate(ctx context.Context, _ string) error {
+	return d.sim.call(ctx, "DeleteCertificate")
+}
+
+func (d *Device) DeleteKeyPair(ctx context.Context, _ string) error {
+	return d.sim.call(ctx, "DeleteKeyPair")
+}
+
+func (d *Device) RemoveRemoteAccess(ctx context.Context) error {
+	return d.sim.call(ctx, "RemoveRemoteAccess")
+}
+
+func (d *Device) SetLinkPreference(ctx context.Context, linkPreference, _ uint32) (int, error) {
+	if err := d.sim.call(ctx, "SetLinkPreference"); err != nil {
+		return 0, err
+	}
+
+	d.mu.Lock()
+	d.linkPreference = linkPreference
+	d.mu.Unlock()
+
+	return 0, nil
+}
+
+// GetEthernetPorts answers with the wired port and the wireless port, which is owned by the host unless the
+// link preference was set to ME.
+func (d *Device) GetEthernetPorts(ctx context.Context) ([]ethernetport.SettingsResponse, error) {
+	if err := d.sim.call(ctx, "GetEthernetPorts"); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return []ethernetport.SettingsResponse{{
+		ElementName:     "Intel(r) AMT Ethernet Port Settings",
+		InstanceID:      wiredPort,
+		SharedMAC:       true,
+		MACAddress:      d.mac(),
+		LinkIsUp:        true,
+		LinkPolicy:      slices.Clone(d.linkPolicies[wiredPort]),
+		SharedDynamicIP: true,
+		IpSyncEnabled:   true,
+		DHCPEnabled:     true,
+		IPAddress:       d.ip(),
+		SubnetMask:      "255.0.0.0",
+		DefaultGateway:  "10.0.0.1",
+		PrimaryDNS:      "10.0.0.1",
+	}, {
+		ElementName:    "Intel(r) AMT Ethernet Port Settings",
+		InstanceID:     wirelessPort,
+		LinkIsUp:       true,
+		LinkPolicy:     slices.Clone(d.linkPolicies[wirelessPort]),
+		LinkPreference: ethernetport.LinkPreference(d.linkPreference),
+		LinkControl:    ethernetport.LinkControl(d.linkPreference),
+		DHCPEnabled:    true,
+	}}, nil
+}
+
+func (d *Device) SetLinkPolicy(ctx context.Context, instanceID string, policy []ethernetport.LinkPolicy) error {
+	if err := d.sim.call(ctx, "SetLinkPolicy"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.linkPolicies[instanceID]; ok {
+		d.linkPolicies[instanceID] = slices.Clone(policy)
+	}
+
+	return nil
+}
+
+func (d *Device) GetKerberosSettingData(ctx context.Context) (kerberos.KerberosSettingDataResponse, error) {
+	if err := d.sim.call(ctx, "GetKerberosSettingData"); err != nil {
+		return kerberos.KerberosSettingDataResponse{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.kerberos, nil
+}
+
+// PutKerberosSettingData keeps the settings without their keys, like AMT: RC4-HMAC is configured with any key,
+// the AES algorithms only when a passphrase and salt are given.
+func (d *Device) PutKerberosSettingData(ctx context.Context, settings kerberos.KerberosSettingDataResponse) (kerberos.KerberosSettingDataResponse, error) {
+	if err := d.sim.call(ctx, "PutKerberosSettingData"); err != nil {
+		return kerberos.KerberosSettingDataResponse{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.kerberos.RealmName = settings.RealmName
+	d.kerberos.KeyVersion = settings.KeyVersion
+	d.kerberos.MaximumClockTolerance = settings.MaximumClockTolerance
+	d.kerberos.KrbEnabled = settings.KrbEnabled
+
+	if len(settings.MasterKey) > 0 || settings.Passphrase != "" {
+		d.kerberos.ConfiguredEncryptionAlgorithms = []kerberos.ConfiguredEncryptionAlgorithms{kerberos.ConfiguredEncryptionAlgorithmsRC4HMAC}
+	}
+
+	if settings.Passphrase != "" && settings.Salt != "" {
+		d.kerberos.ConfiguredEncryptionAlgorithms = append(d.kerberos.ConfiguredEncryptionAlgorithms,
+			kerberos.ConfiguredEncryptionAlgorithmsAES128CTSHMACSHA196, kerberos.ConfiguredEncryptionAlgorithmsAES256CTSHMACSHA196)
+	}
+
+	return d.kerberos, nil
+}
+
+// GetUserACLEntries answers with the users added through the simulator, the device has none at first.
+func (d *Device) GetUserACLEntries(ctx context.Context) ([]wsman.UserACLEntry, error) {
+	if err := d.sim.call(ctx, "GetUserACLEntries"); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	users := make([]wsman.UserACLEntry, 0, len(d.users))
+	for _, user := range d.users {
+		users = append(users, cloneUser(user))
+	}
+
+	return users, nil
+}
+
+// AddUserACLEntry keeps the user enabled, without its password, and refuses a name or SID already in use.
+func (d *Device) AddUserACLEntry(ctx context.Context, entry wsman.UserACLEntry, _ string) (int, error) {
+	if err := d.sim.call(ctx, "AddUserACLEntry"); err != nil {
+		return 0, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.userIndex(entry) >= 0 {
+		return 0, amtstatus.Error{Operation: authorization.AddUserACLEntryEx, Explanation: amtstatus.Explain(returnDuplicate)}
+	}
+
+	d.nextUser++
+
+	entry = cloneUser(entry)
+	entry.Handle = d.nextUser
+	entry.Enabled = true
+	d.users = append(d.users, entry)
+
+	return entry.Handle, nil
+}
+
+func (d *Device) UpdateUserACLEntry(ctx context.Context, entry wsman.UserACLEntry, _ string) error {
+	if err := d.sim.call(ctx, "UpdateUserACLEntry"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := d.userHandle(entry.Handle)
+	if i < 0 {
+		return amtstatus.Error{Operation: authorization.UpdateUserACLEntryEx, Explanation: amtstatus.Explain(returnInvalidHandle)}
+	}
+
+	if j := d.userIndex(entry); j >= 0 && j != i {
+		return amtstatus.Error{Operation: authorization.UpdateUserACLEntryEx, Explanation: amtstatus.Explain(returnDuplicate)}
+	}
+
+	entry = cloneUser(entry)
+	entry.Enabled = d.users[i].Enabled
+	d.users[i] = entry
+
+	return nil
+}
+
+func (d *Device) RemoveUserACLEntry(ctx context.Context, handle int) error {
+	if err := d.sim.call(ctx, "RemoveUserACLEntry"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := d.userHandle(handle)
+	if i < 0 {
+		return amtstatus.Error{Operation: authorization.RemoveUserACLEntry, Explanation: amtstatus.Explain(returnInvalidHandle)}
+	}
+
+	d.users = slices.Delete(d.users, i, i+1)
+
+	return nil
+}
+
+func (d *Device) SetUserACLEnabledState(ctx context.Context, handle int, enabled bool) error {
+	if err := d.sim.call(ctx, "SetUserACLEnabledState"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := d.userHandle(handle)
+	if i < 0 {
+		return amtstatus.Error{Operation: authorization.SetACLEnabledState, Explanation: amtstatus.Explain(returnInvalidHandle)}
+	}
+
+	d.users[i].Enabled = enabled
+
+	return nil
+}
+
+// userHandle returns the index of the user with handle, -1 when there is none. It must be called with d.mu held.
+func (d *Device) userHandle(handle int) int {
+	return slices.IndexFunc(d.users, func(user wsman.UserACLEntry) bool { return user.Handle == handle })
+}
+
+// userIndex returns the index of the user with the name or SID of entry, -1 when there is none. It must be called
+// with d.mu held.
+func (d *Device) userIndex(entry wsman.UserACLEntry) int {
+	return slices.IndexFunc(d.users, func(user wsman.UserACLEntry) bool {
+		if entry.DigestUsername != "" {
+			return user.DigestUsername == entry.DigestUsername
+		}
+
+		return len(user.KerberosUserSID) > 0 && slices.Equal(user.KerberosUserSID, entry.KerberosUserSID)
+	})
+}
+
+func cloneUser(user wsman.UserACLEntry) wsman.UserACLEntry {
+	user.KerberosUserSID = slices.Clone(user.KerberosUserSID)
+	user.Realms = slices.Clone(user.Realms)
+
+	return user
+}
+
+func (d *Device) GetWiFiEndpointSettings(ctx context.Context) ([]wifi.WiFiEndpointSettingsResponse, error) {
+	if err := d.sim.call(ctx, "GetWiFiEndpointSettings"); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return slices.Clone(d.wifiProfiles), nil
+}
+
+func (d *Device) PutWiFiEndpointSettings(ctx context.Context, settings wifi.WiFiEndpointSettingsResponse) error {
+	if err := d.sim.call(ctx, "PutWiFiEndpointSettings"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i := range d.wifiProfiles {
+		if d.wifiProfiles[i].InstanceID == settings.InstanceID {
+			d.wifiProfiles[i].Priority = settings.Priority
+		}
+	}
+
+	return nil
+}
+
+func (d *Device) GetWiFiPortConfigurationService(ctx context.Context) (wifiportconfiguration.WiFiPortConfigurationServiceResponse, error) {
+	if err := d.sim.call(ctx, "GetWiFiPortConfigurationService"); err != nil {
+		return wifiportconfiguration.WiFiPortConfigurationServiceResponse{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.wifiPort, nil
+}
+
+func (d *Device) PutWiFiPortConfigurationService(ctx context.Context, settings wifiportconfiguration.WiFiPortConfigurationServiceResponse) (wifiportconfiguration.WiFiPortConfigurationServiceResponse, error) {
+	if err := d.sim.call(ctx, "PutWiFiPortConfigurationService"); err != nil {
+		return wifiportconfiguration.WiFiPortConfigurationServiceResponse{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.wifiPort.LocalProfileSynchronizationEnabled = settings.LocalProfileSynchronizationEnabled
+	d.wifiPort.UEFIWiFiProfileShareEnabled = settings.UEFIWiFiProfileShareEnabled
+
+	return d.wifiPort, nil
+}
@@ -0,0 +1,150 @@
+// Package simulator answers WS-Man calls for virtual Intel AMT devices, so that the UI and load
+// tests can run without physical AMT hardware. Calls to simulated devices wait for a configurable
+// latency and fail at a configurable rate; every other device is passed on to the real WS-Man client.
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// Tag is added to the simulated devices, so that they can be told apart from real devices.
+const Tag = "simulated"
+
+// ErrInjected is returned by the calls the simulator fails on purpose.
+var ErrInjected = errors.New("simulated device failure")
+
+// Devices stores the simulated devices.
+type Devices interface {
+	GetByID(ctx context.Context, guid, tenantID string, includeSecrets bool) (*dto.Device, error)
+	Insert(ctx context.Context, d *dto.Device) (*dto.Device, error)
+}
+
+// Simulator hands out a simulated device for the GUIDs it knows and the real WS-Man client for any other device.
+type Simulator struct {
+	next      devices.WSMAN
+	latency   time.Duration
+	errorRate float64
+	devices   map[string]*Device
+	random    func() float64
+}
+
+var _ devices.WSMAN = (*Simulator)(nil)
+
+// New creates cfg.Devices simulated devices in front of next.
+func New(next devices.WSMAN, cfg config.Simulator) *Simulator {
+	s := &Simulator{
+		next:      next,
+		latency:   cfg.Latency,
+		errorRate: cfg.ErrorRate,
+		devices:   make(map[string]*Device, cfg.Devices),
+		random:    rand.Float64,
+	}
+
+	for i := 1; i <= cfg.Devices; i++ {
+		guid := GUID(i)
+		s.devices[guid] = newDevice(s, guid, i)
+	}
+
+	return s
+}
+
+// GUID returns the GUID of the simulated device with the given index, the same on every start.
+func GUID(index int) string {
+	return fmt.Sprintf("00000000-0000-4000-8000-%012x", index)
+}
+
+// Device returns the simulated device with the given GUID.
+func (s *Simulator) Device(guid string) (*Device, bool) {
+	d, ok := s.devices[guid]
+
+	return d, ok
+}
+
+// Seed adds the simulated devices that are missing to the default tenant.
+func (s *Simulator) Seed(ctx context.Context, store Devices) (int, error) {
+	added := 0
+
+	for guid, d := range s.devices {
+		if existing, err := store.GetByID(ctx, guid, "", false); err == nil && existing != nil {
+			continue
+		}
+
+		_, err := store.Insert(ctx, &dto.Device{
+			GUID:         guid,
+			Hostname:     fmt.Sprintf("sim-%05d.example.com", d.index),
+			FriendlyName: fmt.Sprintf("Simulated device %d", d.index),
+			Tags:         []string{Tag},
+			Username:     "admin",
+			Password:     "Simulated1!",
+		})
+		if err != nil {
+			return added, fmt.Errorf("simulator - Seed - %s: %w", guid, err)
+		}
+
+		added++
+	}
+
+	return added, nil
+}
+
+func (s *Simulator) SetupWsmanClient(device entity.Device, isRedirection, logMessages bool) (wsman.Management, error) {
+	if d, ok := s.devices[device.GUID]; ok {
+		return d, nil
+	}
+
+	return s.next.SetupWsmanClient(device, isRedirection, logMessages)
+}
+
+func (s *Simulator) DestroyWsmanClient(device dto.Device) {
+	if _, ok := s.devices[device.GUID]; ok {
+		return
+	}
+
+	s.next.DestroyWsmanClient(device)
+}
+
+func (s *Simulator) Worker() {
+	s.next.Worker()
+}
+
+func (s *Simulator) StartCapture(guid string, duration time.Duration) (wsman.CaptureInfo, error) {
+	return s.next.StartCapture(guid, duration)
+}
+
+func (s *Simulator) StopCapture(guid string) (wsman.CaptureInfo, error) {
+	return s.next.StopCapture(guid)
+}
+
+func (s *Simulator) CaptureTrace(guid string) (wsman.CaptureInfo, []byte, error) {
+	return s.next.CaptureTrace(guid)
+}
+
+// call waits between half and one and a half of the latency, then fails at the error rate.
+func (s *Simulator) call(ctx context.Context, name string) error {
+	if s.latency > 0 {
+		timer := time.NewTimer(s.latency/2 + time.Duration(s.random()*float64(s.latency)))
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if s.errorRate > 0 && s.random() < s.errorRate {
+		return fmt.Errorf("%s: %w", name, ErrInjected)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package simulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/service"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+)
+
+// memDevices keeps the seeded devices in memory.
+type memDevices map[string]dto.Device
+
+func (m memDevices) GetByID(_ context.Context, guid, _ string, _ bool) (*dto.Device, error) {
+	d, ok := m[guid]
+	if !ok {
+		return nil, nil
+	}
+
+	return &d, nil
+}
+
+func (m memDevices) Insert(_ context.Context, d *dto.Device) (*dto.Device, error) {
+	m[d.GUID] = *d
+
+	return d, nil
+}
+
+func TestSetupWsmanClient(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	next := mocks.NewMockWSMAN(ctrl)
+	real := mocks.NewMockManagement(ctrl)
+	s := New(next, config.Simulator{Devices: 2})
+
+	client, err := s.SetupWsmanClient(entity.Device{GUID: GUID(2)}, false, false)
+	require.NoError(t, err)
+
+	d, ok := s.Device(GUID(2))
+	require.True(t, ok)
+	require.Same(t, d, client)
+
+	next.EXPECT().SetupWsmanClient(entity.Device{GUID: "real"}, false, false).Return(real, nil)
+
+	client, err = s.SetupWsmanClient(entity.Device{GUID: "real"}, false, false)
+	require.NoError(t, err)
+	require.Same(t, real, client)
+}
+
+func TestCallInjectsErrors(t *testing.T) {
+	t.Parallel()
+
+	s := New(nil, config.Simulator{Devices: 1, ErrorRate: 0.5})
+	d, _ := s.Device(GUID(1))
+
+	s.random = func() float64 { return 0.49 }
+
+	_, err := d.GetPowerState(context.Background())
+	require.ErrorIs(t, err, ErrInjected)
+
+	s.random = func() float64 { return 0.5 }
+
+	states, err := d.GetPowerState(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, powerOn, states[0].PowerState)
+}
+
+func TestCallStopsWaitingWhenCanceled(t *testing.T) {
+	t.Parallel()
+
+	s := New(nil, config.Simulator{Devices: 1, Latency: time.Hour})
+	d, _ := s.Device(GUID(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := d.GetAMTVersion(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDeviceKeepsState(t *testing.T) {
+	t.Parallel()
+
+	s := New(nil, config.Simulator{Devices: 1})
+	d, _ := s.Device(GUID(1))
+	ctx := context.Background()
+
+	_, err := d.SendPowerAction(ctx, int(powerOffSoft))
+	require.NoError(t, err)
+
+	states, err := d.GetPowerState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, service.PowerState(powerOffSoft), states[0].PowerState)
+
+	response, err := d.SendConsentCode(ctx, ConsentCode)
+	require.NoError(t, err)
+	require.Equal(t, returnInvalidCode, response.Body.SendOptInCodeResponse.ReturnValue, "no code is displayed yet")
+
+	_, err = d.GetUserConsentCode(ctx)
+	require.NoError(t, err)
+
+	response, err = d.SendConsentCode(ctx, ConsentCode)
+	require.NoError(t, err)
+	require.Zero(t, response.Body.SendOptInCodeResponse.ReturnValue)
+}
+
+func TestSeedAddsMissingDevices(t *testing.T) {
+	t.Parallel()
+
+	s := New(nil, config.Simulator{Devices: 3})
+	store := memDevices{GUID(2): {GUID: GUID(2), Hostname: "renamed"}}
+
+	added, err := s.Seed(context.Background(), store)
+	require.NoError(t, err)
+	require.Equal(t, 2, added)
+	require.Len(t, store, 3)
+	require.Equal(t, "renamed", store[GUID(2)].Hostname)
+	require.Equal(t, []string{Tag}, store[GUID(1)].Tags)
+
+	added, err = s.Seed(context.Background(), store)
+	require.NoError(t, err)
+	require.Zero(t, added)
+}
@@ -0,0 +1,39 @@
+package devices
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	wsmanAPI "github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// SetSites connects to the devices with the proxy, TLS policy and default credentials of their site.
+func (uc *UseCase) SetSites(sites Sites) {
+	uc.sites = sites
+	uc.device = siteWSMAN{WSMAN: uc.device, sites: sites}
+}
+
+// siteDevice returns device with the connection settings of its site.
+func (uc *UseCase) siteDevice(ctx context.Context, device entity.Device) (entity.Device, error) {
+	if uc.sites == nil {
+		return device, nil
+	}
+
+	return uc.sites.Apply(ctx, device)
+}
+
+// siteWSMAN sets up the WS-Man clients of the devices with the connection settings of their site.
+type siteWSMAN struct {
+	WSMAN
+
+	sites Sites
+}
+
+func (w siteWSMAN) SetupWsmanClient(device entity.Device, isRedirection, logMessages bool) (wsmanAPI.Management, error) {
+	device, err := w.sites.Apply(context.Background(), device)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.WSMAN.SetupWsmanClient(device, isRedirection, logMessages)
+}
@@ -0,0 +1,44 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestSetSites(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+	wsmanMock := mocks.NewMockWSMAN(mockCtl)
+	wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+	sites := mocks.NewMockSites(mockCtl)
+
+	uc := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, nil)
+	uc.SetSites(sites)
+
+	device := entity.Device{GUID: "device-guid-123", Hostname: "10.20.1.5"}
+	sited := entity.Device{GUID: "device-guid-123", Hostname: "10.20.1.5", Proxy: "socks5://jump.example.com", UseTLS: true, Username: "admin"}
+
+	repo.EXPECT().GetByID(context.Background(), "device-guid-123", "").Return(&device, nil)
+	sites.EXPECT().Apply(context.Background(), device).Return(sited, nil)
+	wsmanMock.EXPECT().SetupWsmanClient(sited, false, true).Return(nil, ErrGeneral)
+
+	_, err := uc.SendPowerAction(context.Background(), "device-guid-123", 2)
+	require.ErrorIs(t, err, ErrGeneral)
+
+	repo.EXPECT().GetByID(context.Background(), "device-guid-123", "").Return(&device, nil)
+	sites.EXPECT().Apply(context.Background(), device).Return(device, ErrGeneral)
+
+	_, err = uc.SendPowerAction(context.Background(), "device-guid-123", 2)
+	require.ErrorIs(t, err, ErrGeneral, "a device is not reached without the settings of its site")
+}
@@ -1,14 +1,19 @@
 package devices
 
 import (
+	"maps"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
 
 	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/guid"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -40,17 +45,36 @@ const (
 type UseCase struct {
 	repo             Repository
 	device           WSMAN
+	sites            Sites
+	pending          PendingOperations
+	revocation       Revocation
 	redirection      Redirection
 	redirConnections map[string]*DeviceConnection
-	redirMutex       sync.RWMutex // Protects redirConnections map
+	redirMutex       sync.RWMutex // Protects redirConnections map and the idle timeouts
+	idleTimeout      time.Duration
+	idleWarning      time.Duration
+	kvmLimits        dto.KVMLimits
+	kvmMutex         sync.RWMutex // Protects kvmLimits
+	presence         map[string]map[presenceKey]time.Time
+	presenceMutex    sync.Mutex // Protects presence
+	locks            map[string]dto.DeviceLock
+	lockRequired     bool
+	lockTTL          time.Duration
+	lockMutex        sync.Mutex // Protects locks and the lock policy
 	log              logger.Interface
 	safeRequirements security.Cryptor
+	events           *eventbus.Bus
 }
 
-var ErrAMT = AMTError{Console: consoleerrors.CreateConsoleError("DevicesUseCase")}
+var (
+	ErrAMT          = AMTError{Console: consoleerrors.CreateConsoleError("DevicesUseCase")}
+	ErrUnauthorized = UnauthorizedError{Console: consoleerrors.CreateConsoleError("DevicesUseCase")}
+	ErrUnreachable  = UnreachableError{Console: consoleerrors.CreateConsoleError("DevicesUseCase")}
+	ErrAMTReturned  = AMTReturnedError{Console: consoleerrors.CreateConsoleError("DevicesUseCase")}
+)
 
 // New -.
-func New(r Repository, d WSMAN, redirection Redirection, log logger.Interface, safeRequirements security.Cryptor) *UseCase {
+func New(r Repository, d WSMAN, redirection Redirection, log logger.Interface, safeRequirements security.Cryptor, events *eventbus.Bus) *UseCase {
 	uc := &UseCase{
 		repo:             r,
 		device:           d,
@@ -58,6 +82,7 @@ func New(r Repository, d WSMAN, redirection Redirection, log logger.Interface, s
 		redirConnections: make(map[string]*DeviceConnection),
 		log:              log,
 		safeRequirements: safeRequirements,
+		events:           events,
 	}
 	// start up the worker
 	go d.Worker()
@@ -74,15 +99,30 @@ func (uc *UseCase) dtoToEntity(d *dto.Device) (*entity.Device, error) {
 
 	tags := strings.Join(d.Tags, ",")
 
+	// the same machine is stored under a single form of its GUID, whatever form it is given in
+	if d.GUID != "" {
+		id, err := guid.Parse(d.GUID)
+		if err != nil {
+			return nil, ErrValidationUseCase.Wrap("dtoToEntity", "guid.Parse", "invalid device GUID "+d.GUID)
+		}
+
+		d.GUID = id
+	}
+
 	d1 := &entity.Device{
 		ConnectionStatus: d.ConnectionStatus,
 		MPSInstance:      d.MPSInstance,
 		Hostname:         d.Hostname,
-		GUID:             strings.ToLower(d.GUID), // Normalize GUID to lowercase for case-insensitive matching
+		GUID:             d.GUID,
 		MPSUsername:      d.MPSUsername,
 		Tags:             tags,
 		TenantID:         d.TenantID,
 		FriendlyName:     d.FriendlyName,
+		Location:         d.Location,
+		Notes:            d.Notes,
+		Owner:            d.Owner,
+		Assignee:         d.Assignee,
+		Attributes:       maps.Clone(d.Attributes),
 		DNSSuffix:        d.DNSSuffix,
 		LastConnected:    d.LastConnected,
 		LastSeen:         d.LastSeen,
@@ -96,7 +136,7 @@ func (uc *UseCase) dtoToEntity(d *dto.Device) (*entity.Device, error) {
 
 	var err error
 
-	d1.Password, err = uc.safeRequirements.Encrypt(d1.Password)
+	d1.Password, err = tenantkeys.Encrypt(uc.safeRequirements, d1.TenantID, d1.Password)
 	if err != nil {
 		return nil, ErrDeviceUseCase.Wrap("dtoToEntity", "failed to encrypt password", err)
 	}
@@ -104,7 +144,7 @@ func (uc *UseCase) dtoToEntity(d *dto.Device) (*entity.Device, error) {
 	if d.MPSPassword == "" {
 		d1.MPSPassword = nil
 	} else {
-		encrypted, err := uc.safeRequirements.Encrypt(d.MPSPassword)
+		encrypted, err := tenantkeys.Encrypt(uc.safeRequirements, d.TenantID, d.MPSPassword)
 		if err != nil {
 			return nil, ErrDeviceUseCase.Wrap("dtoToEntity", "failed to encrypt MPS password", err)
 		}
@@ -115,7 +155,7 @@ func (uc *UseCase) dtoToEntity(d *dto.Device) (*entity.Device, error) {
 	if d.MEBXPassword == "" {
 		d1.MEBXPassword = nil
 	} else {
-		encrypted, err := uc.safeRequirements.Encrypt(d.MEBXPassword)
+		encrypted, err := tenantkeys.Encrypt(uc.safeRequirements, d.TenantID, d.MEBXPassword)
 		if err != nil {
 			return nil, ErrDeviceUseCase.Wrap("dtoToEntity", "failed to encrypt MEBX password", err)
 		}
@@ -149,6 +189,11 @@ func (uc *UseCase) entityToDTO(d *entity.Device) *dto.Device {
 		Tags:             tags,
 		TenantID:         d.TenantID,
 		FriendlyName:     d.FriendlyName,
+		Location:         d.Location,
+		Notes:            d.Notes,
+		Owner:            d.Owner,
+		Assignee:         d.Assignee,
+		Attributes:       maps.Clone(d.Attributes),
 		DNSSuffix:        d.DNSSuffix,
 		LastConnected:    d.LastConnected,
 		LastSeen:         d.LastSeen,
@@ -156,8 +201,10 @@ func (uc *UseCase) entityToDTO(d *entity.Device) *dto.Device {
 		// DeviceInfo:       d.DeviceInfo,
 		Username: d.Username,
 		// Password:        d.Password,
-		UseTLS:          d.UseTLS,
-		AllowSelfSigned: d.AllowSelfSigned,
+		UseTLS:            d.UseTLS,
+		AllowSelfSigned:   d.AllowSelfSigned,
+		ControlMode:       d.ControlMode,
+		ProvisioningState: d.ProvisioningState,
 	}
 
 	if d.CertHash != nil {
@@ -0,0 +1,362 @@
+package devices
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // AMT stores digest passwords as the MD5 hash of RFC 2617
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/general"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+const (
+	maxDigestUsernameLength = 16
+	maxSIDSubAuthorities    = 5 // AMT keeps SIDs of at most 28 bytes
+)
+
+// ErrNoDigestRealm is returned when the digest password of a user cannot be computed for lack of the digest realm
+// of the device.
+var ErrNoDigestRealm = errors.New("the device did not return its digest realm")
+
+// accessPermissions are the names of the access permissions of the user ACL entries.
+var accessPermissions = map[string]authorization.AccessPermission{
+	"local":   authorization.AccessPermissionLocalAccessOnly,
+	"network": authorization.AccessPermissionNetworkAccessOnly,
+	"any":     authorization.AccessPermissionLocalAndNetworkAccess,
+}
+
+// realmNames are the names of the realms a user can be given, the reserved realms are left out.
+var realmNames = map[authorization.RealmValues]string{
+	authorization.RealmValuesRedirectionRealm:                "Redirection",
+	authorization.RealmValuesPTAdministrationRealm:           "PTAdministration",
+	authorization.RealmValuesHardwareAssetRealm:              "HardwareAsset",
+	authorization.RealmValuesRemoteControlRealm:              "RemoteControl",
+	authorization.RealmValuesStorageRealm:                    "Storage",
+	authorization.RealmValuesEventManagerRealm:               "EventManager",
+	authorization.RealmValuesStorageAdminRealm:               "StorageAdmin",
+	authorization.RealmValuesAgentPresenceLocalRealm:         "AgentPresenceLocal",
+	authorization.RealmValuesAgentPresenceRemoteRealm:        "AgentPresenceRemote",
+	authorization.RealmValuesCircuitBreakerRealm:             "CircuitBreaker",
+	authorization.RealmValuesNetworkTimeRealm:                "NetworkTime",
+	authorization.RealmValuesGeneralInfoRealm:                "GeneralInfo",
+	authorization.RealmValuesFirmwareUpdateRealm:             "FirmwareUpdate",
+	authorization.RealmValuesEITRealm:                        "EIT",
+	authorization.RealmValuesLocalUN:                         "LocalUN",
+	authorization.RealmValuesEndpointAccessControlRealm:      "EndpointAccessControl",
+	authorization.RealmValuesEndpointAccessControlAdminRealm: "EndpointAccessControlAdmin",
+	authorization.RealmValuesEventLogReaderRealm:             "EventLogReader",
+	authorization.RealmValuesAuditLogRealm:                   "AuditLog",
+	authorization.RealmValuesACLRealm:                        "ACL",
+	authorization.RealmValuesLocalSystemRealm:                "LocalSystem",
+}
+
+// GetDeviceUsers returns the digest and Kerberos users of the user ACL of a device.
+func (uc *UseCase) GetDeviceUsers(c context.Context, guid string) ([]dto.DeviceUser, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return nil, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := device.GetUserACLEntries(c)
+	if err != nil {
+		return nil, deviceError("GetUserACLEntries", err)
+	}
+
+	users := make([]dto.DeviceUser, 0, len(entries))
+	for i := range entries {
+		users = append(users, deviceUser(entries[i]))
+	}
+
+	return users, nil
+}
+
+// AddDeviceUser adds a digest or Kerberos user to the user ACL of a device.
+func (uc *UseCase) AddDeviceUser(c context.Context, guid string, req dto.DeviceUserRequest) (dto.DeviceUser, error) {
+	entry, err := validateDeviceUser("AddDeviceUser", req)
+	if err != nil {
+		return dto.DeviceUser{}, err
+	}
+
+	if entry.DigestUsername != "" && req.Password == "" {
+		return dto.DeviceUser{}, ErrValidationUseCase.Wrap("AddDeviceUser", "validate password", "password is required to add a digest user")
+	}
+
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.DeviceUser{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.DeviceUser{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.DeviceUser{}, err
+	}
+
+	digestPassword, err := digestPassword(c, device, entry.DigestUsername, req.Password)
+	if err != nil {
+		return dto.DeviceUser{}, err
+	}
+
+	entry.Handle, err = device.AddUserACLEntry(c, entry, digestPassword)
+	if err != nil {
+		return dto.DeviceUser{}, deviceError("AddUserACLEntry", err)
+	}
+
+	if !entry.Enabled {
+		if err := device.SetUserACLEnabledState(c, entry.Handle, false); err != nil {
+			return dto.DeviceUser{}, deviceError("SetUserACLEnabledState", err)
+		}
+	}
+
+	return deviceUser(entry), nil
+}
+
+// UpdateDeviceUser replaces the user with handle in the user ACL of a device. A digest user keeps its password
+// unless the request has one.
+func (uc *UseCase) UpdateDeviceUser(c context.Context, guid string, handle int, req dto.DeviceUserRequest) (dto.DeviceUser, error) {
+	entry, err := validateDeviceUser("UpdateDeviceUser", req)
+	if err != nil {
+		return dto.DeviceUser{}, err
+	}
+
+	entry.Handle = handle
+
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.DeviceUser{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.DeviceUser{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.DeviceUser{}, err
+	}
+
+	digestPassword, err := digestPassword(c, device, entry.DigestUsername, req.Password)
+	if err != nil {
+		return dto.DeviceUser{}, err
+	}
+
+	if err := device.UpdateUserACLEntry(c, entry, digestPassword); err != nil {
+		return dto.DeviceUser{}, deviceError("UpdateUserACLEntry", err)
+	}
+
+	if err := device.SetUserACLEnabledState(c, handle, entry.Enabled); err != nil {
+		return dto.DeviceUser{}, deviceError("SetUserACLEnabledState", err)
+	}
+
+	return deviceUser(entry), nil
+}
+
+// RemoveDeviceUser removes the user with handle from the user ACL of a device.
+func (uc *UseCase) RemoveDeviceUser(c context.Context, guid string, handle int) error {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return err
+	}
+
+	if item == nil || item.GUID == "" {
+		return ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return err
+	}
+
+	if err := device.RemoveUserACLEntry(c, handle); err != nil {
+		return deviceError("RemoveUserACLEntry", err)
+	}
+
+	return nil
+}
+
+// validateDeviceUser returns the user ACL entry of the request.
+func validateDeviceUser(call string, req dto.DeviceUserRequest) (wsman.UserACLEntry, error) {
+	entry := wsman.UserACLEntry{DigestUsername: req.Username, Enabled: req.Enabled == nil || *req.Enabled}
+
+	switch {
+	case req.Username == "" && req.KerberosUserSID == "":
+		return entry, ErrValidationUseCase.Wrap(call, "validate user", "username or kerberosUserSid is required")
+	case req.Username != "" && req.KerberosUserSID != "":
+		return entry, ErrValidationUseCase.Wrap(call, "validate user", "username and kerberosUserSid are mutually exclusive")
+	case req.KerberosUserSID != "" && req.Password != "":
+		return entry, ErrValidationUseCase.Wrap(call, "validate user", "a Kerberos user has no password")
+	case len(req.Username) > maxDigestUsernameLength || strings.ContainsFunc(req.Username, invalidUsernameRune):
+		return entry, ErrValidationUseCase.Wrap(call, "validate username", "username must be at most 16 ASCII characters without ':'")
+	}
+
+	if req.KerberosUserSID != "" {
+		sid, ok := parseSID(req.KerberosUserSID)
+		if !ok {
+			return entry, ErrValidationUseCase.Wrap(call, "validate kerberosUserSid", "kerberosUserSid must be a SID like S-1-5-21-...")
+		}
+
+		entry.KerberosUserSID = sid
+	}
+
+	permission, ok := accessPermissions[req.AccessPermission]
+	if !ok {
+		return entry, ErrValidationUseCase.Wrap(call, "validate accessPermission", "accessPermission must be local, network or any")
+	}
+
+	entry.AccessPermission = permission
+
+	if len(req.Realms) == 0 {
+		return entry, ErrValidationUseCase.Wrap(call, "validate realms", "at least one realm is required")
+	}
+
+	for _, name := range req.Realms {
+		realm, ok := realmValue(name)
+		if !ok {
+			return entry, ErrValidationUseCase.Wrap(call, "validate realms", "unknown realm "+name)
+		}
+
+		if !slices.Contains(entry.Realms, realm) {
+			entry.Realms = append(entry.Realms, realm)
+		}
+	}
+
+	return entry, nil
+}
+
+func invalidUsernameRune(r rune) bool {
+	return r <= ' ' || r > '~' || r == ':'
+}
+
+func realmValue(name string) (authorization.RealmValues, bool) {
+	for realm, realmName := range realmNames {
+		if strings.EqualFold(realmName, name) {
+			return realm, true
+		}
+	}
+
+	return 0, false
+}
+
+// digestPassword returns the digest password AMT stores for username: the base64 MD5 hash of
+// username:realm:password, where realm is the digest realm of the device. It is empty without a password.
+func digestPassword(c context.Context, device wsman.Management, username, password string) (string, error) {
+	if username == "" || password == "" {
+		return "", nil
+	}
+
+	response, err := device.GetGeneralSettings(c)
+	if err != nil {
+		return "", deviceError("GetGeneralSettings", err)
+	}
+
+	settings, ok := response.(general.GeneralSettingsResponse)
+	if !ok || settings.DigestRealm == "" {
+		return "", ErrAMT.Wrap("digestPassword", "read digest realm", ErrNoDigestRealm)
+	}
+
+	hash := md5.Sum([]byte(username + ":" + settings.DigestRealm + ":" + password)) //nolint:gosec // see import
+
+	return base64.StdEncoding.EncodeToString(hash[:]), nil
+}
+
+func deviceUser(entry wsman.UserACLEntry) dto.DeviceUser {
+	user := dto.DeviceUser{
+		Handle:          entry.Handle,
+		Username:        entry.DigestUsername,
+		KerberosUserSID: formatSID(entry.KerberosUserSID),
+		Realms:          make([]string, 0, len(entry.Realms)),
+		Enabled:         entry.Enabled,
+	}
+
+	for name, permission := range accessPermissions {
+		if permission == entry.AccessPermission {
+			user.AccessPermission = name
+		}
+	}
+
+	for _, realm := range entry.Realms {
+		if name, ok := realmNames[realm]; ok {
+			user.Realms = append(user.Realms, name)
+		} else {
+			user.Realms = append(user.Realms, strconv.Itoa(int(realm)))
+		}
+	}
+
+	return user
+}
+
+// parseSID returns the binary form of a SID in its string form, e.g. S-1-5-21-3623811015-3361044348-30300820-1013.
+func parseSID(s string) ([]byte, bool) {
+	parts := strings.Split(s, "-")
+	if len(parts) < 4 || len(parts) > 3+maxSIDSubAuthorities || !strings.EqualFold(parts[0], "S") {
+		return nil, false
+	}
+
+	revision, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil {
+		return nil, false
+	}
+
+	authority, err := strconv.ParseUint(parts[2], 10, 48)
+	if err != nil {
+		return nil, false
+	}
+
+	sid := make([]byte, 8, 8+4*(len(parts)-3))
+	sid[0] = byte(revision)
+	sid[1] = byte(len(parts) - 3)
+
+	for i := 0; i < 6; i++ {
+		sid[7-i] = byte(authority >> (8 * i))
+	}
+
+	for _, part := range parts[3:] {
+		subAuthority, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, false
+		}
+
+		sid = binary.LittleEndian.AppendUint32(sid, uint32(subAuthority))
+	}
+
+	return sid, true
+}
+
+// formatSID returns the string form of a binary SID, empty when sid is not one.
+func formatSID(sid []byte) string {
+	if len(sid) < 8 || len(sid) != 8+4*int(sid[1]) {
+		return ""
+	}
+
+	var authority uint64
+	for _, b := range sid[2:8] {
+		authority = authority<<8 | uint64(b)
+	}
+
+	s := "S-" + strconv.Itoa(int(sid[0])) + "-" + strconv.FormatUint(authority, 10)
+	for i := 8; i < len(sid); i += 4 {
+		s += "-" + strconv.FormatUint(uint64(binary.LittleEndian.Uint32(sid[i:])), 10)
+	}
+
+	return s
+}
@@ -0,0 +1,136 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/general"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestAddDeviceUser(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+	disabled := false
+	sid := []byte{1, 5, 0, 0, 0, 0, 0, 5, 21, 0, 0, 0, 199, 247, 254, 215, 124, 119, 85, 200, 148, 90, 206, 1, 245, 3, 0, 0}
+
+	tests := []struct {
+		name     string
+		request  dto.DeviceUserRequest
+		entry    wsman.UserACLEntry
+		password string
+		res      dto.DeviceUser
+		invalid  bool
+		err      error
+	}{
+		{
+			name:    "digest user",
+			request: dto.DeviceUserRequest{Username: "operator", Password: "P@ssw0rd", AccessPermission: "network", Realms: []string{"Redirection", "hardwareasset", "Redirection"}},
+			entry: wsman.UserACLEntry{
+				DigestUsername: "operator", AccessPermission: authorization.AccessPermissionNetworkAccessOnly, Enabled: true,
+				Realms: []authorization.RealmValues{authorization.RealmValuesRedirectionRealm, authorization.RealmValuesHardwareAssetRealm},
+			},
+			password: "e6g5/5JlnnkLz85C0diqRw==",
+			res:      dto.DeviceUser{Handle: 3, Username: "operator", AccessPermission: "network", Realms: []string{"Redirection", "HardwareAsset"}, Enabled: true},
+		},
+		{
+			name:    "disabled Kerberos user",
+			request: dto.DeviceUserRequest{KerberosUserSID: "S-1-5-21-3623811015-3361044348-30300820-1013", AccessPermission: "any", Realms: []string{"RemoteControl"}, Enabled: &disabled},
+			entry: wsman.UserACLEntry{
+				KerberosUserSID: sid, AccessPermission: authorization.AccessPermissionLocalAndNetworkAccess,
+				Realms: []authorization.RealmValues{authorization.RealmValuesRemoteControlRealm},
+			},
+			res: dto.DeviceUser{
+				Handle: 3, KerberosUserSID: "S-1-5-21-3623811015-3361044348-30300820-1013", AccessPermission: "any", Realms: []string{"RemoteControl"},
+			},
+		},
+		{
+			name:    "digest user without password",
+			request: dto.DeviceUserRequest{Username: "operator", AccessPermission: "network", Realms: []string{"Redirection"}},
+			invalid: true,
+		},
+		{
+			name:    "username and SID",
+			request: dto.DeviceUserRequest{Username: "operator", Password: "P@ssw0rd", KerberosUserSID: "S-1-5-18", AccessPermission: "network", Realms: []string{"Redirection"}},
+			invalid: true,
+		},
+		{
+			name:    "username with a colon",
+			request: dto.DeviceUserRequest{Username: "op:erator", Password: "P@ssw0rd", AccessPermission: "network", Realms: []string{"Redirection"}},
+			invalid: true,
+		},
+		{
+			name:    "malformed SID",
+			request: dto.DeviceUserRequest{KerberosUserSID: "S-1-5-x", AccessPermission: "network", Realms: []string{"Redirection"}},
+			invalid: true,
+		},
+		{
+			name:    "unknown realm",
+			request: dto.DeviceUserRequest{Username: "operator", Password: "P@ssw0rd", AccessPermission: "network", Realms: []string{"Bluetooth"}},
+			invalid: true,
+		},
+		{
+			name:    "unknown access permission",
+			request: dto.DeviceUserRequest{Username: "operator", Password: "P@ssw0rd", AccessPermission: "remote", Realms: []string{"Redirection"}},
+			invalid: true,
+		},
+		{
+			name:     "device refuses the user",
+			request:  dto.DeviceUserRequest{Username: "operator", Password: "P@ssw0rd", AccessPermission: "local", Realms: []string{"Redirection"}},
+			entry:    wsman.UserACLEntry{DigestUsername: "operator", Realms: []authorization.RealmValues{authorization.RealmValuesRedirectionRealm}, Enabled: true},
+			password: "e6g5/5JlnnkLz85C0diqRw==",
+			err:      ErrGeneral,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+			wsmanMock := mocks.NewMockWSMAN(mockCtl)
+			wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+			management := mocks.NewMockManagement(mockCtl)
+			useCase := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, nil)
+
+			if !tc.invalid {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+				wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(wsman.Management(management), nil)
+
+				if tc.password != "" {
+					management.EXPECT().GetGeneralSettings(gomock.Any()).Return(general.GeneralSettingsResponse{DigestRealm: "Digest:A1B2C3"}, nil)
+				}
+
+				management.EXPECT().AddUserACLEntry(gomock.Any(), tc.entry, tc.password).Return(3, tc.err)
+
+				if tc.err == nil && !tc.entry.Enabled {
+					management.EXPECT().SetUserACLEnabledState(gomock.Any(), 3, false).Return(nil)
+				}
+			}
+
+			res, err := useCase.AddDeviceUser(context.Background(), device.GUID, tc.request)
+
+			switch {
+			case tc.invalid:
+				require.ErrorAs(t, err, &devices.ValidationError{})
+			case tc.err != nil:
+				require.ErrorIs(t, err, tc.err)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
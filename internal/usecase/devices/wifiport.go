@@ -0,0 +1,105 @@
+package devices
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// GetWiFiPortSettings returns the profile synchronization and UEFI profile share settings of the Wi-Fi port of a device.
+func (uc *UseCase) GetWiFiPortSettings(c context.Context, guid string) (dto.WiFiPortSettings, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.WiFiPortSettings{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.WiFiPortSettings{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.WiFiPortSettings{}, err
+	}
+
+	service, err := device.GetWiFiPortConfigurationService(c)
+	if err != nil {
+		return dto.WiFiPortSettings{}, deviceError("GetWiFiPortConfigurationService", err)
+	}
+
+	supported, err := uefiWiFiProfileShareSupported(c, device)
+	if err != nil {
+		return dto.WiFiPortSettings{}, err
+	}
+
+	return wifiPortSettings(service, supported), nil
+}
+
+// SetWiFiPortSettings replaces the profile synchronization and UEFI profile share settings of the Wi-Fi port of
+// a device. Sharing the profiles with the UEFI BIOS can only be enabled when the firmware supports it.
+func (uc *UseCase) SetWiFiPortSettings(c context.Context, guid string, req dto.WiFiPortSettingsRequest) (dto.WiFiPortSettings, error) {
+	sync := wifiportconfiguration.LocalProfileSynchronizationEnabled(req.LocalProfileSynchronization)
+	if sync != wifiportconfiguration.LocalSyncDisabled && sync != wifiportconfiguration.LocalUserProfileSynchronizationEnabled && sync != wifiportconfiguration.UnrestrictedSync {
+		return dto.WiFiPortSettings{}, ErrValidationUseCase.Wrap("SetWiFiPortSettings", "validate local profile synchronization", "localProfileSynchronization must be 0 (disabled), 1 (user profiles) or 3 (unrestricted)")
+	}
+
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return dto.WiFiPortSettings{}, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return dto.WiFiPortSettings{}, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return dto.WiFiPortSettings{}, err
+	}
+
+	service, err := device.GetWiFiPortConfigurationService(c)
+	if err != nil {
+		return dto.WiFiPortSettings{}, deviceError("GetWiFiPortConfigurationService", err)
+	}
+
+	supported, err := uefiWiFiProfileShareSupported(c, device)
+	if err != nil {
+		return dto.WiFiPortSettings{}, err
+	}
+
+	// the share can be disabled even when the firmware does not support it
+	if req.UEFIWiFiProfileShareEnabled && !supported {
+		return dto.WiFiPortSettings{}, ErrNotSupportedUseCase.Wrap("SetWiFiPortSettings", "device.GetPowerCapabilities", "sharing Wi-Fi profiles with the UEFI BIOS needs Intel CSME 16.0 or later")
+	}
+
+	service.LocalProfileSynchronizationEnabled = sync
+	service.UEFIWiFiProfileShareEnabled = req.UEFIWiFiProfileShareEnabled
+
+	service, err = device.PutWiFiPortConfigurationService(c, service)
+	if err != nil {
+		return dto.WiFiPortSettings{}, deviceError("PutWiFiPortConfigurationService", err)
+	}
+
+	return wifiPortSettings(service, supported), nil
+}
+
+// uefiWiFiProfileShareSupported returns whether the firmware of the device can share its Wi-Fi profiles with the UEFI BIOS.
+func uefiWiFiProfileShareSupported(c context.Context, device wsman.Management) (bool, error) {
+	capabilities, err := device.GetPowerCapabilities(c)
+	if err != nil {
+		return false, deviceError("GetPowerCapabilities", err)
+	}
+
+	return capabilities.UEFIWiFiCoExistenceAndProfileShare, nil
+}
+
+func wifiPortSettings(service wifiportconfiguration.WiFiPortConfigurationServiceResponse, supported bool) dto.WiFiPortSettings {
+	return dto.WiFiPortSettings{
+		LocalProfileSynchronization:   int(service.LocalProfileSynchronizationEnabled),
+		UEFIWiFiProfileShareEnabled:   service.UEFIWiFiProfileShareEnabled,
+		UEFIWiFiProfileShareSupported: supported,
+	}
+}
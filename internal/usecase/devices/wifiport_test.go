@@ -0,0 +1,108 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestSetWiFiPortSettings(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	current := wifiportconfiguration.WiFiPortConfigurationServiceResponse{
+		Name:                               "Intel(r) AMT WiFiPort Configuration Service",
+		LocalProfileSynchronizationEnabled: wifiportconfiguration.UnrestrictedSync,
+		UEFIWiFiProfileShareEnabled:        true,
+	}
+
+	tests := []struct {
+		name        string
+		req         dto.WiFiPortSettingsRequest
+		supported   bool
+		put         *wifiportconfiguration.WiFiPortConfigurationServiceResponse
+		res         dto.WiFiPortSettings
+		invalid     bool
+		unsupported bool
+	}{
+		{
+			name:      "enable the share",
+			req:       dto.WiFiPortSettingsRequest{LocalProfileSynchronization: 1, UEFIWiFiProfileShareEnabled: true},
+			supported: true,
+			put: &wifiportconfiguration.WiFiPortConfigurationServiceResponse{
+				Name:                               current.Name,
+				LocalProfileSynchronizationEnabled: wifiportconfiguration.LocalUserProfileSynchronizationEnabled,
+				UEFIWiFiProfileShareEnabled:        true,
+			},
+			res: dto.WiFiPortSettings{LocalProfileSynchronization: 1, UEFIWiFiProfileShareEnabled: true, UEFIWiFiProfileShareSupported: true},
+		},
+		{
+			name: "disable the share without firmware support",
+			req:  dto.WiFiPortSettingsRequest{LocalProfileSynchronization: 0},
+			put: &wifiportconfiguration.WiFiPortConfigurationServiceResponse{
+				Name:                               current.Name,
+				LocalProfileSynchronizationEnabled: wifiportconfiguration.LocalSyncDisabled,
+			},
+			res: dto.WiFiPortSettings{},
+		},
+		{
+			name:        "enable the share without firmware support",
+			req:         dto.WiFiPortSettingsRequest{LocalProfileSynchronization: 3, UEFIWiFiProfileShareEnabled: true},
+			unsupported: true,
+		},
+		{
+			name:    "invalid local profile synchronization",
+			req:     dto.WiFiPortSettingsRequest{LocalProfileSynchronization: 2},
+			invalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+			wsmanMock := mocks.NewMockWSMAN(mockCtl)
+			wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+			management := mocks.NewMockManagement(mockCtl)
+			useCase := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, nil)
+
+			if !tc.invalid {
+				repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+				wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(wsman.Management(management), nil)
+				management.EXPECT().GetWiFiPortConfigurationService(gomock.Any()).Return(current, nil)
+				management.EXPECT().GetPowerCapabilities(gomock.Any()).Return(boot.BootCapabilitiesResponse{UEFIWiFiCoExistenceAndProfileShare: tc.supported}, nil)
+			}
+
+			if tc.put != nil {
+				management.EXPECT().PutWiFiPortConfigurationService(gomock.Any(), *tc.put).Return(*tc.put, nil)
+			}
+
+			res, err := useCase.SetWiFiPortSettings(context.Background(), device.GUID, tc.req)
+
+			switch {
+			case tc.invalid:
+				require.ErrorAs(t, err, &devices.ValidationError{})
+			case tc.unsupported:
+				require.ErrorAs(t, err, &devices.NotSupportedError{})
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/wifi"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+)
+
+// wifiUserSettings is the ElementName of the profiles the user of the device added from the OS, which AMT
+// manages itself.
+const wifiUserSettings = "Endpoint User Settings"
+
+// GetWiFiProfiles returns the Wi-Fi profiles of a device in the order it connects to them.
+func (uc *UseCase) GetWiFiProfiles(c context.Context, guid string) ([]dto.WiFiProfile, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return nil, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := adminWiFiProfiles(c, device)
+	if err != nil {
+		return nil, err
+	}
+
+	return wifiProfiles(settings), nil
+}
+
+// ReorderWiFiProfiles renumbers the priorities of the Wi-Fi profiles of a device from 1 in the order of
+// order.InstanceIDs, which must list each of them once. AMT updates one profile at a time: when an update
+// fails, the profiles updated before it get their previous priority back, so the device keeps its order.
+func (uc *UseCase) ReorderWiFiProfiles(c context.Context, guid string, order dto.WiFiProfileOrder) ([]dto.WiFiProfile, error) {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil || item.GUID == "" {
+		return nil, ErrNotFound
+	}
+
+	device, err := uc.device.SetupWsmanClient(*item, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := adminWiFiProfiles(c, device)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(order.InstanceIDs) != len(settings) {
+		return nil, ErrValidationUseCase.Wrap("ReorderWiFiProfiles", "validate order", "instanceIDs must list every Wi-Fi profile of the device once")
+	}
+
+	previous := make(map[string]int, len(settings))
+	byID := make(map[string]wifi.WiFiEndpointSettingsResponse, len(settings))
+
+	for _, s := range settings {
+		previous[s.InstanceID] = s.Priority
+		byID[s.InstanceID] = s
+	}
+
+	reordered := make([]wifi.WiFiEndpointSettingsResponse, 0, len(settings))
+
+	for i, id := range order.InstanceIDs {
+		profile, ok := byID[id]
+		if !ok {
+			return nil, ErrValidationUseCase.Wrap("ReorderWiFiProfiles", "validate order", "unknown or repeated Wi-Fi profile "+id)
+		}
+
+		delete(byID, id)
+
+		profile.Priority = i + 1
+		reordered = append(reordered, profile)
+	}
+
+	var applied []wifi.WiFiEndpointSettingsResponse
+
+	for _, profile := range reordered {
+		if profile.Priority == previous[profile.InstanceID] {
+			continue
+		}
+
+		if err := device.PutWiFiEndpointSettings(c, profile); err != nil {
+			// the rollback runs even when the request was cancelled, the device would be left half reordered otherwise
+			return nil, errors.Join(deviceError("PutWiFiEndpointSettings", err), restoreWiFiPriorities(context.WithoutCancel(c), device, applied, previous))
+		}
+
+		applied = append(applied, profile)
+	}
+
+	return wifiProfiles(reordered), nil
+}
+
+// adminWiFiProfiles returns the Wi-Fi profiles of the device added through AMT.
+func adminWiFiProfiles(c context.Context, device wsman.Management) ([]wifi.WiFiEndpointSettingsResponse, error) {
+	settings, err := device.GetWiFiEndpointSettings(c)
+	if err != nil {
+		return nil, deviceError("GetWiFiEndpointSettings", err)
+	}
+
+	return slices.DeleteFunc(settings, func(s wifi.WiFiEndpointSettingsResponse) bool {
+		return s.ElementName == wifiUserSettings
+	}), nil
+}
+
+// restoreWiFiPriorities puts back the previous priority of the applied profiles, the last one updated first.
+func restoreWiFiPriorities(c context.Context, device wsman.Management, applied []wifi.WiFiEndpointSettingsResponse, previous map[string]int) error {
+	var errs []error
+
+	for i := len(applied) - 1; i >= 0; i-- {
+		profile := applied[i]
+		profile.Priority = previous[profile.InstanceID]
+
+		if err := device.PutWiFiEndpointSettings(c, profile); err != nil {
+			errs = append(errs, fmt.Errorf("restore the priority of %s: %w", profile.InstanceID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// wifiProfiles returns the profiles sorted by priority.
+func wifiProfiles(settings []wifi.WiFiEndpointSettingsResponse) []dto.WiFiProfile {
+	profiles := make([]dto.WiFiProfile, 0, len(settings))
+
+	for _, s := range settings {
+		profiles = append(profiles, dto.WiFiProfile{
+			InstanceID:           s.InstanceID,
+			ElementName:          s.ElementName,
+			SSID:                 s.SSID,
+			AuthenticationMethod: s.AuthenticationMethod.String(),
+			EncryptionMethod:     s.EncryptionMethod.String(),
+			Priority:             s.Priority,
+		})
+	}
+
+	slices.SortStableFunc(profiles, func(a, b dto.WiFiProfile) int { return a.Priority - b.Priority })
+
+	return profiles
+}
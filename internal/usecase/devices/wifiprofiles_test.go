@@ -0,0 +1,131 @@
+package devices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/wifi"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	devices "github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestReorderWiFiProfiles(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{GUID: "device-guid-123", TenantID: "tenant-id-456"}
+
+	home := wifi.WiFiEndpointSettingsResponse{
+		InstanceID: "home", ElementName: "home", SSID: "home", Priority: 1,
+		AuthenticationMethod: wifi.AuthenticationMethodWPA2PSK, EncryptionMethod: wifi.EncryptionMethodCCMP,
+	}
+	office := wifi.WiFiEndpointSettingsResponse{
+		InstanceID: "office", ElementName: "office", SSID: "office", Priority: 2,
+		AuthenticationMethod: wifi.AuthenticationMethodWPA2PSK, EncryptionMethod: wifi.EncryptionMethodCCMP,
+	}
+	user := wifi.WiFiEndpointSettingsResponse{InstanceID: "user", ElementName: "Endpoint User Settings", Priority: 3}
+
+	withPriority := func(s wifi.WiFiEndpointSettingsResponse, priority int) wifi.WiFiEndpointSettingsResponse {
+		s.Priority = priority
+
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		order   []string
+		manMock func(*mocks.MockManagement)
+		res     []dto.WiFiProfile
+		invalid bool
+		err     error
+	}{
+		{
+			name:  "success",
+			order: []string{"office", "home"},
+			manMock: func(man *mocks.MockManagement) {
+				gomock.InOrder(
+					man.EXPECT().PutWiFiEndpointSettings(gomock.Any(), withPriority(office, 1)).Return(nil),
+					man.EXPECT().PutWiFiEndpointSettings(gomock.Any(), withPriority(home, 2)).Return(nil),
+				)
+			},
+			res: []dto.WiFiProfile{
+				{InstanceID: "office", ElementName: "office", SSID: "office", AuthenticationMethod: "WPA2PSK", EncryptionMethod: "CCMP", Priority: 1},
+				{InstanceID: "home", ElementName: "home", SSID: "home", AuthenticationMethod: "WPA2PSK", EncryptionMethod: "CCMP", Priority: 2},
+			},
+		},
+		{
+			name:  "profiles keeping their priority are not updated",
+			order: []string{"home", "office"},
+			res: []dto.WiFiProfile{
+				{InstanceID: "home", ElementName: "home", SSID: "home", AuthenticationMethod: "WPA2PSK", EncryptionMethod: "CCMP", Priority: 1},
+				{InstanceID: "office", ElementName: "office", SSID: "office", AuthenticationMethod: "WPA2PSK", EncryptionMethod: "CCMP", Priority: 2},
+			},
+		},
+		{
+			name:    "repeated profile",
+			order:   []string{"home", "home"},
+			invalid: true,
+		},
+		{
+			name:    "profile of the user",
+			order:   []string{"office", "user"},
+			invalid: true,
+		},
+		{
+			name:  "failed update is rolled back",
+			order: []string{"office", "home"},
+			manMock: func(man *mocks.MockManagement) {
+				gomock.InOrder(
+					man.EXPECT().PutWiFiEndpointSettings(gomock.Any(), withPriority(office, 1)).Return(nil),
+					man.EXPECT().PutWiFiEndpointSettings(gomock.Any(), withPriority(home, 2)).Return(ErrGeneral),
+					man.EXPECT().PutWiFiEndpointSettings(gomock.Any(), office).Return(nil),
+				)
+			},
+			err: ErrGeneral,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			repo := mocks.NewMockDeviceManagementRepository(mockCtl)
+			wsmanMock := mocks.NewMockWSMAN(mockCtl)
+			wsmanMock.EXPECT().Worker().Return().AnyTimes()
+
+			management := mocks.NewMockManagement(mockCtl)
+			useCase := devices.New(repo, wsmanMock, mocks.NewMockRedirection(mockCtl), logger.New("error"), mocks.MockCrypto{}, nil)
+
+			repo.EXPECT().GetByID(context.Background(), device.GUID, "").Return(device, nil)
+			wsmanMock.EXPECT().SetupWsmanClient(gomock.Any(), false, true).Return(wsman.Management(management), nil)
+			management.EXPECT().GetWiFiEndpointSettings(gomock.Any()).Return([]wifi.WiFiEndpointSettingsResponse{office, home, user}, nil)
+
+			if tc.manMock != nil {
+				tc.manMock(management)
+			}
+
+			res, err := useCase.ReorderWiFiProfiles(context.Background(), device.GUID, dto.WiFiProfileOrder{InstanceIDs: tc.order})
+			if tc.invalid {
+				require.ErrorAs(t, err, &devices.ValidationError{})
+
+				return
+			}
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.res, res)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package wsman
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// callers holds the contexts of the Management calls in progress per WS-Man client.
+var callers = newCallRegistry()
+
+type call struct {
+	ctx context.Context
+}
+
+type callRegistry struct {
+	mu    sync.Mutex
+	calls map[client.WSMan]map[*call]struct{}
+}
+
+func newCallRegistry() *callRegistry {
+	return &callRegistry{calls: map[client.WSMan]map[*call]struct{}{}}
+}
+
+// add registers ctx as a caller of the client until the returned func is called.
+func (r *callRegistry) add(key client.WSMan, ctx context.Context) func() {
+	c := &call{ctx: ctx}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.calls[key] == nil {
+		r.calls[key] = map[*call]struct{}{}
+	}
+
+	r.calls[key][c] = struct{}{}
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		delete(r.calls[key], c)
+
+		if len(r.calls[key]) == 0 {
+			delete(r.calls, key)
+		}
+	}
+}
+
+func (r *callRegistry) contexts(key client.WSMan) []context.Context {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ctxs := make([]context.Context, 0, len(r.calls[key]))
+	for c := range r.calls[key] {
+		ctxs = append(ctxs, c.ctx)
+	}
+
+	return ctxs
+}
+
+// bind registers the context of a Management call until the returned func is called, use as defer c.bind(ctx)().
+func (c *ConnectionEntry) bind(ctx context.Context) func() {
+	return callers.add(c.WsmanMessages.Client, ctx)
+}
+
+// cancelTransport cancels the requests of a client once every Management call using it
+// was cancelled, e.g. because the API clients disconnected. A connection is shared by the
+// concurrent calls to a device and a request cannot be told apart from the others, so a
+// request is only cancelled when none of the calls is interested in it anymore.
+type cancelTransport struct {
+	key      client.WSMan
+	next     http.RoundTripper
+	registry *callRegistry
+}
+
+func (t *cancelTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctxs := t.registry.contexts(t.key)
+	if len(ctxs) == 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := untilAllDone(req.Context(), ctxs)
+	if err := ctx.Err(); err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// untilAllDone returns a context of parent that is cancelled once all ctxs are done.
+func untilAllDone(parent context.Context, ctxs []context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+
+	var remaining atomic.Int64
+
+	remaining.Store(int64(len(ctxs)))
+
+	stops := make([]func() bool, 0, len(ctxs))
+	done := 0
+
+	for _, c := range ctxs {
+		if c.Err() != nil {
+			done++
+		}
+
+		stops = append(stops, context.AfterFunc(c, func() {
+			if remaining.Add(-1) == 0 {
+				cancel()
+			}
+		}))
+	}
+
+	// AfterFunc runs asynchronously, a request of calls that are already cancelled must not be sent
+	if done == len(ctxs) {
+		cancel()
+	}
+
+	return ctx, func() {
+		for _, stop := range stops {
+			stop()
+		}
+
+		cancel()
+	}
+}
+
+// cancelOnClose releases the context of a request once its response was read.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+
+	return err
+}
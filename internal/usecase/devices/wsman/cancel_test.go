@@ -0,0 +1,134 @@
+package wsman
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingTransport answers a request once release is closed or fails when the request is cancelled.
+type blockingTransport struct {
+	started chan struct{}
+	release chan struct{}
+	sent    int
+}
+
+func (b *blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	b.sent++
+	close(b.started)
+
+	select {
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	case <-b.release:
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok")), Request: req}, nil
+	}
+}
+
+func TestCancelTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		callers int
+		cancel  int
+		err     error
+	}{
+		{
+			name: "without calls requests are passed through",
+		},
+		{
+			name:    "request of a cancelled call is cancelled",
+			callers: 1,
+			cancel:  1,
+			err:     context.Canceled,
+		},
+		{
+			name:    "request is kept while another call waits for it",
+			callers: 2,
+			cancel:  1,
+		},
+		{
+			name:    "request is cancelled once all calls are cancelled",
+			callers: 2,
+			cancel:  2,
+			err:     context.Canceled,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			key := client.NewWsman(client.Parameters{Target: "device"})
+			registry := newCallRegistry()
+			next := &blockingTransport{started: make(chan struct{}), release: make(chan struct{})}
+			transport := &cancelTransport{key: key, next: next, registry: registry}
+
+			cancels := make([]context.CancelFunc, tc.callers)
+
+			for i := range cancels {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancels[i] = cancel
+
+				defer registry.add(key, ctx)()
+				defer cancel()
+			}
+
+			go func() {
+				<-next.started
+
+				for _, cancel := range cancels[:tc.cancel] {
+					cancel()
+				}
+
+				if tc.err == nil {
+					time.Sleep(10 * time.Millisecond)
+					close(next.release)
+				}
+			}()
+
+			req, err := http.NewRequest(http.MethodPost, "http://device:16992/wsman", http.NoBody)
+			require.NoError(t, err)
+
+			resp, err := transport.RoundTrip(req)
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, 1, next.sent)
+		})
+	}
+}
+
+func TestCancelTransportCancelledCalls(t *testing.T) {
+	t.Parallel()
+
+	key := client.NewWsman(client.Parameters{Target: "device"})
+	registry := newCallRegistry()
+	next := &blockingTransport{started: make(chan struct{}), release: make(chan struct{})}
+	transport := &cancelTransport{key: key, next: next, registry: registry}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	release := registry.add(key, ctx)
+
+	req, err := http.NewRequest(http.MethodPost, "http://device:16992/wsman", http.NoBody)
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(req) //nolint:bodyclose // no response on errors
+	require.ErrorIs(t, err, context.Canceled)
+	require.Zero(t, next.sent)
+
+	// finished calls are forgotten
+	release()
+	require.Empty(t, registry.contexts(key))
+}
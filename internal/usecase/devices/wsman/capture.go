@@ -0,0 +1,344 @@
+package wsman
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// maxTraceSize bounds a trace file so that a busy device cannot fill the disk during a capture.
+const maxTraceSize = 16 << 20
+
+var (
+	// ErrCaptureNotFound is returned when no capture was started for a device.
+	ErrCaptureNotFound = errors.New("no wsman capture for device")
+
+	captures = newCaptureRegistry("")
+)
+
+// CaptureInfo describes a capture of the SOAP messages exchanged with one device.
+type CaptureInfo struct {
+	GUID      string
+	StartedAt time.Time
+	EndsAt    time.Time
+	Active    bool
+	Exchanges int
+	Truncated bool
+}
+
+type capture struct {
+	info CaptureInfo
+	path string
+	size int
+}
+
+type captureRegistry struct {
+	mu       sync.Mutex
+	dir      string
+	captures map[string]*capture
+	now      func() time.Time
+}
+
+// newCaptureRegistry keeps trace files in dir; an empty dir resolves to the console config directory.
+func newCaptureRegistry(dir string) *captureRegistry {
+	return &captureRegistry{dir: dir, captures: map[string]*capture{}, now: time.Now}
+}
+
+func (r *captureRegistry) traceDir() string {
+	if r.dir != "" {
+		return r.dir
+	}
+
+	dirname, err := os.UserConfigDir()
+	if err != nil {
+		dirname = os.TempDir()
+	}
+
+	return filepath.Join(dirname, "device-management-toolkit", "traces")
+}
+
+// start begins a capture for guid, replacing the trace of any previous capture of the device.
+func (r *captureRegistry) start(guid string, duration time.Duration) (CaptureInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dir := r.traceDir()
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return CaptureInfo{}, err
+	}
+
+	// device GUIDs come from the URL, so the file name is derived from a hash rather than the GUID itself
+	sum := sha256.Sum256([]byte(guid))
+	c := &capture{
+		info: CaptureInfo{GUID: guid, StartedAt: r.now(), EndsAt: r.now().Add(duration)},
+		path: filepath.Join(dir, hex.EncodeToString(sum[:8])+".trace"),
+	}
+
+	header := fmt.Sprintf("# WS-Man trace of device %s\n# started %s, ends %s\n# values of password, passphrase, PSK, private key and secret elements are redacted\n",
+		guid, c.info.StartedAt.UTC().Format(time.RFC3339), c.info.EndsAt.UTC().Format(time.RFC3339))
+
+	if err := os.WriteFile(c.path, []byte(header), 0o600); err != nil {
+		return CaptureInfo{}, err
+	}
+
+	c.size = len(header)
+	r.captures[guid] = c
+
+	return r.infoLocked(c), nil
+}
+
+// stop ends the capture for guid early and keeps its trace.
+func (r *captureRegistry) stop(guid string) (CaptureInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.captures[guid]
+	if !ok {
+		return CaptureInfo{}, ErrCaptureNotFound
+	}
+
+	if now := r.now(); now.Before(c.info.EndsAt) {
+		c.info.EndsAt = now
+	}
+
+	return r.infoLocked(c), nil
+}
+
+// trace returns the trace file of the last capture for guid.
+func (r *captureRegistry) trace(guid string) (CaptureInfo, []byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.captures[guid]
+	if !ok {
+		return CaptureInfo{}, nil, ErrCaptureNotFound
+	}
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return CaptureInfo{}, nil, err
+	}
+
+	return r.infoLocked(c), data, nil
+}
+
+func (r *captureRegistry) capturing(guid string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.captures[guid]
+
+	return ok && r.now().Before(c.info.EndsAt)
+}
+
+func (r *captureRegistry) infoLocked(c *capture) CaptureInfo {
+	info := c.info
+	info.Active = r.now().Before(c.info.EndsAt)
+
+	return info
+}
+
+// record appends one exchange to the trace of guid if a capture is running for it.
+func (r *captureRegistry) record(guid string, e exchange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.captures[guid]
+	if !ok || !r.now().Before(c.info.EndsAt) || c.info.Truncated {
+		return
+	}
+
+	entry := e.format()
+	if c.size+len(entry) > maxTraceSize {
+		c.info.Truncated = true
+		entry = "\n# trace size limit reached, later messages were not recorded\n"
+	} else {
+		c.info.Exchanges++
+	}
+
+	f, err := os.OpenFile(c.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	n, _ := f.WriteString(entry)
+	c.size += n
+}
+
+type exchange struct {
+	at       time.Time
+	duration time.Duration
+	method   string
+	url      string
+	request  []byte
+	status   string
+	response []byte
+	err      error
+}
+
+func (e exchange) format() string {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "\n=== %s %s %s\n--- request\n", e.at.UTC().Format(time.RFC3339Nano), e.method, e.url)
+	b.WriteString(logger.Redact(string(e.request)))
+
+	if e.err != nil {
+		fmt.Fprintf(&b, "\n--- error after %s: %v\n", e.duration.Round(time.Millisecond), e.err)
+
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "\n--- response %s after %s\n", e.status, e.duration.Round(time.Millisecond))
+	b.WriteString(logger.Redact(string(e.response)))
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// captureTransport records the SOAP messages sent through it while a capture runs for the device.
+type captureTransport struct {
+	guid     string
+	next     http.RoundTripper
+	registry *captureRegistry
+}
+
+func (t *captureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.registry.capturing(t.guid) {
+		return t.next.RoundTrip(req)
+	}
+
+	e := exchange{at: time.Now(), method: req.Method, url: req.URL.Redacted()}
+
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		e.request = body
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	e.duration = time.Since(e.at)
+
+	if err != nil {
+		e.err = err
+		t.registry.record(t.guid, e)
+
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		e.err = err
+		t.registry.record(t.guid, e)
+
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	e.status = resp.Status
+	e.response = body
+	t.registry.record(t.guid, e)
+
+	return resp, nil
+}
+
+// NewMessages creates the WS-Man messages for a device, sent through its proxy unless it is
+// connected over CIRA. While a capture runs for the device, the SOAP requests and responses
+// sent through them are recorded to its trace. Requests are retried and rejected by the circuit breaker of the device as configured,
+// and cancelled when all Management calls using the messages were cancelled. A device the
+// requests cannot be delivered to is reported to the OnUnreachable handlers.
+func NewMessages(device entity.Device, cp client.Parameters) wsman.Messages {
+	guid := device.GUID
+	messages := wsman.NewMessages(cp)
+
+	target, ok := messages.Client.(*client.Target)
+	if !ok {
+		return messages
+	}
+
+	if !cp.IsCIRA {
+		applyProxy(target, device)
+	}
+
+	if captures.capturing(guid) {
+		target.Transport = &captureTransport{guid: guid, next: target.Transport, registry: captures}
+	}
+
+	if policy := currentPolicy(); policy.enabled() {
+		target.Transport = &resilientTransport{guid: guid, next: target.Transport, policy: policy, breakers: breakers}
+	}
+
+	if !cp.IsCIRA {
+		target.Transport = &unreachableTransport{guid: guid, next: target.Transport, handlers: unreachable}
+	}
+
+	target.Transport = &cancelTransport{key: messages.Client, next: target.Transport, registry: callers}
+
+	return messages
+}
+
+// StartCapture records the SOAP messages exchanged with the device for the given duration.
+func (g GoWSMANMessages) StartCapture(guid string, duration time.Duration) (CaptureInfo, error) {
+	info, err := captures.start(guid, duration)
+	if err != nil {
+		return CaptureInfo{}, err
+	}
+
+	// authenticated connections are reused, drop it so the next call creates a recorded client
+	dropCachedConnection(guid)
+
+	return info, nil
+}
+
+// StopCapture ends the capture for the device early; its trace remains available.
+func (g GoWSMANMessages) StopCapture(guid string) (CaptureInfo, error) {
+	info, err := captures.stop(guid)
+	if err != nil {
+		return CaptureInfo{}, err
+	}
+
+	dropCachedConnection(guid)
+
+	return info, nil
+}
+
+// CaptureTrace returns the redacted trace of the last capture for the device.
+func (g GoWSMANMessages) CaptureTrace(guid string) (CaptureInfo, []byte, error) {
+	return captures.trace(guid)
+}
+
+func dropCachedConnection(guid string) {
+	connectionsMu.Lock()
+	defer connectionsMu.Unlock()
+
+	if entry, ok := Connections[guid]; ok && !entry.IsCIRA {
+		if entry.Timer != nil {
+			entry.Timer.Stop()
+		}
+
+		delete(Connections, guid)
+	}
+}
@@ -0,0 +1,145 @@
+package wsman
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeRedacts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		message  string
+		expected string
+	}{
+		{
+			name:     "namespaced password",
+			message:  `<h:AdminPassword>P@ssw0rd</h:AdminPassword>`,
+			expected: `<h:AdminPassword>[REDACTED]</h:AdminPassword>`,
+		},
+		{
+			name:     "psk passphrase with attributes",
+			message:  `<g:PSKPassPhrase xmlns:g="urn">secret value</g:PSKPassPhrase>`,
+			expected: `<g:PSKPassPhrase xmlns:g="urn">[REDACTED]</g:PSKPassPhrase>`,
+		},
+		{
+			name:     "private key",
+			message:  `<PrivateKey>MIIE...</PrivateKey><ElementName>Intel(r) AMT</ElementName>`,
+			expected: `<PrivateKey>[REDACTED]</PrivateKey><ElementName>Intel(r) AMT</ElementName>`,
+		},
+		{
+			name:     "kerberos master key",
+			message:  `<h:RealmName>CORP</h:RealmName><h:MasterKey>ABEiM0RVZneImaq7zN3u/w==</h:MasterKey>`,
+			expected: `<h:RealmName>CORP</h:RealmName><h:MasterKey>[REDACTED]</h:MasterKey>`,
+		},
+		{
+			name:     "private key added with AddKey",
+			message:  `<h:AddKey_INPUT xmlns:h="urn"><h:KeyBlob>MIIEvQIBADANBgkqhkiG9w0BAQEFAASC</h:KeyBlob></h:AddKey_INPUT>`,
+			expected: `<h:AddKey_INPUT xmlns:h="urn"><h:KeyBlob>[REDACTED]</h:KeyBlob></h:AddKey_INPUT>`,
+		},
+		{
+			name:     "wireless psk",
+			message:  `<h:PSKValue>0123456789abcdef</h:PSKValue>`,
+			expected: `<h:PSKValue>[REDACTED]</h:PSKValue>`,
+		},
+		{
+			name:     "unrelated elements",
+			message:  `<h:KeyIndex>1</h:KeyIndex><h:Username>admin</h:Username>`,
+			expected: `<h:KeyIndex>1</h:KeyIndex><h:Username>admin</h:Username>`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			trace := exchange{method: http.MethodPost, request: []byte(tc.message), status: "200 OK", response: []byte(tc.message)}.format()
+
+			assert.Equal(t, 2, strings.Count(trace, tc.expected), trace)
+		})
+	}
+}
+
+func TestCaptureTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(append([]byte("<Envelope><Password>device</Password>"), body...))
+	}))
+	defer server.Close()
+
+	registry := newCaptureRegistry(t.TempDir())
+	transport := &captureTransport{guid: "guid", next: http.DefaultTransport, registry: registry}
+	httpClient := &http.Client{Transport: transport}
+
+	post := func() string {
+		resp, err := httpClient.Post(server.URL, "application/soap+xml", bytes.NewBufferString("<Password>request</Password>"))
+		require.NoError(t, err)
+
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return string(body)
+	}
+
+	// nothing is recorded before a capture starts
+	post()
+
+	_, _, err := registry.trace("guid")
+	require.ErrorIs(t, err, ErrCaptureNotFound)
+
+	_, err = registry.start("guid", time.Minute)
+	require.NoError(t, err)
+
+	body := post()
+	assert.Equal(t, "<Envelope><Password>device</Password><Password>request</Password>", body, "the response must reach the caller unchanged")
+
+	info, err := registry.stop("guid")
+	require.NoError(t, err)
+	assert.False(t, info.Active)
+
+	// nothing is recorded after the capture stopped
+	post()
+
+	info, trace, err := registry.trace("guid")
+	require.NoError(t, err)
+	assert.Equal(t, 1, info.Exchanges)
+	assert.Contains(t, string(trace), "# WS-Man trace of device guid")
+	assert.Contains(t, string(trace), "--- response 200 OK")
+	assert.Contains(t, string(trace), "<Password>[REDACTED]</Password>")
+	assert.NotContains(t, string(trace), "device</Password>")
+	assert.NotContains(t, string(trace), "request</Password>")
+}
+
+func TestCaptureExpires(t *testing.T) {
+	t.Parallel()
+
+	registry := newCaptureRegistry(t.TempDir())
+
+	_, err := registry.start("guid", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, registry.capturing("guid"))
+
+	registry.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+
+	assert.False(t, registry.capturing("guid"))
+
+	registry.record("guid", exchange{method: http.MethodPost})
+
+	info, _, err := registry.trace("guid")
+	require.NoError(t, err)
+	assert.False(t, info.Active)
+	assert.Zero(t, info.Exchanges)
+}
@@ -0,0 +1,310 @@
+package wsman
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// FixtureHost replaces the address of the recorded device in fixtures.
+const FixtureHost = "device.example.com"
+
+var (
+	// ErrFixtureMissing is returned when a fixture holds no response for a request.
+	ErrFixtureMissing = errors.New("no recorded response for wsman request")
+
+	actionElement      = regexp.MustCompile(`<(?:[\w.-]+:)?Action(?:\s[^>]*)?>([^<]*)<`)
+	resourceURIElement = regexp.MustCompile(`<(?:[\w.-]+:)?ResourceURI(?:\s[^>]*)?>([^<]*)<`)
+	selectorElement    = regexp.MustCompile(`<(?:[\w.-]+:)?Selector\s+Name="([^"]*)"[^>]*>([^<]*)<`)
+
+	// identifyingElement matches the opening tag of an element identifying the device or its network and its text content.
+	identifyingElement = regexp.MustCompile(`(?i)(<(?:[\w.-]+:)?(ipaddress|defaultgateway|primarydns|secondarydns|macaddress|hostname|hostosfqdn|domainname|serialnumber|digestrealm|ssid)(?:\s[^>]*)?>)[^<]*`)
+
+	// sanitizedValues replaces the content of identifying elements with documentation values.
+	sanitizedValues = map[string]string{
+		"ipaddress":      "192.0.2.10",
+		"defaultgateway": "192.0.2.1",
+		"primarydns":     "192.0.2.1",
+		"secondarydns":   "192.0.2.2",
+		"macaddress":     "00-53-00-00-00-01",
+		"hostname":       "device",
+		"hostosfqdn":     FixtureHost,
+		"domainname":     "example.com",
+		"serialnumber":   "SANITIZED",
+		"digestrealm":    "Digest:00000000000000000000000000000000",
+		"ssid":           "example",
+	}
+)
+
+// Fixture is a sanitized recording of the WS-Man exchanges with one device, replayed by tests in place of the device.
+type Fixture struct {
+	Name       string            `json:"name"`
+	AMTVersion string            `json:"amtVersion,omitempty"`
+	RecordedAt time.Time         `json:"recordedAt"`
+	Exchanges  []FixtureExchange `json:"exchanges"`
+}
+
+// FixtureExchange is one response of the device, keyed by the action, resource and selectors of its request.
+type FixtureExchange struct {
+	Action      string `json:"action"`
+	ResourceURI string `json:"resourceURI"`
+	Selectors   string `json:"selectors,omitempty"`
+	Status      int    `json:"status"`
+	Response    string `json:"response"`
+}
+
+func (e FixtureExchange) key() string {
+	return e.Action + " " + e.ResourceURI + " " + e.Selectors
+}
+
+// LoadFixture reads a fixture written by Fixture.Save.
+func LoadFixture(path string) (Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Fixture{}, err
+	}
+
+	var f Fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return Fixture{}, fmt.Errorf("fixture %s: %w", path, err)
+	}
+
+	return f, nil
+}
+
+// Save writes the fixture as indented JSON.
+func (f Fixture) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// requestKey identifies a request by its action, resource and selectors; message IDs and
+// enumeration contexts differ between runs and are left out.
+func requestKey(body []byte) FixtureExchange {
+	e := FixtureExchange{}
+
+	if m := actionElement.FindSubmatch(body); m != nil {
+		e.Action = strings.TrimSpace(string(m[1]))
+	}
+
+	if m := resourceURIElement.FindSubmatch(body); m != nil {
+		e.ResourceURI = strings.TrimSpace(string(m[1]))
+	}
+
+	selectors := make([]string, 0, 1)
+	for _, m := range selectorElement.FindAllSubmatch(body, -1) {
+		selectors = append(selectors, string(m[1])+"="+string(m[2]))
+	}
+
+	e.Selectors = strings.Join(selectors, ",")
+
+	return e
+}
+
+// sanitize redacts credentials and replaces the values identifying the device in a SOAP message.
+func sanitize(message []byte, host string) []byte {
+	message = []byte(logger.Redact(string(message)))
+	message = identifyingElement.ReplaceAllFunc(message, func(match []byte) []byte {
+		m := identifyingElement.FindSubmatch(match)
+
+		return append(m[1], sanitizedValues[strings.ToLower(string(m[2]))]...)
+	})
+
+	if host != "" {
+		message = bytes.ReplaceAll(message, []byte(host), []byte(FixtureHost))
+	}
+
+	return message
+}
+
+// Recorder collects the exchanges with a live device into a fixture.
+type Recorder struct {
+	mu      sync.Mutex
+	host    string
+	fixture Fixture
+}
+
+// NewRecorder records the exchanges with the device at host, which is replaced by FixtureHost in the fixture.
+func NewRecorder(name, host string) *Recorder {
+	return &Recorder{host: host, fixture: Fixture{Name: name, RecordedAt: time.Now().UTC(), Exchanges: []FixtureExchange{}}}
+}
+
+// Transport records the exchanges sent through next.
+func (r *Recorder) Transport(next http.RoundTripper) http.RoundTripper {
+	return &recordTransport{next: next, recorder: r}
+}
+
+// SetAMTVersion notes the firmware version of the recorded device.
+func (r *Recorder) SetAMTVersion(version string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fixture.AMTVersion = version
+}
+
+// Fixture returns the exchanges recorded so far.
+func (r *Recorder) Fixture() Fixture {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	f := r.fixture
+	f.Exchanges = append([]FixtureExchange(nil), r.fixture.Exchanges...)
+
+	return f
+}
+
+func (r *Recorder) add(e FixtureExchange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fixture.Exchanges = append(r.fixture.Exchanges, e)
+}
+
+type recordTransport struct {
+	next     http.RoundTripper
+	recorder *Recorder
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// digest challenges are answered by the client itself and not part of the fixture
+	if resp.StatusCode == http.StatusUnauthorized {
+		return resp, nil
+	}
+
+	response, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(response))
+
+	e := requestKey(sanitize(body, t.recorder.host))
+	e.Status = resp.StatusCode
+	e.Response = string(sanitize(response, t.recorder.host))
+	t.recorder.add(e)
+
+	return resp, nil
+}
+
+// replayTransport answers requests with the recorded responses for the same request, in the
+// order they were recorded. The last response for a request is repeated once the others are used.
+type replayTransport struct {
+	mu      sync.Mutex
+	pending map[string][]FixtureExchange
+}
+
+// Transport answers the requests sent through it from the fixture instead of a device.
+func (f Fixture) Transport() http.RoundTripper {
+	t := &replayTransport{pending: map[string][]FixtureExchange{}}
+
+	for _, e := range f.Exchanges {
+		t.pending[e.key()] = append(t.pending[e.key()], e)
+	}
+
+	return t
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	key := requestKey(body).key()
+
+	t.mu.Lock()
+	queue := t.pending[key]
+
+	if len(queue) == 0 {
+		t.mu.Unlock()
+
+		return nil, fmt.Errorf("%w: %s", ErrFixtureMissing, strings.TrimSpace(key))
+	}
+
+	e := queue[0]
+	if len(queue) > 1 {
+		t.pending[key] = queue[1:]
+	}
+	t.mu.Unlock()
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.Status, http.StatusText(e.Status)),
+		StatusCode:    e.Status,
+		Header:        http.Header{"Content-Type": []string{"application/soap+xml; charset=UTF-8"}},
+		Body:          io.NopCloser(strings.NewReader(e.Response)),
+		ContentLength: int64(len(e.Response)),
+		Request:       req,
+	}, nil
+}
+
+// NewRecordingManagement creates the Management of a live device, recording its exchanges to rec.
+func NewRecordingManagement(cp client.Parameters, rec *Recorder) Management {
+	messages := wsman.NewMessages(cp)
+
+	if target, ok := messages.Client.(*client.Target); ok {
+		target.Transport = rec.Transport(target.Transport)
+	}
+
+	return &ConnectionEntry{WsmanMessages: messages, clientParams: cp}
+}
+
+// NewReplayManagement creates a Management answered from the fixture, for tests.
+func NewReplayManagement(f Fixture) Management {
+	cp := client.Parameters{
+		Target:    FixtureHost,
+		Username:  "admin",
+		Password:  "replayed",
+		UseDigest: true,
+		Transport: f.Transport(),
+	}
+
+	return &ConnectionEntry{WsmanMessages: wsman.NewMessages(cp), clientParams: cp}
+}
@@ -0,0 +1,135 @@
+package wsman
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/general"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/stretchr/testify/require"
+)
+
+const generalSettingsResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:b="http://schemas.xmlsoap.org/ws/2004/08/addressing" xmlns:c="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd" xmlns:g="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_GeneralSettings">
+<a:Header>
+<b:To>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</b:To>
+<b:RelatesTo>0</b:RelatesTo>
+<b:Action a:mustUnderstand="true">http://schemas.xmlsoap.org/ws/2004/09/transfer/GetResponse</b:Action>
+<b:MessageID>uuid:00000000-8086-8086-8086-0000000002E4</b:MessageID>
+<c:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_GeneralSettings</c:ResourceURI>
+</a:Header>
+<a:Body>
+<g:AMT_GeneralSettings>
+<g:AMTNetworkEnabled>1</g:AMTNetworkEnabled>
+<g:DHCPSyncRequiresHostname>1</g:DHCPSyncRequiresHostname>
+<g:DigestRealm>Digest:F3EB554784E729164447A89F60B641C5</g:DigestRealm>
+<g:DomainName>corp.internal</g:DomainName>
+<g:ElementName>Intel(r) AMT: General Settings</g:ElementName>
+<g:HostName>finance-laptop-17</g:HostName>
+<g:HostOSFQDN>finance-laptop-17.corp.internal</g:HostOSFQDN>
+<g:InstanceID>Intel(r) AMT: General Settings</g:InstanceID>
+<g:NetworkInterfaceEnabled>true</g:NetworkInterfaceEnabled>
+</g:AMT_GeneralSettings>
+</a:Body>
+</a:Envelope>`
+
+// deviceTransport answers like a device asking for digest authentication first.
+type deviceTransport struct {
+	authenticated bool
+}
+
+func (d *deviceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !d.authenticated {
+		d.authenticated = true
+
+		return &http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Status:     "401 Unauthorized",
+			Header:     http.Header{"Www-Authenticate": []string{`Digest realm="Digest:F3EB554784E729164447A89F60B641C5", nonce="abc", qop="auth"`}},
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Body:       io.NopCloser(strings.NewReader(generalSettingsResponse)),
+		Request:    req,
+	}, nil
+}
+
+func recordGeneralSettings(t *testing.T) Fixture {
+	t.Helper()
+
+	rec := NewRecorder("test", "finance-laptop-17.corp.internal")
+	m := NewRecordingManagement(client.Parameters{
+		Target:    "finance-laptop-17.corp.internal",
+		Username:  "admin",
+		Password:  "P@ssw0rd",
+		UseDigest: true,
+		Transport: &deviceTransport{},
+	}, rec)
+
+	_, err := m.GetGeneralSettings(context.Background())
+	require.NoError(t, err)
+
+	return rec.Fixture()
+}
+
+func TestRecorderSanitizesExchanges(t *testing.T) {
+	t.Parallel()
+
+	f := recordGeneralSettings(t)
+
+	require.Len(t, f.Exchanges, 1, "the digest challenge is not recorded")
+
+	e := f.Exchanges[0]
+	require.Equal(t, "http://schemas.xmlsoap.org/ws/2004/09/transfer/Get", e.Action)
+	require.Equal(t, "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_GeneralSettings", e.ResourceURI)
+	require.Equal(t, http.StatusOK, e.Status)
+	require.NotContains(t, e.Response, "finance-laptop-17")
+	require.NotContains(t, e.Response, "F3EB554784E729164447A89F60B641C5")
+	require.NotContains(t, e.Response, "corp.internal")
+	require.Contains(t, e.Response, "<g:DHCPSyncRequiresHostname>1<", "only whole element names are sanitized")
+	require.Contains(t, e.Response, "<g:HostOSFQDN>"+FixtureHost+"<")
+}
+
+func TestReplayManagement(t *testing.T) {
+	t.Parallel()
+
+	m := NewReplayManagement(recordGeneralSettings(t))
+
+	for range 2 {
+		response, err := m.GetGeneralSettings(context.Background())
+		require.NoError(t, err)
+
+		settings, ok := response.(general.GeneralSettingsResponse)
+		require.True(t, ok)
+		require.Equal(t, "device", settings.HostName)
+		require.Equal(t, "example.com", settings.DomainName)
+	}
+
+	_, err := m.GetAMTVersion(context.Background())
+	require.ErrorIs(t, err, ErrFixtureMissing)
+}
+
+func TestFixtureSaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	f := recordGeneralSettings(t)
+	f.AMTVersion = "16.1.27"
+	path := filepath.Join(t.TempDir(), "fixture.json")
+
+	require.NoError(t, f.Save(path))
+
+	loaded, err := LoadFixture(path)
+	require.NoError(t, err)
+	require.Equal(t, f.AMTVersion, loaded.AMTVersion)
+	require.Equal(t, f.Exchanges, loaded.Exchanges)
+	require.True(t, f.RecordedAt.Equal(loaded.RecordedAt))
+}
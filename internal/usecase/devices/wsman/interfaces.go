@@ -1,16 +1,20 @@
 package wsman
 
 import (
+	"context"
 	gotls "crypto/tls"
 	"time"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/alarmclock"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ethernetport"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
 	cimBoot "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/concrete"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
@@ -18,7 +22,9 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/service"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/software"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/wifi"
 	ipsAlarmClock "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/alarmclock"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbasedsetup"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/kvmredirection"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/optin"
 	ipspower "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/power"
@@ -26,50 +32,67 @@ import (
 )
 
 type Management interface {
-	AddTrustedRootCert(caCert string) (string, error)
-	AddClientCert(clientCert string) (string, error)
-	GetAMTVersion() ([]software.SoftwareIdentity, error)
-	GetSetupAndConfiguration() ([]setupandconfiguration.SetupAndConfigurationServiceResponse, error)
-	GetAMTRedirectionService() (redirection.Response, error)
-	SetAMTRedirectionService(*redirection.RedirectionRequest) (redirection.Response, error)
-	RequestAMTRedirectionServiceStateChange(ider, sol bool) (redirection.RequestedState, int, error)
-	GetIPSOptInService() (optin.Response, error)
-	SetIPSOptInService(optin.OptInServiceRequest) error
-	GetKVMRedirection() (kvm.Response, error)
-	SetKVMRedirection(enable bool) (int, error)
-	GetAlarmOccurrences() ([]ipsAlarmClock.AlarmClockOccurrence, error)
-	CreateAlarmOccurrences(name string, startTime time.Time, interval int, deleteOnCompletion bool) (alarmclock.AddAlarmOutput, error)
-	DeleteAlarmOccurrences(instanceID string) error
-	GetHardwareInfo() (interface{}, error)
-	GetPowerState() ([]service.CIM_AssociatedPowerManagementService, error)
-	GetOSPowerSavingState() (ipspower.OSPowerSavingState, error)
-	GetIPSPowerManagementService() (ipspower.PowerManagementService, error)
-	RequestOSPowerSavingStateChange(osPowerSavingState ipspower.OSPowerSavingState) (ipspower.PowerActionResponse, error)
-	GetPowerCapabilities() (boot.BootCapabilitiesResponse, error)
-	GetGeneralSettings() (interface{}, error)
-	CancelUserConsentRequest() (optin.Response, error)
-	GetUserConsentCode() (optin.Response, error)
-	SendConsentCode(code int) (optin.Response, error)
-	SendPowerAction(action int) (power.PowerActionResponse, error)
-	GetBootData() (boot.BootSettingDataResponse, error)
-	SetBootData(data boot.BootSettingDataRequest) (interface{}, error)
-	GetBootService() (cimBoot.BootService, error)
-	SetBootConfigRole(role int) (interface{}, error)
-	ChangeBootOrder(bootSource string) (cimBoot.ChangeBootOrder_OUTPUT, error)
-	GetAuditLog(startIndex int) (auditlog.Response, error)
-	GetEventLog(startIndex, maxReadRecords int) (messagelog.GetRecordsResponse, error)
-	GetNetworkSettings() (NetworkResults, error)
-	GetCertificates() (Certificates, error)
-	GetTLSSettingData() ([]tls.SettingDataResponse, error)
-	GetCredentialRelationships() (credential.Items, error)
-	GetConcreteDependencies() ([]concrete.ConcreteDependency, error)
-	GetDiskInfo() (interface{}, error)
-	GetDeviceCertificate() (*gotls.Certificate, error)
-	GetCIMBootSourceSetting() (cimBoot.Response, error)
-	BootServiceStateChange(requestedState int) (cimBoot.BootService, error)
-	GetIPSScreenSettingData() (screensetting.Response, error)
-	GetIPSKVMRedirectionSettingData() (kvmredirection.Response, error)
-	SetIPSKVMRedirectionSettingData(data *kvmredirection.KVMRedirectionSettingsRequest) (kvmredirection.Response, error)
-	DeleteCertificate(instanceID string) error
-	SetLinkPreference(linkPreference, timeout uint32) (int, error)
+	AddTrustedRootCert(ctx context.Context, caCert string) (string, error)
+	AddClientCert(ctx context.Context, clientCert string) (string, error)
+	GetAMTVersion(ctx context.Context) ([]software.SoftwareIdentity, error)
+	GetSetupAndConfiguration(ctx context.Context) ([]setupandconfiguration.SetupAndConfigurationServiceResponse, error)
+	GetIPSHostBasedSetupService(ctx context.Context) (hostbasedsetup.Response, error)
+	GetAMTRedirectionService(ctx context.Context) (redirection.Response, error)
+	SetAMTRedirectionService(ctx context.Context, request *redirection.RedirectionRequest) (redirection.Response, error)
+	RequestAMTRedirectionServiceStateChange(ctx context.Context, ider, sol bool) (redirection.RequestedState, int, error)
+	GetIPSOptInService(ctx context.Context) (optin.Response, error)
+	SetIPSOptInService(ctx context.Context, request optin.OptInServiceRequest) error
+	GetKVMRedirection(ctx context.Context) (kvm.Response, error)
+	SetKVMRedirection(ctx context.Context, enable bool) (int, error)
+	GetAlarmOccurrences(ctx context.Context) ([]ipsAlarmClock.AlarmClockOccurrence, error)
+	CreateAlarmOccurrences(ctx context.Context, name string, startTime time.Time, interval int, deleteOnCompletion bool) (alarmclock.AddAlarmOutput, error)
+	DeleteAlarmOccurrences(ctx context.Context, instanceID string) error
+	GetHardwareInfo(ctx context.Context, classes ...string) (interface{}, error)
+	GetPowerState(ctx context.Context) ([]service.CIM_AssociatedPowerManagementService, error)
+	GetOSPowerSavingState(ctx context.Context) (ipspower.OSPowerSavingState, error)
+	GetIPSPowerManagementService(ctx context.Context) (ipspower.PowerManagementService, error)
+	RequestOSPowerSavingStateChange(ctx context.Context, osPowerSavingState ipspower.OSPowerSavingState) (ipspower.PowerActionResponse, error)
+	GetPowerCapabilities(ctx context.Context) (boot.BootCapabilitiesResponse, error)
+	GetGeneralSettings(ctx context.Context) (interface{}, error)
+	CancelUserConsentRequest(ctx context.Context) (optin.Response, error)
+	GetUserConsentCode(ctx context.Context) (optin.Response, error)
+	SendConsentCode(ctx context.Context, code int) (optin.Response, error)
+	SendPowerAction(ctx context.Context, action int) (power.PowerActionResponse, error)
+	GetBootData(ctx context.Context) (boot.BootSettingDataResponse, error)
+	SetBootData(ctx context.Context, data boot.BootSettingDataRequest) (interface{}, error)
+	GetBootService(ctx context.Context) (cimBoot.BootService, error)
+	SetBootConfigRole(ctx context.Context, role int) (interface{}, error)
+	ChangeBootOrder(ctx context.Context, bootSource string) (cimBoot.ChangeBootOrder_OUTPUT, error)
+	GetAuditLog(ctx context.Context, startIndex int) (auditlog.Response, error)
+	GetEventLog(ctx context.Context, startIndex, maxReadRecords int) (messagelog.GetRecordsResponse, error)
+	GetNetworkSettings(ctx context.Context, interfaces ...string) (NetworkResults, error)
+	GetCertificates(ctx context.Context) (Certificates, error)
+	GetTLSSettingData(ctx context.Context) ([]tls.SettingDataResponse, error)
+	PutTLSSettingData(ctx context.Context, settings tls.SettingDataRequest) error
+	GetCredentialRelationships(ctx context.Context) (credential.Items, error)
+	GetConcreteDependencies(ctx context.Context) ([]concrete.ConcreteDependency, error)
+	GetDiskInfo(ctx context.Context) (interface{}, error)
+	GetDeviceCertificate(ctx context.Context) (*gotls.Certificate, error)
+	GetCIMBootSourceSetting(ctx context.Context) (cimBoot.Response, error)
+	BootServiceStateChange(ctx context.Context, requestedState int) (cimBoot.BootService, error)
+	GetIPSScreenSettingData(ctx context.Context) (screensetting.Response, error)
+	GetIPSKVMRedirectionSettingData(ctx context.Context) (kvmredirection.Response, error)
+	SetIPSKVMRedirectionSettingData(ctx context.Context, data *kvmredirection.KVMRedirectionSettingsRequest) (kvmredirection.Response, error)
+	DeleteCertificate(ctx context.Context, instanceID string) error
+	DeleteKeyPair(ctx context.Context, instanceID string) error
+	RemoveRemoteAccess(ctx context.Context) error
+	SetLinkPreference(ctx context.Context, linkPreference, timeout uint32) (int, error)
+	GetEthernetPorts(ctx context.Context) ([]ethernetport.SettingsResponse, error)
+	SetLinkPolicy(ctx context.Context, instanceID string, policy []ethernetport.LinkPolicy) error
+	GetKerberosSettingData(ctx context.Context) (kerberos.KerberosSettingDataResponse, error)
+	PutKerberosSettingData(ctx context.Context, settings kerberos.KerberosSettingDataResponse) (kerberos.KerberosSettingDataResponse, error)
+	GetUserACLEntries(ctx context.Context) ([]UserACLEntry, error)
+	AddUserACLEntry(ctx context.Context, entry UserACLEntry, digestPassword string) (int, error)
+	UpdateUserACLEntry(ctx context.Context, entry UserACLEntry, digestPassword string) error
+	RemoveUserACLEntry(ctx context.Context, handle int) error
+	SetUserACLEnabledState(ctx context.Context, handle int, enabled bool) error
+	GetWiFiEndpointSettings(ctx context.Context) ([]wifi.WiFiEndpointSettingsResponse, error)
+	PutWiFiEndpointSettings(ctx context.Context, settings wifi.WiFiEndpointSettingsResponse) error
+	GetWiFiPortConfigurationService(ctx context.Context) (wifiportconfiguration.WiFiPortConfigurationServiceResponse, error)
+	PutWiFiPortConfigurationService(ctx context.Context, settings wifiportconfiguration.WiFiPortConfigurationServiceResponse) (wifiportconfiguration.WiFiPortConfigurationServiceResponse, error)
 }
@@ -0,0 +1,213 @@
+package wsman
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const simulatedResponse = `<?xml version="1.0" encoding="UTF-8"?>` +
+	`<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:b="http://schemas.xmlsoap.org/ws/2004/08/addressing">` +
+	`<a:Header><b:Action a:mustUnderstand="true">http://schemas.xmlsoap.org/ws/2004/09/transfer/GetResponse</b:Action></a:Header>` +
+	`<a:Body></a:Body></a:Envelope>`
+
+// simulatedAMT answers WS-Man requests like an AMT device: unauthenticated requests
+// get a digest challenge, authenticated ones an empty SOAP envelope.
+type simulatedAMT struct {
+	requests atomic.Int64
+}
+
+func (s *simulatedAMT) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.requests.Add(1)
+
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+		_ = req.Body.Close()
+	}
+
+	if req.Header.Get("Authorization") == "" {
+		header := http.Header{}
+		header.Set("WWW-Authenticate", `Digest realm="Digest:SIMULATED", nonce="simulated-nonce", stale="false", qop="auth"`)
+
+		return &http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/soap+xml; charset=UTF-8"}},
+		Body:       io.NopCloser(strings.NewReader(simulatedResponse)),
+		Request:    req,
+	}, nil
+}
+
+type plainCryptor struct {
+	security.Cryptor
+}
+
+func (plainCryptor) Decrypt(cipherText string) (string, error) {
+	return cipherText, nil
+}
+
+func simulatedDevice(i int) entity.Device {
+	return entity.Device{
+		GUID:     fmt.Sprintf("00000000-0000-0000-0000-%012d", i),
+		Hostname: fmt.Sprintf("amt-%d.simulated", i),
+		Username: "admin",
+		Password: "P@ssw0rd",
+	}
+}
+
+// simulateDevices registers n authenticated connections backed by amt, as if each device
+// had already answered a request, and removes them when the test ends.
+func simulateDevices(tb testing.TB, n int, amt *simulatedAMT) []*ConnectionEntry {
+	tb.Helper()
+
+	entries := make([]*ConnectionEntry, n)
+
+	for i := range entries {
+		device := simulatedDevice(i)
+
+		entries[i] = storeConnection(device.GUID, &ConnectionEntry{
+			WsmanMessages: NewMessages(device, client.Parameters{
+				Target:    device.Hostname,
+				Username:  device.Username,
+				Password:  device.Password,
+				UseDigest: true,
+				Transport: amt,
+			}),
+			Timer: time.AfterFunc(expireAfter, func() {}),
+		})
+
+		_, err := entries[i].GetAMTVersion(context.Background())
+		require.NoError(tb, err)
+		require.True(tb, entries[i].WsmanMessages.Client.IsAuthenticated())
+	}
+
+	tb.Cleanup(func() {
+		for i := range entries {
+			entries[i].Timer.Stop()
+			removeConnection(simulatedDevice(i).GUID)
+		}
+	})
+
+	return entries
+}
+
+// startWorker runs the request queue without the pause between requests.
+func startWorker(tb testing.TB) GoWSMANMessages {
+	tb.Helper()
+
+	if config.ConsoleConfig == nil {
+		config.ConsoleConfig = &config.Config{}
+	}
+
+	tick := queueTickTime
+	queueTickTime = 0
+
+	g := GoWSMANMessages{log: logger.New("error"), safeRequirements: plainCryptor{}}
+
+	go g.Worker()
+
+	tb.Cleanup(func() {
+		shutdownSignal <- struct{}{}
+		queueTickTime = tick
+	})
+
+	return g
+}
+
+// concurrently calls fn for every device from its own goroutine and waits for all of them.
+func concurrently(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+
+	for i := range n {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			fn(i)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestSimulatedDevices(t *testing.T) { //nolint:paralleltest // uses the global connection map and request queue
+	const n = 25
+
+	amt := &simulatedAMT{}
+	entries := simulateDevices(t, n, amt)
+	g := startWorker(t)
+
+	concurrently(n, func(i int) {
+		management, err := g.SetupWsmanClient(simulatedDevice(i), false, false)
+		require.NoError(t, err)
+		require.Same(t, entries[i], management)
+
+		_, err = management.GetHardwareInfo(context.Background())
+		require.NoError(t, err)
+	})
+
+	// per device: the digest challenge and the version enumeration, then five gets and an enumeration for the hardware
+	require.Equal(t, int64(n*(1+2+5+2)), amt.requests.Load())
+
+	g.DestroyWsmanClient(dto.Device{GUID: simulatedDevice(0).GUID})
+	require.Nil(t, getConnection(simulatedDevice(0).GUID))
+}
+
+func TestSelectedHardwareClasses(t *testing.T) { //nolint:paralleltest // uses the global connection map
+	tests := []struct {
+		name     string
+		classes  []string
+		requests int64
+		keys     []string
+	}{
+		{name: "all classes", requests: 5 + 2, keys: HardwareClasses},
+		{name: "one get", classes: []string{ClassChassis}, requests: 1, keys: []string{ClassChassis}},
+		{name: "enumeration only", classes: []string{ClassPhysicalMemory}, requests: 2, keys: []string{ClassPhysicalMemory}},
+		{name: "get and enumeration", classes: []string{ClassBIOSElement, ClassPhysicalMemory}, requests: 1 + 2, keys: []string{ClassBIOSElement, ClassPhysicalMemory}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			amt := &simulatedAMT{}
+			entries := simulateDevices(t, 1, amt)
+			before := amt.requests.Load()
+
+			info, err := entries[0].GetHardwareInfo(context.Background(), tc.classes...)
+			require.NoError(t, err)
+			require.Equal(t, tc.requests, amt.requests.Load()-before)
+
+			all, ok := info.(map[string]interface{})
+			require.True(t, ok)
+
+			keys := make([]string, 0, len(all))
+			for key := range all {
+				keys = append(keys, key)
+			}
+
+			require.ElementsMatch(t, tc.keys, keys)
+		})
+	}
+}
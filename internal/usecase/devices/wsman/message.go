@@ -1,9 +1,13 @@
 package wsman
 
 import (
+	"context"
 	gotls "crypto/tls"
+	"encoding/base64"
+	"encoding/xml"
 	"errors"
 	"net"
+	"slices"
 	"sync"
 	"time"
 
@@ -61,6 +65,8 @@ import (
 	"github.com/device-management-toolkit/console/config"
 	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -80,7 +86,7 @@ var (
 	shutdownSignal      = make(chan struct{})
 
 	// ErrCIRADeviceNotConnected is returned when a CIRA device is not connected or not found.
-	ErrCIRADeviceNotConnected = errors.New("CIRA device not connected/not found")
+	ErrCIRADeviceNotConnected = consoleerrors.New(consoleerrors.CodeCIRANotConnected, "CIRA device not connected/not found")
 	// ErrNoWiFiPort is returned when no WiFi interface is found on the device.
 	ErrNoWiFiPort = errors.New("no WiFi interface found (InstanceID == Intel(r) AMT Ethernet Port Settings 1)")
 )
@@ -93,6 +99,9 @@ type ConnectionEntry struct {
 
 	// APF channel management for CIRA connections (uses types from go-wsman-messages)
 	APFChannelStore *client.APFChannelStore
+
+	device       entity.Device
+	clientParams client.Parameters
 }
 
 type GoWSMANMessages struct {
@@ -108,7 +117,7 @@ func NewGoWSMANMessages(log logger.Interface, safeRequirements security.Cryptor)
 }
 
 func (g GoWSMANMessages) DestroyWsmanClient(device dto.Device) {
-	if entry, ok := Connections[device.GUID]; ok {
+	if entry := getConnection(device.GUID); entry != nil {
 		entry.Timer.Stop()
 		removeConnection(device.GUID)
 	}
@@ -127,19 +136,22 @@ func (g GoWSMANMessages) Worker() {
 }
 
 func (g GoWSMANMessages) SetupWsmanClient(device entity.Device, isRedirection, logAMTMessages bool) (Management, error) {
+	// Messages are only logged for devices with wsman debug logging enabled (see logger.SetDebugTargets).
+	logAMTMessages = logAMTMessages && logger.DebugEnabled(logger.SubsystemWsman, device.GUID)
+
+	// the hostname is resolved before queueing, the lookup may wait on DNS
+	target := ""
+	if device.MPSUsername == "" {
+		target = TargetHost(device, !isRedirection)
+	}
+
 	resultChan := make(chan *ConnectionEntry)
 	errChan := make(chan error, 1)
 	// Queue the request
 	requestQueue <- func() {
 		device.Password, _ = g.safeRequirements.Decrypt(device.Password)
 		if device.MPSUsername != "" {
-			if len(Connections) == 0 {
-				errChan <- ErrCIRADeviceNotConnected
-
-				return
-			}
-
-			connection := Connections[device.GUID]
+			connection := getConnection(device.GUID)
 			if connection == nil {
 				errChan <- ErrCIRADeviceNotConnected
 
@@ -158,10 +170,10 @@ func (g GoWSMANMessages) SetupWsmanClient(device entity.Device, isRedirection, l
 				CIRAManager:       connection,
 			}
 
-			connection.WsmanMessages = wsman.NewMessages(cp)
+			connection.WsmanMessages = NewMessages(device, cp)
 			resultChan <- connection
 		} else {
-			resultChan <- g.setupWsmanClientInternal(device, isRedirection, logAMTMessages)
+			resultChan <- g.setupWsmanClientInternal(device, target, isRedirection, logAMTMessages)
 		}
 	}
 
@@ -173,9 +185,9 @@ func (g GoWSMANMessages) SetupWsmanClient(device entity.Device, isRedirection, l
 	}
 }
 
-func (g GoWSMANMessages) setupWsmanClientInternal(device entity.Device, isRedirection, logAMTMessages bool) *ConnectionEntry {
+func (g GoWSMANMessages) setupWsmanClientInternal(device entity.Device, target string, isRedirection, logAMTMessages bool) *ConnectionEntry {
 	clientParams := client.Parameters{
-		Target:                    device.Hostname,
+		Target:                    target,
 		Username:                  device.Username,
 		Password:                  device.Password,
 		UseDigest:                 true,
@@ -194,18 +206,21 @@ func (g GoWSMANMessages) setupWsmanClientInternal(device entity.Device, isRedire
 		removeConnection(device.GUID)
 	})
 
-	if entry, ok := Connections[device.GUID]; ok {
+	if entry := getConnection(device.GUID); entry != nil {
 		if !entry.IsCIRA && entry.WsmanMessages.Client.IsAuthenticated() {
+			timer.Stop()
 			entry.Timer.Stop() // Stop the previous timer
 			entry.Timer = time.AfterFunc(expireAfter, func() {
 				removeConnection(device.GUID)
 			})
 
-			return Connections[device.GUID]
+			return entry
 		} else if entry.IsCIRA {
-			Connections[device.GUID].WsmanMessages = wsman.NewMessages(clientParams)
+			timer.Stop()
 
-			return Connections[device.GUID]
+			entry.WsmanMessages = NewMessages(device, clientParams)
+
+			return entry
 		}
 
 		ticker := time.NewTicker(waitForAuthTickTime)
@@ -218,36 +233,60 @@ func (g GoWSMANMessages) setupWsmanClientInternal(device entity.Device, isRedire
 			select {
 			case <-ticker.C:
 				if entry.WsmanMessages.Client.IsAuthenticated() {
-					// Your logic when the function check is successful
-					return Connections[device.GUID]
+					timer.Stop()
+
+					return entry
 				}
 			case <-timeout:
-				connectionsMu.Lock()
-
-				Connections[device.GUID] = &ConnectionEntry{
-					WsmanMessages: wsman.NewMessages(clientParams),
+				return storeConnection(device.GUID, &ConnectionEntry{
+					WsmanMessages: NewMessages(device, clientParams),
 					Timer:         timer,
-				}
-
-				connectionsMu.Unlock()
-
-				return Connections[device.GUID]
+					device:        device,
+					clientParams:  clientParams,
+				})
 			}
 		}
 	}
 
-	wsmanMsgs := wsman.NewMessages(clientParams)
+	return storeConnection(device.GUID, &ConnectionEntry{
+		WsmanMessages: NewMessages(device, clientParams),
+		Timer:         timer,
+		device:        device,
+		clientParams:  clientParams,
+	})
+}
+
+// RegisterConnection stores the connection of a device, replacing any previous one.
+func RegisterConnection(guid string, entry *ConnectionEntry) {
+	storeConnection(guid, entry)
+}
+
+// RemoveConnection forgets the stored connection of a device.
+func RemoveConnection(guid string) {
+	removeConnection(guid)
+}
+
+// CIRAConnected reports whether a device is connected to the console over CIRA.
+func CIRAConnected(guid string) bool {
+	entry := getConnection(guid)
 
+	return entry != nil && entry.IsCIRA
+}
+
+func getConnection(guid string) *ConnectionEntry {
 	connectionsMu.Lock()
+	defer connectionsMu.Unlock()
 
-	Connections[device.GUID] = &ConnectionEntry{
-		WsmanMessages: wsmanMsgs,
-		Timer:         timer,
-	}
-	Connections[device.GUID].WsmanMessages.Client.IsAuthenticated()
-	connectionsMu.Unlock()
+	return Connections[guid]
+}
+
+func storeConnection(guid string, entry *ConnectionEntry) *ConnectionEntry {
+	connectionsMu.Lock()
+	defer connectionsMu.Unlock()
 
-	return Connections[device.GUID]
+	Connections[guid] = entry
+
+	return entry
 }
 
 func removeConnection(guid string) {
@@ -289,7 +328,9 @@ func (c *ConnectionEntry) UnregisterAPFChannel(senderChannel uint32) {
 	}
 }
 
-func (c *ConnectionEntry) GetAMTVersion() ([]software.SoftwareIdentity, error) {
+func (c *ConnectionEntry) GetAMTVersion(ctx context.Context) ([]software.SoftwareIdentity, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.CIM.SoftwareIdentity.Enumerate()
 	if err != nil {
 		return []software.SoftwareIdentity{}, err
@@ -303,7 +344,9 @@ func (c *ConnectionEntry) GetAMTVersion() ([]software.SoftwareIdentity, error) {
 	return response.Body.PullResponse.SoftwareIdentityItems, nil
 }
 
-func (c *ConnectionEntry) GetSetupAndConfiguration() ([]setupandconfiguration.SetupAndConfigurationServiceResponse, error) {
+func (c *ConnectionEntry) GetSetupAndConfiguration(ctx context.Context) ([]setupandconfiguration.SetupAndConfigurationServiceResponse, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.AMT.SetupAndConfigurationService.Enumerate()
 	if err != nil {
 		return []setupandconfiguration.SetupAndConfigurationServiceResponse{}, err
@@ -317,11 +360,32 @@ func (c *ConnectionEntry) GetSetupAndConfiguration() ([]setupandconfiguration.Se
 	return response.Body.PullResponse.SetupAndConfigurationServiceItems, nil
 }
 
-func (c *ConnectionEntry) GetDeviceCertificate() (*gotls.Certificate, error) {
+func (c *ConnectionEntry) GetDeviceCertificate(ctx context.Context) (*gotls.Certificate, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// GetServerCertificate dials the device itself, a proxied device is reached through its proxy
+	proxyURL, err := ProxyFor(c.device)
+	if err != nil {
+		return nil, err
+	}
+
+	if proxyURL != nil && c.clientParams.Target != "" {
+		return proxiedServerCertificate(ctx, proxyURL, c.clientParams.Target+":"+client.TLSPort)
+	}
+
+	// GetServerCertificate needs a plain *http.Transport, the one of the messages may be wrapped
+	if c.clientParams.Target != "" {
+		return client.NewWsman(c.clientParams).GetServerCertificate()
+	}
+
 	return c.WsmanMessages.Client.GetServerCertificate()
 }
 
-func (c *ConnectionEntry) RequestAMTRedirectionServiceStateChange(ider, sol bool) (redirection.RequestedState, int, error) {
+func (c *ConnectionEntry) RequestAMTRedirectionServiceStateChange(ctx context.Context, ider, sol bool) (redirection.RequestedState, int, error) {
+	defer c.bind(ctx)()
+
 	requestedState := redirection.DisableIDERAndSOL
 	listenerEnabled := 0
 
@@ -343,7 +407,9 @@ func (c *ConnectionEntry) RequestAMTRedirectionServiceStateChange(ider, sol bool
 	return requestedState, listenerEnabled, nil
 }
 
-func (c *ConnectionEntry) GetKVMRedirection() (kvm.Response, error) {
+func (c *ConnectionEntry) GetKVMRedirection(ctx context.Context) (kvm.Response, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.CIM.KVMRedirectionSAP.Get()
 	if err != nil {
 		return kvm.Response{}, err
@@ -352,7 +418,9 @@ func (c *ConnectionEntry) GetKVMRedirection() (kvm.Response, error) {
 	return response, nil
 }
 
-func (c *ConnectionEntry) SetKVMRedirection(enable bool) (int, error) {
+func (c *ConnectionEntry) SetKVMRedirection(ctx context.Context, enable bool) (int, error) {
+	defer c.bind(ctx)()
+
 	requestedState := kvm.RedirectionSAPDisable
 	listenerEnabled := 0
 
@@ -369,7 +437,9 @@ func (c *ConnectionEntry) SetKVMRedirection(enable bool) (int, error) {
 	return listenerEnabled, nil
 }
 
-func (c *ConnectionEntry) GetAlarmOccurrences() ([]ipsAlarmClock.AlarmClockOccurrence, error) {
+func (c *ConnectionEntry) GetAlarmOccurrences(ctx context.Context) ([]ipsAlarmClock.AlarmClockOccurrence, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.IPS.AlarmClockOccurrence.Enumerate()
 	if err != nil {
 		return []ipsAlarmClock.AlarmClockOccurrence{}, err
@@ -383,7 +453,9 @@ func (c *ConnectionEntry) GetAlarmOccurrences() ([]ipsAlarmClock.AlarmClockOccur
 	return response.Body.PullResponse.Items, nil
 }
 
-func (c *ConnectionEntry) CreateAlarmOccurrences(name string, startTime time.Time, interval int, deleteOnCompletion bool) (amtAlarmClock.AddAlarmOutput, error) {
+func (c *ConnectionEntry) CreateAlarmOccurrences(ctx context.Context, name string, startTime time.Time, interval int, deleteOnCompletion bool) (amtAlarmClock.AddAlarmOutput, error) {
+	defer c.bind(ctx)()
+
 	alarmOccurrence := amtAlarmClock.AlarmClockOccurrence{
 		InstanceID:         name,
 		ElementName:        name,
@@ -400,7 +472,9 @@ func (c *ConnectionEntry) CreateAlarmOccurrences(name string, startTime time.Tim
 	return response.Body.AddAlarmOutput, nil
 }
 
-func (c *ConnectionEntry) DeleteAlarmOccurrences(instanceID string) error {
+func (c *ConnectionEntry) DeleteAlarmOccurrences(ctx context.Context, instanceID string) error {
+	defer c.bind(ctx)()
+
 	_, err := c.WsmanMessages.IPS.AlarmClockOccurrence.Delete(instanceID)
 	if err != nil {
 		return err
@@ -409,42 +483,83 @@ func (c *ConnectionEntry) DeleteAlarmOccurrences(instanceID string) error {
 	return nil
 }
 
-func (c *ConnectionEntry) hardwareGets() (GetHWResults, error) {
+// The CIM classes of the hardware information, which GetHardwareInfo may be limited to.
+const (
+	ClassChassis        = "CIM_Chassis"
+	ClassChip           = "CIM_Chip"
+	ClassCard           = "CIM_Card"
+	ClassBIOSElement    = "CIM_BIOSElement"
+	ClassProcessor      = "CIM_Processor"
+	ClassPhysicalMemory = "CIM_PhysicalMemory"
+)
+
+// HardwareClasses lists the classes GetHardwareInfo fetches.
+var HardwareClasses = []string{ClassChassis, ClassChip, ClassCard, ClassBIOSElement, ClassProcessor, ClassPhysicalMemory}
+
+// The network interfaces of the network settings, which GetNetworkSettings may be limited to.
+const (
+	InterfaceWired    = "wired"
+	InterfaceWireless = "wireless"
+)
+
+// NetworkInterfaces lists the interfaces GetNetworkSettings fetches the settings of.
+var NetworkInterfaces = []string{InterfaceWired, InterfaceWireless}
+
+// selected tells whether name is part of a selection, an empty selection selects everything.
+func selected(selection []string, name string) bool {
+	return len(selection) == 0 || slices.Contains(selection, name)
+}
+
+func (c *ConnectionEntry) hardwareGets(classes []string) (GetHWResults, error) {
 	results := GetHWResults{}
 
 	var err error
 
-	results.ChassisResult, err = c.WsmanMessages.CIM.Chassis.Get()
-	if err != nil {
-		return results, err
+	if selected(classes, ClassChassis) {
+		results.ChassisResult, err = c.WsmanMessages.CIM.Chassis.Get()
+		if err != nil {
+			return results, err
+		}
 	}
 
-	results.CardResult, err = c.WsmanMessages.CIM.Card.Get()
-	if err != nil {
-		return results, err
+	if selected(classes, ClassCard) {
+		results.CardResult, err = c.WsmanMessages.CIM.Card.Get()
+		if err != nil {
+			return results, err
+		}
 	}
 
-	results.ChipResult, err = c.WsmanMessages.CIM.Chip.Get()
-	if err != nil {
-		return results, err
+	if selected(classes, ClassChip) {
+		results.ChipResult, err = c.WsmanMessages.CIM.Chip.Get()
+		if err != nil {
+			return results, err
+		}
 	}
 
-	results.BiosResult, err = c.WsmanMessages.CIM.BIOSElement.Get()
-	if err != nil {
-		return results, err
+	if selected(classes, ClassBIOSElement) {
+		results.BiosResult, err = c.WsmanMessages.CIM.BIOSElement.Get()
+		if err != nil {
+			return results, err
+		}
 	}
 
-	results.ProcessorResult, err = c.WsmanMessages.CIM.Processor.Get()
-	if err != nil {
-		return results, err
+	if selected(classes, ClassProcessor) {
+		results.ProcessorResult, err = c.WsmanMessages.CIM.Processor.Get()
+		if err != nil {
+			return results, err
+		}
 	}
 
 	return results, nil
 }
 
-func (c *ConnectionEntry) hardwarePulls() (PullHWResults, error) {
+func (c *ConnectionEntry) hardwarePulls(classes []string) (PullHWResults, error) {
 	results := PullHWResults{}
 
+	if !selected(classes, ClassPhysicalMemory) {
+		return results, nil
+	}
+
 	var err error
 
 	pmEnumerateResult, err := c.WsmanMessages.CIM.PhysicalMemory.Enumerate()
@@ -460,13 +575,16 @@ func (c *ConnectionEntry) hardwarePulls() (PullHWResults, error) {
 	return results, nil
 }
 
-func (c *ConnectionEntry) GetHardwareInfo() (interface{}, error) {
-	getHWResults, err := c.hardwareGets()
+// GetHardwareInfo fetches the given HardwareClasses, all of them when none is given.
+func (c *ConnectionEntry) GetHardwareInfo(ctx context.Context, classes ...string) (interface{}, error) {
+	defer c.bind(ctx)()
+
+	getHWResults, err := c.hardwareGets(classes)
 	if err != nil {
 		return nil, err
 	}
 
-	pullHWResults, err := c.hardwarePulls()
+	pullHWResults, err := c.hardwarePulls(classes)
 	if err != nil {
 		return nil, err
 	}
@@ -480,7 +598,19 @@ func (c *ConnectionEntry) GetHardwareInfo() (interface{}, error) {
 		ProcessorResult:      getHWResults.ProcessorResult,
 	}
 
-	return createMapInterfaceForHWInfo(hwResults)
+	info, err := createMapInterfaceForHWInfo(hwResults)
+	if err != nil || len(classes) == 0 {
+		return info, err
+	}
+
+	all, _ := info.(map[string]interface{})
+	for class := range all {
+		if !selected(classes, class) {
+			delete(all, class)
+		}
+	}
+
+	return all, nil
 }
 
 type GetHWResults struct {
@@ -538,7 +668,9 @@ type DiskResults struct {
 	PPPullResult          physical.Response
 }
 
-func (c *ConnectionEntry) GetDiskInfo() (interface{}, error) {
+func (c *ConnectionEntry) GetDiskInfo(ctx context.Context) (interface{}, error) {
+	defer c.bind(ctx)()
+
 	results := DiskResults{}
 
 	var err error
@@ -571,7 +703,9 @@ func (c *ConnectionEntry) GetDiskInfo() (interface{}, error) {
 	return createMapInterfaceForDiskInfo(diskResults)
 }
 
-func (c *ConnectionEntry) GetPowerState() ([]service.CIM_AssociatedPowerManagementService, error) {
+func (c *ConnectionEntry) GetPowerState(ctx context.Context) ([]service.CIM_AssociatedPowerManagementService, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.CIM.ServiceAvailableToElement.Enumerate()
 	if err != nil {
 		return []service.CIM_AssociatedPowerManagementService{}, err
@@ -585,8 +719,10 @@ func (c *ConnectionEntry) GetPowerState() ([]service.CIM_AssociatedPowerManageme
 	return response.Body.PullResponse.AssociatedPowerManagementService, nil
 }
 
-func (c *ConnectionEntry) GetOSPowerSavingState() (ipspower.OSPowerSavingState, error) {
-	response, err := c.GetIPSPowerManagementService()
+func (c *ConnectionEntry) GetOSPowerSavingState(ctx context.Context) (ipspower.OSPowerSavingState, error) {
+	defer c.bind(ctx)()
+
+	response, err := c.GetIPSPowerManagementService(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -594,7 +730,9 @@ func (c *ConnectionEntry) GetOSPowerSavingState() (ipspower.OSPowerSavingState,
 	return response.OSPowerSavingState, nil
 }
 
-func (c *ConnectionEntry) GetIPSPowerManagementService() (ipspower.PowerManagementService, error) {
+func (c *ConnectionEntry) GetIPSPowerManagementService(ctx context.Context) (ipspower.PowerManagementService, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.IPS.PowerManagementService.Get()
 	if err != nil {
 		return ipspower.PowerManagementService{}, err
@@ -603,7 +741,9 @@ func (c *ConnectionEntry) GetIPSPowerManagementService() (ipspower.PowerManageme
 	return response.Body.GetResponse, nil
 }
 
-func (c *ConnectionEntry) RequestOSPowerSavingStateChange(newOSPowerStavingState ipspower.OSPowerSavingState) (ipspower.PowerActionResponse, error) {
+func (c *ConnectionEntry) RequestOSPowerSavingStateChange(ctx context.Context, newOSPowerStavingState ipspower.OSPowerSavingState) (ipspower.PowerActionResponse, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.IPS.PowerManagementService.RequestOSPowerSavingStateChange(newOSPowerStavingState)
 	if err != nil {
 		return ipspower.PowerActionResponse{}, err
@@ -612,7 +752,9 @@ func (c *ConnectionEntry) RequestOSPowerSavingStateChange(newOSPowerStavingState
 	return response.Body.RequestOSPowerSavingStateChangeResponse, nil
 }
 
-func (c *ConnectionEntry) GetPowerCapabilities() (boot.BootCapabilitiesResponse, error) {
+func (c *ConnectionEntry) GetPowerCapabilities(ctx context.Context) (boot.BootCapabilitiesResponse, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.AMT.BootCapabilities.Get()
 	if err != nil {
 		return boot.BootCapabilitiesResponse{}, err
@@ -621,7 +763,9 @@ func (c *ConnectionEntry) GetPowerCapabilities() (boot.BootCapabilitiesResponse,
 	return response.Body.BootCapabilitiesGetResponse, nil
 }
 
-func (c *ConnectionEntry) GetGeneralSettings() (interface{}, error) {
+func (c *ConnectionEntry) GetGeneralSettings(ctx context.Context) (interface{}, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.AMT.GeneralSettings.Get()
 	if err != nil {
 		return nil, err
@@ -630,7 +774,9 @@ func (c *ConnectionEntry) GetGeneralSettings() (interface{}, error) {
 	return response.Body.GetResponse, nil
 }
 
-func (c *ConnectionEntry) CancelUserConsentRequest() (optin.Response, error) {
+func (c *ConnectionEntry) CancelUserConsentRequest(ctx context.Context) (optin.Response, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.IPS.OptInService.CancelOptIn()
 	if err != nil {
 		return optin.Response{}, err
@@ -639,7 +785,9 @@ func (c *ConnectionEntry) CancelUserConsentRequest() (optin.Response, error) {
 	return response, nil
 }
 
-func (c *ConnectionEntry) GetUserConsentCode() (optin.Response, error) {
+func (c *ConnectionEntry) GetUserConsentCode(ctx context.Context) (optin.Response, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.IPS.OptInService.StartOptIn()
 	if err != nil {
 		return optin.Response{}, err
@@ -648,7 +796,9 @@ func (c *ConnectionEntry) GetUserConsentCode() (optin.Response, error) {
 	return response, nil
 }
 
-func (c *ConnectionEntry) SendConsentCode(code int) (optin.Response, error) {
+func (c *ConnectionEntry) SendConsentCode(ctx context.Context, code int) (optin.Response, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.IPS.OptInService.SendOptInCode(code)
 	if err != nil {
 		return optin.Response{}, err
@@ -657,7 +807,9 @@ func (c *ConnectionEntry) SendConsentCode(code int) (optin.Response, error) {
 	return response, nil
 }
 
-func (c *ConnectionEntry) GetBootData() (boot.BootSettingDataResponse, error) {
+func (c *ConnectionEntry) GetBootData(ctx context.Context) (boot.BootSettingDataResponse, error) {
+	defer c.bind(ctx)()
+
 	bootSettingData, err := c.WsmanMessages.AMT.BootSettingData.Get()
 	if err != nil {
 		return boot.BootSettingDataResponse{}, err
@@ -666,7 +818,9 @@ func (c *ConnectionEntry) GetBootData() (boot.BootSettingDataResponse, error) {
 	return bootSettingData.Body.BootSettingDataGetResponse, nil
 }
 
-func (c *ConnectionEntry) SetBootData(data boot.BootSettingDataRequest) (interface{}, error) {
+func (c *ConnectionEntry) SetBootData(ctx context.Context, data boot.BootSettingDataRequest) (interface{}, error) {
+	defer c.bind(ctx)()
+
 	bootSettingData, err := c.WsmanMessages.AMT.BootSettingData.Put(data)
 	if err != nil {
 		return nil, err
@@ -675,7 +829,9 @@ func (c *ConnectionEntry) SetBootData(data boot.BootSettingDataRequest) (interfa
 	return bootSettingData.Body, nil
 }
 
-func (c *ConnectionEntry) GetBootService() (cimBoot.BootService, error) {
+func (c *ConnectionEntry) GetBootService(ctx context.Context) (cimBoot.BootService, error) {
+	defer c.bind(ctx)()
+
 	bootService, err := c.WsmanMessages.CIM.BootService.Get()
 	if err != nil {
 		return cimBoot.BootService{}, err
@@ -684,7 +840,9 @@ func (c *ConnectionEntry) GetBootService() (cimBoot.BootService, error) {
 	return bootService.Body.ServiceGetResponse, nil
 }
 
-func (c *ConnectionEntry) BootServiceStateChange(requestedState int) (cimBoot.BootService, error) {
+func (c *ConnectionEntry) BootServiceStateChange(ctx context.Context, requestedState int) (cimBoot.BootService, error) {
+	defer c.bind(ctx)()
+
 	bootService, err := c.WsmanMessages.CIM.BootService.RequestStateChange(requestedState)
 	if err != nil {
 		return cimBoot.BootService{}, err
@@ -693,17 +851,30 @@ func (c *ConnectionEntry) BootServiceStateChange(requestedState int) (cimBoot.Bo
 	return bootService.Body.ServiceGetResponse, nil
 }
 
-func (c *ConnectionEntry) SetBootConfigRole(role int) (interface{}, error) {
+func (c *ConnectionEntry) SetBootConfigRole(ctx context.Context, role int) (interface{}, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.CIM.BootService.SetBootConfigRole("Intel(r) AMT: Boot Configuration 0", role)
 	if err != nil {
 		return cimBoot.ChangeBootOrder_OUTPUT{}, err
 	}
 
+	if rv := int(response.Body.ChangeBootOrder_OUTPUT.ReturnValue); rv != 0 {
+		return response.Body.ChangeBootOrder_OUTPUT, amtstatus.Error{Operation: "SetBootConfigRole", Explanation: amtstatus.ExplainBoot(rv)}
+	}
+
 	return response.Body.ChangeBootOrder_OUTPUT, nil
 }
 
-func (c *ConnectionEntry) ChangeBootOrder(bootSource string) (cimBoot.ChangeBootOrder_OUTPUT, error) {
+func (c *ConnectionEntry) ChangeBootOrder(ctx context.Context, bootSource string) (cimBoot.ChangeBootOrder_OUTPUT, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.CIM.BootConfigSetting.ChangeBootOrder(cimBoot.Source(bootSource))
+	// the client fails non-zero return values with an error that only repeats the value
+	if rv := int(response.Body.ChangeBootOrder_OUTPUT.ReturnValue); rv != 0 {
+		return response.Body.ChangeBootOrder_OUTPUT, amtstatus.Error{Operation: "ChangeBootOrder", Explanation: amtstatus.ExplainBoot(rv)}
+	}
+
 	if err != nil {
 		return cimBoot.ChangeBootOrder_OUTPUT{}, err
 	}
@@ -711,7 +882,9 @@ func (c *ConnectionEntry) ChangeBootOrder(bootSource string) (cimBoot.ChangeBoot
 	return response.Body.ChangeBootOrder_OUTPUT, nil
 }
 
-func (c *ConnectionEntry) GetAuditLog(startIndex int) (auditlog.Response, error) {
+func (c *ConnectionEntry) GetAuditLog(ctx context.Context, startIndex int) (auditlog.Response, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.AMT.AuditLog.ReadRecords(startIndex)
 	if err != nil {
 		return auditlog.Response{}, err
@@ -720,7 +893,9 @@ func (c *ConnectionEntry) GetAuditLog(startIndex int) (auditlog.Response, error)
 	return response, nil
 }
 
-func (c *ConnectionEntry) GetEventLog(startIndex, maxReadRecords int) (messagelog.GetRecordsResponse, error) {
+func (c *ConnectionEntry) GetEventLog(ctx context.Context, startIndex, maxReadRecords int) (messagelog.GetRecordsResponse, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.AMT.MessageLog.GetRecords(startIndex, maxReadRecords)
 	if err != nil {
 		return messagelog.GetRecordsResponse{}, err
@@ -729,7 +904,9 @@ func (c *ConnectionEntry) GetEventLog(startIndex, maxReadRecords int) (messagelo
 	return response.Body.GetRecordsResponse, nil
 }
 
-func (c *ConnectionEntry) SendPowerAction(action int) (power.PowerActionResponse, error) {
+func (c *ConnectionEntry) SendPowerAction(ctx context.Context, action int) (power.PowerActionResponse, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.CIM.PowerManagementService.RequestPowerStateChange(power.PowerState(action))
 	if err != nil {
 		return power.PowerActionResponse{}, err
@@ -818,19 +995,68 @@ func (c *ConnectionEntry) PutEthernetPortSettings(ethernetPortSettings ethernetp
 	return c.WsmanMessages.AMT.EthernetPortSettings.Put(instanceID, ethernetPortSettings)
 }
 
+// GetEthernetPorts returns the settings of the network interfaces of the device.
+func (c *ConnectionEntry) GetEthernetPorts(ctx context.Context) ([]ethernetport.SettingsResponse, error) {
+	defer c.bind(ctx)()
+
+	return c.GetEthernetPortSettings()
+}
+
+// SetLinkPolicy replaces the power states in which AMT may use the network interface with instanceID.
+func (c *ConnectionEntry) SetLinkPolicy(ctx context.Context, instanceID string, policy []ethernetport.LinkPolicy) error {
+	defer c.bind(ctx)()
+
+	response, err := c.WsmanMessages.AMT.EthernetPortSettings.Get(instanceID)
+	if err != nil {
+		return err
+	}
+
+	settings := response.Body.GetAndPutResponse
+	request := ethernetport.SettingsRequest{
+		ElementName:            settings.ElementName,
+		InstanceID:             settings.InstanceID,
+		VLANTag:                settings.VLANTag,
+		SharedMAC:              settings.SharedMAC,
+		LinkIsUp:               settings.LinkIsUp,
+		LinkPolicy:             policy,
+		SharedStaticIp:         settings.SharedStaticIp,
+		IpSyncEnabled:          settings.IpSyncEnabled,
+		DHCPEnabled:            settings.DHCPEnabled,
+		PhysicalConnectionType: settings.PhysicalConnectionType,
+		PhysicalNicMedium:      settings.PhysicalNicMedium,
+	}
+
+	// AMT refuses the static addresses of an interface using DHCP
+	if !settings.DHCPEnabled {
+		request.IPAddress = settings.IPAddress
+		request.SubnetMask = settings.SubnetMask
+		request.DefaultGateway = settings.DefaultGateway
+		request.PrimaryDNS = settings.PrimaryDNS
+		request.SecondaryDNS = settings.SecondaryDNS
+	}
+
+	_, err = c.WsmanMessages.AMT.EthernetPortSettings.Put(instanceID, request)
+
+	return err
+}
+
 func (c *ConnectionEntry) DeletePublicPrivateKeyPair(instanceID string) error {
 	_, err := c.WsmanMessages.AMT.PublicPrivateKeyPair.Delete(instanceID)
 
 	return err
 }
 
-func (c *ConnectionEntry) DeleteCertificate(instanceID string) error {
+func (c *ConnectionEntry) DeleteCertificate(ctx context.Context, instanceID string) error {
+	defer c.bind(ctx)()
+
 	_, err := c.WsmanMessages.AMT.PublicKeyCertificate.Delete(instanceID)
 
 	return err
 }
 
-func (c *ConnectionEntry) GetCredentialRelationships() (credential.Items, error) {
+func (c *ConnectionEntry) GetCredentialRelationships(ctx context.Context) (credential.Items, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.CIM.CredentialContext.Enumerate()
 	if err != nil {
 		return credential.Items{}, err
@@ -844,7 +1070,9 @@ func (c *ConnectionEntry) GetCredentialRelationships() (credential.Items, error)
 	return response.Body.PullResponse.Items, nil
 }
 
-func (c *ConnectionEntry) GetConcreteDependencies() ([]concrete.ConcreteDependency, error) {
+func (c *ConnectionEntry) GetConcreteDependencies(ctx context.Context) ([]concrete.ConcreteDependency, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.CIM.ConcreteDependency.Enumerate()
 	if err != nil {
 		return nil, err
@@ -864,12 +1092,61 @@ func (c *ConnectionEntry) DeleteWiFiSetting(instanceID string) error {
 	return err
 }
 
-func (c *ConnectionEntry) AddTrustedRootCert(caCert string) (handle string, err error) {
+// GetWiFiEndpointSettings returns the Wi-Fi profiles of the device.
+func (c *ConnectionEntry) GetWiFiEndpointSettings(ctx context.Context) ([]wifi.WiFiEndpointSettingsResponse, error) {
+	defer c.bind(ctx)()
+
+	return c.GetWiFiSettings()
+}
+
+// wifiEndpointSettings is the body of a Put of CIM_WiFiEndpointSettings. The Put of the library does not
+// select the instance to update, so Base.Put is given a header selector, which it formats from String.
+type wifiEndpointSettings struct {
+	XMLName              xml.Name                  `xml:"q:CIM_WiFiEndpointSettings"`
+	H                    string                    `xml:"xmlns:q,attr"`
+	ElementName          string                    `xml:"q:ElementName,omitempty"`
+	InstanceID           string                    `xml:"q:InstanceID"`
+	AuthenticationMethod wifi.AuthenticationMethod `xml:"q:AuthenticationMethod,omitempty"`
+	EncryptionMethod     wifi.EncryptionMethod     `xml:"q:EncryptionMethod,omitempty"`
+	SSID                 string                    `xml:"q:SSID,omitempty"`
+	Priority             int                       `xml:"q:Priority"`
+	BSSType              wifi.BSSType              `xml:"q:BSSType,omitempty"`
+}
+
+func (s *wifiEndpointSettings) String() string {
+	return s.InstanceID
+}
+
+// PutWiFiEndpointSettings updates the Wi-Fi profile of the device with the InstanceID of settings.
+func (c *ConnectionEntry) PutWiFiEndpointSettings(ctx context.Context, settings wifi.WiFiEndpointSettingsResponse) error {
+	defer c.bind(ctx)()
+
+	service := c.WsmanMessages.CIM.WiFiEndpointSettings.Base
+	msg := &client.Message{XMLInput: service.Put(&wifiEndpointSettings{
+		ElementName:          settings.ElementName,
+		InstanceID:           settings.InstanceID,
+		AuthenticationMethod: settings.AuthenticationMethod,
+		EncryptionMethod:     settings.EncryptionMethod,
+		SSID:                 settings.SSID,
+		Priority:             settings.Priority,
+		BSSType:              settings.BSSType,
+	}, true, nil)}
+
+	return service.Execute(msg)
+}
+
+func (c *ConnectionEntry) AddTrustedRootCert(ctx context.Context, caCert string) (handle string, err error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.AMT.PublicKeyManagementService.AddTrustedRootCertificate(caCert)
 	if err != nil {
 		return "", err
 	}
 
+	if rv := int(response.Body.AddTrustedRootCertificate_OUTPUT.ReturnValue); rv != 0 {
+		return "", amtstatus.Error{Operation: "AddTrustedRootCertificate", Explanation: amtstatus.Explain(rv)}
+	}
+
 	if len(response.Body.AddTrustedRootCertificate_OUTPUT.CreatedCertificate.ReferenceParameters.SelectorSet.Selectors) > 0 {
 		handle = response.Body.AddTrustedRootCertificate_OUTPUT.CreatedCertificate.ReferenceParameters.SelectorSet.Selectors[0].Text
 	}
@@ -877,12 +1154,18 @@ func (c *ConnectionEntry) AddTrustedRootCert(caCert string) (handle string, err
 	return handle, nil
 }
 
-func (c *ConnectionEntry) AddClientCert(clientCert string) (handle string, err error) {
+func (c *ConnectionEntry) AddClientCert(ctx context.Context, clientCert string) (handle string, err error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.AMT.PublicKeyManagementService.AddCertificate(clientCert)
 	if err != nil {
 		return "", err
 	}
 
+	if rv := int(response.Body.AddCertificate_OUTPUT.ReturnValue); rv != 0 {
+		return "", amtstatus.Error{Operation: "AddCertificate", Explanation: amtstatus.Explain(rv)}
+	}
+
 	if len(response.Body.AddCertificate_OUTPUT.CreatedCertificate.ReferenceParameters.SelectorSet.Selectors) > 0 {
 		handle = response.Body.AddCertificate_OUTPUT.CreatedCertificate.ReferenceParameters.SelectorSet.Selectors[0].Text
 	}
@@ -896,6 +1179,10 @@ func (c *ConnectionEntry) AddPrivateKey(privateKey string) (handle string, err e
 		return "", err
 	}
 
+	if rv := int(response.Body.AddKey_OUTPUT.ReturnValue); rv != 0 {
+		return "", amtstatus.Error{Operation: "AddKey", Explanation: amtstatus.Explain(rv)}
+	}
+
 	if len(response.Body.AddKey_OUTPUT.CreatedKey.ReferenceParameters.SelectorSet.Selectors) > 0 {
 		handle = response.Body.AddKey_OUTPUT.CreatedKey.ReferenceParameters.SelectorSet.Selectors[0].Text
 	}
@@ -903,13 +1190,59 @@ func (c *ConnectionEntry) AddPrivateKey(privateKey string) (handle string, err e
 	return handle, nil
 }
 
-func (c *ConnectionEntry) DeleteKeyPair(instanceID string) error {
+func (c *ConnectionEntry) DeleteKeyPair(ctx context.Context, instanceID string) error {
+	defer c.bind(ctx)()
+
 	_, err := c.WsmanMessages.AMT.PublicKeyManagementService.Delete(instanceID)
 
 	return err
 }
 
-func (c *ConnectionEntry) GetWiFiPortConfigurationService() (wifiportconfiguration.WiFiPortConfigurationServiceResponse, error) {
+// RemoveRemoteAccess removes the CIRA configuration of the device: its remote access policies, the MPS servers
+// they connect to, and the user initiated connections.
+func (c *ConnectionEntry) RemoveRemoteAccess(ctx context.Context) error {
+	defer c.bind(ctx)()
+
+	enum, err := c.WsmanMessages.AMT.RemoteAccessPolicyRule.Enumerate()
+	if err != nil {
+		return err
+	}
+
+	rules, err := c.WsmanMessages.AMT.RemoteAccessPolicyRule.Pull(enum.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules.Body.PullResponse.RemotePolicyRuleItems {
+		if _, err := c.WsmanMessages.AMT.RemoteAccessPolicyRule.Delete(rule.PolicyRuleName); err != nil {
+			return err
+		}
+	}
+
+	sapEnum, err := c.WsmanMessages.AMT.ManagementPresenceRemoteSAP.Enumerate()
+	if err != nil {
+		return err
+	}
+
+	servers, err := c.WsmanMessages.AMT.ManagementPresenceRemoteSAP.Pull(sapEnum.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return err
+	}
+
+	for _, server := range servers.Body.PullResponse.ManagementRemoteItems {
+		if _, err := c.WsmanMessages.AMT.ManagementPresenceRemoteSAP.Delete(server.Name); err != nil {
+			return err
+		}
+	}
+
+	_, err = c.WsmanMessages.AMT.UserInitiatedConnectionService.RequestStateChange(userinitiatedconnection.AllInterfacesDisabled)
+
+	return err
+}
+
+func (c *ConnectionEntry) GetWiFiPortConfigurationService(ctx context.Context) (wifiportconfiguration.WiFiPortConfigurationServiceResponse, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.AMT.WiFiPortConfigurationService.Get()
 	if err != nil {
 		return wifiportconfiguration.WiFiPortConfigurationServiceResponse{}, err
@@ -918,31 +1251,126 @@ func (c *ConnectionEntry) GetWiFiPortConfigurationService() (wifiportconfigurati
 	return response.Body.WiFiPortConfigurationService, nil
 }
 
-func (c *ConnectionEntry) PutWiFiPortConfigurationService(request wifiportconfiguration.WiFiPortConfigurationServiceRequest) (wifiportconfiguration.WiFiPortConfigurationServiceResponse, error) {
-	// if local sync not enable, enable it
-	// if response.Body.WiFiPortConfigurationService.LocalProfileSynchronizationEnabled == wifiportconfiguration.LocalSyncDisabled {
-	// 	putRequest := wifiportconfiguration.WiFiPortConfigurationServiceRequest{
-	// 		RequestedState:                     response.Body.WiFiPortConfigurationService.RequestedState,
-	// 		EnabledState:                       response.Body.WiFiPortConfigurationService.EnabledState,
-	// 		HealthState:                        response.Body.WiFiPortConfigurationService.HealthState,
-	// 		ElementName:                        response.Body.WiFiPortConfigurationService.ElementName,
-	// 		SystemCreationClassName:            response.Body.WiFiPortConfigurationService.SystemCreationClassName,
-	// 		SystemName:                         response.Body.WiFiPortConfigurationService.SystemName,
-	// 		CreationClassName:                  response.Body.WiFiPortConfigurationService.CreationClassName,
-	// 		Name:                               response.Body.WiFiPortConfigurationService.Name,
-	// 		LocalProfileSynchronizationEnabled: wifiportconfiguration.UnrestrictedSync,
-	// 		LastConnectedSsidUnderMeControl:    response.Body.WiFiPortConfigurationService.LastConnectedSsidUnderMeControl,
-	// 		NoHostCsmeSoftwarePolicy:           response.Body.WiFiPortConfigurationService.NoHostCsmeSoftwarePolicy,
-	// 		UEFIWiFiProfileShareEnabled:        response.Body.WiFiPortConfigurationService.UEFIWiFiProfileShareEnabled,
-	// 	}
-	response, err := c.WsmanMessages.AMT.WiFiPortConfigurationService.Put(request)
-	if err != nil {
+// wifiPortConfigurationService is the body of a Put of AMT_WiFiPortConfigurationService. The request of the
+// library omits UEFIWiFiProfileShareEnabled when false and its Put fails whenever local profile synchronization
+// ends up disabled, so the message is built by the base of another AMT class given the class name.
+type wifiPortConfigurationService struct {
+	XMLName                            xml.Name                                                 `xml:"h:AMT_WiFiPortConfigurationService"`
+	H                                  string                                                   `xml:"xmlns:h,attr"`
+	ElementName                        string                                                   `xml:"h:ElementName,omitempty"`
+	SystemCreationClassName            string                                                   `xml:"h:SystemCreationClassName,omitempty"`
+	SystemName                         string                                                   `xml:"h:SystemName,omitempty"`
+	CreationClassName                  string                                                   `xml:"h:CreationClassName,omitempty"`
+	Name                               string                                                   `xml:"h:Name,omitempty"`
+	LocalProfileSynchronizationEnabled wifiportconfiguration.LocalProfileSynchronizationEnabled `xml:"h:localProfileSynchronizationEnabled"`
+	NoHostCsmeSoftwarePolicy           wifiportconfiguration.NoHostCsmeSoftwarePolicy           `xml:"h:NoHostCsmeSoftwarePolicy"`
+	UEFIWiFiProfileShareEnabled        bool                                                     `xml:"h:UEFIWiFiProfileShareEnabled"`
+}
+
+// PutWiFiPortConfigurationService updates the Wi-Fi port configuration of the device and returns it as updated.
+func (c *ConnectionEntry) PutWiFiPortConfigurationService(ctx context.Context, settings wifiportconfiguration.WiFiPortConfigurationServiceResponse) (wifiportconfiguration.WiFiPortConfigurationServiceResponse, error) {
+	defer c.bind(ctx)()
+
+	base := c.WsmanMessages.AMT.PublicKeyManagementService.Base
+	base.ClassName = wifiportconfiguration.AMTWiFiPortConfigurationService
+
+	msg := &client.Message{XMLInput: base.Put(&wifiPortConfigurationService{
+		ElementName:                        settings.ElementName,
+		SystemCreationClassName:            settings.SystemCreationClassName,
+		SystemName:                         settings.SystemName,
+		CreationClassName:                  settings.CreationClassName,
+		Name:                               settings.Name,
+		LocalProfileSynchronizationEnabled: settings.LocalProfileSynchronizationEnabled,
+		NoHostCsmeSoftwarePolicy:           settings.NoHostCsmeSoftwarePolicy,
+		UEFIWiFiProfileShareEnabled:        settings.UEFIWiFiProfileShareEnabled,
+	}, false, nil)}
+
+	if err := base.Execute(msg); err != nil {
+		return wifiportconfiguration.WiFiPortConfigurationServiceResponse{}, err
+	}
+
+	var response wifiportconfiguration.Response
+	if err := xml.Unmarshal([]byte(msg.XMLOutput), &response); err != nil {
 		return wifiportconfiguration.WiFiPortConfigurationServiceResponse{}, err
 	}
 
 	return response.Body.WiFiPortConfigurationService, nil
 }
 
+// GetKerberosSettingData returns the Kerberos authentication settings of the device.
+func (c *ConnectionEntry) GetKerberosSettingData(ctx context.Context) (kerberos.KerberosSettingDataResponse, error) {
+	defer c.bind(ctx)()
+
+	response, err := c.WsmanMessages.AMT.KerberosSettingData.Get()
+	if err != nil {
+		return kerberos.KerberosSettingDataResponse{}, err
+	}
+
+	return response.Body.GetResponse, nil
+}
+
+// kerberosSettingData is the body of a Put of AMT_KerberosSettingData, which the library cannot update. AMT
+// expects the master key as an octet string and KrbEnabled even when false.
+type kerberosSettingData struct {
+	XMLName               xml.Name `xml:"h:AMT_KerberosSettingData"`
+	H                     string   `xml:"xmlns:h,attr"`
+	ElementName           string   `xml:"h:ElementName,omitempty"`
+	InstanceID            string   `xml:"h:InstanceID,omitempty"`
+	RealmName             string   `xml:"h:RealmName,omitempty"`
+	KeyVersion            int      `xml:"h:KeyVersion,omitempty"`
+	EncryptionAlgorithm   int      `xml:"h:EncryptionAlgorithm"`
+	MasterKey             string   `xml:"h:MasterKey,omitempty"`
+	MaximumClockTolerance int      `xml:"h:MaximumClockTolerance,omitempty"`
+	KrbEnabled            bool     `xml:"h:KrbEnabled"`
+	Passphrase            string   `xml:"h:Passphrase,omitempty"`
+	Salt                  string   `xml:"h:Salt,omitempty"`
+	IterationCount        int      `xml:"h:IterationCount,omitempty"`
+}
+
+// String returns the InstanceID selecting the settings to update.
+func (k kerberosSettingData) String() string {
+	return k.InstanceID
+}
+
+// PutKerberosSettingData updates the Kerberos authentication settings of the device and returns them as updated.
+// settings.MasterKey holds the bytes of the RC4-HMAC key, it is only sent when set.
+func (c *ConnectionEntry) PutKerberosSettingData(ctx context.Context, settings kerberos.KerberosSettingDataResponse) (kerberos.KerberosSettingDataResponse, error) {
+	defer c.bind(ctx)()
+
+	var masterKey []byte
+
+	for _, b := range settings.MasterKey {
+		masterKey = append(masterKey, byte(b))
+	}
+
+	base := c.WsmanMessages.AMT.KerberosSettingData.Base
+
+	msg := &client.Message{XMLInput: base.Put(&kerberosSettingData{
+		ElementName:           settings.ElementName,
+		InstanceID:            settings.InstanceID,
+		RealmName:             settings.RealmName,
+		KeyVersion:            settings.KeyVersion,
+		EncryptionAlgorithm:   int(settings.EncryptionAlgorithm),
+		MasterKey:             base64.StdEncoding.EncodeToString(masterKey),
+		MaximumClockTolerance: settings.MaximumClockTolerance,
+		KrbEnabled:            settings.KrbEnabled,
+		Passphrase:            settings.Passphrase,
+		Salt:                  settings.Salt,
+		IterationCount:        settings.IterationCount,
+	}, true, nil)}
+
+	if err := base.Execute(msg); err != nil {
+		return kerberos.KerberosSettingDataResponse{}, err
+	}
+
+	var response kerberos.Response
+	if err := xml.Unmarshal([]byte(msg.XMLOutput), &response); err != nil {
+		return kerberos.KerberosSettingDataResponse{}, err
+	}
+
+	return response.Body.GetResponse, nil
+}
+
 func (c *ConnectionEntry) WiFiRequestStateChange() (err error) {
 	// always turn wifi on via state change request
 	// Enumeration 32769 - WiFi is enabled in S0 + Sx/AC
@@ -1002,7 +1430,9 @@ func (c *ConnectionEntry) GetIpsOptInService() (response optin.Response, err err
 	return c.WsmanMessages.IPS.OptInService.Get()
 }
 
-func (c *ConnectionEntry) GetIPSIEEE8021xSettings() (response ipsIEEE8021x.Response, err error) {
+func (c *ConnectionEntry) GetIPSIEEE8021xSettings(ctx context.Context) (response ipsIEEE8021x.Response, err error) {
+	defer c.bind(ctx)()
+
 	return c.WsmanMessages.IPS.IEEE8021xSettings.Get()
 }
 
@@ -1020,7 +1450,9 @@ type InterfaceTypes struct {
 	hasWireless bool
 }
 
-func (c *ConnectionEntry) GetCIMIEEE8021xSettings() (response cimIEEE8021x.Response, err error) {
+func (c *ConnectionEntry) GetCIMIEEE8021xSettings(ctx context.Context) (response cimIEEE8021x.Response, err error) {
+	defer c.bind(ctx)()
+
 	response, err = c.WsmanMessages.CIM.IEEE8021xSettings.Enumerate()
 	if err != nil {
 		return cimIEEE8021x.Response{}, err
@@ -1034,7 +1466,10 @@ func (c *ConnectionEntry) GetCIMIEEE8021xSettings() (response cimIEEE8021x.Respo
 	return response, nil
 }
 
-func (c *ConnectionEntry) GetNetworkSettings() (NetworkResults, error) {
+// GetNetworkSettings fetches the settings of the given NetworkInterfaces, all of them when none is given.
+func (c *ConnectionEntry) GetNetworkSettings(ctx context.Context, interfaces ...string) (NetworkResults, error) {
+	defer c.bind(ctx)()
+
 	networkResults := NetworkResults{}
 
 	var err error
@@ -1046,8 +1481,8 @@ func (c *ConnectionEntry) GetNetworkSettings() (NetworkResults, error) {
 
 	networkResults.NetworkInterfaces = c.determineInterfaceTypes(networkResults.EthernetPortSettingsResult)
 
-	if networkResults.NetworkInterfaces.hasWired {
-		response, err := c.GetIPSIEEE8021xSettings()
+	if networkResults.NetworkInterfaces.hasWired && selected(interfaces, InterfaceWired) {
+		response, err := c.GetIPSIEEE8021xSettings(ctx)
 		if err != nil {
 			return networkResults, err
 		}
@@ -1055,13 +1490,13 @@ func (c *ConnectionEntry) GetNetworkSettings() (NetworkResults, error) {
 		networkResults.IPSIEEE8021xSettingsResult = response.Body.IEEE8021xSettingsResponse
 	}
 
-	if networkResults.NetworkInterfaces.hasWireless {
+	if networkResults.NetworkInterfaces.hasWireless && selected(interfaces, InterfaceWireless) {
 		networkResults.WiFiSettingsResult, err = c.GetWiFiSettings()
 		if err != nil {
 			return networkResults, err
 		}
 
-		cimResponse, err := c.GetCIMIEEE8021xSettings()
+		cimResponse, err := c.GetCIMIEEE8021xSettings(ctx)
 		if err != nil {
 			return networkResults, err
 		}
@@ -1095,7 +1530,9 @@ func (c *ConnectionEntry) determineInterfaceTypes(ethernetSettings []ethernetpor
 }
 
 // AMT Explorer Functions.
-func (c *ConnectionEntry) GetAMT8021xCredentialContext() (ieee8021x.Response, error) {
+func (c *ConnectionEntry) GetAMT8021xCredentialContext(ctx context.Context) (ieee8021x.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.IEEE8021xCredentialContext.Enumerate()
 	if err != nil {
 		return ieee8021x.Response{}, err
@@ -1109,7 +1546,9 @@ func (c *ConnectionEntry) GetAMT8021xCredentialContext() (ieee8021x.Response, er
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMT8021xProfile() (ieee8021x.Response, error) {
+func (c *ConnectionEntry) GetAMT8021xProfile(ctx context.Context) (ieee8021x.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.IEEE8021xProfile.Enumerate()
 	if err != nil {
 		return ieee8021x.Response{}, err
@@ -1123,7 +1562,9 @@ func (c *ConnectionEntry) GetAMT8021xProfile() (ieee8021x.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTAlarmClockService() (amtAlarmClock.Response, error) {
+func (c *ConnectionEntry) GetAMTAlarmClockService(ctx context.Context) (amtAlarmClock.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.AlarmClockService.Enumerate()
 	if err != nil {
 		return amtAlarmClock.Response{}, err
@@ -1137,7 +1578,9 @@ func (c *ConnectionEntry) GetAMTAlarmClockService() (amtAlarmClock.Response, err
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTAuditLog() (auditlog.Response, error) {
+func (c *ConnectionEntry) GetAMTAuditLog(ctx context.Context) (auditlog.Response, error) {
+	defer c.bind(ctx)()
+
 	readrecords, err := c.WsmanMessages.AMT.AuditLog.ReadRecords(1)
 	if err != nil {
 		return auditlog.Response{}, err
@@ -1146,7 +1589,9 @@ func (c *ConnectionEntry) GetAMTAuditLog() (auditlog.Response, error) {
 	return readrecords, nil
 }
 
-func (c *ConnectionEntry) GetAMTAuthorizationService() (authorization.Response, error) {
+func (c *ConnectionEntry) GetAMTAuthorizationService(ctx context.Context) (authorization.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.AuthorizationService.Enumerate()
 	if err != nil {
 		return authorization.Response{}, err
@@ -1160,7 +1605,9 @@ func (c *ConnectionEntry) GetAMTAuthorizationService() (authorization.Response,
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTBootCapabilities() (boot.Response, error) {
+func (c *ConnectionEntry) GetAMTBootCapabilities(ctx context.Context) (boot.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.BootCapabilities.Enumerate()
 	if err != nil {
 		return boot.Response{}, err
@@ -1174,7 +1621,9 @@ func (c *ConnectionEntry) GetAMTBootCapabilities() (boot.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTBootSettingData() (boot.Response, error) {
+func (c *ConnectionEntry) GetAMTBootSettingData(ctx context.Context) (boot.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.BootSettingData.Enumerate()
 	if err != nil {
 		return boot.Response{}, err
@@ -1188,7 +1637,9 @@ func (c *ConnectionEntry) GetAMTBootSettingData() (boot.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTEnvironmentDetectionSettingData() (environmentdetection.Response, error) {
+func (c *ConnectionEntry) GetAMTEnvironmentDetectionSettingData(ctx context.Context) (environmentdetection.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.EnvironmentDetectionSettingData.Enumerate()
 	if err != nil {
 		return environmentdetection.Response{}, err
@@ -1202,7 +1653,9 @@ func (c *ConnectionEntry) GetAMTEnvironmentDetectionSettingData() (environmentde
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTEthernetPortSettings() (ethernetport.Response, error) {
+func (c *ConnectionEntry) GetAMTEthernetPortSettings(ctx context.Context) (ethernetport.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.EthernetPortSettings.Enumerate()
 	if err != nil {
 		return ethernetport.Response{}, err
@@ -1216,7 +1669,9 @@ func (c *ConnectionEntry) GetAMTEthernetPortSettings() (ethernetport.Response, e
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTGeneralSettings() (general.Response, error) {
+func (c *ConnectionEntry) GetAMTGeneralSettings(ctx context.Context) (general.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.AMT.GeneralSettings.Get()
 	if err != nil {
 		return general.Response{}, err
@@ -1225,7 +1680,9 @@ func (c *ConnectionEntry) GetAMTGeneralSettings() (general.Response, error) {
 	return get, nil
 }
 
-func (c *ConnectionEntry) GetAMTKerberosSettingData() (kerberos.Response, error) {
+func (c *ConnectionEntry) GetAMTKerberosSettingData(ctx context.Context) (kerberos.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.KerberosSettingData.Enumerate()
 	if err != nil {
 		return kerberos.Response{}, err
@@ -1239,7 +1696,9 @@ func (c *ConnectionEntry) GetAMTKerberosSettingData() (kerberos.Response, error)
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTManagementPresenceRemoteSAP() (managementpresence.Response, error) {
+func (c *ConnectionEntry) GetAMTManagementPresenceRemoteSAP(ctx context.Context) (managementpresence.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.ManagementPresenceRemoteSAP.Enumerate()
 	if err != nil {
 		return managementpresence.Response{}, err
@@ -1253,7 +1712,9 @@ func (c *ConnectionEntry) GetAMTManagementPresenceRemoteSAP() (managementpresenc
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTMessageLog() (messagelog.Response, error) {
+func (c *ConnectionEntry) GetAMTMessageLog(ctx context.Context) (messagelog.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.AMT.MessageLog.GetRecords(1, maxReadRecords)
 	if err != nil {
 		return messagelog.Response{}, err
@@ -1262,7 +1723,9 @@ func (c *ConnectionEntry) GetAMTMessageLog() (messagelog.Response, error) {
 	return get, nil
 }
 
-func (c *ConnectionEntry) GetAMTMPSUsernamePassword() (mps.Response, error) {
+func (c *ConnectionEntry) GetAMTMPSUsernamePassword(ctx context.Context) (mps.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.MPSUsernamePassword.Enumerate()
 	if err != nil {
 		return mps.Response{}, err
@@ -1276,7 +1739,9 @@ func (c *ConnectionEntry) GetAMTMPSUsernamePassword() (mps.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTPublicKeyCertificate() (publickey.Response, error) {
+func (c *ConnectionEntry) GetAMTPublicKeyCertificate(ctx context.Context) (publickey.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.PublicKeyCertificate.Enumerate()
 	if err != nil {
 		return publickey.Response{}, err
@@ -1290,7 +1755,9 @@ func (c *ConnectionEntry) GetAMTPublicKeyCertificate() (publickey.Response, erro
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTPublicKeyManagementService() (publickey.Response, error) {
+func (c *ConnectionEntry) GetAMTPublicKeyManagementService(ctx context.Context) (publickey.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.AMT.PublicKeyManagementService.Get()
 	if err != nil {
 		return publickey.Response{}, err
@@ -1299,7 +1766,9 @@ func (c *ConnectionEntry) GetAMTPublicKeyManagementService() (publickey.Response
 	return get, nil
 }
 
-func (c *ConnectionEntry) GetAMTPublicPrivateKeyPair() (publicprivate.Response, error) {
+func (c *ConnectionEntry) GetAMTPublicPrivateKeyPair(ctx context.Context) (publicprivate.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.PublicPrivateKeyPair.Enumerate()
 	if err != nil {
 		return publicprivate.Response{}, err
@@ -1313,7 +1782,9 @@ func (c *ConnectionEntry) GetAMTPublicPrivateKeyPair() (publicprivate.Response,
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTRedirectionService() (redirection.Response, error) {
+func (c *ConnectionEntry) GetAMTRedirectionService(ctx context.Context) (redirection.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.AMT.RedirectionService.Get()
 	if err != nil {
 		return redirection.Response{}, err
@@ -1322,7 +1793,9 @@ func (c *ConnectionEntry) GetAMTRedirectionService() (redirection.Response, erro
 	return get, nil
 }
 
-func (c *ConnectionEntry) SetAMTRedirectionService(request *redirection.RedirectionRequest) (redirection.Response, error) {
+func (c *ConnectionEntry) SetAMTRedirectionService(ctx context.Context, request *redirection.RedirectionRequest) (redirection.Response, error) {
+	defer c.bind(ctx)()
+
 	response, err := c.WsmanMessages.AMT.RedirectionService.Put(request)
 	if err != nil {
 		return redirection.Response{}, err
@@ -1331,7 +1804,9 @@ func (c *ConnectionEntry) SetAMTRedirectionService(request *redirection.Redirect
 	return response, nil
 }
 
-func (c *ConnectionEntry) GetAMTRemoteAccessPolicyAppliesToMPS() (remoteaccess.Response, error) {
+func (c *ConnectionEntry) GetAMTRemoteAccessPolicyAppliesToMPS(ctx context.Context) (remoteaccess.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.RemoteAccessPolicyAppliesToMPS.Enumerate()
 	if err != nil {
 		return remoteaccess.Response{}, err
@@ -1345,7 +1820,9 @@ func (c *ConnectionEntry) GetAMTRemoteAccessPolicyAppliesToMPS() (remoteaccess.R
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTRemoteAccessPolicyRule() (remoteaccess.Response, error) {
+func (c *ConnectionEntry) GetAMTRemoteAccessPolicyRule(ctx context.Context) (remoteaccess.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.RemoteAccessPolicyRule.Enumerate()
 	if err != nil {
 		return remoteaccess.Response{}, err
@@ -1359,7 +1836,9 @@ func (c *ConnectionEntry) GetAMTRemoteAccessPolicyRule() (remoteaccess.Response,
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTRemoteAccessService() (remoteaccess.Response, error) {
+func (c *ConnectionEntry) GetAMTRemoteAccessService(ctx context.Context) (remoteaccess.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.AMT.RemoteAccessService.Get()
 	if err != nil {
 		return remoteaccess.Response{}, err
@@ -1368,7 +1847,9 @@ func (c *ConnectionEntry) GetAMTRemoteAccessService() (remoteaccess.Response, er
 	return get, nil
 }
 
-func (c *ConnectionEntry) GetAMTSetupAndConfigurationService() (setupandconfiguration.Response, error) {
+func (c *ConnectionEntry) GetAMTSetupAndConfigurationService(ctx context.Context) (setupandconfiguration.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.AMT.SetupAndConfigurationService.Get()
 	if err != nil {
 		return setupandconfiguration.Response{}, err
@@ -1377,7 +1858,9 @@ func (c *ConnectionEntry) GetAMTSetupAndConfigurationService() (setupandconfigur
 	return get, nil
 }
 
-func (c *ConnectionEntry) GetAMTTimeSynchronizationService() (timesynchronization.Response, error) {
+func (c *ConnectionEntry) GetAMTTimeSynchronizationService(ctx context.Context) (timesynchronization.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.AMT.TimeSynchronizationService.Get()
 	if err != nil {
 		return timesynchronization.Response{}, err
@@ -1386,7 +1869,9 @@ func (c *ConnectionEntry) GetAMTTimeSynchronizationService() (timesynchronizatio
 	return get, nil
 }
 
-func (c *ConnectionEntry) GetAMTTLSCredentialContext() (tls.Response, error) {
+func (c *ConnectionEntry) GetAMTTLSCredentialContext(ctx context.Context) (tls.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.TLSCredentialContext.Enumerate()
 	if err != nil {
 		return tls.Response{}, err
@@ -1400,7 +1885,9 @@ func (c *ConnectionEntry) GetAMTTLSCredentialContext() (tls.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTTLSProtocolEndpointCollection() (tls.Response, error) {
+func (c *ConnectionEntry) GetAMTTLSProtocolEndpointCollection(ctx context.Context) (tls.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.TLSProtocolEndpointCollection.Enumerate()
 	if err != nil {
 		return tls.Response{}, err
@@ -1414,7 +1901,9 @@ func (c *ConnectionEntry) GetAMTTLSProtocolEndpointCollection() (tls.Response, e
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTTLSSettingData() (tls.Response, error) {
+func (c *ConnectionEntry) GetAMTTLSSettingData(ctx context.Context) (tls.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.TLSSettingData.Enumerate()
 	if err != nil {
 		return tls.Response{}, err
@@ -1428,7 +1917,9 @@ func (c *ConnectionEntry) GetAMTTLSSettingData() (tls.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetAMTUserInitiatedConnectionService() (userinitiatedconnection.Response, error) {
+func (c *ConnectionEntry) GetAMTUserInitiatedConnectionService(ctx context.Context) (userinitiatedconnection.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.AMT.UserInitiatedConnectionService.Get()
 	if err != nil {
 		return userinitiatedconnection.Response{}, err
@@ -1437,7 +1928,9 @@ func (c *ConnectionEntry) GetAMTUserInitiatedConnectionService() (userinitiatedc
 	return get, nil
 }
 
-func (c *ConnectionEntry) GetAMTWiFiPortConfigurationService() (wifiportconfiguration.Response, error) {
+func (c *ConnectionEntry) GetAMTWiFiPortConfigurationService(ctx context.Context) (wifiportconfiguration.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.AMT.WiFiPortConfigurationService.Enumerate()
 	if err != nil {
 		return wifiportconfiguration.Response{}, err
@@ -1451,7 +1944,9 @@ func (c *ConnectionEntry) GetAMTWiFiPortConfigurationService() (wifiportconfigur
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMBIOSElement() (bios.Response, error) {
+func (c *ConnectionEntry) GetCIMBIOSElement(ctx context.Context) (bios.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.BIOSElement.Enumerate()
 	if err != nil {
 		return bios.Response{}, err
@@ -1465,7 +1960,9 @@ func (c *ConnectionEntry) GetCIMBIOSElement() (bios.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMBootConfigSetting() (cimBoot.Response, error) {
+func (c *ConnectionEntry) GetCIMBootConfigSetting(ctx context.Context) (cimBoot.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.BootConfigSetting.Enumerate()
 	if err != nil {
 		return cimBoot.Response{}, err
@@ -1479,7 +1976,9 @@ func (c *ConnectionEntry) GetCIMBootConfigSetting() (cimBoot.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMBootService() (cimBoot.Response, error) {
+func (c *ConnectionEntry) GetCIMBootService(ctx context.Context) (cimBoot.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.BootService.Enumerate()
 	if err != nil {
 		return cimBoot.Response{}, err
@@ -1493,7 +1992,9 @@ func (c *ConnectionEntry) GetCIMBootService() (cimBoot.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMBootSourceSetting() (cimBoot.Response, error) {
+func (c *ConnectionEntry) GetCIMBootSourceSetting(ctx context.Context) (cimBoot.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.BootSourceSetting.Enumerate()
 	if err != nil {
 		return cimBoot.Response{}, err
@@ -1507,7 +2008,9 @@ func (c *ConnectionEntry) GetCIMBootSourceSetting() (cimBoot.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMCard() (card.Response, error) {
+func (c *ConnectionEntry) GetCIMCard(ctx context.Context) (card.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.Card.Enumerate()
 	if err != nil {
 		return card.Response{}, err
@@ -1521,7 +2024,9 @@ func (c *ConnectionEntry) GetCIMCard() (card.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMChassis() (chassis.Response, error) {
+func (c *ConnectionEntry) GetCIMChassis(ctx context.Context) (chassis.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.Chassis.Enumerate()
 	if err != nil {
 		return chassis.Response{}, err
@@ -1535,7 +2040,9 @@ func (c *ConnectionEntry) GetCIMChassis() (chassis.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMChip() (chip.Response, error) {
+func (c *ConnectionEntry) GetCIMChip(ctx context.Context) (chip.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.Chip.Enumerate()
 	if err != nil {
 		return chip.Response{}, err
@@ -1549,7 +2056,9 @@ func (c *ConnectionEntry) GetCIMChip() (chip.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMComputerSystemPackage() (computer.Response, error) {
+func (c *ConnectionEntry) GetCIMComputerSystemPackage(ctx context.Context) (computer.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.ComputerSystemPackage.Enumerate()
 	if err != nil {
 		return computer.Response{}, err
@@ -1563,7 +2072,9 @@ func (c *ConnectionEntry) GetCIMComputerSystemPackage() (computer.Response, erro
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMConcreteDependency() (concrete.Response, error) {
+func (c *ConnectionEntry) GetCIMConcreteDependency(ctx context.Context) (concrete.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.ConcreteDependency.Enumerate()
 	if err != nil {
 		return concrete.Response{}, err
@@ -1577,7 +2088,9 @@ func (c *ConnectionEntry) GetCIMConcreteDependency() (concrete.Response, error)
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMCredentialContext() (credential.Response, error) {
+func (c *ConnectionEntry) GetCIMCredentialContext(ctx context.Context) (credential.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.CredentialContext.Enumerate()
 	if err != nil {
 		return credential.Response{}, err
@@ -1591,7 +2104,9 @@ func (c *ConnectionEntry) GetCIMCredentialContext() (credential.Response, error)
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMKVMRedirectionSAP() (kvm.Response, error) {
+func (c *ConnectionEntry) GetCIMKVMRedirectionSAP(ctx context.Context) (kvm.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.KVMRedirectionSAP.Enumerate()
 	if err != nil {
 		return kvm.Response{}, err
@@ -1605,7 +2120,9 @@ func (c *ConnectionEntry) GetCIMKVMRedirectionSAP() (kvm.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMMediaAccessDevice() (mediaaccess.Response, error) {
+func (c *ConnectionEntry) GetCIMMediaAccessDevice(ctx context.Context) (mediaaccess.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.MediaAccessDevice.Enumerate()
 	if err != nil {
 		return mediaaccess.Response{}, err
@@ -1619,7 +2136,9 @@ func (c *ConnectionEntry) GetCIMMediaAccessDevice() (mediaaccess.Response, error
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMPhysicalMemory() (physical.Response, error) {
+func (c *ConnectionEntry) GetCIMPhysicalMemory(ctx context.Context) (physical.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.PhysicalMemory.Enumerate()
 	if err != nil {
 		return physical.Response{}, err
@@ -1633,7 +2152,9 @@ func (c *ConnectionEntry) GetCIMPhysicalMemory() (physical.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMPhysicalPackage() (physical.Response, error) {
+func (c *ConnectionEntry) GetCIMPhysicalPackage(ctx context.Context) (physical.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.PhysicalPackage.Enumerate()
 	if err != nil {
 		return physical.Response{}, err
@@ -1647,7 +2168,9 @@ func (c *ConnectionEntry) GetCIMPhysicalPackage() (physical.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMPowerManagementService() (power.Response, error) {
+func (c *ConnectionEntry) GetCIMPowerManagementService(ctx context.Context) (power.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.CIM.PowerManagementService.Get()
 	if err != nil {
 		return power.Response{}, err
@@ -1656,7 +2179,9 @@ func (c *ConnectionEntry) GetCIMPowerManagementService() (power.Response, error)
 	return get, nil
 }
 
-func (c *ConnectionEntry) GetCIMProcessor() (processor.Response, error) {
+func (c *ConnectionEntry) GetCIMProcessor(ctx context.Context) (processor.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.Processor.Enumerate()
 	if err != nil {
 		return processor.Response{}, err
@@ -1670,7 +2195,9 @@ func (c *ConnectionEntry) GetCIMProcessor() (processor.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMServiceAvailableToElement() (service.Response, error) {
+func (c *ConnectionEntry) GetCIMServiceAvailableToElement(ctx context.Context) (service.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.ServiceAvailableToElement.Enumerate()
 	if err != nil {
 		return service.Response{}, err
@@ -1684,7 +2211,9 @@ func (c *ConnectionEntry) GetCIMServiceAvailableToElement() (service.Response, e
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMSoftwareIdentity() (software.Response, error) {
+func (c *ConnectionEntry) GetCIMSoftwareIdentity(ctx context.Context) (software.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.SoftwareIdentity.Enumerate()
 	if err != nil {
 		return software.Response{}, err
@@ -1698,7 +2227,9 @@ func (c *ConnectionEntry) GetCIMSoftwareIdentity() (software.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMSystemPackaging() (system.Response, error) {
+func (c *ConnectionEntry) GetCIMSystemPackaging(ctx context.Context) (system.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.SystemPackaging.Enumerate()
 	if err != nil {
 		return system.Response{}, err
@@ -1712,7 +2243,9 @@ func (c *ConnectionEntry) GetCIMSystemPackaging() (system.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMWiFiEndpointSettings() (wifi.Response, error) {
+func (c *ConnectionEntry) GetCIMWiFiEndpointSettings(ctx context.Context) (wifi.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.WiFiEndpointSettings.Enumerate()
 	if err != nil {
 		return wifi.Response{}, err
@@ -1726,7 +2259,9 @@ func (c *ConnectionEntry) GetCIMWiFiEndpointSettings() (wifi.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetCIMWiFiPort() (wifi.Response, error) {
+func (c *ConnectionEntry) GetCIMWiFiPort(ctx context.Context) (wifi.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.CIM.WiFiPort.Enumerate()
 	if err != nil {
 		return wifi.Response{}, err
@@ -1740,7 +2275,9 @@ func (c *ConnectionEntry) GetCIMWiFiPort() (wifi.Response, error) {
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetIPS8021xCredentialContext() (ipsIEEE8021x.Response, error) {
+func (c *ConnectionEntry) GetIPS8021xCredentialContext(ctx context.Context) (ipsIEEE8021x.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.IPS.IEEE8021xCredentialContext.Enumerate()
 	if err != nil {
 		return ipsIEEE8021x.Response{}, err
@@ -1754,7 +2291,9 @@ func (c *ConnectionEntry) GetIPS8021xCredentialContext() (ipsIEEE8021x.Response,
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetIPSAlarmClockOccurrence() (ipsAlarmClock.Response, error) {
+func (c *ConnectionEntry) GetIPSAlarmClockOccurrence(ctx context.Context) (ipsAlarmClock.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.IPS.AlarmClockOccurrence.Enumerate()
 	if err != nil {
 		return ipsAlarmClock.Response{}, err
@@ -1768,7 +2307,9 @@ func (c *ConnectionEntry) GetIPSAlarmClockOccurrence() (ipsAlarmClock.Response,
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetIPSHostBasedSetupService() (hostbasedsetup.Response, error) {
+func (c *ConnectionEntry) GetIPSHostBasedSetupService(ctx context.Context) (hostbasedsetup.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.IPS.HostBasedSetupService.Get()
 	if err != nil {
 		return hostbasedsetup.Response{}, err
@@ -1777,7 +2318,9 @@ func (c *ConnectionEntry) GetIPSHostBasedSetupService() (hostbasedsetup.Response
 	return get, nil
 }
 
-func (c *ConnectionEntry) GetIPSOptInService() (optin.Response, error) {
+func (c *ConnectionEntry) GetIPSOptInService(ctx context.Context) (optin.Response, error) {
+	defer c.bind(ctx)()
+
 	get, err := c.WsmanMessages.IPS.OptInService.Get()
 	if err != nil {
 		return optin.Response{}, err
@@ -1786,7 +2329,9 @@ func (c *ConnectionEntry) GetIPSOptInService() (optin.Response, error) {
 	return get, nil
 }
 
-func (c *ConnectionEntry) SetIPSOptInService(request optin.OptInServiceRequest) error {
+func (c *ConnectionEntry) SetIPSOptInService(ctx context.Context, request optin.OptInServiceRequest) error {
+	defer c.bind(ctx)()
+
 	_, err := c.WsmanMessages.IPS.OptInService.Put(request)
 	if err != nil {
 		return err
@@ -1802,7 +2347,9 @@ type Certificates struct {
 	CIMCredentialContextResponse credential.PullResponse
 }
 
-func (c *ConnectionEntry) GetCertificates() (Certificates, error) {
+func (c *ConnectionEntry) GetCertificates(ctx context.Context) (Certificates, error) {
+	defer c.bind(ctx)()
+
 	concreteDepEnumResp, err := c.WsmanMessages.CIM.ConcreteDependency.Enumerate()
 	if err != nil {
 		return Certificates{}, err
@@ -1853,7 +2400,9 @@ func (c *ConnectionEntry) GetCertificates() (Certificates, error) {
 	return certificates, nil
 }
 
-func (c *ConnectionEntry) GetTLSSettingData() ([]tls.SettingDataResponse, error) {
+func (c *ConnectionEntry) GetTLSSettingData(ctx context.Context) ([]tls.SettingDataResponse, error) {
+	defer c.bind(ctx)()
+
 	tlsSettingDataEnumResp, err := c.WsmanMessages.AMT.TLSSettingData.Enumerate()
 	if err != nil {
 		return nil, err
@@ -1867,7 +2416,30 @@ func (c *ConnectionEntry) GetTLSSettingData() ([]tls.SettingDataResponse, error)
 	return tlsSettingDataResponse.Body.PullResponse.SettingDataItems, nil
 }
 
-func (c *ConnectionEntry) GetIPSKVMRedirectionSettings() (kvmredirection.Response, error) {
+// PutTLSSettingData replaces the TLS settings of an interface, named by their InstanceID, and commits them, AMT
+// applying them to the connections opened from then on.
+func (c *ConnectionEntry) PutTLSSettingData(ctx context.Context, settings tls.SettingDataRequest) error {
+	defer c.bind(ctx)()
+
+	if _, err := c.WsmanMessages.AMT.TLSSettingData.Put(settings.InstanceID, settings); err != nil {
+		return err
+	}
+
+	response, err := c.WsmanMessages.AMT.SetupAndConfigurationService.CommitChanges()
+	if err != nil {
+		return err
+	}
+
+	if rv := int(response.Body.CommitChanges_OUTPUT.ReturnValue); rv != 0 {
+		return amtstatus.Error{Operation: "CommitChanges", Explanation: amtstatus.Explain(rv)}
+	}
+
+	return nil
+}
+
+func (c *ConnectionEntry) GetIPSKVMRedirectionSettings(ctx context.Context) (kvmredirection.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.IPS.KVMRedirectionSettingData.Enumerate()
 	if err != nil {
 		return kvmredirection.Response{}, err
@@ -1881,7 +2453,9 @@ func (c *ConnectionEntry) GetIPSKVMRedirectionSettings() (kvmredirection.Respons
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetIPSScreenSettingData() (screensetting.Response, error) {
+func (c *ConnectionEntry) GetIPSScreenSettingData(ctx context.Context) (screensetting.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.IPS.ScreenSettingData.Enumerate()
 	if err != nil {
 		return screensetting.Response{}, err
@@ -1895,7 +2469,9 @@ func (c *ConnectionEntry) GetIPSScreenSettingData() (screensetting.Response, err
 	return pull, nil
 }
 
-func (c *ConnectionEntry) GetIPSKVMRedirectionSettingData() (kvmredirection.Response, error) {
+func (c *ConnectionEntry) GetIPSKVMRedirectionSettingData(ctx context.Context) (kvmredirection.Response, error) {
+	defer c.bind(ctx)()
+
 	enum, err := c.WsmanMessages.IPS.KVMRedirectionSettingData.Enumerate()
 	if err != nil {
 		return kvmredirection.Response{}, err
@@ -1916,7 +2492,9 @@ func (c *ConnectionEntry) GetIPSKVMRedirectionSettingData() (kvmredirection.Resp
 	return pull, nil
 }
 
-func (c *ConnectionEntry) SetIPSKVMRedirectionSettingData(req *kvmredirection.KVMRedirectionSettingsRequest) (kvmredirection.Response, error) {
+func (c *ConnectionEntry) SetIPSKVMRedirectionSettingData(ctx context.Context, req *kvmredirection.KVMRedirectionSettingsRequest) (kvmredirection.Response, error) {
+	defer c.bind(ctx)()
+
 	return c.WsmanMessages.IPS.KVMRedirectionSettingData.Put(req)
 }
 
@@ -1924,7 +2502,9 @@ func (c *ConnectionEntry) SetIPSKVMRedirectionSettingData(req *kvmredirection.KV
 // linkPreference: 1 for ME, 2 for Host
 // timeout: timeout in seconds
 // Returns the return value from the AMT device or an error.
-func (c *ConnectionEntry) SetLinkPreference(linkPreference, timeout uint32) (int, error) {
+func (c *ConnectionEntry) SetLinkPreference(ctx context.Context, linkPreference, timeout uint32) (int, error) {
+	defer c.bind(ctx)()
+
 	// Get all ethernet port settings to find WiFi interface
 	enumResponse, err := c.WsmanMessages.AMT.EthernetPortSettings.Enumerate()
 	if err != nil {
@@ -0,0 +1,74 @@
+package wsman
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// go test -run ^$ -bench . -benchmem ./internal/usecase/devices/wsman
+
+var simulatedFleetSizes = []int{1, 10, 100}
+
+func BenchmarkSetupWsmanClient(b *testing.B) {
+	b.Run("new connection", func(b *testing.B) {
+		g := startWorker(b)
+
+		devices := make([]entity.Device, b.N)
+		for i := range devices {
+			devices[i] = simulatedDevice(i)
+		}
+
+		b.Cleanup(func() {
+			for i := range devices {
+				g.DestroyWsmanClient(dto.Device{GUID: devices[i].GUID})
+			}
+		})
+
+		b.ResetTimer()
+
+		for i := range devices {
+			if _, err := g.SetupWsmanClient(devices[i], false, false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	for _, n := range simulatedFleetSizes {
+		b.Run(fmt.Sprintf("reused connection/%d devices", n), func(b *testing.B) {
+			simulateDevices(b, n, &simulatedAMT{})
+			g := startWorker(b)
+
+			b.ResetTimer()
+
+			for range b.N {
+				concurrently(n, func(i int) {
+					if _, err := g.SetupWsmanClient(simulatedDevice(i), false, false); err != nil {
+						b.Error(err)
+					}
+				})
+			}
+		})
+	}
+}
+
+func BenchmarkGetHardwareInfo(b *testing.B) {
+	for _, n := range simulatedFleetSizes {
+		b.Run(fmt.Sprintf("%d devices", n), func(b *testing.B) {
+			entries := simulateDevices(b, n, &simulatedAMT{})
+
+			b.ResetTimer()
+
+			for range b.N {
+				concurrently(n, func(i int) {
+					if _, err := entries[i].GetHardwareInfo(context.Background()); err != nil {
+						b.Error(err)
+					}
+				})
+			}
+		})
+	}
+}
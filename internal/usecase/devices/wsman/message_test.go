@@ -0,0 +1,147 @@
+package wsman
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/wifi"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
+)
+
+// answeringTransport records the body of the last request and answers it with response.
+type answeringTransport struct {
+	response string
+	request  string
+}
+
+func (a *answeringTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	a.request = string(body)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Header:     http.Header{"Content-Type": []string{"application/soap+xml; charset=UTF-8"}},
+		Body:       io.NopCloser(strings.NewReader(a.response)),
+		Request:    req,
+	}, nil
+}
+
+func answeringConnection(transport *answeringTransport) *ConnectionEntry {
+	return &ConnectionEntry{WsmanMessages: NewMessages(entity.Device{GUID: "guid"}, client.Parameters{
+		Target:    "device.example.com",
+		Username:  "admin",
+		Password:  "P@ssw0rd",
+		Transport: transport,
+	})}
+}
+
+func TestPutWiFiPortConfigurationService(t *testing.T) {
+	t.Parallel()
+
+	transport := &answeringTransport{response: `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_WiFiPortConfigurationService">` +
+		`<a:Body><h:AMT_WiFiPortConfigurationService><h:Name>Intel(r) AMT WiFiPort Configuration Service</h:Name>` +
+		`<h:localProfileSynchronizationEnabled>0</h:localProfileSynchronizationEnabled>` +
+		`<h:UEFIWiFiProfileShareEnabled>false</h:UEFIWiFiProfileShareEnabled></h:AMT_WiFiPortConfigurationService></a:Body></a:Envelope>`}
+
+	updated, err := answeringConnection(transport).PutWiFiPortConfigurationService(context.Background(), wifiportconfiguration.WiFiPortConfigurationServiceResponse{
+		Name:                               "Intel(r) AMT WiFiPort Configuration Service",
+		LocalProfileSynchronizationEnabled: wifiportconfiguration.LocalSyncDisabled,
+	})
+	require.NoError(t, err, "disabling local profile synchronization is not an error")
+	require.Equal(t, wifiportconfiguration.LocalSyncDisabled, updated.LocalProfileSynchronizationEnabled)
+
+	require.Contains(t, transport.request, "<w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_WiFiPortConfigurationService</w:ResourceURI>")
+	require.Contains(t, transport.request, `<h:AMT_WiFiPortConfigurationService xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_WiFiPortConfigurationService">`)
+	require.Contains(t, transport.request, "<h:localProfileSynchronizationEnabled>0</h:localProfileSynchronizationEnabled>")
+	require.Contains(t, transport.request, "<h:UEFIWiFiProfileShareEnabled>false</h:UEFIWiFiProfileShareEnabled>", "a disabled share is sent")
+}
+
+func TestPutWiFiEndpointSettings(t *testing.T) {
+	t.Parallel()
+
+	transport := &answeringTransport{response: simulatedResponse}
+
+	err := answeringConnection(transport).PutWiFiEndpointSettings(context.Background(), wifi.WiFiEndpointSettingsResponse{
+		InstanceID: "Intel(r) AMT:WiFi Endpoint Settings home",
+		SSID:       "home",
+		Priority:   2,
+	})
+	require.NoError(t, err)
+
+	require.Contains(t, transport.request, `<w:Selector Name="InstanceID">Intel(r) AMT:WiFi Endpoint Settings home</w:Selector>`, "the profile is selected")
+	require.Contains(t, transport.request, "<q:Priority>2</q:Priority>")
+}
+
+func TestPutKerberosSettingData(t *testing.T) {
+	t.Parallel()
+
+	transport := &answeringTransport{response: `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_KerberosSettingData">` +
+		`<a:Body><h:AMT_KerberosSettingData><h:InstanceID>Intel(r) AMT Kerberos Settings</h:InstanceID><h:RealmName>CORP.EXAMPLE.COM</h:RealmName>` +
+		`<h:KrbEnabled>false</h:KrbEnabled><h:ConfiguredEncryptionAlgorithms>0</h:ConfiguredEncryptionAlgorithms></h:AMT_KerberosSettingData></a:Body></a:Envelope>`}
+
+	updated, err := answeringConnection(transport).PutKerberosSettingData(context.Background(), kerberos.KerberosSettingDataResponse{
+		InstanceID: "Intel(r) AMT Kerberos Settings",
+		RealmName:  "CORP.EXAMPLE.COM",
+		KeyVersion: 2,
+		MasterKey:  []int{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "CORP.EXAMPLE.COM", updated.RealmName)
+	require.Equal(t, []kerberos.ConfiguredEncryptionAlgorithms{kerberos.ConfiguredEncryptionAlgorithmsRC4HMAC}, updated.ConfiguredEncryptionAlgorithms)
+
+	require.Contains(t, transport.request, `<w:Selector Name="InstanceID">Intel(r) AMT Kerberos Settings</w:Selector>`)
+	require.Contains(t, transport.request, "<h:MasterKey>ABEiM0RVZneImaq7zN3u/w==</h:MasterKey>", "the key is sent as an octet string")
+	require.Contains(t, transport.request, "<h:KrbEnabled>false</h:KrbEnabled>", "disabling Kerberos is sent")
+	require.NotContains(t, transport.request, "<h:Passphrase>")
+}
+
+func TestAddUserACLEntry(t *testing.T) {
+	t.Parallel()
+
+	transport := &answeringTransport{response: `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService">` +
+		`<a:Body><g:AddUserAclEntryEx_OUTPUT><g:Handle>7</g:Handle><g:ReturnValue>0</g:ReturnValue></g:AddUserAclEntryEx_OUTPUT></a:Body></a:Envelope>`}
+
+	handle, err := answeringConnection(transport).AddUserACLEntry(context.Background(), UserACLEntry{
+		KerberosUserSID:  []byte{1, 1, 0, 0, 0, 0, 0, 5, 18, 0, 0, 0},
+		AccessPermission: authorization.AccessPermissionNetworkAccessOnly,
+		Realms:           []authorization.RealmValues{authorization.RealmValuesRedirectionRealm, authorization.RealmValuesHardwareAssetRealm},
+	}, "")
+	require.NoError(t, err)
+	require.Equal(t, 7, handle)
+
+	require.Contains(t, transport.request, "AMT_AuthorizationService/AddUserAclEntryEx</a:Action>")
+	require.Contains(t, transport.request, "<h:KerberosUserSid>AQEAAAAAAAUSAAAA</h:KerberosUserSid>", "the SID is sent as an octet string")
+	require.Contains(t, transport.request, "<h:AccessPermission>1</h:AccessPermission><h:Realms>2</h:Realms><h:Realms>4</h:Realms>")
+	require.NotContains(t, transport.request, "<h:DigestUsername>")
+	require.NotContains(t, transport.request, "<h:DigestPassword>")
+}
+
+func TestRemoveUserACLEntryInvalidHandle(t *testing.T) {
+	t.Parallel()
+
+	transport := &answeringTransport{response: `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService">` +
+		`<a:Body><g:RemoveUserAclEntry_OUTPUT><g:ReturnValue>2053</g:ReturnValue></g:RemoveUserAclEntry_OUTPUT></a:Body></a:Envelope>`}
+
+	err := answeringConnection(transport).RemoveUserACLEntry(context.Background(), 9)
+	require.Equal(t, amtstatus.Error{Operation: "RemoveUserAclEntry", Explanation: amtstatus.Explain(2053)}, err)
+	require.Contains(t, transport.request, "<h:RemoveUserAclEntry_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService\"><h:Handle>9</h:Handle>")
+}
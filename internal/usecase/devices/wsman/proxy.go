@@ -0,0 +1,315 @@
+package wsman
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	gotls "crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"golang.org/x/net/proxy"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+)
+
+// proxyDirect is the proxy of the devices connected directly while a proxy is set for the others.
+const proxyDirect = "direct"
+
+const proxyDialTimeout = 30 * time.Second
+
+var (
+	// ErrProxyScheme is returned for a proxy URL other than http://, https:// or socks5://.
+	ErrProxyScheme = errors.New("proxy URL must start with http://, https:// or socks5://")
+	// ErrProxyRefused is returned when an HTTP proxy does not open a tunnel to the device.
+	ErrProxyRefused = errors.New("proxy refused to connect to the device")
+	// ErrCertificatePinning is returned when the certificate of a device does not match its pinned hash.
+	ErrCertificatePinning = errors.New("certificate pinning failed")
+	// ErrNoServerCertificate is returned when a device sends no TLS certificate.
+	ErrNoServerCertificate = errors.New("no server certificate captured")
+)
+
+var defaultProxyPorts = map[string]string{"http": "80", "https": "443", "socks5": "1080", "socks5h": "1080"}
+
+func currentProxy() config.WsmanProxy {
+	if config.ConsoleConfig == nil {
+		return config.WsmanProxy{}
+	}
+
+	return config.ConsoleConfig.WsmanProxy
+}
+
+// CheckProxy reports a proxy URL of the configuration that cannot be used. The devices it applies to are not
+// reached until it is fixed, rather than connected directly.
+func CheckProxy(cfg config.WsmanProxy) error {
+	for _, raw := range append([]string{cfg.URL}, mapValues(cfg.Devices)...) {
+		if raw == "" || raw == proxyDirect {
+			continue
+		}
+
+		if _, err := parseProxy(raw); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func mapValues(m map[string]string) []string {
+	values := make([]string, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// ProxyFor returns the proxy device is reached through, nil when it is connected directly. The proxy configured
+// for the device comes first, then the one of its site and last the configured default.
+func ProxyFor(device entity.Device) (*url.URL, error) {
+	return proxyFor(currentProxy(), device)
+}
+
+func proxyFor(cfg config.WsmanProxy, device entity.Device) (*url.URL, error) {
+	raw := cfg.URL
+	if device.Proxy != "" {
+		raw = device.Proxy
+	}
+
+	for guid, deviceProxy := range cfg.Devices {
+		if strings.EqualFold(guid, device.GUID) {
+			raw = deviceProxy
+
+			break
+		}
+	}
+
+	if raw == "" || raw == proxyDirect {
+		return nil, nil
+	}
+
+	return parseProxy(raw)
+}
+
+func parseProxy(raw string) (*url.URL, error) {
+	proxyURL, err := url.Parse(raw)
+	if err != nil {
+		// the error of url.Parse repeats the URL, credentials included
+		return nil, ErrProxyScheme
+	}
+
+	port, ok := defaultProxyPorts[proxyURL.Scheme]
+	if !ok || proxyURL.Hostname() == "" {
+		return nil, ErrProxyScheme
+	}
+
+	if proxyURL.Port() == "" {
+		proxyURL.Host = net.JoinHostPort(proxyURL.Hostname(), port)
+	}
+
+	return proxyURL, nil
+}
+
+// applyProxy sends the WS-Man requests of target through the proxy of device. A proxy that cannot be used
+// fails the requests instead of reaching the device directly.
+func applyProxy(target *client.Target, device entity.Device) {
+	transport, ok := target.Transport.(*http.Transport)
+	if !ok {
+		return
+	}
+
+	proxyURL, err := ProxyFor(device)
+
+	switch {
+	case err != nil:
+		transport.Proxy = func(*http.Request) (*url.URL, error) { return nil, err }
+	case proxyURL != nil:
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+}
+
+// dialProxy opens a connection to address through the proxy, a SOCKS5 proxy or an HTTP proxy tunneling it
+// with CONNECT.
+func dialProxy(ctx context.Context, proxyURL *url.URL, address string) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, proxyDialTimeout)
+	defer cancel()
+
+	if proxyURL.Scheme == "socks5" || proxyURL.Scheme == "socks5h" {
+		dialer, err := proxy.FromURL(proxyURL, &net.Dialer{})
+		if err != nil {
+			return nil, err
+		}
+
+		contextDialer, ok := dialer.(proxy.ContextDialer)
+		if !ok {
+			return dialer.Dial("tcp", address)
+		}
+
+		return contextDialer.DialContext(ctx, "tcp", address)
+	}
+
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "tcp", proxyURL.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	if proxyURL.Scheme == "https" {
+		tlsConn := gotls.Client(conn, &gotls.Config{ServerName: proxyURL.Hostname(), MinVersion: gotls.VersionTLS12})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+
+			return nil, err
+		}
+
+		conn = tlsConn
+	}
+
+	tunnel, err := connectTunnel(ctx, conn, proxyURL, address)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	return tunnel, nil
+}
+
+// connectTunnel asks the HTTP proxy on conn to tunnel it to address.
+func connectTunnel(ctx context.Context, conn net.Conn, proxyURL *url.URL, address string) (net.Conn, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{}) //nolint:errcheck // the connection is returned or closed
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: http.Header{},
+	}
+
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(user.Username()+":"+password)))
+	}
+
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s", ErrProxyRefused, resp.Status)
+	}
+
+	if reader.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+
+	return conn, nil
+}
+
+// bufferedConn reads what the proxy sent past its response before reading the connection.
+type bufferedConn struct {
+	net.Conn
+
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
+
+// DialRedirection opens the redirection connection to device through the proxy, with TLS when the device
+// uses it.
+func DialRedirection(ctx context.Context, proxyURL *url.URL, device entity.Device) (net.Conn, error) {
+	port := client.RedirectionNonTLSPort
+	if device.UseTLS {
+		port = client.RedirectionTLSPort
+	}
+
+	conn, err := dialProxy(ctx, proxyURL, TargetHost(device, false)+":"+port)
+	if err != nil {
+		return nil, err
+	}
+
+	if !device.UseTLS {
+		return conn, nil
+	}
+
+	tlsConn := gotls.Client(conn, deviceTLSConfig(device))
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	return tlsConn, nil
+}
+
+// deviceTLSConfig verifies the certificate of device the way the WS-Man client does: against its pinned hash
+// when it has one, and not at all when self-signed certificates are allowed.
+func deviceTLSConfig(device entity.Device) *gotls.Config {
+	tlsConfig := &gotls.Config{
+		ServerName:         strings.Trim(device.Hostname, "[]"),
+		InsecureSkipVerify: device.AllowSelfSigned, //nolint:gosec // self-signed AMT certificates are allowed per device
+	}
+
+	if device.CertHash != nil && *device.CertHash != "" {
+		pinned := *device.CertHash
+		tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			for _, rawCert := range rawCerts {
+				sum := sha256.Sum256(rawCert)
+				if hex.EncodeToString(sum[:]) == pinned {
+					return nil
+				}
+			}
+
+			return ErrCertificatePinning
+		}
+	}
+
+	return tlsConfig
+}
+
+// proxiedServerCertificate returns the TLS certificate of the device at address, reached through the proxy.
+func proxiedServerCertificate(ctx context.Context, proxyURL *url.URL, address string) (*gotls.Certificate, error) {
+	conn, err := dialProxy(ctx, proxyURL, address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// the certificate is read to be shown or pinned, not to be trusted
+	tlsConn := gotls.Client(conn, &gotls.Config{InsecureSkipVerify: true}) //nolint:gosec // see above
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return nil, err
+	}
+
+	certs := tlsConn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, ErrNoServerCertificate
+	}
+
+	return &gotls.Certificate{Certificate: [][]byte{certs[0].Raw}}, nil
+}
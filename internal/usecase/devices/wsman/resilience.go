@@ -0,0 +1,191 @@
+package wsman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+var breakers = newCircuitBreakers()
+
+// CircuitOpenError is returned without contacting the device while its circuit breaker is open.
+type CircuitOpenError struct {
+	GUID       string
+	RetryAfter time.Duration
+}
+
+func (e CircuitOpenError) Error() string {
+	return fmt.Sprintf("device %s failed repeatedly, calls are suspended for %ds", e.GUID, e.RetryAfterSeconds())
+}
+
+func (e CircuitOpenError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeCircuitOpen
+}
+
+// RetryAfterSeconds is the value of a Retry-After header for the error, at least one second.
+func (e CircuitOpenError) RetryAfterSeconds() int {
+	return max(1, int(math.Ceil(e.RetryAfter.Seconds())))
+}
+
+// resiliencePolicy controls how often a request is retried and when the circuit of a device opens.
+type resiliencePolicy struct {
+	attempts int
+	backoff  time.Duration
+	failures int
+	cooldown time.Duration
+}
+
+func currentPolicy() resiliencePolicy {
+	if config.ConsoleConfig == nil {
+		return resiliencePolicy{}
+	}
+
+	return resiliencePolicy{
+		attempts: config.ConsoleConfig.WsmanRetryAttempts,
+		backoff:  config.ConsoleConfig.WsmanRetryBackoff,
+		failures: config.ConsoleConfig.WsmanCircuitFailures,
+		cooldown: config.ConsoleConfig.WsmanCircuitCooldown,
+	}
+}
+
+func (p resiliencePolicy) enabled() bool {
+	return p.attempts > 1 || p.failures > 0
+}
+
+type circuit struct {
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// circuitBreakers counts the consecutive failed requests per device. Once the circuit of a
+// device is open, a single request is let through after the cooldown to probe the device.
+type circuitBreakers struct {
+	mu       sync.Mutex
+	circuits map[string]*circuit
+	now      func() time.Time
+}
+
+func newCircuitBreakers() *circuitBreakers {
+	return &circuitBreakers{circuits: map[string]*circuit{}, now: time.Now}
+}
+
+func (b *circuitBreakers) allow(guid string, p resiliencePolicy) error {
+	if p.failures <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[guid]
+	if !ok || c.failures < p.failures {
+		return nil
+	}
+
+	if now := b.now(); now.Before(c.openUntil) {
+		return CircuitOpenError{GUID: guid, RetryAfter: c.openUntil.Sub(now)}
+	}
+
+	if c.probing {
+		return CircuitOpenError{GUID: guid, RetryAfter: p.cooldown}
+	}
+
+	c.probing = true
+
+	return nil
+}
+
+func (b *circuitBreakers) record(guid string, p resiliencePolicy, err error) {
+	if p.failures <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err == nil {
+		delete(b.circuits, guid)
+
+		return
+	}
+
+	c, ok := b.circuits[guid]
+	if !ok {
+		c = &circuit{}
+		b.circuits[guid] = c
+	}
+
+	c.failures++
+	c.probing = false
+
+	if c.failures >= p.failures {
+		c.openUntil = b.now().Add(p.cooldown)
+	}
+}
+
+// resilientTransport retries requests that could not be delivered to the device and
+// rejects requests to devices whose circuit is open.
+type resilientTransport struct {
+	guid     string
+	next     http.RoundTripper
+	policy   resiliencePolicy
+	breakers *circuitBreakers
+}
+
+func (t *resilientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.breakers.allow(t.guid, t.policy); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.send(req)
+
+	// requests abandoned by the caller say nothing about the device
+	if !errors.Is(err, context.Canceled) {
+		t.breakers.record(t.guid, t.policy, err)
+	}
+
+	return resp, err
+}
+
+func (t *resilientTransport) send(req *http.Request) (*http.Response, error) {
+	backoff := t.policy.backoff
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err == nil || attempt >= t.policy.attempts || !undelivered(err) || req.GetBody == nil {
+			return resp, err
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, err
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+
+		body, bodyErr := req.GetBody()
+		if bodyErr != nil {
+			return nil, err
+		}
+
+		req = req.Clone(req.Context())
+		req.Body = body
+	}
+}
+
+// undelivered reports whether the request failed before reaching the device, so that sending it again is safe.
+func undelivered(err error) bool {
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
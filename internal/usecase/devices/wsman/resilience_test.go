@@ -0,0 +1,174 @@
+package wsman
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errRefused = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	errReset   = &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+)
+
+// flakyTransport fails with the given errors before answering, recording the request bodies it saw.
+type flakyTransport struct {
+	errs   []error
+	bodies []string
+}
+
+func (f *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, _ := io.ReadAll(req.Body)
+	f.bodies = append(f.bodies, string(body))
+
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+
+		return nil, err
+	}
+
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok")), Request: req}, nil
+}
+
+func TestResilientTransportRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		errs     []error
+		attempts int
+		err      error
+	}{
+		{
+			name:     "retried until delivered",
+			errs:     []error{errRefused, errRefused},
+			attempts: 3,
+		},
+		{
+			name:     "attempts exhausted",
+			errs:     []error{errRefused, errRefused, errRefused},
+			attempts: 3,
+			err:      errRefused,
+		},
+		{
+			name:     "delivered requests are not sent again",
+			errs:     []error{errReset},
+			attempts: 1,
+			err:      errReset,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			next := &flakyTransport{errs: tc.errs}
+			transport := &resilientTransport{
+				guid:     "guid",
+				next:     next,
+				policy:   resiliencePolicy{attempts: 3, backoff: time.Millisecond},
+				breakers: newCircuitBreakers(),
+			}
+
+			req, err := http.NewRequest(http.MethodPost, "http://device:16992/wsman", bytes.NewReader([]byte("<Envelope/>")))
+			require.NoError(t, err)
+
+			resp, err := transport.RoundTrip(req)
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			require.Equal(t, tc.err, err)
+			require.Len(t, next.bodies, tc.attempts)
+
+			for _, body := range next.bodies {
+				require.Equal(t, "<Envelope/>", body)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 29, 18, 0, 0, 0, time.UTC)
+	policy := resiliencePolicy{failures: 2, cooldown: 30 * time.Second}
+
+	b := newCircuitBreakers()
+	b.now = func() time.Time { return now }
+
+	require.NoError(t, b.allow("guid", policy))
+	b.record("guid", policy, errRefused)
+	require.NoError(t, b.allow("guid", policy))
+	b.record("guid", policy, errRefused)
+
+	// the circuit is open, other devices are not affected
+	require.Equal(t, CircuitOpenError{GUID: "guid", RetryAfter: 30 * time.Second}, b.allow("guid", policy))
+	require.NoError(t, b.allow("other", policy))
+
+	now = now.Add(20 * time.Second)
+
+	err := b.allow("guid", policy)
+	require.Equal(t, CircuitOpenError{GUID: "guid", RetryAfter: 10 * time.Second}, err)
+	require.Equal(t, 10, err.(CircuitOpenError).RetryAfterSeconds()) //nolint:errorlint // the error is not wrapped here
+
+	// after the cooldown a single probe is let through, a failed probe opens the circuit again
+	now = now.Add(10 * time.Second)
+
+	require.NoError(t, b.allow("guid", policy))
+	require.Error(t, b.allow("guid", policy))
+	b.record("guid", policy, errRefused)
+	require.Equal(t, CircuitOpenError{GUID: "guid", RetryAfter: 30 * time.Second}, b.allow("guid", policy))
+
+	// a successful probe closes the circuit
+	now = now.Add(30 * time.Second)
+
+	require.NoError(t, b.allow("guid", policy))
+	b.record("guid", policy, nil)
+	require.NoError(t, b.allow("guid", policy))
+	require.NoError(t, b.allow("guid", policy))
+}
+
+func TestResilientTransportCircuitOpen(t *testing.T) {
+	t.Parallel()
+
+	next := &flakyTransport{errs: []error{errRefused, errRefused}}
+	httpClient := &http.Client{Transport: &resilientTransport{
+		guid:     "guid",
+		next:     next,
+		policy:   resiliencePolicy{attempts: 1, failures: 2, cooldown: time.Minute},
+		breakers: newCircuitBreakers(),
+	}}
+
+	for range 3 {
+		req, err := http.NewRequest(http.MethodPost, "http://device:16992/wsman", bytes.NewReader([]byte("<Envelope/>")))
+		require.NoError(t, err)
+
+		resp, err := httpClient.Do(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		require.Error(t, err)
+	}
+
+	// the third request was rejected without reaching the device
+	require.Len(t, next.bodies, 2)
+
+	var circuitErr CircuitOpenError
+
+	req, err := http.NewRequest(http.MethodPost, "http://device:16992/wsman", http.NoBody)
+	require.NoError(t, err)
+
+	_, err = httpClient.Do(req) //nolint:bodyclose // no response on errors
+	require.ErrorAs(t, err, &circuitErr)
+	require.Equal(t, "guid", circuitErr.GUID)
+}
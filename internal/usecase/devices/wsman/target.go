@@ -0,0 +1,129 @@
+package wsman
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+)
+
+// Address families a device hostname resolving to both IPv4 and IPv6 addresses is reached over.
+const (
+	AddressFamilyIPv4 = "ipv4"
+	AddressFamilyIPv6 = "ipv6"
+)
+
+const resolveTimeout = 5 * time.Second
+
+// ErrAddressFamily is returned for an address family other than ipv4, ipv6 or empty.
+var ErrAddressFamily = errors.New("address family must be ipv4, ipv6 or empty")
+
+// CheckAddressPreference reports the address families of the network configuration that are not known,
+// they leave the hostnames they apply to in the order of the resolver.
+func CheckAddressPreference(network config.Network) error {
+	if !validFamily(network.PreferFamily) {
+		return ErrAddressFamily
+	}
+
+	for _, family := range network.Domains {
+		if !validFamily(family) {
+			return ErrAddressFamily
+		}
+	}
+
+	return nil
+}
+
+func validFamily(family string) bool {
+	return family == "" || family == AddressFamilyIPv4 || family == AddressFamilyIPv6
+}
+
+func currentNetwork() config.Network {
+	if config.ConsoleConfig == nil {
+		return config.Network{}
+	}
+
+	return config.ConsoleConfig.Network
+}
+
+// preferredFamily returns the address family of hostname, the one of its longest matching domain or else
+// the default one.
+func preferredFamily(network config.Network, hostname string) string {
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	family, matched := network.PreferFamily, -1
+
+	for domain, domainFamily := range network.Domains {
+		domain = strings.ToLower(strings.Trim(domain, "."))
+		if (hostname == domain || strings.HasSuffix(hostname, "."+domain)) && len(domain) > matched {
+			family, matched = domainFamily, len(domain)
+		}
+	}
+
+	return family
+}
+
+// TargetHost returns the host of the WS-Man client of device. IPv6 literals are bracketed, with their zone
+// escaped when the host goes into a URL rather than a redirection socket. A hostname is resolved to an
+// address of its preferred family; it is kept when it has no such address, and for TLS connections
+// verifying the certificate of the device against the hostname.
+func TargetHost(device entity.Device, forURL bool) string {
+	return targetHost(currentNetwork(), device, forURL, net.DefaultResolver.LookupIPAddr)
+}
+
+func targetHost(network config.Network, device entity.Device, forURL bool, lookupIPAddr func(context.Context, string) ([]net.IPAddr, error)) string {
+	hostname := strings.TrimSuffix(strings.TrimPrefix(device.Hostname, "["), "]")
+
+	if addr, err := netip.ParseAddr(hostname); err == nil {
+		return formatAddr(addr, forURL)
+	}
+
+	family := preferredFamily(network, hostname)
+	if family == "" || !validFamily(family) || (device.UseTLS && !device.AllowSelfSigned) {
+		return device.Hostname
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancel()
+
+	addrs, err := lookupIPAddr(ctx, hostname)
+	if err != nil {
+		return device.Hostname
+	}
+
+	for _, ipAddr := range addrs {
+		addr, ok := netip.AddrFromSlice(ipAddr.IP)
+		if !ok {
+			continue
+		}
+
+		addr = addr.Unmap().WithZone(ipAddr.Zone)
+		if addr.Is4() == (family == AddressFamilyIPv4) {
+			return formatAddr(addr, forURL)
+		}
+	}
+
+	return device.Hostname
+}
+
+func formatAddr(addr netip.Addr, forURL bool) string {
+	if addr.Is4() {
+		return addr.String()
+	}
+
+	host := addr.WithZone("").String()
+	if zone := addr.Zone(); zone != "" {
+		separator := "%"
+		if forURL {
+			separator = "%25"
+		}
+
+		host += separator + zone
+	}
+
+	return "[" + host + "]"
+}
@@ -0,0 +1,105 @@
+package wsman
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+)
+
+var errNoSuchHost = errors.New("no such host")
+
+func fakeLookup(addrs map[string][]net.IPAddr) func(context.Context, string) ([]net.IPAddr, error) {
+	return func(_ context.Context, host string) ([]net.IPAddr, error) {
+		// like DNS, names are matched without case and trailing dot
+		if found, ok := addrs[strings.ToLower(strings.TrimSuffix(host, "."))]; ok {
+			return found, nil
+		}
+
+		return nil, errNoSuchHost
+	}
+}
+
+func TestTargetHost(t *testing.T) {
+	t.Parallel()
+
+	lookup := fakeLookup(map[string][]net.IPAddr{
+		"dual.example.com":     {{IP: net.ParseIP("192.0.2.10")}, {IP: net.ParseIP("2001:db8::10")}},
+		"amt.v6.example.com":   {{IP: net.ParseIP("192.0.2.20")}, {IP: net.ParseIP("fe80::20"), Zone: "eth0"}},
+		"v4only.example.com":   {{IP: net.ParseIP("192.0.2.30")}},
+		"mapped.example.com":   {{IP: net.ParseIP("::ffff:192.0.2.40")}},
+		"verified.example.com": {{IP: net.ParseIP("2001:db8::50")}},
+	})
+
+	network := config.Network{
+		PreferFamily: AddressFamilyIPv4,
+		Domains:      map[string]string{"v6.example.com": AddressFamilyIPv6, "other.example.com": AddressFamilyIPv4},
+	}
+
+	tests := []struct {
+		name     string
+		network  config.Network
+		device   entity.Device
+		forURL   bool
+		expected string
+	}{
+		{name: "IPv4 literal", device: entity.Device{Hostname: "192.0.2.1"}, forURL: true, expected: "192.0.2.1"},
+		{name: "IPv6 literal", device: entity.Device{Hostname: "2001:db8::1"}, forURL: true, expected: "[2001:db8::1]"},
+		{name: "bracketed IPv6 literal", device: entity.Device{Hostname: "[2001:db8::1]"}, expected: "[2001:db8::1]"},
+		{name: "zone in a URL", device: entity.Device{Hostname: "fe80::1%eth0"}, forURL: true, expected: "[fe80::1%25eth0]"},
+		{name: "zone on a socket", device: entity.Device{Hostname: "[fe80::1%eth0]"}, expected: "[fe80::1%eth0]"},
+		{name: "resolver order", device: entity.Device{Hostname: "dual.example.com"}, forURL: true, expected: "dual.example.com"},
+		{name: "prefer IPv4", network: network, device: entity.Device{Hostname: "dual.example.com"}, forURL: true, expected: "192.0.2.10"},
+		{name: "prefer IPv6 in a domain", network: network, device: entity.Device{Hostname: "AMT.v6.example.com."}, forURL: true, expected: "[fe80::20%25eth0]"},
+		{name: "IPv4-mapped address", network: network, device: entity.Device{Hostname: "mapped.example.com"}, expected: "192.0.2.40"},
+		{
+			name:     "no address of the family",
+			network:  config.Network{PreferFamily: AddressFamilyIPv6},
+			device:   entity.Device{Hostname: "v4only.example.com"},
+			expected: "v4only.example.com",
+		},
+		{
+			name:     "lookup failure",
+			network:  network,
+			device:   entity.Device{Hostname: "unknown.example.com"},
+			expected: "unknown.example.com",
+		},
+		{
+			name:     "verified TLS keeps the name",
+			network:  config.Network{PreferFamily: AddressFamilyIPv6},
+			device:   entity.Device{Hostname: "verified.example.com", UseTLS: true},
+			forURL:   true,
+			expected: "verified.example.com",
+		},
+		{
+			name:     "self-signed TLS is resolved",
+			network:  config.Network{PreferFamily: AddressFamilyIPv6},
+			device:   entity.Device{Hostname: "verified.example.com", UseTLS: true, AllowSelfSigned: true},
+			forURL:   true,
+			expected: "[2001:db8::50]",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, targetHost(tc.network, tc.device, tc.forURL, lookup))
+		})
+	}
+}
+
+func TestCheckAddressPreference(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, CheckAddressPreference(config.Network{}))
+	require.NoError(t, CheckAddressPreference(config.Network{PreferFamily: AddressFamilyIPv6, Domains: map[string]string{"example.com": AddressFamilyIPv4}}))
+	require.ErrorIs(t, CheckAddressPreference(config.Network{PreferFamily: "v6"}), ErrAddressFamily)
+	require.ErrorIs(t, CheckAddressPreference(config.Network{Domains: map[string]string{"example.com": "IPv4"}}), ErrAddressFamily)
+}
@@ -0,0 +1,72 @@
+package wsman
+
+import (
+	"net/http"
+	"sync"
+)
+
+var unreachable = &unreachableHandlers{handlers: map[int]func(string){}}
+
+// unreachableHandlers are called with the GUID of each device a request could not be delivered to.
+type unreachableHandlers struct {
+	mu       sync.Mutex
+	next     int
+	handlers map[int]func(guid string)
+}
+
+// OnUnreachable calls handle with the GUID of each device a WS-Man request could not be delivered to, until
+// unsubscribe is called. The stored connection of the device is dropped first, so that the next one resolves
+// the hostname of the device again. handle is called from its own goroutine.
+func OnUnreachable(handle func(guid string)) (unsubscribe func()) {
+	return unreachable.add(handle)
+}
+
+func (u *unreachableHandlers) add(handle func(guid string)) func() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	id := u.next
+	u.next++
+	u.handlers[id] = handle
+
+	return func() {
+		u.mu.Lock()
+		defer u.mu.Unlock()
+
+		delete(u.handlers, id)
+	}
+}
+
+func (u *unreachableHandlers) notify(guid string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for _, handle := range u.handlers {
+		go handle(guid)
+	}
+}
+
+// unreachableTransport drops the stored connection of a device its requests cannot be delivered to, which may
+// hold an address the hostname of the device no longer resolves to, and reports the device.
+type unreachableTransport struct {
+	guid     string
+	next     http.RoundTripper
+	handlers *unreachableHandlers
+}
+
+func (t *unreachableTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil && undelivered(err) {
+		if entry := getConnection(t.guid); entry != nil && !entry.IsCIRA {
+			if entry.Timer != nil {
+				entry.Timer.Stop()
+			}
+
+			removeConnection(t.guid)
+		}
+
+		t.handlers.notify(t.guid)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,59 @@
+package wsman
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnreachableTransport(t *testing.T) {
+	t.Parallel()
+
+	handlers := &unreachableHandlers{handlers: map[int]func(string){}}
+	reported := make(chan string, 2)
+	unsubscribe := handlers.add(func(guid string) { reported <- guid })
+
+	guid := "unreachable-guid"
+	storeConnection(guid, &ConnectionEntry{Timer: time.NewTimer(time.Hour)})
+
+	transport := &unreachableTransport{guid: guid, next: &flakyTransport{errs: []error{errReset, errRefused}}, handlers: handlers}
+
+	send := func() error {
+		req, err := http.NewRequest(http.MethodPost, "http://device:16992/wsman", bytes.NewReader([]byte("<Envelope/>")))
+		require.NoError(t, err)
+
+		resp, err := transport.RoundTrip(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		return err
+	}
+
+	require.Equal(t, errReset, send())
+	require.NotNil(t, getConnection(guid), "the device was reached")
+
+	require.Equal(t, errRefused, send())
+	require.Nil(t, getConnection(guid), "the connection is resolved again")
+
+	select {
+	case got := <-reported:
+		require.Equal(t, guid, got)
+	case <-time.After(time.Second):
+		t.Fatal("the device was not reported")
+	}
+
+	unsubscribe()
+
+	transport.next = &flakyTransport{errs: []error{errRefused}}
+	require.Equal(t, errRefused, send())
+
+	select {
+	case <-reported:
+		t.Fatal("the device was reported after unsubscribing")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
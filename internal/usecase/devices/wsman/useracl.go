@@ -0,0 +1,278 @@
+package wsman
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
+)
+
+// UserACLEntry is an entry of the user access control list of AMT: a digest user, named by DigestUsername, or an
+// Active Directory user authenticated with Kerberos, named by KerberosUserSID.
+type UserACLEntry struct {
+	Handle           int
+	DigestUsername   string
+	KerberosUserSID  []byte
+	AccessPermission authorization.AccessPermission
+	Realms           []authorization.RealmValues
+	Enabled          bool
+}
+
+// The library only reads and removes user ACL entries and does not decode the outputs of its methods, so the
+// messages of AMT_AuthorizationService are built with its message creator.
+type (
+	enumerateUserACLEntriesInput struct {
+		XMLName    xml.Name `xml:"h:EnumerateUserAclEntries_INPUT"`
+		H          string   `xml:"xmlns:h,attr"`
+		StartIndex int      `xml:"h:StartIndex"`
+	}
+
+	userACLHandleInput struct {
+		XMLName xml.Name
+		H       string `xml:"xmlns:h,attr"`
+		Handle  int    `xml:"h:Handle"`
+	}
+
+	// userACLEntryInput is the input of AddUserAclEntryEx and UpdateUserAclEntryEx, whose realms AMT expects as
+	// repeated elements.
+	userACLEntryInput struct {
+		XMLName          xml.Name
+		H                string                         `xml:"xmlns:h,attr"`
+		Handle           int                            `xml:"h:Handle,omitempty"`
+		DigestUsername   string                         `xml:"h:DigestUsername,omitempty"`
+		DigestPassword   string                         `xml:"h:DigestPassword,omitempty"`
+		KerberosUserSid  string                         `xml:"h:KerberosUserSid,omitempty"`
+		AccessPermission authorization.AccessPermission `xml:"h:AccessPermission"`
+		Realms           []authorization.RealmValues    `xml:"h:Realms"`
+	}
+
+	setACLEnabledStateInput struct {
+		XMLName xml.Name `xml:"h:SetAclEnabledState_INPUT"`
+		H       string   `xml:"xmlns:h,attr"`
+		Handle  int      `xml:"h:Handle"`
+		Enabled bool     `xml:"h:Enabled"`
+	}
+
+	authorizationOutput struct {
+		XMLName xml.Name `xml:"Envelope"`
+		Body    struct {
+			EnumerateUserACLEntries struct {
+				TotalCount   int   `xml:"TotalCount"`
+				HandlesCount int   `xml:"HandlesCount"`
+				Handles      []int `xml:"Handles"`
+				ReturnValue  int   `xml:"ReturnValue"`
+			} `xml:"EnumerateUserAclEntries_OUTPUT"`
+			GetUserACLEntryEx struct {
+				DigestUsername   string                         `xml:"DigestUsername"`
+				KerberosUserSid  string                         `xml:"KerberosUserSid"`
+				AccessPermission authorization.AccessPermission `xml:"AccessPermission"`
+				Realms           []authorization.RealmValues    `xml:"Realms"`
+				ReturnValue      int                            `xml:"ReturnValue"`
+			} `xml:"GetUserAclEntryEx_OUTPUT"`
+			GetACLEnabledState struct {
+				Enabled     bool `xml:"Enabled"`
+				ReturnValue int  `xml:"ReturnValue"`
+			} `xml:"GetAclEnabledState_OUTPUT"`
+			AddUserACLEntryEx struct {
+				Handle      int `xml:"Handle"`
+				ReturnValue int `xml:"ReturnValue"`
+			} `xml:"AddUserAclEntryEx_OUTPUT"`
+			UpdateUserACLEntryEx struct {
+				ReturnValue int `xml:"ReturnValue"`
+			} `xml:"UpdateUserAclEntryEx_OUTPUT"`
+			RemoveUserACLEntry struct {
+				ReturnValue int `xml:"ReturnValue"`
+			} `xml:"RemoveUserAclEntry_OUTPUT"`
+			SetACLEnabledState struct {
+				ReturnValue int `xml:"ReturnValue"`
+			} `xml:"SetAclEnabledState_OUTPUT"`
+		} `xml:"Body"`
+	}
+)
+
+// invokeAuthorization calls method of AMT_AuthorizationService with input and decodes its output.
+func (c *ConnectionEntry) invokeAuthorization(method string, input any) (authorizationOutput, error) {
+	service := c.WsmanMessages.AMT.AuthorizationService
+	creator := service.Base.WSManMessageCreator
+
+	header := creator.CreateHeader(methods.GenerateAction(authorization.AMTAuthorizationService, method), authorization.AMTAuthorizationService, nil, "", "")
+	body := creator.CreateBody(methods.GenerateInputMethod(method), authorization.AMTAuthorizationService, input)
+
+	msg := &client.Message{XMLInput: creator.CreateXML(header, body)}
+	if err := service.Base.Execute(msg); err != nil {
+		return authorizationOutput{}, err
+	}
+
+	var output authorizationOutput
+	if err := xml.Unmarshal([]byte(msg.XMLOutput), &output); err != nil {
+		return authorizationOutput{}, err
+	}
+
+	return output, nil
+}
+
+// GetUserACLEntries returns the user ACL entries of the device. The admin account is not part of the list and
+// AMT never returns the password of the users.
+func (c *ConnectionEntry) GetUserACLEntries(ctx context.Context) ([]UserACLEntry, error) {
+	defer c.bind(ctx)()
+
+	var handles []int
+
+	// AMT returns at most 50 handles at a time, the first entry has index 1
+	for start := 1; ; {
+		output, err := c.invokeAuthorization(authorization.EnumerateUserACLEntries, &enumerateUserACLEntriesInput{StartIndex: start})
+		if err != nil {
+			return nil, err
+		}
+
+		page := output.Body.EnumerateUserACLEntries
+		if page.ReturnValue != 0 {
+			return nil, amtstatus.Error{Operation: authorization.EnumerateUserACLEntries, Explanation: amtstatus.Explain(page.ReturnValue)}
+		}
+
+		handles = append(handles, page.Handles...)
+
+		if len(page.Handles) == 0 || len(handles) >= page.TotalCount {
+			break
+		}
+
+		start += len(page.Handles)
+	}
+
+	entries := make([]UserACLEntry, 0, len(handles))
+
+	for _, handle := range handles {
+		entry, err := c.getUserACLEntry(handle)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (c *ConnectionEntry) getUserACLEntry(handle int) (UserACLEntry, error) {
+	output, err := c.invokeAuthorization(authorization.GetUserACLEntryEx, &userACLHandleInput{XMLName: xml.Name{Local: "h:GetUserAclEntryEx_INPUT"}, Handle: handle})
+	if err != nil {
+		return UserACLEntry{}, err
+	}
+
+	get := output.Body.GetUserACLEntryEx
+	if get.ReturnValue != 0 {
+		return UserACLEntry{}, amtstatus.Error{Operation: authorization.GetUserACLEntryEx, Explanation: amtstatus.Explain(get.ReturnValue)}
+	}
+
+	sid, err := base64.StdEncoding.DecodeString(get.KerberosUserSid)
+	if err != nil {
+		return UserACLEntry{}, err
+	}
+
+	output, err = c.invokeAuthorization(authorization.GetACLEnabledState, &userACLHandleInput{XMLName: xml.Name{Local: "h:GetAclEnabledState_INPUT"}, Handle: handle})
+	if err != nil {
+		return UserACLEntry{}, err
+	}
+
+	state := output.Body.GetACLEnabledState
+	if state.ReturnValue != 0 {
+		return UserACLEntry{}, amtstatus.Error{Operation: authorization.GetACLEnabledState, Explanation: amtstatus.Explain(state.ReturnValue)}
+	}
+
+	return UserACLEntry{
+		Handle:           handle,
+		DigestUsername:   get.DigestUsername,
+		KerberosUserSID:  sid,
+		AccessPermission: get.AccessPermission,
+		Realms:           get.Realms,
+		Enabled:          state.Enabled,
+	}, nil
+}
+
+// AddUserACLEntry adds entry to the user ACL of the device and returns its handle. digestPassword is the hash of
+// the password of a digest user, it is empty for a Kerberos user.
+func (c *ConnectionEntry) AddUserACLEntry(ctx context.Context, entry UserACLEntry, digestPassword string) (int, error) {
+	defer c.bind(ctx)()
+
+	output, err := c.invokeAuthorization(authorization.AddUserACLEntryEx, userACLInput("h:AddUserAclEntryEx_INPUT", entry, digestPassword))
+	if err != nil {
+		return 0, err
+	}
+
+	if rv := output.Body.AddUserACLEntryEx.ReturnValue; rv != 0 {
+		return 0, amtstatus.Error{Operation: authorization.AddUserACLEntryEx, Explanation: amtstatus.Explain(rv)}
+	}
+
+	return output.Body.AddUserACLEntryEx.Handle, nil
+}
+
+// UpdateUserACLEntry replaces the user ACL entry with entry.Handle. An empty digestPassword keeps the password of
+// a digest user.
+func (c *ConnectionEntry) UpdateUserACLEntry(ctx context.Context, entry UserACLEntry, digestPassword string) error {
+	defer c.bind(ctx)()
+
+	output, err := c.invokeAuthorization(authorization.UpdateUserACLEntryEx, userACLInput("h:UpdateUserAclEntryEx_INPUT", entry, digestPassword))
+	if err != nil {
+		return err
+	}
+
+	if rv := output.Body.UpdateUserACLEntryEx.ReturnValue; rv != 0 {
+		return amtstatus.Error{Operation: authorization.UpdateUserACLEntryEx, Explanation: amtstatus.Explain(rv)}
+	}
+
+	return nil
+}
+
+// RemoveUserACLEntry removes the user ACL entry with handle from the device.
+func (c *ConnectionEntry) RemoveUserACLEntry(ctx context.Context, handle int) error {
+	defer c.bind(ctx)()
+
+	output, err := c.invokeAuthorization(authorization.RemoveUserACLEntry, &userACLHandleInput{XMLName: xml.Name{Local: "h:RemoveUserAclEntry_INPUT"}, Handle: handle})
+	if err != nil {
+		return err
+	}
+
+	if rv := output.Body.RemoveUserACLEntry.ReturnValue; rv != 0 {
+		return amtstatus.Error{Operation: authorization.RemoveUserACLEntry, Explanation: amtstatus.Explain(rv)}
+	}
+
+	return nil
+}
+
+// SetUserACLEnabledState enables or disables the user ACL entry with handle.
+func (c *ConnectionEntry) SetUserACLEnabledState(ctx context.Context, handle int, enabled bool) error {
+	defer c.bind(ctx)()
+
+	output, err := c.invokeAuthorization(authorization.SetACLEnabledState, &setACLEnabledStateInput{Handle: handle, Enabled: enabled})
+	if err != nil {
+		return err
+	}
+
+	if rv := output.Body.SetACLEnabledState.ReturnValue; rv != 0 {
+		return amtstatus.Error{Operation: authorization.SetACLEnabledState, Explanation: amtstatus.Explain(rv)}
+	}
+
+	return nil
+}
+
+func userACLInput(name string, entry UserACLEntry, digestPassword string) *userACLEntryInput {
+	input := &userACLEntryInput{
+		XMLName:          xml.Name{Local: name},
+		Handle:           entry.Handle,
+		DigestUsername:   entry.DigestUsername,
+		DigestPassword:   digestPassword,
+		AccessPermission: entry.AccessPermission,
+		Realms:           entry.Realms,
+	}
+
+	if len(entry.KerberosUserSID) > 0 {
+		input.KerberosUserSid = base64.StdEncoding.EncodeToString(entry.KerberosUserSID)
+	}
+
+	return input
+}
@@ -18,3 +18,7 @@ func (e CertExpirationError) Wrap(call, function string, err error) error {
 
 	return e
 }
+
+func (e CertExpirationError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeCertExpired
+}
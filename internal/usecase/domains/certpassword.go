@@ -16,3 +16,7 @@ func (e CertPasswordError) Wrap(call, function string, err error) error {
 
 	return e
 }
+
+func (e CertPasswordError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeCertPassword
+}
@@ -0,0 +1,24 @@
+package domains
+
+import "github.com/device-management-toolkit/console/pkg/consoleerrors"
+
+const certRevoked = "certificate has been revoked by its issuer"
+
+type CertRevokedError struct {
+	Console consoleerrors.InternalError
+}
+
+func (e CertRevokedError) Error() string {
+	return certRevoked
+}
+
+func (e CertRevokedError) Wrap(call, function string, err error) error {
+	_ = e.Console.Wrap(call, function, err)
+	e.Console.Message = certRevoked
+
+	return e
+}
+
+func (e CertRevokedError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeCertRevoked
+}
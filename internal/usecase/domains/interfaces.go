@@ -2,6 +2,7 @@ package domains
 
 import (
 	"context"
+	"crypto/x509"
 
 	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
@@ -27,4 +28,8 @@ type (
 		Update(ctx context.Context, d *dto.Domain) (*dto.Domain, error)
 		Insert(ctx context.Context, d *dto.Domain) (*dto.Domain, error)
 	}
+	// Revocation asks the issuer of a certificate whether it was revoked.
+	Revocation interface {
+		Check(ctx context.Context, cert *x509.Certificate, intermediates []*x509.Certificate) dto.RevocationStatus
+	}
 )
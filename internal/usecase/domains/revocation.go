@@ -0,0 +1,57 @@
+package domains
+
+import (
+	"context"
+	"encoding/base64"
+
+	"software.sslmate.com/src/go-pkcs12"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// SetRevocation checks the provisioning certificates with revocation, refusing to add the revoked ones and
+// showing the status of the others.
+func (uc *UseCase) SetRevocation(revocation Revocation) {
+	uc.revocation = revocation
+}
+
+// revocationStatus returns the revocation status of a provisioning certificate opened with the first password
+// that fits, the issuer looked for in the chain of the PFX. It is nil when revocation is not checked.
+func (uc *UseCase) revocationStatus(ctx context.Context, provisioningCert string, passwords ...string) *dto.RevocationStatus {
+	if uc.revocation == nil {
+		return nil
+	}
+
+	pfxData, err := base64.StdEncoding.DecodeString(provisioningCert)
+	if err != nil {
+		return &dto.RevocationStatus{Status: dto.RevocationUnknown, Reason: "certificate cannot be read"}
+	}
+
+	for _, password := range passwords {
+		_, cert, caCerts, err := pkcs12.DecodeChain(pfxData, password)
+		if err != nil && cert == nil {
+			continue
+		}
+
+		status := uc.revocation.Check(ctx, cert, caCerts)
+
+		return &status
+	}
+
+	return &dto.RevocationStatus{Status: dto.RevocationUnknown, Reason: "certificate cannot be read"}
+}
+
+// storedRevocationStatus returns the revocation status of the provisioning certificate of a stored domain.
+func (uc *UseCase) storedRevocationStatus(ctx context.Context, data *entity.Domain, domainName, tenantID string) *dto.RevocationStatus {
+	uc.loadCert(data, domainName, tenantID)
+
+	passwords := []string{data.ProvisioningCertPassword}
+
+	// the password is encrypted in the database, Vault keeps it in plain text
+	if decrypted, err := uc.safeRequirements.Decrypt(data.ProvisioningCertPassword); err == nil {
+		passwords = append([]string{decrypted}, passwords...)
+	}
+
+	return uc.revocationStatus(ctx, data.ProvisioningCert, passwords...)
+}
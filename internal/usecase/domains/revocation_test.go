@@ -0,0 +1,82 @@
+package domains_test
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/domains"
+)
+
+// revocationStatus answers every revocation check with the same status.
+type revocationStatus dto.RevocationStatus
+
+func (r revocationStatus) Check(_ context.Context, _ *x509.Certificate, _ []*x509.Certificate) dto.RevocationStatus {
+	return dto.RevocationStatus(r)
+}
+
+func TestInsertRevocation(t *testing.T) {
+	t.Parallel()
+
+	domainDTO := &dto.Domain{
+		ProfileName:                   "new-domain",
+		DomainSuffix:                  "newdomain.com",
+		ProvisioningCert:              generateTestPFX(),
+		ProvisioningCertStorageFormat: "PEM",
+		ProvisioningCertPassword:      "P@ssw0rd",
+		TenantID:                      "tenant-id-789",
+	}
+
+	t.Run("refuses a revoked certificate", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, _ := domainsTest(t)
+		useCase.SetRevocation(revocationStatus{Status: dto.RevocationRevoked, Source: "ocsp"})
+
+		_, err := useCase.Insert(context.Background(), domainDTO)
+		require.ErrorAs(t, err, &domains.CertRevokedError{})
+	})
+
+	t.Run("shows the status of the certificate", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, repo := domainsTest(t)
+		useCase.SetRevocation(revocationStatus{Status: dto.RevocationGood, Source: "crl"})
+
+		repo.EXPECT().Insert(context.Background(), gomock.Any()).Return("unique-domain-id", nil)
+		repo.EXPECT().
+			GetByName(context.Background(), domainDTO.ProfileName, domainDTO.TenantID).
+			Return(&entity.Domain{ProfileName: domainDTO.ProfileName, TenantID: domainDTO.TenantID}, nil)
+
+		domain, err := useCase.Insert(context.Background(), domainDTO)
+		require.NoError(t, err)
+		require.Equal(t, &dto.RevocationStatus{Status: dto.RevocationGood, Source: "crl"}, domain.Revocation)
+	})
+}
+
+func TestGetByNameRevocation(t *testing.T) {
+	t.Parallel()
+
+	useCase, repo := domainsTest(t)
+	useCase.SetRevocation(revocationStatus{Status: dto.RevocationRevoked, Source: "ocsp"})
+
+	repo.EXPECT().
+		GetByName(context.Background(), "example-domain", "tenant-id-456").
+		Return(&entity.Domain{
+			ProfileName:              "example-domain",
+			ProvisioningCert:         generateTestPFX(),
+			ProvisioningCertPassword: "P@ssw0rd",
+			TenantID:                 "tenant-id-456",
+		}, nil)
+
+	domain, err := useCase.GetByName(context.Background(), "example-domain", "tenant-id-456")
+	require.NoError(t, err)
+	require.Equal(t, &dto.RevocationStatus{Status: dto.RevocationRevoked, Source: "ocsp"}, domain.Revocation)
+}
+
+var _ domains.Revocation = revocationStatus{}
@@ -14,6 +14,7 @@ import (
 	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
@@ -31,6 +32,7 @@ type UseCase struct {
 	log              logger.Interface
 	safeRequirements security.Cryptor
 	certStore        security.Storager
+	revocation       Revocation
 }
 
 // New -.
@@ -50,6 +52,7 @@ var (
 	ErrCertPassword   = CertPasswordError{Console: ErrDomainsUseCase}
 	ErrCertExpiration = CertExpirationError{Console: ErrDomainsUseCase}
 	ErrCertStore      = CertStoreError{Console: ErrDomainsUseCase}
+	ErrCertRevoked    = CertRevokedError{Console: ErrDomainsUseCase}
 )
 
 // domainCertKey generates the key path for storing domain certificates in Vault.
@@ -112,6 +115,10 @@ func (uc *UseCase) GetByName(ctx context.Context, domainName, tenantID string) (
 
 	d2 := uc.entityToDTO(data)
 
+	if uc.revocation != nil {
+		d2.Revocation = uc.storedRevocationStatus(ctx, data, domainName, tenantID)
+	}
+
 	return d2, nil
 }
 
@@ -127,6 +134,13 @@ func (uc *UseCase) GetByNameWithCert(ctx context.Context, domainName, tenantID s
 		return nil, ErrNotFound
 	}
 
+	uc.loadCert(data, domainName, tenantID)
+
+	return data, nil
+}
+
+// loadCert fills in the certificate of a domain kept in Vault rather than in the database.
+func (uc *UseCase) loadCert(data *entity.Domain, domainName, tenantID string) {
 	// If cert store is available and cert is not in DB, fetch from Vault
 	if uc.certStore != nil && data.ProvisioningCert == "" {
 		certKey := domainCertKey(tenantID, domainName)
@@ -143,8 +157,6 @@ func (uc *UseCase) GetByNameWithCert(ctx context.Context, domainName, tenantID s
 			}
 		}
 	}
-
-	return data, nil
 }
 
 func (uc *UseCase) Delete(ctx context.Context, domainName, tenantID string) error {
@@ -199,6 +211,11 @@ func (uc *UseCase) Insert(ctx context.Context, d *dto.Domain) (*dto.Domain, erro
 		return nil, err
 	}
 
+	revocation := uc.revocationStatus(ctx, d.ProvisioningCert, d.ProvisioningCertPassword)
+	if revocation != nil && revocation.Status == dto.RevocationRevoked {
+		return nil, ErrCertRevoked.Wrap("Insert", "uc.revocation.Check", nil)
+	}
+
 	d1 := uc.dtoToEntity(d)
 	d1.ExpirationDate = cert.NotAfter.Format(time.RFC3339)
 
@@ -241,6 +258,7 @@ func (uc *UseCase) Insert(ctx context.Context, d *dto.Domain) (*dto.Domain, erro
 	}
 
 	d2 := uc.entityToDTO(newDomain)
+	d2.Revocation = revocation
 
 	return d2, nil
 }
@@ -278,7 +296,7 @@ func (uc *UseCase) dtoToEntity(d *dto.Domain) *entity.Domain {
 		Version:                       d.Version,
 	}
 
-	d1.ProvisioningCertPassword, _ = uc.safeRequirements.Encrypt(d.ProvisioningCertPassword)
+	d1.ProvisioningCertPassword, _ = tenantkeys.Encrypt(uc.safeRequirements, d.TenantID, d.ProvisioningCertPassword)
 
 	return d1
 }
@@ -0,0 +1,43 @@
+package energypolicies
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		GetCount(ctx context.Context, tenantID string) (int, error)
+		Get(ctx context.Context, top, skip int, tenantID string) ([]entity.EnergyPolicy, error)
+		GetEnabled(ctx context.Context) ([]entity.EnergyPolicy, error)
+		GetByName(ctx context.Context, name, tenantID string) (*entity.EnergyPolicy, error)
+		Delete(ctx context.Context, name, tenantID string) (bool, error)
+		Update(ctx context.Context, p *entity.EnergyPolicy) (bool, error)
+		Insert(ctx context.Context, p *entity.EnergyPolicy) error
+	}
+	// Devices is the part of the device management use case the policies act through.
+	Devices interface {
+		GetByTags(ctx context.Context, tags, method string, limit, offset int, tenantID string) ([]dto.Device, error)
+		GetPowerState(ctx context.Context, guid string) (dto.PowerState, error)
+		SendPowerAction(ctx context.Context, guid string, action int) (power.PowerActionResponse, error)
+		CreateAlarmOccurrences(ctx context.Context, guid string, alarm dto.AlarmClockOccurrenceInput) (dto.AddAlarmOutput, error)
+		DeleteAlarmOccurrences(ctx context.Context, guid, instanceID string) error
+		HasActiveRedirection(guid string) bool
+		LockDevice(ctx context.Context, guid string, req dto.DeviceLockRequest) (dto.DeviceLock, error)
+		UnlockDevice(ctx context.Context, guid string, force bool) error
+	}
+	Feature interface {
+		GetCount(ctx context.Context, tenantID string) (int, error)
+		Get(ctx context.Context, top, skip int, tenantID string) ([]dto.EnergyPolicy, error)
+		GetByName(ctx context.Context, name, tenantID string) (*dto.EnergyPolicy, error)
+		Delete(ctx context.Context, name, tenantID string) error
+		Update(ctx context.Context, p *dto.EnergyPolicy) (*dto.EnergyPolicy, error)
+		Insert(ctx context.Context, p *dto.EnergyPolicy) (*dto.EnergyPolicy, error)
+		DryRun(ctx context.Context, name, tenantID string) ([]dto.EnergyPolicyReport, error)
+		Start(ctx context.Context)
+	}
+)
@@ -0,0 +1,361 @@
+package energypolicies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+const (
+	checkInterval      = 30 * time.Second
+	devicesPerPage     = 100
+	maxConcurrentCalls = 8
+
+	// alarmPrefix names the alarm clock occurrence that wakes a device powered off by a policy.
+	alarmPrefix = "EnergyPolicy-"
+
+	powerStateOn = 2
+)
+
+var (
+	errNoTimes    = errors.New("a power off or power on time is required")
+	errNoDays     = errors.New("at least one day is required")
+	errInvalidDay = errors.New("invalid day")
+
+	weekdays = map[string]time.Weekday{
+		"sun": time.Sunday,
+		"mon": time.Monday,
+		"tue": time.Tuesday,
+		"wed": time.Wednesday,
+		"thu": time.Thursday,
+		"fri": time.Friday,
+		"sat": time.Saturday,
+	}
+)
+
+// schedule is the parsed timing of a policy.
+type schedule struct {
+	location *time.Location
+	days     map[time.Weekday]bool
+	// times holds the time of day of each configured action, in minutes after midnight.
+	times map[string]int
+}
+
+func parseSchedule(p *entity.EnergyPolicy) (schedule, error) {
+	location, err := time.LoadLocation(p.TimeZone)
+	if err != nil || p.TimeZone == "" || p.TimeZone == "Local" {
+		return schedule{}, fmt.Errorf("invalid time zone %q", p.TimeZone)
+	}
+
+	s := schedule{location: location, days: map[time.Weekday]bool{}, times: map[string]int{}}
+
+	for _, day := range split(p.Days) {
+		weekday, ok := weekdays[day]
+		if !ok {
+			return schedule{}, fmt.Errorf("%w %q", errInvalidDay, day)
+		}
+
+		s.days[weekday] = true
+	}
+
+	if len(s.days) == 0 {
+		return schedule{}, errNoDays
+	}
+
+	for action, value := range map[string]string{dto.EnergyActionPowerOff: p.PowerOffTime, dto.EnergyActionPowerOn: p.PowerOnTime} {
+		if value == "" {
+			continue
+		}
+
+		t, err := time.Parse("15:04", value)
+		if err != nil {
+			return schedule{}, fmt.Errorf("invalid %s time %q", action, value)
+		}
+
+		s.times[action] = t.Hour()*60 + t.Minute()
+	}
+
+	if len(s.times) == 0 {
+		return schedule{}, errNoTimes
+	}
+
+	return s, nil
+}
+
+// actions returns the configured actions, power off first.
+func (s schedule) actions() []string {
+	actions := []string{}
+
+	for _, action := range []string{dto.EnergyActionPowerOff, dto.EnergyActionPowerOn} {
+		if _, ok := s.times[action]; ok {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
+
+// next returns the first time after t the action is scheduled at.
+func (s schedule) next(action string, t time.Time) time.Time {
+	local := t.In(s.location)
+	minutes := s.times[action]
+
+	for i := 0; i <= 7; i++ {
+		candidate := time.Date(local.Year(), local.Month(), local.Day()+i, minutes/60, minutes%60, 0, 0, s.location)
+		if candidate.After(t) && s.days[candidate.Weekday()] {
+			return candidate
+		}
+	}
+
+	return time.Time{}
+}
+
+// due reports whether the action is scheduled in the minute of t.
+func (s schedule) due(action string, t time.Time) bool {
+	minutes, ok := s.times[action]
+	local := t.In(s.location)
+
+	return ok && s.days[local.Weekday()] && local.Hour()*60+local.Minute() == minutes
+}
+
+// Start runs the enabled policies in the background until ctx is done.
+func (uc *UseCase) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				uc.RunDue(ctx)
+			}
+		}
+	}()
+}
+
+// RunDue runs the actions of the enabled policies scheduled in the current minute, each once.
+func (uc *UseCase) RunDue(ctx context.Context) {
+	now := uc.now()
+	minute := now.Truncate(time.Minute)
+
+	policies, err := uc.repo.GetEnabled(ctx)
+	if err != nil {
+		uc.log.Error(err, "energypolicies - RunDue - uc.repo.GetEnabled")
+
+		return
+	}
+
+	for i := range policies {
+		p := &policies[i]
+
+		s, err := parseSchedule(p)
+		if err != nil {
+			uc.log.Warn("energypolicies - RunDue: skipping policy %s: %v", p.Name, err)
+
+			continue
+		}
+
+		for _, action := range s.actions() {
+			key := p.TenantID + "/" + p.Name + "/" + action
+			if !s.due(action, now) || uc.lastRun[key].Equal(minute) {
+				continue
+			}
+
+			uc.lastRun[key] = minute
+
+			uc.run(ctx, p, s, action, now)
+		}
+	}
+}
+
+// run applies the action of a policy to its devices that qualify for it.
+func (uc *UseCase) run(ctx context.Context, p *entity.EnergyPolicy, s schedule, action string, now time.Time) {
+	results, err := uc.evaluate(ctx, p, action)
+	if err != nil {
+		uc.log.Error(err, "energypolicies - run - uc.evaluate")
+
+		return
+	}
+
+	var applied atomic.Int32
+
+	uc.forEach(len(results), func(i int) {
+		if results[i].Decision != action {
+			return
+		}
+
+		if err := uc.apply(ctx, p, s, action, results[i].GUID, now); err != nil {
+			uc.log.Error(err, "energypolicies - run - uc.apply")
+
+			return
+		}
+
+		applied.Add(1)
+	})
+
+	uc.log.Info("energypolicies - run: policy %s: %s sent to %d of %d devices", p.Name, action, applied.Load(), len(results))
+}
+
+// apply sends the action to a device. Before powering a device off it sets an alarm clock occurrence
+// that powers it on again at the next power on time, so that it wakes even if the console is not running.
+func (uc *UseCase) apply(ctx context.Context, p *entity.EnergyPolicy, s schedule, action, guid string, now time.Time) error {
+	// a device a user has locked is left alone
+	ctx = devices.WithLockHolder(ctx, "energy policy "+p.Name)
+	if _, err := uc.devices.LockDevice(ctx, guid, dto.DeviceLockRequest{Reason: "energy policy"}); err != nil {
+		return err
+	}
+
+	defer func() { _ = uc.devices.UnlockDevice(ctx, guid, false) }()
+
+	if action == dto.EnergyActionPowerOn {
+		_, err := uc.devices.SendPowerAction(ctx, guid, devices.CIMPMSPowerOn)
+
+		return err
+	}
+
+	if _, ok := s.times[dto.EnergyActionPowerOn]; ok {
+		name := alarmPrefix + p.Name
+
+		// an occurrence left over from an earlier run would make the new one fail
+		_ = uc.devices.DeleteAlarmOccurrences(ctx, guid, name)
+
+		alarm := dto.AlarmClockOccurrenceInput{
+			ElementName:        name,
+			StartTime:          s.next(dto.EnergyActionPowerOn, now).UTC(),
+			DeleteOnCompletion: true,
+		}
+
+		if _, err := uc.devices.CreateAlarmOccurrences(ctx, guid, alarm); err != nil {
+			uc.log.Warn("energypolicies - apply: device %s will not wake on its own: %v", guid, err)
+		}
+	}
+
+	_, err := uc.devices.SendPowerAction(ctx, guid, devices.GracefulShutdown)
+
+	return err
+}
+
+// DryRun reports what each action of a policy would do to its devices if it ran now.
+func (uc *UseCase) DryRun(ctx context.Context, name, tenantID string) ([]dto.EnergyPolicyReport, error) {
+	p, err := uc.repo.GetByName(ctx, name, tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("DryRun", "uc.repo.GetByName", err)
+	}
+
+	if p == nil {
+		return nil, ErrNotFound
+	}
+
+	s, err := parseSchedule(p)
+	if err != nil {
+		return nil, ErrValidation.Wrap("DryRun", "parseSchedule", err)
+	}
+
+	now := uc.now()
+	reports := []dto.EnergyPolicyReport{}
+
+	for _, action := range s.actions() {
+		results, err := uc.evaluate(ctx, p, action)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, dto.EnergyPolicyReport{
+			Policy:      p.Name,
+			Action:      action,
+			ScheduledAt: s.next(action, now),
+			Devices:     results,
+		})
+	}
+
+	return reports, nil
+}
+
+// evaluate decides for each device of the policy whether the action applies to it.
+func (uc *UseCase) evaluate(ctx context.Context, p *entity.EnergyPolicy, action string) ([]dto.EnergyPolicyDevice, error) {
+	exceptions := map[string]bool{}
+	for _, guid := range split(p.Exceptions) {
+		exceptions[guid] = true
+	}
+
+	results := []dto.EnergyPolicyDevice{}
+
+	for skip := 0; ; skip += devicesPerPage {
+		page, err := uc.devices.GetByTags(ctx, p.Tags, "OR", devicesPerPage, skip, p.TenantID)
+		if err != nil {
+			return nil, ErrDatabase.Wrap("evaluate", "uc.devices.GetByTags", err)
+		}
+
+		for i := range page {
+			results = append(results, dto.EnergyPolicyDevice{GUID: page[i].GUID, Hostname: page[i].Hostname})
+		}
+
+		if len(page) < devicesPerPage {
+			break
+		}
+	}
+
+	uc.forEach(len(results), func(i int) {
+		results[i].Decision, results[i].Reason = uc.decide(ctx, results[i].GUID, action, exceptions)
+	})
+
+	return results, nil
+}
+
+// decide returns the action for a device that is on, or off for a power on, and not in use; skip and why otherwise.
+func (uc *UseCase) decide(ctx context.Context, guid, action string, exceptions map[string]bool) (decision, reason string) {
+	if exceptions[strings.ToLower(guid)] {
+		return dto.EnergyActionSkip, "listed as exception"
+	}
+
+	if action == dto.EnergyActionPowerOff && uc.devices.HasActiveRedirection(guid) {
+		return dto.EnergyActionSkip, "redirection session active"
+	}
+
+	state, err := uc.devices.GetPowerState(ctx, guid)
+	if err != nil && state.PowerState == 0 {
+		return dto.EnergyActionSkip, "power state unavailable: " + err.Error()
+	}
+
+	if action == dto.EnergyActionPowerOff && state.PowerState != powerStateOn {
+		return dto.EnergyActionSkip, "not powered on"
+	}
+
+	if action == dto.EnergyActionPowerOn && state.PowerState == powerStateOn {
+		return dto.EnergyActionSkip, "already powered on"
+	}
+
+	return action, ""
+}
+
+// forEach calls fn for the indexes 0 to n-1, at most maxConcurrentCalls at a time, and waits for all calls to return.
+func (uc *UseCase) forEach(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, maxConcurrentCalls)
+
+	for i := range n {
+		wg.Add(1)
+
+		sem <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			fn(i)
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,245 @@
+package energypolicies
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errUnreachable = errors.New("device unreachable")
+
+func officePolicy() entity.EnergyPolicy {
+	return entity.EnergyPolicy{
+		Name:         "office",
+		Tags:         "office",
+		TimeZone:     "Europe/Berlin",
+		Days:         "mon,tue,wed,thu,fri",
+		PowerOffTime: "19:00",
+		PowerOnTime:  "07:00",
+		Exceptions:   "guid3",
+		Enabled:      true,
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		change  func(*entity.EnergyPolicy)
+		actions []string
+		err     bool
+	}{
+		{
+			name:    "power off and on",
+			change:  func(*entity.EnergyPolicy) {},
+			actions: []string{dto.EnergyActionPowerOff, dto.EnergyActionPowerOn},
+		},
+		{
+			name:    "power off only",
+			change:  func(p *entity.EnergyPolicy) { p.PowerOnTime = "" },
+			actions: []string{dto.EnergyActionPowerOff},
+		},
+		{
+			name:   "no times",
+			change: func(p *entity.EnergyPolicy) { p.PowerOffTime, p.PowerOnTime = "", "" },
+			err:    true,
+		},
+		{
+			name:   "invalid time",
+			change: func(p *entity.EnergyPolicy) { p.PowerOffTime = "25:00" },
+			err:    true,
+		},
+		{
+			name:   "invalid day",
+			change: func(p *entity.EnergyPolicy) { p.Days = "mon,someday" },
+			err:    true,
+		},
+		{
+			name:   "no days",
+			change: func(p *entity.EnergyPolicy) { p.Days = "" },
+			err:    true,
+		},
+		{
+			name:   "local time zone",
+			change: func(p *entity.EnergyPolicy) { p.TimeZone = "Local" },
+			err:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := officePolicy()
+			tc.change(&p)
+
+			s, err := parseSchedule(&p)
+			if tc.err {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.actions, s.actions())
+		})
+	}
+}
+
+func TestScheduleNextAndDue(t *testing.T) {
+	t.Parallel()
+
+	p := officePolicy()
+
+	s, err := parseSchedule(&p)
+	require.NoError(t, err)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	// Friday 2024-03-29 19:00 in Berlin is 18:00 UTC
+	friday := time.Date(2024, 3, 29, 18, 0, 30, 0, time.UTC)
+
+	require.True(t, s.due(dto.EnergyActionPowerOff, friday))
+	require.False(t, s.due(dto.EnergyActionPowerOn, friday))
+	require.False(t, s.due(dto.EnergyActionPowerOff, friday.Add(time.Minute)))
+	require.False(t, s.due(dto.EnergyActionPowerOff, friday.Add(24*time.Hour)))
+
+	// the weekend is skipped and the switch to summer time on Sunday is respected
+	require.Equal(t, time.Date(2024, 4, 1, 7, 0, 0, 0, berlin), s.next(dto.EnergyActionPowerOn, friday))
+	require.Equal(t, "2024-04-01T05:00:00Z", s.next(dto.EnergyActionPowerOn, friday).UTC().Format(time.RFC3339))
+	require.Equal(t, time.Date(2024, 4, 1, 19, 0, 0, 0, berlin), s.next(dto.EnergyActionPowerOff, friday))
+}
+
+func TestRunDue(t *testing.T) {
+	t.Parallel()
+
+	// Friday 2024-03-29 19:00 in Berlin
+	powerOffTime := time.Date(2024, 3, 29, 18, 0, 10, 0, time.UTC)
+	wake := time.Date(2024, 4, 1, 5, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockEnergyPolicyRepository(ctrl)
+	d := mocks.NewMockEnergyPolicyDevices(ctrl)
+
+	repo.EXPECT().GetEnabled(gomock.Any()).Return([]entity.EnergyPolicy{officePolicy()}, nil).Times(2)
+	d.EXPECT().GetByTags(gomock.Any(), "office", "OR", 100, 0, "").
+		Return([]dto.Device{{GUID: "guid1"}, {GUID: "guid2"}, {GUID: "guid3"}, {GUID: "guid4"}, {GUID: "guid5"}, {GUID: "guid6"}}, nil)
+
+	// guid1 is idle and powered off with a wake alarm
+	d.EXPECT().HasActiveRedirection("guid1").Return(false)
+	d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 2}, nil)
+	d.EXPECT().LockDevice(gomock.Any(), "guid1", dto.DeviceLockRequest{Reason: "energy policy"}).Return(dto.DeviceLock{GUID: "guid1"}, nil)
+	d.EXPECT().UnlockDevice(gomock.Any(), "guid1", false).Return(nil)
+	d.EXPECT().DeleteAlarmOccurrences(gomock.Any(), "guid1", "EnergyPolicy-office").Return(errUnreachable)
+	d.EXPECT().CreateAlarmOccurrences(gomock.Any(), "guid1", dto.AlarmClockOccurrenceInput{
+		ElementName:        "EnergyPolicy-office",
+		StartTime:          wake,
+		DeleteOnCompletion: true,
+	}).Return(dto.AddAlarmOutput{}, nil)
+	d.EXPECT().SendPowerAction(gomock.Any(), "guid1", devices.GracefulShutdown).Return(power.PowerActionResponse{}, nil)
+
+	// guid2 is in use, guid3 is an exception, guid4 is already off and guid5 cannot be reached
+	d.EXPECT().HasActiveRedirection("guid2").Return(true)
+	d.EXPECT().HasActiveRedirection("guid4").Return(false)
+	d.EXPECT().GetPowerState(gomock.Any(), "guid4").Return(dto.PowerState{PowerState: 8}, nil)
+	d.EXPECT().HasActiveRedirection("guid5").Return(false)
+	d.EXPECT().GetPowerState(gomock.Any(), "guid5").Return(dto.PowerState{}, errUnreachable)
+
+	// guid6 is locked by a user
+	d.EXPECT().HasActiveRedirection("guid6").Return(false)
+	d.EXPECT().GetPowerState(gomock.Any(), "guid6").Return(dto.PowerState{PowerState: 2}, nil)
+	d.EXPECT().LockDevice(gomock.Any(), "guid6", dto.DeviceLockRequest{Reason: "energy policy"}).
+		Return(dto.DeviceLock{}, devices.LockedError{Holder: "admin", ExpiresAt: wake})
+
+	uc := New(repo, d, logger.New("error"))
+	uc.now = func() time.Time { return powerOffTime }
+
+	uc.RunDue(context.Background())
+
+	// a second check within the same minute does not run the policy again
+	uc.now = func() time.Time { return powerOffTime.Add(30 * time.Second) }
+
+	uc.RunDue(context.Background())
+}
+
+func TestDecide(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		action   string
+		mock     func(*mocks.MockEnergyPolicyDevices)
+		decision string
+		reason   string
+	}{
+		{
+			name:   "power on a device that is off",
+			action: dto.EnergyActionPowerOn,
+			mock: func(d *mocks.MockEnergyPolicyDevices) {
+				d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 8}, nil)
+			},
+			decision: dto.EnergyActionPowerOn,
+		},
+		{
+			name:   "power on a device that is on",
+			action: dto.EnergyActionPowerOn,
+			mock: func(d *mocks.MockEnergyPolicyDevices) {
+				d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 2}, nil)
+			},
+			decision: dto.EnergyActionSkip,
+			reason:   "already powered on",
+		},
+		{
+			name:   "power off a sleeping device",
+			action: dto.EnergyActionPowerOff,
+			mock: func(d *mocks.MockEnergyPolicyDevices) {
+				d.EXPECT().HasActiveRedirection("guid1").Return(false)
+				d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 4}, nil)
+			},
+			decision: dto.EnergyActionSkip,
+			reason:   "not powered on",
+		},
+		{
+			name:     "exception",
+			action:   dto.EnergyActionPowerOn,
+			mock:     func(*mocks.MockEnergyPolicyDevices) {},
+			decision: dto.EnergyActionSkip,
+			reason:   "listed as exception",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			d := mocks.NewMockEnergyPolicyDevices(ctrl)
+			tc.mock(d)
+
+			exceptions := map[string]bool{}
+			if tc.name == "exception" {
+				exceptions["guid1"] = true
+			}
+
+			uc := New(mocks.NewMockEnergyPolicyRepository(ctrl), d, logger.New("error"))
+
+			decision, reason := uc.decide(context.Background(), "guid1", tc.action, exceptions)
+			require.Equal(t, tc.decision, decision)
+			require.Equal(t, tc.reason, reason)
+		})
+	}
+}
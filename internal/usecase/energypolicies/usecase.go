@@ -0,0 +1,167 @@
+package energypolicies
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/guid"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// UseCase -.
+type UseCase struct {
+	repo    Repository
+	devices Devices
+	log     logger.Interface
+	now     func() time.Time
+	// lastRun holds the minute each policy action last ran in, so that it runs once per scheduled time.
+	lastRun map[string]time.Time
+}
+
+var (
+	ErrEnergyPoliciesUseCase = consoleerrors.CreateConsoleError("EnergyPoliciesUseCase")
+	ErrDatabase              = sqldb.DatabaseError{Console: ErrEnergyPoliciesUseCase}
+	ErrNotFound              = sqldb.NotFoundError{Console: ErrEnergyPoliciesUseCase}
+	ErrValidation            = dto.NotValidError{Console: ErrEnergyPoliciesUseCase}
+)
+
+// New -.
+func New(r Repository, d Devices, log logger.Interface) *UseCase {
+	return &UseCase{
+		repo:    r,
+		devices: d,
+		log:     log,
+		now:     time.Now,
+		lastRun: make(map[string]time.Time),
+	}
+}
+
+func (uc *UseCase) GetCount(ctx context.Context, tenantID string) (int, error) {
+	count, err := uc.repo.GetCount(ctx, tenantID)
+	if err != nil {
+		return 0, ErrDatabase.Wrap("GetCount", "uc.repo.GetCount", err)
+	}
+
+	return count, nil
+}
+
+func (uc *UseCase) Get(ctx context.Context, top, skip int, tenantID string) ([]dto.EnergyPolicy, error) {
+	data, err := uc.repo.Get(ctx, top, skip, tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("Get", "uc.repo.Get", err)
+	}
+
+	d1 := make([]dto.EnergyPolicy, len(data))
+
+	for i := range data {
+		d1[i] = *uc.entityToDTO(&data[i])
+	}
+
+	return d1, nil
+}
+
+func (uc *UseCase) GetByName(ctx context.Context, name, tenantID string) (*dto.EnergyPolicy, error) {
+	data, err := uc.repo.GetByName(ctx, name, tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("GetByName", "uc.repo.GetByName", err)
+	}
+
+	if data == nil {
+		return nil, ErrNotFound
+	}
+
+	return uc.entityToDTO(data), nil
+}
+
+func (uc *UseCase) Delete(ctx context.Context, name, tenantID string) error {
+	deleted, err := uc.repo.Delete(ctx, name, tenantID)
+	if err != nil {
+		return ErrDatabase.Wrap("Delete", "uc.repo.Delete", err)
+	}
+
+	if !deleted {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (uc *UseCase) Update(ctx context.Context, p *dto.EnergyPolicy) (*dto.EnergyPolicy, error) {
+	d1 := uc.dtoToEntity(p)
+	if _, err := parseSchedule(d1); err != nil {
+		return nil, ErrValidation.Wrap("Update", "parseSchedule", err)
+	}
+
+	updated, err := uc.repo.Update(ctx, d1)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("Update", "uc.repo.Update", err)
+	}
+
+	if !updated {
+		return nil, ErrNotFound
+	}
+
+	return uc.GetByName(ctx, p.Name, p.TenantID)
+}
+
+func (uc *UseCase) Insert(ctx context.Context, p *dto.EnergyPolicy) (*dto.EnergyPolicy, error) {
+	d1 := uc.dtoToEntity(p)
+	if _, err := parseSchedule(d1); err != nil {
+		return nil, ErrValidation.Wrap("Insert", "parseSchedule", err)
+	}
+
+	if err := uc.repo.Insert(ctx, d1); err != nil {
+		return nil, ErrDatabase.Wrap("Insert", "uc.repo.Insert", err)
+	}
+
+	return uc.GetByName(ctx, p.Name, p.TenantID)
+}
+
+// convert dto.EnergyPolicy to entity.EnergyPolicy.
+func (uc *UseCase) dtoToEntity(d *dto.EnergyPolicy) *entity.EnergyPolicy {
+	exceptions := make([]string, len(d.Exceptions))
+	for i, id := range d.Exceptions {
+		exceptions[i] = guid.Normalize(id)
+	}
+
+	return &entity.EnergyPolicy{
+		Name:         d.Name,
+		Tags:         strings.Join(d.Tags, ","),
+		TimeZone:     d.TimeZone,
+		Days:         strings.ToLower(strings.Join(d.Days, ",")),
+		PowerOffTime: d.PowerOffTime,
+		PowerOnTime:  d.PowerOnTime,
+		Exceptions:   strings.Join(exceptions, ","),
+		Enabled:      d.Enabled,
+		TenantID:     d.TenantID,
+	}
+}
+
+// convert entity.EnergyPolicy to dto.EnergyPolicy.
+func (uc *UseCase) entityToDTO(d *entity.EnergyPolicy) *dto.EnergyPolicy {
+	return &dto.EnergyPolicy{
+		Name:         d.Name,
+		Tags:         split(d.Tags),
+		TimeZone:     d.TimeZone,
+		Days:         split(d.Days),
+		PowerOffTime: d.PowerOffTime,
+		PowerOnTime:  d.PowerOnTime,
+		Exceptions:   split(d.Exceptions),
+		Enabled:      d.Enabled,
+		TenantID:     d.TenantID,
+	}
+}
+
+// split turns a comma separated column into a list, an empty one for an empty column.
+func split(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+
+	return strings.Split(s, ",")
+}
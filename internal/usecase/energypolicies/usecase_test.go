@@ -0,0 +1,222 @@
+package energypolicies_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/energypolicies"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errTest = errors.New("test error")
+
+func energyPolicyTest(t *testing.T) (*energypolicies.UseCase, *mocks.MockEnergyPolicyRepository, *mocks.MockEnergyPolicyDevices) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockEnergyPolicyRepository(ctrl)
+	d := mocks.NewMockEnergyPolicyDevices(ctrl)
+
+	return energypolicies.New(repo, d, logger.New("error")), repo, d
+}
+
+func policyEntity() *entity.EnergyPolicy {
+	return &entity.EnergyPolicy{
+		Name:         "office",
+		Tags:         "office,lab",
+		TimeZone:     "Europe/Berlin",
+		Days:         "mon,fri",
+		PowerOffTime: "19:00",
+		PowerOnTime:  "07:00",
+		Exceptions:   "123e4567-e89b-12d3-a456-426614174000",
+		Enabled:      true,
+	}
+}
+
+func policyDTO() *dto.EnergyPolicy {
+	return &dto.EnergyPolicy{
+		Name:         "office",
+		Tags:         []string{"office", "lab"},
+		TimeZone:     "Europe/Berlin",
+		Days:         []string{"mon", "fri"},
+		PowerOffTime: "19:00",
+		PowerOnTime:  "07:00",
+		Exceptions:   []string{"123e4567-e89b-12d3-a456-426614174000"},
+		Enabled:      true,
+	}
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	uc, repo, _ := energyPolicyTest(t)
+
+	empty := policyEntity()
+	empty.Exceptions = ""
+
+	repo.EXPECT().Get(context.Background(), 10, 0, "").Return([]entity.EnergyPolicy{*policyEntity(), *empty}, nil)
+
+	policies, err := uc.Get(context.Background(), 10, 0, "")
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	require.Equal(t, *policyDTO(), policies[0])
+	require.Equal(t, []string{}, policies[1].Exceptions)
+
+	repo.EXPECT().Get(context.Background(), 10, 0, "").Return(nil, errTest)
+
+	_, err = uc.Get(context.Background(), 10, 0, "")
+	require.IsType(t, energypolicies.ErrDatabase, err)
+}
+
+func TestGetByName(t *testing.T) {
+	t.Parallel()
+
+	uc, repo, _ := energyPolicyTest(t)
+
+	repo.EXPECT().GetByName(context.Background(), "office", "").Return(policyEntity(), nil)
+
+	policy, err := uc.GetByName(context.Background(), "office", "")
+	require.NoError(t, err)
+	require.Equal(t, policyDTO(), policy)
+
+	repo.EXPECT().GetByName(context.Background(), "missing", "").Return(nil, nil)
+
+	_, err = uc.GetByName(context.Background(), "missing", "")
+	require.Equal(t, energypolicies.ErrNotFound, err)
+}
+
+func TestInsert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		policy func() *dto.EnergyPolicy
+		mock   func(*mocks.MockEnergyPolicyRepository)
+		err    error
+	}{
+		{
+			name:   "success",
+			policy: policyDTO,
+			mock: func(repo *mocks.MockEnergyPolicyRepository) {
+				repo.EXPECT().Insert(context.Background(), policyEntity()).Return(nil)
+				repo.EXPECT().GetByName(context.Background(), "office", "").Return(policyEntity(), nil)
+			},
+		},
+		{
+			name: "exceptions and days are stored in lower case",
+			policy: func() *dto.EnergyPolicy {
+				p := policyDTO()
+				p.Days = []string{"MON", "Fri"}
+				p.Exceptions = []string{"123E4567-E89B-12D3-A456-426614174000"}
+
+				return p
+			},
+			mock: func(repo *mocks.MockEnergyPolicyRepository) {
+				repo.EXPECT().Insert(context.Background(), policyEntity()).Return(nil)
+				repo.EXPECT().GetByName(context.Background(), "office", "").Return(policyEntity(), nil)
+			},
+		},
+		{
+			name: "no times",
+			policy: func() *dto.EnergyPolicy {
+				p := policyDTO()
+				p.PowerOffTime, p.PowerOnTime = "", ""
+
+				return p
+			},
+			mock: func(*mocks.MockEnergyPolicyRepository) {},
+			err:  energypolicies.ErrValidation,
+		},
+		{
+			name:   "database error",
+			policy: policyDTO,
+			mock: func(repo *mocks.MockEnergyPolicyRepository) {
+				repo.EXPECT().Insert(context.Background(), policyEntity()).Return(errTest)
+			},
+			err: energypolicies.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, repo, _ := energyPolicyTest(t)
+			tc.mock(repo)
+
+			policy, err := uc.Insert(context.Background(), tc.policy())
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, policyDTO(), policy)
+		})
+	}
+}
+
+func TestUpdateAndDelete(t *testing.T) {
+	t.Parallel()
+
+	uc, repo, _ := energyPolicyTest(t)
+
+	repo.EXPECT().Update(context.Background(), policyEntity()).Return(false, nil)
+
+	_, err := uc.Update(context.Background(), policyDTO())
+	require.Equal(t, energypolicies.ErrNotFound, err)
+
+	repo.EXPECT().Update(context.Background(), policyEntity()).Return(true, nil)
+	repo.EXPECT().GetByName(context.Background(), "office", "").Return(policyEntity(), nil)
+
+	policy, err := uc.Update(context.Background(), policyDTO())
+	require.NoError(t, err)
+	require.Equal(t, policyDTO(), policy)
+
+	repo.EXPECT().Delete(context.Background(), "office", "").Return(true, nil)
+	require.NoError(t, uc.Delete(context.Background(), "office", ""))
+
+	repo.EXPECT().Delete(context.Background(), "office", "").Return(false, nil)
+	require.Equal(t, energypolicies.ErrNotFound, uc.Delete(context.Background(), "office", ""))
+}
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+
+	uc, repo, d := energyPolicyTest(t)
+
+	repo.EXPECT().GetByName(context.Background(), "office", "").Return(policyEntity(), nil)
+	d.EXPECT().GetByTags(context.Background(), "office,lab", "OR", 100, 0, "").
+		Return([]dto.Device{{GUID: "guid1", Hostname: "pc1"}, {GUID: "123e4567-e89b-12d3-a456-426614174000", Hostname: "pc2"}}, nil).Times(2)
+	d.EXPECT().HasActiveRedirection("guid1").Return(false)
+	d.EXPECT().GetPowerState(context.Background(), "guid1").Return(dto.PowerState{PowerState: 2}, nil).Times(2)
+
+	reports, err := uc.DryRun(context.Background(), "office", "")
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+
+	require.Equal(t, dto.EnergyActionPowerOff, reports[0].Action)
+	require.Equal(t, []dto.EnergyPolicyDevice{
+		{GUID: "guid1", Hostname: "pc1", Decision: dto.EnergyActionPowerOff},
+		{GUID: "123e4567-e89b-12d3-a456-426614174000", Hostname: "pc2", Decision: dto.EnergyActionSkip, Reason: "listed as exception"},
+	}, reports[0].Devices)
+	require.Equal(t, 19, reports[0].ScheduledAt.Hour())
+
+	require.Equal(t, dto.EnergyActionPowerOn, reports[1].Action)
+	require.Equal(t, dto.EnergyActionSkip, reports[1].Devices[0].Decision)
+	require.Equal(t, "already powered on", reports[1].Devices[0].Reason)
+	require.Equal(t, 7, reports[1].ScheduledAt.Hour())
+
+	repo.EXPECT().GetByName(context.Background(), "missing", "").Return(nil, nil)
+
+	_, err = uc.DryRun(context.Background(), "missing", "")
+	require.Equal(t, energypolicies.ErrNotFound, err)
+}
@@ -0,0 +1,37 @@
+package enrollment
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		Insert(ctx context.Context, t entity.EnrollmentToken) error
+		Get(ctx context.Context, tenantID string) ([]entity.EnrollmentToken, error)
+		GetByHash(ctx context.Context, hash string) (*entity.EnrollmentToken, error)
+		Delete(ctx context.Context, id, tenantID string) (bool, error)
+		Redeem(ctx context.Context, id, guid string, at time.Time) (bool, error)
+		Release(ctx context.Context, id string) error
+	}
+
+	// Devices is the part of the devices use case the enrollment depends on to create the enrolled devices.
+	Devices interface {
+		Insert(ctx context.Context, d *dto.Device) (*dto.Device, error)
+	}
+
+	// CIRAConfigs is the part of the CIRA configs use case the enrollment depends on.
+	CIRAConfigs interface {
+		GetByName(ctx context.Context, configName, tenantID string) (*dto.CIRAConfig, error)
+	}
+
+	Feature interface {
+		Mint(ctx context.Context, tenantID, user string, req dto.EnrollmentTokenRequest) (dto.EnrollmentToken, error)
+		Get(ctx context.Context, tenantID string) ([]dto.EnrollmentToken, error)
+		Delete(ctx context.Context, id, tenantID string) error
+		Enroll(ctx context.Context, req dto.EnrollmentRequest) (dto.Enrollment, error)
+	}
+)
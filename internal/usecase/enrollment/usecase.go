@@ -0,0 +1,303 @@
+package enrollment
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/amtpassword"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/guid"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	// tokenBytes is the number of random bytes of a token.
+	tokenBytes = 32
+
+	// defaultAMTUsername is the AMT admin an enrolled device is managed with when the request does not name one.
+	defaultAMTUsername = "admin"
+
+	// mpsPasswordLength is the length of the MPS password generated for an enrolled device.
+	mpsPasswordLength = 16
+)
+
+var (
+	ErrEnrollmentUseCase = consoleerrors.CreateConsoleError("EnrollmentUseCase")
+	ErrDatabase          = sqldb.DatabaseError{Console: ErrEnrollmentUseCase}
+	ErrNotFound          = sqldb.NotFoundError{Console: ErrEnrollmentUseCase}
+	ErrValidation        = dto.NotValidError{Console: ErrEnrollmentUseCase}
+)
+
+// TokenError is returned for an enrollment token that is unknown, used already or expired. The reason is logged,
+// not told to the caller.
+type TokenError struct{}
+
+func (e TokenError) Error() string {
+	return "the enrollment token is not valid"
+}
+
+func (e TokenError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeUnauthorized
+}
+
+// UseCase mints the single-use tokens a provisioning agent enrolls a device with: the device is created from the
+// identity the agent gives and the settings of the token, and gets MPS credentials of its own to connect over CIRA.
+type UseCase struct {
+	repo        Repository
+	devices     Devices
+	ciraConfigs CIRAConfigs
+	ttl         time.Duration
+	maxTTL      time.Duration
+	log         logger.Interface
+	now         func() time.Time
+}
+
+// New creates the enrollment of the devices. ttl is how long a token can be redeemed when the request minting it
+// does not say, maxTTL the longest it may be.
+func New(repo Repository, devices Devices, ciraConfigs CIRAConfigs, ttl, maxTTL time.Duration, log logger.Interface) *UseCase {
+	return &UseCase{
+		repo:        repo,
+		devices:     devices,
+		ciraConfigs: ciraConfigs,
+		ttl:         ttl,
+		maxTTL:      maxTTL,
+		log:         log,
+		now:         time.Now,
+	}
+}
+
+// Mint creates an enrollment token of a tenant. The token itself is returned here only, the console keeps its hash.
+func (uc *UseCase) Mint(ctx context.Context, tenantID, user string, req dto.EnrollmentTokenRequest) (dto.EnrollmentToken, error) {
+	ttl := uc.ttl
+	if req.TTL > 0 {
+		ttl = time.Duration(req.TTL) * time.Second
+	}
+
+	if ttl > uc.maxTTL {
+		return dto.EnrollmentToken{}, ErrValidation.Wrap("Mint", "validate",
+			fmt.Errorf("an enrollment token lasts at most %d seconds", int(uc.maxTTL.Seconds())))
+	}
+
+	if _, err := uc.ciraConfig(ctx, req.CIRAConfig, tenantID); err != nil {
+		return dto.EnrollmentToken{}, err
+	}
+
+	raw := make([]byte, tokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return dto.EnrollmentToken{}, ErrEnrollmentUseCase.Wrap("Mint", "rand.Read", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	now := uc.now().UTC().Truncate(time.Second)
+
+	t := entity.EnrollmentToken{
+		ID:          uuid.NewString(),
+		TokenHash:   hashToken(token),
+		TenantID:    tenantID,
+		Description: req.Description,
+		CIRAConfig:  req.CIRAConfig,
+		Tags:        strings.Join(req.Tags, ","),
+		CreatedBy:   user,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+
+	if err := uc.repo.Insert(ctx, t); err != nil {
+		return dto.EnrollmentToken{}, ErrDatabase.Wrap("Mint", "uc.repo.Insert", err)
+	}
+
+	uc.log.Info("enrollment - Mint: token %s for the CIRA config %s minted by %s until %s",
+		t.ID, t.CIRAConfig, user, t.ExpiresAt.Format(time.RFC3339))
+
+	d := toDTO(t)
+	d.Token = token
+
+	return d, nil
+}
+
+// Get returns the enrollment tokens of a tenant, newest first.
+func (uc *UseCase) Get(ctx context.Context, tenantID string) ([]dto.EnrollmentToken, error) {
+	tokens, err := uc.repo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("Get", "uc.repo.Get", err)
+	}
+
+	d := make([]dto.EnrollmentToken, len(tokens))
+	for i := range tokens {
+		d[i] = toDTO(tokens[i])
+	}
+
+	return d, nil
+}
+
+// Delete revokes an enrollment token of a tenant.
+func (uc *UseCase) Delete(ctx context.Context, id, tenantID string) error {
+	deleted, err := uc.repo.Delete(ctx, id, tenantID)
+	if err != nil {
+		return ErrDatabase.Wrap("Delete", "uc.repo.Delete", err)
+	}
+
+	if !deleted {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Enroll redeems an enrollment token for a device: the device is created in the tenant of the token with its
+// tags and MPS credentials of its own, and the CIRA settings it connects with are returned. A token that is
+// unknown, used or expired fails with a TokenError. The token is usable again when the device is not created.
+func (uc *UseCase) Enroll(ctx context.Context, req dto.EnrollmentRequest) (dto.Enrollment, error) {
+	deviceGUID, err := guid.Parse(req.GUID)
+	if err != nil {
+		return dto.Enrollment{}, ErrValidation.Wrap("Enroll", "guid.Parse", err)
+	}
+
+	t, err := uc.repo.GetByHash(ctx, hashToken(req.Token))
+	if err != nil {
+		return dto.Enrollment{}, ErrDatabase.Wrap("Enroll", "uc.repo.GetByHash", err)
+	}
+
+	now := uc.now().UTC().Truncate(time.Second)
+
+	switch {
+	case t == nil:
+		uc.log.Warn("enrollment - Enroll: device %s presented an unknown token", deviceGUID)
+
+		return dto.Enrollment{}, TokenError{}
+	case !t.UsedAt.IsZero():
+		uc.log.Warn("enrollment - Enroll: device %s presented the token %s used by %s", deviceGUID, t.ID, t.UsedBy)
+
+		return dto.Enrollment{}, TokenError{}
+	case !now.Before(t.ExpiresAt):
+		uc.log.Warn("enrollment - Enroll: device %s presented the token %s expired at %s", deviceGUID, t.ID, t.ExpiresAt.Format(time.RFC3339))
+
+		return dto.Enrollment{}, TokenError{}
+	}
+
+	cira, err := uc.ciraConfig(ctx, t.CIRAConfig, t.TenantID)
+	if err != nil {
+		return dto.Enrollment{}, err
+	}
+
+	password, err := amtpassword.Generate(mpsPasswordLength)
+	if err != nil {
+		return dto.Enrollment{}, ErrEnrollmentUseCase.Wrap("Enroll", "amtpassword.Generate", err)
+	}
+
+	// of concurrent enrollments with the same token only one redeems it
+	redeemed, err := uc.repo.Redeem(ctx, t.ID, deviceGUID, now)
+	if err != nil {
+		return dto.Enrollment{}, ErrDatabase.Wrap("Enroll", "uc.repo.Redeem", err)
+	}
+
+	if !redeemed {
+		uc.log.Warn("enrollment - Enroll: device %s lost the token %s to another enrollment", deviceGUID, t.ID)
+
+		return dto.Enrollment{}, TokenError{}
+	}
+
+	device := &dto.Device{
+		GUID:         deviceGUID,
+		Hostname:     req.Hostname,
+		FriendlyName: req.FriendlyName,
+		DNSSuffix:    req.DNSSuffix,
+		TenantID:     t.TenantID,
+		Tags:         []string{},
+		Username:     req.Username,
+		Password:     req.Password,
+		MPSUsername:  cira.Username,
+		MPSPassword:  password,
+	}
+
+	if device.Username == "" {
+		device.Username = defaultAMTUsername
+	}
+
+	if t.Tags != "" {
+		device.Tags = strings.Split(t.Tags, ",")
+	}
+
+	if _, err := uc.devices.Insert(ctx, device); err != nil {
+		if releaseErr := uc.repo.Release(ctx, t.ID); releaseErr != nil {
+			uc.log.Warn("enrollment - Enroll: token %s not released after the failed enrollment of %s: %v", t.ID, deviceGUID, releaseErr)
+		}
+
+		return dto.Enrollment{}, err
+	}
+
+	uc.log.Info("enrollment - Enroll: device %s enrolled with the token %s", deviceGUID, t.ID)
+
+	return dto.Enrollment{
+		GUID:                deviceGUID,
+		MPSAddress:          cira.MPSAddress,
+		MPSPort:             cira.MPSPort,
+		Username:            cira.Username,
+		Password:            password,
+		CommonName:          cira.CommonName,
+		ServerAddressFormat: cira.ServerAddressFormat,
+		AuthMethod:          cira.AuthMethod,
+		MPSRootCertificate:  cira.MPSRootCertificate,
+		ProxyDetails:        cira.ProxyDetails,
+	}, nil
+}
+
+// ciraConfig returns the CIRA config of a token, a validation error when it does not exist.
+func (uc *UseCase) ciraConfig(ctx context.Context, name, tenantID string) (*dto.CIRAConfig, error) {
+	cira, err := uc.ciraConfigs.GetByName(ctx, name, tenantID)
+
+	var nfErr sqldb.NotFoundError
+	if errors.As(err, &nfErr) || (err == nil && cira == nil) {
+		return nil, ErrValidation.Wrap("ciraConfig", "uc.ciraConfigs.GetByName", fmt.Errorf("the CIRA config %s does not exist", name))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cira, nil
+}
+
+// hashToken returns the hexadecimal SHA-256 of a token, as the token is stored.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func toDTO(t entity.EnrollmentToken) dto.EnrollmentToken {
+	d := dto.EnrollmentToken{
+		ID:          t.ID,
+		TenantID:    t.TenantID,
+		Description: t.Description,
+		CIRAConfig:  t.CIRAConfig,
+		Tags:        []string{},
+		CreatedBy:   t.CreatedBy,
+		CreatedAt:   t.CreatedAt,
+		ExpiresAt:   t.ExpiresAt,
+		UsedBy:      t.UsedBy,
+	}
+
+	if t.Tags != "" {
+		d.Tags = strings.Split(t.Tags, ",")
+	}
+
+	if !t.UsedAt.IsZero() {
+		usedAt := t.UsedAt
+		d.UsedAt = &usedAt
+	}
+
+	return d
+}
@@ -0,0 +1,277 @@
+package enrollment_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/ciraconfigs"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/enrollment"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const deviceGUID = "123e4567-e89b-12d3-a456-426614174000"
+
+var errQuery = errors.New("query failed")
+
+type enrollmentMocks struct {
+	repo        *mocks.MockEnrollmentRepository
+	devices     *mocks.MockEnrollmentDevices
+	ciraConfigs *mocks.MockEnrollmentCIRAConfigs
+}
+
+func newEnrollment(t *testing.T, mock func(enrollmentMocks)) *enrollment.UseCase {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	m := enrollmentMocks{
+		repo:        mocks.NewMockEnrollmentRepository(ctrl),
+		devices:     mocks.NewMockEnrollmentDevices(ctrl),
+		ciraConfigs: mocks.NewMockEnrollmentCIRAConfigs(ctrl),
+	}
+
+	mock(m)
+
+	return enrollment.New(m.repo, m.devices, m.ciraConfigs, 24*time.Hour, 48*time.Hour, logger.New("error"))
+}
+
+func hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+var ciraConfig = &dto.CIRAConfig{
+	ConfigName:          "cira",
+	MPSAddress:          "console.example.com",
+	MPSPort:             4433,
+	Username:            "mpsuser",
+	CommonName:          "console.example.com",
+	ServerAddressFormat: dto.ServerAddressFormatURL,
+	AuthMethod:          2,
+	MPSRootCertificate:  "cert",
+}
+
+func TestMint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		req  dto.EnrollmentTokenRequest
+		mock func(enrollmentMocks)
+		err  error
+	}{
+		{
+			name: "success",
+			req:  dto.EnrollmentTokenRequest{CIRAConfig: "cira", Tags: []string{"lab", "berlin"}, TTL: 3600},
+			mock: func(m enrollmentMocks) {
+				m.ciraConfigs.EXPECT().GetByName(gomock.Any(), "cira", "tenant").Return(ciraConfig, nil)
+				m.repo.EXPECT().Insert(gomock.Any(), gomock.Cond(func(t entity.EnrollmentToken) bool {
+					return t.TenantID == "tenant" && t.CreatedBy == "admin" && t.Tags == "lab,berlin" &&
+						t.ExpiresAt.Sub(t.CreatedAt) == time.Hour
+				})).Return(nil)
+			},
+		},
+		{
+			name: "a token longer than the maximum is refused",
+			req:  dto.EnrollmentTokenRequest{CIRAConfig: "cira", TTL: 3 * 24 * 3600},
+			mock: func(enrollmentMocks) {},
+			err:  enrollment.ErrValidation,
+		},
+		{
+			name: "unknown CIRA config",
+			req:  dto.EnrollmentTokenRequest{CIRAConfig: "unknown"},
+			mock: func(m enrollmentMocks) {
+				m.ciraConfigs.EXPECT().GetByName(gomock.Any(), "unknown", "tenant").Return(nil, ciraconfigs.ErrNotFound)
+			},
+			err: enrollment.ErrValidation,
+		},
+		{
+			name: "database error",
+			req:  dto.EnrollmentTokenRequest{CIRAConfig: "cira"},
+			mock: func(m enrollmentMocks) {
+				m.ciraConfigs.EXPECT().GetByName(gomock.Any(), "cira", "tenant").Return(ciraConfig, nil)
+				m.repo.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errQuery)
+			},
+			err: enrollment.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := newEnrollment(t, tc.mock)
+
+			token, err := uc.Mint(context.Background(), "tenant", "admin", tc.req)
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEmpty(t, token.Token)
+			require.Equal(t, tc.req.Tags, token.Tags)
+			require.Nil(t, token.UsedAt)
+		})
+	}
+}
+
+func TestEnroll(t *testing.T) {
+	t.Parallel()
+
+	token := func(modify func(*entity.EnrollmentToken)) *entity.EnrollmentToken {
+		t := &entity.EnrollmentToken{
+			ID:         "token1",
+			TokenHash:  hash("secret"),
+			TenantID:   "tenant",
+			CIRAConfig: "cira",
+			Tags:       "lab",
+			CreatedAt:  time.Now().Add(-time.Hour),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+
+		if modify != nil {
+			modify(t)
+		}
+
+		return t
+	}
+
+	req := dto.EnrollmentRequest{
+		Token:    "secret",
+		GUID:     "123E4567-E89B-12D3-A456-426614174000",
+		Hostname: "frontdesk-01",
+		Password: "P@ssw0rd",
+	}
+
+	tests := []struct {
+		name string
+		mock func(enrollmentMocks)
+		err  error
+	}{
+		{
+			name: "success",
+			mock: func(m enrollmentMocks) {
+				m.repo.EXPECT().GetByHash(gomock.Any(), hash("secret")).Return(token(nil), nil)
+				m.ciraConfigs.EXPECT().GetByName(gomock.Any(), "cira", "tenant").Return(ciraConfig, nil)
+				m.repo.EXPECT().Redeem(gomock.Any(), "token1", deviceGUID, gomock.Any()).Return(true, nil)
+				m.devices.EXPECT().Insert(gomock.Any(), gomock.Cond(func(d *dto.Device) bool {
+					return d.GUID == deviceGUID && d.TenantID == "tenant" && d.Username == "admin" && d.Password == "P@ssw0rd" &&
+						d.MPSUsername == "mpsuser" && len(d.MPSPassword) == 16 && len(d.Tags) == 1 && d.Tags[0] == "lab"
+				})).DoAndReturn(func(_ context.Context, d *dto.Device) (*dto.Device, error) { return d, nil })
+			},
+		},
+		{
+			name: "unknown token",
+			mock: func(m enrollmentMocks) {
+				m.repo.EXPECT().GetByHash(gomock.Any(), hash("secret")).Return(nil, nil)
+			},
+			err: enrollment.TokenError{},
+		},
+		{
+			name: "used token",
+			mock: func(m enrollmentMocks) {
+				m.repo.EXPECT().GetByHash(gomock.Any(), hash("secret")).Return(token(func(t *entity.EnrollmentToken) {
+					t.UsedAt, t.UsedBy = time.Now().Add(-time.Minute), "guid2"
+				}), nil)
+			},
+			err: enrollment.TokenError{},
+		},
+		{
+			name: "expired token",
+			mock: func(m enrollmentMocks) {
+				m.repo.EXPECT().GetByHash(gomock.Any(), hash("secret")).Return(token(func(t *entity.EnrollmentToken) {
+					t.ExpiresAt = time.Now().Add(-time.Minute)
+				}), nil)
+			},
+			err: enrollment.TokenError{},
+		},
+		{
+			name: "token redeemed by a concurrent enrollment",
+			mock: func(m enrollmentMocks) {
+				m.repo.EXPECT().GetByHash(gomock.Any(), hash("secret")).Return(token(nil), nil)
+				m.ciraConfigs.EXPECT().GetByName(gomock.Any(), "cira", "tenant").Return(ciraConfig, nil)
+				m.repo.EXPECT().Redeem(gomock.Any(), "token1", deviceGUID, gomock.Any()).Return(false, nil)
+			},
+			err: enrollment.TokenError{},
+		},
+		{
+			name: "the token is released when the device is not created",
+			mock: func(m enrollmentMocks) {
+				m.repo.EXPECT().GetByHash(gomock.Any(), hash("secret")).Return(token(nil), nil)
+				m.ciraConfigs.EXPECT().GetByName(gomock.Any(), "cira", "tenant").Return(ciraConfig, nil)
+				m.repo.EXPECT().Redeem(gomock.Any(), "token1", deviceGUID, gomock.Any()).Return(true, nil)
+				m.devices.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, devices.ErrDatabase)
+				m.repo.EXPECT().Release(gomock.Any(), "token1").Return(nil)
+			},
+			err: devices.ErrDatabase,
+		},
+		{
+			name: "database error",
+			mock: func(m enrollmentMocks) {
+				m.repo.EXPECT().GetByHash(gomock.Any(), hash("secret")).Return(nil, errQuery)
+			},
+			err: enrollment.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := newEnrollment(t, tc.mock)
+
+			res, err := uc.Enroll(context.Background(), req)
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, deviceGUID, res.GUID)
+			require.Equal(t, "console.example.com", res.MPSAddress)
+			require.Equal(t, 4433, res.MPSPort)
+			require.Equal(t, "mpsuser", res.Username)
+			require.Len(t, res.Password, 16)
+			require.Equal(t, "cert", res.MPSRootCertificate)
+		})
+	}
+}
+
+func TestGetAndDelete(t *testing.T) {
+	t.Parallel()
+
+	usedAt := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	uc := newEnrollment(t, func(m enrollmentMocks) {
+		m.repo.EXPECT().Get(gomock.Any(), "tenant").Return([]entity.EnrollmentToken{
+			{ID: "token1", TokenHash: hash("secret"), TenantID: "tenant", CIRAConfig: "cira", Tags: "lab,berlin", UsedAt: usedAt, UsedBy: deviceGUID},
+			{ID: "token2", TenantID: "tenant", CIRAConfig: "cira"},
+		}, nil)
+		m.repo.EXPECT().Delete(gomock.Any(), "token1", "tenant").Return(true, nil)
+		m.repo.EXPECT().Delete(gomock.Any(), "token3", "tenant").Return(false, nil)
+	})
+
+	tokens, err := uc.Get(context.Background(), "tenant")
+	require.NoError(t, err)
+	require.Equal(t, []dto.EnrollmentToken{
+		{ID: "token1", TenantID: "tenant", CIRAConfig: "cira", Tags: []string{"lab", "berlin"}, UsedAt: &usedAt, UsedBy: deviceGUID},
+		{ID: "token2", TenantID: "tenant", CIRAConfig: "cira", Tags: []string{}},
+	}, tokens)
+
+	require.NoError(t, uc.Delete(context.Background(), "token1", "tenant"))
+	require.IsType(t, enrollment.ErrNotFound, uc.Delete(context.Background(), "token3", "tenant"))
+}
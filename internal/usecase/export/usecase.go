@@ -5,6 +5,9 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
 
@@ -63,3 +66,75 @@ func (e *FileExporter) ExportEventLogsCSV(logs []dto.EventLog) (io.Reader, error
 
 	return buffer, nil
 }
+
+// WriteDevicesCSV writes the devices as CSV, one row per device with its descriptive fields.
+func WriteDevicesCSV(w io.Writer, devices []dto.Device) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"GUID", "Hostname", "Friendly Name", "Location", "Notes", "Tags", "Owner", "Assignee", "Connected"}); err != nil {
+		return fmt.Errorf("error writing CSV: %w", err)
+	}
+
+	for i := range devices {
+		if err := writer.Write([]string{
+			devices[i].GUID,
+			devices[i].Hostname,
+			devices[i].FriendlyName,
+			devices[i].Location,
+			devices[i].Notes,
+			strings.Join(devices[i].Tags, ","),
+			devices[i].Owner,
+			devices[i].Assignee,
+			strconv.FormatBool(devices[i].ConnectionStatus),
+		}); err != nil {
+			return fmt.Errorf("error writing CSV: %w", err)
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("error writing CSV: %w", err)
+	}
+
+	return nil
+}
+
+// AuditLogCSVWriter writes decoded audit log entries as CSV rows while they are read from a device.
+type AuditLogCSVWriter struct {
+	writer *csv.Writer
+}
+
+func NewAuditLogCSVWriter(w io.Writer) *AuditLogCSVWriter {
+	return &AuditLogCSVWriter{writer: csv.NewWriter(w)}
+}
+
+// WriteHeader writes the header row, it must be called before the first entry is written.
+func (a *AuditLogCSVWriter) WriteHeader() error {
+	return a.write([]string{"Index", "Time", "Application ID", "Application", "Event ID", "Event", "Initiator", "Net Address", "Description"})
+}
+
+// Write writes the entry and flushes it to the underlying writer.
+func (a *AuditLogCSVWriter) Write(entry *dto.AuditLogEntry) error {
+	return a.write([]string{
+		strconv.Itoa(entry.Index),
+		entry.Time.Format(time.RFC3339),
+		strconv.Itoa(entry.AuditAppID),
+		entry.AuditApp,
+		strconv.Itoa(entry.EventID),
+		entry.Event,
+		entry.Initiator,
+		entry.NetAddress,
+		entry.Description,
+	})
+}
+
+func (a *AuditLogCSVWriter) write(record []string) error {
+	if err := a.writer.Write(record); err != nil {
+		return fmt.Errorf("error writing CSV: %w", err)
+	}
+
+	a.writer.Flush()
+
+	return a.writer.Error()
+}
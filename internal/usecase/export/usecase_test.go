@@ -1,6 +1,7 @@
 package export_test
 
 import (
+	"bytes"
 	"encoding/csv"
 	"testing"
 	"time"
@@ -121,3 +122,32 @@ func TestExportEventLogsCSV(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteDevicesCSV(t *testing.T) {
+	t.Parallel()
+
+	devices := []dto.Device{
+		{
+			GUID:             "123e4567-e89b-12d3-a456-426614174000",
+			Hostname:         "pc-042",
+			FriendlyName:     "Front desk PC",
+			Location:         "Building 2, Floor 3",
+			Notes:            "Replaced battery 2026-09\nCall before reboot",
+			Tags:             []string{"lobby", "kiosk"},
+			Owner:            "jdoe",
+			ConnectionStatus: true,
+		},
+		{GUID: "00000000-0000-0000-0000-000000000000", Hostname: "pc-043"},
+	}
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, export.WriteDevicesCSV(buffer, devices))
+
+	records, err := csv.NewReader(buffer).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"GUID", "Hostname", "Friendly Name", "Location", "Notes", "Tags", "Owner", "Assignee", "Connected"},
+		{"123e4567-e89b-12d3-a456-426614174000", "pc-042", "Front desk PC", "Building 2, Floor 3", "Replaced battery 2026-09\nCall before reboot", "lobby,kiosk", "jdoe", "", "true"},
+		{"00000000-0000-0000-0000-000000000000", "pc-043", "", "", "", "", "", "", "false"},
+	}, records)
+}
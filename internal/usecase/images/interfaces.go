@@ -0,0 +1,15 @@
+package images
+
+import (
+	"context"
+	"io"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type Feature interface {
+	Get(ctx context.Context) ([]dto.Image, error)
+	Upload(ctx context.Context, name string, r io.Reader) (dto.Image, error)
+	Path(ctx context.Context, name string) (string, error)
+	Delete(ctx context.Context, name string) error
+}
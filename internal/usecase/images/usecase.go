@@ -0,0 +1,171 @@
+// Package images keeps the ISO and boot images uploaded to the console in a directory. Uploads
+// are streamed to a temporary file next to the images and renamed into place once complete, so
+// that a multi-GB image is never held in memory and a failed upload never replaces an image.
+package images
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// uploadPrefix starts the names of the temporary files of the uploads in progress.
+const uploadPrefix = ".upload-"
+
+// namePattern keeps the image names to a single path element.
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,254}$`)
+
+var (
+	ErrImagesUseCase = consoleerrors.CreateConsoleError("ImagesUseCase")
+	ErrNotFound      = sqldb.NotFoundError{Console: ErrImagesUseCase}
+	ErrValidation    = dto.NotValidError{Console: ErrImagesUseCase}
+
+	ErrInvalidName = errors.New("image names contain only letters, digits, dots, hyphens and underscores")
+)
+
+// UseCase -.
+type UseCase struct {
+	dir string
+	log logger.Interface
+}
+
+// New creates the image store in dir, which is created when missing.
+func New(dir string, log logger.Interface) (*UseCase, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &UseCase{dir: dir, log: log}, nil
+}
+
+// Get lists the images by name.
+func (uc *UseCase) Get(_ context.Context) ([]dto.Image, error) {
+	entries, err := os.ReadDir(uc.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.Image, 0, len(entries))
+
+	for _, e := range entries {
+		if !e.Type().IsRegular() || strings.HasPrefix(e.Name(), uploadPrefix) {
+			continue
+		}
+
+		info, err := e.Info()
+		if err != nil {
+			// deleted since the directory was read
+			continue
+		}
+
+		items = append(items, dto.Image{Name: e.Name(), Size: info.Size(), ModifiedAt: info.ModTime().UTC()})
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+
+	return items, nil
+}
+
+// Upload streams an image to disk, replacing the image of the same name once the upload is complete.
+func (uc *UseCase) Upload(ctx context.Context, name string, r io.Reader) (dto.Image, error) {
+	if !namePattern.MatchString(name) {
+		return dto.Image{}, ErrValidation.Wrap("Upload", "namePattern.MatchString", ErrInvalidName)
+	}
+
+	tmp, err := os.CreateTemp(uc.dir, uploadPrefix+"*")
+	if err != nil {
+		return dto.Image{}, err
+	}
+
+	defer os.Remove(tmp.Name()) //nolint:errcheck // gone once renamed into place
+
+	hash := sha256.New()
+
+	size, err := io.Copy(io.MultiWriter(tmp, hash), contextReader{ctx, r})
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return dto.Image{}, err
+	}
+
+	path := filepath.Join(uc.dir, name)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return dto.Image{}, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return dto.Image{}, err
+	}
+
+	uc.log.Info("images - Upload: stored %s, %d bytes", name, size)
+
+	return dto.Image{
+		Name:       name,
+		Size:       size,
+		SHA256:     hex.EncodeToString(hash.Sum(nil)),
+		ModifiedAt: info.ModTime().UTC(),
+	}, nil
+}
+
+// Path returns the file of an image.
+func (uc *UseCase) Path(_ context.Context, name string) (string, error) {
+	if !namePattern.MatchString(name) {
+		return "", ErrNotFound
+	}
+
+	path := filepath.Join(uc.dir, name)
+
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) || (err == nil && !info.Mode().IsRegular()) {
+		return "", ErrNotFound
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// Delete -.
+func (uc *UseCase) Delete(ctx context.Context, name string) error {
+	path, err := uc.Path(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// contextReader stops an upload once its request is canceled.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (cr contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return cr.r.Read(p)
+}
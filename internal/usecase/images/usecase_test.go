@@ -0,0 +1,113 @@
+package images_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/images"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var ErrGeneral = errors.New("connection reset")
+
+func setup(t *testing.T) (*images.UseCase, string) {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), "images")
+
+	uc, err := images.New(dir, logger.New("error"))
+	require.NoError(t, err)
+
+	return uc, dir
+}
+
+func TestUpload(t *testing.T) {
+	t.Parallel()
+
+	uc, dir := setup(t)
+
+	image, err := uc.Upload(context.Background(), "boot.iso", strings.NewReader("hello"))
+	require.NoError(t, err)
+	require.Equal(t, "boot.iso", image.Name)
+	require.Equal(t, int64(5), image.Size)
+	require.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", image.SHA256)
+
+	data, err := os.ReadFile(filepath.Join(dir, "boot.iso"))
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(data))
+
+	// a failed upload leaves the image in place and no temporary file behind
+	_, err = uc.Upload(context.Background(), "boot.iso", io.MultiReader(strings.NewReader("partial"), errReader{}))
+	require.ErrorIs(t, err, ErrGeneral)
+
+	data, err = os.ReadFile(filepath.Join(dir, "boot.iso"))
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(data))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = uc.Upload(ctx, "other.iso", strings.NewReader("hello"))
+	require.ErrorIs(t, err, context.Canceled)
+
+	for _, name := range []string{"", "../boot.iso", ".hidden", "a/b.iso"} {
+		_, err = uc.Upload(context.Background(), name, strings.NewReader("hello"))
+		require.ErrorAs(t, err, &dto.NotValidError{}, name)
+	}
+}
+
+func TestGetPathDelete(t *testing.T) {
+	t.Parallel()
+
+	uc, dir := setup(t)
+
+	for _, name := range []string{"b.img", "a.iso"} {
+		_, err := uc.Upload(context.Background(), name, strings.NewReader(name))
+		require.NoError(t, err)
+	}
+
+	// uploads in progress and directories are not listed
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".upload-123"), []byte("x"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "c.iso"), 0o750))
+
+	items, err := uc.Get(context.Background())
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, "a.iso", items[0].Name)
+	require.Equal(t, int64(5), items[1].Size)
+
+	path, err := uc.Path(context.Background(), "a.iso")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "a.iso"), path)
+
+	for _, name := range []string{"missing.iso", "c.iso", "../images/a.iso"} {
+		_, err = uc.Path(context.Background(), name)
+		require.ErrorAs(t, err, &sqldb.NotFoundError{}, name)
+	}
+
+	require.NoError(t, uc.Delete(context.Background(), "a.iso"))
+	require.ErrorAs(t, uc.Delete(context.Background(), "a.iso"), &sqldb.NotFoundError{})
+
+	items, err = uc.Get(context.Background())
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+}
+
+type errReader struct{}
+
+func (errReader) Read(_ []byte) (int, error) {
+	return 0, ErrGeneral
+}
@@ -0,0 +1,20 @@
+package integrity
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+type (
+	Repository interface {
+		Sample(ctx context.Context, c sqldb.EncryptedColumn, limit int) ([]entity.StoredSecret, error)
+		Quarantine(ctx context.Context, c sqldb.EncryptedColumn, s entity.StoredSecret, reason string, at time.Time) error
+	}
+
+	Feature interface {
+		Check(ctx context.Context) (Report, error)
+	}
+)
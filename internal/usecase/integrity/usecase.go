@@ -0,0 +1,115 @@
+// Package integrity checks at startup that the secrets stored in the database can be decrypted with the configured
+// key, so that a wrong or rotated key is reported when the console starts rather than hours later, deep in an
+// operation on a device.
+//
+// The secrets that cannot be decrypted are reported, and optionally moved to the quarantined_secrets table so that
+// the rows holding them stop failing. A quarantined secret is kept as it was stored, it can be restored once the key
+// it was encrypted with is found.
+package integrity
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var (
+	ErrIntegrityUseCase = consoleerrors.CreateConsoleError("IntegrityUseCase")
+	ErrDatabase         = sqldb.DatabaseError{Console: ErrIntegrityUseCase}
+)
+
+// Report is the outcome of a check.
+type Report struct {
+	Checked     int
+	Quarantined int
+	Failures    []Failure
+}
+
+// Failure is a secret that cannot be decrypted, Key names its row within its tenant.
+type Failure struct {
+	Table    string
+	Column   string
+	Key      string
+	TenantID string
+	Error    string
+}
+
+// UseCase -.
+type UseCase struct {
+	repo       Repository
+	cryptor    security.Cryptor
+	columns    []sqldb.EncryptedColumn
+	sample     int
+	quarantine bool
+	log        logger.Interface
+	now        func() time.Time
+}
+
+// New checks up to sample secrets of each column, every secret when sample is 0, and quarantines those that cannot
+// be decrypted when quarantine is set.
+func New(repo Repository, cryptor security.Cryptor, columns []sqldb.EncryptedColumn, sample int, quarantine bool, log logger.Interface) *UseCase {
+	return &UseCase{
+		repo:       repo,
+		cryptor:    cryptor,
+		columns:    columns,
+		sample:     sample,
+		quarantine: quarantine,
+		log:        log,
+		now:        time.Now,
+	}
+}
+
+// Check decrypts a sample of the secrets of each column and reports those that cannot be decrypted.
+func (uc *UseCase) Check(ctx context.Context) (Report, error) {
+	report := Report{Failures: []Failure{}}
+
+	for _, c := range uc.columns {
+		secrets, err := uc.repo.Sample(ctx, c, uc.sample)
+		if err != nil {
+			return report, ErrDatabase.Wrap("Check", "uc.repo.Sample", err)
+		}
+
+		for _, s := range secrets {
+			report.Checked++
+
+			_, err := uc.cryptor.Decrypt(s.CipherText)
+			if err == nil {
+				continue
+			}
+
+			uc.log.Warn("integrity check: %s.%s of %q (tenant %q) cannot be decrypted: %v", c.Table, c.Column, s.Key, s.TenantID, err)
+
+			report.Failures = append(report.Failures, Failure{
+				Table:    c.Table,
+				Column:   c.Column,
+				Key:      s.Key,
+				TenantID: s.TenantID,
+				Error:    err.Error(),
+			})
+
+			if !uc.quarantine {
+				continue
+			}
+
+			if err := uc.repo.Quarantine(ctx, c, s, err.Error(), uc.now()); err != nil {
+				return report, ErrDatabase.Wrap("Check", "uc.repo.Quarantine", err)
+			}
+
+			report.Quarantined++
+		}
+	}
+
+	if len(report.Failures) > 0 {
+		uc.log.Warn("integrity check: %d of %d secrets cannot be decrypted with the configured key, %d quarantined",
+			len(report.Failures), report.Checked, report.Quarantined)
+	} else {
+		uc.log.Info("integrity check: %d secrets decrypted", report.Checked)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,110 @@
+package integrity_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/integrity"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var (
+	key     = security.Crypto{EncryptionKey: "Jf3Q2nXJ+GZzN1dbVQms0wbB4BamdsXG"}
+	other   = security.Crypto{EncryptionKey: "Zk1wN8aQ4vTbR7cXe2LmP5sYd9HjU3gF"}
+	columns = []sqldb.EncryptedColumn{
+		{Table: "devices", KeyColumn: "guid", TenantColumn: "tenantid", Column: "password"},
+		{Table: "sites", KeyColumn: "name", TenantColumn: "tenant_id", Column: "password"},
+	}
+)
+
+func encrypt(t *testing.T, c security.Crypto, plainText string) string {
+	t.Helper()
+
+	cipherText, err := c.Encrypt(plainText)
+	require.NoError(t, err)
+
+	return cipherText
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	good := entity.StoredSecret{Table: "devices", Column: "password", Key: "guid1", TenantID: "tenant1", CipherText: encrypt(t, key, "P@ssw0rd")}
+	bad := entity.StoredSecret{Table: "sites", Column: "password", Key: "site1", CipherText: encrypt(t, other, "P@ssw0rd")}
+
+	tests := []struct {
+		name       string
+		quarantine bool
+		mock       func(repo *mocks.MockIntegrityRepository)
+		res        integrity.Report
+		err        error
+	}{
+		{
+			name: "every secret decrypts",
+			mock: func(repo *mocks.MockIntegrityRepository) {
+				repo.EXPECT().Sample(context.Background(), columns[0], 10).Return([]entity.StoredSecret{good}, nil)
+				repo.EXPECT().Sample(context.Background(), columns[1], 10).Return([]entity.StoredSecret{}, nil)
+			},
+			res: integrity.Report{Checked: 1, Failures: []integrity.Failure{}},
+		},
+		{
+			name: "secret of another key is reported",
+			mock: func(repo *mocks.MockIntegrityRepository) {
+				repo.EXPECT().Sample(context.Background(), columns[0], 10).Return([]entity.StoredSecret{good}, nil)
+				repo.EXPECT().Sample(context.Background(), columns[1], 10).Return([]entity.StoredSecret{bad}, nil)
+			},
+			res: integrity.Report{Checked: 2, Failures: []integrity.Failure{
+				{Table: "sites", Column: "password", Key: "site1", Error: "cipher: message authentication failed"},
+			}},
+		},
+		{
+			name:       "secret of another key is quarantined",
+			quarantine: true,
+			mock: func(repo *mocks.MockIntegrityRepository) {
+				repo.EXPECT().Sample(context.Background(), columns[0], 10).Return([]entity.StoredSecret{good}, nil)
+				repo.EXPECT().Sample(context.Background(), columns[1], 10).Return([]entity.StoredSecret{bad}, nil)
+				repo.EXPECT().Quarantine(context.Background(), columns[1], bad, "cipher: message authentication failed", gomock.Any()).Return(nil)
+			},
+			res: integrity.Report{Checked: 2, Quarantined: 1, Failures: []integrity.Failure{
+				{Table: "sites", Column: "password", Key: "site1", Error: "cipher: message authentication failed"},
+			}},
+		},
+		{
+			name: "database error",
+			mock: func(repo *mocks.MockIntegrityRepository) {
+				repo.EXPECT().Sample(context.Background(), columns[0], 10).Return(nil, errors.New("database error"))
+			},
+			res: integrity.Report{Failures: []integrity.Failure{}},
+			err: integrity.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewMockIntegrityRepository(gomock.NewController(t))
+			tc.mock(repo)
+
+			uc := integrity.New(repo, key, columns, 10, tc.quarantine, logger.New("error"))
+
+			res, err := uc.Check(context.Background())
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.res, res)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	// Devices is the part of the devices use case the inventory depends on.
+	Devices interface {
+		Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Device, error)
+	}
+
+	// Addresses is the part of the address history repository the inventory reads the addresses of the devices from.
+	Addresses interface {
+		GetByGUID(ctx context.Context, guid string, limit int) ([]entity.DeviceAddress, error)
+	}
+
+	// PowerSamples is the part of the power history repository the inventory reads the power states of the devices from.
+	PowerSamples interface {
+		GetByGUID(ctx context.Context, guid string, from time.Time) ([]entity.PowerSample, error)
+	}
+
+	// Sites is the part of the sites use case the inventory depends on.
+	Sites interface {
+		Locate(ctx context.Context, addresses []string, tenantID string) (*dto.Site, error)
+	}
+
+	Feature interface {
+		GetAnsible(ctx context.Context, tenantID string) (dto.AnsibleInventory, error)
+	}
+)
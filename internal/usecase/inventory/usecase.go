@@ -0,0 +1,227 @@
+package inventory
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	devicesPerPage = 100
+
+	groupAll = "all"
+
+	powerOn      = "on"
+	powerSleep   = "sleep"
+	powerOff     = "off"
+	powerUnknown = "unknown"
+)
+
+var ErrDatabase = sqldb.DatabaseError{Console: consoleerrors.CreateConsoleError("InventoryUseCase")}
+
+// UseCase lists the devices as inventories of configuration management tools.
+type UseCase struct {
+	devices   Devices
+	addresses Addresses
+	power     PowerSamples
+	sites     Sites
+	interval  time.Duration
+	log       logger.Interface
+	now       func() time.Time
+}
+
+// New creates the inventory of the devices. interval is the one of the power state poller, the power state of a
+// device is the one it last recorded unless older than two intervals; 0 leaves the power states unknown.
+func New(devices Devices, addresses Addresses, power PowerSamples, sites Sites, interval time.Duration, log logger.Interface) *UseCase {
+	return &UseCase{
+		devices:   devices,
+		addresses: addresses,
+		power:     power,
+		sites:     sites,
+		interval:  interval,
+		log:       log,
+		now:       time.Now,
+	}
+}
+
+// GetAnsible returns the devices of a tenant as an Ansible dynamic inventory, grouped by tag, site and power
+// state: tag_<tag>, site_<site> and power_on, power_sleep, power_off or power_unknown, with the characters
+// Ansible does not allow in group names replaced by underscores. A host is named by the hostname of its
+// device, by its GUID when the device has none or shares it with another device.
+func (uc *UseCase) GetAnsible(ctx context.Context, tenantID string) (dto.AnsibleInventory, error) {
+	var items []dto.Device
+
+	for skip := 0; ; skip += devicesPerPage {
+		page, err := uc.devices.Get(ctx, devicesPerPage, skip, tenantID)
+		if err != nil {
+			return dto.AnsibleInventory{}, err
+		}
+
+		items = append(items, page...)
+
+		if len(page) < devicesPerPage {
+			break
+		}
+	}
+
+	hostnames := make(map[string]int, len(items))
+	for i := range items {
+		hostnames[strings.ToLower(items[i].Hostname)]++
+	}
+
+	inventory := dto.AnsibleInventory{
+		Groups: map[string]dto.AnsibleGroup{},
+		Meta:   dto.AnsibleMeta{HostVars: make(map[string]dto.AnsibleHostVars, len(items))},
+	}
+
+	for i := range items {
+		vars, err := uc.hostVars(ctx, &items[i], tenantID)
+		if err != nil {
+			return dto.AnsibleInventory{}, err
+		}
+
+		host := items[i].Hostname
+		if host == "" || hostnames[strings.ToLower(host)] > 1 {
+			host = items[i].GUID
+		}
+
+		inventory.Meta.HostVars[host] = vars
+
+		for _, tag := range vars.Tags {
+			addHost(inventory.Groups, groupName("tag", tag), host)
+		}
+
+		if vars.Site != "" {
+			addHost(inventory.Groups, groupName("site", vars.Site), host)
+		}
+
+		addHost(inventory.Groups, groupName("power", vars.PowerState), host)
+	}
+
+	children := make([]string, 0, len(inventory.Groups))
+
+	for name, group := range inventory.Groups {
+		slices.Sort(group.Hosts)
+		children = append(children, name)
+	}
+
+	slices.Sort(children)
+
+	inventory.Groups[groupAll] = dto.AnsibleGroup{Children: children}
+
+	return inventory, nil
+}
+
+// hostVars returns the variables of a device: its address is the last one recorded for its hostname, the
+// hostname itself for an address literal.
+func (uc *UseCase) hostVars(ctx context.Context, d *dto.Device, tenantID string) (dto.AnsibleHostVars, error) {
+	vars := dto.AnsibleHostVars{
+		AnsibleHost:  d.Hostname,
+		GUID:         d.GUID,
+		Hostname:     d.Hostname,
+		FriendlyName: d.FriendlyName,
+		Tags:         d.Tags,
+	}
+
+	if vars.Tags == nil {
+		vars.Tags = []string{}
+	}
+
+	addresses, err := uc.deviceAddresses(ctx, d)
+	if err != nil {
+		return dto.AnsibleHostVars{}, err
+	}
+
+	if len(addresses) > 0 {
+		vars.IP = addresses[0]
+		vars.AnsibleHost = addresses[0]
+
+		site, err := uc.sites.Locate(ctx, addresses, tenantID)
+		if err != nil {
+			return dto.AnsibleHostVars{}, err
+		}
+
+		if site != nil {
+			vars.Site = site.Name
+		}
+	}
+
+	vars.PowerState, err = uc.powerState(ctx, d.GUID)
+	if err != nil {
+		return dto.AnsibleHostVars{}, err
+	}
+
+	return vars, nil
+}
+
+// deviceAddresses returns the addresses last recorded for the hostname of a device, none when they were
+// recorded for a hostname it no longer has.
+func (uc *UseCase) deviceAddresses(ctx context.Context, d *dto.Device) ([]string, error) {
+	host := strings.TrimSuffix(strings.TrimPrefix(d.Hostname, "["), "]")
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []string{addr.WithZone("").Unmap().String()}, nil
+	}
+
+	records, err := uc.addresses.GetByGUID(ctx, d.GUID, 1)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("GetAnsible", "uc.addresses.GetByGUID", err)
+	}
+
+	if len(records) == 0 || records[0].Hostname != d.Hostname {
+		return nil, nil
+	}
+
+	return records[0].Addresses, nil
+}
+
+// powerState names the power state last recorded for a device by the power state poller: 2 is on, 3 and 4
+// are sleep states and the others are off. A device not reached or not sampled for two intervals is unknown.
+func (uc *UseCase) powerState(ctx context.Context, guid string) (string, error) {
+	if uc.interval <= 0 {
+		return powerUnknown, nil
+	}
+
+	samples, err := uc.power.GetByGUID(ctx, guid, uc.now().Add(-2*uc.interval))
+	if err != nil {
+		return "", ErrDatabase.Wrap("GetAnsible", "uc.power.GetByGUID", err)
+	}
+
+	if len(samples) == 0 {
+		return powerUnknown, nil
+	}
+
+	switch samples[len(samples)-1].PowerState {
+	case 0:
+		return powerUnknown, nil
+	case 2:
+		return powerOn, nil
+	case 3, 4:
+		return powerSleep, nil
+	default:
+		return powerOff, nil
+	}
+}
+
+func addHost(groups map[string]dto.AnsibleGroup, name, host string) {
+	group := groups[name]
+	group.Hosts = append(group.Hosts, host)
+	groups[name] = group
+}
+
+// groupName prefixes value, with the characters other than letters, digits and underscores replaced.
+func groupName(prefix, value string) string {
+	return prefix + "_" + strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+
+		return '_'
+	}, value)
+}
@@ -0,0 +1,132 @@
+package inventory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/inventory"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errQuery = errors.New("query failed")
+
+type inventoryMocks struct {
+	devices   *mocks.MockInventoryDevices
+	addresses *mocks.MockInventoryAddresses
+	power     *mocks.MockInventoryPowerSamples
+	sites     *mocks.MockInventorySites
+}
+
+func inventoryTest(t *testing.T, interval time.Duration) (*inventory.UseCase, inventoryMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	m := inventoryMocks{
+		devices:   mocks.NewMockInventoryDevices(ctrl),
+		addresses: mocks.NewMockInventoryAddresses(ctrl),
+		power:     mocks.NewMockInventoryPowerSamples(ctrl),
+		sites:     mocks.NewMockInventorySites(ctrl),
+	}
+
+	return inventory.New(m.devices, m.addresses, m.power, m.sites, interval, logger.New("error")), m
+}
+
+func TestGetAnsible(t *testing.T) {
+	t.Parallel()
+
+	uc, m := inventoryTest(t, time.Minute)
+
+	m.devices.EXPECT().Get(gomock.Any(), 100, 0, "").Return([]dto.Device{
+		{GUID: "guid1", Hostname: "amt01.example.com", FriendlyName: "Front desk", Tags: []string{"lab", "floor 2"}},
+		{GUID: "guid2", Hostname: "[fd00::9]"},
+	}, nil)
+
+	m.addresses.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).
+		Return([]entity.DeviceAddress{{GUID: "guid1", Hostname: "amt01.example.com", Addresses: []string{"10.20.1.5"}}}, nil)
+	m.sites.EXPECT().Locate(gomock.Any(), []string{"10.20.1.5"}, "").Return(&dto.Site{Name: "branch-1"}, nil)
+	m.sites.EXPECT().Locate(gomock.Any(), []string{"fd00::9"}, "").Return(nil, nil)
+	m.power.EXPECT().GetByGUID(gomock.Any(), "guid1", gomock.Any()).
+		Return([]entity.PowerSample{{GUID: "guid1", PowerState: 8}, {GUID: "guid1", PowerState: 2}}, nil)
+	m.power.EXPECT().GetByGUID(gomock.Any(), "guid2", gomock.Any()).Return([]entity.PowerSample{}, nil)
+
+	got, err := uc.GetAnsible(context.Background(), "")
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]dto.AnsibleGroup{
+		"all":           {Children: []string{"power_on", "power_unknown", "site_branch_1", "tag_floor_2", "tag_lab"}},
+		"power_on":      {Hosts: []string{"amt01.example.com"}},
+		"power_unknown": {Hosts: []string{"[fd00::9]"}},
+		"site_branch_1": {Hosts: []string{"amt01.example.com"}},
+		"tag_floor_2":   {Hosts: []string{"amt01.example.com"}},
+		"tag_lab":       {Hosts: []string{"amt01.example.com"}},
+	}, got.Groups)
+
+	require.Equal(t, map[string]dto.AnsibleHostVars{
+		"amt01.example.com": {
+			AnsibleHost: "10.20.1.5", GUID: "guid1", IP: "10.20.1.5", Hostname: "amt01.example.com",
+			FriendlyName: "Front desk", Tags: []string{"lab", "floor 2"}, Site: "branch-1", PowerState: "on",
+		},
+		"[fd00::9]": {
+			AnsibleHost: "fd00::9", GUID: "guid2", IP: "fd00::9", Hostname: "[fd00::9]", Tags: []string{}, PowerState: "unknown",
+		},
+	}, got.Meta.HostVars)
+}
+
+func TestGetAnsibleHostNames(t *testing.T) {
+	t.Parallel()
+
+	uc, m := inventoryTest(t, 0)
+
+	m.devices.EXPECT().Get(gomock.Any(), 100, 0, "").Return([]dto.Device{
+		{GUID: "guid1", Hostname: "amt01.example.com"},
+		{GUID: "guid2", Hostname: "AMT01.example.com"},
+		{GUID: "guid3"},
+	}, nil)
+
+	m.addresses.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).
+		Return([]entity.DeviceAddress{{GUID: "guid1", Hostname: "old.example.com", Addresses: []string{"10.0.0.1"}}}, nil)
+	m.addresses.EXPECT().GetByGUID(gomock.Any(), "guid2", 1).Return([]entity.DeviceAddress{}, nil)
+	m.addresses.EXPECT().GetByGUID(gomock.Any(), "guid3", 1).Return([]entity.DeviceAddress{}, nil)
+
+	got, err := uc.GetAnsible(context.Background(), "")
+	require.NoError(t, err)
+
+	require.Equal(t, dto.AnsibleGroup{Hosts: []string{"guid1", "guid2", "guid3"}}, got.Groups["power_unknown"],
+		"shared or missing hostnames are replaced by the GUIDs and power states are unknown without the poller")
+	require.Empty(t, got.Meta.HostVars["guid1"].IP, "addresses of a former hostname are not used")
+	require.Equal(t, "amt01.example.com", got.Meta.HostVars["guid1"].AnsibleHost)
+}
+
+func TestGetAnsibleErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("devices", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := inventoryTest(t, time.Minute)
+		m.devices.EXPECT().Get(gomock.Any(), 100, 0, "").Return(nil, errQuery)
+
+		_, err := uc.GetAnsible(context.Background(), "")
+		require.ErrorIs(t, err, errQuery)
+	})
+
+	t.Run("power samples", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := inventoryTest(t, time.Minute)
+		m.devices.EXPECT().Get(gomock.Any(), 100, 0, "").Return([]dto.Device{{GUID: "guid1", Hostname: "amt01.example.com"}}, nil)
+		m.addresses.EXPECT().GetByGUID(gomock.Any(), "guid1", 1).Return(nil, nil)
+		m.power.EXPECT().GetByGUID(gomock.Any(), "guid1", gomock.Any()).Return(nil, errQuery)
+
+		_, err := uc.GetAnsible(context.Background(), "")
+		require.IsType(t, inventory.ErrDatabase, err)
+	})
+}
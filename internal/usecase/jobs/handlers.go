@@ -0,0 +1,492 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/correlations"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+// hardwareResultTTL is how long the hardware information read by a job is kept.
+const hardwareResultTTL = time.Hour
+
+// maxImportRecords matches the limit of the synchronous correlation import.
+const maxImportRecords = 10000
+
+var (
+	ErrNoAction         = errors.New("a power action is required")
+	ErrUnknownSource    = errors.New("the source is intune or configmgr")
+	ErrTooManyRecords   = fmt.Errorf("an import has at most %d records", maxImportRecords)
+	ErrPowerActionFails = errors.New("the device refused the power action")
+	ErrNoProfile        = errors.New("a profile is required")
+)
+
+// decode reads an optional JSON payload into v.
+func decode(payload []byte, v any) error {
+	if len(payload) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(payload, v)
+}
+
+// setDevices sets the devices of a job from the first attempt on: the GUIDs requested or,
+// without any, every device of the tenant.
+func setDevices(ctx context.Context, run *Run, devices Devices, guids []string) error {
+	if run.HasDevices() {
+		return nil
+	}
+
+	guids, err := resolveDevices(ctx, devices, run.TenantID(), guids)
+	if err != nil {
+		return err
+	}
+
+	run.SetDevices(guids)
+
+	return nil
+}
+
+// resolveDevices returns the GUIDs requested or, without any, the GUIDs of every device of the tenant.
+func resolveDevices(ctx context.Context, devices Devices, tenantID string, guids []string) ([]string, error) {
+	if len(guids) > 0 {
+		return guids, nil
+	}
+
+	for skip := 0; ; skip += devicesPerPage {
+		page, err := devices.Get(ctx, devicesPerPage, skip, tenantID)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range page {
+			guids = append(guids, page[i].GUID)
+		}
+
+		if len(page) < devicesPerPage {
+			return guids, nil
+		}
+	}
+}
+
+// planDevices plans the same action on the devices of a job, lower-cased and deduplicated like Run.SetDevices.
+func planDevices(ctx context.Context, devices Devices, tenantID string, guids []string, action string) ([]dto.PlannedAction, error) {
+	guids, err := resolveDevices(ctx, devices, tenantID, guids)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]dto.PlannedAction, 0, len(guids))
+	seen := make(map[string]bool, len(guids))
+
+	for _, guid := range guids {
+		guid = strings.ToLower(guid)
+		if seen[guid] {
+			continue
+		}
+
+		seen[guid] = true
+
+		actions = append(actions, dto.PlannedAction{Target: guid, Action: action})
+	}
+
+	return actions, nil
+}
+
+// hardwareRefresh reads the hardware information of devices and keeps it in memory for the UI
+// to fetch, device by device.
+type hardwareRefresh struct {
+	devices Devices
+
+	mu      sync.Mutex
+	results map[string]*hardwareResults
+}
+
+type hardwareResults struct {
+	readAt  time.Time
+	devices map[string]dto.HardwareInfo
+}
+
+func newHardwareRefresh(devices Devices) *hardwareRefresh {
+	return &hardwareRefresh{devices: devices, results: map[string]*hardwareResults{}}
+}
+
+func (h *hardwareRefresh) Validate(payload []byte) error {
+	return decode(payload, &dto.HardwareRefreshRequest{})
+}
+
+func (h *hardwareRefresh) Plan(ctx context.Context, tenantID string, payload []byte) ([]dto.PlannedAction, error) {
+	var req dto.HardwareRefreshRequest
+	if err := decode(payload, &req); err != nil {
+		return nil, err
+	}
+
+	return planDevices(ctx, h.devices, tenantID, req.GUIDs, "read the hardware information")
+}
+
+func (h *hardwareRefresh) Run(ctx context.Context, run *Run) error {
+	var req dto.HardwareRefreshRequest
+	if err := run.Decode(&req); err != nil {
+		return err
+	}
+
+	if err := setDevices(ctx, run, h.devices, req.GUIDs); err != nil {
+		return err
+	}
+
+	return run.ForEachDevice(ctx, func(ctx context.Context, guid string) error {
+		info, err := h.devices.GetHardwareInfo(ctx, guid)
+		if err != nil {
+			return err
+		}
+
+		h.keep(run.ID(), guid, info)
+
+		return nil
+	})
+}
+
+func (h *hardwareRefresh) keep(id, guid string, info dto.HardwareInfo) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+
+	for jobID, r := range h.results {
+		if now.Sub(r.readAt) > hardwareResultTTL {
+			delete(h.results, jobID)
+		}
+	}
+
+	r, ok := h.results[id]
+	if !ok {
+		r = &hardwareResults{devices: map[string]dto.HardwareInfo{}}
+		h.results[id] = r
+	}
+
+	r.readAt = now
+	r.devices[guid] = info
+}
+
+func (h *hardwareRefresh) result(id, guid string) (dto.HardwareInfo, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r, ok := h.results[id]
+	if !ok || time.Since(r.readAt) > hardwareResultTTL {
+		return dto.HardwareInfo{}, false
+	}
+
+	info, ok := r.devices[strings.ToLower(guid)]
+
+	return info, ok
+}
+
+// bulkPower sends a power action to devices.
+type bulkPower struct {
+	devices Devices
+}
+
+func (h bulkPower) Validate(payload []byte) error {
+	var req dto.BulkPowerRequest
+	if err := decode(payload, &req); err != nil {
+		return err
+	}
+
+	if req.Action <= 0 {
+		return ErrNoAction
+	}
+
+	return nil
+}
+
+func (h bulkPower) Plan(ctx context.Context, tenantID string, payload []byte) ([]dto.PlannedAction, error) {
+	var req dto.BulkPowerRequest
+	if err := decode(payload, &req); err != nil {
+		return nil, err
+	}
+
+	return planDevices(ctx, h.devices, tenantID, req.GUIDs, "power action "+devices.DescribePowerAction(req.Action))
+}
+
+func (h bulkPower) Run(ctx context.Context, run *Run) error {
+	var req dto.BulkPowerRequest
+	if err := run.Decode(&req); err != nil {
+		return err
+	}
+
+	if err := setDevices(ctx, run, h.devices, req.GUIDs); err != nil {
+		return err
+	}
+
+	return run.ForEachDevice(ctx, func(ctx context.Context, guid string) error {
+		// the device is locked for the action, so that it is not taken from a user who has it locked
+		ctx = devices.WithLockHolder(ctx, "job "+run.ID())
+		if _, err := h.devices.LockDevice(ctx, guid, dto.DeviceLockRequest{Reason: "bulk power action"}); err != nil {
+			return err
+		}
+
+		defer func() { _ = h.devices.UnlockDevice(ctx, guid, false) }()
+
+		response, err := h.devices.SendPowerAction(ctx, guid, req.Action)
+		if err != nil {
+			return err
+		}
+
+		if response.ReturnValue != 0 {
+			return fmt.Errorf("%w: return value %d", ErrPowerActionFails, response.ReturnValue)
+		}
+
+		return nil
+	})
+}
+
+// correlationImport imports the device records of Intune or ConfigMgr.
+type correlationImport struct {
+	correlations Correlations
+}
+
+func (h correlationImport) Validate(payload []byte) error {
+	var req dto.CorrelationImport
+	if err := decode(payload, &req); err != nil {
+		return err
+	}
+
+	if req.Source != correlations.SourceIntune && req.Source != correlations.SourceConfigMgr {
+		return ErrUnknownSource
+	}
+
+	if len(req.Records) > maxImportRecords {
+		return ErrTooManyRecords
+	}
+
+	return nil
+}
+
+func (h correlationImport) Plan(_ context.Context, _ string, payload []byte) ([]dto.PlannedAction, error) {
+	var req dto.CorrelationImport
+	if err := decode(payload, &req); err != nil {
+		return nil, err
+	}
+
+	return []dto.PlannedAction{{Target: req.Source, Action: fmt.Sprintf("import %d device records", len(req.Records))}}, nil
+}
+
+func (h correlationImport) Run(ctx context.Context, run *Run) error {
+	var req dto.CorrelationImport
+	if err := run.Decode(&req); err != nil {
+		return err
+	}
+
+	result, err := h.correlations.Import(ctx, req.Source, req.Records, run.TenantID())
+	if err != nil {
+		return err
+	}
+
+	return run.SetResult(result)
+}
+
+// keyRotation creates a new key for the tenant of the job and saves every device of the tenant
+// again, so that their secrets are encrypted with the new key.
+type keyRotation struct {
+	devices    Devices
+	tenantKeys TenantKeys
+}
+
+func (h keyRotation) Validate(_ []byte) error {
+	return nil
+}
+
+func (h keyRotation) Plan(ctx context.Context, tenantID string, _ []byte) ([]dto.PlannedAction, error) {
+	actions, err := planDevices(ctx, h.devices, tenantID, nil, "encrypt the secrets with the new key")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]dto.PlannedAction{{Target: fmt.Sprintf("tenant %q", tenantID), Action: "create a new key"}}, actions...), nil
+}
+
+func (h keyRotation) Run(ctx context.Context, run *Run) error {
+	if !run.HasDevices() {
+		key, err := h.tenantKeys.Rotate(ctx, run.TenantID())
+		if err != nil {
+			return err
+		}
+
+		if err := run.SetResult(key); err != nil {
+			return err
+		}
+
+		if err := setDevices(ctx, run, h.devices, nil); err != nil {
+			return err
+		}
+	}
+
+	return run.ForEachDevice(ctx, func(ctx context.Context, guid string) error {
+		d, err := h.devices.GetByID(ctx, guid, run.TenantID(), true)
+		if err != nil {
+			return err
+		}
+
+		_, err = h.devices.Update(ctx, d)
+
+		return err
+	})
+}
+
+// tlsEnforcement applies the TLS settings of a profile to its devices.
+type tlsEnforcement struct {
+	devices  Devices
+	profiles Profiles
+}
+
+func (h tlsEnforcement) Validate(payload []byte) error {
+	var req dto.TLSEnforcementRequest
+	if err := decode(payload, &req); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(req.Profile) == "" {
+		return ErrNoProfile
+	}
+
+	return nil
+}
+
+func (h tlsEnforcement) Plan(ctx context.Context, tenantID string, payload []byte) ([]dto.PlannedAction, error) {
+	var req dto.TLSEnforcementRequest
+	if err := decode(payload, &req); err != nil {
+		return nil, err
+	}
+
+	_, guids, err := h.resolve(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(guids) == 0 {
+		return []dto.PlannedAction{}, nil
+	}
+
+	return planDevices(ctx, h.devices, tenantID, guids, "apply the TLS settings of profile "+req.Profile)
+}
+
+func (h tlsEnforcement) Run(ctx context.Context, run *Run) error {
+	var req dto.TLSEnforcementRequest
+	if err := run.Decode(&req); err != nil {
+		return err
+	}
+
+	// the settings are read again on every attempt, so that a retry applies the profile as it is now
+	settings, guids, err := h.resolve(ctx, run.TenantID(), req)
+	if err != nil {
+		return err
+	}
+
+	run.SetDevices(guids)
+
+	return run.ForEachDevice(ctx, func(ctx context.Context, guid string) error {
+		ctx = devices.WithLockHolder(ctx, "job "+run.ID())
+		if _, err := h.devices.LockDevice(ctx, guid, dto.DeviceLockRequest{Reason: "TLS enforcement"}); err != nil {
+			return err
+		}
+
+		defer func() { _ = h.devices.UnlockDevice(ctx, guid, false) }()
+
+		_, err := h.devices.SetTLSSettings(ctx, guid, settings)
+
+		return err
+	})
+}
+
+// resolve returns the TLS settings of the profile of a job and the GUIDs requested or, without any, the GUIDs
+// of the devices of the profile.
+func (h tlsEnforcement) resolve(ctx context.Context, tenantID string, req dto.TLSEnforcementRequest) (dto.TLSSettingsRequest, []string, error) {
+	settings, targets, err := h.profiles.TLSEnforcement(ctx, req.Profile, tenantID)
+	if err != nil {
+		return dto.TLSSettingsRequest{}, nil, err
+	}
+
+	if len(req.GUIDs) > 0 {
+		return settings, req.GUIDs, nil
+	}
+
+	guids := make([]string, len(targets))
+	for i := range targets {
+		guids[i] = targets[i].GUID
+	}
+
+	return settings, guids, nil
+}
+
+// certificateCleanup deletes the certificates and key pairs devices keep without any credential context using them.
+type certificateCleanup struct {
+	devices Devices
+}
+
+func (h certificateCleanup) Validate(payload []byte) error {
+	return decode(payload, &dto.CertificateCleanupRequest{})
+}
+
+func (h certificateCleanup) Plan(ctx context.Context, tenantID string, payload []byte) ([]dto.PlannedAction, error) {
+	var req dto.CertificateCleanupRequest
+	if err := decode(payload, &req); err != nil {
+		return nil, err
+	}
+
+	return planDevices(ctx, h.devices, tenantID, req.GUIDs, "delete the orphaned certificates and key pairs")
+}
+
+func (h certificateCleanup) Run(ctx context.Context, run *Run) error {
+	var req dto.CertificateCleanupRequest
+	if err := run.Decode(&req); err != nil {
+		return err
+	}
+
+	if err := setDevices(ctx, run, h.devices, req.GUIDs); err != nil {
+		return err
+	}
+
+	return run.ForEachDevice(ctx, func(ctx context.Context, guid string) error {
+		ctx = devices.WithLockHolder(ctx, "job "+run.ID())
+		if _, err := h.devices.LockDevice(ctx, guid, dto.DeviceLockRequest{Reason: "certificate cleanup"}); err != nil {
+			return err
+		}
+
+		defer func() { _ = h.devices.UnlockDevice(ctx, guid, false) }()
+
+		_, err := h.devices.CleanupCertificates(ctx, guid, false)
+
+		return err
+	})
+}
+
+// duplicateDetection looks for the device records of the tenant likely to be the same machine, the groups found are
+// the result of the job.
+type duplicateDetection struct {
+	devices Devices
+}
+
+func (h duplicateDetection) Validate(_ []byte) error {
+	return nil
+}
+
+func (h duplicateDetection) Plan(_ context.Context, tenantID string, _ []byte) ([]dto.PlannedAction, error) {
+	return []dto.PlannedAction{{Target: fmt.Sprintf("tenant %q", tenantID), Action: "look for duplicate device records"}}, nil
+}
+
+func (h duplicateDetection) Run(ctx context.Context, run *Run) error {
+	groups, err := h.devices.FindDuplicates(ctx, run.TenantID())
+	if err != nil {
+		return err
+	}
+
+	return run.SetResult(dto.DuplicateReport{Groups: groups})
+}
@@ -0,0 +1,83 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		Get(ctx context.Context, top, skip int, tenantID, state string) ([]entity.Job, error)
+		GetByID(ctx context.Context, id string) (*entity.Job, error)
+		GetRunnable(ctx context.Context, now time.Time, limit int, excludedTenants []string) ([]entity.Job, error)
+		GetRunning(ctx context.Context) ([]entity.Job, error)
+		CountPending(ctx context.Context) (map[string]int, error)
+		Insert(ctx context.Context, j entity.Job) error
+		Update(ctx context.Context, j entity.Job) (bool, error)
+		Claim(ctx context.Context, id string, startedAt time.Time) (bool, error)
+		CancelPending(ctx context.Context, id string, at time.Time) (bool, error)
+		UpdatePending(ctx context.Context, j entity.Job) (bool, error)
+		DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error)
+	}
+
+	// Devices is the part of the devices use case the jobs depend on.
+	Devices interface {
+		Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Device, error)
+		GetByID(ctx context.Context, guid, tenantID string, includeSecrets bool) (*dto.Device, error)
+		Update(ctx context.Context, d *dto.Device) (*dto.Device, error)
+		GetHardwareInfo(ctx context.Context, guid string, sections ...string) (dto.HardwareInfo, error)
+		SendPowerAction(ctx context.Context, guid string, action int) (power.PowerActionResponse, error)
+		LockDevice(ctx context.Context, guid string, req dto.DeviceLockRequest) (dto.DeviceLock, error)
+		UnlockDevice(ctx context.Context, guid string, force bool) error
+		SetTLSSettings(c context.Context, guid string, req dto.TLSSettingsRequest) (dto.SettingDataResponse, error)
+		CleanupCertificates(c context.Context, guid string, dryRun bool) (dto.CertificateCleanup, error)
+		FindDuplicates(ctx context.Context, tenantID string) ([]dto.DuplicateGroup, error)
+	}
+
+	// Correlations imports the device records of Intune and ConfigMgr.
+	Correlations interface {
+		Import(ctx context.Context, source string, records []dto.CorrelationRecord, tenantID string) (dto.CorrelationImportResult, error)
+	}
+
+	// TenantKeys rotates the data-encryption key of a tenant.
+	TenantKeys interface {
+		Rotate(ctx context.Context, tenantID string) (dto.TenantKey, error)
+	}
+
+	// Profiles tells the TLS settings a profile requires of its devices.
+	Profiles interface {
+		TLSEnforcement(ctx context.Context, profileName, tenantID string) (dto.TLSSettingsRequest, []dto.Device, error)
+	}
+
+	// Handler runs the jobs of one type.
+	Handler interface {
+		// Validate checks the payload of a job before it is queued.
+		Validate(payload []byte) error
+		// Run does the work of a job. An error fails the attempt, the job is retried while it has attempts left.
+		Run(ctx context.Context, run *Run) error
+	}
+
+	// Planner is a Handler that tells the actions a job would take, for dry runs.
+	Planner interface {
+		Plan(ctx context.Context, tenantID string, payload []byte) ([]dto.PlannedAction, error)
+	}
+
+	Feature interface {
+		Start(ctx context.Context)
+		Enqueue(ctx context.Context, tenantID string, req dto.JobRequest) (dto.Job, error)
+		Plan(ctx context.Context, tenantID string, req dto.JobRequest) (dto.DryRunResult, error)
+		Get(ctx context.Context, top, skip int, tenantID, state string) ([]dto.Job, error)
+		GetByID(ctx context.Context, id string) (dto.Job, error)
+		Cancel(ctx context.Context, id string) (dto.Job, error)
+		Watch(ctx context.Context, id string) (<-chan dto.Job, error)
+		StartHardwareRefresh(ctx context.Context, req dto.HardwareRefreshRequest) (dto.Job, error)
+		GetHardwareInfo(ctx context.Context, id, guid string) (dto.HardwareInfo, error)
+		ReassignDevices(ctx context.Context, tenantID string, from []string, to string) (int, error)
+		RemoveDevice(ctx context.Context, tenantID, guid string) (int, error)
+	}
+)
@@ -0,0 +1,264 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// ReassignDevices makes the pending jobs of a tenant work on the device to instead of the devices from, merged into
+// it, returning how many jobs changed. A job listing both keeps a single entry for to, with the progress it had.
+// The jobs running or finished are left as they are.
+func (uc *UseCase) ReassignDevices(ctx context.Context, tenantID string, from []string, to string) (int, error) {
+	merged := make(map[string]bool, len(from))
+	for _, guid := range from {
+		merged[strings.ToLower(guid)] = true
+	}
+
+	to = strings.ToLower(to)
+
+	pending, err := uc.pendingJobs(ctx, tenantID, "ReassignDevices")
+	if err != nil {
+		return 0, err
+	}
+
+	changed := 0
+
+	for i := range pending {
+		j := pending[i]
+		if !reassign(&j, merged, to) {
+			continue
+		}
+
+		j.UpdatedAt = uc.now().UTC()
+
+		updated, err := uc.repo.UpdatePending(ctx, j)
+		if err != nil {
+			return changed, ErrDatabase.Wrap("ReassignDevices", "uc.repo.UpdatePending", err)
+		}
+
+		// a job claimed in the meantime runs on the devices it had
+		if updated {
+			changed++
+		}
+	}
+
+	return changed, nil
+}
+
+// RemoveDevice takes a deleted device out of the pending jobs of a tenant, returning how many jobs changed. A job
+// requested for that device alone is cancelled, the jobs running or finished are left as they are.
+func (uc *UseCase) RemoveDevice(ctx context.Context, tenantID, guid string) (int, error) {
+	guid = strings.ToLower(guid)
+
+	pending, err := uc.pendingJobs(ctx, tenantID, "RemoveDevice")
+	if err != nil {
+		return 0, err
+	}
+
+	changed := 0
+
+	for i := range pending {
+		j := pending[i]
+
+		payloadChanged, empty := removePayload(&j, guid)
+		itemsChanged := removeItems(&j, guid)
+
+		if !payloadChanged && !itemsChanged {
+			continue
+		}
+
+		now := uc.now().UTC()
+
+		var updated bool
+
+		// a job without GUIDs works on every device, it is not left to run on them
+		if empty {
+			updated, err = uc.repo.CancelPending(ctx, j.ID, now)
+			if err != nil {
+				return changed, ErrDatabase.Wrap("RemoveDevice", "uc.repo.CancelPending", err)
+			}
+
+			if updated {
+				uc.publish(entity.Job{ID: j.ID, Type: j.Type, TenantID: j.TenantID, State: dto.JobStateCancelled,
+					Total: j.Total, Succeeded: j.Succeeded, Failed: j.Failed})
+			}
+		} else {
+			j.UpdatedAt = now
+
+			updated, err = uc.repo.UpdatePending(ctx, j)
+			if err != nil {
+				return changed, ErrDatabase.Wrap("RemoveDevice", "uc.repo.UpdatePending", err)
+			}
+		}
+
+		// a job claimed in the meantime fails on the deleted device
+		if updated {
+			changed++
+		}
+	}
+
+	return changed, nil
+}
+
+// pendingJobs returns all the pending jobs of a tenant.
+func (uc *UseCase) pendingJobs(ctx context.Context, tenantID, function string) ([]entity.Job, error) {
+	var pending []entity.Job
+
+	for skip := 0; ; skip += devicesPerPage {
+		page, err := uc.repo.Get(ctx, devicesPerPage, skip, tenantID, dto.JobStatePending)
+		if err != nil {
+			return nil, ErrDatabase.Wrap(function, "uc.repo.Get", err)
+		}
+
+		pending = append(pending, page...)
+
+		if len(page) < devicesPerPage {
+			return pending, nil
+		}
+	}
+}
+
+// removePayload drops a device from the GUIDs requested by the payload of a job, reporting whether the job changed
+// and whether no device is left.
+func removePayload(j *entity.Job, guid string) (changed, empty bool) {
+	var payload map[string]json.RawMessage
+	if j.Payload == "" || json.Unmarshal([]byte(j.Payload), &payload) != nil {
+		return false, false
+	}
+
+	var guids []string
+	if raw, ok := payload["guids"]; !ok || json.Unmarshal(raw, &guids) != nil {
+		return false, false
+	}
+
+	kept := slices.DeleteFunc(slices.Clone(guids), func(g string) bool { return strings.ToLower(g) == guid })
+	if len(kept) == len(guids) {
+		return false, false
+	}
+
+	payload["guids"], _ = json.Marshal(kept)
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return false, false
+	}
+
+	j.Payload = string(data)
+
+	return true, len(kept) == 0
+}
+
+// removeItems drops a device from the progress of a job set by an earlier attempt.
+func removeItems(j *entity.Job, guid string) bool {
+	var devices []dto.JobDeviceProgress
+	if j.Items == "" || json.Unmarshal([]byte(j.Items), &devices) != nil {
+		return false
+	}
+
+	kept := slices.DeleteFunc(slices.Clone(devices), func(d dto.JobDeviceProgress) bool { return d.GUID == guid })
+	if len(kept) == len(devices) {
+		return false
+	}
+
+	data, err := json.Marshal(kept)
+	if err != nil {
+		return false
+	}
+
+	j.Items = string(data)
+	j.Total, j.Succeeded, j.Failed = countDevices(kept)
+
+	return true
+}
+
+// reassign replaces the merged devices by to in the GUIDs of the payload and the progress of a job, reporting
+// whether the job changed.
+func reassign(j *entity.Job, merged map[string]bool, to string) bool {
+	payloadChanged := reassignPayload(j, merged, to)
+	itemsChanged := reassignItems(j, merged, to)
+
+	return payloadChanged || itemsChanged
+}
+
+// reassignPayload replaces the merged devices in the GUIDs requested by the payload of a job.
+func reassignPayload(j *entity.Job, merged map[string]bool, to string) bool {
+	var payload map[string]json.RawMessage
+	if j.Payload == "" || json.Unmarshal([]byte(j.Payload), &payload) != nil {
+		return false
+	}
+
+	var guids []string
+	if raw, ok := payload["guids"]; !ok || json.Unmarshal(raw, &guids) != nil {
+		return false
+	}
+
+	if !slices.ContainsFunc(guids, func(guid string) bool { return merged[strings.ToLower(guid)] }) {
+		return false
+	}
+
+	replaced := make([]string, 0, len(guids))
+
+	for _, guid := range guids {
+		if merged[strings.ToLower(guid)] {
+			guid = to
+		}
+
+		if !slices.Contains(replaced, guid) {
+			replaced = append(replaced, guid)
+		}
+	}
+
+	payload["guids"], _ = json.Marshal(replaced)
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return false
+	}
+
+	j.Payload = string(data)
+
+	return true
+}
+
+// reassignItems replaces the merged devices in the progress of a job set by an earlier attempt.
+func reassignItems(j *entity.Job, merged map[string]bool, to string) bool {
+	var devices []dto.JobDeviceProgress
+	if j.Items == "" || json.Unmarshal([]byte(j.Items), &devices) != nil {
+		return false
+	}
+
+	if !slices.ContainsFunc(devices, func(d dto.JobDeviceProgress) bool { return merged[d.GUID] }) {
+		return false
+	}
+
+	kept := make([]dto.JobDeviceProgress, 0, len(devices))
+	hasTo := slices.ContainsFunc(devices, func(d dto.JobDeviceProgress) bool { return d.GUID == to })
+
+	for _, d := range devices {
+		if merged[d.GUID] {
+			if hasTo {
+				continue
+			}
+
+			d.GUID = to
+			hasTo = true
+		}
+
+		kept = append(kept, d)
+	}
+
+	data, err := json.Marshal(kept)
+	if err != nil {
+		return false
+	}
+
+	j.Items = string(data)
+	j.Total, j.Succeeded, j.Failed = countDevices(kept)
+
+	return true
+}
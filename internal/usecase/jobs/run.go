@@ -0,0 +1,240 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// ErrDevicesFailed fails the attempt of a job in which some devices failed, the next attempt
+// runs only the devices that failed.
+var ErrDevicesFailed = errors.New("the job failed on some devices")
+
+// Run is a job as its handler sees it while it runs. The progress reported through it is saved
+// and published at most once per saveInterval, and when the attempt ends.
+type Run struct {
+	uc *UseCase
+
+	mu      sync.Mutex
+	job     entity.Job
+	devices []dto.JobDeviceProgress
+	index   map[string]int
+	savedAt time.Time
+}
+
+func newRun(uc *UseCase, j entity.Job) *Run {
+	r := &Run{uc: uc, job: j, index: map[string]int{}}
+
+	if j.Items != "" {
+		if err := json.Unmarshal([]byte(j.Items), &r.devices); err != nil {
+			uc.log.Warn("jobs - newRun - %s: the progress of the devices is unreadable: %s", j.ID, err)
+		}
+	}
+
+	for i := range r.devices {
+		r.index[r.devices[i].GUID] = i
+	}
+
+	return r
+}
+
+// ID -.
+func (r *Run) ID() string {
+	return r.job.ID
+}
+
+// TenantID is the tenant the job was queued for.
+func (r *Run) TenantID() string {
+	return r.job.TenantID
+}
+
+// Decode reads the payload of the job into v.
+func (r *Run) Decode(v any) error {
+	if r.job.Payload == "" {
+		return nil
+	}
+
+	return json.Unmarshal([]byte(r.job.Payload), v)
+}
+
+// HasDevices reports whether the devices of the job are known already, from an earlier attempt.
+func (r *Run) HasDevices() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.devices) > 0
+}
+
+// SetDevices sets the devices the job works on, once. The GUIDs are lower-cased and deduplicated.
+func (r *Run) SetDevices(guids []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.devices) > 0 {
+		return
+	}
+
+	for _, guid := range guids {
+		guid = strings.ToLower(guid)
+		if _, ok := r.index[guid]; ok {
+			continue
+		}
+
+		r.index[guid] = len(r.devices)
+		r.devices = append(r.devices, dto.JobDeviceProgress{GUID: guid, State: dto.JobStatePending})
+	}
+
+	r.count()
+}
+
+// ForEachDevice calls fn for every device that has not completed in an earlier attempt, at most
+// DeviceConcurrency at a time, and records the outcome of each. It returns ErrDevicesFailed when
+// fn failed for a device, and the error of ctx when the job was stopped.
+func (r *Run) ForEachDevice(ctx context.Context, fn func(ctx context.Context, guid string) error) error {
+	r.mu.Lock()
+
+	pending := make([]string, 0, len(r.devices))
+
+	for i := range r.devices {
+		if r.devices[i].State != dto.JobStateCompleted {
+			pending = append(pending, r.devices[i].GUID)
+		}
+	}
+
+	r.mu.Unlock()
+
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, r.uc.deviceConcurrency)
+
+	for _, guid := range pending {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			r.setDevice(guid, dto.JobStateRunning, nil)
+
+			if err := fn(ctx, guid); err != nil {
+				r.setDevice(guid, dto.JobStateFailed, err)
+
+				return
+			}
+
+			r.setDevice(guid, dto.JobStateCompleted, nil)
+		}()
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.job.Failed > 0 {
+		return ErrDevicesFailed
+	}
+
+	return nil
+}
+
+// SetResult keeps the outcome of the job, v is stored as JSON.
+func (r *Run) SetResult(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.job.Result = string(data)
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *Run) setDevice(guid, state string, err error) {
+	r.mu.Lock()
+
+	device := &r.devices[r.index[guid]]
+	device.State = state
+	device.Error = ""
+
+	if err != nil {
+		device.Error = err.Error()
+	}
+
+	r.count()
+
+	now := r.uc.now()
+
+	due := now.Sub(r.savedAt) >= saveInterval
+	if due {
+		r.savedAt = now
+	}
+
+	r.mu.Unlock()
+
+	if due {
+		r.save(context.Background())
+	}
+}
+
+// count updates the totals of the job from its devices; callers must hold r.mu.
+func (r *Run) count() {
+	r.job.Total, r.job.Succeeded, r.job.Failed = countDevices(r.devices)
+}
+
+// countDevices returns the number of devices of a job, and of those completed and failed.
+func countDevices(devices []dto.JobDeviceProgress) (total, succeeded, failed int) {
+	for i := range devices {
+		switch devices[i].State {
+		case dto.JobStateCompleted:
+			succeeded++
+		case dto.JobStateFailed:
+			failed++
+		}
+	}
+
+	return len(devices), succeeded, failed
+}
+
+// save stores the job with the progress of its devices and publishes the update.
+func (r *Run) save(ctx context.Context) {
+	r.mu.Lock()
+
+	r.savedAt = r.uc.now()
+	r.job.UpdatedAt = r.savedAt
+
+	if len(r.devices) > 0 {
+		items, err := json.Marshal(r.devices)
+		if err == nil {
+			r.job.Items = string(items)
+		}
+	}
+
+	j := r.job
+	r.mu.Unlock()
+
+	r.uc.save(ctx, j)
+}
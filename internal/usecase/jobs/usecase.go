@@ -0,0 +1,768 @@
+// Package jobs runs the long tasks started through the API in the background, so that a client
+// starts a task once and follows its progress instead of holding hundreds of slow requests open.
+// The jobs are kept in the database: a job survives a restart of the console, a failed attempt
+// is retried with a growing delay and a job can be cancelled while it waits or runs. The jobs a
+// console stopped without finishing, even abruptly, are queued again when it starts, resuming
+// from the devices they had not completed.
+//
+// A job moves from pending to running, and from running to completed, failed or cancelled, or
+// back to pending for its next attempt. A pending job can be cancelled right away.
+//
+// The workers are shared fairly between the tenants: a tenant runs at most TenantWorkers jobs at
+// the same time, always leaving a worker to the others, and the free workers go round the tenants
+// with due jobs, those running the fewest jobs first. A tenant's bulk job over thousands of devices
+// thus never holds back the single power action of another tenant.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	devicesPerPage = 100
+
+	// runnableWindow is the number of due jobs the workers are shared out among at a time.
+	runnableWindow = 100
+
+	// maxAttempts caps the attempts a job may ask for.
+	maxAttempts = 10
+
+	// retryDelay is the wait before the second attempt of a job, it doubles with every attempt up to maxRetryDelay.
+	retryDelay    = 30 * time.Second
+	maxRetryDelay = 10 * time.Minute
+
+	// pollInterval is how often the queue is checked for the jobs due for a retry.
+	pollInterval = time.Second
+
+	// saveInterval is how often the progress of a running job is saved and published.
+	saveInterval = time.Second
+
+	// retention is how long a finished job is kept.
+	retention = 7 * 24 * time.Hour
+
+	// watchBuffer is the number of updates queued for a client following a job.
+	watchBuffer = 16
+
+	// interrupted is the error of a job the console stopped while it ran, until it runs again.
+	interrupted = "interrupted by a restart of the console"
+)
+
+var (
+	ErrJobsUseCase = consoleerrors.CreateConsoleError("JobsUseCase")
+	ErrDatabase    = sqldb.DatabaseError{Console: ErrJobsUseCase}
+	ErrNotFound    = sqldb.NotFoundError{Console: ErrJobsUseCase}
+	ErrValidation  = dto.NotValidError{Console: ErrJobsUseCase}
+
+	ErrUnknownType = errors.New("unknown job type")
+	ErrNoResult    = errors.New("the device has no result in this job")
+)
+
+// QueueFullError refuses a job while too many jobs wait to run, for the tenant of the job when
+// Tenant is set and over all tenants otherwise.
+type QueueFullError struct {
+	Limit  int
+	Tenant bool
+}
+
+func (e QueueFullError) Error() string {
+	if e.Tenant {
+		return fmt.Sprintf("the tenant has %d jobs waiting to run, try again later", e.Limit)
+	}
+
+	return fmt.Sprintf("%d jobs are waiting to run, try again later", e.Limit)
+}
+
+func (e QueueFullError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeQueueFull
+}
+
+// RetryAfterSeconds is the value of a Retry-After header for the error.
+func (e QueueFullError) RetryAfterSeconds() int {
+	return int(retryDelay.Seconds())
+}
+
+// UseCase queues the jobs and runs them on a pool of workers.
+type UseCase struct {
+	repo     Repository
+	log      logger.Interface
+	events   *eventbus.Bus
+	handlers map[string]Handler
+	hardware *hardwareRefresh
+	now      func() time.Time
+
+	workers           int
+	tenantWorkers     int
+	deviceConcurrency int
+	queueDepth        int
+	tenantQueueDepth  int
+
+	wake chan struct{}
+
+	mu      sync.Mutex
+	running map[string]*running
+}
+
+// running is a job being run, cancelled is set when it was cancelled through the API.
+type running struct {
+	tenantID  string
+	cancel    context.CancelFunc
+	cancelled bool
+}
+
+// New creates the job engine with the handlers of the jobs the console runs. The correlation
+// imports, key rotations and TLS enforcements are refused when their use case is nil. A queue depth of 0 puts no
+// limit on the jobs waiting to run.
+func New(repo Repository, devices Devices, correlations Correlations, tenantKeys TenantKeys, profiles Profiles, cfg config.Jobs, log logger.Interface, events *eventbus.Bus) *UseCase {
+	workers := max(cfg.Workers, 1)
+	tenantWorkers := min(max(cfg.TenantWorkers, 1), max(workers-1, 1))
+
+	uc := &UseCase{
+		repo:              repo,
+		log:               log,
+		events:            events,
+		handlers:          map[string]Handler{},
+		hardware:          newHardwareRefresh(devices),
+		now:               time.Now,
+		workers:           workers,
+		tenantWorkers:     tenantWorkers,
+		deviceConcurrency: max(cfg.DeviceConcurrency, 1),
+		queueDepth:        max(cfg.QueueDepth, 0),
+		tenantQueueDepth:  max(cfg.TenantQueueDepth, 0),
+		wake:              make(chan struct{}, 1),
+		running:           map[string]*running{},
+	}
+
+	uc.Register(dto.JobTypeHardwareRefresh, uc.hardware)
+	uc.Register(dto.JobTypePower, bulkPower{devices})
+	uc.Register(dto.JobTypeCertificateCleanup, certificateCleanup{devices})
+	uc.Register(dto.JobTypeDuplicateDetection, duplicateDetection{devices})
+
+	if correlations != nil {
+		uc.Register(dto.JobTypeCorrelationImport, correlationImport{correlations})
+	}
+
+	if tenantKeys != nil {
+		uc.Register(dto.JobTypeKeyRotation, keyRotation{devices, tenantKeys})
+	}
+
+	if profiles != nil {
+		uc.Register(dto.JobTypeTLSEnforcement, tlsEnforcement{devices, profiles})
+	}
+
+	return uc
+}
+
+// Register sets the handler of a job type. It must be called before Start.
+func (uc *UseCase) Register(jobType string, h Handler) {
+	uc.handlers[jobType] = h
+}
+
+// Start queues the jobs left running by the last run of the console again, then runs the queued jobs in the
+// background until ctx is done.
+func (uc *UseCase) Start(ctx context.Context) {
+	uc.resume(ctx)
+
+	go uc.dispatch(ctx)
+}
+
+// resume queues the jobs a stopped console left running again and logs a summary of the recovery. The devices
+// the jobs completed are kept, the devices they were working on run again.
+func (uc *UseCase) resume(ctx context.Context) {
+	items, err := uc.repo.GetRunning(ctx)
+	if err != nil {
+		uc.log.Error(err, "jobs - resume - uc.repo.GetRunning")
+
+		return
+	}
+
+	now := uc.now().UTC()
+	left := 0
+
+	for i := range items {
+		run := newRun(uc, items[i])
+
+		run.mu.Lock()
+
+		for k := range run.devices {
+			if run.devices[k].State == dto.JobStateRunning {
+				run.devices[k].State = dto.JobStatePending
+			}
+		}
+
+		run.count()
+
+		run.job.State = dto.JobStatePending
+		run.job.Error = interrupted
+		run.job.RunAfter = now
+		done := run.job.Succeeded
+		left += run.job.Total - done
+
+		run.mu.Unlock()
+
+		run.save(ctx)
+
+		uc.log.Info("jobs - resume: %s %s of tenant %q queued again, %d of %d devices completed", items[i].Type, items[i].ID,
+			items[i].TenantID, done, run.job.Total)
+	}
+
+	counts, err := uc.repo.CountPending(ctx)
+	if err != nil {
+		uc.log.Error(err, "jobs - resume - uc.repo.CountPending")
+
+		return
+	}
+
+	pending := 0
+	for _, count := range counts {
+		pending += count
+	}
+
+	if len(items) > 0 || pending > 0 {
+		uc.log.Info("jobs - resume: %d interrupted jobs queued again with %d devices left, %d jobs pending", len(items), left, pending)
+	}
+}
+
+// Enqueue validates and queues a job for a tenant. It returns a QueueFullError while the queue
+// of the tenant, or of all tenants, is full.
+func (uc *UseCase) Enqueue(ctx context.Context, tenantID string, req dto.JobRequest) (dto.Job, error) {
+	if _, err := uc.validate(ctx, "Enqueue", tenantID, req); err != nil {
+		return dto.Job{}, err
+	}
+
+	now := uc.now().UTC()
+
+	j := entity.Job{
+		ID:          uuid.NewString(),
+		Type:        req.Type,
+		TenantID:    tenantID,
+		Payload:     string(req.Payload),
+		State:       dto.JobStatePending,
+		MaxAttempts: min(max(req.MaxAttempts, 1), maxAttempts),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := uc.repo.Insert(ctx, j); err != nil {
+		return dto.Job{}, ErrDatabase.Wrap("Enqueue", "uc.repo.Insert", err)
+	}
+
+	uc.publish(j)
+	uc.notify()
+
+	return toDTO(j, true), nil
+}
+
+// Plan validates a job like Enqueue and returns the actions it would take, without queueing it.
+func (uc *UseCase) Plan(ctx context.Context, tenantID string, req dto.JobRequest) (dto.DryRunResult, error) {
+	h, err := uc.validate(ctx, "Plan", tenantID, req)
+	if err != nil {
+		return dto.DryRunResult{}, err
+	}
+
+	result := dto.DryRunResult{Operation: req.Type, Actions: []dto.PlannedAction{}}
+
+	if p, ok := h.(Planner); ok {
+		actions, err := p.Plan(ctx, tenantID, req.Payload)
+		if err != nil {
+			return dto.DryRunResult{}, err
+		}
+
+		result.Actions = actions
+	}
+
+	return result, nil
+}
+
+// validate returns the handler of a job after checking its payload and that the queue takes it.
+func (uc *UseCase) validate(ctx context.Context, call, tenantID string, req dto.JobRequest) (Handler, error) {
+	h, ok := uc.handlers[req.Type]
+	if !ok {
+		return nil, ErrValidation.Wrap(call, "uc.handlers", fmt.Errorf("%w: %q", ErrUnknownType, req.Type))
+	}
+
+	if err := h.Validate(req.Payload); err != nil {
+		return nil, ErrValidation.Wrap(call, "h.Validate", err)
+	}
+
+	if err := uc.checkQueue(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// checkQueue refuses a job for a tenant when its queue or the queue of all tenants is full.
+func (uc *UseCase) checkQueue(ctx context.Context, tenantID string) error {
+	if uc.queueDepth == 0 && uc.tenantQueueDepth == 0 {
+		return nil
+	}
+
+	counts, err := uc.repo.CountPending(ctx)
+	if err != nil {
+		return ErrDatabase.Wrap("checkQueue", "uc.repo.CountPending", err)
+	}
+
+	if uc.tenantQueueDepth > 0 && counts[tenantID] >= uc.tenantQueueDepth {
+		return QueueFullError{Limit: uc.tenantQueueDepth, Tenant: true}
+	}
+
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+
+	if uc.queueDepth > 0 && total >= uc.queueDepth {
+		return QueueFullError{Limit: uc.queueDepth}
+	}
+
+	return nil
+}
+
+// Get returns a page of the jobs of a tenant without the progress of their devices.
+func (uc *UseCase) Get(ctx context.Context, top, skip int, tenantID, state string) ([]dto.Job, error) {
+	items, err := uc.repo.Get(ctx, top, skip, tenantID, state)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("Get", "uc.repo.Get", err)
+	}
+
+	d := make([]dto.Job, len(items))
+	for i := range items {
+		d[i] = toDTO(items[i], false)
+	}
+
+	return d, nil
+}
+
+// GetByID returns a job with the progress of its devices.
+func (uc *UseCase) GetByID(ctx context.Context, id string) (dto.Job, error) {
+	j, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return dto.Job{}, ErrDatabase.Wrap("GetByID", "uc.repo.GetByID", err)
+	}
+
+	if j == nil {
+		return dto.Job{}, ErrNotFound
+	}
+
+	return toDTO(*j, true), nil
+}
+
+// Cancel stops a job. A pending job is cancelled right away, a running job once its handler
+// returned. A finished job is returned as it is.
+func (uc *UseCase) Cancel(ctx context.Context, id string) (dto.Job, error) {
+	cancelled, err := uc.repo.CancelPending(ctx, id, uc.now().UTC())
+	if err != nil {
+		return dto.Job{}, ErrDatabase.Wrap("Cancel", "uc.repo.CancelPending", err)
+	}
+
+	if !cancelled {
+		uc.mu.Lock()
+		if r, ok := uc.running[id]; ok {
+			r.cancelled = true
+			r.cancel()
+		}
+		uc.mu.Unlock()
+	}
+
+	job, err := uc.GetByID(ctx, id)
+	if err != nil {
+		return dto.Job{}, err
+	}
+
+	if cancelled {
+		uc.publish(entity.Job{ID: job.ID, Type: job.Type, TenantID: job.TenantID, State: job.State,
+			Total: job.Total, Succeeded: job.Succeeded, Failed: job.Failed})
+	}
+
+	return job, nil
+}
+
+// Watch returns the job followed by its updates until it finished or ctx is done. The updates
+// carry the totals of the job without the progress of each device.
+func (uc *UseCase) Watch(ctx context.Context, id string) (<-chan dto.Job, error) {
+	events := make(chan eventbus.JobUpdatedEvent, watchBuffer)
+
+	// subscribed before the job is read, so that no update is missed in between
+	unsubscribe := eventbus.Subscribe(uc.events, eventbus.JobUpdated, func(e eventbus.JobUpdatedEvent) {
+		if e.ID != id {
+			return
+		}
+
+		select {
+		case events <- e:
+		default: // the client is behind, the next update carries the totals anyway
+		}
+	})
+
+	job, err := uc.GetByID(ctx, id)
+	if err != nil {
+		unsubscribe()
+
+		return nil, err
+	}
+
+	updates := make(chan dto.Job, watchBuffer)
+	updates <- job
+
+	go func() {
+		defer close(updates)
+		defer unsubscribe()
+
+		for !job.Finished() {
+			select {
+			case <-ctx.Done():
+				return
+			case e := <-events:
+				job = dto.Job{
+					ID: e.ID, Type: e.Type, TenantID: e.TenantID, State: e.State,
+					Total: e.Total, Succeeded: e.Succeeded, Failed: e.Failed, Error: e.Error,
+				}
+			}
+
+			select {
+			case updates <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// StartHardwareRefresh queues a job reading the hardware information of the requested devices,
+// or of every device when none is requested.
+func (uc *UseCase) StartHardwareRefresh(ctx context.Context, req dto.HardwareRefreshRequest) (dto.Job, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return dto.Job{}, ErrValidation.Wrap("StartHardwareRefresh", "json.Marshal", err)
+	}
+
+	return uc.Enqueue(ctx, "", dto.JobRequest{Type: dto.JobTypeHardwareRefresh, Payload: payload})
+}
+
+// GetHardwareInfo returns the hardware information a hardware refresh job read from a device.
+// The information is kept in memory for an hour after it was read.
+func (uc *UseCase) GetHardwareInfo(ctx context.Context, id, guid string) (dto.HardwareInfo, error) {
+	if _, err := uc.GetByID(ctx, id); err != nil {
+		return dto.HardwareInfo{}, err
+	}
+
+	info, ok := uc.hardware.result(id, guid)
+	if !ok {
+		return dto.HardwareInfo{}, ErrNotFound.Wrap("GetHardwareInfo", "uc.hardware.result", ErrNoResult)
+	}
+
+	return info, nil
+}
+
+// notify wakes the dispatcher up for a job queued just now or a worker freed.
+func (uc *UseCase) notify() {
+	select {
+	case uc.wake <- struct{}{}:
+	default:
+	}
+}
+
+// dispatch hands the due jobs to the workers as they become free.
+func (uc *UseCase) dispatch(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var purged time.Time
+
+	for {
+		if now := uc.now(); now.Sub(purged) >= time.Hour {
+			purged = now
+			uc.purge(ctx)
+		}
+
+		uc.runDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-uc.wake:
+		}
+	}
+}
+
+// runDue starts the due jobs the free workers are shared out to. The tenants running as many jobs
+// as they may are not even looked at, so that their backlog never hides the jobs of the others.
+func (uc *UseCase) runDue(ctx context.Context) {
+	uc.mu.Lock()
+
+	free := uc.workers - len(uc.running)
+	perTenant := map[string]int{}
+
+	for _, r := range uc.running {
+		perTenant[r.tenantID]++
+	}
+
+	uc.mu.Unlock()
+
+	if free <= 0 {
+		return
+	}
+
+	busy := []string{}
+
+	for tenantID, count := range perTenant {
+		if count >= uc.tenantWorkers {
+			busy = append(busy, tenantID)
+		}
+	}
+
+	due, err := uc.repo.GetRunnable(ctx, uc.now().UTC(), runnableWindow, busy)
+	if err != nil {
+		uc.log.Error(err, "jobs - runDue - uc.repo.GetRunnable")
+
+		return
+	}
+
+	for _, j := range uc.share(due, perTenant, free) {
+		startedAt := uc.now().UTC()
+
+		claimed, err := uc.repo.Claim(ctx, j.ID, startedAt)
+		if err != nil {
+			uc.log.Error(err, "jobs - runDue - uc.repo.Claim")
+
+			continue
+		}
+
+		if !claimed {
+			continue
+		}
+
+		j.State = dto.JobStateRunning
+		j.Attempts++
+		j.StartedAt = startedAt
+		j.UpdatedAt = startedAt
+
+		jobCtx, cancel := context.WithCancel(ctx)
+		r := &running{tenantID: j.TenantID, cancel: cancel}
+
+		uc.mu.Lock()
+		uc.running[j.ID] = r
+		uc.mu.Unlock()
+
+		go func() {
+			defer func() {
+				cancel()
+
+				uc.mu.Lock()
+				delete(uc.running, j.ID)
+				uc.mu.Unlock()
+
+				uc.notify()
+			}()
+
+			uc.run(ctx, jobCtx, r, j)
+		}()
+	}
+}
+
+// share picks up to free of the due jobs, oldest first. The picks go round the tenants, those
+// running the fewest jobs first, and no tenant gets more than tenantWorkers jobs running.
+func (uc *UseCase) share(due []entity.Job, perTenant map[string]int, free int) []entity.Job {
+	queues := map[string][]entity.Job{}
+	tenants := []string{}
+
+	for i := range due {
+		tenantID := due[i].TenantID
+		if _, ok := queues[tenantID]; !ok {
+			tenants = append(tenants, tenantID)
+		}
+
+		queues[tenantID] = append(queues[tenantID], due[i])
+	}
+
+	slices.SortStableFunc(tenants, func(a, b string) int { return perTenant[a] - perTenant[b] })
+
+	picked := []entity.Job{}
+
+	for len(picked) < free {
+		before := len(picked)
+
+		for _, tenantID := range tenants {
+			if len(picked) == free {
+				break
+			}
+
+			if len(queues[tenantID]) == 0 || perTenant[tenantID] >= uc.tenantWorkers {
+				continue
+			}
+
+			picked = append(picked, queues[tenantID][0])
+			queues[tenantID] = queues[tenantID][1:]
+			perTenant[tenantID]++
+		}
+
+		if len(picked) == before {
+			break
+		}
+	}
+
+	return picked
+}
+
+// run runs one attempt of a job in jobCtx and decides what comes next. ctx is the context of the
+// engine, the job goes back to the queue when it is done.
+func (uc *UseCase) run(ctx, jobCtx context.Context, r *running, j entity.Job) {
+	uc.publish(j)
+
+	run := newRun(uc, j)
+
+	h, ok := uc.handlers[j.Type]
+
+	var err error
+
+	if ok {
+		err = h.Run(jobCtx, run)
+	} else {
+		err = fmt.Errorf("%w: %q", ErrUnknownType, j.Type)
+	}
+
+	uc.mu.Lock()
+	cancelled := r.cancelled
+	uc.mu.Unlock()
+
+	now := uc.now().UTC()
+
+	run.mu.Lock()
+
+	job := &run.job
+	job.Error = ""
+
+	switch {
+	case cancelled:
+		job.State = dto.JobStateCancelled
+		job.FinishedAt = now
+	case ctx.Err() != nil:
+		// the console is stopping, the job runs again at the next start
+		job.State = dto.JobStatePending
+	case err == nil:
+		job.State = dto.JobStateCompleted
+		job.FinishedAt = now
+	case ok && job.Attempts < job.MaxAttempts:
+		job.State = dto.JobStatePending
+		job.Error = err.Error()
+		job.RunAfter = now.Add(backoff(job.Attempts))
+	case errors.Is(err, ErrDevicesFailed) && job.Succeeded > 0:
+		job.State = dto.JobStateCompleted
+		job.FinishedAt = now
+	default:
+		job.State = dto.JobStateFailed
+		job.Error = err.Error()
+		job.FinishedAt = now
+	}
+
+	if err != nil && !cancelled && ctx.Err() == nil {
+		uc.log.Warn("jobs - run - %s %s, attempt %d of %d: %s", job.Type, job.ID, job.Attempts, job.MaxAttempts, err)
+	}
+
+	run.mu.Unlock()
+
+	// saved even when the console is stopping
+	run.save(context.WithoutCancel(ctx))
+}
+
+// save stores a job and publishes its update.
+func (uc *UseCase) save(ctx context.Context, j entity.Job) {
+	if _, err := uc.repo.Update(ctx, j); err != nil {
+		uc.log.Error(err, "jobs - save - uc.repo.Update")
+	}
+
+	uc.publish(j)
+}
+
+func (uc *UseCase) publish(j entity.Job) {
+	eventbus.Publish(uc.events, eventbus.JobUpdated, eventbus.JobUpdatedEvent{
+		ID:        j.ID,
+		Type:      j.Type,
+		TenantID:  j.TenantID,
+		State:     j.State,
+		Total:     j.Total,
+		Succeeded: j.Succeeded,
+		Failed:    j.Failed,
+		Error:     j.Error,
+	})
+}
+
+// purge removes the jobs finished longer than the retention ago.
+func (uc *UseCase) purge(ctx context.Context) {
+	count, err := uc.repo.DeleteFinishedBefore(ctx, uc.now().Add(-retention))
+	if err != nil {
+		uc.log.Error(err, "jobs - purge - uc.repo.DeleteFinishedBefore")
+
+		return
+	}
+
+	if count > 0 {
+		uc.log.Info("jobs - purge: removed %d finished jobs", count)
+	}
+}
+
+// backoff is the wait after the attempt-th attempt of a job failed.
+func backoff(attempt int) time.Duration {
+	delay := retryDelay
+
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxRetryDelay)
+}
+
+func toDTO(j entity.Job, withDevices bool) dto.Job {
+	d := dto.Job{
+		ID:          j.ID,
+		Type:        j.Type,
+		TenantID:    j.TenantID,
+		State:       j.State,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		Total:       j.Total,
+		Succeeded:   j.Succeeded,
+		Failed:      j.Failed,
+		Error:       j.Error,
+		CreatedAt:   j.CreatedAt,
+	}
+
+	if j.Result != "" {
+		d.Result = json.RawMessage(j.Result)
+	}
+
+	if withDevices && j.Items != "" {
+		_ = json.Unmarshal([]byte(j.Items), &d.Devices) //nolint:errcheck // the progress is written by the jobs only
+	}
+
+	if !j.StartedAt.IsZero() {
+		startedAt := j.StartedAt
+		d.StartedAt = &startedAt
+	}
+
+	if !j.FinishedAt.IsZero() {
+		finishedAt := j.FinishedAt
+		d.FinishedAt = &finishedAt
+	}
+
+	return d
+}
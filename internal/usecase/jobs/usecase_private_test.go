@@ -0,0 +1,1031 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var (
+	errUnreachable    = errors.New("device unreachable")
+	errUnknownProfile = errors.New("profile not found")
+)
+
+// memRepo keeps the jobs in memory like the database does.
+type memRepo struct {
+	mu   sync.Mutex
+	jobs map[string]entity.Job
+}
+
+func (r *memRepo) Get(_ context.Context, top, skip int, tenantID, state string) ([]entity.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := []entity.Job{}
+
+	for _, j := range r.jobs {
+		if j.TenantID == tenantID && (state == "" || j.State == state) {
+			items = append(items, j)
+		}
+	}
+
+	sort.Slice(items, func(a, b int) bool { return items[a].CreatedAt.After(items[b].CreatedAt) })
+
+	items = items[min(skip, len(items)):]
+
+	return items[:min(top, len(items))], nil
+}
+
+func (r *memRepo) GetByID(_ context.Context, id string) (*entity.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	j, ok := r.jobs[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &j, nil
+}
+
+func (r *memRepo) GetRunnable(_ context.Context, now time.Time, limit int, excludedTenants []string) ([]entity.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := []entity.Job{}
+
+	for _, j := range r.jobs {
+		if j.State == dto.JobStatePending && !j.RunAfter.After(now) && !slices.Contains(excludedTenants, j.TenantID) {
+			items = append(items, j)
+		}
+	}
+
+	sort.Slice(items, func(a, b int) bool { return items[a].CreatedAt.Before(items[b].CreatedAt) })
+
+	return items[:min(limit, len(items))], nil
+}
+
+func (r *memRepo) GetRunning(_ context.Context) ([]entity.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := []entity.Job{}
+
+	for _, j := range r.jobs {
+		if j.State == dto.JobStateRunning {
+			items = append(items, j)
+		}
+	}
+
+	sort.Slice(items, func(a, b int) bool { return items[a].CreatedAt.Before(items[b].CreatedAt) })
+
+	return items, nil
+}
+
+func (r *memRepo) CountPending(_ context.Context) (map[string]int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := map[string]int{}
+
+	for _, j := range r.jobs {
+		if j.State == dto.JobStatePending {
+			counts[j.TenantID]++
+		}
+	}
+
+	return counts, nil
+}
+
+func (r *memRepo) Insert(_ context.Context, j entity.Job) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.jobs == nil {
+		r.jobs = map[string]entity.Job{}
+	}
+
+	r.jobs[j.ID] = j
+
+	return nil
+}
+
+func (r *memRepo) Update(_ context.Context, j entity.Job) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.jobs[j.ID]; !ok {
+		return false, nil
+	}
+
+	r.jobs[j.ID] = j
+
+	return true, nil
+}
+
+func (r *memRepo) UpdatePending(_ context.Context, j entity.Job) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.jobs[j.ID]
+	if !ok || stored.State != dto.JobStatePending {
+		return false, nil
+	}
+
+	stored.Payload, stored.Items = j.Payload, j.Items
+	stored.Total, stored.Succeeded, stored.Failed = j.Total, j.Succeeded, j.Failed
+	stored.UpdatedAt = j.UpdatedAt
+	r.jobs[j.ID] = stored
+
+	return true, nil
+}
+
+func (r *memRepo) Claim(_ context.Context, id string, startedAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	j, ok := r.jobs[id]
+	if !ok || j.State != dto.JobStatePending {
+		return false, nil
+	}
+
+	j.State = dto.JobStateRunning
+	j.Attempts++
+	j.StartedAt = startedAt
+	r.jobs[id] = j
+
+	return true, nil
+}
+
+func (r *memRepo) CancelPending(_ context.Context, id string, at time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	j, ok := r.jobs[id]
+	if !ok || j.State != dto.JobStatePending {
+		return false, nil
+	}
+
+	j.State = dto.JobStateCancelled
+	j.FinishedAt = at
+	r.jobs[id] = j
+
+	return true, nil
+}
+
+func (r *memRepo) DeleteFinishedBefore(_ context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var count int64
+
+	for id, j := range r.jobs {
+		if !j.FinishedAt.IsZero() && j.FinishedAt.Before(before) {
+			delete(r.jobs, id)
+
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// fakeDevices answers for the devices guid1 to guid3, the devices listed in fail fail.
+type fakeDevices struct {
+	mu      sync.Mutex
+	fail    map[string]int // number of calls that fail, -1 for all
+	calls   map[string]int
+	updated []string
+}
+
+func (d *fakeDevices) call(guid string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.calls == nil {
+		d.calls = map[string]int{}
+	}
+
+	d.calls[guid]++
+
+	if n := d.fail[guid]; n < 0 || d.calls[guid] <= n {
+		return errUnreachable
+	}
+
+	return nil
+}
+
+func (d *fakeDevices) Get(_ context.Context, _, skip int, _ string) ([]dto.Device, error) {
+	if skip > 0 {
+		return []dto.Device{}, nil
+	}
+
+	return []dto.Device{{GUID: "guid1"}, {GUID: "guid2"}, {GUID: "guid3"}}, nil
+}
+
+func (d *fakeDevices) GetByID(_ context.Context, guid, _ string, _ bool) (*dto.Device, error) {
+	return &dto.Device{GUID: guid, Password: "P@ssw0rd"}, d.call(guid)
+}
+
+func (d *fakeDevices) Update(_ context.Context, device *dto.Device) (*dto.Device, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.updated = append(d.updated, device.GUID)
+
+	return device, nil
+}
+
+func (d *fakeDevices) GetHardwareInfo(_ context.Context, guid string, _ ...string) (dto.HardwareInfo, error) {
+	return dto.HardwareInfo{CIMChassis: dto.CIMResponse{Response: guid}}, d.call(guid)
+}
+
+func (d *fakeDevices) SendPowerAction(_ context.Context, guid string, _ int) (power.PowerActionResponse, error) {
+	return power.PowerActionResponse{}, d.call(guid)
+}
+
+func (d *fakeDevices) LockDevice(_ context.Context, guid string, _ dto.DeviceLockRequest) (dto.DeviceLock, error) {
+	return dto.DeviceLock{GUID: guid}, nil
+}
+
+func (d *fakeDevices) UnlockDevice(_ context.Context, _ string, _ bool) error {
+	return nil
+}
+
+func (d *fakeDevices) SetTLSSettings(_ context.Context, guid string, req dto.TLSSettingsRequest) (dto.SettingDataResponse, error) {
+	return dto.SettingDataResponse{MutualAuthentication: req.MutualAuthentication, TrustedCN: req.TrustedCNs}, d.call(guid)
+}
+
+func (d *fakeDevices) CleanupCertificates(_ context.Context, guid string, dryRun bool) (dto.CertificateCleanup, error) {
+	return dto.CertificateCleanup{DryRun: dryRun, Certificates: []string{}, Keys: []string{}}, d.call(guid)
+}
+
+func (d *fakeDevices) FindDuplicates(_ context.Context, _ string) ([]dto.DuplicateGroup, error) {
+	return []dto.DuplicateGroup{{MatchedBy: "serialNumber", Value: "SN1", GUIDs: []string{"guid1", "guid2"}}}, nil
+}
+
+type fakeCorrelations struct{}
+
+func (fakeCorrelations) Import(_ context.Context, _ string, records []dto.CorrelationRecord, _ string) (dto.CorrelationImportResult, error) {
+	return dto.CorrelationImportResult{Matched: len(records), Unmatched: []string{}}, nil
+}
+
+// fakeProfiles has the profile office, requiring mutual TLS of the devices guid1 and guid2.
+type fakeProfiles struct{}
+
+func (fakeProfiles) TLSEnforcement(_ context.Context, profileName, _ string) (dto.TLSSettingsRequest, []dto.Device, error) {
+	if profileName != "office" {
+		return dto.TLSSettingsRequest{}, nil, errUnknownProfile
+	}
+
+	return dto.TLSSettingsRequest{MutualAuthentication: true, TrustedCNs: []string{"console.example.com"}},
+		[]dto.Device{{GUID: "guid1"}, {GUID: "guid2"}}, nil
+}
+
+type fakeTenantKeys struct{}
+
+func (fakeTenantKeys) Rotate(_ context.Context, tenantID string) (dto.TenantKey, error) {
+	return dto.TenantKey{KeyID: "key2", TenantID: tenantID, Active: true}, nil
+}
+
+// blockingHandler runs until its job is cancelled.
+type blockingHandler struct {
+	started chan struct{}
+}
+
+func (h blockingHandler) Validate(_ []byte) error { return nil }
+
+func (h blockingHandler) Run(ctx context.Context, _ *Run) error {
+	close(h.started)
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+var testConfig = config.Jobs{Workers: 4, TenantWorkers: 2, DeviceConcurrency: 8, QueueDepth: 1000, TenantQueueDepth: 100}
+
+func newTestUseCase(d *fakeDevices) (*UseCase, *memRepo, *testClock) {
+	return newTestUseCaseWith(d, testConfig)
+}
+
+func newTestUseCaseWith(d *fakeDevices, cfg config.Jobs) (*UseCase, *memRepo, *testClock) {
+	repo := &memRepo{}
+	clock := &testClock{now: time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)}
+
+	uc := New(repo, d, fakeCorrelations{}, fakeTenantKeys{}, fakeProfiles{}, cfg, logger.New("error"), eventbus.New())
+	uc.now = clock.Now
+
+	return uc, repo, clock
+}
+
+// runQueue runs the due jobs and waits until they are done.
+func runQueue(t *testing.T, uc *UseCase) {
+	t.Helper()
+
+	uc.runDue(context.Background())
+
+	require.Eventually(t, func() bool { return uc.runningCount() == 0 }, 5*time.Second, time.Millisecond)
+}
+
+func (uc *UseCase) runningCount() int {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	return len(uc.running)
+}
+
+func enqueue(t *testing.T, uc *UseCase, jobType string, payload any, attempts int) dto.Job {
+	t.Helper()
+
+	var raw json.RawMessage
+
+	if payload != nil {
+		var err error
+
+		raw, err = json.Marshal(payload)
+		require.NoError(t, err)
+	}
+
+	job, err := uc.Enqueue(context.Background(), "", dto.JobRequest{Type: jobType, Payload: raw, MaxAttempts: attempts})
+	require.NoError(t, err)
+	require.Equal(t, dto.JobStatePending, job.State)
+
+	return job
+}
+
+func TestJobs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		jobType   string
+		payload   any
+		fail      map[string]int
+		state     string
+		succeeded int
+		failed    int
+		result    string
+	}{
+		{
+			name:      "hardware refresh of every device",
+			jobType:   dto.JobTypeHardwareRefresh,
+			fail:      map[string]int{"guid2": -1},
+			state:     dto.JobStateCompleted,
+			succeeded: 2,
+			failed:    1,
+		},
+		{
+			name:      "power action on some devices",
+			jobType:   dto.JobTypePower,
+			payload:   dto.BulkPowerRequest{GUIDs: []string{"GUID1", "guid3", "guid1"}, Action: 8},
+			state:     dto.JobStateCompleted,
+			succeeded: 2,
+		},
+		{
+			name:    "power action failing on every device",
+			jobType: dto.JobTypePower,
+			payload: dto.BulkPowerRequest{GUIDs: []string{"guid1"}, Action: 8},
+			fail:    map[string]int{"guid1": -1},
+			state:   dto.JobStateFailed,
+			failed:  1,
+		},
+		{
+			name:    "correlation import",
+			jobType: dto.JobTypeCorrelationImport,
+			payload: dto.CorrelationImport{Source: "intune", Records: []dto.CorrelationRecord{{DeviceName: "DESKTOP-1"}}},
+			state:   dto.JobStateCompleted,
+			result:  `{"matched":1,"unmatched":[]}`,
+		},
+		{
+			name:      "key rotation",
+			jobType:   dto.JobTypeKeyRotation,
+			state:     dto.JobStateCompleted,
+			succeeded: 3,
+			result:    `{"keyId":"key2","tenantId":"","active":true,"createdAt":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			name:      "TLS enforcement on the devices of a profile",
+			jobType:   dto.JobTypeTLSEnforcement,
+			payload:   dto.TLSEnforcementRequest{Profile: "office"},
+			fail:      map[string]int{"guid2": -1},
+			state:     dto.JobStateCompleted,
+			succeeded: 1,
+			failed:    1,
+		},
+		{
+			name:      "certificate cleanup of every device",
+			jobType:   dto.JobTypeCertificateCleanup,
+			fail:      map[string]int{"guid3": -1},
+			state:     dto.JobStateCompleted,
+			succeeded: 2,
+			failed:    1,
+		},
+		{
+			name:    "duplicate detection",
+			jobType: dto.JobTypeDuplicateDetection,
+			state:   dto.JobStateCompleted,
+			result:  `{"groups":[{"matchedBy":"serialNumber","value":"SN1","guids":["guid1","guid2"]}]}`,
+		},
+		{
+			name:    "TLS enforcement of an unknown profile",
+			jobType: dto.JobTypeTLSEnforcement,
+			payload: dto.TLSEnforcementRequest{Profile: "lab"},
+			state:   dto.JobStateFailed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, _, _ := newTestUseCase(&fakeDevices{fail: tc.fail})
+
+			job := enqueue(t, uc, tc.jobType, tc.payload, 1)
+
+			runQueue(t, uc)
+
+			job, err := uc.GetByID(context.Background(), job.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.state, job.State)
+			assert.Equal(t, 1, job.Attempts)
+			assert.Equal(t, tc.succeeded, job.Succeeded)
+			assert.Equal(t, tc.failed, job.Failed)
+			assert.Equal(t, tc.succeeded+tc.failed, len(job.Devices))
+			assert.NotNil(t, job.FinishedAt)
+
+			if tc.result != "" {
+				assert.JSONEq(t, tc.result, string(job.Result))
+			}
+		})
+	}
+}
+
+func TestEnqueueValidation(t *testing.T) {
+	t.Parallel()
+
+	uc, _, _ := newTestUseCase(&fakeDevices{})
+
+	tests := map[string]dto.JobRequest{
+		"unknown type":        {Type: "firmware-update"},
+		"malformed payload":   {Type: dto.JobTypeHardwareRefresh, Payload: json.RawMessage(`{"guids":1}`)},
+		"no power action":     {Type: dto.JobTypePower, Payload: json.RawMessage(`{"guids":["guid1"]}`)},
+		"unknown import type": {Type: dto.JobTypeCorrelationImport, Payload: json.RawMessage(`{"source":"sccm"}`)},
+		"no profile":          {Type: dto.JobTypeTLSEnforcement, Payload: json.RawMessage(`{"guids":["guid1"]}`)},
+	}
+
+	for name, req := range tests {
+		_, err := uc.Enqueue(context.Background(), "", req)
+		require.ErrorAs(t, err, &dto.NotValidError{}, name)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		jobType string
+		payload any
+		actions []dto.PlannedAction
+	}{
+		{
+			name:    "power action on some devices",
+			jobType: dto.JobTypePower,
+			payload: dto.BulkPowerRequest{GUIDs: []string{"GUID1", "guid3", "guid1"}, Action: 8},
+			actions: []dto.PlannedAction{
+				{Target: "guid1", Action: "power action 8 (Power down)"},
+				{Target: "guid3", Action: "power action 8 (Power down)"},
+			},
+		},
+		{
+			name:    "hardware refresh of every device",
+			jobType: dto.JobTypeHardwareRefresh,
+			actions: []dto.PlannedAction{
+				{Target: "guid1", Action: "read the hardware information"},
+				{Target: "guid2", Action: "read the hardware information"},
+				{Target: "guid3", Action: "read the hardware information"},
+			},
+		},
+		{
+			name:    "correlation import",
+			jobType: dto.JobTypeCorrelationImport,
+			payload: dto.CorrelationImport{Source: "intune", Records: []dto.CorrelationRecord{{DeviceName: "DESKTOP-1"}}},
+			actions: []dto.PlannedAction{{Target: "intune", Action: "import 1 device records"}},
+		},
+		{
+			name:    "key rotation",
+			jobType: dto.JobTypeKeyRotation,
+			actions: []dto.PlannedAction{
+				{Target: `tenant ""`, Action: "create a new key"},
+				{Target: "guid1", Action: "encrypt the secrets with the new key"},
+				{Target: "guid2", Action: "encrypt the secrets with the new key"},
+				{Target: "guid3", Action: "encrypt the secrets with the new key"},
+			},
+		},
+		{
+			name:    "TLS enforcement on some devices",
+			jobType: dto.JobTypeTLSEnforcement,
+			payload: dto.TLSEnforcementRequest{Profile: "office", GUIDs: []string{"GUID3"}},
+			actions: []dto.PlannedAction{{Target: "guid3", Action: "apply the TLS settings of profile office"}},
+		},
+		{
+			name:    "certificate cleanup of some devices",
+			jobType: dto.JobTypeCertificateCleanup,
+			payload: dto.CertificateCleanupRequest{GUIDs: []string{"guid2"}},
+			actions: []dto.PlannedAction{{Target: "guid2", Action: "delete the orphaned certificates and key pairs"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			devices := &fakeDevices{}
+			uc, _, _ := newTestUseCase(devices)
+
+			var payload json.RawMessage
+
+			if tc.payload != nil {
+				var err error
+
+				payload, err = json.Marshal(tc.payload)
+				require.NoError(t, err)
+			}
+
+			result, err := uc.Plan(context.Background(), "", dto.JobRequest{Type: tc.jobType, Payload: payload})
+			require.NoError(t, err)
+			require.Equal(t, dto.DryRunResult{Operation: tc.jobType, Actions: tc.actions}, result)
+
+			// nothing is queued or run
+			jobs, err := uc.Get(context.Background(), 10, 0, "", "")
+			require.NoError(t, err)
+			require.Empty(t, jobs)
+			require.Empty(t, devices.calls)
+		})
+	}
+
+	uc, _, _ := newTestUseCase(&fakeDevices{})
+
+	_, err := uc.Plan(context.Background(), "", dto.JobRequest{Type: dto.JobTypePower, Payload: json.RawMessage(`{"guids":["guid1"]}`)})
+	require.ErrorAs(t, err, &dto.NotValidError{}, "the job is validated like it is queued")
+}
+
+func TestRetryRunsTheFailedDevices(t *testing.T) {
+	t.Parallel()
+
+	d := &fakeDevices{fail: map[string]int{"guid2": 1}}
+	uc, _, clock := newTestUseCase(d)
+
+	job := enqueue(t, uc, dto.JobTypeHardwareRefresh, nil, 2)
+
+	runQueue(t, uc)
+
+	job, err := uc.GetByID(context.Background(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dto.JobStatePending, job.State)
+	assert.Equal(t, ErrDevicesFailed.Error(), job.Error)
+	assert.Equal(t, 1, job.Failed)
+
+	// not due before the retry delay
+	runQueue(t, uc)
+
+	job, err = uc.GetByID(context.Background(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, job.Attempts)
+
+	clock.Add(retryDelay)
+	runQueue(t, uc)
+
+	job, err = uc.GetByID(context.Background(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dto.JobStateCompleted, job.State)
+	assert.Equal(t, 2, job.Attempts)
+	assert.Equal(t, 3, job.Succeeded)
+	assert.Empty(t, job.Error)
+	assert.Equal(t, map[string]int{"guid1": 1, "guid2": 2, "guid3": 1}, d.calls)
+
+	info, err := uc.GetHardwareInfo(context.Background(), job.ID, "GUID2")
+	require.NoError(t, err)
+	assert.Equal(t, "guid2", info.CIMChassis.Response)
+
+	_, err = uc.GetHardwareInfo(context.Background(), job.ID, "guid4")
+	require.ErrorAs(t, err, &sqldb.NotFoundError{})
+}
+
+func TestReassignDevices(t *testing.T) {
+	t.Parallel()
+
+	d := &fakeDevices{fail: map[string]int{"guid2": 1}}
+	uc, repo, _ := newTestUseCase(d)
+
+	// an earlier attempt left guid2 failed
+	refresh := enqueue(t, uc, dto.JobTypeHardwareRefresh, nil, 2)
+	runQueue(t, uc)
+
+	power := enqueue(t, uc, dto.JobTypePower, dto.BulkPowerRequest{GUIDs: []string{"guid2", "guid1"}, Action: 8}, 1)
+	other := enqueue(t, uc, dto.JobTypePower, dto.BulkPowerRequest{GUIDs: []string{"guid3"}, Action: 8}, 1)
+
+	changed, err := uc.ReassignDevices(context.Background(), "", []string{"GUID2"}, "guid1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, changed)
+
+	refresh, err = uc.GetByID(context.Background(), refresh.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dto.JobStatePending, refresh.State)
+	assert.Equal(t, 2, refresh.Succeeded)
+	assert.Equal(t, 0, refresh.Failed)
+	assert.Len(t, refresh.Devices, 2)
+
+	stored, err := repo.GetByID(context.Background(), power.ID)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"guids":["guid1"],"action":8}`, stored.Payload)
+
+	stored, err = repo.GetByID(context.Background(), other.ID)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"guids":["guid3"],"action":8}`, stored.Payload)
+}
+
+func TestRemoveDevice(t *testing.T) {
+	t.Parallel()
+
+	d := &fakeDevices{fail: map[string]int{"guid2": 1}}
+	uc, repo, _ := newTestUseCase(d)
+
+	// an earlier attempt left guid2 failed
+	refresh := enqueue(t, uc, dto.JobTypeHardwareRefresh, nil, 2)
+	runQueue(t, uc)
+
+	power := enqueue(t, uc, dto.JobTypePower, dto.BulkPowerRequest{GUIDs: []string{"guid2", "guid1"}, Action: 8}, 1)
+	alone := enqueue(t, uc, dto.JobTypePower, dto.BulkPowerRequest{GUIDs: []string{"GUID2"}, Action: 8}, 1)
+	other := enqueue(t, uc, dto.JobTypePower, dto.BulkPowerRequest{GUIDs: []string{"guid3"}, Action: 8}, 1)
+
+	changed, err := uc.RemoveDevice(context.Background(), "", "GUID2")
+	require.NoError(t, err)
+	assert.Equal(t, 3, changed)
+
+	refresh, err = uc.GetByID(context.Background(), refresh.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dto.JobStatePending, refresh.State)
+	assert.Equal(t, 2, refresh.Succeeded)
+	assert.Equal(t, 0, refresh.Failed)
+	assert.Len(t, refresh.Devices, 2)
+
+	stored, err := repo.GetByID(context.Background(), power.ID)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"guids":["guid1"],"action":8}`, stored.Payload)
+
+	// the job of the deleted device alone does not fall back to every device
+	stored, err = repo.GetByID(context.Background(), alone.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dto.JobStateCancelled, stored.State)
+
+	stored, err = repo.GetByID(context.Background(), other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dto.JobStatePending, stored.State)
+	assert.JSONEq(t, `{"guids":["guid3"],"action":8}`, stored.Payload)
+}
+
+func TestResumeInterruptedJobs(t *testing.T) {
+	t.Parallel()
+
+	d := &fakeDevices{}
+	uc, repo, clock := newTestUseCase(d)
+
+	// left running by a console that stopped abruptly while working on guid2
+	require.NoError(t, repo.Insert(context.Background(), entity.Job{
+		ID:          "job1",
+		Type:        dto.JobTypeHardwareRefresh,
+		State:       dto.JobStateRunning,
+		Attempts:    1,
+		MaxAttempts: 1,
+		Total:       3,
+		Succeeded:   1,
+		Items:       `[{"guid":"guid1","state":"completed"},{"guid":"guid2","state":"running"},{"guid":"guid3","state":"pending"}]`,
+		CreatedAt:   clock.Now().Add(-time.Hour),
+		StartedAt:   clock.Now().Add(-time.Hour),
+	}))
+
+	uc.resume(context.Background())
+
+	job, err := uc.GetByID(context.Background(), "job1")
+	require.NoError(t, err)
+	assert.Equal(t, dto.JobStatePending, job.State)
+	assert.Equal(t, interrupted, job.Error)
+	assert.Equal(t, dto.JobStatePending, job.Devices[1].State)
+	assert.Equal(t, 1, job.Succeeded)
+
+	runQueue(t, uc)
+
+	job, err = uc.GetByID(context.Background(), "job1")
+	require.NoError(t, err)
+	assert.Equal(t, dto.JobStateCompleted, job.State)
+	assert.Equal(t, 3, job.Succeeded)
+	assert.Empty(t, job.Error)
+	assert.Equal(t, map[string]int{"guid2": 1, "guid3": 1}, d.calls, "the completed device is not run again")
+}
+
+func TestCancel(t *testing.T) {
+	t.Parallel()
+
+	uc, _, _ := newTestUseCase(&fakeDevices{})
+
+	handler := blockingHandler{started: make(chan struct{})}
+	uc.Register("blocking", handler)
+
+	pending := enqueue(t, uc, dto.JobTypeHardwareRefresh, nil, 1)
+
+	cancelled, err := uc.Cancel(context.Background(), pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dto.JobStateCancelled, cancelled.State)
+
+	running := enqueue(t, uc, "blocking", nil, 3)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		runQueue(t, uc)
+	}()
+
+	<-handler.started
+
+	_, err = uc.Cancel(context.Background(), running.ID)
+	require.NoError(t, err)
+
+	<-done
+
+	job, err := uc.GetByID(context.Background(), running.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dto.JobStateCancelled, job.State)
+	assert.Equal(t, 1, job.Attempts)
+
+	_, err = uc.Cancel(context.Background(), "unknown")
+	require.ErrorAs(t, err, &sqldb.NotFoundError{})
+}
+
+func TestWatch(t *testing.T) {
+	t.Parallel()
+
+	uc, _, _ := newTestUseCase(&fakeDevices{})
+
+	job := enqueue(t, uc, dto.JobTypePower, dto.BulkPowerRequest{Action: 8}, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates, err := uc.Watch(ctx, job.ID)
+	require.NoError(t, err)
+
+	first := <-updates
+	assert.Equal(t, dto.JobStatePending, first.State)
+
+	runQueue(t, uc)
+
+	var last dto.Job
+
+	for update := range updates {
+		last = update
+	}
+
+	assert.Equal(t, dto.JobStateCompleted, last.State)
+	assert.Equal(t, 3, last.Succeeded)
+
+	_, err = uc.Watch(ctx, "unknown")
+	require.ErrorAs(t, err, &sqldb.NotFoundError{})
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	uc, _, clock := newTestUseCase(&fakeDevices{})
+
+	first := enqueue(t, uc, dto.JobTypeHardwareRefresh, nil, 1)
+
+	clock.Add(time.Second)
+
+	second := enqueue(t, uc, dto.JobTypePower, dto.BulkPowerRequest{Action: 8}, 1)
+
+	runQueue(t, uc)
+
+	items, err := uc.Get(context.Background(), 10, 0, "", "")
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, second.ID, items[0].ID)
+	assert.Equal(t, first.ID, items[1].ID)
+	assert.Nil(t, items[0].Devices)
+
+	items, err = uc.Get(context.Background(), 10, 0, "", dto.JobStatePending)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, retryDelay, backoff(1))
+	assert.Equal(t, 2*retryDelay, backoff(2))
+	assert.Equal(t, maxRetryDelay, backoff(9))
+}
+
+func TestNewLeavesAWorkerToTheOtherTenants(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		cfg           config.Jobs
+		workers       int
+		tenantWorkers int
+	}{
+		{name: "configured", cfg: testConfig, workers: 4, tenantWorkers: 2},
+		{name: "tenant share too large", cfg: config.Jobs{Workers: 4, TenantWorkers: 4}, workers: 4, tenantWorkers: 3},
+		{name: "a single worker", cfg: config.Jobs{Workers: 1, TenantWorkers: 2}, workers: 1, tenantWorkers: 1},
+		{name: "not configured", cfg: config.Jobs{}, workers: 1, tenantWorkers: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, _, _ := newTestUseCaseWith(&fakeDevices{}, tc.cfg)
+
+			assert.Equal(t, tc.workers, uc.workers)
+			assert.Equal(t, tc.tenantWorkers, uc.tenantWorkers)
+			assert.Equal(t, max(tc.cfg.DeviceConcurrency, 1), uc.deviceConcurrency)
+		})
+	}
+}
+
+func TestShare(t *testing.T) {
+	t.Parallel()
+
+	job := func(id, tenantID string) entity.Job { return entity.Job{ID: id, TenantID: tenantID} }
+
+	a1, a2, a3, b1, b2 := job("a1", "a"), job("a2", "a"), job("a3", "a"), job("b1", "b"), job("b2", "b")
+
+	tests := []struct {
+		name      string
+		due       []entity.Job
+		perTenant map[string]int
+		free      int
+		expected  []entity.Job
+	}{
+		{
+			name:     "round the tenants",
+			due:      []entity.Job{a1, a2, a3, b1},
+			free:     2,
+			expected: []entity.Job{a1, b1},
+		},
+		{
+			name:     "no more than the share of a tenant",
+			due:      []entity.Job{a1, a2, a3, b1},
+			free:     4,
+			expected: []entity.Job{a1, b1, a2},
+		},
+		{
+			name:      "the tenant running the fewest jobs first",
+			due:       []entity.Job{a1, b1},
+			perTenant: map[string]int{"a": 1},
+			free:      1,
+			expected:  []entity.Job{b1},
+		},
+		{
+			name:      "a busy tenant waits",
+			due:       []entity.Job{a1, b1, b2},
+			perTenant: map[string]int{"a": 2},
+			free:      3,
+			expected:  []entity.Job{b1, b2},
+		},
+		{
+			name:     "nothing due",
+			free:     4,
+			expected: []entity.Job{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, _, _ := newTestUseCase(&fakeDevices{})
+
+			perTenant := map[string]int{}
+			for tenantID, count := range tc.perTenant {
+				perTenant[tenantID] = count
+			}
+
+			assert.Equal(t, tc.expected, uc.share(tc.due, perTenant, tc.free))
+		})
+	}
+}
+
+// parkedHandler runs until its job is cancelled.
+type parkedHandler struct{}
+
+func (parkedHandler) Validate(_ []byte) error { return nil }
+
+func (parkedHandler) Run(ctx context.Context, _ *Run) error {
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+func TestBulkJobsDoNotStarveOtherTenants(t *testing.T) {
+	t.Parallel()
+
+	uc, _, clock := newTestUseCase(&fakeDevices{})
+	uc.Register("parked", parkedHandler{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	for range 5 {
+		_, err := uc.Enqueue(ctx, "tenant1", dto.JobRequest{Type: "parked"})
+		require.NoError(t, err)
+
+		clock.Add(time.Second)
+	}
+
+	uc.runDue(ctx)
+
+	_, err := uc.Enqueue(ctx, "tenant2", dto.JobRequest{Type: "parked"})
+	require.NoError(t, err)
+
+	uc.runDue(ctx)
+
+	uc.mu.Lock()
+
+	perTenant := map[string]int{}
+	for _, r := range uc.running {
+		perTenant[r.tenantID]++
+	}
+
+	uc.mu.Unlock()
+
+	assert.Equal(t, map[string]int{"tenant1": 2, "tenant2": 1}, perTenant)
+
+	cancel()
+
+	require.Eventually(t, func() bool { return uc.runningCount() == 0 }, 5*time.Second, time.Millisecond)
+}
+
+func TestQueueDepth(t *testing.T) {
+	t.Parallel()
+
+	uc, _, _ := newTestUseCaseWith(&fakeDevices{}, config.Jobs{Workers: 4, TenantWorkers: 2, QueueDepth: 3, TenantQueueDepth: 2})
+
+	req := dto.JobRequest{Type: dto.JobTypeHardwareRefresh}
+
+	for range 2 {
+		_, err := uc.Enqueue(context.Background(), "tenant1", req)
+		require.NoError(t, err)
+	}
+
+	_, err := uc.Enqueue(context.Background(), "tenant1", req)
+	require.ErrorIs(t, err, QueueFullError{Limit: 2, Tenant: true})
+
+	_, err = uc.Enqueue(context.Background(), "tenant2", req)
+	require.NoError(t, err)
+
+	_, err = uc.Enqueue(context.Background(), "tenant3", req)
+	require.ErrorIs(t, err, QueueFullError{Limit: 3})
+
+	runQueue(t, uc)
+
+	_, err = uc.Enqueue(context.Background(), "tenant1", req)
+	require.NoError(t, err)
+}
@@ -0,0 +1,287 @@
+package ldapsync
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/device-management-toolkit/console/pkg/ber"
+)
+
+// BER tags of the search filter choices, RFC 4511 4.5.1.
+const (
+	filterAnd            = 0xa0
+	filterOr             = 0xa1
+	filterNot            = 0xa2
+	filterEquality       = 0xa3
+	filterSubstrings     = 0xa4
+	filterGreaterOrEqual = 0xa5
+	filterLessOrEqual    = 0xa6
+	filterPresent        = 0x87
+	filterApprox         = 0xa8
+	filterExtensible     = 0xa9
+
+	substringInitial = 0x80
+	substringAny     = 0x81
+	substringFinal   = 0x82
+
+	matchingRule     = 0x81
+	matchingType     = 0x82
+	matchValue       = 0x83
+	matchDNAttribute = 0x84
+)
+
+var ErrInvalidFilter = errors.New("invalid ldap filter")
+
+// parseFilter encodes a search filter in the string representation of RFC 4515, such as
+// (&(objectClass=user)(!(userAccountControl:1.2.840.113556.1.4.803:=2))).
+func parseFilter(s string) ([]byte, error) {
+	p := &filterParser{s: strings.TrimSpace(s)}
+
+	filter, err := p.filter()
+	if err != nil || p.pos != len(p.s) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, s)
+	}
+
+	return filter, nil
+}
+
+type filterParser struct {
+	s   string
+	pos int
+}
+
+func (p *filterParser) peek() byte {
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+
+	return 0
+}
+
+func (p *filterParser) consume(c byte) bool {
+	if p.peek() != c {
+		return false
+	}
+
+	p.pos++
+
+	return true
+}
+
+func (p *filterParser) filter() ([]byte, error) {
+	if !p.consume('(') {
+		return nil, ErrInvalidFilter
+	}
+
+	var (
+		filter []byte
+		err    error
+	)
+
+	switch p.peek() {
+	case '&':
+		p.pos++
+		filter, err = p.list(filterAnd)
+	case '|':
+		p.pos++
+		filter, err = p.list(filterOr)
+	case '!':
+		p.pos++
+
+		var inner []byte
+		if inner, err = p.filter(); err == nil {
+			filter = ber.TLV(filterNot, inner)
+		}
+	default:
+		filter, err = p.item()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.consume(')') {
+		return nil, ErrInvalidFilter
+	}
+
+	return filter, nil
+}
+
+func (p *filterParser) list(tag byte) ([]byte, error) {
+	var filters [][]byte
+
+	for p.peek() == '(' {
+		filter, err := p.filter()
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, filter)
+	}
+
+	if len(filters) == 0 {
+		return nil, ErrInvalidFilter
+	}
+
+	return ber.TLV(tag, filters...), nil
+}
+
+// item parses a comparison, a literal parenthesis in its value must be escaped as \28 or \29.
+func (p *filterParser) item() ([]byte, error) {
+	end := strings.IndexByte(p.s[p.pos:], ')')
+	if end < 0 {
+		return nil, ErrInvalidFilter
+	}
+
+	item := p.s[p.pos : p.pos+end]
+	p.pos += end
+
+	eq := strings.IndexByte(item, '=')
+	if eq <= 0 || strings.ContainsAny(item, "(") {
+		return nil, ErrInvalidFilter
+	}
+
+	attr, value := item[:eq], item[eq+1:]
+
+	switch {
+	case strings.HasSuffix(attr, ":"):
+		return extensibleMatch(attr[:len(attr)-1], value)
+	case strings.HasSuffix(attr, "~"):
+		return assertion(filterApprox, attr[:len(attr)-1], value)
+	case strings.HasSuffix(attr, ">"):
+		return assertion(filterGreaterOrEqual, attr[:len(attr)-1], value)
+	case strings.HasSuffix(attr, "<"):
+		return assertion(filterLessOrEqual, attr[:len(attr)-1], value)
+	case value == "*":
+		return ber.TLV(filterPresent, []byte(attr)), nil
+	case strings.Contains(value, "*"):
+		return substrings(attr, value)
+	default:
+		return assertion(filterEquality, attr, value)
+	}
+}
+
+func assertion(tag byte, attr, value string) ([]byte, error) {
+	if attr == "" {
+		return nil, ErrInvalidFilter
+	}
+
+	v, err := unescapeValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return ber.TLV(tag, ber.TLV(tagOctetString, []byte(attr)), ber.TLV(tagOctetString, v)), nil
+}
+
+func substrings(attr, value string) ([]byte, error) {
+	parts := strings.Split(value, "*")
+
+	var subs [][]byte
+
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+
+		v, err := unescapeValue(part)
+		if err != nil {
+			return nil, err
+		}
+
+		tag := byte(substringAny)
+
+		switch i {
+		case 0:
+			tag = substringInitial
+		case len(parts) - 1:
+			tag = substringFinal
+		}
+
+		subs = append(subs, ber.TLV(tag, v))
+	}
+
+	if attr == "" || len(subs) == 0 {
+		return nil, ErrInvalidFilter
+	}
+
+	return ber.TLV(filterSubstrings, ber.TLV(tagOctetString, []byte(attr)), ber.TLV(tagSequence, subs...)), nil
+}
+
+// extensibleMatch encodes attr:dn:rule:=value, the attribute or the matching rule may be omitted.
+func extensibleMatch(spec, value string) ([]byte, error) {
+	fields := strings.Split(spec, ":")
+	attr := fields[0]
+
+	var (
+		rule         string
+		dnAttributes bool
+	)
+
+	for _, f := range fields[1:] {
+		switch {
+		case strings.EqualFold(f, "dn") && !dnAttributes && rule == "":
+			dnAttributes = true
+		case f != "" && rule == "":
+			rule = f
+		default:
+			return nil, ErrInvalidFilter
+		}
+	}
+
+	if attr == "" && rule == "" {
+		return nil, ErrInvalidFilter
+	}
+
+	v, err := unescapeValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts [][]byte
+
+	if rule != "" {
+		parts = append(parts, ber.TLV(matchingRule, []byte(rule)))
+	}
+
+	if attr != "" {
+		parts = append(parts, ber.TLV(matchingType, []byte(attr)))
+	}
+
+	parts = append(parts, ber.TLV(matchValue, v))
+
+	if dnAttributes {
+		parts = append(parts, ber.TLV(matchDNAttribute, []byte{0xff}))
+	}
+
+	return ber.TLV(filterExtensible, parts...), nil
+}
+
+// unescapeValue decodes the \XX hex escapes of an assertion value.
+func unescapeValue(value string) ([]byte, error) {
+	out := make([]byte, 0, len(value))
+
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' {
+			out = append(out, value[i])
+
+			continue
+		}
+
+		if i+2 >= len(value) {
+			return nil, ErrInvalidFilter
+		}
+
+		b, err := hex.DecodeString(value[i+1 : i+3])
+		if err != nil {
+			return nil, ErrInvalidFilter
+		}
+
+		out = append(out, b[0])
+		i += 2
+	}
+
+	return out, nil
+}
@@ -0,0 +1,29 @@
+package ldapsync
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		Get(ctx context.Context, top, skip int) ([]entity.User, error)
+		GetBySource(ctx context.Context, source string) ([]entity.User, error)
+		GetByUsername(ctx context.Context, username string) (*entity.User, error)
+		Save(ctx context.Context, u entity.User) error
+	}
+
+	// Searcher reads the user accounts of the directory.
+	Searcher interface {
+		Search(ctx context.Context) ([]Account, error)
+	}
+
+	Feature interface {
+		GetUsers(ctx context.Context, top, skip int) ([]dto.User, error)
+		GetUser(ctx context.Context, username string) (*dto.User, error)
+		Sync(ctx context.Context) (dto.UserSyncResult, error)
+		Start(ctx context.Context)
+	}
+)
@@ -0,0 +1,569 @@
+package ldapsync
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/ber"
+)
+
+const (
+	defaultPort    = "389"
+	defaultTLSPort = "636"
+	requestTimeout = 30 * time.Second
+	// pageSize is the number of entries asked for per page, below the 1000 entries Active Directory returns at most.
+	pageSize = 500
+	// maxMessageSize bounds the messages read from the server.
+	maxMessageSize = 16 << 20
+
+	// BER tags of the LDAP messages and types used in a search, RFC 4511.
+	tagBoolean               = 0x01
+	tagInteger               = 0x02
+	tagOctetString           = 0x04
+	tagEnumerated            = 0x0a
+	tagSequence              = 0x30
+	tagSet                   = 0x31
+	tagBindRequest           = 0x60
+	tagBindResponse          = 0x61
+	tagUnbindRequest         = 0x42
+	tagSearchRequest         = 0x63
+	tagSearchResultEntry     = 0x64
+	tagSearchResultDone      = 0x65
+	tagSearchResultReference = 0x73
+	tagControls              = 0xa0
+	tagSimpleAuth            = 0x80
+
+	scopeWholeSubtree = 2
+	derefNever        = 0
+	resultSuccess     = 0
+
+	oidPagedResults = "1.2.840.113556.1.4.319"
+
+	// uacAccountDisable is the userAccountControl flag of disabled Active Directory accounts.
+	uacAccountDisable = 0x2
+
+	attrDisplayName        = "displayName"
+	attrMail               = "mail"
+	attrMemberOf           = "memberOf"
+	attrUserAccountControl = "userAccountControl"
+)
+
+var (
+	ErrNoURL           = errors.New("ldap url is required")
+	ErrUnknownScheme   = errors.New("unknown ldap url scheme, expected ldap or ldaps")
+	ErrNoBaseDN        = errors.New("ldap base dn is required")
+	ErrLDAPResult      = errors.New("ldap request failed")
+	ErrMalformedPacket = errors.New("malformed ldap message")
+)
+
+// Account is a user account read from the directory. Groups are the DNs of the groups the
+// account is a direct member of.
+type Account struct {
+	DN          string
+	Username    string
+	DisplayName string
+	Email       string
+	Groups      []string
+	Disabled    bool
+}
+
+// Directory reads the user accounts of an LDAP directory such as Active Directory with a simple
+// bind and a paged subtree search.
+type Directory struct {
+	address           string
+	tlsConfig         *tls.Config
+	bindDN            string
+	bindPassword      string
+	baseDN            string
+	filter            []byte
+	usernameAttribute string
+}
+
+// NewDirectory creates the directory of the configured server. The user filter is parsed here
+// so that a typo disables the sync at startup rather than failing every run.
+func NewDirectory(cfg config.LDAPSync) (*Directory, error) {
+	if cfg.URL == "" {
+		return nil, ErrNoURL
+	}
+
+	u, err := url.Parse(cfg.URL)
+	if err != nil || u.Hostname() == "" {
+		return nil, ErrNoURL
+	}
+
+	d := &Directory{
+		bindDN:            cfg.BindDN,
+		bindPassword:      cfg.BindPassword,
+		baseDN:            cfg.BaseDN,
+		usernameAttribute: cfg.UsernameAttribute,
+	}
+
+	port := u.Port()
+
+	switch strings.ToLower(u.Scheme) {
+	case "ldap":
+		if port == "" {
+			port = defaultPort
+		}
+	case "ldaps":
+		if port == "" {
+			port = defaultTLSPort
+		}
+
+		d.tlsConfig = &tls.Config{
+			ServerName:         u.Hostname(),
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // opt-in for directories with self-signed certificates
+		}
+	default:
+		return nil, ErrUnknownScheme
+	}
+
+	d.address = net.JoinHostPort(u.Hostname(), port)
+
+	if cfg.BaseDN == "" {
+		return nil, ErrNoBaseDN
+	}
+
+	if d.usernameAttribute == "" {
+		d.usernameAttribute = "sAMAccountName"
+	}
+
+	filter := cfg.UserFilter
+	if filter == "" {
+		filter = "(objectClass=*)"
+	}
+
+	if d.filter, err = parseFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Search returns the accounts matching the user filter below the base DN. Entries without the
+// username attribute, such as computer accounts a loose filter lets through, are skipped.
+func (d *Directory) Search(ctx context.Context) ([]Account, error) {
+	conn, err := d.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c := &ldapConn{conn: conn, reader: bufio.NewReader(conn)}
+
+	if d.bindDN != "" {
+		if err := c.bind(d.bindDN, d.bindPassword); err != nil {
+			return nil, err
+		}
+	}
+
+	attributes := []string{d.usernameAttribute, attrDisplayName, attrMail, attrMemberOf, attrUserAccountControl}
+
+	var (
+		accounts []Account
+		cookie   []byte
+	)
+
+	for {
+		entries, next, err := c.search(d.baseDN, d.filter, attributes, cookie)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			return nil, err
+		}
+
+		for _, e := range entries {
+			if account, ok := d.account(e); ok {
+				accounts = append(accounts, account)
+			}
+		}
+
+		if len(next) == 0 {
+			break
+		}
+
+		cookie = next
+	}
+
+	c.unbind()
+
+	return accounts, nil
+}
+
+func (d *Directory) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: requestTimeout}
+
+	if d.tlsConfig != nil {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: d.tlsConfig}
+
+		return tlsDialer.DialContext(ctx, "tcp", d.address)
+	}
+
+	return dialer.DialContext(ctx, "tcp", d.address)
+}
+
+func (d *Directory) account(e entry) (Account, bool) {
+	username := e.first(d.usernameAttribute)
+	if username == "" {
+		return Account{}, false
+	}
+
+	uac, _ := strconv.ParseInt(e.first(attrUserAccountControl), 10, 64)
+
+	return Account{
+		DN:          e.dn,
+		Username:    username,
+		DisplayName: e.first(attrDisplayName),
+		Email:       e.first(attrMail),
+		Groups:      e.values(attrMemberOf),
+		Disabled:    uac&uacAccountDisable != 0,
+	}, true
+}
+
+// entry is a search result entry, attribute names are compared case-insensitively.
+type entry struct {
+	dn         string
+	attributes map[string][]string
+}
+
+func (e entry) values(name string) []string {
+	return e.attributes[strings.ToLower(name)]
+}
+
+func (e entry) first(name string) string {
+	if v := e.values(name); len(v) > 0 {
+		return v[0]
+	}
+
+	return ""
+}
+
+// ldapConn is a connection the requests are sent on one after the other.
+type ldapConn struct {
+	conn      net.Conn
+	reader    *bufio.Reader
+	messageID int64
+}
+
+func (c *ldapConn) send(op []byte, controls ...[]byte) error {
+	c.messageID++
+
+	message := ber.Concat(ber.Integer(tagInteger, c.messageID), op)
+	if len(controls) > 0 {
+		message = ber.Concat(message, ber.TLV(tagControls, controls...))
+	}
+
+	if err := c.conn.SetDeadline(time.Now().Add(requestTimeout)); err != nil {
+		return err
+	}
+
+	_, err := c.conn.Write(ber.TLV(tagSequence, message))
+
+	return err
+}
+
+// receive reads the next message of the current request and returns its operation and controls.
+func (c *ldapConn) receive() (op element, controls []element, err error) {
+	message, err := readElement(c.reader)
+	if err != nil {
+		return element{}, nil, err
+	}
+
+	parts, err := parseElements(message.content)
+	if err != nil || len(parts) < 2 || parts[0].tag != tagInteger {
+		return element{}, nil, ErrMalformedPacket
+	}
+
+	if parseInteger(parts[0].content) != c.messageID {
+		return element{}, nil, fmt.Errorf("%w: unexpected message id", ErrMalformedPacket)
+	}
+
+	if len(parts) > 2 && parts[2].tag == tagControls {
+		if controls, err = parseElements(parts[2].content); err != nil {
+			return element{}, nil, err
+		}
+	}
+
+	return parts[1], controls, nil
+}
+
+func (c *ldapConn) bind(dn, password string) error {
+	request := ber.TLV(tagBindRequest,
+		ber.Integer(tagInteger, 3),
+		ber.TLV(tagOctetString, []byte(dn)),
+		ber.TLV(tagSimpleAuth, []byte(password)),
+	)
+
+	if err := c.send(request); err != nil {
+		return err
+	}
+
+	op, _, err := c.receive()
+	if err != nil {
+		return err
+	}
+
+	if op.tag != tagBindResponse {
+		return fmt.Errorf("%w: unexpected bind response", ErrMalformedPacket)
+	}
+
+	return checkResult("bind", op.content)
+}
+
+// search runs one page of a search and returns the cookie of the next page, empty after the last one.
+func (c *ldapConn) search(baseDN string, filter []byte, attributes []string, cookie []byte) ([]entry, []byte, error) {
+	attrs := make([][]byte, len(attributes))
+	for i, a := range attributes {
+		attrs[i] = ber.TLV(tagOctetString, []byte(a))
+	}
+
+	request := ber.TLV(tagSearchRequest,
+		ber.TLV(tagOctetString, []byte(baseDN)),
+		ber.Integer(tagEnumerated, scopeWholeSubtree),
+		ber.Integer(tagEnumerated, derefNever),
+		ber.Integer(tagInteger, 0),
+		ber.Integer(tagInteger, 0),
+		ber.TLV(tagBoolean, []byte{0}),
+		filter,
+		ber.TLV(tagSequence, attrs...),
+	)
+
+	paging := ber.TLV(tagSequence,
+		ber.TLV(tagOctetString, []byte(oidPagedResults)),
+		ber.TLV(tagOctetString, ber.TLV(tagSequence, ber.Integer(tagInteger, pageSize), ber.TLV(tagOctetString, cookie))),
+	)
+
+	if err := c.send(request, paging); err != nil {
+		return nil, nil, err
+	}
+
+	var entries []entry
+
+	for {
+		op, controls, err := c.receive()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch op.tag {
+		case tagSearchResultEntry:
+			e, err := parseEntry(op.content)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			entries = append(entries, e)
+		case tagSearchResultReference:
+			// referrals to other servers are not followed
+		case tagSearchResultDone:
+			if err := checkResult("search", op.content); err != nil {
+				return nil, nil, err
+			}
+
+			return entries, pagingCookie(controls), nil
+		default:
+			return nil, nil, fmt.Errorf("%w: unexpected search response", ErrMalformedPacket)
+		}
+	}
+}
+
+func (c *ldapConn) unbind() {
+	_ = c.send(ber.TLV(tagUnbindRequest))
+}
+
+// checkResult returns an error unless an LDAPResult reports success.
+func checkResult(operation string, content []byte) error {
+	parts, err := parseElements(content)
+	if err != nil || len(parts) < 3 || parts[0].tag != tagEnumerated {
+		return ErrMalformedPacket
+	}
+
+	code := parseInteger(parts[0].content)
+	if code == resultSuccess {
+		return nil
+	}
+
+	message := string(parts[2].content)
+	if message == "" {
+		return fmt.Errorf("%w: %s: result code %d", ErrLDAPResult, operation, code)
+	}
+
+	return fmt.Errorf("%w: %s: result code %d: %s", ErrLDAPResult, operation, code, message)
+}
+
+func parseEntry(content []byte) (entry, error) {
+	parts, err := parseElements(content)
+	if err != nil || len(parts) < 2 {
+		return entry{}, ErrMalformedPacket
+	}
+
+	e := entry{dn: string(parts[0].content), attributes: map[string][]string{}}
+
+	attributes, err := parseElements(parts[1].content)
+	if err != nil {
+		return entry{}, err
+	}
+
+	for _, a := range attributes {
+		fields, err := parseElements(a.content)
+		if err != nil || len(fields) < 2 {
+			return entry{}, ErrMalformedPacket
+		}
+
+		values, err := parseElements(fields[1].content)
+		if err != nil {
+			return entry{}, err
+		}
+
+		name := strings.ToLower(string(fields[0].content))
+		for _, v := range values {
+			e.attributes[name] = append(e.attributes[name], string(v.content))
+		}
+	}
+
+	return e, nil
+}
+
+// pagingCookie returns the cookie of the paged results control of a search result, RFC 2696.
+func pagingCookie(controls []element) []byte {
+	for _, control := range controls {
+		fields, err := parseElements(control.content)
+		if err != nil || len(fields) < 2 || string(fields[0].content) != oidPagedResults {
+			continue
+		}
+
+		value := fields[len(fields)-1]
+		if value.tag != tagOctetString {
+			return nil
+		}
+
+		outer, err := parseElements(value.content)
+		if err != nil || len(outer) != 1 {
+			return nil
+		}
+
+		inner, err := parseElements(outer[0].content)
+		if err != nil || len(inner) < 2 {
+			return nil
+		}
+
+		return inner[1].content
+	}
+
+	return nil
+}
+
+type element struct {
+	tag     byte
+	content []byte
+}
+
+func readElement(r *bufio.Reader) (element, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return element{}, err
+	}
+
+	first, err := r.ReadByte()
+	if err != nil {
+		return element{}, err
+	}
+
+	length := int(first)
+
+	if first&0x80 != 0 {
+		n := int(first & 0x7f)
+		if n == 0 || n > 4 {
+			return element{}, ErrMalformedPacket
+		}
+
+		length = 0
+
+		for range n {
+			b, err := r.ReadByte()
+			if err != nil {
+				return element{}, err
+			}
+
+			length = length<<8 | int(b)
+		}
+	}
+
+	if length > maxMessageSize {
+		return element{}, fmt.Errorf("%w: message too large", ErrMalformedPacket)
+	}
+
+	content := make([]byte, length)
+	if _, err := io.ReadFull(r, content); err != nil {
+		return element{}, err
+	}
+
+	return element{tag: tag, content: content}, nil
+}
+
+// parseElements splits the contents of a constructed element into its elements.
+func parseElements(content []byte) ([]element, error) {
+	var elements []element
+
+	for len(content) > 0 {
+		if len(content) < 2 {
+			return nil, ErrMalformedPacket
+		}
+
+		tag, length, offset := content[0], int(content[1]), 2
+
+		if content[1]&0x80 != 0 {
+			n := int(content[1] & 0x7f)
+			if n == 0 || n > 4 || len(content) < 2+n {
+				return nil, ErrMalformedPacket
+			}
+
+			length = 0
+			for _, b := range content[2 : 2+n] {
+				length = length<<8 | int(b)
+			}
+
+			offset += n
+		}
+
+		if length > len(content)-offset {
+			return nil, ErrMalformedPacket
+		}
+
+		elements = append(elements, element{tag: tag, content: content[offset : offset+length]})
+		content = content[offset+length:]
+	}
+
+	return elements, nil
+}
+
+func parseInteger(content []byte) int64 {
+	var value int64
+
+	for i, b := range content {
+		if i == 0 && b&0x80 != 0 {
+			value = -1
+		}
+
+		value = value<<8 | int64(b)
+	}
+
+	return value
+}
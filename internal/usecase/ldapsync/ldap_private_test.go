@@ -0,0 +1,248 @@
+package ldapsync
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/ber"
+)
+
+func TestParseFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter   string
+		expected string
+	}{
+		{filter: "(cn=a)", expected: "a3070402636e040161"},
+		{filter: "(cn=*)", expected: "8702636e"},
+		{filter: "(!(cn=a))", expected: "a209a3070402636e040161"},
+		{filter: "(cn=a*b)", expected: "a40c0402636e3006800161820162"},
+		{filter: "(cn=*a*)", expected: "a4090402636e3003810161"},
+		{filter: `(cn=\2a)`, expected: "a3070402636e04012a"},
+		{filter: "(cn>=a)", expected: "a5070402636e040161"},
+		{filter: "(x:1.2:=2)", expected: "a90b8103312e32820178830132"},
+		{filter: "(x:dn:=2)", expected: "a9098201788301328401ff"},
+		{filter: "(&(a=1)(b=2))", expected: "a010a306040161040131a306040162040132"},
+		{filter: " (|(a=1)) ", expected: "a108a306040161040131"},
+	}
+
+	for _, tc := range tests {
+		filter, err := parseFilter(tc.filter)
+		require.NoError(t, err, tc.filter)
+		require.Equal(t, tc.expected, hex.EncodeToString(filter), tc.filter)
+	}
+
+	for _, invalid := range []string{"", "cn=a", "(cn=a", "(cn=a))", "(&)", "(=a)", `(cn=\2)`, `(cn=\zz)`, "(:=a)", "(cn=**)", "(x:a:b:=1)"} {
+		_, err := parseFilter(invalid)
+		require.ErrorIs(t, err, ErrInvalidFilter, invalid)
+	}
+}
+
+func TestNewDirectory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     config.LDAPSync
+		address string
+		err     error
+	}{
+		{name: "ldap default port", cfg: config.LDAPSync{URL: "ldap://dc.example.com", BaseDN: "DC=example"}, address: "dc.example.com:389"},
+		{name: "ldaps default port", cfg: config.LDAPSync{URL: "ldaps://dc.example.com", BaseDN: "DC=example"}, address: "dc.example.com:636"},
+		{name: "explicit port", cfg: config.LDAPSync{URL: "ldap://dc.example.com:3268", BaseDN: "DC=example"}, address: "dc.example.com:3268"},
+		{name: "no url", cfg: config.LDAPSync{BaseDN: "DC=example"}, err: ErrNoURL},
+		{name: "unknown scheme", cfg: config.LDAPSync{URL: "http://dc.example.com", BaseDN: "DC=example"}, err: ErrUnknownScheme},
+		{name: "no base dn", cfg: config.LDAPSync{URL: "ldap://dc.example.com"}, err: ErrNoBaseDN},
+		{name: "invalid filter", cfg: config.LDAPSync{URL: "ldap://dc.example.com", BaseDN: "DC=example", UserFilter: "(cn=a"}, err: ErrInvalidFilter},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := NewDirectory(tc.cfg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.address, d.address)
+			require.Equal(t, tc.cfg.URL[:5] == "ldaps", d.tlsConfig != nil)
+		})
+	}
+}
+
+// fakeServer answers the requests of one connection with the responses of handle.
+func fakeServer(t *testing.T, handle func(op element, controls []element) [][]byte) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+
+		for {
+			message, err := readElement(reader)
+			if err != nil {
+				return
+			}
+
+			parts, err := parseElements(message.content)
+			if err != nil || len(parts) < 2 {
+				return
+			}
+
+			var controls []element
+			if len(parts) > 2 {
+				controls, _ = parseElements(parts[2].content)
+			}
+
+			for _, op := range handle(parts[1], controls) {
+				if _, err := conn.Write(ber.TLV(tagSequence, parts[0].encode(), op)); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func (e element) encode() []byte {
+	return ber.TLV(e.tag, e.content)
+}
+
+func ldapResult(tag byte, code int64, message string, controls ...[]byte) []byte {
+	result := ber.TLV(tag, ber.Integer(tagEnumerated, code), ber.TLV(tagOctetString, nil), ber.TLV(tagOctetString, []byte(message)))
+	if len(controls) == 0 {
+		return result
+	}
+
+	return ber.Concat(result, ber.TLV(tagControls, controls...))
+}
+
+func pagingControl(cookie string) []byte {
+	return ber.TLV(tagSequence,
+		ber.TLV(tagOctetString, []byte(oidPagedResults)),
+		ber.TLV(tagOctetString, ber.TLV(tagSequence, ber.Integer(tagInteger, 0), ber.TLV(tagOctetString, []byte(cookie)))),
+	)
+}
+
+func searchEntry(dn string, attributes map[string][]string) []byte {
+	var attrs [][]byte
+
+	for name, values := range attributes {
+		vals := make([][]byte, len(values))
+		for i, v := range values {
+			vals[i] = ber.TLV(tagOctetString, []byte(v))
+		}
+
+		attrs = append(attrs, ber.TLV(tagSequence, ber.TLV(tagOctetString, []byte(name)), ber.TLV(tagSet, vals...)))
+	}
+
+	return ber.TLV(tagSearchResultEntry, ber.TLV(tagOctetString, []byte(dn)), ber.TLV(tagSequence, attrs...))
+}
+
+func TestDirectorySearch(t *testing.T) {
+	t.Parallel()
+
+	var binds, pages []string
+
+	address := fakeServer(t, func(op element, controls []element) [][]byte {
+		switch op.tag {
+		case tagBindRequest:
+			fields, _ := parseElements(op.content)
+			binds = append(binds, string(fields[1].content)+":"+string(fields[2].content))
+
+			return [][]byte{ldapResult(tagBindResponse, resultSuccess, "")}
+		case tagSearchRequest:
+			cookie := string(pagingCookie(controls))
+			pages = append(pages, cookie)
+
+			if cookie == "" {
+				return [][]byte{
+					searchEntry("CN=Alice,DC=example", map[string][]string{
+						"sAMAccountName": {"alice"},
+						"displayName":    {"Alice"},
+						"mail":           {"alice@example.com"},
+						"memberOf":       {"CN=Admins,DC=example", "CN=Staff,DC=example"},
+					}),
+					ldapResult(tagSearchResultDone, resultSuccess, "", pagingControl("page2")),
+				}
+			}
+
+			return [][]byte{
+				searchEntry("CN=Bob,DC=example", map[string][]string{
+					"samaccountname":     {"bob"},
+					"userAccountControl": {"514"},
+				}),
+				searchEntry("CN=PC1,DC=example", map[string][]string{"cn": {"PC1"}}),
+				ber.TLV(tagSearchResultReference, ber.TLV(tagOctetString, []byte("ldap://other/DC=example"))),
+				ldapResult(tagSearchResultDone, resultSuccess, "", pagingControl("")),
+			}
+		}
+
+		return nil
+	})
+
+	d, err := NewDirectory(config.LDAPSync{
+		URL:          "ldap://" + address,
+		BindDN:       "CN=svc,DC=example",
+		BindPassword: "secret",
+		BaseDN:       "DC=example",
+		UserFilter:   "(objectClass=user)",
+	})
+	require.NoError(t, err)
+
+	accounts, err := d.Search(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []Account{
+		{
+			DN:          "CN=Alice,DC=example",
+			Username:    "alice",
+			DisplayName: "Alice",
+			Email:       "alice@example.com",
+			Groups:      []string{"CN=Admins,DC=example", "CN=Staff,DC=example"},
+		},
+		{DN: "CN=Bob,DC=example", Username: "bob", Disabled: true},
+	}, accounts)
+	require.Equal(t, []string{"CN=svc,DC=example:secret"}, binds)
+	require.Equal(t, []string{"", "page2"}, pages)
+}
+
+func TestDirectorySearchBindFailure(t *testing.T) {
+	t.Parallel()
+
+	address := fakeServer(t, func(op element, _ []element) [][]byte {
+		if op.tag == tagBindRequest {
+			return [][]byte{ldapResult(tagBindResponse, 49, "invalid credentials")}
+		}
+
+		return nil
+	})
+
+	d, err := NewDirectory(config.LDAPSync{URL: "ldap://" + address, BindDN: "CN=svc,DC=example", BaseDN: "DC=example"})
+	require.NoError(t, err)
+
+	_, err = d.Search(context.Background())
+	require.ErrorIs(t, err, ErrLDAPResult)
+	require.Contains(t, err.Error(), "result code 49: invalid credentials")
+}
@@ -0,0 +1,281 @@
+// Package ldapsync provisions console accounts from Active Directory or another LDAP directory.
+// Members of the configured directory groups get an account with the role mapped to their groups,
+// accounts that leave those groups or are disabled in the directory are disabled in the console.
+package ldapsync
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// Source is the source of the accounts provisioned by the sync.
+const Source = "ldap"
+
+var (
+	ErrNotConfigured = errors.New("ldap sync is not configured")
+	// ErrNoAccounts is returned rather than disabling every account when the search finds nobody,
+	// which is far more likely a wrong base DN or filter than an empty directory.
+	ErrNoAccounts = errors.New("the directory search returned no accounts")
+
+	ErrLDAPSyncUseCase = consoleerrors.CreateConsoleError("LDAPSyncUseCase")
+	ErrDatabase        = sqldb.DatabaseError{Console: ErrLDAPSyncUseCase}
+	ErrValidation      = dto.NotValidError{Console: ErrLDAPSyncUseCase}
+)
+
+// rolePrecedence ranks the roles, a member of several groups gets the highest ranked role.
+var rolePrecedence = map[string]int{
+	entity.RoleViewer:   1,
+	entity.RoleOperator: 2,
+	entity.RoleAdmin:    3,
+}
+
+// UseCase syncs the console accounts from the directory.
+type UseCase struct {
+	repo       Repository
+	directory  Searcher
+	groupRoles map[string]string
+	log        logger.Interface
+	interval   time.Duration
+	now        func() time.Time
+
+	// mu keeps a sync requested through the API from running next to the scheduled one
+	mu sync.Mutex
+}
+
+// New creates the sync. A nil directory disables it, an interval of 0 leaves it to be run through the API.
+// Group DNs are compared case-insensitively, groups mapped to an unknown role are ignored.
+func New(repo Repository, directory Searcher, groupRoles map[string]string, log logger.Interface, interval time.Duration) *UseCase {
+	roles := make(map[string]string, len(groupRoles))
+
+	for group, role := range groupRoles {
+		role = strings.ToLower(strings.TrimSpace(role))
+		if _, ok := rolePrecedence[role]; !ok {
+			log.Warn("ldapsync - New: group %s is ignored, unknown role %q", group, role)
+
+			continue
+		}
+
+		roles[normalizeDN(group)] = role
+	}
+
+	return &UseCase{
+		repo:       repo,
+		directory:  directory,
+		groupRoles: roles,
+		log:        log,
+		interval:   interval,
+		now:        time.Now,
+	}
+}
+
+// Start syncs the accounts in the background until ctx is done. It does nothing while the sync is disabled.
+func (uc *UseCase) Start(ctx context.Context) {
+	if uc.interval <= 0 || uc.directory == nil {
+		return
+	}
+
+	uc.log.Info("ldapsync - Start: syncing console accounts from the directory every %s", uc.interval)
+
+	go func() {
+		ticker := time.NewTicker(uc.interval)
+		defer ticker.Stop()
+
+		for {
+			if result, err := uc.Sync(ctx); err != nil {
+				uc.log.Error(err, "ldapsync - Start - uc.Sync")
+			} else {
+				uc.log.Info("ldapsync - Start: %d accounts created, %d updated, %d disabled", result.Created, result.Updated, result.Disabled)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// GetUsers -.
+func (uc *UseCase) GetUsers(ctx context.Context, top, skip int) ([]dto.User, error) {
+	users, err := uc.repo.Get(ctx, top, skip)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("GetUsers", "uc.repo.Get", err)
+	}
+
+	out := make([]dto.User, len(users))
+	for i := range users {
+		out[i] = userToDTO(&users[i])
+	}
+
+	return out, nil
+}
+
+// GetUser returns the account of username, nil when there is none.
+func (uc *UseCase) GetUser(ctx context.Context, username string) (*dto.User, error) {
+	user, err := uc.repo.GetByUsername(ctx, username)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("GetUser", "uc.repo.GetByUsername", err)
+	}
+
+	if user == nil {
+		return nil, nil
+	}
+
+	out := userToDTO(user)
+
+	return &out, nil
+}
+
+// Sync creates an account for every directory account in a mapped group, updates the accounts whose
+// role or details changed and disables the synced accounts that are no longer entitled to one.
+func (uc *UseCase) Sync(ctx context.Context) (dto.UserSyncResult, error) {
+	var result dto.UserSyncResult
+
+	if uc.directory == nil {
+		return result, ErrValidation.Wrap("Sync", "uc.directory", ErrNotConfigured)
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	accounts, err := uc.directory.Search(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	existing, err := uc.repo.GetBySource(ctx, Source)
+	if err != nil {
+		return result, ErrDatabase.Wrap("Sync", "uc.repo.GetBySource", err)
+	}
+
+	if len(accounts) == 0 && len(existing) > 0 {
+		return result, ErrNoAccounts
+	}
+
+	known := make(map[string]entity.User, len(existing))
+	for _, u := range existing {
+		known[strings.ToLower(u.Username)] = u
+	}
+
+	now := uc.now().UTC()
+
+	for i := range accounts {
+		account := &accounts[i]
+		key := strings.ToLower(account.Username)
+		current, found := known[key]
+
+		delete(known, key)
+
+		role := uc.role(account.Groups)
+
+		if !found && (role == "" || account.Disabled) {
+			continue
+		}
+
+		u := entity.User{
+			Username:    account.Username,
+			DN:          account.DN,
+			DisplayName: account.DisplayName,
+			Email:       account.Email,
+			Role:        role,
+			Source:      Source,
+			Disabled:    role == "" || account.Disabled,
+			UpdatedAt:   now,
+		}
+
+		if found {
+			// a disabled account keeps its last role so that it is visible what it was used for
+			if role == "" {
+				u.Role = current.Role
+			}
+
+			u.Username = current.Username
+
+			if sameUser(&u, &current) {
+				continue
+			}
+		}
+
+		if err := uc.repo.Save(ctx, u); err != nil {
+			return result, ErrDatabase.Wrap("Sync", "uc.repo.Save", err)
+		}
+
+		switch {
+		case !found:
+			result.Created++
+		case u.Disabled && !current.Disabled:
+			result.Disabled++
+		default:
+			result.Updated++
+		}
+	}
+
+	// the accounts left were deleted from the directory or fell out of the user filter
+	for _, u := range known {
+		if u.Disabled {
+			continue
+		}
+
+		u.Disabled = true
+		u.UpdatedAt = now
+
+		if err := uc.repo.Save(ctx, u); err != nil {
+			return result, ErrDatabase.Wrap("Sync", "uc.repo.Save", err)
+		}
+
+		result.Disabled++
+	}
+
+	return result, nil
+}
+
+// role returns the highest ranked role mapped to one of the groups, empty when none is mapped.
+func (uc *UseCase) role(groups []string) string {
+	role := ""
+
+	for _, group := range groups {
+		if r, ok := uc.groupRoles[normalizeDN(group)]; ok && rolePrecedence[r] > rolePrecedence[role] {
+			role = r
+		}
+	}
+
+	return role
+}
+
+// normalizeDN lower-cases a DN and drops the spaces around its separators, so that
+// "CN=Admins, DC=example" and "cn=admins,dc=example" are the same group.
+func normalizeDN(dn string) string {
+	parts := strings.Split(dn, ",")
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+	}
+
+	return strings.ToLower(strings.Join(parts, ","))
+}
+
+func sameUser(a, b *entity.User) bool {
+	return a.DN == b.DN && a.DisplayName == b.DisplayName && a.Email == b.Email &&
+		a.Role == b.Role && a.Disabled == b.Disabled
+}
+
+func userToDTO(u *entity.User) dto.User {
+	return dto.User{
+		Username:    u.Username,
+		DisplayName: u.DisplayName,
+		Email:       u.Email,
+		Role:        u.Role,
+		Source:      u.Source,
+		Disabled:    u.Disabled,
+		UpdatedAt:   u.UpdatedAt,
+	}
+}
@@ -0,0 +1,228 @@
+package ldapsync_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/ldapsync"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var (
+	errDatabase  = errors.New("database error")
+	errDirectory = errors.New("connection refused")
+
+	groupRoles = map[string]string{
+		"CN=Admins,DC=example":  "Admin",
+		"CN=Staff, DC=example":  entity.RoleViewer,
+		"CN=Ops,DC=example":     entity.RoleOperator,
+		"CN=Unknown,DC=example": "superuser",
+	}
+
+	updatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// saved matches the account of username with the given role and state.
+func saved(username, role string, disabled bool) gomock.Matcher {
+	return gomock.Cond(func(u entity.User) bool {
+		return u.Username == username && u.Role == role && u.Disabled == disabled && u.Source == ldapsync.Source && u.UpdatedAt.After(updatedAt)
+	})
+}
+
+func synced(username, role string, disabled bool) entity.User {
+	return entity.User{
+		Username:  username,
+		DN:        "CN=" + username + ",DC=example",
+		Role:      role,
+		Source:    ldapsync.Source,
+		Disabled:  disabled,
+		UpdatedAt: updatedAt,
+	}
+}
+
+func TestSync(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		accounts []ldapsync.Account
+		mock     func(*mocks.MockLDAPSyncRepository)
+		res      dto.UserSyncResult
+		err      error
+	}{
+		{
+			name: "creates members of mapped groups with their highest role",
+			accounts: []ldapsync.Account{
+				{DN: "CN=alice,DC=example", Username: "alice", Groups: []string{"cn=staff,dc=example", "CN=ADMINS, DC=example"}},
+				{DN: "CN=bob,DC=example", Username: "bob", Groups: []string{"CN=Ops,DC=example"}},
+				{DN: "CN=carol,DC=example", Username: "carol", Groups: []string{"CN=Unknown,DC=example"}},
+				{DN: "CN=dave,DC=example", Username: "dave", Groups: []string{"CN=Admins,DC=example"}, Disabled: true},
+			},
+			mock: func(repo *mocks.MockLDAPSyncRepository) {
+				repo.EXPECT().GetBySource(gomock.Any(), ldapsync.Source).Return([]entity.User{}, nil)
+				repo.EXPECT().Save(gomock.Any(), saved("alice", entity.RoleAdmin, false)).Return(nil)
+				repo.EXPECT().Save(gomock.Any(), saved("bob", entity.RoleOperator, false)).Return(nil)
+			},
+			res: dto.UserSyncResult{Created: 2},
+		},
+		{
+			name:     "leaves unchanged accounts alone",
+			accounts: []ldapsync.Account{{DN: "CN=alice,DC=example", Username: "Alice", Groups: []string{"CN=Admins,DC=example"}}},
+			mock: func(repo *mocks.MockLDAPSyncRepository) {
+				repo.EXPECT().GetBySource(gomock.Any(), ldapsync.Source).Return([]entity.User{synced("alice", entity.RoleAdmin, false)}, nil)
+			},
+		},
+		{
+			name:     "updates a changed role",
+			accounts: []ldapsync.Account{{DN: "CN=alice,DC=example", Username: "alice", Groups: []string{"CN=Ops,DC=example"}}},
+			mock: func(repo *mocks.MockLDAPSyncRepository) {
+				repo.EXPECT().GetBySource(gomock.Any(), ldapsync.Source).Return([]entity.User{synced("alice", entity.RoleAdmin, false)}, nil)
+				repo.EXPECT().Save(gomock.Any(), saved("alice", entity.RoleOperator, false)).Return(nil)
+			},
+			res: dto.UserSyncResult{Updated: 1},
+		},
+		{
+			name: "disables accounts that left the groups, were disabled or deleted",
+			accounts: []ldapsync.Account{
+				{DN: "CN=alice,DC=example", Username: "alice"},
+				{DN: "CN=bob,DC=example", Username: "bob", Groups: []string{"CN=Ops,DC=example"}, Disabled: true},
+			},
+			mock: func(repo *mocks.MockLDAPSyncRepository) {
+				repo.EXPECT().GetBySource(gomock.Any(), ldapsync.Source).Return([]entity.User{
+					synced("alice", entity.RoleAdmin, false),
+					synced("bob", entity.RoleOperator, false),
+					synced("carol", entity.RoleViewer, false),
+					synced("dave", entity.RoleViewer, true),
+				}, nil)
+				repo.EXPECT().Save(gomock.Any(), saved("alice", entity.RoleAdmin, true)).Return(nil)
+				repo.EXPECT().Save(gomock.Any(), saved("bob", entity.RoleOperator, true)).Return(nil)
+				repo.EXPECT().Save(gomock.Any(), saved("carol", entity.RoleViewer, true)).Return(nil)
+			},
+			res: dto.UserSyncResult{Disabled: 3},
+		},
+		{
+			name:     "re-enables a returning account",
+			accounts: []ldapsync.Account{{DN: "CN=alice,DC=example", Username: "alice", Groups: []string{"CN=Staff,DC=example"}}},
+			mock: func(repo *mocks.MockLDAPSyncRepository) {
+				repo.EXPECT().GetBySource(gomock.Any(), ldapsync.Source).Return([]entity.User{synced("alice", entity.RoleAdmin, true)}, nil)
+				repo.EXPECT().Save(gomock.Any(), saved("alice", entity.RoleViewer, false)).Return(nil)
+			},
+			res: dto.UserSyncResult{Updated: 1},
+		},
+		{
+			name: "refuses to disable everyone when the search finds nobody",
+			mock: func(repo *mocks.MockLDAPSyncRepository) {
+				repo.EXPECT().GetBySource(gomock.Any(), ldapsync.Source).Return([]entity.User{synced("alice", entity.RoleAdmin, false)}, nil)
+			},
+			err: ldapsync.ErrNoAccounts,
+		},
+		{
+			name:     "database error",
+			accounts: []ldapsync.Account{{Username: "alice"}},
+			mock: func(repo *mocks.MockLDAPSyncRepository) {
+				repo.EXPECT().GetBySource(gomock.Any(), ldapsync.Source).Return(nil, errDatabase)
+			},
+			err: ldapsync.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			repo := mocks.NewMockLDAPSyncRepository(mockCtl)
+			directory := mocks.NewMockLDAPSearcher(mockCtl)
+
+			directory.EXPECT().Search(gomock.Any()).Return(tc.accounts, nil)
+			tc.mock(repo)
+
+			uc := ldapsync.New(repo, directory, groupRoles, logger.New("error"), 0)
+
+			res, err := uc.Sync(context.Background())
+			require.Equal(t, tc.res, res)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			if errors.Is(tc.err, ldapsync.ErrNoAccounts) {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.IsType(t, tc.err, err)
+			}
+		})
+	}
+}
+
+func TestSyncDirectoryUnavailable(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockLDAPSyncRepository(mockCtl)
+	directory := mocks.NewMockLDAPSearcher(mockCtl)
+
+	directory.EXPECT().Search(gomock.Any()).Return(nil, errDirectory)
+
+	_, err := ldapsync.New(repo, directory, groupRoles, logger.New("error"), 0).Sync(context.Background())
+	require.ErrorIs(t, err, errDirectory)
+
+	_, err = ldapsync.New(repo, nil, groupRoles, logger.New("error"), 0).Sync(context.Background())
+	require.IsType(t, ldapsync.ErrValidation, err)
+}
+
+func TestGetUsers(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockLDAPSyncRepository(mockCtl)
+
+	repo.EXPECT().Get(gomock.Any(), 10, 5).Return([]entity.User{synced("alice", entity.RoleAdmin, false)}, nil)
+	repo.EXPECT().Get(gomock.Any(), 0, 0).Return(nil, errDatabase)
+
+	uc := ldapsync.New(repo, nil, nil, logger.New("error"), 0)
+
+	users, err := uc.GetUsers(context.Background(), 10, 5)
+	require.NoError(t, err)
+	require.Equal(t, []dto.User{{Username: "alice", Role: entity.RoleAdmin, Source: ldapsync.Source, UpdatedAt: updatedAt}}, users)
+
+	_, err = uc.GetUsers(context.Background(), 0, 0)
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
+
+func TestGetUser(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockLDAPSyncRepository(mockCtl)
+
+	alice := synced("alice", entity.RoleAdmin, true)
+
+	repo.EXPECT().GetByUsername(gomock.Any(), "alice").Return(&alice, nil)
+	repo.EXPECT().GetByUsername(gomock.Any(), "bob").Return(nil, nil)
+	repo.EXPECT().GetByUsername(gomock.Any(), "carol").Return(nil, errDatabase)
+
+	uc := ldapsync.New(repo, nil, nil, logger.New("error"), 0)
+
+	user, err := uc.GetUser(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Equal(t, &dto.User{Username: "alice", Role: entity.RoleAdmin, Source: ldapsync.Source, Disabled: true, UpdatedAt: updatedAt}, user)
+
+	user, err = uc.GetUser(context.Background(), "bob")
+	require.NoError(t, err)
+	require.Nil(t, user)
+
+	_, err = uc.GetUser(context.Background(), "carol")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
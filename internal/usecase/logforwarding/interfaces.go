@@ -0,0 +1,31 @@
+package logforwarding
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		Get(ctx context.Context, guid, log string) (entity.LogCursor, error)
+		Save(ctx context.Context, c entity.LogCursor) error
+	}
+
+	// Devices is the part of the devices use case the logs are read through.
+	Devices interface {
+		Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Device, error)
+		GetAuditLog(ctx context.Context, startIndex int, guid string) (dto.AuditLog, error)
+		GetEventLog(ctx context.Context, startIndex, maxReadRecords int, guid string) (dto.EventLogs, error)
+	}
+
+	// Sink ships forwarded records to a syslog server or collector.
+	Sink interface {
+		Send(ctx context.Context, records []Record) error
+	}
+
+	Feature interface {
+		Start(ctx context.Context)
+	}
+)
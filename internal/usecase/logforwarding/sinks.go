@@ -0,0 +1,219 @@
+package logforwarding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+const (
+	TargetSyslog = "syslog"
+	TargetSplunk = "splunk"
+	TargetHTTP   = "http"
+
+	sendTimeout = 30 * time.Second
+
+	// syslogFacility is the log audit facility of RFC 5424.
+	syslogFacility = 13
+	// syslogSDID is the structured data ID of the device fields, 32473 is the enterprise number reserved for documentation.
+	syslogSDID = "amt@32473"
+)
+
+var (
+	ErrUnknownTarget = errors.New("unknown log forwarding target, expected syslog, splunk or http")
+	ErrNoAddress     = errors.New("log forwarding address is required")
+	ErrCollector     = errors.New("collector rejected the records")
+)
+
+var syslogSeverities = map[string]int{
+	"critical": 2,
+	"error":    3,
+	"warning":  4,
+	"notice":   5,
+	"info":     6,
+}
+
+// NewSink creates the sink of the configured target.
+func NewSink(cfg config.LogForwarding) (Sink, error) {
+	if cfg.Address == "" {
+		return nil, ErrNoAddress
+	}
+
+	client := &http.Client{Timeout: sendTimeout}
+
+	switch cfg.Target {
+	case TargetSyslog:
+		network := cfg.Network
+		if network == "" {
+			network = "udp"
+		}
+
+		return &SyslogSink{network: network, address: cfg.Address, dialer: &net.Dialer{Timeout: sendTimeout}}, nil
+	case TargetSplunk:
+		return &SplunkSink{url: cfg.Address, token: cfg.Token, client: client}, nil
+	case TargetHTTP:
+		return &HTTPSink{url: cfg.Address, token: cfg.Token, client: client}, nil
+	default:
+		return nil, ErrUnknownTarget
+	}
+}
+
+// SyslogSink sends records as RFC 5424 messages, over TCP with octet counting framing.
+type SyslogSink struct {
+	network string
+	address string
+	dialer  *net.Dialer
+}
+
+func (s *SyslogSink) Send(ctx context.Context, records []Record) error {
+	conn, err := s.dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.SetWriteDeadline(time.Now().Add(sendTimeout)); err != nil {
+		return err
+	}
+
+	stream := strings.HasPrefix(s.network, "tcp")
+
+	for i := range records {
+		msg := syslogMessage(&records[i])
+		if stream {
+			msg = fmt.Sprintf("%d %s", len(msg), msg)
+		}
+
+		if _, err := conn.Write([]byte(msg)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syslogMessage formats a record as <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID [SD] MSG.
+func syslogMessage(r *Record) string {
+	severity, ok := syslogSeverities[r.Severity]
+	if !ok {
+		severity = syslogSeverities["info"]
+	}
+
+	host := r.Hostname
+	if host == "" {
+		host = r.GUID
+	}
+
+	sd := fmt.Sprintf(`[%s guid="%s" source="%s"`, syslogSDID, sdEscape(r.GUID), sdEscape(r.Source))
+	if r.Event != "" {
+		sd += fmt.Sprintf(` event="%s"`, sdEscape(r.Event))
+	}
+
+	if r.Initiator != "" {
+		sd += fmt.Sprintf(` initiator="%s"`, sdEscape(r.Initiator))
+	}
+
+	if r.NetAddress != "" {
+		sd += fmt.Sprintf(` address="%s"`, sdEscape(r.NetAddress))
+	}
+
+	return fmt.Sprintf("<%d>1 %s %s console - %s %s] %s",
+		syslogFacility*8+severity, r.Time.UTC().Format(time.RFC3339), strings.ReplaceAll(host, " ", "-"), r.Log, sd, r.Description)
+}
+
+// sdEscape escapes the characters RFC 5424 does not allow in structured data values.
+func sdEscape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
+
+// SplunkSink sends records to a Splunk HTTP Event Collector.
+type SplunkSink struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+type splunkEvent struct {
+	Time       int64  `json:"time"`
+	Host       string `json:"host,omitempty"`
+	Source     string `json:"source"`
+	SourceType string `json:"sourcetype"`
+	Event      Record `json:"event"`
+}
+
+func (s *SplunkSink) Send(ctx context.Context, records []Record) error {
+	var body bytes.Buffer
+
+	encoder := json.NewEncoder(&body)
+
+	for i := range records {
+		event := splunkEvent{
+			Time:       records[i].Time.Unix(),
+			Host:       records[i].Hostname,
+			Source:     "console",
+			SourceType: "amt:" + records[i].Log,
+			Event:      records[i],
+		}
+
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+
+	return post(ctx, s.client, s.url, "Splunk "+s.token, &body)
+}
+
+// HTTPSink posts records as a JSON array to a collector.
+type HTTPSink struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func (s *HTTPSink) Send(ctx context.Context, records []Record) error {
+	body, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	authorization := ""
+	if s.token != "" {
+		authorization = "Bearer " + s.token
+	}
+
+	return post(ctx, s.client, s.url, authorization, bytes.NewReader(body))
+}
+
+func post(ctx context.Context, client *http.Client, url, authorization string, body io.Reader) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %s", ErrCollector, resp.Status)
+	}
+
+	return nil
+}
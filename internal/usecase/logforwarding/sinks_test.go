@@ -0,0 +1,191 @@
+package logforwarding_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/usecase/logforwarding"
+)
+
+var testRecord = logforwarding.Record{
+	GUID:        "guid1",
+	Hostname:    "host one",
+	Log:         logforwarding.AuditLog,
+	Time:        at(1),
+	Severity:    "notice",
+	Source:      "Security Admin",
+	Event:       "Provisioning Started",
+	Initiator:   `admin "local"`,
+	Description: "Security Admin: Provisioning Started",
+}
+
+const testSyslogMessage = `<109>1 2024-01-01T00:01:00Z host-one console - audit ` +
+	`[amt@32473 guid="guid1" source="Security Admin" event="Provisioning Started" initiator="admin \"local\""] ` +
+	`Security Admin: Provisioning Started`
+
+func TestNewSink(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  config.LogForwarding
+		err  error
+	}{
+		{name: "syslog", cfg: config.LogForwarding{Target: "syslog", Address: "localhost:514"}},
+		{name: "splunk", cfg: config.LogForwarding{Target: "splunk", Address: "https://localhost:8088/services/collector/event"}},
+		{name: "http", cfg: config.LogForwarding{Target: "http", Address: "https://localhost/logs"}},
+		{name: "no address", cfg: config.LogForwarding{Target: "syslog"}, err: logforwarding.ErrNoAddress},
+		{name: "unknown target", cfg: config.LogForwarding{Target: "kafka", Address: "localhost:9092"}, err: logforwarding.ErrUnknownTarget},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sink, err := logforwarding.NewSink(tc.cfg)
+
+			require.ErrorIs(t, err, tc.err)
+
+			if tc.err == nil {
+				require.NotNil(t, sink)
+			}
+		})
+	}
+}
+
+func TestSyslogSinkUDP(t *testing.T) {
+	t.Parallel()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	sink, err := logforwarding.NewSink(config.LogForwarding{Target: "syslog", Network: "udp", Address: conn.LocalAddr().String()})
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(context.Background(), []logforwarding.Record{testRecord}))
+
+	buf := make([]byte, 2048)
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	require.Equal(t, testSyslogMessage, string(buf[:n]))
+}
+
+func TestSyslogSinkTCP(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer listener.Close()
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close()
+
+		data, _ := io.ReadAll(conn)
+		received <- string(data)
+	}()
+
+	sink, err := logforwarding.NewSink(config.LogForwarding{Target: "syslog", Network: "tcp", Address: listener.Addr().String()})
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(context.Background(), []logforwarding.Record{testRecord, testRecord}))
+
+	frame := strconv.Itoa(len(testSyslogMessage)) + " " + testSyslogMessage
+	require.Equal(t, frame+frame, <-received)
+}
+
+func TestSplunkSink(t *testing.T) {
+	t.Parallel()
+
+	var (
+		authorization string
+		events        []map[string]any
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+
+		decoder := json.NewDecoder(r.Body)
+		for decoder.More() {
+			var event map[string]any
+			if err := decoder.Decode(&event); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			events = append(events, event)
+		}
+	}))
+	defer server.Close()
+
+	sink, err := logforwarding.NewSink(config.LogForwarding{Target: "splunk", Address: server.URL, Token: "secret"})
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(context.Background(), []logforwarding.Record{testRecord, testRecord}))
+
+	require.Equal(t, "Splunk secret", authorization)
+	require.Len(t, events, 2)
+	require.Equal(t, "amt:audit", events[0]["sourcetype"])
+	require.InDelta(t, float64(at(1).Unix()), events[0]["time"], 0)
+	require.Equal(t, "guid1", events[0]["event"].(map[string]any)["guid"])
+}
+
+func TestHTTPSink(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		token         string
+		status        int
+		authorization string
+		err           error
+	}{
+		{name: "records are posted", status: http.StatusOK},
+		{name: "bearer token", token: "secret", status: http.StatusNoContent, authorization: "Bearer secret"},
+		{name: "collector rejects the records", status: http.StatusForbidden, err: logforwarding.ErrCollector},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				authorization string
+				records       []logforwarding.Record
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+				_ = json.NewDecoder(r.Body).Decode(&records)
+
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			sink, err := logforwarding.NewSink(config.LogForwarding{Target: "http", Address: server.URL, Token: tc.token})
+			require.NoError(t, err)
+
+			err = sink.Send(context.Background(), []logforwarding.Record{testRecord})
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.authorization, authorization)
+			require.Len(t, records, 1)
+			require.Equal(t, testRecord.Description, records[0].Description)
+		})
+	}
+}
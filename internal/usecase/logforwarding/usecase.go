@@ -0,0 +1,296 @@
+package logforwarding
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	AuditLog = "audit"
+	EventLog = "event"
+
+	devicesPerPage        = 100
+	maxConcurrentForwards = 8
+	// maxEventRecords is the most event log records AMT returns per request.
+	maxEventRecords = 390
+
+	// eventTimeLayout is the layout the devices use case formats event times with.
+	eventTimeLayout = "2006-01-02 15:04:05 -0700 MST"
+)
+
+// Record is a device log record as it is forwarded.
+type Record struct {
+	GUID        string    `json:"guid"`
+	Hostname    string    `json:"hostname"`
+	Log         string    `json:"log"`
+	Time        time.Time `json:"time"`
+	Severity    string    `json:"severity"`
+	Source      string    `json:"source"`
+	Event       string    `json:"event,omitempty"`
+	Initiator   string    `json:"initiator,omitempty"`
+	NetAddress  string    `json:"netAddress,omitempty"`
+	Description string    `json:"description"`
+}
+
+// UseCase pulls the audit and event log records added since the last run from every device and
+// ships them to a sink. How far each log was forwarded is kept in the repository.
+type UseCase struct {
+	repo     Repository
+	devices  Devices
+	sink     Sink
+	log      logger.Interface
+	interval time.Duration
+}
+
+// New creates the log forwarder. A nil sink or an interval of 0 disables forwarding.
+func New(repo Repository, d Devices, sink Sink, log logger.Interface, interval time.Duration) *UseCase {
+	return &UseCase{
+		repo:     repo,
+		devices:  d,
+		sink:     sink,
+		log:      log,
+		interval: interval,
+	}
+}
+
+// Start forwards the logs in the background until ctx is done. It does nothing while forwarding is disabled.
+func (uc *UseCase) Start(ctx context.Context) {
+	if uc.interval <= 0 || uc.sink == nil {
+		return
+	}
+
+	uc.log.Info("logforwarding - Start: forwarding device audit and event logs every %s", uc.interval)
+
+	go func() {
+		ticker := time.NewTicker(uc.interval)
+		defer ticker.Stop()
+
+		for {
+			uc.Forward(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Forward ships the new log records of every device once.
+func (uc *UseCase) Forward(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, maxConcurrentForwards)
+
+	for skip := 0; ; skip += devicesPerPage {
+		page, err := uc.devices.Get(ctx, devicesPerPage, skip, "")
+		if err != nil {
+			uc.log.Error(err, "logforwarding - Forward - uc.devices.Get")
+
+			break
+		}
+
+		for i := range page {
+			device := page[i]
+
+			wg.Add(1)
+
+			sem <- struct{}{}
+
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				if err := uc.forwardAuditLog(ctx, &device); err != nil {
+					uc.log.Warn("logforwarding - Forward: audit log of device %s not forwarded: %v", device.GUID, err)
+				}
+
+				if err := uc.forwardEventLog(ctx, &device); err != nil {
+					uc.log.Warn("logforwarding - Forward: event log of device %s not forwarded: %v", device.GUID, err)
+				}
+			}()
+		}
+
+		if len(page) < devicesPerPage {
+			break
+		}
+	}
+
+	wg.Wait()
+}
+
+// forwardAuditLog ships the audit log records after the cursor. The record at the cursor is read
+// again to notice a log that was cleared or rolled over, which is then forwarded from the last time sent.
+func (uc *UseCase) forwardAuditLog(ctx context.Context, device *dto.Device) error {
+	cursor, err := uc.repo.Get(ctx, device.GUID, AuditLog)
+	if err != nil {
+		return err
+	}
+
+	entries, err := uc.readAuditLog(ctx, device.GUID, max(cursor.LastIndex, 1))
+	if err != nil {
+		return err
+	}
+
+	if cursor.LastIndex > 0 {
+		if len(entries) > 0 && entries[0].Time.Equal(cursor.LastTime) {
+			entries = entries[1:]
+		} else {
+			if entries, err = uc.readAuditLog(ctx, device.GUID, 1); err != nil {
+				return err
+			}
+
+			entries = newerEntries(entries, cursor.LastTime)
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	records := make([]Record, len(entries))
+	for i := range entries {
+		records[i] = auditRecord(device, &entries[i])
+	}
+
+	if err := uc.sink.Send(ctx, records); err != nil {
+		return err
+	}
+
+	last := entries[len(entries)-1]
+
+	return uc.repo.Save(ctx, entity.LogCursor{GUID: device.GUID, Log: AuditLog, LastIndex: last.Index, LastTime: last.Time})
+}
+
+// readAuditLog reads the audit log from startIndex to its end, oldest first.
+func (uc *UseCase) readAuditLog(ctx context.Context, guid string, startIndex int) ([]dto.AuditLogEntry, error) {
+	var entries []dto.AuditLogEntry
+
+	for {
+		page, err := uc.devices.GetAuditLog(ctx, startIndex, guid)
+		if err != nil {
+			return nil, err
+		}
+
+		// the records of a page are decoded newest first
+		for i := len(page.Records) - 1; i >= 0; i-- {
+			entries = append(entries, devices.AuditLogEntry(startIndex+len(page.Records)-1-i, &page.Records[i]))
+		}
+
+		startIndex += len(page.Records)
+
+		if len(page.Records) == 0 || startIndex > page.TotalCount {
+			return entries, nil
+		}
+	}
+}
+
+func newerEntries(entries []dto.AuditLogEntry, after time.Time) []dto.AuditLogEntry {
+	newer := entries[:0]
+
+	for i := range entries {
+		if entries[i].Time.After(after) {
+			newer = append(newer, entries[i])
+		}
+	}
+
+	return newer
+}
+
+// forwardEventLog ships the event log records newer than the cursor. The event log has no stable
+// index, records of the same second as the last one forwarded are not forwarded again.
+func (uc *UseCase) forwardEventLog(ctx context.Context, device *dto.Device) error {
+	cursor, err := uc.repo.Get(ctx, device.GUID, EventLog)
+	if err != nil {
+		return err
+	}
+
+	var records []Record
+
+	for startIndex := 1; ; {
+		page, err := uc.devices.GetEventLog(ctx, startIndex, maxEventRecords, device.GUID)
+		if err != nil {
+			return err
+		}
+
+		for i := range page.Records {
+			record, ok := eventRecord(device, &page.Records[i])
+			if ok && record.Time.After(cursor.LastTime) {
+				records = append(records, record)
+			}
+		}
+
+		startIndex += len(page.Records)
+
+		if !page.HasMoreRecords || len(page.Records) == 0 {
+			break
+		}
+	}
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Time.Before(records[j].Time) })
+
+	if err := uc.sink.Send(ctx, records); err != nil {
+		return err
+	}
+
+	return uc.repo.Save(ctx, entity.LogCursor{GUID: device.GUID, Log: EventLog, LastTime: records[len(records)-1].Time})
+}
+
+func auditRecord(device *dto.Device, entry *dto.AuditLogEntry) Record {
+	return Record{
+		GUID:        device.GUID,
+		Hostname:    device.Hostname,
+		Log:         AuditLog,
+		Time:        entry.Time,
+		Severity:    "notice",
+		Source:      entry.AuditApp,
+		Event:       entry.Event,
+		Initiator:   entry.Initiator,
+		NetAddress:  entry.NetAddress,
+		Description: entry.Description,
+	}
+}
+
+// eventRecord converts an event log record, false when its time cannot be read.
+func eventRecord(device *dto.Device, event *dto.EventLog) (Record, bool) {
+	at, err := time.Parse(eventTimeLayout, event.Time)
+	if err != nil {
+		return Record{}, false
+	}
+
+	return Record{
+		GUID:        device.GUID,
+		Hostname:    device.Hostname,
+		Log:         EventLog,
+		Time:        at.UTC(),
+		Severity:    eventSeverity(event.EventSeverity),
+		Source:      event.Entity,
+		Description: event.Description,
+	}, true
+}
+
+// eventSeverity maps the severity of an AMT event to a syslog severity.
+func eventSeverity(severity string) string {
+	switch severity {
+	case "Non-recoverable condition":
+		return "critical"
+	case "Critical condition":
+		return "error"
+	case "Non-critical condition":
+		return "warning"
+	default:
+		return "info"
+	}
+}
@@ -0,0 +1,159 @@
+package logforwarding_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/logforwarding"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errUnreachable = errors.New("collector unreachable")
+
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func at(minutes int) time.Time {
+	return start.Add(time.Duration(minutes) * time.Minute)
+}
+
+// auditPage returns a page of an audit log of total records, times are given oldest first
+// and decoded newest first as the devices use case does.
+func auditPage(total int, times ...time.Time) dto.AuditLog {
+	records := make([]auditlog.AuditLogRecord, len(times))
+	for i, t := range times {
+		records[len(times)-1-i] = auditlog.AuditLogRecord{Time: t, AuditApp: "Security Admin", Event: "Provisioning Started"}
+	}
+
+	return dto.AuditLog{TotalCount: total, Records: records}
+}
+
+// recordsAt matches the records sent of a log by their times.
+func recordsAt(log string, times ...time.Time) gomock.Matcher {
+	return gomock.Cond(func(records []logforwarding.Record) bool {
+		if len(records) != len(times) {
+			return false
+		}
+
+		for i := range records {
+			if records[i].Log != log || records[i].GUID != "guid1" || !records[i].Time.Equal(times[i]) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+func noEvents(d *mocks.MockLogForwardingDevices, repo *mocks.MockLogForwardingRepository) {
+	repo.EXPECT().Get(gomock.Any(), "guid1", logforwarding.EventLog).Return(entity.LogCursor{}, nil)
+	d.EXPECT().GetEventLog(gomock.Any(), 1, 390, "guid1").Return(dto.EventLogs{}, nil)
+}
+
+func TestForward(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		mock func(*mocks.MockLogForwardingRepository, *mocks.MockLogForwardingDevices, *mocks.MockSink)
+	}{
+		{
+			name: "first run forwards the complete audit log",
+			mock: func(repo *mocks.MockLogForwardingRepository, d *mocks.MockLogForwardingDevices, sink *mocks.MockSink) {
+				repo.EXPECT().Get(gomock.Any(), "guid1", logforwarding.AuditLog).Return(entity.LogCursor{}, nil)
+				d.EXPECT().GetAuditLog(gomock.Any(), 1, "guid1").Return(auditPage(2, at(1), at(2)), nil)
+				sink.EXPECT().Send(gomock.Any(), recordsAt(logforwarding.AuditLog, at(1), at(2))).Return(nil)
+				repo.EXPECT().Save(gomock.Any(), entity.LogCursor{GUID: "guid1", Log: logforwarding.AuditLog, LastIndex: 2, LastTime: at(2)}).Return(nil)
+				noEvents(d, repo)
+			},
+		},
+		{
+			name: "audit log continues after the cursor",
+			mock: func(repo *mocks.MockLogForwardingRepository, d *mocks.MockLogForwardingDevices, sink *mocks.MockSink) {
+				repo.EXPECT().Get(gomock.Any(), "guid1", logforwarding.AuditLog).Return(entity.LogCursor{GUID: "guid1", Log: logforwarding.AuditLog, LastIndex: 2, LastTime: at(2)}, nil)
+				d.EXPECT().GetAuditLog(gomock.Any(), 2, "guid1").Return(auditPage(3, at(2), at(3)), nil)
+				sink.EXPECT().Send(gomock.Any(), recordsAt(logforwarding.AuditLog, at(3))).Return(nil)
+				repo.EXPECT().Save(gomock.Any(), entity.LogCursor{GUID: "guid1", Log: logforwarding.AuditLog, LastIndex: 3, LastTime: at(3)}).Return(nil)
+				noEvents(d, repo)
+			},
+		},
+		{
+			name: "audit log without new records",
+			mock: func(repo *mocks.MockLogForwardingRepository, d *mocks.MockLogForwardingDevices, _ *mocks.MockSink) {
+				repo.EXPECT().Get(gomock.Any(), "guid1", logforwarding.AuditLog).Return(entity.LogCursor{GUID: "guid1", Log: logforwarding.AuditLog, LastIndex: 2, LastTime: at(2)}, nil)
+				d.EXPECT().GetAuditLog(gomock.Any(), 2, "guid1").Return(auditPage(2, at(2)), nil)
+				noEvents(d, repo)
+			},
+		},
+		{
+			name: "rolled over audit log is forwarded from the last time sent",
+			mock: func(repo *mocks.MockLogForwardingRepository, d *mocks.MockLogForwardingDevices, sink *mocks.MockSink) {
+				repo.EXPECT().Get(gomock.Any(), "guid1", logforwarding.AuditLog).Return(entity.LogCursor{GUID: "guid1", Log: logforwarding.AuditLog, LastIndex: 2, LastTime: at(2)}, nil)
+				d.EXPECT().GetAuditLog(gomock.Any(), 2, "guid1").Return(auditPage(2, at(5)), nil)
+				d.EXPECT().GetAuditLog(gomock.Any(), 1, "guid1").Return(auditPage(2, at(1), at(5)), nil)
+				sink.EXPECT().Send(gomock.Any(), recordsAt(logforwarding.AuditLog, at(5))).Return(nil)
+				repo.EXPECT().Save(gomock.Any(), entity.LogCursor{GUID: "guid1", Log: logforwarding.AuditLog, LastIndex: 2, LastTime: at(5)}).Return(nil)
+				noEvents(d, repo)
+			},
+		},
+		{
+			name: "cursor is kept when the sink fails",
+			mock: func(repo *mocks.MockLogForwardingRepository, d *mocks.MockLogForwardingDevices, sink *mocks.MockSink) {
+				repo.EXPECT().Get(gomock.Any(), "guid1", logforwarding.AuditLog).Return(entity.LogCursor{}, nil)
+				d.EXPECT().GetAuditLog(gomock.Any(), 1, "guid1").Return(auditPage(1, at(1)), nil)
+				sink.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errUnreachable)
+				noEvents(d, repo)
+			},
+		},
+		{
+			name: "event log records newer than the cursor are forwarded oldest first",
+			mock: func(repo *mocks.MockLogForwardingRepository, d *mocks.MockLogForwardingDevices, sink *mocks.MockSink) {
+				repo.EXPECT().Get(gomock.Any(), "guid1", logforwarding.AuditLog).Return(entity.LogCursor{}, nil)
+				d.EXPECT().GetAuditLog(gomock.Any(), 1, "guid1").Return(dto.AuditLog{}, nil)
+				repo.EXPECT().Get(gomock.Any(), "guid1", logforwarding.EventLog).Return(entity.LogCursor{GUID: "guid1", Log: logforwarding.EventLog, LastTime: at(2)}, nil)
+				d.EXPECT().GetEventLog(gomock.Any(), 1, 390, "guid1").Return(dto.EventLogs{
+					Records:        []dto.EventLog{{Time: at(4).String()}, {Time: at(1).String()}},
+					HasMoreRecords: true,
+				}, nil)
+				d.EXPECT().GetEventLog(gomock.Any(), 3, 390, "guid1").Return(dto.EventLogs{
+					Records: []dto.EventLog{{Time: at(3).String()}, {Time: "not a time"}, {Time: at(2).String()}},
+				}, nil)
+				sink.EXPECT().Send(gomock.Any(), recordsAt(logforwarding.EventLog, at(3), at(4))).Return(nil)
+				repo.EXPECT().Save(gomock.Any(), entity.LogCursor{GUID: "guid1", Log: logforwarding.EventLog, LastTime: at(4)}).Return(nil)
+			},
+		},
+		{
+			name: "devices cannot be listed",
+			mock: func(_ *mocks.MockLogForwardingRepository, d *mocks.MockLogForwardingDevices, _ *mocks.MockSink) {
+				d.EXPECT().Get(gomock.Any(), 100, 0, "").Return(nil, errUnreachable)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := mocks.NewMockLogForwardingRepository(ctrl)
+			d := mocks.NewMockLogForwardingDevices(ctrl)
+			sink := mocks.NewMockSink(ctrl)
+
+			if tc.name != "devices cannot be listed" {
+				d.EXPECT().Get(gomock.Any(), 100, 0, "").Return([]dto.Device{{GUID: "guid1", Hostname: "host1"}}, nil)
+			}
+
+			tc.mock(repo, d, sink)
+
+			uc := logforwarding.New(repo, d, sink, logger.New("error"), time.Minute)
+			uc.Forward(context.Background())
+		})
+	}
+}
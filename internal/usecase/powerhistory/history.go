@@ -0,0 +1,147 @@
+package powerhistory
+
+import (
+	"math"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type category int
+
+const (
+	unknown category = iota
+	on
+	sleeping
+	off
+)
+
+// categorize groups the CIM power states: 2 is on, 3 and 4 are sleep states and the others are off
+// or transitions to off. 0 is recorded when the device could not be reached.
+func categorize(powerState int) category {
+	switch powerState {
+	case 0:
+		return unknown
+	case 2:
+		return on
+	case 3, 4:
+		return sleeping
+	default:
+		return off
+	}
+}
+
+// span is a period in which a device was in the power state of a sample.
+type span struct {
+	start, end time.Time
+	category   category
+}
+
+// spans lets each sample hold until the next one, but for no longer than hold so that
+// gaps in the samples, e.g. while the poller was stopped, are not attributed to a state.
+func spans(samples []entity.PowerSample, to time.Time, hold time.Duration) []span {
+	result := make([]span, 0, len(samples))
+
+	for i, s := range samples {
+		end := s.SampledAt.Add(hold)
+		if i+1 < len(samples) && samples[i+1].SampledAt.Before(end) {
+			end = samples[i+1].SampledAt
+		}
+
+		if end.After(to) {
+			end = to
+		}
+
+		if end.After(s.SampledAt) {
+			result = append(result, span{start: s.SampledAt, end: end, category: categorize(s.PowerState)})
+		}
+	}
+
+	return result
+}
+
+// overlap returns how long the span lies within [from, to).
+func (s span) overlap(from, to time.Time) time.Duration {
+	start, end := s.start, s.end
+	if from.After(start) {
+		start = from
+	}
+
+	if to.Before(end) {
+		end = to
+	}
+
+	if end.Before(start) {
+		return 0
+	}
+
+	return end.Sub(start)
+}
+
+// summarize computes the time spent in each power state between from and to and
+// splits the window into buckets holding the share of each the device was on.
+func summarize(samples []entity.PowerSample, from, to time.Time, hold time.Duration, buckets int) dto.PowerHistory {
+	history := dto.PowerHistory{
+		From:    from,
+		To:      to,
+		Buckets: make([]*float64, buckets),
+		Samples: make([]dto.PowerSample, 0, len(samples)),
+	}
+
+	for _, s := range samples {
+		history.Samples = append(history.Samples, dto.PowerSample{Timestamp: s.SampledAt, PowerState: s.PowerState})
+	}
+
+	periods := spans(samples, to, hold)
+
+	var durations [off + 1]time.Duration
+
+	for _, p := range periods {
+		durations[p.category] += p.overlap(from, to)
+	}
+
+	known := durations[on] + durations[sleeping] + durations[off]
+
+	history.UptimeSeconds = int64(durations[on].Seconds())
+	history.SleepSeconds = int64(durations[sleeping].Seconds())
+	history.DowntimeSeconds = int64(durations[off].Seconds())
+	history.UnknownSeconds = int64((to.Sub(from) - known).Seconds())
+	history.Availability = share(durations[on], known)
+
+	width := to.Sub(from) / time.Duration(buckets)
+
+	for i := range history.Buckets {
+		start := from.Add(time.Duration(i) * width)
+		end := start.Add(width)
+
+		var upTime, knownTime time.Duration
+
+		for _, p := range periods {
+			d := p.overlap(start, end)
+			if p.category != unknown {
+				knownTime += d
+			}
+
+			if p.category == on {
+				upTime += d
+			}
+		}
+
+		if knownTime > 0 {
+			value := share(upTime, knownTime)
+			history.Buckets[i] = &value
+		}
+	}
+
+	return history
+}
+
+// share returns part/whole rounded to three decimals, 0 for an empty whole.
+func share(part, whole time.Duration) float64 {
+	if whole <= 0 {
+		return 0
+	}
+
+	return math.Round(float64(part)/float64(whole)*1000) / 1000
+}
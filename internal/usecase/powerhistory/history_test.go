@@ -0,0 +1,107 @@
+package powerhistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+)
+
+func ptr(v float64) *float64 {
+	return &v
+}
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(4 * time.Hour)
+
+	at := func(minutes int, powerState int) entity.PowerSample {
+		return entity.PowerSample{GUID: "guid", PowerState: powerState, SampledAt: from.Add(time.Duration(minutes) * time.Minute)}
+	}
+
+	everyHour := func(states ...int) []entity.PowerSample {
+		samples := []entity.PowerSample{}
+		for i, s := range states {
+			samples = append(samples, at(i*60, s))
+		}
+
+		return samples
+	}
+
+	tests := []struct {
+		name         string
+		samples      []entity.PowerSample
+		hold         time.Duration
+		uptime       int64
+		sleep        int64
+		downtime     int64
+		unknown      int64
+		availability float64
+		buckets      []*float64
+	}{
+		{
+			name:    "no samples",
+			hold:    time.Hour,
+			unknown: 4 * 3600,
+			buckets: []*float64{nil, nil, nil, nil},
+		},
+		{
+			name:         "on, sleeping and off",
+			samples:      everyHour(2, 2, 4, 8),
+			hold:         time.Hour,
+			uptime:       2 * 3600,
+			sleep:        3600,
+			downtime:     3600,
+			availability: 0.5,
+			buckets:      []*float64{ptr(1), ptr(1), ptr(0), ptr(0)},
+		},
+		{
+			name:         "unreachable samples count as unknown",
+			samples:      everyHour(2, 0, 0, 2),
+			hold:         time.Hour,
+			uptime:       2 * 3600,
+			unknown:      2 * 3600,
+			availability: 1,
+			buckets:      []*float64{ptr(1), nil, nil, ptr(1)},
+		},
+		{
+			name:         "samples hold no longer than hold",
+			samples:      []entity.PowerSample{at(0, 2), at(180, 8)},
+			hold:         30 * time.Minute,
+			uptime:       1800,
+			downtime:     1800,
+			unknown:      3 * 3600,
+			availability: 0.5,
+			buckets:      []*float64{ptr(1), nil, nil, ptr(0)},
+		},
+		{
+			name:         "state changes within a bucket",
+			samples:      []entity.PowerSample{at(0, 2), at(15, 8), at(30, 2)},
+			hold:         4 * time.Hour,
+			uptime:       4*3600 - 900,
+			downtime:     900,
+			availability: 0.938,
+			buckets:      []*float64{ptr(0.75), ptr(1), ptr(1), ptr(1)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			history := summarize(tc.samples, from, to, tc.hold, 4)
+
+			require.Equal(t, tc.uptime, history.UptimeSeconds)
+			require.Equal(t, tc.sleep, history.SleepSeconds)
+			require.Equal(t, tc.downtime, history.DowntimeSeconds)
+			require.Equal(t, tc.unknown, history.UnknownSeconds)
+			require.InDelta(t, tc.availability, history.Availability, 0)
+			require.Equal(t, tc.buckets, history.Buckets)
+			require.Len(t, history.Samples, len(tc.samples))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package powerhistory
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		Insert(ctx context.Context, s entity.PowerSample) error
+		GetByGUID(ctx context.Context, guid string, from time.Time) ([]entity.PowerSample, error)
+		DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+	}
+
+	// Devices is the part of the devices use case the poller depends on.
+	Devices interface {
+		Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Device, error)
+		GetByID(ctx context.Context, guid, tenantID string, includeSecrets bool) (*dto.Device, error)
+		GetPowerState(ctx context.Context, guid string) (dto.PowerState, error)
+	}
+
+	Feature interface {
+		Start(ctx context.Context)
+		GetHistory(ctx context.Context, guid string, query dto.PowerHistoryQuery) (dto.PowerHistory, error)
+	}
+)
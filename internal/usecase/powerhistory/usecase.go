@@ -0,0 +1,163 @@
+package powerhistory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	devicesPerPage     = 100
+	maxConcurrentPolls = 8
+
+	// defaultHold is how long a sample is taken to hold while the poller is disabled.
+	defaultHold = 10 * time.Minute
+
+	defaultHours   = 24
+	defaultBuckets = 24
+)
+
+// UseCase records the power state of every device at a fixed interval and summarizes it.
+type UseCase struct {
+	repo      Repository
+	devices   Devices
+	log       logger.Interface
+	interval  time.Duration
+	retention time.Duration
+	now       func() time.Time
+}
+
+// New creates the power state poller. An interval of 0 disables polling, a retention of 0 keeps samples forever.
+func New(repo Repository, devices Devices, log logger.Interface, interval, retention time.Duration) *UseCase {
+	return &UseCase{
+		repo:      repo,
+		devices:   devices,
+		log:       log,
+		interval:  interval,
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// Start polls the power states in the background until ctx is done. It does nothing while polling is disabled.
+func (uc *UseCase) Start(ctx context.Context) {
+	if uc.interval <= 0 {
+		return
+	}
+
+	uc.log.Info("powerhistory - Start: recording device power states every %s", uc.interval)
+
+	go func() {
+		ticker := time.NewTicker(uc.interval)
+		defer ticker.Stop()
+
+		for {
+			uc.Poll(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Poll records the power state of every device once and removes the samples older than the retention.
+func (uc *UseCase) Poll(ctx context.Context) {
+	at := uc.now()
+
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, maxConcurrentPolls)
+
+	for skip := 0; ; skip += devicesPerPage {
+		page, err := uc.devices.Get(ctx, devicesPerPage, skip, "")
+		if err != nil {
+			uc.log.Error(err, "powerhistory - Poll - uc.devices.Get")
+
+			break
+		}
+
+		for i := range page {
+			guid := page[i].GUID
+
+			wg.Add(1)
+
+			sem <- struct{}{}
+
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				uc.sample(ctx, guid, at)
+			}()
+		}
+
+		if len(page) < devicesPerPage {
+			break
+		}
+	}
+
+	wg.Wait()
+
+	if uc.retention <= 0 {
+		return
+	}
+
+	if _, err := uc.repo.DeleteBefore(ctx, at.Add(-uc.retention)); err != nil {
+		uc.log.Error(err, "powerhistory - Poll - uc.repo.DeleteBefore")
+	}
+}
+
+// sample records the power state of a device, 0 when it cannot be reached.
+func (uc *UseCase) sample(ctx context.Context, guid string, at time.Time) {
+	// the power state is still returned when only the OS power saving state cannot be read
+	state, err := uc.devices.GetPowerState(ctx, guid)
+	if err != nil && state.PowerState == 0 {
+		uc.log.Debug("powerhistory - sample: power state of device %s unavailable: %v", guid, err)
+	}
+
+	s := entity.PowerSample{GUID: guid, PowerState: state.PowerState, SampledAt: at}
+	if err := uc.repo.Insert(ctx, s); err != nil {
+		uc.log.Error(err, "powerhistory - sample - uc.repo.Insert")
+	}
+}
+
+// GetHistory summarizes the power states of a device over the last query.Hours hours.
+func (uc *UseCase) GetHistory(ctx context.Context, guid string, query dto.PowerHistoryQuery) (dto.PowerHistory, error) {
+	device, err := uc.devices.GetByID(ctx, guid, "", false)
+	if err != nil {
+		return dto.PowerHistory{}, err
+	}
+
+	if query.Hours <= 0 {
+		query.Hours = defaultHours
+	}
+
+	if query.Buckets <= 0 {
+		query.Buckets = defaultBuckets
+	}
+
+	to := uc.now().UTC().Truncate(time.Second)
+	from := to.Add(-time.Duration(query.Hours) * time.Hour)
+
+	samples, err := uc.repo.GetByGUID(ctx, device.GUID, from)
+	if err != nil {
+		return dto.PowerHistory{}, err
+	}
+
+	hold := defaultHold
+	if uc.interval > 0 {
+		hold = 2 * uc.interval
+	}
+
+	history := summarize(samples, from, to, hold, query.Buckets)
+	history.GUID = device.GUID
+
+	return history, nil
+}
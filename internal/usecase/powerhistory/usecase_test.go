@@ -0,0 +1,179 @@
+package powerhistory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/powerhistory"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errUnreachable = errors.New("device unreachable")
+
+func sampleOf(guid string, powerState int) gomock.Matcher {
+	return gomock.Cond(func(s entity.PowerSample) bool {
+		return s.GUID == guid && s.PowerState == powerState && !s.SampledAt.IsZero()
+	})
+}
+
+func TestPoll(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		retention time.Duration
+		mock      func(*mocks.MockPowerHistoryRepository, *mocks.MockPowerHistoryDevices)
+	}{
+		{
+			name:      "records the power state of every device",
+			retention: time.Hour,
+			mock: func(repo *mocks.MockPowerHistoryRepository, d *mocks.MockPowerHistoryDevices) {
+				d.EXPECT().Get(gomock.Any(), 100, 0, "").Return([]dto.Device{{GUID: "guid1"}, {GUID: "guid2"}, {GUID: "guid3"}}, nil)
+				d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 2}, nil)
+				d.EXPECT().GetPowerState(gomock.Any(), "guid2").Return(dto.PowerState{}, errUnreachable)
+				d.EXPECT().GetPowerState(gomock.Any(), "guid3").Return(dto.PowerState{PowerState: 8}, errUnreachable)
+				repo.EXPECT().Insert(gomock.Any(), sampleOf("guid1", 2)).Return(nil)
+				repo.EXPECT().Insert(gomock.Any(), sampleOf("guid2", 0)).Return(nil)
+				repo.EXPECT().Insert(gomock.Any(), sampleOf("guid3", 8)).Return(nil)
+				repo.EXPECT().DeleteBefore(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+			},
+		},
+		{
+			name: "pages through the devices and keeps samples without retention",
+			mock: func(repo *mocks.MockPowerHistoryRepository, d *mocks.MockPowerHistoryDevices) {
+				page := make([]dto.Device, 100)
+				for i := range page {
+					page[i].GUID = "guid"
+				}
+
+				d.EXPECT().Get(gomock.Any(), 100, 0, "").Return(page, nil)
+				d.EXPECT().Get(gomock.Any(), 100, 100, "").Return([]dto.Device{}, nil)
+				d.EXPECT().GetPowerState(gomock.Any(), "guid").Return(dto.PowerState{PowerState: 2}, nil).Times(100)
+				repo.EXPECT().Insert(gomock.Any(), sampleOf("guid", 2)).Return(nil).Times(100)
+			},
+		},
+		{
+			name:      "stops when the devices cannot be listed",
+			retention: time.Hour,
+			mock: func(repo *mocks.MockPowerHistoryRepository, d *mocks.MockPowerHistoryDevices) {
+				d.EXPECT().Get(gomock.Any(), 100, 0, "").Return(nil, errUnreachable)
+				repo.EXPECT().DeleteBefore(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := mocks.NewMockPowerHistoryRepository(ctrl)
+			d := mocks.NewMockPowerHistoryDevices(ctrl)
+
+			tc.mock(repo, d)
+
+			uc := powerhistory.New(repo, d, logger.New("error"), time.Minute, tc.retention)
+			uc.Poll(context.Background())
+		})
+	}
+}
+
+func TestGetHistory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query dto.PowerHistoryQuery
+		mock  func(*mocks.MockPowerHistoryRepository, *mocks.MockPowerHistoryDevices)
+		check func(*testing.T, dto.PowerHistory)
+		err   error
+	}{
+		{
+			name:  "summarizes the samples of the device",
+			query: dto.PowerHistoryQuery{Hours: 1, Buckets: 4},
+			mock: func(repo *mocks.MockPowerHistoryRepository, d *mocks.MockPowerHistoryDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "GUID1", "", false).Return(&dto.Device{GUID: "guid1"}, nil)
+				repo.EXPECT().GetByGUID(gomock.Any(), "guid1", gomock.Any()).
+					DoAndReturn(func(_ context.Context, guid string, from time.Time) ([]entity.PowerSample, error) {
+						return []entity.PowerSample{{GUID: guid, PowerState: 2, SampledAt: from.Add(30 * time.Minute)}}, nil
+					})
+			},
+			check: func(t *testing.T, h dto.PowerHistory) {
+				t.Helper()
+
+				require.Equal(t, "guid1", h.GUID)
+				require.Equal(t, time.Hour, h.To.Sub(h.From))
+				require.Equal(t, int64(10*60), h.UptimeSeconds)
+				require.Equal(t, int64(50*60), h.UnknownSeconds)
+				require.InDelta(t, 1.0, h.Availability, 0)
+				require.Len(t, h.Buckets, 4)
+				require.Nil(t, h.Buckets[0])
+				require.NotNil(t, h.Buckets[2])
+				require.Len(t, h.Samples, 1)
+			},
+		},
+		{
+			name: "defaults the window and buckets",
+			mock: func(repo *mocks.MockPowerHistoryRepository, d *mocks.MockPowerHistoryDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "GUID1", "", false).Return(&dto.Device{GUID: "guid1"}, nil)
+				repo.EXPECT().GetByGUID(gomock.Any(), "guid1", gomock.Any()).Return([]entity.PowerSample{}, nil)
+			},
+			check: func(t *testing.T, h dto.PowerHistory) {
+				t.Helper()
+
+				require.Equal(t, 24*time.Hour, h.To.Sub(h.From))
+				require.Len(t, h.Buckets, 24)
+				require.Equal(t, int64(24*60*60), h.UnknownSeconds)
+			},
+		},
+		{
+			name: "unknown device",
+			mock: func(_ *mocks.MockPowerHistoryRepository, d *mocks.MockPowerHistoryDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "GUID1", "", false).Return(nil, devices.ErrNotFound)
+			},
+			err: devices.ErrNotFound,
+		},
+		{
+			name: "repository error",
+			mock: func(repo *mocks.MockPowerHistoryRepository, d *mocks.MockPowerHistoryDevices) {
+				d.EXPECT().GetByID(gomock.Any(), "GUID1", "", false).Return(&dto.Device{GUID: "guid1"}, nil)
+				repo.EXPECT().GetByGUID(gomock.Any(), "guid1", gomock.Any()).Return(nil, errUnreachable)
+			},
+			err: errUnreachable,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := mocks.NewMockPowerHistoryRepository(ctrl)
+			d := mocks.NewMockPowerHistoryDevices(ctrl)
+
+			tc.mock(repo, d)
+
+			// samples hold for twice the interval
+			uc := powerhistory.New(repo, d, logger.New("error"), 5*time.Minute, 0)
+
+			history, err := uc.GetHistory(context.Background(), "GUID1", tc.query)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			tc.check(t, history)
+		})
+	}
+}
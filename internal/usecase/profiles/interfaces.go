@@ -3,6 +3,8 @@ package profiles
 import (
 	"context"
 
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/config"
+
 	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 )
@@ -17,13 +19,23 @@ type (
 		Insert(ctx context.Context, p *entity.Profile) (string, error)
 	}
 
+	// Devices is the part of the device management use case the TLS settings of a profile are checked through.
+	Devices interface {
+		GetByTags(ctx context.Context, tags, method string, limit, offset int, tenantID string) ([]dto.Device, error)
+		GetTLSSettingData(c context.Context, guid string) ([]dto.SettingDataResponse, error)
+	}
+
 	Feature interface {
 		GetCount(ctx context.Context, tenantID string) (int, error)
 		Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Profile, error)
 		GetByName(ctx context.Context, profileName, tenantID string) (*dto.Profile, error)
 		Delete(ctx context.Context, profileName, tenantID string) error
+		PlanDelete(ctx context.Context, profileName, tenantID string) (dto.DryRunResult, error)
 		Update(ctx context.Context, p *dto.Profile) (*dto.Profile, error)
 		Insert(ctx context.Context, p *dto.Profile) (*dto.Profile, error)
 		Export(ctx context.Context, profileName, domainName, tenantID string) (string, string, error)
+		Configuration(ctx context.Context, profileName, domainName, tenantID string) (config.Configuration, error)
+		TLSEnforcement(ctx context.Context, profileName, tenantID string) (dto.TLSSettingsRequest, []dto.Device, error)
+		GetTLSCompliance(ctx context.Context, profileName, tenantID string) (dto.TLSComplianceReport, error)
 	}
 )
@@ -0,0 +1,203 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+const (
+	devicesPerPage     = 100
+	maxConcurrentCalls = 8
+)
+
+var (
+	errNoTLS  = errors.New("the profile does not configure TLS")
+	errNoTags = errors.New("the profile has no tags to select its devices")
+)
+
+// SetDevices lets the use case read and check the devices the profiles are applied to.
+func (uc *UseCase) SetDevices(d Devices) {
+	uc.devices = d
+}
+
+// TLSEnforcement returns the TLS settings a profile requires of its devices, mutual authentication for the
+// mutual TLS modes and the trusted common names, and the devices, selected by the tags of the profile, they
+// apply to.
+func (uc *UseCase) TLSEnforcement(ctx context.Context, profileName, tenantID string) (dto.TLSSettingsRequest, []dto.Device, error) {
+	p, err := uc.repo.GetByName(ctx, profileName, tenantID)
+	if err != nil {
+		return dto.TLSSettingsRequest{}, nil, ErrDatabase.Wrap("TLSEnforcement", "uc.repo.GetByName", err)
+	}
+
+	if p == nil {
+		return dto.TLSSettingsRequest{}, nil, ErrNotFound
+	}
+
+	if p.TLSMode == 0 {
+		return dto.TLSSettingsRequest{}, nil, ErrNotValid.Wrap("TLSEnforcement", "TLSMode", errNoTLS)
+	}
+
+	targets, err := uc.tagged(ctx, p)
+	if err != nil {
+		return dto.TLSSettingsRequest{}, nil, err
+	}
+
+	settings := dto.TLSSettingsRequest{
+		MutualAuthentication: p.TLSMode == 3 || p.TLSMode == 4,
+		TrustedCNs:           splitCNs(p.TLSTrustedCNs),
+	}
+
+	return settings, targets, nil
+}
+
+// GetTLSCompliance checks the TLS settings of the devices of a profile and reports those not enforcing the
+// settings of the profile.
+func (uc *UseCase) GetTLSCompliance(ctx context.Context, profileName, tenantID string) (dto.TLSComplianceReport, error) {
+	settings, targets, err := uc.TLSEnforcement(ctx, profileName, tenantID)
+	if err != nil {
+		return dto.TLSComplianceReport{}, err
+	}
+
+	reasons := make([]string, len(targets))
+
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, maxConcurrentCalls)
+
+	for i := range targets {
+		wg.Add(1)
+
+		sem <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			reasons[i] = uc.checkTLS(ctx, targets[i].GUID, settings)
+		}()
+	}
+
+	wg.Wait()
+
+	report := dto.TLSComplianceReport{
+		Profile:              profileName,
+		MutualAuthentication: settings.MutualAuthentication,
+		TrustedCNs:           settings.TrustedCNs,
+		Total:                len(targets),
+		Devices:              []dto.TLSComplianceDevice{},
+	}
+
+	for i := range targets {
+		if reasons[i] == "" {
+			report.Compliant++
+
+			continue
+		}
+
+		report.Devices = append(report.Devices, dto.TLSComplianceDevice{
+			GUID:     targets[i].GUID,
+			Hostname: targets[i].Hostname,
+			Reason:   reasons[i],
+		})
+	}
+
+	return report, nil
+}
+
+// tagged returns the devices of the tenant with any of the tags of the profile.
+func (uc *UseCase) tagged(ctx context.Context, p *entity.Profile) ([]dto.Device, error) {
+	tags := []string{}
+
+	for _, tag := range strings.Split(p.Tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil, ErrNotValid.Wrap("TLSEnforcement", "Tags", errNoTags)
+	}
+
+	result := []dto.Device{}
+
+	for skip := 0; ; skip += devicesPerPage {
+		page, err := uc.devices.GetByTags(ctx, strings.Join(tags, ","), "OR", devicesPerPage, skip, p.TenantID)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, page...)
+
+		if len(page) < devicesPerPage {
+			return result, nil
+		}
+	}
+}
+
+// checkTLS returns why the remote TLS interface of a device does not enforce settings, empty when it does.
+func (uc *UseCase) checkTLS(ctx context.Context, guid string, settings dto.TLSSettingsRequest) string {
+	response, err := uc.devices.GetTLSSettingData(ctx, guid)
+	if err != nil {
+		return "TLS settings unavailable: " + err.Error()
+	}
+
+	i := slices.IndexFunc(response, func(s dto.SettingDataResponse) bool { return s.InstanceID == devices.RemoteTLSInstanceID })
+	if i < 0 || !response[i].Enabled {
+		return "TLS is not enabled"
+	}
+
+	if settings.MutualAuthentication && !response[i].MutualAuthentication {
+		return "mutual authentication is disabled"
+	}
+
+	if !settings.MutualAuthentication && response[i].MutualAuthentication {
+		return "mutual authentication is enabled"
+	}
+
+	if !sameCNs(settings.TrustedCNs, response[i].TrustedCN) {
+		return "the trusted common names differ"
+	}
+
+	return ""
+}
+
+// splitCNs returns the trusted common names stored comma separated, nil for none.
+func splitCNs(cns string) []string {
+	if cns == "" {
+		return nil
+	}
+
+	result := strings.Split(cns, ",")
+	for i := range result {
+		result[i] = strings.TrimSpace(result[i])
+	}
+
+	return result
+}
+
+// sameCNs reports whether two lists hold the same common names, in any order and case.
+func sameCNs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	normalize := func(cns []string) []string {
+		result := make([]string, len(cns))
+		for i := range cns {
+			result[i] = strings.ToLower(cns[i])
+		}
+
+		slices.Sort(result)
+
+		return result
+	}
+
+	return slices.Equal(normalize(a), normalize(b))
+}
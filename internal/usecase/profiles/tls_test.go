@@ -0,0 +1,85 @@
+package profiles_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/profiles"
+)
+
+var errUnreachable = errors.New("device unreachable")
+
+func remoteTLS(mutual bool, cns ...string) []dto.SettingDataResponse {
+	return []dto.SettingDataResponse{{InstanceID: devices.RemoteTLSInstanceID, Enabled: true, MutualAuthentication: mutual, TrustedCN: cns}}
+}
+
+func TestGetTLSCompliance(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockProfilesRepository(mockCtl)
+	devicesMock := mocks.NewMockProfilesDevices(mockCtl)
+
+	useCase := profiles.New(repo, nil, nil, nil, nil, nil, nil, nil)
+	useCase.SetDevices(devicesMock)
+
+	repo.EXPECT().GetByName(gomock.Any(), "office", "").Return(&entity.Profile{
+		ProfileName:   "office",
+		Tags:          "floor1, floor2",
+		TLSMode:       3,
+		TLSTrustedCNs: "console.example.com,Backup.example.com",
+	}, nil)
+	devicesMock.EXPECT().GetByTags(gomock.Any(), "floor1,floor2", "OR", 100, 0, "").Return([]dto.Device{
+		{GUID: "guid1", Hostname: "pc1"},
+		{GUID: "guid2", Hostname: "pc2"},
+		{GUID: "guid3", Hostname: "pc3"},
+		{GUID: "guid4", Hostname: "pc4"},
+		{GUID: "guid5", Hostname: "pc5"},
+	}, nil)
+	devicesMock.EXPECT().GetTLSSettingData(gomock.Any(), "guid1").Return(remoteTLS(true, "backup.example.com", "console.example.com"), nil)
+	devicesMock.EXPECT().GetTLSSettingData(gomock.Any(), "guid2").Return(remoteTLS(false), nil)
+	devicesMock.EXPECT().GetTLSSettingData(gomock.Any(), "guid3").Return(remoteTLS(true, "console.example.com"), nil)
+	devicesMock.EXPECT().GetTLSSettingData(gomock.Any(), "guid4").Return([]dto.SettingDataResponse{{InstanceID: devices.RemoteTLSInstanceID}}, nil)
+	devicesMock.EXPECT().GetTLSSettingData(gomock.Any(), "guid5").Return(nil, errUnreachable)
+
+	report, err := useCase.GetTLSCompliance(context.Background(), "office", "")
+	require.NoError(t, err)
+	require.Equal(t, dto.TLSComplianceReport{
+		Profile:              "office",
+		MutualAuthentication: true,
+		TrustedCNs:           []string{"console.example.com", "Backup.example.com"},
+		Total:                5,
+		Compliant:            1,
+		Devices: []dto.TLSComplianceDevice{
+			{GUID: "guid2", Hostname: "pc2", Reason: "mutual authentication is disabled"},
+			{GUID: "guid3", Hostname: "pc3", Reason: "the trusted common names differ"},
+			{GUID: "guid4", Hostname: "pc4", Reason: "TLS is not enabled"},
+			{GUID: "guid5", Hostname: "pc5", Reason: "TLS settings unavailable: device unreachable"},
+		},
+	}, report)
+}
+
+func TestTLSEnforcementValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]entity.Profile{
+		"no TLS":  {ProfileName: "office", Tags: "floor1"},
+		"no tags": {ProfileName: "office", Tags: " ", TLSMode: 1},
+	}
+
+	for name, p := range tests {
+		repo := mocks.NewMockProfilesRepository(gomock.NewController(t))
+		repo.EXPECT().GetByName(gomock.Any(), "office", "").Return(&p, nil)
+
+		_, _, err := profiles.New(repo, nil, nil, nil, nil, nil, nil, nil).TLSEnforcement(context.Background(), "office", "")
+		require.IsType(t, profiles.ErrNotValid, err, name)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/device-management-toolkit/console/internal/usecase/ieee8021xconfigs"
 	"github.com/device-management-toolkit/console/internal/usecase/profilewificonfigs"
 	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
 	"github.com/device-management-toolkit/console/internal/usecase/wificonfigs"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/logger"
@@ -33,6 +34,7 @@ type UseCase struct {
 	log               logger.Interface
 	domains           domains.Feature
 	safeRequirements  security.Cryptor
+	devices           Devices
 }
 
 var (
@@ -342,6 +344,7 @@ func (uc *UseCase) BuildConfigurationObject(profileName string, data *entity.Pro
 				MutualAuthentication: data.TLSMode == 3 || data.TLSMode == 4,
 				Enabled:              data.TLSMode >= 1,
 				AllowNonTLS:          data.TLSMode == 2 || data.TLSMode == 4,
+				TrustedCN:            splitCNs(data.TLSTrustedCNs),
 			},
 			EnterpriseAssistant: config.EnterpriseAssistant{
 				URL:      local.ConsoleConfig.EA.URL,
@@ -380,45 +383,61 @@ func (uc *UseCase) SerializeAndEncryptYAML(configuration config.Configuration) (
 
 // Export - will call GetByName and return the profile with the associated wifi configs in YAML format to be downloaded.
 func (uc *UseCase) Export(ctx context.Context, profileName, domainName, tenantID string) (encryptedYAML, encryptionKey string, err error) {
-	data, err := uc.GetProfileData(ctx, profileName, tenantID)
+	configuration, err := uc.Configuration(ctx, profileName, domainName, tenantID)
+	if err != nil {
+		return "", "", err
+	}
+
+	encryptedYAML, encryptionKey, err = uc.SerializeAndEncryptYAML(configuration)
 	if err != nil {
 		return "", "", err
 	}
 
+	return encryptedYAML, encryptionKey, nil
+}
+
+// Configuration returns the profile as the configuration of an activation, with its passwords in clear and the
+// provisioning certificate of the domain domainName for a profile activating in admin control mode.
+func (uc *UseCase) Configuration(ctx context.Context, profileName, domainName, tenantID string) (config.Configuration, error) {
+	data, err := uc.GetProfileData(ctx, profileName, tenantID)
+	if err != nil {
+		return config.Configuration{}, err
+	}
+
 	if data == nil {
-		return "", "", ErrNotFound
+		return config.Configuration{}, ErrNotFound
 	}
 
 	err = uc.DecryptPasswords(data)
 	if err != nil {
-		return "", "", err
+		return config.Configuration{}, err
 	}
 
 	domainStuff, err := uc.GetDomainInformation(ctx, data.Activation, domainName, tenantID)
 	if err != nil {
-		return "", "", err
+		return config.Configuration{}, err
 	}
 
 	wifiConfigs, err := uc.GetWiFiConfigurations(ctx, profileName, tenantID)
 	if err != nil {
-		return "", "", err
+		return config.Configuration{}, err
 	}
 
 	wifiProfiles, err := uc.BuildWirelessProfiles(ctx, wifiConfigs, tenantID)
 	if err != nil {
-		return "", "", err
+		return config.Configuration{}, err
 	}
 
 	var cira *entity.CIRAConfig
 	if data.CIRAConfigName != nil && *data.CIRAConfigName != "" {
 		cira, err = uc.cira.GetByName(ctx, *data.CIRAConfigName, tenantID)
 		if err != nil {
-			return "", "", err
+			return config.Configuration{}, err
 		}
 
 		cira.Password, err = uc.safeRequirements.Decrypt(cira.Password)
 		if err != nil {
-			return "", "", err
+			return config.Configuration{}, err
 		}
 	}
 
@@ -426,15 +445,10 @@ func (uc *UseCase) Export(ctx context.Context, profileName, domainName, tenantID
 
 	err = uc.HandleIEEE8021xSettings(ctx, data, &configuration, tenantID)
 	if err != nil {
-		return "", "", err
-	}
-
-	encryptedYAML, encryptionKey, err = uc.SerializeAndEncryptYAML(configuration)
-	if err != nil {
-		return "", "", err
+		return config.Configuration{}, err
 	}
 
-	return encryptedYAML, encryptionKey, nil
+	return configuration, nil
 }
 
 func (uc *UseCase) Delete(ctx context.Context, profileName, tenantID string) error {
@@ -456,6 +470,33 @@ func (uc *UseCase) Delete(ctx context.Context, profileName, tenantID string) err
 	return nil
 }
 
+// PlanDelete returns what Delete would do to the profile without deleting it.
+func (uc *UseCase) PlanDelete(ctx context.Context, profileName, tenantID string) (dto.DryRunResult, error) {
+	data, err := uc.repo.GetByName(ctx, profileName, tenantID)
+	if err != nil {
+		return dto.DryRunResult{}, ErrDatabase.Wrap("PlanDelete", "uc.repo.GetByName", err)
+	}
+
+	if data == nil {
+		return dto.DryRunResult{}, ErrNotFound
+	}
+
+	wifiConfigs, err := uc.profileWifiConfig.GetByProfileName(ctx, profileName, tenantID)
+	if err != nil {
+		return dto.DryRunResult{}, ErrDatabase.Wrap("PlanDelete", "uc.profileWifiConfig.GetByProfileName", err)
+	}
+
+	actions := make([]dto.PlannedAction, 0, len(wifiConfigs)+1)
+
+	for _, wifiConfig := range wifiConfigs {
+		actions = append(actions, dto.PlannedAction{Target: profileName, Action: "remove wireless profile " + wifiConfig.WirelessProfileName})
+	}
+
+	actions = append(actions, dto.PlannedAction{Target: profileName, Action: "delete the profile"})
+
+	return dto.DryRunResult{Operation: "delete profile", Actions: actions}, nil
+}
+
 func (uc *UseCase) isWifiProfileExists(ctx context.Context, d *dto.Profile, action string) error {
 	if len(d.WiFiConfigs) > 0 {
 		// check if the wireless profile is exists in the database
@@ -633,6 +674,7 @@ func (uc *UseCase) dtoToEntity(d *dto.Profile) *entity.Profile {
 		TenantID:                   d.TenantID,
 		TLSMode:                    d.TLSMode,
 		TLSSigningAuthority:        d.TLSSigningAuthority,
+		TLSTrustedCNs:              strings.Join(d.TLSTrustedCNs, ","),
 		UserConsent:                d.UserConsent,
 		IDEREnabled:                d.IDEREnabled,
 		KVMEnabled:                 d.KVMEnabled,
@@ -642,8 +684,8 @@ func (uc *UseCase) dtoToEntity(d *dto.Profile) *entity.Profile {
 		UEFIWiFiSyncEnabled:        d.UEFIWiFiSyncEnabled,
 	}
 
-	d1.AMTPassword, _ = uc.safeRequirements.Encrypt(d.AMTPassword)
-	d1.MEBXPassword, _ = uc.safeRequirements.Encrypt(d.MEBXPassword)
+	d1.AMTPassword, _ = tenantkeys.Encrypt(uc.safeRequirements, d.TenantID, d.AMTPassword)
+	d1.MEBXPassword, _ = tenantkeys.Encrypt(uc.safeRequirements, d.TenantID, d.MEBXPassword)
 
 	return d1
 }
@@ -669,6 +711,7 @@ func (uc *UseCase) entityToDTO(d *entity.Profile) *dto.Profile {
 		TenantID:                   d.TenantID,
 		TLSMode:                    d.TLSMode,
 		TLSSigningAuthority:        d.TLSSigningAuthority,
+		TLSTrustedCNs:              splitCNs(d.TLSTrustedCNs),
 		UserConsent:                d.UserConsent,
 		IDEREnabled:                d.IDEREnabled,
 		KVMEnabled:                 d.KVMEnabled,
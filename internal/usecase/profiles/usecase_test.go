@@ -321,6 +321,31 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestPlanDelete(t *testing.T) {
+	t.Parallel()
+
+	useCase, repo, _, pwfFeat := profilesTest(t)
+
+	repo.EXPECT().GetByName(context.Background(), "example-profile", "tenant-id-456").Return(&entity.Profile{ProfileName: "example-profile"}, nil)
+	pwfFeat.EXPECT().GetByProfileName(context.Background(), "example-profile", "tenant-id-456").
+		Return([]dto.ProfileWiFiConfigs{{WirelessProfileName: "office", ProfileName: "example-profile"}}, nil)
+	repo.EXPECT().GetByName(context.Background(), "nonexistent-profile", "tenant-id-456").Return(nil, nil)
+
+	// nothing is deleted
+	result, err := useCase.PlanDelete(context.Background(), "example-profile", "tenant-id-456")
+	require.NoError(t, err)
+	require.Equal(t, dto.DryRunResult{
+		Operation: "delete profile",
+		Actions: []dto.PlannedAction{
+			{Target: "example-profile", Action: "remove wireless profile office"},
+			{Target: "example-profile", Action: "delete the profile"},
+		},
+	}, result)
+
+	_, err = useCase.PlanDelete(context.Background(), "nonexistent-profile", "tenant-id-456")
+	require.Equal(t, profiles.ErrNotFound, err)
+}
+
 func TestUpdate(t *testing.T) {
 	t.Parallel()
 
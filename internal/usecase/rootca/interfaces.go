@@ -0,0 +1,23 @@
+package rootca
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	// Devices lists the devices and manages the certificates they trust.
+	Devices interface {
+		Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Device, error)
+		GetCertificates(c context.Context, guid string) (dto.SecuritySettings, error)
+		AddCertificate(c context.Context, guid string, certInfo dto.CertInfo) (string, error)
+	}
+
+	Feature interface {
+		Get(ctx context.Context) (dto.RootCA, error)
+		Regenerate(ctx context.Context) (dto.RootCertificate, error)
+		CrossSign(ctx context.Context) (dto.RootCACrossSigned, error)
+		Rollover(ctx context.Context, force bool) (dto.RootCARollover, error)
+	}
+)
@@ -0,0 +1,366 @@
+// Package rootca manages the root certificate of the console, which issues the certificate of the
+// MPS web server the devices connecting over CIRA trust. A new root is generated as pending, then
+// rolled over to: it is added to the trusted roots of every device, and only once all of them trust
+// it does it re-issue the web server certificate and replace the current root, which is kept as the
+// previous one. The MPS serves the new web server certificate after the console restarts.
+//
+// The certificates are kept in the secret store when it holds objects, and in PEM files next to the
+// configuration, where the MPS reads them. A root key kept on a token or in a KMS is only signed with,
+// it is rotated there rather than regenerated by the console.
+package rootca
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+
+	"github.com/device-management-toolkit/console/internal/certificates"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	country      = "US"
+	organization = "device-management-toolkit"
+
+	devicesPerPage     = 100
+	maxConcurrentCalls = 8
+)
+
+// Names of the certificates in the secret store, under certs/.
+const (
+	currentName  = "root"
+	pendingName  = "root-pending"
+	previousName = "root-previous"
+)
+
+var (
+	ErrRootCAUseCase = consoleerrors.CreateConsoleError("RootCAUseCase")
+	ErrNotFound      = sqldb.NotFoundError{Console: ErrRootCAUseCase}
+	ErrValidation    = dto.NotValidError{Console: ErrRootCAUseCase}
+
+	ErrExternalRootKey = errors.New("the root key is kept outside the console, rotate it on its token or KMS")
+)
+
+// UseCase -.
+type UseCase struct {
+	store      security.Storager
+	devices    Devices
+	dir        string
+	commonName string
+	alg        certificates.KeyAlgorithm
+	rootKey    crypto.Signer // nil when the console holds the root key
+	log        logger.Interface
+
+	mu sync.Mutex // Serializes the changes of the roots
+}
+
+// New manages the root certificate kept in store and in dir, issuing the web server certificate of commonName.
+// The certificates generated have keys of alg. rootKey is the key of the current root when it is kept on a token or
+// in a KMS, nil otherwise.
+func New(store security.Storager, devices Devices, dir, commonName string, alg certificates.KeyAlgorithm, rootKey crypto.Signer, log logger.Interface) *UseCase {
+	return &UseCase{
+		store:      store,
+		devices:    devices,
+		dir:        dir,
+		commonName: commonName,
+		alg:        alg,
+		rootKey:    rootKey,
+		log:        log,
+	}
+}
+
+// Get returns the current root certificate, with the pending and the previous ones when there are.
+func (uc *UseCase) Get(_ context.Context) (dto.RootCA, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	current, _, err := uc.load(currentName)
+	if err != nil {
+		return dto.RootCA{}, ErrNotFound.WrapWithMessage("Get", "uc.load", "the console has no root certificate")
+	}
+
+	d := dto.RootCA{Current: certificates.RootCertificateInfo(current)}
+
+	if pending, _, err := uc.load(pendingName); err == nil {
+		d.Pending = certificates.RootCertificateInfo(pending)
+	}
+
+	if previous, _, err := uc.load(previousName); err == nil {
+		d.Previous = certificates.RootCertificateInfo(previous)
+	}
+
+	return d, nil
+}
+
+// Regenerate generates a new root certificate as the pending one, replacing the pending root not rolled over to.
+func (uc *UseCase) Regenerate(_ context.Context) (dto.RootCertificate, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if uc.rootKey != nil {
+		return dto.RootCertificate{}, ErrValidation.Wrap("Regenerate", "uc.rootKey", ErrExternalRootKey)
+	}
+
+	cert, key, err := certificates.NewRootCertificate(true, uc.commonName, country, organization, uc.alg)
+	if err != nil {
+		return dto.RootCertificate{}, ErrRootCAUseCase.Wrap("Regenerate", "certificates.NewRootCertificate", err)
+	}
+
+	if err := uc.save(pendingName, cert, key); err != nil {
+		return dto.RootCertificate{}, ErrRootCAUseCase.Wrap("Regenerate", "uc.save", err)
+	}
+
+	uc.log.Info("root certificate %s generated, pending rollover", cert.SerialNumber.Text(16))
+
+	return *certificates.RootCertificateInfo(cert), nil
+}
+
+// CrossSign signs the pending root with the current one and the current root with the pending one.
+func (uc *UseCase) CrossSign(_ context.Context) (dto.RootCACrossSigned, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	current, currentKey, err := uc.load(currentName)
+	if err != nil {
+		return dto.RootCACrossSigned{}, ErrNotFound.WrapWithMessage("CrossSign", "uc.load", "the console has no root certificate")
+	}
+
+	pending, pendingKey, err := uc.load(pendingName)
+	if err != nil {
+		return dto.RootCACrossSigned{}, ErrNotFound.WrapWithMessage("CrossSign", "uc.load", "no root certificate is pending rollover")
+	}
+
+	pendingByCurrent, err := certificates.CrossSign(pending, certificates.CertAndKeyType{Cert: current, Key: currentKey})
+	if err != nil {
+		return dto.RootCACrossSigned{}, ErrRootCAUseCase.Wrap("CrossSign", "certificates.CrossSign", err)
+	}
+
+	currentByPending, err := certificates.CrossSign(current, certificates.CertAndKeyType{Cert: pending, Key: pendingKey})
+	if err != nil {
+		return dto.RootCACrossSigned{}, ErrRootCAUseCase.Wrap("CrossSign", "certificates.CrossSign", err)
+	}
+
+	return dto.RootCACrossSigned{
+		PendingByCurrent: encodePEM(pendingByCurrent),
+		CurrentByPending: encodePEM(currentByPending),
+	}, nil
+}
+
+// Rollover adds the pending root to the trusted roots of the devices not trusting it yet. Once every device
+// trusts it, or when forced, the web server certificate is re-issued by the pending root, which replaces the
+// current one. A rollover that did not complete is retried by calling it again.
+func (uc *UseCase) Rollover(ctx context.Context, force bool) (dto.RootCARollover, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	pending, pendingKey, err := uc.load(pendingName)
+	if err != nil {
+		return dto.RootCARollover{}, ErrNotFound.WrapWithMessage("Rollover", "uc.load", "no root certificate is pending rollover")
+	}
+
+	items := []dto.Device{}
+
+	for skip := 0; ; skip += devicesPerPage {
+		page, err := uc.devices.Get(ctx, devicesPerPage, skip, "")
+		if err != nil {
+			return dto.RootCARollover{}, err
+		}
+
+		items = append(items, page...)
+
+		if len(page) < devicesPerPage {
+			break
+		}
+	}
+
+	trusting := make([]bool, len(items))
+	errs := make([]error, len(items))
+
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, maxConcurrentCalls)
+
+	for i := range items {
+		wg.Add(1)
+
+		sem <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			trusting[i], errs[i] = uc.push(ctx, items[i].GUID, pending)
+		}()
+	}
+
+	wg.Wait()
+
+	d := dto.RootCARollover{
+		Devices: len(items),
+		Pushed:  []string{},
+		Failed:  []dto.RootCARolloverFailure{},
+	}
+
+	for i := range items {
+		switch {
+		case errs[i] != nil:
+			d.Failed = append(d.Failed, dto.RootCARolloverFailure{GUID: items[i].GUID, Hostname: items[i].Hostname, Error: errs[i].Error()})
+		case trusting[i]:
+			d.Trusting++
+		default:
+			d.Pushed = append(d.Pushed, items[i].GUID)
+		}
+	}
+
+	if len(d.Failed) > 0 && !force {
+		uc.log.Warn("root certificate rollover is not completed: %d of %d devices do not trust the new root", len(d.Failed), d.Devices)
+
+		return d, nil
+	}
+
+	if err := uc.promote(pending, pendingKey); err != nil {
+		return d, ErrRootCAUseCase.Wrap("Rollover", "uc.promote", err)
+	}
+
+	d.Completed = true
+	d.Current = certificates.RootCertificateInfo(pending)
+
+	uc.log.Warn("root certificate rolled over to %s, restart the console for the MPS to serve the new web server certificate", pending.SerialNumber.Text(16))
+
+	return d, nil
+}
+
+// push adds root to the trusted roots of a device, reporting whether the device trusted it already.
+func (uc *UseCase) push(ctx context.Context, guid string, root *x509.Certificate) (bool, error) {
+	settings, err := uc.devices.GetCertificates(ctx, guid)
+	if err != nil {
+		return false, err
+	}
+
+	for _, cert := range settings.CertificateResponse.Certificates {
+		if !cert.TrustedRootCertificate {
+			continue
+		}
+
+		der, err := base64.StdEncoding.DecodeString(cert.X509Certificate)
+		if err == nil && bytes.Equal(der, root.Raw) {
+			return true, nil
+		}
+	}
+
+	if _, err := uc.devices.AddCertificate(ctx, guid, dto.CertInfo{Cert: base64.StdEncoding.EncodeToString(root.Raw), IsTrusted: true}); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// promote issues the web server certificate with the pending root and makes it the current root, the current
+// root being kept as the previous one.
+func (uc *UseCase) promote(pending *x509.Certificate, pendingKey crypto.Signer) error {
+	webCert, webKey, err := certificates.NewWebServerCertificate(certificates.CertAndKeyType{Cert: pending, Key: pendingKey},
+		false, uc.commonName, country, organization, uc.alg)
+	if err != nil {
+		return err
+	}
+
+	if current, currentKey, err := uc.load(currentName); err == nil {
+		if err := uc.save(previousName, current, currentKey); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.save(currentName, pending, pendingKey); err != nil {
+		return err
+	}
+
+	if err := uc.save("webserver-"+uc.commonName, webCert, webKey); err != nil {
+		return err
+	}
+
+	uc.remove(pendingName)
+
+	return nil
+}
+
+// load reads a certificate from the secret store, or from its files when the store does not hold it. The current
+// root is read with the external root key when there is one.
+func (uc *UseCase) load(name string) (*x509.Certificate, crypto.Signer, error) {
+	certPath, keyPath := uc.paths(name)
+
+	if name == currentName && uc.rootKey != nil {
+		cert, err := certificates.LoadCertificateWithKey(uc.store, name, certPath, uc.rootKey)
+
+		return cert, uc.rootKey, err
+	}
+
+	return certificates.LoadCertificate(uc.store, name, certPath, keyPath)
+}
+
+// save writes a certificate to its files and to the secret store when it holds objects.
+func (uc *UseCase) save(name string, cert *x509.Certificate, key crypto.Signer) error {
+	certPath, keyPath := uc.paths(name)
+
+	if err := certificates.SaveCertificateToFiles(cert, key, certPath, keyPath); err != nil {
+		return err
+	}
+
+	if uc.store == nil {
+		return nil
+	}
+
+	if err := certificates.SaveCertificateToStore(uc.store, name, cert, key); err != nil && !errors.Is(err, certificates.ErrStoreNotObjectStorage) {
+		return err
+	}
+
+	return nil
+}
+
+// remove deletes a certificate from its files and the secret store.
+func (uc *UseCase) remove(name string) {
+	certPath, keyPath := uc.paths(name)
+
+	for _, path := range []string{certPath, keyPath} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			uc.log.Warn("rootca - remove - %s: %v", path, err)
+		}
+	}
+
+	if uc.store != nil {
+		if err := uc.store.DeleteKeyValue("certs/" + name); err != nil {
+			uc.log.Debug("rootca - remove - certs/%s: %v", name, err)
+		}
+	}
+}
+
+// paths returns the files of a certificate: root_cert.pem for the current root, {common name}_cert.pem for the
+// web server certificate, as the MPS reads them.
+func (uc *UseCase) paths(name string) (certPath, keyPath string) {
+	base := map[string]string{
+		currentName:  "root",
+		pendingName:  "root_pending",
+		previousName: "root_previous",
+	}[name]
+
+	if base == "" {
+		base = uc.commonName
+	}
+
+	return filepath.Join(uc.dir, base+"_cert.pem"), filepath.Join(uc.dir, base+"_key.pem")
+}
+
+func encodePEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
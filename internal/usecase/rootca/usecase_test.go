@@ -0,0 +1,222 @@
+package rootca_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/certificates"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/rootca"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errNotConnected = errors.New("device is not connected")
+
+// setup returns a use case keeping the roots in a directory holding a current root.
+func setup(t *testing.T) (*rootca.UseCase, *mocks.MockRootCADevices, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	cert, key, err := certificates.NewRootCertificate(false, "console", "US", "device-management-toolkit", certificates.KeyRSA2048)
+	require.NoError(t, err)
+	require.NoError(t, certificates.SaveCertificateToFiles(cert, key, filepath.Join(dir, "root_cert.pem"), filepath.Join(dir, "root_key.pem")))
+
+	mockCtl := gomock.NewController(t)
+	devices := mocks.NewMockRootCADevices(mockCtl)
+
+	return rootca.New(nil, devices, dir, "console", certificates.KeyECDSAP256, nil, logger.New("error")), devices, dir
+}
+
+func parsePEM(t *testing.T, data string) *x509.Certificate {
+	t.Helper()
+
+	block, _ := pem.Decode([]byte(data))
+	require.NotNil(t, block)
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	uc := rootca.New(nil, nil, t.TempDir(), "console", certificates.KeyECDSAP256, nil, logger.New("error"))
+
+	_, err := uc.Get(context.Background())
+	require.ErrorAs(t, err, &sqldb.NotFoundError{})
+
+	uc, _, _ = setup(t)
+
+	d, err := uc.Get(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, d.Current)
+	require.Nil(t, d.Pending)
+	require.Nil(t, d.Previous)
+	require.Len(t, d.Current.SHA256Fingerprint, 64)
+	require.Equal(t, "rsa2048", d.Current.KeyAlgorithm)
+}
+
+func TestRegenerateAndCrossSign(t *testing.T) {
+	t.Parallel()
+
+	uc, _, _ := setup(t)
+
+	_, err := uc.CrossSign(context.Background())
+	require.ErrorAs(t, err, &sqldb.NotFoundError{})
+
+	pending, err := uc.Regenerate(context.Background())
+	require.NoError(t, err)
+
+	d, err := uc.Get(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &pending, d.Pending)
+	require.NotEqual(t, d.Current.SHA256Fingerprint, pending.SHA256Fingerprint)
+
+	crossSigned, err := uc.CrossSign(context.Background())
+	require.NoError(t, err)
+
+	current := parsePEM(t, d.Current.Certificate)
+	newRoot := parsePEM(t, pending.Certificate)
+
+	// the pending root signed by the current one chains to the current root
+	pendingByCurrent := parsePEM(t, crossSigned.PendingByCurrent)
+	require.NoError(t, pendingByCurrent.CheckSignatureFrom(current))
+	require.Equal(t, newRoot.RawSubject, pendingByCurrent.RawSubject)
+	require.Equal(t, newRoot.PublicKey, pendingByCurrent.PublicKey)
+
+	currentByPending := parsePEM(t, crossSigned.CurrentByPending)
+	require.NoError(t, currentByPending.CheckSignatureFrom(newRoot))
+	require.Equal(t, current.RawSubject, currentByPending.RawSubject)
+}
+
+func TestRollover(t *testing.T) {
+	t.Parallel()
+
+	uc, devices, dir := setup(t)
+
+	_, err := uc.Rollover(context.Background(), false)
+	require.ErrorAs(t, err, &sqldb.NotFoundError{})
+
+	before, err := uc.Get(context.Background())
+	require.NoError(t, err)
+
+	pending, err := uc.Regenerate(context.Background())
+	require.NoError(t, err)
+
+	newRoot := parsePEM(t, pending.Certificate)
+	trusted := dto.SecuritySettings{CertificateResponse: dto.CertificatePullResponse{Certificates: []dto.RefinedCertificate{
+		{TrustedRootCertificate: true, X509Certificate: base64.StdEncoding.EncodeToString(newRoot.Raw)},
+	}}}
+
+	devices.EXPECT().Get(context.Background(), 100, 0, "").Return([]dto.Device{{GUID: "guid1"}, {GUID: "guid2"}}, nil)
+	devices.EXPECT().GetCertificates(context.Background(), "guid1").Return(trusted, nil)
+	devices.EXPECT().GetCertificates(context.Background(), "guid2").Return(dto.SecuritySettings{}, nil)
+	devices.EXPECT().AddCertificate(context.Background(), "guid2", dto.CertInfo{Cert: base64.StdEncoding.EncodeToString(newRoot.Raw), IsTrusted: true}).Return("Intel(r) AMT Certificate: Handle: 3", nil)
+
+	d, err := uc.Rollover(context.Background(), false)
+	require.NoError(t, err)
+	require.Equal(t, dto.RootCARollover{
+		Devices:   2,
+		Trusting:  1,
+		Pushed:    []string{"guid2"},
+		Failed:    []dto.RootCARolloverFailure{},
+		Completed: true,
+		Current:   &pending,
+	}, d)
+
+	after, err := uc.Get(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &pending, after.Current)
+	require.Equal(t, before.Current, after.Previous)
+	require.Nil(t, after.Pending)
+
+	// the web server certificate is re-issued by the new root
+	webCert, _, err := certificates.LoadCertificateFromFile(filepath.Join(dir, "console_cert.pem"), filepath.Join(dir, "console_key.pem"))
+	require.NoError(t, err)
+	require.NoError(t, webCert.CheckSignatureFrom(newRoot))
+	require.Equal(t, "ecdsa-p256", after.Current.KeyAlgorithm)
+}
+
+func TestRolloverFailed(t *testing.T) {
+	t.Parallel()
+
+	uc, devices, _ := setup(t)
+
+	pending, err := uc.Regenerate(context.Background())
+	require.NoError(t, err)
+
+	devices.EXPECT().Get(context.Background(), 100, 0, "").Return([]dto.Device{{GUID: "guid1", Hostname: "desktop-1"}}, nil).Times(2)
+	devices.EXPECT().GetCertificates(context.Background(), "guid1").Return(dto.SecuritySettings{}, errNotConnected).Times(2)
+
+	// the rollover stops while a device does not trust the new root
+	d, err := uc.Rollover(context.Background(), false)
+	require.NoError(t, err)
+	require.False(t, d.Completed)
+	require.Equal(t, []dto.RootCARolloverFailure{{GUID: "guid1", Hostname: "desktop-1", Error: errNotConnected.Error()}}, d.Failed)
+
+	state, err := uc.Get(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &pending, state.Pending)
+
+	// unless forced
+	d, err = uc.Rollover(context.Background(), true)
+	require.NoError(t, err)
+	require.True(t, d.Completed)
+	require.Len(t, d.Failed, 1)
+}
+
+// externalKey hides the private key it signs with, as the signers of a token or a KMS do.
+type externalKey struct {
+	crypto.Signer
+}
+
+func TestExternalRootKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	key, err := certificates.GenerateKey(certificates.KeyECDSAP256)
+	require.NoError(t, err)
+
+	rootKey := externalKey{key}
+
+	cert, err := certificates.NewRootCertificateWithKey(rootKey, false, "console", "US", "device-management-toolkit")
+	require.NoError(t, err)
+	require.NoError(t, certificates.SaveCertificateToFiles(cert, rootKey, filepath.Join(dir, "root_cert.pem"), filepath.Join(dir, "root_key.pem")))
+
+	// only the certificate is saved
+	require.NoFileExists(t, filepath.Join(dir, "root_key.pem"))
+
+	uc := rootca.New(nil, nil, dir, "console", certificates.KeyECDSAP256, rootKey, logger.New("error"))
+
+	d, err := uc.Get(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, cert.Raw, parsePEM(t, d.Current.Certificate).Raw)
+
+	// the root key is rotated on its token
+	_, err = uc.Regenerate(context.Background())
+	require.ErrorAs(t, err, &dto.NotValidError{})
+
+	// a root certificate of another key is not used
+	other, err := certificates.GenerateKey(certificates.KeyECDSAP256)
+	require.NoError(t, err)
+
+	uc = rootca.New(nil, nil, dir, "console", certificates.KeyECDSAP256, externalKey{other}, logger.New("error"))
+
+	_, err = uc.Get(context.Background())
+	require.ErrorAs(t, err, &sqldb.NotFoundError{})
+}
@@ -0,0 +1,42 @@
+package rps
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/config"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	// Conn is the WebSocket of an rpc-go client.
+	Conn interface {
+		ReadJSON(v any) error
+		WriteJSON(v any) error
+		SetReadDeadline(t time.Time) error
+	}
+
+	// Devices is the part of the devices use case the activation depends on to keep the activated devices.
+	Devices interface {
+		GetByID(ctx context.Context, guid, tenantID string, includeSecrets bool) (*dto.Device, error)
+		Insert(ctx context.Context, d *dto.Device) (*dto.Device, error)
+		Update(ctx context.Context, d *dto.Device) (*dto.Device, error)
+		Delete(ctx context.Context, guid, tenantID string) error
+	}
+
+	// Profiles is the part of the profiles use case the activation reads the profiles of the devices with.
+	Profiles interface {
+		GetByName(ctx context.Context, profileName, tenantID string) (*dto.Profile, error)
+		Configuration(ctx context.Context, profileName, domainName, tenantID string) (config.Configuration, error)
+	}
+
+	// Domains is the part of the domains use case the activation in admin control mode finds the domain with.
+	Domains interface {
+		GetDomainByDomainSuffix(ctx context.Context, domainSuffix, tenantID string) (*dto.Domain, error)
+	}
+
+	Feature interface {
+		Serve(ctx context.Context, conn Conn, tenantID string) error
+	}
+)
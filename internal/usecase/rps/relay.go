@@ -0,0 +1,70 @@
+package rps
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// ErrClient is returned when the rpc-go client fails to relay a message or answers with something else.
+var ErrClient = errors.New("the rpc-go client did not relay the message")
+
+// relay is the transport of the WS-Management messages to a device: the raw HTTP requests are sent to its rpc-go
+// client, which passes them on to AMT through the local manageability service and sends the raw responses back.
+type relay struct {
+	conn            Conn
+	timeout         time.Duration
+	protocolVersion string
+}
+
+func (r *relay) RoundTrip(req *http.Request) (*http.Response, error) {
+	var raw bytes.Buffer
+	if err := req.Write(&raw); err != nil {
+		return nil, err
+	}
+
+	if err := r.conn.WriteJSON(dto.RPSMessage{
+		Method:          methodWSMan,
+		ProtocolVersion: r.protocolVersion,
+		Status:          statusOK,
+		Message:         statusOK,
+		Payload:         base64.StdEncoding.EncodeToString(raw.Bytes()),
+	}); err != nil {
+		return nil, err
+	}
+
+	msg, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Method != methodResponse {
+		return nil, fmt.Errorf("%w: %s %s", ErrClient, msg.Method, msg.Message)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(msg.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrClient, err)
+	}
+
+	return http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+}
+
+// read returns the next message of the client, failing when it does not come within the timeout.
+func (r *relay) read() (dto.RPSMessage, error) {
+	var msg dto.RPSMessage
+
+	if err := r.conn.SetReadDeadline(time.Now().Add(r.timeout)); err != nil {
+		return msg, err
+	}
+
+	err := r.conn.ReadJSON(&msg)
+
+	return msg, err
+}
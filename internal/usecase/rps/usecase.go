@@ -0,0 +1,441 @@
+package rps
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"software.sslmate.com/src/go-pkcs12"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbasedsetup"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/amtpassword"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/guid"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	methodActivate   = "activate"
+	methodDeactivate = "deactivate"
+	methodWSMan      = "wsman"
+	methodResponse   = "response"
+	methodSuccess    = "success"
+	methodError      = "error"
+
+	statusOK      = "ok"
+	statusSuccess = "success"
+	statusFailed  = "failed"
+
+	acmActivate = "acmactivate"
+
+	// amtUsername is the AMT admin the activation sets the password of.
+	amtUsername = "admin"
+
+	// amtPasswordLength is the length of the AMT password generated for a profile asking for a random one.
+	amtPasswordLength = 16
+
+	// mcNonceBytes is the length of the nonce the console signs with the firmware nonce in admin control mode.
+	mcNonceBytes = 20
+
+	// notConfigured is told of the network, CIRA and TLS settings the console leaves to other tools.
+	notConfigured = "Not Configured"
+)
+
+var (
+	ErrRPSUseCase = consoleerrors.CreateConsoleError("RPSUseCase")
+	ErrValidation = dto.NotValidError{Console: ErrRPSUseCase}
+)
+
+// UseCase activates and deactivates devices for the rpc-go clients pointing at the console as their RPS: the
+// client tells of its device and relays the WS-Management messages of the activation to it over the WebSocket.
+// The devices are activated in the control mode and with the passwords of the profile named by the client and
+// kept as devices of the console. Unlike RPS, the network, CIRA and TLS settings of the profile are not configured.
+type UseCase struct {
+	devices  Devices
+	profiles Profiles
+	domains  Domains
+	timeout  time.Duration
+	log      logger.Interface
+}
+
+// New creates the activation of the rpc-go clients. timeout is how long a client is waited for to answer.
+func New(devices Devices, profiles Profiles, domains Domains, timeout time.Duration, log logger.Interface) *UseCase {
+	return &UseCase{
+		devices:  devices,
+		profiles: profiles,
+		domains:  domains,
+		timeout:  timeout,
+		log:      log,
+	}
+}
+
+// Serve runs the request of an rpc-go client of the tenant on its WebSocket, telling the client how it ended.
+// The tenant is the one of the access token of the client, a message naming another tenant is refused.
+func (uc *UseCase) Serve(ctx context.Context, conn Conn, tenantID string) error {
+	r := &relay{conn: conn, timeout: uc.timeout}
+
+	msg, err := r.read()
+	if err != nil {
+		return ErrRPSUseCase.Wrap("Serve", "conn.ReadJSON", err)
+	}
+
+	r.protocolVersion = msg.ProtocolVersion
+
+	status, err := uc.run(ctx, r, msg, tenantID)
+	if err != nil {
+		_ = conn.WriteJSON(dto.RPSMessage{
+			Method:          methodError,
+			ProtocolVersion: msg.ProtocolVersion,
+			Status:          statusFailed,
+			Message:         err.Error(),
+		})
+
+		return err
+	}
+
+	message, err := json.Marshal(status)
+	if err != nil {
+		return ErrRPSUseCase.Wrap("Serve", "json.Marshal", err)
+	}
+
+	return conn.WriteJSON(dto.RPSMessage{
+		Method:          methodSuccess,
+		ProtocolVersion: msg.ProtocolVersion,
+		Status:          statusSuccess,
+		Message:         string(message),
+	})
+}
+
+// run dispatches the request of a client. Older clients send the profile as an option of the method.
+func (uc *UseCase) run(ctx context.Context, r *relay, msg dto.RPSMessage, tenantID string) (dto.RPSStatus, error) {
+	if msg.TenantID != "" && msg.TenantID != tenantID {
+		return dto.RPSStatus{}, ErrValidation.Wrap("Serve", "tenantId", errors.New("the message is for another tenant than the access token"))
+	}
+
+	method, profile := parseMethod(msg.Method)
+
+	data, err := base64.StdEncoding.DecodeString(msg.Payload)
+	if err != nil {
+		return dto.RPSStatus{}, ErrValidation.Wrap("Serve", "base64.DecodeString", err)
+	}
+
+	var payload dto.RPSPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return dto.RPSStatus{}, ErrValidation.Wrap("Serve", "json.Unmarshal", err)
+	}
+
+	if payload.Profile == "" {
+		payload.Profile = profile
+	}
+
+	payload.UUID, err = guid.Parse(payload.UUID)
+	if err != nil {
+		return dto.RPSStatus{}, ErrValidation.Wrap("Serve", "guid.Parse", err)
+	}
+
+	switch method {
+	case methodActivate:
+		return uc.activate(ctx, r, tenantID, payload)
+	case methodDeactivate:
+		return uc.deactivate(ctx, r, tenantID, payload)
+	default:
+		return dto.RPSStatus{}, ErrValidation.Wrap("Serve", "method", fmt.Errorf("%q is not supported by the console", method))
+	}
+}
+
+// parseMethod splits the method of a message from the --profile option older clients append to it.
+func parseMethod(method string) (name, profile string) {
+	fields := strings.Fields(method)
+	if len(fields) == 0 {
+		return "", ""
+	}
+
+	for i, field := range fields[1:] {
+		option, value, found := strings.Cut(strings.TrimLeft(field, "-"), "=")
+		if option != "profile" {
+			continue
+		}
+
+		if found {
+			profile = value
+		} else if i+2 < len(fields) {
+			profile = fields[i+2]
+		}
+	}
+
+	return fields[0], profile
+}
+
+// activate activates the device of a client with its profile and keeps it as a device of the tenant.
+func (uc *UseCase) activate(ctx context.Context, r *relay, tenantID string, p dto.RPSPayload) (dto.RPSStatus, error) {
+	if p.Profile == "" {
+		return dto.RPSStatus{}, ErrValidation.Wrap("activate", "profile", errors.New("the client names no profile"))
+	}
+
+	if p.CurrentMode != 0 {
+		return dto.RPSStatus{}, ErrValidation.Wrap("activate", "currentMode", errors.New("the device is activated already"))
+	}
+
+	profile, err := uc.profiles.GetByName(ctx, p.Profile, tenantID)
+	if err != nil {
+		return dto.RPSStatus{}, err
+	}
+
+	domainName := ""
+
+	if profile.Activation == acmActivate {
+		domain, err := uc.domains.GetDomainByDomainSuffix(ctx, p.FQDN, tenantID)
+		if err != nil {
+			return dto.RPSStatus{}, err
+		}
+
+		domainName = domain.ProfileName
+	}
+
+	configuration, err := uc.profiles.Configuration(ctx, p.Profile, domainName, tenantID)
+	if err != nil {
+		return dto.RPSStatus{}, err
+	}
+
+	amt := configuration.Configuration.AMTSpecific
+
+	password := amt.AdminPassword
+	if amt.GenerateRandomPassword {
+		password, err = amtpassword.Generate(amtPasswordLength)
+		if err != nil {
+			return dto.RPSStatus{}, ErrRPSUseCase.Wrap("activate", "amtpassword.Generate", err)
+		}
+	}
+
+	// before it is provisioned AMT accepts the local credentials of the host the client read from it
+	messages := wsman.NewMessages(client.Parameters{
+		Target:    "localhost",
+		Username:  p.Username,
+		Password:  p.Password,
+		UseDigest: true,
+		Transport: r,
+	})
+
+	settings, err := messages.AMT.GeneralSettings.Get()
+	if err != nil {
+		return dto.RPSStatus{}, ErrRPSUseCase.Wrap("activate", "GeneralSettings.Get", err)
+	}
+
+	realm := settings.Body.GetResponse.DigestRealm
+	status := dto.RPSStatus{Status: "Client control mode.", Network: notConfigured, CIRAConnection: notConfigured}
+
+	if profile.Activation == acmActivate {
+		if err := adminSetup(messages, realm, password, amt.ProvisioningCert, amt.ProvisioningCertPwd, p.CertHashes); err != nil {
+			return dto.RPSStatus{}, err
+		}
+
+		status.Status = "Admin control mode."
+	} else if _, err := messages.IPS.HostBasedSetupService.Setup(hostbasedsetup.AdminPassEncryptionTypeHTTPDigestMD5A1, realm, password); err != nil {
+		return dto.RPSStatus{}, ErrRPSUseCase.Wrap("activate", "HostBasedSetupService.Setup", err)
+	}
+
+	// the MEBx password can only be set in admin control mode, by the admin the device was just activated with
+	if profile.Activation == acmActivate && amt.MEBXPassword != "" {
+		admin := wsman.NewMessages(client.Parameters{
+			Target:    "localhost",
+			Username:  amtUsername,
+			Password:  password,
+			UseDigest: true,
+			Transport: r,
+		})
+
+		if _, err := admin.AMT.SetupAndConfigurationService.SetMEBXPassword(amt.MEBXPassword); err != nil {
+			uc.log.Warn("rps - activate: the MEBx password of %s was not set: %v", p.UUID, err)
+		}
+	}
+
+	if err := uc.saveDevice(ctx, tenantID, p, profile, password, amt.MEBXPassword); err != nil {
+		return dto.RPSStatus{}, err
+	}
+
+	uc.log.Info("rps - activate: device %s activated with the profile %s", p.UUID, p.Profile)
+
+	return status, nil
+}
+
+// adminSetup activates a device in admin control mode: the chain of the provisioning certificate is given to the
+// device, its root must be one of the hashes trusted by the device, and the nonces are signed with its key.
+func adminSetup(messages wsman.Messages, realm, password, provisioningCert, certPassword string, trustedHashes []string) error {
+	pfx, err := base64.StdEncoding.DecodeString(provisioningCert)
+	if err != nil {
+		return ErrValidation.Wrap("adminSetup", "base64.DecodeString", err)
+	}
+
+	key, leaf, caCerts, err := pkcs12.DecodeChain(pfx, certPassword)
+	if err != nil {
+		return ErrValidation.Wrap("adminSetup", "pkcs12.DecodeChain", err)
+	}
+
+	signer, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return ErrValidation.Wrap("adminSetup", "pkcs12.DecodeChain", errors.New("the provisioning certificate has no RSA key"))
+	}
+
+	chain, err := certificateChain(leaf, caCerts)
+	if err != nil {
+		return err
+	}
+
+	rootHash := sha256.Sum256(chain[len(chain)-1].Raw)
+	if !slices.ContainsFunc(trustedHashes, func(h string) bool { return strings.EqualFold(h, hex.EncodeToString(rootHash[:])) }) {
+		return ErrValidation.Wrap("adminSetup", "certHashes", errors.New("the root of the provisioning certificate is not trusted by the device"))
+	}
+
+	service, err := messages.IPS.HostBasedSetupService.Get()
+	if err != nil {
+		return ErrRPSUseCase.Wrap("adminSetup", "HostBasedSetupService.Get", err)
+	}
+
+	fwNonce, err := base64.StdEncoding.DecodeString(service.Body.GetResponse.ConfigurationNonce)
+	if err != nil {
+		return ErrRPSUseCase.Wrap("adminSetup", "base64.DecodeString", err)
+	}
+
+	for i, cert := range chain {
+		if _, err := messages.IPS.HostBasedSetupService.AddNextCertInChain(base64.StdEncoding.EncodeToString(cert.Raw), i == 0, i == len(chain)-1); err != nil {
+			return ErrRPSUseCase.Wrap("adminSetup", "HostBasedSetupService.AddNextCertInChain", err)
+		}
+	}
+
+	mcNonce := make([]byte, mcNonceBytes)
+	if _, err := rand.Read(mcNonce); err != nil {
+		return ErrRPSUseCase.Wrap("adminSetup", "rand.Read", err)
+	}
+
+	digest := sha256.Sum256(append(fwNonce, mcNonce...))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, signer, crypto.SHA256, digest[:])
+	if err != nil {
+		return ErrRPSUseCase.Wrap("adminSetup", "rsa.SignPKCS1v15", err)
+	}
+
+	if _, err := messages.IPS.HostBasedSetupService.AdminSetup(hostbasedsetup.AdminPassEncryptionTypeHTTPDigestMD5A1, realm, password,
+		base64.StdEncoding.EncodeToString(mcNonce), hostbasedsetup.SigningAlgorithmRSASHA2256, base64.StdEncoding.EncodeToString(signature)); err != nil {
+		return ErrRPSUseCase.Wrap("adminSetup", "HostBasedSetupService.AdminSetup", err)
+	}
+
+	return nil
+}
+
+// certificateChain orders the certificates of a provisioning certificate from the leaf to its self-signed root.
+func certificateChain(leaf *x509.Certificate, caCerts []*x509.Certificate) ([]*x509.Certificate, error) {
+	chain := []*x509.Certificate{leaf}
+
+	for last := leaf; !bytes.Equal(last.RawIssuer, last.RawSubject); {
+		i := slices.IndexFunc(caCerts, func(c *x509.Certificate) bool {
+			return bytes.Equal(c.RawSubject, last.RawIssuer) && !slices.Contains(chain, c)
+		})
+		if i < 0 {
+			return nil, ErrValidation.Wrap("certificateChain", "issuer", errors.New("the provisioning certificate lacks the root of its chain"))
+		}
+
+		last = caCerts[i]
+		chain = append(chain, last)
+	}
+
+	return chain, nil
+}
+
+// saveDevice keeps an activated device as a device of the tenant, updating the record of a device activated
+// again.
+func (uc *UseCase) saveDevice(ctx context.Context, tenantID string, p dto.RPSPayload, profile *dto.Profile, password, mebxPassword string) error {
+	tags := profile.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	existing, err := uc.devices.GetByID(ctx, p.UUID, tenantID, true)
+	if err != nil && !errors.As(err, &sqldb.NotFoundError{}) {
+		return err
+	}
+
+	if existing != nil {
+		existing.Hostname = p.Hostname
+		existing.DNSSuffix = p.FQDN
+		existing.Username = amtUsername
+		existing.Password = password
+		existing.MEBXPassword = mebxPassword
+		existing.Tags = tags
+
+		if p.FriendlyName != "" {
+			existing.FriendlyName = p.FriendlyName
+		}
+
+		_, err = uc.devices.Update(ctx, existing)
+
+		return err
+	}
+
+	_, err = uc.devices.Insert(ctx, &dto.Device{
+		GUID:         p.UUID,
+		Hostname:     p.Hostname,
+		FriendlyName: p.FriendlyName,
+		DNSSuffix:    p.FQDN,
+		TenantID:     tenantID,
+		Tags:         tags,
+		Username:     amtUsername,
+		Password:     password,
+		MEBXPassword: mebxPassword,
+	})
+
+	return err
+}
+
+// deactivate unprovisions the device of a client with the AMT password the console keeps for it, or the one the
+// client gives for a device it does not know, and removes it from the devices of the tenant.
+func (uc *UseCase) deactivate(ctx context.Context, r *relay, tenantID string, p dto.RPSPayload) (dto.RPSStatus, error) {
+	device, err := uc.devices.GetByID(ctx, p.UUID, tenantID, true)
+	if err != nil && !errors.As(err, &sqldb.NotFoundError{}) {
+		return dto.RPSStatus{}, err
+	}
+
+	username, password := amtUsername, p.Password
+	if device != nil && device.Password != "" {
+		username, password = device.Username, device.Password
+	}
+
+	messages := wsman.NewMessages(client.Parameters{
+		Target:    "localhost",
+		Username:  username,
+		Password:  password,
+		UseDigest: true,
+		Transport: r,
+	})
+
+	if _, err := messages.AMT.SetupAndConfigurationService.Unprovision(setupandconfiguration.AdminControlMode); err != nil {
+		return dto.RPSStatus{}, ErrRPSUseCase.Wrap("deactivate", "SetupAndConfigurationService.Unprovision", err)
+	}
+
+	if device != nil {
+		if err := uc.devices.Delete(ctx, p.UUID, tenantID); err != nil {
+			uc.log.Warn("rps - deactivate: the deactivated device %s was not removed: %v", p.UUID, err)
+		}
+	}
+
+	uc.log.Info("rps - deactivate: device %s deactivated", p.UUID)
+
+	return dto.RPSStatus{Status: "Deactivated"}, nil
+}
@@ -0,0 +1,408 @@
+package rps_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+	"software.sslmate.com/src/go-pkcs12"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/config"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/rps"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const deviceGUID = "123e4567-e89b-12d3-a456-426614174000"
+
+var actionPattern = regexp.MustCompile(`Action[^>]*>([^<]+)<`)
+
+// fakeClient is an rpc-go client whose device answers the WS-Management messages relayed to it, asking for digest
+// authentication first.
+type fakeClient struct {
+	incoming []dto.RPSMessage
+	sent     []dto.RPSMessage
+	actions  []string
+	users    []string
+	certs    []string
+}
+
+func newFakeClient(t *testing.T, method string, payload dto.RPSPayload) *fakeClient {
+	t.Helper()
+
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	return &fakeClient{incoming: []dto.RPSMessage{{
+		Method:          method,
+		APIKey:          "key",
+		ProtocolVersion: "4.0.0",
+		Status:          "ok",
+		Message:         "ok",
+		Payload:         base64.StdEncoding.EncodeToString(data),
+		TenantID:        "tenant",
+	}}}
+}
+
+func (c *fakeClient) ReadJSON(v any) error {
+	if len(c.incoming) == 0 {
+		return io.EOF
+	}
+
+	msg, ok := v.(*dto.RPSMessage)
+	if !ok {
+		return fmt.Errorf("unexpected %T", v)
+	}
+
+	*msg, c.incoming = c.incoming[0], c.incoming[1:]
+
+	return nil
+}
+
+func (c *fakeClient) SetReadDeadline(_ time.Time) error {
+	return nil
+}
+
+func (c *fakeClient) WriteJSON(v any) error {
+	msg, ok := v.(dto.RPSMessage)
+	if !ok {
+		return fmt.Errorf("unexpected %T", v)
+	}
+
+	if msg.Method != "wsman" {
+		c.sent = append(c.sent, msg)
+
+		return nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(msg.Payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.ReadRequest(bufio.NewReader(bytes.NewReader(raw)))
+	if err != nil {
+		return err
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+
+	response := "HTTP/1.1 401 Unauthorized\r\nWWW-Authenticate: Digest realm=\"Digest:A3829B3827DE4D33D4449B366831FD01\", nonce=\"abcdef\", stale=\"false\", qop=\"auth\"\r\nContent-Length: 0\r\n\r\n"
+
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		output := c.answer(string(body))
+		response = fmt.Sprintf("HTTP/1.1 200 OK\r\nContent-Type: application/soap+xml; charset=UTF-8\r\nContent-Length: %d\r\n\r\n%s", len(output), output)
+		c.users = append(c.users, regexp.MustCompile(`username="([^"]*)"`).FindStringSubmatch(auth)[1])
+	}
+
+	c.incoming = append(c.incoming, dto.RPSMessage{Method: "response", Status: "ok", Payload: base64.StdEncoding.EncodeToString([]byte(response))})
+
+	return nil
+}
+
+// answer returns the response of the device to a message, recording its action.
+func (c *fakeClient) answer(body string) string {
+	action := path.Base(actionPattern.FindStringSubmatch(body)[1])
+
+	var output string
+
+	switch {
+	case action == "Get" && strings.Contains(body, "AMT_GeneralSettings"):
+		action = "GeneralSettings"
+		output = "<h:AMT_GeneralSettings><h:DigestRealm>Digest:A3829B3827DE4D33D4449B366831FD01</h:DigestRealm></h:AMT_GeneralSettings>"
+	case action == "Get":
+		action = "HostBasedSetupService"
+		output = "<h:IPS_HostBasedSetupService><h:ConfigurationNonce>" + base64.StdEncoding.EncodeToString(make([]byte, 20)) +
+			"</h:ConfigurationNonce></h:IPS_HostBasedSetupService>"
+	default:
+		if action == "AddNextCertInChain" {
+			c.certs = append(c.certs, regexp.MustCompile(`NextCertificate>([^<]+)<`).FindStringSubmatch(body)[1])
+		}
+
+		output = "<h:" + action + "_OUTPUT><h:ReturnValue>0</h:ReturnValue></h:" + action + "_OUTPUT>"
+	}
+
+	c.actions = append(c.actions, action)
+
+	return `<?xml version="1.0" encoding="UTF-8"?><a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" ` +
+		`xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1"><a:Header></a:Header><a:Body>` + output + `</a:Body></a:Envelope>`
+}
+
+// provisioningCert returns a PFX of a leaf certificate issued by a root, the hash of the root and the chain.
+func provisioningCert(t *testing.T) (pfx, rootHash string, chain []*x509.Certificate) {
+	t.Helper()
+
+	rootKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, &rootKey.PublicKey, rootKey)
+	require.NoError(t, err)
+
+	root, err := x509.ParseCertificate(rootDER)
+	require.NoError(t, err)
+
+	leafKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	leafDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "vprodemo.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, root, &leafKey.PublicKey, rootKey)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(leafDER)
+	require.NoError(t, err)
+
+	data, err := pkcs12.Modern.Encode(leafKey, leaf, []*x509.Certificate{root}, "P@ssw0rd")
+	require.NoError(t, err)
+
+	hash := sha256.Sum256(rootDER)
+
+	return base64.StdEncoding.EncodeToString(data), hex.EncodeToString(hash[:]), []*x509.Certificate{leaf, root}
+}
+
+func configuration(amt config.AMTSpecific) config.Configuration {
+	return config.Configuration{Configuration: config.RemoteManagement{AMTSpecific: amt}}
+}
+
+func TestServe(t *testing.T) {
+	t.Parallel()
+
+	pfx, rootHash, chain := provisioningCert(t)
+
+	payload := dto.RPSPayload{
+		UUID:       strings.ToUpper(deviceGUID),
+		Username:   "$$OsAdmin",
+		Password:   "local-password",
+		Hostname:   "frontdesk-01",
+		FQDN:       "vprodemo.com",
+		CertHashes: []string{"e7685634efacf69ace939a6b255b7b4fabef42935b50a265acb5cb6027e44e70", strings.ToUpper(rootHash)},
+		Profile:    "ccm",
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		tenant  string
+		payload func(p dto.RPSPayload) dto.RPSPayload
+		mock    func(*mocks.MockRPSDevices, *mocks.MockRPSProfiles, *mocks.MockRPSDomains)
+		actions []string
+		users   []string
+		certs   []string
+		status  string
+		err     error
+	}{
+		{
+			name:   "client control mode of a profile named in the method",
+			method: "activate --profile ccm",
+			payload: func(p dto.RPSPayload) dto.RPSPayload {
+				p.Profile = ""
+
+				return p
+			},
+			mock: func(devices *mocks.MockRPSDevices, profiles *mocks.MockRPSProfiles, _ *mocks.MockRPSDomains) {
+				profiles.EXPECT().GetByName(context.Background(), "ccm", "tenant").Return(&dto.Profile{ProfileName: "ccm", Activation: "ccmactivate", Tags: []string{"lab"}}, nil)
+				profiles.EXPECT().Configuration(context.Background(), "ccm", "", "tenant").Return(configuration(config.AMTSpecific{AdminPassword: "Amt!Pass1"}), nil)
+				devices.EXPECT().GetByID(context.Background(), deviceGUID, "tenant", true).Return(nil, sqldb.NotFoundError{})
+				devices.EXPECT().Insert(context.Background(), &dto.Device{
+					GUID:      deviceGUID,
+					Hostname:  "frontdesk-01",
+					DNSSuffix: "vprodemo.com",
+					TenantID:  "tenant",
+					Tags:      []string{"lab"},
+					Username:  "admin",
+					Password:  "Amt!Pass1",
+				}).Return(&dto.Device{}, nil)
+			},
+			actions: []string{"GeneralSettings", "Setup"},
+			users:   []string{"$$OsAdmin", "$$OsAdmin"},
+			status:  `{"Status":"Client control mode.","Network":"Not Configured","CIRAConnection":"Not Configured"}`,
+		},
+		{
+			name:   "admin control mode of a device activated before",
+			method: "activate",
+			payload: func(p dto.RPSPayload) dto.RPSPayload {
+				p.Profile = "acm"
+
+				return p
+			},
+			mock: func(devices *mocks.MockRPSDevices, profiles *mocks.MockRPSProfiles, domains *mocks.MockRPSDomains) {
+				profiles.EXPECT().GetByName(context.Background(), "acm", "tenant").Return(&dto.Profile{ProfileName: "acm", Activation: "acmactivate"}, nil)
+				domains.EXPECT().GetDomainByDomainSuffix(context.Background(), "vprodemo.com", "tenant").Return(&dto.Domain{ProfileName: "demo"}, nil)
+				profiles.EXPECT().Configuration(context.Background(), "acm", "demo", "tenant").Return(configuration(config.AMTSpecific{
+					AdminPassword: "Amt!Pass1", MEBXPassword: "Mebx!Pass1", ProvisioningCert: pfx, ProvisioningCertPwd: "P@ssw0rd",
+				}), nil)
+				devices.EXPECT().GetByID(context.Background(), deviceGUID, "tenant", true).Return(&dto.Device{GUID: deviceGUID, TenantID: "tenant", FriendlyName: "Front desk"}, nil)
+				devices.EXPECT().Update(context.Background(), &dto.Device{
+					GUID:         deviceGUID,
+					Hostname:     "frontdesk-01",
+					FriendlyName: "Front desk",
+					DNSSuffix:    "vprodemo.com",
+					TenantID:     "tenant",
+					Tags:         []string{},
+					Username:     "admin",
+					Password:     "Amt!Pass1",
+					MEBXPassword: "Mebx!Pass1",
+				}).Return(&dto.Device{}, nil)
+			},
+			actions: []string{"GeneralSettings", "HostBasedSetupService", "AddNextCertInChain", "AddNextCertInChain", "AdminSetup", "SetMEBxPassword"},
+			users:   []string{"$$OsAdmin", "$$OsAdmin", "$$OsAdmin", "$$OsAdmin", "$$OsAdmin", "admin"},
+			certs:   []string{base64.StdEncoding.EncodeToString(chain[0].Raw), base64.StdEncoding.EncodeToString(chain[1].Raw)},
+			status:  `{"Status":"Admin control mode.","Network":"Not Configured","CIRAConnection":"Not Configured"}`,
+		},
+		{
+			name:   "root of the provisioning certificate not trusted",
+			method: "activate",
+			payload: func(p dto.RPSPayload) dto.RPSPayload {
+				p.Profile = "acm"
+				p.CertHashes = p.CertHashes[:1]
+
+				return p
+			},
+			mock: func(_ *mocks.MockRPSDevices, profiles *mocks.MockRPSProfiles, domains *mocks.MockRPSDomains) {
+				profiles.EXPECT().GetByName(context.Background(), "acm", "tenant").Return(&dto.Profile{ProfileName: "acm", Activation: "acmactivate"}, nil)
+				domains.EXPECT().GetDomainByDomainSuffix(context.Background(), "vprodemo.com", "tenant").Return(&dto.Domain{ProfileName: "demo"}, nil)
+				profiles.EXPECT().Configuration(context.Background(), "acm", "demo", "tenant").Return(configuration(config.AMTSpecific{
+					AdminPassword: "Amt!Pass1", ProvisioningCert: pfx, ProvisioningCertPwd: "P@ssw0rd",
+				}), nil)
+			},
+			actions: []string{"GeneralSettings"},
+			users:   []string{"$$OsAdmin"},
+			err:     rps.ErrValidation,
+		},
+		{
+			name:   "activated already",
+			method: "activate",
+			payload: func(p dto.RPSPayload) dto.RPSPayload {
+				p.CurrentMode = 1
+
+				return p
+			},
+			err: rps.ErrValidation,
+		},
+		{
+			name:   "unknown profile",
+			method: "activate",
+			mock: func(_ *mocks.MockRPSDevices, profiles *mocks.MockRPSProfiles, _ *mocks.MockRPSDomains) {
+				profiles.EXPECT().GetByName(context.Background(), "ccm", "tenant").Return(nil, sqldb.NotFoundError{})
+			},
+			err: sqldb.NotFoundError{},
+		},
+		{
+			name:   "deactivate with the stored password",
+			method: "deactivate",
+			mock: func(devices *mocks.MockRPSDevices, _ *mocks.MockRPSProfiles, _ *mocks.MockRPSDomains) {
+				devices.EXPECT().GetByID(context.Background(), deviceGUID, "tenant", true).Return(&dto.Device{GUID: deviceGUID, Username: "admin", Password: "Amt!Pass1"}, nil)
+				devices.EXPECT().Delete(context.Background(), deviceGUID, "tenant").Return(nil)
+			},
+			actions: []string{"Unprovision"},
+			users:   []string{"admin"},
+			status:  `{"Status":"Deactivated"}`,
+		},
+		{
+			name:   "message for another tenant",
+			method: "activate",
+			tenant: "other",
+			err:    rps.ErrValidation,
+		},
+		{
+			name:   "unsupported method",
+			method: "maintenance",
+			err:    rps.ErrValidation,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtl := gomock.NewController(t)
+			devices := mocks.NewMockRPSDevices(mockCtl)
+			profiles := mocks.NewMockRPSProfiles(mockCtl)
+			domains := mocks.NewMockRPSDomains(mockCtl)
+
+			if tc.mock != nil {
+				tc.mock(devices, profiles, domains)
+			}
+
+			p := payload
+			if tc.payload != nil {
+				p = tc.payload(p)
+			}
+
+			client := newFakeClient(t, tc.method, p)
+
+			tenant := tc.tenant
+			if tenant == "" {
+				tenant = "tenant"
+			}
+
+			err := rps.New(devices, profiles, domains, time.Second, logger.New("error")).Serve(context.Background(), client, tenant)
+
+			assert.Equal(t, tc.actions, client.actions)
+			assert.Equal(t, tc.users, client.users)
+			assert.Equal(t, tc.certs, client.certs)
+			require.Len(t, client.sent, 1)
+
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+				assert.Equal(t, "error", client.sent[0].Method)
+				assert.Equal(t, "failed", client.sent[0].Status)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "success", client.sent[0].Method)
+			assert.JSONEq(t, tc.status, client.sent[0].Message)
+		})
+	}
+}
+
+func TestServeClientGone(t *testing.T) {
+	t.Parallel()
+
+	err := rps.New(nil, nil, nil, time.Second, logger.New("error")).Serve(context.Background(), &fakeClient{}, "tenant")
+
+	require.IsType(t, &consoleerrors.InternalError{}, err)
+}
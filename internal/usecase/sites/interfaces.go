@@ -0,0 +1,30 @@
+package sites
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		GetCount(ctx context.Context, tenantID string) (int, error)
+		Get(ctx context.Context, top, skip int, tenantID string) ([]entity.Site, error)
+		GetAll(ctx context.Context, tenantID string) ([]entity.Site, error)
+		GetByName(ctx context.Context, name, tenantID string) (*entity.Site, error)
+		Delete(ctx context.Context, name, tenantID string) (bool, error)
+		Update(ctx context.Context, s *entity.Site) (bool, error)
+		Insert(ctx context.Context, s *entity.Site) error
+	}
+	Feature interface {
+		GetCount(ctx context.Context, tenantID string) (int, error)
+		Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Site, error)
+		GetByName(ctx context.Context, name, tenantID string) (*dto.Site, error)
+		Delete(ctx context.Context, name, tenantID string) error
+		Update(ctx context.Context, s *dto.Site) (*dto.Site, error)
+		Insert(ctx context.Context, s *dto.Site) (*dto.Site, error)
+		Apply(ctx context.Context, device entity.Device) (entity.Device, error)
+		Locate(ctx context.Context, addresses []string, tenantID string) (*dto.Site, error)
+	}
+)
@@ -0,0 +1,340 @@
+package sites
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const resolveTimeout = 5 * time.Second
+
+// UseCase -.
+type UseCase struct {
+	repo             Repository
+	log              logger.Interface
+	safeRequirements security.Cryptor
+	resolver         resolver
+}
+
+// resolver looks up the addresses of the devices with a hostname rather than an address.
+type resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+var (
+	ErrSitesUseCase = consoleerrors.CreateConsoleError("SitesUseCase")
+	ErrDatabase     = sqldb.DatabaseError{Console: ErrSitesUseCase}
+	ErrNotFound     = sqldb.NotFoundError{Console: ErrSitesUseCase}
+	ErrValidation   = dto.NotValidError{Console: ErrSitesUseCase}
+	// ErrTLSPolicy is returned for a TLS policy other than none, verified, selfSigned or empty.
+	ErrTLSPolicy = errors.New("TLS policy must be none, verified, selfSigned or empty")
+)
+
+// New -.
+func New(r Repository, log logger.Interface, safeRequirements security.Cryptor) *UseCase {
+	return &UseCase{
+		repo:             r,
+		log:              log,
+		safeRequirements: safeRequirements,
+		resolver:         net.DefaultResolver,
+	}
+}
+
+func (uc *UseCase) GetCount(ctx context.Context, tenantID string) (int, error) {
+	count, err := uc.repo.GetCount(ctx, tenantID)
+	if err != nil {
+		return 0, ErrDatabase.Wrap("GetCount", "uc.repo.GetCount", err)
+	}
+
+	return count, nil
+}
+
+func (uc *UseCase) Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Site, error) {
+	data, err := uc.repo.Get(ctx, top, skip, tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("Get", "uc.repo.Get", err)
+	}
+
+	d1 := make([]dto.Site, len(data))
+
+	for i := range data {
+		d1[i] = *uc.entityToDTO(&data[i])
+	}
+
+	return d1, nil
+}
+
+func (uc *UseCase) GetByName(ctx context.Context, name, tenantID string) (*dto.Site, error) {
+	data, err := uc.repo.GetByName(ctx, name, tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("GetByName", "uc.repo.GetByName", err)
+	}
+
+	if data == nil {
+		return nil, ErrNotFound
+	}
+
+	return uc.entityToDTO(data), nil
+}
+
+func (uc *UseCase) Delete(ctx context.Context, name, tenantID string) error {
+	deleted, err := uc.repo.Delete(ctx, name, tenantID)
+	if err != nil {
+		return ErrDatabase.Wrap("Delete", "uc.repo.Delete", err)
+	}
+
+	if !deleted {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (uc *UseCase) Update(ctx context.Context, s *dto.Site) (*dto.Site, error) {
+	if err := validate(s); err != nil {
+		return nil, ErrValidation.Wrap("Update", "validate", err)
+	}
+
+	d1, err := uc.dtoToEntity(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Password == "" {
+		current, err := uc.repo.GetByName(ctx, s.Name, s.TenantID)
+		if err != nil {
+			return nil, ErrDatabase.Wrap("Update", "uc.repo.GetByName", err)
+		}
+
+		if current == nil {
+			return nil, ErrNotFound
+		}
+
+		d1.Password = current.Password
+	}
+
+	updated, err := uc.repo.Update(ctx, d1)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("Update", "uc.repo.Update", err)
+	}
+
+	if !updated {
+		return nil, ErrNotFound
+	}
+
+	return uc.GetByName(ctx, s.Name, s.TenantID)
+}
+
+func (uc *UseCase) Insert(ctx context.Context, s *dto.Site) (*dto.Site, error) {
+	if err := validate(s); err != nil {
+		return nil, ErrValidation.Wrap("Insert", "validate", err)
+	}
+
+	d1, err := uc.dtoToEntity(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Insert(ctx, d1); err != nil {
+		return nil, ErrDatabase.Wrap("Insert", "uc.repo.Insert", err)
+	}
+
+	return uc.GetByName(ctx, s.Name, s.TenantID)
+}
+
+// validate checks the subnets, TLS policy and proxy of a site, a device is only matched against valid subnets
+// and a site with an invalid proxy would leave its devices unreachable.
+func validate(s *dto.Site) error {
+	switch s.TLSPolicy {
+	case "", dto.SiteTLSNone, dto.SiteTLSVerified, dto.SiteTLSSelfSigned:
+	default:
+		return ErrTLSPolicy
+	}
+
+	for _, subnet := range s.Subnets {
+		if _, err := netip.ParsePrefix(subnet); err != nil {
+			return err
+		}
+	}
+
+	return wsman.CheckProxy(config.WsmanProxy{URL: s.Proxy})
+}
+
+// Apply returns device with the connection settings of its site: the site with the most specific subnet
+// containing the address of the device. Its proxy and TLS policy replace the ones of the device, its
+// credentials are used when the device has none. A device outside of all sites is returned as it is.
+func (uc *UseCase) Apply(ctx context.Context, device entity.Device) (entity.Device, error) {
+	sites, err := uc.repo.GetAll(ctx, device.TenantID)
+	if err != nil {
+		return device, ErrDatabase.Wrap("Apply", "uc.repo.GetAll", err)
+	}
+
+	if len(sites) == 0 {
+		return device, nil
+	}
+
+	site := match(sites, uc.addresses(ctx, device.Hostname))
+	if site == nil {
+		return device, nil
+	}
+
+	device.Proxy = site.Proxy
+
+	switch site.TLSPolicy {
+	case dto.SiteTLSNone:
+		device.UseTLS, device.AllowSelfSigned = false, false
+	case dto.SiteTLSVerified:
+		device.UseTLS, device.AllowSelfSigned = true, false
+	case dto.SiteTLSSelfSigned:
+		device.UseTLS, device.AllowSelfSigned = true, true
+	}
+
+	if device.Username == "" {
+		device.Username, device.Password = site.Username, site.Password
+	}
+
+	return device, nil
+}
+
+// Locate returns the site of a device at addresses, the site with the most specific subnet containing one of
+// them, nil when it is outside of all sites. Addresses that cannot be parsed are ignored.
+func (uc *UseCase) Locate(ctx context.Context, addresses []string, tenantID string) (*dto.Site, error) {
+	addrs := make([]netip.Addr, 0, len(addresses))
+
+	for _, address := range addresses {
+		if addr, err := netip.ParseAddr(address); err == nil {
+			addrs = append(addrs, addr.WithZone("").Unmap())
+		}
+	}
+
+	if len(addrs) == 0 {
+		return nil, nil
+	}
+
+	sites, err := uc.repo.GetAll(ctx, tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("Locate", "uc.repo.GetAll", err)
+	}
+
+	site := match(sites, addrs)
+	if site == nil {
+		return nil, nil
+	}
+
+	return uc.entityToDTO(site), nil
+}
+
+// addresses returns the addresses of hostname, itself for an IP address. A name that cannot be resolved
+// has none and so belongs to no site.
+func (uc *UseCase) addresses(ctx context.Context, hostname string) []netip.Addr {
+	hostname = strings.TrimSuffix(strings.TrimPrefix(hostname, "["), "]")
+
+	if addr, err := netip.ParseAddr(hostname); err == nil {
+		return []netip.Addr{addr.WithZone("").Unmap()}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, resolveTimeout)
+	defer cancel()
+
+	ipAddrs, err := uc.resolver.LookupIPAddr(ctx, hostname)
+	if err != nil {
+		uc.log.Debug("sites: %s not resolved: %v", hostname, err)
+
+		return nil
+	}
+
+	addrs := make([]netip.Addr, 0, len(ipAddrs))
+
+	for _, ipAddr := range ipAddrs {
+		if addr, ok := netip.AddrFromSlice(ipAddr.IP); ok {
+			addrs = append(addrs, addr.Unmap())
+		}
+	}
+
+	return addrs
+}
+
+// match returns the site with the longest subnet containing one of addrs, nil when none does.
+func match(sites []entity.Site, addrs []netip.Addr) *entity.Site {
+	var (
+		matched *entity.Site
+		bits    = -1
+	)
+
+	for i := range sites {
+		for _, subnet := range split(sites[i].Subnets) {
+			prefix, err := netip.ParsePrefix(subnet)
+			if err != nil || prefix.Bits() <= bits {
+				continue
+			}
+
+			for _, addr := range addrs {
+				if prefix.Contains(addr) {
+					matched, bits = &sites[i], prefix.Bits()
+
+					break
+				}
+			}
+		}
+	}
+
+	return matched
+}
+
+// convert dto.Site to entity.Site.
+func (uc *UseCase) dtoToEntity(d *dto.Site) (*entity.Site, error) {
+	d1 := &entity.Site{
+		Name:      d.Name,
+		Subnets:   strings.Join(d.Subnets, ","),
+		Proxy:     d.Proxy,
+		TLSPolicy: d.TLSPolicy,
+		Username:  d.Username,
+		TenantID:  d.TenantID,
+	}
+
+	if d.Password != "" {
+		var err error
+
+		d1.Password, err = tenantkeys.Encrypt(uc.safeRequirements, d.TenantID, d.Password)
+		if err != nil {
+			return nil, ErrSitesUseCase.Wrap("dtoToEntity", "failed to encrypt password", err)
+		}
+	}
+
+	return d1, nil
+}
+
+// convert entity.Site to dto.Site.
+func (uc *UseCase) entityToDTO(d *entity.Site) *dto.Site {
+	return &dto.Site{
+		Name:      d.Name,
+		Subnets:   split(d.Subnets),
+		Proxy:     d.Proxy,
+		TLSPolicy: d.TLSPolicy,
+		Username:  d.Username,
+		TenantID:  d.TenantID,
+	}
+}
+
+// split turns a comma separated column into a list, an empty one for an empty column.
+func split(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+
+	return strings.Split(s, ",")
+}
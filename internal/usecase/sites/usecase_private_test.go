@@ -0,0 +1,33 @@
+package sites
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResolver struct{}
+
+func (fakeResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	if host != "amt.branch.example.com" {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+
+	return []net.IPAddr{{IP: net.ParseIP("10.20.1.5")}, {IP: net.ParseIP("fd00:20::5")}}, nil
+}
+
+func TestAddresses(t *testing.T) {
+	t.Parallel()
+
+	uc := &UseCase{resolver: fakeResolver{}}
+
+	addrs := uc.addresses(context.Background(), "amt.branch.example.com")
+	require.Len(t, addrs, 2)
+	require.Equal(t, "10.20.1.5", addrs[0].String(), "IPv4-mapped addresses are unmapped")
+	require.Equal(t, "fd00:20::5", addrs[1].String())
+
+	addrs = uc.addresses(context.Background(), "[fe80::1%eth0]")
+	require.Equal(t, "fe80::1", addrs[0].String(), "the zone does not take part in matching")
+}
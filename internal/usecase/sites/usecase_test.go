@@ -0,0 +1,278 @@
+package sites_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sites"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errTest = errors.New("test error")
+
+func sitesTest(t *testing.T) (*sites.UseCase, *mocks.MockSiteRepository) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockSiteRepository(ctrl)
+
+	return sites.New(repo, logger.New("error"), mocks.MockCrypto{}), repo
+}
+
+func siteEntity() *entity.Site {
+	return &entity.Site{
+		Name:      "branch",
+		Subnets:   "10.20.0.0/16,fd00:20::/64",
+		Proxy:     "socks5://jump.example.com",
+		TLSPolicy: dto.SiteTLSSelfSigned,
+		Username:  "admin",
+		Password:  "encrypted",
+	}
+}
+
+func siteDTO() *dto.Site {
+	return &dto.Site{
+		Name:      "branch",
+		Subnets:   []string{"10.20.0.0/16", "fd00:20::/64"},
+		Proxy:     "socks5://jump.example.com",
+		TLSPolicy: dto.SiteTLSSelfSigned,
+		Username:  "admin",
+	}
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	uc, repo := sitesTest(t)
+
+	repo.EXPECT().Get(context.Background(), 10, 0, "").Return([]entity.Site{*siteEntity()}, nil)
+
+	items, err := uc.Get(context.Background(), 10, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []dto.Site{*siteDTO()}, items, "the password is not returned")
+
+	repo.EXPECT().Get(context.Background(), 10, 0, "").Return(nil, errTest)
+
+	_, err = uc.Get(context.Background(), 10, 0, "")
+	require.IsType(t, sites.ErrDatabase, err)
+
+	repo.EXPECT().GetByName(context.Background(), "missing", "").Return(nil, nil)
+
+	_, err = uc.GetByName(context.Background(), "missing", "")
+	require.Equal(t, sites.ErrNotFound, err)
+}
+
+func TestInsert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		site func() *dto.Site
+		mock func(*mocks.MockSiteRepository)
+		err  error
+	}{
+		{
+			name: "success",
+			site: func() *dto.Site {
+				s := siteDTO()
+				s.Password = "P@ssw0rd"
+
+				return s
+			},
+			mock: func(repo *mocks.MockSiteRepository) {
+				repo.EXPECT().Insert(context.Background(), siteEntity()).Return(nil)
+				repo.EXPECT().GetByName(context.Background(), "branch", "").Return(siteEntity(), nil)
+			},
+		},
+		{
+			name: "invalid subnet",
+			site: func() *dto.Site {
+				s := siteDTO()
+				s.Subnets = []string{"10.20.0.0"}
+
+				return s
+			},
+			mock: func(*mocks.MockSiteRepository) {},
+			err:  sites.ErrValidation,
+		},
+		{
+			name: "invalid proxy",
+			site: func() *dto.Site {
+				s := siteDTO()
+				s.Proxy = "ftp://jump.example.com"
+
+				return s
+			},
+			mock: func(*mocks.MockSiteRepository) {},
+			err:  sites.ErrValidation,
+		},
+		{
+			name: "unknown TLS policy",
+			site: func() *dto.Site {
+				s := siteDTO()
+				s.TLSPolicy = "always"
+
+				return s
+			},
+			mock: func(*mocks.MockSiteRepository) {},
+			err:  sites.ErrValidation,
+		},
+		{
+			name: "database error",
+			site: siteDTO,
+			mock: func(repo *mocks.MockSiteRepository) {
+				repo.EXPECT().Insert(context.Background(), gomock.Any()).Return(errTest)
+			},
+			err: sites.ErrDatabase,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, repo := sitesTest(t)
+			tc.mock(repo)
+
+			site, err := uc.Insert(context.Background(), tc.site())
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, siteDTO(), site)
+		})
+	}
+}
+
+func TestUpdateKeepsPassword(t *testing.T) {
+	t.Parallel()
+
+	uc, repo := sitesTest(t)
+
+	current := siteEntity()
+	current.Password = "current"
+
+	updated := siteEntity()
+	updated.Password = "current"
+
+	repo.EXPECT().GetByName(context.Background(), "branch", "").Return(current, nil)
+	repo.EXPECT().Update(context.Background(), updated).Return(true, nil)
+	repo.EXPECT().GetByName(context.Background(), "branch", "").Return(updated, nil)
+
+	site, err := uc.Update(context.Background(), siteDTO())
+	require.NoError(t, err)
+	require.Equal(t, siteDTO(), site)
+
+	repo.EXPECT().GetByName(context.Background(), "branch", "").Return(nil, nil)
+
+	_, err = uc.Update(context.Background(), siteDTO())
+	require.Equal(t, sites.ErrNotFound, err)
+
+	repo.EXPECT().Delete(context.Background(), "branch", "").Return(false, nil)
+	require.Equal(t, sites.ErrNotFound, uc.Delete(context.Background(), "branch", ""))
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	lab := entity.Site{Name: "lab", Subnets: "10.20.30.0/24", TLSPolicy: dto.SiteTLSVerified}
+	sitesOfTenant := []entity.Site{*siteEntity(), lab}
+
+	tests := []struct {
+		name   string
+		device entity.Device
+		want   entity.Device
+	}{
+		{
+			name:   "site defaults",
+			device: entity.Device{GUID: "guid", Hostname: "10.20.1.5"},
+			want: entity.Device{
+				GUID: "guid", Hostname: "10.20.1.5", Proxy: "socks5://jump.example.com",
+				UseTLS: true, AllowSelfSigned: true, Username: "admin", Password: "encrypted",
+			},
+		},
+		{
+			name:   "credentials of the device are kept",
+			device: entity.Device{GUID: "guid", Hostname: "[fd00:20::5]", Username: "amt", Password: "own"},
+			want: entity.Device{
+				GUID: "guid", Hostname: "[fd00:20::5]", Proxy: "socks5://jump.example.com",
+				UseTLS: true, AllowSelfSigned: true, Username: "amt", Password: "own",
+			},
+		},
+		{
+			name:   "most specific subnet",
+			device: entity.Device{GUID: "guid", Hostname: "10.20.30.40", AllowSelfSigned: true},
+			want:   entity.Device{GUID: "guid", Hostname: "10.20.30.40", UseTLS: true},
+		},
+		{
+			name:   "outside of all sites",
+			device: entity.Device{GUID: "guid", Hostname: "192.168.1.5", Username: "amt"},
+			want:   entity.Device{GUID: "guid", Hostname: "192.168.1.5", Username: "amt"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, repo := sitesTest(t)
+			repo.EXPECT().GetAll(context.Background(), "").Return(sitesOfTenant, nil)
+
+			device, err := uc.Apply(context.Background(), tc.device)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, device)
+		})
+	}
+}
+
+func TestLocate(t *testing.T) {
+	t.Parallel()
+
+	lab := entity.Site{Name: "lab", Subnets: "10.20.30.0/24", TLSPolicy: dto.SiteTLSVerified}
+	sitesOfTenant := []entity.Site{*siteEntity(), lab}
+
+	tests := []struct {
+		name      string
+		addresses []string
+		queried   bool
+		want      string
+	}{
+		{name: "site of an address", addresses: []string{"10.20.1.5"}, queried: true, want: "branch"},
+		{name: "most specific subnet", addresses: []string{"192.168.1.5", "10.20.30.40"}, queried: true, want: "lab"},
+		{name: "outside of all sites", addresses: []string{"192.168.1.5"}, queried: true},
+		{name: "no address", addresses: []string{"amt01.example.com"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, repo := sitesTest(t)
+			if tc.queried {
+				repo.EXPECT().GetAll(context.Background(), "").Return(sitesOfTenant, nil)
+			}
+
+			site, err := uc.Locate(context.Background(), tc.addresses, "")
+			require.NoError(t, err)
+
+			if tc.want == "" {
+				require.Nil(t, site)
+
+				return
+			}
+
+			require.NotNil(t, site)
+			require.Equal(t, tc.want, site.Name)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package snmptraps
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	// Devices is the part of the devices use case the devices are checked through.
+	Devices interface {
+		Get(ctx context.Context, top, skip int, tenantID string) ([]dto.Device, error)
+		GetPowerState(ctx context.Context, guid string) (dto.PowerState, error)
+		GetDeviceCertificate(c context.Context, guid string) (dto.Certificate, error)
+		GetEventLog(ctx context.Context, startIndex, maxReadRecords int, guid string) (dto.EventLogs, error)
+	}
+
+	// Sender delivers traps to the trap receiver.
+	Sender interface {
+		Send(ctx context.Context, trap Trap) error
+	}
+
+	Feature interface {
+		Start(ctx context.Context)
+	}
+)
@@ -0,0 +1,374 @@
+package snmptraps
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // HMAC-SHA-96 is the authentication protocol of SNMPv3 USM
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/ber"
+)
+
+const (
+	defaultPort = "162"
+	sendTimeout = 10 * time.Second
+
+	// BER tags of the SNMP types used in traps.
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagOID         = 0x06
+	tagSequence    = 0x30
+	tagTimeTicks   = 0x43
+	tagTrapPDU     = 0xa7
+
+	versionV2c = 1
+	versionV3  = 3
+
+	usmSecurityModel = 3
+	maxMessageSize   = 65507
+	flagAuth         = 0x01
+	flagPriv         = 0x02
+	authParamsLen    = 12
+	// passwordKeyLen is the number of password bytes hashed into a user key, RFC 3414 A.2.
+	passwordKeyLen = 1048576
+
+	oidSysUpTime   = "1.3.6.1.2.1.1.3.0"
+	oidSnmpTrapOID = "1.3.6.1.6.3.1.1.4.1.0"
+)
+
+var (
+	ErrNoAddress       = errors.New("snmp trap receiver address is required")
+	ErrUnknownVersion  = errors.New("unknown snmp version, expected 2c or 3")
+	ErrNoUser          = errors.New("snmpv3 user is required")
+	ErrPrivWithoutAuth = errors.New("snmpv3 privacy requires an auth password")
+	ErrEngineID        = errors.New("snmpv3 engine id must be 5 to 32 hex encoded bytes")
+	ErrInvalidOID      = errors.New("invalid object identifier")
+)
+
+// TrapSender sends SNMPv2c or SNMPv3 traps over UDP.
+type TrapSender struct {
+	address   string
+	version   int
+	community string
+	user      string
+	engineID  []byte
+	authKey   []byte
+	privKey   []byte
+	started   time.Time
+	requestID atomic.Int32
+}
+
+// NewTrapSender creates the sender of the configured receiver. The SNMPv3 keys are localized
+// once here as hashing the passwords is deliberately slow.
+func NewTrapSender(cfg config.SNMPTraps) (*TrapSender, error) {
+	if cfg.Address == "" {
+		return nil, ErrNoAddress
+	}
+
+	address := cfg.Address
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, defaultPort)
+	}
+
+	s := &TrapSender{address: address, started: time.Now()}
+
+	switch cfg.Version {
+	case "", "2c":
+		s.version = versionV2c
+		s.community = cfg.Community
+	case "3":
+		if err := s.setupUSM(cfg); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnknownVersion
+	}
+
+	return s, nil
+}
+
+func (s *TrapSender) setupUSM(cfg config.SNMPTraps) error {
+	if cfg.User == "" {
+		return ErrNoUser
+	}
+
+	if cfg.PrivPassword != "" && cfg.AuthPassword == "" {
+		return ErrPrivWithoutAuth
+	}
+
+	engineID, err := hex.DecodeString(cfg.EngineID)
+	if err != nil || len(engineID) < 5 || len(engineID) > 32 {
+		return ErrEngineID
+	}
+
+	s.version = versionV3
+	s.user = cfg.User
+	s.engineID = engineID
+
+	if cfg.AuthPassword != "" {
+		s.authKey = localizeKey(cfg.AuthPassword, engineID)
+	}
+
+	if cfg.PrivPassword != "" {
+		s.privKey = localizeKey(cfg.PrivPassword, engineID)[:aes.BlockSize]
+	}
+
+	return nil
+}
+
+// Send sends a trap, varbinds are sent as octet strings after sysUpTime and snmpTrapOID.
+func (s *TrapSender) Send(ctx context.Context, trap Trap) error {
+	message, err := s.message(trap)
+	if err != nil {
+		return err
+	}
+
+	dialer := net.Dialer{Timeout: sendTimeout}
+
+	conn, err := dialer.DialContext(ctx, "udp", s.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.SetWriteDeadline(time.Now().Add(sendTimeout)); err != nil {
+		return err
+	}
+
+	_, err = conn.Write(message)
+
+	return err
+}
+
+func (s *TrapSender) message(trap Trap) ([]byte, error) {
+	pdu, err := s.trapPDU(trap)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.version == versionV2c {
+		return ber.TLV(tagSequence, ber.Integer(tagInteger, versionV2c), ber.TLV(tagOctetString, []byte(s.community)), pdu), nil
+	}
+
+	return s.messageV3(pdu)
+}
+
+func (s *TrapSender) trapPDU(trap Trap) ([]byte, error) {
+	trapOID, err := encodeOID(trap.OID)
+	if err != nil {
+		return nil, err
+	}
+
+	upTime, err := encodeOID(oidSysUpTime)
+	if err != nil {
+		return nil, err
+	}
+
+	snmpTrapOID, err := encodeOID(oidSnmpTrapOID)
+	if err != nil {
+		return nil, err
+	}
+
+	// sysUpTime is in hundredths of a second and wraps like a Counter32
+	ticks := uint32(time.Since(s.started).Milliseconds() / 10) //nolint:gosec // wrapping is intended
+
+	varbinds := [][]byte{
+		ber.TLV(tagSequence, upTime, ber.TLV(tagTimeTicks, encodeUnsigned(uint64(ticks)))),
+		ber.TLV(tagSequence, snmpTrapOID, trapOID),
+	}
+
+	for _, v := range trap.Varbinds {
+		oid, err := encodeOID(v.OID)
+		if err != nil {
+			return nil, err
+		}
+
+		varbinds = append(varbinds, ber.TLV(tagSequence, oid, ber.TLV(tagOctetString, []byte(v.Value))))
+	}
+
+	return ber.TLV(tagTrapPDU,
+		ber.Integer(tagInteger, int64(s.requestID.Add(1))),
+		ber.Integer(tagInteger, 0),
+		ber.Integer(tagInteger, 0),
+		ber.TLV(tagSequence, varbinds...),
+	), nil
+}
+
+// messageV3 wraps the PDU in a USM message of the console's engine, RFC 3412 and RFC 3414.
+func (s *TrapSender) messageV3(pdu []byte) ([]byte, error) {
+	var flags byte
+
+	authParams := []byte{}
+	privParams := []byte{}
+
+	if s.authKey != nil {
+		flags |= flagAuth
+		authParams = make([]byte, authParamsLen)
+	}
+
+	boots := int64(1)
+	engineTime := int64(time.Since(s.started).Seconds())
+
+	data := ber.TLV(tagSequence, ber.TLV(tagOctetString, s.engineID), ber.TLV(tagOctetString, nil), pdu)
+
+	if s.privKey != nil {
+		flags |= flagPriv
+		privParams = make([]byte, aes.BlockSize/2)
+
+		if _, err := rand.Read(privParams); err != nil {
+			return nil, err
+		}
+
+		encrypted, err := encryptAES(s.privKey, boots, engineTime, privParams, data)
+		if err != nil {
+			return nil, err
+		}
+
+		data = ber.TLV(tagOctetString, encrypted)
+	}
+
+	// the auth params follow these fields, their offset is needed to fill in the MAC
+	securityPrefix := ber.Concat(
+		ber.TLV(tagOctetString, s.engineID),
+		ber.Integer(tagInteger, boots),
+		ber.Integer(tagInteger, engineTime),
+		ber.TLV(tagOctetString, []byte(s.user)),
+	)
+	authTLV := ber.TLV(tagOctetString, authParams)
+	securityContent := ber.Concat(securityPrefix, authTLV, ber.TLV(tagOctetString, privParams))
+	securityParams := ber.TLV(tagSequence, securityContent)
+	securityOctets := ber.TLV(tagOctetString, securityParams)
+
+	version := ber.Integer(tagInteger, versionV3)
+	globalData := ber.TLV(tagSequence,
+		ber.Integer(tagInteger, int64(s.requestID.Load())),
+		ber.Integer(tagInteger, maxMessageSize),
+		ber.TLV(tagOctetString, []byte{flags}),
+		ber.Integer(tagInteger, usmSecurityModel),
+	)
+
+	body := ber.Concat(version, globalData, securityOctets, data)
+	message := ber.TLV(tagSequence, body)
+
+	if s.authKey != nil {
+		// the MAC is computed over the message with zeroed auth params and then put in their place
+		mac := hmac.New(sha1.New, s.authKey)
+		mac.Write(message)
+
+		offset := len(message) - len(body) + len(version) + len(globalData) +
+			len(securityOctets) - len(securityParams) + len(securityParams) - len(securityContent) +
+			len(securityPrefix) + len(authTLV) - authParamsLen
+		copy(message[offset:], mac.Sum(nil)[:authParamsLen])
+	}
+
+	return message, nil
+}
+
+// localizeKey derives the key of a user for an engine from a password with SHA-1, RFC 3414 A.2.2.
+func localizeKey(password string, engineID []byte) []byte {
+	hash := sha1.New() //nolint:gosec // required by RFC 3414
+
+	pw := []byte(password)
+	chunk := make([]byte, 64)
+
+	for i := 0; i < passwordKeyLen; i += len(chunk) {
+		for j := range chunk {
+			chunk[j] = pw[(i+j)%len(pw)]
+		}
+
+		hash.Write(chunk)
+	}
+
+	key := hash.Sum(nil)
+
+	hash.Reset()
+	hash.Write(key)
+	hash.Write(engineID)
+	hash.Write(key)
+
+	return hash.Sum(nil)
+}
+
+// encryptAES encrypts a scoped PDU with AES-128 in CFB mode, RFC 3826.
+func encryptAES(key []byte, boots, engineTime int64, salt, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	binary.BigEndian.PutUint32(iv, uint32(boots))          //nolint:gosec // engine boots and time fit in 31 bits
+	binary.BigEndian.PutUint32(iv[4:], uint32(engineTime)) //nolint:gosec // engine boots and time fit in 31 bits
+	copy(iv[8:], salt)
+
+	encrypted := make([]byte, len(data))
+	cipher.NewCFBEncrypter(block, iv).XORKeyStream(encrypted, data) //nolint:staticcheck // CFB is required by RFC 3826
+
+	return encrypted, nil
+}
+
+// encodeUnsigned encodes the contents of an unsigned application type such as TimeTicks.
+func encodeUnsigned(value uint64) []byte {
+	content := []byte{byte(value)}
+
+	for v := value >> 8; v > 0; v >>= 8 {
+		content = append([]byte{byte(v)}, content...)
+	}
+
+	if content[0]&0x80 != 0 {
+		content = append([]byte{0}, content...)
+	}
+
+	return content
+}
+
+func encodeOID(oid string) ([]byte, error) {
+	parts := strings.Split(strings.TrimPrefix(oid, "."), ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOID, oid)
+	}
+
+	arcs := make([]uint64, len(parts))
+
+	for i, p := range parts {
+		arc, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidOID, oid)
+		}
+
+		arcs[i] = arc
+	}
+
+	if arcs[0] > 2 || (arcs[0] < 2 && arcs[1] > 39) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOID, oid)
+	}
+
+	content := encodeBase128(arcs[0]*40 + arcs[1])
+	for _, arc := range arcs[2:] {
+		content = append(content, encodeBase128(arc)...)
+	}
+
+	return ber.TLV(tagOID, content), nil
+}
+
+func encodeBase128(value uint64) []byte {
+	out := []byte{byte(value & 0x7f)}
+
+	for v := value >> 7; v > 0; v >>= 7 {
+		out = append([]byte{byte(v&0x7f) | 0x80}, out...)
+	}
+
+	return out
+}
@@ -0,0 +1,218 @@
+package snmptraps
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // HMAC-SHA-96 is the authentication protocol of SNMPv3 USM
+	"encoding/binary"
+	"encoding/hex"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+var testTrap = Trap{
+	OID:      TrapDeviceUnreachable,
+	Varbinds: []Varbind{{OID: OIDDeviceGUID, Value: "guid1"}},
+}
+
+// readTLV splits the first BER encoding off b.
+func readTLV(t *testing.T, b []byte) (tag byte, content, rest []byte) {
+	t.Helper()
+
+	require.GreaterOrEqual(t, len(b), 2)
+
+	length, header := int(b[1]), 2
+	if b[1]&0x80 != 0 {
+		n := int(b[1] & 0x7f)
+		length = 0
+
+		for _, digit := range b[2 : 2+n] {
+			length = length<<8 | int(digit)
+		}
+
+		header += n
+	}
+
+	require.GreaterOrEqual(t, len(b), header+length)
+
+	return b[0], b[header : header+length], b[header+length:]
+}
+
+func TestEncodeOID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		oid      string
+		expected string
+		err      error
+	}{
+		{oid: "1.3.6.1.4.1.32473", expected: "06082b0601040181fd59"},
+		{oid: ".1.3.6.1.2.1.1.3.0", expected: "06082b06010201010300"},
+		{oid: "1", err: ErrInvalidOID},
+		{oid: "1.3.x", err: ErrInvalidOID},
+		{oid: "1.40", err: ErrInvalidOID},
+	}
+
+	for _, tc := range tests {
+		encoded, err := encodeOID(tc.oid)
+
+		require.ErrorIs(t, err, tc.err, tc.oid)
+		require.Equal(t, tc.expected, hex.EncodeToString(encoded), tc.oid)
+	}
+}
+
+func TestLocalizeKey(t *testing.T) {
+	t.Parallel()
+
+	// RFC 3414 A.3.2
+	engineID, err := hex.DecodeString("000000000000000000000002")
+	require.NoError(t, err)
+	require.Equal(t, "6695febc9288e36282235fc7151f128497b38f3f", hex.EncodeToString(localizeKey("maplesyrup", engineID)))
+}
+
+func TestNewTrapSender(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  config.SNMPTraps
+		err  error
+	}{
+		{name: "v2c", cfg: config.SNMPTraps{Address: "localhost", Version: "2c", Community: "public"}},
+		{name: "v3 without auth", cfg: config.SNMPTraps{Address: "localhost", Version: "3", User: "console", EngineID: "80007ed904636f6e736f6c65"}},
+		{name: "no address", cfg: config.SNMPTraps{Version: "2c"}, err: ErrNoAddress},
+		{name: "unknown version", cfg: config.SNMPTraps{Address: "localhost", Version: "1"}, err: ErrUnknownVersion},
+		{name: "v3 without user", cfg: config.SNMPTraps{Address: "localhost", Version: "3"}, err: ErrNoUser},
+		{name: "v3 privacy without auth", cfg: config.SNMPTraps{Address: "localhost", Version: "3", User: "console", PrivPassword: "privpassword"}, err: ErrPrivWithoutAuth},
+		{name: "v3 invalid engine id", cfg: config.SNMPTraps{Address: "localhost", Version: "3", User: "console", EngineID: "zz"}, err: ErrEngineID},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sender, err := NewTrapSender(tc.cfg)
+
+			require.ErrorIs(t, err, tc.err)
+
+			if tc.err == nil {
+				require.Equal(t, "localhost:162", sender.address)
+			}
+		})
+	}
+}
+
+func TestSendV2c(t *testing.T) {
+	t.Parallel()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	sender, err := NewTrapSender(config.SNMPTraps{Address: conn.LocalAddr().String(), Version: "2c", Community: "public"})
+	require.NoError(t, err)
+	require.NoError(t, sender.Send(context.Background(), testTrap))
+
+	buf := make([]byte, 2048)
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+
+	tag, message, rest := readTLV(t, buf[:n])
+	require.Equal(t, byte(tagSequence), tag)
+	require.Empty(t, rest)
+
+	_, version, message := readTLV(t, message)
+	require.Equal(t, []byte{versionV2c}, version)
+
+	_, community, message := readTLV(t, message)
+	require.Equal(t, "public", string(community))
+
+	tag, pdu, _ := readTLV(t, message)
+	require.Equal(t, byte(tagTrapPDU), tag)
+
+	trapOID, err := encodeOID(TrapDeviceUnreachable)
+	require.NoError(t, err)
+	require.True(t, bytes.Contains(pdu, trapOID))
+	require.True(t, bytes.Contains(pdu, []byte("guid1")))
+}
+
+func TestMessageV3AuthPriv(t *testing.T) {
+	t.Parallel()
+
+	sender, err := NewTrapSender(config.SNMPTraps{
+		Address:      "localhost",
+		Version:      "3",
+		User:         "console",
+		AuthPassword: "authpassword",
+		PrivPassword: "privpassword",
+		EngineID:     "80007ed904636f6e736f6c65",
+	})
+	require.NoError(t, err)
+
+	message, err := sender.message(testTrap)
+	require.NoError(t, err)
+
+	_, content, _ := readTLV(t, message)
+	_, version, content := readTLV(t, content)
+	require.Equal(t, []byte{versionV3}, version)
+
+	_, globalData, content := readTLV(t, content)
+	_, _, globalData = readTLV(t, globalData)
+	_, _, globalData = readTLV(t, globalData)
+	_, flags, _ := readTLV(t, globalData)
+	require.Equal(t, []byte{flagAuth | flagPriv}, flags)
+
+	_, securityOctets, content := readTLV(t, content)
+	_, securityParams, _ := readTLV(t, securityOctets)
+	_, engineID, securityParams := readTLV(t, securityParams)
+	require.Equal(t, sender.engineID, engineID)
+
+	_, boots, securityParams := readTLV(t, securityParams)
+	_, engineTime, securityParams := readTLV(t, securityParams)
+	_, user, securityParams := readTLV(t, securityParams)
+	require.Equal(t, "console", string(user))
+
+	_, authParams, securityParams := readTLV(t, securityParams)
+	_, privParams, _ := readTLV(t, securityParams)
+	require.Len(t, authParams, authParamsLen)
+	require.Len(t, privParams, 8)
+
+	// the MAC is over the message with zeroed auth params
+	unsigned := bytes.Clone(message)
+	offset := bytes.Index(message, authParams)
+	copy(unsigned[offset:], make([]byte, authParamsLen))
+
+	mac := hmac.New(sha1.New, sender.authKey)
+	mac.Write(unsigned)
+	require.Equal(t, mac.Sum(nil)[:authParamsLen], authParams)
+
+	tag, encrypted, _ := readTLV(t, content)
+	require.Equal(t, byte(tagOctetString), tag)
+
+	block, err := aes.NewCipher(sender.privKey)
+	require.NoError(t, err)
+
+	iv := make([]byte, aes.BlockSize)
+	binary.BigEndian.PutUint32(iv, uint32(boots[0]))
+	binary.BigEndian.PutUint32(iv[4:], uint32(engineTime[0]))
+	copy(iv[8:], privParams)
+
+	scopedPDU := make([]byte, len(encrypted))
+	cipher.NewCFBDecrypter(block, iv).XORKeyStream(scopedPDU, encrypted) //nolint:staticcheck // CFB is required by RFC 3826
+
+	_, scoped, _ := readTLV(t, scopedPDU)
+	_, contextEngineID, scoped := readTLV(t, scoped)
+	require.Equal(t, sender.engineID, contextEngineID)
+
+	_, _, scoped = readTLV(t, scoped)
+	tag, _, _ = readTLV(t, scoped)
+	require.Equal(t, byte(tagTrapPDU), tag)
+}
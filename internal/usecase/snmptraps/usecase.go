@@ -0,0 +1,339 @@
+package snmptraps
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	// enterpriseOID is the root of the console traps, 32473 is the enterprise number reserved for documentation.
+	enterpriseOID = "1.3.6.1.4.1.32473.1"
+
+	TrapDeviceUnreachable  = enterpriseOID + ".0.1"
+	TrapCertificateExpired = enterpriseOID + ".0.2"
+	TrapPowerFailure       = enterpriseOID + ".0.3"
+
+	OIDDeviceGUID     = enterpriseOID + ".1.1"
+	OIDDeviceHostname = enterpriseOID + ".1.2"
+	OIDDescription    = enterpriseOID + ".1.3"
+
+	devicesPerPage      = 100
+	maxConcurrentChecks = 8
+	// maxEventRecords is the most event log records AMT returns per request.
+	maxEventRecords = 390
+
+	// certExpiryWarning is how long before it expires a device certificate is reported as expiring.
+	certExpiryWarning = 30 * 24 * time.Hour
+
+	// eventTimeLayout is the layout the devices use case formats event times with.
+	eventTimeLayout = "2006-01-02 15:04:05 -0700 MST"
+
+	// IPMI sensor types and offsets of power failures.
+	sensorPowerSupply          = 8
+	offsetPowerSupplyFailure   = 1
+	offsetPowerSupplyInputLost = 3
+	sensorPowerUnit            = 9
+	offsetPowerUnitACLost      = 4
+	offsetPowerUnitFailure     = 6
+)
+
+// Trap is an SNMPv2 trap, the values of its varbinds are sent as octet strings.
+type Trap struct {
+	OID      string
+	Varbinds []Varbind
+}
+
+type Varbind struct {
+	OID   string
+	Value string
+}
+
+// deviceState remembers the conditions traps were sent for, so that a condition is reported
+// once when it starts rather than on every check.
+type deviceState struct {
+	unreachable  bool
+	expiringCert string
+	expiredCert  string
+	eventsRead   bool
+	lastEvent    time.Time
+}
+
+// UseCase checks every device for critical conditions and sends a trap when one starts:
+// the device becomes unreachable, its TLS certificate expires or a power failure is logged.
+// Expiring certificates are published on the event bus for every notification channel,
+// the traps of expired certificates are sent by the subscription of this use case.
+type UseCase struct {
+	devices  Devices
+	sender   Sender
+	events   *eventbus.Bus
+	log      logger.Interface
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	states map[string]*deviceState
+}
+
+// New creates the trap emitter. A nil sender or an interval of 0 disables the traps.
+func New(d Devices, sender Sender, events *eventbus.Bus, log logger.Interface, interval time.Duration) *UseCase {
+	return &UseCase{
+		devices:  d,
+		sender:   sender,
+		events:   events,
+		log:      log,
+		interval: interval,
+		now:      time.Now,
+		states:   map[string]*deviceState{},
+	}
+}
+
+// Start checks the devices in the background until ctx is done. It does nothing while the traps are disabled.
+func (uc *UseCase) Start(ctx context.Context) {
+	if uc.interval <= 0 || uc.sender == nil {
+		return
+	}
+
+	uc.log.Info("snmptraps - Start: checking devices for critical conditions every %s", uc.interval)
+
+	unsubscribe := eventbus.Subscribe(uc.events, eventbus.CertExpiring, uc.NotifyCertExpiring)
+
+	go func() {
+		defer unsubscribe()
+
+		ticker := time.NewTicker(uc.interval)
+		defer ticker.Stop()
+
+		for {
+			uc.Check(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Check checks every device once.
+func (uc *UseCase) Check(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, maxConcurrentChecks)
+
+	for skip := 0; ; skip += devicesPerPage {
+		page, err := uc.devices.Get(ctx, devicesPerPage, skip, "")
+		if err != nil {
+			uc.log.Error(err, "snmptraps - Check - uc.devices.Get")
+
+			break
+		}
+
+		for i := range page {
+			device := page[i]
+
+			wg.Add(1)
+
+			sem <- struct{}{}
+
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				uc.checkDevice(ctx, &device)
+			}()
+		}
+
+		if len(page) < devicesPerPage {
+			break
+		}
+	}
+
+	wg.Wait()
+}
+
+func (uc *UseCase) state(guid string) *deviceState {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	state, ok := uc.states[guid]
+	if !ok {
+		state = &deviceState{}
+		uc.states[guid] = state
+	}
+
+	return state
+}
+
+func (uc *UseCase) checkDevice(ctx context.Context, device *dto.Device) {
+	state := uc.state(device.GUID)
+
+	if _, err := uc.devices.GetPowerState(ctx, device.GUID); err != nil {
+		if ctx.Err() != nil || state.unreachable {
+			return
+		}
+
+		state.unreachable = uc.send(ctx, device, TrapDeviceUnreachable, "device is unreachable: "+err.Error())
+
+		return
+	}
+
+	state.unreachable = false
+
+	uc.checkCertificate(ctx, device, state)
+	uc.checkPowerFailures(ctx, device, state)
+}
+
+// checkCertificate publishes the certificate of a device once when it is about to expire and once when it has expired.
+func (uc *UseCase) checkCertificate(ctx context.Context, device *dto.Device, state *deviceState) {
+	cert, err := uc.devices.GetDeviceCertificate(ctx, device.GUID)
+	if err != nil {
+		uc.log.Debug("snmptraps - checkCertificate: certificate of device %s not read: %v", device.GUID, err)
+
+		return
+	}
+
+	event := eventbus.CertExpiringEvent{
+		GUID:              device.GUID,
+		Hostname:          device.Hostname,
+		CommonName:        cert.CommonName,
+		SHA256Fingerprint: cert.SHA256Fingerprint,
+		NotAfter:          cert.NotAfter,
+	}
+
+	now := uc.now()
+
+	switch {
+	case cert.NotAfter.IsZero() || now.Add(certExpiryWarning).Before(cert.NotAfter):
+		return
+	case event.Expired(now):
+		if state.expiredCert == cert.SHA256Fingerprint {
+			return
+		}
+
+		state.expiredCert = cert.SHA256Fingerprint
+	default:
+		if state.expiringCert == cert.SHA256Fingerprint {
+			return
+		}
+
+		state.expiringCert = cert.SHA256Fingerprint
+	}
+
+	eventbus.Publish(uc.events, eventbus.CertExpiring, event)
+}
+
+// NotifyCertExpiring sends a trap for a certificate that has expired.
+func (uc *UseCase) NotifyCertExpiring(e eventbus.CertExpiringEvent) {
+	if uc.sender == nil || !e.Expired(uc.now()) {
+		return
+	}
+
+	description := fmt.Sprintf("certificate %s expired %s", e.CommonName, e.NotAfter.UTC().Format(time.RFC3339))
+
+	uc.send(context.Background(), &dto.Device{GUID: e.GUID, Hostname: e.Hostname}, TrapCertificateExpired, description)
+}
+
+// checkPowerFailures reports the power failures logged since the last check. The first check of
+// a device only notes the newest record, so that old failures are not reported on every start.
+func (uc *UseCase) checkPowerFailures(ctx context.Context, device *dto.Device, state *deviceState) {
+	newest := state.lastEvent
+
+	var failures []powerEvent
+
+	for startIndex := 1; ; {
+		page, err := uc.devices.GetEventLog(ctx, startIndex, maxEventRecords, device.GUID)
+		if err != nil {
+			uc.log.Debug("snmptraps - checkPowerFailures: event log of device %s not read: %v", device.GUID, err)
+
+			return
+		}
+
+		for i := range page.Records {
+			at, err := time.Parse(eventTimeLayout, page.Records[i].Time)
+			if err != nil || !at.After(state.lastEvent) {
+				continue
+			}
+
+			if at.After(newest) {
+				newest = at
+			}
+
+			if powerFailure(&page.Records[i]) {
+				failures = append(failures, powerEvent{at: at, event: page.Records[i]})
+			}
+		}
+
+		startIndex += len(page.Records)
+
+		if !page.HasMoreRecords || len(page.Records) == 0 {
+			break
+		}
+	}
+
+	if !state.eventsRead {
+		state.eventsRead = true
+		state.lastEvent = newest
+
+		return
+	}
+
+	sort.SliceStable(failures, func(i, j int) bool { return failures[i].at.Before(failures[j].at) })
+
+	for i := range failures {
+		event := &failures[i].event
+
+		description := fmt.Sprintf("%s: %s at %s", event.Entity, event.Description, failures[i].at.UTC().Format(time.RFC3339))
+		if !uc.send(ctx, device, TrapPowerFailure, description) {
+			// the failures not sent are reported again by the next check
+			return
+		}
+
+		state.lastEvent = failures[i].at
+	}
+
+	state.lastEvent = newest
+}
+
+type powerEvent struct {
+	at    time.Time
+	event dto.EventLog
+}
+
+func powerFailure(event *dto.EventLog) bool {
+	switch event.EventSensorType {
+	case sensorPowerSupply:
+		return event.EventOffset == offsetPowerSupplyFailure || event.EventOffset == offsetPowerSupplyInputLost
+	case sensorPowerUnit:
+		return event.EventOffset == offsetPowerUnitACLost || event.EventOffset == offsetPowerUnitFailure
+	default:
+		return false
+	}
+}
+
+// send sends a trap about a device, false when it could not be sent.
+func (uc *UseCase) send(ctx context.Context, device *dto.Device, oid, description string) bool {
+	trap := Trap{
+		OID: oid,
+		Varbinds: []Varbind{
+			{OID: OIDDeviceGUID, Value: device.GUID},
+			{OID: OIDDeviceHostname, Value: device.Hostname},
+			{OID: OIDDescription, Value: description},
+		},
+	}
+
+	if err := uc.sender.Send(ctx, trap); err != nil {
+		uc.log.Warn("snmptraps - send: trap %s of device %s not sent: %v", oid, device.GUID, err)
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,194 @@
+package snmptraps_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/snmptraps"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var errUnreachable = errors.New("device unreachable")
+
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func eventAt(minutes, sensorType, offset int) dto.EventLog {
+	return dto.EventLog{
+		EventSensorType: sensorType,
+		EventOffset:     offset,
+		Entity:          "Power supply",
+		Description:     "Power supply failure",
+		Time:            start.Add(time.Duration(minutes) * time.Minute).String(),
+	}
+}
+
+// trapOf matches a trap about guid1 of the given kind.
+func trapOf(oid string) gomock.Matcher {
+	return gomock.Cond(func(trap snmptraps.Trap) bool {
+		return trap.OID == oid && len(trap.Varbinds) == 3 && trap.Varbinds[0].Value == "guid1"
+	})
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	valid := dto.Certificate{NotAfter: time.Now().AddDate(1, 0, 0), SHA256Fingerprint: "valid"}
+
+	tests := []struct {
+		name   string
+		checks int
+		mock   func(*mocks.MockSNMPTrapsDevices, *mocks.MockTrapSender)
+	}{
+		{
+			name:   "unreachable device is reported once until it is reachable again",
+			checks: 4,
+			mock: func(d *mocks.MockSNMPTrapsDevices, sender *mocks.MockTrapSender) {
+				gomock.InOrder(
+					d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{}, errUnreachable).Times(2),
+					d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 2}, nil),
+					d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{}, errUnreachable),
+				)
+				d.EXPECT().GetDeviceCertificate(gomock.Any(), "guid1").Return(valid, nil)
+				d.EXPECT().GetEventLog(gomock.Any(), 1, 390, "guid1").Return(dto.EventLogs{}, nil)
+				sender.EXPECT().Send(gomock.Any(), trapOf(snmptraps.TrapDeviceUnreachable)).Return(nil).Times(2)
+			},
+		},
+		{
+			name:   "unreachable device is reported again when the trap was not sent",
+			checks: 2,
+			mock: func(d *mocks.MockSNMPTrapsDevices, sender *mocks.MockTrapSender) {
+				d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{}, errUnreachable).Times(2)
+				gomock.InOrder(
+					sender.EXPECT().Send(gomock.Any(), trapOf(snmptraps.TrapDeviceUnreachable)).Return(errUnreachable),
+					sender.EXPECT().Send(gomock.Any(), trapOf(snmptraps.TrapDeviceUnreachable)).Return(nil),
+				)
+			},
+		},
+		{
+			name:   "power failures logged after the first check are reported",
+			checks: 3,
+			mock: func(d *mocks.MockSNMPTrapsDevices, sender *mocks.MockTrapSender) {
+				d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 2}, nil).Times(3)
+				d.EXPECT().GetDeviceCertificate(gomock.Any(), "guid1").Return(valid, nil).Times(3)
+				gomock.InOrder(
+					d.EXPECT().GetEventLog(gomock.Any(), 1, 390, "guid1").Return(dto.EventLogs{
+						Records: []dto.EventLog{eventAt(1, 8, 1)},
+					}, nil),
+					d.EXPECT().GetEventLog(gomock.Any(), 1, 390, "guid1").Return(dto.EventLogs{
+						Records: []dto.EventLog{eventAt(5, 9, 4), eventAt(4, 15, 0), eventAt(3, 8, 3), eventAt(1, 8, 1)},
+					}, nil),
+					d.EXPECT().GetEventLog(gomock.Any(), 1, 390, "guid1").Return(dto.EventLogs{
+						Records: []dto.EventLog{eventAt(5, 9, 4), eventAt(4, 15, 0), eventAt(3, 8, 3), eventAt(1, 8, 1)},
+					}, nil),
+				)
+				sender.EXPECT().Send(gomock.Any(), trapOf(snmptraps.TrapPowerFailure)).Return(nil).Times(2)
+			},
+		},
+		{
+			name:   "devices cannot be listed",
+			checks: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			d := mocks.NewMockSNMPTrapsDevices(ctrl)
+			sender := mocks.NewMockTrapSender(ctrl)
+
+			if tc.mock == nil {
+				d.EXPECT().Get(gomock.Any(), 100, 0, "").Return(nil, errUnreachable)
+			} else {
+				d.EXPECT().Get(gomock.Any(), 100, 0, "").Return([]dto.Device{{GUID: "guid1", Hostname: "host1"}}, nil).Times(tc.checks)
+				tc.mock(d, sender)
+			}
+
+			uc := snmptraps.New(d, sender, nil, logger.New("error"), time.Minute)
+
+			for range tc.checks {
+				uc.Check(context.Background())
+			}
+		})
+	}
+}
+
+func TestCheckCertificate(t *testing.T) {
+	t.Parallel()
+
+	expiring := dto.Certificate{CommonName: "amt", NotAfter: time.Now().AddDate(0, 0, 7), SHA256Fingerprint: "expiring"}
+	expired := dto.Certificate{CommonName: "amt", NotAfter: time.Now().Add(-time.Hour), SHA256Fingerprint: "expired"}
+
+	ctrl := gomock.NewController(t)
+	d := mocks.NewMockSNMPTrapsDevices(ctrl)
+
+	d.EXPECT().Get(gomock.Any(), 100, 0, "").Return([]dto.Device{{GUID: "guid1", Hostname: "host1"}}, nil).Times(4)
+	d.EXPECT().GetPowerState(gomock.Any(), "guid1").Return(dto.PowerState{PowerState: 2}, nil).Times(4)
+	d.EXPECT().GetEventLog(gomock.Any(), 1, 390, "guid1").Return(dto.EventLogs{}, nil).Times(4)
+	gomock.InOrder(
+		d.EXPECT().GetDeviceCertificate(gomock.Any(), "guid1").Return(expiring, nil).Times(2),
+		d.EXPECT().GetDeviceCertificate(gomock.Any(), "guid1").Return(expired, nil).Times(2),
+	)
+
+	bus := eventbus.New()
+	defer bus.Close()
+
+	published := make(chan eventbus.CertExpiringEvent, 4)
+	eventbus.Subscribe(bus, eventbus.CertExpiring, func(e eventbus.CertExpiringEvent) { published <- e })
+
+	uc := snmptraps.New(d, mocks.NewMockTrapSender(ctrl), bus, logger.New("error"), time.Minute)
+
+	for range 4 {
+		uc.Check(context.Background())
+	}
+
+	for _, fingerprint := range []string{"expiring", "expired"} {
+		select {
+		case e := <-published:
+			require.Equal(t, fingerprint, e.SHA256Fingerprint)
+			require.Equal(t, "host1", e.Hostname)
+		case <-time.After(time.Second):
+			require.Fail(t, "certificate not published", fingerprint)
+		}
+	}
+
+	require.Never(t, func() bool { return len(published) > 0 }, 50*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestNotifyCertExpiring(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		notAfter time.Time
+		sent     bool
+	}{
+		{name: "expired certificate is trapped", notAfter: time.Now().Add(-time.Hour), sent: true},
+		{name: "expiring certificate is not trapped", notAfter: time.Now().Add(time.Hour)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			sender := mocks.NewMockTrapSender(ctrl)
+
+			if tc.sent {
+				sender.EXPECT().Send(gomock.Any(), trapOf(snmptraps.TrapCertificateExpired)).Return(nil)
+			}
+
+			uc := snmptraps.New(mocks.NewMockSNMPTrapsDevices(ctrl), sender, nil, logger.New("error"), time.Minute)
+			uc.NotifyCertExpiring(eventbus.CertExpiringEvent{GUID: "guid1", Hostname: "host1", CommonName: "amt", NotAfter: tc.notAfter})
+		})
+	}
+}
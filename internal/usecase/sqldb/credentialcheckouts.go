@@ -0,0 +1,128 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// CredentialCheckoutRepo stores the audit trail of the device passwords revealed to users.
+// Times are kept as unix seconds so that the same queries work on sqlite and postgres.
+type CredentialCheckoutRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var ErrCredentialCheckoutDatabase = DatabaseError{Console: consoleerrors.CreateConsoleError("CredentialCheckoutRepo")}
+
+// NewCredentialCheckoutRepo -.
+func NewCredentialCheckoutRepo(database *db.SQL, log logger.Interface) *CredentialCheckoutRepo {
+	return &CredentialCheckoutRepo{database, log}
+}
+
+// Insert -.
+func (r *CredentialCheckoutRepo) Insert(ctx context.Context, c entity.CredentialCheckout) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("credential_checkouts").
+		Columns("id", "guid", "username", "reason", "checked_out_at", "expires_at", "checked_in_at").
+		Values(c.ID, c.GUID, c.Username, c.Reason, c.CheckedOutAt.Unix(), c.ExpiresAt.Unix(), unixOrZero(c.CheckedInAt)).
+		ToSql()
+	if err != nil {
+		return ErrCredentialCheckoutDatabase.Wrap("Insert", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrCredentialCheckoutDatabase.Wrap("Insert", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
+
+// CheckIn sets the check-in time of a checkout not checked in yet, it returns false when there is none.
+func (r *CredentialCheckoutRepo) CheckIn(ctx context.Context, id string, at time.Time) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Update("credential_checkouts").
+		Set("checked_in_at", at.Unix()).
+		Where("id = ? AND checked_in_at = 0", id).
+		ToSql()
+	if err != nil {
+		return false, ErrCredentialCheckoutDatabase.Wrap("CheckIn", "r.Builder", err)
+	}
+
+	res, err := r.Pool.ExecContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return false, ErrCredentialCheckoutDatabase.Wrap("CheckIn", "r.Pool.Exec", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, ErrCredentialCheckoutDatabase.Wrap("CheckIn", "res.RowsAffected", err)
+	}
+
+	return rows > 0, nil
+}
+
+// GetByGUID returns the latest limit checkouts of a device, newest first. A limit of 0 returns all of them.
+func (r *CredentialCheckoutRepo) GetByGUID(ctx context.Context, guid string, limit int) ([]entity.CredentialCheckout, error) {
+	builder := r.Builder.
+		Select("id", "username", "reason", "checked_out_at", "expires_at", "checked_in_at").
+		From("credential_checkouts").
+		Where("guid = ?", guid).
+		OrderBy("checked_out_at DESC", "id")
+
+	if limit > 0 {
+		builder = builder.Limit(uint64(limit))
+	}
+
+	sqlQuery, args, err := builder.ToSql()
+	if err != nil {
+		return nil, ErrCredentialCheckoutDatabase.Wrap("GetByGUID", "r.Builder", err)
+	}
+
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrCredentialCheckoutDatabase.Wrap("GetByGUID", "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	checkouts := make([]entity.CredentialCheckout, 0)
+
+	for rows.Next() {
+		var checkedOutAt, expiresAt, checkedInAt int64
+
+		c := entity.CredentialCheckout{GUID: guid}
+
+		if err = rows.Scan(&c.ID, &c.Username, &c.Reason, &checkedOutAt, &expiresAt, &checkedInAt); err != nil {
+			return nil, ErrCredentialCheckoutDatabase.Wrap("GetByGUID", "rows.Scan", err)
+		}
+
+		c.CheckedOutAt = time.Unix(checkedOutAt, 0).UTC()
+		c.ExpiresAt = time.Unix(expiresAt, 0).UTC()
+
+		if checkedInAt != 0 {
+			c.CheckedInAt = time.Unix(checkedInAt, 0).UTC()
+		}
+
+		checkouts = append(checkouts, c)
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrCredentialCheckoutDatabase.Wrap("GetByGUID", "rows.Err", rows.Err())
+	}
+
+	return checkouts, nil
+}
+
+// unixOrZero stores the zero time as 0 rather than the far negative Unix of year 1.
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
@@ -0,0 +1,76 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupCredentialCheckoutTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE credential_checkouts (
+			id TEXT NOT NULL,
+			guid TEXT NOT NULL,
+			username TEXT NOT NULL,
+			reason TEXT NOT NULL,
+			checked_out_at BIGINT NOT NULL,
+			expires_at BIGINT NOT NULL,
+			checked_in_at BIGINT NOT NULL DEFAULT 0,
+			PRIMARY KEY (id)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestCredentialCheckoutRepo(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupCredentialCheckoutTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewCredentialCheckoutRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := entity.CredentialCheckout{ID: "1", GUID: "guid1", Username: "jdoe", Reason: "kiosk", CheckedOutAt: start, ExpiresAt: start.Add(15 * time.Minute)}
+	second := entity.CredentialCheckout{ID: "2", GUID: "guid1", Username: "admin", Reason: "audit", CheckedOutAt: start.Add(time.Hour), ExpiresAt: start.Add(2 * time.Hour)}
+
+	for _, c := range []entity.CredentialCheckout{first, second, {ID: "3", GUID: "guid2", Username: "jdoe", Reason: "kiosk", CheckedOutAt: start, ExpiresAt: start}} {
+		require.NoError(t, repo.Insert(context.Background(), c))
+	}
+
+	checkedIn, err := repo.CheckIn(context.Background(), "2", start.Add(90*time.Minute))
+	require.NoError(t, err)
+	require.True(t, checkedIn)
+
+	// a checkout is checked in once
+	checkedIn, err = repo.CheckIn(context.Background(), "2", start.Add(100*time.Minute))
+	require.NoError(t, err)
+	require.False(t, checkedIn)
+
+	second.CheckedInAt = start.Add(90 * time.Minute)
+
+	checkouts, err := repo.GetByGUID(context.Background(), "guid1", 0)
+	require.NoError(t, err)
+	require.Equal(t, []entity.CredentialCheckout{second, first}, checkouts)
+
+	checkouts, err = repo.GetByGUID(context.Background(), "guid1", 1)
+	require.NoError(t, err)
+	require.Equal(t, []entity.CredentialCheckout{second}, checkouts)
+
+	_, err = sqldb.NewCredentialCheckoutRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil)).GetByGUID(context.Background(), "guid1", 0)
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
@@ -1,6 +1,10 @@
 package sqldb
 
-import "github.com/device-management-toolkit/console/pkg/consoleerrors"
+import (
+	"errors"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
 
 type DatabaseError struct {
 	Console consoleerrors.InternalError
@@ -16,3 +20,14 @@ func (e DatabaseError) Wrap(call, function string, err error) error {
 
 	return e
 }
+
+// ErrorCode is the code of the error the query failed with when it has one, e.g. a unique constraint
+// violation, and CodeDatabase otherwise.
+func (e DatabaseError) ErrorCode() consoleerrors.Code {
+	var coder consoleerrors.Coder
+	if errors.As(e.Console.OriginalError, &coder) {
+		return coder.ErrorCode()
+	}
+
+	return consoleerrors.CodeDatabase
+}
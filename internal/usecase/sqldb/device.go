@@ -3,9 +3,15 @@ package sqldb
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/Masterminds/squirrel"
+
 	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/db"
@@ -21,8 +27,57 @@ type DeviceRepo struct {
 var (
 	ErrDeviceDatabase  = DatabaseError{Console: consoleerrors.CreateConsoleError("DeviceRepo")}
 	ErrDeviceNotUnique = NotUniqueError{Console: consoleerrors.CreateConsoleError("DeviceRepo")}
+	ErrAttributesType  = errors.New("unsupported attributes type")
 )
 
+// attributes stores the custom attributes of a device as a JSON object.
+type attributes map[string]string
+
+// Value encodes the attributes, a device without attributes is stored as an empty object.
+func (a attributes) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return "{}", nil
+	}
+
+	b, err := json.Marshal(map[string]string(a))
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+// Scan decodes the attributes read from the database.
+func (a *attributes) Scan(src any) error {
+	var b []byte
+
+	switch v := src.(type) {
+	case nil:
+		*a = nil
+
+		return nil
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return fmt.Errorf("%w: %T", ErrAttributesType, src)
+	}
+
+	var m map[string]string
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	if len(m) == 0 {
+		m = nil
+	}
+
+	*a = m
+
+	return nil
+}
+
 // New -.
 func NewDeviceRepo(database *db.SQL, log logger.Interface) *DeviceRepo {
 	return &DeviceRepo{database, log}
@@ -80,8 +135,15 @@ func (r *DeviceRepo) Get(_ context.Context, top, skip int, tenantID string) ([]e
 			"mpsusername",
 			"tenantid",
 			"friendlyname",
+			"location",
+			"notes",
+			"owner",
+			"assignee",
+			"attributes",
 			"dnssuffix",
 			"deviceinfo",
+			"controlmode",
+			"provisioningstate",
 			"username",
 			"password",
 			"usetls",
@@ -113,7 +175,7 @@ func (r *DeviceRepo) Get(_ context.Context, top, skip int, tenantID string) ([]e
 	for rows.Next() {
 		d := entity.Device{}
 
-		err = rows.Scan(&d.GUID, &d.Hostname, &d.Tags, &d.MPSInstance, &d.ConnectionStatus, &d.MPSUsername, &d.TenantID, &d.FriendlyName, &d.DNSSuffix, &d.DeviceInfo, &d.Username, &d.Password, &d.UseTLS, &d.AllowSelfSigned, &d.CertHash)
+		err = rows.Scan(&d.GUID, &d.Hostname, &d.Tags, &d.MPSInstance, &d.ConnectionStatus, &d.MPSUsername, &d.TenantID, &d.FriendlyName, &d.Location, &d.Notes, &d.Owner, &d.Assignee, (*attributes)(&d.Attributes), &d.DNSSuffix, &d.DeviceInfo, &d.ControlMode, &d.ProvisioningState, &d.Username, &d.Password, &d.UseTLS, &d.AllowSelfSigned, &d.CertHash)
 		if err != nil {
 			return nil, ErrDeviceDatabase.Wrap("Get", "rows.Scan: ", err)
 		}
@@ -136,8 +198,15 @@ func (r *DeviceRepo) GetByID(_ context.Context, guid, tenantID string) (*entity.
 			"mpsusername",
 			"tenantid",
 			"friendlyname",
+			"location",
+			"notes",
+			"owner",
+			"assignee",
+			"attributes",
 			"dnssuffix",
 			"deviceinfo",
+			"controlmode",
+			"provisioningstate",
 			"username",
 			"password",
 			"mpspassword",
@@ -168,7 +237,7 @@ func (r *DeviceRepo) GetByID(_ context.Context, guid, tenantID string) (*entity.
 	for rows.Next() {
 		d := &entity.Device{}
 
-		err = rows.Scan(&d.GUID, &d.Hostname, &d.Tags, &d.MPSInstance, &d.ConnectionStatus, &d.MPSUsername, &d.TenantID, &d.FriendlyName, &d.DNSSuffix, &d.DeviceInfo, &d.Username, &d.Password, &d.MPSPassword, &d.MEBXPassword, &d.UseTLS, &d.AllowSelfSigned, &d.CertHash)
+		err = rows.Scan(&d.GUID, &d.Hostname, &d.Tags, &d.MPSInstance, &d.ConnectionStatus, &d.MPSUsername, &d.TenantID, &d.FriendlyName, &d.Location, &d.Notes, &d.Owner, &d.Assignee, (*attributes)(&d.Attributes), &d.DNSSuffix, &d.DeviceInfo, &d.ControlMode, &d.ProvisioningState, &d.Username, &d.Password, &d.MPSPassword, &d.MEBXPassword, &d.UseTLS, &d.AllowSelfSigned, &d.CertHash)
 		if err != nil {
 			return d, ErrDeviceDatabase.Wrap("Get", "rows.Scan: ", err)
 		}
@@ -234,8 +303,15 @@ func (r *DeviceRepo) GetByTags(_ context.Context, tags []string, method string,
 			"mpsusername",
 			"tenantid",
 			"friendlyname",
+			"location",
+			"notes",
+			"owner",
+			"assignee",
+			"attributes",
 			"dnssuffix",
-			"deviceinfo").
+			"deviceinfo",
+			"controlmode",
+			"provisioningstate").
 		From("devices")
 
 	var params []interface{}
@@ -291,7 +367,7 @@ func (r *DeviceRepo) GetByTags(_ context.Context, tags []string, method string,
 
 	for rows.Next() {
 		var d entity.Device
-		if err := rows.Scan(&d.GUID, &d.Hostname, &d.Tags, &d.MPSInstance, &d.ConnectionStatus, &d.MPSUsername, &d.TenantID, &d.FriendlyName, &d.DNSSuffix, &d.DeviceInfo); err != nil {
+		if err := rows.Scan(&d.GUID, &d.Hostname, &d.Tags, &d.MPSInstance, &d.ConnectionStatus, &d.MPSUsername, &d.TenantID, &d.FriendlyName, &d.Location, &d.Notes, &d.Owner, &d.Assignee, (*attributes)(&d.Attributes), &d.DNSSuffix, &d.DeviceInfo, &d.ControlMode, &d.ProvisioningState); err != nil {
 			return nil, ErrDeviceDatabase.Wrap("GetByTags", "rows.Scan", err)
 		}
 
@@ -324,6 +400,142 @@ func (r *DeviceRepo) Delete(_ context.Context, guid, tenantID string) (bool, err
 	return rowsAffected > 0, nil
 }
 
+// deviceTables are the tables keeping data about the devices by their GUID, the devices themselves last. The jobs
+// name their devices in their payload and progress rather than in a column, the jobs use case takes a deleted device
+// out of them.
+var deviceTables = []string{"power_samples", "log_cursors", "device_addresses", "device_correlations", "credential_checkouts", "devices"}
+
+// DeleteCascade deletes a device and the data kept about it in one transaction, returning how many rows of each
+// table were deleted. Nothing is deleted when the device does not exist.
+func (r *DeviceRepo) DeleteCascade(ctx context.Context, guid, tenantID string) (map[string]int64, bool, error) {
+	tx, err := r.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, ErrDeviceDatabase.Wrap("DeleteCascade", "r.Pool.BeginTx", err)
+	}
+
+	// the rollback of a committed transaction does nothing
+	defer func() { _ = tx.Rollback() }()
+
+	deleted := map[string]int64{}
+
+	for _, table := range deviceTables {
+		builder := r.Builder.Delete(table).Where("guid = ?", guid)
+		if table == "devices" {
+			builder = builder.Where("tenantid = ?", tenantID)
+		}
+
+		sqlQuery, args, err := builder.ToSql()
+		if err != nil {
+			return nil, false, ErrDeviceDatabase.Wrap("DeleteCascade", "r.Builder", err)
+		}
+
+		res, err := tx.ExecContext(ctx, sqlQuery, args...)
+		if err != nil {
+			return nil, false, ErrDeviceDatabase.Wrap("DeleteCascade", "tx.Exec", err)
+		}
+
+		if deleted[table], err = res.RowsAffected(); err != nil {
+			return nil, false, ErrDeviceDatabase.Wrap("DeleteCascade", "res.RowsAffected", err)
+		}
+	}
+
+	if deleted["devices"] == 0 {
+		return nil, false, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, ErrDeviceDatabase.Wrap("DeleteCascade", "tx.Commit", err)
+	}
+
+	return deleted, true, nil
+}
+
+// mergedTables are the tables of deviceTables whose rows move from a duplicate to the device it is merged into,
+// with the column completing the GUID in their primary key. The rows of the duplicate clashing with a row of the
+// device are dropped, the device keeps its own.
+var mergedTables = []struct{ name, key string }{
+	{name: "power_samples", key: "sampled_at"},
+	{name: "log_cursors", key: "log"},
+	{name: "device_addresses", key: "recorded_at"},
+	{name: "device_correlations", key: "source"},
+	{name: "credential_checkouts"},
+}
+
+// Merge saves the tags and attributes of d, moves the data kept about the duplicates to it and deletes the
+// duplicates in one transaction, returning how many rows of each table were moved. Nothing changes when d or one
+// of the duplicates is not a device of the tenant of d.
+func (r *DeviceRepo) Merge(ctx context.Context, d *entity.Device, duplicates []string) (map[string]int64, bool, error) {
+	tx, err := r.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, ErrDeviceDatabase.Wrap("Merge", "r.Pool.BeginTx", err)
+	}
+
+	// the rollback of a committed transaction does nothing
+	defer func() { _ = tx.Rollback() }()
+
+	updated, err := r.execTx(ctx, tx, r.Builder.
+		Update("devices").
+		Set("tags", d.Tags).
+		Set("attributes", attributes(d.Attributes)).
+		Where("guid = ? AND tenantid = ?", d.GUID, d.TenantID))
+	if err != nil || updated == 0 {
+		return nil, false, err
+	}
+
+	moved := map[string]int64{}
+
+	for _, duplicate := range duplicates {
+		for _, table := range mergedTables {
+			if table.key != "" {
+				// the key is one of mergedTables, not user input
+				clash := squirrel.Expr(table.key+" IN (SELECT "+table.key+" FROM "+table.name+" WHERE guid = ?)", d.GUID)
+				if _, err := r.execTx(ctx, tx, r.Builder.Delete(table.name).Where("guid = ?", duplicate).Where(clash)); err != nil {
+					return nil, false, err
+				}
+			}
+
+			n, err := r.execTx(ctx, tx, r.Builder.Update(table.name).Set("guid", d.GUID).Where("guid = ?", duplicate))
+			if err != nil {
+				return nil, false, err
+			}
+
+			moved[table.name] += n
+		}
+
+		// the duplicate goes last, the correlations moved above would otherwise be deleted with it
+		deleted, err := r.execTx(ctx, tx, r.Builder.Delete("devices").Where("guid = ? AND tenantid = ?", duplicate, d.TenantID))
+		if err != nil || deleted == 0 {
+			return nil, false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, ErrDeviceDatabase.Wrap("Merge", "tx.Commit", err)
+	}
+
+	return moved, true, nil
+}
+
+// execTx runs a statement of Merge in tx, returning the number of rows it changed.
+func (r *DeviceRepo) execTx(ctx context.Context, tx *sql.Tx, builder squirrel.Sqlizer) (int64, error) {
+	sqlQuery, args, err := builder.ToSql()
+	if err != nil {
+		return 0, ErrDeviceDatabase.Wrap("Merge", "r.Builder", err)
+	}
+
+	res, err := tx.ExecContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return 0, ErrDeviceDatabase.Wrap("Merge", "tx.Exec", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, ErrDeviceDatabase.Wrap("Merge", "res.RowsAffected", err)
+	}
+
+	return rowsAffected, nil
+}
+
 // Update -.
 func (r *DeviceRepo) Update(_ context.Context, d *entity.Device) (bool, error) {
 	sqlQuery, args, err := r.Builder.
@@ -336,6 +548,11 @@ func (r *DeviceRepo) Update(_ context.Context, d *entity.Device) (bool, error) {
 		Set("mpsusername", d.MPSUsername).
 		Set("tenantid", d.TenantID).
 		Set("friendlyname", d.FriendlyName).
+		Set("location", d.Location).
+		Set("notes", d.Notes).
+		Set("owner", d.Owner).
+		Set("assignee", d.Assignee).
+		Set("attributes", attributes(d.Attributes)).
 		Set("dnssuffix", d.DNSSuffix).
 		Set("deviceinfo", d.DeviceInfo).
 		Set("username", d.Username).
@@ -364,12 +581,37 @@ func (r *DeviceRepo) Update(_ context.Context, d *entity.Device) (bool, error) {
 	return rowsAffected > 0, nil
 }
 
+// UpdateControlMode stores the control mode and provisioning state read from a device.
+func (r *DeviceRepo) UpdateControlMode(_ context.Context, guid, tenantID, controlMode, provisioningState string) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Update("devices").
+		Set("controlmode", controlMode).
+		Set("provisioningstate", provisioningState).
+		Where("guid = ? AND tenantid = ?", guid, tenantID).
+		ToSql()
+	if err != nil {
+		return false, ErrDeviceDatabase.Wrap("UpdateControlMode", "r.Builder", err)
+	}
+
+	res, err := r.Pool.ExecContext(context.Background(), sqlQuery, args...)
+	if err != nil {
+		return false, ErrDeviceDatabase.Wrap("UpdateControlMode", "r.Pool.Exec", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, ErrDeviceDatabase.Wrap("UpdateControlMode", "res.RowsAffected", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
 // Insert -.
 func (r *DeviceRepo) Insert(_ context.Context, d *entity.Device) (string, error) {
 	insertBuilder := r.Builder.
 		Insert("devices").
-		Columns("guid", "hostname", "tags", "mpsinstance", "connectionstatus", "mpsusername", "tenantid", "friendlyname", "dnssuffix", "deviceinfo", "username", "password", "mpspassword", "mebxpassword", "usetls", "allowselfsigned", "certhash").
-		Values(d.GUID, d.Hostname, d.Tags, d.MPSInstance, d.ConnectionStatus, d.MPSUsername, d.TenantID, d.FriendlyName, d.DNSSuffix, d.DeviceInfo, d.Username, d.Password, d.MPSPassword, d.MEBXPassword, d.UseTLS, d.AllowSelfSigned, d.CertHash)
+		Columns("guid", "hostname", "tags", "mpsinstance", "connectionstatus", "mpsusername", "tenantid", "friendlyname", "location", "notes", "owner", "assignee", "attributes", "dnssuffix", "deviceinfo", "username", "password", "mpspassword", "mebxpassword", "usetls", "allowselfsigned", "certhash").
+		Values(d.GUID, d.Hostname, d.Tags, d.MPSInstance, d.ConnectionStatus, d.MPSUsername, d.TenantID, d.FriendlyName, d.Location, d.Notes, d.Owner, d.Assignee, attributes(d.Attributes), d.DNSSuffix, d.DeviceInfo, d.Username, d.Password, d.MPSPassword, d.MEBXPassword, d.UseTLS, d.AllowSelfSigned, d.CertHash)
 
 	if !r.IsEmbedded {
 		insertBuilder = insertBuilder.Suffix("RETURNING xmin::text")
@@ -410,8 +652,15 @@ func (r *DeviceRepo) GetByColumn(_ context.Context, columnName, queryValue, tena
 			"mpsusername",
 			"tenantid",
 			"friendlyname",
+			"location",
+			"notes",
+			"owner",
+			"assignee",
+			"attributes",
 			"dnssuffix",
 			"deviceinfo",
+			"controlmode",
+			"provisioningstate",
 			"username",
 			"password",
 			"usetls",
@@ -440,7 +689,7 @@ func (r *DeviceRepo) GetByColumn(_ context.Context, columnName, queryValue, tena
 	for rows.Next() {
 		d := entity.Device{}
 
-		err = rows.Scan(&d.GUID, &d.Hostname, &d.Tags, &d.MPSInstance, &d.ConnectionStatus, &d.MPSUsername, &d.TenantID, &d.FriendlyName, &d.DNSSuffix, &d.DeviceInfo, &d.Username, &d.Password, &d.UseTLS, &d.AllowSelfSigned, &d.CertHash)
+		err = rows.Scan(&d.GUID, &d.Hostname, &d.Tags, &d.MPSInstance, &d.ConnectionStatus, &d.MPSUsername, &d.TenantID, &d.FriendlyName, &d.Location, &d.Notes, &d.Owner, &d.Assignee, (*attributes)(&d.Attributes), &d.DNSSuffix, &d.DeviceInfo, &d.ControlMode, &d.ProvisioningState, &d.Username, &d.Password, &d.UseTLS, &d.AllowSelfSigned, &d.CertHash)
 		if err != nil {
 			return nil, ErrDeviceDatabase.Wrap("Get", "rows.Scan: ", err)
 		}
@@ -450,3 +699,95 @@ func (r *DeviceRepo) GetByColumn(_ context.Context, columnName, queryValue, tena
 
 	return devices, nil
 }
+
+// GetByAttributes returns the devices whose custom attributes hold all of the given values.
+func (r *DeviceRepo) GetByAttributes(ctx context.Context, attrs map[string]string, top, skip int, tenantID string) ([]entity.Device, error) {
+	const defaultTop = 100
+
+	limitedTop := uint64(defaultTop)
+	if top > 0 {
+		limitedTop = uint64(top)
+	}
+
+	limitedSkip := uint64(0)
+	if skip > 0 {
+		limitedSkip = uint64(skip)
+	}
+
+	builder := r.Builder.
+		Select(
+			"guid",
+			"hostname",
+			"tags",
+			"mpsinstance",
+			"connectionstatus",
+			"mpsusername",
+			"tenantid",
+			"friendlyname",
+			"location",
+			"notes",
+			"owner",
+			"assignee",
+			"attributes",
+			"dnssuffix",
+			"deviceinfo",
+			"controlmode",
+			"provisioningstate",
+			"username",
+			"password",
+			"usetls",
+			"allowselfsigned",
+			"certhash").
+		From("devices").
+		Where("tenantid = ?", tenantID)
+
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if r.IsEmbedded {
+			builder = builder.Where("json_extract(attributes, ?) = ?", `$."`+key+`"`, attrs[key])
+		} else {
+			builder = builder.Where("attributes ->> CAST(? AS TEXT) = ?", key, attrs[key])
+		}
+	}
+
+	sqlQuery, args, err := builder.
+		OrderBy("guid").
+		Limit(limitedTop).
+		Offset(limitedSkip).
+		ToSql()
+	if err != nil {
+		return nil, ErrDeviceDatabase.Wrap("GetByAttributes", "r.Builder: ", err)
+	}
+
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrDeviceDatabase.Wrap("GetByAttributes", "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	devices := make([]entity.Device, 0)
+
+	for rows.Next() {
+		d := entity.Device{}
+
+		err = rows.Scan(&d.GUID, &d.Hostname, &d.Tags, &d.MPSInstance, &d.ConnectionStatus, &d.MPSUsername, &d.TenantID, &d.FriendlyName, &d.Location, &d.Notes, &d.Owner, &d.Assignee, (*attributes)(&d.Attributes), &d.DNSSuffix, &d.DeviceInfo, &d.ControlMode, &d.ProvisioningState, &d.Username, &d.Password, &d.UseTLS, &d.AllowSelfSigned, &d.CertHash)
+		if err != nil {
+			return nil, ErrDeviceDatabase.Wrap("GetByAttributes", "rows.Scan: ", err)
+		}
+
+		devices = append(devices, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, ErrDeviceDatabase.Wrap("GetByAttributes", "rows.Err", err)
+	}
+
+	return devices, nil
+}
@@ -39,6 +39,13 @@ func setupDeviceTable(t *testing.T) *sql.DB {
 			mpsusername TEXT NOT NULL DEFAULT '',
 			tenantid TEXT NOT NULL,
 			friendlyname TEXT NOT NULL DEFAULT '',
+			location TEXT NOT NULL DEFAULT '',
+			notes TEXT NOT NULL DEFAULT '',
+			owner TEXT NOT NULL DEFAULT '',
+			assignee TEXT NOT NULL DEFAULT '',
+			controlmode TEXT NOT NULL DEFAULT '',
+			provisioningstate TEXT NOT NULL DEFAULT '',
+			attributes TEXT NOT NULL DEFAULT '{}',
 			dnssuffix TEXT NOT NULL DEFAULT '',
 			deviceinfo TEXT NOT NULL DEFAULT '',
 			username TEXT NOT NULL DEFAULT '',
@@ -560,6 +567,13 @@ func TestDeviceRepo_GetByTags(t *testing.T) {
                     mpsusername TEXT NOT NULL DEFAULT '',
                     tenantid TEXT NOT NULL,
                     friendlyname TEXT NOT NULL DEFAULT '',
+                    location TEXT NOT NULL DEFAULT '',
+                    notes TEXT NOT NULL DEFAULT '',
+                    owner TEXT NOT NULL DEFAULT '',
+                    assignee TEXT NOT NULL DEFAULT '',
+                    controlmode TEXT NOT NULL DEFAULT '',
+                    provisioningstate TEXT NOT NULL DEFAULT '',
+                    attributes TEXT NOT NULL DEFAULT '{}',
                     dnssuffix TEXT NOT NULL DEFAULT '',
                     deviceinfo TEXT NOT NULL DEFAULT ''
                 );
@@ -652,6 +666,13 @@ func TestDeviceRepo_Delete(t *testing.T) {
 					mpsusername TEXT NOT NULL DEFAULT '',
 					tenantid TEXT NOT NULL,
 					friendlyname TEXT NOT NULL DEFAULT '',
+					location TEXT NOT NULL DEFAULT '',
+					notes TEXT NOT NULL DEFAULT '',
+					owner TEXT NOT NULL DEFAULT '',
+					assignee TEXT NOT NULL DEFAULT '',
+					controlmode TEXT NOT NULL DEFAULT '',
+					provisioningstate TEXT NOT NULL DEFAULT '',
+					attributes TEXT NOT NULL DEFAULT '{}',
 					dnssuffix TEXT NOT NULL DEFAULT '',
 					deviceinfo TEXT NOT NULL DEFAULT '',
 					username TEXT NOT NULL DEFAULT '',
@@ -697,6 +718,174 @@ func TestDeviceRepo_Delete(t *testing.T) {
 	}
 }
 
+func TestDeviceRepo_DeleteCascade(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		guid     string
+		tenantID string
+		expected map[string]int64
+		ok       bool
+	}{
+		{
+			name:     "Device deleted with its data",
+			guid:     "guid1",
+			tenantID: "tenant1",
+			expected: map[string]int64{
+				"power_samples":        2,
+				"log_cursors":          1,
+				"device_addresses":     0,
+				"device_correlations":  0,
+				"credential_checkouts": 0,
+				"devices":              1,
+			},
+			ok: true,
+		},
+		{
+			name:     "Device of another tenant",
+			guid:     "guid1",
+			tenantID: "tenant2",
+			expected: nil,
+			ok:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dbConn := setupDeviceTable(t)
+
+			defer dbConn.Close()
+
+			for _, table := range []string{"power_samples", "log_cursors", "device_addresses", "device_correlations", "credential_checkouts"} {
+				_, err := dbConn.ExecContext(context.Background(), `CREATE TABLE `+table+` (guid TEXT NOT NULL)`)
+				require.NoError(t, err)
+			}
+
+			_, err := dbConn.ExecContext(context.Background(), `INSERT INTO devices (guid, tenantid) VALUES ('guid1', 'tenant1'), ('guid2', 'tenant1')`)
+			require.NoError(t, err)
+
+			_, err = dbConn.ExecContext(context.Background(), `INSERT INTO power_samples (guid) VALUES ('guid1'), ('guid1'), ('guid2')`)
+			require.NoError(t, err)
+
+			_, err = dbConn.ExecContext(context.Background(), `INSERT INTO log_cursors (guid) VALUES ('guid1')`)
+			require.NoError(t, err)
+
+			repo := sqldb.NewDeviceRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+			deleted, ok, err := repo.DeleteCascade(context.Background(), tc.guid, tc.tenantID)
+			require.NoError(t, err)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.expected, deleted)
+
+			var samples int
+
+			require.NoError(t, dbConn.QueryRowContext(context.Background(), `SELECT COUNT(*) FROM power_samples`).Scan(&samples))
+
+			if tc.ok {
+				require.Equal(t, 1, samples)
+			} else {
+				require.Equal(t, 3, samples)
+			}
+		})
+	}
+}
+
+func TestDeviceRepo_Merge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		tenantID   string
+		duplicates []string
+		expected   map[string]int64
+		ok         bool
+	}{
+		{
+			name:       "Duplicates merged with their data",
+			tenantID:   "tenant1",
+			duplicates: []string{"guid2", "guid3"},
+			expected: map[string]int64{
+				"power_samples":        2,
+				"log_cursors":          0,
+				"device_addresses":     0,
+				"device_correlations":  1,
+				"credential_checkouts": 1,
+			},
+			ok: true,
+		},
+		{
+			name:       "Duplicate of another tenant",
+			tenantID:   "tenant1",
+			duplicates: []string{"guid2", "guid4"},
+			ok:         false,
+		},
+		{
+			name:       "Device of another tenant",
+			tenantID:   "tenant2",
+			duplicates: []string{"guid2"},
+			ok:         false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dbConn := setupDeviceTable(t)
+
+			defer dbConn.Close()
+
+			for _, statement := range []string{
+				`CREATE TABLE power_samples (guid TEXT NOT NULL, sampled_at BIGINT NOT NULL, PRIMARY KEY (guid, sampled_at))`,
+				`CREATE TABLE log_cursors (guid TEXT NOT NULL, log TEXT NOT NULL, PRIMARY KEY (guid, log))`,
+				`CREATE TABLE device_addresses (guid TEXT NOT NULL, recorded_at BIGINT NOT NULL, PRIMARY KEY (guid, recorded_at))`,
+				`CREATE TABLE device_correlations (guid TEXT NOT NULL, source TEXT NOT NULL, PRIMARY KEY (guid, source))`,
+				`CREATE TABLE credential_checkouts (id TEXT PRIMARY KEY, guid TEXT NOT NULL)`,
+				`INSERT INTO devices (guid, tenantid, tags) VALUES ('guid1', 'tenant1', 'a'), ('guid2', 'tenant1', 'b'), ('guid3', 'tenant1', ''), ('guid4', 'tenant2', '')`,
+				`INSERT INTO power_samples (guid, sampled_at) VALUES ('guid1', 1), ('guid2', 1), ('guid2', 2), ('guid3', 2), ('guid3', 3)`,
+				`INSERT INTO log_cursors (guid, log) VALUES ('guid1', 'audit'), ('guid2', 'audit')`,
+				`INSERT INTO device_correlations (guid, source) VALUES ('guid2', 'intune')`,
+				`INSERT INTO credential_checkouts (id, guid) VALUES ('1', 'guid3')`,
+			} {
+				_, err := dbConn.ExecContext(context.Background(), statement)
+				require.NoError(t, err)
+			}
+
+			repo := sqldb.NewDeviceRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+			device := &entity.Device{GUID: "guid1", TenantID: tc.tenantID, Tags: "a,b", Attributes: map[string]string{"serialNumber": "5CG1"}}
+
+			moved, ok, err := repo.Merge(context.Background(), device, tc.duplicates)
+			require.NoError(t, err)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.expected, moved)
+
+			var devices, samples int
+
+			require.NoError(t, dbConn.QueryRowContext(context.Background(), `SELECT COUNT(*) FROM devices`).Scan(&devices))
+			require.NoError(t, dbConn.QueryRowContext(context.Background(), `SELECT COUNT(*) FROM power_samples WHERE guid = 'guid1'`).Scan(&samples))
+
+			if !tc.ok {
+				require.Equal(t, 4, devices)
+				require.Equal(t, 1, samples)
+
+				return
+			}
+
+			var tags string
+
+			require.NoError(t, dbConn.QueryRowContext(context.Background(), `SELECT tags FROM devices WHERE guid = 'guid1'`).Scan(&tags))
+			require.Equal(t, "a,b", tags)
+			require.Equal(t, 2, devices)
+			require.Equal(t, 3, samples)
+		})
+	}
+}
+
 func TestDeviceRepo_Update(t *testing.T) {
 	t.Parallel()
 
@@ -802,6 +991,13 @@ func TestDeviceRepo_Update(t *testing.T) {
 					mpsusername TEXT NOT NULL DEFAULT '',
 					tenantid TEXT NOT NULL,
 					friendlyname TEXT NOT NULL DEFAULT '',
+					location TEXT NOT NULL DEFAULT '',
+					notes TEXT NOT NULL DEFAULT '',
+					owner TEXT NOT NULL DEFAULT '',
+					assignee TEXT NOT NULL DEFAULT '',
+					controlmode TEXT NOT NULL DEFAULT '',
+					provisioningstate TEXT NOT NULL DEFAULT '',
+					attributes TEXT NOT NULL DEFAULT '{}',
 					dnssuffix TEXT NOT NULL DEFAULT '',
 					deviceinfo TEXT NOT NULL DEFAULT '',
 					username TEXT NOT NULL DEFAULT '',
@@ -848,6 +1044,46 @@ func TestDeviceRepo_Update(t *testing.T) {
 	}
 }
 
+func TestDeviceRepo_UpdateControlMode(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupDeviceTable(t)
+	defer dbConn.Close()
+
+	_, err := dbConn.ExecContext(context.Background(), `INSERT INTO devices (guid, hostname, tenantid, friendlyname) VALUES (?, ?, ?, ?)`,
+		"guid1", "hostname1", "tenant1", "friendlyname1")
+	require.NoError(t, err)
+
+	repo := sqldb.NewDeviceRepo(&db.SQL{
+		Builder:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Question),
+		Pool:       dbConn,
+		IsEmbedded: true,
+	}, mocks.NewMockLogger(nil))
+
+	updated, err := repo.UpdateControlMode(context.Background(), "guid1", "tenant1", "ACM", "post")
+	require.NoError(t, err)
+	require.True(t, updated)
+
+	d, err := repo.GetByID(context.Background(), "guid1", "tenant1")
+	require.NoError(t, err)
+	require.Equal(t, "ACM", d.ControlMode)
+	require.Equal(t, "post", d.ProvisioningState)
+	require.Equal(t, "friendlyname1", d.FriendlyName)
+
+	// a device update from the API leaves the values read from the device alone
+	d.FriendlyName = "renamed"
+	_, err = repo.Update(context.Background(), d)
+	require.NoError(t, err)
+
+	d, err = repo.GetByID(context.Background(), "guid1", "tenant1")
+	require.NoError(t, err)
+	require.Equal(t, "ACM", d.ControlMode)
+
+	updated, err = repo.UpdateControlMode(context.Background(), "guid1", "tenant2", "CCM", "post")
+	require.NoError(t, err)
+	require.False(t, updated)
+}
+
 func TestDeviceRepo_Insert(t *testing.T) {
 	t.Parallel()
 
@@ -1008,6 +1244,28 @@ func TestDeviceRepo_GetByColumn(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "Successful retrieval by assignee",
+			setup: func(dbConn *sql.DB) {
+				_, err := dbConn.ExecContext(context.Background(), `INSERT INTO devices (guid, hostname, tenantid, owner, assignee) VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)`,
+					"guid1", "hostname1", "tenant1", "helpdesk", "jdoe",
+					"guid2", "hostname2", "tenant1", "helpdesk", "asmith")
+				require.NoError(t, err)
+			},
+			columnName: "assignee",
+			queryValue: "jdoe",
+			tenantID:   "tenant1",
+			expected: []entity.Device{
+				{
+					GUID:     "guid1",
+					Hostname: "hostname1",
+					TenantID: "tenant1",
+					Owner:    "helpdesk",
+					Assignee: "jdoe",
+				},
+			},
+			expectError: false,
+		},
 		{
 			name:        "No devices found",
 			setup:       func(_ *sql.DB) {},
@@ -1059,6 +1317,13 @@ func TestDeviceRepo_GetByColumn(t *testing.T) {
                     mpsusername TEXT NOT NULL DEFAULT '',
                     tenantid TEXT NOT NULL,
                     friendlyname TEXT NOT NULL DEFAULT '',
+                    location TEXT NOT NULL DEFAULT '',
+                    notes TEXT NOT NULL DEFAULT '',
+                    owner TEXT NOT NULL DEFAULT '',
+                    assignee TEXT NOT NULL DEFAULT '',
+                    controlmode TEXT NOT NULL DEFAULT '',
+                    provisioningstate TEXT NOT NULL DEFAULT '',
+                    attributes TEXT NOT NULL DEFAULT '{}',
                     dnssuffix TEXT NOT NULL DEFAULT '',
                     deviceinfo TEXT NOT NULL DEFAULT '',
                     username TEXT NOT NULL DEFAULT '',
@@ -1094,3 +1359,98 @@ func TestDeviceRepo_GetByColumn(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceRepo_GetByAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		attrs    map[string]string
+		expected []string
+		err      error
+	}{
+		{
+			name:     "single attribute",
+			attrs:    map[string]string{"costCenter": "1234"},
+			expected: []string{"guid1", "guid2"},
+		},
+		{
+			name:     "all attributes must match",
+			attrs:    map[string]string{"costCenter": "1234", "asset-tag": "A-1"},
+			expected: []string{"guid1"},
+		},
+		{
+			name:     "no match",
+			attrs:    map[string]string{"costCenter": "9999"},
+			expected: []string{},
+		},
+		{
+			name:  QueryExecutionErrorTestName,
+			attrs: map[string]string{"costCenter": "1234"},
+			err:   sqldb.DatabaseError{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dbConn := setupDeviceTable(t)
+			defer dbConn.Close()
+
+			repo := sqldb.NewDeviceRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+			for guid, attrs := range map[string]map[string]string{
+				"guid1": {"costCenter": "1234", "asset-tag": "A-1"},
+				"guid2": {"costCenter": "1234"},
+				"guid3": nil,
+			} {
+				_, err := repo.Insert(context.Background(), &entity.Device{GUID: guid, TenantID: "tenant1", CertHash: Certhash, Attributes: attrs})
+				require.NoError(t, err)
+			}
+
+			repo = sqldb.NewDeviceRepo(CreateSQLConfig(dbConn, tc.name == QueryExecutionErrorTestName), mocks.NewMockLogger(nil))
+
+			devices, err := repo.GetByAttributes(context.Background(), tc.attrs, 0, 0, "tenant1")
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			guids := make([]string, 0, len(devices))
+			for i := range devices {
+				guids = append(guids, devices[i].GUID)
+				require.Equal(t, tc.attrs["costCenter"], devices[i].Attributes["costCenter"])
+			}
+
+			require.Equal(t, tc.expected, guids)
+		})
+	}
+}
+
+func TestDeviceRepo_AttributesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupDeviceTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewDeviceRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+	_, err := repo.Insert(context.Background(), &entity.Device{GUID: "guid1", TenantID: "tenant1", CertHash: Certhash, Attributes: map[string]string{"costCenter": "1234"}})
+	require.NoError(t, err)
+
+	device, err := repo.GetByID(context.Background(), "guid1", "tenant1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"costCenter": "1234"}, device.Attributes)
+
+	device.Attributes = nil
+	_, err = repo.Update(context.Background(), device)
+	require.NoError(t, err)
+
+	device, err = repo.GetByID(context.Background(), "guid1", "tenant1")
+	require.NoError(t, err)
+	require.Nil(t, device.Attributes)
+}
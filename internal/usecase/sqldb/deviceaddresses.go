@@ -0,0 +1,99 @@
+package sqldb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// DeviceAddressRepo stores the address history of the devices.
+// Record times are kept as unix seconds so that the same queries work on sqlite and postgres.
+type DeviceAddressRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var ErrDeviceAddressDatabase = DatabaseError{Console: consoleerrors.CreateConsoleError("DeviceAddressRepo")}
+
+// NewDeviceAddressRepo -.
+func NewDeviceAddressRepo(database *db.SQL, log logger.Interface) *DeviceAddressRepo {
+	return &DeviceAddressRepo{database, log}
+}
+
+// Insert -.
+func (r *DeviceAddressRepo) Insert(ctx context.Context, a entity.DeviceAddress) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("device_addresses").
+		Columns("guid", "recorded_at", "hostname", "addresses").
+		Values(a.GUID, a.RecordedAt.Unix(), a.Hostname, strings.Join(a.Addresses, ",")).
+		ToSql()
+	if err != nil {
+		return ErrDeviceAddressDatabase.Wrap("Insert", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrDeviceAddressDatabase.Wrap("Insert", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
+
+// GetByGUID returns the latest limit records of a device, newest first. A limit of 0 returns all of them.
+func (r *DeviceAddressRepo) GetByGUID(ctx context.Context, guid string, limit int) ([]entity.DeviceAddress, error) {
+	builder := r.Builder.
+		Select("recorded_at", "hostname", "addresses").
+		From("device_addresses").
+		Where("guid = ?", guid).
+		OrderBy("recorded_at DESC")
+
+	if limit > 0 {
+		builder = builder.Limit(uint64(limit))
+	}
+
+	sqlQuery, args, err := builder.ToSql()
+	if err != nil {
+		return nil, ErrDeviceAddressDatabase.Wrap("GetByGUID", "r.Builder", err)
+	}
+
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrDeviceAddressDatabase.Wrap("GetByGUID", "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	records := make([]entity.DeviceAddress, 0)
+
+	for rows.Next() {
+		var (
+			recordedAt int64
+			addresses  string
+		)
+
+		a := entity.DeviceAddress{GUID: guid}
+
+		if err = rows.Scan(&recordedAt, &a.Hostname, &addresses); err != nil {
+			return nil, ErrDeviceAddressDatabase.Wrap("GetByGUID", "rows.Scan", err)
+		}
+
+		a.RecordedAt = time.Unix(recordedAt, 0).UTC()
+		a.Addresses = []string{}
+
+		if addresses != "" {
+			a.Addresses = strings.Split(addresses, ",")
+		}
+
+		records = append(records, a)
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrDeviceAddressDatabase.Wrap("GetByGUID", "rows.Err", rows.Err())
+	}
+
+	return records, nil
+}
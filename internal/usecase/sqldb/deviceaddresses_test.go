@@ -0,0 +1,104 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupDeviceAddressTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE device_addresses (
+			guid TEXT NOT NULL,
+			recorded_at BIGINT NOT NULL,
+			hostname TEXT NOT NULL,
+			addresses TEXT NOT NULL,
+			PRIMARY KEY (guid, recorded_at)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestDeviceAddressRepo_GetByGUID(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := entity.DeviceAddress{GUID: "guid1", Hostname: "device.example.com", Addresses: []string{"10.0.0.5"}, RecordedAt: start}
+	second := entity.DeviceAddress{GUID: "guid1", Hostname: "device.example.com", Addresses: []string{"10.0.0.9", "fd00::9"}, RecordedAt: start.Add(time.Hour)}
+	unresolved := entity.DeviceAddress{GUID: "guid1", Hostname: "device.example.com", Addresses: []string{}, RecordedAt: start.Add(2 * time.Hour)}
+
+	tests := []struct {
+		name     string
+		records  []entity.DeviceAddress
+		limit    int
+		expected []entity.DeviceAddress
+		err      error
+	}{
+		{
+			name: "records of the device, newest first",
+			records: []entity.DeviceAddress{
+				first, unresolved, second,
+				{GUID: "guid2", Hostname: "other.example.com", Addresses: []string{"10.0.0.7"}, RecordedAt: start},
+			},
+			expected: []entity.DeviceAddress{unresolved, second, first},
+		},
+		{
+			name:     "latest records",
+			records:  []entity.DeviceAddress{first, second},
+			limit:    1,
+			expected: []entity.DeviceAddress{second},
+		},
+		{
+			name:     "no records",
+			expected: []entity.DeviceAddress{},
+		},
+		{
+			name: QueryExecutionErrorTestName,
+			err:  sqldb.DatabaseError{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dbConn := setupDeviceAddressTable(t)
+			defer dbConn.Close()
+
+			repo := sqldb.NewDeviceAddressRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+			for _, a := range tc.records {
+				require.NoError(t, repo.Insert(context.Background(), a))
+			}
+
+			if tc.name == QueryExecutionErrorTestName {
+				repo = sqldb.NewDeviceAddressRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil))
+			}
+
+			records, err := repo.GetByGUID(context.Background(), "guid1", tc.limit)
+
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, records)
+		})
+	}
+}
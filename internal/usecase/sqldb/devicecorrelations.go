@@ -0,0 +1,104 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// DeviceCorrelationRepo stores the records of endpoint management systems matched to devices,
+// times are kept as unix seconds and a zero last sync time as 0.
+type DeviceCorrelationRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var ErrDeviceCorrelationDatabase = DatabaseError{Console: consoleerrors.CreateConsoleError("DeviceCorrelationRepo")}
+
+// NewDeviceCorrelationRepo -.
+func NewDeviceCorrelationRepo(database *db.SQL, log logger.Interface) *DeviceCorrelationRepo {
+	return &DeviceCorrelationRepo{database, log}
+}
+
+// GetByGUID returns the correlations of a device ordered by source.
+func (r *DeviceCorrelationRepo) GetByGUID(ctx context.Context, guid, tenantID string) ([]entity.DeviceCorrelation, error) {
+	sqlQuery, args, err := r.Builder.
+		Select("guid", "tenantid", "source", "external_id", "device_name", "serial_number", "matched_by",
+			"compliance_state", "management_state", "last_sync", "imported_at").
+		From("device_correlations").
+		Where("guid = ? AND tenantid = ?", guid, tenantID).
+		OrderBy("source").
+		ToSql()
+	if err != nil {
+		return nil, ErrDeviceCorrelationDatabase.Wrap("GetByGUID", "r.Builder", err)
+	}
+
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrDeviceCorrelationDatabase.Wrap("GetByGUID", "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	correlations := make([]entity.DeviceCorrelation, 0)
+
+	for rows.Next() {
+		var (
+			c                    entity.DeviceCorrelation
+			lastSync, importedAt int64
+		)
+
+		err = rows.Scan(&c.GUID, &c.TenantID, &c.Source, &c.ExternalID, &c.DeviceName, &c.SerialNumber, &c.MatchedBy,
+			&c.ComplianceState, &c.ManagementState, &lastSync, &importedAt)
+		if err != nil {
+			return nil, ErrDeviceCorrelationDatabase.Wrap("GetByGUID", "rows.Scan", err)
+		}
+
+		if lastSync != 0 {
+			c.LastSync = time.Unix(lastSync, 0).UTC()
+		}
+
+		c.ImportedAt = time.Unix(importedAt, 0).UTC()
+
+		correlations = append(correlations, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, ErrDeviceCorrelationDatabase.Wrap("GetByGUID", "rows.Err", err)
+	}
+
+	return correlations, nil
+}
+
+// Save inserts or replaces the correlation of a device with a source.
+func (r *DeviceCorrelationRepo) Save(ctx context.Context, c entity.DeviceCorrelation) error {
+	var lastSync int64
+	if !c.LastSync.IsZero() {
+		lastSync = c.LastSync.Unix()
+	}
+
+	sqlQuery, args, err := r.Builder.
+		Insert("device_correlations").
+		Columns("guid", "tenantid", "source", "external_id", "device_name", "serial_number", "matched_by",
+			"compliance_state", "management_state", "last_sync", "imported_at").
+		Values(c.GUID, c.TenantID, c.Source, c.ExternalID, c.DeviceName, c.SerialNumber, c.MatchedBy,
+			c.ComplianceState, c.ManagementState, lastSync, c.ImportedAt.Unix()).
+		Suffix("ON CONFLICT (guid, source) DO UPDATE SET tenantid = excluded.tenantid, external_id = excluded.external_id, " +
+			"device_name = excluded.device_name, serial_number = excluded.serial_number, matched_by = excluded.matched_by, " +
+			"compliance_state = excluded.compliance_state, management_state = excluded.management_state, " +
+			"last_sync = excluded.last_sync, imported_at = excluded.imported_at").
+		ToSql()
+	if err != nil {
+		return ErrDeviceCorrelationDatabase.Wrap("Save", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrDeviceCorrelationDatabase.Wrap("Save", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupDeviceCorrelationTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE device_correlations (
+			guid TEXT NOT NULL,
+			tenantid TEXT NOT NULL DEFAULT '',
+			source TEXT NOT NULL,
+			external_id TEXT NOT NULL DEFAULT '',
+			device_name TEXT NOT NULL DEFAULT '',
+			serial_number TEXT NOT NULL DEFAULT '',
+			matched_by TEXT NOT NULL,
+			compliance_state TEXT NOT NULL DEFAULT '',
+			management_state TEXT NOT NULL DEFAULT '',
+			last_sync BIGINT NOT NULL DEFAULT 0,
+			imported_at BIGINT NOT NULL,
+			PRIMARY KEY (guid, source)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestDeviceCorrelationRepo(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupDeviceCorrelationTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewDeviceCorrelationRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+	importedAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	lastSync := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	correlations, err := repo.GetByGUID(context.Background(), "guid1", "")
+	require.NoError(t, err)
+	require.Empty(t, correlations)
+
+	intune := entity.DeviceCorrelation{
+		GUID:            "guid1",
+		Source:          "intune",
+		ExternalID:      "id1",
+		DeviceName:      "DESKTOP-1",
+		MatchedBy:       "uuid",
+		ComplianceState: "noncompliant",
+		LastSync:        lastSync,
+		ImportedAt:      importedAt,
+	}
+	configmgr := entity.DeviceCorrelation{GUID: "guid1", Source: "configmgr", SerialNumber: "SN1", MatchedBy: "serialNumber", ImportedAt: importedAt}
+
+	require.NoError(t, repo.Save(context.Background(), intune))
+	require.NoError(t, repo.Save(context.Background(), configmgr))
+
+	intune.ComplianceState = "compliant"
+	intune.ImportedAt = importedAt.Add(time.Hour)
+	require.NoError(t, repo.Save(context.Background(), intune))
+
+	correlations, err = repo.GetByGUID(context.Background(), "guid1", "")
+	require.NoError(t, err)
+	require.Equal(t, []entity.DeviceCorrelation{configmgr, intune}, correlations)
+
+	failing := sqldb.NewDeviceCorrelationRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil))
+
+	_, err = failing.GetByGUID(context.Background(), "guid1", "")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	err = failing.Save(context.Background(), intune)
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
@@ -0,0 +1,227 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// EnergyPolicyRepo -.
+type EnergyPolicyRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var (
+	ErrEnergyPolicyDatabase  = DatabaseError{Console: consoleerrors.CreateConsoleError("EnergyPolicyRepo")}
+	ErrEnergyPolicyNotUnique = NotUniqueError{Console: consoleerrors.CreateConsoleError("EnergyPolicyRepo")}
+)
+
+var energyPolicyColumns = []string{
+	"name",
+	"tags",
+	"time_zone",
+	"days",
+	"power_off_time",
+	"power_on_time",
+	"exceptions",
+	"enabled",
+	"tenant_id",
+}
+
+// NewEnergyPolicyRepo -.
+func NewEnergyPolicyRepo(database *db.SQL, log logger.Interface) *EnergyPolicyRepo {
+	return &EnergyPolicyRepo{database, log}
+}
+
+// GetCount -.
+func (r *EnergyPolicyRepo) GetCount(ctx context.Context, tenantID string) (int, error) {
+	sqlQuery, args, err := r.Builder.
+		Select("COUNT(*)").
+		From("energy_policies").
+		Where("tenant_id = ?", tenantID).
+		ToSql()
+	if err != nil {
+		return 0, ErrEnergyPolicyDatabase.Wrap("GetCount", "r.Builder", err)
+	}
+
+	var count int
+
+	if err = r.Pool.QueryRowContext(ctx, sqlQuery, args...).Scan(&count); err != nil {
+		return 0, ErrEnergyPolicyDatabase.Wrap("GetCount", "r.Pool.QueryRow", err)
+	}
+
+	return count, nil
+}
+
+// Get -.
+func (r *EnergyPolicyRepo) Get(ctx context.Context, top, skip int, tenantID string) ([]entity.EnergyPolicy, error) {
+	const defaultTop = 100
+
+	limitedTop := uint64(defaultTop)
+	if top > 0 {
+		limitedTop = uint64(top)
+	}
+
+	limitedSkip := uint64(0)
+	if skip > 0 {
+		limitedSkip = uint64(skip)
+	}
+
+	sqlQuery, args, err := r.Builder.
+		Select(energyPolicyColumns...).
+		From("energy_policies").
+		Where("tenant_id = ?", tenantID).
+		OrderBy("name").
+		Limit(limitedTop).
+		Offset(limitedSkip).
+		ToSql()
+	if err != nil {
+		return nil, ErrEnergyPolicyDatabase.Wrap("Get", "r.Builder", err)
+	}
+
+	return r.query(ctx, "Get", sqlQuery, args)
+}
+
+// GetEnabled returns the enabled policies of all tenants.
+func (r *EnergyPolicyRepo) GetEnabled(ctx context.Context) ([]entity.EnergyPolicy, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(energyPolicyColumns...).
+		From("energy_policies").
+		Where("enabled = ?", true).
+		OrderBy("tenant_id", "name").
+		ToSql()
+	if err != nil {
+		return nil, ErrEnergyPolicyDatabase.Wrap("GetEnabled", "r.Builder", err)
+	}
+
+	return r.query(ctx, "GetEnabled", sqlQuery, args)
+}
+
+// GetByName -.
+func (r *EnergyPolicyRepo) GetByName(ctx context.Context, name, tenantID string) (*entity.EnergyPolicy, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(energyPolicyColumns...).
+		From("energy_policies").
+		Where("name = ? AND tenant_id = ?", name, tenantID).
+		ToSql()
+	if err != nil {
+		return nil, ErrEnergyPolicyDatabase.Wrap("GetByName", "r.Builder", err)
+	}
+
+	p := entity.EnergyPolicy{}
+
+	err = r.Pool.QueryRowContext(ctx, sqlQuery, args...).
+		Scan(&p.Name, &p.Tags, &p.TimeZone, &p.Days, &p.PowerOffTime, &p.PowerOnTime, &p.Exceptions, &p.Enabled, &p.TenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, ErrEnergyPolicyDatabase.Wrap("GetByName", "row.Scan", err)
+	}
+
+	return &p, nil
+}
+
+// Delete -.
+func (r *EnergyPolicyRepo) Delete(ctx context.Context, name, tenantID string) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Delete("energy_policies").
+		Where("name = ? AND tenant_id = ?", name, tenantID).
+		ToSql()
+	if err != nil {
+		return false, ErrEnergyPolicyDatabase.Wrap("Delete", "r.Builder", err)
+	}
+
+	return r.exec(ctx, "Delete", sqlQuery, args)
+}
+
+// Update -.
+func (r *EnergyPolicyRepo) Update(ctx context.Context, p *entity.EnergyPolicy) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Update("energy_policies").
+		Set("tags", p.Tags).
+		Set("time_zone", p.TimeZone).
+		Set("days", p.Days).
+		Set("power_off_time", p.PowerOffTime).
+		Set("power_on_time", p.PowerOnTime).
+		Set("exceptions", p.Exceptions).
+		Set("enabled", p.Enabled).
+		Where("name = ? AND tenant_id = ?", p.Name, p.TenantID).
+		ToSql()
+	if err != nil {
+		return false, ErrEnergyPolicyDatabase.Wrap("Update", "r.Builder", err)
+	}
+
+	return r.exec(ctx, "Update", sqlQuery, args)
+}
+
+// Insert -.
+func (r *EnergyPolicyRepo) Insert(ctx context.Context, p *entity.EnergyPolicy) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("energy_policies").
+		Columns(energyPolicyColumns...).
+		Values(p.Name, p.Tags, p.TimeZone, p.Days, p.PowerOffTime, p.PowerOnTime, p.Exceptions, p.Enabled, p.TenantID).
+		ToSql()
+	if err != nil {
+		return ErrEnergyPolicyDatabase.Wrap("Insert", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		if db.CheckNotUnique(err) {
+			return ErrEnergyPolicyNotUnique.Wrap(err.Error())
+		}
+
+		return ErrEnergyPolicyDatabase.Wrap("Insert", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
+
+func (r *EnergyPolicyRepo) query(ctx context.Context, function, sqlQuery string, args []interface{}) ([]entity.EnergyPolicy, error) {
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrEnergyPolicyDatabase.Wrap(function, "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	policies := make([]entity.EnergyPolicy, 0)
+
+	for rows.Next() {
+		p := entity.EnergyPolicy{}
+
+		err = rows.Scan(&p.Name, &p.Tags, &p.TimeZone, &p.Days, &p.PowerOffTime, &p.PowerOnTime, &p.Exceptions, &p.Enabled, &p.TenantID)
+		if err != nil {
+			return nil, ErrEnergyPolicyDatabase.Wrap(function, "rows.Scan", err)
+		}
+
+		policies = append(policies, p)
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrEnergyPolicyDatabase.Wrap(function, "rows.Err", rows.Err())
+	}
+
+	return policies, nil
+}
+
+func (r *EnergyPolicyRepo) exec(ctx context.Context, function, sqlQuery string, args []interface{}) (bool, error) {
+	res, err := r.Pool.ExecContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return false, ErrEnergyPolicyDatabase.Wrap(function, "r.Pool.Exec", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, ErrEnergyPolicyDatabase.Wrap(function, "res.RowsAffected", err)
+	}
+
+	return affected > 0, nil
+}
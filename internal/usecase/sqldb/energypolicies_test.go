@@ -0,0 +1,150 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupEnergyPolicyTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE energy_policies (
+			name TEXT NOT NULL,
+			tags TEXT NOT NULL,
+			time_zone TEXT NOT NULL,
+			days TEXT NOT NULL,
+			power_off_time TEXT NOT NULL DEFAULT '',
+			power_on_time TEXT NOT NULL DEFAULT '',
+			exceptions TEXT NOT NULL DEFAULT '',
+			enabled BOOLEAN NOT NULL,
+			tenant_id TEXT NOT NULL,
+			PRIMARY KEY (name, tenant_id)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func energyPolicy(name, tenantID string, enabled bool) entity.EnergyPolicy {
+	return entity.EnergyPolicy{
+		Name:         name,
+		Tags:         "office,lab",
+		TimeZone:     "Europe/Berlin",
+		Days:         "mon,tue,wed,thu,fri",
+		PowerOffTime: "19:00",
+		PowerOnTime:  "07:00",
+		Exceptions:   "123e4567-e89b-12d3-a456-426614174000",
+		Enabled:      enabled,
+		TenantID:     tenantID,
+	}
+}
+
+func TestEnergyPolicyRepo(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupEnergyPolicyTable(t)
+	defer dbConn.Close()
+
+	ctx := context.Background()
+	repo := sqldb.NewEnergyPolicyRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+	office := energyPolicy("office", "", true)
+	lab := energyPolicy("lab", "", false)
+	other := energyPolicy("office", "tenant2", true)
+
+	for _, p := range []entity.EnergyPolicy{office, lab, other} {
+		require.NoError(t, repo.Insert(ctx, &p))
+	}
+
+	err := repo.Insert(ctx, &office)
+	require.IsType(t, sqldb.NotUniqueError{}, err)
+
+	count, err := repo.GetCount(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	policies, err := repo.Get(ctx, 0, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []entity.EnergyPolicy{lab, office}, policies)
+
+	policies, err = repo.Get(ctx, 1, 1, "")
+	require.NoError(t, err)
+	require.Equal(t, []entity.EnergyPolicy{office}, policies)
+
+	policies, err = repo.GetEnabled(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []entity.EnergyPolicy{office, other}, policies)
+
+	lab.Enabled = true
+	lab.PowerOffTime = ""
+
+	updated, err := repo.Update(ctx, &lab)
+	require.NoError(t, err)
+	require.True(t, updated)
+
+	got, err := repo.GetByName(ctx, "lab", "")
+	require.NoError(t, err)
+	require.Equal(t, &lab, got)
+
+	missing := energyPolicy("missing", "", true)
+
+	updated, err = repo.Update(ctx, &missing)
+	require.NoError(t, err)
+	require.False(t, updated)
+
+	deleted, err := repo.Delete(ctx, "lab", "")
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	got, err = repo.GetByName(ctx, "lab", "")
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	deleted, err = repo.Delete(ctx, "lab", "")
+	require.NoError(t, err)
+	require.False(t, deleted)
+}
+
+func TestEnergyPolicyRepo_QueryExecutionError(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupEnergyPolicyTable(t)
+	defer dbConn.Close()
+
+	ctx := context.Background()
+	repo := sqldb.NewEnergyPolicyRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil))
+	p := energyPolicy("office", "", true)
+
+	_, err := repo.GetCount(ctx, "")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	_, err = repo.Get(ctx, 0, 0, "")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	_, err = repo.GetEnabled(ctx)
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	_, err = repo.GetByName(ctx, "office", "")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	_, err = repo.Update(ctx, &p)
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	_, err = repo.Delete(ctx, "office", "")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	err = repo.Insert(ctx, &p)
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
@@ -0,0 +1,188 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// EnrollmentTokenRepo stores the single-use tokens devices are enrolled with.
+// Times are kept as unix seconds so that the same queries work on sqlite and postgres.
+type EnrollmentTokenRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var ErrEnrollmentTokenDatabase = DatabaseError{Console: consoleerrors.CreateConsoleError("EnrollmentTokenRepo")}
+
+// enrollmentTokenColumns are the columns a token is read from, in the order query scans them.
+var enrollmentTokenColumns = []string{
+	"id", "token_hash", "tenant_id", "description", "cira_config", "tags", "created_by", "created_at", "expires_at", "used_at", "used_by",
+}
+
+// NewEnrollmentTokenRepo -.
+func NewEnrollmentTokenRepo(database *db.SQL, log logger.Interface) *EnrollmentTokenRepo {
+	return &EnrollmentTokenRepo{database, log}
+}
+
+// Insert -.
+func (r *EnrollmentTokenRepo) Insert(ctx context.Context, t entity.EnrollmentToken) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("enrollment_tokens").
+		Columns(enrollmentTokenColumns...).
+		Values(t.ID, t.TokenHash, t.TenantID, t.Description, t.CIRAConfig, t.Tags, t.CreatedBy,
+			t.CreatedAt.Unix(), t.ExpiresAt.Unix(), unixOrZero(t.UsedAt), t.UsedBy).
+		ToSql()
+	if err != nil {
+		return ErrEnrollmentTokenDatabase.Wrap("Insert", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrEnrollmentTokenDatabase.Wrap("Insert", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
+
+// Get returns the tokens of a tenant, newest first.
+func (r *EnrollmentTokenRepo) Get(ctx context.Context, tenantID string) ([]entity.EnrollmentToken, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(enrollmentTokenColumns...).
+		From("enrollment_tokens").
+		Where("tenant_id = ?", tenantID).
+		OrderBy("created_at DESC", "id").
+		ToSql()
+	if err != nil {
+		return nil, ErrEnrollmentTokenDatabase.Wrap("Get", "r.Builder", err)
+	}
+
+	return r.query(ctx, "Get", sqlQuery, args)
+}
+
+// GetByHash returns the token of a hash, nil when there is none.
+func (r *EnrollmentTokenRepo) GetByHash(ctx context.Context, hash string) (*entity.EnrollmentToken, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(enrollmentTokenColumns...).
+		From("enrollment_tokens").
+		Where("token_hash = ?", hash).
+		ToSql()
+	if err != nil {
+		return nil, ErrEnrollmentTokenDatabase.Wrap("GetByHash", "r.Builder", err)
+	}
+
+	tokens, err := r.query(ctx, "GetByHash", sqlQuery, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	return &tokens[0], nil
+}
+
+// Delete deletes a token of a tenant, it returns false when there is none.
+func (r *EnrollmentTokenRepo) Delete(ctx context.Context, id, tenantID string) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Delete("enrollment_tokens").
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		ToSql()
+	if err != nil {
+		return false, ErrEnrollmentTokenDatabase.Wrap("Delete", "r.Builder", err)
+	}
+
+	return r.exec(ctx, "Delete", sqlQuery, args)
+}
+
+// Redeem marks a token as used by the device guid when it is neither used nor expired at the time at, it returns
+// false otherwise. Of concurrent redemptions of a token only one succeeds.
+func (r *EnrollmentTokenRepo) Redeem(ctx context.Context, id, guid string, at time.Time) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Update("enrollment_tokens").
+		Set("used_at", at.Unix()).
+		Set("used_by", guid).
+		Where("id = ? AND used_at = 0 AND expires_at > ?", id, at.Unix()).
+		ToSql()
+	if err != nil {
+		return false, ErrEnrollmentTokenDatabase.Wrap("Redeem", "r.Builder", err)
+	}
+
+	return r.exec(ctx, "Redeem", sqlQuery, args)
+}
+
+// Release makes a redeemed token usable again, for an enrollment that failed after redeeming it.
+func (r *EnrollmentTokenRepo) Release(ctx context.Context, id string) error {
+	sqlQuery, args, err := r.Builder.
+		Update("enrollment_tokens").
+		Set("used_at", 0).
+		Set("used_by", "").
+		Where("id = ?", id).
+		ToSql()
+	if err != nil {
+		return ErrEnrollmentTokenDatabase.Wrap("Release", "r.Builder", err)
+	}
+
+	_, err = r.exec(ctx, "Release", sqlQuery, args)
+
+	return err
+}
+
+// exec runs a statement of the operation op, it returns whether a row was changed.
+func (r *EnrollmentTokenRepo) exec(ctx context.Context, op, sqlQuery string, args []interface{}) (bool, error) {
+	res, err := r.Pool.ExecContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return false, ErrEnrollmentTokenDatabase.Wrap(op, "r.Pool.Exec", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, ErrEnrollmentTokenDatabase.Wrap(op, "res.RowsAffected", err)
+	}
+
+	return rows > 0, nil
+}
+
+// query returns the tokens selected by sqlQuery from the columns enrollmentTokenColumns.
+func (r *EnrollmentTokenRepo) query(ctx context.Context, function, sqlQuery string, args []interface{}) ([]entity.EnrollmentToken, error) {
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrEnrollmentTokenDatabase.Wrap(function, "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	tokens := make([]entity.EnrollmentToken, 0)
+
+	for rows.Next() {
+		var (
+			t                            entity.EnrollmentToken
+			createdAt, expiresAt, usedAt int64
+		)
+
+		err = rows.Scan(&t.ID, &t.TokenHash, &t.TenantID, &t.Description, &t.CIRAConfig, &t.Tags, &t.CreatedBy,
+			&createdAt, &expiresAt, &usedAt, &t.UsedBy)
+		if err != nil {
+			return nil, ErrEnrollmentTokenDatabase.Wrap(function, "rows.Scan", err)
+		}
+
+		t.CreatedAt = time.Unix(createdAt, 0).UTC()
+		t.ExpiresAt = time.Unix(expiresAt, 0).UTC()
+
+		if usedAt != 0 {
+			t.UsedAt = time.Unix(usedAt, 0).UTC()
+		}
+
+		tokens = append(tokens, t)
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrEnrollmentTokenDatabase.Wrap(function, "rows.Err", rows.Err())
+	}
+
+	return tokens, nil
+}
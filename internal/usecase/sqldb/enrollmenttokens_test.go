@@ -0,0 +1,109 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupEnrollmentTokenTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE enrollment_tokens (
+			id TEXT NOT NULL,
+			token_hash TEXT NOT NULL,
+			tenant_id TEXT NOT NULL DEFAULT '',
+			description TEXT NOT NULL DEFAULT '',
+			cira_config TEXT NOT NULL,
+			tags TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			created_at BIGINT NOT NULL,
+			expires_at BIGINT NOT NULL,
+			used_at BIGINT NOT NULL DEFAULT 0,
+			used_by TEXT NOT NULL DEFAULT '',
+			PRIMARY KEY (id),
+			UNIQUE (token_hash)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestEnrollmentTokenRepo(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupEnrollmentTokenTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewEnrollmentTokenRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+	ctx := context.Background()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := entity.EnrollmentToken{ID: "1", TokenHash: "hash1", TenantID: "tenant", CIRAConfig: "cira", Tags: "lab", CreatedBy: "admin", CreatedAt: start, ExpiresAt: start.Add(time.Hour)}
+	second := entity.EnrollmentToken{ID: "2", TokenHash: "hash2", TenantID: "tenant", CIRAConfig: "cira", CreatedAt: start.Add(time.Minute), ExpiresAt: start.Add(time.Hour)}
+
+	for _, token := range []entity.EnrollmentToken{first, second, {ID: "3", TokenHash: "hash3", TenantID: "other", CIRAConfig: "cira", CreatedAt: start, ExpiresAt: start}} {
+		require.NoError(t, repo.Insert(ctx, token))
+	}
+
+	// a hash is unique
+	require.Error(t, repo.Insert(ctx, entity.EnrollmentToken{ID: "4", TokenHash: "hash1", CreatedAt: start, ExpiresAt: start}))
+
+	token, err := repo.GetByHash(ctx, "hash1")
+	require.NoError(t, err)
+	require.Equal(t, &first, token)
+
+	token, err = repo.GetByHash(ctx, "unknown")
+	require.NoError(t, err)
+	require.Nil(t, token)
+
+	// an expired token is not redeemed
+	redeemed, err := repo.Redeem(ctx, "1", "guid1", start.Add(time.Hour))
+	require.NoError(t, err)
+	require.False(t, redeemed)
+
+	redeemed, err = repo.Redeem(ctx, "1", "guid1", start.Add(30*time.Minute))
+	require.NoError(t, err)
+	require.True(t, redeemed)
+
+	// a token is redeemed once
+	redeemed, err = repo.Redeem(ctx, "1", "guid2", start.Add(31*time.Minute))
+	require.NoError(t, err)
+	require.False(t, redeemed)
+
+	first.UsedAt, first.UsedBy = start.Add(30*time.Minute), "guid1"
+
+	tokens, err := repo.Get(ctx, "tenant")
+	require.NoError(t, err)
+	require.Equal(t, []entity.EnrollmentToken{second, first}, tokens)
+
+	// a released token is redeemed again
+	require.NoError(t, repo.Release(ctx, "1"))
+
+	redeemed, err = repo.Redeem(ctx, "1", "guid2", start.Add(32*time.Minute))
+	require.NoError(t, err)
+	require.True(t, redeemed)
+
+	deleted, err := repo.Delete(ctx, "3", "tenant")
+	require.NoError(t, err)
+	require.False(t, deleted)
+
+	deleted, err = repo.Delete(ctx, "3", "other")
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	_, err = sqldb.NewEnrollmentTokenRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil)).Get(ctx, "tenant")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
@@ -15,3 +15,7 @@ func (e ForeignKeyViolationError) Wrap(details string) error {
 
 	return e
 }
+
+func (e ForeignKeyViolationError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeInUse
+}
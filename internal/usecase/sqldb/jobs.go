@@ -0,0 +1,361 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// Job states the repository changes on its own, they match the states of the jobs use case.
+const (
+	jobStatePending   = "pending"
+	jobStateRunning   = "running"
+	jobStateCancelled = "cancelled"
+)
+
+// JobRepo stores the background jobs, the times are kept as unix nanoseconds and 0 when unset.
+type JobRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var ErrJobDatabase = DatabaseError{Console: consoleerrors.CreateConsoleError("JobRepo")}
+
+var jobColumns = []string{
+	"id",
+	"type",
+	"tenant_id",
+	"payload",
+	"state",
+	"attempts",
+	"max_attempts",
+	"total",
+	"succeeded",
+	"failed",
+	"items",
+	"result",
+	"error",
+	"created_at",
+	"updated_at",
+	"run_after",
+	"started_at",
+	"finished_at",
+}
+
+// NewJobRepo -.
+func NewJobRepo(database *db.SQL, log logger.Interface) *JobRepo {
+	return &JobRepo{database, log}
+}
+
+// Get returns a page of the jobs of a tenant, the newest first. An empty state returns the jobs
+// in every state.
+func (r *JobRepo) Get(ctx context.Context, top, skip int, tenantID, state string) ([]entity.Job, error) {
+	builder := r.Builder.
+		Select(jobColumns...).
+		From("jobs").
+		Where("tenant_id = ?", tenantID).
+		OrderBy("created_at DESC", "id").
+		Limit(uint64(top)).
+		Offset(uint64(skip))
+
+	if state != "" {
+		builder = builder.Where("state = ?", state)
+	}
+
+	sqlQuery, args, err := builder.ToSql()
+	if err != nil {
+		return nil, ErrJobDatabase.Wrap("Get", "r.Builder", err)
+	}
+
+	return r.query(ctx, "Get", sqlQuery, args)
+}
+
+// GetByID returns a job, or nil when there is no job with the ID.
+func (r *JobRepo) GetByID(ctx context.Context, id string) (*entity.Job, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(jobColumns...).
+		From("jobs").
+		Where("id = ?", id).
+		ToSql()
+	if err != nil {
+		return nil, ErrJobDatabase.Wrap("GetByID", "r.Builder", err)
+	}
+
+	jobs, err := r.query(ctx, "GetByID", sqlQuery, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	return &jobs[0], nil
+}
+
+// GetRunnable returns up to limit pending jobs due at now, the oldest first. The jobs of the
+// excluded tenants are skipped.
+func (r *JobRepo) GetRunnable(ctx context.Context, now time.Time, limit int, excludedTenants []string) ([]entity.Job, error) {
+	builder := r.Builder.
+		Select(jobColumns...).
+		From("jobs").
+		Where("state = ? AND run_after <= ?", jobStatePending, now.UnixNano()).
+		OrderBy("created_at", "id").
+		Limit(uint64(limit))
+
+	if len(excludedTenants) > 0 {
+		builder = builder.Where(squirrel.NotEq{"tenant_id": excludedTenants})
+	}
+
+	sqlQuery, args, err := builder.ToSql()
+	if err != nil {
+		return nil, ErrJobDatabase.Wrap("GetRunnable", "r.Builder", err)
+	}
+
+	return r.query(ctx, "GetRunnable", sqlQuery, args)
+}
+
+// GetRunning returns the jobs of every tenant marked running, the oldest first.
+func (r *JobRepo) GetRunning(ctx context.Context) ([]entity.Job, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(jobColumns...).
+		From("jobs").
+		Where("state = ?", jobStateRunning).
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, ErrJobDatabase.Wrap("GetRunning", "r.Builder", err)
+	}
+
+	return r.query(ctx, "GetRunning", sqlQuery, args)
+}
+
+// CountPending returns the number of pending jobs of every tenant with any.
+func (r *JobRepo) CountPending(ctx context.Context) (map[string]int, error) {
+	sqlQuery, args, err := r.Builder.
+		Select("tenant_id", "COUNT(*)").
+		From("jobs").
+		Where("state = ?", jobStatePending).
+		GroupBy("tenant_id").
+		ToSql()
+	if err != nil {
+		return nil, ErrJobDatabase.Wrap("CountPending", "r.Builder", err)
+	}
+
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrJobDatabase.Wrap("CountPending", "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	counts := map[string]int{}
+
+	for rows.Next() {
+		var (
+			tenantID string
+			count    int
+		)
+
+		if err := rows.Scan(&tenantID, &count); err != nil {
+			return nil, ErrJobDatabase.Wrap("CountPending", "rows.Scan", err)
+		}
+
+		counts[tenantID] = count
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrJobDatabase.Wrap("CountPending", "rows.Err", rows.Err())
+	}
+
+	return counts, nil
+}
+
+// Insert -.
+func (r *JobRepo) Insert(ctx context.Context, j entity.Job) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("jobs").
+		Columns(jobColumns...).
+		Values(j.ID, j.Type, j.TenantID, j.Payload, j.State, j.Attempts, j.MaxAttempts, j.Total, j.Succeeded, j.Failed,
+			j.Items, j.Result, j.Error, j.CreatedAt.UnixNano(), unixNano(j.UpdatedAt), unixNano(j.RunAfter),
+			unixNano(j.StartedAt), unixNano(j.FinishedAt)).
+		ToSql()
+	if err != nil {
+		return ErrJobDatabase.Wrap("Insert", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrJobDatabase.Wrap("Insert", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
+
+// Update saves the state, progress and outcome of a job. It returns false when the job does not exist.
+func (r *JobRepo) Update(ctx context.Context, j entity.Job) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Update("jobs").
+		Set("state", j.State).
+		Set("attempts", j.Attempts).
+		Set("total", j.Total).
+		Set("succeeded", j.Succeeded).
+		Set("failed", j.Failed).
+		Set("items", j.Items).
+		Set("result", j.Result).
+		Set("error", j.Error).
+		Set("updated_at", unixNano(j.UpdatedAt)).
+		Set("run_after", unixNano(j.RunAfter)).
+		Set("started_at", unixNano(j.StartedAt)).
+		Set("finished_at", unixNano(j.FinishedAt)).
+		Where("id = ?", j.ID).
+		ToSql()
+	if err != nil {
+		return false, ErrJobDatabase.Wrap("Update", "r.Builder", err)
+	}
+
+	return r.exec(ctx, "Update", sqlQuery, args)
+}
+
+// Claim marks a pending job running and counts the attempt. It returns false when the job is no
+// longer pending, taken by another worker or cancelled in the meantime.
+func (r *JobRepo) Claim(ctx context.Context, id string, startedAt time.Time) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Update("jobs").
+		Set("state", jobStateRunning).
+		Set("attempts", squirrel.Expr("attempts + 1")).
+		Set("started_at", startedAt.UnixNano()).
+		Set("updated_at", startedAt.UnixNano()).
+		Where("id = ? AND state = ?", id, jobStatePending).
+		ToSql()
+	if err != nil {
+		return false, ErrJobDatabase.Wrap("Claim", "r.Builder", err)
+	}
+
+	return r.exec(ctx, "Claim", sqlQuery, args)
+}
+
+// CancelPending cancels a job that has not started. It returns false when the job is not pending.
+func (r *JobRepo) CancelPending(ctx context.Context, id string, at time.Time) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Update("jobs").
+		Set("state", jobStateCancelled).
+		Set("updated_at", at.UnixNano()).
+		Set("finished_at", at.UnixNano()).
+		Where("id = ? AND state = ?", id, jobStatePending).
+		ToSql()
+	if err != nil {
+		return false, ErrJobDatabase.Wrap("CancelPending", "r.Builder", err)
+	}
+
+	return r.exec(ctx, "CancelPending", sqlQuery, args)
+}
+
+// UpdatePending stores the payload and the progress of the devices of a job still pending. It returns false when
+// the job is no longer pending, taken by a worker or cancelled in the meantime.
+func (r *JobRepo) UpdatePending(ctx context.Context, j entity.Job) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Update("jobs").
+		Set("payload", j.Payload).
+		Set("total", j.Total).
+		Set("succeeded", j.Succeeded).
+		Set("failed", j.Failed).
+		Set("items", j.Items).
+		Set("updated_at", unixNano(j.UpdatedAt)).
+		Where("id = ? AND state = ?", j.ID, jobStatePending).
+		ToSql()
+	if err != nil {
+		return false, ErrJobDatabase.Wrap("UpdatePending", "r.Builder", err)
+	}
+
+	return r.exec(ctx, "UpdatePending", sqlQuery, args)
+}
+
+// DeleteFinishedBefore removes the jobs that finished before a time, it returns the number removed.
+func (r *JobRepo) DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	sqlQuery, args, err := r.Builder.
+		Delete("jobs").
+		Where("finished_at > 0 AND finished_at < ?", before.UnixNano()).
+		ToSql()
+	if err != nil {
+		return 0, ErrJobDatabase.Wrap("DeleteFinishedBefore", "r.Builder", err)
+	}
+
+	res, err := r.Pool.ExecContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return 0, ErrJobDatabase.Wrap("DeleteFinishedBefore", "r.Pool.Exec", err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, ErrJobDatabase.Wrap("DeleteFinishedBefore", "res.RowsAffected", err)
+	}
+
+	return count, nil
+}
+
+func (r *JobRepo) exec(ctx context.Context, function, sqlQuery string, args []interface{}) (bool, error) {
+	res, err := r.Pool.ExecContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return false, ErrJobDatabase.Wrap(function, "r.Pool.Exec", err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return false, ErrJobDatabase.Wrap(function, "res.RowsAffected", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *JobRepo) query(ctx context.Context, function, sqlQuery string, args []interface{}) ([]entity.Job, error) {
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrJobDatabase.Wrap(function, "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	jobs := make([]entity.Job, 0)
+
+	for rows.Next() {
+		var (
+			j                                                     entity.Job
+			createdAt, updatedAt, runAfter, startedAt, finishedAt int64
+		)
+
+		err = rows.Scan(&j.ID, &j.Type, &j.TenantID, &j.Payload, &j.State, &j.Attempts, &j.MaxAttempts, &j.Total,
+			&j.Succeeded, &j.Failed, &j.Items, &j.Result, &j.Error, &createdAt, &updatedAt, &runAfter, &startedAt, &finishedAt)
+		if err != nil {
+			return nil, ErrJobDatabase.Wrap(function, "rows.Scan", err)
+		}
+
+		j.CreatedAt = time.Unix(0, createdAt).UTC()
+		j.UpdatedAt = fromUnixNano(updatedAt)
+		j.RunAfter = fromUnixNano(runAfter)
+		j.StartedAt = fromUnixNano(startedAt)
+		j.FinishedAt = fromUnixNano(finishedAt)
+
+		jobs = append(jobs, j)
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrJobDatabase.Wrap(function, "rows.Err", rows.Err())
+	}
+
+	return jobs, nil
+}
+
+// fromUnixNano reads 0 back as the zero time.
+func fromUnixNano(n int64) time.Time {
+	if n == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, n).UTC()
+}
@@ -0,0 +1,163 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupJobTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE jobs (
+			id TEXT NOT NULL,
+			type TEXT NOT NULL,
+			tenant_id TEXT NOT NULL DEFAULT '',
+			payload TEXT NOT NULL DEFAULT '',
+			state TEXT NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			max_attempts INTEGER NOT NULL DEFAULT 1,
+			total INTEGER NOT NULL DEFAULT 0,
+			succeeded INTEGER NOT NULL DEFAULT 0,
+			failed INTEGER NOT NULL DEFAULT 0,
+			items TEXT NOT NULL DEFAULT '',
+			result TEXT NOT NULL DEFAULT '',
+			error TEXT NOT NULL DEFAULT '',
+			created_at BIGINT NOT NULL,
+			updated_at BIGINT NOT NULL,
+			run_after BIGINT NOT NULL DEFAULT 0,
+			started_at BIGINT NOT NULL DEFAULT 0,
+			finished_at BIGINT NOT NULL DEFAULT 0,
+			PRIMARY KEY (id)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestJobRepo(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupJobTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewJobRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+	ctx := context.Background()
+	createdAt := time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)
+
+	job1 := entity.Job{ID: "job1", Type: "power", Payload: `{"action":8}`, State: "pending", MaxAttempts: 3,
+		CreatedAt: createdAt, UpdatedAt: createdAt}
+	job2 := entity.Job{ID: "job2", Type: "hardware-refresh", State: "pending", MaxAttempts: 1,
+		CreatedAt: createdAt.Add(time.Second), UpdatedAt: createdAt, RunAfter: createdAt.Add(time.Minute)}
+	other := entity.Job{ID: "job3", Type: "key-rotation", TenantID: "tenant2", State: "pending", MaxAttempts: 1,
+		CreatedAt: createdAt, UpdatedAt: createdAt}
+
+	for _, j := range []entity.Job{job1, job2, other} {
+		require.NoError(t, repo.Insert(ctx, j))
+	}
+
+	require.Error(t, repo.Insert(ctx, job1))
+
+	jobs, err := repo.Get(ctx, 10, 0, "", "")
+	require.NoError(t, err)
+	require.Equal(t, []entity.Job{job2, job1}, jobs)
+
+	jobs, err = repo.GetRunnable(ctx, createdAt, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Job{job1, other}, jobs)
+
+	jobs, err = repo.GetRunnable(ctx, createdAt, 10, []string{""})
+	require.NoError(t, err)
+	require.Equal(t, []entity.Job{other}, jobs)
+
+	counts, err := repo.CountPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"": 2, "tenant2": 1}, counts)
+
+	startedAt := createdAt.Add(2 * time.Second)
+
+	claimed, err := repo.Claim(ctx, "job1", startedAt)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	claimed, err = repo.Claim(ctx, "job1", startedAt)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	jobs, err = repo.GetRunning(ctx)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "job1", jobs[0].ID)
+
+	job, err := repo.GetByID(ctx, "job1")
+	require.NoError(t, err)
+	require.Equal(t, "running", job.State)
+	require.Equal(t, 1, job.Attempts)
+	require.Equal(t, startedAt, job.StartedAt)
+
+	job.State = "completed"
+	job.Total, job.Succeeded = 1, 1
+	job.Items = `[{"guid":"guid1","state":"completed"}]`
+	job.FinishedAt = startedAt.Add(time.Second)
+
+	updated, err := repo.Update(ctx, *job)
+	require.NoError(t, err)
+	require.True(t, updated)
+
+	saved, err := repo.GetByID(ctx, "job1")
+	require.NoError(t, err)
+	require.Equal(t, job, saved)
+
+	updated, err = repo.UpdatePending(ctx, *job)
+	require.NoError(t, err)
+	require.False(t, updated)
+
+	job2.Payload = `{"guids":["guid2"]}`
+	job2.Total = 1
+	job2.Items = `[{"guid":"guid2","state":"pending"}]`
+
+	updated, err = repo.UpdatePending(ctx, job2)
+	require.NoError(t, err)
+	require.True(t, updated)
+
+	saved, err = repo.GetByID(ctx, "job2")
+	require.NoError(t, err)
+	require.Equal(t, &job2, saved)
+
+	cancelled, err := repo.CancelPending(ctx, "job1", startedAt)
+	require.NoError(t, err)
+	require.False(t, cancelled)
+
+	cancelled, err = repo.CancelPending(ctx, "job2", startedAt)
+	require.NoError(t, err)
+	require.True(t, cancelled)
+
+	jobs, err = repo.Get(ctx, 10, 0, "", "cancelled")
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "job2", jobs[0].ID)
+
+	count, err := repo.DeleteFinishedBefore(ctx, startedAt.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	job, err = repo.GetByID(ctx, "job1")
+	require.NoError(t, err)
+	require.Nil(t, job)
+
+	updated, err = repo.Update(ctx, entity.Job{ID: "job1"})
+	require.NoError(t, err)
+	require.False(t, updated)
+}
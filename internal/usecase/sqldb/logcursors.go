@@ -0,0 +1,74 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// LogCursorRepo stores how far the device logs were forwarded, times are kept as unix seconds.
+type LogCursorRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var ErrLogCursorDatabase = DatabaseError{Console: consoleerrors.CreateConsoleError("LogCursorRepo")}
+
+// NewLogCursorRepo -.
+func NewLogCursorRepo(database *db.SQL, log logger.Interface) *LogCursorRepo {
+	return &LogCursorRepo{database, log}
+}
+
+// Get returns the cursor of a device log, a zero cursor when the log was never forwarded.
+func (r *LogCursorRepo) Get(ctx context.Context, guid, log string) (entity.LogCursor, error) {
+	cursor := entity.LogCursor{GUID: guid, Log: log}
+
+	sqlQuery, args, err := r.Builder.
+		Select("last_index", "last_time").
+		From("log_cursors").
+		Where("guid = ? AND log = ?", guid, log).
+		ToSql()
+	if err != nil {
+		return cursor, ErrLogCursorDatabase.Wrap("Get", "r.Builder", err)
+	}
+
+	var lastTime int64
+
+	err = r.Pool.QueryRowContext(ctx, sqlQuery, args...).Scan(&cursor.LastIndex, &lastTime)
+	if errors.Is(err, sql.ErrNoRows) {
+		return cursor, nil
+	}
+
+	if err != nil {
+		return cursor, ErrLogCursorDatabase.Wrap("Get", "r.Pool.QueryRow", err)
+	}
+
+	cursor.LastTime = time.Unix(lastTime, 0).UTC()
+
+	return cursor, nil
+}
+
+// Save inserts or replaces the cursor of a device log.
+func (r *LogCursorRepo) Save(ctx context.Context, c entity.LogCursor) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("log_cursors").
+		Columns("guid", "log", "last_index", "last_time").
+		Values(c.GUID, c.Log, c.LastIndex, c.LastTime.Unix()).
+		Suffix("ON CONFLICT (guid, log) DO UPDATE SET last_index = excluded.last_index, last_time = excluded.last_time").
+		ToSql()
+	if err != nil {
+		return ErrLogCursorDatabase.Wrap("Save", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrLogCursorDatabase.Wrap("Save", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
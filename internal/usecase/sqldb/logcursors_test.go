@@ -0,0 +1,68 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupLogCursorTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE log_cursors (
+			guid TEXT NOT NULL,
+			log TEXT NOT NULL,
+			last_index INTEGER NOT NULL DEFAULT 0,
+			last_time BIGINT NOT NULL DEFAULT 0,
+			PRIMARY KEY (guid, log)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestLogCursorRepo(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupLogCursorTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewLogCursorRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+	lastTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cursor, err := repo.Get(context.Background(), "guid1", "audit")
+	require.NoError(t, err)
+	require.Equal(t, entity.LogCursor{GUID: "guid1", Log: "audit"}, cursor)
+
+	require.NoError(t, repo.Save(context.Background(), entity.LogCursor{GUID: "guid1", Log: "audit", LastIndex: 3, LastTime: lastTime}))
+	require.NoError(t, repo.Save(context.Background(), entity.LogCursor{GUID: "guid1", Log: "event", LastTime: lastTime}))
+	require.NoError(t, repo.Save(context.Background(), entity.LogCursor{GUID: "guid1", Log: "audit", LastIndex: 5, LastTime: lastTime.Add(time.Hour)}))
+
+	cursor, err = repo.Get(context.Background(), "guid1", "audit")
+	require.NoError(t, err)
+	require.Equal(t, entity.LogCursor{GUID: "guid1", Log: "audit", LastIndex: 5, LastTime: lastTime.Add(time.Hour)}, cursor)
+
+	cursor, err = repo.Get(context.Background(), "guid1", "event")
+	require.NoError(t, err)
+	require.Equal(t, entity.LogCursor{GUID: "guid1", Log: "event", LastTime: lastTime}, cursor)
+
+	failing := sqldb.NewLogCursorRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil))
+
+	_, err = failing.Get(context.Background(), "guid1", "audit")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	err = failing.Save(context.Background(), entity.LogCursor{GUID: "guid1", Log: "audit"})
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
@@ -23,3 +23,12 @@ func (e NotFoundError) WrapWithMessage(call, function, message string) error {
 
 	return e
 }
+
+// ErrorCode is the code of the error, CodeNotFound unless the resource sets its own.
+func (e NotFoundError) ErrorCode() consoleerrors.Code {
+	if e.Console.Code != "" {
+		return e.Console.Code
+	}
+
+	return consoleerrors.CodeNotFound
+}
@@ -15,3 +15,7 @@ func (e NotUniqueError) Wrap(details string) error {
 
 	return e
 }
+
+func (e NotUniqueError) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeAlreadyExists
+}
@@ -0,0 +1,107 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// PowerSampleRepo stores the power state samples of the power state poller.
+// Sample times are kept as unix seconds so that the same queries work on sqlite and postgres.
+type PowerSampleRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var ErrPowerSampleDatabase = DatabaseError{Console: consoleerrors.CreateConsoleError("PowerSampleRepo")}
+
+// NewPowerSampleRepo -.
+func NewPowerSampleRepo(database *db.SQL, log logger.Interface) *PowerSampleRepo {
+	return &PowerSampleRepo{database, log}
+}
+
+// Insert -.
+func (r *PowerSampleRepo) Insert(ctx context.Context, s entity.PowerSample) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("power_samples").
+		Columns("guid", "sampled_at", "power_state").
+		Values(s.GUID, s.SampledAt.Unix(), s.PowerState).
+		ToSql()
+	if err != nil {
+		return ErrPowerSampleDatabase.Wrap("Insert", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrPowerSampleDatabase.Wrap("Insert", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
+
+// GetByGUID returns the samples of a device taken at or after from, oldest first.
+func (r *PowerSampleRepo) GetByGUID(ctx context.Context, guid string, from time.Time) ([]entity.PowerSample, error) {
+	sqlQuery, args, err := r.Builder.
+		Select("sampled_at", "power_state").
+		From("power_samples").
+		Where("guid = ? AND sampled_at >= ?", guid, from.Unix()).
+		OrderBy("sampled_at").
+		ToSql()
+	if err != nil {
+		return nil, ErrPowerSampleDatabase.Wrap("GetByGUID", "r.Builder", err)
+	}
+
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrPowerSampleDatabase.Wrap("GetByGUID", "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	samples := make([]entity.PowerSample, 0)
+
+	for rows.Next() {
+		var sampledAt int64
+
+		s := entity.PowerSample{GUID: guid}
+
+		if err = rows.Scan(&sampledAt, &s.PowerState); err != nil {
+			return nil, ErrPowerSampleDatabase.Wrap("GetByGUID", "rows.Scan", err)
+		}
+
+		s.SampledAt = time.Unix(sampledAt, 0).UTC()
+		samples = append(samples, s)
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrPowerSampleDatabase.Wrap("GetByGUID", "rows.Err", rows.Err())
+	}
+
+	return samples, nil
+}
+
+// DeleteBefore removes the samples taken before the given time and returns how many were removed.
+func (r *PowerSampleRepo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	sqlQuery, args, err := r.Builder.
+		Delete("power_samples").
+		Where("sampled_at < ?", before.Unix()).
+		ToSql()
+	if err != nil {
+		return 0, ErrPowerSampleDatabase.Wrap("DeleteBefore", "r.Builder", err)
+	}
+
+	res, err := r.Pool.ExecContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return 0, ErrPowerSampleDatabase.Wrap("DeleteBefore", "r.Pool.Exec", err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, ErrPowerSampleDatabase.Wrap("DeleteBefore", "res.RowsAffected", err)
+	}
+
+	return deleted, nil
+}
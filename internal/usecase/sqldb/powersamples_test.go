@@ -0,0 +1,128 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupPowerSampleTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE power_samples (
+			guid TEXT NOT NULL,
+			sampled_at BIGINT NOT NULL,
+			power_state INTEGER NOT NULL,
+			PRIMARY KEY (guid, sampled_at)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestPowerSampleRepo_GetByGUID(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		samples  []entity.PowerSample
+		from     time.Time
+		expected []entity.PowerSample
+		err      error
+	}{
+		{
+			name: "samples of the device from the given time, oldest first",
+			samples: []entity.PowerSample{
+				{GUID: "guid1", PowerState: 8, SampledAt: start.Add(10 * time.Minute)},
+				{GUID: "guid1", PowerState: 2, SampledAt: start.Add(5 * time.Minute)},
+				{GUID: "guid1", PowerState: 2, SampledAt: start},
+				{GUID: "guid2", PowerState: 2, SampledAt: start.Add(5 * time.Minute)},
+			},
+			from: start.Add(time.Minute),
+			expected: []entity.PowerSample{
+				{GUID: "guid1", PowerState: 2, SampledAt: start.Add(5 * time.Minute)},
+				{GUID: "guid1", PowerState: 8, SampledAt: start.Add(10 * time.Minute)},
+			},
+		},
+		{
+			name:     "no samples",
+			from:     start,
+			expected: []entity.PowerSample{},
+		},
+		{
+			name: QueryExecutionErrorTestName,
+			from: start,
+			err:  sqldb.DatabaseError{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dbConn := setupPowerSampleTable(t)
+			defer dbConn.Close()
+
+			repo := sqldb.NewPowerSampleRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+			for _, s := range tc.samples {
+				require.NoError(t, repo.Insert(context.Background(), s))
+			}
+
+			if tc.name == QueryExecutionErrorTestName {
+				repo = sqldb.NewPowerSampleRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil))
+			}
+
+			samples, err := repo.GetByGUID(context.Background(), "guid1", tc.from)
+
+			if tc.err != nil {
+				require.IsType(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, samples)
+		})
+	}
+}
+
+func TestPowerSampleRepo_DeleteBefore(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupPowerSampleTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewPowerSampleRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := range 4 {
+		require.NoError(t, repo.Insert(context.Background(), entity.PowerSample{GUID: "guid1", PowerState: 2, SampledAt: start.Add(time.Duration(i) * time.Hour)}))
+	}
+
+	deleted, err := repo.DeleteBefore(context.Background(), start.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	samples, err := repo.GetByGUID(context.Background(), "guid1", start)
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	require.Equal(t, start.Add(2*time.Hour), samples[0].SampledAt)
+
+	_, err = sqldb.NewPowerSampleRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil)).DeleteBefore(context.Background(), start)
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
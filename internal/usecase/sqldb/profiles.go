@@ -88,6 +88,7 @@ func (r *ProfileRepo) Get(_ context.Context, top, skip int, tenantID string) ([]
 			"p.kvm_enabled",
 			"p.sol_enabled",
 			"p.tls_signing_authority",
+			"p.tls_trusted_cns",
 			"p.ip_sync_enabled",
 			"p.local_wifi_sync_enabled",
 			"p.ieee8021x_profile_name",
@@ -115,6 +116,7 @@ func (r *ProfileRepo) Get(_ context.Context, top, skip int, tenantID string) ([]
 			"p.kvm_enabled",
 			"p.sol_enabled",
 			"p.tls_signing_authority",
+			"p.tls_trusted_cns",
 			"p.ip_sync_enabled",
 			"p.local_wifi_sync_enabled",
 			"p.ieee8021x_profile_name",
@@ -148,7 +150,7 @@ func (r *ProfileRepo) Get(_ context.Context, top, skip int, tenantID string) ([]
 
 		err = rows.Scan(&p.ProfileName, &p.Activation, &p.GenerateRandomPassword, &p.CIRAConfigName,
 			&p.GenerateRandomMEBxPassword, &p.Tags, &p.DHCPEnabled, &p.TenantID, &p.TLSMode,
-			&p.UserConsent, &p.IDEREnabled, &p.KVMEnabled, &p.SOLEnabled, &p.TLSSigningAuthority,
+			&p.UserConsent, &p.IDEREnabled, &p.KVMEnabled, &p.SOLEnabled, &p.TLSSigningAuthority, &p.TLSTrustedCNs,
 			&p.IPSyncEnabled, &p.LocalWiFiSyncEnabled, &p.IEEE8021xProfileName, &p.UEFIWiFiSyncEnabled, &p.AuthenticationProtocol, &p.PXETimeout, &p.WiredInterface)
 		if err != nil {
 			return nil, ErrProfileDatabase.Wrap("Get", "rows.Scan", err)
@@ -181,6 +183,7 @@ func (r *ProfileRepo) GetByName(_ context.Context, profileName, tenantID string)
 			"p.kvm_enabled",
 			"p.sol_enabled",
 			"p.tls_signing_authority",
+			"p.tls_trusted_cns",
 			"p.ip_sync_enabled",
 			"p.local_wifi_sync_enabled",
 			"p.ieee8021x_profile_name",
@@ -216,7 +219,7 @@ func (r *ProfileRepo) GetByName(_ context.Context, profileName, tenantID string)
 		err = rows.Scan(&p.ProfileName, &p.Activation, &p.GenerateRandomPassword, &p.AMTPassword, &p.MEBXPassword,
 			&p.CIRAConfigName,
 			&p.GenerateRandomMEBxPassword, &p.Tags, &p.DHCPEnabled, &p.TenantID, &p.TLSMode,
-			&p.UserConsent, &p.IDEREnabled, &p.KVMEnabled, &p.SOLEnabled, &p.TLSSigningAuthority,
+			&p.UserConsent, &p.IDEREnabled, &p.KVMEnabled, &p.SOLEnabled, &p.TLSSigningAuthority, &p.TLSTrustedCNs,
 			&p.IPSyncEnabled, &p.LocalWiFiSyncEnabled, &p.IEEE8021xProfileName, &p.UEFIWiFiSyncEnabled, &p.AuthenticationProtocol, &p.PXETimeout, &p.WiredInterface)
 		if err != nil {
 			return p, ErrProfileDatabase.Wrap("GetByName", "rows.Scan", err)
@@ -275,6 +278,7 @@ func (r *ProfileRepo) Update(_ context.Context, p *entity.Profile) (bool, error)
 		Set("kvm_enabled", p.KVMEnabled).
 		Set("sol_enabled", p.SOLEnabled).
 		Set("tls_signing_authority", p.TLSSigningAuthority).
+		Set("tls_trusted_cns", p.TLSTrustedCNs).
 		Set("ieee8021x_profile_name", p.IEEE8021xProfileName).
 		Set("ip_sync_enabled", p.IPSyncEnabled).
 		Set("local_wifi_sync_enabled", p.LocalWiFiSyncEnabled).
@@ -318,8 +322,8 @@ func (r *ProfileRepo) Insert(_ context.Context, p *entity.Profile) (string, erro
 
 	insertBuilder := r.Builder.
 		Insert("profiles").
-		Columns("profile_name", "activation", "amt_password", "generate_random_password", "cira_config_name", "mebx_password", "generate_random_mebx_password", "tags", "dhcp_enabled", "tls_mode", "user_consent", "ider_enabled", "kvm_enabled", "sol_enabled", "tls_signing_authority", "ieee8021x_profile_name", "ip_sync_enabled", "local_wifi_sync_enabled", "tenant_id", "uefi_wifi_sync_enabled").
-		Values(p.ProfileName, p.Activation, p.AMTPassword, p.GenerateRandomPassword, ciraConfigName, p.MEBXPassword, p.GenerateRandomMEBxPassword, p.Tags, p.DHCPEnabled, p.TLSMode, p.UserConsent, p.IDEREnabled, p.KVMEnabled, p.SOLEnabled, p.TLSSigningAuthority, ieee8021xProfileName, p.IPSyncEnabled, p.LocalWiFiSyncEnabled, p.TenantID, p.UEFIWiFiSyncEnabled)
+		Columns("profile_name", "activation", "amt_password", "generate_random_password", "cira_config_name", "mebx_password", "generate_random_mebx_password", "tags", "dhcp_enabled", "tls_mode", "user_consent", "ider_enabled", "kvm_enabled", "sol_enabled", "tls_signing_authority", "ieee8021x_profile_name", "ip_sync_enabled", "local_wifi_sync_enabled", "tenant_id", "uefi_wifi_sync_enabled", "tls_trusted_cns").
+		Values(p.ProfileName, p.Activation, p.AMTPassword, p.GenerateRandomPassword, ciraConfigName, p.MEBXPassword, p.GenerateRandomMEBxPassword, p.Tags, p.DHCPEnabled, p.TLSMode, p.UserConsent, p.IDEREnabled, p.KVMEnabled, p.SOLEnabled, p.TLSSigningAuthority, ieee8021xProfileName, p.IPSyncEnabled, p.LocalWiFiSyncEnabled, p.TenantID, p.UEFIWiFiSyncEnabled, p.TLSTrustedCNs)
 
 	if !r.IsEmbedded {
 		insertBuilder = insertBuilder.Suffix("RETURNING xmin::text")
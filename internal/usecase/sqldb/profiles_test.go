@@ -100,6 +100,7 @@ CREATE TABLE IF NOT EXISTS profiles(
   kvm_enabled BOOLEAN NOT NULL, 
   sol_enabled BOOLEAN NOT NULL, 
   tls_signing_authority TEXT,
+  tls_trusted_cns TEXT NOT NULL DEFAULT '',
   ip_sync_enabled BOOLEAN NOT NULL, 
   local_wifi_sync_enabled BOOLEAN NOT NULL, 
   ieee8021x_profile_name TEXT,
@@ -0,0 +1,132 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// EncryptedColumn is a database column holding secrets encrypted with the console key, KeyColumn and
+// TenantColumn name the rows.
+type EncryptedColumn struct {
+	Table        string
+	KeyColumn    string
+	TenantColumn string
+	Column       string
+	Nullable     bool // a cleared secret is NULL rather than an empty string
+}
+
+// EncryptedColumns are the columns of the schema holding encrypted secrets.
+var EncryptedColumns = []EncryptedColumn{
+	{Table: "devices", KeyColumn: "guid", TenantColumn: "tenantid", Column: "password"},
+	{Table: "devices", KeyColumn: "guid", TenantColumn: "tenantid", Column: "mpspassword", Nullable: true},
+	{Table: "devices", KeyColumn: "guid", TenantColumn: "tenantid", Column: "mebxpassword", Nullable: true},
+	{Table: "profiles", KeyColumn: "profile_name", TenantColumn: "tenant_id", Column: "amt_password"},
+	{Table: "profiles", KeyColumn: "profile_name", TenantColumn: "tenant_id", Column: "mebx_password"},
+	{Table: "domains", KeyColumn: "name", TenantColumn: "tenant_id", Column: "provisioning_cert_key"},
+	{Table: "wirelessconfigs", KeyColumn: "wireless_profile_name", TenantColumn: "tenant_id", Column: "psk_passphrase"},
+	{Table: "ciraconfigs", KeyColumn: "cira_config_name", TenantColumn: "tenant_id", Column: "password"},
+	{Table: "sites", KeyColumn: "name", TenantColumn: "tenant_id", Column: "password"},
+	{Table: "tenant_keys", KeyColumn: "key_id", TenantColumn: "tenant_id", Column: "wrapped_key"},
+}
+
+// SecretRepo reads the encrypted secrets of the schema and quarantines those that cannot be decrypted.
+type SecretRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var ErrSecretDatabase = DatabaseError{Console: consoleerrors.CreateConsoleError("SecretRepo")}
+
+// NewSecretRepo -.
+func NewSecretRepo(database *db.SQL, log logger.Interface) *SecretRepo {
+	return &SecretRepo{database, log}
+}
+
+// Sample returns up to limit secrets of a column picked at random, every secret when limit is 0. Empty secrets
+// are left out.
+func (r *SecretRepo) Sample(ctx context.Context, c EncryptedColumn, limit int) ([]entity.StoredSecret, error) {
+	builder := r.Builder.
+		Select(c.KeyColumn, c.TenantColumn, c.Column).
+		From(c.Table).
+		Where(squirrel.And{squirrel.NotEq{c.Column: nil}, squirrel.NotEq{c.Column: ""}})
+
+	if limit > 0 {
+		builder = builder.OrderBy("RANDOM()").Limit(uint64(limit))
+	}
+
+	sqlQuery, args, err := builder.ToSql()
+	if err != nil {
+		return nil, ErrSecretDatabase.Wrap("Sample", "r.Builder", err)
+	}
+
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrSecretDatabase.Wrap("Sample", "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	secrets := make([]entity.StoredSecret, 0)
+
+	for rows.Next() {
+		s := entity.StoredSecret{Table: c.Table, Column: c.Column}
+
+		if err := rows.Scan(&s.Key, &s.TenantID, &s.CipherText); err != nil {
+			return nil, ErrSecretDatabase.Wrap("Sample", "rows.Scan", err)
+		}
+
+		secrets = append(secrets, s)
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrSecretDatabase.Wrap("Sample", "rows.Err", rows.Err())
+	}
+
+	return secrets, nil
+}
+
+// Quarantine copies a secret to quarantined_secrets with the reason it cannot be decrypted, then clears it from
+// its row unless the row was changed since it was read.
+func (r *SecretRepo) Quarantine(ctx context.Context, c EncryptedColumn, s entity.StoredSecret, reason string, at time.Time) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("quarantined_secrets").
+		Columns("id", "table_name", "column_name", "row_key", "tenant_id", "cipher_text", "reason", "quarantined_at").
+		Values(uuid.NewString(), c.Table, c.Column, s.Key, s.TenantID, s.CipherText, reason, at.Unix()).
+		ToSql()
+	if err != nil {
+		return ErrSecretDatabase.Wrap("Quarantine", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrSecretDatabase.Wrap("Quarantine", "r.Pool.Exec", err)
+	}
+
+	var cleared interface{} = ""
+	if c.Nullable {
+		cleared = sql.NullString{}
+	}
+
+	sqlQuery, args, err = r.Builder.
+		Update(c.Table).
+		Set(c.Column, cleared).
+		Where(squirrel.Eq{c.KeyColumn: s.Key, c.TenantColumn: s.TenantID, c.Column: s.CipherText}).
+		ToSql()
+	if err != nil {
+		return ErrSecretDatabase.Wrap("Quarantine", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrSecretDatabase.Wrap("Quarantine", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
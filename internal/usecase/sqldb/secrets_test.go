@@ -0,0 +1,111 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupSecretTables(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE devices (
+			guid TEXT NOT NULL,
+			tenantid TEXT NOT NULL,
+			password TEXT,
+			mpspassword TEXT,
+			PRIMARY KEY (guid, tenantid)
+		);
+		CREATE TABLE quarantined_secrets (
+			id TEXT NOT NULL,
+			table_name TEXT NOT NULL,
+			column_name TEXT NOT NULL,
+			row_key TEXT NOT NULL,
+			tenant_id TEXT NOT NULL DEFAULT '',
+			cipher_text TEXT NOT NULL,
+			reason TEXT NOT NULL DEFAULT '',
+			quarantined_at BIGINT NOT NULL,
+			PRIMARY KEY (id)
+		);
+		INSERT INTO devices (guid, tenantid, password, mpspassword) VALUES
+			('guid1', 'tenant1', 'secret1', 'mps1'),
+			('guid2', 'tenant1', '', NULL),
+			('guid3', 'tenant2', 'secret3', NULL);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestSecretRepo(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupSecretTables(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewSecretRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+	ctx := context.Background()
+	password := sqldb.EncryptedColumn{Table: "devices", KeyColumn: "guid", TenantColumn: "tenantid", Column: "password"}
+	mpsPassword := sqldb.EncryptedColumn{Table: "devices", KeyColumn: "guid", TenantColumn: "tenantid", Column: "mpspassword", Nullable: true}
+
+	secrets, err := repo.Sample(ctx, password, 0)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entity.StoredSecret{
+		{Table: "devices", Column: "password", Key: "guid1", TenantID: "tenant1", CipherText: "secret1"},
+		{Table: "devices", Column: "password", Key: "guid3", TenantID: "tenant2", CipherText: "secret3"},
+	}, secrets)
+
+	secrets, err = repo.Sample(ctx, password, 1)
+	require.NoError(t, err)
+	require.Len(t, secrets, 1)
+
+	secrets, err = repo.Sample(ctx, mpsPassword, 0)
+	require.NoError(t, err)
+	require.Equal(t, []entity.StoredSecret{
+		{Table: "devices", Column: "mpspassword", Key: "guid1", TenantID: "tenant1", CipherText: "mps1"},
+	}, secrets)
+
+	at := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, repo.Quarantine(ctx, password, entity.StoredSecret{Key: "guid3", TenantID: "tenant2", CipherText: "secret3"}, "bad key", at))
+	require.NoError(t, repo.Quarantine(ctx, mpsPassword, secrets[0], "bad key", at))
+
+	// a secret changed since it was sampled is left as is
+	require.NoError(t, repo.Quarantine(ctx, password, entity.StoredSecret{Key: "guid1", TenantID: "tenant1", CipherText: "stale"}, "bad key", at))
+
+	var (
+		guid1Password, guid3Password string
+		guid1MPSPassword             sql.NullString
+	)
+
+	require.NoError(t, dbConn.QueryRowContext(ctx, "SELECT password, mpspassword FROM devices WHERE guid = 'guid1'").Scan(&guid1Password, &guid1MPSPassword))
+	require.NoError(t, dbConn.QueryRowContext(ctx, "SELECT password FROM devices WHERE guid = 'guid3'").Scan(&guid3Password))
+	require.Equal(t, "secret1", guid1Password)
+	require.False(t, guid1MPSPassword.Valid)
+	require.Empty(t, guid3Password)
+
+	var (
+		tableName, columnName, cipherText, reason string
+		quarantinedAt                             int64
+	)
+
+	require.NoError(t, dbConn.QueryRowContext(ctx,
+		"SELECT table_name, column_name, cipher_text, reason, quarantined_at FROM quarantined_secrets WHERE row_key = 'guid3'",
+	).Scan(&tableName, &columnName, &cipherText, &reason, &quarantinedAt))
+	require.Equal(t, "devices", tableName)
+	require.Equal(t, "password", columnName)
+	require.Equal(t, "secret3", cipherText)
+	require.Equal(t, "bad key", reason)
+	require.Equal(t, at.Unix(), quarantinedAt)
+}
@@ -0,0 +1,223 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// SiteRepo -.
+type SiteRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var (
+	ErrSiteDatabase  = DatabaseError{Console: consoleerrors.CreateConsoleError("SiteRepo")}
+	ErrSiteNotUnique = NotUniqueError{Console: consoleerrors.CreateConsoleError("SiteRepo")}
+)
+
+var siteColumns = []string{
+	"name",
+	"subnets",
+	"proxy",
+	"tls_policy",
+	"username",
+	"password",
+	"tenant_id",
+}
+
+// NewSiteRepo -.
+func NewSiteRepo(database *db.SQL, log logger.Interface) *SiteRepo {
+	return &SiteRepo{database, log}
+}
+
+// GetCount -.
+func (r *SiteRepo) GetCount(ctx context.Context, tenantID string) (int, error) {
+	sqlQuery, args, err := r.Builder.
+		Select("COUNT(*)").
+		From("sites").
+		Where("tenant_id = ?", tenantID).
+		ToSql()
+	if err != nil {
+		return 0, ErrSiteDatabase.Wrap("GetCount", "r.Builder", err)
+	}
+
+	var count int
+
+	if err = r.Pool.QueryRowContext(ctx, sqlQuery, args...).Scan(&count); err != nil {
+		return 0, ErrSiteDatabase.Wrap("GetCount", "r.Pool.QueryRow", err)
+	}
+
+	return count, nil
+}
+
+// Get -.
+func (r *SiteRepo) Get(ctx context.Context, top, skip int, tenantID string) ([]entity.Site, error) {
+	const defaultTop = 100
+
+	limitedTop := uint64(defaultTop)
+	if top > 0 {
+		limitedTop = uint64(top)
+	}
+
+	limitedSkip := uint64(0)
+	if skip > 0 {
+		limitedSkip = uint64(skip)
+	}
+
+	sqlQuery, args, err := r.Builder.
+		Select(siteColumns...).
+		From("sites").
+		Where("tenant_id = ?", tenantID).
+		OrderBy("name").
+		Limit(limitedTop).
+		Offset(limitedSkip).
+		ToSql()
+	if err != nil {
+		return nil, ErrSiteDatabase.Wrap("Get", "r.Builder", err)
+	}
+
+	return r.query(ctx, "Get", sqlQuery, args)
+}
+
+// GetAll returns all sites of the tenant, unpaged.
+func (r *SiteRepo) GetAll(ctx context.Context, tenantID string) ([]entity.Site, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(siteColumns...).
+		From("sites").
+		Where("tenant_id = ?", tenantID).
+		OrderBy("name").
+		ToSql()
+	if err != nil {
+		return nil, ErrSiteDatabase.Wrap("GetAll", "r.Builder", err)
+	}
+
+	return r.query(ctx, "GetAll", sqlQuery, args)
+}
+
+// GetByName -.
+func (r *SiteRepo) GetByName(ctx context.Context, name, tenantID string) (*entity.Site, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(siteColumns...).
+		From("sites").
+		Where("name = ? AND tenant_id = ?", name, tenantID).
+		ToSql()
+	if err != nil {
+		return nil, ErrSiteDatabase.Wrap("GetByName", "r.Builder", err)
+	}
+
+	p := entity.Site{}
+
+	err = r.Pool.QueryRowContext(ctx, sqlQuery, args...).
+		Scan(&p.Name, &p.Subnets, &p.Proxy, &p.TLSPolicy, &p.Username, &p.Password, &p.TenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, ErrSiteDatabase.Wrap("GetByName", "row.Scan", err)
+	}
+
+	return &p, nil
+}
+
+// Delete -.
+func (r *SiteRepo) Delete(ctx context.Context, name, tenantID string) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Delete("sites").
+		Where("name = ? AND tenant_id = ?", name, tenantID).
+		ToSql()
+	if err != nil {
+		return false, ErrSiteDatabase.Wrap("Delete", "r.Builder", err)
+	}
+
+	return r.exec(ctx, "Delete", sqlQuery, args)
+}
+
+// Update -.
+func (r *SiteRepo) Update(ctx context.Context, p *entity.Site) (bool, error) {
+	sqlQuery, args, err := r.Builder.
+		Update("sites").
+		Set("subnets", p.Subnets).
+		Set("proxy", p.Proxy).
+		Set("tls_policy", p.TLSPolicy).
+		Set("username", p.Username).
+		Set("password", p.Password).
+		Where("name = ? AND tenant_id = ?", p.Name, p.TenantID).
+		ToSql()
+	if err != nil {
+		return false, ErrSiteDatabase.Wrap("Update", "r.Builder", err)
+	}
+
+	return r.exec(ctx, "Update", sqlQuery, args)
+}
+
+// Insert -.
+func (r *SiteRepo) Insert(ctx context.Context, p *entity.Site) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("sites").
+		Columns(siteColumns...).
+		Values(p.Name, p.Subnets, p.Proxy, p.TLSPolicy, p.Username, p.Password, p.TenantID).
+		ToSql()
+	if err != nil {
+		return ErrSiteDatabase.Wrap("Insert", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		if db.CheckNotUnique(err) {
+			return ErrSiteNotUnique.Wrap(err.Error())
+		}
+
+		return ErrSiteDatabase.Wrap("Insert", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
+
+func (r *SiteRepo) query(ctx context.Context, function, sqlQuery string, args []interface{}) ([]entity.Site, error) {
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrSiteDatabase.Wrap(function, "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	sites := make([]entity.Site, 0)
+
+	for rows.Next() {
+		p := entity.Site{}
+
+		err = rows.Scan(&p.Name, &p.Subnets, &p.Proxy, &p.TLSPolicy, &p.Username, &p.Password, &p.TenantID)
+		if err != nil {
+			return nil, ErrSiteDatabase.Wrap(function, "rows.Scan", err)
+		}
+
+		sites = append(sites, p)
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrSiteDatabase.Wrap(function, "rows.Err", rows.Err())
+	}
+
+	return sites, nil
+}
+
+func (r *SiteRepo) exec(ctx context.Context, function, sqlQuery string, args []interface{}) (bool, error) {
+	res, err := r.Pool.ExecContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return false, ErrSiteDatabase.Wrap(function, "r.Pool.Exec", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, ErrSiteDatabase.Wrap(function, "res.RowsAffected", err)
+	}
+
+	return affected > 0, nil
+}
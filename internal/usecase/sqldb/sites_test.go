@@ -0,0 +1,126 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupSiteTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE sites (
+			name TEXT NOT NULL,
+			subnets TEXT NOT NULL,
+			proxy TEXT NOT NULL DEFAULT '',
+			tls_policy TEXT NOT NULL DEFAULT '',
+			username TEXT NOT NULL DEFAULT '',
+			password TEXT NOT NULL DEFAULT '',
+			tenant_id TEXT NOT NULL,
+			PRIMARY KEY (name, tenant_id)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func site(name, tenantID string) entity.Site {
+	return entity.Site{
+		Name:      name,
+		Subnets:   "10.20.0.0/16,fd00:20::/64",
+		Proxy:     "socks5://jump.example.com:1080",
+		TLSPolicy: "selfSigned",
+		Username:  "admin",
+		Password:  "encrypted",
+		TenantID:  tenantID,
+	}
+}
+
+func TestSiteRepo(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupSiteTable(t)
+	defer dbConn.Close()
+
+	ctx := context.Background()
+	repo := sqldb.NewSiteRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+	branch := site("branch", "")
+	lab := site("lab", "")
+	other := site("branch", "tenant2")
+
+	for _, s := range []entity.Site{branch, lab, other} {
+		require.NoError(t, repo.Insert(ctx, &s))
+	}
+
+	err := repo.Insert(ctx, &branch)
+	require.IsType(t, sqldb.NotUniqueError{}, err)
+
+	count, err := repo.GetCount(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	sites, err := repo.Get(ctx, 1, 1, "")
+	require.NoError(t, err)
+	require.Equal(t, []entity.Site{lab}, sites)
+
+	sites, err = repo.GetAll(ctx, "tenant2")
+	require.NoError(t, err)
+	require.Equal(t, []entity.Site{other}, sites)
+
+	lab.Proxy = ""
+	lab.TLSPolicy = "verified"
+
+	updated, err := repo.Update(ctx, &lab)
+	require.NoError(t, err)
+	require.True(t, updated)
+
+	got, err := repo.GetByName(ctx, "lab", "")
+	require.NoError(t, err)
+	require.Equal(t, &lab, got)
+
+	deleted, err := repo.Delete(ctx, "lab", "")
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	got, err = repo.GetByName(ctx, "lab", "")
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestSiteRepo_QueryExecutionError(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupSiteTable(t)
+	defer dbConn.Close()
+
+	ctx := context.Background()
+	repo := sqldb.NewSiteRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil))
+	s := site("branch", "")
+
+	_, err := repo.GetCount(ctx, "")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	_, err = repo.GetAll(ctx, "")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	_, err = repo.GetByName(ctx, "branch", "")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	_, err = repo.Update(ctx, &s)
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	err = repo.Insert(ctx, &s)
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
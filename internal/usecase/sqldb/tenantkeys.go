@@ -0,0 +1,162 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// TenantKeyRepo stores the data-encryption keys of the tenants, the times are kept as unix
+// nanoseconds so that keys rotated within a second keep their order. A revoked_at of 0 marks an
+// active key.
+type TenantKeyRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var ErrTenantKeyDatabase = DatabaseError{Console: consoleerrors.CreateConsoleError("TenantKeyRepo")}
+
+var tenantKeyColumns = []string{
+	"key_id",
+	"tenant_id",
+	"wrapped_key",
+	"created_at",
+	"revoked_at",
+}
+
+// NewTenantKeyRepo -.
+func NewTenantKeyRepo(database *db.SQL, log logger.Interface) *TenantKeyRepo {
+	return &TenantKeyRepo{database, log}
+}
+
+// GetByTenant returns the keys of a tenant, the newest first.
+func (r *TenantKeyRepo) GetByTenant(ctx context.Context, tenantID string) ([]entity.TenantKey, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(tenantKeyColumns...).
+		From("tenant_keys").
+		Where("tenant_id = ?", tenantID).
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, ErrTenantKeyDatabase.Wrap("GetByTenant", "r.Builder", err)
+	}
+
+	return r.query(ctx, "GetByTenant", sqlQuery, args)
+}
+
+// GetByID returns a key, or nil when there is no key with the ID.
+func (r *TenantKeyRepo) GetByID(ctx context.Context, keyID string) (*entity.TenantKey, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(tenantKeyColumns...).
+		From("tenant_keys").
+		Where("key_id = ?", keyID).
+		ToSql()
+	if err != nil {
+		return nil, ErrTenantKeyDatabase.Wrap("GetByID", "r.Builder", err)
+	}
+
+	keys, err := r.query(ctx, "GetByID", sqlQuery, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	return &keys[0], nil
+}
+
+// Insert -.
+func (r *TenantKeyRepo) Insert(ctx context.Context, k entity.TenantKey) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("tenant_keys").
+		Columns(tenantKeyColumns...).
+		Values(k.KeyID, k.TenantID, k.WrappedKey, k.CreatedAt.UnixNano(), unixNano(k.RevokedAt)).
+		ToSql()
+	if err != nil {
+		return ErrTenantKeyDatabase.Wrap("Insert", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrTenantKeyDatabase.Wrap("Insert", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
+
+// Revoke marks the active keys of a tenant revoked and clears their key material, it returns
+// the number of keys revoked.
+func (r *TenantKeyRepo) Revoke(ctx context.Context, tenantID string, revokedAt time.Time) (int64, error) {
+	sqlQuery, args, err := r.Builder.
+		Update("tenant_keys").
+		Set("wrapped_key", "").
+		Set("revoked_at", revokedAt.UnixNano()).
+		Where("tenant_id = ? AND revoked_at = 0", tenantID).
+		ToSql()
+	if err != nil {
+		return 0, ErrTenantKeyDatabase.Wrap("Revoke", "r.Builder", err)
+	}
+
+	res, err := r.Pool.ExecContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return 0, ErrTenantKeyDatabase.Wrap("Revoke", "r.Pool.Exec", err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, ErrTenantKeyDatabase.Wrap("Revoke", "res.RowsAffected", err)
+	}
+
+	return count, nil
+}
+
+func (r *TenantKeyRepo) query(ctx context.Context, function, sqlQuery string, args []interface{}) ([]entity.TenantKey, error) {
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrTenantKeyDatabase.Wrap(function, "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	keys := make([]entity.TenantKey, 0)
+
+	for rows.Next() {
+		var (
+			k                    entity.TenantKey
+			createdAt, revokedAt int64
+		)
+
+		err = rows.Scan(&k.KeyID, &k.TenantID, &k.WrappedKey, &createdAt, &revokedAt)
+		if err != nil {
+			return nil, ErrTenantKeyDatabase.Wrap(function, "rows.Scan", err)
+		}
+
+		k.CreatedAt = time.Unix(0, createdAt).UTC()
+
+		if revokedAt != 0 {
+			k.RevokedAt = time.Unix(0, revokedAt).UTC()
+		}
+
+		keys = append(keys, k)
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrTenantKeyDatabase.Wrap(function, "rows.Err", rows.Err())
+	}
+
+	return keys, nil
+}
+
+// unixNano stores the zero time as 0 rather than the far negative UnixNano of year 1.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
@@ -0,0 +1,110 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupTenantKeyTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE tenant_keys (
+			key_id TEXT NOT NULL,
+			tenant_id TEXT NOT NULL DEFAULT '',
+			wrapped_key TEXT NOT NULL DEFAULT '',
+			created_at BIGINT NOT NULL,
+			revoked_at BIGINT NOT NULL DEFAULT 0,
+			PRIMARY KEY (key_id)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestTenantKeyRepo(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupTenantKeyTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewTenantKeyRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+	ctx := context.Background()
+	createdAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	key1 := entity.TenantKey{KeyID: "key1", TenantID: "tenant1", WrappedKey: "wrapped1", CreatedAt: createdAt}
+	key2 := entity.TenantKey{KeyID: "key2", TenantID: "tenant1", WrappedKey: "wrapped2", CreatedAt: createdAt.Add(time.Millisecond)}
+	other := entity.TenantKey{KeyID: "key3", TenantID: "tenant2", WrappedKey: "wrapped3", CreatedAt: createdAt}
+
+	for _, k := range []entity.TenantKey{key1, key2, other} {
+		require.NoError(t, repo.Insert(ctx, k))
+	}
+
+	require.Error(t, repo.Insert(ctx, key1))
+
+	keys, err := repo.GetByTenant(ctx, "tenant1")
+	require.NoError(t, err)
+	require.Equal(t, []entity.TenantKey{key2, key1}, keys)
+
+	key, err := repo.GetByID(ctx, "key3")
+	require.NoError(t, err)
+	require.Equal(t, &other, key)
+
+	key, err = repo.GetByID(ctx, "missing")
+	require.NoError(t, err)
+	require.Nil(t, key)
+
+	revokedAt := createdAt.Add(time.Hour)
+
+	count, err := repo.Revoke(ctx, "tenant1", revokedAt)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	count, err = repo.Revoke(ctx, "tenant1", revokedAt.Add(time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	keys, err = repo.GetByTenant(ctx, "tenant1")
+	require.NoError(t, err)
+
+	for _, k := range keys {
+		require.Empty(t, k.WrappedKey)
+		require.Equal(t, revokedAt, k.RevokedAt)
+	}
+
+	key, err = repo.GetByID(ctx, "key3")
+	require.NoError(t, err)
+	require.Equal(t, &other, key)
+}
+
+func TestTenantKeyRepoDatabaseError(t *testing.T) {
+	t.Parallel()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	defer dbConn.Close()
+
+	repo := sqldb.NewTenantKeyRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+	_, err = repo.GetByTenant(context.Background(), "")
+	require.ErrorAs(t, err, &sqldb.DatabaseError{})
+
+	err = repo.Insert(context.Background(), entity.TenantKey{KeyID: "key1", CreatedAt: time.Now()})
+	require.ErrorAs(t, err, &sqldb.DatabaseError{})
+
+	_, err = repo.Revoke(context.Background(), "", time.Now())
+	require.ErrorAs(t, err, &sqldb.DatabaseError{})
+}
@@ -0,0 +1,151 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// UserRepo stores the console accounts, the update time is kept as unix seconds.
+type UserRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var ErrUserDatabase = DatabaseError{Console: consoleerrors.CreateConsoleError("UserRepo")}
+
+var userColumns = []string{
+	"username",
+	"dn",
+	"display_name",
+	"email",
+	"role",
+	"source",
+	"disabled",
+	"updated_at",
+}
+
+// NewUserRepo -.
+func NewUserRepo(database *db.SQL, log logger.Interface) *UserRepo {
+	return &UserRepo{database, log}
+}
+
+// Get -.
+func (r *UserRepo) Get(ctx context.Context, top, skip int) ([]entity.User, error) {
+	const defaultTop = 100
+
+	limitedTop := uint64(defaultTop)
+	if top > 0 {
+		limitedTop = uint64(top)
+	}
+
+	limitedSkip := uint64(0)
+	if skip > 0 {
+		limitedSkip = uint64(skip)
+	}
+
+	sqlQuery, args, err := r.Builder.
+		Select(userColumns...).
+		From("users").
+		OrderBy("username").
+		Limit(limitedTop).
+		Offset(limitedSkip).
+		ToSql()
+	if err != nil {
+		return nil, ErrUserDatabase.Wrap("Get", "r.Builder", err)
+	}
+
+	return r.query(ctx, "Get", sqlQuery, args)
+}
+
+// GetBySource returns all accounts provisioned from a source.
+func (r *UserRepo) GetBySource(ctx context.Context, source string) ([]entity.User, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(userColumns...).
+		From("users").
+		Where("source = ?", source).
+		OrderBy("username").
+		ToSql()
+	if err != nil {
+		return nil, ErrUserDatabase.Wrap("GetBySource", "r.Builder", err)
+	}
+
+	return r.query(ctx, "GetBySource", sqlQuery, args)
+}
+
+// GetByUsername returns the account of username, nil when there is none.
+func (r *UserRepo) GetByUsername(ctx context.Context, username string) (*entity.User, error) {
+	sqlQuery, args, err := r.Builder.
+		Select(userColumns...).
+		From("users").
+		Where("username = ?", username).
+		ToSql()
+	if err != nil {
+		return nil, ErrUserDatabase.Wrap("GetByUsername", "r.Builder", err)
+	}
+
+	users, err := r.query(ctx, "GetByUsername", sqlQuery, args)
+	if err != nil || len(users) == 0 {
+		return nil, err
+	}
+
+	return &users[0], nil
+}
+
+// Save inserts or replaces an account.
+func (r *UserRepo) Save(ctx context.Context, u entity.User) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("users").
+		Columns(userColumns...).
+		Values(u.Username, u.DN, u.DisplayName, u.Email, u.Role, u.Source, u.Disabled, u.UpdatedAt.Unix()).
+		Suffix("ON CONFLICT (username) DO UPDATE SET dn = excluded.dn, display_name = excluded.display_name, " +
+			"email = excluded.email, role = excluded.role, source = excluded.source, disabled = excluded.disabled, " +
+			"updated_at = excluded.updated_at").
+		ToSql()
+	if err != nil {
+		return ErrUserDatabase.Wrap("Save", "r.Builder", err)
+	}
+
+	if _, err = r.Pool.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return ErrUserDatabase.Wrap("Save", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
+
+func (r *UserRepo) query(ctx context.Context, function, sqlQuery string, args []interface{}) ([]entity.User, error) {
+	rows, err := r.Pool.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, ErrUserDatabase.Wrap(function, "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	users := make([]entity.User, 0)
+
+	for rows.Next() {
+		var (
+			u         entity.User
+			updatedAt int64
+		)
+
+		err = rows.Scan(&u.Username, &u.DN, &u.DisplayName, &u.Email, &u.Role, &u.Source, &u.Disabled, &updatedAt)
+		if err != nil {
+			return nil, ErrUserDatabase.Wrap(function, "rows.Scan", err)
+		}
+
+		u.UpdatedAt = time.Unix(updatedAt, 0).UTC()
+
+		users = append(users, u)
+	}
+
+	if rows.Err() != nil {
+		return nil, ErrUserDatabase.Wrap(function, "rows.Err", rows.Err())
+	}
+
+	return users, nil
+}
@@ -0,0 +1,91 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupUserTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE users (
+			username TEXT NOT NULL,
+			dn TEXT NOT NULL DEFAULT '',
+			display_name TEXT NOT NULL DEFAULT '',
+			email TEXT NOT NULL DEFAULT '',
+			role TEXT NOT NULL,
+			source TEXT NOT NULL,
+			disabled BOOLEAN NOT NULL DEFAULT FALSE,
+			updated_at BIGINT NOT NULL,
+			PRIMARY KEY (username)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestUserRepo(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupUserTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewUserRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+	updatedAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	users, err := repo.Get(context.Background(), 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, users)
+
+	alice := entity.User{
+		Username:    "alice",
+		DN:          "CN=Alice,OU=Staff,DC=example,DC=com",
+		DisplayName: "Alice",
+		Email:       "alice@example.com",
+		Role:        entity.RoleAdmin,
+		Source:      "ldap",
+		UpdatedAt:   updatedAt,
+	}
+	bob := entity.User{Username: "bob", Role: entity.RoleViewer, Source: "local", UpdatedAt: updatedAt}
+
+	require.NoError(t, repo.Save(context.Background(), bob))
+	require.NoError(t, repo.Save(context.Background(), alice))
+
+	alice.Role = entity.RoleOperator
+	alice.Disabled = true
+	alice.UpdatedAt = updatedAt.Add(time.Hour)
+	require.NoError(t, repo.Save(context.Background(), alice))
+
+	users, err = repo.Get(context.Background(), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []entity.User{alice, bob}, users)
+
+	users, err = repo.Get(context.Background(), 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []entity.User{bob}, users)
+
+	users, err = repo.GetBySource(context.Background(), "ldap")
+	require.NoError(t, err)
+	require.Equal(t, []entity.User{alice}, users)
+
+	failing := sqldb.NewUserRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil))
+
+	_, err = failing.GetBySource(context.Background(), "ldap")
+	require.IsType(t, sqldb.DatabaseError{}, err)
+
+	err = failing.Save(context.Background(), alice)
+	require.IsType(t, sqldb.DatabaseError{}, err)
+}
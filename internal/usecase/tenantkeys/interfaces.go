@@ -0,0 +1,29 @@
+package tenantkeys
+
+import (
+	"context"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type (
+	Repository interface {
+		GetByTenant(ctx context.Context, tenantID string) ([]entity.TenantKey, error)
+		GetByID(ctx context.Context, keyID string) (*entity.TenantKey, error)
+		Insert(ctx context.Context, k entity.TenantKey) error
+		Revoke(ctx context.Context, tenantID string, revokedAt time.Time) (int64, error)
+	}
+
+	// TenantEncryptor encrypts a secret with the key of the tenant it belongs to.
+	TenantEncryptor interface {
+		EncryptForTenant(tenantID, plainText string) (string, error)
+	}
+
+	Feature interface {
+		GetKeys(ctx context.Context, tenantID string) ([]dto.TenantKey, error)
+		Rotate(ctx context.Context, tenantID string) (dto.TenantKey, error)
+		Revoke(ctx context.Context, tenantID string) (dto.TenantKeyRevokeResult, error)
+	}
+)
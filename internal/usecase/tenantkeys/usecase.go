@@ -0,0 +1,307 @@
+// Package tenantkeys encrypts the secrets of each tenant with a data-encryption key of its own,
+// which is stored wrapped by the master key. The ID of the key is written in front of every
+// secret encrypted with it, so that rotating a key leaves the older secrets readable and revoking
+// the keys of a tenant makes its secrets unrecoverable without touching the rows holding them.
+//
+// Secrets written before tenant keys were enabled carry no key ID and are decrypted with the
+// master key.
+package tenantkeys
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/config"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// keyPrefix starts a secret encrypted with a tenant key, "dek:<key id>:<cipher text>". The base64
+// cipher text of the master key never contains a ":".
+const keyPrefix = "dek:"
+
+// keySize is the size of the data-encryption keys, AES-256.
+const keySize = 32
+
+var (
+	ErrKeyRevoked = errors.New("the tenant key of the secret is revoked")
+	ErrKeyUnknown = errors.New("the tenant key of the secret does not exist")
+
+	ErrTenantKeysUseCase = consoleerrors.CreateConsoleError("TenantKeysUseCase")
+	ErrDatabase          = sqldb.DatabaseError{Console: ErrTenantKeysUseCase}
+)
+
+// UseCase is a security.Cryptor encrypting with the keys of the tenants. Unwrapped keys are
+// cached, a key revoked by another console instance stays usable here until it is restarted.
+type UseCase struct {
+	repo   Repository
+	master security.Cryptor
+	log    logger.Interface
+	now    func() time.Time
+
+	mu     sync.Mutex
+	keys   map[string]cachedKey // by key ID
+	active map[string]string    // key ID by tenant ID
+}
+
+type cachedKey struct {
+	tenantID string
+	key      []byte
+}
+
+// New -.
+func New(repo Repository, master security.Cryptor, log logger.Interface) *UseCase {
+	return &UseCase{
+		repo:   repo,
+		master: master,
+		log:    log,
+		now:    time.Now,
+		keys:   map[string]cachedKey{},
+		active: map[string]string{},
+	}
+}
+
+// Encrypt encrypts a secret with the key of the default tenant.
+func (uc *UseCase) Encrypt(plainText string) (string, error) {
+	return uc.EncryptForTenant("", plainText)
+}
+
+// EncryptForTenant encrypts a secret with the active key of a tenant, creating the key the first time.
+func (uc *UseCase) EncryptForTenant(tenantID, plainText string) (string, error) {
+	keyID, key, err := uc.activeKey(context.Background(), tenantID)
+	if err != nil {
+		return "", err
+	}
+
+	cipherText, err := uc.master.EncryptWithKey(plainText, string(key))
+	if err != nil {
+		return "", err
+	}
+
+	return keyPrefix + keyID + ":" + cipherText, nil
+}
+
+// Decrypt decrypts a secret with the key it was encrypted with.
+func (uc *UseCase) Decrypt(cipherText string) (string, error) {
+	if !strings.HasPrefix(cipherText, keyPrefix) {
+		return uc.master.Decrypt(cipherText)
+	}
+
+	keyID, data, found := strings.Cut(strings.TrimPrefix(cipherText, keyPrefix), ":")
+	if !found {
+		return "", ErrKeyUnknown
+	}
+
+	key, err := uc.key(context.Background(), keyID)
+	if err != nil {
+		return "", err
+	}
+
+	return security.Crypto{EncryptionKey: string(key)}.Decrypt(data)
+}
+
+// EncryptWithKey encrypts with a key given by the caller, such as the password of an exported profile.
+func (uc *UseCase) EncryptWithKey(plainText, key string) (string, error) {
+	return uc.master.EncryptWithKey(plainText, key)
+}
+
+// GenerateKey -.
+func (uc *UseCase) GenerateKey() string {
+	return uc.master.GenerateKey()
+}
+
+// ReadAndDecryptFile -.
+func (uc *UseCase) ReadAndDecryptFile(filePath string) (config.Configuration, error) {
+	return uc.master.ReadAndDecryptFile(filePath)
+}
+
+// GetKeys lists the keys of a tenant, the newest first.
+func (uc *UseCase) GetKeys(ctx context.Context, tenantID string) ([]dto.TenantKey, error) {
+	keys, err := uc.repo.GetByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("GetKeys", "uc.repo.GetByTenant", err)
+	}
+
+	d := make([]dto.TenantKey, len(keys))
+	active := true
+
+	for i := range keys {
+		d[i] = dto.TenantKey{
+			KeyID:     keys[i].KeyID,
+			TenantID:  keys[i].TenantID,
+			CreatedAt: keys[i].CreatedAt,
+		}
+
+		if keys[i].RevokedAt.IsZero() {
+			// the newest key still active encrypts the new secrets
+			d[i].Active = active
+			active = false
+		} else {
+			revokedAt := keys[i].RevokedAt
+			d[i].RevokedAt = &revokedAt
+		}
+	}
+
+	return d, nil
+}
+
+// Rotate creates a new key for a tenant. The secrets written with the older keys stay readable,
+// they are encrypted with the new key the next time they are saved.
+func (uc *UseCase) Rotate(ctx context.Context, tenantID string) (dto.TenantKey, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	k, err := uc.createKey(ctx, tenantID)
+	if err != nil {
+		return dto.TenantKey{}, err
+	}
+
+	return dto.TenantKey{KeyID: k.KeyID, TenantID: k.TenantID, Active: true, CreatedAt: k.CreatedAt}, nil
+}
+
+// Revoke destroys the keys of a tenant. Its secrets cannot be decrypted anymore, new secrets
+// are encrypted with a new key.
+func (uc *UseCase) Revoke(ctx context.Context, tenantID string) (dto.TenantKeyRevokeResult, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	count, err := uc.repo.Revoke(ctx, tenantID, uc.now())
+	if err != nil {
+		return dto.TenantKeyRevokeResult{}, ErrDatabase.Wrap("Revoke", "uc.repo.Revoke", err)
+	}
+
+	for keyID, k := range uc.keys {
+		if k.tenantID == tenantID {
+			delete(uc.keys, keyID)
+		}
+	}
+
+	delete(uc.active, tenantID)
+
+	uc.log.Info("tenantkeys - Revoke: revoked %d keys of tenant %q", count, tenantID)
+
+	return dto.TenantKeyRevokeResult{Revoked: int(count)}, nil
+}
+
+// activeKey returns the newest key of a tenant that is not revoked.
+func (uc *UseCase) activeKey(ctx context.Context, tenantID string) (string, []byte, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if keyID, ok := uc.active[tenantID]; ok {
+		return keyID, uc.keys[keyID].key, nil
+	}
+
+	keys, err := uc.repo.GetByTenant(ctx, tenantID)
+	if err != nil {
+		return "", nil, ErrDatabase.Wrap("activeKey", "uc.repo.GetByTenant", err)
+	}
+
+	for i := range keys {
+		if !keys[i].RevokedAt.IsZero() {
+			continue
+		}
+
+		key, err := uc.unwrap(keys[i])
+		if err != nil {
+			return "", nil, err
+		}
+
+		uc.active[tenantID] = keys[i].KeyID
+
+		return keys[i].KeyID, key, nil
+	}
+
+	k, err := uc.createKey(ctx, tenantID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return k.KeyID, uc.keys[k.KeyID].key, nil
+}
+
+// key returns a key by its ID.
+func (uc *UseCase) key(ctx context.Context, keyID string) ([]byte, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if k, ok := uc.keys[keyID]; ok {
+		return k.key, nil
+	}
+
+	k, err := uc.repo.GetByID(ctx, keyID)
+	if err != nil {
+		return nil, ErrDatabase.Wrap("key", "uc.repo.GetByID", err)
+	}
+
+	if k == nil {
+		return nil, ErrKeyUnknown
+	}
+
+	if !k.RevokedAt.IsZero() {
+		return nil, ErrKeyRevoked
+	}
+
+	return uc.unwrap(*k)
+}
+
+// createKey generates a key for a tenant and makes it the active one, uc.mu must be held.
+func (uc *UseCase) createKey(ctx context.Context, tenantID string) (entity.TenantKey, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return entity.TenantKey{}, err
+	}
+
+	wrapped, err := uc.master.Encrypt(string(key))
+	if err != nil {
+		return entity.TenantKey{}, err
+	}
+
+	k := entity.TenantKey{
+		KeyID:      uuid.NewString(),
+		TenantID:   tenantID,
+		WrappedKey: wrapped,
+		CreatedAt:  uc.now().UTC(),
+	}
+
+	if err := uc.repo.Insert(ctx, k); err != nil {
+		return entity.TenantKey{}, ErrDatabase.Wrap("createKey", "uc.repo.Insert", err)
+	}
+
+	uc.keys[k.KeyID] = cachedKey{tenantID: tenantID, key: key}
+	uc.active[tenantID] = k.KeyID
+
+	return k, nil
+}
+
+// unwrap decrypts a key with the master key and caches it, uc.mu must be held.
+func (uc *UseCase) unwrap(k entity.TenantKey) ([]byte, error) {
+	key, err := uc.master.Decrypt(k.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.keys[k.KeyID] = cachedKey{tenantID: k.TenantID, key: []byte(key)}
+
+	return []byte(key), nil
+}
+
+// Encrypt encrypts a secret with the key of its tenant when c is a TenantEncryptor, otherwise with c.
+func Encrypt(c security.Cryptor, tenantID, plainText string) (string, error) {
+	if te, ok := c.(TenantEncryptor); ok {
+		return te.EncryptForTenant(tenantID, plainText)
+	}
+
+	return c.Encrypt(plainText)
+}
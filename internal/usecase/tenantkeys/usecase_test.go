@@ -0,0 +1,246 @@
+package tenantkeys_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+var master = security.Crypto{EncryptionKey: "Jf3Q2nXJ+GZzN1dbVQms0wbB4BamdsXG"}
+
+func setup(t *testing.T) (*tenantkeys.UseCase, *mocks.MockTenantKeyRepository) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockTenantKeyRepository(mockCtl)
+
+	return tenantkeys.New(repo, master, logger.New("error")), repo
+}
+
+func TestEncryptForTenant(t *testing.T) {
+	t.Parallel()
+
+	uc, repo := setup(t)
+
+	var stored entity.TenantKey
+
+	repo.EXPECT().GetByTenant(context.Background(), "tenant1").Return([]entity.TenantKey{}, nil)
+	repo.EXPECT().Insert(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, k entity.TenantKey) error {
+		stored = k
+
+		return nil
+	})
+
+	cipherText, err := uc.EncryptForTenant("tenant1", "P@ssw0rd")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(cipherText, "dek:"+stored.KeyID+":"), cipherText)
+	require.Equal(t, "tenant1", stored.TenantID)
+
+	// the key is cached, encrypting again does not reach the database
+	again, err := uc.EncryptForTenant("tenant1", "P@ssw0rd")
+	require.NoError(t, err)
+	require.NotEqual(t, cipherText, again)
+
+	plainText, err := uc.Decrypt(cipherText)
+	require.NoError(t, err)
+	require.Equal(t, "P@ssw0rd", plainText)
+
+	// another instance unwraps the key with the master key
+	other, otherRepo := setup(t)
+	otherRepo.EXPECT().GetByID(context.Background(), stored.KeyID).Return(&stored, nil)
+
+	plainText, err = other.Decrypt(cipherText)
+	require.NoError(t, err)
+	require.Equal(t, "P@ssw0rd", plainText)
+
+	// and encrypts with the same key
+	otherRepo.EXPECT().GetByTenant(context.Background(), "tenant1").Return([]entity.TenantKey{stored}, nil)
+
+	cipherText, err = other.EncryptForTenant("tenant1", "P@ssw0rd")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(cipherText, "dek:"+stored.KeyID+":"), cipherText)
+}
+
+func TestDecrypt(t *testing.T) {
+	t.Parallel()
+
+	legacy, err := master.Encrypt("P@ssw0rd")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		cipherText string
+		mock       func(repo *mocks.MockTenantKeyRepository)
+		res        string
+		err        error
+	}{
+		{
+			name:       "encrypted with the master key",
+			cipherText: legacy,
+			mock:       func(_ *mocks.MockTenantKeyRepository) {},
+			res:        "P@ssw0rd",
+		},
+		{
+			name:       "unknown key",
+			cipherText: "dek:missing:" + legacy,
+			mock: func(repo *mocks.MockTenantKeyRepository) {
+				repo.EXPECT().GetByID(context.Background(), "missing").Return(nil, nil)
+			},
+			err: tenantkeys.ErrKeyUnknown,
+		},
+		{
+			name:       "revoked key",
+			cipherText: "dek:revoked:" + legacy,
+			mock: func(repo *mocks.MockTenantKeyRepository) {
+				repo.EXPECT().GetByID(context.Background(), "revoked").
+					Return(&entity.TenantKey{KeyID: "revoked", RevokedAt: time.Now()}, nil)
+			},
+			err: tenantkeys.ErrKeyRevoked,
+		},
+		{
+			name:       "no key id",
+			cipherText: "dek:" + legacy,
+			mock:       func(_ *mocks.MockTenantKeyRepository) {},
+			err:        tenantkeys.ErrKeyUnknown,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, repo := setup(t)
+			tc.mock(repo)
+
+			res, err := uc.Decrypt(tc.cipherText)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.res, res)
+		})
+	}
+
+	uc, repo := setup(t)
+	repo.EXPECT().GetByID(context.Background(), "key1").Return(nil, ErrGeneral)
+
+	_, err = uc.Decrypt("dek:key1:" + legacy)
+	require.ErrorAs(t, err, &sqldb.DatabaseError{})
+}
+
+var ErrGeneral = errors.New("general error")
+
+func TestRotateAndRevoke(t *testing.T) {
+	t.Parallel()
+
+	uc, repo := setup(t)
+
+	var keys []entity.TenantKey
+
+	repo.EXPECT().Insert(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, k entity.TenantKey) error {
+		keys = append(keys, k)
+
+		return nil
+	}).Times(3)
+
+	first, err := uc.Rotate(context.Background(), "tenant1")
+	require.NoError(t, err)
+	require.True(t, first.Active)
+
+	second, err := uc.Rotate(context.Background(), "tenant1")
+	require.NoError(t, err)
+	require.NotEqual(t, first.KeyID, second.KeyID)
+
+	old, err := uc.EncryptForTenant("tenant1", "P@ssw0rd")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(old, "dek:"+second.KeyID+":"), old)
+
+	repo.EXPECT().Revoke(context.Background(), "tenant1", gomock.Any()).Return(int64(2), nil)
+
+	result, err := uc.Revoke(context.Background(), "tenant1")
+	require.NoError(t, err)
+	require.Equal(t, dto.TenantKeyRevokeResult{Revoked: 2}, result)
+
+	// the revoked keys are no longer cached
+	revoked := keys[1]
+	revoked.WrappedKey = ""
+	revoked.RevokedAt = time.Now()
+
+	repo.EXPECT().GetByID(context.Background(), second.KeyID).Return(&revoked, nil)
+
+	_, err = uc.Decrypt(old)
+	require.ErrorIs(t, err, tenantkeys.ErrKeyRevoked)
+
+	// new secrets get a new key
+	repo.EXPECT().GetByTenant(context.Background(), "tenant1").Return([]entity.TenantKey{revoked}, nil)
+
+	cipherText, err := uc.EncryptForTenant("tenant1", "P@ssw0rd")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(cipherText, "dek:"+keys[2].KeyID+":"), cipherText)
+
+	repo.EXPECT().Revoke(context.Background(), "tenant2", gomock.Any()).Return(int64(0), ErrGeneral)
+
+	_, err = uc.Revoke(context.Background(), "tenant2")
+	require.ErrorAs(t, err, &sqldb.DatabaseError{})
+}
+
+func TestGetKeys(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	revokedAt := createdAt.Add(time.Hour)
+
+	uc, repo := setup(t)
+
+	repo.EXPECT().GetByTenant(context.Background(), "tenant1").Return([]entity.TenantKey{
+		{KeyID: "key3", TenantID: "tenant1", WrappedKey: "wrapped", CreatedAt: createdAt.Add(2 * time.Hour)},
+		{KeyID: "key2", TenantID: "tenant1", WrappedKey: "wrapped", CreatedAt: createdAt.Add(time.Hour)},
+		{KeyID: "key1", TenantID: "tenant1", CreatedAt: createdAt, RevokedAt: revokedAt},
+	}, nil)
+
+	keys, err := uc.GetKeys(context.Background(), "tenant1")
+	require.NoError(t, err)
+	require.Equal(t, []dto.TenantKey{
+		{KeyID: "key3", TenantID: "tenant1", Active: true, CreatedAt: createdAt.Add(2 * time.Hour)},
+		{KeyID: "key2", TenantID: "tenant1", CreatedAt: createdAt.Add(time.Hour)},
+		{KeyID: "key1", TenantID: "tenant1", CreatedAt: createdAt, RevokedAt: &revokedAt},
+	}, keys)
+
+	repo.EXPECT().GetByTenant(context.Background(), "tenant2").Return(nil, ErrGeneral)
+
+	_, err = uc.GetKeys(context.Background(), "tenant2")
+	require.ErrorAs(t, err, &sqldb.DatabaseError{})
+}
+
+func TestEncryptHelper(t *testing.T) {
+	t.Parallel()
+
+	cipherText, err := tenantkeys.Encrypt(mocks.MockCrypto{}, "tenant1", "P@ssw0rd")
+	require.NoError(t, err)
+	require.Equal(t, "encrypted", cipherText)
+
+	uc, repo := setup(t)
+	repo.EXPECT().GetByTenant(context.Background(), "tenant1").Return([]entity.TenantKey{}, nil)
+	repo.EXPECT().Insert(context.Background(), gomock.Any()).Return(nil)
+
+	cipherText, err = tenantkeys.Encrypt(uc, "tenant1", "P@ssw0rd")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(cipherText, "dek:"), cipherText)
+}
@@ -0,0 +1,12 @@
+package tickets
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+type Feature interface {
+	Mint(ctx context.Context, guid, subject string, options dto.KVMSessionOptions) (dto.RedirectionTicket, error)
+	Redeem(ctx context.Context, ticket, guid string) (dto.RedirectionTicket, error)
+}
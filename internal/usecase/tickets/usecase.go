@@ -0,0 +1,109 @@
+package tickets
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+const (
+	// DefaultTTL is how long a ticket remains redeemable when no lifetime is configured.
+	DefaultTTL = 30 * time.Second
+
+	ticketBytes = 32
+)
+
+var (
+	ErrTicketUseCase = consoleerrors.CreateConsoleError("TicketsUseCase")
+
+	ErrTicketNotFound = errors.New("redirection ticket not found or already used")
+	ErrTicketExpired  = errors.New("redirection ticket expired")
+	ErrTicketMismatch = errors.New("redirection ticket was not issued for this device")
+)
+
+// UseCase issues and redeems single-use redirection tickets held in memory.
+type UseCase struct {
+	mu      sync.Mutex
+	tickets map[string]dto.RedirectionTicket
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+// New creates a ticket store whose tickets expire after ttl.
+func New(ttl time.Duration) *UseCase {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	return &UseCase{
+		tickets: make(map[string]dto.RedirectionTicket),
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// Mint issues a ticket bound to the device GUID and the authenticated subject, the session opened with it gets options.
+func (uc *UseCase) Mint(_ context.Context, guid, subject string, options dto.KVMSessionOptions) (dto.RedirectionTicket, error) {
+	raw := make([]byte, ticketBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return dto.RedirectionTicket{}, ErrTicketUseCase.Wrap("Mint", "rand.Read", err)
+	}
+
+	ticket := dto.RedirectionTicket{
+		Ticket:    base64.RawURLEncoding.EncodeToString(raw),
+		GUID:      strings.ToLower(guid),
+		Subject:   subject,
+		ExpiresAt: uc.now().Add(uc.ttl),
+		Options:   options,
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	uc.purgeExpired()
+	uc.tickets[ticket.Ticket] = ticket
+
+	return ticket, nil
+}
+
+// Redeem consumes a ticket. A ticket is valid once, before it expires, and only for the device it was minted for.
+func (uc *UseCase) Redeem(_ context.Context, ticket, guid string) (dto.RedirectionTicket, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	t, ok := uc.tickets[ticket]
+	if !ok {
+		return dto.RedirectionTicket{}, ErrTicketNotFound
+	}
+
+	// single use: the ticket is burned even if the checks below fail
+	delete(uc.tickets, ticket)
+
+	if uc.now().After(t.ExpiresAt) {
+		return dto.RedirectionTicket{}, ErrTicketExpired
+	}
+
+	if t.GUID != strings.ToLower(guid) {
+		return dto.RedirectionTicket{}, ErrTicketMismatch
+	}
+
+	return t, nil
+}
+
+// purgeExpired drops expired tickets; callers must hold uc.mu.
+func (uc *UseCase) purgeExpired() {
+	now := uc.now()
+
+	for id, t := range uc.tickets {
+		if now.After(t.ExpiresAt) {
+			delete(uc.tickets, id)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package tickets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+const testGUID = "123e4567-e89b-12d3-a456-426614174000"
+
+func TestMintAndRedeem(t *testing.T) {
+	t.Parallel()
+
+	uc := New(time.Minute)
+
+	options := dto.KVMSessionOptions{KeyboardLayout: dto.KeyboardLayoutFrench, Clipboard: true}
+
+	ticket, err := uc.Mint(context.Background(), testGUID, "admin", options)
+	require.NoError(t, err)
+	assert.NotEmpty(t, ticket.Ticket)
+	assert.Equal(t, testGUID, ticket.GUID)
+
+	redeemed, err := uc.Redeem(context.Background(), ticket.Ticket, "123E4567-E89B-12D3-A456-426614174000")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", redeemed.Subject)
+	assert.Equal(t, options, redeemed.Options)
+
+	_, err = uc.Redeem(context.Background(), ticket.Ticket, testGUID)
+	require.ErrorIs(t, err, ErrTicketNotFound)
+}
+
+func TestRedeemFailures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		guid    string
+		advance time.Duration
+		err     error
+	}{
+		{
+			name: "wrong device",
+			guid: "00000000-0000-0000-0000-000000000000",
+			err:  ErrTicketMismatch,
+		},
+		{
+			name:    "expired",
+			guid:    testGUID,
+			advance: 2 * time.Minute,
+			err:     ErrTicketExpired,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Now()
+			uc := New(time.Minute)
+			uc.now = func() time.Time { return now }
+
+			ticket, err := uc.Mint(context.Background(), testGUID, "admin", dto.KVMSessionOptions{})
+			require.NoError(t, err)
+
+			now = now.Add(tc.advance)
+
+			_, err = uc.Redeem(context.Background(), ticket.Ticket, tc.guid)
+			require.ErrorIs(t, err, tc.err)
+
+			// a failed redemption still burns the ticket
+			_, err = uc.Redeem(context.Background(), ticket.Ticket, testGUID)
+			require.ErrorIs(t, err, ErrTicketNotFound)
+		})
+	}
+}
+
+func TestMintPurgesExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	uc := New(0)
+	uc.now = func() time.Time { return now }
+
+	_, err := uc.Mint(context.Background(), testGUID, "admin", dto.KVMSessionOptions{})
+	require.NoError(t, err)
+
+	now = now.Add(DefaultTTL + time.Second)
+
+	_, err = uc.Mint(context.Background(), testGUID, "admin", dto.KVMSessionOptions{})
+	require.NoError(t, err)
+	assert.Len(t, uc.tickets, 1)
+}
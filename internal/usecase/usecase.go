@@ -1,21 +1,47 @@
 package usecase
 
 import (
+	"context"
+	"crypto"
+
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
 
 	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/certificates"
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/addresshistory"
 	"github.com/device-management-toolkit/console/internal/usecase/amtexplorer"
+	"github.com/device-management-toolkit/console/internal/usecase/checkouts"
+	"github.com/device-management-toolkit/console/internal/usecase/checks"
 	"github.com/device-management-toolkit/console/internal/usecase/ciraconfigs"
+	"github.com/device-management-toolkit/console/internal/usecase/correlations"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/simulator"
 	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
 	"github.com/device-management-toolkit/console/internal/usecase/domains"
+	"github.com/device-management-toolkit/console/internal/usecase/energypolicies"
+	"github.com/device-management-toolkit/console/internal/usecase/enrollment"
 	"github.com/device-management-toolkit/console/internal/usecase/export"
 	"github.com/device-management-toolkit/console/internal/usecase/ieee8021xconfigs"
+	"github.com/device-management-toolkit/console/internal/usecase/images"
+	"github.com/device-management-toolkit/console/internal/usecase/integrity"
+	"github.com/device-management-toolkit/console/internal/usecase/inventory"
+	"github.com/device-management-toolkit/console/internal/usecase/jobs"
+	"github.com/device-management-toolkit/console/internal/usecase/ldapsync"
+	"github.com/device-management-toolkit/console/internal/usecase/logforwarding"
+	"github.com/device-management-toolkit/console/internal/usecase/powerhistory"
 	"github.com/device-management-toolkit/console/internal/usecase/profiles"
 	"github.com/device-management-toolkit/console/internal/usecase/profilewificonfigs"
+	"github.com/device-management-toolkit/console/internal/usecase/rootca"
+	"github.com/device-management-toolkit/console/internal/usecase/rps"
+	"github.com/device-management-toolkit/console/internal/usecase/sites"
+	"github.com/device-management-toolkit/console/internal/usecase/snmptraps"
 	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
+	"github.com/device-management-toolkit/console/internal/usecase/tickets"
 	"github.com/device-management-toolkit/console/internal/usecase/wificonfigs"
 	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -30,36 +56,286 @@ type Usecases struct {
 	CIRAConfigs        ciraconfigs.Feature
 	WirelessProfiles   wificonfigs.Feature
 	Exporter           export.Exporter
+	Tickets            tickets.Feature
+	PowerHistory       powerhistory.Feature
+	AddressHistory     addresshistory.Feature
+	Checkouts          checkouts.Feature
+	Checks             checks.Feature
+	Enrollment         enrollment.Feature
+	Jobs               jobs.Feature
+	EnergyPolicies     energypolicies.Feature
+	Sites              sites.Feature
+	LogForwarding      logforwarding.Feature
+	SNMPTraps          snmptraps.Feature
+	Correlations       correlations.Feature
+	Inventory          inventory.Feature
+	LDAPSync           ldapsync.Feature
+	TenantKeys         tenantkeys.Feature // nil unless tenant encryption keys are enabled
+	Images             images.Feature     // nil unless an image directory is configured
+	RootCA             rootca.Feature     // nil when CIRA is disabled
+	RPS                rps.Feature        // nil unless the activation of rpc-go clients is enabled
+	Integrity          integrity.Feature
+	Events             *eventbus.Bus
 }
 
 // New -.
-func NewUseCases(database *db.SQL, log logger.Interface, certStore security.Storager) *Usecases {
+func NewUseCases(database *db.SQL, log logger.Interface, certStore security.Storager, rootKey crypto.Signer, cryptor security.Cryptor) *Usecases {
 	pwc := profilewificonfigs.New(sqldb.NewProfileWiFiConfigsRepo(database, log), log)
 	ieee := ieee8021xconfigs.New(sqldb.NewIEEE8021xRepo(database, log), log)
 	wifiConfigRepo := sqldb.NewWirelessRepo(database, log)
 	key := config.ConsoleConfig.EncryptionKey
-	safeRequirements := security.Crypto{
+
+	// the passwords are encrypted with the local key unless a cryptor keeping its key elsewhere is given
+	var master security.Cryptor = security.Crypto{
 		EncryptionKey: key,
 	}
+
+	if cryptor != nil {
+		master = cryptor
+	}
+
+	var (
+		safeRequirements security.Cryptor = master
+		tenantKeys       tenantkeys.Feature
+	)
+
+	if config.ConsoleConfig.TenantEncryptionKeys {
+		keys := tenantkeys.New(sqldb.NewTenantKeyRepo(database, log), master, log)
+		safeRequirements = keys
+		tenantKeys = keys
+	}
 	wsman1 := wsman.NewGoWSMANMessages(log, safeRequirements)
 	wsman2 := amtexplorer.NewGoWSMANMessages(log, safeRequirements)
 	domainRepo := sqldb.NewDomainRepo(database, log)
-	deviceRepo := sqldb.NewDeviceRepo(database, log)
+	var deviceRepo devices.Repository = sqldb.NewDeviceRepo(database, log)
 	ciraRepo := sqldb.NewCIRARepo(database, log)
 	profileRepo := sqldb.NewProfileRepo(database, log)
 
+	if config.ConsoleConfig.DeviceCredentials {
+		if credentialStore, ok := certStore.(devices.CredentialStore); ok {
+			deviceRepo = devices.NewVaultRepository(deviceRepo, credentialStore, safeRequirements, log)
+		} else {
+			log.Warn("device credentials are kept in the database: no secret store is configured")
+		}
+	}
+
 	domains1 := domains.New(domainRepo, log, safeRequirements, certStore)
 	wificonfig := wificonfigs.New(wifiConfigRepo, ieee, log, safeRequirements)
+	events := eventbus.New()
+
+	var (
+		deviceWSMAN devices.WSMAN = wsman1
+		sim         *simulator.Simulator
+	)
+
+	if config.ConsoleConfig.Simulator.Devices > 0 {
+		sim = simulator.New(wsman1, config.ConsoleConfig.Simulator)
+		deviceWSMAN = sim
+	}
+
+	devices1 := devices.New(deviceRepo, deviceWSMAN, devices.NewRedirector(safeRequirements), log, safeRequirements, events)
+
+	kvm := config.ConsoleConfig.KVM
+	if _, err := devices1.SetKVMLimits(context.Background(), dto.KVMLimits{Quality: kvm.Quality, FrameRate: kvm.FrameRate, Bandwidth: kvm.Bandwidth}); err != nil {
+		log.Warn("KVM sessions are not limited: %v", err)
+	}
+
+	devices1.SetIdleTimeout(config.ConsoleConfig.Redirection.IdleTimeout, config.ConsoleConfig.Redirection.IdleWarning)
+	devices1.SetLockPolicy(config.ConsoleConfig.DeviceLock.Required, config.ConsoleConfig.DeviceLock.TTL)
+
+	sites1 := sites.New(sqldb.NewSiteRepo(database, log), log, safeRequirements)
+	devices1.SetSites(sites1)
+
+	if cfg := config.ConsoleConfig.Revocation; cfg.Enabled {
+		revocation := certificates.NewRevocationChecker(cfg.Timeout, cfg.CacheTTL)
+		domains1.SetRevocation(revocation)
+		devices1.SetRevocation(revocation)
+	}
+
+	if err := wsman.CheckAddressPreference(config.ConsoleConfig.Network); err != nil {
+		log.Warn("device hostnames are reached in the order of the resolver: %v", err)
+	}
+
+	if err := wsman.CheckProxy(config.ConsoleConfig.WsmanProxy); err != nil {
+		log.Warn("devices are not reached through a proxy with an invalid URL: %v", err)
+	}
+
+	if sim != nil {
+		seedSimulator(sim, devices1, log)
+	}
+
+	correlations1 := correlations.New(sqldb.NewDeviceCorrelationRepo(database, log), deviceRepo, log)
+	jobRepo := sqldb.NewJobRepo(database, log)
+	profiles1 := profiles.New(profileRepo, wifiConfigRepo, pwc, ieee, log, domains1, ciraRepo, safeRequirements)
+	profiles1.SetDevices(devices1)
+
+	jobs1 := jobs.New(jobRepo, devices1, correlations1, tenantKeys, profiles1, config.ConsoleConfig.Jobs, log, events)
+	devices1.SetPendingOperations(jobs1)
+
+	var rootCA rootca.Feature
+
+	if !config.ConsoleConfig.DisableCIRA {
+		alg, err := certificates.ParseKeyAlgorithm(config.ConsoleConfig.CertificateKeyAlgorithm)
+		if err != nil {
+			log.Warn("root certificates are generated with %s keys: %v", certificates.DefaultKeyAlgorithm, err)
+
+			alg = certificates.DefaultKeyAlgorithm
+		}
+
+		rootCA = rootca.New(certStore, devices1, "config", config.ConsoleConfig.CommonName, alg, rootKey, log)
+	}
+
+	ciraConfigs := ciraconfigs.New(ciraRepo, log, safeRequirements)
+
+	powerSampleRepo := sqldb.NewPowerSampleRepo(database, log)
+	powerHistory := powerhistory.New(powerSampleRepo, devices1, log,
+		config.ConsoleConfig.PowerPollInterval, config.ConsoleConfig.PowerHistoryRetention)
+
+	deviceAddressRepo := sqldb.NewDeviceAddressRepo(database, log)
 
 	return &Usecases{
 		Domains:            domains1,
-		Devices:            devices.New(deviceRepo, wsman1, devices.NewRedirector(safeRequirements), log, safeRequirements),
+		Devices:            devices1,
 		AMTExplorer:        amtexplorer.New(deviceRepo, wsman2, log, safeRequirements),
-		Profiles:           profiles.New(profileRepo, wifiConfigRepo, pwc, ieee, log, domains1, ciraRepo, safeRequirements),
+		Profiles:           profiles1,
 		IEEE8021xProfiles:  ieee,
-		CIRAConfigs:        ciraconfigs.New(ciraRepo, log, safeRequirements),
+		CIRAConfigs:        ciraConfigs,
 		WirelessProfiles:   wificonfig,
 		ProfileWiFiConfigs: pwc,
 		Exporter:           export.NewFileExporter(),
+		Tickets:            tickets.New(config.ConsoleConfig.RedirectionTicketExpiration),
+		PowerHistory:       powerHistory,
+		AddressHistory:     addresshistory.New(deviceAddressRepo, devices1, log, events),
+		Checkouts:          newCheckouts(database, log, devices1, events),
+		Checks:             checks.New(devices1, log),
+		Enrollment:         newEnrollment(database, log, devices1, ciraConfigs),
+		Jobs:               jobs1,
+		EnergyPolicies:     energypolicies.New(sqldb.NewEnergyPolicyRepo(database, log), devices1, log),
+		Sites:              sites1,
+		LogForwarding:      newLogForwarding(database, log, devices1),
+		SNMPTraps:          newSNMPTraps(log, devices1, events),
+		Correlations:       correlations1,
+		Inventory:          inventory.New(devices1, deviceAddressRepo, powerSampleRepo, sites1, config.ConsoleConfig.PowerPollInterval, log),
+		LDAPSync:           newLDAPSync(database, log),
+		TenantKeys:         tenantKeys,
+		Images:             newImages(log),
+		RootCA:             rootCA,
+		RPS:                newRPS(log, devices1, profiles1, domains1),
+		Integrity:          newIntegrity(database, log, safeRequirements),
+		Events:             events,
 	}
 }
+
+// newCheckouts creates the checkouts of the device passwords, lasting as configured.
+func newCheckouts(database *db.SQL, log logger.Interface, d checkouts.Devices, events *eventbus.Bus) *checkouts.UseCase {
+	cfg := config.ConsoleConfig.CredentialCheckout
+
+	return checkouts.New(sqldb.NewCredentialCheckoutRepo(database, log), sqldb.NewUserRepo(database, log), d,
+		config.ConsoleConfig.AdminUsername, cfg.TTL, cfg.MaxTTL, log, events)
+}
+
+// newEnrollment creates the enrollment of the devices with tokens lasting as configured.
+func newEnrollment(database *db.SQL, log logger.Interface, d enrollment.Devices, c enrollment.CIRAConfigs) *enrollment.UseCase {
+	cfg := config.ConsoleConfig.Enrollment
+
+	return enrollment.New(sqldb.NewEnrollmentTokenRepo(database, log), d, c, cfg.TokenTTL, cfg.MaxTokenTTL, log)
+}
+
+// newRPS creates the activation of the rpc-go clients pointed at the console, nil unless it is enabled.
+func newRPS(log logger.Interface, d rps.Devices, p rps.Profiles, dm rps.Domains) rps.Feature {
+	cfg := config.ConsoleConfig.RPS
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return rps.New(d, p, dm, cfg.Timeout, log)
+}
+
+// newIntegrity creates the check of the stored secrets, decrypting them as the use cases do.
+func newIntegrity(database *db.SQL, log logger.Interface, cryptor security.Cryptor) *integrity.UseCase {
+	cfg := config.ConsoleConfig.IntegrityCheck
+
+	return integrity.New(sqldb.NewSecretRepo(database, log), cryptor, sqldb.EncryptedColumns, cfg.Sample, cfg.Quarantine, log)
+}
+
+// seedSimulator adds the simulated devices missing from the database, the simulator keeps answering for the others.
+func seedSimulator(sim *simulator.Simulator, d simulator.Devices, log logger.Interface) {
+	added, err := sim.Seed(context.Background(), d)
+	if err != nil {
+		log.Error(err, "simulated devices are not seeded")
+
+		return
+	}
+
+	log.Warn("simulator mode: %d simulated devices, %d added", config.ConsoleConfig.Simulator.Devices, added)
+}
+
+// newLogForwarding creates the log forwarder, which stays disabled when its target is not configured correctly.
+func newLogForwarding(database *db.SQL, log logger.Interface, d logforwarding.Devices) *logforwarding.UseCase {
+	cfg := config.ConsoleConfig.LogForwarding
+
+	var sink logforwarding.Sink
+
+	if cfg.Interval > 0 {
+		var err error
+
+		if sink, err = logforwarding.NewSink(cfg); err != nil {
+			log.Error(err, "log forwarding is disabled")
+		}
+	}
+
+	return logforwarding.New(sqldb.NewLogCursorRepo(database, log), d, sink, log, cfg.Interval)
+}
+
+// newSNMPTraps creates the trap emitter, which stays disabled when the trap receiver is not configured correctly.
+func newSNMPTraps(log logger.Interface, d snmptraps.Devices, events *eventbus.Bus) *snmptraps.UseCase {
+	cfg := config.ConsoleConfig.SNMPTraps
+
+	var sender snmptraps.Sender
+
+	if cfg.Interval > 0 {
+		trapSender, err := snmptraps.NewTrapSender(cfg)
+		if err != nil {
+			log.Error(err, "snmp traps are disabled")
+		} else {
+			sender = trapSender
+		}
+	}
+
+	return snmptraps.New(d, sender, events, log, cfg.Interval)
+}
+
+// newLDAPSync creates the account sync, which stays disabled when the directory is not configured correctly.
+func newLDAPSync(database *db.SQL, log logger.Interface) *ldapsync.UseCase {
+	cfg := config.ConsoleConfig.LDAPSync
+
+	var directory ldapsync.Searcher
+
+	if cfg.URL != "" {
+		ldapDirectory, err := ldapsync.NewDirectory(cfg)
+		if err != nil {
+			log.Error(err, "ldap sync is disabled")
+		} else {
+			directory = ldapDirectory
+		}
+	}
+
+	return ldapsync.New(sqldb.NewUserRepo(database, log), directory, cfg.GroupRoles, log, cfg.Interval)
+}
+
+// newImages creates the image store, which stays disabled when its directory is not configured or cannot be created.
+func newImages(log logger.Interface) images.Feature {
+	dir := config.ConsoleConfig.Images.Dir
+	if dir == "" {
+		return nil
+	}
+
+	store, err := images.New(dir, log)
+	if err != nil {
+		log.Error(err, "image uploads are disabled")
+
+		return nil
+	}
+
+	return store
+}
@@ -19,9 +19,11 @@ import (
 	"github.com/device-management-toolkit/console/internal/usecase/ieee8021xconfigs"
 	"github.com/device-management-toolkit/console/internal/usecase/profiles"
 	"github.com/device-management-toolkit/console/internal/usecase/profilewificonfigs"
+	"github.com/device-management-toolkit/console/internal/usecase/sites"
 	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
 	"github.com/device-management-toolkit/console/internal/usecase/wificonfigs"
 	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/eventbus"
 )
 
 type usecaseTest struct {
@@ -49,6 +51,14 @@ func TestUsecases(t *testing.T) {
 		EncryptionKey: "test",
 	}
 
+	// the devices use case is shared with the profiles, as NewUseCases does
+	expectedDevices := func() *devices.UseCase {
+		d := devices.New(sqldb.NewDeviceRepo(&db.SQL{}, mocks.NewMockLogger(nil)), wsman.NewGoWSMANMessages(mocks.NewMockLogger(nil), safeRequirements), devices.NewRedirector(safeRequirements), mocks.NewMockLogger(nil), safeRequirements, eventbus.New())
+		d.SetSites(sites.New(sqldb.NewSiteRepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil), safeRequirements))
+
+		return d
+	}()
+
 	tests := []usecaseTest{
 		{
 			name: "NewUseCases initializes correctly",
@@ -58,20 +68,25 @@ func TestUsecases(t *testing.T) {
 
 				setupConfig()
 
-				return NewUseCases(mockDB, mockLogger, nil)
+				return NewUseCases(mockDB, mockLogger, nil, nil, nil)
 			},
 			expectedResult: &Usecases{
 				Domains: domains.New(sqldb.NewDomainRepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil), safeRequirements, nil),
-				Devices: devices.New(sqldb.NewDeviceRepo(&db.SQL{}, mocks.NewMockLogger(nil)), wsman.NewGoWSMANMessages(mocks.NewMockLogger(nil), safeRequirements), devices.NewRedirector(safeRequirements), mocks.NewMockLogger(nil), safeRequirements),
-				Profiles: profiles.New(
-					sqldb.NewProfileRepo(&db.SQL{}, mocks.NewMockLogger(nil)),
-					sqldb.NewWirelessRepo(&db.SQL{}, mocks.NewMockLogger(nil)),
-					profilewificonfigs.New(sqldb.NewProfileWiFiConfigsRepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil)),
-					ieee8021xconfigs.New(sqldb.NewIEEE8021xRepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil),
-					domains.New(sqldb.NewDomainRepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil), safeRequirements, nil),
-					sqldb.NewCIRARepo(&db.SQL{}, mocks.NewMockLogger(nil)),
-					safeRequirements,
-				),
+				Devices: expectedDevices,
+				Profiles: func() *profiles.UseCase {
+					p := profiles.New(
+						sqldb.NewProfileRepo(&db.SQL{}, mocks.NewMockLogger(nil)),
+						sqldb.NewWirelessRepo(&db.SQL{}, mocks.NewMockLogger(nil)),
+						profilewificonfigs.New(sqldb.NewProfileWiFiConfigsRepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil)),
+						ieee8021xconfigs.New(sqldb.NewIEEE8021xRepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil),
+						domains.New(sqldb.NewDomainRepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil), safeRequirements, nil),
+						sqldb.NewCIRARepo(&db.SQL{}, mocks.NewMockLogger(nil)),
+						safeRequirements,
+					)
+					p.SetDevices(expectedDevices)
+
+					return p
+				}(),
 				IEEE8021xProfiles:  ieee8021xconfigs.New(sqldb.NewIEEE8021xRepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil)),
 				CIRAConfigs:        ciraconfigs.New(sqldb.NewCIRARepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil), safeRequirements),
 				WirelessProfiles:   wificonfigs.New(sqldb.NewWirelessRepo(&db.SQL{}, mocks.NewMockLogger(nil)), ieee8021xconfigs.New(sqldb.NewIEEE8021xRepo(&db.SQL{}, mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil)), mocks.NewMockLogger(nil), safeRequirements),
@@ -88,6 +103,9 @@ func TestUsecases(t *testing.T) {
 
 			uc := tc.initializeFunc()
 
+			// the pending jobs of merged devices are moved by the jobs use case
+			expectedDevices.SetPendingOperations(uc.Jobs)
+
 			require.NotNil(t, uc)
 			assert.NotNil(t, uc.Devices)
 			assert.NotNil(t, uc.Domains)
@@ -96,6 +114,18 @@ func TestUsecases(t *testing.T) {
 			assert.NotNil(t, uc.IEEE8021xProfiles)
 			assert.NotNil(t, uc.CIRAConfigs)
 			assert.NotNil(t, uc.WirelessProfiles)
+			assert.NotNil(t, uc.Tickets)
+			assert.NotNil(t, uc.PowerHistory)
+			assert.NotNil(t, uc.AddressHistory)
+			assert.NotNil(t, uc.Checks)
+			assert.NotNil(t, uc.EnergyPolicies)
+			assert.NotNil(t, uc.Sites)
+			assert.NotNil(t, uc.LogForwarding)
+			assert.NotNil(t, uc.SNMPTraps)
+			assert.NotNil(t, uc.Correlations)
+			assert.NotNil(t, uc.Inventory)
+			assert.NotNil(t, uc.LDAPSync)
+			assert.NotNil(t, uc.Events)
 
 			assert.Equal(t, tc.expectedResult.Domains, uc.Domains)
 			assert.Equal(t, tc.expectedResult.Devices, uc.Devices)
@@ -136,7 +166,7 @@ func TestInitialization(t *testing.T) {
 
 			mockLogger := mocks.NewMockLogger(mockCtl)
 
-			uc := NewUseCases(mockDB, mockLogger, nil)
+			uc := NewUseCases(mockDB, mockLogger, nil, nil, nil)
 
 			require.NotNil(t, uc)
 			assert.NotNil(t, uc.Devices)
@@ -12,6 +12,7 @@ import (
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/ieee8021xconfigs"
 	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/internal/usecase/tenantkeys"
 	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
@@ -179,7 +180,7 @@ func (uc *UseCase) dtoToEntity(d *dto.WirelessConfig) *entity.WirelessConfig {
 		Version:              d.Version,
 	}
 
-	d1.PSKPassphrase, _ = uc.safeRequirements.Encrypt(d.PSKPassphrase)
+	d1.PSKPassphrase, _ = tenantkeys.Encrypt(uc.safeRequirements, d.TenantID, d.PSKPassphrase)
 
 	return d1
 }
@@ -0,0 +1,64 @@
+// Package amtpassword generates the random passwords the console sets on the devices, meeting the rules of AMT for
+// its admin and MPS passwords: 8 to 32 characters with an uppercase and a lowercase letter, a digit and a symbol.
+package amtpassword
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const (
+	// MinLength and MaxLength bound the length of an AMT password.
+	MinLength = 8
+	MaxLength = 32
+)
+
+// ErrLength is returned for a length AMT does not take.
+var ErrLength = errors.New("an AMT password has 8 to 32 characters")
+
+// classes are the characters of the passwords, one of each class is in every password. Look-alike characters and
+// the ones AMT refuses in a password are left out.
+var classes = []string{
+	"ABCDEFGHJKLMNPQRSTUVWXYZ",
+	"abcdefghijkmnopqrstuvwxyz",
+	"23456789",
+	"!#$%*+-=?@_",
+}
+
+// Generate returns a random password of length characters with a character of each class.
+func Generate(length int) (string, error) {
+	if length < MinLength || length > MaxLength {
+		return "", ErrLength
+	}
+
+	all := strings.Join(classes, "")
+	password := make([]byte, length)
+
+	for i := range password {
+		chars := all
+		if i < len(classes) {
+			chars = classes[i]
+		}
+
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+		if err != nil {
+			return "", err
+		}
+
+		password[i] = chars[n.Int64()]
+	}
+
+	// the characters of each class are moved off the start of the password
+	for i := len(password) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+
+		password[i], password[n.Int64()] = password[n.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
@@ -0,0 +1,29 @@
+package amtpassword_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/amtpassword"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	for _, length := range []int{amtpassword.MinLength, 16, amtpassword.MaxLength} {
+		password, err := amtpassword.Generate(length)
+		require.NoError(t, err)
+		require.Len(t, password, length)
+		require.True(t, strings.ContainsAny(password, "ABCDEFGHJKLMNPQRSTUVWXYZ"), password)
+		require.True(t, strings.ContainsAny(password, "abcdefghijkmnopqrstuvwxyz"), password)
+		require.True(t, strings.ContainsAny(password, "23456789"), password)
+		require.True(t, strings.ContainsAny(password, "!#$%*+-=?@_"), password)
+	}
+
+	for _, length := range []int{0, amtpassword.MinLength - 1, amtpassword.MaxLength + 1} {
+		_, err := amtpassword.Generate(length)
+		require.ErrorIs(t, err, amtpassword.ErrLength)
+	}
+}
@@ -0,0 +1,150 @@
+// Package amtstatus explains the return codes of AMT: the PT_STATUS codes returned by the AMT_ methods and the
+// ReturnValue of the CIM power and boot methods, with what to do about them. The explanations are included in
+// the error responses of the operations that fail with them.
+package amtstatus
+
+import (
+	"fmt"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+// Explanation tells what a return code of AMT means and how to remedy it.
+type Explanation struct {
+	Code        int    `json:"code" example:"2058"`
+	Name        string `json:"name" example:"PT_STATUS_DUPLICATE"`
+	Description string `json:"description" example:"the object already exists on the device"`
+	Remediation string `json:"remediation,omitempty" example:"remove the existing certificate first or reuse it"`
+}
+
+// Error is returned when AMT answers Operation with a non-zero return code.
+type Error struct {
+	Operation string
+	Explanation
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%s returned %d (%s): %s", e.Operation, e.Code, e.Name, e.Description)
+}
+
+func (e Error) ErrorCode() consoleerrors.Code {
+	return consoleerrors.CodeAMTError
+}
+
+// entry is the explanation of a code in one of the tables.
+type entry struct {
+	name        string
+	description string
+	remediation string
+}
+
+// ptStatus explains the PT_STATUS codes of the AMT_ methods, e.g. AMT_PublicKeyManagementService.
+var ptStatus = map[int]entry{
+	0:    {"PT_STATUS_SUCCESS", "the operation completed", ""},
+	1:    {"PT_STATUS_INTERNAL_ERROR", "AMT failed internally", "retry the operation; if it keeps failing, reset the AMT firmware by removing power from the device"},
+	3:    {"PT_STATUS_INVALID_PT_MODE", "the operation is not allowed in the current mode of AMT", "check that the device is activated in the expected control mode"},
+	9:    {"PT_STATUS_INVALID_REGISTRATION_DATA", "the registration data is invalid", "check the parameters of the request"},
+	10:   {"PT_STATUS_APPLICATION_DOES_NOT_EXIST", "the application is not registered on the device", ""},
+	11:   {"PT_STATUS_NOT_ENOUGH_STORAGE", "the device has no room left for the object", "delete unused certificates, keys or profiles from the device"},
+	12:   {"PT_STATUS_INVALID_NAME", "the name is invalid", "check the name given in the request"},
+	13:   {"PT_STATUS_BLOCK_DOES_NOT_EXIST", "the storage block does not exist", ""},
+	14:   {"PT_STATUS_INVALID_BYTE_OFFSET", "the byte offset is invalid", ""},
+	15:   {"PT_STATUS_INVALID_BYTE_COUNT", "the byte count is invalid", ""},
+	16:   {"PT_STATUS_NOT_PERMITTED", "the user is not permitted to perform the operation", "use an account with the required realms, e.g. the admin account"},
+	17:   {"PT_STATUS_NOT_OWNER", "the caller does not own the object", ""},
+	18:   {"PT_STATUS_BLOCK_LOCKED_BY_OTHER", "the storage block is locked by another application", "retry once the other application released it"},
+	19:   {"PT_STATUS_BLOCK_NOT_LOCKED", "the storage block is not locked", ""},
+	20:   {"PT_STATUS_INVALID_GROUP_PERMISSIONS", "the group permissions are invalid", ""},
+	21:   {"PT_STATUS_GROUP_DOES_NOT_EXIST", "the group does not exist", ""},
+	22:   {"PT_STATUS_INVALID_MEMBER_COUNT", "the member count is invalid", ""},
+	23:   {"PT_STATUS_MAX_LIMIT_REACHED", "the device holds the maximum number of such objects", "delete unused objects of the same kind from the device"},
+	24:   {"PT_STATUS_INVALID_AUTH_TYPE", "the authentication type is invalid", ""},
+	26:   {"PT_STATUS_INVALID_DHCP_MODE", "the operation is not allowed in the current DHCP mode", "check whether the interface uses DHCP or a static address"},
+	27:   {"PT_STATUS_INVALID_IP_ADDRESS", "the IP address is invalid", "check the address given in the request"},
+	28:   {"PT_STATUS_INVALID_DOMAIN_NAME", "the domain name is invalid", "check the domain name given in the request"},
+	30:   {"PT_STATUS_REQUEST_UNEXPECTED", "the request was not expected in the current state", "retry the operation"},
+	32:   {"PT_STATUS_INVALID_PROVISIONING_STATE", "the operation is not allowed in the current provisioning state", "check that the device is activated"},
+	34:   {"PT_STATUS_INVALID_TIME", "the time is invalid", "check the clock of the device and of the console"},
+	35:   {"PT_STATUS_INVALID_INDEX", "the index is invalid", ""},
+	36:   {"PT_STATUS_INVALID_PARAMETER", "a parameter of the request is invalid", "check the parameters of the request"},
+	37:   {"PT_STATUS_INVALID_NETMASK", "the netmask is invalid", "check the netmask given in the request"},
+	38:   {"PT_STATUS_FLASH_WRITE_LIMIT_EXCEEDED", "the device refused to write its flash memory again this soon", "wait before retrying the operation"},
+	2049: {"PT_STATUS_UNSUPPORTED_OEM_NUMBER", "the OEM number is not supported", ""},
+	2050: {"PT_STATUS_UNSUPPORTED_BOOT_OPTION", "the boot option is not supported by the device", "use a boot option listed in the boot capabilities of the device"},
+	2051: {"PT_STATUS_INVALID_COMMAND", "the command is invalid", ""},
+	2052: {"PT_STATUS_INVALID_SPECIAL_COMMAND", "the special command is invalid", ""},
+	2053: {"PT_STATUS_INVALID_HANDLE", "the handle does not refer to an object of the device", "reload the objects of the device, the handle may be stale"},
+	2054: {"PT_STATUS_INVALID_PASSWORD", "the password does not meet the requirements of AMT", "use 8 to 32 characters with upper and lower case letters, a digit and a special character"},
+	2055: {"PT_STATUS_INVALID_REALM", "the realm is invalid", ""},
+	2056: {"PT_STATUS_STORAGE_ACL_ENTRY_IN_USE", "the storage ACL entry is in use", ""},
+	2057: {"PT_STATUS_DATA_MISSING", "data required by the operation is missing", "check the parameters of the request"},
+	2058: {"PT_STATUS_DUPLICATE", "the object already exists on the device", "remove the existing object first or reuse it"},
+	2059: {"PT_STATUS_EVENT_LOG_FROZEN", "the event log is frozen", "unfreeze the event log"},
+	2060: {"PT_STATUS_PKI_MISSING_KEYS", "the key pair is missing", "generate or add the key pair of the certificate first"},
+	2061: {"PT_STATUS_PKI_GENERATING_KEYS", "the device is still generating keys", "retry in a few seconds"},
+	2062: {"PT_STATUS_INVALID_KEY", "the key is invalid", "check the format and length of the key"},
+	2063: {"PT_STATUS_INVALID_CERT", "the certificate is invalid", "check that the certificate is a PEM or DER X.509 certificate AMT supports"},
+	2064: {"PT_STATUS_CERT_KEY_NOT_MATCH", "the certificate does not match its key", "add the key pair the certificate was issued for"},
+	2065: {"PT_STATUS_MAX_KERB_DOMAIN_REACHED", "the device holds the maximum number of Kerberos domains", "remove an unused Kerberos domain"},
+	2066: {"PT_STATUS_UNSUPPORTED", "the operation is not supported by the device", "check the AMT version and SKU of the device"},
+	2067: {"PT_STATUS_INVALID_PRIORITY", "the priority is invalid", ""},
+	2068: {"PT_STATUS_NOT_FOUND", "the object was not found on the device", "reload the objects of the device"},
+	2069: {"PT_STATUS_INVALID_CREDENTIALS", "the credentials are invalid", "check the username and password"},
+	2070: {"PT_STATUS_INVALID_PASSPHRASE", "the passphrase is invalid", "check the passphrase given in the request"},
+	2072: {"PT_STATUS_NO_ASSOCIATION", "the object is not associated", ""},
+	2075: {"PT_STATUS_AUDIT_FAIL", "the audit log refused to record the operation", "make room in the audit log or change its policy"},
+	2076: {"PT_STATUS_BLOCKING_COMPONENT", "a component of the device blocks the operation", ""},
+	2081: {"PT_STATUS_USER_CONSENT_REQUIRED", "the operation requires user consent", "start a user consent session and enter the code shown on the device"},
+	2082: {"PT_STATUS_OPERATION_IN_PROGRESS", "another operation is in progress", "retry once it completed"},
+}
+
+// powerReturnValues explains the ReturnValue of CIM_PowerManagementService.RequestPowerStateChange.
+var powerReturnValues = map[int]entry{
+	0:    {"CompletedWithNoError", "the power action completed", ""},
+	1:    {"MethodNotSupported", "the device does not support the power action", "use a power action listed in the power capabilities of the device"},
+	2:    {"UnknownError", "the device failed the power action", "retry the power action"},
+	3:    {"CannotCompleteWithinTimeoutPeriod", "the power action did not complete in time", "check the power state of the device before retrying"},
+	4:    {"Failed", "the power action failed", "check the power state of the device before retrying"},
+	5:    {"InvalidParameter", "the power action is not valid", "use a power action listed in the power capabilities of the device"},
+	6:    {"InUse", "the device is busy with another power action", "retry once the other power action completed"},
+	4096: {"MethodParametersCheckedJobStarted", "the power action was accepted and runs in the background", ""},
+	4097: {"InvalidStateTransition", "the power action is not possible in the current power state", "check the power state of the device, e.g. power it up before a reset"},
+	4098: {"UseOfTimeoutParameterNotSupported", "the device does not support a timeout for the power action", ""},
+	4099: {"Busy", "the device is busy", "retry the power action"},
+}
+
+// bootReturnValues explains the ReturnValue of CIM_BootConfigSetting.ChangeBootOrder and
+// CIM_BootService.SetBootConfigRole.
+var bootReturnValues = map[int]entry{
+	0: {"CompletedNoError", "the boot configuration completed", ""},
+	1: {"NotSupported", "the device does not support the boot source", "use a boot option listed in the boot capabilities of the device"},
+	2: {"UnknownError", "the device failed to change its boot configuration", "retry the operation"},
+	3: {"Busy", "the device is busy", "retry the operation"},
+	4: {"InvalidReference", "the boot source does not exist on the device", "use a boot source listed by the device"},
+	5: {"InvalidParameter", "a parameter of the boot configuration is invalid", "check the boot settings of the request"},
+	6: {"AccessDenied", "the device denied the change of its boot configuration", "check that the boot options are not locked by the BIOS"},
+}
+
+// Explain returns the explanation of a PT_STATUS code returned by an AMT_ method.
+func Explain(code int) Explanation {
+	return explain(ptStatus, code)
+}
+
+// ExplainPower returns the explanation of a ReturnValue of CIM_PowerManagementService.RequestPowerStateChange.
+func ExplainPower(code int) Explanation {
+	return explain(powerReturnValues, code)
+}
+
+// ExplainBoot returns the explanation of a ReturnValue of the CIM boot methods.
+func ExplainBoot(code int) Explanation {
+	return explain(bootReturnValues, code)
+}
+
+func explain(table map[int]entry, code int) Explanation {
+	e, ok := table[code]
+	if !ok {
+		return Explanation{Code: code, Name: "UNKNOWN", Description: "the device returned an unknown code"}
+	}
+
+	return Explanation{Code: code, Name: e.name, Description: e.description, Remediation: e.remediation}
+}
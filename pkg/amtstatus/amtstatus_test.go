@@ -0,0 +1,34 @@
+package amtstatus_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/amtstatus"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	duplicate := amtstatus.Explain(2058)
+	require.Equal(t, "PT_STATUS_DUPLICATE", duplicate.Name)
+	require.NotEmpty(t, duplicate.Remediation)
+
+	// the same value means different things for the power and boot methods
+	require.Equal(t, "CannotCompleteWithinTimeoutPeriod", amtstatus.ExplainPower(3).Name)
+	require.Equal(t, "Busy", amtstatus.ExplainBoot(3).Name)
+
+	unknown := amtstatus.Explain(9999)
+	require.Equal(t, amtstatus.Explanation{Code: 9999, Name: "UNKNOWN", Description: "the device returned an unknown code"}, unknown)
+}
+
+func TestError(t *testing.T) {
+	t.Parallel()
+
+	err := amtstatus.Error{Operation: "AddTrustedRootCertificate", Explanation: amtstatus.Explain(2063)}
+
+	require.EqualError(t, err, "AddTrustedRootCertificate returned 2063 (PT_STATUS_INVALID_CERT): the certificate is invalid")
+	require.Equal(t, consoleerrors.CodeAMTError, consoleerrors.CodeOf(err))
+}
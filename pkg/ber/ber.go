@@ -0,0 +1,50 @@
+// Package ber encodes the ASN.1 BER elements of the protocols the console speaks itself, such as the
+// SNMP traps and the LDAP directory sync. Only the definite length form is written.
+package ber
+
+// TLV encodes an element of tag whose contents are the concatenation of contents.
+func TLV(tag byte, contents ...[]byte) []byte {
+	content := Concat(contents...)
+
+	return append(append([]byte{tag}, Length(len(content))...), content...)
+}
+
+// Concat returns the concatenation of parts.
+func Concat(parts ...[]byte) []byte {
+	var out []byte
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+
+	return out
+}
+
+// Length encodes the length of the contents of an element, in the short form below 128.
+func Length(length int) []byte {
+	if length < 0x80 {
+		return []byte{byte(length)}
+	}
+
+	var digits []byte
+	for l := length; l > 0; l >>= 8 {
+		digits = append([]byte{byte(l)}, digits...)
+	}
+
+	return append([]byte{0x80 | byte(len(digits))}, digits...)
+}
+
+// Integer encodes an INTEGER or ENUMERATED of tag in the fewest two's complement bytes.
+func Integer(tag byte, value int64) []byte {
+	content := []byte{byte(value)}
+
+	for v := value >> 8; ; v >>= 8 {
+		last := content[0]
+		if (v == 0 && last&0x80 == 0) || (v == -1 && last&0x80 != 0) {
+			break
+		}
+
+		content = append([]byte{byte(v)}, content...)
+	}
+
+	return TLV(tag, content)
+}
@@ -0,0 +1,51 @@
+package ber_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/ber"
+)
+
+func TestInteger(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    int64
+		expected string
+	}{
+		{value: 0, expected: "020100"},
+		{value: 127, expected: "02017f"},
+		{value: 128, expected: "02020080"},
+		{value: 65507, expected: "020300ffe3"},
+		{value: -1, expected: "0201ff"},
+		{value: -129, expected: "0202ff7f"},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.expected, hex.EncodeToString(ber.Integer(0x02, tc.value)), tc.value)
+	}
+
+	require.Equal(t, "0a0105", hex.EncodeToString(ber.Integer(0x0a, 5)), "the tag is kept for ENUMERATED")
+}
+
+func TestLength(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []byte{0x7f}, ber.Length(127))
+	require.Equal(t, []byte{0x81, 0xc8}, ber.Length(200))
+	require.Equal(t, []byte{0x82, 0x01, 0x2c}, ber.Length(300))
+}
+
+func TestTLV(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "3006040161040162", hex.EncodeToString(ber.TLV(0x30, ber.TLV(0x04, []byte("a")), ber.TLV(0x04, []byte("b")))))
+	require.Equal(t, "0400", hex.EncodeToString(ber.TLV(0x04)))
+
+	long := ber.TLV(0x04, make([]byte, 200))
+	require.Equal(t, []byte{0x04, 0x81, 0xc8}, long[:3])
+	require.Len(t, long, 203)
+}
@@ -0,0 +1,16 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// PowerAction sends an AMT power action (e.g. 2 power on, 8 power off, 10 reset) to the device identified by guid.
+func (c *Client) PowerAction(ctx context.Context, guid string, action int) (PowerActionResponse, error) {
+	var resp PowerActionResponse
+
+	err := c.do(ctx, http.MethodPost, _apiPrefix+"/amt/power/action/"+url.PathEscape(guid), nil, PowerAction{Action: action}, &resp)
+
+	return resp, err
+}
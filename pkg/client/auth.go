@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+type loginResponse struct {
+	Token string `json:"token"`
+}
+
+// Login exchanges basic credentials for a JWT and uses it for all following requests.
+// Consoles configured for OAuth do not issue tokens; pass the identity provider token with the Token option instead.
+func (c *Client) Login(ctx context.Context, username, password string) error {
+	var resp loginResponse
+
+	if err := c.do(ctx, http.MethodPost, _apiPrefix+"/authorize", nil, Credentials{Username: username, Password: password}, &resp); err != nil {
+		return err
+	}
+
+	c.SetToken(resp.Token)
+
+	return nil
+}
+
+// Token returns the bearer token currently used by the client.
+func (c *Client) Token() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.token
+}
+
+// SetToken replaces the bearer token used by the client.
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token = token
+}
@@ -0,0 +1,145 @@
+// Package client is a Go SDK for the console REST API.
+//
+// It wraps the v1 endpoints described by the console OpenAPI spec
+// (GET /api/openapi.json?version=v1) with typed resource collections and adds
+// authentication, pagination iterators and retries for idempotent requests.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	_defaultTimeout = 30 * time.Second
+	_apiPrefix      = "/api/v1"
+)
+
+// ErrInvalidBaseURL is returned by New when the base URL cannot be used.
+var ErrInvalidBaseURL = errors.New("client: invalid base URL")
+
+// Client talks to a single console instance.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	retry      RetryPolicy
+
+	mu    sync.RWMutex
+	token string
+
+	Devices          *Collection[Device]
+	Domains          *Collection[Domain]
+	Profiles         *Collection[Profile]
+	CIRAConfigs      *Collection[CIRAConfig]
+	WirelessConfigs  *Collection[WirelessConfig]
+	IEEE8021xConfigs *Collection[IEEE8021xConfig]
+}
+
+// New creates a client for the console at baseURL, e.g. "https://console.example.com".
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidBaseURL, baseURL)
+	}
+
+	c := &Client{
+		baseURL:    u,
+		httpClient: &http.Client{Timeout: _defaultTimeout},
+		retry:      DefaultRetryPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.Devices = &Collection[Device]{c: c, path: _apiPrefix + "/devices"}
+	c.Domains = &Collection[Domain]{c: c, path: _apiPrefix + "/admin/domains"}
+	c.Profiles = &Collection[Profile]{c: c, path: _apiPrefix + "/admin/profiles"}
+	c.CIRAConfigs = &Collection[CIRAConfig]{c: c, path: _apiPrefix + "/admin/ciraconfigs"}
+	c.WirelessConfigs = &Collection[WirelessConfig]{c: c, path: _apiPrefix + "/admin/wirelessconfigs"}
+	c.IEEE8021xConfigs = &Collection[IEEE8021xConfig]{c: c, path: _apiPrefix + "/admin/ieee8021xconfigs"}
+
+	return c, nil
+}
+
+// do sends a request and decodes a JSON response into out when out is not nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	var body []byte
+
+	if in != nil {
+		var err error
+
+		body, err = json.Marshal(in)
+		if err != nil {
+			return err
+		}
+	}
+
+	u := c.baseURL.JoinPath(path)
+	u.RawQuery = query.Encode()
+
+	resp, err := c.send(ctx, method, u.String(), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return newAPIError(resp)
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		_, _ = io.Copy(io.Discard, resp.Body)
+
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// send performs the request, retrying according to the client's retry policy.
+func (c *Client) send(ctx context.Context, method, rawURL string, body []byte) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, rawURL, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Accept", "application/json")
+
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		if token := c.Token(); token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err := c.httpClient.Do(req)
+
+		wait, retry := c.retry.next(attempt, method, resp, err)
+		if !retry {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
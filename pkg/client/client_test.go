@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fastRetry = RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+func TestNewInvalidBaseURL(t *testing.T) {
+	t.Parallel()
+
+	_, err := New("not a url")
+	require.ErrorIs(t, err, ErrInvalidBaseURL)
+}
+
+func TestLoginSetsBearerToken(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/authorize":
+			var creds Credentials
+
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&creds))
+			assert.Equal(t, "admin", creds.Username)
+
+			_, _ = w.Write([]byte(`{"token":"abc"}`))
+		case "/api/v1/devices/guid-1":
+			assert.Equal(t, "Bearer abc", r.Header.Get("Authorization"))
+
+			_, _ = w.Write([]byte(`{"guid":"guid-1","hostname":"host"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, c.Login(context.Background(), "admin", "secret"))
+	assert.Equal(t, "abc", c.Token())
+
+	device, err := c.Devices.Get(context.Background(), "guid-1")
+	require.NoError(t, err)
+	assert.Equal(t, "host", device.Hostname)
+}
+
+func TestCollectionAllPaginates(t *testing.T) {
+	t.Parallel()
+
+	const total = 5
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/admin/domains", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("$count"))
+
+		top, _ := strconv.Atoi(r.URL.Query().Get("$top"))
+		skip, _ := strconv.Atoi(r.URL.Query().Get("$skip"))
+
+		page := Page[Domain]{TotalCount: total}
+		for i := skip; i < total && i < skip+top; i++ {
+			page.Items = append(page.Items, Domain{ProfileName: "domain" + strconv.Itoa(i)})
+		}
+
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(srv.URL)
+	require.NoError(t, err)
+
+	names := []string{}
+
+	for domain, err := range c.Domains.All(context.Background(), 2) {
+		require.NoError(t, err)
+
+		names = append(names, domain.ProfileName)
+	}
+
+	assert.Equal(t, []string{"domain0", "domain1", "domain2", "domain3", "domain4"}, names)
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		call          func(c *Client) error
+		expectedCalls int32
+		expectSuccess bool
+	}{
+		{
+			name: "idempotent request is retried",
+			call: func(c *Client) error {
+				_, err := c.Profiles.Get(context.Background(), "p1")
+
+				return err
+			},
+			expectedCalls: 2,
+			expectSuccess: true,
+		},
+		{
+			name: "non-idempotent request is not retried",
+			call: func(c *Client) error {
+				_, err := c.Profiles.Create(context.Background(), Profile{})
+
+				return err
+			},
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if calls.Add(1) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+
+					return
+				}
+
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			t.Cleanup(srv.Close)
+
+			c, err := New(srv.URL, Retry(fastRetry))
+			require.NoError(t, err)
+
+			err = tc.call(c)
+			assert.Equal(t, tc.expectedCalls, calls.Load())
+
+			if tc.expectSuccess {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var apiErr *APIError
+
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+		})
+	}
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{name: "v1 error", body: `{"error":"not found","message":"device not found"}`, expected: "device not found"},
+		{name: "v2 problem", body: `{"error":{"type":"about:blank","title":"Not Found","status":404,"detail":"no such device"}}`, expected: "no such device"},
+		{name: "not json", body: `oops`, expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			t.Cleanup(srv.Close)
+
+			c, err := New(srv.URL, Retry(NoRetry))
+			require.NoError(t, err)
+
+			err = c.Devices.Delete(context.Background(), "guid")
+
+			var apiErr *APIError
+
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+			assert.Equal(t, tc.expected, apiErr.Message)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is the page size used by Collection.All.
+const DefaultPageSize = 100
+
+// Page is a single page of a collection.
+type Page[T any] struct {
+	Items      []T `json:"data"`
+	TotalCount int `json:"totalCount"`
+}
+
+// Collection exposes the CRUD endpoints of one console resource.
+type Collection[T any] struct {
+	c    *Client
+	path string
+}
+
+// List returns one page of the collection.
+func (col *Collection[T]) List(ctx context.Context, top, skip int) (Page[T], error) {
+	query := url.Values{}
+	query.Set("$top", strconv.Itoa(top))
+	query.Set("$skip", strconv.Itoa(skip))
+	query.Set("$count", "true")
+
+	var page Page[T]
+
+	err := col.c.do(ctx, http.MethodGet, col.path, query, nil, &page)
+
+	return page, err
+}
+
+// All iterates over every item of the collection, fetching pages of pageSize items as needed.
+// Iteration stops at the first error, which is yielded with a zero item.
+func (col *Collection[T]) All(ctx context.Context, pageSize int) iter.Seq2[T, error] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	return func(yield func(T, error) bool) {
+		for skip := 0; ; {
+			page, err := col.List(ctx, pageSize, skip)
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			skip += len(page.Items)
+			if len(page.Items) < pageSize || skip >= page.TotalCount {
+				return
+			}
+		}
+	}
+}
+
+// Get returns the item identified by id (a device GUID or a configuration name).
+func (col *Collection[T]) Get(ctx context.Context, id string) (T, error) {
+	var item T
+
+	err := col.c.do(ctx, http.MethodGet, col.path+"/"+url.PathEscape(id), nil, nil, &item)
+
+	return item, err
+}
+
+// Create inserts item and returns it as stored by the console.
+func (col *Collection[T]) Create(ctx context.Context, item T) (T, error) {
+	var created T
+
+	err := col.c.do(ctx, http.MethodPost, col.path, nil, item, &created)
+
+	return created, err
+}
+
+// Update replaces item and returns it as stored by the console.
+func (col *Collection[T]) Update(ctx context.Context, item T) (T, error) {
+	var updated T
+
+	err := col.c.do(ctx, http.MethodPatch, col.path, nil, item, &updated)
+
+	return updated, err
+}
+
+// Delete removes the item identified by id.
+func (col *Collection[T]) Delete(ctx context.Context, id string) error {
+	return col.c.do(ctx, http.MethodDelete, col.path+"/"+url.PathEscape(id), nil, nil, nil)
+}
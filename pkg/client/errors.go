@@ -0,0 +1,66 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const _maxErrorBody = 64 << 10
+
+// APIError is returned for responses with a 4xx or 5xx status.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("console: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	return fmt.Sprintf("console: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// errorBody covers the v1 {error, message} shape and the v2 problem details envelope.
+type errorBody struct {
+	Error   json.RawMessage `json:"error"`
+	Message string          `json:"message"`
+}
+
+type problemBody struct {
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+func newAPIError(resp *http.Response) error {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, _maxErrorBody))
+	if err != nil {
+		return apiErr
+	}
+
+	var body errorBody
+	if json.Unmarshal(data, &body) != nil {
+		return apiErr
+	}
+
+	apiErr.Message = body.Message
+
+	var text string
+	if json.Unmarshal(body.Error, &text) == nil && apiErr.Message == "" {
+		apiErr.Message = text
+	}
+
+	var problem problemBody
+	if json.Unmarshal(body.Error, &problem) == nil {
+		apiErr.Message = problem.Detail
+		if apiErr.Message == "" {
+			apiErr.Message = problem.Title
+		}
+	}
+
+	return apiErr
+}
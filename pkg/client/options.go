@@ -0,0 +1,27 @@
+package client
+
+import "net/http"
+
+// Option -.
+type Option func(*Client)
+
+// HTTPClient replaces the underlying HTTP client, e.g. to configure TLS.
+func HTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// Token authenticates requests with an existing bearer token instead of calling Login.
+func Token(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// Retry sets the retry policy for idempotent requests.
+func Retry(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how idempotent requests are retried after transient failures.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry; it doubles on every further retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between attempts.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy retries idempotent requests up to three times in total.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+// NoRetry disables retries.
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// next reports whether the attempt should be retried and how long to wait first.
+// Only idempotent methods are retried, on network errors, 429 and 502-504 responses.
+func (p RetryPolicy) next(attempt int, method string, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || !idempotent(method) {
+		return 0, false
+	}
+
+	if err == nil && !retryableStatus(resp.StatusCode) {
+		return 0, false
+	}
+
+	wait := p.InitialBackoff << (attempt - 1)
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+
+	// honour Retry-After (seconds) when the server asks us to slow down
+	if resp != nil {
+		if seconds, convErr := strconv.Atoi(resp.Header.Get("Retry-After")); convErr == nil && seconds >= 0 {
+			wait = time.Duration(seconds) * time.Second
+		}
+	}
+
+	return wait, true
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+func retryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
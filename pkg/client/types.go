@@ -0,0 +1,201 @@
+package client
+
+import "time"
+
+// The resource types below mirror the JSON of the console's API models, which live in internal packages
+// outside modules cannot import. TestTypesMatchAPI keeps their fields in step with the server.
+
+// Credentials are a username and password.
+type Credentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// Device is a device known to the console.
+type Device struct {
+	ConnectionStatus  bool                `json:"connectionStatus"`
+	MPSInstance       string              `json:"mpsInstance"`
+	Hostname          string              `json:"hostname"`
+	GUID              string              `json:"guid"`
+	MPSUsername       string              `json:"mpsusername"`
+	Tags              []string            `json:"tags"`
+	TenantID          string              `json:"tenantId"`
+	FriendlyName      string              `json:"friendlyName"`
+	Location          string              `json:"location"`
+	Notes             string              `json:"notes"`
+	Owner             string              `json:"owner"`
+	Assignee          string              `json:"assignee"`
+	Attributes        map[string]string   `json:"attributes,omitempty"`
+	DNSSuffix         string              `json:"dnsSuffix"`
+	LastConnected     *time.Time          `json:"lastConnected,omitempty"`
+	LastSeen          *time.Time          `json:"lastSeen,omitempty"`
+	LastDisconnected  *time.Time          `json:"lastDisconnected,omitempty"`
+	DeviceInfo        *DeviceInfo         `json:"deviceInfo,omitempty"`
+	Username          string              `json:"username"`
+	Password          string              `json:"password"`
+	MPSPassword       string              `json:"mpspassword"`
+	MEBXPassword      string              `json:"mebxpassword"`
+	UseTLS            bool                `json:"useTLS"`
+	AllowSelfSigned   bool                `json:"allowSelfSigned"`
+	CertHash          string              `json:"certHash"`
+	ControlMode       string              `json:"controlMode"`
+	ProvisioningState string              `json:"provisioningState"`
+	Correlations      []DeviceCorrelation `json:"correlations,omitempty"`
+}
+
+// DeviceInfo is the firmware and network information a device reported when it connected.
+type DeviceInfo struct {
+	FWVersion   string    `json:"fwVersion"`
+	FWBuild     string    `json:"fwBuild"`
+	FWSku       string    `json:"fwSku"`
+	CurrentMode string    `json:"currentMode"`
+	Features    string    `json:"features"`
+	IPAddress   string    `json:"ipAddress"`
+	LastUpdated time.Time `json:"lastUpdated"`
+}
+
+// DeviceCorrelation links a device to its record in an external device management system.
+type DeviceCorrelation struct {
+	Source          string     `json:"source"`
+	ExternalID      string     `json:"externalId"`
+	DeviceName      string     `json:"deviceName"`
+	SerialNumber    string     `json:"serialNumber"`
+	MatchedBy       string     `json:"matchedBy"`
+	ComplianceState string     `json:"complianceState"`
+	ManagementState string     `json:"managementState"`
+	LastSync        *time.Time `json:"lastSync,omitempty"`
+	ImportedAt      time.Time  `json:"importedAt"`
+}
+
+// Domain is a domain profile, holding the provisioning certificate of a DNS suffix.
+type Domain struct {
+	ProfileName                   string            `json:"profileName"`
+	DomainSuffix                  string            `json:"domainSuffix"`
+	ProvisioningCert              string            `json:"provisioningCert,omitempty"`
+	ProvisioningCertStorageFormat string            `json:"provisioningCertStorageFormat"`
+	ProvisioningCertPassword      string            `json:"provisioningCertPassword,omitempty"`
+	ExpirationDate                time.Time         `json:"expirationDate,omitempty"`
+	TenantID                      string            `json:"tenantId"`
+	Version                       string            `json:"version,omitempty"`
+	Revocation                    *RevocationStatus `json:"revocation,omitempty"`
+}
+
+// RevocationStatus is the revocation status of a provisioning certificate.
+type RevocationStatus struct {
+	Status    string     `json:"status"`
+	Source    string     `json:"source,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	CheckedAt time.Time  `json:"checkedAt"`
+}
+
+// Profile is an activation profile.
+type Profile struct {
+	ProfileName                string               `json:"profileName,omitempty"`
+	AMTPassword                string               `json:"amtPassword,omitempty"`
+	CreationDate               string               `json:"creationDate,omitempty"`
+	CreatedBy                  string               `json:"created_by,omitempty"`
+	GenerateRandomPassword     bool                 `json:"generateRandomPassword"`
+	CIRAConfigName             *string              `json:"ciraConfigName,omitempty"`
+	Activation                 string               `json:"activation"`
+	MEBXPassword               string               `json:"mebxPassword,omitempty"`
+	GenerateRandomMEBxPassword bool                 `json:"generateRandomMEBxPassword"`
+	CIRAConfigObject           *CIRAConfig          `json:"ciraConfigObject,omitempty"`
+	Tags                       []string             `json:"tags,omitempty"`
+	DHCPEnabled                bool                 `json:"dhcpEnabled"`
+	IPSyncEnabled              bool                 `json:"ipSyncEnabled"`
+	LocalWiFiSyncEnabled       bool                 `json:"localWifiSyncEnabled"`
+	WiFiConfigs                []ProfileWiFiConfigs `json:"wifiConfigs,omitempty"`
+	TenantID                   string               `json:"tenantId"`
+	TLSMode                    int                  `json:"tlsMode,omitempty"`
+	TLSCerts                   *TLSCerts            `json:"tlsCerts,omitempty"`
+	TLSSigningAuthority        string               `json:"tlsSigningAuthority,omitempty"`
+	TLSTrustedCNs              []string             `json:"tlsTrustedCNs,omitempty"`
+	UserConsent                string               `json:"userConsent,omitempty"`
+	IDEREnabled                bool                 `json:"iderEnabled"`
+	KVMEnabled                 bool                 `json:"kvmEnabled"`
+	SOLEnabled                 bool                 `json:"solEnabled"`
+	IEEE8021xProfileName       *string              `json:"ieee8021xProfileName,omitempty"`
+	IEEE8021xProfile           *IEEE8021xConfig     `json:"ieee8021xProfile,omitempty"`
+	Version                    string               `json:"version,omitempty"`
+	UEFIWiFiSyncEnabled        bool                 `json:"uefiWifiSyncEnabled"`
+}
+
+// ProfileWiFiConfigs assigns a wireless profile to an activation profile with its priority.
+type ProfileWiFiConfigs struct {
+	Priority            int    `json:"priority,omitempty"`
+	WirelessProfileName string `json:"profileName"`
+	ProfileName         string `json:"profileProfileName"`
+	TenantID            string `json:"tenantId"`
+}
+
+// TLSCerts are the root and issued certificates of a profile using TLS.
+type TLSCerts struct {
+	RootCertificate   CertCreationResult `json:"rootCertificate"`
+	IssuedCertificate CertCreationResult `json:"issuedCertificate"`
+	Version           string             `json:"version"`
+}
+
+// CertCreationResult is a certificate the console created and its private key.
+type CertCreationResult struct {
+	H             string `json:"h:"`
+	Cert          string `json:"cert"`
+	PEM           string `json:"pem"`
+	CertBin       string `json:"certBin"`
+	PrivateKey    string `json:"privateKey"`
+	PrivateKeyBin string `json:"privateKeyBin"`
+	Checked       bool   `json:"checked"`
+	Key           []byte `json:"key"`
+}
+
+// CIRAConfig is the configuration of the connection of a device to the MPS.
+type CIRAConfig struct {
+	ConfigName             string `json:"configName"`
+	MPSAddress             string `json:"mpsServerAddress"`
+	MPSPort                int    `json:"mpsPort"`
+	Username               string `json:"username"`
+	Password               string `json:"password,omitempty"`
+	CommonName             string `json:"commonName"`
+	ServerAddressFormat    int    `json:"serverAddressFormat"` // 3 = IPV4, 4= IPV6, 201 = FQDN
+	AuthMethod             int    `json:"authMethod"`          // 1 = Mutal Auth, 2 = Username and Password
+	MPSRootCertificate     string `json:"mpsRootCertificate"`
+	ProxyDetails           string `json:"proxyDetails"`
+	TenantID               string `json:"tenantId"`
+	GenerateRandomPassword bool   `json:"generateRandomPassword"`
+	Version                string `json:"version,omitempty"`
+}
+
+// WirelessConfig is a wireless profile.
+type WirelessConfig struct {
+	ProfileName            string           `json:"profileName,omitempty"`
+	AuthenticationMethod   int              `json:"authenticationMethod"`
+	EncryptionMethod       int              `json:"encryptionMethod"`
+	SSID                   string           `json:"ssid"`
+	PSKValue               int              `json:"pskValue"`
+	PSKPassphrase          string           `json:"pskPassphrase,omitempty"`
+	LinkPolicy             []int            `json:"linkPolicy"`
+	TenantID               string           `json:"tenantId"`
+	IEEE8021xProfileName   *string          `json:"ieee8021xProfileName,omitempty"`
+	IEEE8021xProfileObject *IEEE8021xConfig `json:"ieee8021xProfileObject,omitempty"`
+	Version                string           `json:"version"`
+}
+
+// IEEE8021xConfig is an IEEE 802.1x profile.
+type IEEE8021xConfig struct {
+	ProfileName            string `json:"profileName"`
+	AuthenticationProtocol int    `json:"authenticationProtocol"`
+	PXETimeout             *int   `json:"pxeTimeout"`
+	WiredInterface         bool   `json:"wiredInterface"`
+	TenantID               string `json:"tenantId"`
+	Version                string `json:"version,omitempty"`
+}
+
+// PowerAction is the AMT power action sent to a device.
+type PowerAction struct {
+	Action int `json:"action"`
+}
+
+// PowerActionResponse is the answer of a device to a power action.
+type PowerActionResponse struct {
+	ReturnValue int `json:"ReturnValue"` // Return code. 0 indicates success
+}
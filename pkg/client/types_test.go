@@ -0,0 +1,64 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
+)
+
+// jsonShape describes the JSON of a type: its kind, and the shape of each field of a struct by its json tag.
+func jsonShape(t reflect.Type) interface{} {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return "bytes"
+		}
+
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || t == reflect.TypeFor[time.Time]() {
+		return t.Kind().String()
+	}
+
+	fields := map[string]interface{}{}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		fields[field.Tag.Get("json")] = jsonShape(field.Type)
+	}
+
+	return fields
+}
+
+func TestTypesMatchAPI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		client interface{}
+		api    interface{}
+	}{
+		{Credentials{}, dto.Credentials{}},
+		{Device{}, dto.Device{}},
+		{Domain{}, dto.Domain{}},
+		{Profile{}, dto.Profile{}},
+		{CIRAConfig{}, dto.CIRAConfig{}},
+		{WirelessConfig{}, dto.WirelessConfig{}},
+		{IEEE8021xConfig{}, dto.IEEE8021xConfig{}},
+		{PowerAction{}, dto.PowerAction{}},
+		{PowerActionResponse{}, dto.PowerActionResponse{}},
+	}
+
+	for _, tc := range tests {
+		name := reflect.TypeOf(tc.client).Name()
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, jsonShape(reflect.TypeOf(tc.api)), jsonShape(reflect.TypeOf(tc.client)))
+		})
+	}
+}
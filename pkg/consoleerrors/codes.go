@@ -0,0 +1,129 @@
+package consoleerrors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+)
+
+// Code is the machine-readable code of an error, returned in every error body of the API next to its
+// message. Clients match the code rather than the message, which may be translated or reworded.
+type Code string
+
+// The error codes of the API.
+const (
+	// CodeInternal is an unexpected error of the console.
+	CodeInternal Code = "INTERNAL_ERROR"
+	// CodeValidation is a request with missing or invalid fields.
+	CodeValidation Code = "VALIDATION_FAILED"
+	// CodeNotFound is a resource that does not exist, or does not exist for the tenant.
+	CodeNotFound Code = "NOT_FOUND"
+	// CodeDeviceNotFound is a device that does not exist, or does not exist for the tenant.
+	CodeDeviceNotFound Code = "DEVICE_NOT_FOUND"
+	// CodeAlreadyExists is a resource whose name or key is taken.
+	CodeAlreadyExists Code = "ALREADY_EXISTS"
+	// CodeInUse is a resource referenced by others, or a reference to a resource that does not exist.
+	CodeInUse Code = "RESOURCE_IN_USE"
+	// CodeDatabase is a failed database query.
+	CodeDatabase Code = "DATABASE_ERROR"
+	// CodeAMTError is a WS-Man call the device answered with a fault.
+	CodeAMTError Code = "AMT_ERROR"
+	// CodeAMTAuthFailed is a device rejecting the stored credentials.
+	CodeAMTAuthFailed Code = "AMT_AUTH_FAILED"
+	// CodeCIRANotConnected is a device managed over CIRA that has no open connection.
+	CodeCIRANotConnected Code = "CIRA_NOT_CONNECTED"
+	// CodeDeviceUnreachable is a device that could not be connected to or did not answer in time.
+	CodeDeviceUnreachable Code = "DEVICE_UNREACHABLE"
+	// CodeNotSupported is an operation the device or its AMT version does not support.
+	CodeNotSupported Code = "NOT_SUPPORTED"
+	// CodeDeviceLocked is a device another holder has locked.
+	CodeDeviceLocked Code = "DEVICE_LOCKED"
+	// CodeCircuitOpen is a device whose calls are suspended after failing repeatedly.
+	CodeCircuitOpen Code = "CIRCUIT_OPEN"
+	// CodeQueueFull is a job refused while too many jobs wait to run.
+	CodeQueueFull Code = "QUEUE_FULL"
+	// CodeCertExpired is a certificate that has expired.
+	CodeCertExpired Code = "CERT_EXPIRED"
+	// CodeCertPassword is a certificate that cannot be decrypted with the given password.
+	CodeCertPassword Code = "CERT_PASSWORD_INVALID"
+	// CodeCertRevoked is a certificate its issuer has revoked.
+	CodeCertRevoked Code = "CERT_REVOKED"
+	// CodeUnauthorized is a request without valid credentials or access token.
+	CodeUnauthorized Code = "UNAUTHORIZED"
+	// CodeRateLimited is a request over the rate limits.
+	CodeRateLimited Code = "RATE_LIMITED"
+	// CodeReadOnly is a request changing data while the console is in read-only mode.
+	CodeReadOnly Code = "READ_ONLY"
+	// CodeBodyTooLarge is a request body over the size limit of its route.
+	CodeBodyTooLarge Code = "BODY_TOO_LARGE"
+	// CodeRequestTimeout is a request cancelled after the timeout of its route.
+	CodeRequestTimeout Code = "REQUEST_TIMEOUT"
+	// CodeIdempotencyConflict is an Idempotency-Key that is invalid, reused or still in progress.
+	CodeIdempotencyConflict Code = "IDEMPOTENCY_CONFLICT"
+	// CodeForbidden is a request of a user without the rights it needs.
+	CodeForbidden Code = "FORBIDDEN"
+	// CodeCredentialCheckedOut is the password of a device checked out by another user.
+	CodeCredentialCheckedOut Code = "CREDENTIAL_CHECKED_OUT"
+)
+
+// Coder is implemented by the errors that know their code.
+type Coder interface {
+	ErrorCode() Code
+}
+
+// CodeOf returns the code of err: the one of the first error of its chain implementing Coder, else
+// CodeRequestTimeout for a request cancelled after its timeout, CodeDeviceUnreachable for network errors,
+// CodeAMTAuthFailed for a device answering 401 and CodeInternal for anything else. It returns "" for a nil error.
+func CodeOf(err error) Code {
+	if err == nil {
+		return ""
+	}
+
+	var coder Coder
+	if errors.As(err, &coder) {
+		return coder.ErrorCode()
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return CodeRequestTimeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return CodeDeviceUnreachable
+	}
+
+	// the WS-Man client reports the status of the device in its message only
+	if strings.Contains(err.Error(), "401 Unauthorized") {
+		return CodeAMTAuthFailed
+	}
+
+	return CodeInternal
+}
+
+// codedError is an error of a fixed message and code.
+type codedError struct {
+	code    Code
+	message string
+}
+
+// New returns an error with message carrying code.
+func New(code Code, message string) error {
+	return codedError{code: code, message: message}
+}
+
+func (e codedError) Error() string {
+	return e.message
+}
+
+func (e codedError) ErrorCode() Code {
+	return e.code
+}
+
+// WithCode returns the error with code, overriding the code of the error type wrapping it.
+func (e InternalError) WithCode(code Code) InternalError {
+	e.Code = code
+
+	return e
+}
@@ -0,0 +1,41 @@
+package consoleerrors_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+var errPlain = errors.New("plain")
+
+func TestCodeOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		code consoleerrors.Code
+	}{
+		{name: "nil", err: nil, code: ""},
+		{name: "coded", err: consoleerrors.New(consoleerrors.CodeCIRANotConnected, "not connected"), code: consoleerrors.CodeCIRANotConnected},
+		{name: "wrapped coded", err: fmt.Errorf("call: %w", consoleerrors.New(consoleerrors.CodeQueueFull, "full")), code: consoleerrors.CodeQueueFull},
+		{name: "deadline", err: fmt.Errorf("call: %w", context.DeadlineExceeded), code: consoleerrors.CodeRequestTimeout},
+		{name: "network", err: &net.OpError{Op: "dial", Err: errPlain}, code: consoleerrors.CodeDeviceUnreachable},
+		{name: "device answering 401", err: errors.New("wsman.Client.Post: 401 Unauthorized"), code: consoleerrors.CodeAMTAuthFailed}, //nolint:err113 // message of the WS-Man client
+		{name: "other", err: errPlain, code: consoleerrors.CodeInternal},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.code, consoleerrors.CodeOf(tc.err))
+		})
+	}
+}
@@ -11,6 +11,8 @@ type InternalError struct {
 	Message       string
 	InnerTrace    string
 	OriginalError error
+	// Code overrides the code of the error type, e.g. to tell the devices apart from other resources not found.
+	Code Code
 }
 
 func (e InternalError) Error() string {
@@ -0,0 +1,144 @@
+// Package eventbus passes events published by the use cases to the notification channels
+// subscribed to them, so that a channel does not need hooks in every use case it reports on.
+package eventbus
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// subscriberBuffer is the number of events queued per subscriber, events published while
+// the queue of a subscriber is full are dropped for that subscriber.
+const subscriberBuffer = 256
+
+// Topic names the events of a payload type.
+type Topic[T any] struct {
+	name string
+}
+
+// NewTopic creates a topic carrying payloads of type T.
+func NewTopic[T any](name string) Topic[T] {
+	return Topic[T]{name: name}
+}
+
+func (t Topic[T]) Name() string {
+	return t.name
+}
+
+// Event is a published payload as it is passed to the subscribers of all topics.
+type Event struct {
+	Topic   string    `json:"topic"`
+	Time    time.Time `json:"time"`
+	Payload any       `json:"payload"`
+}
+
+type subscriber struct {
+	topic  string // empty for subscribers of all topics
+	events chan Event
+}
+
+// Bus delivers every event to each subscriber in the order it was published. Subscribers are
+// called from their own goroutine, a slow subscriber delays only its own events.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+	closed      bool
+	dropped     atomic.Int64
+}
+
+// New creates an empty bus.
+func New() *Bus {
+	return &Bus{subscribers: map[*subscriber]struct{}{}}
+}
+
+// Publish passes payload to the subscribers of topic. Publishing on a nil or closed bus does nothing.
+func Publish[T any](b *Bus, topic Topic[T], payload T) {
+	if b == nil {
+		return
+	}
+
+	b.publish(Event{Topic: topic.name, Time: time.Now().UTC(), Payload: payload})
+}
+
+// Subscribe calls handle with the payload of every event of topic until unsubscribe is called.
+// Subscribing to a nil bus does nothing.
+func Subscribe[T any](b *Bus, topic Topic[T], handle func(T)) (unsubscribe func()) {
+	return b.subscribe(topic.name, func(e Event) {
+		if payload, ok := e.Payload.(T); ok {
+			handle(payload)
+		}
+	})
+}
+
+// SubscribeAll calls handle with the events of every topic until unsubscribe is called.
+func (b *Bus) SubscribeAll(handle func(Event)) (unsubscribe func()) {
+	return b.subscribe("", handle)
+}
+
+// Dropped returns the number of events not delivered because a subscriber fell behind.
+func (b *Bus) Dropped() int64 {
+	return b.dropped.Load()
+}
+
+// Close ends all subscriptions once their queued events are handled, later events are discarded.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+
+	for s := range b.subscribers {
+		close(s.events)
+		delete(b.subscribers, s)
+	}
+}
+
+func (b *Bus) publish(e Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.subscribers {
+		if s.topic != "" && s.topic != e.Topic {
+			continue
+		}
+
+		select {
+		case s.events <- e:
+		default:
+			b.dropped.Add(1)
+		}
+	}
+}
+
+func (b *Bus) subscribe(topic string, handle func(Event)) func() {
+	if b == nil {
+		return func() {}
+	}
+
+	s := &subscriber{topic: topic, events: make(chan Event, subscriberBuffer)}
+
+	b.mu.Lock()
+	if b.closed {
+		close(s.events)
+	} else {
+		b.subscribers[s] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	go func() {
+		for e := range s.events {
+			handle(e)
+		}
+	}()
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if _, ok := b.subscribers[s]; ok {
+			close(s.events)
+			delete(b.subscribers, s)
+		}
+	}
+}
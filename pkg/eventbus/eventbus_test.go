@@ -0,0 +1,134 @@
+package eventbus_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/eventbus"
+)
+
+// receive waits for the next value of ch.
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second):
+		require.FailNow(t, "nothing received")
+	}
+
+	var zero T
+
+	return zero
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	defer bus.Close()
+
+	power := make(chan eventbus.DevicePowerChangedEvent, 4)
+	eventbus.Subscribe(bus, eventbus.DevicePowerChanged, func(e eventbus.DevicePowerChangedEvent) { power <- e })
+
+	all := make(chan eventbus.Event, 4)
+	bus.SubscribeAll(func(e eventbus.Event) { all <- e })
+
+	eventbus.Publish(bus, eventbus.DeviceConnected, eventbus.DeviceConnectedEvent{GUID: "guid1", Transport: "cira"})
+	eventbus.Publish(bus, eventbus.DevicePowerChanged, eventbus.DevicePowerChangedEvent{GUID: "guid1", Action: 2})
+	eventbus.Publish(bus, eventbus.DevicePowerChanged, eventbus.DevicePowerChangedEvent{GUID: "guid1", Action: 8})
+
+	require.Equal(t, 2, receive(t, power).Action)
+	require.Equal(t, 8, receive(t, power).Action)
+
+	connected := receive(t, all)
+	require.Equal(t, "device.connected", connected.Topic)
+	require.Equal(t, eventbus.DeviceConnectedEvent{GUID: "guid1", Transport: "cira"}, connected.Payload)
+	require.False(t, connected.Time.IsZero())
+	require.Equal(t, "device.power.changed", receive(t, all).Topic)
+}
+
+func TestUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	defer bus.Close()
+
+	sessions := make(chan eventbus.SessionCreatedEvent, 4)
+	unsubscribe := eventbus.Subscribe(bus, eventbus.SessionCreated, func(e eventbus.SessionCreatedEvent) { sessions <- e })
+
+	eventbus.Publish(bus, eventbus.SessionCreated, eventbus.SessionCreatedEvent{Username: "admin"})
+	require.Equal(t, "admin", receive(t, sessions).Username)
+
+	unsubscribe()
+	unsubscribe()
+
+	eventbus.Publish(bus, eventbus.SessionCreated, eventbus.SessionCreatedEvent{Username: "admin"})
+	require.Never(t, func() bool { return len(sessions) > 0 }, 50*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	defer bus.Close()
+
+	release := make(chan struct{})
+
+	var (
+		mu       sync.Mutex
+		received int
+	)
+
+	eventbus.Subscribe(bus, eventbus.DeviceConnected, func(eventbus.DeviceConnectedEvent) {
+		<-release
+		mu.Lock()
+		received++
+		mu.Unlock()
+	})
+
+	// one event is handled, the buffer holds 256, the rest is dropped
+	for range 300 {
+		eventbus.Publish(bus, eventbus.DeviceConnected, eventbus.DeviceConnectedEvent{GUID: "guid1"})
+	}
+
+	close(release)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return int64(received)+bus.Dropped() == 300
+	}, time.Second, 10*time.Millisecond)
+	require.Positive(t, bus.Dropped())
+}
+
+func TestNilAndClosedBus(t *testing.T) {
+	t.Parallel()
+
+	var bus *eventbus.Bus
+
+	eventbus.Publish(bus, eventbus.SessionCreated, eventbus.SessionCreatedEvent{Username: "admin"})
+	eventbus.Subscribe(bus, eventbus.SessionCreated, func(eventbus.SessionCreatedEvent) {})()
+
+	bus = eventbus.New()
+	bus.Close()
+
+	eventbus.Publish(bus, eventbus.SessionCreated, eventbus.SessionCreatedEvent{Username: "admin"})
+	eventbus.Subscribe(bus, eventbus.SessionCreated, func(eventbus.SessionCreatedEvent) {})()
+	require.Zero(t, bus.Dropped())
+}
+
+func TestCertExpiringEventExpired(t *testing.T) {
+	t.Parallel()
+
+	notAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := eventbus.CertExpiringEvent{NotAfter: notAfter}
+
+	require.False(t, e.Expired(notAfter.Add(-time.Second)))
+	require.True(t, e.Expired(notAfter))
+}
@@ -0,0 +1,107 @@
+package eventbus
+
+import "time"
+
+// Topics published by the console.
+var (
+	DeviceConnected      = NewTopic[DeviceConnectedEvent]("device.connected")
+	DevicePowerChanged   = NewTopic[DevicePowerChangedEvent]("device.power.changed")
+	DeviceAssigned       = NewTopic[DeviceAssignedEvent]("device.assigned")
+	CertExpiring         = NewTopic[CertExpiringEvent]("cert.expiring")
+	SessionCreated       = NewTopic[SessionCreatedEvent]("session.created")
+	JobUpdated           = NewTopic[JobUpdatedEvent]("job.updated")
+	RedirectionClosed    = NewTopic[RedirectionClosedEvent]("redirection.closed")
+	DeviceUpdated        = NewTopic[DeviceUpdatedEvent]("device.updated")
+	DeviceAddressChanged = NewTopic[DeviceAddressChangedEvent]("device.address.changed")
+	CredentialCheckedOut = NewTopic[CredentialCheckoutEvent]("credential.checkedout")
+	CredentialCheckedIn  = NewTopic[CredentialCheckoutEvent]("credential.checkedin")
+)
+
+// DeviceConnectedEvent is published when a device opens a CIRA connection and is authenticated.
+type DeviceConnectedEvent struct {
+	GUID      string `json:"guid"`
+	Transport string `json:"transport"`
+}
+
+// DevicePowerChangedEvent is published when a power action was accepted by a device.
+type DevicePowerChangedEvent struct {
+	GUID   string `json:"guid"`
+	Action int    `json:"action"`
+}
+
+// DeviceAssignedEvent is published when a device is assigned to a new assignee.
+type DeviceAssignedEvent struct {
+	GUID             string `json:"guid"`
+	Hostname         string `json:"hostname"`
+	Owner            string `json:"owner"`
+	Assignee         string `json:"assignee"`
+	PreviousAssignee string `json:"previousAssignee,omitempty"`
+}
+
+// CertExpiringEvent is published when the TLS certificate of a device is about to expire
+// and again once it has expired.
+type CertExpiringEvent struct {
+	GUID              string    `json:"guid"`
+	Hostname          string    `json:"hostname"`
+	CommonName        string    `json:"commonName"`
+	SHA256Fingerprint string    `json:"sha256Fingerprint"`
+	NotAfter          time.Time `json:"notAfter"`
+}
+
+// Expired reports whether the certificate was expired at t.
+func (e CertExpiringEvent) Expired(t time.Time) bool {
+	return !t.Before(e.NotAfter)
+}
+
+// SessionCreatedEvent is published when a user logs in to the console.
+type SessionCreatedEvent struct {
+	Username string `json:"username"`
+	Source   string `json:"source"`
+}
+
+// JobUpdatedEvent is published when a background job changes state or makes progress.
+type JobUpdatedEvent struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	TenantID  string `json:"tenantId"`
+	State     string `json:"state"`
+	Total     int    `json:"total"`
+	Succeeded int    `json:"succeeded"`
+	Failed    int    `json:"failed"`
+	Error     string `json:"error,omitempty"`
+}
+
+// RedirectionClosedEvent is published when the console closes a KVM or SOL session on its own.
+type RedirectionClosedEvent struct {
+	GUID        string `json:"guid"`
+	Mode        string `json:"mode"`
+	Reason      string `json:"reason"`
+	IdleSeconds int    `json:"idleSeconds"`
+}
+
+// DeviceUpdatedEvent is published when a device is added or its settings are replaced.
+type DeviceUpdatedEvent struct {
+	GUID     string `json:"guid"`
+	TenantID string `json:"tenantId"`
+	Hostname string `json:"hostname"`
+}
+
+// DeviceAddressChangedEvent is published when the hostname of a device or the addresses it resolves to change.
+// Addresses is empty when the hostname no longer resolves.
+type DeviceAddressChangedEvent struct {
+	GUID              string   `json:"guid"`
+	Hostname          string   `json:"hostname"`
+	PreviousHostname  string   `json:"previousHostname"`
+	Addresses         []string `json:"addresses"`
+	PreviousAddresses []string `json:"previousAddresses"`
+}
+
+// CredentialCheckoutEvent is published when the AMT password of a device is checked out, and when it is checked
+// in before it expires.
+type CredentialCheckoutEvent struct {
+	ID        string    `json:"id"`
+	GUID      string    `json:"guid"`
+	Holder    string    `json:"holder"`
+	Reason    string    `json:"reason"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
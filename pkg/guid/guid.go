@@ -0,0 +1,62 @@
+// Package guid parses the GUIDs identifying the devices into a single canonical form, so that the same machine is
+// found whatever the case, hyphenation or wrapping of the GUID it is given with. Some firmware reports the GUID with
+// the byte order of its first three fields swapped, Swapped gives that variant of a GUID to look for it too.
+package guid
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalid is returned for a string that is not a GUID.
+var ErrInvalid = errors.New("not a GUID")
+
+// Parse returns the canonical form of a GUID, lowercase 8-4-4-4-12 hexadecimal. The GUID may be given in any case,
+// with or without hyphens, in braces or as a urn:uuid: URN.
+func Parse(s string) (string, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return "", ErrInvalid
+	}
+
+	return id.String(), nil
+}
+
+// Normalize returns the canonical form of s when it is a GUID, s in lowercase otherwise.
+func Normalize(s string) string {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return strings.ToLower(s)
+	}
+
+	return id.String()
+}
+
+// Valid reports whether s is a GUID in any of the forms Parse takes.
+func Valid(s string) bool {
+	_, err := uuid.Parse(s)
+
+	return err == nil
+}
+
+// Swapped returns the canonical form of the GUID with the byte order of its first three fields reversed, as reported
+// by the firmware reading them as little-endian, empty when s is not a GUID or is its own swapped form.
+func Swapped(s string) string {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return ""
+	}
+
+	swapped := id
+	swapped[0], swapped[1], swapped[2], swapped[3] = id[3], id[2], id[1], id[0]
+	swapped[4], swapped[5] = id[5], id[4]
+	swapped[6], swapped[7] = id[7], id[6]
+
+	if swapped == id {
+		return ""
+	}
+
+	return swapped.String()
+}
@@ -0,0 +1,50 @@
+package guid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/guid"
+)
+
+const canonical = "aaf0c395-c2a2-992e-5655-48210b50d8c9"
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		canonical,
+		"AAF0C395-C2A2-992E-5655-48210B50D8C9",
+		"aaf0c395c2a2992e565548210b50d8c9",
+		"{AAF0C395-C2A2-992E-5655-48210B50D8C9}",
+		"urn:uuid:aaf0c395-c2a2-992e-5655-48210b50d8c9",
+	} {
+		got, err := guid.Parse(s)
+		require.NoError(t, err, s)
+		require.Equal(t, canonical, got, s)
+		require.True(t, guid.Valid(s), s)
+	}
+
+	for _, s := range []string{"", "guid", "aaf0c395-c2a2-992e-5655-48210b50d8c", "aaf0c395-c2a2-992e-5655-48210b50d8cz", " " + canonical} {
+		_, err := guid.Parse(s)
+		require.ErrorIs(t, err, guid.ErrInvalid, s)
+		require.False(t, guid.Valid(s), s)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, canonical, guid.Normalize("{AAF0C395-C2A2-992E-5655-48210B50D8C9}"))
+	require.Equal(t, "device-guid-123", guid.Normalize("Device-GUID-123"))
+}
+
+func TestSwapped(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "95c3f0aa-a2c2-2e99-5655-48210b50d8c9", guid.Swapped(canonical))
+	require.Equal(t, canonical, guid.Swapped(guid.Swapped("AAF0C395-C2A2-992E-5655-48210B50D8C9")))
+	require.Empty(t, guid.Swapped("guid"))
+	require.Empty(t, guid.Swapped("00000000-0000-0000-0000-000000000000"))
+}
@@ -2,6 +2,7 @@ package httpserver
 
 import (
 	"net"
+	"net/http"
 	"time"
 
 	appLogger "github.com/device-management-toolkit/console/pkg/logger"
@@ -47,6 +48,20 @@ func WriteTimeout(timeout time.Duration) Option {
 	}
 }
 
+// IdleTimeout closes the keep-alive connections idle for longer, 0 keeps them open until the read timeout.
+func IdleTimeout(timeout time.Duration) Option {
+	return func(s *Server) {
+		s.server.IdleTimeout = timeout
+	}
+}
+
+// HTTP2 limits the streams a client may open at once on an HTTP/2 connection, 0 keeps the default of 100.
+func HTTP2(maxConcurrentStreams int) Option {
+	return func(s *Server) {
+		s.server.HTTP2 = &http.HTTP2Config{MaxConcurrentStreams: maxConcurrentStreams}
+	}
+}
+
 // ShutdownTimeout -.
 func ShutdownTimeout(timeout time.Duration) Option {
 	return func(s *Server) {
@@ -48,6 +48,31 @@ func TestWriteTimeout(t *testing.T) {
 	assert.Equal(t, timeout, s.server.WriteTimeout, "WriteTimeout() should set the correct write timeout")
 }
 
+func TestIdleTimeout(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{server: &http.Server{
+		ReadHeaderTimeout: 1 * time.Second,
+	}}
+	timeout := 2 * time.Minute
+	opt := IdleTimeout(timeout)
+	opt(s)
+
+	assert.Equal(t, timeout, s.server.IdleTimeout, "IdleTimeout() should set the correct idle timeout")
+}
+
+func TestHTTP2(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{server: &http.Server{
+		ReadHeaderTimeout: 1 * time.Second,
+	}}
+	opt := HTTP2(250)
+	opt(s)
+
+	assert.Equal(t, 250, s.server.HTTP2.MaxConcurrentStreams, "HTTP2() should set the maximum of concurrent streams")
+}
+
 func TestShutdownTimeout(t *testing.T) {
 	t.Parallel()
 
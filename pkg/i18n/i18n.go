@@ -0,0 +1,157 @@
+// Package i18n localizes user-facing API messages.
+//
+// Translation catalogs are embedded JSON files (locales/<lang>.json) mapping
+// message keys to fmt format strings. The language of a request is negotiated
+// from its Accept-Language header; keys missing from a catalog fall back to
+// English and finally to the key itself.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+)
+
+// DefaultLanguage is used when no supported language matches the request.
+const DefaultLanguage = "en"
+
+const contextKey = "i18n.language"
+
+//go:embed locales/*.json
+var locales embed.FS
+
+var (
+	catalogs  = mustLoadCatalogs()
+	supported = supportedLanguages()
+	matcher   = newMatcher(supported)
+)
+
+func mustLoadCatalogs() map[string]map[string]string {
+	entries, err := locales.ReadDir("locales")
+	if err != nil {
+		panic(err)
+	}
+
+	loaded := make(map[string]map[string]string, len(entries))
+
+	for _, entry := range entries {
+		data, err := locales.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			panic(err)
+		}
+
+		messages := map[string]string{}
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Errorf("i18n: decoding %s: %w", entry.Name(), err))
+		}
+
+		loaded[strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))] = messages
+	}
+
+	return loaded
+}
+
+// supportedLanguages lists the catalog languages with the default language first,
+// which is what the matcher falls back to.
+func supportedLanguages() []string {
+	langs := []string{DefaultLanguage}
+
+	for lang := range catalogs {
+		if lang != DefaultLanguage {
+			langs = append(langs, lang)
+		}
+	}
+
+	sort.Strings(langs[1:])
+
+	return langs
+}
+
+func newMatcher(langs []string) language.Matcher {
+	tags := make([]language.Tag, 0, len(langs))
+	for _, lang := range langs {
+		tags = append(tags, language.Make(lang))
+	}
+
+	return language.NewMatcher(tags)
+}
+
+// Languages returns the languages that have a catalog, starting with DefaultLanguage.
+func Languages() []string {
+	return append([]string(nil), supported...)
+}
+
+// Negotiate returns the supported language that best matches an Accept-Language header value.
+func Negotiate(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLanguage
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLanguage
+	}
+
+	return supported[index]
+}
+
+// T returns the message for key in lang, formatted with args.
+func T(lang, key string, args ...any) string {
+	format, ok := catalogs[lang][key]
+	if !ok {
+		format, ok = catalogs[DefaultLanguage][key]
+	}
+
+	if !ok {
+		return key
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+
+	return fmt.Sprintf(format, args...)
+}
+
+// Catalog returns every message of lang, with English filling the gaps.
+func Catalog(lang string) map[string]string {
+	messages := make(map[string]string, len(catalogs[DefaultLanguage]))
+
+	for key, msg := range catalogs[DefaultLanguage] {
+		messages[key] = msg
+	}
+
+	for key, msg := range catalogs[lang] {
+		messages[key] = msg
+	}
+
+	return messages
+}
+
+// Middleware negotiates the request language once and advertises it in the Content-Language header.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := Negotiate(c.GetHeader("Accept-Language"))
+
+		c.Set(contextKey, lang)
+		c.Header("Content-Language", lang)
+		c.Next()
+	}
+}
+
+// Language returns the language negotiated for the request. Requests that did
+// not pass through Middleware are negotiated on the fly.
+func Language(c *gin.Context) string {
+	if lang := c.GetString(contextKey); lang != "" {
+		return lang
+	}
+
+	return Negotiate(c.GetHeader("Accept-Language"))
+}
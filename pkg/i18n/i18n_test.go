@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{header: "", expected: "en"},
+		{header: "es", expected: "es"},
+		{header: "de-CH,de;q=0.9", expected: "de"},
+		{header: "fr-FR,es;q=0.5", expected: "es"},
+		{header: "ja", expected: "en"},
+		{header: "not a header;;", expected: "en"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.header, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, Negotiate(tc.header))
+		})
+	}
+}
+
+func TestCatalogsHaveEnglishKeys(t *testing.T) {
+	t.Parallel()
+
+	for _, lang := range Languages() {
+		for key := range catalogs[lang] {
+			_, ok := catalogs[DefaultLanguage][key]
+			assert.True(t, ok, "%s has key %s missing from %s", lang, key, DefaultLanguage)
+		}
+	}
+}
+
+func TestT(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Apagar", T("es", "power.action.8"))
+	assert.Equal(t, "general error", T("xx", "error.general"))
+	assert.Equal(t, "no.such.key", T("de", "no.such.key"))
+	assert.Equal(t, "Name ist erforderlich", T("de", "validation.required", "Name", ""))
+}
+
+func TestValidationMessage(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Name  string `validate:"required"`
+		Count int    `validate:"min=2"`
+		Mode  string `validate:"startswith=x"`
+	}
+
+	err := validator.New().Struct(request{Count: 1, Mode: "y"})
+
+	var errs validator.ValidationErrors
+
+	require.ErrorAs(t, err, &errs)
+
+	assert.Equal(t, "Name is required; Count must be at least 2; Mode is invalid (startswith)", ValidationMessage("en", errs))
+	assert.Equal(t, "Name es obligatorio; Count debe ser como mínimo 2; Mode no es válido (startswith)", ValidationMessage("es", errs))
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(Middleware())
+	engine.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, T(Language(c), "error.general"))
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	req.Header.Set("Accept-Language", "de-DE")
+
+	engine.ServeHTTP(w, req)
+
+	assert.Equal(t, "de", w.Header().Get("Content-Language"))
+	assert.Equal(t, "allgemeiner Fehler", w.Body.String())
+}
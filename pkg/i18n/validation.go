@@ -0,0 +1,26 @@
+package i18n
+
+import (
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ValidationMessage describes validation failures in lang, one sentence per invalid field.
+// Tags without a dedicated message use the generic "validation.invalid" message.
+func ValidationMessage(lang string, errs validator.ValidationErrors) string {
+	msgs := make([]string, 0, len(errs))
+
+	for _, fe := range errs {
+		key := "validation." + fe.Tag()
+		if _, ok := catalogs[DefaultLanguage][key]; !ok {
+			msgs = append(msgs, T(lang, "validation.invalid", fe.Field(), fe.Tag()))
+
+			continue
+		}
+
+		msgs = append(msgs, T(lang, key, fe.Field(), fe.Param()))
+	}
+
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,190 @@
+package kerberos
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // HMAC-SHA1-96 is the checksum of the AES Kerberos encryption types
+	"encoding/binary"
+	"errors"
+)
+
+// Encryption types, RFC 3962. The RC4 and DES types are not supported.
+const (
+	ETypeAES128CTSHMACSHA196 = 17
+	ETypeAES256CTSHMACSHA196 = 18
+)
+
+// Key usages of the messages decrypted by the acceptor, RFC 4120 7.5.1.
+const (
+	usageTicket        = 2
+	usageAuthenticator = 11
+)
+
+const macSize = 12
+
+var (
+	ErrUnsupportedEType = errors.New("unsupported kerberos encryption type")
+	ErrIntegrity        = errors.New("kerberos message integrity check failed")
+)
+
+// decrypt decrypts and verifies the cipher of an EncryptedData with aes*-cts-hmac-sha1-96 and
+// returns the plaintext without the confounder, RFC 3961 5.3 and RFC 3962.
+func decrypt(etype int, key []byte, usage uint32, ciphertext []byte) ([]byte, error) {
+	if etype != ETypeAES128CTSHMACSHA196 && etype != ETypeAES256CTSHMACSHA196 {
+		return nil, ErrUnsupportedEType
+	}
+
+	if len(key) != keySize(etype) || len(ciphertext) < aes.BlockSize+macSize {
+		return nil, ErrIntegrity
+	}
+
+	ke, err := deriveKey(key, usageConstant(usage, 0xaa))
+	if err != nil {
+		return nil, err
+	}
+
+	ki, err := deriveKey(key, usageConstant(usage, 0x55))
+	if err != nil {
+		return nil, err
+	}
+
+	data, mac := ciphertext[:len(ciphertext)-macSize], ciphertext[len(ciphertext)-macSize:]
+
+	plaintext, err := decryptCTS(ke, data)
+	if err != nil {
+		return nil, err
+	}
+
+	h := hmac.New(sha1.New, ki)
+	h.Write(plaintext)
+
+	if !hmac.Equal(mac, h.Sum(nil)[:macSize]) {
+		return nil, ErrIntegrity
+	}
+
+	return plaintext[aes.BlockSize:], nil
+}
+
+func keySize(etype int) int {
+	if etype == ETypeAES128CTSHMACSHA196 {
+		return 16
+	}
+
+	return 32
+}
+
+func usageConstant(usage uint32, kind byte) []byte {
+	constant := binary.BigEndian.AppendUint32(nil, usage)
+
+	return append(constant, kind)
+}
+
+// deriveKey is DK(key, constant) of RFC 3961 5.1, random-to-key is the identity for AES.
+func deriveKey(key, constant []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	next := nfold(constant, aes.BlockSize)
+	derived := make([]byte, 0, len(key)+aes.BlockSize)
+
+	for len(derived) < len(key) {
+		out := make([]byte, aes.BlockSize)
+		block.Encrypt(out, next)
+		derived = append(derived, out...)
+		next = out
+	}
+
+	return derived[:len(key)], nil
+}
+
+// nfold stretches or folds in to size bytes, RFC 3961 5.1.
+func nfold(in []byte, size int) []byte {
+	inBits := len(in) * 8
+	lcm := len(in) * size / gcd(len(in), size)
+	out := make([]byte, size)
+	carry := 0
+
+	for i := lcm - 1; i >= 0; i-- {
+		// the bit of the rotated input copies that ends up in the top bit of byte i
+		msbit := (inBits - 1 + (inBits+13)*(i/len(in)) + (len(in)-i%len(in))*8) % inBits
+
+		hi := int(in[(len(in)-1-msbit/8)%len(in)])
+		lo := int(in[(len(in)-msbit/8)%len(in)])
+
+		carry += ((hi<<8 | lo) >> (msbit%8 + 1)) & 0xff
+		carry += int(out[i%size])
+		out[i%size] = byte(carry)
+		carry >>= 8
+	}
+
+	for i := size - 1; i >= 0 && carry != 0; i-- {
+		carry += int(out[i])
+		out[i] = byte(carry)
+		carry >>= 8
+	}
+
+	return out
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// decryptCTS decrypts AES in CBC mode with ciphertext stealing and a zero IV. The last two
+// blocks are always swapped, also when the plaintext is a whole number of blocks, RFC 3962 5.
+func decryptCTS(key, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aes.BlockSize {
+		return nil, ErrIntegrity
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	plaintext := make([]byte, len(ciphertext))
+
+	if len(ciphertext) == aes.BlockSize {
+		block.Decrypt(plaintext, ciphertext)
+
+		return plaintext, nil
+	}
+
+	// the blocks before the last two are plain CBC
+	blocks := (len(ciphertext) + aes.BlockSize - 1) / aes.BlockSize
+	head := (blocks - 2) * aes.BlockSize
+
+	if head > 0 {
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext[:head], ciphertext[:head])
+		copy(iv, ciphertext[head-aes.BlockSize:head])
+	}
+
+	last := ciphertext[head : head+aes.BlockSize]
+	tail := ciphertext[head+aes.BlockSize:]
+
+	decrypted := make([]byte, aes.BlockSize)
+	block.Decrypt(decrypted, last)
+
+	// the stolen bytes of the second to last block follow its truncated copy
+	previous := append(append([]byte{}, tail...), decrypted[len(tail):]...)
+
+	for i := range tail {
+		plaintext[head+aes.BlockSize+i] = decrypted[i] ^ previous[i]
+	}
+
+	block.Decrypt(plaintext[head:head+aes.BlockSize], previous)
+
+	for i := range aes.BlockSize {
+		plaintext[head+i] ^= iv[i]
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,152 @@
+package kerberos
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // HMAC-SHA1-96 is the checksum of the AES Kerberos encryption types
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// encryptCTS is the inverse of decryptCTS, used to build test messages.
+func encryptCTS(t *testing.T, key, plaintext []byte) []byte {
+	t.Helper()
+
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+
+	padded := append([]byte{}, plaintext...)
+	for len(padded)%aes.BlockSize != 0 {
+		padded = append(padded, 0)
+	}
+
+	ciphertext := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, make([]byte, aes.BlockSize)).CryptBlocks(ciphertext, padded)
+
+	if len(plaintext) <= aes.BlockSize {
+		return ciphertext[:len(plaintext)]
+	}
+
+	n := len(ciphertext)
+	swapped := append(append(append([]byte{}, ciphertext[:n-2*aes.BlockSize]...), ciphertext[n-aes.BlockSize:]...), ciphertext[n-2*aes.BlockSize:n-aes.BlockSize]...)
+
+	return swapped[:len(plaintext)]
+}
+
+// encrypt builds the cipher of an EncryptedData as a KDC or client would.
+func encrypt(t *testing.T, etype int, key []byte, usage uint32, plaintext []byte) []byte {
+	t.Helper()
+
+	require.Contains(t, []int{ETypeAES128CTSHMACSHA196, ETypeAES256CTSHMACSHA196}, etype)
+
+	ke, err := deriveKey(key, usageConstant(usage, 0xaa))
+	require.NoError(t, err)
+
+	ki, err := deriveKey(key, usageConstant(usage, 0x55))
+	require.NoError(t, err)
+
+	confounder := make([]byte, aes.BlockSize)
+	_, err = rand.Read(confounder)
+	require.NoError(t, err)
+
+	data := append(confounder, plaintext...)
+
+	h := hmac.New(sha1.New, ki)
+	h.Write(data)
+
+	return append(encryptCTS(t, ke, data), h.Sum(nil)[:macSize]...)
+}
+
+func TestNFold(t *testing.T) {
+	t.Parallel()
+
+	// test vectors of RFC 3961 A.1
+	tests := []struct {
+		in       string
+		bits     int
+		expected string
+	}{
+		{in: "012345", bits: 64, expected: "be072631276b1955"},
+		{in: "password", bits: 56, expected: "78a07b6caf85fa"},
+		{in: "Rough Consensus, and Running Code", bits: 64, expected: "bb6ed30870b7f0e0"},
+		{in: "password", bits: 168, expected: "59e4a8ca7c0385c3c37b3f6d2000247cb6e6bd5b3e"},
+		{in: "MASSACHVSETTS INSTITVTE OF TECHNOLOGY", bits: 192, expected: "db3b0d8f0b061e603282b308a50841229ad798fab9540c1b"},
+		{in: "Q", bits: 168, expected: "518a54a215a8452a518a54a215a8452a518a54a215"},
+		{in: "ba", bits: 168, expected: "fb25d531ae8974499f52fd92ea9857c4ba24cf297e"},
+		{in: "kerberos", bits: 64, expected: "6b65726265726f73"},
+		{in: "kerberos", bits: 128, expected: "6b65726265726f737b9b5b2b93132b93"},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.expected, hex.EncodeToString(nfold([]byte(tc.in), tc.bits/8)), tc.in)
+	}
+}
+
+func TestDecryptCTS(t *testing.T) {
+	t.Parallel()
+
+	// test vectors of RFC 3962 B
+	key, err := hex.DecodeString("636869636b656e207465726979616b69")
+	require.NoError(t, err)
+
+	tests := []struct {
+		plaintext  string
+		ciphertext string
+	}{
+		{plaintext: "I would like the ", ciphertext: "c6353568f2bf8cb4d8a580362da7ff7f97"},
+		{plaintext: "I would like the General Gau's ", ciphertext: "fc00783e0efdb2c1d445d4c8eff7ed2297687268d6ecccc0c07b25e25ecfe5"},
+		{plaintext: "I would like the General Gau's C", ciphertext: "39312523a78662d5be7fcbcc98ebf5a897687268d6ecccc0c07b25e25ecfe584"},
+	}
+
+	for _, tc := range tests {
+		ciphertext, err := hex.DecodeString(tc.ciphertext)
+		require.NoError(t, err)
+
+		plaintext, err := decryptCTS(key, ciphertext)
+		require.NoError(t, err)
+		require.Equal(t, tc.plaintext, string(plaintext))
+		require.Equal(t, ciphertext, encryptCTS(t, key, []byte(tc.plaintext)))
+	}
+
+	_, err = decryptCTS(key, []byte("short"))
+	require.ErrorIs(t, err, ErrIntegrity)
+}
+
+func TestDecrypt(t *testing.T) {
+	t.Parallel()
+
+	for _, etype := range []int{ETypeAES128CTSHMACSHA196, ETypeAES256CTSHMACSHA196} {
+		key := make([]byte, keySize(etype))
+		_, err := rand.Read(key)
+		require.NoError(t, err)
+
+		for _, size := range []int{0, 1, 16, 33, 100} {
+			plaintext := make([]byte, size)
+			_, err := rand.Read(plaintext)
+			require.NoError(t, err)
+
+			ciphertext := encrypt(t, etype, key, usageTicket, plaintext)
+
+			decrypted, err := decrypt(etype, key, usageTicket, ciphertext)
+			require.NoError(t, err)
+			require.Equal(t, plaintext, decrypted)
+
+			_, err = decrypt(etype, key, usageAuthenticator, ciphertext)
+			require.ErrorIs(t, err, ErrIntegrity)
+
+			ciphertext[0] ^= 1
+			_, err = decrypt(etype, key, usageTicket, ciphertext)
+			require.ErrorIs(t, err, ErrIntegrity)
+		}
+	}
+
+	_, err := decrypt(23, make([]byte, 16), usageTicket, make([]byte, 64))
+	require.ErrorIs(t, err, ErrUnsupportedEType)
+
+	_, err = decrypt(ETypeAES256CTSHMACSHA196, make([]byte, 16), usageTicket, make([]byte, 64))
+	require.ErrorIs(t, err, ErrIntegrity)
+}
@@ -0,0 +1,191 @@
+package kerberos
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"strings"
+)
+
+const (
+	keytabV1 = 0x0501
+	keytabV2 = 0x0502
+)
+
+var (
+	ErrInvalidKeytab = errors.New("invalid keytab")
+	ErrNoKey         = errors.New("no key in the keytab for the ticket")
+)
+
+// Keytab holds the long-term keys of the service principals, as written by ktutil or ktpass.
+type Keytab struct {
+	entries []keytabEntry
+}
+
+type keytabEntry struct {
+	principal string // the name components joined with "/"
+	realm     string
+	kvno      uint32
+	etype     int
+	key       []byte
+}
+
+// LoadKeytab reads a keytab file.
+func LoadKeytab(path string) (*Keytab, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseKeytab(b)
+}
+
+// ParseKeytab parses a keytab in the MIT file format, version 1 or 2.
+func ParseKeytab(b []byte) (*Keytab, error) {
+	if len(b) < 2 {
+		return nil, ErrInvalidKeytab
+	}
+
+	version := binary.BigEndian.Uint16(b)
+	if version != keytabV1 && version != keytabV2 {
+		return nil, ErrInvalidKeytab
+	}
+
+	kt := &Keytab{}
+	b = b[2:]
+
+	for len(b) >= 4 {
+		size := int32(binary.BigEndian.Uint32(b)) //nolint:gosec // negative sizes mark deleted entries
+		b = b[4:]
+
+		// a negative size is a hole left by a deleted entry
+		length := int(size)
+		if size < 0 {
+			length = -length
+		}
+
+		if length > len(b) {
+			return nil, ErrInvalidKeytab
+		}
+
+		if size > 0 {
+			entry, err := parseKeytabEntry(b[:length], version)
+			if err != nil {
+				return nil, err
+			}
+
+			kt.entries = append(kt.entries, entry)
+		}
+
+		b = b[length:]
+	}
+
+	return kt, nil
+}
+
+func parseKeytabEntry(b []byte, version uint16) (keytabEntry, error) {
+	r := &keytabReader{b: b}
+
+	components := int(r.uint16())
+	if version == keytabV1 {
+		// version 1 counts the realm as a component
+		components--
+	}
+
+	var entry keytabEntry
+
+	entry.realm = string(r.data())
+
+	names := make([]string, 0, components)
+	for range components {
+		names = append(names, string(r.data()))
+	}
+
+	entry.principal = strings.Join(names, "/")
+
+	if version == keytabV2 {
+		r.uint32() // name type
+	}
+
+	r.uint32() // timestamp
+	entry.kvno = uint32(r.uint8())
+	entry.etype = int(r.uint16())
+	entry.key = r.data()
+
+	// newer keytabs append the full 32 bit key version
+	if len(r.b) >= 4 {
+		if kvno := r.uint32(); kvno != 0 {
+			entry.kvno = kvno
+		}
+	}
+
+	if r.err {
+		return keytabEntry{}, ErrInvalidKeytab
+	}
+
+	return entry, nil
+}
+
+// key returns the key of a principal for an encryption type. The key of the ticket's key
+// version is preferred, a kvno of 0 or a version missing from the keytab takes the newest key.
+func (kt *Keytab) key(principal, realm string, etype int, kvno uint32) ([]byte, error) {
+	var found *keytabEntry
+
+	for i := range kt.entries {
+		e := &kt.entries[i]
+		if e.etype != etype || !strings.EqualFold(e.principal, principal) || !strings.EqualFold(e.realm, realm) {
+			continue
+		}
+
+		if kvno != 0 && e.kvno == kvno {
+			return e.key, nil
+		}
+
+		if found == nil || e.kvno > found.kvno {
+			found = e
+		}
+	}
+
+	if found == nil {
+		return nil, ErrNoKey
+	}
+
+	return found.key, nil
+}
+
+type keytabReader struct {
+	b   []byte
+	err bool
+}
+
+func (r *keytabReader) next(n int) []byte {
+	if r.err || len(r.b) < n {
+		r.err = true
+
+		return make([]byte, n)
+	}
+
+	out := r.b[:n]
+	r.b = r.b[n:]
+
+	return out
+}
+
+func (r *keytabReader) uint8() uint8 {
+	return r.next(1)[0]
+}
+
+func (r *keytabReader) uint16() uint16 {
+	return binary.BigEndian.Uint16(r.next(2))
+}
+
+func (r *keytabReader) uint32() uint32 {
+	return binary.BigEndian.Uint32(r.next(4))
+}
+
+// data reads a counted octet string.
+func (r *keytabReader) data() []byte {
+	n := int(r.uint16())
+
+	return append([]byte{}, r.next(n)...)
+}
@@ -0,0 +1,99 @@
+package kerberos
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testKey struct {
+	principal []string
+	realm     string
+	kvno      uint32
+	etype     int
+	key       []byte
+}
+
+// buildKeytab writes the keys in the version 2 keytab format with a hole before the first entry.
+func buildKeytab(keys ...testKey) []byte {
+	counted := func(b []byte, s []byte) []byte {
+		return append(binary.BigEndian.AppendUint16(b, uint16(len(s))), s...) //nolint:gosec // test data is short
+	}
+
+	out := []byte{0x05, 0x02}
+	out = binary.BigEndian.AppendUint32(out, uint32(0xfffffffc))
+	out = append(out, 0, 0, 0, 0)
+
+	for _, k := range keys {
+		entry := binary.BigEndian.AppendUint16(nil, uint16(len(k.principal))) //nolint:gosec // test data is short
+		entry = counted(entry, []byte(k.realm))
+
+		for _, c := range k.principal {
+			entry = counted(entry, []byte(c))
+		}
+
+		entry = binary.BigEndian.AppendUint32(entry, 1)          // KRB5_NT_PRINCIPAL
+		entry = binary.BigEndian.AppendUint32(entry, 1700000000) // timestamp
+		entry = append(entry, byte(k.kvno))
+		entry = binary.BigEndian.AppendUint16(entry, uint16(k.etype)) //nolint:gosec // test data is short
+		entry = counted(entry, k.key)
+		entry = binary.BigEndian.AppendUint32(entry, k.kvno)
+
+		out = binary.BigEndian.AppendUint32(out, uint32(len(entry))) //nolint:gosec // test data is short
+		out = append(out, entry...)
+	}
+
+	return out
+}
+
+func TestParseKeytab(t *testing.T) {
+	t.Parallel()
+
+	key1 := make([]byte, 32)
+	key2 := append(make([]byte, 31), 2)
+	key3 := append(make([]byte, 15), 3)
+
+	b := buildKeytab(
+		testKey{principal: []string{"HTTP", "console.example.com"}, realm: "EXAMPLE.COM", kvno: 1, etype: ETypeAES256CTSHMACSHA196, key: key1},
+		testKey{principal: []string{"HTTP", "console.example.com"}, realm: "EXAMPLE.COM", kvno: 300, etype: ETypeAES256CTSHMACSHA196, key: key2},
+		testKey{principal: []string{"HTTP", "console.example.com"}, realm: "EXAMPLE.COM", kvno: 1, etype: ETypeAES128CTSHMACSHA196, key: key3},
+	)
+
+	path := filepath.Join(t.TempDir(), "console.keytab")
+	require.NoError(t, os.WriteFile(path, b, 0o600))
+
+	kt, err := LoadKeytab(path)
+	require.NoError(t, err)
+	require.Len(t, kt.entries, 3)
+
+	key, err := kt.key("http/Console.example.com", "example.com", ETypeAES256CTSHMACSHA196, 1)
+	require.NoError(t, err)
+	require.Equal(t, key1, key)
+
+	// the 32 bit key version takes precedence over the truncated 8 bit one
+	key, err = kt.key("HTTP/console.example.com", "EXAMPLE.COM", ETypeAES256CTSHMACSHA196, 300)
+	require.NoError(t, err)
+	require.Equal(t, key2, key)
+
+	key, err = kt.key("HTTP/console.example.com", "EXAMPLE.COM", ETypeAES256CTSHMACSHA196, 0)
+	require.NoError(t, err)
+	require.Equal(t, key2, key)
+
+	key, err = kt.key("HTTP/console.example.com", "EXAMPLE.COM", ETypeAES128CTSHMACSHA196, 7)
+	require.NoError(t, err)
+	require.Equal(t, key3, key)
+
+	_, err = kt.key("HTTP/other.example.com", "EXAMPLE.COM", ETypeAES256CTSHMACSHA196, 1)
+	require.ErrorIs(t, err, ErrNoKey)
+
+	for _, invalid := range [][]byte{nil, {0x05, 0x03}, b[:len(b)-40]} {
+		_, err := ParseKeytab(invalid)
+		require.ErrorIs(t, err, ErrInvalidKeytab)
+	}
+
+	_, err = LoadKeytab(filepath.Join(t.TempDir(), "missing.keytab"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,320 @@
+// Package kerberos accepts the Kerberos tickets browsers and tools such as curl --negotiate
+// send in "Authorization: Negotiate" headers (SPNEGO, RFC 4559), so that users of domain-joined
+// workstations are signed in with their Windows logon. Only the AES encryption types are
+// supported and no mutual authentication token is returned.
+package kerberos
+
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultClockSkew is the largest difference between the client and console clocks accepted.
+const DefaultClockSkew = 5 * time.Minute
+
+const (
+	gssAPIToken = 0 // [APPLICATION 0] of the GSS-API initial context token
+	tagAPReq    = 14
+	tagTicket   = 1
+	tagEncPart  = 3
+	tagAuth     = 2
+
+	msgTypeAPReq = 14
+	krb5TokenID  = 0x0100
+)
+
+var (
+	oidSPNEGO  = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 2}
+	oidKRB5    = asn1.ObjectIdentifier{1, 2, 840, 113554, 1, 2, 2}
+	oidMSKRB5  = asn1.ObjectIdentifier{1, 2, 840, 48018, 1, 2, 2} // the Kerberos OID as sent by Windows
+	ntlmPrefix = []byte("NTLMSSP\x00")
+)
+
+var (
+	ErrInvalidToken    = errors.New("invalid negotiate token")
+	ErrUnsupportedMech = errors.New("negotiate token is not kerberos, NTLM is not supported")
+	ErrWrongService    = errors.New("kerberos ticket is for another service")
+	ErrClientMismatch  = errors.New("kerberos authenticator does not match the ticket")
+	ErrTicketExpired   = errors.New("kerberos ticket is expired or not yet valid")
+	ErrClockSkew       = errors.New("kerberos authenticator time is outside the allowed clock skew")
+	ErrReplay          = errors.New("kerberos authenticator was replayed")
+)
+
+type (
+	principalName struct {
+		NameType   int             `asn1:"explicit,tag:0"`
+		NameString []asn1.RawValue `asn1:"explicit,tag:1"`
+	}
+
+	encryptedData struct {
+		EType  int    `asn1:"explicit,tag:0"`
+		KVNO   int    `asn1:"optional,explicit,tag:1"`
+		Cipher []byte `asn1:"explicit,tag:2"`
+	}
+
+	encryptionKey struct {
+		KeyType  int    `asn1:"explicit,tag:0"`
+		KeyValue []byte `asn1:"explicit,tag:1"`
+	}
+
+	negTokenInit struct {
+		MechTypes   []asn1.ObjectIdentifier `asn1:"explicit,tag:0"`
+		ReqFlags    asn1.BitString          `asn1:"optional,explicit,tag:1"`
+		MechToken   []byte                  `asn1:"optional,explicit,tag:2"`
+		MechListMIC []byte                  `asn1:"optional,explicit,tag:3"`
+	}
+
+	apReq struct {
+		PVNO          int            `asn1:"explicit,tag:0"`
+		MsgType       int            `asn1:"explicit,tag:1"`
+		APOptions     asn1.BitString `asn1:"explicit,tag:2"`
+		Ticket        asn1.RawValue  `asn1:"explicit,tag:3"`
+		Authenticator encryptedData  `asn1:"explicit,tag:4"`
+	}
+
+	ticket struct {
+		TktVNO  int           `asn1:"explicit,tag:0"`
+		Realm   asn1.RawValue `asn1:"explicit,tag:1"`
+		SName   principalName `asn1:"explicit,tag:2"`
+		EncPart encryptedData `asn1:"explicit,tag:3"`
+	}
+
+	encTicketPart struct {
+		Flags     asn1.BitString `asn1:"explicit,tag:0"`
+		Key       encryptionKey  `asn1:"explicit,tag:1"`
+		CRealm    asn1.RawValue  `asn1:"explicit,tag:2"`
+		CName     principalName  `asn1:"explicit,tag:3"`
+		Transited asn1.RawValue  `asn1:"explicit,tag:4"`
+		AuthTime  time.Time      `asn1:"generalized,explicit,tag:5"`
+		StartTime time.Time      `asn1:"generalized,optional,explicit,tag:6"`
+		EndTime   time.Time      `asn1:"generalized,explicit,tag:7"`
+	}
+
+	authenticator struct {
+		AVNO   int           `asn1:"explicit,tag:0"`
+		CRealm asn1.RawValue `asn1:"explicit,tag:1"`
+		CName  principalName `asn1:"explicit,tag:2"`
+		Cksum  asn1.RawValue `asn1:"optional,explicit,tag:3"`
+		CUSec  int           `asn1:"explicit,tag:4"`
+		CTime  time.Time     `asn1:"generalized,explicit,tag:5"`
+	}
+)
+
+// String joins the name components with "/".
+func (p principalName) String() string {
+	names := make([]string, len(p.NameString))
+	for i, n := range p.NameString {
+		names[i] = string(n.Bytes)
+	}
+
+	return strings.Join(names, "/")
+}
+
+// explicitValue returns the element inside an explicitly tagged RawValue field, encoding/asn1
+// keeps the tag of such fields rather than parsing the element inside.
+func explicitValue(rv asn1.RawValue) (asn1.RawValue, error) {
+	var inner asn1.RawValue
+	if _, err := asn1.Unmarshal(rv.Bytes, &inner); err != nil {
+		return asn1.RawValue{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	return inner, nil
+}
+
+// kerberosString decodes an explicitly tagged KerberosString, a GeneralString encoding/asn1 does not decode.
+func kerberosString(rv asn1.RawValue) string {
+	inner, err := explicitValue(rv)
+	if err != nil {
+		return ""
+	}
+
+	return string(inner.Bytes)
+}
+
+// Acceptor validates the Kerberos AP-REQ of negotiate tokens with the keys of a keytab.
+type Acceptor struct {
+	keytab    *Keytab
+	service   string
+	clockSkew time.Duration
+	now       func() time.Time
+
+	mu     sync.Mutex
+	replay map[string]time.Time
+}
+
+// NewAcceptor creates an acceptor of the tickets for service, such as HTTP/console.example.com.
+// An empty service accepts a ticket for any principal of the keytab.
+func NewAcceptor(kt *Keytab, service string) *Acceptor {
+	return &Acceptor{
+		keytab:    kt,
+		service:   strings.SplitN(service, "@", 2)[0],
+		clockSkew: DefaultClockSkew,
+		now:       time.Now,
+		replay:    map[string]time.Time{},
+	}
+}
+
+// Accept validates a negotiate token and returns the client principal, as user@REALM.
+func (a *Acceptor) Accept(token []byte) (string, error) {
+	req, err := parseNegotiateToken(token)
+	if err != nil {
+		return "", err
+	}
+
+	raw, err := explicitValue(req.Ticket)
+	if err != nil {
+		return "", err
+	}
+
+	var t ticket
+	if _, err := asn1.UnmarshalWithParams(raw.FullBytes, &t, fmt.Sprintf("application,explicit,tag:%d", tagTicket)); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	service := t.SName.String()
+	if a.service != "" && !strings.EqualFold(service, a.service) {
+		return "", ErrWrongService
+	}
+
+	key, err := a.keytab.key(service, kerberosString(t.Realm), t.EncPart.EType, uint32(t.EncPart.KVNO)) //nolint:gosec // key versions are unsigned
+	if err != nil {
+		return "", err
+	}
+
+	plain, err := decrypt(t.EncPart.EType, key, usageTicket, t.EncPart.Cipher)
+	if err != nil {
+		return "", err
+	}
+
+	var part encTicketPart
+	if _, err := asn1.UnmarshalWithParams(plain, &part, fmt.Sprintf("application,explicit,tag:%d", tagEncPart)); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	now := a.now()
+
+	if now.Add(a.clockSkew).Before(part.StartTime) || now.Add(-a.clockSkew).After(part.EndTime) {
+		return "", ErrTicketExpired
+	}
+
+	plain, err = decrypt(req.Authenticator.EType, part.Key.KeyValue, usageAuthenticator, req.Authenticator.Cipher)
+	if err != nil {
+		return "", err
+	}
+
+	var auth authenticator
+	if _, err := asn1.UnmarshalWithParams(plain, &auth, fmt.Sprintf("application,explicit,tag:%d", tagAuth)); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	client := auth.CName.String() + "@" + kerberosString(auth.CRealm)
+	if client != part.CName.String()+"@"+kerberosString(part.CRealm) {
+		return "", ErrClientMismatch
+	}
+
+	if auth.CTime.Before(now.Add(-a.clockSkew)) || auth.CTime.After(now.Add(a.clockSkew)) {
+		return "", ErrClockSkew
+	}
+
+	if err := a.checkReplay(client, auth.CTime, auth.CUSec, now); err != nil {
+		return "", err
+	}
+
+	return client, nil
+}
+
+// checkReplay remembers the authenticators seen within the clock skew, RFC 4120 3.2.3.
+func (a *Acceptor) checkReplay(client string, ctime time.Time, cusec int, now time.Time) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for k, expires := range a.replay {
+		if now.After(expires) {
+			delete(a.replay, k)
+		}
+	}
+
+	k := fmt.Sprintf("%s|%d|%d", client, ctime.Unix(), cusec)
+	if _, seen := a.replay[k]; seen {
+		return ErrReplay
+	}
+
+	a.replay[k] = ctime.Add(a.clockSkew)
+
+	return nil
+}
+
+// parseNegotiateToken unwraps the AP-REQ of an SPNEGO NegTokenInit or of a plain Kerberos GSS-API token.
+func parseNegotiateToken(token []byte) (*apReq, error) {
+	if len(token) >= len(ntlmPrefix) && string(token[:len(ntlmPrefix)]) == string(ntlmPrefix) {
+		return nil, ErrUnsupportedMech
+	}
+
+	mech, inner, err := unwrapGSSAPI(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if mech.Equal(oidSPNEGO) {
+		var init negTokenInit
+		if _, err := asn1.UnmarshalWithParams(inner, &init, "explicit,tag:0"); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+		}
+
+		if len(init.MechTypes) == 0 || !isKerberos(init.MechTypes[0]) || len(init.MechToken) == 0 {
+			return nil, ErrUnsupportedMech
+		}
+
+		if mech, inner, err = unwrapGSSAPI(init.MechToken); err != nil {
+			return nil, err
+		}
+	}
+
+	if !isKerberos(mech) {
+		return nil, ErrUnsupportedMech
+	}
+
+	if len(inner) < 2 || int(inner[0])<<8|int(inner[1]) != krb5TokenID {
+		return nil, ErrInvalidToken
+	}
+
+	var req apReq
+	if _, err := asn1.UnmarshalWithParams(inner[2:], &req, fmt.Sprintf("application,explicit,tag:%d", tagAPReq)); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if req.MsgType != msgTypeAPReq {
+		return nil, ErrInvalidToken
+	}
+
+	return &req, nil
+}
+
+// unwrapGSSAPI splits an InitialContextToken into its mechanism and the mechanism specific token, RFC 2743 3.1.
+func unwrapGSSAPI(token []byte) (asn1.ObjectIdentifier, []byte, error) {
+	var outer asn1.RawValue
+	if _, err := asn1.Unmarshal(token, &outer); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if outer.Class != asn1.ClassApplication || outer.Tag != gssAPIToken {
+		return nil, nil, ErrInvalidToken
+	}
+
+	var mech asn1.ObjectIdentifier
+
+	inner, err := asn1.Unmarshal(outer.Bytes, &mech)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	return mech, inner, nil
+}
+
+func isKerberos(mech asn1.ObjectIdentifier) bool {
+	return mech.Equal(oidKRB5) || mech.Equal(oidMSKRB5)
+}
@@ -0,0 +1,215 @@
+package kerberos
+
+import (
+	"crypto/rand"
+	"encoding/asn1"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRealm   = "EXAMPLE.COM"
+	testService = "HTTP/console.example.com"
+)
+
+var testNow = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type tokenParams struct {
+	service      []string
+	ticketClient string
+	authClient   string
+	start, end   time.Time
+	ctime        time.Time
+	serviceKey   []byte
+	plainKRB5    bool
+	tamper       bool
+}
+
+func generalString(s string) asn1.RawValue {
+	return asn1.RawValue{Tag: 27, Bytes: []byte(s)}
+}
+
+// tagged wraps v in an explicit context tag. encoding/asn1 writes a RawValue field as it is,
+// ignoring the explicit tag of the field.
+func tagged(t *testing.T, tag int, v asn1.RawValue) asn1.RawValue {
+	t.Helper()
+
+	b, err := asn1.Marshal(v)
+	require.NoError(t, err)
+
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: tag, IsCompound: true, Bytes: b}
+}
+
+func testName(names ...string) principalName {
+	p := principalName{NameType: 1}
+	for _, n := range names {
+		p.NameString = append(p.NameString, generalString(n))
+	}
+
+	return p
+}
+
+func marshal(t *testing.T, v any, params string) []byte {
+	t.Helper()
+
+	b, err := asn1.MarshalWithParams(v, params)
+	require.NoError(t, err)
+
+	return b
+}
+
+func gssWrap(t *testing.T, mech asn1.ObjectIdentifier, inner []byte) []byte {
+	t.Helper()
+
+	oid, err := asn1.Marshal(mech)
+	require.NoError(t, err)
+
+	return marshal(t, asn1.RawValue{Class: asn1.ClassApplication, Tag: gssAPIToken, IsCompound: true, Bytes: append(oid, inner...)}, "")
+}
+
+// buildToken builds the negotiate token a browser sends for a service ticket issued with p.
+func buildToken(t *testing.T, p tokenParams) []byte {
+	t.Helper()
+
+	sessionKey := make([]byte, 32)
+	_, err := rand.Read(sessionKey)
+	require.NoError(t, err)
+
+	transited := marshal(t, struct {
+		TRType   int    `asn1:"explicit,tag:0"`
+		Contents []byte `asn1:"explicit,tag:1"`
+	}{TRType: 1, Contents: []byte{}}, "")
+
+	part := marshal(t, encTicketPart{
+		Flags:     asn1.BitString{Bytes: make([]byte, 4), BitLength: 32},
+		Key:       encryptionKey{KeyType: ETypeAES256CTSHMACSHA196, KeyValue: sessionKey},
+		CRealm:    tagged(t, 2, generalString(testRealm)),
+		CName:     testName(p.ticketClient),
+		Transited: tagged(t, 4, asn1.RawValue{FullBytes: transited}),
+		AuthTime:  p.start,
+		StartTime: p.start,
+		EndTime:   p.end,
+	}, "application,explicit,tag:3")
+
+	tkt := marshal(t, ticket{
+		TktVNO:  5,
+		Realm:   tagged(t, 1, generalString(testRealm)),
+		SName:   testName(p.service...),
+		EncPart: encryptedData{EType: ETypeAES256CTSHMACSHA196, KVNO: 2, Cipher: encrypt(t, ETypeAES256CTSHMACSHA196, p.serviceKey, usageTicket, part)},
+	}, "application,explicit,tag:1")
+
+	auth := marshal(t, authenticator{
+		AVNO:   5,
+		CRealm: tagged(t, 1, generalString(testRealm)),
+		CName:  testName(p.authClient),
+		CUSec:  42,
+		CTime:  p.ctime,
+	}, "application,explicit,tag:2")
+
+	authCipher := encrypt(t, ETypeAES256CTSHMACSHA196, sessionKey, usageAuthenticator, auth)
+	if p.tamper {
+		authCipher[len(authCipher)-1] ^= 1
+	}
+
+	req := marshal(t, apReq{
+		PVNO:          5,
+		MsgType:       msgTypeAPReq,
+		APOptions:     asn1.BitString{Bytes: make([]byte, 4), BitLength: 32},
+		Ticket:        tagged(t, 3, asn1.RawValue{FullBytes: tkt}),
+		Authenticator: encryptedData{EType: ETypeAES256CTSHMACSHA196, Cipher: authCipher},
+	}, "application,explicit,tag:14")
+
+	krb5 := gssWrap(t, oidKRB5, append([]byte{0x01, 0x00}, req...))
+	if p.plainKRB5 {
+		return krb5
+	}
+
+	init := marshal(t, negTokenInit{MechTypes: []asn1.ObjectIdentifier{oidMSKRB5, oidKRB5}, MechToken: krb5}, "explicit,tag:0")
+
+	return gssWrap(t, oidSPNEGO, init)
+}
+
+func TestAccept(t *testing.T) {
+	t.Parallel()
+
+	serviceKey := make([]byte, 32)
+	_, err := rand.Read(serviceKey)
+	require.NoError(t, err)
+
+	otherKey := append([]byte{}, serviceKey...)
+	otherKey[0] ^= 1
+
+	kt, err := ParseKeytab(buildKeytab(testKey{
+		principal: []string{"HTTP", "console.example.com"},
+		realm:     testRealm,
+		kvno:      2,
+		etype:     ETypeAES256CTSHMACSHA196,
+		key:       serviceKey,
+	}))
+	require.NoError(t, err)
+
+	valid := func() tokenParams {
+		return tokenParams{
+			service:      []string{"HTTP", "console.example.com"},
+			ticketClient: "alice",
+			authClient:   "alice",
+			start:        testNow.Add(-time.Hour),
+			end:          testNow.Add(9 * time.Hour),
+			ctime:        testNow.Add(-time.Minute),
+			serviceKey:   serviceKey,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		service string
+		params  func(p *tokenParams)
+		token   []byte
+		err     error
+	}{
+		{name: "spnego token", service: testService + "@" + testRealm, params: func(_ *tokenParams) {}},
+		{name: "plain kerberos token", service: testService, params: func(p *tokenParams) { p.plainKRB5 = true }},
+		{name: "any service of the keytab", params: func(_ *tokenParams) {}},
+		{name: "ntlm", token: []byte("NTLMSSP\x00\x01\x00\x00\x00"), err: ErrUnsupportedMech},
+		{name: "garbage", token: []byte("not a token"), err: ErrInvalidToken},
+		{name: "other service", service: testService, params: func(p *tokenParams) { p.service = []string{"HTTP", "other.example.com"} }, err: ErrWrongService},
+		{name: "not in keytab", params: func(p *tokenParams) { p.service = []string{"HTTP", "other.example.com"} }, err: ErrNoKey},
+		{name: "wrong service key", params: func(p *tokenParams) { p.serviceKey = otherKey }, err: ErrIntegrity},
+		{name: "tampered authenticator", params: func(p *tokenParams) { p.tamper = true }, err: ErrIntegrity},
+		{name: "expired ticket", params: func(p *tokenParams) { p.end = testNow.Add(-10 * time.Minute) }, err: ErrTicketExpired},
+		{name: "postdated ticket", params: func(p *tokenParams) { p.start = testNow.Add(10 * time.Minute) }, err: ErrTicketExpired},
+		{name: "clock skew", params: func(p *tokenParams) { p.ctime = testNow.Add(-6 * time.Minute) }, err: ErrClockSkew},
+		{name: "client mismatch", params: func(p *tokenParams) { p.authClient = "mallory" }, err: ErrClientMismatch},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			token := tc.token
+			if token == nil {
+				p := valid()
+				tc.params(&p)
+				token = buildToken(t, p)
+			}
+
+			a := NewAcceptor(kt, tc.service)
+			a.now = func() time.Time { return testNow }
+
+			client, err := a.Accept(token)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "alice@"+testRealm, client)
+
+			_, err = a.Accept(token)
+			require.ErrorIs(t, err, ErrReplay)
+		})
+	}
+}
@@ -3,7 +3,6 @@ package logger
 import (
 	"fmt"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -23,22 +22,9 @@ type logger struct {
 	logger *zerolog.Logger
 }
 
-// New -.
+// New -. The level is applied globally and can be changed at runtime with SetLevel.
 func New(level string) Interface {
-	var l zerolog.Level
-
-	switch strings.ToLower(level) {
-	case "error":
-		l = zerolog.ErrorLevel
-	case "warn":
-		l = zerolog.WarnLevel
-	case "info":
-		l = zerolog.InfoLevel
-	case "debug":
-		l = zerolog.DebugLevel
-	default:
-		l = zerolog.InfoLevel
-	}
+	l, _ := parseLevel(level)
 
 	skipFrameCount := 2
 
@@ -49,15 +35,13 @@ func New(level string) Interface {
 			With().
 			Timestamp().
 			CallerWithSkipFrameCount(zerolog.CallerSkipFrameCount + skipFrameCount).
-			Logger().
-			Level(l)
+			Logger()
 	} else {
 		z = zerolog.New(os.Stdout).
 			With().
 			Timestamp().
 			CallerWithSkipFrameCount(zerolog.CallerSkipFrameCount + skipFrameCount).
-			Logger().
-			Level(l)
+			Logger()
 	}
 
 	zerolog.SetGlobalLevel(l)
@@ -110,10 +94,11 @@ func (l *logger) Fatal(message interface{}, args ...any) {
 	os.Exit(1)
 }
 
+// log writes the message with the secrets in it redacted, including those in the arguments.
 func (l *logger) log(e *zerolog.Event, m string, args ...any) {
 	if len(args) == 0 {
-		e.Msg(m)
+		e.Msg(Redact(m))
 	} else {
-		e.Msgf(m, args...)
+		e.Msg(Redact(fmt.Sprintf(m, args...)))
 	}
 }
@@ -149,8 +149,7 @@ func TestNewLogger(t *testing.T) {
 			log := New(tc.level)
 			require.NotNil(t, log)
 
-			level := log.(*logger).logger.GetLevel() //nolint:errcheck // It's a test...
-			assert.Equal(t, tc.expectedLevel, level)
+			assert.Equal(t, tc.expectedLevel, zerolog.GlobalLevel())
 		})
 	}
 }
@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Redacted replaces the secrets removed from log messages.
+const Redacted = "[REDACTED]"
+
+// secretName matches the names of the fields holding secrets, such as password, amtPassword,
+// PSKPassPhrase, PSKValue, DigestPassword, client_secret, jwtKey, the Kerberos MasterKey or the
+// KeyBlob of the private keys added to AMT.
+const secretName = `[A-Za-z0-9_]*(?i:password|passphrase|passwd|pskvalue|secret|token|jwtkey|encryptionkey|privatekey|masterkey|keyblob)`
+
+// redactions are applied in order, the ones matching whole values before the ones matching field names.
+var redactions = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// PEM private keys, RSA, EC and PKCS#8
+	{regexp.MustCompile(`-----BEGIN ([A-Z ]*)PRIVATE KEY-----[\s\S]*?(-----END [A-Z ]*PRIVATE KEY-----|$)`), "-----BEGIN ${1}PRIVATE KEY-----" + Redacted},
+	// Authorization headers of every scheme, digest responses included
+	{regexp.MustCompile(`(?i)(authorization\s*:\s*(?:bearer|basic|digest|negotiate)\s+)[^\r\n]+`), "${1}" + Redacted},
+	{regexp.MustCompile(`(?i)("authorization"\s*:\s*"(?:bearer|basic|digest|negotiate)\s+)(?:\\.|[^"\\])*`), "${1}" + Redacted},
+	// JSON Web Tokens
+	{regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`), Redacted},
+	// Vault service, batch and recovery tokens, current and legacy formats
+	{regexp.MustCompile(`\bhv[sbr]\.[A-Za-z0-9_-]{20,}`), Redacted},
+	{regexp.MustCompile(`\b[sbr]\.[A-Za-z0-9]{24}\b`), Redacted},
+	// XML elements, as in WS-Man messages: <h:DigestPassword>secret</h:DigestPassword>
+	{regexp.MustCompile(`(<(?:[A-Za-z0-9_]+:)?` + secretName + `(?:\s[^>]*)?>)[^<]*`), "${1}" + Redacted},
+	// JSON fields: "password":"secret"
+	{regexp.MustCompile(`("` + secretName + `"\s*:\s*")(?:\\.|[^"\\])*`), "${1}" + Redacted},
+	// key=value pairs, query parameters, headers and %+v formatted structs: password=secret, X-Vault-Token: s.xxx
+	{regexp.MustCompile(`(\b` + secretName + `\s*[=:]\s*)[^\s&,;"'}<]+`), "${1}" + Redacted},
+}
+
+// Redact masks the passwords, tokens and private keys in a log message.
+func Redact(message string) string {
+	for _, r := range redactions {
+		message = r.pattern.ReplaceAllString(message, r.replacement)
+	}
+
+	return message
+}
+
+// redactingFormatter redacts the messages of logrus, which go-wsman-messages traces the
+// WS-Man messages exchanged with the devices through.
+type redactingFormatter struct {
+	logrus.Formatter
+}
+
+// Format -.
+func (f redactingFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	redacted := *entry
+	redacted.Message = Redact(entry.Message)
+
+	return f.Formatter.Format(&redacted)
+}
+
+// SetupLogrus redacts the secrets from the logrus output.
+func SetupLogrus() {
+	if _, ok := logrus.StandardLogger().Formatter.(redactingFormatter); ok {
+		return
+	}
+
+	logrus.SetFormatter(redactingFormatter{Formatter: logrus.StandardLogger().Formatter})
+}
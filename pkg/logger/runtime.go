@@ -0,0 +1,153 @@
+package logger
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"github.com/sirupsen/logrus"
+)
+
+// Subsystems that support targeted debug logging. Targets are device GUIDs.
+const (
+	// SubsystemWsman logs the raw WS-Man messages exchanged with a device.
+	SubsystemWsman = "wsman"
+	// SubsystemCIRA logs the APF traffic of a device's CIRA tunnel.
+	SubsystemCIRA = "cira"
+	// AllTargets enables debug logging of a subsystem for every device.
+	AllTargets = "*"
+)
+
+var (
+	ErrInvalidLevel     = errors.New("invalid log level")
+	ErrUnknownSubsystem = errors.New("unknown debug subsystem")
+
+	debugMu      sync.RWMutex
+	debugTargets = map[string][]string{}
+)
+
+// Subsystems lists the subsystems that support targeted debug logging.
+func Subsystems() []string {
+	return []string{SubsystemWsman, SubsystemCIRA}
+}
+
+func parseLevel(level string) (zerolog.Level, bool) {
+	switch strings.ToLower(level) {
+	case "error":
+		return zerolog.ErrorLevel, true
+	case "warn":
+		return zerolog.WarnLevel, true
+	case "info":
+		return zerolog.InfoLevel, true
+	case "debug":
+		return zerolog.DebugLevel, true
+	default:
+		return zerolog.InfoLevel, false
+	}
+}
+
+// ValidLevel reports whether level is a level SetLevel accepts.
+func ValidLevel(level string) bool {
+	_, ok := parseLevel(level)
+
+	return ok
+}
+
+// SetLevel changes the level of every logger at runtime.
+func SetLevel(level string) error {
+	l, ok := parseLevel(level)
+	if !ok {
+		return ErrInvalidLevel
+	}
+
+	zerolog.SetGlobalLevel(l)
+
+	return nil
+}
+
+// Level returns the current global log level.
+func Level() string {
+	return zerolog.GlobalLevel().String()
+}
+
+// SetDebugTargets enables debug logging of subsystem for the given device GUIDs,
+// replacing the previous targets. An empty list disables it, AllTargets enables it for every device.
+func SetDebugTargets(subsystem string, targets []string) error {
+	if !slices.Contains(Subsystems(), subsystem) {
+		return ErrUnknownSubsystem
+	}
+
+	debugMu.Lock()
+	defer debugMu.Unlock()
+
+	if len(targets) == 0 {
+		delete(debugTargets, subsystem)
+	} else {
+		debugTargets[subsystem] = slices.Clone(targets)
+	}
+
+	// go-wsman-messages traces messages through logrus, which is otherwise left at its default level.
+	if subsystem == SubsystemWsman {
+		if len(targets) == 0 {
+			logrus.SetLevel(logrus.InfoLevel)
+		} else {
+			logrus.SetLevel(logrus.TraceLevel)
+		}
+	}
+
+	return nil
+}
+
+// DebugTargets returns the enabled debug targets by subsystem.
+func DebugTargets() map[string][]string {
+	debugMu.RLock()
+	defer debugMu.RUnlock()
+
+	targets := make(map[string][]string, len(debugTargets))
+	for subsystem, t := range debugTargets {
+		targets[subsystem] = slices.Clone(t)
+	}
+
+	return targets
+}
+
+// DebugEnabled reports whether debug logging of subsystem is enabled for target.
+func DebugEnabled(subsystem, target string) bool {
+	debugMu.RLock()
+	defer debugMu.RUnlock()
+
+	for _, t := range debugTargets[subsystem] {
+		if t == AllTargets || strings.EqualFold(t, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ForTarget returns a logger whose Debug messages are written regardless of the
+// global level while debug logging of subsystem is enabled for target.
+func ForTarget(l Interface, subsystem, target string) Interface {
+	return targetedLogger{Interface: l, subsystem: subsystem, target: target}
+}
+
+type targetedLogger struct {
+	Interface
+	subsystem string
+	target    string
+}
+
+// Debug -.
+func (t targetedLogger) Debug(message any, args ...any) {
+	l, ok := t.Interface.(*logger)
+	if !ok || !DebugEnabled(t.subsystem, t.target) {
+		t.Interface.Debug(message, args...)
+
+		return
+	}
+
+	// Log() is not filtered by level, so the level field is added by hand.
+	l.log(l.logger.Log().Str(zerolog.LevelFieldName, zerolog.DebugLevel.String()), l.formatMessage(message), args...)
+}
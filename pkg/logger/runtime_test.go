@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLevel(t *testing.T) { //nolint:paralleltest // changes the global log level
+	mu.Lock()
+	defer mu.Unlock()
+
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+
+	require.NoError(t, SetLevel("warn"))
+	assert.Equal(t, "warn", Level())
+
+	require.ErrorIs(t, SetLevel("verbose"), ErrInvalidLevel)
+	assert.Equal(t, "warn", Level())
+}
+
+func TestDebugTargets(t *testing.T) { //nolint:paralleltest // changes the global debug targets
+	mu.Lock()
+	defer mu.Unlock()
+
+	defer func() {
+		_ = SetDebugTargets(SubsystemWsman, nil)
+		_ = SetDebugTargets(SubsystemCIRA, nil)
+	}()
+
+	require.ErrorIs(t, SetDebugTargets("amt", []string{"guid"}), ErrUnknownSubsystem)
+
+	require.NoError(t, SetDebugTargets(SubsystemWsman, []string{"ABC-123"}))
+	require.NoError(t, SetDebugTargets(SubsystemCIRA, []string{AllTargets}))
+
+	assert.True(t, DebugEnabled(SubsystemWsman, "abc-123"))
+	assert.False(t, DebugEnabled(SubsystemWsman, "def-456"))
+	assert.True(t, DebugEnabled(SubsystemCIRA, "def-456"))
+	assert.Equal(t, map[string][]string{
+		SubsystemWsman: {"ABC-123"},
+		SubsystemCIRA:  {AllTargets},
+	}, DebugTargets())
+
+	require.NoError(t, SetDebugTargets(SubsystemWsman, []string{}))
+
+	assert.False(t, DebugEnabled(SubsystemWsman, "abc-123"))
+	assert.NotContains(t, DebugTargets(), SubsystemWsman)
+}
+
+func TestForTarget(t *testing.T) { //nolint:paralleltest // changes the global log level and debug targets
+	mu.Lock()
+	defer mu.Unlock()
+
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	defer func() { _ = SetDebugTargets(SubsystemCIRA, nil) }()
+
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	require.NoError(t, SetDebugTargets(SubsystemCIRA, []string{"enabled"}))
+
+	var buf bytes.Buffer
+
+	zl := zerolog.New(&buf)
+	log := &logger{logger: &zl}
+
+	ForTarget(log, SubsystemCIRA, "disabled").Debug("not written")
+	ForTarget(log, SubsystemCIRA, "enabled").Debug("written %d", 1)
+
+	assert.NotContains(t, buf.String(), "not written")
+	assert.Contains(t, buf.String(), `"level":"debug"`)
+	assert.Contains(t, buf.String(), "written 1")
+}
@@ -0,0 +1,253 @@
+// Package openapivalidate checks the JSON request bodies against the schemas of the operations of an OpenAPI
+// document, so that malformed payloads are refused with an error per field before they reach a handler.
+package openapivalidate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+)
+
+// FieldError is a property of the request body that does not match the schema of the operation,
+// Field is its path of dot separated keys and indexes, empty for the body itself.
+type FieldError struct {
+	Field   string `json:"field" example:"profiles.0.priority"`
+	Message string `json:"message" example:"value must be an integer"`
+}
+
+// Error is returned for a request body not matching the schema of its operation. Malformed is set for a body
+// that could not be decoded, its only field error then tells why.
+type Error struct {
+	Fields    []FieldError
+	Malformed bool
+}
+
+func (e Error) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+
+	for _, f := range e.Fields {
+		if f.Field == "" {
+			messages = append(messages, f.Message)
+		} else {
+			messages = append(messages, f.Field+": "+f.Message)
+		}
+	}
+
+	return "request body is not valid: " + strings.Join(messages, "; ")
+}
+
+// operation is an operation of the document with the route it is found by.
+type operation struct {
+	route *routers.Route
+}
+
+// Validator validates the requests of the operations of a document, found by method and route.
+type Validator struct {
+	operations map[string]operation
+	options    *openapi3filter.Options
+	partial    bool
+	prefixes   []prefix
+}
+
+// prefix is a prefix of the paths of the document and the prefix of the routes they are served at.
+type prefix struct {
+	path, route string
+}
+
+// Option changes how a Validator checks the requests.
+type Option func(*Validator)
+
+// Partial leaves out the missing required properties of every request, for documents whose required properties
+// are those of the resources as served rather than as sent. The requests of PATCH are always partial.
+func Partial() Option {
+	return func(v *Validator) {
+		v.partial = true
+	}
+}
+
+// ServedAt finds the operations of the paths of the document starting with pathPrefix at the routes starting with
+// routePrefix instead, for documents describing some operations at other paths than those of the router.
+func ServedAt(pathPrefix, routePrefix string) Option {
+	return func(v *Validator) {
+		v.prefixes = append(v.prefixes, prefix{path: pathPrefix, route: routePrefix})
+	}
+}
+
+// New returns a Validator of the operations of doc.
+func New(doc *openapi3.T, opts ...Option) *Validator {
+	v := &Validator{
+		operations: map[string]operation{},
+		// the handlers ignore the read-only properties sent back to them
+		options: &openapi3filter.Options{MultiError: true, SkipSettingDefaults: true, ExcludeReadOnlyValidations: true},
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	visited := map[*openapi3.Schema]bool{}
+
+	for path, item := range doc.Paths.Map() {
+		for method, op := range item.Operations() {
+			if op.RequestBody == nil || op.RequestBody.Value == nil {
+				continue
+			}
+
+			for _, content := range op.RequestBody.Value.Content {
+				allowNull(content.Schema, visited)
+			}
+
+			v.operations[key(method, v.route(path))] = operation{route: &routers.Route{
+				Spec: doc, Path: path, PathItem: item, Method: method, Operation: op,
+			}}
+		}
+	}
+
+	return v
+}
+
+// route returns the route path of the document is served at.
+func (v *Validator) route(path string) string {
+	for _, p := range v.prefixes {
+		if path == p.path || strings.HasPrefix(path, p.path+"/") {
+			return p.route + strings.TrimPrefix(path, p.path)
+		}
+	}
+
+	return path
+}
+
+// allowNull makes the schema and the schemas of its properties and items nullable: encoding/json takes null
+// for a value of any type, leaving it unchanged, so a null is not an error of the request.
+func allowNull(ref *openapi3.SchemaRef, visited map[*openapi3.Schema]bool) {
+	if ref == nil || ref.Value == nil || visited[ref.Value] {
+		return
+	}
+
+	schema := ref.Value
+	visited[schema] = true
+	schema.Nullable = true
+
+	for _, property := range schema.Properties {
+		allowNull(property, visited)
+	}
+
+	allowNull(schema.Items, visited)
+
+	if schema.AdditionalProperties.Schema != nil {
+		allowNull(schema.AdditionalProperties.Schema, visited)
+	}
+
+	for _, refs := range []openapi3.SchemaRefs{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for _, r := range refs {
+			allowNull(r, visited)
+		}
+	}
+}
+
+// Load returns a Validator of the operations of the JSON or YAML document data.
+func Load(data []byte, opts ...Option) (*Validator, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("openapivalidate - Load: %w", err)
+	}
+
+	return New(doc, opts...), nil
+}
+
+// Validate checks the body of r against the schema of the operation of its method and route, the route being the
+// path as registered with the router: ":name" and "*name" segments match the "{name}" parameters of the document.
+// Requests of unknown operations, without a body or with a body other than JSON are not checked. A body not matching
+// the schema returns Error, other errors come from reading the body. The body is kept for the handler.
+func (v *Validator) Validate(ctx context.Context, r *http.Request, route string) error {
+	op, ok := v.operations[key(r.Method, route)]
+	if !ok || r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) {
+		return nil
+	}
+
+	input := &openapi3filter.RequestValidationInput{Request: r, Route: op.route, Options: v.options}
+
+	err = openapi3filter.ValidateRequestBody(ctx, input, op.route.Operation.RequestBody.Value)
+	if err == nil {
+		return nil
+	}
+
+	var requestErr *openapi3filter.RequestError
+	if !errors.As(err, &requestErr) {
+		return err
+	}
+
+	switch {
+	case errors.Is(requestErr.Err, openapi3filter.ErrInvalidRequired):
+		// an empty body is left to the handler, which may take it for the defaults
+		return nil
+	case requestErr.Reason == "reading failed":
+		return requestErr.Err
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(requestErr.Err, &schemaErr) {
+		return Error{Fields: fieldErrors(requestErr, false), Malformed: true}
+	}
+
+	// a PATCH carries the properties it changes only
+	fields := fieldErrors(requestErr, v.partial || r.Method == http.MethodPatch)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return Error{Fields: fields}
+}
+
+// fieldErrors lists the schema errors of err by field, or err itself for a body that could not be decoded.
+// Partial leaves out the missing required properties.
+func fieldErrors(err *openapi3filter.RequestError, partial bool) []FieldError {
+	var multi openapi3.MultiError
+	if !errors.As(err.Err, &multi) {
+		multi = openapi3.MultiError{err.Err}
+	}
+
+	fields := make([]FieldError, 0, len(multi))
+
+	for _, e := range multi {
+		var schemaErr *openapi3.SchemaError
+		if errors.As(e, &schemaErr) {
+			if partial && schemaErr.SchemaField == "required" {
+				continue
+			}
+
+			fields = append(fields, FieldError{Field: strings.Join(schemaErr.JSONPointer(), "."), Message: schemaErr.Reason})
+
+			continue
+		}
+
+		fields = append(fields, FieldError{Message: err.Reason + ": " + e.Error()})
+	}
+
+	return fields
+}
+
+// key identifies an operation by its method and its path, with the names of the parameters left out.
+func key(method, path string) string {
+	segments := strings.Split(path, "/")
+
+	for i, s := range segments {
+		if strings.HasPrefix(s, ":") || strings.HasPrefix(s, "*") || (strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}")) {
+			segments[i] = "{}"
+		}
+	}
+
+	return strings.ToUpper(method) + " " + strings.Join(segments, "/")
+}
@@ -0,0 +1,177 @@
+package openapivalidate_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/openapivalidate"
+)
+
+const testSpec = `{
+  "openapi": "3.0.3",
+  "info": {"title": "test", "version": "1.0.0"},
+  "paths": {
+    "/api/v1/admin/profiles/{name}": {
+      "post": {"requestBody": {"content": {"*/*": {"schema": {"$ref": "#/components/schemas/Profile"}}}, "required": true}, "responses": {"200": {"description": "OK"}}},
+      "patch": {"requestBody": {"content": {"*/*": {"schema": {"$ref": "#/components/schemas/Profile"}}}, "required": true}, "responses": {"200": {"description": "OK"}}}
+    }
+  },
+  "components": {"schemas": {
+    "Profile": {"type": "object", "required": ["profileName"], "properties": {
+      "profileName": {"type": "string"},
+      "dhcpEnabled": {"type": "boolean"},
+      "wifiConfigs": {"type": "array", "items": {"type": "object", "properties": {"priority": {"type": "integer"}}}}
+    }}
+  }}
+}`
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	validator, err := openapivalidate.Load([]byte(testSpec))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		method      string
+		route       string
+		contentType string
+		body        string
+		fields      []openapivalidate.FieldError
+		malformed   bool
+	}{
+		{
+			name:   "valid body",
+			method: http.MethodPost,
+			body:   `{"profileName":"p1","dhcpEnabled":true,"wifiConfigs":[{"priority":1}]}`,
+		},
+		{
+			name:   "properties of the wrong type",
+			method: http.MethodPost,
+			body:   `{"profileName":"p1","dhcpEnabled":"yes","wifiConfigs":[{"priority":"high"}]}`,
+			fields: []openapivalidate.FieldError{
+				{Field: "dhcpEnabled", Message: "value must be a boolean"},
+				{Field: "wifiConfigs.0.priority", Message: "value must be an integer"},
+			},
+		},
+		{
+			name:   "missing required property",
+			method: http.MethodPost,
+			body:   `{"dhcpEnabled":true}`,
+			fields: []openapivalidate.FieldError{{Field: "profileName", Message: `property "profileName" is missing`}},
+		},
+		{
+			name:   "PATCH without the required properties",
+			method: http.MethodPatch,
+			body:   `{"dhcpEnabled":true}`,
+		},
+		{
+			name:   "null values",
+			method: http.MethodPost,
+			body:   `{"profileName":"p1","wifiConfigs":null}`,
+		},
+		{
+			name:      "malformed JSON",
+			method:    http.MethodPost,
+			body:      `{"profileName":`,
+			malformed: true,
+		},
+		{
+			name:        "body other than JSON",
+			method:      http.MethodPost,
+			contentType: "application/octet-stream",
+			body:        `{"dhcpEnabled":"yes"}`,
+		},
+		{
+			name:   "unknown route",
+			method: http.MethodPost,
+			route:  "/api/v1/admin/domains",
+			body:   `{"dhcpEnabled":"yes"}`,
+		},
+		{
+			name:   "empty body",
+			method: http.MethodPost,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tc.route == "" {
+				tc.route = "/api/v1/admin/profiles/:name"
+			}
+
+			if tc.contentType == "" {
+				tc.contentType = "application/json; charset=utf-8"
+			}
+
+			req := httptest.NewRequest(tc.method, "/api/v1/admin/profiles/p1", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", tc.contentType)
+
+			err := validator.Validate(req.Context(), req, tc.route)
+
+			if tc.fields == nil && !tc.malformed {
+				require.NoError(t, err)
+
+				// the body is left for the handler
+				body, readErr := io.ReadAll(req.Body)
+				require.NoError(t, readErr)
+				assert.Equal(t, tc.body, string(body))
+
+				return
+			}
+
+			var invalid openapivalidate.Error
+			require.ErrorAs(t, err, &invalid)
+			assert.Equal(t, tc.malformed, invalid.Malformed)
+
+			if tc.malformed {
+				require.Len(t, invalid.Fields, 1)
+				assert.Empty(t, invalid.Fields[0].Field)
+
+				return
+			}
+
+			assert.ElementsMatch(t, tc.fields, invalid.Fields)
+		})
+	}
+}
+
+func TestValidateServedAt(t *testing.T) {
+	t.Parallel()
+
+	validator, err := openapivalidate.Load([]byte(testSpec), openapivalidate.ServedAt("/api/v1/admin/profiles", "/api/v1/profiles"))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/profiles/p1", strings.NewReader(`{"profileName":"p1","dhcpEnabled":"yes"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	var invalid openapivalidate.Error
+	require.ErrorAs(t, validator.Validate(req.Context(), req, "/api/v1/profiles/:name"), &invalid)
+	assert.Equal(t, []openapivalidate.FieldError{{Field: "dhcpEnabled", Message: "value must be a boolean"}}, invalid.Fields)
+
+	// the path of the document is not a route any more
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/admin/profiles/p1", strings.NewReader(`{"dhcpEnabled":"yes"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	require.NoError(t, validator.Validate(req.Context(), req, "/api/v1/admin/profiles/:name"))
+}
+
+func TestValidatePartial(t *testing.T) {
+	t.Parallel()
+
+	validator, err := openapivalidate.Load([]byte(testSpec), openapivalidate.Partial())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/profiles/p1", strings.NewReader(`{"dhcpEnabled":true}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	require.NoError(t, validator.Validate(req.Context(), req, "/api/v1/admin/profiles/{name}"))
+}
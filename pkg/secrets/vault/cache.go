@@ -0,0 +1,260 @@
+package secrets
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+)
+
+// DefaultCacheTTL is how long a cached secret may be used while the secret store is unreachable.
+const DefaultCacheTTL = 24 * time.Hour
+
+// FallbackAudit is called every time a secret is served from the local cache
+// because the secret store could not be reached.
+type FallbackAudit func(key string, cachedAt time.Time, cause error)
+
+// CachedClient is a break-glass wrapper around a secret store. Secrets read or written
+// through it are kept in an encrypted file so that, while the store is unreachable,
+// recently used secrets (no older than the TTL) can still be read.
+//
+// The cache is held in memory, read from the file once when the client is created and
+// written back only when an entry changes, so reading a secret costs no disk access.
+type CachedClient struct {
+	ObjectStorager
+	cryptor security.Cryptor
+	path    string
+	ttl     time.Duration
+	audit   FallbackAudit
+	now     func() time.Time
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	Value    string            `json:"value,omitempty"`
+	Object   map[string]string `json:"object,omitempty"`
+	CachedAt time.Time         `json:"cachedAt"`
+}
+
+// Ensure CachedClient implements ObjectStorager.
+var _ ObjectStorager = (*CachedClient)(nil)
+
+// NewCachedClient wraps store with an on-disk fallback cache at path, encrypted with cryptor.
+func NewCachedClient(store ObjectStorager, cryptor security.Cryptor, path string, ttl time.Duration, audit FallbackAudit) *CachedClient {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	c := &CachedClient{
+		ObjectStorager: store,
+		cryptor:        cryptor,
+		path:           path,
+		ttl:            ttl,
+		audit:          audit,
+		now:            time.Now,
+	}
+
+	c.entries = c.load()
+
+	return c
+}
+
+// DefaultCachePath returns the location of the cache file next to the console database.
+func DefaultCachePath() (string, error) {
+	dirname, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dirname, "device-management-toolkit", "secrets.cache"), nil
+}
+
+// GetKeyValue reads a value from the secret store, falling back to the cache when the store is unreachable.
+func (c *CachedClient) GetKeyValue(key string) (string, error) {
+	value, err := c.ObjectStorager.GetKeyValue(key)
+	if err == nil {
+		c.remember(key, cacheEntry{Value: value})
+
+		return value, nil
+	}
+
+	if entry, ok := c.fallback(key, err); ok {
+		return entry.Value, nil
+	}
+
+	return "", err
+}
+
+// SetKeyValue writes a value to the secret store and caches it.
+func (c *CachedClient) SetKeyValue(key, value string) error {
+	if err := c.ObjectStorager.SetKeyValue(key, value); err != nil {
+		return err
+	}
+
+	c.remember(key, cacheEntry{Value: value})
+
+	return nil
+}
+
+// DeleteKeyValue deletes a value from the secret store and the cache.
+func (c *CachedClient) DeleteKeyValue(key string) error {
+	if err := c.ObjectStorager.DeleteKeyValue(key); err != nil {
+		return err
+	}
+
+	c.forget(key)
+
+	return nil
+}
+
+// GetObject reads an object from the secret store, falling back to the cache when the store is unreachable.
+func (c *CachedClient) GetObject(key string) (map[string]string, error) {
+	data, err := c.ObjectStorager.GetObject(key)
+	if err == nil {
+		c.remember(key, cacheEntry{Object: data})
+
+		return data, nil
+	}
+
+	if entry, ok := c.fallback(key, err); ok && entry.Object != nil {
+		return entry.Object, nil
+	}
+
+	return nil, err
+}
+
+// SetObject writes an object to the secret store and caches it.
+func (c *CachedClient) SetObject(key string, data map[string]string) error {
+	if err := c.ObjectStorager.SetObject(key, data); err != nil {
+		return err
+	}
+
+	c.remember(key, cacheEntry{Object: data})
+
+	return nil
+}
+
+// fallback returns the cached entry for key if err means the store could not be reached.
+func (c *CachedClient) fallback(key string, err error) (cacheEntry, bool) {
+	if !isUnavailable(err) {
+		return cacheEntry{}, false
+	}
+
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok || c.expired(entry) {
+		return cacheEntry{}, false
+	}
+
+	if c.audit != nil {
+		c.audit(key, entry.CachedAt, err)
+	}
+
+	return entry, true
+}
+
+// remember caches entry for key. An unchanged entry is only written again once it is half
+// way through its TTL, so that the secrets in use stay available for the fallback.
+func (c *CachedClient) remember(key string, entry cacheEntry) {
+	now := c.now()
+
+	c.mu.RLock()
+	cached, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if ok && cached.Value == entry.Value && maps.Equal(cached.Object, entry.Object) && now.Sub(cached.CachedAt) < c.ttl/2 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry.CachedAt = now
+	c.entries[key] = entry
+
+	c.save()
+}
+
+func (c *CachedClient) forget(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok {
+		return
+	}
+
+	delete(c.entries, key)
+
+	c.save()
+}
+
+func (c *CachedClient) expired(entry cacheEntry) bool {
+	return c.now().Sub(entry.CachedAt) > c.ttl
+}
+
+// load reads the cache file; a missing or unreadable cache is treated as empty.
+func (c *CachedClient) load() map[string]cacheEntry {
+	entries := map[string]cacheEntry{}
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return entries
+	}
+
+	plain, err := c.cryptor.Decrypt(string(data))
+	if err != nil {
+		return entries
+	}
+
+	_ = json.Unmarshal([]byte(plain), &entries)
+
+	return entries
+}
+
+// save drops the expired entries and writes the others to the cache file, c.mu being held.
+// The cache is best effort, so errors are ignored.
+func (c *CachedClient) save() {
+	for key, entry := range c.entries {
+		if c.expired(entry) {
+			delete(c.entries, key)
+		}
+	}
+
+	data, err := json.Marshal(c.entries)
+	if err != nil {
+		return
+	}
+
+	encrypted, err := c.cryptor.Encrypt(string(data))
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return
+	}
+
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(encrypted), 0o600); err != nil {
+		return
+	}
+
+	_ = os.Rename(tmp, c.path)
+}
+
+// isUnavailable reports whether err means the secret store could not answer,
+// as opposed to the secret not existing or being malformed.
+func isUnavailable(err error) bool {
+	return !errors.Is(err, ErrSecretNotFound) &&
+		!errors.Is(err, ErrKeyNotFound) &&
+		!errors.Is(err, ErrUnexpectedDataFormat) &&
+		!errors.Is(err, ErrValueNotString)
+}
@@ -0,0 +1,271 @@
+package secrets
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+)
+
+var errConnectionRefused = errors.New("dial tcp 127.0.0.1:8200: connect: connection refused")
+
+type fakeStore struct {
+	values  map[string]string
+	objects map[string]map[string]string
+	err     error
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{values: map[string]string{}, objects: map[string]map[string]string{}}
+}
+
+func (s *fakeStore) GetKeyValue(key string) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+
+	v, ok := s.values[key]
+	if !ok {
+		return "", ErrSecretNotFound
+	}
+
+	return v, nil
+}
+
+func (s *fakeStore) SetKeyValue(key, value string) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.values[key] = value
+
+	return nil
+}
+
+func (s *fakeStore) DeleteKeyValue(key string) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	delete(s.values, key)
+	delete(s.objects, key)
+
+	return nil
+}
+
+func (s *fakeStore) GetObject(key string) (map[string]string, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	v, ok := s.objects[key]
+	if !ok {
+		return nil, ErrSecretNotFound
+	}
+
+	return v, nil
+}
+
+func (s *fakeStore) SetObject(key string, data map[string]string) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.objects[key] = data
+
+	return nil
+}
+
+type auditRecord struct {
+	key      string
+	cachedAt time.Time
+}
+
+func newCachedClientTest(t *testing.T) (*CachedClient, *fakeStore, *[]auditRecord) {
+	t.Helper()
+
+	crypto := security.Crypto{}
+	crypto.EncryptionKey = crypto.GenerateKey()
+
+	store := newFakeStore()
+	audits := &[]auditRecord{}
+
+	c := NewCachedClient(store, crypto, filepath.Join(t.TempDir(), "secrets.cache"), time.Hour, func(key string, cachedAt time.Time, _ error) {
+		*audits = append(*audits, auditRecord{key: key, cachedAt: cachedAt})
+	})
+
+	return c, store, audits
+}
+
+func TestCachedClient_FallsBackWhenStoreIsUnreachable(t *testing.T) {
+	t.Parallel()
+
+	c, store, audits := newCachedClientTest(t)
+	store.objects["credentials/devices/t/guid"] = map[string]string{"password": "P@ssw0rd"}
+
+	_, err := c.GetObject("credentials/devices/t/guid")
+	require.NoError(t, err)
+
+	store.err = errConnectionRefused
+
+	obj, err := c.GetObject("credentials/devices/t/guid")
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "P@ssw0rd"}, obj)
+	require.Len(t, *audits, 1)
+	assert.Equal(t, "credentials/devices/t/guid", (*audits)[0].key)
+}
+
+func TestCachedClient_CachesWrittenValues(t *testing.T) {
+	t.Parallel()
+
+	c, store, audits := newCachedClientTest(t)
+
+	require.NoError(t, c.SetKeyValue("default-security-key", "key"))
+
+	store.err = errConnectionRefused
+
+	value, err := c.GetKeyValue("default-security-key")
+
+	require.NoError(t, err)
+	assert.Equal(t, "key", value)
+	assert.Len(t, *audits, 1)
+}
+
+func TestCachedClient_DoesNotFallBack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("secret not found", func(t *testing.T) {
+		t.Parallel()
+
+		c, store, audits := newCachedClientTest(t)
+		require.NoError(t, c.SetObject("certs/domains/t/p", map[string]string{"cert": "c"}))
+
+		delete(store.objects, "certs/domains/t/p")
+
+		_, err := c.GetObject("certs/domains/t/p")
+
+		require.ErrorIs(t, err, ErrSecretNotFound)
+		assert.Empty(t, *audits)
+	})
+
+	t.Run("entry expired", func(t *testing.T) {
+		t.Parallel()
+
+		c, store, audits := newCachedClientTest(t)
+		require.NoError(t, c.SetKeyValue("key", "value"))
+
+		c.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+		store.err = errConnectionRefused
+
+		_, err := c.GetKeyValue("key")
+
+		require.ErrorIs(t, err, errConnectionRefused)
+		assert.Empty(t, *audits)
+	})
+
+	t.Run("entry deleted", func(t *testing.T) {
+		t.Parallel()
+
+		c, store, _ := newCachedClientTest(t)
+		require.NoError(t, c.SetKeyValue("key", "value"))
+		require.NoError(t, c.DeleteKeyValue("key"))
+
+		store.err = errConnectionRefused
+
+		_, err := c.GetKeyValue("key")
+
+		require.ErrorIs(t, err, errConnectionRefused)
+	})
+}
+
+// countingCryptor counts the encryptions and decryptions, one each per write and read of the cache file.
+type countingCryptor struct {
+	security.Crypto
+	encrypted, decrypted atomic.Int32
+}
+
+func (c *countingCryptor) Encrypt(plainText string) (string, error) {
+	c.encrypted.Add(1)
+
+	return c.Crypto.Encrypt(plainText)
+}
+
+func (c *countingCryptor) Decrypt(cipherText string) (string, error) {
+	c.decrypted.Add(1)
+
+	return c.Crypto.Decrypt(cipherText)
+}
+
+func TestCachedClient_KeepsTheCacheInMemory(t *testing.T) {
+	t.Parallel()
+
+	crypto := &countingCryptor{}
+	crypto.EncryptionKey = crypto.GenerateKey()
+
+	path := filepath.Join(t.TempDir(), "secrets.cache")
+	store := newFakeStore()
+	store.values["key"] = "value"
+
+	c := NewCachedClient(store, crypto, path, time.Hour, nil)
+
+	for range 3 {
+		_, err := c.GetKeyValue("key")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(1), crypto.encrypted.Load(), "unchanged reads are not written")
+	assert.Equal(t, int32(0), crypto.decrypted.Load(), "reads never load the file")
+
+	store.values["key"] = "rotated"
+
+	_, err := c.GetKeyValue("key")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), crypto.encrypted.Load(), "a changed value is written")
+
+	start := time.Now()
+	c.now = func() time.Time { return start.Add(31 * time.Minute) }
+
+	_, err = c.GetKeyValue("key")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), crypto.encrypted.Load(), "an entry half way through its TTL is written again")
+
+	// a restarted console loads the cache once and falls back on it
+	store.err = errConnectionRefused
+	restarted := NewCachedClient(store, crypto, path, time.Hour, nil)
+	restarted.now = c.now
+
+	for range 2 {
+		value, err := restarted.GetKeyValue("key")
+		require.NoError(t, err)
+		assert.Equal(t, "rotated", value)
+	}
+
+	assert.Equal(t, int32(1), crypto.decrypted.Load())
+}
+
+func TestCachedClient_CacheFileIsEncrypted(t *testing.T) {
+	t.Parallel()
+
+	c, _, _ := newCachedClientTest(t)
+	require.NoError(t, c.SetObject("credentials/devices/t/guid", map[string]string{"password": "P@ssw0rd"}))
+
+	data, err := os.ReadFile(c.path)
+	require.NoError(t, err)
+	assert.False(t, strings.Contains(string(data), "P@ssw0rd"))
+
+	info, err := os.Stat(c.path)
+	require.NoError(t, err)
+
+	if os.PathSeparator == '/' {
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+}
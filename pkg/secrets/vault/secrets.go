@@ -209,3 +209,8 @@ func (c *Client) SetObject(key string, data map[string]string) error {
 
 	return err
 }
+
+// Capabilities returns the capabilities of the configured token on the secrets path.
+func (c *Client) Capabilities(ctx context.Context) ([]string, error) {
+	return c.client.Sys().CapabilitiesSelfWithContext(ctx, c.path+"/keys")
+}
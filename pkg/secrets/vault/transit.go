@@ -0,0 +1,236 @@
+package secrets
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+)
+
+// Sentinel errors of the transit engine.
+var (
+	ErrTransitKeyNotFound = errors.New("transit key not found")
+	ErrTransitUnsupported = errors.New("unsupported by the transit signer")
+	ErrTransitSignature   = errors.New("unexpected transit signature")
+	ErrTransitCipherText  = errors.New("unexpected transit cipher text")
+	ErrNoLocalKey         = errors.New("secret encrypted with the local encryption key, which is not configured")
+)
+
+// transitHashes are the names of the hashes in the transit sign endpoint.
+var transitHashes = map[crypto.Hash]string{
+	crypto.SHA256: "sha2-256",
+	crypto.SHA384: "sha2-384",
+	crypto.SHA512: "sha2-512",
+}
+
+// TransitSigner signs with the latest version of a key of the Vault transit secrets engine, the private key never
+// leaves Vault.
+type TransitSigner struct {
+	client  *Client
+	mount   string
+	key     string
+	version int64
+	public  crypto.PublicKey
+}
+
+// Ensure TransitSigner implements crypto.Signer.
+var _ crypto.Signer = (*TransitSigner)(nil)
+
+// TransitSigner returns a signer of the key of the transit engine mounted at mount.
+func (c *Client) TransitSigner(mount, key string) (*TransitSigner, error) {
+	path := mount + "/keys/" + key
+
+	secret, err := c.client.Logical().ReadWithContext(context.Background(), path)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil {
+		return nil, fmt.Errorf("%w at path: %s", ErrTransitKeyNotFound, path)
+	}
+
+	version, err := jsonInt(secret.Data["latest_version"])
+	if err != nil {
+		return nil, fmt.Errorf("%w at %s: latest_version", ErrUnexpectedDataFormat, path)
+	}
+
+	versions, ok := secret.Data["keys"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w at %s: keys", ErrUnexpectedDataFormat, path)
+	}
+
+	latest, ok := versions[fmt.Sprint(version)].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w at %s: version %d", ErrUnexpectedDataFormat, path, version)
+	}
+
+	// symmetric keys have no public key
+	publicPEM, _ := latest["public_key"].(string)
+
+	block, _ := pem.Decode([]byte(publicPEM))
+	if block == nil {
+		return nil, fmt.Errorf("%w: key %s has no public key", ErrTransitUnsupported, key)
+	}
+
+	public, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransitSigner{client: c, mount: mount, key: key, version: version, public: public}, nil
+}
+
+// Public returns the public key of the latest version of the transit key.
+func (s *TransitSigner) Public() crypto.PublicKey {
+	return s.public
+}
+
+// Sign asks Vault to sign a digest, PKCS#1 v1.5 or PSS for RSA and ASN.1 encoded for ECDSA.
+func (s *TransitSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	hash, ok := transitHashes[opts.HashFunc()]
+	if !ok {
+		return nil, fmt.Errorf("%w: hash %v", ErrTransitUnsupported, opts.HashFunc())
+	}
+
+	data := map[string]interface{}{
+		"input":                base64.StdEncoding.EncodeToString(digest),
+		"prehashed":            true,
+		"key_version":          s.version,
+		"marshaling_algorithm": "asn1",
+	}
+
+	if _, ok := s.public.(*rsa.PublicKey); ok {
+		data["signature_algorithm"] = "pkcs1v15"
+
+		if pss, ok := opts.(*rsa.PSSOptions); ok {
+			if pss.SaltLength != rsa.PSSSaltLengthEqualsHash {
+				return nil, fmt.Errorf("%w: PSS salt length", ErrTransitUnsupported)
+			}
+
+			data["signature_algorithm"] = "pss"
+			data["salt_length"] = "hash"
+		}
+	}
+
+	secret, err := s.client.client.Logical().WriteWithContext(context.Background(), s.mount+"/sign/"+s.key+"/"+hash, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil {
+		return nil, ErrTransitSignature
+	}
+
+	// vault:v{version}:{base64 signature}
+	signature, _ := secret.Data["signature"].(string)
+
+	parts := strings.Split(signature, ":")
+	if len(parts) != 3 || parts[0] != "vault" {
+		return nil, ErrTransitSignature
+	}
+
+	return base64.StdEncoding.DecodeString(parts[2])
+}
+
+// jsonInt reads an integer of a Vault response, decoded as a json.Number.
+func jsonInt(v interface{}) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Int64()
+	case float64:
+		return int64(n), nil
+	default:
+		return 0, ErrUnexpectedDataFormat
+	}
+}
+
+// transitPrefix starts the cipher texts of the transit engine, "vault:v{version}:{base64}". The base64 cipher text
+// of the local encryption key never contains a ":".
+const transitPrefix = "vault:"
+
+// TransitCryptor is a security.Cryptor encrypting the secrets with a key of the Vault transit secrets engine, the
+// key never leaves Vault. The secrets encrypted with the local encryption key before stay readable with it, when
+// it is configured.
+type TransitCryptor struct {
+	security.Crypto // the local encryption key, decrypts the secrets encrypted before transit was used
+
+	client *Client
+	mount  string
+	key    string
+}
+
+// Ensure TransitCryptor implements security.Cryptor.
+var _ security.Cryptor = (*TransitCryptor)(nil)
+
+// TransitCryptor returns a cryptor of the key of the transit engine mounted at mount, decrypting the older secrets
+// with localKey.
+func (c *Client) TransitCryptor(mount, key, localKey string) *TransitCryptor {
+	return &TransitCryptor{
+		Crypto: security.Crypto{EncryptionKey: localKey},
+		client: c,
+		mount:  mount,
+		key:    key,
+	}
+}
+
+// Encrypt asks Vault to encrypt a secret with the transit key.
+func (t *TransitCryptor) Encrypt(plainText string) (string, error) {
+	secret, err := t.client.client.Logical().WriteWithContext(context.Background(), t.mount+"/encrypt/"+t.key, map[string]interface{}{
+		"plaintext": base64.StdEncoding.EncodeToString([]byte(plainText)),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if secret == nil {
+		return "", ErrTransitCipherText
+	}
+
+	cipherText, _ := secret.Data["ciphertext"].(string)
+	if !strings.HasPrefix(cipherText, transitPrefix) {
+		return "", ErrTransitCipherText
+	}
+
+	return cipherText, nil
+}
+
+// Decrypt asks Vault to decrypt a secret encrypted with the transit key, the older secrets are decrypted with the
+// local encryption key.
+func (t *TransitCryptor) Decrypt(cipherText string) (string, error) {
+	if !strings.HasPrefix(cipherText, transitPrefix) {
+		if t.EncryptionKey == "" {
+			return "", ErrNoLocalKey
+		}
+
+		return t.Crypto.Decrypt(cipherText)
+	}
+
+	secret, err := t.client.client.Logical().WriteWithContext(context.Background(), t.mount+"/decrypt/"+t.key, map[string]interface{}{
+		"ciphertext": cipherText,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if secret == nil {
+		return "", ErrTransitCipherText
+	}
+
+	encoded, _ := secret.Data["plaintext"].(string)
+
+	plainText, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plainText), nil
+}
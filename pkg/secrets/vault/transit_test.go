@@ -0,0 +1,191 @@
+package secrets
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
+)
+
+// newFakeTransit serves the keys, sign, encrypt and decrypt endpoints of a transit engine mounted at transit,
+// holding key. Its cipher texts are the plain texts as they are sent.
+func newFakeTransit(t *testing.T, key crypto.Signer) *Client {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+
+	publicPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/transit/keys/root", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"latest_version": 2,
+				"keys":           map[string]interface{}{"2": map[string]interface{}{"public_key": publicPEM}},
+			},
+		})
+	})
+	mux.HandleFunc("PUT /v1/transit/sign/root/sha2-256", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Input      string      `json:"input"`
+			Prehashed  bool        `json:"prehashed"`
+			KeyVersion json.Number `json:"key_version"`
+			Algorithm  string      `json:"signature_algorithm"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || !body.Prehashed || body.KeyVersion != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if _, ok := key.(*rsa.PrivateKey); ok && body.Algorithm != "pkcs1v15" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		digest, _ := base64.StdEncoding.DecodeString(body.Input)
+		signature, _ := key.Sign(rand.Reader, digest, crypto.SHA256)
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"signature": "vault:v2:" + base64.StdEncoding.EncodeToString(signature)},
+		})
+	})
+
+	mux.HandleFunc("PUT /v1/transit/encrypt/passwords", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"ciphertext": "vault:v1:" + body["plaintext"]}})
+	})
+	mux.HandleFunc("PUT /v1/transit/decrypt/passwords", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"plaintext": strings.TrimPrefix(body["ciphertext"], "vault:v1:")}})
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		// Vault answers an unknown path with no error message
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[]}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	vaultConfig := api.DefaultConfig()
+	vaultConfig.Address = server.URL
+
+	apiClient, err := api.NewClient(vaultConfig)
+	require.NoError(t, err)
+
+	client, err := NewClient(nil, WithClient(apiClient))
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestTransitSigner(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		key  crypto.Signer
+	}{
+		{name: "rsa", key: rsaKey},
+		{name: "ecdsa", key: ecKey},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			signer, err := newFakeTransit(t, tc.key).TransitSigner("transit", "root")
+			require.NoError(t, err)
+			require.Equal(t, tc.key.Public(), signer.Public())
+
+			// a certificate signed through Vault verifies with the transit key
+			template := &x509.Certificate{
+				SerialNumber:          big.NewInt(1),
+				Subject:               pkix.Name{CommonName: "Test CA"},
+				NotBefore:             time.Now().Add(-time.Hour),
+				NotAfter:              time.Now().Add(time.Hour),
+				BasicConstraintsValid: true,
+				IsCA:                  true,
+				KeyUsage:              x509.KeyUsageCertSign,
+			}
+
+			der, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+			require.NoError(t, err)
+
+			cert, err := x509.ParseCertificate(der)
+			require.NoError(t, err)
+			require.NoError(t, cert.CheckSignatureFrom(cert))
+		})
+	}
+}
+
+func TestTransitSigner_KeyNotFound(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, err = newFakeTransit(t, key).TransitSigner("transit", "missing")
+	require.ErrorIs(t, err, ErrTransitKeyNotFound)
+}
+
+func TestTransitCryptor(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	local := security.Crypto{EncryptionKey: "Jf3Q2nXJ+GZzN1dbVQms0wbB4BamdsXG"}
+	client := newFakeTransit(t, key)
+	cryptor := client.TransitCryptor("transit", "passwords", local.EncryptionKey)
+
+	cipherText, err := cryptor.Encrypt("P@ssw0rd")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(cipherText, "vault:v1:"))
+
+	plainText, err := cryptor.Decrypt(cipherText)
+	require.NoError(t, err)
+	require.Equal(t, "P@ssw0rd", plainText)
+
+	// the secrets encrypted before are read with the local key
+	older, err := local.Encrypt("0ld-P@ssw0rd")
+	require.NoError(t, err)
+
+	plainText, err = cryptor.Decrypt(older)
+	require.NoError(t, err)
+	require.Equal(t, "0ld-P@ssw0rd", plainText)
+
+	_, err = client.TransitCryptor("transit", "passwords", "").Decrypt(older)
+	require.ErrorIs(t, err, ErrNoLocalKey)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
 	"github.com/device-management-toolkit/console/pkg/db"
 	"github.com/device-management-toolkit/console/pkg/logger"
+	"github.com/device-management-toolkit/console/pkg/openapivalidate"
 	redfishgenerated "github.com/device-management-toolkit/console/redfish/internal/controller/http/v1/generated"
 	v1 "github.com/device-management-toolkit/console/redfish/internal/controller/http/v1/handler"
 	sessioninfra "github.com/device-management-toolkit/console/redfish/internal/infrastructure/sessions"
@@ -52,7 +53,7 @@ var (
 )
 
 // Initialize initializes the Redfish component with DMT infrastructure.
-func Initialize(_ *gin.Engine, log logger.Interface, _ *db.SQL, usecases *dmtusecase.Usecases, config *dmtconfig.Config) error {
+func Initialize(_ *gin.Engine, log logger.Interface, database *db.SQL, usecases *dmtusecase.Usecases, config *dmtconfig.Config) error {
 	// Initialize configuration with defaults
 	auth := config.Auth
 	componentConfig = &ComponentConfig{
@@ -61,6 +62,16 @@ func Initialize(_ *gin.Engine, log logger.Interface, _ *db.SQL, usecases *dmtuse
 		BaseURL:      "/redfish/v1",
 	}
 
+	// Select the Base message registry version used for error and task messages
+	registryVersion := config.Redfish.BaseRegistryVersion
+	if registryVersion == "" {
+		registryVersion = v1.DefaultBaseRegistryVersion
+	}
+
+	if err := v1.GetRegistryManager().SetActiveVersion("Base", registryVersion); err != nil {
+		log.Warn("Base registry version %s is not available, using embedded default: %v", registryVersion, err)
+	}
+
 	// Check if we should use mock repository (for testing)
 	useMock := os.Getenv("REDFISH_USE_MOCK") == "true"
 
@@ -90,6 +101,13 @@ func Initialize(_ *gin.Engine, log logger.Interface, _ *db.SQL, usecases *dmtuse
 	sessionRepo := sessioninfra.NewInMemoryRepository(sessionCleanupInterval)
 	sessionUseCase := sessions.NewUseCase(sessionRepo, config)
 
+	// Persist SessionService settings (e.g. SessionTimeout) in the console database
+	if database != nil {
+		if err := sessionUseCase.UseSettings(sessioninfra.NewSQLSettingsRepository(database)); err != nil {
+			log.Warn("Failed to load persisted SessionService settings: %v", err)
+		}
+	}
+
 	// Initialize the Redfish server with configuration
 	server = &v1.RedfishServer{
 		ComputerSystemUC: computerSystemUC,
@@ -113,6 +131,16 @@ func Initialize(_ *gin.Engine, log logger.Interface, _ *db.SQL, usecases *dmtuse
 	return nil
 }
 
+// OpenAPISpec returns the Redfish OpenAPI document as JSON so it can be merged into the server-wide spec.
+func OpenAPISpec() ([]byte, error) {
+	swagger, err := redfishgenerated.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	return swagger.MarshalJSON()
+}
+
 // isPublicEndpoint checks if the request path is a public endpoint.
 func isPublicEndpoint(path, method string) bool {
 	// Public endpoints as defined in OpenAPI spec (security: [{}])
@@ -161,8 +189,25 @@ func createAuthMiddleware() redfishgenerated.MiddlewareFunc {
 	}
 }
 
-// RegisterRoutes registers Redfish API routes.
-func RegisterRoutes(router *gin.Engine, _ logger.Interface) error {
+// createRequestValidationMiddleware creates the middleware validating the request bodies against the embedded
+// OpenAPI document, or one letting every request through when the document cannot be loaded.
+func createRequestValidationMiddleware() redfishgenerated.MiddlewareFunc {
+	swagger, err := redfishgenerated.GetSwagger()
+	if err != nil {
+		server.Logger.Warn("Redfish request validation is disabled: %v", err)
+
+		return func(*gin.Context) {}
+	}
+
+	// the schemas require the properties of the resources as served, such as Id and Name
+	validate := v1.RequestValidationMiddleware(openapivalidate.New(swagger, openapivalidate.Partial()))
+
+	return redfishgenerated.MiddlewareFunc(validate)
+}
+
+// RegisterRoutes registers Redfish API routes. The systems are not changed while readOnly, when set, reports
+// the console in read-only mode.
+func RegisterRoutes(router *gin.Engine, _ logger.Interface, readOnly func() (enabled bool, retryAfter int)) error {
 	if !componentConfig.Enabled {
 		server.Logger.Info("Redfish component is disabled, skipping route registration")
 
@@ -181,6 +226,16 @@ func RegisterRoutes(router *gin.Engine, _ logger.Interface) error {
 		middlewares = append(middlewares, createAuthMiddleware())
 	}
 
+	// Maintenance windows of the console leave the systems as they are
+	if readOnly != nil {
+		middlewares = append(middlewares, redfishgenerated.MiddlewareFunc(v1.ReadOnlyMiddleware(readOnly)))
+	}
+
+	// JSON bodies are checked against the Redfish schemas once the caller is authenticated
+	if server.Config.RequestValidation {
+		middlewares = append(middlewares, createRequestValidationMiddleware())
+	}
+
 	// Register handlers with OpenAPI-spec-compliant middleware
 	redfishgenerated.RegisterHandlersWithOptions(router, server, redfishgenerated.GinServerOptions{
 		BaseURL:      "",
@@ -188,6 +243,8 @@ func RegisterRoutes(router *gin.Engine, _ logger.Interface) error {
 		Middlewares:  middlewares,
 	})
 
+	registerRegistryRoutes(router, middlewares)
+
 	if componentConfig.AuthRequired {
 		server.Logger.Info("Redfish API routes registered with authentication")
 	} else {
@@ -210,6 +267,24 @@ func RegisterRoutes(router *gin.Engine, _ logger.Interface) error {
 	return nil
 }
 
+// withMiddlewares chains the middlewares of the generated OpenAPI handlers before a handler registered by hand.
+func withMiddlewares(middlewares []redfishgenerated.MiddlewareFunc, handler gin.HandlerFunc) []gin.HandlerFunc {
+	handlers := make([]gin.HandlerFunc, 0, len(middlewares)+1)
+	for _, m := range middlewares {
+		handlers = append(handlers, gin.HandlerFunc(m))
+	}
+
+	return append(handlers, handler)
+}
+
+// registerRegistryRoutes registers the message registry resources, which are served from the
+// embedded registries rather than the generated OpenAPI handlers, behind the same middleware chain.
+func registerRegistryRoutes(router *gin.Engine, middlewares []redfishgenerated.MiddlewareFunc) {
+	router.GET("/redfish/v1/Registries", withMiddlewares(middlewares, server.GetRedfishV1Registries)...)
+	router.GET("/redfish/v1/Registries/:RegistryId", withMiddlewares(middlewares, server.GetRedfishV1RegistriesRegistryID)...)
+	router.GET("/redfish/v1/Registries/:RegistryId/Registry", withMiddlewares(middlewares, server.GetRedfishV1RegistriesRegistryIDRegistry)...)
+}
+
 // createErrorHandler creates an error handler for OpenAPI-generated routes.
 func createErrorHandler() func(*gin.Context, error, int) {
 	return func(c *gin.Context, err error, statusCode int) {
@@ -2,6 +2,7 @@
 package redfish
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -330,6 +331,29 @@ func TestEmbeddedOpenAPISpec(t *testing.T) {
 	assert.Greater(t, len(embeddedOpenAPISpec), 0, "OpenAPI spec should not be empty")
 }
 
+// TestOpenAPISpecDescribesChassisAndManagers tests that the served OpenAPI document describes the Chassis and Managers.
+func TestOpenAPISpecDescribesChassisAndManagers(t *testing.T) {
+	t.Parallel()
+
+	data, err := OpenAPISpec()
+	require.NoError(t, err)
+
+	var spec struct {
+		Paths map[string]interface{} `json:"paths"`
+	}
+
+	require.NoError(t, json.Unmarshal(data, &spec))
+
+	for _, path := range []string{
+		"/redfish/v1/Chassis",
+		"/redfish/v1/Chassis/{ChassisId}",
+		"/redfish/v1/Managers",
+		"/redfish/v1/Managers/{ManagerId}",
+	} {
+		assert.Contains(t, spec.Paths, path)
+	}
+}
+
 // TestExtractServicesFromOpenAPIData tests service extraction from embedded spec.
 func TestExtractServicesFromOpenAPIData(t *testing.T) {
 	t.Parallel()
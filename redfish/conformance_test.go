@@ -0,0 +1,258 @@
+//go:build redfish_conformance
+
+// Package redfish provides a protocol conformance harness for the Redfish component.
+//
+// The harness registers the full Redfish route table against the mock computer
+// system repository and checks the responses against an embedded subset of the
+// assertions exercised by the DMTF Redfish Service Validator and Protocol Validator.
+// It is excluded from the default test run; execute it with:
+//
+//	go test -tags redfish_conformance ./redfish/...
+package redfish
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const (
+	conformanceUser      = "admin"
+	conformancePassword  = "testpassword"
+	conformanceSystemID  = "550e8400-e29b-41d4-a716-446655440001"
+	conformanceMissingID = "550e8400-e29b-41d4-a716-4466554400ff"
+)
+
+var (
+	// odataTypePattern matches a versioned OData type, e.g. "#ComputerSystem.v1_22_0.ComputerSystem".
+	odataTypePattern = regexp.MustCompile(`^#[A-Za-z]+\.v\d+_\d+_\d+\.[A-Za-z]+$`)
+	// collectionTypePattern matches an unversioned collection type, e.g. "#ComputerSystemCollection.ComputerSystemCollection".
+	collectionTypePattern = regexp.MustCompile(`^#[A-Za-z]+Collection\.[A-Za-z]+Collection$`)
+	// messageIDPattern matches a registry message ID, e.g. "Base.1.22.0.ResourceMissing".
+	messageIDPattern = regexp.MustCompile(`^[A-Za-z]+\.\d+\.\d+\.\d+\.[A-Za-z]+$`)
+)
+
+// conformanceResource describes a GET-able resource checked by the harness.
+type conformanceResource struct {
+	path       string
+	odataID    string
+	collection bool
+}
+
+// conformanceError describes a request that must produce a Redfish error payload.
+type conformanceError struct {
+	name   string
+	method string
+	path   string
+	body   string
+	auth   bool
+	status int
+}
+
+// setupConformanceServer registers the complete Redfish route table, including the
+// OData and authentication middleware, against the mock repository.
+func setupConformanceServer(t *testing.T) *gin.Engine {
+	t.Helper()
+
+	router, testServer := setupTestServer(t)
+	testServer.Logger = logger.New("error")
+	testServer.Config.RequestValidation = true
+
+	require.NoError(t, RegisterRoutes(router, testServer.Logger, nil))
+
+	return router
+}
+
+// doConformanceRequest performs a request against the router, optionally using Basic Auth.
+func doConformanceRequest(router *gin.Engine, method, path, body string, auth bool) *httptest.ResponseRecorder {
+	var req *http.Request
+	if body == "" {
+		req = httptest.NewRequest(method, path, http.NoBody)
+	} else {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if auth {
+		req.SetBasicAuth(conformanceUser, conformancePassword)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+// assertProtocolHeaders checks the response headers required by DSP0266 for JSON payloads.
+func assertProtocolHeaders(t *testing.T, w *httptest.ResponseRecorder) {
+	t.Helper()
+
+	assert.Equal(t, "4.0", w.Header().Get("OData-Version"), "Req.Headers.OData-Version")
+	assert.True(t, strings.HasPrefix(w.Header().Get("Content-Type"), "application/json"),
+		"Req.Headers.Content-Type: got %q", w.Header().Get("Content-Type"))
+}
+
+// TestConformance_ResourceAnnotations verifies OData annotations and headers on every GET-able resource.
+//
+//nolint:paralleltest // Cannot run in parallel - modifies global state (server, componentConfig)
+func TestConformance_ResourceAnnotations(t *testing.T) {
+	router := setupConformanceServer(t)
+
+	resources := []conformanceResource{
+		{path: "/redfish/v1/", odataID: "/redfish/v1"},
+		{path: "/redfish/v1/SessionService", odataID: "/redfish/v1/SessionService"},
+		{path: "/redfish/v1/SessionService/Sessions", odataID: "/redfish/v1/SessionService/Sessions", collection: true},
+		{path: "/redfish/v1/Systems", odataID: "/redfish/v1/Systems", collection: true},
+		{path: "/redfish/v1/Systems/" + conformanceSystemID, odataID: "/redfish/v1/Systems/" + conformanceSystemID},
+		{path: "/redfish/v1/Chassis", odataID: "/redfish/v1/Chassis", collection: true},
+		{path: "/redfish/v1/Chassis/" + conformanceSystemID, odataID: "/redfish/v1/Chassis/" + conformanceSystemID},
+		{path: "/redfish/v1/Managers", odataID: "/redfish/v1/Managers", collection: true},
+		{path: "/redfish/v1/Managers/" + conformanceSystemID, odataID: "/redfish/v1/Managers/" + conformanceSystemID},
+		{path: "/redfish/v1/Registries", odataID: "/redfish/v1/Registries", collection: true},
+		{path: "/redfish/v1/Registries/Base.1.22.0", odataID: "/redfish/v1/Registries/Base.1.22.0"},
+	}
+
+	for _, res := range resources {
+		t.Run(res.path, func(t *testing.T) {
+			w := doConformanceRequest(router, http.MethodGet, res.path, "", true)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+			assertProtocolHeaders(t, w)
+
+			var payload map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &payload), "Resp.Body.JSON")
+
+			assert.Equal(t, res.odataID, payload["@odata.id"], "Resp.OData.Id")
+
+			odataType, ok := payload["@odata.type"].(string)
+			require.True(t, ok, "Resp.OData.Type must be present")
+
+			if res.collection {
+				assert.Regexp(t, collectionTypePattern, odataType, "Resp.OData.Type")
+
+				members, ok := payload["Members"].([]interface{})
+				require.True(t, ok, "Resp.Collection.Members must be an array")
+				assert.InDelta(t, float64(len(members)), payload["Members@odata.count"], 0, "Resp.Collection.Count")
+
+				for _, m := range members {
+					member, ok := m.(map[string]interface{})
+					require.True(t, ok)
+
+					memberID, _ := member["@odata.id"].(string)
+					assert.True(t, strings.HasPrefix(memberID, res.odataID+"/"), "Resp.Collection.MemberId: %q", memberID)
+				}
+			} else {
+				assert.Regexp(t, odataTypePattern, odataType, "Resp.OData.Type")
+				assert.NotEmpty(t, payload["Id"], "Resp.Resource.Id")
+				assert.NotEmpty(t, payload["Name"], "Resp.Resource.Name")
+			}
+		})
+	}
+}
+
+// TestConformance_ServiceDocuments verifies the OData service document and metadata document.
+//
+//nolint:paralleltest // Cannot run in parallel - modifies global state (server, componentConfig)
+func TestConformance_ServiceDocuments(t *testing.T) {
+	router := setupConformanceServer(t)
+
+	w := doConformanceRequest(router, http.MethodGet, "/redfish/v1/odata", "", false)
+	require.Equal(t, http.StatusOK, w.Code)
+	assertProtocolHeaders(t, w)
+
+	var doc struct {
+		Context string `json:"@odata.context"`
+		Value   []struct {
+			Name string `json:"name"`
+			Kind string `json:"kind"`
+			URL  string `json:"url"`
+		} `json:"value"`
+	}
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.True(t, strings.HasPrefix(doc.Context, "/redfish/v1/$metadata"), "Resp.ServiceDocument.Context: %q", doc.Context)
+	assert.NotEmpty(t, doc.Value, "Resp.ServiceDocument.Value")
+
+	for _, svc := range doc.Value {
+		assert.Equal(t, "Singleton", svc.Kind, "Resp.ServiceDocument.Kind for %s", svc.Name)
+		assert.True(t, strings.HasPrefix(svc.URL, "/redfish/v1"), "Resp.ServiceDocument.URL for %s", svc.Name)
+	}
+
+	w = doConformanceRequest(router, http.MethodGet, "/redfish/v1/$metadata", "", false)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "4.0", w.Header().Get("OData-Version"), "Req.Headers.OData-Version")
+	assert.True(t, strings.HasPrefix(w.Header().Get("Content-Type"), "application/xml"), "Resp.Metadata.Content-Type")
+	assert.Contains(t, w.Body.String(), "<edmx:Edmx", "Resp.Metadata.Edmx")
+}
+
+// TestConformance_ErrorFormat verifies that error responses follow the Redfish error payload format.
+//
+//nolint:paralleltest // Cannot run in parallel - modifies global state (server, componentConfig)
+func TestConformance_ErrorFormat(t *testing.T) {
+	router := setupConformanceServer(t)
+
+	cases := []conformanceError{
+		{name: "unauthenticated", method: http.MethodGet, path: "/redfish/v1/Systems", status: http.StatusUnauthorized},
+		{name: "missing resource", method: http.MethodGet, path: "/redfish/v1/Systems/" + conformanceMissingID, auth: true, status: http.StatusNotFound},
+		{name: "method not allowed", method: http.MethodDelete, path: "/redfish/v1/Systems", auth: true, status: http.StatusMethodNotAllowed},
+		{name: "malformed json", method: http.MethodPost, path: "/redfish/v1/SessionService/Sessions", body: "{", status: http.StatusBadRequest},
+		{
+			name:   "invalid reset type",
+			method: http.MethodPost,
+			path:   "/redfish/v1/Systems/" + conformanceSystemID + "/Actions/ComputerSystem.Reset",
+			body:   `{"ResetType":"NotAResetType"}`,
+			auth:   true,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "property of the wrong type",
+			method: http.MethodPatch,
+			path:   "/redfish/v1/Systems/" + conformanceSystemID,
+			body:   `{"Boot":{"BootSourceOverrideEnabled":5}}`,
+			auth:   true,
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := doConformanceRequest(router, tc.method, tc.path, tc.body, tc.auth)
+			require.Equal(t, tc.status, w.Code, w.Body.String())
+
+			assertProtocolHeaders(t, w)
+
+			var payload struct {
+				Error struct {
+					Code         string `json:"code"`
+					Message      string `json:"message"`
+					ExtendedInfo []struct {
+						MessageID  string `json:"MessageId"`
+						Message    string `json:"Message"`
+						Severity   string `json:"Severity"`
+						Resolution string `json:"Resolution"`
+					} `json:"@Message.ExtendedInfo"`
+				} `json:"error"`
+			}
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &payload), "Resp.Error.JSON")
+			assert.Regexp(t, messageIDPattern, payload.Error.Code, "Resp.Error.Code")
+			assert.NotEmpty(t, payload.Error.Message, "Resp.Error.Message")
+			require.NotEmpty(t, payload.Error.ExtendedInfo, "Resp.Error.ExtendedInfo")
+
+			for _, info := range payload.Error.ExtendedInfo {
+				assert.Regexp(t, messageIDPattern, info.MessageID, "Resp.Error.ExtendedInfo.MessageId")
+				assert.NotEmpty(t, info.Message, "Resp.Error.ExtendedInfo.Message")
+				assert.Contains(t, []string{"OK", "Warning", "Critical"}, info.Severity, "Resp.Error.ExtendedInfo.Severity")
+			}
+		})
+	}
+}
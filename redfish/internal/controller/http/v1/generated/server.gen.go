@@ -24,6 +24,18 @@ type ServerInterface interface {
 	// (GET /redfish/v1/$metadata)
 	GetRedfishV1Metadata(c *gin.Context)
 
+	// (GET /redfish/v1/Chassis)
+	GetRedfishV1Chassis(c *gin.Context)
+
+	// (GET /redfish/v1/Chassis/{ChassisId})
+	GetRedfishV1ChassisChassisId(c *gin.Context, chassisId string)
+
+	// (GET /redfish/v1/Managers)
+	GetRedfishV1Managers(c *gin.Context)
+
+	// (GET /redfish/v1/Managers/{ManagerId})
+	GetRedfishV1ManagersManagerId(c *gin.Context, managerId string)
+
 	// (GET /redfish/v1/SessionService)
 	GetRedfishV1SessionService(c *gin.Context)
 
@@ -96,6 +108,88 @@ func (siw *ServerInterfaceWrapper) GetRedfishV1Metadata(c *gin.Context) {
 	siw.Handler.GetRedfishV1Metadata(c)
 }
 
+// GetRedfishV1Chassis operation middleware
+func (siw *ServerInterfaceWrapper) GetRedfishV1Chassis(c *gin.Context) {
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRedfishV1Chassis(c)
+}
+
+// GetRedfishV1ChassisChassisId operation middleware
+func (siw *ServerInterfaceWrapper) GetRedfishV1ChassisChassisId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ChassisId" -------------
+	var chassisId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ChassisId", c.Param("ChassisId"), &chassisId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ChassisId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRedfishV1ChassisChassisId(c, chassisId)
+}
+
+// GetRedfishV1Managers operation middleware
+func (siw *ServerInterfaceWrapper) GetRedfishV1Managers(c *gin.Context) {
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRedfishV1Managers(c)
+}
+
+// GetRedfishV1ManagersManagerId operation middleware
+func (siw *ServerInterfaceWrapper) GetRedfishV1ManagersManagerId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ManagerId" -------------
+	var managerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ManagerId", c.Param("ManagerId"), &managerId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ManagerId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRedfishV1ManagersManagerId(c, managerId)
+}
+
 // GetRedfishV1SessionService operation middleware
 func (siw *ServerInterfaceWrapper) GetRedfishV1SessionService(c *gin.Context) {
 
@@ -358,6 +452,10 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 
 	router.GET(options.BaseURL+"/redfish/v1/", wrapper.GetRedfishV1)
 	router.GET(options.BaseURL+"/redfish/v1/$metadata", wrapper.GetRedfishV1Metadata)
+	router.GET(options.BaseURL+"/redfish/v1/Chassis", wrapper.GetRedfishV1Chassis)
+	router.GET(options.BaseURL+"/redfish/v1/Chassis/:ChassisId", wrapper.GetRedfishV1ChassisChassisId)
+	router.GET(options.BaseURL+"/redfish/v1/Managers", wrapper.GetRedfishV1Managers)
+	router.GET(options.BaseURL+"/redfish/v1/Managers/:ManagerId", wrapper.GetRedfishV1ManagersManagerId)
 	router.GET(options.BaseURL+"/redfish/v1/SessionService", wrapper.GetRedfishV1SessionService)
 	router.PATCH(options.BaseURL+"/redfish/v1/SessionService", wrapper.PatchRedfishV1SessionService)
 	router.PUT(options.BaseURL+"/redfish/v1/SessionService", wrapper.PutRedfishV1SessionService)
@@ -438,6 +536,120 @@ func (response GetRedfishV1MetadatadefaultJSONResponse) VisitGetRedfishV1Metadat
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type GetRedfishV1ChassisRequestObject struct {
+}
+
+type GetRedfishV1ChassisResponseObject interface {
+	VisitGetRedfishV1ChassisResponse(w http.ResponseWriter) error
+}
+
+type GetRedfishV1Chassis200JSONResponse ChassisCollectionChassisCollection
+
+func (response GetRedfishV1Chassis200JSONResponse) VisitGetRedfishV1ChassisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRedfishV1ChassisdefaultJSONResponse struct {
+	Body       RedfishError
+	StatusCode int
+}
+
+func (response GetRedfishV1ChassisdefaultJSONResponse) VisitGetRedfishV1ChassisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetRedfishV1ChassisChassisIdRequestObject struct {
+	ChassisId string `json:"ChassisId"`
+}
+
+type GetRedfishV1ChassisChassisIdResponseObject interface {
+	VisitGetRedfishV1ChassisChassisIdResponse(w http.ResponseWriter) error
+}
+
+type GetRedfishV1ChassisChassisId200JSONResponse ChassisChassis
+
+func (response GetRedfishV1ChassisChassisId200JSONResponse) VisitGetRedfishV1ChassisChassisIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRedfishV1ChassisChassisIddefaultJSONResponse struct {
+	Body       RedfishError
+	StatusCode int
+}
+
+func (response GetRedfishV1ChassisChassisIddefaultJSONResponse) VisitGetRedfishV1ChassisChassisIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetRedfishV1ManagersRequestObject struct {
+}
+
+type GetRedfishV1ManagersResponseObject interface {
+	VisitGetRedfishV1ManagersResponse(w http.ResponseWriter) error
+}
+
+type GetRedfishV1Managers200JSONResponse ManagerCollectionManagerCollection
+
+func (response GetRedfishV1Managers200JSONResponse) VisitGetRedfishV1ManagersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRedfishV1ManagersdefaultJSONResponse struct {
+	Body       RedfishError
+	StatusCode int
+}
+
+func (response GetRedfishV1ManagersdefaultJSONResponse) VisitGetRedfishV1ManagersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetRedfishV1ManagersManagerIdRequestObject struct {
+	ManagerId string `json:"ManagerId"`
+}
+
+type GetRedfishV1ManagersManagerIdResponseObject interface {
+	VisitGetRedfishV1ManagersManagerIdResponse(w http.ResponseWriter) error
+}
+
+type GetRedfishV1ManagersManagerId200JSONResponse ManagerManager
+
+func (response GetRedfishV1ManagersManagerId200JSONResponse) VisitGetRedfishV1ManagersManagerIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRedfishV1ManagersManagerIddefaultJSONResponse struct {
+	Body       RedfishError
+	StatusCode int
+}
+
+func (response GetRedfishV1ManagersManagerIddefaultJSONResponse) VisitGetRedfishV1ManagersManagerIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type GetRedfishV1SessionServiceRequestObject struct {
 }
 
@@ -848,6 +1060,18 @@ type StrictServerInterface interface {
 	// (GET /redfish/v1/$metadata)
 	GetRedfishV1Metadata(ctx context.Context, request GetRedfishV1MetadataRequestObject) (GetRedfishV1MetadataResponseObject, error)
 
+	// (GET /redfish/v1/Chassis)
+	GetRedfishV1Chassis(ctx context.Context, request GetRedfishV1ChassisRequestObject) (GetRedfishV1ChassisResponseObject, error)
+
+	// (GET /redfish/v1/Chassis/{ChassisId})
+	GetRedfishV1ChassisChassisId(ctx context.Context, request GetRedfishV1ChassisChassisIdRequestObject) (GetRedfishV1ChassisChassisIdResponseObject, error)
+
+	// (GET /redfish/v1/Managers)
+	GetRedfishV1Managers(ctx context.Context, request GetRedfishV1ManagersRequestObject) (GetRedfishV1ManagersResponseObject, error)
+
+	// (GET /redfish/v1/Managers/{ManagerId})
+	GetRedfishV1ManagersManagerId(ctx context.Context, request GetRedfishV1ManagersManagerIdRequestObject) (GetRedfishV1ManagersManagerIdResponseObject, error)
+
 	// (GET /redfish/v1/SessionService)
 	GetRedfishV1SessionService(ctx context.Context, request GetRedfishV1SessionServiceRequestObject) (GetRedfishV1SessionServiceResponseObject, error)
 
@@ -947,6 +1171,110 @@ func (sh *strictHandler) GetRedfishV1Metadata(ctx *gin.Context) {
 	}
 }
 
+// GetRedfishV1Chassis operation middleware
+func (sh *strictHandler) GetRedfishV1Chassis(ctx *gin.Context) {
+	var request GetRedfishV1ChassisRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRedfishV1Chassis(ctx, request.(GetRedfishV1ChassisRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRedfishV1Chassis")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetRedfishV1ChassisResponseObject); ok {
+		if err := validResponse.VisitGetRedfishV1ChassisResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRedfishV1ChassisChassisId operation middleware
+func (sh *strictHandler) GetRedfishV1ChassisChassisId(ctx *gin.Context, chassisId string) {
+	var request GetRedfishV1ChassisChassisIdRequestObject
+
+	request.ChassisId = chassisId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRedfishV1ChassisChassisId(ctx, request.(GetRedfishV1ChassisChassisIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRedfishV1ChassisChassisId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetRedfishV1ChassisChassisIdResponseObject); ok {
+		if err := validResponse.VisitGetRedfishV1ChassisChassisIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRedfishV1Managers operation middleware
+func (sh *strictHandler) GetRedfishV1Managers(ctx *gin.Context) {
+	var request GetRedfishV1ManagersRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRedfishV1Managers(ctx, request.(GetRedfishV1ManagersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRedfishV1Managers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetRedfishV1ManagersResponseObject); ok {
+		if err := validResponse.VisitGetRedfishV1ManagersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRedfishV1ManagersManagerId operation middleware
+func (sh *strictHandler) GetRedfishV1ManagersManagerId(ctx *gin.Context, managerId string) {
+	var request GetRedfishV1ManagersManagerIdRequestObject
+
+	request.ManagerId = managerId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRedfishV1ManagersManagerId(ctx, request.(GetRedfishV1ManagersManagerIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRedfishV1ManagersManagerId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetRedfishV1ManagersManagerIdResponseObject); ok {
+		if err := validResponse.VisitGetRedfishV1ManagersManagerIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetRedfishV1SessionService operation middleware
 func (sh *strictHandler) GetRedfishV1SessionService(ctx *gin.Context) {
 	var request GetRedfishV1SessionServiceRequestObject
@@ -18,242 +18,272 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e3cbN5I4+lVw+jfnZOYuxdhJdnZG/9yRJXuimzjSlezMns1kTbAbJLFuAhwALZmT",
-	"1Xf/HVTh1U82qUdsT/5JZDYehUKhUFWoxy9ZLtcbKZgwOjv+JdP5iq0p/HmSGy7FuVjId5dU0TUzTL3Z",
-	"bhh8ZKJaZ8c/ZS+kLBkV2ST7oVrPmQp/nChFt9kkuzaKi2X4w/98Mf8flpvwB/788yQz2w3LjjPte304",
-	"sjOdMZ0rvrHwwOx+1uPshMzx72kEwf4q4M9pA5zj7EQQav8kcuHa6GkEB76z9ZwVBSvI/3d98QOR8GXa",
-	"gLQ+UNIQRnNrtnDgQqaN9de7Yxs9ze7uJp1YhzXTouD2Ey0vldwwZbjdiQUtNZtkRURQdpy9WTHCxUKq",
-	"NbW/EDqXlSGUbPyAhIu8rOwauSCUXLFiwfWKUJibLKQiZsU1UUzLSuXMgr9JJv0lOylLeUvnJUPswm9t",
-	"GKhvZVHNFM/JDS0rpolUpKgUAoc/TRAkzW8YUVQsbaNF+EaFhTRXbM2EoSXRhm38WAHauDqqCd1sSs4K",
-	"YiR+dEszVC0Z7CY3bA1gi6osLYzZsVEVa1Lg3SRTjBYXotw2GlBHyR+OSimWZ43FW3gQY1uiV7QsCRcF",
-	"z6lhxAxg5knRMLEtCrbgAgnBAvZF2Nl0YHdSAAC/cfoLkpe00sxCaLt6KrresJwv7Fq5FFNCXkmFtK4n",
-	"DsI6YhTbKKaZMA3MJPMzmq/ceVkzONiEdOFYinJL5oz4AR3sgOCAFyA/3N70R7OihmiAfUtoWOYUtviG",
-	"Kc2lOLF8ITvObp6/+/rdM0scAV2X1BimxK6DsMFmh1Jtk1h7aDNhn+OIM5fCUOAFii2rkirCPlgkapje",
-	"YgYXNWe6f5f2Xc+vThotIhi72T/a+ZuXUn3b06/2hIzA2K/ClvzOWwQXB8Bssd3oiQPTzYZR5fmKpmvm",
-	"bzxVIHXY32d1nOpZgG9v8hgFfZtcerb8m64t33nPfR530sd3pmEr7A/X/J/sNf3A11b87NqLNX50VxbQ",
-	"AQzESrg1Nc7Iip7zh0JTdpxxYf74zXiOy4VhSxB/Dzl4O4EGFgzQaqZueM6IrjYbqUzvmWxPKKRp3Y1S",
-	"HOFMu3ngV+5AhF3gYmAX8GP/ghT7R8XVx7YNo6Geb+u78cSbcEYNfQMr7kI+qCNhXouZHixLwS4W2fFP",
-	"v2S/U2yRHWf/58uoDX7pVMEv+/XAu0lQBO3+/Hz3c3tjDtmHkQuwiGALWpUGmHM3MgpsgeylBw+PLVWZ",
-	"EXAQjlJ0XnJLGIVkGohlo+QNL5BD70lcfEFmV45iZx4YTWZ2UbMpIecLMvOUNIszeqhnqLHOJniBe77j",
-	"V3bDFLJwXJGRhApy9er0T1/9+5+PvFYBOxl14C6S/nckacfUB3bSM0nH+VjxEHsqvNWid08dSyFBDRrD",
-	"yB1Oern23vdkfeB9BN8ejtOxrJ3c57nbKmSTQ1vlGOlHvVUOxoffqtrAv+5W/UDXPVskrEDuNPfIhMIG",
-	"oVRnR38MZtg9+ZBRCrj9D3KY3587cTbIS0wxwi1Xe8hrYB5MngeI2fuChcTHPtD1pmQTy9IrzRTgj4qC",
-	"bKjWt1IVqSGDKkbkBk2FyLvpXDORtzGuA2QATK5YwYThtMRBKs2KB7hpLN3aBunezQiMCb13XAxodh2W",
-	"eApqKMoJVmYTzhZ7NKeaFU9z1XvzCCDYItELLnATguHWkvXsL9LCOjVw5aKU67Z99/U+Z3WxOdnHW25W",
-	"hNbxMEPMAf5nifF6hobEXpbTHFZago1DaziKfrfb+3EiPFXZNcsFuV0xGKFx2BNp2khi5c6SwREZw33u",
-	"cQbvAc4daN5+4T8hd42PFtK/aezLEh/aVv/hyJkZL2tG+//+/U/06J8nR//17mf3x7OjP7/7+f/5w//7",
-	"l98DWf6vG/x/XzOt6ZL94e9/n3Z3+V3XOWwvMJpTb2jJCwKzWHr0qwjGnuzOIvdUrjeVYep6qw1bn8qy",
-	"ZLDMd30fDngYyUNnOCT1kWcBj4QLbajImW6/frhDbLePfTD2lyH9CRof3Xzzzre/m/gRmKHL0d2hcezL",
-	"i9E9eZH0M46LjuoJjUHPStD4y0jF8cqh8l3ae7fOaCVMNvCqhCKUxtuM62RDaza0kZi5YotHsKbS5Hmv",
-	"5OK9RnPZMPBx4YHAKrEPednWrUEE+2C+5+L96HFCh0R+HLXN0Nje2Gw9uo9t22Srfve70ZEegDpRT/Zn",
-	"yOG0NwV5Gj/tYBiBTwAvjgya2xsEHuriM9JnwZbfoUHokEdpekM5iF/u+tJj3pr/T3366RXTbOepaICM",
-	"fexaRpMGiFFt1WXkEj6bva6MtJJJfsWM2p5KseDL1P/jjGuLDHsUocWJMWy9MTr8u7ylWz3esyOMd+z/",
-	"JNSDQJQdkYulPYdzKY2zKqUTHWf4R+xFy3KLPdt9PLDH2cnQJFY6RF3CCmgOpZwVbljgS8C+cU3f1wmq",
-	"uS7YJVT4utRDuguSwg3VXvqOkcGMB9tKbnlZEnoQpkZMEu3ndjSOTgxduMQXwBqJ+ZniQyCqK9D0iq0p",
-	"F1wsd/VJFNsmQG4EQl0nFOsbZP9CSnMAf7NrrEnzo1xpONV2vgtVMNU+1PAzK6I8AZO4u4nazkzHi8uu",
-	"DDQbq05rY68yaO8fXbXMOTWsQNXOiSC4dEcbNSFqnKDXgbxrgK9D2rt7CNlKjsGJs3bMIjgzZOpApwFj",
-	"egBfUUqro8jp5zh9VKQ9hEW1KfFI4KPmlJAfuTIVLUnBbniQFQTCSvRKVmVBDH0PmnjOCjTX3NgtI5vV",
-	"VtvD6fpOCUE8a7KmW0/GVr4EwBdKrsm6Kg3flCxMh84tK6pYmLXP7PJH987XecJ6bInxvcy1a/OaYSbT",
-	"eu9b+9fFZ5O+1/D7vfXdD2bv1sBuvNXXdWQCbXCCMKXAxcSSgRTQsddY+7wL5/Gy7VJi7TfvQicXZCVv",
-	"UzA83/Xg1pYy/ulvjDDQpdAdsiH3XtIQZi0P+MHp6m1sAou4gF+u2IIpe/pmnn/YbwQ/2kO2YcoSK6FE",
-	"CnZk+Jol5+52xUTKcC5umFK8YG/AOQKMnrO3bME9OLMuk+RDGNgHV/T25atzx+U24ZaSgpGwmppnILx1",
-	"u15N3743+P7Hi7bPiAfO8pwoMTn4vB9oRPEFXlCWo05JFw5fCpB6ZkQz4HazU2mvu0pWeobWdJO8N1k4",
-	"BjbCj1HbDDJnOTg0uncgEySu6CMJWKC6tf2u9Q5bdtyVA0wUAzJC6k3cvfXOx7hx9w1cfRaB/TICIacr",
-	"dE91do2kp9luAssEpd6KzP4MW8TP2UIqsJRv8c5jiwXLzZSQc2PRXfL3rNw6adg/iC+4KJzL2F+c6jK9",
-	"RqTrGTFMrZG2ElnLOcwuCIdhF7ISxSR9ZF/T9wztvkwbWEoOq0pX4zZW22OwlgVfbL2IFS2F8GoC5O3O",
-	"Sh+I+zl5PaAN6gGJooceXkmFhwN/8S+lXPcwj+AiAJ8CbY85QLadE8e31yyxBA+/cibriL4lDb2o0oGH",
-	"9eDg4MszgN7vO3PAWwbPVz0L23NNA6JgLy/uxrkTeRKku3MiXW+yYNRUit0Lld0APYQwMruAw4HWvDqX",
-	"9ytAzlK7gbA5qpdHNDf8hh1VwvDyKKciZ2VpNV7XHX1v/B0E000SVxpkYe6rNxzMCF0Y92w1cLF5o9SK",
-	"3rDwilUQXeU503pRWVmpwbxTTBQS7lFa+qlenF9c9zCDmsA27SaU17LoebKFgWG0tSzAfcjevCBAJbRr",
-	"G2iUrXYsPCG0B6QrgP+hJNxHXvKAG0jfGD3KRaVU2Gt3dh2w3sDDPhhv7NCG0cIfd2H1uBI/oe4JPgtD",
-	"4lzb7yyQ/ANs5INtXsQDLC7ZMLtVE3+2vXCQIgJ5LArLUTJoOb5TISQ+FgR5WXuuMCBgW2nZirFBx4iW",
-	"r9QkEG5h3BayoWaFIhH4JcQR2ls181NESXnXJN3yRpwuKkA9phHnT/7EphE/a5Do1OMxy1OmDEpD7ABl",
-	"4FtjNhaJbxXvPsRvr87rCx+hm6ZakZ1gVjPNVIofKb+Zj6S3OqiDki3It2/eXBKp4P+Oex60Erhy6/vH",
-	"A9+BrbEXtIoq5CQAFLxvnC8uiPmUnH17egneghgXEXXcfoW5h0v/Gbn0Duv2LttbYtquPyHglbK3me1R",
-	"XOw7zG4R7qYB7iFMbujm/Mx7MWOXFQWHty6MAYXhxAHCurvUoFlwsROyIecuR7ZRFLSqW99bR7XB16gg",
-	"2iHsDupdFrFrI4GBXIhXtCo7JIHzVIBHLqyN3OATGHjyHXw9N+d+sDvam2xGgd6JHadnvlGVNqx4LYuq",
-	"ZN7X7Y30z0LtY+g6EOzhqQj6rZ2P40GoGgLkodA2AvZOXLkQuSHRoeduasohTnp0P7nryqq2/gYba1h1",
-	"/34ss2of4EGzRdnQh8I9iuRwTx+G+PhYj+vtfrukyaPg5+LS0DDEdFIog6hxGmJJZ/VOswADWBNXkqN3",
-	"daclJlHz7CYoWZZMHWJkyyZp2g1nC540H5BHu1o0H57ReJPYjFx8btpqVltXioRew9LOGQ4fvGdzneaX",
-	"eKn8IIWVWC8/2P++KuVms80m2WmRTbK3ep5Nsm8L+/cLLvU1M9XG/m54yYHGJ9kZp0v7f8thrlesLLOU",
-	"62WT7PrslKrC/WrlTfCbWEvDzhS/Ye5DeISyH3PLM/ZJeRJgOwaUejkBOIe2H0gFIMNb2GnhmwW97PTM",
-	"HpGzH8+mYUX1kdZUVAuam0ox9YUmBadLIbXhORzApaJAgQ57zdEX8DMpuH5PCrvmqcNq2pCSFVVF/A7b",
-	"0hypbUKYuq1rtrxU7Aiasf9keQU866W44UoKe22R31/+58s/TFNkx/V6vnZUMM2XAjwilGtmV2slKohb",
-	"WNMlmzZ2s74kBV9wURMrja3gfUgQfn16fZ5EdzsiqfUW5PqM5FSBM0+NRup7k148wWYdteiEQ3rLwTSl",
-	"xjrEMBjoVYKZW6nek1JGBSUSeQcI8GFaJ/9+SFvPfsNmhoRv47msg+0YhyX4ozjy2+sXCZnEUztI25Vv",
-	"5inb7rX7UyeeXD/WhJ5fUkqyApDV25qk4YJ0k+12ZrjW/rpXjdo2eZPdEGPrMMJ3eONdoJIeTdTjWU3S",
-	"p8ay0TLpMOrtM60t7hfSApGCXbytjNcNfzWPuQYMyCPw2R8WepxdCMLtl2CZzLd5ySZty2p46E5fLpsv",
-	"FEGEe7NiYtK3sGDDHLLbT8ftpreU+638ni1pbm8pe6DG753r1oEzLiCYMD7+eyP0LiR4GLrHPGC8DnzU",
-	"/3mA513LLRv1qdTNi0aBSnqZKnhvBx9DLRfmlip2dMM1n5fxmVdP4EIA6+ItNysf121lz01JRcL812wt",
-	"1XZCTi/f4kuRC6FKLY7ckJwKQkF5nxJyxgzlpXOJkDqZF61Sc/euCv4UtNQS4gqYBVrJarna5Wb4YDEr",
-	"Txp3kria7+On5HrdoUjnuHi3pO/0Wq/JJZcM3gl8TdU2mPq5WlvieKqkAR44l6jIwejeZ8otUi88LVoq",
-	"U5UAYxYAb7WID3/6o3+Ody/0SIjJEz2L06/pNskD1Zi602eyDznuxWnPXXM2jaeONfpWatMfJn32wzVZ",
-	"SW3Ak2ACBx/i84RV4teN4L3HMjsEAKJuiDgHPy3FnM+TszvbYSjYwdzGSEVW2w1TN1xLNSXkJMS4e4pJ",
-	"52l1T/15MOWMiS7gUb6iS3gteZ34yKSrstSF/jN4m3q0z+zk9k6WiqylYmT20tKoYOZcGKYWNGezhBc6",
-	"tHh5I2ACiO68GB13dF5g0okoFvYlnYgtLIgXL18Hr+Colj9+WrYkS0HjFNYhTLmYjyiTantdre1R3fNE",
-	"1vvawWTBym5EbZQsqryPTP25aQFsmz9JXLb3Jq3NDs9Z0T4Xvbs9yU9SWuee7fWvdNdCDw2pu5S3TF0b",
-	"atjwk/3Gtqt74EQq3ZOTJnM+VqKfAXDtolEPl+ow6m11v5tk10xxWvo8sZ3eS9DCv1M1tvep7v0uIGqo",
-	"sdtS6dFk5JrbjjDGmxGyWPPNJnZsxmv2x2OeFw8clIkSfHI5OYR15x79jIzWyIpfc6Uk5haOmqLTmSbZ",
-	"2fnr19kk+3Y7V7AXYF4bH3lne9d0vJBizn4iaz+3dwKCX0t2w8opIeei4De8qGgJv2tQbubM9cJgNQdY",
-	"9xyUrOBzex7X0kq4cUo9ck5nYkxmDP5GburG6sBS57TQPZDhWvWiw2ptkM6LhvQdcQwvylgNulM9bl3i",
-	"e2rHqJA2dBwuyJIJpsB9xiqfbaWxg+o6gnrnYHZGDdrlHnETxiXG/EqNV42YZ+nQJ9ImkI91WT3gOoeS",
-	"azGjeH6AR9DBV8IbaWiJuERU/pW/6N5pY1vW/GUausIRmjPAbuJQ8/urk9d/mJA1oxq6cEH+yl9MD/M9",
-	"2Z0CbGj/1rISxu5asgK3Of4gbCq1kZqRG1lSw0uG4Pu1gGknrmPJ53y+NcxtaSU4BMj+lb/YPt4zLRgA",
-	"mDAWWgfW5/pQ2yX/7ZvsxWFq44fS+zPBU6nYqc/EMeSAFSYhuVRMh9THYWN+ffcrPMER5BZ++kAfcgAZ",
-	"hxzvqplsxSeAoAB2ByU1wL+bZN/LpW0cCHcUZkrs9IkhxkO9Gy/DaT8Pve6GTRGOmK1AVcbYF2evtB/o",
-	"/0hlL/P02OqWTelptL0+eHehdkI2zpEkwYFvEmiQnCc+PUfJ76/ompdbomNuG9vv+jWYj2uKFDm7vnz2",
-	"/OtvyFfTP1nsldTn3z1c6FhZTHKx7A0lGsgj57oSrgnD7uDaV5bDezkyd1zie2l2JnO0v8wCTlNLZX1+",
-	"Cz3GPCbNMfFuGD/mTTy9fDvDEJ7grh5X7fUWt/RhV8anEEMSpNdEERKJTYeeMXd2Mt2ckZKvufFp5Vk6",
-	"JkzMPrC8MuHazqUPwop+uS5PXtq10k7XkotFKWlBFpXIUXxwjjKfi6wUkiLtJSDF9P3wcK0bfLsuB5me",
-	"kKHvuXhvt42LG/nep0UaChxoB7pyU3YYNl8pzkRRbj2Mgq47uu9D4W4gb1eCt/o0w4AzNMW3lPCeis9n",
-	"/g0WX3B9gi9ycnp5TsBcSl5UxkhRpzPnP3BZ6RU0wjazWhIdH/EgFWHryjJYQkXHwHaQJCHOK6lydrFY",
-	"dA/WOcJFiGHM5XoNZVHeC3krMKp9ISt1pFkuRUFWsgyBXWipncMQn925ucII8Bey2D7uEYLZxhhfw40Z",
-	"e/xG6L8R+j0IvSPeI3jHsht0JBZb/Dbehdj3qJtKk5CLGHgv4NE8RF/gpLV+bjc1NHRuoMEp0wc2FxXY",
-	"2dBbfeHjOYjM80rpHUnYauD2ZzGrByHVl9Ge0oXzOOlDTyzt+NCyKNRMsJM7URQSXvgFU5Ez950YWTKF",
-	"P1hlnG5o7iSViLCxkOfox4e1GRqxkF2o6yCa2muRp5dLpxNnk8zFbmaT7OI6m4Qv5faSKgNMFJwRXbPm",
-	"z3Y2qeHPs8u3Q2S3UcwuEdSEi+s6FlZUkzljghShmRW+F/RGuri4JDda02KpfZITRmYX/lszF3BCVm1f",
-	"SbeE4+ykZfLNpdBGVbnjs3O7U2IZ37fmpcwhNewSNJupw0PXUEA24YCAx7XjvFjryvmhoehrJ6kEN4k7",
-	"MrleU2V+OD+d4l6BftVy9fDecNNkL7vAmfbutW28oqoAF7qQfx0/I6AdQ3kqsp1vXDTwmuYrLmIa5uCr",
-	"Ilt6XSd1pWPZC8279Y2DaZCN1PZ8Fs/ILOSHtV9ndceZ6IVot6dkXTQCjs4F13S5VGzpnNK9Phn9/OJz",
-	"J0gOGhfifHpSImrBdmb1ylFg1enNq0+IJ5eoXB9EdW2gLq77YBIEvfCi9xK54ey27dlQo9XWBP5rzzTg",
-	"+eRIllB7zRp3pryZJa4H/ZxAFoqxPMOnoRecWtNDqSX1nAq2yii9IcnQ4JsH2Kwfz4Ru0nPYAtt97Ac0",
-	"IRyUQOFMWnml50hPCblieiOFZiEHYi2JWfMBYIZpV2qvobOajULacW4bVjXbyT2VxONESwh6iBaHBpSD",
-	"jKUPOQ++pR6o9o42GFrYxd7YhYRrufKGnlHcPH/33KX6qXXtuHZvnr/7qidAYUfIbGfCYOdDckh24B9k",
-	"M241CVn1Ys40ncVuXFRXmr0bBSCw9/1T+tKOeUSMry16IB0a0K/B8ttRiwAzOygh79z/D3IhgJ4x+sjf",
-	"QcGXlJlKCd3lRBDmbA+7qtZUHClGC4qPxtD2MeoO0d65In5O1FIPZ9fzaKBqWSWlGSHJYTXXhpvKhKqG",
-	"aTNfdcr1h3DQUsr3rCDVpvlVsSXXRm1r+eIeuwIs/fWWCZn/Vq4kLJSK1WxNBcQe+kKxyRbV8jzXiNG3",
-	"unIj+zgQn/6DkVwqBZcOiOIzqGiIfByPWzTC41vXzvJ37kkMgwrRWT5we5q+rPiS9JHcznsyqMX0MwHD",
-	"j3owZgGeWWdN8PDZVSwaV/k7Wei11V252Y42e33LaGlWBxWTsHy0tJdW3WDTONKQIQYf/7zw0HihqOH8",
-	"VzmEV69O//jnZ8/rxRQ3kou0dFdI+L17IZCKi25sS8XtXdIiLkCelmXVnVHxrcZ7xdek1NVyybRTQgW6",
-	"WEuQscobZ47gpsJDAPwD0qsWj03Q3p6Jy/BE6ick5BrPMvoLKrYpac6anRpHoMmxfMEtENJcDGSe9uci",
-	"9NnQrVWedAO914ZterIPlFwbtFzkcr1morACnm1+EHpHVcSpw/Wu/s8HTkb6AMsaW5UuvTk8E8KCaPFf",
-	"ycmJKSC/m+0owZuytCIRlJGEu/zKsX2THEfRf5zgilHdgexdNrAWDiY+wUqSZK6K6Xxr+S/8WfDsYobc",
-	"ObmFXTzkDN/3HeoOcXvvxFHnnYRTjbuJBo48XvojTzs2hjMPVdBzkCMdyrrq/dy0Fak0xLte9MiLBD/f",
-	"78mejhbVHV79ZfHZutK7n18qJXuCPjA9l2PUPt9AA2ttxYb1D9jrNxFygY2d5C8Oa9OXHwywzHOxkKN0",
-	"lLC3aDWNRgUEwLcafUU0dcjDLwXEQ0vZQHIfDfbdJMs708ueeDk8EZFovKUTadfvQpfe9fBi9v2huptk",
-	"6z5t+qSp39bw1dB7QjU6/FhjG4+LhAcHsslIgSYinkZz0x7OdxQZ5D7+UTvPeRNsZCaPC20Aahenawmq",
-	"TYHwAC8JVw0XwdOQOKcuBgazPkIR1J4oVXcUTwJL2GWoWNvDjWNFWzeH8494e3UeEncH0d3CckARJATF",
-	"cud3CTyPUQDJHLikkE/h7dV5TyjPCLT0unU9hho3alEHmAguFZdRem/f3viVxLySqLfU5v5IMqqOBTYU",
-	"a6kYmT2bdTkPrPhyxbQJQ0IgW64Yhbc9pyE0gzILFlqk/RbE3MpwdzehQefWYO3zHdHWhr6eoLrHKlwd",
-	"YwRhggdhwmXaPl+0SSUG/sHcNCKOas2XkDNs4ryp0TGCa/LMoS0i2TmVGkkKe/zWXLB9gG7w1v6S5j7C",
-	"rGOQ/WK5O5k4epQ9UqDcIOh3rqLgqNAIX/NpNzP6CBIa43IHAfaLh9QWN7S8Bv+znluLu0Zkzsyt1e0/",
-	"AWx4mCf2TKJ3nZ6EBYzAUBpIh4EC4HV86mm79+py+cjsTeHT5Pg+B9xlTxH2sT/EvZmjmxavcM3PWtnO",
-	"Q37ztgjaYA8H2iGiCHyIhIfsNtxhTUMhVSzw39RimHsjECWlXBImjIK6oZgtfAIuPgLlVXCsC5JwYob7",
-	"hO0bQ1w+eXs/tdSXmx+ZKKSCB2cwhIXDlU2yV85R5O2moIZBGw0pTsG19nSbl+HHN/IML0oNrnxQJPss",
-	"JA09o4Zmk+zi5et9Eg92DXLsf09SkoLj0dTlN4xLOvb/JjfwA5yjxLTSWN1xdhmq+QUPmQq+ed84VDrs",
-	"KpLGgly8fN1BvYGvJMhK50DfYkhMWB+/tRHH/ifnnAa/TsN2pIOid3djuPr2HOMvaY50sPQuaG6k2nqB",
-	"x/mABln51B+ZPdW9k3jYfMYiYDIavFBFtyYXJtstEoXRD0hqU5/msSWgGqSdjwnuNeF7uXxpedYBcZD7",
-	"e1ekGaYT+J7K4SK17zWh+M0V4/N3xfiXcoA4wDZxofiSi4tFjf3uxxSGHvGvdz7aPypX6H6cj1Mmb3XO",
-	"JfRxXuh3JTV42If6Gko/vQf5dHtOfPXUnAoMjnGmj6Qsas1MI0XeGAX9FNfyxru81iLQ+jbmT+2H9z19",
-	"id7wNdOGrjc98gVkha4BCrExLpVUUBatfAhFIR/weNTscIOA3E2yt5qpk8qsLJNEXhNLHnR4Hrg0zAtC",
-	"a30Ca600UwLNcL5ms9Yy5+BFEMJjaiT8FPrx26vv/RNQA3D/jh18zTvAbS3Ka8+Oq8xZjA4PSwulcHVV",
-	"hrzzdiRniO4V4565Ujhu0u6NCCAFi30OGYk+CmwfBtyj4/SxvCSaWTOoKFwCIO1DgmoqDPvAtUkSf6dp",
-	"Cp2tpqwF7AxpPp+0paFDjUpMDCclU3YvkqQjEB547ROY71Ofxg7l8jilV4e9uuy3aWuejsY8bTCtQdLR",
-	"Gl5B4L5wEUC+8a7I0gBrv99+n1IMj945mqZipFEIlG2vcP8pAvVNyJpyYZgIIaZa2jMPiVXgUKIF17f2",
-	"8LhIMPRZCEUOoMyxnVmw21pu9DqOR8MbfK/7twErJ4cy/87B1V0IXa7atLylWx1cVusKpxUelQ9Balg/",
-	"GmnI2+w8ZR8+V0wSRv82csL12gXIhzc6LE4AIiwyyWn2MGx8B1DxCTB0BTNSYO1fJNON13KaslbCEC6+",
-	"yybZ36gSuKZTxaFy4h5GQd8DDEvuH8kWBiLn6zUrwK+5xmwvvoNoIbXG4+9BqZupuvh0jRr6NNVK8H9U",
-	"HQprej8k1SJytGS63dF8zUuqYlTaQ1HBEDijqOC8OGDz+7Pop4JF7doEDWTNMDDiYdYe52re0elkY5Bg",
-	"13MAGi6GCpj0uQdfvHxN2AfDhHZkO1q4wRbNgFY7XjSwuuUGbNSe8qFK/Daue5djURJnpz9ZaWaS/fdP",
-	"J0f/RY/+CVP82+/2sdJc4KbURaJ6ivbA/oRlQYuFfxTgYgk/hX/gJ/BwH88TbS+km7ClFh12TFYQuVi4",
-	"DY/rSJlQ6LPmy5WppYkA14iTt/+JY2F5paGZgFQd9B3N4MO0sVzLeQ1bb6SC4iGQ/N2/EDtJfPQKciqw",
-	"Nriv6ORrt8VULnKxSDSNZBMG4bCdBCzwweAQAYzeGN2AyZvn755/7fSfjlxATQLz2XGySfZXRXO2qMrr",
-	"VWUKeSuSn66YNhSkc2gf//nDmodBgDjrCXyar3/Xld4wUfidxwenCswhr6qyTNqOJuiwgOPsTaUQ/wav",
-	"VxMv9nJLfi+kOFq6BRHtFgll/jz8fgjROcK0ufzjzKKK2GFqM9ntTydzPynsFsaetld9DD/UXvt2r+UP",
-	"U0JeMHv+NCn5e4YGMsipgGUdSckF0xOykGUpb1H2tcBg3ulaI282SU1qTSJIlz1iiS2yGugfzt3U0dZx",
-	"9lfIsQsyf/KIC7qGqjbGR6OAd5VLGyDID6/P7S4mVYwmEOFv5ManBwrPnnqC9xgzLJ3BZQ2CRFKTGI8/",
-	"IStamkYSiU7SCfwtO8b/p45eCYXNARcutHstb9ypD9mBdJIMgJIFo6ZSIFQ0kyPEXBe6/ZJ8P4ryD9AA",
-	"IC3rpBQ/WpJiXSTUZAvH2TV3KbzQEdElAfGZtELG3ySlVkjf4pHrWMex+6ONXrxEsP19sek513H2N8V9",
-	"xqS0+ghg1EisYzpnC0ir4a6McCtxzB5xq2NlDhjDSFfF3SKwwAc3f1POaf6e1Hh/lxkhZYODaZ4S3aIs",
-	"iVFUuCwwEIYcL75YcZ6lrVxiK3vIU9ZIyNuNFGlFeXemwBaAKTmClBNf/iCuNOjkk646Zuh1erFYzBqc",
-	"+v6rFE+zyIFViVnHpfJwK5sQuoA64a4VvLP4NCCOV388W+yQ0bwR90HHJjrlNO9Rqi0gg5cdvIz71yV/",
-	"nUE5kPE48lym4AvwCXR261lc0gxNOVDI8Qt4Gc9LqTHVGrFKXhPsjcS6CC7BIvtALdon8Zo/OfVd6Lxk",
-	"OhQIZsKObPmbS4YokmvfLrzREU1kaY3AuhDqaGpvLjLBuXeewoHJa0Pte7ofn26d33iwH/qKL0kJsDmW",
-	"9lLMFXTxlT25NlzkprbpC3tFdJkkfQLnGjlRZ9fmFCh6Vj9BM0+U3rMIbbBQshGkkqQIn7N1evJoBn9a",
-	"5SRCGRNjjOtt7z5IXthM5Oxh6lwwc2k8Y6ZUMLn6GxY6GhfytVHgs+lfma1CJSu0NLRl2I+Iy2L3BVfa",
-	"pOZyQ/V7TExFF1ZE00Fq7ki7GrgCyA62KQACzVtZ6iYDNRu9iIKesq2pnXbKNPAU5Fy5FAJNk/qpbokO",
-	"peKpxJ7fdmt/sQ1VuX12aDms9iX2pQcUA5+EdL1OeC/IUbN5GrjRtDRr6ZSHCWb1G+S+YlXjrktEq0TP",
-	"C8koS5nTkv+TeUODZv+omMgTzTNCveIlA7u6EkER5ZpoA8tBg3nzNJsKXojI2Sm5kaVxTmVWz8XyQYso",
-	"iA039TrfbwLZPc5Zy+awD8HOGcb6aR8Z022TgKcEy2G9jgD9uHRVnZxZo7+/FcPmjBRsw0RhydqZLtqm",
-	"BZ/PPEzguvqKZFaCr1lFPj3OGG0s+8CO+yS8HT7x2iC3ihvDYE0bpjTXxqLYl2QLR3GnMPAUl2SvZb+l",
-	"EPtMmvEq8Tb/BoMOyToDTcSGEdedDwat1yhfbGeSpuG8NlQU8+3FYmH16fjD9YYqKOIq3jBt8Hdl0Ip/",
-	"MtcYL/RW0BvKwTstDnDGFkw5e///X3Gmc5gIom78r7TkC46gsKWixfDzV5qINPbdKw14uqqBhN5uZW5o",
-	"n2uTSEUK5jUgIU3CUn3wHTQxoBr5uy7QeC3/a043vpYkhqIFFMLdhkSPSqaf1FU2DfX+pzUsuyQ2rkhD",
-	"iHP2b1D2WOVyvaai0KDB/qNilW3DbuExl2lUr8JG4Hjp2tNXvcI1m6Zk1IGvWh/XzvYJFZ+GuyTljRwF",
-	"7uhQiYKppQRWzTRme1foApYmBIa4HLoxlUvGEhnNAnZwXi2Xrg5sndIihv9ROW+e2mMgzXO2MbCNiool",
-	"MP5aWFY4CvXRkipWdnOgUEUi8oPyqTho3C4u0m/mtH16xyEVJqK3lGOW7SAgOf08de1CK32NJeyYY2MF",
-	"P4kR+EUlCiryLUBuebWflCwoL+UNFtVHI8ooKJAB7YBAu3bTbga1C353ni2OcipcUjn/kh2YWGsTK/cF",
-	"803D07rtF+dHDuEPz7A/YY0L9dyhLrtXN4+icyyZ3Q5mwbGj09FIF5cmwxm62x+WFY2SIpqnKOjYEODj",
-	"0e5KLE/GJW/GQKsBhhO2toOvHTIi3AxlyjmQiOsMcJgcYhD5E/DEMURwHy45cvwH559Dm5dumPcf4eW2",
-	"dtItICja53LNkgs+2BRbXLePzY4FZdT0tDLS7qx7Ok2ZtWfIkwEYwWM48Zn1by/5CraSi6jyUOFM48iR",
-	"EkLv4uTDJJ0u0xWy8fnBalL/zB3B2TDjP+RkppeCZWg+82PfzTCScP2d4fRO/yXZu8b9gUeFEQA2cDUo",
-	"Ow/KaqwoZFs3yh32aicpZ3cpTms82GoXf4Yf0+N/8/yd/zEc2dC7E/0u92UNVzGl6kDxgca0AExL1cFC",
-	"pHvmtdfQEe7s1OM8viaKgliJJV/xslBsKHK9I0gwfiOtsJHURb/usrxn5rGOgP2HTDsWUz35J5wblob3",
-	"4BJA8PGLCIvtDI0JLnVhCEvvriZWLcGsVP7vK1mW1WbW59Pu35vsQEqWJak2sXRqMpGE2FoUDFwokh9p",
-	"Qqpg5pkFTJ4Ll03cI3kWo+h95OILqhlxTqzkKgZSQ0a1mJeqlhAA1+z4JkUBKIUzzysQjalOf6Y+ldZN",
-	"K/ahN2zyOR6u6JnfkSYACT8xV6V06i9uKzZiHCGHJJ3e2FXzXt+TYkNs5sPlYaiTa3tprO6YLyvwgNW8",
-	"QCefvrUlDuO64TEOl6DUrTTC/b7iKU13b4m9ie0cNfgDxcUqSOlR+NTQ71nro6IbjfvxUojwpn7sE6IZ",
-	"66gPESN0mrOcUUMJFCF/mKj/YKvrvqJa2Dtgt3GKh9zseunBbjDvW4LaG4UruE0cQSV5V2vX9ScdXekS",
-	"H1xJad59z8X7Q8SZ0vazRwSl77R8nEsa4m9jOEYN9jF6nw5IPhsgG4YJLFPgWxPiAeGuw2DFai5VYS9w",
-	"BjU1Wwv4LPY++fsACpgl3Wcx0tDn3YXDCQVZF10OPhPiy6I53euLLxW2+PLm+Rfk7dX5lJCTsmzRF4V3",
-	"FZ7quOnw8Oa/oFZ+cKKhUwztXlvSYAUpuGK5KbeoZIZ/hVsvpK939bIaidIBxVNLM+yDGZ0zxbe/m/gR",
-	"eLFHwpWknxlR1zn0NJh+KmtEme7J0dPeu/n63SQ7L0bfS+ewtsCHhvq0GdfdJPPRgKMmg8aQl1cWVW56",
-	"k+rbjx0pCRKOlIjDj5U2wZX6bUQbRvha5bW7weuS1r/2yWegtVOFu7HhOvecYzgfyAqz4+y///734t/+",
-	"/vdp8r/fPWDykrrMl3A4I3NZekghs5LLxJOYoy8bzUaZoyEQxFUwgQQUjjU4GRHT7GJhQr1/+qS3b8/P",
-	"OqofdYb6Rn/RpKzl31ZMkOvrs0ufL3iSOpHWwtwhxZad0FXlQMxQQb598+aSzL569oxcfDeLFjfvFgyj",
-	"z14fXb88uTr9duat574+dsE1Fs7uOggJYfz07OjP9GhxcvTq51/+dHeU/vObff75/Ku73z1StqgE4d20",
-	"nmL+fNGP946CM4+NfEKuXp1+8/yrr5IbeM3MShYgClVoQs0VQyc5AAWAbQQo+/QqXlN1shIjckP/Aa1P",
-	"0V3WlwX1fcAabuz4sGCvVuK4kGiiEtyePDAIt6PZrY7mhrISGVzjGwWPeVsrji04K4uagd+uAY6fz8nZ",
-	"zbwgPSc4wIhqQXNTKTvbR8rZa0BK5cEfw+idf3U6l+VX0aPERHTAlLVSVg6gwC1n7oJM4lKQXHruk3/v",
-	"yKETxZy66ALSgbu398muE/T5rstgQNT8xOV1bVF9GvI5vGv9coDsXk8PMXNDzlJbHHI6/YjyLzN0Obo7",
-	"NP7XkJ1fQ+aInkyAmFZCB6Nc3MnRGf/acsgjZhUN2rjZAXxceCAwl7h/JHnZ1q1BBPtgrKYwepzQ4VDN",
-	"Ym+TXCvzGO5+NzomA1z14Rhq8izVxykCg3DCadC/O6rTfdqs1ymd7zDX/SG2suRZHMfoSJaT3T/N3Mhp",
-	"Ppf9qP/zMANWOkKPDUtjo6AD+MeEeClOUIascTorw9Ey5G11g/x2m96BS68sK8Mc9t9g3F3PbUc/8HW1",
-	"ToqfuFof3o3C7w7XRG6Y8IF+qRUP4pjqe6nYkqqidA5J8NIMfgtdlU0Qguz4T3/85tmzpNLJ188eqJTJ",
-	"k68xLXwyWHq2e6eC+1lHznRfchBvi9sVA+OtlQfcUAE8F24JnjabTcldSFpyThxy51KWjIpDUtzeY37Q",
-	"pmZWGuquyw6xcb51fF+A/fHJGtobGo1S7k191o3iWfp4mG6jhz5sJ8IJLA68GHiHDoh+kXCzd60FvIw2",
-	"G0yF0oenHUWKk7tx2H7beaN+Aubpg0RBt879D0zN1Ji4qDeocqgRksSEcJO6xU8w2xC7jbQbPY/ROlRM",
-	"iCyLru8FK5nxYzBt6LzkesXqbWOWr5pjadOQ8nCH+ylxVTOZdKOr1uRwjEVxZ/B+bLMYuSBcePbQvEHs",
-	"bBAAVbtC6vJNx1VSYM62OO6vdFOalWJ6ZdFtFUi+Zknxr6R4GXp3Qx60mJA/cE7jzPkbyYXpxEDK4ofR",
-	"4H3Cuvr75AtUbH1Oh6Q6GfKMo3ghOGyhT7+TCAyDF4ZWqTJHGQco+tF7cbQjR6WfzrBXf7BooDVxxfjc",
-	"Vc7fdM2PZSN+HfeYuop4UZm5rERxGlIx7Hvw737zuPn1Scn9/x6GisQ+n9a31lwsyzRTB/m9451/CHcO",
-	"9WluIDau/2HzN/vEnrpMqsTg0yiWVTq/PCkKxXSPGf/8klD8HioTuTREkJ8eRcv0Gnyq8hwHATbgYXIa",
-	"CadVvA8GPrKCEujfCHcotuKzG/p00U+ICNqCzdGzj6VYg5TSBK3mkOZ++0KTki+YlVYH304n2Sni1sr7",
-	"PXUA4O1eFCj6Qi6gdPJbqv3+9FYRegoK2g/MLoS4ylhPbBN4+WHDMTrw4C1gdgimdxltHrvG0x67MRLi",
-	"evCLr78LSXAbJQj8BCxg02lqthHNV17pHmOlcmqXj+1FWMmcasgIXjNXDZ0vV8/rsVwSU0HxYHdEqvWt",
-	"VD21Hzbua5SxPS9MnXe4JjNL3bNGsZGnOfXjYAyFpLsgde5swBywAEyTr0bBBfKbRV9fjKiigsxOKrP6",
-	"QQo2I0qWLt9Q/zM1W2/sz8aFI7uIAvSxmssCQxmvZMl6rnEPjp1L12rLuIn5DS/ZksUn7+QWC4/1Pcax",
-	"R6v6ae4Ledcp+w9fNQ+tVr3FdV1cX9ja7WaPEJOGLG1n0Y8YVeQTTkY6RNEk5mTB1eywjr+R71mPcy3E",
-	"Dh5hfeRm9Tlju33MZ/6ewA8ygx6M/jHWv/thd/27UKvW1ZhrgfPUNe+AgNbU5CuGaVgdYIrlcikg15x7",
-	"GfJfonsfMEf6sbHHX8vnL67+8zQGWrg9Yi8E6gYWwYHyE6Hh57aVATljkpHrW6nNqRRaQmWN15iDN/5w",
-	"fvn6PJtk3/34+vzS1aEP0QHZJPsR092/ZgWn2ST7G5u/tc07LFOjK3GkALnoYKnNFxCGrCVmO+X5iuSJ",
-	"S7LAfMCe3t+wUjAzIdfX304wwxVa23xAwNQv7Dg7F4aVJV9a0rksqYEAT8QCJEI492dlGrBgVdTv2HYu",
-	"qSqOfuQFk0evJcQL3DBl9eSEiTTw6Z0X4MfDl3T9mpx+f9m7tC67YHbSa8DDGFbpOiXmIiiFjE8dX1y0",
-	"P4/P2+PpJYUiQVKNiI79Pwkk7pwGqrKdhRRHfoBbNk8KBgI7c2OmVQyXim5WkDAxCQCLaLtl8yPUGyIC",
-	"B/MiNalzKM1G4p9hHoWMo8KUTsXUGsylBeZjci+Nfb4KrpyqtxD6m9FeuAwlMrgzHNTptrkTNBoHVJDR",
-	"py2RC55iiXYp6dL8QR+/tnEc4WlW892Pr492sqE9CPdR+dXToMTnlu8g405uuQ9Rd3DOkBnACW33YJ8o",
-	"GM/aUCa+QBxictBxCOwNg1VDSi7ee3fELthDKlhzf5J1PhMoucbYms7lpI868cbY4wg2rpbWJjS/l3Jp",
-	"xdmafjJ2U+6DFC5AzksyyHkyDYK7VZI6rsY9kHGT3qJPzIA653YeDO9dqQj4xEUatS3DNZne+nuseZx4",
-	"8FS715vsqpPjeEvJ3SRrPZZ1KrMXkACkUW6Xkg3dlpLWLf2V4keKQcbz/HB7slfPZdNIG/R1RxnwIALF",
-	"861W24j8D7lX8hzedSGLGqzlKATiYsyfG9QdRBAVehKu6Fb+k0b4xw4fKTC4YZ7GethMy5epB3O73Jd2",
-	"o44K4gaJuqxPBrUT0HTB/jm1I7YLjVPk5Ru67Erm8lhUQZsPeA2+DPBAvOuK0QIy9qU5b65enf7HV19/",
-	"VV8l36sacj09y69yLPrKNLeyET0U4aOHx34+BSckoANzmPf7nhz2AD/a32Q3Uitf3TyArJswp+hI473a",
-	"ZPP26rxVxcuJTOEQWqbm0nduqDIc7jcIxPpViIp6oNMEcvHhma4ZSexsk72WkyLO9BrsvRGcPhEXsetd",
-	"KLpcB68D7yWpa0b5hz5Ud5NMs7xS3GyvLVHjAXhBNc9Pqq6MdsDL4Ds5qRu/F4lR7+TyPJtkcEzA49m2",
-	"jw54K2M26CbExUK253jN1JIV6WB1uO1I3JRY1zKd8Man9MieT59NwWguN0zQDc+Os6/hJ8jPsIJVJrl3",
-	"vrT/XjK4TEO+3PMCaqsany/kOVCAs9Xbll89e2b/B1IBXsQQVYFAfvk/Gp/wkVnsk+UlTVEEaGrTZ0ia",
-	"ELxHabxbg2u93flksEA5GYy5oFVpHmwBDksvlZKqC2r4ENMt1kgvO/7pl7uf7S/pnvxuzQy1R3XU5rz2",
-	"jffapA/rMjv+pQ0tCqABgumngbF2dOBOtDW6PCqFD0UyPgCdp+N91KSe8tefMHvshpp81d6pS/vz0F7B",
-	"s9QLWWyfcpvi25fzIvoMaearZ9+0L6ZrrG8zcYmq40zAeD4RSqs6OMJlZX6jst+o7KGobPBa+jKN5Nnz",
-	"fgpdH58aBpPPPBRNxCE/uQtL6i4+IvWorXs0hhICDkaxkOePO33bAOEUN0sEVqFzrWN1Lecj/C/PGr78",
-	"xf11XtwhFkqGCZzr9HYGv++guDDUE3CNIQI4iEP8awglB14Dn8/OfhIqiqJrZiBJ10+daQejlYuR8yLa",
-	"ufrWPcm47buhZpVNMgGujVl9T1P+PUnW3vAWBgBrfCVmJ91NW67tI9LQqStXjVMlokXfh/tTWd/InxbZ",
-	"dW/rl7/UV+duibE73ez8dDvf2O+H3uV/OZbSt/xOztK57aMZzFgbzQCJPbzUO4K6nk6N/vVI/V9EVu5l",
-	"fl+6eNzGFkyvmEaW+IkdtBG6Zfcxc3io/4xYeJIDCFNdJfM88RHcRYLdJ849Klal0dE1DGrKOlnk0zsr",
-	"cvS70cX+j0btpfZ5MHnvqkLmFTzturJoXfUEHjgDAZzobthigkaYHV6kb3gRvVY6wAtxe3U433PR5a3i",
-	"XquTOnI/htCta8jcYKSYof9KWZKcalZznXEA7H5zv/NMp1VPQDN1tFCciaLcRstLmnd8/Mv+3SSrVNme",
-	"BKrX8RsGDmHe58XIzRGWjNxjgkZEkcBgF8Auzt2KH2qWHOwJSvIU4QmiPU7f42eTdj/WJ9BhzjD5xd4o",
-	"H47ABeGIBp8GWIf7d9LF4hBAOrLQlpzaFborC/FxpKQ0R5tqXvLcMfS7/xsAAP//QBZHgehNAQA=",
+	"H4sIAAAAAAAC/+y9a3cbN5IA+ldwuHNOZu6SjJ1ksxN9uSPLTqLN2NKV7OyezWRNqBsksW4CHAAtmcn6",
+	"v9+DKjz7QTaphx/xl0Rm41EoFApVhXr8Pirkai0FE0aPjn4f6WLJVhT+PC4Ml+JUzOXrc6roihmmXm7W",
+	"DD4yUa9GR7+MnkhZMSpG49GLenXFVPjjWCm6GY1Hl0ZxsQh/+J/Prv6XFSb8gT//Oh6ZzZqNjkba93o7",
+	"sTM9ZbpQfG3hgdn9rEejY3KFf08jCPZXAX9OG+AcjY4FofZPIueujZ5GcOA7W12xsmQl+Y/LsxdEwpdp",
+	"A9J8oKShnoalAhy4kGlj/Xl3bKOno3fvxp1YhzXTsuT2E63OlVwzZbjdiTmtNBuPyoig0dHo5ZIRLuZS",
+	"raj9hdArWRtCydoPSLgoqtqukQtCyQUr51wvCYW5yVwqYpZcE8W0rFXBLPjrZNLfR8dVJW/oVcUQu/Bb",
+	"GwbqW1lUM8ULck2rmmkiFSlrhcDhT2MESfNrRhQVC9toHr5RYSEtFFsxYWhFtGFrP1aANq6OakLX64qz",
+	"khiJH93SDFULBrvJDVsB2KKuKgvj6MiomjUp8N14pBgtz0S1aTSgjpLfTiopFk8bi7fwIMY2RC9pVREu",
+	"Sl5Qw4jZgpkHRcPYtijZnAskBAvYF2Fn04HdSQEA/MbpL0hR0VozC6Ht6qnocs0KPrdr5VJMCfleKqR1",
+	"PSamAzGKrRXTTJgGZpL5GS2W7rysGBxsQrpwLEW1IVeM+AEd7IDggBcgP9ze9EezpIZogH1DaFjmFLb4",
+	"minNpTi2fGF0NLp+/Prr148scQR0nVNjmBK7DsIamx1KtU1i7aHNhH0OI85CCkOBFyi2qCuqCHtrkahh",
+	"eosZXNQV0/27tO963jtptIhg6Gb/bOdvXkr5tqdf7QkZgLH3wpb8zlsElwfAbLHd6IkD0/WaUeX5iqYr",
+	"5m88VSJ12N9nOU71LMC3N3kMgr5NLj1b/k3Xlu+85z6NO+nDO9OwFfaHS/4be07f8lW96t6LFX50VxbQ",
+	"AQzEKrg1Nc7Iyp7zh0LT6GjEhfn2m+EclwvDFkztgfv04O0EGlgwQKuZuuYFI7per6UyvWeyPaGQpnU3",
+	"SjHBmXbzwK/cgQi7wMWWXeBi+4IU+2fN1Ye2DYOhvtrku/HAm/CUGvoSVtyFfFBHwrwWMz1YloKdzUdH",
+	"v/w++pNi89HR6F++jNrgl04V/LJfD3w3Doqg3Z9f3/3a3phD9mHgAiwi2JzWlQHm3I2MElsge+nBw31L",
+	"VWYAHISjFF1U3BJGKZkGYlkrec1L5NB7Ehefk9mFo9iZB0aTmV3UbErI6ZzMPCXN4owe6hlqrLMxzB34",
+	"jl/ZNVPIwnFFRhIqyMX3J3/96t++m3itAnYy6sBdJP1vSNKOqW/ZSc8kHedj5V3sqfBWi949dSyFBDVo",
+	"CCN3OOnl2nvfk/nA+wi+PRynY1k7uc9jt1Vc7NoqbPFhb5WD8e63Khv4/W7VC7rq2SJBV0FzD8PFDUKp",
+	"bjrq3Y1bMcPuybcZpYDbv5Db+f2pE2eDvMQUI9xytbu8Bq6CyfMAMXtfsJD42Fu6WldsbFl6rZkC/FFR",
+	"kjXV+kaqMvbQhCpG5BpNhci76ZVmomhjXAfIAJhCsZIJw2mFg9SalXdw01i6tQ3SvZsRrn3vHRcDml23",
+	"SzwlNRTlBCuzCWeLnVxRzcqHueq9eQQQbJHoBRe4CcFwa8l69jdpYZ0auHJRynXbvvt6v2K52Jzs4w03",
+	"S0JzPMwQc4D/WWK8nqEhsZflNIeVlmDj0BqOot/t9n4cC09Vds1yTm6WDEZoHPZEmjaSWLmzYoYN5D63",
+	"OIO3AOcdaN5+4b8gd42PFtK/aezLEu/aVv924syM55nR/n/+/Aud/HY8+e/Xv7o/Hk2+e/3r//OX//dv",
+	"fway/D83+P89Z1rTBfvLP/4x7e7yp65z2F5gNKde04qXBGax9OhX4dtb3L4bj06WVGuuT2RVMVjf69Yv",
+	"BzyFFKEzHAs35CygjHChDRUF0+2HDnde7U6xt2Z0tF1VgsaT629e+/bvxn4EZuhicHdoHPvycnBPXib9",
+	"jGOYg3pCY1CpEvz9PlBHvHCofJ323q0eWmGSbXlAQmlJ48XFdbKTmblsIGYu2PweDKc0ecmruHij0TK2",
+	"Hfi48EBgtdiHvGzr1iCCvTV/5+LN4HFCh0RUHLTN0Nhezmw1uI9t2+Sgfve70ZEegJyox/vz3nDamzI7",
+	"jZ/6OEVgEMBvIxPmqzWahpKnoo+b9XqGewCbjejCrY8oRlP/ernRvKAViUQC4hrRG23Yygs9YTMa3fWS",
+	"MTNZMQMjCLQv6DW1u2LlmUouYPTfpGCa6NqqXJooWrzRY8JEUUldK6bHpEAwoZPFFMo37jhblYqQy/oK",
+	"gdLjMJJmQksFGiA1xOKDGkZkbTQvWbqQLzQKS+uKCgaCtGULrCSMw0wlV6ww1QbkYhH+ZZZK1ovlrpf3",
+	"P96F5Mjq5YC+DSJ++X6utNNyME88BexYFqyHrg0bg8VM1HNamFox1Wcxiy3iNYTjPJTdM1X1pVpQwX9D",
+	"QVcxvZZCc/v2A2qHkmVd2PdY29RD6ZhC1nNF4SU30bTmSq7IzVKu0r6gYtbK/ouVY3JVOwMPRzOnYAXT",
+	"mipuz56qGV7KsmRVDzLtp+RpIIXyIXF5tSE3S14siWnu8IIJpmhVbYhic6aCIBKgfLBbfjw6lzdMXRpq",
+	"ejT2olbKXr5r245oA7y0hdQ9T2oy6X09TWyHt8uM8ej1Y4uQS6Y4rbyHWhdKNLR4fxRGM2qa0KqSBTWs",
+	"9BDBvcfBQjTnTOfg2RUaamo9mFBc86ZEmLLubfLfaXnHQqDnGlI5iSDIK0F4+MNIf/6udSdodEGLN6Px",
+	"6ElFS4v7Zx4h4NZIRXlcSWH/Yds9d6L7CVUl/s8oXi7gs7wZWdZgf3/2dk2FPSp2DF6ygqrRePTfOM5l",
+	"xWybyyWr5qPx6KmiN2D5fy7LurINTjxhWUo4f2I5/FPFrz0MPyhZrwE4qeiCpfCerlZ4RF9SYdf0I6Pm",
+	"2dtiaZ3t1CgwLsVt/zNLCHu4pQJ+0IkUpmSlxblmKz7xP4ytEc2SVbUh9gkN/5xIxZkw9jMKkYEc4dSt",
+	"ao1muqpeLMASZKQ9r3Vl+KTZQZJ5LVCJEVKt7PBTvx/WJbWSUjNSMnjlkIoUXBU1N+RKUgVDM1GiFezK",
+	"mUaqyluf3FzhjISjMc222k6jV0CIrJpPvGxd+v6NSQYsa5ptehzffR6TgqpybAFzCwO8BUs0/ujVtzW1",
+	"eAc3N+0lfdvV420aaW74Zi6l4r/Z+XZvZ0GFXbau+M41R9K1a4YbnhfEMLVya8kGDm+4c24IFRtvu410",
+	"Os2Onh0zG4DZT6Xj7XRNr3jFDUePXfvvwkpbYGPHkRNI84NkR14yGNH9NG0e1XRyIK7S/myXtSL2FpIK",
+	"7c+n586QL5i5keqN3VWBWrqetk40bBj3P5FCygpkSireTCMHCQSU7qBiK3ltb9hxehlYwro1NSEjaaG7",
+	"vV9w6+v02UBPHc+E3pmBwl6ba3sBoZqLWKyoWrAxqfgbBpItFXotlXHr8lruNGd1dmwv2ii+TrGyrmgR",
+	"/YN/Y0pOXqHejUqvnXrq+K47LhlY6ViUPP5uwkWxJDe8ZGSuGNP2/rA7VAtuphn/Boq3f9mJcIWANaAQ",
+	"SjQXiyqoAPZoLqkCx3dFtVE1yDHT7FaCbYduTa5ZMs0XaFFw3ssWXrvBK9vTAoi2vnxxU3er7dybabjO",
+	"7oWj3MUF4W/hFpWu4IFwxexBdqDhe1POBOzAyD8IN7qXfUz9/b4LEfexTqL5ituTCt2u7H2N72UJI0sk",
+	"mkgvYyDWSaBWnMseqNUKXIsLvCUpMXCM4CLSb4xc50M35JE2A8bla6Kx6dQLRXhzo4mr5NccuJtUxJ5t",
+	"R3I0MbFl143nW4CmdcUCA5PKLSReTP5B0I1kUcbWVFHDSpDyUfL5OVNzfm8xf+uqZp2mWyzfOVO3uXbs",
+	"kbEl+/M3rx+5U+z5gvPP7UTo9ePX39qX40SqDfaVPS2awZyPdO6VB4csqhhRrAIVyTvD9tvtrPBSG6Yu",
+	"Ed+d76bd7wiF65lvVWrLiSZFe/hultJum9/1D/ylJCJVzvEoznJUzZDZeJsH14E2421GuCZUa1lwoFNw",
+	"bYuiEa20jBPSJkLtj8noHqCk2zjHdnw5j5YBaprDTp2Nji5Y+WSzx36voI/SLQsZfEADWW7H+7i2F3Gi",
+	"Zv5ysf9qLOnAl6XBZgDb0EU/oduG8+jtCWIaN1basboujnBV408JpcBEoKbo+kqqkgtwC5LjT+kRPzu+",
+	"6Vt+z4c7eNJvcIzPL/ufX/Y/v+x/ftnvf9lvMIw/wgN/tuTXGNVxiFRMrymHhwjng6aHBIz/Sz799IJp",
+	"tvNUNEDGPntf9B2vOAOX8MnsdW3kihpeXDCjNidSzPkiNe8/5doiwx5FaHFsDFutjQ7/rm7oRg+3g4fx",
+	"jvyfhHoQiLIjWilWzsmVlMaFhqQTHY3wj9jL2eeM2rT7eGCPRsfbJiFcE7QjSrBko4DHSjcs8KVEw/17",
+	"TlDNdcEuodd2l4833QVJ6YZqL33HyJZ8nd5yw6uK0IMwNWCS+A5pR+MowXfhEsN4MxLzM8VoXvQ5hqYX",
+	"bEW5sLkwdvRJvNObALkRCHWdpqMOsn8ipTmAv9k1Zi65g/JhcKrtfGeq7HrfhZ9ZGeUJmCQompxqpuPF",
+	"5Z0g1kxpro29yqC9j5z2ii5qxk4EaSifiRA1TNDrQN4lwNch7b27C9lKDsGJewafRXBmyNSBTpseZp34",
+	"ilJajiLnWoLTt3X6sl5XeCTgnOgpIT9zZWpa5fZ/gbASvZR1VRJD34A7fcFKjLm4Ziq1zGFf62PmrDkr",
+	"uvFkDEqjBRz8WVrWOlgyGrn9rH1OB9+6YN3OE9YTEBSOlz9UbV6zncm0gnZXPkT40bgvpP12Abu3g9m/",
+	"abBrH7rlOjKBgTSCMKVkcPcQ0LE34upxF87jZdulxNpvPg+OnJOlvEnB8HzXg5stZbh7zBBhoEuhO2RD",
+	"br2kbZi1POCF09U73E+B/cIvF2zOlD19M88/7DeCH+0hWzPlHpGkYBPDVyw5dzdLJlKGc3bNlOIlewkZ",
+	"DiByafaKzbkHZzYd9ZL3rdy9tq7o1bPvTx2XW4dbSgpGwmqy9D5Xm9irmaDnJQbx8rKd+MEDRxVLJCYH",
+	"n2udoPgMLyjLUaekC4fPBEg9M6IZcLvZibTXXS1rPfOOeTFo1MKxZSP8GNlmkCtWQFYiF8xpgsQVEx0B",
+	"Fqhubb9rvSMgLe7KASaKLTJCahDu3nqkJt24+7ZcfXP/ht5zAZ4sMceUs2skPdNXcVDqo/OHndW+Ec2l",
+	"slhmG7zz2HzOCmNt78ai278/W1Cpj2qfc+9cMPubU12ml4h0PUOvBi5yWctlvZoTDsPOZS3KcRopv6Jv",
+	"GAZvMW1gKfgMla7Gbay2x2AlSz7feBErWgq9Y1s4K30g7pep5Q5tUHdIFD30YGNN4XAE73fvMdvNPEKc",
+	"P3wKtD3kANl2ThzfXLLEErw9VDlZR8BYUy+qdeBhPTg4+PIMoPcnwDggIJEXy56F7bmmLaJgLy/uxnnm",
+	"4ZoK5tL1JnNGvYPFLRWMJkB3IYzMzuBwoDUv5/J+BchZshsIm6N6OaGF4ddsUgvDq0lBRcHghd93x1dG",
+	"fwfBdON4LWJAqf/qDQczQufGxZ5uudi8UWpJr1kIRS2JrouCaT2vrazUYN4pJkoJ9yit/FRPTs8ue5hB",
+	"JrBNuwnFOsV3UwkMDKOtZMksJPbmBQEqoV3bQKNstWPhCaHdIV0B/Hcl4d7zkrfkcugbY7uDfXp2HbDe",
+	"wMPeGm/s0IbR0h93dJbBT6h7QuKBbeJcO3lMIPk72Mg727yIB1hcsmF2q8b+bHvhIEUE8lgUlqNk0Mpe",
+	"Bw40qP14eVkTs1PAnhIUY4OOES1fqUkg3MK4LWRNzRJFIkguEEdob9XMTxEl5V2TdMsbcbqoAPWYRlxS",
+	"uAc2jfhZg0Sn7o9ZnjBlUBpiBygDPxqztkh8pXj3IX51cZovfIBummpFdoJZZpqpFZ8ov5n3pLc6qIOS",
+	"LciPL1+eE6ng/457HrQSuHLz/eOB78DWOE9Cr0KOA0AhhYZLqAViPiVPfzw5h5Q/mNww6rj9CnMPl/4O",
+	"ufQO6/Yu21ti2s6fEPBK2dvMdi958jrMbhHupgHuLkxumKvs0WycdllSu/WdGLOd3MQBwjznyVaz4Hwn",
+	"ZNsytDiyjaKgVd363jrqNb5GBdEOYXdQ77KIXRoJDORMfE/rqkMSOE0FeOTC2sg1PoFBOp6Dr+fm3Hd2",
+	"R3uTzSDQO7Hj9MyXqtaGlejy7xPWvJT+Wah9DF0Hgj08FUG/FROHo2obIHeFtgGwd+LK5bndJjr03E1N",
+	"OcRJj+4nd12BW6UczPJTIei+zKp9gAfNFoAN+WzvRXK4pQ9DfHzMk3N3v13S5FHwU3FpaBhiOimUQep3",
+	"GhJCz/JOswADRrJKjiEtnZaYRM2zm6BkVTF1iJFtNE5rZyA4o3HzAXmwq0WjHy48tRm5JNtpq1m2rhQJ",
+	"vYalnTMcPnjP5jrNL/FSeYGxoedv7X+/r+R6bQ2qJ+VoPHqlr2wEWmn/fsKlvmQG4j9fGReAYgP6OF3Y",
+	"/1sOYyNxqlHK9WwswVMXsurlTfCbWEnDfGhpqj7Bx8LyjH3qlgTYjgClXk4AzqHtB1IDyBixWfpmQS87",
+	"eWqPyNOfn07DivKR0hBum4iE04WQ2vACDuBC0dU0Yq85+hx+JiXXbzAYb+qwmjakZElVGb+/wOiUfKS2",
+	"CWHqtq7Z8lyxCTRj/8WKGnjWM3HNlRQQPPXn8/969pdpiuy4Xs/XJhi8BR4RyjWzqy2YhhAXvnKBNOlu",
+	"5ktS8AUXFVO+UEH45cnlaZKi3RFJ1luQy6cQJjht0ki+N+nFE2zWUYtOOKS3HExTaswhhsFAr/JhkRCu",
+	"7xSUSOQdIMCHaU7+/ZC2nv22mxkSvo3nMgfbMQ5L8JM48qvLJwmZxFO7lbZr38xTNpHK/6m3xCollGQF",
+	"oO8wuigjDZdpO9luZ4Zr7a971ci2yZvstjG2DiN8hzfeGSrp0UQ9nNUkfTKWjZZJh1Fvn2ltcb+QFogU",
+	"7OJtZTw3/GUecw0Y0thDWOjR6ExA6GC0TBabomLjtmU1PHSnL5fNF4ogwr1cMjHuW1iwYW6z20+H7aa3",
+	"lPut/Dtb0MLeUvZADd87160DZ1xARuD4+O+N0LuQ4GHoHvOA8Trwkf/zoAxiDbfsdiKxJHorBC8G7+3g",
+	"Y6jl3NxQxSbXHMOnQqAOxN7A6y08jDt6j/m6IvNfsZVUmzE5OX+FL0U+lj6xOHKM5aegvE8JecoM5ZVz",
+	"iZA6mdeF/PgQPqqS+DJWPnjyrweNO0lczffxU3K93qFI57h4t6Tv9FqvySWXDN4JfEXVxv8852plieOh",
+	"sjZ54HAQD6N7n6k2SYoNS2WqFmDMAuCtFvH2r986yH0qciTE5ImexemtydxPTptTd/pM9iHHvTjtuWvO",
+	"pvHQsUY/Sm36c50/fXFJllIb8CQYw8GHJLvCKvGrRgbe+zI7BACibuiDyCn4/qLPk7M7S8gvGCPNLR0v",
+	"N2umrrmW9kHjOCSq9xSTztPqnvrz2Jk0QhBqNySBp1NCnic+Mlzn1IX+M3iberTbtyS4kyFfgmJk9szS",
+	"qGDmVBim5tQ+HEVeGIMhvbdPEjK7d/a8ffPgKXL27HnwCo5q+f3n10pKDTROYTtTH8vCiOEuuqxX9qju",
+	"eSLzvttz3GEOvj4y9eemBbBt/iDJ1b03aTZ7muwubighLz3Jj1Na557t9a9010IPDam7RU68SKUfRUq8",
+	"hHTPUQ+X6jDqbXU/IJVeY3sf6t7vAiJDzYEJ88YjxM2ghKiNN5vYsRmv+ZCZ9gCo5HJyCOsuIPoJGa2R",
+	"FT/nSkksEBw1RWxhNebT58+t1W1zpWAvwLw2PPLO9s50vFAnzn4iKz+3dwKCXyt2zSr7yCpKfs3Lmlbw",
+	"u/aJyrAXBqs5wLrnoGQJn9vzuJZWwo1T6oFzOhNjMmPwN3JTN1YHljqnhe6BDNeqFx0+mcOahhoccQwv",
+	"ylgNejrq3f2EDe6pHaNC2tBxuPBpX0kJymdbaeyguvbgmKoJE8j4AiJuwrjEJPFMI4VKKJZ06BNpE8j7",
+	"uqzucJ3bKmQxo3hxgEfQwVfCS2lohbhEVP7An3TvtJEhg7rzl2noChM0Z4DdxKHmzxfHz/8yJitGNXTh",
+	"gvzAn0wP8z3ZXcdr2/5BOja7a8kK3Ob4g7Cu1VpqRq5lRQ2vGILv10J1to4Fv+JXG8PcltaCQ4DsD/zJ",
+	"5v6eae1KCiaMhdaB9ak+1HbJf/sme3GYWvuh9P5M8EQqduIzcWxzwAqTkEIqpkP94rAx79/9Ck9wBLmF",
+	"nz7QtzmADEOOd9VMtuIjQFAAu4OSGuDbbPyYZS8Q7iDM+NR8HxdiPNS78bK9dueh1912U4QjZky8H2Jf",
+	"nL3SfqD/K5VLSNuE/MG1vT54d6F2TNbOkSTBgW8SaJCcJj49k+T37+mKQ47GkNvG9rt8DubjTJEiTy/P",
+	"Hz3++hvy1fSvFnsV9UV0Dxc6lhaTXCx6Q4m2FINzXQnXhGF3cO2rqu17ObAAXOJ7aXZWZLS/zAJOU0tl",
+	"Pr+FHmMek+ZYPTeMH4sfnpy/mmEIT3BXj6v2eotb+nZXxocQQxKkZ6IIicTWSpeXT3fFSMVXPKTDZOmY",
+	"Lrs0K2oTru1C+iCs6Jfrit2lXWvtdC05n1eSliGrK/WOMp+KrBSSIu0lIMUa/PBwrRt8O5eDTE/IkM0V",
+	"ZreNi2v5xqdF2hY40A505abqMGx+rzgTZbXxMAq66ui+D4W7gbxdCd7q0wwDztAU31LCeyo+n2VpVEOC",
+	"L3J8cn5KwFxKntTGSJHTmfMfOK/1Ehphm1mWRMdHPEhF2Kq2DJZQ0TGwHSRJiPO9tC4E83n3YJ0jnIUY",
+	"xpCA+I2QNwKj2ueyVhPNCilKspRVCOxCS+0VDPHJnZsLjAB/IsvN/R4hmG2I8TXcmLHHZ0L/TOi3IPSO",
+	"eI/gHcuu0ZFYbPDbcBdi3yM3lSYhFzHwXsCjeYi+wEmzfm43NTR0bqDBKdMHNpe1hQjH5HMfz0FkUdRK",
+	"70jCloHbn8UsD0LKl9Ge0oXzOOlDY7UwF1oWhZoxdnInikLCC79gKgrmvhMjK6bwh0bi+4iwoZAX6McH",
+	"j/TNWMgu1HUQTfZa5Onl3OnEo/HIxW5aN7zL0Th8qTbnVBlgoqyMzZo/29mkhj+fnr/aRnZrxewSQU04",
+	"u8yxsKSaXDEmSBmaES7InF5LFxeX5EZrWiy1T3LCyOzMf2vmAk7Iqu0r6ZaAZRxyk28hBVaTQD57xbEA",
+	"gB+WXFUSKlPIBWg2U4eHrqGAbMIBseB6zusqYaIfGoq+viZG4o5MLldUmRenJ1PcK9CvmsgI3nDTZC+7",
+	"wJn27rVtvKSqBBe6UEQdPyOgHUN5KrKdr/FvsqLFkouYhjn4qsiWXtdJXelYUkW3vmEwbWUj2Z7P4hmZ",
+	"hfyw9ussd5yJXoiu+EgHjYCjc8k1XSwUWzin9Jgm3Pv5xefOAubChTifnpSIWrA9PX81EKyc3rz6hHhy",
+	"1cb1QVTXBurssg8mQc4uc+8lcs3ZTduzIaPV1gT+a880dihPsoTaazavLpuuB/2cQBaKsTzbT0MvOFnT",
+	"Q6kl9ZwKtsoovSHJ0OCbB9jMj2dCN+k5bIHtPvYDmhAOSqBwJq280nOkp4RcYJ0CFnIgZknMmg8AM0y7",
+	"kr2GzjIbhSTaO/pEq5rt5J5K4nGKNQp9wG0O5VbG0oecO99SD1R7RxsMLexib+xCwrVCDRRkFNZY5FL9",
+	"ZF07rl0b3dAToLAjZLYzYTA2Oig78AvZjFtNQla9mDNNZ7EbF9WVZm+u/QBl7H37lL60Yx4hTcdcGaTb",
+	"BvRrsPx20CJCMRGVVFRo/XL7UgqhOsfnGgqfayh8rqHwuYZCbw2FwCn+AMUT3Fo/iEpiDpbvpXL1zfap",
+	"JRbqwnHt/f29EPyhl5LysEPaqKRgl19GUDKXVPs1JUW4LMIuIdHPPghr11z72LDWrB53KPpORUJu+63z",
+	"cy2vj53vuf/vyflOhR93HNRurNUXqDHRxV0h11AhOb5YSOWzdrlQS4z+9zB7tYmQZ2+p7QRShZsOvXaf",
+	"PD/R46SQu/847jYG6DTiMwnbDJRBtSsXi929V2kw530WlR9cVN47bizc5dv65Bd/PBFD3v8ah+elw8vD",
+	"iJK3cOt59er0aU/Op1enT2M4jY8X7PCwcuxudDT6n18eTb6jk/nx5Ptff//ru0n6z2/2+efjr979aXQ/",
+	"vlvJsmIU5PuMkEmYUuA+n75w3Tha3tj0PCDjJDDotDK+62ZT/zw/8eW+w2/H9Vtecao2WedLvDJsKhx6",
+	"pXjh2w9/NO0YFt9YkuRQ1Ph7K7vo4qPP9gLyofa5zzlANbQlcu7rvKcPMHb1h4LgrJkdzzotNB86RVJS",
+	"v7Ww6KwwbW1J93x8RRWvNmQlBTfgEe7q47qEVO5VzL8NbZkxXupcGKYKKQQDO25ieAeALGidtHgnECJg",
+	"Ea5pg5IPRbutBg3DRsrxhev9IbBDd1uiB0yzxVjdfUaC3bq5zTEZTwTMNQYugdzptfv/QQFU0DPmXmoI",
+	"kEQxU6su4S2Zsz3ssl5RMbH3EsWQGWg7Hd1HBHnfXBE/x2qxQ7/1aKBqUa/c45k7AZb9GG5qX3zFLNNm",
+	"7rb0/SEZXiWlzV9Sr5tfFVtwbdQmU49bToP3qAE/8DKh7smS4tnWdMWIZisqIPOa+3WWbFFW5S4jRt/q",
+	"wo3ss+D45MeWSyssDQ6OCLNzqqh7xcLHhuiCjJ7+Pm2uo/KAsmumslJ/mFIN9iZKIzT1K8eGKbmd9tSP",
+	"iMm3o2B1nwdjFuCZZXWPHHK/CJ8JerR+QYoKygTJeYb9RuxxXOglu2aKm81gefpHRiuzPFB2v0DLQy7J",
+	"NY400cy40AfXqG0CSXD+Xg7hxfcn33736HGoE/Mfl2cvyFpyzKnsQwN8ucPdC4FCBHRtWypODWsTFyBP",
+	"y6rurifzSqM9x9sTdL1YMO1ccAQmmEBbUHXtnLG4qfEQOMsE+KPfM0F7b05chidSPyEh7obEaGnF1hUt",
+	"WLNT4wg0ORb6TFF8onYZ4Iq0Pxehz5puKklL3UDvpWHrnrepimuDfluFXK2YKFlJtG1+EHoH2VdzuF7n",
+	"/7zjUkx3sKzuDOJCGkxSj6qgS4I9azChGZGKzOK/kpMTC+D8NOuLKvnKZQ9PWFqZuAkgCXdl1cD2TXIc",
+	"RP9xggtGdQeyd3kAtnAw9lbspMRGHYuZZdl//Vnw7GKG3Dm5hV02uBmaQWbTAwjE9OOo807CqYbdRFuO",
+	"PF76A087NoYzT9dQ4NTKkQ5lXTaF67YbSZrgMn+u9CLBr7cLWKKDRfWG4f+TTSTifn6mlOxJeYPFCRyj",
+	"9tlWmxbylmLD+gfsjRoLlRCGTvI3h7Xps7cGWKYNZhyko4S9RZ/R6FKFAPhWg6+Ipg55+KWAeGjCS5Dc",
+	"B4P9bjwqOotrHXs5PBGRaLylE2nX70KX3nX3YvbtoXo3Hq36tOnjpn6b4auh9wQ/EvzIRed0D6CE3x7I",
+	"JiMFmoh4GsxNezjfJDLIfaJDd57zJtjQ+p6hpWIgp2sJqk2B8IAYMYDHg6cJbYmBwakZoQhqT5SqO0rH",
+	"gx0NlHhmen291uG7n8NFh9naRr5soZ8EYDmgBDyCYrnz6wSe+yj/bg5cUsgm++ritBtRQ9DSG9R6H2rc",
+	"oEUdYCI4V1xG6b19e+NXEqvqoN6Szf2B1JMaCmwoVV0zWw2qK3RqyRdLpk0YEtJ4FYpRiGxwGkLzwa1k",
+	"oUXab07MjQx3dxMaDO0P1j7fEW1tGOlu22I5EEfgrTGCMMGDMOHqDJ7O26QS057B3DQiDv0RrOg+drkk",
+	"MCyMa/LIoS0i2YXUG0lKe/xWXLB9gG7wVv9c16ZBn1+rY5D9Mll2MnH3nH4/acK2gg7Lh9r5AxLD+Ir3",
+	"u5nRB1DODZe7FWC/eEjse02rS4i+7bm1uGtErpi5YUx8DNjwMI/tmcTYYj0OCxiAoTSNGKZJgZwLJ562",
+	"e68uV43B3hTSW/pdnwPusodIerM/xL1185oWr3DNz1q1HkN1x7YI2mAPB9ohogh8iISH7DbcYU1DIVUs",
+	"8N/UYlh4IxAllVwQJozajEHevQa2Ti3mUV4Fp8MgCSdmuI/YvrGNyyfOIPYpmRbmZyZKqSDcBgxh4XDZ",
+	"d2UXJvdqXVLDoI2GAk+QWOBkU1Thx5fyKV6UGgKZwb39aSiZ9JQaakMenz3fp+xK1yBH/vekIBOEXU5H",
+	"48aSjvy/yTX8AOcoMa00Vnc0OvfpE2J8YA3foofB1K0iaSxsEvQO6g18JUFWOgdmVoCyLPn4rY048j+5",
+	"0Fz4dRq2Ix0Uc1s0hsu35wh/SStEgqV3Tgsj1cYLPC4CPsjKJ/7I7KnuHcfD5vO1A5PREIMvujW5MNlu",
+	"kSiMfkBK73ya+5aAMkg7HxPca8Lf5eKZ5VkHZIHb37sira+XwPdQDhepfa8JxWdXjE/fFeMP5QBxgG3i",
+	"TPEFF2fzjP3uxxS2PeJf7ny0v1eu0P04H6dM3upcQPz9vNDvSul6tw/1GUo/vgf5dHuOSVFxiPigAlMD",
+	"OdOHc0RpmWmkKBqjYJT2Sl77gP8s/1bfxvy1/fC+py/RS75i2tDVuke+4KsmoJAZyCXSD8piSQ2b2MZ3",
+	"eDwyO9xWQGyMgWbquDZLyySR18SCr+1l+SJ0c0KzPoG11popgWY4BxPVWhYcvAhCcqCMhB9CP3518Xf/",
+	"BNQAPHOw5boT3NaivPbsuMoVi7kxw9K0l1e1tf+51xE7kjNE94pxj1whcDdp90YEkILFHmKRPwxsHwbc",
+	"veP0vrwkmjmDqShd+nPtEyJlKgx7y7VJyh6mISjOVlNl6Yq2aT4ftaWhQ41KTAzHFVN2L5KUy5Ac7dLH",
+	"ZuxTndsO5fNsJFeHvbrst2lrno7GPG0wzSDpaA2vIHBfIBWEkJJdefUCrP1ZS/qUYnj0Llx4bcizFNIE",
+	"tle4/xSB+sZkRbkwTIQEe1qumIvVhEOJFlwmcnhcHiz0WQglXu0BvrYzC3aTVYbMcTwY3uB73b8N8LYT",
+	"bJjewdVdCF2u2rS6oRsdXFZzhZNwSFqKCZga1o9GBGabnSe/hEQcSRLRV5ETrlYuPWh4o8PSrCDC8hDz",
+	"ehdsfAdQ8QkwdLX7Gln7F8l0w7WcpqyVMISzn0bj0X9SJXBNJ4obyFM23Cjoe4Bhyf0j2cJA5Hy1YiX4",
+	"NWfM9uwnyJWkVnj8PSi5maqLT2fU0Kep1oL/s+5QWNP7IamVm2cB0XzFK5pktLgrKtgGziAqOC0P2Pz+",
+	"GqKpYJFdm6CBYDabu1p7nIvr/smGIMGu5wA0nG0r39znHmyrajLr4acd2Q4WbrBFM52fHS8aWN1yAzay",
+	"p3yrO1WbuO5djkVJljH90Uoz49H//HI8+W86+Q2m+Nc/7WOlOcNNyUWivEBlYH/CsqD53D8KcLE4E+k/",
+	"8BN4uA/nibbX0SjbUosOOyYriZzP3YbHdaRMKPRZ8cXSZElywTXi+NV/4VhYXH7bTGIaoe9oBh+mjeVa",
+	"zmvYai0VlE62GAsvxE4SH7yCggpi6JtYz97lPEwSWcv5PNE0kk3YCsd8joCIu4NDBDB6gz4DJq2942un",
+	"/3RkQm8SmM8NPhqPflC0YPO6ulzWppQ3IvnpgmlDlfHt4z9frHgYBIgzT1/efP27rPWaidLvPD441WAO",
+	"+b6uqqTtYIIOCzgavawV4t/g9WrixV5tyJ9tBf+FWxDRbpF/mSbw+yFE5wjT5vKPRhZVxA6TzWS3P53M",
+	"/aSwWxh72l71EfyQvfbtXstfpoQ8Yfb8aVLxNwwNZJBR1mB+lIoLpsdkLqtK3qDsa4GRqtXIm01Sk1qT",
+	"CNJlD1hii6y29A/nbupo62j0A1QYA5k/ecQFXUPVa+OjUcC7yiVNFeTF81O7i0kN97FdGdgcXXL08Oyp",
+	"x3iPMcPSGfDEYUaaccxGOiZLWplGCt1O0gn8bXSE/08dvWIzSHFUSpfY0lo2HQf1udF1nNwqd4yaWoFQ",
+	"0UwNGzP96vZL8u0oyj9AA4C0ykkpfrQkxbpIqMkWjkaX3BUwQEdElwLZ1xEI9c6SggIhebVHrmMdR+6P",
+	"NnrxEsH2t8Wm51xHo/9U3IGd1V4GjBpJSq7fkCs2t5hYuysj3Eocc+fe6FiXGMYw0lrAHAJLfHDzN+UV",
+	"Ld4QKXaYEVI2uDXJfaJbVBUxigqXAxvCkOPFB4C5KyxpBb3wkKeskZBXayms0m/vLstg3ZkCWwAmJA5S",
+	"Tnz5g7jSoJOPO2Ry53Vqq1c0OPXtVykeZpFbViVmHZfK3a1sTOjcMBVaiUWSXcPx6g9ni8Ws80bcBx3r",
+	"6JTTvEeptoBsvexc8jl8XfLXGRRDHo4jz2VKPgefQGe3nsUlzdCUQw3h5gt4GS8qqRnun1XymmCvJVaF",
+	"deVlGCZbG8dr/vjEd7Gmfo0zUpHkXXOlYERy7duFNzqiicyVw6+CyalJU3tzkTHOvfMUbpl8Pt979jju",
+	"/dOt8xsP9kPs5vJ9Yjt7zRuoY4f5DJ3ltOTacFGYbNPn9oroMkm6gXNyos6uzSlQ9Cw/QTNPlN6zCG2w",
+	"K7pxro9J8i1n6/Tk0Qz+NDKFMibGGNaba1e6pVnGzsPUuWCGlJvUiQKTq79hoaNxIV9rBT6b/pXZ8BWT",
+	"tdGpEPpBclnsPudKm9RcbqjGFJmazq2IpoPU3FF0KnAFkB1sUwAEmrdqdCCml1KbjjImTkRhZefUTjtl",
+	"GngKci6XuwmM0Q90S3QoFQ8l9nzerf3FNlTl9tmhxXa1L7Ev3aEY+CCk63XCW0GOms3DwA0Al7OWTnmY",
+	"YJbfILcVqxp3XSJaJXqem1yTSha04r8xb2jQtnahKBLNM0K95BUDu7oSQRHlmmgDy0GDefM0mxpeiMjT",
+	"E3ItK+Ocyqyei8XT51EQ297U63yfBbJbnLOWzWEfgr1iGOunCd9mk4CnBKZ10BGgH5eupr0za/T3t2LY",
+	"FSMlWzNRMmG86aJtWnCUEydwXe3jE1/UykrwmVXk4+OM0cayD+y4T8Lb4ROvDXKjuDEM1rRmSnNtLIpd",
+	"OaN4FHcKAw9xSfZa9lsKsa8jFK8Sb/NvMOiQ8jHQRGwYcd35YNB6jfKlxsdpEaJLQ0V5tTmbzysuWPzh",
+	"ck0VAweUl0wb/F0ZtOIfX2mMF3ol6DXl4J0WB3jK5kw5e///V3OmC5gIom78r7Tic46gsIWi5fbnr7QM",
+	"U+y7VxHEdFVbyhm6lbmhfaZOIpVPMuri6CJL9cF30MSAauTvukDjWfWrgq59znMMRQsohLsNiR6VTD9p",
+	"QQUGOmtDK1fyPGLZJbFxCd9DnLN/g7LHyjqhUFFq0GD/WbPatmE38JjLNKpXYSNwvHTt6ate6ZpNUzLq",
+	"wFfWx7XDpLiDuiTF3R0F7uhQi5KphQRWzTTWuoTbPgTKIzrk3O6AqV0ylsho5rCDV/ViwcVimlFpjuF/",
+	"1s6bJ3sMpEXB1ga2UVGxAMafhWWFo5CPFtcKmwNlehOR367RKA4at4uL9Js5bZ/eYUiFiegN5VhjMAhI",
+	"tAgM1Lt2cZPMgixhxxxJrlxW1qKkotgA5JZX+0nJnPIKqmlI5Ywog6BABrQDAu3aTbsZ1C743Xm2OCqo",
+	"cEnl/Et2YGKtTazdF1gnPq3bfnF+5BD+8Gz3J8y4UM8d6rJ7dfMoCiN0BbPg2GHJA3OotRjOtrv9blnR",
+	"ICmieYqCjg0BPh7tZCVL1sW4+lFMtzCcsLUdfO2QEeFmqFLOIRZtBridHGIQ+QPwxCFEcBsuOXD8O+ef",
+	"2zYv3TDvP8KrTXbSLSAo2hdyxZILPtgUW1y3j80OBWXQ9LQ20u6sezpNmbVnyOMtMILHcOIzm1TGEpih",
+	"K6g8VDjTeEjNnj4lNzn5dpJOl+nKePv8YJnUP3NHcLad8R9yMtNLQUhDfObHvpthIOH6O8Ppnf5LsneN",
+	"+wOPCiPUpFytYtesAmU11lO3rWOxT242yZXT1E5Szu5SnGY8OGaXT4//9ePX/sdwZEPvTvS73JcZrmJK",
+	"1S2lVxvTPv6uS9WpDykup6Ej3Nmpx3l8TRQl4UaTYsmrUrFtkesdQYLxG2mFjaQu+rnL8p6ZxzoC9u8y",
+	"7VhM9eSfcK5ZGt6DSwDBxy8iLLYzNCa41IUhLL3js1KeYFYq//eFrKp6PevzaffvTXYgJauK1GuyqivD",
+	"11U2kYTYWhQMXCiSH2lM6mDmmQVMngqXTdwjeRaj6H3k4hOqGXFOrOQiBlJbwJK8VFlCAFyz45sUBaAU",
+	"ThvuB9jT6c/Up9K6bsU+9IZNPsbDFT3z22fAEX5irkrp1F/cVmzEOEJ7HqKxK/Ne35NiQ2zm3eVhyMm1",
+	"vTSWO+bLGjxgNS/RyadvbYnDuG54jMMlKHUrjXC/r3hK091bYm9iO0cGf6C4WAO+USvvo0K/Z633im40",
+	"7sdLIcKb+rGPiWasoz5EjNBpzmJT09ii06y6m6j/YKvrvqJa2Dtgt3GKu9zsTKzpAXPvepStQPWNv6Sl",
+	"8gQVb+D8uv6ooytd4oMLKc17qpU7eJ8OSD77uVbo0L1P/j6AAmZJ91mMNPR5d+FwSmma1p4QQgkmaFYS",
+	"p3t98aXCFl9eP/7CJquzKSCqqkVfWFuUpzpuOjy8+c9pwYIDsFMM7V5b0mAlKblihbWog5IZ/hVuvZC+",
+	"Hor631uN0M91Pvet89lmXIdW6jxXsqwL05tU337sSEmQcKREHL6vtAlcFFVdska0YYQviECJj1wHeF3S",
+	"+tc++Qy0dqpwNzZc555zDOcj1hH9xz/Kf/3HP6bJ/+6yHGgu8yUczshCVh5SyKyEfJEn5ujzRrNB5mgI",
+	"BEnqcHvW4GRETLN7iUEj+6dP6q7h+qoz1Df6i/oa4FNC/nPJBLm8fHru8wWPUyfSLMwdUmzZCV1VDsQM",
+	"FeTHly/PyeyrR4/I2U+zaHHzbsEw+uz55PLZ8cXJjzNvPScl2nBLrgurQ2w+/gKzCcK7aT3F/Om8H+/t",
+	"oe8d+cQW8frm8VdfJTfwipmlLEEUqtGEWiiGTnIACgDbCFD26VW8pupkJUbkmv4TWp+gu6wvRen7gDXc",
+	"2PFhwV6txHEh0UQtuD15YBBuR7NbHc0NJaTBa3yt4DFvY8WxOWdVmRn47Rrg+PmcnN3My35zVT3rOS1M",
+	"rZj6YDl7BqRUHvwhjN75V6dzcZ16lJiIDpgyK2XlAArccuYuyCQuBcml5z75t44cOg9WinmHqPmRy+va",
+	"ovok5HN43frlANk9Tw8xc0POUlsccrrPNfIfXnZ+DpkjejIBYloJHYxycScHZ/xryyH3mFU0aONmB/Bx",
+	"4YHAXOL+geRlW7cGEeytsZrC4HFCh0M1i71Ncq3MY7j73egYb+Gqd1nbPnzq4xSBQXy6Fe/dWp3S+Rpz",
+	"3R9iK0uexZOq34cbxXrSzA2c5lPZj/yfhxmw0hF6bFgaGwUdwD8mxEtxjDJkxumsDEerkLfVDfL5Nn0H",
+	"Lr2yqg1z2H+JcXc9tx19a+uKJMVPXK0P70bhd4drItdM+EC/1IoHcUz5Xiq2oKqsnEMSvDSD30JXZROE",
+	"YHT012+/efQoqXTy9aM7KmXy4GtMC59sLT3bvVPB/awjZ7ovOYi3xc2SgfHWygNuqACeC7e0i4ISpy4k",
+	"LTknDrlXUlaMikNS3N5iftCmZlYa6q7LbvEdWsf3Bdgfn6yhvaHRKOXe1GfdKJ6lj4fpNnrow3YinMDi",
+	"wIuBd+iA6BcJN3vXWuxni4LNNjztKFKc3I3b7bedN+pHYJ4+SBR069z/wGSmxsRFvUGV2xohSYwJN6lb",
+	"POSnAR+QQLvR8xitQ+WYyKrs+l6yihk/BtPWt5LrJcvbxixfmWNp05Byd4f7IXGVmUy60ZU1ORxjUdzZ",
+	"ej+2WYycEy48e2jeIHY2CIDKrpBcvum4SkrM2RbHfU83pVkqppcW3XKOCdBi8a+keBl6d0MetJiQP3BO",
+	"48z5a8mF6cRAyuK3o8H7hHX198kXqHCms6w6GfKMSbwQHLbQp99JBIbBC0OrVJmjjAMU/ei9ONiRo9YP",
+	"Z9jLHywaaE1cMT51lfOzrvmhbMT7cY/JVcSz2lzJWpQnIRXDvgf/3WePm/dPSu7/tzBUJPb5tL615mJR",
+	"pZk6yJ8d7/xLuHOoT3MDsXH9D5uf7RN76jKpEoNPo1hW6fT8uCwV0z1m/NNzQvF7qEyE+wMHx4mW6TX4",
+	"UOU5DgJsi4fJSSScVvE+GHiia6d/I9yh2IrPbujTRT8gImgLNkfPPpZiBVJKE7TMIc399oUmFZ8zw1fb",
+	"305txnrArZX3u2kGykXa82sHw1xA6eQ3VPv96a0i9BAUtB+YXQhxlbEe2Cbw7O2aY3TgwVvA7BBM7zLa",
+	"3HeNpz12YyDEefALzoLKbbMEgZ+ABWzizNCIFkuvdA+xUjm1y8f2IqzkimpWYgLvaK7adr5cPa/7cklM",
+	"BcWD3RGp1jdS9dR+WLuvUcb2vDB13uGazCx1zxrFRh7m1A+DMRSS7oLUubMBc8ACME2+GgUXyG8WfX0x",
+	"oooKMrPlyl5IwWZEycrlG+p/pmartf3ZuHBkaO19rK5kiaGMF7JiPde4B8fOpbPaMm5ifs0rtmDxyTu5",
+	"xcJjfY9x7N6qfprbQt51yv7dV81Dq1VvcV0X1xe2drPeI8SkIUvbWfQ9RhX5hJORDlE0iTlZcDU7rOMv",
+	"5RvW41wLsYMTrI/crD5nbLcP+czfEvitzKAHo9/G+ncvdte/C7VqXY25FjgPXfMOCGhFTbFkmIbVAaZY",
+	"IReC/xZfhvyX6N4HzJF+aOzxffn8xdV/msZAC7dH7JlA3cAiOFB+IjT82rYyIGdMMnL9KLU5kUJLqKzx",
+	"HHPwxh9Oz5+fjsajn35+fnru6tCH6IDRePQzprt/zkpObW0rdvXKNu+wTA2uxJEC5KKDpbYprgv80Zdq",
+	"KBKXZIH5gD29v2SVYGZMLi9/HGOGK7S2+YCAqV/Y0ehUGFZVfMGEIecVNRDgiViwxEJO/VmZBixYFfUn",
+	"trmSVJWTn3nJ5OS5hHiBa6asnpwwkQY+vfMC/Hj4ki6fk5O/n/curcsuODruNeBhDKt0nRJzEZRCxqeO",
+	"L87an4fn7XEfMigSJGVEdOT/SSBx5zRQle0spJj4AW7YVVIwENiZGzOtYrhQdL2EhImxWYK2G3Y1Qb0h",
+	"InBrXqQmdW5Ls5H4Z5h7IeOoMKVTMbUCc2lJpHLKWNnvq2CWYeq0JK+9cBlKZHBnOKjTbXMnaDAOqCCD",
+	"T1siFzzEEu1S0qX5gz58bcM4wsOs5qefn092sqE9CPde+dXDoMQtoYuMO7nlPkTdwTlDZoCrzW3ZJwrG",
+	"szaUiS8Qh5gcdBwCe8PWqiH2Vca7I3bBHlLBmtuTrPOZgC9JbE3nctJHnXhj7HEEG1dLaxOa3yu54KKh",
+	"nwzdlNsghQuQ85IMcp5M/WigJHVcjXsg4zq9RR+YAXXO7TwY3uAA+ImLNGpbhmsyvfX3WPMw8eChdq83",
+	"2VUnx/GWknfjUeuxrFOZPYMEII1yu5Ss6aaSNLf014pPFIOM58Xh9mSvnsumkTbo644yLMxYPN9qtY3I",
+	"/5B7pSjgXReyqMFaJiEQF2P+3KDuINrF9CVc0a38J43wjx0+UmBwwzyNedhMy5epB3O73Jd2o44K4gaJ",
+	"uqxPBrUT0HTB/jm1vV5nnCLPXtJFVzKX+6IK2nzAa/BlgAfiXZeMlkw1ct5cfH/y7199/VW+Sr5XNeQ8",
+	"Pct7ORZ9ZZpb2YjuivDRw2M/n4JjEtCBOcz7fU8Oe4Af7G+yG6m1r24eQNZNmFN0pPFebbJ5dXHaquLl",
+	"RKZwCC1Tc+k711QZDvebPZj6vRAV9UCnCeTiwzNdMZLY2cZ7LSdFnOk12HsjOH0gLmLXO1d0sQpeB478",
+	"3dXi4bnrQ/VuPNKsqBU3m0tL1HgAnlDNC2s1bSMHeBl8J8e58XueGPWOz62lDI4JeDzb9tEBb2nMGt2E",
+	"uJjL9hzPmVqwMh0sh9uOxE2FdS3TCa99So/R4+mjKRjN5ZoJuuajo9HX8BPkZ1jCKpPcO1/afy8YXKYh",
+	"X659Ox39wIzPF/IYKMDZ6m3Lrx49sv8DqQAvYoiqQCC//F+NT/jILPbJ8pL8jWhq06eHI3qP0ni3Btd6",
+	"u/PJYIFyRjDmnNaVubMFOCw9U0qqLqjhQ0y3mJGefQJ796v9Jd2TP62YofaoDtqc577xXpv0dlWNjn5v",
+	"Q4sCaIBg+nFg7GRJteZ6EL5823ukaTdFEsbf+uX29N0a8oOm8pS1/vJr7w5++bv747R8t89uhl4PsK1+",
+	"M+9sCz+ujbMXiaIrZiBrwS+deVjitc/IaRkv/r512wtxdAQ31Gg8EvDWO8r31L86ogAS195wn3j3a4Ow",
+	"nJV0GG8Ije+RioLZNjCH1i+3p6zWkB81c/Db8uXv7q+h3MF3DN0eYGP9dt7ZJv7h2ENr3Z3sId/TQ9lD",
+	"O6/ATqJqdLlX2XhbDoQ7kJDT8T4+MjPFsr1T5/bnbXsFDi1PZLl5yG3KCfTdJ0kzXz36pq3SXmJlvLEr",
+	"cRFnApXlI6G0uoMjnNfmM5V9prJ7knhyBHyZxgDveT+FrvdPDVvT1t0VTXysIu14tJa6i49IPWjr7o2h",
+	"+L0axkIe3+/07acL3GIgAmsKdq1jXU4XXfSHZw1f/u7+croRPna36e0p/L6D4sJQD8A1thHAQRzijyGU",
+	"HHgNfDo7+0fQhFvr7tSE8z09WBOOec1305Zre5/GVrla14YpnCo1pfd8uANzbM/IH7XtzG3Vl7/nqxtq",
+	"QXPdm50fbucb+33Xu/zHs733LL/bBN+17YMZzFAbzRYSu3updwB1PZwa/f5I/Q8iK/cyvy9dJo/GFkwv",
+	"mEaW+JEdtAG6Zfcxc3jIf0YsPMgBhKkuknke+AjuIsHuE+fckerK6OhUDtXonSzy8Z0VOdjj5Gx/d5P2",
+	"Uvt8n71fdimLGpzCXEHVrkpEd5y7CE50N2wxtTPMDr5s17yM/q4d4IWI/xzON1x0+bk6P7ekAu3PIej7",
+	"EnI+GRtPAZ6v1mufapY53ToAdnvrvfNMp1WJSDM1mSvORFltAiBZxZLhPoHvxqNaVe1JoO6t9au3ruTe",
+	"W9bI9QSLTe8xQSMWWWCYLGAX525FHjeLFfeEM3uK8ATRHqfPbapJux+q89R2zjD+3d4obyfgvDihwRsS",
+	"1uH+nXSxOASQJhbailNh/I45fEyUlGayrq8qXuCnd+/+/wEAYbtxFeWKAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
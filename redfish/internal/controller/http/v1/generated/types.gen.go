@@ -25,6 +25,34 @@ const (
 	StringArray ActionInfoParameterTypes = "StringArray"
 )
 
+// Defines values for ChassisChassisType.
+const (
+	Blade            ChassisChassisType = "Blade"
+	Card             ChassisChassisType = "Card"
+	Cartridge        ChassisChassisType = "Cartridge"
+	Component        ChassisChassisType = "Component"
+	Drawer           ChassisChassisType = "Drawer"
+	Enclosure        ChassisChassisType = "Enclosure"
+	Expansion        ChassisChassisType = "Expansion"
+	HeatExchanger    ChassisChassisType = "HeatExchanger"
+	IPBasedDrive     ChassisChassisType = "IPBasedDrive"
+	ImmersionTank    ChassisChassisType = "ImmersionTank"
+	Module           ChassisChassisType = "Module"
+	Other            ChassisChassisType = "Other"
+	Pod              ChassisChassisType = "Pod"
+	PowerStrip       ChassisChassisType = "PowerStrip"
+	Rack             ChassisChassisType = "Rack"
+	RackGroup        ChassisChassisType = "RackGroup"
+	RackMount        ChassisChassisType = "RackMount"
+	Row              ChassisChassisType = "Row"
+	Shelf            ChassisChassisType = "Shelf"
+	Sidecar          ChassisChassisType = "Sidecar"
+	Sled             ChassisChassisType = "Sled"
+	StandAlone       ChassisChassisType = "StandAlone"
+	StorageEnclosure ChassisChassisType = "StorageEnclosure"
+	Zone             ChassisChassisType = "Zone"
+)
+
 // Defines values for ComputerSystemAutomaticRetryConfig.
 const (
 	ComputerSystemAutomaticRetryConfigDisabled      ComputerSystemAutomaticRetryConfig = "Disabled"
@@ -102,6 +130,17 @@ const (
 	ComputerSystemTrustedModuleRequiredToBootRequired ComputerSystemTrustedModuleRequiredToBoot = "Required"
 )
 
+// Defines values for ManagerManagerType.
+const (
+	AuxiliaryController  ManagerManagerType = "AuxiliaryController"
+	BMC                  ManagerManagerType = "BMC"
+	EnclosureManager     ManagerManagerType = "EnclosureManager"
+	FabricManager        ManagerManagerType = "FabricManager"
+	ManagementController ManagerManagerType = "ManagementController"
+	RackManager          ManagerManagerType = "RackManager"
+	Service              ManagerManagerType = "Service"
+)
+
 // Defines values for ResolutionStepResolutionType.
 const (
 	ResolutionStepResolutionTypeCollectDiagnosticData ResolutionStepResolutionType = "CollectDiagnosticData"
@@ -240,6 +279,120 @@ type ActionInfoParameters_DataType struct {
 	union json.RawMessage
 }
 
+// ChassisCollectionChassisCollection The collection of `Chassis` resource instances.
+type ChassisCollectionChassisCollection struct {
+	// OdataContext The OData description of a payload.
+	OdataContext *OdataV4Context `json:"@odata.context,omitempty"`
+
+	// OdataEtag The current ETag of the resource.
+	OdataEtag *OdataV4Etag `json:"@odata.etag,omitempty"`
+
+	// OdataId The unique identifier for a resource.
+	OdataId *OdataV4Id `json:"@odata.id,omitempty"`
+
+	// OdataType The type of a resource.
+	OdataType   *OdataV4Type                                    `json:"@odata.type,omitempty"`
+	Description *ChassisCollectionChassisCollection_Description `json:"Description,omitempty"`
+
+	// Members The members of this collection.
+	Members *[]OdataV4IdRef `json:"Members,omitempty"`
+
+	// MembersOdataCount The number of items in a collection.
+	MembersOdataCount *OdataV4Count `json:"Members@odata.count,omitempty"`
+
+	// MembersOdataNextLink The URI to the resource containing the next set of partial members.
+	MembersOdataNextLink *OdataV4NextLink `json:"Members@odata.nextLink,omitempty"`
+
+	// Name The name of the resource or array member.
+	Name ResourceName `json:"Name"`
+
+	// Oem The OEM extension.
+	Oem *ResourceOem `json:"Oem,omitempty"`
+}
+
+// ChassisCollectionChassisCollectionDescription1 defines model for .
+type ChassisCollectionChassisCollectionDescription1 = interface{}
+
+// ChassisCollectionChassisCollection_Description defines model for ChassisCollectionChassisCollection.Description.
+type ChassisCollectionChassisCollection_Description struct {
+	union json.RawMessage
+}
+
+// ChassisChassis The `Chassis` schema represents the physical components of a system.  This resource represents the sheet-metal confined spaces and logical zones such as racks, enclosures, chassis and all other containers.  Subsystems, such as sensors, that operate outside of a system's data plane are linked either directly or indirectly through this resource.
+type ChassisChassis struct {
+	// OdataContext The OData description of a payload.
+	OdataContext *OdataV4Context `json:"@odata.context,omitempty"`
+
+	// OdataEtag The current ETag of the resource.
+	OdataEtag *OdataV4Etag `json:"@odata.etag,omitempty"`
+
+	// OdataId The unique identifier for a resource.
+	OdataId *OdataV4Id `json:"@odata.id,omitempty"`
+
+	// OdataType The type of a resource.
+	OdataType   *OdataV4Type                `json:"@odata.type,omitempty"`
+	ChassisType ChassisChassisType          `json:"ChassisType"`
+	Description *ChassisChassis_Description `json:"Description,omitempty"`
+
+	// Id The unique identifier for this resource within the collection of similar resources.
+	Id ResourceId `json:"Id"`
+
+	// Links The links to other resources that are related to this resource.
+	Links *ChassisLinks `json:"Links,omitempty"`
+
+	// Manufacturer The manufacturer of this chassis.
+	Manufacturer *string `json:"Manufacturer"`
+
+	// Model The model number of the chassis.
+	Model *string `json:"Model"`
+
+	// Name The name of the resource or array member.
+	Name ResourceName `json:"Name"`
+
+	// Oem The OEM extension.
+	Oem *ResourceOem `json:"Oem,omitempty"`
+
+	// PowerState The current power state of the chassis.
+	PowerState *ChassisChassis_PowerState `json:"PowerState,omitempty"`
+
+	// SerialNumber The serial number of the chassis.
+	SerialNumber *string `json:"SerialNumber"`
+
+	// Status The status and health of a resource and its children.
+	Status *ResourceStatus `json:"Status,omitempty"`
+}
+
+// ChassisChassisDescription1 defines model for .
+type ChassisChassisDescription1 = interface{}
+
+// ChassisChassis_Description defines model for ChassisChassis.Description.
+type ChassisChassis_Description struct {
+	union json.RawMessage
+}
+
+// ChassisChassisPowerState1 defines model for .
+type ChassisChassisPowerState1 = interface{}
+
+// ChassisChassis_PowerState The current power state of the chassis.
+type ChassisChassis_PowerState struct {
+	union json.RawMessage
+}
+
+// ChassisChassisType defines model for Chassis_ChassisType.
+type ChassisChassisType string
+
+// ChassisLinks The links to other resources that are related to this resource.
+type ChassisLinks struct {
+	// ComputerSystems An array of links to the computer systems that this chassis directly and wholly contains.
+	ComputerSystems *[]OdataV4IdRef `json:"ComputerSystems,omitempty"`
+
+	// ManagedBy An array of links to the managers responsible for managing this chassis.
+	ManagedBy *[]OdataV4IdRef `json:"ManagedBy,omitempty"`
+
+	// Oem The OEM extension.
+	Oem *ResourceOem `json:"Oem,omitempty"`
+}
+
 // ComputerSystemCollectionComputerSystemCollection The collection of `ComputerSystem` resource instances.
 type ComputerSystemCollectionComputerSystemCollection struct {
 	// OdataContext The OData description of a payload.
@@ -557,6 +710,106 @@ type ComputerSystemSystemType string
 // ComputerSystemTrustedModuleRequiredToBoot defines model for ComputerSystem_TrustedModuleRequiredToBoot.
 type ComputerSystemTrustedModuleRequiredToBoot string
 
+// ManagerCollectionManagerCollection The collection of `Manager` resource instances.
+type ManagerCollectionManagerCollection struct {
+	// OdataContext The OData description of a payload.
+	OdataContext *OdataV4Context `json:"@odata.context,omitempty"`
+
+	// OdataEtag The current ETag of the resource.
+	OdataEtag *OdataV4Etag `json:"@odata.etag,omitempty"`
+
+	// OdataId The unique identifier for a resource.
+	OdataId *OdataV4Id `json:"@odata.id,omitempty"`
+
+	// OdataType The type of a resource.
+	OdataType   *OdataV4Type                                    `json:"@odata.type,omitempty"`
+	Description *ManagerCollectionManagerCollection_Description `json:"Description,omitempty"`
+
+	// Members The members of this collection.
+	Members *[]OdataV4IdRef `json:"Members,omitempty"`
+
+	// MembersOdataCount The number of items in a collection.
+	MembersOdataCount *OdataV4Count `json:"Members@odata.count,omitempty"`
+
+	// MembersOdataNextLink The URI to the resource containing the next set of partial members.
+	MembersOdataNextLink *OdataV4NextLink `json:"Members@odata.nextLink,omitempty"`
+
+	// Name The name of the resource or array member.
+	Name ResourceName `json:"Name"`
+
+	// Oem The OEM extension.
+	Oem *ResourceOem `json:"Oem,omitempty"`
+}
+
+// ManagerCollectionManagerCollectionDescription1 defines model for .
+type ManagerCollectionManagerCollectionDescription1 = interface{}
+
+// ManagerCollectionManagerCollection_Description defines model for ManagerCollectionManagerCollection.Description.
+type ManagerCollectionManagerCollection_Description struct {
+	union json.RawMessage
+}
+
+// ManagerLinks The links to other resources that are related to this resource.
+type ManagerLinks struct {
+	// ManagerForChassis An array of links to the chassis this manager controls.
+	ManagerForChassis *[]OdataV4IdRef `json:"ManagerForChassis,omitempty"`
+
+	// ManagerForServers An array of links to the systems that this manager controls.
+	ManagerForServers *[]OdataV4IdRef `json:"ManagerForServers,omitempty"`
+
+	// ManagerInChassis A reference to a resource.
+	ManagerInChassis *OdataV4IdRef `json:"ManagerInChassis,omitempty"`
+
+	// Oem The OEM extension.
+	Oem *ResourceOem `json:"Oem,omitempty"`
+}
+
+// ManagerManager In Redfish, a manager is a systems management entity that can implement or provide access to a Redfish service.  Examples of managers are BMCs, enclosure managers, management controllers, and other subsystems that are assigned manageability functions.
+type ManagerManager struct {
+	// OdataContext The OData description of a payload.
+	OdataContext *OdataV4Context `json:"@odata.context,omitempty"`
+
+	// OdataEtag The current ETag of the resource.
+	OdataEtag *OdataV4Etag `json:"@odata.etag,omitempty"`
+
+	// OdataId The unique identifier for a resource.
+	OdataId *OdataV4Id `json:"@odata.id,omitempty"`
+
+	// OdataType The type of a resource.
+	OdataType   *OdataV4Type                `json:"@odata.type,omitempty"`
+	Description *ManagerManager_Description `json:"Description,omitempty"`
+
+	// Id The unique identifier for this resource within the collection of similar resources.
+	Id ResourceId `json:"Id"`
+
+	// Links The links to other resources that are related to this resource.
+	Links       *ManagerLinks       `json:"Links,omitempty"`
+	ManagerType *ManagerManagerType `json:"ManagerType,omitempty"`
+
+	// Name The name of the resource or array member.
+	Name ResourceName `json:"Name"`
+
+	// Oem The OEM extension.
+	Oem *ResourceOem `json:"Oem,omitempty"`
+
+	// Status The status and health of a resource and its children.
+	Status *ResourceStatus `json:"Status,omitempty"`
+
+	// UUID The UUID for this manager.
+	UUID *string `json:"UUID"`
+}
+
+// ManagerManagerDescription1 defines model for .
+type ManagerManagerDescription1 = interface{}
+
+// ManagerManager_Description defines model for ManagerManager.Description.
+type ManagerManager_Description struct {
+	union json.RawMessage
+}
+
+// ManagerManagerType defines model for Manager_ManagerType.
+type ManagerManagerType string
+
 // MessageMessage The message that the Redfish service returns.
 type MessageMessage struct {
 	// Message The human-readable message.
@@ -1097,6 +1350,192 @@ func (t *ActionInfoParameters_DataType) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsResourceDescription returns the union data inside the ChassisCollectionChassisCollection_Description as a ResourceDescription
+func (t ChassisCollectionChassisCollection_Description) AsResourceDescription() (ResourceDescription, error) {
+	var body ResourceDescription
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromResourceDescription overwrites any union data inside the ChassisCollectionChassisCollection_Description as the provided ResourceDescription
+func (t *ChassisCollectionChassisCollection_Description) FromResourceDescription(v ResourceDescription) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeResourceDescription performs a merge with any union data inside the ChassisCollectionChassisCollection_Description, using the provided ResourceDescription
+func (t *ChassisCollectionChassisCollection_Description) MergeResourceDescription(v ResourceDescription) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChassisCollectionChassisCollectionDescription1 returns the union data inside the ChassisCollectionChassisCollection_Description as a ChassisCollectionChassisCollectionDescription1
+func (t ChassisCollectionChassisCollection_Description) AsChassisCollectionChassisCollectionDescription1() (ChassisCollectionChassisCollectionDescription1, error) {
+	var body ChassisCollectionChassisCollectionDescription1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChassisCollectionChassisCollectionDescription1 overwrites any union data inside the ChassisCollectionChassisCollection_Description as the provided ChassisCollectionChassisCollectionDescription1
+func (t *ChassisCollectionChassisCollection_Description) FromChassisCollectionChassisCollectionDescription1(v ChassisCollectionChassisCollectionDescription1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChassisCollectionChassisCollectionDescription1 performs a merge with any union data inside the ChassisCollectionChassisCollection_Description, using the provided ChassisCollectionChassisCollectionDescription1
+func (t *ChassisCollectionChassisCollection_Description) MergeChassisCollectionChassisCollectionDescription1(v ChassisCollectionChassisCollectionDescription1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ChassisCollectionChassisCollection_Description) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ChassisCollectionChassisCollection_Description) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsResourceDescription returns the union data inside the ChassisChassis_Description as a ResourceDescription
+func (t ChassisChassis_Description) AsResourceDescription() (ResourceDescription, error) {
+	var body ResourceDescription
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromResourceDescription overwrites any union data inside the ChassisChassis_Description as the provided ResourceDescription
+func (t *ChassisChassis_Description) FromResourceDescription(v ResourceDescription) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeResourceDescription performs a merge with any union data inside the ChassisChassis_Description, using the provided ResourceDescription
+func (t *ChassisChassis_Description) MergeResourceDescription(v ResourceDescription) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChassisChassisDescription1 returns the union data inside the ChassisChassis_Description as a ChassisChassisDescription1
+func (t ChassisChassis_Description) AsChassisChassisDescription1() (ChassisChassisDescription1, error) {
+	var body ChassisChassisDescription1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChassisChassisDescription1 overwrites any union data inside the ChassisChassis_Description as the provided ChassisChassisDescription1
+func (t *ChassisChassis_Description) FromChassisChassisDescription1(v ChassisChassisDescription1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChassisChassisDescription1 performs a merge with any union data inside the ChassisChassis_Description, using the provided ChassisChassisDescription1
+func (t *ChassisChassis_Description) MergeChassisChassisDescription1(v ChassisChassisDescription1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ChassisChassis_Description) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ChassisChassis_Description) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsResourcePowerState returns the union data inside the ChassisChassis_PowerState as a ResourcePowerState
+func (t ChassisChassis_PowerState) AsResourcePowerState() (ResourcePowerState, error) {
+	var body ResourcePowerState
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromResourcePowerState overwrites any union data inside the ChassisChassis_PowerState as the provided ResourcePowerState
+func (t *ChassisChassis_PowerState) FromResourcePowerState(v ResourcePowerState) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeResourcePowerState performs a merge with any union data inside the ChassisChassis_PowerState, using the provided ResourcePowerState
+func (t *ChassisChassis_PowerState) MergeResourcePowerState(v ResourcePowerState) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChassisChassisPowerState1 returns the union data inside the ChassisChassis_PowerState as a ChassisChassisPowerState1
+func (t ChassisChassis_PowerState) AsChassisChassisPowerState1() (ChassisChassisPowerState1, error) {
+	var body ChassisChassisPowerState1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChassisChassisPowerState1 overwrites any union data inside the ChassisChassis_PowerState as the provided ChassisChassisPowerState1
+func (t *ChassisChassis_PowerState) FromChassisChassisPowerState1(v ChassisChassisPowerState1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChassisChassisPowerState1 performs a merge with any union data inside the ChassisChassis_PowerState, using the provided ChassisChassisPowerState1
+func (t *ChassisChassis_PowerState) MergeChassisChassisPowerState1(v ChassisChassisPowerState1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ChassisChassis_PowerState) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ChassisChassis_PowerState) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsResourceDescription returns the union data inside the ComputerSystemCollectionComputerSystemCollection_Description as a ResourceDescription
 func (t ComputerSystemCollectionComputerSystemCollection_Description) AsResourceDescription() (ResourceDescription, error) {
 	var body ResourceDescription
@@ -1841,6 +2280,130 @@ func (t *ComputerSystemMemorySummary_MemoryMirroring) UnmarshalJSON(b []byte) er
 	return err
 }
 
+// AsResourceDescription returns the union data inside the ManagerCollectionManagerCollection_Description as a ResourceDescription
+func (t ManagerCollectionManagerCollection_Description) AsResourceDescription() (ResourceDescription, error) {
+	var body ResourceDescription
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromResourceDescription overwrites any union data inside the ManagerCollectionManagerCollection_Description as the provided ResourceDescription
+func (t *ManagerCollectionManagerCollection_Description) FromResourceDescription(v ResourceDescription) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeResourceDescription performs a merge with any union data inside the ManagerCollectionManagerCollection_Description, using the provided ResourceDescription
+func (t *ManagerCollectionManagerCollection_Description) MergeResourceDescription(v ResourceDescription) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsManagerCollectionManagerCollectionDescription1 returns the union data inside the ManagerCollectionManagerCollection_Description as a ManagerCollectionManagerCollectionDescription1
+func (t ManagerCollectionManagerCollection_Description) AsManagerCollectionManagerCollectionDescription1() (ManagerCollectionManagerCollectionDescription1, error) {
+	var body ManagerCollectionManagerCollectionDescription1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromManagerCollectionManagerCollectionDescription1 overwrites any union data inside the ManagerCollectionManagerCollection_Description as the provided ManagerCollectionManagerCollectionDescription1
+func (t *ManagerCollectionManagerCollection_Description) FromManagerCollectionManagerCollectionDescription1(v ManagerCollectionManagerCollectionDescription1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeManagerCollectionManagerCollectionDescription1 performs a merge with any union data inside the ManagerCollectionManagerCollection_Description, using the provided ManagerCollectionManagerCollectionDescription1
+func (t *ManagerCollectionManagerCollection_Description) MergeManagerCollectionManagerCollectionDescription1(v ManagerCollectionManagerCollectionDescription1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ManagerCollectionManagerCollection_Description) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ManagerCollectionManagerCollection_Description) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsResourceDescription returns the union data inside the ManagerManager_Description as a ResourceDescription
+func (t ManagerManager_Description) AsResourceDescription() (ResourceDescription, error) {
+	var body ResourceDescription
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromResourceDescription overwrites any union data inside the ManagerManager_Description as the provided ResourceDescription
+func (t *ManagerManager_Description) FromResourceDescription(v ResourceDescription) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeResourceDescription performs a merge with any union data inside the ManagerManager_Description, using the provided ResourceDescription
+func (t *ManagerManager_Description) MergeResourceDescription(v ResourceDescription) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsManagerManagerDescription1 returns the union data inside the ManagerManager_Description as a ManagerManagerDescription1
+func (t ManagerManager_Description) AsManagerManagerDescription1() (ManagerManagerDescription1, error) {
+	var body ManagerManagerDescription1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromManagerManagerDescription1 overwrites any union data inside the ManagerManager_Description as the provided ManagerManagerDescription1
+func (t *ManagerManager_Description) FromManagerManagerDescription1(v ManagerManagerDescription1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeManagerManagerDescription1 performs a merge with any union data inside the ManagerManager_Description, using the provided ManagerManagerDescription1
+func (t *ManagerManager_Description) MergeManagerManagerDescription1(v ManagerManagerDescription1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ManagerManager_Description) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ManagerManager_Description) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsActionInfoParameters returns the union data inside the ResolutionStepResolutionStep_ActionParameters_Item as a ActionInfoParameters
 func (t ResolutionStepResolutionStep_ActionParameters_Item) AsActionInfoParameters() (ActionInfoParameters, error) {
 	var body ActionInfoParameters
@@ -0,0 +1,108 @@
+// Package v1 provides HTTP handlers for the Redfish Chassis endpoints.
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Chassis OData metadata constants
+const (
+	odataContextChassisCollection = "/redfish/v1/$metadata#ChassisCollection.ChassisCollection"
+	odataTypeChassisCollection    = "#ChassisCollection.ChassisCollection"
+	odataContextChassis           = "/redfish/v1/$metadata#Chassis.Chassis"
+	odataTypeChassis              = "#Chassis.v1_25_0.Chassis"
+	chassisCollectionName         = "Chassis Collection"
+	chassisCollectionDescription  = "Collection of Chassis"
+	chassisResourceName           = "Chassis"
+	chassisNameSuffix             = " Chassis"
+
+	// chassisTypeOther is reported as AMT does not tell a desktop from a laptop or a server.
+	chassisTypeOther = "Other"
+)
+
+// GetRedfishV1Chassis returns the collection of chassis, one per computer system.
+// Path: GET /redfish/v1/Chassis
+// Spec: Redfish ChassisCollection
+func (s *RedfishServer) GetRedfishV1Chassis(c *gin.Context) {
+	systemIDs, err := s.ComputerSystemUC.GetAll(c.Request.Context())
+	if err != nil {
+		if s.Logger != nil {
+			s.Logger.Error("Failed to retrieve chassis collection", "error", err)
+		}
+
+		InternalServerError(c, err)
+
+		return
+	}
+
+	SetRedfishHeaders(c)
+
+	members := idRefs(chassisBasePath, systemIDs)
+
+	c.JSON(http.StatusOK, map[string]interface{}{
+		"@odata.context":      odataContextChassisCollection,
+		"@odata.id":           chassisBasePath,
+		"@odata.type":         odataTypeChassisCollection,
+		"Name":                chassisCollectionName,
+		"Description":         chassisCollectionDescription,
+		"Members":             members,
+		"Members@odata.count": len(members),
+	})
+}
+
+// GetRedfishV1ChassisChassisId returns the chassis containing a computer system.
+// Path: GET /redfish/v1/Chassis/{ChassisId}
+// Spec: Redfish Chassis.v1_25_0
+//
+//revive:disable-next-line var-naming. Codegen is using openapi spec for generation which required Id to be Redfish complaint.
+func (s *RedfishServer) GetRedfishV1ChassisChassisId(c *gin.Context, chassisID string) {
+	if err := validateSystemID(chassisID); err != nil {
+		BadRequestError(c, fmt.Sprintf("Invalid chassis ID: %s", err.Error()))
+
+		return
+	}
+
+	system, err := s.ComputerSystemUC.GetSystem(c.Request.Context(), chassisID)
+	if err != nil {
+		s.handleSystemLookupError(c, err, chassisResourceName, chassisID)
+
+		return
+	}
+
+	SetRedfishHeaders(c)
+
+	chassis := map[string]interface{}{
+		"@odata.context": odataContextChassis,
+		"@odata.id":      chassisBasePath + "/" + chassisID,
+		"@odata.type":    odataTypeChassis,
+		"Id":             chassisID,
+		"Name":           system.Name + chassisNameSuffix,
+		"ChassisType":    chassisTypeOther,
+		"Links":          newChassisLinks(chassisID),
+	}
+
+	if system.Manufacturer != "" {
+		chassis["Manufacturer"] = system.Manufacturer
+	}
+
+	if system.Model != "" {
+		chassis["Model"] = system.Model
+	}
+
+	if system.SerialNumber != "" {
+		chassis["SerialNumber"] = system.SerialNumber
+	}
+
+	if system.PowerState != "" {
+		chassis["PowerState"] = system.PowerState
+	}
+
+	if system.Status != nil {
+		chassis["Status"] = system.Status
+	}
+
+	c.JSON(http.StatusOK, chassis)
+}
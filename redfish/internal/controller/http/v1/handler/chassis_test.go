@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	redfishv1 "github.com/device-management-toolkit/console/redfish/internal/entity/v1"
+	"github.com/device-management-toolkit/console/redfish/internal/usecase"
+)
+
+// setupLinkedResourcesRouter serves the systems, chassis and managers of a repository holding testUUID1 and testUUID2.
+func setupLinkedResourcesRouter() (*gin.Engine, *TestSystemsComputerSystemRepository) {
+	gin.SetMode(gin.TestMode)
+
+	repo := NewTestSystemsComputerSystemRepository()
+	repo.AddSystem(testUUID1, createTestSystemEntityDataWithAllProperties(testUUID1, "System 1", "Intel", "NUC", "SN-1"))
+	repo.AddSystem(testUUID2, createTestSystemEntityData(testUUID2, "System 2", "", "", ""))
+
+	server := &RedfishServer{ComputerSystemUC: &usecase.ComputerSystemUseCase{Repo: repo}}
+
+	router := gin.New()
+	router.GET("/redfish/v1/Systems/:ComputerSystemId", func(c *gin.Context) {
+		server.GetRedfishV1SystemsComputerSystemId(c, c.Param("ComputerSystemId"))
+	})
+	router.GET("/redfish/v1/Chassis", server.GetRedfishV1Chassis)
+	router.GET("/redfish/v1/Chassis/:ChassisId", func(c *gin.Context) {
+		server.GetRedfishV1ChassisChassisId(c, c.Param("ChassisId"))
+	})
+	router.GET("/redfish/v1/Managers", server.GetRedfishV1Managers)
+	router.GET("/redfish/v1/Managers/:ManagerId", func(c *gin.Context) {
+		server.GetRedfishV1ManagersManagerId(c, c.Param("ManagerId"))
+	})
+
+	return router, repo
+}
+
+// getLinkedResource requests a resource and decodes its body.
+func getLinkedResource(t *testing.T, router *gin.Engine, path string) (int, map[string]interface{}) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, http.NoBody))
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body), w.Body.String())
+
+	return w.Code, body
+}
+
+// linkedIDs returns the @odata.id of the references in a Links property.
+func linkedIDs(t *testing.T, links map[string]interface{}, name string) []string {
+	t.Helper()
+
+	refs, ok := links[name].([]interface{})
+	require.True(t, ok, "Links.%s must be an array", name)
+
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.(map[string]interface{})["@odata.id"].(string))
+	}
+
+	return ids
+}
+
+func TestGetRedfishV1Chassis(t *testing.T) {
+	t.Parallel()
+
+	router, _ := setupLinkedResourcesRouter()
+
+	code, body := getLinkedResource(t, router, "/redfish/v1/Chassis")
+	require.Equal(t, http.StatusOK, code)
+
+	assert.Equal(t, "/redfish/v1/Chassis", body["@odata.id"])
+	assert.Equal(t, "#ChassisCollection.ChassisCollection", body["@odata.type"])
+	assert.InDelta(t, 2, body["Members@odata.count"], 0)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/" + testUUID1},
+		map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/" + testUUID2},
+	}, body["Members"])
+}
+
+func TestGetRedfishV1ChassisChassisId(t *testing.T) {
+	t.Parallel()
+
+	router, repo := setupLinkedResourcesRouter()
+	repo.SetErrorOnGetByID(testUUID3, errSystemRepoFailure)
+
+	t.Run("chassis of a system", func(t *testing.T) {
+		t.Parallel()
+
+		code, body := getLinkedResource(t, router, "/redfish/v1/Chassis/"+testUUID1)
+		require.Equal(t, http.StatusOK, code)
+
+		assert.Equal(t, "/redfish/v1/Chassis/"+testUUID1, body["@odata.id"])
+		assert.Equal(t, "#Chassis.v1_25_0.Chassis", body["@odata.type"])
+		assert.Equal(t, testUUID1, body["Id"])
+		assert.Equal(t, "System 1 Chassis", body["Name"])
+		assert.Equal(t, "Other", body["ChassisType"])
+		assert.Equal(t, "Intel", body["Manufacturer"])
+		assert.Equal(t, "SN-1", body["SerialNumber"])
+		assert.Equal(t, string(redfishv1.PowerStateOn), body["PowerState"])
+
+		links, ok := body["Links"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, []string{"/redfish/v1/Systems/" + testUUID1}, linkedIDs(t, links, "ComputerSystems"))
+		assert.Equal(t, []string{"/redfish/v1/Managers/" + testUUID1}, linkedIDs(t, links, "ManagedBy"))
+	})
+
+	t.Run("properties the system lacks are omitted", func(t *testing.T) {
+		t.Parallel()
+
+		code, body := getLinkedResource(t, router, "/redfish/v1/Chassis/"+testUUID2)
+		require.Equal(t, http.StatusOK, code)
+
+		assert.NotContains(t, body, "Manufacturer")
+		assert.NotContains(t, body, "Status")
+	})
+
+	tests := []struct {
+		name   string
+		id     string
+		status int
+	}{
+		{name: "invalid id", id: "not-a-guid", status: http.StatusBadRequest},
+		{name: "unknown system", id: testUUIDNotFound, status: http.StatusNotFound},
+		{name: "repository error", id: testUUID3, status: http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, body := getLinkedResource(t, router, "/redfish/v1/Chassis/"+tc.id)
+			assert.Equal(t, tc.status, code)
+			assert.Contains(t, body, "error")
+		})
+	}
+}
@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/device-management-toolkit/console/pkg/openapivalidate"
 	"github.com/device-management-toolkit/console/redfish/internal/controller/http/v1/generated"
 )
 
@@ -25,16 +28,40 @@ const (
 
 	// Common error messages
 	msgInternalServerError = "An internal server error occurred."
+	msgInvalidProperties   = "The request body contains properties that do not match the schema of the resource."
+	msgCorrectProperty     = "Correct the value of the property and resubmit the request."
 )
 
-// ErrUnknownErrorType is returned when an unknown error type is requested
-var ErrUnknownErrorType = errors.New("unknown error type")
+var (
+	// ErrUnknownErrorType is returned when an unknown error type is requested
+	ErrUnknownErrorType = errors.New("unknown error type")
+	// ErrErrorTypeExists is returned when registering an error type that is already defined
+	ErrErrorTypeExists = errors.New("error type already registered")
+)
 
 // registryMgr is the global registry manager instance
 var registryMgr = GetRegistryManager()
 
+// Built-in error types
+const (
+	errTypeConflict               = "Conflict"
+	errTypeSessionConflict        = "SessionConflict"
+	errTypePowerStateConflict     = "PowerStateConflict"
+	errTypeMethodNotAllowed       = "MethodNotAllowed"
+	errTypeUnauthorized           = "Unauthorized"
+	errTypeBadRequest             = "BadRequest"
+	errTypeForbidden              = "Forbidden"
+	errTypeServiceUnavailable     = "ServiceUnavailable"
+	errTypeNotFound               = "NotFound"
+	errTypeMalformedJSON          = "MalformedJSON"
+	errTypePropertyMissing        = "PropertyMissing"
+	errTypePropertyValueNotInList = "PropertyValueNotInList"
+)
+
 // ErrorConfig defines configuration for a specific error type
 type ErrorConfig struct {
+	// Registry is the message registry prefix; defaults to Base when empty
+	Registry       string
 	RegistryKey    string
 	StatusCode     int
 	CustomMessage  string
@@ -43,78 +70,105 @@ type ErrorConfig struct {
 	MessageOveride func(string) string
 }
 
-// errorConfigMap maps error types to their registry configuration
-var errorConfigMap = map[string]ErrorConfig{
-	"Conflict": {
-		RegistryKey: "ResourceInUse",
-		StatusCode:  http.StatusConflict,
-	},
-	"SessionConflict": {
+// errorDefinitions holds the built-in error types; modules add their own through RegisterErrorType
+var errorDefinitions = []struct {
+	errorType string
+	config    ErrorConfig
+}{
+	{errTypeConflict, ErrorConfig{RegistryKey: "ResourceInUse", StatusCode: http.StatusConflict}},
+	{errTypeSessionConflict, ErrorConfig{
 		RegistryKey:   "ResourceInUse",
 		StatusCode:    http.StatusConflict,
-		CustomMessage: "A session already exists for this user. Delete the existing session before creating a new one.",
+		CustomMessage: "The maximum number of sessions for this user has been reached. Delete an existing session before creating a new one.",
 		OverrideMsg:   true,
-	},
-	"PowerStateConflict": {
-		RegistryKey: "ResourceInUse",
-		StatusCode:  http.StatusConflict,
-	},
-	"MethodNotAllowed": {
-		RegistryKey: "MethodNotAllowed",
-		StatusCode:  http.StatusMethodNotAllowed,
-	},
-	"Unauthorized": {
+	}},
+	{errTypePowerStateConflict, ErrorConfig{RegistryKey: "ResourceInUse", StatusCode: http.StatusConflict}},
+	{errTypeMethodNotAllowed, ErrorConfig{RegistryKey: "MethodNotAllowed", StatusCode: http.StatusMethodNotAllowed}},
+	{errTypeUnauthorized, ErrorConfig{
 		RegistryKey:   "InsufficientPrivilege",
 		StatusCode:    http.StatusUnauthorized,
 		CustomMessage: "Unauthorized access",
 		OverrideMsg:   true,
-	},
-	"BadRequest": {
-		RegistryKey: "GeneralError",
-		StatusCode:  http.StatusBadRequest,
-	},
-	"Forbidden": {
+	}},
+	{errTypeBadRequest, ErrorConfig{RegistryKey: "GeneralError", StatusCode: http.StatusBadRequest}},
+	{errTypeForbidden, ErrorConfig{
 		RegistryKey:   "InsufficientPrivilege",
 		StatusCode:    http.StatusForbidden,
 		CustomMessage: "Insufficient privileges to perform operation",
 		OverrideMsg:   true,
-	},
-	"ServiceUnavailable": {
-		RegistryKey: "ServiceTemporarilyUnavailable",
-		StatusCode:  http.StatusServiceUnavailable,
-	},
-	"NotFound": {
+	}},
+	{errTypeServiceUnavailable, ErrorConfig{RegistryKey: "ServiceTemporarilyUnavailable", StatusCode: http.StatusServiceUnavailable}},
+	{errTypeNotFound, ErrorConfig{
 		RegistryKey: "ResourceMissing",
 		StatusCode:  http.StatusNotFound,
 		MessageOveride: func(resource string) string {
 			return resource + " not found"
 		},
-	},
-	"MalformedJSON": {
-		RegistryKey: "MalformedJSON",
-		StatusCode:  http.StatusBadRequest,
-	},
-	"PropertyMissing": {
+	}},
+	{errTypeMalformedJSON, ErrorConfig{RegistryKey: "MalformedJSON", StatusCode: http.StatusBadRequest}},
+	{errTypePropertyMissing, ErrorConfig{
 		RegistryKey: "PropertyMissing",
 		StatusCode:  http.StatusBadRequest,
 		MessageOveride: func(propertyName string) string {
 			return "Missing or empty " + propertyName
 		},
-	},
-	"PropertyValueNotInList": {
+	}},
+	{errTypePropertyValueNotInList, ErrorConfig{
 		RegistryKey: "PropertyValueNotInList",
 		StatusCode:  http.StatusBadRequest,
 		MessageOveride: func(propertyName string) string {
 			return "Invalid " + propertyName
 		},
-	},
+	}},
+}
+
+var (
+	// errorConfigMap maps error types to their registry configuration
+	errorConfigMap   = make(map[string]ErrorConfig, len(errorDefinitions))
+	errorConfigMutex sync.RWMutex
+)
+
+func init() {
+	for _, def := range errorDefinitions {
+		errorConfigMap[def.errorType] = def.config
+	}
+}
+
+// RegisterErrorType adds an error type so that modules (e.g. Tasks, Events) can send
+// registry-backed errors through SendError without defining their own response builders.
+func RegisterErrorType(errorType string, config ErrorConfig) error {
+	errorConfigMutex.Lock()
+	defer errorConfigMutex.Unlock()
+
+	if _, exists := errorConfigMap[errorType]; exists {
+		return fmt.Errorf("%w: %s", ErrErrorTypeExists, errorType)
+	}
+
+	errorConfigMap[errorType] = config
+
+	return nil
+}
+
+// lookupErrorConfig returns the configuration registered for an error type
+func lookupErrorConfig(errorType string) (ErrorConfig, bool) {
+	errorConfigMutex.RLock()
+	defer errorConfigMutex.RUnlock()
+
+	config, exists := errorConfigMap[errorType]
+
+	return config, exists
+}
+
+// SendError writes the Redfish error response for a registered error type.
+func SendError(c *gin.Context, errorType, customMessage string, args ...interface{}) {
+	sendRedfishError(c, errorType, customMessage, args...)
 }
 
 // sendRedfishError is a generic error handler using the error configuration lookup table
 func sendRedfishError(c *gin.Context, errorType, customMessage string, args ...interface{}) {
 	SetRedfishHeaders(c)
 
-	config, exists := errorConfigMap[errorType]
+	config, exists := lookupErrorConfig(errorType)
 	if !exists {
 		// Fallback to internal error if config not found
 		InternalServerError(c, fmt.Errorf("%w: %s", ErrUnknownErrorType, errorType))
@@ -124,7 +178,12 @@ func sendRedfishError(c *gin.Context, errorType, customMessage string, args ...i
 
 	handleRetryAfterHeader(c, config, errorType, args)
 
-	errorResponse, err := createErrorResponse("Base", config.RegistryKey, args...)
+	registry := config.Registry
+	if registry == "" {
+		registry = registryPrefixBase
+	}
+
+	errorResponse, err := createErrorResponse(registry, config.RegistryKey, args...)
 	if err != nil {
 		// This should never happen since the registry is embedded
 		InternalServerError(c, err)
@@ -139,7 +198,7 @@ func sendRedfishError(c *gin.Context, errorType, customMessage string, args ...i
 
 // handleRetryAfterHeader sets the Retry-After header for service unavailable errors
 func handleRetryAfterHeader(c *gin.Context, config ErrorConfig, errorType string, args []interface{}) {
-	if config.RetryAfter <= 0 && (errorType != "ServiceUnavailable" || len(args) == 0) {
+	if config.RetryAfter <= 0 && (errorType != errTypeServiceUnavailable || len(args) == 0) {
 		return
 	}
 
@@ -194,9 +253,52 @@ func SetRedfishHeaders(c *gin.Context) {
 	c.Header("Cache-Control", "no-cache")
 }
 
+// errorBuilder assembles a generated.RedfishError payload.
+// It is the only place that spells out the anonymous error struct of the generated type.
+type errorBuilder struct {
+	code         string
+	message      string
+	extendedInfo []generated.MessageMessage
+}
+
+// newErrorBuilder starts an error payload with the given code and top-level message
+func newErrorBuilder(code, message string) *errorBuilder {
+	return &errorBuilder{code: code, message: message}
+}
+
+// withExtendedInfo appends a @Message.ExtendedInfo entry; an empty resolution is omitted
+func (b *errorBuilder) withExtendedInfo(messageID, message, severity, resolution string) *errorBuilder {
+	info := generated.MessageMessage{
+		MessageId: &messageID,
+		Message:   &message,
+		Severity:  &severity,
+	}
+
+	if resolution != "" {
+		info.Resolution = &resolution
+	}
+
+	b.extendedInfo = append(b.extendedInfo, info)
+
+	return b
+}
+
+// build returns the assembled error payload
+func (b *errorBuilder) build() *generated.RedfishError {
+	errorResponse := &generated.RedfishError{}
+	errorResponse.Error.Code = &b.code
+	errorResponse.Error.Message = &b.message
+
+	if len(b.extendedInfo) > 0 {
+		errorResponse.Error.MessageExtendedInfo = &b.extendedInfo
+	}
+
+	return errorResponse
+}
+
 // createErrorResponse creates a Redfish error response using registry lookup.
-// Note: registryName currently always receives "Base", but the parameter is kept for
-// future extensibility when additional registries (Task, Update, ResourceEvent) are added.
+// Messages resolve against the active version of the named registry, so a registry
+// version bump only requires embedding the new file and updating configuration.
 func createErrorResponse(registryName, messageKey string, args ...interface{}) (*generated.RedfishError, error) {
 	regMsg, err := registryMgr.LookupMessage(registryName, messageKey)
 	if err != nil {
@@ -204,70 +306,50 @@ func createErrorResponse(registryName, messageKey string, args ...interface{}) (
 	}
 
 	messageStr := regMsg.FormatMessage(args...)
-	messageID := regMsg.MessageID
-	resolution := regMsg.Resolution
-	severity := mapSeverityToResourceHealth(regMsg.Severity)
-
-	errorResponse := &generated.RedfishError{
-		Error: struct {
-			MessageExtendedInfo *[]generated.MessageMessage `json:"@Message.ExtendedInfo,omitempty"`
-			Code                *string                     `json:"code,omitempty"`
-			Message             *string                     `json:"message,omitempty"`
-		}{
-			Code:    &[]string{regMsg.RegistryPrefix + "." + regMsg.RegistryVersion + ".GeneralError"}[0],
-			Message: &messageStr,
-			MessageExtendedInfo: &[]generated.MessageMessage{
-				{
-					MessageId:  &messageID,
-					Message:    &messageStr,
-					Severity:   &severity,
-					Resolution: &resolution,
-				},
-			},
-		},
-	}
 
-	return errorResponse, nil
+	return newErrorBuilder(regMsg.RegistryPrefix+"."+regMsg.RegistryVersion+".GeneralError", messageStr).
+		withExtendedInfo(regMsg.MessageID, messageStr, mapSeverityToResourceHealth(regMsg.Severity), regMsg.Resolution).
+		build(), nil
 }
 
 // ConflictError returns a Redfish-compliant 409 error
 func ConflictError(c *gin.Context, _, message string) {
-	sendRedfishError(c, "Conflict", message)
+	sendRedfishError(c, errTypeConflict, message)
 }
 
-// SessionConflictError returns a Redfish-compliant 409 error for duplicate sessions
+// SessionConflictError returns a Redfish-compliant 409 error when the per-user session limit is reached
 func SessionConflictError(c *gin.Context) {
-	sendRedfishError(c, "SessionConflict", "")
+	sendRedfishError(c, errTypeSessionConflict, "")
 }
 
 // PowerStateConflictError returns a Redfish-compliant 409 error for power state conflicts
 func PowerStateConflictError(c *gin.Context, _ string) {
-	sendRedfishError(c, "PowerStateConflict", "")
+	sendRedfishError(c, errTypePowerStateConflict, "")
 }
 
 // MethodNotAllowedError returns a Redfish-compliant 405 error
 func MethodNotAllowedError(c *gin.Context) {
-	sendRedfishError(c, "MethodNotAllowed", "")
+	sendRedfishError(c, errTypeMethodNotAllowed, "")
 }
 
 // UnauthorizedError returns a Redfish-compliant 401 error
 func UnauthorizedError(c *gin.Context) {
-	sendRedfishError(c, "Unauthorized", "")
+	sendRedfishError(c, errTypeUnauthorized, "")
 }
 
 // BadRequestError returns a Redfish-compliant 400 error
 func BadRequestError(c *gin.Context, customMessage string) {
-	sendRedfishError(c, "BadRequest", customMessage)
+	sendRedfishError(c, errTypeBadRequest, customMessage)
 }
 
 // ForbiddenError returns a Redfish-compliant 403 error for insufficient privileges
 func ForbiddenError(c *gin.Context) {
-	sendRedfishError(c, "Forbidden", "")
+	sendRedfishError(c, errTypeForbidden, "")
 }
 
 // ServiceUnavailableError returns a Redfish-compliant 503 error
 func ServiceUnavailableError(c *gin.Context, retryAfterSeconds int) {
-	sendRedfishError(c, "ServiceUnavailable", "", retryAfterSeconds, fmt.Sprintf("%d", retryAfterSeconds))
+	sendRedfishError(c, errTypeServiceUnavailable, "", retryAfterSeconds, fmt.Sprintf("%d", retryAfterSeconds))
 }
 
 // NotFoundError returns a Redfish-compliant 404 error
@@ -277,35 +359,20 @@ func NotFoundError(c *gin.Context, resource string, id ...string) {
 		identifier = id[0]
 	}
 
-	sendRedfishError(c, "NotFound", "", resource, identifier)
+	sendRedfishError(c, errTypeNotFound, "", resource, identifier)
 }
 
 // InternalServerError returns a Redfish-compliant 500 error
 func InternalServerError(c *gin.Context, err error) {
 	SetRedfishHeaders(c)
 
-	errorResponse, regErr := createErrorResponse("Base", "InternalError")
+	errorResponse, regErr := createErrorResponse(registryPrefixBase, "InternalError")
 	if regErr != nil {
 		// Ultimate fallback - if even the registry lookup fails, return a minimal error
-		errorMessage := msgInternalServerError
-		errMsg := err.Error()
-		c.JSON(http.StatusInternalServerError, generated.RedfishError{
-			Error: struct {
-				MessageExtendedInfo *[]generated.MessageMessage `json:"@Message.ExtendedInfo,omitempty"`
-				Code                *string                     `json:"code,omitempty"`
-				Message             *string                     `json:"message,omitempty"`
-			}{
-				Code:    &[]string{"Base.1.22.0.InternalError"}[0],
-				Message: &errorMessage,
-				MessageExtendedInfo: &[]generated.MessageMessage{
-					{
-						MessageId: &[]string{"Base.1.22.0.InternalError"}[0],
-						Message:   &errMsg,
-						Severity:  &[]string{string(generated.Critical)}[0],
-					},
-				},
-			},
-		})
+		messageID := registryMgr.MessageID(registryPrefixBase, "InternalError")
+		c.JSON(http.StatusInternalServerError, newErrorBuilder(messageID, msgInternalServerError).
+			withExtendedInfo(messageID, err.Error(), string(generated.Critical), "").
+			build())
 
 		return
 	}
@@ -319,17 +386,40 @@ func InternalServerError(c *gin.Context, err error) {
 	c.JSON(http.StatusInternalServerError, errorResponse)
 }
 
+// InvalidPropertiesError returns a Redfish-compliant 400 error with an @Message.ExtendedInfo entry per property
+// of the request body not matching the schema, related to the property by its JSON pointer
+func InvalidPropertiesError(c *gin.Context, fields []openapivalidate.FieldError) {
+	SetRedfishHeaders(c)
+
+	messageID := registryMgr.MessageID(registryPrefixBase, "GeneralError")
+	builder := newErrorBuilder(messageID, msgInvalidProperties)
+
+	for _, field := range fields {
+		message := field.Message
+		if field.Field != "" {
+			message = field.Field + ": " + message
+		}
+
+		builder.withExtendedInfo(messageID, message, string(generated.Warning), msgCorrectProperty)
+
+		related := []string{"#/" + strings.ReplaceAll(field.Field, ".", "/")}
+		builder.extendedInfo[len(builder.extendedInfo)-1].RelatedProperties = &related
+	}
+
+	c.JSON(http.StatusBadRequest, builder.build())
+}
+
 // MalformedJSONError returns a Redfish-compliant 400 error for malformed JSON
 func MalformedJSONError(c *gin.Context) {
-	sendRedfishError(c, "MalformedJSON", "")
+	sendRedfishError(c, errTypeMalformedJSON, "")
 }
 
 // PropertyMissingError returns a Redfish-compliant 400 error for missing required property
 func PropertyMissingError(c *gin.Context, propertyName string) {
-	sendRedfishError(c, "PropertyMissing", "", propertyName)
+	sendRedfishError(c, errTypePropertyMissing, "", propertyName)
 }
 
 // PropertyValueNotInListError returns a Redfish-compliant 400 error for invalid property value
 func PropertyValueNotInListError(c *gin.Context, propertyName string) {
-	sendRedfishError(c, "PropertyValueNotInList", "", "invalid", propertyName)
+	sendRedfishError(c, errTypePropertyValueNotInList, "", "invalid", propertyName)
 }
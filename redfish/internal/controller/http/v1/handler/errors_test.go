@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestFailure = errors.New("test failure")
+
+type errorPayload struct {
+	Error struct {
+		Code         string `json:"code"`
+		Message      string `json:"message"`
+		ExtendedInfo []struct {
+			MessageID  string `json:"MessageId"`
+			Message    string `json:"Message"`
+			Severity   string `json:"Severity"`
+			Resolution string `json:"Resolution"`
+		} `json:"@Message.ExtendedInfo"`
+	} `json:"error"`
+}
+
+func serveError(t *testing.T, send func(c *gin.Context)) (*httptest.ResponseRecorder, errorPayload) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/test", send)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", http.NoBody))
+
+	var payload errorPayload
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &payload))
+
+	return w, payload
+}
+
+func TestErrorHelpers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		send              func(c *gin.Context)
+		expectedStatus    int
+		expectedMessageID string
+		expectedMessage   string
+	}{
+		{
+			name:              "not found",
+			send:              func(c *gin.Context) { NotFoundError(c, "System", "abc") },
+			expectedStatus:    http.StatusNotFound,
+			expectedMessageID: "Base.1.22.0.ResourceMissing",
+			expectedMessage:   "System not found",
+		},
+		{
+			name:              "bad request with custom message",
+			send:              func(c *gin.Context) { BadRequestError(c, "bad input") },
+			expectedStatus:    http.StatusBadRequest,
+			expectedMessageID: "Base.1.22.0.GeneralError",
+			expectedMessage:   "bad input",
+		},
+		{
+			name:              "unauthorized",
+			send:              UnauthorizedError,
+			expectedStatus:    http.StatusUnauthorized,
+			expectedMessageID: "Base.1.22.0.InsufficientPrivilege",
+			expectedMessage:   "Unauthorized access",
+		},
+		{
+			name:              "internal error",
+			send:              func(c *gin.Context) { InternalServerError(c, errTestFailure) },
+			expectedStatus:    http.StatusInternalServerError,
+			expectedMessageID: "Base.1.22.0.InternalError",
+			expectedMessage:   msgInternalServerError,
+		},
+		{
+			name:              "unknown error type",
+			send:              func(c *gin.Context) { SendError(c, "NoSuchErrorType", "") },
+			expectedStatus:    http.StatusInternalServerError,
+			expectedMessageID: "Base.1.22.0.InternalError",
+			expectedMessage:   msgInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			w, payload := serveError(t, tc.send)
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			assert.Equal(t, tc.expectedMessage, payload.Error.Message)
+			require.Len(t, payload.Error.ExtendedInfo, 1)
+			assert.Equal(t, tc.expectedMessageID, payload.Error.ExtendedInfo[0].MessageID)
+		})
+	}
+}
+
+func TestServiceUnavailableErrorSetsRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	w, payload := serveError(t, func(c *gin.Context) { ServiceUnavailableError(c, 30) })
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "30", w.Header().Get(headerRetryAfter))
+	assert.Contains(t, payload.Error.Message, "30")
+}
+
+func TestRegisterErrorType(t *testing.T) {
+	t.Parallel()
+
+	err := RegisterErrorType("TestTaskAborted", ErrorConfig{
+		Registry:    registryPrefixTaskEvent,
+		RegistryKey: "TaskAborted",
+		StatusCode:  http.StatusConflict,
+	})
+	require.NoError(t, err)
+
+	err = RegisterErrorType("TestTaskAborted", ErrorConfig{RegistryKey: "TaskAborted"})
+	require.ErrorIs(t, err, ErrErrorTypeExists)
+
+	w, payload := serveError(t, func(c *gin.Context) { SendError(c, "TestTaskAborted", "", "42") })
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, "The task with Id '42' has been aborted.", payload.Error.Message)
+	require.Len(t, payload.Error.ExtendedInfo, 1)
+	assert.Equal(t, "TaskEvent.1.0.3.TaskAborted", payload.Error.ExtendedInfo[0].MessageID)
+}
+
+func TestErrorBuilder(t *testing.T) {
+	t.Parallel()
+
+	built := newErrorBuilder("Base.1.22.0.GeneralError", "top").
+		withExtendedInfo("Base.1.22.0.InternalError", "detail", "Critical", "").
+		build()
+
+	require.NotNil(t, built.Error.Code)
+	assert.Equal(t, "Base.1.22.0.GeneralError", *built.Error.Code)
+	assert.Equal(t, "top", *built.Error.Message)
+	require.NotNil(t, built.Error.MessageExtendedInfo)
+	require.Len(t, *built.Error.MessageExtendedInfo, 1)
+	assert.Nil(t, (*built.Error.MessageExtendedInfo)[0].Resolution)
+
+	empty := newErrorBuilder("code", "msg").build()
+	assert.Nil(t, empty.Error.MessageExtendedInfo)
+}
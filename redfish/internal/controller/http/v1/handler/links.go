@@ -0,0 +1,85 @@
+// Package v1 provides the Links relating Redfish Computer Systems to their Chassis and Managers.
+package v1
+
+import (
+	"github.com/device-management-toolkit/console/redfish/internal/controller/http/v1/generated"
+)
+
+// An AMT device is served as one ComputerSystem, the Chassis containing it and the Manager, its management engine,
+// all three identified by the GUID of the device.
+const (
+	chassisBasePath  = "/redfish/v1/Chassis"
+	managersBasePath = "/redfish/v1/Managers"
+)
+
+// computerSystemLinks are the Links of a ComputerSystem.
+type computerSystemLinks struct {
+	Chassis   []generated.OdataV4IdRef `json:"Chassis"`
+	ManagedBy []generated.OdataV4IdRef `json:"ManagedBy"`
+}
+
+// computerSystemWithLinks adds its Links and Oem to a ComputerSystem, which the generated type does not carry.
+type computerSystemWithLinks struct {
+	*generated.ComputerSystemComputerSystem
+	Links computerSystemLinks `json:"Links"`
+	Oem   *amtOem             `json:"Oem,omitempty"`
+}
+
+// chassisLinks are the Links of a Chassis.
+type chassisLinks struct {
+	ComputerSystems []generated.OdataV4IdRef `json:"ComputerSystems"`
+	ManagedBy       []generated.OdataV4IdRef `json:"ManagedBy"`
+}
+
+// managerLinks are the Links of a Manager.
+type managerLinks struct {
+	ManagerForServers []generated.OdataV4IdRef `json:"ManagerForServers"`
+	ManagerForChassis []generated.OdataV4IdRef `json:"ManagerForChassis"`
+	ManagerInChassis  generated.OdataV4IdRef   `json:"ManagerInChassis"`
+}
+
+// idRef returns the reference to the resource id of a collection.
+func idRef(basePath, id string) generated.OdataV4IdRef {
+	return generated.OdataV4IdRef{OdataId: StringPtr(basePath + "/" + id)}
+}
+
+// idRefs returns the references to the resources of a collection, skipping the empty ids.
+func idRefs(basePath string, ids []string) []generated.OdataV4IdRef {
+	refs := make([]generated.OdataV4IdRef, 0, len(ids))
+
+	for _, id := range ids {
+		if id != "" {
+			refs = append(refs, idRef(basePath, id))
+		}
+	}
+
+	return refs
+}
+
+// withSystemLinks links a ComputerSystem to its Chassis and Manager.
+func withSystemLinks(system *generated.ComputerSystemComputerSystem) computerSystemWithLinks {
+	return computerSystemWithLinks{
+		ComputerSystemComputerSystem: system,
+		Links: computerSystemLinks{
+			Chassis:   []generated.OdataV4IdRef{idRef(chassisBasePath, system.Id)},
+			ManagedBy: []generated.OdataV4IdRef{idRef(managersBasePath, system.Id)},
+		},
+	}
+}
+
+// newChassisLinks links the Chassis of a system to the system and its Manager.
+func newChassisLinks(systemID string) chassisLinks {
+	return chassisLinks{
+		ComputerSystems: []generated.OdataV4IdRef{idRef(systemsOdataIDCollection, systemID)},
+		ManagedBy:       []generated.OdataV4IdRef{idRef(managersBasePath, systemID)},
+	}
+}
+
+// newManagerLinks links the Manager of a system to the system and the Chassis it manages and sits in.
+func newManagerLinks(systemID string) managerLinks {
+	return managerLinks{
+		ManagerForServers: []generated.OdataV4IdRef{idRef(systemsOdataIDCollection, systemID)},
+		ManagerForChassis: []generated.OdataV4IdRef{idRef(chassisBasePath, systemID)},
+		ManagerInChassis:  idRef(chassisBasePath, systemID),
+	}
+}
@@ -0,0 +1,97 @@
+// Package v1 provides HTTP handlers for the Redfish Managers endpoints.
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Manager OData metadata constants
+const (
+	odataContextManagerCollection = "/redfish/v1/$metadata#ManagerCollection.ManagerCollection"
+	odataTypeManagerCollection    = "#ManagerCollection.ManagerCollection"
+	odataContextManager           = "/redfish/v1/$metadata#Manager.Manager"
+	odataTypeManager              = "#Manager.v1_19_0.Manager"
+	managerCollectionName         = "Manager Collection"
+	managerCollectionDescription  = "Collection of Managers"
+	managerResourceName           = "Manager"
+	managerNameSuffix             = " Manager"
+	managerDescription            = "Intel Active Management Technology"
+
+	// managerTypeManagementController is the Redfish ManagerType of the AMT management engine.
+	managerTypeManagementController = "ManagementController"
+)
+
+// GetRedfishV1Managers returns the collection of managers, one per computer system.
+// Path: GET /redfish/v1/Managers
+// Spec: Redfish ManagerCollection
+func (s *RedfishServer) GetRedfishV1Managers(c *gin.Context) {
+	systemIDs, err := s.ComputerSystemUC.GetAll(c.Request.Context())
+	if err != nil {
+		if s.Logger != nil {
+			s.Logger.Error("Failed to retrieve managers collection", "error", err)
+		}
+
+		InternalServerError(c, err)
+
+		return
+	}
+
+	SetRedfishHeaders(c)
+
+	members := idRefs(managersBasePath, systemIDs)
+
+	c.JSON(http.StatusOK, map[string]interface{}{
+		"@odata.context":      odataContextManagerCollection,
+		"@odata.id":           managersBasePath,
+		"@odata.type":         odataTypeManagerCollection,
+		"Name":                managerCollectionName,
+		"Description":         managerCollectionDescription,
+		"Members":             members,
+		"Members@odata.count": len(members),
+	})
+}
+
+// GetRedfishV1ManagersManagerId returns the manager of a computer system, its AMT management engine.
+// Path: GET /redfish/v1/Managers/{ManagerId}
+// Spec: Redfish Manager.v1_19_0
+//
+//revive:disable-next-line var-naming. Codegen is using openapi spec for generation which required Id to be Redfish complaint.
+func (s *RedfishServer) GetRedfishV1ManagersManagerId(c *gin.Context, managerID string) {
+	if err := validateSystemID(managerID); err != nil {
+		BadRequestError(c, fmt.Sprintf("Invalid manager ID: %s", err.Error()))
+
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	system, err := s.ComputerSystemUC.GetSystem(ctx, managerID)
+	if err != nil {
+		s.handleSystemLookupError(c, err, managerResourceName, managerID)
+
+		return
+	}
+
+	SetRedfishHeaders(c)
+
+	manager := map[string]interface{}{
+		"@odata.context": odataContextManager,
+		"@odata.id":      managersBasePath + "/" + managerID,
+		"@odata.type":    odataTypeManager,
+		"Id":             managerID,
+		"Name":           system.Name + managerNameSuffix,
+		"Description":    managerDescription,
+		"ManagerType":    managerTypeManagementController,
+		"UUID":           managerID,
+		"Links":          newManagerLinks(managerID),
+	}
+
+	if oem := s.getAMTOem(ctx, managerID); oem != nil {
+		manager["Oem"] = oem
+	}
+
+	c.JSON(http.StatusOK, manager)
+}
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRedfishV1Managers(t *testing.T) {
+	t.Parallel()
+
+	router, _ := setupLinkedResourcesRouter()
+
+	code, body := getLinkedResource(t, router, "/redfish/v1/Managers")
+	require.Equal(t, http.StatusOK, code)
+
+	assert.Equal(t, "/redfish/v1/Managers", body["@odata.id"])
+	assert.Equal(t, "#ManagerCollection.ManagerCollection", body["@odata.type"])
+	assert.InDelta(t, 2, body["Members@odata.count"], 0)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"@odata.id": "/redfish/v1/Managers/" + testUUID1},
+		map[string]interface{}{"@odata.id": "/redfish/v1/Managers/" + testUUID2},
+	}, body["Members"])
+}
+
+func TestGetRedfishV1ManagersManagerId(t *testing.T) {
+	t.Parallel()
+
+	router, _ := setupLinkedResourcesRouter()
+
+	t.Run("manager of a system", func(t *testing.T) {
+		t.Parallel()
+
+		code, body := getLinkedResource(t, router, "/redfish/v1/Managers/"+testUUID1)
+		require.Equal(t, http.StatusOK, code)
+
+		assert.Equal(t, "/redfish/v1/Managers/"+testUUID1, body["@odata.id"])
+		assert.Equal(t, "#Manager.v1_19_0.Manager", body["@odata.type"])
+		assert.Equal(t, testUUID1, body["Id"])
+		assert.Equal(t, "ManagementController", body["ManagerType"])
+
+		links, ok := body["Links"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, []string{"/redfish/v1/Systems/" + testUUID1}, linkedIDs(t, links, "ManagerForServers"))
+		assert.Equal(t, []string{"/redfish/v1/Chassis/" + testUUID1}, linkedIDs(t, links, "ManagerForChassis"))
+		assert.Equal(t, map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/" + testUUID1}, links["ManagerInChassis"])
+
+		assert.Equal(t, map[string]interface{}{"Intel_AMT": map[string]interface{}{"ControlMode": "CCM"}}, body["Oem"])
+	})
+
+	t.Run("unknown system", func(t *testing.T) {
+		t.Parallel()
+
+		code, _ := getLinkedResource(t, router, "/redfish/v1/Managers/"+testUUIDNotFound)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		code, _ := getLinkedResource(t, router, "/redfish/v1/Managers/not-a-guid")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
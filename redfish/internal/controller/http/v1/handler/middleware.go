@@ -4,9 +4,13 @@ package v1
 import (
 	"crypto/subtle"
 	"encoding/base64"
+	"errors"
+	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/pkg/openapivalidate"
 )
 
 const expectedCredentialParts = 2
@@ -57,3 +61,49 @@ func BasicAuthValidator(expectedUsername, expectedPassword string) gin.HandlerFu
 		}
 	}
 }
+
+// RequestValidationMiddleware refuses the requests whose JSON body does not match the schema of their operation
+// in the Redfish OpenAPI document: malformed JSON with MalformedJSON, other mismatches with an error per property
+func RequestValidationMiddleware(validator *openapivalidate.Validator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		err := validator.Validate(c.Request.Context(), c.Request, c.FullPath())
+		if err == nil {
+			return
+		}
+
+		var invalid openapivalidate.Error
+
+		switch {
+		case !errors.As(err, &invalid):
+			BadRequestError(c, err.Error())
+		case invalid.Malformed:
+			MalformedJSONError(c)
+		default:
+			InvalidPropertiesError(c, invalid.Fields)
+		}
+
+		c.Abort()
+	}
+}
+
+// ReadOnlyMiddleware refuses the requests changing the systems, such as PATCH and the ComputerSystem.Reset
+// action, with 503 ServiceUnavailable and Retry-After while readOnly reports the console in read-only mode.
+// The reads and the sessions are still served.
+func ReadOnlyMiddleware(readOnly func() (enabled bool, retryAfter int)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return
+		}
+
+		// the sessions are still created and deleted
+		if strings.HasPrefix(c.Request.URL.Path, sessionServicePath+"/") {
+			return
+		}
+
+		if enabled, retryAfter := readOnly(); enabled {
+			ServiceUnavailableError(c, retryAfter)
+			c.Abort()
+		}
+	}
+}
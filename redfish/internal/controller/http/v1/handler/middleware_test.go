@@ -78,3 +78,45 @@ func TestBasicAuthValidator(t *testing.T) {
 		})
 	}
 }
+
+// TestReadOnlyMiddleware tests that the systems are not changed in read-only mode.
+func TestReadOnlyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		enabled        bool
+		expectedStatus int
+	}{
+		{name: "PATCH system", method: http.MethodPatch, path: "/redfish/v1/Systems/" + testUUID1, enabled: true, expectedStatus: http.StatusServiceUnavailable},
+		{name: "Reset action", method: http.MethodPost, path: "/redfish/v1/Systems/" + testUUID1 + "/Actions/ComputerSystem.Reset", enabled: true, expectedStatus: http.StatusServiceUnavailable},
+		{name: "GET system", method: http.MethodGet, path: "/redfish/v1/Systems/" + testUUID1, enabled: true, expectedStatus: http.StatusOK},
+		{name: "Create session", method: http.MethodPost, path: "/redfish/v1/SessionService/Sessions", enabled: true, expectedStatus: http.StatusOK},
+		{name: "Delete session", method: http.MethodDelete, path: "/redfish/v1/SessionService/Sessions/1", enabled: true, expectedStatus: http.StatusOK},
+		{name: "Reset action out of read-only mode", method: http.MethodPost, path: "/redfish/v1/Systems/" + testUUID1 + "/Actions/ComputerSystem.Reset", expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := gin.New()
+			router.Use(ReadOnlyMiddleware(func() (bool, int) { return tt.enabled, 120 }))
+			router.Any("/redfish/v1/*path", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, http.NoBody))
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusServiceUnavailable {
+				assert.Equal(t, "120", w.Header().Get("Retry-After"))
+				assert.Contains(t, w.Body.String(), "ServiceTemporarilyUnavailable")
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+// Package v1 provides the Intel AMT OEM extension of the Redfish resources.
+package v1
+
+import (
+	"context"
+
+	redfishv1 "github.com/device-management-toolkit/console/redfish/internal/entity/v1"
+)
+
+// amtOem is the Oem property of the ComputerSystem and Manager resources, holding the Intel AMT extension.
+type amtOem struct {
+	IntelAMT *redfishv1.AMTOem `json:"Intel_AMT"`
+}
+
+// getAMTOem returns the Oem property of the resources of a system, nil when the AMT state cannot be retrieved so
+// that the resources are served without it.
+func (s *RedfishServer) getAMTOem(ctx context.Context, systemID string) *amtOem {
+	state, err := s.ComputerSystemUC.GetAMTOem(ctx, systemID)
+	if err != nil {
+		if s.Logger != nil {
+			s.Logger.Warn("Failed to retrieve AMT state", "systemID", systemID, "error", err)
+		}
+
+		return nil
+	}
+
+	return &amtOem{IntelAMT: state}
+}
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/redfish/internal/usecase"
+)
+
+func TestGetAMTOem(t *testing.T) {
+	t.Parallel()
+
+	repo := NewTestSystemsComputerSystemRepository()
+	repo.AddSystem(testUUID1, createTestSystemEntityData(testUUID1, "System 1", "Intel", "NUC", "SN-1"))
+
+	testLogger := NewTestLogger()
+	server := &RedfishServer{ComputerSystemUC: &usecase.ComputerSystemUseCase{Repo: repo}, Logger: testLogger}
+
+	oem := server.getAMTOem(context.Background(), testUUID1)
+	require.NotNil(t, oem)
+	assert.Equal(t, "CCM", oem.IntelAMT.ControlMode)
+
+	assert.Nil(t, server.getAMTOem(context.Background(), testUUIDNotFound), "the Oem property is omitted without the AMT state")
+	assert.Len(t, testLogger.WarnCalls, 1)
+}
@@ -0,0 +1,112 @@
+// Package v1 provides Redfish v1 API handlers for the message registry resources.
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MessageRegistryFile OData metadata constants
+const (
+	odataIDRegistries                 = "/redfish/v1/Registries"
+	odataContextRegistryCollection    = "/redfish/v1/$metadata#MessageRegistryFileCollection.MessageRegistryFileCollection"
+	odataTypeRegistryCollection       = "#MessageRegistryFileCollection.MessageRegistryFileCollection"
+	odataContextMessageRegistryFile   = "/redfish/v1/$metadata#MessageRegistryFile.MessageRegistryFile"
+	odataTypeMessageRegistryFile      = "#MessageRegistryFile.v1_1_3.MessageRegistryFile"
+	registryCollectionName            = "Registry File Collection"
+	registryFileResourceName          = "MessageRegistryFile"
+	registryDocumentPathSuffix        = "/Registry"
+	registryFileNameSuffix            = " File"
+	registryCollectionDescriptionText = "Message registries available on this service"
+)
+
+// GetRedfishV1Registries returns the collection of message registry files.
+// Path: GET /redfish/v1/Registries
+// Spec: Redfish MessageRegistryFileCollection
+func (s *RedfishServer) GetRedfishV1Registries(c *gin.Context) {
+	SetRedfishHeaders(c)
+
+	registries := registryMgr.ActiveRegistries()
+
+	members := make([]map[string]string, 0, len(registries))
+	for _, registry := range registries {
+		members = append(members, map[string]string{
+			"@odata.id": odataIDRegistries + "/" + registry.ID,
+		})
+	}
+
+	c.JSON(http.StatusOK, map[string]interface{}{
+		"@odata.context":      odataContextRegistryCollection,
+		"@odata.id":           odataIDRegistries,
+		"@odata.type":         odataTypeRegistryCollection,
+		"Name":                registryCollectionName,
+		"Description":         registryCollectionDescriptionText,
+		"Members":             members,
+		"Members@odata.count": len(members),
+	})
+}
+
+// GetRedfishV1RegistriesRegistryID returns the MessageRegistryFile resource for a registry.
+// Path: GET /redfish/v1/Registries/{RegistryId}
+// Spec: Redfish MessageRegistryFile.v1_1_3
+func (s *RedfishServer) GetRedfishV1RegistriesRegistryID(c *gin.Context) {
+	registryID := c.Param("RegistryId")
+
+	registry, err := registryMgr.GetRegistry(registryID)
+	if err != nil {
+		handleRegistryLookupError(c, err, registryID)
+
+		return
+	}
+
+	SetRedfishHeaders(c)
+
+	fileID := odataIDRegistries + "/" + registry.ID
+
+	c.JSON(http.StatusOK, map[string]interface{}{
+		"@odata.context": odataContextMessageRegistryFile,
+		"@odata.id":      fileID,
+		"@odata.type":    odataTypeMessageRegistryFile,
+		"Id":             registry.ID,
+		"Name":           registry.Name + registryFileNameSuffix,
+		"Description":    registry.Description,
+		"Languages":      []string{registry.Language},
+		"Registry":       registry.ID,
+		"Location": []map[string]string{
+			{
+				"Language": registry.Language,
+				"Uri":      fileID + registryDocumentPathSuffix,
+			},
+		},
+	})
+}
+
+// GetRedfishV1RegistriesRegistryIDRegistry returns the registry document itself.
+// Path: GET /redfish/v1/Registries/{RegistryId}/Registry
+// Spec: Redfish MessageRegistry.v1_6_2 (served as embedded)
+func (s *RedfishServer) GetRedfishV1RegistriesRegistryIDRegistry(c *gin.Context) {
+	registryID := c.Param("RegistryId")
+
+	registry, err := registryMgr.GetRegistry(registryID)
+	if err != nil {
+		handleRegistryLookupError(c, err, registryID)
+
+		return
+	}
+
+	SetRedfishHeaders(c)
+	c.Data(http.StatusOK, contentTypeJSON, registry.raw)
+}
+
+// handleRegistryLookupError maps registry lookup failures to Redfish error responses.
+func handleRegistryLookupError(c *gin.Context, err error, registryID string) {
+	if errors.Is(err, ErrRegistryNotFound) {
+		NotFoundError(c, registryFileResourceName, registryID)
+
+		return
+	}
+
+	InternalServerError(c, err)
+}
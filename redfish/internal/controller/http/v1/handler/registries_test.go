@@ -0,0 +1,141 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRegistriesRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	server := &RedfishServer{}
+	router := gin.New()
+	router.GET("/redfish/v1/Registries", server.GetRedfishV1Registries)
+	router.GET("/redfish/v1/Registries/:RegistryId", server.GetRedfishV1RegistriesRegistryID)
+	router.GET("/redfish/v1/Registries/:RegistryId/Registry", server.GetRedfishV1RegistriesRegistryIDRegistry)
+
+	return router
+}
+
+func TestGetRedfishV1Registries(t *testing.T) {
+	t.Parallel()
+
+	router := setupRegistriesRouter()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/redfish/v1/Registries", http.NoBody))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "4.0", w.Header().Get(headerODataVersion))
+
+	var body struct {
+		ID      string `json:"@odata.id"`
+		Count   int    `json:"Members@odata.count"`
+		Members []struct {
+			ID string `json:"@odata.id"`
+		} `json:"Members"`
+	}
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "/redfish/v1/Registries", body.ID)
+	assert.Equal(t, len(body.Members), body.Count)
+
+	ids := make([]string, 0, len(body.Members))
+	for _, m := range body.Members {
+		ids = append(ids, m.ID)
+	}
+
+	assert.Contains(t, ids, "/redfish/v1/Registries/Base.1.22.0")
+	assert.Contains(t, ids, "/redfish/v1/Registries/ResourceEvent.1.3.0")
+	assert.Contains(t, ids, "/redfish/v1/Registries/TaskEvent.1.0.3")
+}
+
+func TestGetRedfishV1RegistriesRegistryID(t *testing.T) {
+	t.Parallel()
+
+	router := setupRegistriesRouter()
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		expectedField  string
+		expectedValue  string
+	}{
+		{
+			name:           "registry file",
+			path:           "/redfish/v1/Registries/TaskEvent.1.0.3",
+			expectedStatus: http.StatusOK,
+			expectedField:  "Registry",
+			expectedValue:  "TaskEvent.1.0.3",
+		},
+		{
+			name:           "registry document",
+			path:           "/redfish/v1/Registries/Base.1.22.0/Registry",
+			expectedStatus: http.StatusOK,
+			expectedField:  "RegistryPrefix",
+			expectedValue:  "Base",
+		},
+		{
+			name:           "unknown registry",
+			path:           "/redfish/v1/Registries/Unknown.1.0.0",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.path, http.NoBody))
+
+			require.Equal(t, tc.expectedStatus, w.Code)
+
+			if tc.expectedField == "" {
+				return
+			}
+
+			var body map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tc.expectedValue, body[tc.expectedField])
+		})
+	}
+}
+
+func TestRegistryManagerVersions(t *testing.T) {
+	t.Parallel()
+
+	rm := &RegistryManager{
+		registries: make(map[string]map[string]*MessageRegistry),
+		active:     make(map[string]string),
+	}
+
+	require.NoError(t, rm.AddRegistry([]byte(`{"Id":"Test.1.10.0","RegistryPrefix":"Test","RegistryVersion":"1.10.0","Messages":{"Hello":{"Message":"new"}}}`)))
+	require.NoError(t, rm.AddRegistry([]byte(`{"Id":"Test.1.9.0","RegistryPrefix":"Test","RegistryVersion":"1.9.0","Messages":{"Hello":{"Message":"old"}}}`)))
+
+	// Highest version wins by default
+	assert.Equal(t, "1.10.0", rm.ActiveVersion("Test"))
+
+	msg, err := rm.LookupMessage("Test", "Hello")
+	require.NoError(t, err)
+	assert.Equal(t, "Test.1.10.0.Hello", msg.MessageID)
+
+	require.NoError(t, rm.SetActiveVersion("Test", "1.9.0"))
+
+	msg, err = rm.LookupMessage("Test", "Hello")
+	require.NoError(t, err)
+	assert.Equal(t, "old", msg.Message)
+	assert.Equal(t, "Test.1.9.0.Hello", rm.MessageID("Test", "Hello"))
+
+	require.ErrorIs(t, rm.SetActiveVersion("Test", "2.0.0"), ErrRegistryVersionNotFound)
+	require.ErrorIs(t, rm.SetActiveVersion("Missing", "1.0.0"), ErrRegistryNotFound)
+	assert.Equal(t, DefaultBaseRegistryVersion, rm.ActiveVersion("Missing"))
+}
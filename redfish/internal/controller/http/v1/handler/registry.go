@@ -2,10 +2,13 @@
 package v1
 
 import (
-	_ "embed"
+	"embed"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 )
@@ -15,10 +18,26 @@ var (
 	ErrRegistryNotFound = errors.New("registry not found")
 	// ErrMessageNotFound is returned when a message is not found in a registry
 	ErrMessageNotFound = errors.New("message not found in registry")
+	// ErrRegistryVersionNotFound is returned when a requested registry version is not embedded
+	ErrRegistryVersionNotFound = errors.New("registry version not found")
 )
 
-//go:embed registries/Base.1.22.0.json
-var baseRegistryJSON []byte
+const (
+	// Registry prefixes shipped with the service
+	registryPrefixBase          = "Base"
+	registryPrefixResourceEvent = "ResourceEvent"
+	registryPrefixTaskEvent     = "TaskEvent"
+
+	// DefaultBaseRegistryVersion is the Base registry version used when none is configured
+	DefaultBaseRegistryVersion = "1.22.0"
+
+	registriesDir = "registries"
+)
+
+// Embedded message registries (Base, ResourceEvent, TaskEvent)
+//
+//go:embed registries/*.json
+var registriesFS embed.FS
 
 // MessageRegistry represents a Redfish message registry
 type MessageRegistry struct {
@@ -30,6 +49,9 @@ type MessageRegistry struct {
 	RegistryVersion string                    `json:"RegistryVersion"`
 	OwningEntity    string                    `json:"OwningEntity"`
 	Messages        map[string]MessageDetails `json:"Messages"`
+
+	// raw holds the registry document as embedded, served verbatim from /redfish/v1/Registries
+	raw []byte
 }
 
 // MessageDetails contains the details of a specific message in the registry
@@ -48,9 +70,11 @@ type MessageDetails struct {
 	Deprecated string `json:"Deprecated,omitempty"`
 }
 
-// RegistryManager manages message registries
+// RegistryManager manages message registries.
+// Several versions of the same registry may be loaded; lookups resolve against the active version of each prefix.
 type RegistryManager struct {
-	registries map[string]*MessageRegistry
+	registries map[string]map[string]*MessageRegistry // prefix -> version -> registry
+	active     map[string]string                      // prefix -> active version
 	mu         sync.RWMutex
 }
 
@@ -63,40 +87,139 @@ var (
 func GetRegistryManager() *RegistryManager {
 	once.Do(func() {
 		registryManager = &RegistryManager{
-			registries: make(map[string]*MessageRegistry),
+			registries: make(map[string]map[string]*MessageRegistry),
+			active:     make(map[string]string),
 		}
-		// Load the Base registry
-		if err := registryManager.loadBaseRegistry(); err != nil {
-			// Log error but don't fail - we can still use hardcoded fallbacks
-			// Note: In production, use proper logging instead of fmt.Printf
-			_ = err // Registry loading errors are handled by fallback mechanisms
+		// Load the embedded registries
+		if err := registryManager.loadEmbeddedRegistries(); err != nil {
+			// Registry loading errors are handled by fallback mechanisms
+			_ = err
 		}
 	})
 
 	return registryManager
 }
 
-// loadBaseRegistry loads the Base.1.22.0 registry
-func (rm *RegistryManager) loadBaseRegistry() error {
+// loadEmbeddedRegistries loads every registry file embedded under registries/.
+// The highest version of each prefix becomes active until SetActiveVersion is called.
+func (rm *RegistryManager) loadEmbeddedRegistries() error {
+	entries, err := registriesFS.ReadDir(registriesDir)
+	if err != nil {
+		return fmt.Errorf("failed to read embedded registries: %w", err)
+	}
+
+	for _, entry := range entries {
+		data, err := registriesFS.ReadFile(path.Join(registriesDir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read registry %s: %w", entry.Name(), err)
+		}
+
+		if err := rm.AddRegistry(data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRegistry parses a registry document and adds it to the manager.
+func (rm *RegistryManager) AddRegistry(data []byte) error {
 	var registry MessageRegistry
-	if err := json.Unmarshal(baseRegistryJSON, &registry); err != nil {
-		return fmt.Errorf("failed to unmarshal Base registry: %w", err)
+	if err := json.Unmarshal(data, &registry); err != nil {
+		return fmt.Errorf("failed to unmarshal registry: %w", err)
+	}
+
+	registry.raw = data
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	versions, exists := rm.registries[registry.RegistryPrefix]
+	if !exists {
+		versions = make(map[string]*MessageRegistry)
+		rm.registries[registry.RegistryPrefix] = versions
 	}
 
+	versions[registry.RegistryVersion] = &registry
+
+	if current, ok := rm.active[registry.RegistryPrefix]; !ok || compareRegistryVersions(registry.RegistryVersion, current) > 0 {
+		rm.active[registry.RegistryPrefix] = registry.RegistryVersion
+	}
+
+	return nil
+}
+
+// SetActiveVersion selects which loaded version of a registry is used for lookups.
+func (rm *RegistryManager) SetActiveVersion(prefix, version string) error {
 	rm.mu.Lock()
 	defer rm.mu.Unlock()
 
-	rm.registries["Base"] = &registry
+	versions, exists := rm.registries[prefix]
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrRegistryNotFound, prefix)
+	}
+
+	if _, exists := versions[version]; !exists {
+		return fmt.Errorf("%w: %s.%s", ErrRegistryVersionNotFound, prefix, version)
+	}
+
+	rm.active[prefix] = version
 
 	return nil
 }
 
-// LookupMessage looks up a message from the registry
+// ActiveVersion returns the active version of a registry, or the default Base version when the
+// prefix is unknown so that fallback message IDs remain well formed.
+func (rm *RegistryManager) ActiveVersion(prefix string) string {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	if version, ok := rm.active[prefix]; ok {
+		return version
+	}
+
+	return DefaultBaseRegistryVersion
+}
+
+// MessageID builds the fully qualified message ID (Prefix.Major.Minor.Errata.Key) for the active registry version.
+func (rm *RegistryManager) MessageID(prefix, messageKey string) string {
+	return fmt.Sprintf("%s.%s.%s", prefix, rm.ActiveVersion(prefix), messageKey)
+}
+
+// ActiveRegistries returns the active version of every loaded registry, ordered by prefix.
+func (rm *RegistryManager) ActiveRegistries() []*MessageRegistry {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	registries := make([]*MessageRegistry, 0, len(rm.active))
+	for prefix, version := range rm.active {
+		registries = append(registries, rm.registries[prefix][version])
+	}
+
+	sort.Slice(registries, func(i, j int) bool {
+		return registries[i].RegistryPrefix < registries[j].RegistryPrefix
+	})
+
+	return registries
+}
+
+// GetRegistry returns the active registry whose Id (Prefix.Major.Minor.Errata) matches registryID.
+func (rm *RegistryManager) GetRegistry(registryID string) (*MessageRegistry, error) {
+	for _, registry := range rm.ActiveRegistries() {
+		if registry.ID == registryID {
+			return registry, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrRegistryNotFound, registryID)
+}
+
+// LookupMessage looks up a message from the active version of a registry
 func (rm *RegistryManager) LookupMessage(registryName, messageKey string) (*RegistryMessage, error) {
 	rm.mu.RLock()
 	defer rm.mu.RUnlock()
 
-	registry, exists := rm.registries[registryName]
+	registry, exists := rm.registries[registryName][rm.active[registryName]]
 	if !exists {
 		return nil, fmt.Errorf("%w: %s", ErrRegistryNotFound, registryName)
 	}
@@ -118,6 +241,31 @@ func (rm *RegistryManager) LookupMessage(registryName, messageKey string) (*Regi
 	}, nil
 }
 
+// compareRegistryVersions compares two dotted registry versions numerically.
+// It returns a positive number if a > b, a negative number if a < b and zero if they are equal.
+func compareRegistryVersions(a, b string) int {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		var numA, numB int
+
+		if i < len(partsA) {
+			numA, _ = strconv.Atoi(partsA[i])
+		}
+
+		if i < len(partsB) {
+			numB, _ = strconv.Atoi(partsB[i])
+		}
+
+		if numA != numB {
+			return numA - numB
+		}
+	}
+
+	return 0
+}
+
 // RegistryMessage contains the formatted message details from registry
 type RegistryMessage struct {
 	MessageID       string
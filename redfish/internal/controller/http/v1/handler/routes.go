@@ -13,9 +13,6 @@ const (
 	// Task state constants from Redfish Task.v1_8_0 specification
 	taskStateCompleted = "Completed"
 
-	// Registry message IDs
-	msgIDBaseSuccess = "Base.1.22.0.Success"
-
 	// OData metadata constants - Task
 	odataContextTask = "/redfish/v1/$metadata#Task.Task"
 	odataTypeTask    = "#Task.v1_6_0.Task"
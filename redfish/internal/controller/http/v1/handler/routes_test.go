@@ -92,6 +92,14 @@ func (r *TestComputerSystemRepository) UpdateBootSettings(_ context.Context, sys
 	return usecase.ErrSystemNotFound
 }
 
+func (r *TestComputerSystemRepository) GetAMTOem(_ context.Context, systemID string) (*redfishv1.AMTOem, error) {
+	if _, exists := r.systems[systemID]; exists {
+		return &redfishv1.AMTOem{ControlMode: "CCM"}, nil
+	}
+
+	return nil, usecase.ErrSystemNotFound
+}
+
 // createTestSystemData creates a test system for the repository
 func createTestSystemData(systemID, name, manufacturer, model, serialNumber string) *redfishv1.ComputerSystem {
 	return &redfishv1.ComputerSystem{
@@ -285,6 +285,9 @@ func (s *RedfishServer) GetRedfishV1(c *gin.Context) {
 	type ServiceRootWithSessionService struct {
 		generated.ServiceRootServiceRoot
 		SessionService *generated.OdataV4IdRef `json:"SessionService,omitempty"`
+		Registries     *generated.OdataV4IdRef `json:"Registries,omitempty"`
+		Chassis        *generated.OdataV4IdRef `json:"Chassis,omitempty"`
+		Managers       *generated.OdataV4IdRef `json:"Managers,omitempty"`
 	}
 
 	// Create Links with Sessions for redfishtool compatibility
@@ -313,6 +316,15 @@ func (s *RedfishServer) GetRedfishV1(c *gin.Context) {
 		SessionService: &generated.OdataV4IdRef{
 			OdataId: StringPtr("/redfish/v1/SessionService"),
 		},
+		Registries: &generated.OdataV4IdRef{
+			OdataId: StringPtr(odataIDRegistries),
+		},
+		Chassis: &generated.OdataV4IdRef{
+			OdataId: StringPtr(chassisBasePath),
+		},
+		Managers: &generated.OdataV4IdRef{
+			OdataId: StringPtr(managersBasePath),
+		},
 	}
 
 	c.JSON(http.StatusOK, serviceRoot)
@@ -1248,6 +1248,10 @@ func TestGetRedfishV1ServiceRootAllFields(t *testing.T) {
 	systems, ok := response["Systems"].(map[string]interface{})
 	assert.True(t, ok, "Systems should be an object")
 	assert.Equal(t, "/redfish/v1/Systems", systems["@odata.id"])
+
+	// Verify Chassis and Managers references
+	assert.Equal(t, map[string]interface{}{"@odata.id": "/redfish/v1/Chassis"}, response["Chassis"])
+	assert.Equal(t, map[string]interface{}{"@odata.id": "/redfish/v1/Managers"}, response["Managers"])
 }
 
 // TestGenerateServiceUUIDFallback tests UUID generation fallback behavior
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -57,6 +58,15 @@ const (
 	contextKeySession  = "session"
 	contextKeyUsername = "username"
 
+	// OEM session metadata
+	oemVendorKey          = "DMT"
+	oemSessionOdataType   = "#DMTSession.v1_0_0.Session"
+	oemPropCreatedAt      = "CreatedAt"
+	oemPropLastUsedAt     = "LastUsedAt"
+	oemPropUserAgent      = "UserAgent"
+	oemPropOdataType      = "@odata.type"
+	oemPropTimeoutSeconds = "TimeoutSeconds"
+
 	// Status values
 	statusEnabled = "Enabled"
 	statusOK      = "OK"
@@ -70,6 +80,8 @@ const (
 	errMsgFailedDeleteSession      = "failed to delete session"
 	errMsgFailedListSessions       = "failed to list sessions"
 	errMsgFailedBuildServiceResp   = "failed to build session service response"
+	errMsgFailedUpdateService      = "failed to update session service"
+	errMsgSessionTimeoutRange      = "SessionTimeout must be between %d and %d seconds"
 	errMsgFailedCreateDescription  = "failed to create description"
 	errMsgFailedCreateStatusState  = "failed to create status state"
 	errMsgFailedCreateStatusHealth = "failed to create status health"
@@ -117,8 +129,16 @@ func SessionAuthMiddleware(sessionUseCase *sessions.UseCase) gin.HandlerFunc {
 // RedfishServer session endpoint implementations
 // These methods are part of RedfishServer to satisfy the generated.ServerInterface
 
-// sessionToRedfishResponse converts entity.Session to generated.SessionSession format.
-func sessionToRedfishResponse(s *entity.Session) (*generated.SessionSession, error) {
+// sessionResponse extends the generated Session with the OEM metadata recorded by the service.
+type sessionResponse struct {
+	generated.SessionSession
+	Oem *generated.ResourceOem `json:"Oem,omitempty"`
+}
+
+// sessionToRedfishResponse converts entity.Session to the Redfish Session format.
+// Client IP is reported in ClientOriginIPAddress; the user agent, creation and last-use
+// timestamps are exposed as DMT OEM properties.
+func sessionToRedfishResponse(s *entity.Session) (*sessionResponse, error) {
 	context := sessionOdataContext
 	odataID := sessionBasePath + s.ID
 	odataType := sessionOdataType
@@ -137,7 +157,17 @@ func sessionToRedfishResponse(s *entity.Session) (*generated.SessionSession, err
 		return nil, fmt.Errorf("failed to create session type: %w", err)
 	}
 
-	return &generated.SessionSession{
+	oem := generated.ResourceOem{
+		oemVendorKey: map[string]interface{}{
+			oemPropOdataType:      oemSessionOdataType,
+			oemPropCreatedAt:      s.CreatedTime.UTC().Format(time.RFC3339),
+			oemPropLastUsedAt:     s.LastAccessTime.UTC().Format(time.RFC3339),
+			oemPropUserAgent:      s.UserAgent,
+			oemPropTimeoutSeconds: s.TimeoutSeconds,
+		},
+	}
+
+	return &sessionResponse{SessionSession: generated.SessionSession{
 		OdataContext:          &context,
 		OdataId:               &odataID,
 		OdataType:             &odataType,
@@ -150,7 +180,7 @@ func sessionToRedfishResponse(s *entity.Session) (*generated.SessionSession, err
 		ClientOriginIPAddress: &s.ClientIP,
 		Password:              nil, // Always null in responses per Redfish spec
 		Token:                 nil, // Always null in responses per Redfish spec
-	}, nil
+	}, Oem: &oem}, nil
 }
 
 // buildSessionServiceResponse builds the SessionService response object using generated types
@@ -160,6 +190,11 @@ func (r *RedfishServer) buildSessionServiceResponse() (*generated.SessionService
 	odataID := sessionServiceOdataID
 	odataType := sessionServiceOdataType
 	sessionTimeout := int64(sessions.DefaultSessionTimeout)
+
+	if r.SessionUC != nil {
+		sessionTimeout = int64(r.SessionUC.SessionTimeout())
+	}
+
 	serviceEnabled := true
 
 	// Create description
@@ -275,7 +310,7 @@ func (r *RedfishServer) PostRedfishV1SessionServiceSessions(c *gin.Context) {
 		userAgent,
 	)
 	if err != nil {
-		if errors.Is(err, sessions.ErrSessionAlreadyExists) {
+		if errors.Is(err, sessions.ErrSessionLimitReached) {
 			SessionConflictError(c)
 
 			return
@@ -366,19 +401,49 @@ func (r *RedfishServer) DeleteRedfishV1SessionServiceSessionsSessionId(c *gin.Co
 	c.Status(http.StatusNoContent)
 }
 
-// PatchRedfishV1SessionService handles PATCH /redfish/v1/SessionService
-func (r *RedfishServer) PatchRedfishV1SessionService(c *gin.Context) {
-	SetRedfishHeaders(c)
+// sessionServiceUpdate holds the writable SessionService properties.
+type sessionServiceUpdate struct {
+	SessionTimeout *int64 `json:"SessionTimeout"`
+}
 
-	var req map[string]interface{}
+// applySessionServiceUpdate binds the request body and applies writable properties.
+// It writes the error response and returns false when the update cannot be applied.
+func (r *RedfishServer) applySessionServiceUpdate(c *gin.Context) bool {
+	var req sessionServiceUpdate
 	if err := c.BindJSON(&req); err != nil {
 		BadRequestError(c, fmt.Sprintf("%s: %v", errMsgInvalidRequestBody, err))
 
+		return false
+	}
+
+	if req.SessionTimeout == nil {
+		return true
+	}
+
+	if err := r.SessionUC.SetSessionTimeout(int(*req.SessionTimeout)); err != nil {
+		if errors.Is(err, sessions.ErrInvalidSessionTimeout) {
+			BadRequestError(c, fmt.Sprintf(errMsgSessionTimeoutRange, sessions.MinSessionTimeout, sessions.MaxSessionTimeout))
+
+			return false
+		}
+
+		InternalServerError(c, fmt.Errorf("%s: %w", errMsgFailedUpdateService, err))
+
+		return false
+	}
+
+	return true
+}
+
+// PatchRedfishV1SessionService handles PATCH /redfish/v1/SessionService
+// SessionTimeout is writable; the new value is persisted and applied to existing sessions.
+func (r *RedfishServer) PatchRedfishV1SessionService(c *gin.Context) {
+	SetRedfishHeaders(c)
+
+	if !r.applySessionServiceUpdate(c) {
 		return
 	}
 
-	// For now, PATCH returns the current state without modifications.
-	// Future: Implement PATCH logic to update configurable properties.
 	response, err := r.buildSessionServiceResponse()
 	if err != nil {
 		InternalServerError(c, fmt.Errorf("%s: %w", errMsgFailedBuildServiceResp, err))
@@ -393,15 +458,10 @@ func (r *RedfishServer) PatchRedfishV1SessionService(c *gin.Context) {
 func (r *RedfishServer) PutRedfishV1SessionService(c *gin.Context) {
 	SetRedfishHeaders(c)
 
-	var req map[string]interface{}
-	if err := c.BindJSON(&req); err != nil {
-		BadRequestError(c, fmt.Sprintf("%s: %v", errMsgInvalidRequestBody, err))
-
+	if !r.applySessionServiceUpdate(c) {
 		return
 	}
 
-	// For now, PUT returns the current state without modifications.
-	// Future: Implement PUT logic to replace the resource.
 	response, err := r.buildSessionServiceResponse()
 	if err != nil {
 		InternalServerError(c, fmt.Errorf("%s: %w", errMsgFailedBuildServiceResp, err))
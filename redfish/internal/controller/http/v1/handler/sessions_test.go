@@ -239,6 +239,116 @@ func TestSessionServiceEndpoint(t *testing.T) {
 	assert.True(t, serviceEnabled)
 }
 
+// TestPatchSessionServiceTimeout tests that PATCHing SessionTimeout updates the service and existing sessions.
+func TestPatchSessionServiceTimeout(t *testing.T) {
+	t.Parallel()
+
+	router, server := setupTestEnvironment()
+	router.PATCH("/redfish/v1/SessionService", server.PatchRedfishV1SessionService)
+
+	session, _, err := server.SessionUC.CreateSession("admin", "password", "127.0.0.1", "test-agent")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.DefaultSessionTimeout, session.TimeoutSeconds)
+
+	tests := []struct {
+		name            string
+		body            string
+		expectedStatus  int
+		expectedTimeout float64
+	}{
+		{
+			name:            "valid timeout",
+			body:            `{"SessionTimeout": 600}`,
+			expectedStatus:  http.StatusOK,
+			expectedTimeout: 600,
+		},
+		{
+			name:           "timeout below minimum",
+			body:           `{"SessionTimeout": 5}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "timeout above maximum",
+			body:           `{"SessionTimeout": 100000}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:            "no writable properties",
+			body:            `{}`,
+			expectedStatus:  http.StatusOK,
+			expectedTimeout: 600,
+		},
+	}
+
+	for _, tc := range tests {
+		req := httptest.NewRequest(http.MethodPatch, "/redfish/v1/SessionService", bytes.NewBufferString(tc.body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, tc.expectedStatus, w.Code, tc.name)
+
+		if tc.expectedStatus != http.StatusOK {
+			continue
+		}
+
+		var resp map[string]interface{}
+
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp), tc.name)
+		assert.InDelta(t, tc.expectedTimeout, resp["SessionTimeout"], 0, tc.name)
+	}
+
+	// Existing sessions pick up the new timeout
+	updated, err := server.SessionUC.GetSession(session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 600, updated.TimeoutSeconds)
+	assert.Equal(t, 600, server.SessionUC.SessionTimeout())
+}
+
+// TestSessionLimitAndMetadata tests the per-user session cap and the OEM session metadata.
+func TestSessionLimitAndMetadata(t *testing.T) {
+	t.Parallel()
+
+	router, server := setupTestEnvironment()
+	server.Config.Redfish.MaxSessionsPerUser = 2
+	server.SessionUC = sessions.NewUseCase(sessioninfra.NewInMemoryRepository(1*time.Minute), server.Config)
+
+	router.POST("/redfish/v1/SessionService/Sessions", server.PostRedfishV1SessionServiceSessions)
+
+	createSession := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/redfish/v1/SessionService/Sessions",
+			bytes.NewBufferString(`{"UserName":"admin","Password":"password"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "redfishtool/1.0")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		return w
+	}
+
+	w := createSession()
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp map[string]interface{}
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	oem, ok := resp["Oem"].(map[string]interface{})
+	require.True(t, ok, "Oem should be present")
+
+	dmt, ok := oem["DMT"].(map[string]interface{})
+	require.True(t, ok, "Oem.DMT should be present")
+	assert.Equal(t, "redfishtool/1.0", dmt["UserAgent"])
+	assert.NotEmpty(t, dmt["CreatedAt"])
+	assert.NotEmpty(t, dmt["LastUsedAt"])
+	assert.NotNil(t, resp["ClientOriginIPAddress"])
+
+	assert.Equal(t, http.StatusCreated, createSession().Code, "second session is within the limit")
+	assert.Equal(t, http.StatusConflict, createSession().Code, "third session exceeds the limit")
+}
+
 // TestListSessions tests listing all active sessions.
 func TestListSessions(t *testing.T) {
 	t.Parallel()
@@ -11,6 +11,7 @@ import (
 
 	"github.com/device-management-toolkit/console/pkg/logger"
 	"github.com/device-management-toolkit/console/redfish/internal/controller/http/v1/generated"
+	redfishv1 "github.com/device-management-toolkit/console/redfish/internal/entity/v1"
 	"github.com/device-management-toolkit/console/redfish/internal/usecase"
 )
 
@@ -93,12 +94,21 @@ func (s *RedfishServer) buildSystemsCollectionResponse(members []generated.Odata
 
 // handleGetSystemError handles errors from GetComputerSystem operations.
 func (s *RedfishServer) handleGetSystemError(c *gin.Context, err error, systemID string) {
+	s.handleSystemLookupError(c, err, "System", systemID)
+}
+
+// handleSystemLookupError handles the errors retrieving the system a resource is served from, reporting a missing
+// system as the resource missing.
+func (s *RedfishServer) handleSystemLookupError(c *gin.Context, err error, resource, systemID string) {
 	switch {
 	case errors.Is(err, usecase.ErrSystemNotFound):
-		NotFoundError(c, "System", systemID)
+		NotFoundError(c, resource, systemID)
+	case errors.Is(err, usecase.ErrSystemUnreachable):
+		ServiceUnavailableError(c, redfishv1.ServiceUnavailableRetryAfterSeconds)
 	default:
 		if s.Logger != nil {
 			s.Logger.Error("Failed to retrieve computer system",
+				"resource", resource,
 				"systemID", systemID,
 				"error", err)
 		}
@@ -149,5 +159,8 @@ func (s *RedfishServer) GetRedfishV1SystemsComputerSystemId(c *gin.Context, comp
 		return
 	}
 
-	c.JSON(http.StatusOK, system)
+	resource := withSystemLinks(system)
+	resource.Oem = s.getAMTOem(ctx, computerSystemID)
+
+	c.JSON(http.StatusOK, resource)
 }
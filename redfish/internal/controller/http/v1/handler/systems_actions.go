@@ -11,6 +11,7 @@ import (
 	"github.com/labstack/gommon/log"
 
 	"github.com/device-management-toolkit/console/redfish/internal/controller/http/v1/generated"
+	redfishv1 "github.com/device-management-toolkit/console/redfish/internal/entity/v1"
 	"github.com/device-management-toolkit/console/redfish/internal/usecase"
 )
 
@@ -46,6 +47,8 @@ func (s *RedfishServer) PostRedfishV1SystemsComputerSystemIdActionsComputerSyste
 		switch {
 		case errors.Is(err, usecase.ErrSystemNotFound):
 			NotFoundError(c, "System", computerSystemID)
+		case errors.Is(err, usecase.ErrSystemUnreachable):
+			ServiceUnavailableError(c, redfishv1.ServiceUnavailableRetryAfterSeconds)
 		case errors.Is(err, usecase.ErrInvalidResetType):
 			BadRequestError(c, fmt.Sprintf("Invalid reset type: %s", string(*req.ResetType)))
 		case errors.Is(err, usecase.ErrPowerStateConflict):
@@ -64,7 +67,7 @@ func (s *RedfishServer) PostRedfishV1SystemsComputerSystemIdActionsComputerSyste
 	now := time.Now().UTC().Format(time.RFC3339)
 
 	// Get success message from registry
-	successMsg, err := registryMgr.LookupMessage("Base", "Success")
+	successMsg, err := registryMgr.LookupMessage(registryPrefixBase, "Success")
 	if err != nil {
 		// Fallback if registry lookup fails
 		InternalServerError(c, err)
@@ -81,7 +84,7 @@ func (s *RedfishServer) PostRedfishV1SystemsComputerSystemIdActionsComputerSyste
 		"Messages": []map[string]interface{}{
 			{
 				"Message":   successMsg.Message,
-				"MessageId": msgIDBaseSuccess,
+				"MessageId": successMsg.MessageID,
 				"Severity":  string(generated.OK),
 			},
 		},
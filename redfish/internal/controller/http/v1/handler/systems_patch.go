@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/device-management-toolkit/console/redfish/internal/controller/http/v1/generated"
+	redfishv1 "github.com/device-management-toolkit/console/redfish/internal/entity/v1"
 	"github.com/device-management-toolkit/console/redfish/internal/usecase"
 )
 
@@ -51,7 +52,10 @@ func (s *RedfishServer) PatchRedfishV1SystemsComputerSystemId(c *gin.Context, co
 		return
 	}
 
-	c.JSON(http.StatusOK, updatedSystem)
+	resource := withSystemLinks(updatedSystem)
+	resource.Oem = s.getAMTOem(ctx, computerSystemID)
+
+	c.JSON(http.StatusOK, resource)
 }
 
 // handlePatchSystemError handles errors from PATCH operations on ComputerSystem.
@@ -59,6 +63,8 @@ func (s *RedfishServer) handlePatchSystemError(c *gin.Context, err error, system
 	switch {
 	case errors.Is(err, usecase.ErrSystemNotFound):
 		NotFoundError(c, "System", systemID)
+	case errors.Is(err, usecase.ErrSystemUnreachable):
+		ServiceUnavailableError(c, redfishv1.ServiceUnavailableRetryAfterSeconds)
 	case errors.Is(err, usecase.ErrInvalidBootSettings),
 		errors.Is(err, usecase.ErrInvalidBootTarget),
 		errors.Is(err, usecase.ErrInvalidBootEnabled):
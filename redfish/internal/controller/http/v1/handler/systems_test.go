@@ -12,6 +12,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/device-management-toolkit/console/redfish/internal/controller/http/v1/generated"
 	redfishv1 "github.com/device-management-toolkit/console/redfish/internal/entity/v1"
@@ -141,6 +142,14 @@ func (r *TestSystemsComputerSystemRepository) UpdateBootSettings(_ context.Conte
 	return usecase.ErrSystemNotFound
 }
 
+func (r *TestSystemsComputerSystemRepository) GetAMTOem(_ context.Context, systemID string) (*redfishv1.AMTOem, error) {
+	if _, exists := r.systems[systemID]; exists {
+		return &redfishv1.AMTOem{ControlMode: "CCM"}, nil
+	}
+
+	return nil, usecase.ErrSystemNotFound
+}
+
 // TestCase represents a generic test case structure
 type SystemsTestCase[T any] struct {
 	name           string
@@ -843,6 +852,33 @@ func TestSystemsHandler_GetSystemByID_WithLogger(t *testing.T) {
 	assert.Equal(t, "Failed to retrieve computer system", testLogger.ErrorCalls[0][0])
 }
 
+// TestSystemsHandler_GetSystemByID_Links tests that a system links to its chassis and manager
+func TestSystemsHandler_GetSystemByID_Links(t *testing.T) {
+	t.Parallel()
+
+	router, _ := setupLinkedResourcesRouter()
+
+	code, body := getLinkedResource(t, router, "/redfish/v1/Systems/"+testUUID1)
+	require.Equal(t, http.StatusOK, code)
+
+	links, ok := body["Links"].(map[string]interface{})
+	require.True(t, ok, "Links should be an object")
+	assert.Equal(t, []string{"/redfish/v1/Chassis/" + testUUID1}, linkedIDs(t, links, "Chassis"))
+	assert.Equal(t, []string{"/redfish/v1/Managers/" + testUUID1}, linkedIDs(t, links, "ManagedBy"))
+}
+
+// TestSystemsHandler_GetSystemByID_Oem tests that a system carries its AMT state as Oem.Intel_AMT
+func TestSystemsHandler_GetSystemByID_Oem(t *testing.T) {
+	t.Parallel()
+
+	router, _ := setupLinkedResourcesRouter()
+
+	code, body := getLinkedResource(t, router, "/redfish/v1/Systems/"+testUUID1)
+	require.Equal(t, http.StatusOK, code)
+
+	assert.Equal(t, map[string]interface{}{"Intel_AMT": map[string]interface{}{"ControlMode": "CCM"}}, body["Oem"])
+}
+
 // createTestSystemEntityDataWithMemory creates a test system entity with MemorySummary
 func createTestSystemEntityDataWithMemory(systemID, name, manufacturer, model, serialNumber string) *redfishv1.ComputerSystem {
 	system := createTestSystemEntityData(systemID, name, manufacturer, model, serialNumber)
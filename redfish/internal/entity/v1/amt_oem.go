@@ -0,0 +1,16 @@
+// Package redfish provides entity definitions for the Intel AMT OEM extension.
+package redfish
+
+// AMTOem represents the Intel AMT state of a system, served as Oem.Intel_AMT of its ComputerSystem and Manager.
+// The KVM and user consent properties are omitted when the device does not answer.
+type AMTOem struct {
+	ControlMode         string `json:"ControlMode,omitempty"`
+	CIRAConnected       *bool  `json:"CIRAConnected,omitempty"`
+	KVMAvailable        *bool  `json:"KVMAvailable,omitempty"`
+	KVMEnabled          *bool  `json:"KVMEnabled,omitempty"`
+	UserConsent         string `json:"UserConsent,omitempty"`
+	UserConsentRequired *bool  `json:"UserConsentRequired,omitempty"`
+}
+
+// UserConsentNone is the user consent setting of a device requiring no consent.
+const UserConsentNone = "none"
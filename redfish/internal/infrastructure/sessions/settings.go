@@ -0,0 +1,65 @@
+package sessions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/redfish/internal/usecase/sessions"
+)
+
+// SQLSettingsRepository persists SessionService settings in the console database.
+type SQLSettingsRepository struct {
+	*db.SQL
+}
+
+// NewSQLSettingsRepository creates a settings repository backed by the console database.
+func NewSQLSettingsRepository(database *db.SQL) *SQLSettingsRepository {
+	return &SQLSettingsRepository{database}
+}
+
+// GetSetting returns the stored value of a setting.
+func (r *SQLSettingsRepository) GetSetting(name string) (string, error) {
+	sqlQuery, args, err := r.Builder.
+		Select("value").
+		From("redfish_settings").
+		Where("name = ?", name).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("failed to build settings query: %w", err)
+	}
+
+	var value string
+
+	err = r.Pool.QueryRowContext(context.Background(), sqlQuery, args...).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", sessions.ErrSettingNotFound
+		}
+
+		return "", fmt.Errorf("failed to read setting %s: %w", name, err)
+	}
+
+	return value, nil
+}
+
+// SetSetting inserts or replaces the value of a setting.
+func (r *SQLSettingsRepository) SetSetting(name, value string) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("redfish_settings").
+		Columns("name", "value").
+		Values(name, value).
+		Suffix("ON CONFLICT (name) DO UPDATE SET value = EXCLUDED.value").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build settings statement: %w", err)
+	}
+
+	if _, err := r.Pool.ExecContext(context.Background(), sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to write setting %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package sessions_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/db"
+	sessioninfra "github.com/device-management-toolkit/console/redfish/internal/infrastructure/sessions"
+	"github.com/device-management-toolkit/console/redfish/internal/usecase/sessions"
+)
+
+func setupSettingsRepo(t *testing.T) *sessioninfra.SQLSettingsRepository {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { dbConn.Close() })
+
+	_, err = dbConn.ExecContext(context.Background(), `CREATE TABLE redfish_settings (name TEXT NOT NULL PRIMARY KEY, value TEXT NOT NULL)`)
+	require.NoError(t, err)
+
+	return sessioninfra.NewSQLSettingsRepository(&db.SQL{
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		Pool:    dbConn,
+	})
+}
+
+func TestSQLSettingsRepository(t *testing.T) {
+	t.Parallel()
+
+	repo := setupSettingsRepo(t)
+
+	_, err := repo.GetSetting("session_timeout")
+	require.ErrorIs(t, err, sessions.ErrSettingNotFound)
+
+	require.NoError(t, repo.SetSetting("session_timeout", "600"))
+	require.NoError(t, repo.SetSetting("session_timeout", "900"))
+
+	value, err := repo.GetSetting("session_timeout")
+	require.NoError(t, err)
+	assert.Equal(t, "900", value)
+}
+
+func TestUseCaseLoadsPersistedTimeout(t *testing.T) {
+	t.Parallel()
+
+	repo := setupSettingsRepo(t)
+	require.NoError(t, repo.SetSetting("session_timeout", "1200"))
+
+	memory := sessioninfra.NewInMemoryRepository(time.Minute)
+	t.Cleanup(memory.Stop)
+
+	uc := sessions.NewUseCase(memory, &config.Config{})
+	require.NoError(t, uc.UseSettings(repo))
+	assert.Equal(t, 1200, uc.SessionTimeout())
+
+	require.NoError(t, uc.SetSessionTimeout(300))
+
+	value, err := repo.GetSetting("session_timeout")
+	require.NoError(t, err)
+	assert.Equal(t, "300", value)
+}
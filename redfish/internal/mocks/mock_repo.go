@@ -205,3 +205,22 @@ func (r *MockComputerSystemRepo) UpdateBootSettings(_ context.Context, systemID
 	// Mock implementation accepts any valid boot settings
 	return nil
 }
+
+// GetAMTOem retrieves the Intel AMT state of a system (mock implementation).
+func (r *MockComputerSystemRepo) GetAMTOem(_ context.Context, systemID string) (*redfishv1.AMTOem, error) {
+	if _, exists := r.systems[systemID]; !exists {
+		return nil, usecase.ErrSystemNotFound
+	}
+
+	enabled := true
+
+	// Mock an admin control mode device connected over CIRA with KVM enabled and no user consent
+	return &redfishv1.AMTOem{
+		ControlMode:         "ACM",
+		CIRAConnected:       &enabled,
+		KVMAvailable:        &enabled,
+		KVMEnabled:          &enabled,
+		UserConsent:         redfishv1.UserConsentNone,
+		UserConsentRequired: new(bool),
+	}, nil
+}
@@ -31,6 +31,9 @@ var (
 
 	// ErrSystemNotFound is returned when a system is not found.
 	ErrSystemNotFound = errors.New("system not found")
+
+	// ErrSystemUnreachable is returned when a system cannot be connected to or does not answer.
+	ErrSystemUnreachable = errors.New("system unreachable")
 )
 
 // OData and schema constants for ComputerSystem.
@@ -81,6 +84,17 @@ func (uc *ComputerSystemUseCase) GetAll(ctx context.Context) ([]string, error) {
 	return uc.Repo.GetAll(ctx)
 }
 
+// GetSystem retrieves a ComputerSystem entity by its systemID, for the resources derived from the system such as
+// its Chassis and Manager.
+func (uc *ComputerSystemUseCase) GetSystem(ctx context.Context, systemID string) (*redfishv1.ComputerSystem, error) {
+	return uc.Repo.GetByID(ctx, systemID)
+}
+
+// GetAMTOem retrieves the Intel AMT state of a system, served as the Oem.Intel_AMT property of its resources.
+func (uc *ComputerSystemUseCase) GetAMTOem(ctx context.Context, systemID string) (*redfishv1.AMTOem, error) {
+	return uc.Repo.GetAMTOem(ctx, systemID)
+}
+
 // GetComputerSystem retrieves a ComputerSystem by its systemID and converts it to the generated API type.
 func (uc *ComputerSystemUseCase) GetComputerSystem(ctx context.Context, systemID string) (*generated.ComputerSystemComputerSystem, error) {
 	// Get device information from repository - this gives us basic device data
@@ -17,8 +17,14 @@ var (
 	// ErrInvalidToken is returned when a token is invalid.
 	ErrInvalidToken = errors.New("invalid token")
 
-	// ErrSessionAlreadyExists is returned when trying to create a session for a user who already has an active session.
-	ErrSessionAlreadyExists = errors.New("an active session already exists for this user")
+	// ErrSessionLimitReached is returned when a user already holds the maximum number of concurrent sessions.
+	ErrSessionLimitReached = errors.New("maximum number of concurrent sessions reached for this user")
+
+	// ErrSettingNotFound is returned when a SessionService setting has not been persisted.
+	ErrSettingNotFound = errors.New("setting not found")
+
+	// ErrInvalidSessionTimeout is returned when a session timeout is outside the range allowed by the Redfish schema.
+	ErrInvalidSessionTimeout = errors.New("session timeout out of range")
 )
 
 // Repository defines the interface for session storage.
@@ -44,3 +50,12 @@ type Repository interface {
 	// DeleteExpired removes all expired sessions
 	DeleteExpired() (int, error)
 }
+
+// SettingsRepository defines the interface for persisting SessionService configuration.
+type SettingsRepository interface {
+	// GetSetting returns the stored value of a setting, or ErrSettingNotFound
+	GetSetting(name string) (string, error)
+
+	// SetSetting stores the value of a setting
+	SetSetting(name, value string) error
+}
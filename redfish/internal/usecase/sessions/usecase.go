@@ -2,7 +2,10 @@
 package sessions
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -15,50 +18,152 @@ import (
 const (
 	// DefaultSessionTimeout is the default session timeout in seconds (30 minutes).
 	DefaultSessionTimeout = 1800
+
+	// MinSessionTimeout and MaxSessionTimeout bound SessionTimeout per the SessionService schema.
+	MinSessionTimeout = 30
+	MaxSessionTimeout = 86400
+
+	// DefaultMaxSessionsPerUser is the number of concurrent sessions an account may hold when not configured.
+	DefaultMaxSessionsPerUser = 1
+
+	// settingSessionTimeout is the settings key holding the SessionService timeout.
+	settingSessionTimeout = "session_timeout"
 )
 
 // UseCase defines the session management business logic.
 type UseCase struct {
 	repo           Repository
+	settings       SettingsRepository
 	config         *config.Config
 	sessionTimeout int // seconds
+	maxPerUser     int
+	mu             sync.RWMutex
 }
 
 // NewUseCase creates a new session use case.
+// The session timeout defaults to the configured Redfish session timeout, or DefaultSessionTimeout if unset.
 func NewUseCase(repo Repository, cfg *config.Config) *UseCase {
+	timeout := cfg.Redfish.SessionTimeout
+	if timeout < MinSessionTimeout || timeout > MaxSessionTimeout {
+		timeout = DefaultSessionTimeout
+	}
+
+	maxPerUser := cfg.Redfish.MaxSessionsPerUser
+	if maxPerUser <= 0 {
+		maxPerUser = DefaultMaxSessionsPerUser
+	}
+
 	return &UseCase{
 		repo:           repo,
 		config:         cfg,
-		sessionTimeout: DefaultSessionTimeout,
+		sessionTimeout: timeout,
+		maxPerUser:     maxPerUser,
+	}
+}
+
+// UseSettings attaches persistent SessionService settings and applies any stored session timeout.
+func (uc *UseCase) UseSettings(settings SettingsRepository) error {
+	uc.mu.Lock()
+	uc.settings = settings
+	uc.mu.Unlock()
+
+	value, err := settings.GetSetting(settingSessionTimeout)
+	if err != nil {
+		if errors.Is(err, ErrSettingNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to load session timeout: %w", err)
+	}
+
+	timeout, err := strconv.Atoi(value)
+	if err != nil || timeout < MinSessionTimeout || timeout > MaxSessionTimeout {
+		return fmt.Errorf("%w: stored value %q", ErrInvalidSessionTimeout, value)
+	}
+
+	uc.mu.Lock()
+	uc.sessionTimeout = timeout
+	uc.mu.Unlock()
+
+	return nil
+}
+
+// SessionTimeout returns the current session idle timeout in seconds.
+func (uc *UseCase) SessionTimeout() int {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	return uc.sessionTimeout
+}
+
+// SetSessionTimeout changes the session idle timeout, persists it when settings are attached,
+// and applies it to every existing session.
+func (uc *UseCase) SetSessionTimeout(seconds int) error {
+	if seconds < MinSessionTimeout || seconds > MaxSessionTimeout {
+		return fmt.Errorf("%w: %d (allowed %d-%d)", ErrInvalidSessionTimeout, seconds, MinSessionTimeout, MaxSessionTimeout)
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if uc.settings != nil {
+		if err := uc.settings.SetSetting(settingSessionTimeout, strconv.Itoa(seconds)); err != nil {
+			return fmt.Errorf("failed to persist session timeout: %w", err)
+		}
+	}
+
+	uc.sessionTimeout = seconds
+
+	existingSessions, err := uc.repo.List()
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
 	}
+
+	for _, session := range existingSessions {
+		session.TimeoutSeconds = seconds
+
+		if err := uc.repo.Update(session); err != nil {
+			return fmt.Errorf("failed to update session %s: %w", session.ID, err)
+		}
+	}
+
+	return nil
 }
 
 // CreateSession creates a new session with JWT token.
 // This integrates with DMT Console's existing JWT authentication.
-// If a session already exists for this user, it returns an error to prevent multiple concurrent sessions.
+// If the user already holds the maximum number of concurrent sessions, ErrSessionLimitReached is returned.
 func (uc *UseCase) CreateSession(username, password, clientIP, userAgent string) (*entity.Session, string, error) {
 	// Validate credentials using DMT Console's admin credentials
 	if username != uc.config.AdminUsername || password != uc.config.AdminPassword {
 		return nil, "", ErrInvalidCredentials
 	}
 
-	// Check if an active session already exists for this user
+	// Enforce the per-account concurrent session limit
 	existingSessions, err := uc.repo.List()
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to check existing sessions: %w", err)
 	}
 
+	userSessions := 0
+
 	for _, session := range existingSessions {
 		if session.Username == username && session.IsActive {
-			// Return error - cannot create duplicate session
-			return nil, "", ErrSessionAlreadyExists
+			userSessions++
 		}
 	}
 
+	if userSessions >= uc.maxPerUser {
+		return nil, "", ErrSessionLimitReached
+	}
+
 	// Generate unique session ID
 	sessionID := uuid.New().String()
 
-	// Create JWT token (reusing DMT Console's JWT infrastructure)
+	// Create JWT token (reusing DMT Console's JWT infrastructure).
+	// The idle timeout is enforced statefully from the SessionService timeout on every
+	// validation; the JWT expiry only remains as an absolute cap because the same token
+	// is accepted by the console REST API.
 	expirationTime := time.Now().Add(uc.config.JWTExpiration)
 	claims := jwt.RegisteredClaims{
 		ExpiresAt: jwt.NewNumericDate(expirationTime),
@@ -81,7 +186,7 @@ func (uc *UseCase) CreateSession(username, password, clientIP, userAgent string)
 		Token:          jwtToken,
 		CreatedTime:    time.Now(),
 		LastAccessTime: time.Now(),
-		TimeoutSeconds: uc.sessionTimeout,
+		TimeoutSeconds: uc.SessionTimeout(),
 		ClientIP:       clientIP,
 		UserAgent:      userAgent,
 		IsActive:       true,
@@ -141,6 +246,11 @@ func (uc *UseCase) ListSessions() ([]*entity.Session, error) {
 	return uc.repo.List()
 }
 
+// MaxSessionsPerUser returns the number of concurrent sessions an account may hold.
+func (uc *UseCase) MaxSessionsPerUser() int {
+	return uc.maxPerUser
+}
+
 // GetSessionCount returns the number of active sessions.
 func (uc *UseCase) GetSessionCount() (int, error) {
 	sessions, err := uc.repo.List()
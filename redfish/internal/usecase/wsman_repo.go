@@ -6,21 +6,21 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sync"
+	"time"
 
 	amtBoot "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
 	cimBoot "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
 
 	"github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/devices/wsman"
 	"github.com/device-management-toolkit/console/pkg/logger"
 	"github.com/device-management-toolkit/console/redfish/internal/controller/http/v1/generated"
 	redfishv1 "github.com/device-management-toolkit/console/redfish/internal/entity/v1"
 )
 
 const (
-	// ErrMsgDeviceNotFound is the error message returned by devices use case when device is not found.
-	ErrMsgDeviceNotFound = "DevicesUseCase -  - : "
-
 	// Power action constants for AMT/WSMAN power management.
 	powerActionPowerUp    = 2  // CIM Power Management Service - Power On
 	powerActionPowerCycle = 5  // Power Cycle (off then on)
@@ -76,6 +76,9 @@ const (
 
 	// Maximum items to process in arrays to prevent hangs.
 	maxArrayItems = 10
+
+	// amtFeaturesTTL is how long the KVM and user consent settings of a device are served from memory.
+	amtFeaturesTTL = 30 * time.Second
 )
 
 var (
@@ -120,6 +123,18 @@ type CIMPropertyConfig struct {
 type WsmanComputerSystemRepo struct {
 	usecase *devices.UseCase
 	log     logger.Interface
+	now     func() time.Time
+
+	mu       sync.Mutex // Protects features
+	features map[string]cachedAMTFeatures
+}
+
+// cachedAMTFeatures holds the KVM and user consent settings of a device until they expire.
+type cachedAMTFeatures struct {
+	kvmAvailable bool
+	kvmEnabled   bool
+	userConsent  string
+	expires      time.Time
 }
 
 // Forward declarations for transformer functions.
@@ -253,8 +268,10 @@ func NewWsmanComputerSystemRepo(uc *devices.UseCase, log logger.Interface) *Wsma
 	}
 
 	return &WsmanComputerSystemRepo{
-		usecase: uc,
-		log:     log,
+		usecase:  uc,
+		log:      log,
+		now:      time.Now,
+		features: map[string]cachedAMTFeatures{},
 	}
 }
 
@@ -470,9 +487,18 @@ func (r *WsmanComputerSystemRepo) extractFromMap(response interface{}, config CI
 	return r.extractFromSingleItem(responseMap, config.CIMProperty)
 }
 
-// isDeviceNotFoundError checks if the error indicates a device was not found.
-func (r *WsmanComputerSystemRepo) isDeviceNotFoundError(err error) bool {
-	return err != nil && err.Error() == ErrMsgDeviceNotFound
+// systemError maps the typed errors of the devices use case to those of the Redfish use case.
+func (r *WsmanComputerSystemRepo) systemError(err error) error {
+	var unreachableErr devices.UnreachableError
+
+	switch {
+	case errors.Is(err, devices.ErrNotFound):
+		return ErrSystemNotFound
+	case errors.As(err, &unreachableErr):
+		return fmt.Errorf("%w: %w", ErrSystemUnreachable, err)
+	default:
+		return err
+	}
 }
 
 // mapCIMPowerStateToRedfish converts CIM power state to Redfish PowerState.
@@ -829,12 +855,8 @@ func (r *WsmanComputerSystemRepo) GetAll(ctx context.Context) ([]string, error)
 func (r *WsmanComputerSystemRepo) GetByID(ctx context.Context, systemID string) (*redfishv1.ComputerSystem, error) {
 	// Verify device exists first
 	device, err := r.usecase.GetByID(ctx, systemID, "", true)
-	if r.isDeviceNotFoundError(err) {
-		return nil, ErrSystemNotFound
-	}
-
 	if err != nil {
-		return nil, err
+		return nil, r.systemError(err)
 	}
 
 	if device == nil {
@@ -843,12 +865,8 @@ func (r *WsmanComputerSystemRepo) GetByID(ctx context.Context, systemID string)
 
 	// Get power state from devices use case
 	powerState, err := r.usecase.GetPowerState(ctx, systemID)
-	if r.isDeviceNotFoundError(err) {
-		return nil, ErrSystemNotFound
-	}
-
 	if err != nil {
-		return nil, err
+		return nil, r.systemError(err)
 	}
 
 	// Map the integer power state to Redfish PowerState
@@ -891,11 +909,75 @@ func (r *WsmanComputerSystemRepo) UpdatePowerState(ctx context.Context, systemID
 
 	// Send power action command
 	_, err = r.usecase.SendPowerAction(ctx, systemID, action)
-	if r.isDeviceNotFoundError(err) {
-		return ErrSystemNotFound
+
+	return r.systemError(err)
+}
+
+// GetAMTOem retrieves the Intel AMT state of a system: its control mode as stored when it connected, whether it
+// is connected over CIRA when it connects that way and, when it answers, its KVM and user consent settings. The
+// settings take several round trips to the device, so they are cached for amtFeaturesTTL; a device that does not
+// answer is asked again the next time.
+func (r *WsmanComputerSystemRepo) GetAMTOem(ctx context.Context, systemID string) (*redfishv1.AMTOem, error) {
+	device, err := r.usecase.GetByID(ctx, systemID, "", false)
+	if err != nil {
+		return nil, r.systemError(err)
 	}
 
-	return err
+	if device == nil {
+		return nil, ErrSystemNotFound
+	}
+
+	oem := &redfishv1.AMTOem{ControlMode: device.ControlMode}
+
+	if device.MPSUsername != "" {
+		connected := wsman.CIRAConnected(systemID)
+		oem.CIRAConnected = &connected
+	}
+
+	features, ok := r.getAMTFeatures(ctx, systemID)
+	if !ok {
+		return oem, nil
+	}
+
+	consentRequired := features.userConsent != redfishv1.UserConsentNone
+	oem.KVMAvailable = &features.kvmAvailable
+	oem.KVMEnabled = &features.kvmEnabled
+	oem.UserConsent = features.userConsent
+	oem.UserConsentRequired = &consentRequired
+
+	return oem, nil
+}
+
+// getAMTFeatures returns the KVM and user consent settings of a device from the cache while they are fresh, from
+// the device otherwise. It returns false when the device does not answer.
+func (r *WsmanComputerSystemRepo) getAMTFeatures(ctx context.Context, systemID string) (cachedAMTFeatures, bool) {
+	r.mu.Lock()
+	cached, ok := r.features[systemID]
+	r.mu.Unlock()
+
+	if ok && r.now().Before(cached.expires) {
+		return cached, true
+	}
+
+	features, _, err := r.usecase.GetFeatures(ctx, systemID)
+	if err != nil {
+		r.log.Warn("Failed to get AMT features from device", "systemID", systemID, "error", err)
+
+		return cachedAMTFeatures{}, false
+	}
+
+	cached = cachedAMTFeatures{
+		kvmAvailable: features.KVMAvailable,
+		kvmEnabled:   features.EnableKVM,
+		userConsent:  features.UserConsent,
+		expires:      r.now().Add(amtFeaturesTTL),
+	}
+
+	r.mu.Lock()
+	r.features[systemID] = cached
+	r.mu.Unlock()
+
+	return cached, true
 }
 
 // GetBootSettings retrieves the current boot configuration for a system.
@@ -903,8 +985,8 @@ func (r *WsmanComputerSystemRepo) GetBootSettings(ctx context.Context, systemID
 	// Get current boot data from AMT via devices use case
 	bootData, err := r.usecase.GetBootData(ctx, systemID)
 	if err != nil {
-		if r.isDeviceNotFoundError(err) {
-			return nil, ErrSystemNotFound
+		if err := r.systemError(err); errors.Is(err, ErrSystemNotFound) || errors.Is(err, ErrSystemUnreachable) {
+			return nil, err
 		}
 
 		r.log.Warn("Failed to get boot data from device", "systemID", systemID, "error", err)
@@ -967,8 +1049,8 @@ func (r *WsmanComputerSystemRepo) UpdateBootSettings(ctx context.Context, system
 	// Get current boot data to preserve settings
 	bootData, err := r.usecase.GetBootData(ctx, systemID)
 	if err != nil {
-		if r.isDeviceNotFoundError(err) {
-			return ErrSystemNotFound
+		if err := r.systemError(err); errors.Is(err, ErrSystemNotFound) || errors.Is(err, ErrSystemUnreachable) {
+			return err
 		}
 
 		return fmt.Errorf("failed to get current boot data: %w", err)